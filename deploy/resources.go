@@ -51,252 +51,252 @@ var assets = func() http.FileSystem {
 			modTime:          time.Time{},
 			uncompressedSize: 357,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x90\x3d\x4f\xc4\x30\x0c\x86\xf7\xfc\x0a\xab\x7b\x83\xd8\x50\x36\x58\xd8\x18\x8a\xc4\xee\xa6\x06\x4c\x93\x38\xca\x47\x07\xaa\xfe\x77\xd4\x16\xe9\x7a\xd2\xa9\x37\x26\x8f\x5e\xfb\xf1\x3b\x72\x18\x0c\x74\xe2\xe8\x85\xc3\xc0\xe1\x4b\x61\xe4\x0f\x4a\x99\x25\x18\x48\x3d\x5a\x8d\xb5\x7c\x4b\xe2\x5f\x2c\x2c\x41\x8f\x4f\x59\xb3\x3c\x4c\x8f\xca\x53\xc1\x01\x0b\x1a\x05\x10\xd0\x93\x81\x79\x06\xfd\x86\x9e\x60\x59\xfe\xff\x72\x44\x7b\x00\xdb\x73\xa7\x0e\x7b\x72\x79\xcd\x02\x60\x8c\x06\x1a\x8b\x9e\x5c\x3b\x36\x2a\xd7\xfe\x87\x6c\xd9\x60\x0b\xbb\xe1\x3b\xa5\x89\x2d\x3d\x5b\x2b\x35\x94\x2d\x75\x3e\xff\xe8\x74\x1d\x5e\x79\x12\x47\x1d\x7d\xae\x1b\x2e\x0d\xdc\x75\xbe\x75\x25\x46\x7e\x4d\x52\xe3\x49\x59\xea\x2f\x00\x00\xff\xff\xe6\x36\xce\x65\x65\x01\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x90\x3d\x4f\xc4\x30\x0c\x86\xf7\xfc\x0a\xab\x7b\x83\xd8\x50\x36\x58\xd8\x18\x8a\xc4\xee\xa6\x06\x4c\x93\x38\xca\x47\x07\xaa\xfe\x77\xd4\x16\xe9\x7a\xd2\xa9\x37\x26\x8f\x5e\xfb\xf1\x3b\x72\x18\x0c\x74\xe2\xe8\x85\xc3\xc0\xe1\x4b\x61\xe4\x0f\x4a\x99\x25\x18\x48\x3d\x5a\x8d\xb5\x7c\x4b\xe2\x5f\x2c\x2c\x41\x8f\x4f\x59\xb3\x3c\x4c\x8f\xca\x53\xc1\x01\x0b\x1a\x05\x10\xd0\x93\x81\x79\x06\xfd\x86\x9e\x60\x59\xfe\xff\x72\x44\x7b\x00\xdb\x73\xa7\x0e\x7b\x72\x79\xcd\x02\x60\x8c\x06\x1a\x8b\x9e\x5c\x3b\x36\x2a\xd7\xfe\x87\x6c\xd9\x60\x0b\xbb\xe1\x3b\xa5\x89\x2d\x3d\x5b\x2b\x35\x94\x2d\x75\x3e\xff\xe8\x74\x1d\x5e\x79\x12\x47\x1d\x7d\xae\x1b\x2e\x0d\xdc\x75\xbe\x75\x25\x46\x7e\x4d\x52\xe3\x49\x59\xea\x6f\x00\xe6\x36\xce\x65\x65\x01\x00\x00"),
 		},
 		"/addons/master/master-role-configmap.tmpl": &vfsgen۰CompressedFileInfo{
 			name:             "master-role-configmap.tmpl",
 			modTime:          time.Time{},
 			uncompressedSize: 342,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x8d\xb1\x4e\x04\x31\x0c\x44\xfb\x7c\x85\xb5\xfd\x2e\xa2\x43\xf9\x01\x3a\x0a\x0a\x7a\x6f\x62\xee\xac\x4d\x62\xcb\x49\x0e\x89\xd3\xfd\x3b\x22\x7b\x2b\x21\x68\xa8\x3c\x7e\x33\xf6\x6c\x5c\xa2\x87\x57\x49\xe4\x50\xf9\x8d\xac\xb2\x14\x0f\xb6\x62\x58\xb0\xb7\xb3\x18\x7f\x62\x63\x29\xcb\xf6\x54\x17\x96\x87\xcb\xa3\xcb\xd4\x30\x62\x43\xef\x00\x0a\x66\xf2\x70\xbd\xc2\xf2\x82\x99\xe0\x76\xbb\xb3\xaa\x18\x7e\x18\x63\xdd\xdd\x84\x2b\xa5\xfa\x7d\x0b\x80\xaa\x1e\xa6\x80\x99\xd2\xbc\x4d\xce\x7a\xa2\xea\xdd\x0c\xa8\xfc\x6c\xd2\x75\xc4\x66\x98\x26\x07\x60\x54\xa5\x5b\xa0\x3b\x0b\x52\xde\xf9\x94\x51\xab\x03\xb8\x90\xad\x07\x37\xc2\x46\x43\x9e\xa8\x8d\x99\xb8\xee\x42\xb1\x85\xf3\x50\x5d\xe3\x91\xfa\x18\xf0\x5f\x9d\x2a\xf1\x57\xdb\x9f\x8a\xfd\xdb\x57\x00\x00\x00\xff\xff\xc4\x4d\x51\x51\x56\x01\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x8d\xb1\x4e\x03\x31\x10\x44\x7b\x7f\xc5\xea\xfa\x3b\x44\x87\xfc\x03\x74\x14\x14\xf4\x73\xbe\x25\x67\x9d\xcf\xbb\x5a\xdb\x89\x44\x94\x7f\x47\x38\x89\x84\xa0\x49\xe5\xf1\x9b\x59\xbd\x2d\xe6\xc5\xd3\xbb\x24\x76\xd0\xf8\xc1\x56\xa2\x64\x4f\x36\x23\x4c\x68\x75\x15\x8b\x5f\xa8\x51\xf2\xb4\xbd\x94\x29\xca\xd3\xf1\xd9\xed\x5c\xb1\xa0\xc2\x3b\xa2\x8c\x9d\x3d\x9d\xcf\x34\xbd\x61\x67\xba\x5c\x6e\xac\x28\xc2\xaf\xa2\x7f\xaf\x6d\xc2\xcc\xa9\xfc\xdc\x12\x41\xd5\xd3\x10\xb0\x73\x1a\xb7\xc1\x59\x4b\x5c\xbc\x1b\x09\x1a\x5f\x4d\x9a\xf6\xd9\x48\xc3\xe0\x88\x8c\x8b\x34\x0b\x7c\x63\x41\xf2\x67\x3c\xec\xd0\xe2\x88\x8e\x6c\xf3\x9d\x1b\xa3\x72\x8f\x07\xae\xfd\x4d\xb1\x5c\x83\xa2\x86\xb5\xa7\xa6\xcb\x7d\x75\xea\xf0\x21\xa7\xca\xf2\xc7\xf6\x4f\x71\x42\x0d\xab\xfb\x1e\x00\xc4\x4d\x51\x51\x56\x01\x00\x00"),
 		},
 		"/addons/master/master-role-lease.tmpl": &vfsgen۰CompressedFileInfo{
 			name:             "master-role-lease.tmpl",
 			modTime:          time.Time{},
 			uncompressedSize: 389,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x8e\x31\x4e\xc4\x30\x10\x45\x7b\x9f\x62\x94\x3e\x41\x74\xc8\x17\xa0\xa3\xa0\xa0\x9f\xd8\x5f\xac\x15\xc7\x63\x8d\xed\x45\x62\xb5\x77\x47\xeb\x04\x69\x21\xdb\x7d\xff\x19\xbf\x79\x4b\x48\xde\xd2\xbb\x44\x18\xce\xe1\x03\x5a\x82\x24\x4b\x3a\xb3\x9b\xb8\xd5\x93\x68\xf8\xe6\x1a\x24\x4d\xcb\x4b\x99\x82\x3c\x9d\x9f\xcd\x8a\xca\x9e\x2b\x5b\x43\x94\x78\x85\xa5\xcb\x85\xa6\x37\x5e\x41\xd7\xeb\xde\x95\xcc\xee\x6e\xd0\x9f\xdb\x34\xf2\x8c\x58\x6e\x7f\x89\x38\x67\x4b\x83\xe3\x15\x71\x5c\x06\xa3\x2d\xa2\x58\x33\x12\xe7\xf0\xaa\xd2\x72\x5f\x1b\x69\x70\x22\xea\x43\xba\x17\x19\x0c\x91\xa2\x48\x53\x87\x7d\x2d\x82\x0b\x8a\x21\x3a\x43\xe7\xbd\x73\x0a\xae\xe8\xd1\x23\xe2\x4f\x74\x12\x23\xdc\x8d\xd9\xcb\x4f\xd4\x0d\x13\xca\x16\x32\x57\x77\xea\xa9\x65\xff\x4b\xf9\xea\xe5\x51\xf1\x81\x4f\x16\xff\xcf\xe6\x70\x62\xa3\xfd\x04\x00\x00\xff\xff\xe4\xea\xfb\x8f\x85\x01\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x8e\xb1\x4e\xc4\x30\x0c\x86\xf7\x3c\x85\xd5\xbd\x45\x6c\x28\x2f\xc0\xc6\xc0\xc0\xee\x3a\x16\x8d\xea\xc6\x91\x93\xdc\x49\x9c\xee\xdd\xd1\xe5\x8a\x74\x50\xa6\xfc\xf9\xec\xfc\xf9\xd6\x98\x82\x87\x77\x15\x76\x98\xe3\x07\x5b\x89\x9a\x3c\xd8\x8c\x34\x61\xab\x8b\x5a\xfc\xc2\x1a\x35\x4d\xeb\x4b\x99\xa2\x3e\x9d\x9e\xdd\xc6\x15\x03\x56\xf4\x0e\x20\xe1\xc6\x1e\x2e\x17\x98\xde\x70\x63\xb8\x5e\x77\x56\x32\xd2\xc3\xa0\x5f\xef\x53\xc1\x99\xa5\xdc\xde\x02\x60\xce\x1e\x06\xc2\x8d\x65\x5c\x07\x67\x4d\xb8\x78\x37\x02\xe6\xf8\x6a\xda\x72\x5f\x1b\x61\x20\x55\x0b\x31\x3d\x8a\x0c\x0e\xc0\xb8\x68\x33\xe2\x7d\x4d\x18\x0b\x17\x07\x70\x62\x9b\x77\x46\xc6\x58\xb9\xc7\xc0\xc2\xbf\x22\xa9\x08\xd3\xad\xb3\xc3\x4f\xae\xfd\x94\x58\xee\x21\x63\xa5\xa5\xa7\x96\xc3\x4f\xcb\xb9\xc3\xa3\xe2\x3f\x3e\x59\xc3\x1f\x9b\xc3\x17\x67\xac\xb4\xb8\xef\x01\x00\xe4\xea\xfb\x8f\x85\x01\x00\x00"),
 		},
 		"/builder-role-binding.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "builder-role-binding.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 1202,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x93\x41\x6f\xe3\x36\x14\x84\xef\xfc\x15\x03\xeb\x92\x00\xb6\xdc\xf6\x54\xb8\x27\x25\xb1\x5b\xa1\x81\x0d\x58\xce\x06\x39\x52\xd4\xb3\xf4\xd6\x14\xa9\x25\xa9\x28\xde\x5f\xbf\xa0\x6c\x6f\x12\x2c\x36\xa7\xf0\x26\xe8\x69\xde\x37\x9c\x51\x82\xd9\xe7\x1d\x91\xe0\x9e\x15\x19\x4f\x15\x82\x45\x68\x08\x59\x27\x55\x43\x28\xec\x3e\x0c\xd2\x11\x56\xb6\x37\x95\x0c\x6c\x0d\xae\xb2\x62\x75\x8d\xde\x54\xe4\x60\x0d\xc1\x3a\xb4\xd6\x91\x48\xa0\xac\x09\x8e\xcb\x3e\x58\x07\x7d\x12\x84\xac\x1d\x51\x4b\x26\xf8\x14\x28\x88\x46\xf5\xf5\x66\x97\xdf\x2e\xb1\x67\x4d\xa8\xd8\x9f\x3e\xa2\x0a\x03\x87\x46\x24\x08\x0d\x7b\x0c\xd6\x1d\xb0\xb7\x0e\xb2\xaa\x38\x2e\x96\x1a\x6c\xf6\xd6\xb5\x27\x0c\x47\xb5\x74\x15\x9b\x1a\xca\x76\x47\xc7\x75\x13\x60\x07\x43\xce\x37\xdc\xa5\x22\xc1\x2e\xda\x28\x56\x17\x12\x7f\x92\x1d\x77\x06\x8b\x27\xdb\x9f\x3d\xbc\xb1\x7b\xbe\x85\x29\xbe\x90\xf3\x71\xc9\x5f\xe9\x1f\x22\xc1\x55\x1c\x99\x9c\x5f\x4e\xae\xff\xc1\xd1\xf6\x68\xe5\x11\xc6\x06\xf4\x9e\xde\x28\xd3\x8b\xa2\x2e\x80\x0d\x94\x6d\x3b\xcd\xd2\x28\x7a\xb5\xf5\x73\x43\x8a\x11\x20\x6a\xd8\x32\x48\x36\x90\xa3\x0d\xd8\xfd\xdb\x31\xc8\x20\x12\x91\x60\x3c\x4d\x08\xdd\x62\x3e\x1f\x86\x21\x95\x23\x6e\x6a\x5d\x3d\xbf\xb8\x9b\xdf\xe7\xb7\xcb\x75\xb1\x9c\x8d\xc8\x22\xc1\x83\xd1\xe4\x3d\x1c\x7d\xeb\xd9\x51\x85\xf2\x08\xd9\x75\x9a\x95\x2c\x35\x41\xcb\x21\x06\x37\xa6\x33\x86\xce\x06\x83\xe3\xc0\xa6\x9e\xc2\x9f\x53\x17\xc9\xbb\x74\x5e\xaf\xeb\x82\xc7\xfe\xdd\x80\x35\x90\x06\x93\xac\x40\x5e\x4c\x70\x93\x15\x79\x31\x15\x09\x1e\xf3\xdd\x7f\x9b\x87\x1d\x1e\xb3\xed\x36\x5b\xef\xf2\x65\x81\xcd\x16\xb7\x9b\xf5\x5d\xbe\xcb\x37\xeb\x02\x9b\x15\xb2\xf5\x13\xfe\xcf\xd7\x77\x53\x10\x87\x86\x1c\xe8\xa5\x73\x91\xdf\x3a\x70\xbc\x48\xaa\x62\xa6\x97\x02\x5d\x00\x62\x3f\xe2\xb3\xef\x48\xf1\x9e\x15\xb4\x34\x75\x2f\x6b\x42\x6d\x9f\xc9\x99\x58\x8f\x8e\x5c\xcb\x3e\xc6\xe9\x21\x4d\x25\x12\x68\x6e\x39\x8c\x2d\xf2\xbf\x9a\x8a\x6b\x3e\xf3\xdf\x12\x07\x36\xd5\x02\x5b\xab\xe9\x86\x4d\x2c\xac\x90\x1d\x9f\x0b\xb6\x80\x2b\xa5\x4a\x65\x1f\x1a\xeb\xf8\xfb\xc8\x94\x1e\xfe\xf6\x29\xdb\xf9\xf3\x9f\xa2\xa5\x20\x2b\x19\xe4\x42\x00\x46\xb6\xb4\x80\x92\x2d\xe9\xd9\x61\x56\xf6\xac\x2b\x72\x02\xd0\xb2\x24\xed\xe3\x04\x62\xc0\x0b\x4c\xce\x33\x13\xe1\xfb\xf2\x2b\xa9\xe0\x17\x62\x86\x13\x45\x41\xee\x99\x15\x65\x4a\xd9\xde\x84\xdf\xaa\x3a\xab\x69\x4b\xfb\x28\xfa\x4a\xff\x01\x83\xec\xf8\x5f\x67\xfb\xee\x03\x3b\xe2\x47\x00\x00\x00\xff\xff\x40\x55\xd6\x57\xb2\x04\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x93\x41\x6f\xe3\x36\x14\x84\xef\xfc\x15\x03\xeb\x92\x00\xb6\xdc\xf6\x54\xb8\x27\x25\xb1\x5b\xa1\x81\x0d\x58\xce\x06\x39\x52\xd4\xb3\xf4\xd6\x14\xa9\x25\xa9\x28\xde\x5f\xbf\xa0\x6c\x6f\x12\x2c\x36\xa7\xf0\x26\xe8\x69\xde\x37\x9c\x51\x82\xd9\xe7\x1d\x91\xe0\x9e\x15\x19\x4f\x15\x82\x45\x68\x08\x59\x27\x55\x43\x28\xec\x3e\x0c\xd2\x11\x56\xb6\x37\x95\x0c\x6c\x0d\xae\xb2\x62\x75\x8d\xde\x54\xe4\x60\x0d\xc1\x3a\xb4\xd6\x91\x48\xa0\xac\x09\x8e\xcb\x3e\x58\x07\x7d\x12\x84\xac\x1d\x51\x4b\x26\xf8\x14\x28\x88\x46\xf5\xf5\x66\x97\xdf\x2e\xb1\x67\x4d\xa8\xd8\x9f\x3e\xa2\x0a\x03\x87\x46\x24\x08\x0d\x7b\x0c\xd6\x1d\xb0\xb7\x0e\xb2\xaa\x38\x2e\x96\x1a\x6c\xf6\xd6\xb5\x27\x0c\x47\xb5\x74\x15\x9b\x1a\xca\x76\x47\xc7\x75\x13\x60\x07\x43\xce\x37\xdc\xa5\x22\xc1\x2e\xda\x28\x56\x17\x12\x7f\x92\x1d\x77\x06\x8b\x27\xdb\x9f\x3d\xbc\xb1\x7b\xbe\x85\x29\xbe\x90\xf3\x71\xc9\x5f\xe9\x1f\x22\xc1\x55\x1c\x99\x9c\x5f\x4e\xae\xff\xc1\xd1\xf6\x68\xe5\x11\xc6\x06\xf4\x9e\xde\x28\xd3\x8b\xa2\x2e\x80\x0d\x94\x6d\x3b\xcd\xd2\x28\x7a\xb5\xf5\x73\x43\x8a\x11\x20\x6a\xd8\x32\x48\x36\x90\xa3\x0d\xd8\xfd\xdb\x31\xc8\x20\x12\x91\x60\x3c\x4d\x08\xdd\x62\x3e\x1f\x86\x21\x95\x63\x3a\xa9\x75\xf5\xfc\xe2\x6e\x7e\x9f\xdf\x2e\xd7\xc5\x72\x36\x22\x8b\x04\x0f\x46\x93\xf7\x70\xf4\xad\x67\x47\x15\xca\x23\x64\xd7\x69\x56\xb2\xd4\x04\x2d\x87\x18\xdc\x98\xce\x18\x3a\x1b\x0c\x8e\x03\x9b\x7a\x0a\x7f\x4e\x5d\x24\xef\xd2\x79\xbd\xae\x0b\x1e\xfb\x77\x03\xd6\x40\x1a\x4c\xb2\x02\x79\x31\xc1\x4d\x56\xe4\xc5\x54\x24\x78\xcc\x77\xff\x6d\x1e\x76\x78\xcc\xb6\xdb\x6c\xbd\xcb\x97\x05\x36\x5b\xdc\x6e\xd6\x77\xf9\x2e\xdf\xac\x0b\x6c\x56\xc8\xd6\x4f\xf8\x3f\x5f\xdf\x4d\x41\x1c\x1a\x72\xa0\x97\xce\x45\x7e\xeb\xc0\xf1\x22\xa9\x8a\x99\x5e\x0a\x74\x01\x88\xfd\x88\xcf\xbe\x23\xc5\x7b\x56\xd0\xd2\xd4\xbd\xac\x09\xb5\x7d\x26\x67\x62\x3d\x3a\x72\x2d\xfb\x18\xa7\x87\x34\x95\x48\xa0\xb9\xe5\x30\xb6\xc8\xff\x6a\x2a\xae\xb9\xfc\x18\x9f\x70\x84\x38\xb0\xa9\x16\xd8\x5a\x4d\x37\x6c\x62\x61\x85\xec\xf8\x5c\xb0\x05\x5c\x29\x55\x2a\xfb\xd0\x58\xc7\xdf\x47\xa6\xf4\xf0\xb7\x4f\xd9\xce\x9f\xff\x14\x2d\x05\x59\xc9\x20\x17\x02\x30\xb2\xa5\x05\x94\x6c\x49\xcf\x0e\xb3\xb2\x67\x5d\x91\x13\x80\x96\x25\x69\x1f\x27\x10\x03\x5e\x60\x72\x9e\x99\x08\xdf\x97\x5f\x49\x05\xbf\x10\x33\x9c\x28\x0a\x72\xcf\xac\x28\x53\xca\xf6\x26\xfc\x56\xd5\x59\x4d\x5b\xda\x47\xd1\x57\xfa\x0f\x18\x64\xc7\xff\x3a\xdb\x77\x1f\xd8\x11\x3f\x06\x00\x40\x55\xd6\x57\xb2\x04\x00\x00"),
 		},
 		"/builder-role-kubernetes.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "builder-role-kubernetes.yaml",
 			modTime:          time.Time{},
-			uncompressedSize: 1451,
+			uncompressedSize: 1682,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x54\xc1\x6e\x1b\x37\x10\xbd\xf3\x2b\x1e\x76\x2f\x49\x61\x49\x6d\x4f\x85\x7a\x52\x1d\xbb\x5d\x34\x90\x00\xaf\xd2\x20\x47\x8a\x3b\xda\x1d\x98\x4b\xb2\x43\xae\x37\xee\xd7\x17\xa4\xa4\x46\xae\x2f\x09\x10\x5e\x34\xa4\x1e\xdf\xbc\xc7\x37\x52\x8d\xc5\xf7\x5b\xaa\xc6\x7b\x36\xe4\x22\x75\x48\x1e\x69\x20\x6c\x82\x36\x03\xa1\xf5\xc7\x34\x6b\x21\xdc\xfb\xc9\x75\x3a\xb1\x77\x78\xb3\x69\xef\xdf\x62\x72\x1d\x09\xbc\x23\x78\xc1\xe8\x85\x54\x0d\xe3\x5d\x12\x3e\x4c\xc9\x0b\xec\x89\x10\xba\x17\xa2\x91\x5c\x8a\x4b\xa0\x25\x2a\xec\xdb\xdd\xbe\xb9\xbd\xc3\x91\x2d\xa1\xe3\x78\xba\x44\x1d\x66\x4e\x83\xaa\x91\x06\x8e\x98\xbd\x3c\xe2\xe8\x05\xba\xeb\x38\x37\xd6\x16\xec\x8e\x5e\xc6\x93\x0c\xa1\x5e\x4b\xc7\xae\x87\xf1\xe1\x59\xb8\x1f\x12\xfc\xec\x48\xe2\xc0\x61\xa9\x6a\xec\xb3\x8d\xf6\xfe\xa2\x24\x9e\x68\x4b\xcf\xe4\xf1\xc9\x4f\x67\x0f\x57\x76\xcf\xaf\x70\x83\xbf\x48\x62\x6e\xf2\xf3\xf2\x47\x55\xe3\x4d\x86\x54\xe7\x2f\xab\xb7\xbf\xe2\xd9\x4f\x18\xf5\x33\x9c\x4f\x98\x22\x5d\x31\xd3\x67\x43\x21\x81\x1d\x8c\x1f\x83\x65\xed\x0c\x7d\xb1\xf5\x5f\x87\x25\x8a\x80\xcc\xe1\x0f\x49\xb3\x83\x2e\x36\xe0\x8f\xd7\x30\xe8\xa4\x6a\x55\xa3\xac\x21\xa5\xb0\x5e\xad\xe6\x79\x5e\xea\x22\x77\xe9\xa5\x5f\x5d\xdc\xad\xde\x37\xb7\x77\xdb\xf6\x6e\x51\x24\xab\x1a\x1f\x9c\xa5\x18\x21\xf4\xf7\xc4\x42\x1d\x0e\xcf\xd0\x21\x58\x36\xfa\x60\x09\x56\xcf\x39\xb8\x92\x4e\x09\x9d\x1d\x66\xe1\xc4\xae\xbf\x41\x3c\xa7\xae\xea\x17\xe9\x7c\x79\xae\x8b\x3c\x8e\x2f\x00\xde\x41\x3b\x54\x9b\x16\x4d\x5b\xe1\xb7\x4d\xdb\xb4\x37\xaa\xc6\xc7\x66\xff\xc7\xee\xc3\x1e\x1f\x37\x0f\x0f\x9b\xed\xbe\xb9\x6b\xb1\x7b\xc0\xed\x6e\xfb\xae\xd9\x37\xbb\x6d\x8b\xdd\x3d\x36\xdb\x4f\xf8\xb3\xd9\xbe\xbb\x01\x71\x1a\x48\x40\x9f\x83\x64\xfd\x5e\xc0\xf9\x21\xa9\xcb\x99\x5e\x06\xe8\x22\x20\xcf\x47\xde\xc7\x40\x86\x8f\x6c\x60\xb5\xeb\x27\xdd\x13\x7a\xff\x44\xe2\xf2\x78\x04\x92\x91\x63\x8e\x33\x42\xbb\x4e\xd5\xb0\x3c\x72\x2a\x53\x14\x5f\x9b\xca\x6d\xbe\xe7\x6f\x4b\x3d\xb2\xeb\xd6\x78\xf0\x96\x94\x0e\x7c\x9e\xac\x35\xe4\xa0\xcd\x52\x4f\x69\xf0\xc2\xff\x14\x31\xcb\xc7\x5f\xe2\x92\xfd\xea\xe9\x27\x35\x52\xd2\x9d\x4e\x7a\xad\x00\xa7\x47\x5a\xc3\xe8\x91\xec\xe2\x71\x71\x98\xd8\x76\x24\x0a\xb0\xfa\x40\x36\x66\x04\x72\xb2\x6b\x54\x67\x4c\xa5\x64\xb2\x14\xd7\x6a\x01\x1d\xf8\x77\xf1\x53\x28\xb0\xc5\x89\xe4\x6a\x7a\x14\x20\x14\xfd\x24\x86\xce\x88\xea\x87\x4a\x01\x4f\x24\x87\xab\x83\x57\x3c\x55\xf5\xfa\x66\xf0\x5d\x7c\x79\xd5\x08\xe9\x44\xa5\xec\xc8\xd2\x8b\xd2\x78\x6b\xc9\x64\xd7\xe5\xb0\xa7\x54\x3e\x2d\xc7\x53\x11\x74\x32\x43\xa9\xa6\xd0\x5d\x58\xe6\x72\xf8\x55\x6a\x8c\x77\x47\xee\x47\x1d\x62\xd9\x46\x32\x42\xe9\x7f\xfa\x5e\x35\xfd\x06\x7e\x7a\xca\x7f\x69\x5f\xc5\xf7\x6f\x00\x00\x00\xff\xff\x1f\xdb\xb4\x5c\xab\x05\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x53\x4d\x6f\xe3\x36\x10\xbd\xf3\x57\x3c\x48\x97\xdd\x22\x96\xdb\x9e\x0a\xf7\xe4\x66\x93\x56\xe8\xc2\x06\x22\x6f\x17\x7b\xa4\xa9\xb1\x34\x08\x45\xb2\x43\x2a\x4a\xfa\xeb\x0b\xca\x76\x37\x6e\x5a\x20\x0b\x84\x17\xd3\xe4\xf0\x7d\xcc\x1b\x95\x58\xbc\xdd\x52\x25\x3e\xb2\x21\x17\xa9\x45\xf2\x48\x3d\x61\x1d\xb4\xe9\x09\x8d\x3f\xa4\x49\x0b\xe1\xd6\x8f\xae\xd5\x89\xbd\xc3\xbb\x75\x73\xfb\x1e\xa3\x6b\x49\xe0\x1d\xc1\x0b\x06\x2f\xa4\x4a\x18\xef\x92\xf0\x7e\x4c\x5e\x60\x8f\x80\xd0\x9d\x10\x0d\xe4\x52\xac\x80\x86\x68\x46\xdf\x6c\x77\xf5\xf5\x0d\x0e\x6c\x09\x2d\xc7\xe3\x23\x6a\x31\x71\xea\x55\x89\xd4\x73\xc4\xe4\xe5\x1e\x07\x2f\xd0\x6d\xcb\x99\x58\x5b\xb0\x3b\x78\x19\x8e\x32\x84\x3a\x2d\x2d\xbb\x0e\xc6\x87\x27\xe1\xae\x4f\xf0\x93\x23\x89\x3d\x87\x4a\x95\xd8\x65\x1b\xcd\xed\x59\x49\x3c\xc2\xce\x9c\xc9\xe3\x8b\x1f\x4f\x1e\x9e\xd9\x3d\x75\xe1\x0a\x7f\x90\xc4\x4c\xf2\x63\xf5\xbd\x2a\xf1\x2e\x97\x14\xa7\xcb\xe2\xfd\xcf\x78\xf2\x23\x06\xfd\x04\xe7\x13\xc6\x48\xcf\x90\xe9\xd1\x50\x48\x60\x07\xe3\x87\x60\x59\x3b\x43\x5f\x6d\xfd\xc3\x50\x61\x16\x90\x31\xfc\x3e\x69\x76\xd0\xb3\x0d\xf8\xc3\xf3\x32\xe8\xa4\x4a\x55\x62\x5e\x7d\x4a\x61\xb5\x5c\x4e\xd3\x54\xe9\x39\x9d\xca\x4b\xb7\x3c\xbb\x5b\x7e\xac\xaf\x6f\x36\xcd\xcd\x62\x96\xac\x4a\x7c\x72\x96\x62\x84\xd0\x9f\x23\x0b\xb5\xd8\x3f\x41\x87\x60\xd9\xe8\xbd\x25\x58\x3d\xe5\xe0\xe6\x74\xe6\xd0\xd9\x61\x12\x4e\xec\xba\x2b\xc4\x53\xea\xaa\xbc\x48\xe7\x6b\xbb\xce\xf2\x38\x5e\x14\x78\x07\xed\x50\xac\x1b\xd4\x4d\x81\x5f\xd6\x4d\xdd\x5c\xa9\x12\x9f\xeb\xdd\x6f\xdb\x4f\x3b\x7c\x5e\xdf\xdd\xad\x37\xbb\xfa\xa6\xc1\xf6\x0e\xd7\xdb\xcd\x87\x7a\x57\x6f\x37\x0d\xb6\xb7\x58\x6f\xbe\xe0\xf7\x7a\xf3\xe1\x0a\xc4\xa9\x27\x01\x3d\x06\xc9\xfa\xbd\x80\x73\x23\xa9\xcd\x99\x9e\x07\xe8\x2c\x20\xcf\x47\xfe\x1f\x03\x19\x3e\xb0\x81\xd5\xae\x1b\x75\x47\xe8\xfc\x03\x89\xcb\xe3\x11\x48\x06\x8e\x39\xce\x08\xed\x5a\x55\xc2\xf2\xc0\x69\x9e\xa2\xf8\xd2\x54\xa6\x39\x7f\x18\x6f\xb0\x94\xba\x67\xd7\xae\x70\xe7\x2d\x29\x1d\xf8\x34\x59\x2b\xc8\x5e\x9b\x4a\x8f\xa9\xf7\xc2\x7f\xcd\x62\xaa\xfb\x9f\x62\xc5\x7e\xf9\xf0\x83\x1a\x28\xe9\x56\x27\xbd\x52\x80\xd3\x03\xad\x60\xf4\x40\x76\x71\xbf\xd8\x8f\x6c\x5b\x12\x05\x58\xbd\x27\x1b\x73\x05\x72\xb2\x2b\x14\xa7\x9a\x42\xc9\x68\x29\xae\xd4\x02\x3a\xf0\xaf\xe2\xc7\x30\x97\x2d\x8e\x20\xcf\xa6\x47\x01\x42\xd1\x8f\x62\xe8\x54\x51\x7c\x57\x28\xe0\x81\x64\xff\xec\xe0\x05\x4e\x51\xbc\x7c\x19\x7c\x1b\x2f\x9f\x1a\x21\x9d\x68\xbe\x6c\xc9\xd2\xc5\xd6\x78\x6b\xc9\x64\xd7\xf3\x61\x47\x69\xfe\xb5\x1c\x8f\x9b\xa0\x93\xe9\xe7\xdd\x18\xda\x33\xca\x34\x1f\xbe\x5a\xcd\xd2\xfa\xee\x52\x51\xa6\x79\xfd\x73\x7a\x24\xf3\x9f\x8e\x5e\x05\x61\xbc\x3b\x70\x37\xe8\xf0\xff\x5d\x79\x73\xd7\x91\x8c\x50\xfa\x17\xe1\x0b\x96\x6f\x00\xa4\x07\x72\xaf\xc4\xfb\x7b\x00\x39\xf6\x0b\xb6\x92\x06\x00\x00"),
 		},
 		"/builder-role-openshift.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "builder-role-openshift.yaml",
 			modTime:          time.Time{},
-			uncompressedSize: 2116,
+			uncompressedSize: 2347,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x54\x4f\x8f\xdb\xb6\x13\xbd\xf3\x53\x3c\x48\x97\xe4\x87\xb5\xfc\x6b\x4f\x85\x7b\x72\x37\xbb\xad\xd1\xc0\x06\x56\x4e\x83\x1c\xc7\xd4\x58\x1a\x2c\x45\xb2\x24\xb5\xca\xf6\xd3\x17\xa2\xed\xae\x1d\xa7\xff\xd0\x00\xd1\xc5\xa3\xe1\xf0\xcd\x7b\xf3\xc6\x2a\x31\xfb\x72\x8f\x2a\xf1\x56\x34\xdb\xc8\x0d\x92\x43\xea\x18\x4b\x4f\xba\x63\xd4\x6e\x9f\x46\x0a\x8c\x7b\x37\xd8\x86\x92\x38\x8b\x57\xcb\xfa\xfe\x35\x06\xdb\x70\x80\xb3\x0c\x17\xd0\xbb\xc0\xaa\x84\x76\x36\x05\xd9\x0d\xc9\x05\x98\x03\x20\xa8\x0d\xcc\x3d\xdb\x14\x2b\xa0\x66\xce\xe8\xeb\xcd\x76\x75\x7b\x87\xbd\x18\x46\x23\xf1\x70\x89\x1b\x8c\x92\x3a\x55\x22\x75\x12\x31\xba\xf0\x88\xbd\x0b\xa0\xa6\x91\xa9\x31\x19\x88\xdd\xbb\xd0\x1f\x68\x04\x6e\x29\x34\x62\x5b\x68\xe7\x9f\x83\xb4\x5d\x82\x1b\x2d\x87\xd8\x89\xaf\x54\x89\xed\x24\xa3\xbe\x3f\x31\x89\x07\xd8\xdc\x33\x39\x7c\x70\xc3\x51\xc3\x99\xdc\xe3\x14\x6e\xf0\x0b\x87\x38\x35\xf9\xb6\xfa\xbf\x2a\xf1\x6a\x2a\x29\x8e\x87\xc5\xeb\xef\xf1\xec\x06\xf4\xf4\x0c\xeb\x12\x86\xc8\x67\xc8\xfc\x51\xb3\x4f\x10\x0b\xed\x7a\x6f\x84\xac\xe6\x17\x59\x7f\x74\xa8\x90\x09\x4c\x18\x6e\x97\x48\x2c\x28\xcb\x80\xdb\x9f\x97\x81\x92\x2a\x55\x89\xfc\x74\x29\xf9\xc5\x7c\x3e\x8e\x63\x45\x99\x6e\xe5\x42\x3b\x3f\xa9\x9b\xbf\x5d\xdd\xde\xad\xeb\xbb\x59\xa6\xac\x4a\xbc\xb3\x86\x63\x44\xe0\x5f\x07\x09\xdc\x60\xf7\x0c\xf2\xde\x88\xa6\x9d\x61\x18\x1a\x27\xe3\xb2\x3b\xd9\x74\xb1\x18\x83\x24\xb1\xed\x0d\xe2\xd1\x75\x55\x5e\xb8\xf3\x32\xae\x13\x3d\x89\x17\x05\xce\x82\x2c\x8a\x65\x8d\x55\x5d\xe0\x87\x65\xbd\xaa\x6f\x54\x89\xf7\xab\xed\x4f\x9b\x77\x5b\xbc\x5f\x3e\x3c\x2c\xd7\xdb\xd5\x5d\x8d\xcd\x03\x6e\x37\xeb\x37\xab\xed\x6a\xb3\xae\xb1\xb9\xc7\x72\xfd\x01\x3f\xaf\xd6\x6f\x6e\xc0\x92\x3a\x0e\xe0\x8f\x3e\x4c\xfc\x5d\x80\x4c\x83\xe4\x66\xf2\xf4\xb4\x40\x27\x02\xd3\x7e\x4c\xef\xd1\xb3\x96\xbd\x68\x18\xb2\xed\x40\x2d\xa3\x75\x4f\x1c\xec\xb4\x1e\x9e\x43\x2f\x71\xb2\x33\x82\x6c\xa3\x4a\x18\xe9\x25\xe5\x2d\x8a\xd7\xa2\xa6\x36\x5f\xf2\xbf\xa5\x1e\xc5\x36\x0b\x3c\x38\xc3\x8a\xbc\x1c\x37\x6b\x81\xb0\x23\x5d\xd1\x90\x3a\x17\xe4\xb7\x4c\xa6\x7a\xfc\x2e\x56\xe2\xe6\x4f\xdf\xa8\x9e\x13\x35\x94\x68\xa1\x00\x4b\x3d\x2f\xa0\xa9\x67\x33\x7b\x9c\xed\x06\x31\x0d\x07\x05\x18\xda\xb1\x89\x53\x05\x26\x67\x17\x28\x8e\x35\x85\x0a\x83\xe1\xb8\x50\x33\x90\x97\x1f\x83\x1b\x7c\x2e\x9b\x1d\x40\xce\xb6\x47\x01\x81\xa3\x1b\x82\xe6\x63\x45\xf1\xbf\x42\x01\x4f\x1c\x76\x67\x89\x2b\x9c\xa2\xb8\xbe\xe9\x5d\x13\x2f\xaf\xea\xc0\x94\x38\x87\x0d\x1b\xbe\x08\xb5\x33\x86\xf5\xa4\x3a\x27\x5b\x4e\xf9\xd7\x48\x3c\x04\x9e\x92\xee\x72\x34\xf8\xe6\x84\x32\xe6\xe4\x3f\x62\xa3\x9d\xdd\x4b\xdb\x93\x8f\xf9\x35\xb2\x0e\x9c\x3e\xe1\x77\xd5\xf4\x5f\xe0\xf3\xd3\xf4\x49\xfb\x0f\x78\x33\x14\xd9\xca\xca\x79\xb6\xb1\x93\x7d\xaa\xc4\x7d\xa6\x51\x2e\x3a\xa8\x89\x57\x89\xf9\xc8\xbb\xce\xb9\xc7\xb3\x93\xaf\xe9\xc1\x0c\x85\xf4\xd4\xf2\xdf\x69\xca\x45\x31\x05\xa6\xfe\x10\x7e\x9a\xed\xc9\x7b\xb1\xed\x55\xfe\x3a\x31\x7f\x71\xf6\xe2\x20\x51\xfb\x75\x27\x71\x6d\xae\x02\x28\x1d\xbf\x95\x0f\x13\x4d\xc9\x1d\xe3\x02\x76\x30\xe6\xaf\x9d\x9f\x8b\x8d\x89\x6c\x92\x53\xf3\x3f\x3b\xdc\x89\xa5\xf0\x7c\xb6\x0e\x73\x6d\x9c\xe5\xcf\x8e\xe2\xf7\x00\x00\x00\xff\xff\xc1\xd8\x41\x81\x44\x08\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x55\x41\x6f\xe3\x36\x13\xbd\xf3\x57\x3c\x48\x97\xdd\x0f\xb1\xf4\xb5\xa7\xc2\x3d\xb9\xd9\xa4\x35\xba\xb0\x81\xc8\xdb\xc5\x1e\x29\x6a\x2c\x0d\x42\x91\x2c\x49\x45\x71\x7f\x7d\x21\xda\x6e\xec\x75\xd2\xa6\x68\x8a\xf2\x62\x7a\x66\xf8\xe6\xbd\x79\x63\x38\xc7\xec\xed\x8e\xc8\xf1\x91\x15\x99\x40\x0d\xa2\x45\xec\x08\x0b\x27\x55\x47\xa8\xec\x36\x8e\xd2\x13\x6e\xed\x60\x1a\x19\xd9\x1a\xbc\x5b\x54\xb7\xef\x31\x98\x86\x3c\xac\x21\x58\x8f\xde\x7a\x12\x39\x94\x35\xd1\x73\x3d\x44\xeb\xa1\xf7\x80\x90\xad\x27\xea\xc9\xc4\x50\x00\x15\x51\x42\x5f\xad\x37\xcb\xeb\x1b\x6c\x59\x13\x1a\x0e\xfb\x47\xd4\x60\xe4\xd8\x89\x1c\xb1\xe3\x80\xd1\xfa\x7b\x6c\xad\x87\x6c\x1a\x9e\x1a\x4b\x0d\x36\x5b\xeb\xfb\x3d\x0d\x4f\xad\xf4\x0d\x9b\x16\xca\xba\x9d\xe7\xb6\x8b\xb0\xa3\x21\x1f\x3a\x76\x85\xc8\xb1\x99\x64\x54\xb7\x47\x26\x61\x0f\x9b\x7a\x46\x8b\x2f\x76\x38\x68\x38\x91\x7b\x98\xc2\x15\x7e\x21\x1f\xa6\x26\xdf\x16\xff\x17\x39\xde\x4d\x25\xd9\x21\x99\xbd\xff\x1e\x3b\x3b\xa0\x97\x3b\x18\x1b\x31\x04\x3a\x41\xa6\x47\x45\x2e\x82\x0d\x94\xed\x9d\x66\x69\x14\x3d\xc9\xfa\xa3\x43\x81\x44\x60\xc2\xb0\x75\x94\x6c\x20\x93\x0c\xd8\xed\x69\x19\x64\x14\xb9\xc8\x91\x4e\x17\xa3\x9b\x97\xe5\x38\x8e\x85\x4c\xee\x14\xd6\xb7\xe5\x51\x5d\xf9\x71\x79\x7d\xb3\xaa\x6e\x66\x89\xb2\xc8\xf1\xc9\x68\x0a\x01\x9e\x7e\x1d\xd8\x53\x83\x7a\x07\xe9\x9c\x66\x25\x6b\x4d\xd0\x72\x9c\x8c\x4b\xee\x24\xd3\xd9\x60\xf4\x1c\xd9\xb4\x57\x08\x07\xd7\x45\x7e\xe6\xce\xd3\xb8\x8e\xf4\x38\x9c\x15\x58\x03\x69\x90\x2d\x2a\x2c\xab\x0c\x3f\x2c\xaa\x65\x75\x25\x72\x7c\x5e\x6e\x7e\x5a\x7f\xda\xe0\xf3\xe2\xee\x6e\xb1\xda\x2c\x6f\x2a\xac\xef\x70\xbd\x5e\x7d\x58\x6e\x96\xeb\x55\x85\xf5\x2d\x16\xab\x2f\xf8\x79\xb9\xfa\x70\x05\xe2\xd8\x91\x07\x3d\x3a\x3f\xf1\xb7\x1e\x3c\x0d\x92\x9a\xc9\xd3\xe3\x02\x1d\x09\x4c\xfb\x31\x7d\x0f\x8e\x14\x6f\x59\x41\x4b\xd3\x0e\xb2\x25\xb4\xf6\x81\xbc\x99\xd6\xc3\x91\xef\x39\x4c\x76\x06\x48\xd3\x88\x1c\x9a\x7b\x8e\x69\x8b\xc2\xa5\xa8\xa9\xcd\xf1\x87\xf1\x06\x47\x88\x7b\x36\xcd\x1c\x77\x56\x93\x90\x8e\x0f\x9b\x35\x87\xaf\xa5\x2a\xe4\x10\x3b\xeb\xf9\xb7\x44\xa6\xb8\xff\x2e\x14\x6c\xcb\x87\x6f\x44\x4f\x51\x36\x32\xca\xb9\x00\x8c\xec\x69\x0e\x25\x7b\xd2\xb3\xfb\x59\x3d\xb0\x6e\xc8\x0b\x40\xcb\x9a\x74\x98\x2a\x30\x39\x3b\x47\x76\xa8\xc9\x84\x1f\x34\x85\xb9\x98\x41\x3a\xfe\xd1\xdb\xc1\xa5\xb2\xd9\x1e\xe4\x64\x7b\x04\xe0\x29\xd8\xc1\x2b\x3a\x54\x64\xff\xcb\x04\xf0\x40\xbe\x3e\x09\x5c\xe0\x64\xd9\xe5\x4b\x67\x9b\x70\xfe\x54\x79\x92\x91\x52\xb2\x21\x4d\x67\x57\x65\xb5\x26\x35\xa9\x4e\xc1\x96\x62\xfa\xd4\x1c\xf6\x17\x27\xa3\xea\xd2\x6d\x70\xcd\x11\x65\x4c\xc1\x57\xb3\x29\xb5\x6d\xcf\x19\x4d\x6d\x5e\xff\x9c\x1e\x49\x3d\xab\xe8\x55\x10\xca\x9a\x2d\xb7\xbd\x74\x2f\x4f\xe5\xcd\x55\x07\x52\x9e\xe2\x57\x0d\x2f\xba\xfc\x0d\x40\x7a\x20\xf3\x8f\xf0\x66\xc8\xd2\xc6\x16\xd6\x91\x09\x1d\x6f\x63\xc1\xf6\x99\x46\xa9\x68\x3f\xb2\x70\x11\x28\x47\xaa\x3b\x6b\xef\x4f\x32\x2f\x0f\xf5\xdf\x5f\xb5\x19\x32\xee\x65\x4b\x7f\xa5\x29\x15\x85\xe8\x49\xf6\xfb\xeb\xd7\xd1\x5e\x3a\xc7\xa6\xbd\x88\x5f\x06\xca\x27\x67\xcf\x12\x51\xb6\xff\xed\x24\x2e\xcd\x15\x80\x8c\x87\xbf\x84\xbb\x89\x26\xa7\xd9\x87\x39\xcc\xa0\xf5\x9f\x3b\x5f\xb2\x09\x51\x9a\xc8\xc7\xe6\x2f\x25\x6b\x36\xd2\xef\x9e\x4a\x42\xa9\xb4\x35\xf4\xec\x28\x7e\x1f\x00\xd9\xa8\x32\x69\x2b\x09\x00\x00"),
 		},
 		"/builder-service-account.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "builder-service-account.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 1038,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x53\x3d\x6f\xdb\x30\x14\xdc\xf9\x2b\x0e\xd6\x92\x00\xfe\x68\x3b\xba\x93\x9a\xd8\xa8\xd0\xc0\x06\x22\xa7\x41\xc6\x67\xf1\x59\x7a\x08\x45\xaa\x24\x15\xc5\xff\xbe\xa0\x6c\x37\x09\xba\x86\x9b\xa0\xd3\x7d\xf0\x4e\x19\x66\x9f\x77\x54\x86\x3b\xa9\xd8\x06\xd6\x88\x0e\xb1\x61\xe4\x1d\x55\x0d\xa3\x74\x87\x38\x90\x67\xac\x5d\x6f\x35\x45\x71\x16\x57\x79\xb9\xbe\x46\x6f\x35\x7b\x38\xcb\x70\x1e\xad\xf3\xac\x32\x54\xce\x46\x2f\xfb\x3e\x3a\x0f\x73\x22\x04\xd5\x9e\xb9\x65\x1b\xc3\x1c\x28\x99\x47\xf6\xcd\x76\x57\xdc\xac\x70\x10\xc3\xd0\x12\x4e\x1f\xb1\xc6\x20\xb1\x51\x19\x62\x23\x01\x83\xf3\xcf\x38\x38\x0f\xd2\x5a\x92\x30\x19\x88\x3d\x38\xdf\x9e\x6c\x78\xae\xc9\x6b\xb1\x35\x2a\xd7\x1d\xbd\xd4\x4d\x84\x1b\x2c\xfb\xd0\x48\x37\x57\x19\x76\x29\x46\xb9\xbe\x38\x09\x27\xda\x51\x33\x3a\x3c\xb9\xfe\x9c\xe1\x5d\xdc\xf3\x2d\x4c\xf1\x9b\x7d\x48\x22\xdf\xe6\x5f\x54\x86\xab\x04\x99\x9c\x5f\x4e\xae\xbf\xe3\xe8\x7a\xb4\x74\x84\x75\x11\x7d\xe0\x77\xcc\xfc\x5a\x71\x17\x21\x16\x95\x6b\x3b\x23\x64\x2b\x7e\x8b\xf5\x4f\x61\x8e\xd1\x40\xe2\x70\xfb\x48\x62\x41\x63\x0c\xb8\xc3\x7b\x18\x28\xaa\x4c\x65\x18\x4f\x13\x63\xb7\x5c\x2c\x86\x61\x98\xd3\x68\x77\xee\x7c\xbd\xb8\xa4\x5b\xdc\x15\x37\xab\x4d\xb9\x9a\x8d\x96\x55\x86\x07\x6b\x38\x04\x78\xfe\xd3\x8b\x67\x8d\xfd\x11\xd4\x75\x46\x2a\xda\x1b\x86\xa1\x21\x15\x37\xb6\x33\x96\x2e\x16\x83\x97\x28\xb6\x9e\x22\x9c\x5b\x57\xd9\x87\x76\xde\xae\xeb\x62\x4f\xc2\x07\x80\xb3\x20\x8b\x49\x5e\xa2\x28\x27\xf8\x91\x97\x45\x39\x55\x19\x1e\x8b\xdd\xcf\xed\xc3\x0e\x8f\xf9\xfd\x7d\xbe\xd9\x15\xab\x12\xdb\x7b\xdc\x6c\x37\xb7\xc5\xae\xd8\x6e\x4a\x6c\xd7\xc8\x37\x4f\xf8\x55\x6c\x6e\xa7\x60\x89\x0d\x7b\xf0\x6b\xe7\x93\x7f\xe7\x21\xe9\x22\x59\xa7\x4e\x2f\x03\xba\x18\x48\xfb\x48\xcf\xa1\xe3\x4a\x0e\x52\xc1\x90\xad\x7b\xaa\x19\xb5\x7b\x61\x6f\xd3\x3c\x3a\xf6\xad\x84\x54\x67\x00\x59\xad\x32\x18\x69\x25\x8e\x2b\x0a\xff\x87\x4a\x32\x9f\xf9\x6f\x29\xea\xe4\x3c\xa7\x25\x5e\xbe\xaa\x67\xb1\x7a\x89\x92\xfd\x8b\x54\x9c\x57\x95\xeb\x6d\x54\x2d\x47\xd2\x14\x69\xa9\x00\x4b\x2d\x2f\x51\x51\xcb\x66\xf6\x3c\xdb\xf7\x62\x34\x7b\x05\x18\xda\xb3\x09\x09\x81\xd4\xe4\x12\x93\x33\x66\xa2\xfe\x06\x00\x00\xff\xff\x4e\x4d\xa1\x73\x0e\x04\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x53\x4d\x6f\xdb\x38\x10\xbd\xf3\x57\x3c\x58\x97\x04\xf0\xc7\xee\x1e\xbd\x27\x35\xb1\x51\xa1\x81\x0d\x44\x4e\x83\x1c\xc7\xd4\x58\x1a\x98\x22\x55\x92\x8a\xe2\x7f\x5f\x50\xb6\x9b\x04\xbd\x66\x6e\x82\x46\xef\x63\xde\x53\x86\xd9\xd7\x8d\xca\xf0\x20\x9a\x6d\xe0\x0a\xd1\x21\x36\x8c\xbc\x23\xdd\x30\x4a\x77\x88\x03\x79\xc6\xda\xf5\xb6\xa2\x28\xce\xe2\x26\x2f\xd7\xb7\xe8\x6d\xc5\x1e\xce\x32\x9c\x47\xeb\x3c\xab\x0c\xda\xd9\xe8\x65\xdf\x47\xe7\x61\xce\x80\xa0\xda\x33\xb7\x6c\x63\x98\x03\x25\xf3\x88\xbe\xd9\xee\x8a\xbb\x15\x0e\x62\x18\x95\x84\xf3\x47\x5c\x61\x90\xd8\xa8\x0c\xb1\x91\x80\xc1\xf9\x23\x0e\xce\x83\xaa\x4a\x12\x31\x19\x88\x3d\x38\xdf\x9e\x65\x78\xae\xc9\x57\x62\x6b\x68\xd7\x9d\xbc\xd4\x4d\x84\x1b\x2c\xfb\xd0\x48\x37\x57\x19\x76\xc9\x46\xb9\xbe\x2a\x09\x67\xd8\x91\x33\x3a\xbc\xb8\xfe\xe2\xe1\x83\xdd\xcb\x15\xa6\xf8\xc9\x3e\x24\x92\xff\xe6\xff\xa8\x0c\x37\x69\x65\x72\x79\x39\xb9\xfd\x1f\x27\xd7\xa3\xa5\x13\xac\x8b\xe8\x03\x7f\x40\xe6\x37\xcd\x5d\x84\x58\x68\xd7\x76\x46\xc8\x6a\x7e\xb7\xf5\x87\x61\x8e\x51\x40\xc2\x70\xfb\x48\x62\x41\xa3\x0d\xb8\xc3\xc7\x35\x50\x54\x99\xca\x30\x4e\x13\x63\xb7\x5c\x2c\x86\x61\x98\xd3\x98\xce\xdc\xf9\x7a\x71\x75\xb7\x78\x28\xee\x56\x9b\x72\x35\x1b\x25\xab\x0c\x4f\xd6\x70\x08\xf0\xfc\xab\x17\xcf\x15\xf6\x27\x50\xd7\x19\xd1\xb4\x37\x0c\x43\x43\x0a\x6e\x4c\x67\x0c\x5d\x2c\x06\x2f\x51\x6c\x3d\x45\xb8\xa4\xae\xb2\x4f\xe9\xbc\x9f\xeb\x2a\x4f\xc2\xa7\x05\x67\x41\x16\x93\xbc\x44\x51\x4e\xf0\x2d\x2f\x8b\x72\xaa\x32\x3c\x17\xbb\xef\xdb\xa7\x1d\x9e\xf3\xc7\xc7\x7c\xb3\x2b\x56\x25\xb6\x8f\xb8\xdb\x6e\xee\x8b\x5d\xb1\xdd\x94\xd8\xae\x91\x6f\x5e\xf0\xa3\xd8\xdc\x4f\xc1\x12\x1b\xf6\xe0\xb7\xce\x27\xfd\xce\x43\xd2\x21\xb9\x4a\x99\x5e\x0b\x74\x15\x90\xfa\x91\x9e\x43\xc7\x5a\x0e\xa2\x61\xc8\xd6\x3d\xd5\x8c\xda\xbd\xb2\xb7\xa9\x1e\x1d\xfb\x56\x42\x8a\x33\x80\x6c\xa5\x32\x18\x69\x25\x8e\x2d\x0a\x7f\x9b\x4a\x34\xd7\x1f\xe3\x0b\x46\x29\xea\xe4\x52\xa7\x25\x5e\xff\x55\x47\xb1\xd5\x12\x25\xfb\x57\xd1\x9c\x6b\xed\x7a\x1b\x55\xcb\x91\x2a\x8a\xb4\x54\x80\xa5\x96\x97\xd0\xd4\xb2\x99\x1d\x67\xfb\x5e\x4c\xc5\x5e\x01\x86\xf6\x6c\x42\xda\x40\x4a\x72\x89\x89\xa6\x96\xcd\xec\x38\x51\xbf\x07\x00\x4e\x4d\xa1\x73\x0e\x04\x00\x00"),
 		},
 		"/camel-catalog-1.6.0.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "camel-catalog-1.6.0.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 89253,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x7d\x4b\x77\xdb\xb8\xb2\xee\x3c\xbf\x82\xab\x33\x39\x67\xdd\xad\xda\xdd\xc9\xd9\xdd\x77\xf5\x1d\xd9\x4a\x9c\xd8\xb1\x1d\x27\xf2\x4e\xb2\x7b\xd2\x0b\x22\x21\x09\x16\x49\xd0\x00\x24\xd9\xf9\xf5\x77\xe1\xc1\x97\x24\x17\x1f\x06\x7c\x3c\x30\x49\xa1\xf0\x15\x50\x78\x15\x80\x42\xe1\x75\x34\xf1\xf7\xf7\xea\x75\x74\xc9\x62\x9a\x4b\x9a\x44\x8a\x47\x6a\x45\xa3\x93\x82\xc4\x2b\x1a\xcd\xf8\x42\xed\x88\xa0\xd1\x19\xdf\xe4\x09\x51\x8c\xe7\xd1\x7f\x9d\xcc\xce\xfe\x3b\xda\xe4\x09\x15\x11\xcf\x69\xc4\x45\x94\x71\x41\x5f\xbd\x8e\x62\x9e\x2b\xc1\xe6\x1b\xc5\x45\x94\x5a\xc0\x88\x2c\x05\xa5\x19\xcd\x95\x84\x28\x9a\x51\x6a\xd0\xaf\x3f\xdf\x9e\x4f\xdf\x47\x0b\x96\xd2\x28\x61\xd2\x46\xa2\x49\xb4\x63\x6a\xf5\xea\x75\xa4\x56\x4c\x46\x3b\x2e\xd6\xd1\x82\x8b\x88\x24\x09\xd3\x8c\x49\x1a\xb1\x7c\xc1\x45\x66\x93\x21\xe8\x92\x88\x84\xe5\xcb\x28\xe6\xc5\xa3\x60\xcb\x95\x8a\xf8\x2e\xa7\x42\xae\x58\x01\xaf\x5e\x47\xb7\x3a\x1b\xb3\xb3\x32\x25\xd2\xc2\x1a\x9e\x8a\x47\xff\xe1\x1b\x97\x87\x46\x76\x9d\x14\xfe\x11\x7d\xa3\x42\x6a\x26\x6f\xe0\xd7\x57\xaf\xa3\xff\xd2\x24\xbf\xb8\xc0\x5f\xfe\xfb\xff\x45\x8f\x7c\x13\x65\xe4\x31\xca\xb9\x8a\x36\x92\x36\x90\xe9\x43\x4c\x0b\x15\xb1\x3c\x8a\x79\x56\xa4\x8c\xe4\x31\xad\xb3\x55\x71\x80\xc8\x24\x40\x63\xf0\xb9\x22\x2c\x8f\x88\xc9\x46\xc4\x17\x4d\xb2\x88\xa8\x57\xaf\x5f\xbd\x8e\xcc\xdf\x4a\xa9\xe2\xcf\x7f\xfe\x73\xb7\xdb\x01\x31\xc9\x05\x2e\x96\xff\x2c\x73\xf7\xcf\xcb\xf3\xe9\xfb\xeb\xd9\xfb\x89\x49\xf2\xab\xd7\xd1\xbf\xf3\x94\x4a\x19\x09\x7a\xbf\x61\x82\x26\xd1\xfc\x31\x22\x45\x91\xb2\x98\xcc\x53\x1a\xa5\x64\xa7\x0b\xce\x94\x8e\x29\x74\x96\x47\x3b\xc1\x14\xcb\x97\xff\x88\xa4\x2b\xf5\x57\xaf\x5b\xa5\x53\x8b\xab\x4c\x1e\x93\x2d\x02\x9e\x47\x24\x8f\x7e\x39\x99\x45\xe7\xb3\x5f\xa2\xd3\x93\xd9\xf9\xec\x1f\xaf\x5e\x47\xdf\xcf\x6f\x3f\x7e\xfe\xf7\x6d\xf4\xfd\xe4\xeb\xd7\x93\xeb\xdb\xf3\xf7\xb3\xe8\xf3\xd7\x68\xfa\xf9\xfa\xdd\xf9\xed\xf9\xe7\xeb\x59\xf4\xf9\x2c\x3a\xb9\xfe\x4f\xf4\xe9\xfc\xfa\xdd\x3f\x22\xca\xd4\x8a\x8a\x88\x3e\x14\x42\xa7\x9f\x8b\x88\x69\x41\xd2\x44\x97\x69\x59\x81\xca\x04\xe8\xfa\xa1\xbf\x65\x41\x63\xb6\x60\x71\x94\x92\x7c\xb9\x21\x4b\x1a\x2d\xf9\x96\x8a\x5c\x57\x8f\x82\x8a\x8c\x49\x5d\x9c\x32\x22\x79\xf2\xea\x75\x94\xb2\x8c\x29\x53\x8b\xe4\x61\xa6\x34\x1b\x9f\x6d\xeb\x15\x29\x98\xab\x4e\x7f\x46\x31\xc9\x68\xda\x2c\xbe\xed\x6f\xaf\xd6\x2c\x4f\xfe\x8c\xa6\x3a\x64\x4a\x14\x49\xf9\xf2\x55\x46\x15\x49\x88\x22\x7f\xbe\x8a\xa2\x9c\x64\xd4\x45\x9c\xc4\x36\x7c\xf2\x1b\xfc\x0e\xbf\xbe\x8a\xa2\x94\xcc\x69\x2a\x35\x55\x74\x08\xed\x88\x21\xe5\x24\xa1\x02\xb6\x65\x1a\xde\xc2\x1f\x26\x72\xa4\xeb\x43\x89\xbc\xc6\x31\x8e\x44\x3e\xa0\x15\x9b\x5c\xb1\x8c\xd6\xb4\x36\x95\xba\x68\x74\x0a\x5d\xb0\x4d\xec\x1e\x8d\xfe\xa9\x10\x7c\xcb\x12\x2a\xfe\x8c\xee\x37\x44\xac\x37\xb2\x4c\xa2\xae\xb2\xba\xac\xa6\x29\x91\xf2\xcf\x88\x71\x70\x04\x20\x36\x79\x4e\x05\x7c\xa0\x39\x15\x44\xd1\xe4\x8a\xb0\xdc\xc4\x6a\xca\x4f\xff\x95\x11\x1a\x6c\x7f\xfb\x15\xde\xc2\x19\xcb\x49\xea\x68\x6c\x7e\x8e\x64\xd4\x05\x4d\x8e\x80\xfc\xcb\x91\x24\xb4\xa0\x79\x42\xf3\x98\x51\x57\x1a\x93\x68\x29\xf8\xa6\x38\x4f\xfe\x8c\xb8\x58\x96\x62\xb2\x3c\xd6\x0e\x96\x08\xc5\x16\x24\x56\x9a\xc8\x95\xc2\xc4\x49\xc9\x49\xb8\x20\x73\x96\x32\x55\xa1\x46\x51\x46\xa4\xa2\xa2\xfc\x3a\xc6\xb9\x2f\xf7\xa7\x52\x60\x39\x94\x39\x67\x22\xde\x30\x35\x99\x0b\x4a\xd6\xcf\xe3\xdb\x2c\xd5\xa7\xb8\x3b\x9a\x49\xc6\x62\xc1\x0b\xc1\x75\x97\x3a\x59\x90\x4d\xaa\x26\x8a\xa7\x54\xe8\xde\xd4\x01\xac\x28\x49\xd5\xea\x7f\x25\x41\x96\xb5\x8b\x57\xa4\x44\xe9\x41\x69\x62\xba\xe7\x17\x4a\x4e\x8b\x69\x59\x54\x82\xe7\x21\xeb\x85\xc6\x77\x24\x82\x4a\xf5\x52\x59\xd5\xbc\x5e\x44\x7e\x4a\x90\x98\xe5\xcb\x97\xca\x17\x2f\x68\xee\x58\xbe\xaa\xe9\x9a\x7d\x79\x45\x4a\x64\xfe\x5b\x99\x80\xbe\xcc\x9f\x66\xac\xd1\x1c\x91\xee\x22\xad\x42\x55\x65\x70\x12\x35\xc2\xef\xc8\x96\xdc\x3e\x16\xb4\x11\x7a\xc0\xb6\xc6\x00\x1d\x13\x4e\x66\xd7\xbf\xbd\x23\x8a\x9c\x99\xdf\x8e\xe5\x66\x27\xdf\x4c\xe2\x9d\xc7\x0c\x59\x40\x47\x27\xe3\x15\xcd\x9a\x29\x66\xc9\x9f\x51\x9b\xc4\xa9\x52\xd1\x82\xa4\x92\x56\xbf\x15\x44\x4a\xb6\xa5\xed\x9f\xfb\x48\x40\x2b\x7a\x3c\xa7\xb9\x02\xcd\x06\xe2\x1d\x4c\x77\x6f\xa6\xe5\x8f\x47\x24\x20\x59\xe1\x2f\xf7\x92\x15\x58\xce\x25\x2b\xe4\xf0\x6c\x57\x71\x43\x4b\x4c\xb2\x02\x66\xac\xc0\xa4\xa5\x56\x82\x2d\x94\x3f\x81\x59\x3c\x4c\x66\x2d\x8a\x01\x59\x3f\xda\x9c\x5a\x60\x03\x1b\x94\x8d\x0b\xb7\xe6\xb1\xd7\xa8\x3a\x04\xdb\x8a\x8a\x89\xf7\x2e\x93\xfe\x64\x7b\x97\x49\x4c\xb0\x75\x70\xb0\x0a\x75\x97\x49\xb8\xc8\x24\x96\xe3\x62\x39\x11\xb4\xd2\x2a\x27\x32\xe5\x1e\x6b\xd7\x11\x70\x4c\x22\x4f\x93\x07\x93\x50\xb1\x84\x06\x4b\xd0\x2c\xe1\x66\xf9\xb5\xfe\x69\x96\x72\xb4\xc6\xe8\xa7\xfa\xe9\x4f\x64\x16\x0f\x93\x52\x8b\x22\x98\x60\x2c\x17\xf8\x62\x1e\x98\x00\xa8\x8a\x13\x7f\xd9\xd7\x68\x58\xe6\x75\xf8\x44\x2a\xa2\x46\xf7\xe3\x06\x61\x4d\x1f\x9f\x07\xb0\x23\x2a\x5e\x85\x2e\x03\xcd\x09\xde\xab\x38\x99\xe9\x0c\xb7\x0b\xa1\x6f\xd4\xef\x3a\xa1\xe3\xa2\x7e\xa2\x8f\x68\xd7\x41\xe4\x1b\x9f\x8a\xd8\x1b\x54\x67\xa9\x82\xc3\xe9\x2b\xf2\x0d\x9c\xcc\x50\x5d\x85\xa8\x8c\x7b\x1c\x20\x0c\x1c\x9a\xeb\x06\x41\xb8\x7c\x6b\x26\x70\xa2\xff\x1f\xcb\xfb\x7a\xb2\xd6\x4f\xaa\x26\x82\xb2\xc5\x63\x77\xee\xb1\x09\x7d\x0b\x0a\xcb\xf9\x4e\x90\xc1\xea\x56\x5b\xb8\x0f\xf1\x8a\xe4\x4b\xea\xaf\xb4\x1c\x20\x96\xea\x36\x49\xb0\x12\x73\x6c\xe0\xc7\xd4\x3c\xb1\x1a\x9b\x26\xc4\xa3\x7a\xad\xd1\xb0\xfc\x37\xc2\x83\x65\x5e\xf3\x80\xcb\x84\xa0\x6a\xb2\xbc\xcb\x7c\x76\x4e\x06\x0e\x9d\x58\x34\x08\xc2\x4d\x0f\x34\x13\x98\xe9\xff\x58\xde\x7f\x72\xbe\xa6\xb4\xa8\xd7\xa7\x9e\x9f\xff\x0a\x12\x93\xc1\x3e\x51\x30\x39\x54\x8c\xe0\x2f\xce\x3f\x99\x37\x4c\x1e\x3c\x65\xf9\x92\xff\x8f\x3f\x69\x38\x40\x4c\x16\x6d\x92\x60\x92\x70\x6c\xe0\xb3\x7d\xa2\x43\xd7\x4e\x4e\xe4\xbd\xcf\xc1\xcb\x02\xa2\xc3\x57\x8b\x24\xe4\x42\x03\xc8\x7b\x09\xb3\x7b\x54\x5d\x99\x0b\xc2\x72\x25\xa8\xc7\x21\xa1\x82\xc4\xa4\xb0\x4f\x14\x4c\x0e\x15\x23\x38\x2d\xdf\xd0\x7e\x82\xe6\x09\x95\x6b\x8f\xbd\x84\x05\x44\xfb\x88\x16\x49\xb8\x1e\xc2\xb2\x81\xbf\xec\x13\x5d\x50\xd9\xb1\x94\x71\x8f\x0b\x2a\x06\x0f\x93\x41\x8b\x22\x98\x08\x2c\x17\xb8\x35\x0f\xb4\x63\x88\x15\xdb\xd2\xec\xde\x63\xcf\xe0\x10\xd1\xae\xa1\x4d\x13\xae\x6f\x70\x7c\xe0\xc4\xbc\x5c\x7d\xc1\x44\x91\x33\x25\x98\xf2\xd8\x3f\x38\x40\x4c\x10\x6d\x92\x60\x72\x70\x6c\xe0\xda\x3e\x51\xdd\x89\x26\xc4\xa3\xea\x44\x13\x82\x6a\x4e\x75\x78\x47\xe6\x95\xd8\x8c\xd4\x9b\x68\x42\x60\x46\x13\xd2\x35\x3e\xd2\xb5\xe7\xf1\x91\xae\x3b\xc7\xc7\x9a\x24\xe8\xf8\x48\xd7\x12\xde\x7f\x9a\x61\x12\x48\xf8\x4f\x9f\x3a\xa3\x81\xc3\x72\xdf\x24\x08\x96\x77\xc3\x04\xde\xe9\xff\x68\x9d\x2f\xd2\x4d\xee\x71\x28\xb4\x78\x68\xbd\x6f\x52\x84\x9b\x32\x18\x2e\x30\x33\x0f\x4c\x00\x4b\xce\x97\x29\x9d\x24\x42\x33\xf4\x26\x86\x26\x2a\x26\x8c\x23\x74\xc1\x44\x62\x79\x81\xe1\x05\x1f\xcc\xc7\x3b\xfd\xde\x35\x9d\xf4\x3b\x9b\xec\x9a\x4c\x0e\x97\x42\x1d\x77\x32\x7f\x89\xc5\x49\xcd\x09\x0c\x27\x33\x25\x3d\x1d\xbc\xce\x68\x00\x74\x54\x4c\xf2\x2c\x26\xa9\x3f\xc9\x6b\x34\x47\x74\x74\x7b\xaa\x11\x3e\x4c\x18\x3a\x22\x9c\x4f\x49\x8a\x6e\xf6\xc6\x44\x4a\x92\x27\x82\xdc\x7b\xcc\x53\x03\x14\xab\x54\x71\x15\x1c\xac\x46\x54\x29\x81\x69\xf9\x86\xee\xb5\x99\xbc\xcb\x89\x20\x3e\x37\x34\x9b\xa8\x98\x3c\x8e\xd0\x85\xdb\x86\xb3\xbc\x40\xf3\x82\x8b\x0f\xe6\xe3\x2b\xc1\x77\x22\x33\x9e\x2f\x79\x32\xf7\x27\x18\x07\x88\xc9\xa4\x4d\x12\x4c\x1c\x8e\x0d\x5c\xe9\xe7\xbb\x39\xaa\x93\xe4\x1e\xfb\xdd\x24\x47\xbb\xdd\x3a\x38\x9c\x36\x92\x4b\x78\x97\x3f\xb1\xcc\xbe\xa3\xf3\x15\xe7\x3d\x94\x10\x6c\x81\xdd\x81\x60\xf9\x6c\x93\x94\x79\x6d\x68\xd7\xc7\x74\xee\xbd\x3e\x67\xe5\x55\x5b\x32\x70\x68\xe7\xb5\x7a\x01\x5d\xc9\x30\x81\xe9\xaa\x43\x53\x8a\x79\x46\x95\xc7\x1d\x4f\x8b\x87\xe6\xde\x50\x8c\x56\x09\x5a\x0c\xc2\x49\xcf\x70\x81\xa9\x79\xa0\x4d\x5a\xb0\xd4\xe3\xf0\x67\xe0\xd0\x66\xdd\x20\x08\xd7\xb0\x35\x13\x78\xa7\xff\xa3\x7a\x24\x17\x7c\x23\x57\x1e\x55\x49\x0b\x88\x6a\x93\x2d\x92\x70\xea\xa0\x65\x33\xe7\xaa\xf1\xe3\xcc\xfe\x78\x8a\x9b\x50\x10\xc5\x33\x9f\x9b\xaa\x3c\xc3\xe4\xd1\x08\x0f\xb8\xa5\xca\x33\x38\x51\x3c\xc3\x2d\x6f\xe8\x96\xe6\x5e\xad\x6d\x0c\x20\x96\xf9\x36\x49\x40\xab\x1a\xc3\x06\x6e\x96\xef\xf5\x13\x9d\x78\x52\x1e\xf3\xc4\xe7\xc2\x43\x89\x88\xc9\x61\x8f\x26\xdc\x64\xd3\xf1\x81\x0f\x94\x4f\xf5\x0b\x2a\x0a\x59\xdb\x2c\x7b\x10\x83\xac\x2c\x94\x8f\xce\x75\xee\x24\xcf\x9b\x44\x03\x33\x26\x79\x0e\x1f\x24\xcf\xd1\x09\x8f\x90\x1e\xd5\x37\x21\x51\xf5\xad\x0e\x7e\xae\x91\x62\x8d\x34\xd0\x42\x51\x48\x09\x5f\xa5\x1c\x66\x9b\xe8\x22\xa1\x26\x56\x0f\xf6\xd0\x88\x1f\x39\x6a\x34\x4c\x90\x8d\xf0\x70\x96\x4d\x0f\x34\x86\xf7\x0f\x34\x46\x37\xec\x88\xa4\xbf\x7b\xdc\xb7\xb5\x78\x58\xc9\xb7\x28\x06\x16\xbe\x8d\x0b\xa7\xe6\x81\xb6\x0a\x96\x2f\x58\xce\x64\x41\x3c\xb6\xf6\x1a\x13\x2b\xda\x03\xaa\x60\x05\x5c\x73\x82\xf3\xea\x15\xdd\x7b\x69\x14\x84\x87\x8d\x97\xda\x40\xf0\xf8\xae\xcb\x28\x03\xc2\x81\x5b\x2e\x44\x49\xb8\x3e\x30\xdc\xdb\x9b\x95\x37\x8f\xd2\x64\x54\x09\x16\x7b\x14\xc3\x31\x74\x4c\x2c\x08\x7d\xb8\xd5\x80\x06\x4f\x70\x3c\xe1\x4a\xff\x78\x63\x7f\xbc\xb2\xbf\xe1\x96\x88\x8a\x0a\xe6\x73\x2f\xbb\x44\x44\x35\xc9\x36\x4d\x40\xc3\x44\xcb\x07\x4e\xdc\x0b\x2a\x8a\x9f\x1b\x41\x27\x52\x71\x41\x96\x74\x32\x4f\xb9\xc7\x25\xa4\x43\x6c\x54\x3c\x4f\x51\x87\x13\x94\xe6\x08\x8e\x23\x68\x8e\x70\x9a\x72\x74\x81\xa9\x10\x5c\xf1\xf9\x66\xe1\x51\x0b\x77\x88\xd8\x38\xb3\x47\x33\x70\xa4\x29\x63\xc3\x8d\x7b\x41\x47\x1b\x45\x96\x92\x6f\x3c\x1a\x01\x3a\x40\x2c\x7f\x8a\x25\x8f\x57\x9a\xba\x18\x97\x43\xc7\x02\x6e\x2b\x9c\xa3\x59\xac\xec\x49\xbd\x18\xa5\x62\x75\xb9\x4d\xd2\x77\xa3\xfa\x60\x88\x4e\x37\x0f\x3e\x97\x74\x4b\xc4\x8e\x21\xbf\x49\x13\x72\xc0\x37\x7c\xf4\x70\x9f\x6e\x1e\xf0\x65\xdd\x9c\x2a\xf5\xd8\x3a\x23\xea\x61\xc8\xaf\x30\xd1\x81\x7f\x9f\x0a\x5f\xff\x7c\xc6\xe8\xaf\x19\x81\xc6\x86\x6b\xfd\xfa\x51\x29\xd4\x62\xd5\x1c\xca\xa3\x3e\x07\xff\x12\x11\xed\xa3\xdb\x34\x61\xcf\x02\xd2\x58\xc2\xfb\x29\x6e\x61\xff\x93\x15\x7a\xcc\xf7\x68\x94\x66\x01\xb1\xde\xaa\x4d\x32\xb0\xab\x72\x91\xe1\x2f\x56\x9c\xb1\x94\xa2\x5d\xf1\x22\x25\xaa\x20\xb1\x47\x35\xa5\x44\xc4\xca\x78\x8f\xe6\xb9\xb3\xe3\x3d\xb8\x81\xe2\x2a\x63\xc3\x99\x7b\x19\x36\x59\x3e\x88\x8e\xae\xb6\xa6\x5e\x65\x6d\xe0\x30\x41\x37\x09\xc2\xad\xb3\x6a\x26\x30\x4b\x3b\xf2\x6e\x4c\x6f\x7d\x6e\x9e\x39\xc0\x8e\xce\xa4\x41\x12\xd6\xdc\x37\x97\x30\x3b\xbe\x91\x56\xef\xab\x6c\xe2\x95\x9e\x94\xfb\xdc\xaa\x71\x90\x98\x14\xf6\x89\x02\xee\xb8\x38\x46\x30\x2d\xdf\xd0\x7d\x17\xb6\x64\x8a\xa4\x3c\xa6\x3e\xd7\x1d\x9a\xa8\x98\x54\x8e\xd0\x85\xdb\x8c\x69\xf0\x82\x77\xf6\xe3\xb3\xfe\x40\xed\x80\x59\xe6\x73\x11\xda\xc0\x61\x02\x69\x12\x84\x33\x02\xd6\x4c\xe0\x56\xff\x47\x27\x41\xc7\xbc\x76\x78\x98\x09\x1d\xf1\x2b\x71\x7c\x57\xe2\x08\x61\x28\xbd\xac\xe4\x65\x55\xb3\x1b\xf7\xd5\xa5\x9d\x65\x84\x79\xdc\xb3\xd4\x68\xa8\xce\x9e\x8d\x39\x46\x55\xc7\x1d\x6f\x3d\x96\x1d\xc8\xbf\x77\xdc\x82\x17\x6f\x9f\xc3\x77\x74\xa2\x35\x63\x5f\x4b\xff\x19\xcb\xe8\x24\xdb\xa4\x8a\x15\x44\x0c\xf1\x53\xd0\x58\xcf\x22\x2c\x85\x2b\xc2\xd2\x1e\xc6\x70\x0d\xc5\x48\x73\x86\x8a\x33\x5c\xb1\x8c\x5e\x95\x5f\xa8\x52\xe9\x6c\x27\xe7\x6c\x79\xbf\xa1\xa2\xc7\xf9\xcc\x81\xc6\x9b\x25\x30\x56\x61\xf7\x48\x27\x72\x8c\xb1\xd9\x51\xa8\xd0\x3d\xa4\xb3\x06\x2d\xd9\x39\x83\xd0\x53\xb6\xfc\xa2\x3f\x07\xd9\x33\xee\x43\xc9\xfb\x74\x0f\x6e\xf6\xe5\x12\xb7\x8c\xe0\xc5\x9c\x3f\xf8\xb4\x8d\x30\x80\xe8\xb8\xdc\x22\x09\x68\x1f\x61\xd8\xc0\x3b\xfb\xec\x9c\x08\xcb\xb7\x9e\xe7\xc1\xf2\x6d\x87\xe6\xda\x20\x09\x3b\x0b\x96\x6f\xe1\xe4\xfb\xec\xcd\xec\x2d\x6a\x1b\x29\x79\x3e\xd9\x92\x94\x25\x44\x71\x8f\x6a\x49\x1b\x17\x13\xc9\x51\xca\x70\x16\x92\x92\xe7\x15\x33\xb8\x90\x3c\xff\x56\x7e\xa1\xa7\x9b\x37\xd9\x9c\x8a\x3b\xaf\xd3\xbc\x1a\x13\x13\xcf\x01\x55\xb8\xf3\xce\x15\x27\xb8\xac\x5e\x31\xa1\xec\xe8\x5c\xf2\x78\x4d\xd5\xe4\x4e\x8a\xb7\xff\xfa\xdd\x9f\x68\xf6\x91\x31\x01\x3d\x41\xeb\x59\x4c\x15\x17\xb0\x5c\xe0\x62\xf6\xf5\xed\xbf\x7e\xff\x4e\xe7\x33\xf3\x73\x8f\x23\x0f\x7e\x55\xbb\x06\x68\x8f\x01\x53\x93\x4d\xa4\x12\x94\x8c\x30\x49\x3a\x40\x7a\xa1\xb1\xd2\x28\x38\x76\x60\xeb\xa9\xe6\x1c\x87\xb0\xf9\x6e\x20\xcd\xcc\x0f\xe8\xd8\x20\x88\x67\xab\xe8\x12\x11\x1d\x1d\xda\x34\xe1\x86\x07\xc7\x07\x4e\xcc\x0b\xbe\x84\x2e\xb9\x4f\xc7\x0e\x1a\x0d\xd3\x8b\x75\xf8\x1d\x79\x98\x0f\xc8\x57\x43\xb7\xd5\xb1\x61\xc6\x49\x71\x41\x1e\xe6\xf8\x0a\xa9\xa0\x34\x23\x62\xed\x73\x2a\x5e\x63\x62\x85\x7c\x40\x15\xac\x98\x6b\x4e\x70\x56\xbd\xe2\x07\x32\xc5\xb6\xd7\x26\x57\xef\xd2\xb6\x80\x98\x38\xda\x24\xa1\x26\xe3\x8e\x0b\xcc\xec\x13\xed\xad\x99\x47\x01\x2c\x19\x9a\xf9\x3a\x38\x5c\x5f\xca\x14\x7c\x60\xb8\x59\xec\x4e\x4e\xd6\x2c\xa7\x92\x79\x5e\xc6\x75\xa0\x1d\x0a\x71\x49\x36\x59\x30\x41\x57\x7c\xcc\x7a\xe6\x01\xd6\x0b\x28\xd8\xe0\x58\xc1\x27\xfb\x1c\x34\x3c\xe9\xf8\x65\x76\x4b\x80\x33\xf7\x8d\xba\x18\x29\x68\x2e\x95\x57\x35\xb4\x82\xc4\x8a\xa9\x22\x9a\x2c\xd3\x86\x37\xe0\xc1\x45\x54\xe3\xc8\xdd\x28\x67\x8f\xfb\x30\x39\xdd\xa8\xda\x43\xee\x33\x80\xd6\xf4\x51\x2a\x9e\x7b\xc8\x59\xcc\xf2\x51\x06\xbf\x07\x59\xe3\xdb\x9e\x07\xd6\xc7\xd7\xe3\x8a\x1b\x98\xf2\x80\xd9\xee\xc0\x6f\x65\x6f\x04\x5b\x33\xe0\x83\x79\x8c\xc4\x70\xc5\x09\xd7\xf6\x39\x12\xa5\x2c\x4b\xf8\xe4\x5e\x46\xe2\xd8\x92\x84\xa9\x79\x8c\xcd\x11\xdf\x12\xb8\xe6\x5b\xf4\x84\xe2\x9c\x88\x98\x27\x3e\xfd\xc5\x58\x40\x4c\xd3\x6a\x93\x0c\xb6\x41\x35\x91\xe1\xd4\x3e\x51\x55\x4b\xe6\x99\x4f\x05\x32\xcf\x70\xcf\xbb\x75\x78\xb8\xad\xd1\x3c\x2b\x60\x96\x67\x7d\xec\x2c\x7c\x7a\x2e\x74\x88\x1d\xe3\x69\x93\x26\xb4\x9d\xc5\x1b\xb3\xc4\xf4\x7e\x7a\xd4\xd4\xa2\x74\x6a\xff\x3c\xa3\xa9\x96\x63\xfc\xe3\xc6\xa4\x4d\x8a\x91\x4e\xfc\xfa\xa6\xb4\xff\xce\x4b\x98\x54\x0f\xdf\x24\xd0\x5c\xe0\xca\x3c\xd0\x8d\xa7\x8d\xee\xaf\x56\x1e\xfb\xa0\x12\x11\x15\x42\x9b\x26\x9c\x18\x1c\x1f\xb8\x72\x2f\xe8\xf2\xf8\x9d\xcf\x63\x83\x77\xf8\xa1\xc1\xbb\xf0\x47\x06\xef\x52\x78\x77\x81\x6e\x08\xcc\x79\xce\x94\x47\x5f\x3c\x16\x0f\xcb\x77\x8b\x22\x9c\xa3\x32\xc3\x05\x4e\xcd\x03\x3f\x14\xb0\x64\x3e\x1d\xae\x5a\x3c\x4c\x00\x2d\x8a\x80\x47\x03\x34\x17\xb8\x36\x0f\xd4\x88\x45\x3c\x16\xca\xa3\x77\x32\x8b\x87\x09\xa0\x45\xf1\xdc\xed\xd4\x62\x59\x54\xef\x2d\xe0\x81\x96\x2e\x26\x6a\x69\xca\x31\x63\xcb\x9c\xa8\x8d\xe8\xa7\x3e\xe6\x5b\x2a\x74\x57\xeb\x30\xa6\xe6\xd1\xcf\xe8\x6c\x2f\xea\xcd\x87\x1b\xdc\xbb\x47\x36\x91\x3e\xdd\xc4\x58\x3c\xb4\xac\x9a\x14\xe1\xec\x8c\x32\x98\x5e\x75\xaa\x54\x44\xad\x68\xee\xb1\xaf\x6a\x80\x76\x2a\x56\x2d\xb2\xb0\xba\x95\x65\x05\x27\xe6\x81\x5a\xb2\xae\x37\x73\x2a\x72\xaa\xa8\xc7\x2a\x51\x63\x62\x32\xa9\xa9\x26\x39\x4f\xe8\x68\xc3\x8a\x26\x0e\xc9\xa8\x2c\x48\xec\x05\x2c\xde\x48\xc5\xb3\x89\xa0\x92\x6f\xc4\x33\x20\xcd\x6c\x6e\xc5\x16\x6a\x32\xdf\xb0\x74\xbc\x83\x84\x46\xd2\xaa\x34\x4d\xee\x37\x7c\xcc\x40\x78\x04\xb1\xbe\xd0\xc0\xdc\x08\xc8\xd3\x94\x0a\x1f\x69\xbd\x1b\x73\xa0\xe6\xa8\xe4\x74\xc2\x16\x6c\xe9\x23\x51\x92\x8a\x2d\x8b\xe9\x84\xc4\x31\xdf\xe4\xe3\xbd\xf4\xb7\x20\x63\x41\x3d\x21\x99\xc4\xf9\x80\x2a\xa8\x90\x4c\x2a\x9a\xab\xc9\x96\xa7\x9b\xcc\x4f\xc3\x30\xc5\x30\x79\x8e\x05\x57\x33\x89\xdc\x4b\x93\x38\xcc\xe9\x24\x4e\x09\x1b\xef\xa2\xac\x81\x9d\xd0\x22\xe5\x8f\xe6\x3e\x4c\x0f\x68\xab\x22\xf8\x18\x50\x73\x83\xaa\xab\xf8\xdb\x74\x15\xf0\xa9\x0a\xfa\x5a\x86\x7c\xd1\x01\x83\x16\xa8\x1a\xf8\xae\xb2\xfe\x5d\xb6\xa4\x06\x83\x99\x0d\x3a\x71\x21\x63\x39\xd8\xea\xf6\xb7\xae\x6e\x0d\xf0\xa9\xf9\xf5\x8a\x14\xc3\x70\xab\x2e\x05\x6c\x67\x0c\x9f\xcb\x1f\x4e\xcd\xf7\x78\x31\x98\xd6\xdf\xca\xbd\xf9\x65\x2c\xa0\x19\x12\x1b\x70\xd7\xfa\xfb\x99\x85\x74\xa4\x70\x46\x43\xd6\xed\xed\x6f\xd7\xde\x1a\xe0\x37\x55\xe0\x37\x1b\xf6\x9c\x32\xfa\xdb\x75\xfb\x7b\x45\x65\x2b\x80\xc7\xf4\xff\x6d\xfb\x0b\x34\x1b\x86\x62\x74\x45\x36\x0a\x45\xd5\x20\x9b\x95\xd9\x84\x54\x0d\x72\x2c\x83\xc6\x20\xfe\x77\x63\x10\x6f\xb5\xf9\xfa\x66\xce\x9a\x60\x2c\xbf\x3b\x3e\x6f\x60\x5f\xec\x1f\x61\xed\x0f\xd4\xe8\x60\x1b\x80\xef\xea\x5f\x47\x97\x32\x4f\x5a\x05\xca\xc7\xb7\xf0\x5a\xb7\x6c\xb6\xca\xea\xc7\xb1\xb0\xab\xa2\xd9\x27\x7f\xbc\x39\x41\x2d\xe0\x13\x9f\x27\x80\x93\x05\xa6\x9e\xd7\xc1\xe1\xfc\xef\x24\x0b\xb8\x49\x16\xc7\x57\x7f\x9b\x57\x63\x8e\x5b\xfb\x6d\x5c\x7e\xf9\xc4\x1a\xc2\xf0\xad\xbf\x23\xb3\xbf\x94\x64\x73\x9f\xae\xa8\x1b\xa0\x9d\x53\xca\x16\x59\xd8\x29\xa5\x65\x05\x97\xe6\x81\x5f\x95\xa8\x04\xcb\x97\x8a\x66\x45\x4a\x7c\xba\x29\x6f\xe3\x62\xb2\xb1\x94\x93\x3d\xd2\x70\x3b\x3a\xad\x84\xc1\xcc\x7c\xde\xba\x4f\x5c\x52\xdc\xeb\xee\x96\x86\xc3\xe5\xc2\x5f\x60\x7f\x4b\x33\x81\x99\xfe\xdf\xc3\xae\x51\xae\x28\xf5\xe9\x52\xa5\x05\x8b\xc9\xe2\x18\xe1\x58\xb3\x46\x0b\x32\xda\x44\x72\x94\x7d\xa3\xe5\xd9\x36\x4f\x9c\x99\xdf\x8e\x19\x28\x0e\xc2\x6c\x82\x61\x45\xb8\xf0\x79\x0c\x6b\x81\x1f\xbe\x92\x8b\xf1\x67\x7e\x16\xcf\x38\xb6\x33\x8a\xed\x40\x4b\x3b\x96\x52\x10\x34\xe3\x8a\xc2\x99\x1a\x38\xa1\xd9\x8b\x3c\x3a\xee\x6c\x81\x1f\x2d\x0b\x60\xe5\xdf\xcb\xc0\x7f\xa0\x6d\xff\xe8\xdb\x17\x6a\xb3\xfe\x5e\x26\xfd\x77\x24\x5e\x7b\xf5\x83\xe7\x00\x1d\xdd\xd3\xae\xf0\xda\x74\x03\x8f\x2f\xd8\xb8\x70\x61\x9f\xe8\x36\x41\x21\x58\xee\x75\x4f\xdb\x01\x62\x65\x9d\x16\xc1\x77\xb4\x5d\x2a\xe0\xc6\x3e\x7b\x0c\x50\xc5\x66\x2e\x37\x1e\x4d\xb9\x5b\xb0\x3d\x06\xa8\x16\x61\xe8\x41\xc5\x32\x73\x03\xc0\x8d\xf9\x40\xf7\xfd\x32\x9f\x46\x9f\x1a\x0d\xd5\xd7\xb3\xf0\xa6\x99\x9a\x07\x4c\xaf\xce\xd1\x2b\x48\xec\x39\x2c\x9f\x3b\x26\x25\x62\xa7\xc2\x2f\xc7\xac\xe0\x8e\x39\xfe\x45\x25\xcc\x28\x7e\x83\xdf\x8a\xfc\xa4\x69\x4c\xa4\x47\x8b\xe7\x0a\x12\x13\x44\x45\x34\xe9\x7f\x2b\xcf\xe1\xc0\x5e\xa3\x3c\xe3\x18\x73\x33\x29\xa3\xed\x51\x6b\x90\xfb\x0d\xdd\x8c\xb6\x21\xad\x61\x52\x26\x3d\x24\x86\xe5\x52\x3d\xc7\x5a\xb7\x46\xd2\xd3\xa1\xf9\x66\xb1\x18\x6f\xd7\x5a\x63\x11\xc5\x33\x16\x6f\x49\xea\x43\x50\xe5\x82\x19\x4d\xbc\xd4\x01\xc5\x0b\x36\xc2\x27\xe9\x41\x7d\xdc\xa4\x6a\xdc\xd9\xfa\x61\x0d\xbd\xe2\x08\x19\x29\xe0\x63\xf9\x75\xb5\x7f\x67\x69\x6f\x18\x53\x7d\x6b\xa0\x2f\xfa\x73\x24\x94\x2d\xe6\xdc\x9c\x24\xac\x11\x4f\x1a\xbf\x8e\x04\xd6\x6d\xa3\x06\xbc\x64\x72\x98\xc1\x72\x0d\x64\x0a\xbb\x46\xba\xd5\x9f\x23\xa1\xea\xf6\x51\xe3\x7d\xad\x7e\x1b\x09\x5a\xd6\xa2\x46\xc1\xba\x5f\x46\x02\x96\xfd\x41\x0d\x78\xee\x7e\x19\x09\x28\x69\xa3\x24\x66\xfb\xe7\x6a\xfa\x4b\xaf\xd9\x88\x1b\x02\x6c\xfe\x3c\x3a\x85\x09\x69\x26\x11\xbf\x9b\xcd\xeb\xa1\xf8\x8e\x03\xf1\x2f\x70\x18\x7e\xce\x1f\xe0\x14\x3f\x04\xbf\xe3\x22\x29\x04\xf5\xe9\x3e\xbb\x82\xc4\x72\xbf\x4f\x14\x4c\x06\x15\x23\xf8\x5e\xbe\xa1\x93\x64\x2a\xd4\x83\x67\xf7\x34\x35\x26\x3a\x4d\xde\xa7\x0a\x26\x12\xc3\xc9\xba\xa5\xf9\xa6\x5f\x7b\x79\x0c\x54\x82\xe4\xd2\xef\x6a\x70\x1b\xb7\x53\x71\xde\xa7\x0c\xab\x3e\x57\xdc\xe0\xb6\x7c\xc3\x6f\xec\x5f\xc5\x93\x9d\xcf\xf9\x84\xc1\x43\x85\xd2\xa4\xe8\x7f\x70\xb2\x19\x39\xfc\x4c\x64\x15\xc3\x4e\xc2\x77\xdc\x35\x75\x9a\x7a\x6c\x6d\x1a\x0d\x13\x5b\x23\x3c\x9c\xb9\x79\x9a\x16\x70\x95\xa6\x68\xb3\x62\xc2\xa3\xaf\x7d\x26\x50\x57\xfb\x75\x70\x38\xbf\xac\x22\x86\x73\x81\xfa\xd9\xbf\x93\xb1\xc7\x2b\x69\x34\x1a\x96\x67\x19\x87\xdf\x28\x89\x0b\x98\xc5\x68\x29\xcf\x29\xc9\x7d\x5e\xfb\x6c\xf1\x1c\xd9\xf1\x83\x5d\x4d\x8a\xa1\xe7\xba\x4c\x5c\x38\xa5\x24\x3f\xff\x8c\xae\x2e\x9a\x2e\xd9\xeb\x65\xce\x16\xb0\x73\x18\x78\x81\xab\x9c\x75\xff\x9f\xdd\xc3\xd5\x17\xb4\xc7\x8f\xbd\x3a\x46\x88\x6b\xc7\x08\x4f\x78\x7b\x7c\x86\xb1\x9e\x89\xfc\x7f\xd4\x98\x06\x51\x03\x3c\x27\xee\x38\xde\xfd\x8a\x8d\x14\x30\xe5\x27\x37\xb8\xcf\xc5\xb5\xc7\xed\x7d\x8d\x86\x15\x55\x23\x3c\xa0\xc3\xc5\x35\x81\x5b\xb6\x46\xa7\x33\xd2\xeb\x0d\x60\x1d\xb7\x7f\xbd\xc0\xcd\x5f\x72\x05\x33\xb9\xc2\x72\xfc\x20\x53\x35\x91\xe4\xc1\xe7\xbe\x4e\x8d\x89\xe5\xff\x80\x2a\x98\x18\x34\x27\x30\x9c\xe0\x87\x4c\xd5\x4c\xbf\xa1\xe7\x03\xc8\xc2\x67\xed\x37\x70\x98\x24\x9a\x04\xe1\xec\x63\x35\x13\xf8\xa4\xff\x77\x18\xb2\x50\xe2\xf1\xce\xb7\x96\x7d\xc0\x53\x86\x2b\x2f\x60\x41\xe0\x6c\x06\xba\x1d\x19\xcd\x05\xdf\xf9\xf4\x4c\x6c\xf1\x30\x01\xb4\x28\x42\xed\xf5\x5a\x26\x70\x6a\x1e\xe8\xb4\x6c\x2b\xf8\x44\x14\x1e\x35\xed\x12\x11\x55\x54\xb6\x62\xc4\xc9\xb6\x81\x5a\xca\x56\x70\x38\xd9\x0a\xde\x63\x07\x34\x26\x29\xcd\x13\xe2\xf3\xee\xbb\x36\x30\x26\x8c\x3d\x52\x3f\x6e\xc8\xf6\x18\x87\xde\x55\x2d\xd9\xb9\x7d\xd5\xa9\xfb\x1c\x63\xa1\x53\x41\xb5\xec\x7e\x4a\xc4\xee\x16\x6d\xd7\x89\x2a\x7f\x74\xbe\x97\xa9\x2a\x60\xac\x48\x8f\x93\x06\x5e\xb0\xaa\x5d\xf0\x99\x55\xab\xef\xe5\x27\x3a\xe3\x8c\xfd\x9e\x69\xb7\x78\x98\x68\x5a\x14\xe1\xdc\x58\x1a\x2e\x70\x31\xed\x3a\xcb\x2e\x49\x4a\xe5\x82\x8b\xd8\xa7\x15\x67\x85\x89\x0e\x84\xfb\x54\xe1\x06\xc3\x8a\x13\xcc\xaa\x57\xb4\x56\xcc\x0b\x8f\x3a\x81\x46\x43\x6b\x44\x1d\x1e\xae\x3e\xcc\x8b\x0c\x2e\x4e\x6f\xba\x8e\xcf\x7a\xbf\xf8\xa5\xc7\xbd\x2f\x2f\x74\xed\x4b\x79\xeb\x0b\xda\x1a\x8a\x74\x93\xaf\x27\x2b\x9f\xb7\x5c\xd6\x98\x68\x6b\xd8\xa7\x0a\xd7\x1a\x0c\xa7\x15\x8d\x61\x66\xde\x3e\xbe\x9f\xe2\x9e\x6d\x63\x9a\x7b\xec\x1c\x2c\x1e\x26\x8a\x16\x45\x40\x6f\xb6\x9a\x0b\x5c\x9a\x47\xe7\xee\xc6\xda\xe7\x79\xfa\x12\xb1\xa3\x5d\x34\x69\xc2\xae\x65\xad\x33\x09\x9f\xae\xf0\xfb\x90\x62\xb9\xf5\xb8\x98\x25\xb7\x8e\xe6\xe8\x0a\x65\x1d\x3c\x70\x79\x32\x96\x5b\x98\xca\x2d\xee\xd9\x73\xc5\x7c\xfa\xf4\x5c\x31\xdc\x9b\x67\x1d\xfe\xec\xfb\x8e\x56\x4c\x5c\xd4\x86\xa1\xf6\x87\x1f\x59\x3a\xaa\xdc\x75\x5c\x38\xb3\x00\x03\x6f\x3e\x2a\x63\x0e\x33\x44\x2e\x63\x5d\x74\x99\xa5\x1a\xbb\x10\x8f\xdb\xec\x16\x0f\x6d\x6b\x86\xe2\x19\x46\x39\x2d\x98\x67\x19\x2e\x95\x49\xa1\x52\x92\x25\xcb\x97\xcf\xc4\x79\x86\xc9\x9a\x43\x78\x96\xbd\x5a\x99\x9b\xf0\x86\x4e\x96\x13\xc4\x29\xd3\x5f\xd6\x4a\xc9\x98\x12\x3d\x0c\x37\x51\x6a\x63\x55\x45\xe1\xf0\xae\xca\xef\x67\x60\x4a\xaa\x1c\xda\x50\x9b\x98\x36\x8e\xa9\x6a\x0e\xe9\x9b\x7e\x7f\x4e\x3e\x4b\x63\x22\x97\x4d\xfd\x39\xd4\x4e\x6c\x0f\xb1\x06\xdb\xc7\xd9\xdb\x91\x65\xa9\xc7\x0d\x30\x8d\x86\xb5\x76\x1d\x3e\xb1\x49\x1c\x5b\xaf\x0d\x84\xa4\x62\xfb\x02\xfe\xd9\x58\xca\xc1\xb2\x82\x2b\x96\xf2\x99\x79\x1d\x54\x2a\x06\xc2\x95\x89\x86\x98\x9a\xd7\x0e\x5b\x05\xaf\x86\x0a\x68\xe7\xbb\xda\xd7\x78\x7d\xfb\x76\x26\xab\x18\x4e\x56\xe8\xc6\x73\xca\x97\x1e\x35\x5c\xbe\x44\xd5\x5b\xde\xb3\x57\x1f\xbd\xf8\x99\xf2\x25\x5c\xf2\x25\xba\xee\x27\x48\xb1\xba\xf7\x79\xdd\x80\x05\xc4\x32\xde\x26\x09\xb7\x22\x67\xd9\xc0\x07\xfb\xc4\x6f\xa2\x93\x62\x53\x78\x3d\xf1\x54\x41\x62\x92\xa8\x88\x26\xdb\xd1\xeb\x9c\xfb\x8c\x02\x5e\x62\xe7\x18\xc1\xbb\xf2\x6d\x50\xf7\x53\xc7\xdf\x66\x35\xc4\x37\x74\x0d\x73\x95\xfe\xe1\xf1\xc4\x41\xfa\x87\xa3\x49\x49\xbe\xdc\x90\x65\x33\xe3\xab\xf4\x0f\x45\x85\xac\x3a\xf2\xa3\x9a\x77\x8d\x30\xd0\xfc\x3a\xfd\x03\x3e\x5e\xfe\x31\x4c\xbf\x36\x91\xd2\x3f\x6e\x4d\xaa\x2e\x5d\x8a\x8f\xc8\xe8\x4e\xf2\xbc\x20\xca\xab\xc1\x8c\x45\x7c\x5a\x5a\x7b\x14\x7d\xa4\x51\x46\x31\x77\xe9\xdc\x10\xb5\xc2\xb2\xc4\xb9\x47\x83\x11\x8d\x86\xb5\xc2\x46\x78\xb8\x75\x37\xce\xef\xe1\x82\xf3\x7b\xdc\x41\x5b\xb2\xf1\xb8\xff\xba\x49\x36\x58\xb6\x1b\xe1\x01\xbd\x13\x25\x1b\xf8\xb4\x49\x36\x68\x23\x4f\x16\x1e\x17\x55\x34\x1a\x96\xed\x46\x78\xb8\xe3\x16\xc9\x42\xc2\xc7\x64\x81\x5a\x33\xde\xc5\x3e\xaf\xd1\x8a\xf1\xbb\xb3\xe2\xf0\x17\x66\xc5\x02\x2e\x62\xf4\x98\x65\x42\xe7\xf4\x27\xdb\x64\x93\xec\x51\xfa\xd4\x39\xda\xb8\xe8\x80\x7b\x8c\x32\xdc\x88\xe9\xb8\xc1\x3b\xf7\x72\xf5\x38\xc3\x15\x91\x98\x0b\xaf\xb7\x03\x0b\x8a\x8c\x78\x94\xd4\xd7\x0f\x4c\xa2\xc6\x85\xe3\x93\x48\xf1\x35\xcd\xd9\xcf\xfa\x07\xfa\x10\xaf\x48\xbe\xa4\x37\x82\x17\x54\xa8\xc7\x2a\x20\x96\x2c\x2b\x1a\x31\x63\x6e\x4e\x8a\xd4\x23\x9c\xa0\x8b\xea\xbd\x45\xdb\x47\x96\x65\xb2\xc1\xb1\x81\xe9\xcc\x3c\x5b\x63\x07\x1e\xd3\x45\x3c\x63\x83\x62\x09\xba\x80\xaf\x74\x31\x20\x46\x29\x32\x01\xb7\xee\x6d\x40\xe4\xc2\x89\x15\xde\xef\xc9\x79\x00\x86\x2d\x4f\xf8\x68\x1e\xc3\x05\x34\x58\xb0\x65\x49\xc3\xd4\xbd\x20\x03\xfa\x96\xa6\x3c\x66\xca\xe3\x5d\xa0\x25\x22\xd6\xda\xf7\x68\x02\x6e\x3b\x5b\x3e\xf0\xcd\xbd\x74\x6e\x23\x30\x9f\xb6\x46\x25\x22\x26\x8a\x3d\x9a\xb0\xdb\x08\x8c\x64\x70\x7e\x72\x85\x6e\x23\xd0\x95\xe7\x1d\x77\x07\x88\x09\xa1\x4d\x12\x4c\x06\x8e\x0d\xbc\xb7\x4f\x74\x9f\xd1\xe7\x30\xd8\x31\xf6\xc9\xfb\x74\x22\x15\x17\x34\x19\x3b\xd5\x7c\x81\x21\x53\xde\xa7\x70\x30\x46\xf6\x88\x63\x33\xa6\xa3\xce\xcc\x1b\xaa\x78\x31\xe1\xd1\xcc\x51\xa3\xa1\xaa\x57\x1d\x1e\x4e\xf7\x62\x82\xc0\x05\x13\xf8\x99\x45\x4a\x82\xdc\xe0\xda\xc6\xc5\x44\x71\x94\x32\xdc\xa1\x46\x4a\xf2\x86\xaf\x97\x53\x4a\xfa\x5d\xe1\x4a\x53\x22\x15\x8b\x25\x25\x22\x5e\x4d\x04\xf5\xe9\xf4\xe0\x10\x1b\xed\xaf\x9e\xa2\x0e\xd7\x75\x35\x39\xc2\xfb\xe6\x17\xaa\xb6\x92\xc5\x82\x32\x9f\xd6\x01\x25\x22\x26\x9e\x92\x66\x32\xb2\x57\xdf\x43\x49\x39\x49\x5e\x64\x7c\x28\x39\x82\xe6\x08\x53\xf7\x75\xc9\x49\x72\xc4\x4a\xab\x2f\x96\x1d\x72\x4a\xb0\x1e\xe6\x5e\x5e\x4d\xdf\xbb\xad\xde\x47\xde\x9d\x7e\x64\xde\xd2\x82\x1a\x34\x89\xb0\x31\xe1\x87\xb9\xfa\xbc\x5b\xd3\x6d\x58\xd2\x37\x23\xe2\x76\x43\x44\x78\xbc\x72\xcf\xc0\xa1\x63\x7a\x83\x20\xa0\xa1\x10\x11\x6b\x98\xe9\xff\xe8\xee\x9d\x4f\xc3\x90\xac\xb2\xfc\x38\xbe\x77\x57\x05\x87\x53\xea\xab\x91\x23\x93\x5b\xb8\x92\xdb\x2e\xeb\x71\xbf\x96\xe3\x8e\xe8\xe8\x32\x74\x23\x7c\xa0\x5d\x4c\x65\x0b\x8e\x5a\x5e\x28\x22\x16\x2c\xf5\xd8\x9b\x3b\x40\x2c\x4f\x6d\x92\x81\xd9\x72\x91\xe1\x96\x08\x3d\xcf\xef\x3e\x8f\x28\x7d\xfa\xa1\x2c\x11\x3b\xe7\x5f\x72\x8c\xf7\xc9\x31\x1e\x9d\x94\x84\xd9\x2d\x6e\xc6\x45\x54\x4a\x64\xe6\xf3\x60\x62\x89\x88\x8a\xa1\x4d\x13\xd0\x0c\xc4\xf2\x81\x13\xfd\xd2\x69\x76\xe0\xf5\xe0\xad\x81\x43\x3b\xaf\x06\x41\x40\x7b\x81\x9c\x71\xb8\xd2\xff\xbb\x4c\x7d\x03\x78\x35\xee\xe7\xd4\xf8\xe5\x7c\x1a\xb7\x5d\x1a\xa3\x07\x03\xb3\xc2\x63\x9b\xd0\x68\xa8\x5a\x54\x87\x87\x3b\x06\x98\x15\x05\xfc\xc8\x8a\x1e\x97\x50\x6e\x69\xae\xe6\x82\x25\x4b\xdf\xce\x3b\x1a\xc8\x9d\xbd\xe4\x21\x6d\xe0\xcb\x29\x6b\x7e\xf0\xbe\x7e\xef\xb8\xfa\x7b\xe5\xd5\x51\xa4\xc1\xc3\x24\xd3\xa2\x08\x67\x32\x61\xb8\xc0\x07\xa6\x3e\x76\x78\x83\xe4\xc2\x67\x8f\x61\xe0\xd0\xa9\x5e\x83\x20\xdc\xbc\x4c\x33\x81\xa9\xfe\x8f\xf7\x10\xe9\xe4\x8e\x3c\x78\xed\x25\x2c\x22\x32\xe7\xd9\xdb\x90\x19\x36\xed\xa9\xb7\x26\x7e\x5c\x5d\x1e\xdd\x9d\x68\xa7\x27\x4d\x98\xc7\xcb\x07\x34\x1a\x56\xb6\x8d\xf0\x70\x46\xfe\x09\x5b\xc0\x65\xc2\x8e\x5e\x40\xd0\x3e\xa5\xe2\xf7\x1e\xde\x1e\xd7\xf0\xbe\xd0\x2d\xbc\xe6\x12\xde\x27\x2e\xe0\x2d\x13\xbc\xa3\xf3\xb7\x77\xfe\xf2\x6f\xe0\xb0\xdc\x37\x09\xc2\x39\xf6\xd2\x4c\xe0\xbb\xfe\x8f\x7a\xd9\x59\xe6\xcc\xa7\xd3\x2a\x8b\x87\xe5\xde\x52\xd8\xf1\x6e\xb4\xc7\x0e\x07\xf2\x2c\xf3\x6c\x87\xf1\x0c\x23\x71\x87\xa0\xc7\xcd\xd1\x57\xec\x3b\x8c\x67\x2d\xe6\x95\x18\x3c\x2b\x36\x63\xfc\x7f\xb5\x50\x9e\x61\x7c\x3f\xd0\x23\x92\xe1\x07\x46\x7a\x70\x6e\x3e\xce\x93\x0f\x74\xd8\xad\xf6\x0e\x44\x52\xe5\x20\x86\x9a\x94\x3b\x80\xda\xce\xdd\xc2\x8c\xb3\x73\x77\x60\xd6\x00\xdf\x02\x0d\x37\xc0\x77\x20\xae\x34\x1d\xcc\xd4\x7e\x8d\x01\xb2\x2d\xcd\xe1\x18\x55\x6f\x98\x03\xfe\x32\x3d\x26\xd0\xa5\xa6\x6b\xa1\x35\xe3\xf9\x92\x27\xf3\xc9\x52\x30\xaf\x66\x4e\x6d\x5c\x74\xba\x79\x8c\x32\xdc\xbc\xd3\x72\x03\xcb\x0d\x3e\x08\x96\x9c\x75\x5c\x28\xec\x73\x21\x3a\xc4\x45\x40\xc3\x2f\x07\xe6\x39\x4c\x05\xee\x6c\x85\xfc\xdc\x08\x6a\xf6\x63\xc9\xd2\x75\xdf\x1e\x15\x8f\x43\x70\x54\x09\x79\x92\x3c\x9c\x42\xa2\x59\x82\x63\xe9\xba\x89\x23\x1d\x44\x3b\x5b\x82\x92\x58\xb1\x2d\x75\x9e\x21\x3c\xb6\xa6\x7d\x64\x4c\x5a\x4f\xd0\x06\x13\x55\xc9\xcf\xf9\x81\x90\xf0\xd5\xfd\x60\x5d\x40\xa0\x8d\x4b\x90\xf9\x9c\x29\x9f\xde\xd7\x4a\x44\x54\x42\x6d\x9a\x70\x92\x71\x7c\xe0\xab\x79\xb9\xfa\x82\xf6\x33\x2b\xa2\x64\x2c\x58\xe1\x71\x3b\xb7\xc6\x44\xfb\x9c\x7d\xaa\x70\x3d\x4f\xc5\x09\xa6\x2b\xa2\x66\xe6\xb5\x6b\xca\x13\xc6\x61\x6b\x6f\x7f\xad\x2f\xea\xae\xf5\x98\xb7\xd6\xce\x5e\xda\x73\xbf\xdc\xdd\x13\xcf\xd3\xf1\x17\x29\x5b\x84\x17\xec\xc3\x1b\x7d\xb7\xbb\x68\x74\xd8\x51\x41\x03\xa2\x73\x0c\xa7\x29\x9f\x1f\x85\x38\x58\x2d\x91\x34\xde\x08\xaf\xe6\x84\x0d\x50\xac\x7c\x1a\x64\x93\x2d\x15\x6c\x31\x62\x4b\xfb\x10\x49\xb2\xe5\x08\xa5\xe4\xe8\x36\x9a\xc1\xa4\x3f\xae\x2e\x47\x95\x68\x23\x51\xf0\x23\x4b\xbf\xe9\x1c\xb2\x5e\xa7\x0c\x1b\x9b\x71\x2d\x90\xab\xcb\x99\x7b\x1f\x76\xf8\x65\x2f\x25\x33\xb6\xcc\x3b\xae\xf0\x11\x9c\x6f\x3d\x56\x08\x8b\xe7\xc8\x8e\xac\xc8\xb5\xc2\x07\x2d\xc7\xd9\x98\xf0\xc1\x3c\x90\x55\x38\xbf\x96\x47\x5d\xb6\x46\x47\xac\x8b\xfa\xba\x88\xd6\x51\x27\xa4\x60\xe1\x15\x21\xa9\xe0\x2b\x1d\x78\x8d\x43\x15\xeb\xa4\x60\x5d\x1e\x21\x3d\xf6\x27\x32\x45\xc5\xdd\x08\x0f\xeb\xff\xf1\x87\x4c\x51\x0d\x60\x4e\x49\x2e\x15\x49\x3d\x5a\xad\x54\x90\x98\x00\xf6\x89\x82\x9a\x01\x1a\x46\xc6\x02\xd0\xbc\xe1\x86\x51\x4b\x8f\x1b\x1b\x1a\x0d\x93\x42\x23\x3c\xa0\xd7\xab\x25\x81\x19\x59\xa2\x9b\x1a\x0b\xee\xf3\x7e\x47\x8e\x6e\x7a\xd6\xc1\xe1\xee\x59\xe4\x05\x9c\x71\x74\xc7\xf3\x8e\xaf\xf2\x9f\x5e\xaf\xf5\xb3\x80\xd8\xe8\x6c\xaf\xf5\x6b\xd1\x0d\x3d\x46\x68\xe2\xc2\x85\x7d\xa2\x86\x2e\x92\xa7\x1e\xad\x7c\x35\x1a\x5a\x93\x79\x2a\x46\x2f\x62\x37\xc0\x47\xc5\x9d\xa6\x7c\x33\xc2\xaa\x7d\x60\x3b\xe2\xa9\x80\x99\xe6\x86\xd4\xaa\xfb\x0d\x8b\xd7\x0b\x9f\x6e\x6b\x4a\x44\x4c\xf8\x7b\x34\xc1\x44\x50\xf2\xb9\x83\x2f\xe5\x1b\x7e\xc2\x51\x97\x8b\xc7\x65\x1a\x07\x88\x89\xa2\x4d\x12\xf0\xb4\xa3\x61\x03\x17\xf6\x89\xaf\x5a\x49\x35\xe1\x05\xcd\x49\xc1\xfc\xea\x73\x25\x6a\x97\x5e\xb7\x47\x17\x56\x41\x73\xbc\x8c\xca\xf5\xb9\xa0\x79\x87\xda\xb5\x60\x29\x9d\xec\x88\xf2\x79\xe1\x43\x8d\x89\x0e\x43\xfb\x54\x61\x6f\xfd\x35\x9c\xcc\x31\xc4\xef\xfa\x0d\xad\x31\x7c\xce\xd5\x42\x90\x8c\xee\xb8\x4f\x73\xe2\x36\x2e\x5a\x6b\x8e\x51\x86\xab\x37\x2d\x6e\xf0\x55\x7f\x9e\x95\x9f\x5d\x95\xc7\x6f\xb5\xe9\xaa\x30\x2f\x52\x55\x74\x25\x41\xf7\x94\x1e\xe7\x44\xf9\xbc\x8e\xd5\x01\x62\x99\x77\x24\x46\xb9\x1f\x3b\x54\xb7\xd9\x84\xdb\x82\xb2\x6c\xe0\xea\xf1\x54\x3f\x87\xf9\x48\x6a\xc7\xd5\x53\x06\x74\x51\x79\xee\xf3\x24\x95\x46\xc3\xd4\xc7\x46\xf8\xc0\x75\xe1\x39\x17\x30\x3d\xfd\xfc\x15\x55\x18\x69\xea\xf5\x38\xa2\x81\xc3\xaa\x54\x93\x20\xe0\x89\x26\x79\x9f\xc2\x7b\xfd\x1f\xb7\x01\x8e\x05\xcf\xa8\xd7\x6b\xb0\x6b\x4c\xb4\x61\xed\x53\x05\x34\x09\x2e\x39\xc1\x55\xf5\x8a\x09\x25\xa7\xca\xe7\x1a\xab\x81\xc3\x44\xd1\x24\x08\x26\x05\xc3\x04\xae\xf5\x7f\x7c\x7a\xe8\x73\x39\x48\xa3\xa1\x5a\x2b\x0f\xbf\x1c\xa4\x79\xc0\x05\xc7\x97\x83\xfc\x5e\x68\xd8\x75\x95\xa1\x0e\x1f\x3d\x69\x7c\x89\x1b\x10\xcd\xdd\x87\x5d\xd7\x1e\x2a\x9a\xd2\xa5\xf0\x79\xa2\xbf\x44\xc4\x64\xb7\x47\x13\xee\x02\x21\xc7\x07\x6e\xdd\x0b\xba\x0a\x4f\xf2\x65\xca\x3c\x2e\xa0\x39\x40\x4c\x10\x6d\x92\x70\xb6\xd1\x96\x0d\x7c\xb0\xcf\x6e\x4f\xd1\x2c\xa7\xd2\xa7\x92\xd6\x44\xc5\xe4\x71\x84\x6e\xf8\x2e\x62\x03\x63\xb2\x60\x82\xae\xb8\x7c\x19\x6b\x7c\xc7\x14\x3e\xd9\xe7\x9b\x61\x9b\x89\x1a\xa1\x4c\x6e\x09\x71\xe6\xbe\x51\xcb\x57\xb5\x63\xca\xeb\xe0\xef\x00\xd1\x16\x6c\x49\x26\xf6\x7c\xf5\xd8\xa2\x2a\x51\x14\xcb\x68\xca\xc6\xf8\x4b\x6f\xe3\x24\x4c\xd0\x58\x59\x2b\xc0\xe0\x45\xee\x98\x82\x3b\x70\x7e\x6b\x3f\x67\x47\x0e\x9c\xf7\x04\x6a\xa5\xbe\xc4\x7b\x67\x7e\xb4\x86\x8c\xc3\x76\xa7\x4b\xd8\x52\xb8\x25\xe2\xad\xfb\xee\xb2\xad\x90\x3b\x8f\x86\xf4\x0e\xb0\xa3\xed\x37\x48\x82\x5a\x52\xc8\xdd\x02\x66\xdf\xcf\x30\x09\x14\x9b\x79\xee\xf3\x98\x8c\xc5\xc3\xf2\xdf\xa2\x08\x96\x7d\xcb\x05\x6e\x36\xf3\x6b\xfc\x98\x8c\x54\x3e\xb3\xaf\xd1\xb0\xcc\x37\xc2\x43\x79\x94\xd5\x2c\x60\xa6\xf0\x5c\xdb\xb3\xae\xf7\xde\x4f\xd8\xde\xf7\x38\x61\x7b\xff\x42\x27\x6c\xef\x25\xcc\xee\xf7\x47\xa7\x3d\x9d\x5f\xfd\xcf\xaf\xbf\x7a\x9c\x42\x68\x38\x74\x0e\xd1\x20\x08\x37\x89\xd0\x4c\xe0\x42\xff\x47\x0f\x53\x70\xe5\x51\x09\xd4\x68\x58\xce\x1b\xe1\xe1\x6c\xf4\xb9\x22\x70\xfe\xf9\xf6\x04\xbf\xb7\x98\xfb\x5d\xe5\x77\x80\x8e\xee\xc9\x3d\xc5\x93\x8a\x64\x60\x69\x5a\x78\xe3\x92\xf5\xa4\x60\xdd\xe7\xe6\xe9\xda\x77\xab\xa6\xeb\xee\x56\x5d\xd3\x04\x3e\x09\xba\x96\xf0\xfe\x13\x7e\x6e\x7e\x47\x89\x5a\xf9\x54\x14\x1d\x20\x26\x84\x36\x49\xc0\x83\x52\x86\x0d\x7c\xb7\x4f\x74\x60\x8b\x57\x34\xd9\xa4\x3e\xe5\x50\x41\xa2\x43\xdc\x1e\x51\xc0\x0b\xbb\x1d\x23\x98\x95\x6f\x68\xc3\x27\x0f\x1e\x07\x7a\x8d\x86\x36\xf9\x3a\xbc\x5f\xae\xf2\x2d\x15\x5a\x8d\xd5\x11\xe1\x82\x3c\xcc\xd1\x96\x7e\xc7\x72\x8f\x5e\xf0\xef\xea\x03\x54\x4f\xf8\x47\x0b\x7f\xc0\xaa\xf6\xe5\xa2\xb9\xc1\xc5\xc1\x69\xa6\x76\x92\x6b\xa1\xfb\x13\x43\x8d\x89\x09\xe3\x80\x2a\x94\x22\xd7\xb0\xd7\xac\xeb\x02\x5a\xc3\x8d\x08\x7c\xee\xe5\x5b\x40\xb4\x6a\xb4\x48\xc2\xe9\x34\x96\x0d\x5c\x7c\x30\x4f\x4c\x0a\x8f\x24\xcb\x7c\x76\x7a\x16\x0f\x93\x41\x8b\x22\x98\x08\x2c\x17\xf8\x8f\x79\xa0\xeb\xc3\x73\xe2\xf3\x96\x60\x03\x87\x65\xbf\x49\x10\x6e\x95\x57\x33\x81\x8f\xa7\xa4\xe3\x8a\x60\x3d\xb9\x4e\x3c\xf6\xf3\x0e\xb0\x43\xff\x69\x90\x84\x9d\xd0\x27\x73\x98\x25\xf8\x7c\x96\x14\x93\x9c\xaa\x1d\x25\x5b\xaf\x43\x7f\x13\x16\x1d\xfe\x8f\x10\x06\xb4\x9b\x2c\xa0\x62\x06\xd7\x54\x7d\x37\x6f\xa8\x7c\xec\xe9\x85\x9c\xef\x3c\x0a\xa7\xc2\x44\x25\xb3\x4f\x15\x4e\x2c\x15\x27\x70\x87\x35\xae\xf9\x0e\xb7\xcc\xf7\x79\xab\xf6\xb2\xe3\x46\xed\x46\x78\xa8\x7b\x84\x34\x0b\xf8\x20\x0a\xf4\x26\x21\x56\xf8\x3c\xed\xab\xd1\xb0\x5c\x37\xc2\xc3\xcd\x80\x8b\x85\x84\xf3\x9b\x33\xf4\xe2\x54\xdd\x5f\xf9\xbe\x23\xb3\xc7\x15\x99\x2f\x74\x43\x66\x79\x41\x26\xbe\xe6\x47\x3c\x5a\x7f\x6a\x34\xb4\xdd\xd7\xe1\xe1\x5a\xbc\x22\x0f\x30\x53\x27\x3f\x3a\x17\xfd\x32\xe9\xd1\x5a\xad\x44\xec\x28\xfa\x26\x4d\xd8\xe5\x81\x4c\xae\xe1\x6a\xf6\x09\x5d\x1e\xa8\x2e\x98\x90\xf7\xa9\xbd\xad\xcd\xe3\x14\xe2\x00\x1b\x9d\x4a\x3c\x45\xfd\x72\xd7\x5d\xcc\x4a\xce\x68\x7b\xc9\xc9\x9a\x3e\x92\xcc\xa7\x23\xf4\x12\xd2\x51\x1e\x9d\x44\xeb\xf0\x03\xca\x81\x0d\xa3\x8c\x0d\x33\xfd\xf6\x9f\x93\xab\x4b\xfc\xc6\x59\x22\xd5\x9d\xf4\x79\xe6\xa0\x44\xc4\x32\x6a\x0e\x1d\xec\x11\x0e\x34\x4a\x74\x91\xe1\xcc\xbd\xa0\xb9\x7c\xf0\xe8\x41\xe8\x01\x75\x1f\xf4\x10\xdc\x77\xd0\xc3\x1d\xfc\xb8\xc0\x6f\x8e\x13\x34\xf6\xb9\x4c\x60\xf0\xd0\x76\xdd\xa4\x08\xb6\x3c\x60\x98\x80\xdd\x38\xed\x3a\x3e\xe6\x2f\xf7\x0d\xe3\xd2\x27\x0f\x8d\x0d\xce\xb9\x3b\x84\x9f\x12\xd9\x53\x45\x68\xc4\x3d\x72\xf0\xb3\x91\x86\xe1\x07\xd1\x4c\x2a\x60\xaa\xff\x0f\xda\x87\x36\xbe\xec\x0f\x8d\x51\xf1\x83\xa6\x55\x24\xe4\x90\xa9\x99\x5f\xbe\xf5\x3c\xa5\x7d\xdb\xa5\xad\x55\x14\x61\x27\xb4\x6f\x61\xf6\xb6\xe3\xcc\xb0\x47\x7d\x45\xa3\x61\xfd\x70\x23\x7c\xe8\x7c\x83\xaf\xe1\x83\xe0\x6b\xb4\xdb\x8d\xf9\x26\x5e\xf9\x5c\x9d\x70\x80\x58\x59\xb6\x49\x02\x3a\x66\x34\x6c\x60\xaa\x9f\xef\xd0\x15\x8a\x1d\x17\xeb\x84\x78\x34\x5a\x75\x80\x98\x14\xda\x24\xe1\xb6\x67\x2c\x1b\xf8\x6e\x9f\x98\x14\x7e\xb2\x62\x92\xd0\x45\x4a\xbc\x9a\x34\x35\x51\xd1\x8a\xfe\x93\x15\x7b\x74\x93\xe8\xf0\xb7\x81\x3e\xbe\xcb\xd8\xf0\x17\x2b\xde\xb9\xf7\x3e\x3e\x05\x8e\x41\x7c\xf8\xf9\x24\xc6\x9e\x26\xe9\xd5\x1b\xb0\xec\xf0\x06\xac\xc3\xc7\x9f\xa1\x7c\x01\x57\xc2\x9a\x07\xcc\x3a\x5c\x09\xcb\x47\xe9\xf5\x1a\x65\x8b\x87\x55\xb8\x16\xc5\xc0\x1c\x99\xa8\x30\x33\x8f\xae\xfd\x6f\xef\xd7\x76\xf5\xb8\xb5\xeb\x85\x2e\xed\x2a\xef\xec\xea\x35\xb7\x2d\xb8\x54\x4b\x41\x3d\xae\xf2\x1c\x40\xf7\x9a\xd9\xee\x11\xbf\xdc\xc4\xf6\xc6\x31\x46\xcf\xa6\xa5\x2c\xf7\xa8\x5d\x18\x38\x4c\x2a\x4d\x82\x70\xc7\xd3\x34\x13\x38\xd3\xff\xd1\xc5\xa0\xec\xde\xe7\xc5\x02\xd9\x3d\x7e\xa9\x40\x1d\x1e\xae\x89\x64\xf7\x05\x9c\x5c\x7d\xb9\x41\xf5\xc9\x0d\xd9\x12\xe7\xbf\x7c\xe3\xb1\x75\xb4\x71\x31\x51\x1c\xa5\x0c\x67\x12\xaf\xb9\x41\xc9\x0d\x3e\xe8\x4f\xe3\x56\xf3\x74\x83\x36\x8d\x62\x93\x4a\xe2\x51\x31\xb1\x78\x98\x5c\x5a\x14\x01\xed\x42\x35\x17\xb8\x31\x0f\x74\x33\xbd\xf0\x79\x01\x5d\x81\xdf\x3f\x57\x84\xbf\x7e\xae\x20\x70\x51\x74\x1f\x89\x48\x3c\x6f\x9e\x1a\xc4\x8e\xf1\xb3\x49\x33\xee\x18\x44\x92\xcc\xad\xe7\xc5\x17\x18\x86\xdf\x40\x92\xcc\xe1\x5d\x32\x1f\x71\xee\xa1\x4a\xa7\x89\x6f\x9d\x43\xe2\x9e\x69\x63\x8f\xe3\x93\x46\xc3\x8a\xa2\x11\x1e\x6a\xd1\x4a\xb3\x80\x2b\x1e\xa3\x43\x13\xa3\xf1\xef\xbf\xfe\xdf\x3f\x3c\x1a\xe6\x96\x88\x58\xee\x4b\x9a\xc9\xd8\x95\xf8\x3d\x98\x38\x65\x75\xd5\x08\xb7\xe3\xe7\xd8\x81\x65\x07\x53\xf3\x18\xe6\x32\xb9\x84\xb0\x19\x37\x5b\xc6\xf8\x7e\xc0\x8e\xae\x3d\x76\x8e\x1a\x0d\x2b\x98\x46\x78\x40\xbb\xca\x35\x81\xef\x74\x8d\x76\x90\x31\xcf\x95\xe0\xa9\x57\xbd\xa1\xc6\xc4\x44\x70\x40\x15\xaa\x7d\xd6\x8c\x60\x6a\x5f\x3b\xf4\x84\x2d\x15\xca\xe3\x5e\xaa\x81\xc3\x24\xd1\x24\x08\x78\x59\xb4\x50\x0f\xf0\x4d\xff\x47\x55\x84\x64\xee\xd1\x6a\x42\xa3\xa1\x4a\x42\x1d\x1e\x4e\x4b\x48\xe6\x31\x5c\x24\x73\xd4\x6c\x22\xe6\xb9\xdc\x78\xdc\x0a\xb4\x78\x1d\xd5\xbf\xa6\x08\xb8\x84\xa9\xb9\x98\xab\xd2\x37\xa8\x23\x02\xbe\xcc\x3d\x66\x5f\xa3\x39\xa2\x23\x5b\x19\x8d\xd0\x41\x1e\x2c\x75\x3c\xf8\xbc\xcc\x53\x64\x63\x61\x41\x62\x3a\xe7\x3e\xd7\xd7\x4b\x44\xac\x30\xf7\x68\xc2\xcd\x83\x1d\x1f\x38\x73\x2f\xa8\x67\xc9\x82\x28\x8f\xde\x8d\x0c\xdc\xd3\x85\xda\x0c\x1e\x76\x4d\x90\x8e\x08\x3f\x6e\x88\x5a\x21\xe5\x9a\x4b\x8f\xce\xc4\x73\x89\xfa\x11\xaf\x83\xc3\x79\x83\x90\xf7\x70\x2d\xef\xbb\x0e\xf5\xf8\x34\xee\xd7\x70\x8e\x0a\x71\x12\xd8\xa0\x1a\x7e\xa6\x67\x6e\x4e\xf4\xe0\x56\xfe\xf6\xbc\x5c\xee\xfd\x94\x5e\xde\xe3\x94\x5e\xfe\x42\xa7\xf4\x72\x09\xb3\x1c\x3f\xa5\x97\x6d\xa9\xc7\xfe\x56\xa3\xa1\xb3\xa0\x3a\xfc\x79\x97\x20\x37\x80\x06\x4e\x92\xb6\x34\x85\xab\x2d\xed\x73\xc5\x7e\xd5\x35\x54\x91\x90\x8e\x61\x93\xb3\x2d\x8f\x99\x7a\x9c\x14\x44\x48\x2a\x3c\x56\xac\x03\x68\xac\xf9\xd4\xc4\x71\x75\x49\x70\xf3\x57\x75\xf4\xd7\x05\x7b\xa0\xc9\x00\x81\x36\x76\x95\x2a\x10\xf8\x77\xce\xbe\x99\xb7\xa9\xdc\x0e\xdc\x9a\x3a\x02\x72\xeb\x03\xe4\x4c\xe7\xeb\x3b\x4b\xd4\x0a\xed\x0c\x04\xf5\x78\x4e\x5d\x50\xf4\x8c\x7a\x1d\x1c\x6a\xa2\x21\xe8\x02\xbe\x52\xf4\xae\xb7\xf4\xa7\xcf\x1b\xee\x7e\x2e\xb0\x1a\x59\x07\x0f\xac\x59\xe9\xcf\x05\x5c\xfe\x75\x86\x96\x5c\x41\x56\x1e\xef\xae\xd5\x68\x58\xd9\x35\xc2\xc3\xad\xa2\x92\x15\x87\x1b\xb2\x42\xef\xad\xdd\x7a\xdc\x88\xdb\xa2\x7b\x70\xdb\x9e\xeb\x7e\xa3\x6b\xeb\x36\x83\x6f\xe8\x42\xdd\x9c\xe5\x89\x47\x8b\x06\x03\x87\xd5\x57\x43\xd0\xea\x11\xcb\xdf\xd6\xdb\x62\xef\x97\xba\x8f\x1d\x58\xb7\x4d\x74\x30\x4c\xe0\x54\xbf\x9b\x9e\xea\x92\xe6\xcb\x83\xae\xaa\x1f\xd4\x7a\x5b\x58\xa0\x4f\xf4\xf1\x1b\x49\x37\xf4\x86\xb0\xa1\x06\x02\x16\x29\x96\x5b\x8b\x74\xd8\x8d\xef\xab\x72\x5e\x7d\x84\x75\xb8\x8c\xbf\x7b\x01\x97\xf1\x9a\x07\x5c\x74\xb8\x8c\x27\x85\x57\xad\xb1\xe8\xd0\x18\x8b\x17\xd0\x16\x8b\x5c\xc2\x49\x91\xa3\xab\x51\xbc\x50\xa4\x48\x49\x9e\xfb\xb4\xa9\x69\x80\x62\x42\x38\x24\x0b\x26\x8b\x06\x2b\xf8\x5c\x28\x72\x63\xdf\xf1\xab\x13\xcc\x66\x83\xc7\x29\x6e\x6b\xc3\xe5\xe9\x59\x52\x9b\x6e\x12\xb5\xbf\x07\xf6\x48\x2e\x32\xfc\xb0\x5b\x27\x03\xbb\x8e\x32\xf6\x45\x97\x79\x74\x65\xbd\xe0\x6e\xca\x0b\x60\x44\xe1\x90\xb1\x0a\xf5\x04\xed\xcb\x99\x50\x5c\x59\xbe\xf8\xa1\xfa\x78\x2d\x79\xfe\xe0\xf3\x68\x40\x8d\x89\x56\xae\x7d\xaa\x81\xbd\x68\x15\x1d\x2e\xec\xeb\x8f\x8e\x93\x01\x3f\x39\x5f\x53\x5a\x50\x31\xc9\x88\xf4\x6b\xb4\xb7\x87\x8c\x55\x8a\x27\x68\x83\x55\x8a\x8a\x9f\x65\x07\x57\xe6\x71\xac\x4a\xac\x27\xeb\x9c\x28\xcd\xae\x53\x30\xeb\x27\x45\x52\x81\x60\x32\x68\x93\xf4\x3f\x42\x18\x45\x85\xe0\xc9\x26\xae\xcb\xce\xd4\x2e\x5a\xd0\x3c\xa1\x79\xcc\x6a\x3e\x51\x69\x0d\xdc\x91\x81\xce\x6c\x4c\x4a\x8e\x55\x04\xb3\xca\x9c\xbd\x64\x12\x4a\x8e\xc7\xa6\x58\x6e\xad\xc0\xe3\x3c\xab\xb9\xfa\xf0\xd4\x6d\xe2\x6d\x9a\x50\x4a\x7b\xb5\x34\x52\xae\x88\x74\x79\x4b\xc9\x88\xf2\x7a\xd1\x69\x8d\x89\x49\xe3\x80\x2a\xa8\xc3\x14\xcb\xc9\x78\x4c\xb1\xaf\x4d\xa1\xa4\x9c\x24\xd5\xaa\xd0\x5d\x0f\x05\x12\x6b\xc8\x16\x6c\x72\x57\xca\xe9\xc8\x0a\x59\x15\x96\x51\x45\x74\x2f\x5f\x57\x7e\xd7\x95\x44\xbf\xe8\xe2\xff\xc5\xfc\xbc\x56\x7e\x52\xb4\xe6\x2a\x65\xf9\xd3\xa9\x5a\xab\x1e\xc9\x32\xa5\x60\xd3\xd5\xef\x60\x5c\x8f\x84\x35\x0e\xb9\x1d\x49\x56\x23\xb4\x97\xb8\xfa\xde\xb6\xd6\x23\x61\x83\x2e\x5a\xeb\x27\x34\x5d\x73\xfd\xd4\x2f\xb2\x25\x48\x0d\xab\x43\xfb\xa5\xab\x97\x26\xd3\x23\x59\x0f\x58\x51\x3e\xf4\x2f\xc9\xff\x1f\x00\x00\xff\xff\xd9\x7d\x98\x19\xa5\x5c\x01\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x7d\xdd\x72\xdb\xb8\xb2\xee\xbd\x9f\x82\xb5\x72\xb3\x77\x9d\x21\x66\x4d\x72\xd6\x4c\x55\xce\x95\xad\xd8\x89\x1d\xcb\x71\x2c\xef\x24\x6b\xdd\x4c\x41\x24\x44\xc1\x22\x09\x1a\x80\x24\x3b\x4f\x7f\xaa\x41\xf0\xd7\x74\x4b\x94\x80\xec\xa4\xca\xfc\x41\xe3\x6b\xf4\xd7\x20\x09\x01\x0d\xe0\x4d\x10\xba\xfb\x77\xf2\x26\xb8\xe6\x11\xcb\x15\x8b\x03\x2d\x02\xbd\x64\xc1\x69\x41\xa3\x25\x0b\x66\x62\xa1\xb7\x54\xb2\xe0\x42\xac\xf3\x98\x6a\x2e\xf2\xe0\xbf\x4e\x67\x17\xff\x1d\xac\xf3\x98\xc9\x40\xe4\x2c\x10\x32\xc8\x84\x64\x27\x6f\x82\x48\xe4\x5a\xf2\xf9\x5a\x0b\x19\xa4\x25\x60\x40\x13\xc9\x58\xc6\x72\xad\x48\x10\xcc\x18\x33\xe8\x37\x5f\xee\x2f\x27\xe7\xc1\x82\xa7\x2c\x88\xb9\x2a\x33\xb1\x38\xd8\x72\xbd\x3c\x79\x13\xe8\x25\x57\xc1\x56\xc8\x55\xb0\x10\x32\xa0\x71\xcc\x41\x31\x4d\x03\x9e\x2f\x84\xcc\xca\x62\x48\x96\x50\x19\xf3\x3c\x09\x22\x51\x3c\x4b\x9e\x2c\x75\x20\xb6\x39\x93\x6a\xc9\x0b\x72\xf2\x26\xb8\x07\x33\x66\x17\x55\x49\x54\x09\x6b\x74\x6a\x11\xfc\x5b\xac\xad\x0d\x2d\x73\x2d\x0b\xbf\x05\xdf\x98\x54\xa0\xe4\x2d\xf9\xe7\xc9\x9b\xe0\xbf\x40\xe4\x1f\x36\xf1\x1f\xff\xfd\xff\x82\x67\xb1\x0e\x32\xfa\x1c\xe4\x42\x07\x6b\xc5\x5a\xc8\xec\x29\x62\x85\x0e\x78\x1e\x44\x22\x2b\x52\x4e\xf3\x88\x35\x66\xd5\x1a\x48\x60\x0a\x00\x18\x62\xae\x29\xcf\x03\x6a\xcc\x08\xc4\xa2\x2d\x16\x50\x7d\xf2\xe6\xe4\x4d\x60\xfe\x2d\xb5\x2e\xde\xff\xfe\xfb\x76\xbb\x25\xd4\x78\x87\x08\x99\xfc\x5e\x59\xf7\xfb\xf5\xe5\xe4\xfc\x66\x76\x1e\x9a\x22\x9f\xbc\x09\xfe\x27\x4f\x99\x52\x81\x64\x8f\x6b\x2e\x59\x1c\xcc\x9f\x03\x5a\x14\x29\x8f\xe8\x3c\x65\x41\x4a\xb7\xe0\x38\xe3\x1d\xe3\x74\x9e\x07\x5b\xc9\x35\xcf\x93\xdf\x02\x65\xbd\x7e\xf2\xa6\xe3\x9d\x86\xae\xaa\x78\x5c\x75\x04\x44\x1e\xd0\x3c\xf8\xc7\xe9\x2c\xb8\x9c\xfd\x23\x38\x3b\x9d\x5d\xce\x7e\x3b\x79\x13\x7c\xbf\xbc\xff\xf4\xe5\x7f\xee\x83\xef\xa7\x77\x77\xa7\x37\xf7\x97\xe7\xb3\xe0\xcb\x5d\x30\xf9\x72\xf3\xe1\xf2\xfe\xf2\xcb\xcd\x2c\xf8\x72\x11\x9c\xde\xfc\x3b\xf8\x7c\x79\xf3\xe1\xb7\x80\x71\xbd\x64\x32\x60\x4f\x85\x84\xf2\x0b\x19\x70\x20\x92\xc5\xe0\xd3\xaa\x02\x55\x05\x80\xfa\x01\xd7\xaa\x60\x11\x5f\xf0\x28\x48\x69\x9e\xac\x69\xc2\x82\x44\x6c\x98\xcc\xa1\x7a\x14\x4c\x66\x5c\x81\x3b\x55\x40\xf3\xf8\xe4\x4d\x90\xf2\x8c\x6b\x53\x8b\xd4\x4b\xa3\x40\x4d\xf5\x60\x38\xf8\x77\x72\x42\x0b\x6e\xab\xd3\xfb\x20\xa2\x19\x4b\xdb\xee\xdb\xfc\x71\xb2\xe2\x79\xfc\x3e\x98\x40\xca\x84\x6a\x9a\x8a\xe4\x24\x63\x9a\xc6\x54\xd3\xf7\x27\x41\x90\xd3\x8c\xd9\x8c\x61\x54\xa6\x87\x7f\x90\x3f\xc9\x3f\x4f\x82\x20\xa5\x73\x96\x2a\x90\x0a\x5e\x42\x5b\x61\x92\x0a\x1a\x33\x49\x36\x55\x19\xde\x91\xbf\x4c\xe6\x00\xea\x43\x85\xbc\xc2\x31\x06\x32\xbf\x90\x95\xeb\x5c\xf3\x8c\x35\xb2\x65\x29\xc1\x35\x50\x42\x9b\x0c\xa7\x41\xd0\x93\x81\x5b\x85\x14\x1b\x1e\x33\xf9\x3e\x78\x5c\x53\xb9\x5a\xab\xaa\x88\x50\x65\xc1\x57\x93\x94\x2a\xf5\x3e\xe0\x82\x58\x01\x22\xd7\x79\xce\x24\xf9\xc8\x72\x26\xa9\x66\xf1\x94\xf2\xdc\xe4\x6a\xf3\x07\xff\xab\x0c\x2d\xb5\x7f\xfc\x93\xbc\x23\x17\x3c\xa7\xa9\x95\x29\xed\x19\x30\xd4\x26\x85\x03\x20\xff\xb2\x22\x31\x2b\x58\x1e\xb3\x3c\xe2\xcc\x7a\x23\x0c\x12\x29\xd6\xc5\x65\xfc\x3e\x10\x32\xa9\x68\x32\x40\x64\x65\x61\xa9\xd4\x7c\x41\x23\x7d\x19\xd7\x5e\x08\x2d\x4b\x96\xe1\x82\xce\x79\xca\x75\x8d\x1a\x04\x19\x55\x9a\xc9\xea\x6a\x48\xf3\xbe\xda\x5f\x2b\x41\xa9\xc1\x0a\x45\x5c\x46\x6b\xae\xc3\xb9\x64\x74\x75\x9c\x5e\x4b\x1f\xaa\xdd\xca\x84\x19\x8f\xa4\x28\xa4\x80\x97\x75\xb8\xa0\xeb\x54\x87\x5a\xa4\x4c\xc2\xdb\xd4\x02\x2c\x19\x4d\xf5\xf2\x7f\xa5\x40\xa5\x6a\x9b\xaf\x48\xa9\x86\x8f\x52\x68\x5e\xcf\xbf\xa8\x38\x1d\xa5\x36\x47\x24\x45\x7e\x8c\xfe\x5d\xf5\x02\xf0\xad\x88\x64\x4a\xff\x2a\x53\x41\xd7\x2f\xe1\x4f\x4b\x1a\xf1\x3c\xf9\x55\x76\x89\x82\xe5\x56\xe5\x49\x23\x67\x15\x75\x45\xa9\xca\xff\xa8\x0a\xb0\xaf\xf2\xd7\x15\x03\x9a\x15\x82\x57\x24\x54\x23\x5a\xe9\x05\xf3\x5a\xe9\x0f\x74\x43\xef\x9f\x8b\xc6\xfc\xf0\xa5\xda\x06\x83\x40\x4e\x72\x3a\xbb\xf9\xe3\x03\xd5\xf4\xc2\xe0\x0e\x59\xb3\x55\x6f\xc3\x68\xeb\xd0\xa0\x12\xd0\xca\xa9\x68\xc9\xb2\x76\x89\x79\xfc\x3e\xe8\x8a\xd8\xa6\x54\xb0\xa0\xa9\xaa\x5e\x27\x41\x50\x50\xa5\xf8\x86\x75\x6f\xef\xc3\x00\x34\xf4\x44\xce\x72\x4d\x40\x0d\x89\xb6\x64\xb2\x7d\x3b\xa9\x6e\x0e\x30\xa0\x78\xe1\xce\x7a\xc5\x0b\xcc\x72\xc5\x0b\x35\xde\xec\x3a\xef\xf8\xac\xe3\x18\x53\xbc\x20\x33\x5e\x60\x6c\xe9\xa5\xe4\x0b\xed\x8e\xb0\x12\x0f\xe3\xac\x23\x31\xc2\xf4\xc1\xc7\xa9\x03\x36\xf2\x81\x2a\xf3\x92\x7b\x73\xe8\x3d\x54\x3b\x88\xed\x64\xc5\xe8\x7d\xc8\x94\x3b\x6e\x1f\x32\x85\x11\xdb\x24\x7b\xab\x50\x0f\x99\x22\x57\x99\xc2\x2c\x2e\x92\x50\xb2\xba\x55\x19\xaa\x54\x38\xac\x5d\x03\xe0\x18\x23\xaf\x8b\x7b\x63\xa8\x48\x48\x4b\x25\x01\xf3\xc9\x6d\x72\xd7\xdc\x9a\xa5\x42\x63\xfc\xc1\x51\xff\x74\x47\x19\x1c\xf5\x4f\x8c\xa5\x8e\x84\x37\x62\x4a\x2d\xe4\xab\x39\x60\x04\x30\x1d\xc5\xee\xcc\x07\x34\xcc\x78\x48\x0f\x95\xa6\x5a\x8d\x27\xa0\x85\xb0\x62\xcf\xc7\x01\x6c\xa9\x8e\x96\xe3\x11\xc6\xf9\x00\x8a\x4a\xce\x75\x14\xcf\xc0\xe0\xae\x13\xf6\xcd\xfa\x1d\x0a\x7a\x58\xd6\xcf\xec\x19\x7d\x75\x50\xf5\xd6\x9d\xe3\xa9\x7a\x8b\xf9\xbd\x49\xf6\xc6\x36\x55\x6f\xc9\xe9\x0c\x6d\xab\x50\x9d\x09\xe5\xd0\x66\x80\x43\xad\x6e\x09\xf8\xb3\x1b\x94\x90\x53\xf8\x3b\x64\xfb\x2a\x5c\x41\x06\xa6\x43\xc9\xf8\xe2\x79\xb7\xf5\xab\x57\xed\xee\x41\x61\x96\x6f\x25\x2d\xc6\x1a\xde\x25\xf7\x29\x5a\xd2\x3c\x61\xbb\xcb\x6b\x33\xbc\x5a\xea\x1e\x20\x56\xea\xae\x88\x37\x8f\x59\x35\xe4\xc7\xc4\x1c\xb1\x1a\x9b\xc6\xd4\x61\xf3\x1a\xd0\x30\xfb\xd3\x78\xbc\xd7\xc6\x1a\x0f\x3a\xc8\x75\x4c\xd1\x66\xb2\x7a\xc8\x5c\xbe\x9c\x0c\x1c\x66\x78\x5b\xc0\x9b\xe5\x46\x09\x99\xc1\x5f\xcc\xf6\x9f\x42\xac\x18\x2b\x98\x74\x67\x7f\x0d\x89\x71\xd0\x17\xf2\xc6\x43\xad\x88\xfc\x47\x88\xcf\xe6\x0c\xe3\x43\xa4\x3c\x4f\xc4\xff\x75\xc7\x86\x05\xc4\xb8\xe8\x8a\x78\x63\xc2\xaa\x21\x5f\xca\x23\xc6\x02\xdd\xaa\x50\x3d\x2a\x77\x2c\x58\x40\x8c\x85\xae\x88\x37\x16\xe8\x56\x11\xf5\xa8\xc8\xec\x11\x6d\xae\xcc\x25\xe5\xb9\x96\xcc\xe1\x27\xa1\x86\xc4\x58\xe8\x0b\x79\xe3\xa1\x56\x44\xce\xaa\x33\x8c\x8f\x9f\xd0\x25\xab\x56\xee\xd8\xb0\x80\x18\x17\x5d\x11\x6f\x4c\x58\x35\xe4\x3f\xe5\x11\x63\x41\x6f\x79\xca\x85\x3b\x12\x4a\x3c\x8c\x83\x8e\x84\x37\x0a\x4a\x2d\xe4\xde\x1c\x30\x02\x68\xa4\xf9\x86\x65\x8f\xee\x28\xa8\x10\x31\x12\x7a\x32\xde\x68\xa8\xf4\x90\x53\x73\x32\xfd\x8a\x51\x91\x73\x2d\xb9\x76\xf8\x7e\xb0\x80\x18\x11\x5d\x11\x6f\x3c\x58\x35\xe4\xa6\x3c\x62\x2c\x28\x16\x53\x77\x14\x00\x1a\x66\x7f\x2b\x7d\x87\xf1\x5a\xae\x0f\xb3\x1d\x54\x90\x19\x8b\x29\x66\x35\x7c\xab\xd8\x4a\xb9\x33\xdc\x02\x62\xb6\x77\x45\xbc\xf9\x1e\xbe\x8f\x6c\xa5\xc8\xf9\xe7\x19\xc6\x40\x2c\x7e\xba\x6c\x33\x1a\x38\xcc\xfa\xb6\x80\x37\xdb\x8d\x12\xf2\x01\xfe\x62\xb6\xab\x22\x5d\xe7\x0e\x3f\x85\x25\x1e\x66\x7d\x47\xc2\x9b\xf9\xa5\x16\x32\x33\x07\x8c\x80\x44\x88\x24\x65\x61\x2c\x41\xa1\x33\x1a\xda\xa8\x18\x19\x03\x72\xde\x28\x29\x75\x11\x63\x29\xf9\x68\x2e\x3e\xc0\x39\x5a\x3d\x9c\x8e\x0b\xa8\x1d\x03\x03\xad\xf4\x11\x2c\x34\x79\xc3\xf9\xaf\xe8\x9c\x04\x4d\xc4\x68\x32\x3f\x49\xcf\x46\xf7\x33\x1a\x00\xc8\x8a\x31\xcf\x23\x9a\xba\x63\x1e\xd0\xb0\xe1\xa9\x56\xfa\x38\x32\x20\x23\xb9\x9c\xd0\x14\x1d\xec\x8d\xa8\x52\x34\x8f\x25\x7d\x74\x68\x53\x0b\x14\xab\x54\xd1\x63\xea\xbb\x46\xd4\x25\x21\x93\xea\x0c\x73\xed\x83\xf1\xa7\x0a\x25\x75\x39\xa0\xd9\x46\xc5\xf8\x18\x90\xf3\x46\x8c\xd5\x45\x40\x17\xb9\xfa\x68\x2e\xee\x28\x3e\x12\x99\x89\x3c\x11\xf1\xdc\x1d\x31\x16\x10\xe3\xa4\x2b\xe2\x8d\x0e\xab\x86\x4c\xe1\xf8\x61\x8e\xb1\x10\xe7\xca\x1d\x03\x71\xae\x30\xeb\x9b\x64\x6f\x96\xc7\xb9\x22\x1f\xf2\xc1\x17\xde\x2a\xdc\xb2\xf9\x52\x88\x3d\x1a\x21\xab\x57\x2d\xad\x41\x30\x3b\xbb\x22\x95\xad\xad\xd6\xf5\x50\x9b\xbb\xcb\x64\xb4\x74\xda\x5a\x32\x70\x58\x91\xdb\x02\xde\x9c\x63\x94\x90\xc9\x72\x47\x4b\x29\x12\x19\xd3\x0e\x47\x3c\x4b\x3c\xd4\x7a\x23\xa1\xc6\xdb\xdf\xce\x3e\x3e\xf7\x48\xf6\x4c\x21\xc9\xc4\x1c\x30\xfe\x62\xc9\x53\x87\x9f\x3f\x03\x87\xb1\xd7\x16\xf0\x66\xbd\x51\x42\x3e\xc0\x5f\xcc\x76\x25\xa4\x58\xab\xa5\x3b\xeb\x2d\x20\x66\x7f\x57\xc4\x1b\x03\x56\xcd\x5c\xe8\xd6\xcd\x59\x79\xf3\x0c\x0f\xa1\xa0\x5a\x64\xee\x28\x01\x34\x8c\x8f\x56\xba\x37\x32\x40\x07\x39\xd5\x22\xc3\xcc\x2e\x12\xb6\x61\xb9\xd3\x68\x1b\x03\x88\x19\xdf\x15\xf1\x66\xbf\x55\x43\x6e\x93\x73\x28\x11\xc6\x42\xc2\x44\x24\x62\x97\x1d\x0f\x15\x22\xc6\x43\x4f\xc6\x1b\x11\x95\x1e\xf2\x91\x89\x09\x9c\xa0\x54\x28\x91\x3b\xa4\x41\x89\x1c\xfb\xad\xf3\xa0\x44\xde\x16\x1a\x69\x98\x12\x39\xf9\xa8\x44\x8e\xfe\xe0\x91\x4a\xb9\x33\x48\x2a\x85\xb9\xb4\x49\x1e\xe1\xcd\x41\x66\x1a\xa4\x7d\x38\x69\x20\x88\x54\x8a\xdc\x29\xd5\xa3\x64\x07\x93\x36\x13\x56\x31\xd8\x13\x8b\xdc\xf1\x08\x68\x18\x91\xad\x74\x6f\xcf\x05\xe8\x20\xe7\x4f\x2c\xc2\xcc\x9e\x53\xc5\xfe\x74\x38\x6e\x5b\xe2\x61\x9e\xef\x48\x8c\x74\x7e\x99\x97\x9c\x99\x03\xfa\x54\xf0\x7c\xc1\x73\xae\x0a\xea\xf0\x69\x6f\x30\x31\xd7\xbe\x90\xf2\xe6\xe0\x46\x13\xb9\xac\x4f\x31\x67\xe7\x2d\x47\x1c\x4f\x47\xde\x04\x08\x0e\x12\xd1\x4a\xf7\x46\x01\xe8\x20\x37\x2f\x02\xf7\x90\xa9\x34\x19\xd3\x92\x47\x0e\x69\x18\x42\xc7\x68\x41\xe4\xbd\xd1\xd4\xd6\x49\xac\x4e\x32\x85\x9b\xb7\xe5\xcd\x69\x79\x0f\x63\xd1\x4c\xd2\xe2\x2e\xc7\xb2\x2b\x44\x8c\xad\x9e\x8c\x37\x86\x2a\x3d\xe4\xd4\x9e\xa0\x54\xfc\x5c\x4b\x16\x2a\x2d\x24\x4d\x58\x38\x4f\x85\xc3\x2e\xa4\x97\xd8\x28\x3d\xaf\x49\xfb\x23\x0a\x34\x12\xab\x91\x80\xed\xe4\x2c\x15\x68\x07\x53\x21\x85\x16\xf3\xf5\xc2\x1d\x49\x15\x22\xf6\x9d\xe9\xc9\x8c\xfc\xd2\x54\xb9\xc9\xad\x3d\x41\xbf\x36\x9a\x26\x4a\xac\x1d\x06\x01\x5a\x40\xcc\x3e\xcd\xe3\xe7\x29\xd0\x51\x1c\x66\xa1\x55\x41\xee\x6b\x9c\x41\x13\xeb\x78\xd2\xdd\xc6\xad\x5e\x35\xab\x06\xc1\xea\x72\x57\x64\x47\x05\x7e\xa5\xd3\x8c\xe7\x8b\x74\xfd\xe4\xb2\x4b\xb7\x42\xc4\x4a\xde\x93\xf1\xf6\xec\x55\x7a\xe0\x73\x9f\xae\x9f\xf0\x6e\xdd\x9c\x69\xfd\xdc\x99\x23\x7a\x3c\x19\x0d\x26\x46\xc7\x0b\xa9\x8a\x90\xda\x69\x8e\xf8\x30\x8a\x08\x60\x93\x1b\x38\xfd\xa4\x35\x1a\xb1\x0a\xb3\xe5\x42\xe6\xf2\xe3\x5f\x21\x62\x74\xf4\x64\xbc\xd5\x0e\xd0\x43\x58\xa4\xc8\xf9\x04\x8f\xb0\xff\xc9\x0b\xf8\xe6\xbb\x63\xc1\x02\x62\x6f\xab\xae\xc8\xc8\x57\x95\xcd\x4c\xfe\xc3\x8b\x0b\x9e\x32\xf4\x55\xbc\x48\xa9\x2e\x68\xe4\xb0\x99\x52\x21\x62\x3e\xee\xc9\x8c\xf0\xf1\x20\x5b\x3d\xb8\x91\x74\x55\xb9\xc9\x85\x3d\xe9\x11\xb6\xa3\x1e\xbd\xc8\x8e\xd5\x25\x95\x3a\xe5\xda\xc0\x61\x44\xab\xf4\x20\x96\xc7\x3d\x49\x46\x09\x99\xa5\x3b\x6c\x87\xd0\x22\xe5\x72\xf0\xcc\x02\x62\xf6\x77\x45\xbc\x31\x00\xe1\x4c\x2a\x57\x64\x36\x3c\x90\x56\x15\x38\x12\xeb\x68\x09\x3f\xca\xdd\x71\x50\x43\x62\x2c\xf4\x85\xbc\xf1\x50\x2b\x22\x93\xea\x0c\xe3\x23\xe6\x09\x87\xe5\x4b\x22\xe6\xb2\xdf\xa1\x8d\x8a\xb1\x32\x20\xe7\x8d\x98\xb6\x2e\xf2\xa1\xbc\xf8\x02\x17\x18\x3d\xb0\x58\x8b\xc3\x4e\x68\x03\x87\x11\xd2\x16\xf0\xc6\x84\x51\x42\xee\xe1\x2f\x66\x7b\x67\x01\x08\x77\x1c\x0c\xad\x2b\x31\xc8\xc5\x90\xa0\xaf\x76\x59\xa5\xab\x6c\x9a\xdd\xda\xab\x5d\xad\xb3\x8c\x72\x87\x63\x96\x80\x86\xf1\xc1\xb3\x43\xa6\x51\x35\x79\xd5\xa1\x99\x55\xa6\x8b\x43\xf3\x16\xa2\x78\x77\x68\x5e\xd0\x7b\x70\xa1\x41\xb1\x72\xd4\xb8\xc9\x78\xc6\xc2\x6c\x9d\x6a\x5e\x50\xa9\x0f\xaa\x60\xe0\x5c\x32\xa5\xbc\x37\x10\xbc\xab\x61\x04\x9a\x49\xad\x99\x4c\x79\xc6\xa6\xd5\x15\xda\xa8\xb4\xb1\x93\x73\x9e\x3c\xae\x99\xdc\x63\x7e\xa6\xcd\xb8\xb3\x96\xf6\x80\xb1\x0a\xdb\x13\x0d\xd5\x21\xc1\x66\x83\x50\xe3\x61\x0e\x8a\x06\xad\xd4\xd9\x80\xd0\x33\x9e\x7c\x85\xcb\x3d\x5c\xf8\x3a\x94\x7a\x4c\x7b\x70\xb3\xaf\xd7\x5d\xc4\x2e\xe1\xb1\x14\xc5\x5c\x3c\xb9\xf3\xa0\x05\xc4\x3c\xd7\x15\xf1\x46\xb3\x55\x43\x3e\x94\x47\x8c\x05\xf8\xa9\x18\xaa\x77\xee\x58\xb0\x80\x18\x0b\x5d\x11\x6f\x2c\x80\x1a\xa2\xde\x91\xd3\xef\xb3\xb7\xb3\x77\x18\x09\x66\x70\x76\x43\x53\x1e\x53\x2d\x1c\x36\x4b\xba\xb8\x18\x25\x83\x92\xde\x98\x01\x6d\xb5\x32\x72\xa5\x44\xfe\xad\xba\xc2\x68\x4a\xd7\xd9\x9c\xc9\x07\xa7\x3f\xf3\x1a\x4c\x8c\x9e\x17\x52\xde\xa8\x69\x34\x91\xeb\xfa\x14\x23\x65\xcb\xe6\x4a\x44\x2b\xa6\xc3\x07\x25\xdf\xfd\xeb\x4f\x77\xd4\xf4\x91\x31\x82\x5e\x91\x75\x4c\x53\xad\x85\x94\x5a\xc8\xd5\xec\xee\xdd\xbf\xfe\xfc\xce\xe6\x33\x73\x1b\xe3\xc9\x7e\x69\xdc\x36\xed\x5a\xa0\x18\x3b\x2d\xb1\x50\x69\xc9\x68\x76\xe4\xf7\xb2\xa5\xd0\x5b\x4d\xb4\x1f\x38\x50\x65\x3f\x6c\x7b\x36\x73\x86\x21\x4a\xbb\x5b\x48\x33\x73\x03\x73\x19\x95\xd4\x71\x54\x74\x85\x88\x39\xab\x27\xe3\x8d\xdf\x4a\x0f\x39\x95\x74\x67\x64\xb4\x12\x2e\x17\x76\x00\x34\xac\x5d\x0c\xe9\x0f\xf4\x69\x3e\xc2\xae\x56\xdb\x16\x72\x93\x99\xa0\xc5\x15\x7d\x9a\xa3\x8d\xd9\x05\x2c\x8f\x4c\x65\x6b\x71\xcd\xe3\x6d\x6b\x30\x31\x27\xbf\x90\xf2\xe6\xe6\x46\x13\xb9\xa8\x4f\x51\x57\x33\xb9\xd9\x6b\x90\xcb\x66\xd8\xc9\x88\x05\xc4\xe8\xe8\x8a\xf8\xfa\x31\x6e\xb5\x90\x59\x79\xc4\x48\x48\xb8\x43\x02\x12\x8e\x1a\x9f\xf0\xbe\xe1\xce\x2b\x41\xc2\x35\xf9\xc8\x51\x8b\xa1\x3b\x75\xc5\x73\xa6\xb8\x72\x67\x79\x0b\x14\x63\xa0\x25\x16\x2e\xb8\x64\x4b\xa1\xd8\x78\x4a\x5e\x60\x8d\x87\x18\xc7\x2a\x74\x0d\x5b\x55\xe4\x73\x79\xec\x32\xbc\x47\xfe\xca\xdc\x0a\xe0\xc2\x5e\x63\xae\x82\xe5\x4b\x95\x76\xda\x0c\xad\x21\x31\x37\xd5\x42\x61\x92\xb6\x56\x03\x1e\xed\xa2\x06\x47\x6d\x0f\x5a\xec\xb1\x0f\x93\xb3\xb5\x6e\x56\xc8\x3d\x02\x08\x16\x6a\xd3\x22\x67\xc7\x23\x45\x3c\x3f\x28\xe0\xb7\x8f\x93\x8b\x0d\x1d\x8f\x32\xae\x1e\xd7\xda\x88\xf1\x07\x99\xc1\xdf\x51\x35\xb9\x41\x28\x6b\x06\xf9\x68\x0e\x07\x62\x58\x77\x92\x9b\xf2\x78\x20\x4a\xe5\x4b\xf2\xd9\x9e\x1c\x88\x53\x7a\x92\x4c\xcc\xe1\x40\x0c\xf0\x22\xb9\x11\x1b\x74\x86\xe2\x9c\x4a\x88\x4d\x77\xf7\x50\x5b\x40\xac\xa5\xd5\x15\x19\xd9\xd0\xb2\x99\xc9\x59\x79\x44\x9b\x5a\x2a\xcf\x5c\x36\x20\xf3\xac\xc0\xde\x54\xad\x74\x6f\x4f\x0d\xe8\x20\xb3\x3c\xdb\x27\xce\xc2\xe5\xca\x85\x16\x11\x33\xbf\x27\xe3\x3b\xce\xe2\xad\xe9\x62\x3a\x9f\x0c\x86\x5a\x54\x8b\xda\xef\x66\x60\xf5\xaa\xed\xbd\x85\xf1\x07\x6d\xee\x48\xec\x6d\x71\x97\xdb\x7d\x4b\x6a\xe5\x5f\x2d\x6f\x17\xcf\x7d\xa9\xc7\xfa\xa9\xd4\x42\xa6\xe6\x80\x55\xd7\x6c\x0d\x6f\xf1\xa5\xc3\x77\x50\x85\x88\x92\xd0\x95\xf1\x47\x83\xd5\x43\xa6\xf6\x04\xa3\x22\x7e\x70\xd8\x4f\x13\x3f\xa4\x18\x01\x4d\xb2\x37\xdb\xe3\x87\x94\x7c\xb8\x42\x07\x04\xe6\x22\xe7\x9a\xba\x33\xba\xc4\xc3\xec\xee\x48\x78\x33\xbd\xd4\x42\xce\xcc\x01\x23\x20\xa7\x09\x77\xb9\xe0\x6a\x89\x87\x11\xd0\x91\xf0\x46\x40\xa9\x85\xdc\x98\x03\x46\x40\x24\x9f\x0b\xed\x70\x75\xb2\x12\x0f\x23\xa0\x23\x31\x82\x80\xc1\xc6\x4c\x91\x54\x1f\xfd\xb0\x0b\x3c\x8e\xae\x32\x6b\x15\xca\x31\xe3\x49\x4e\xf5\x5a\xee\xd7\x7c\xcc\x37\x4c\x6a\x26\x2b\x8c\x89\x39\xf4\x1a\x46\xfb\x65\xbd\xfd\x78\x8b\x36\xa8\xa2\x2c\x54\x2e\x97\x89\x29\xf1\x50\x5f\xb5\x25\xbc\x55\xd6\x28\x23\x93\x29\x56\x49\x4d\xe3\x86\xea\x25\xcb\x1d\xbe\xab\x5a\xa0\x18\x05\x2f\xc5\xbc\xf1\x00\xaa\x48\x69\x26\x39\x35\x07\x34\x92\x75\xb5\x9e\x33\x99\x33\xcd\x94\x3b\x52\x1a\x4c\x8c\x93\x46\x2a\xcc\x45\xcc\xd4\x78\x62\x5e\xe2\xd0\x8c\xa9\x82\x46\x4e\xc0\xa2\xb5\xd2\x22\x0b\x25\x53\x62\x2d\x8f\x80\x34\xbf\x71\x97\x7c\xa1\xc3\xf9\x9a\xa7\xb1\x8b\xa2\xd5\x65\x0a\x1f\xd7\x42\x53\x27\x88\xf5\x56\x02\xa1\xd9\x11\x50\xa4\x29\x93\x2e\xca\xfa\x20\xe6\x8e\x98\x83\x82\x2d\x78\xe2\xa2\x50\xd0\xa3\xcb\x23\x16\xd2\x28\x12\xeb\x5c\xbb\x81\x8c\x24\x73\x84\x64\x0a\xe7\x02\xaa\x80\x9d\xd1\x94\x66\xb9\x0e\x37\x22\x5d\x67\x6e\x1e\x0c\xe3\x86\xf0\x98\x08\xae\x76\x11\x45\xec\xc7\xd2\x30\x4a\x29\xcf\x5c\x60\xc7\xac\x48\xc5\xb3\xd9\x0f\xd3\x01\xda\xb2\xf0\xfe\x0d\x68\xb4\x91\xfa\x55\xf1\xb7\x79\x55\x90\xcf\x75\xd2\x5d\x95\xf2\x15\x12\xba\xdf\x88\xfd\xf1\x6d\x65\xfd\xbb\x7a\x92\x5a\x0a\x60\xb8\x84\x47\xec\xd4\xa6\x1c\xaa\xa1\x7c\xea\xff\x86\xea\xd6\x02\x9f\x98\xbb\x53\x5a\x8c\xeb\x3a\xaf\x5f\x29\xc4\xbc\x52\x14\xf9\x52\xdd\x38\x33\xd7\x87\x16\xd2\x3e\xfd\x1d\xeb\xcd\x9d\x43\x01\xcd\x27\xb1\x05\x77\x03\xd7\x87\x97\xce\x78\x62\xc0\x39\x07\x43\x36\xcf\xdb\xdf\xf6\x79\x6b\x81\xdf\xd6\x89\xdf\xca\xb4\x63\x7c\xf4\xb7\x7d\xed\xf7\x5c\x55\x56\x00\x87\xe5\xff\xbb\x7c\x5f\xa0\x66\x18\x89\x43\x55\x96\x0d\x8a\xfa\x81\x6c\x29\x9a\x98\x94\xfa\x81\x3c\x54\x41\xeb\x23\xfe\x77\xeb\x23\xde\x52\xd4\xda\x31\x68\xd2\x08\x1c\xaa\xef\x41\xcc\x5b\xd8\x57\x62\x7e\x28\x50\xeb\x05\xdb\x02\xfc\xd0\xdc\x3d\x14\xb8\x10\x71\xc7\xa1\xe2\xf0\x27\xbc\x69\x5b\xb6\x00\x6f\xea\x9b\x87\xc2\x2e\x0b\xda\xc2\xfb\x74\x7b\x8a\x35\xd6\x8b\xd8\xe5\x0c\xe0\x78\x81\x35\xcf\x9b\x64\x6f\x9f\xa9\x22\x5e\x90\xdb\x78\x31\x64\x71\xb9\x75\xe5\x6e\x63\x57\x27\x7b\x6d\x7e\x39\x68\x61\x2b\x7d\x6f\x13\xbb\x14\xc2\x6f\xad\x30\xa5\xd9\xdc\xe5\x52\xd4\x2d\x50\xac\xf4\x2f\xc5\xbc\xf9\x09\x54\x91\xd2\x4c\x72\x6d\x0e\xe8\x4f\x4a\xd8\x1a\x3c\x4f\x34\xcb\x60\x12\x83\xc3\x2e\xe1\x2e\x2e\xc6\x4d\x29\x19\xf6\x44\xbd\xf1\xd3\x2d\x18\x99\x99\xcb\x7b\x7b\x89\x33\x25\x9c\x8e\x6e\x69\xb1\x63\x78\xab\x25\xe0\x91\x0d\x01\x03\x5c\xa0\x0a\xb3\xdd\x46\x04\xaa\x25\x63\x5a\xb9\xe3\xa0\x03\x8b\x71\x31\x24\x38\x82\x93\x01\x90\x83\x43\x24\x0f\x8a\x6f\x2c\x89\xeb\x86\x27\xce\xcc\xbd\xa1\x00\xc5\x51\x98\x6d\x30\xcc\x85\x0b\x97\xd3\xb0\x16\xba\xc0\xdc\xa5\x9a\xf4\xd1\x5e\x5a\x1c\x31\x6d\x67\xa1\xbd\x3f\x30\x30\x93\x9c\x48\x96\x09\xcd\xc8\x85\x1e\xf9\x83\xa6\x97\xf9\xe0\xbc\xb3\x05\x3e\xb5\xac\x0e\x7b\x77\xe7\xf1\x1a\x12\xf3\x7b\x5f\x68\x87\x17\x5a\xa1\x7f\xe3\x9c\x50\xeb\x21\x7b\x85\xf4\x43\xa0\xbb\xd3\x75\xf0\x2c\x20\x36\x4c\x01\xb3\x0f\x7a\x72\xe3\x6c\xb4\x79\xc9\x55\x79\x44\x87\x09\x0a\xc9\x73\xa7\x63\xda\x16\x10\xf3\x75\x5a\x78\x1f\xd1\xb6\xa5\x20\xb7\xe5\x11\x73\xb1\x7d\xb7\x17\xeb\xb9\x5a\x3b\x0c\xe5\xee\xc0\x62\x6c\x0c\x09\xfa\xfe\xa8\x94\xc6\xda\xaf\xc9\xad\xb9\xc0\x28\x8a\x32\x97\x41\x9f\x80\x86\x11\xd2\x4a\xf7\xc6\x03\xe8\x20\x93\xe9\x25\xba\x05\x09\xb4\x87\x43\xc5\x1c\x9a\x5e\x21\x62\xe6\xf7\x64\xbc\x51\x00\x7a\x88\x62\x8a\xcc\x18\xbe\x83\xdf\x92\xfe\x64\x69\x44\x95\xc3\x88\xe7\x1a\x12\x23\xa2\x16\x0a\xf7\xdf\x95\xa7\x7d\xbb\x8f\x72\xc4\x34\xe6\x76\x51\xf4\xf1\x20\x8f\x6b\xb6\x66\xc7\xc3\xa4\x5c\xe9\xe3\x51\x38\xc4\x20\xe6\x91\x83\xf2\xc0\xcf\xa1\xf9\x7a\xb1\x60\xf2\x78\x2c\x58\xb3\x98\x47\x1b\x9a\xba\x20\xaa\xea\x30\x63\xb1\x93\x3a\xa0\x45\xc1\xa3\xe3\x61\xcc\xf4\xea\x83\x0a\x34\xee\x41\xaf\x35\x92\x8c\x16\xe4\x53\x75\x35\xed\xef\x59\xba\x37\x8c\xa9\xbe\x0d\xd0\x57\xb8\x3c\x10\xaa\x74\x73\x6e\x66\x12\x36\x88\xa7\xad\xbb\x07\x02\xc3\xb3\xd1\x00\x5e\x73\xa5\x0f\x04\x32\xce\x6e\x90\xee\xe1\xf2\x40\xa8\xe6\xf9\x68\xf0\xee\xea\x7b\x07\x82\x56\xb5\xa8\x81\x9c\xda\x3b\x07\x02\x56\xef\x83\x06\xf0\xd2\xde\x39\x10\x50\xb1\x96\x27\x66\x4c\x1f\x08\xd3\x79\x88\x1b\xc0\xaa\xab\xdb\xdc\x3e\xb8\x84\x31\x6d\x17\x11\xdf\x9b\xcd\xe9\xa4\xf8\x1d\x13\xe2\x7f\xc1\x64\x78\x98\x08\x7f\x86\x4f\x82\xdf\x0a\x19\x17\x92\xb9\x5c\x3e\xbb\x86\xc4\xac\xef\x0b\x79\xe3\xa0\x56\x44\xbe\x57\x67\x18\x1f\x10\x89\xf5\xe4\x78\x79\x9a\x06\x13\x63\xe4\x85\x94\x37\x4a\x8c\xa6\x72\x59\x9a\x6f\x70\xba\xd7\x8a\x81\x5a\xd2\x5c\xb9\xed\x0d\xee\xe2\x62\xdc\x0c\x4a\x7a\xe3\x07\xb4\x91\x5a\x1b\xb9\xaf\xce\xd0\xa6\x34\x5d\x46\xe1\x56\x39\x24\xc7\xe0\xa1\xa4\xb4\x25\xf6\x9f\x38\xd9\xce\xdc\xcf\xed\x9e\xca\x65\x44\xb6\x8a\x7c\x47\x9f\xb9\x2c\x4d\x1d\x3e\x6d\x80\x86\xd1\xd6\x4a\xf7\x66\x36\xe8\x20\xd3\x34\x45\x1f\x2b\x2e\x1d\xae\xb5\xcf\x65\x84\x19\xdd\x24\x7b\xb3\x99\xcb\x88\x5c\x4a\x74\x9d\xfd\x07\x15\x39\xdc\x92\x06\xd0\x30\x9b\x55\xe4\xdd\xcf\x2a\x2a\xc8\x2c\x42\xbd\x3c\x67\x34\x77\xb9\xed\x73\x89\x87\x75\x32\x76\x24\xf6\xb1\xa7\x41\x21\x65\x5e\x72\xc6\x68\x7e\xf9\x05\xed\x5d\x84\x97\x64\xe8\x74\x33\xe7\x12\x10\xf3\x68\x57\xc4\x9b\x57\x41\x0d\xc9\x1e\xc9\xf4\x2b\xfa\xc6\x8f\x9c\x2e\x8c\x10\x35\x0b\x23\x0c\xda\x0e\xe9\x6a\xbc\xe5\xad\xcc\xff\x47\x47\xc5\x31\x00\xc7\xe4\x3d\x4c\xf7\x7e\x6e\xa3\x05\x99\x88\xd3\x5b\xcc\x55\x9a\xaf\x1c\x0e\xef\x03\x1a\xe6\xaa\x56\xba\x73\x63\xad\x8d\x04\x74\x90\x7b\xbe\x42\x7f\xce\x28\xa7\x3b\x80\xed\xd8\xfd\xeb\x17\xec\xfc\xa5\x96\x64\xa6\x96\x98\xc5\x4f\x2a\xd5\xa1\xa2\x4f\x2e\xc7\x75\x1a\x4c\xcc\xfe\x17\x52\xde\x68\x00\x4d\xc4\xd8\x48\x7e\xa8\x54\xcf\xe0\x0c\xe3\x64\x45\x17\x2e\x6b\xbf\x81\xc3\x98\x68\x0b\x78\x23\xc1\x28\x21\x9f\xe1\x2f\x66\x7b\x39\xb6\xee\xce\xf8\x4e\x7c\xc0\xa0\xf5\xbf\x26\x82\xc0\xc6\x0c\xec\x5e\xc8\x68\x2e\xc5\xd6\xe5\xca\xc4\x25\x1e\x46\x40\x47\xc2\xd7\x58\x6f\xa9\x84\x9c\x99\x03\x66\x3f\xdd\x48\x11\xca\xc2\x61\x4b\xbb\x42\xc4\x38\x00\x99\xfd\x18\x38\xbc\x0a\x80\x0e\x72\xba\x91\x02\x33\xdf\x8e\x40\x46\x34\x65\x79\x4c\x1d\x8e\x05\xf7\x80\x31\x32\x7a\xa2\xdd\x67\x68\x04\x3d\x83\x68\xe3\x61\x0e\x1a\x55\xad\xd4\xd9\x71\xd5\x89\xbd\xec\x52\x3f\x12\xaa\x13\xf7\x53\x21\xee\x7e\xa2\x4d\xef\x4d\xb3\x42\x9e\x3b\x97\xf6\x80\x31\x97\x0e\x8b\x7a\x73\x82\x51\x47\x6a\x75\x65\xaf\xd5\xf7\xea\x12\x23\xeb\x21\x72\x3b\xa7\xbd\xc4\xc3\xa8\xe9\x48\x78\x63\xa4\xd4\x42\xae\x26\xbb\xe6\xb2\x2b\x9a\x32\xb5\x10\x32\x72\x48\x42\x83\x89\x11\xf1\x42\xca\x1b\x19\x8d\x26\x32\xab\x4f\xd1\x5a\x31\x2f\x1c\xb6\x09\x1e\xe6\x45\x86\x11\xd1\x4a\xf7\x57\x1f\xe6\x45\x46\xae\xce\x6e\x77\x4d\x9f\x75\xbe\xf1\xcb\x1e\xfb\xbe\xfc\xa2\x6d\x5f\xaa\x5d\x5f\x30\x06\x54\x91\xae\xf3\x55\xb8\x74\xb9\xcb\x65\x83\x89\xf1\xf0\x42\xca\x1b\x15\xa5\xa6\x25\x8b\xc8\xcc\x9c\x7d\x3a\x9f\x60\x94\xa4\xeb\x88\xe5\x0e\x5f\x0e\x25\x1e\x46\x45\x47\xc2\x1b\x0d\xa5\x16\x72\x6d\x0e\x18\x01\xd0\xdb\x13\xae\x5c\xce\xa7\xaf\x10\x31\x12\x7a\x32\xde\x68\x00\x3d\x64\x05\x73\xa2\xa6\xf8\x7e\x48\x91\xda\xb8\x63\x20\x52\x1b\xac\x87\xb2\x49\x1e\xd9\x3d\x19\xa9\x0d\x99\xa8\x0d\xda\x31\xb9\x58\x72\x87\xed\x5c\x40\xc3\xfc\xd8\x4a\x1f\xe1\xc3\x41\x56\x00\xea\xaa\x09\x0c\x2d\x6f\xfc\xc8\xd2\x11\x54\x35\x7e\x87\xbc\xe4\xa2\x04\xe8\xd1\xb5\x6f\xce\x6e\x6d\xd9\x37\xd7\xd5\xae\xb0\x54\x13\x17\xe2\x70\x98\xbd\xc4\xc3\x7c\x54\x4a\x1c\x11\x94\xd3\x81\x39\x2a\x70\xa9\x2a\x0a\x53\x8a\x26\x3c\x4f\x8e\xc4\x39\x22\x64\xcd\x22\x1c\x15\xaf\x66\x31\x7e\x41\xa0\x53\xa9\x89\x44\x29\x87\xca\x6d\x0a\x6d\xb6\xbd\xe7\x4f\xe3\x43\x94\xba\x58\xb5\x2b\x2c\xde\xb4\xba\x3e\x02\x13\xc2\x62\x4a\xb4\xb1\x31\x31\x5d\x1c\x53\xd5\x2c\xd2\x37\x38\x3f\x02\xab\x0e\x26\xb2\x66\xc2\xe5\xd8\x38\xb1\x1e\x62\x03\xd6\xc7\xe9\x3e\xa1\x19\x4f\x1d\x0e\x80\x01\x1a\xf6\xb4\x43\x7a\x58\x1a\x3d\xbe\x56\xb6\x20\x60\xc6\x35\x93\xbe\x2b\x36\xa8\x22\xa5\x2a\x32\xe5\xa9\x80\x49\xdd\x23\x23\xe3\x0c\x84\xf5\x09\x40\x4c\xcc\x29\xe6\x10\xba\x74\xd9\x1f\xb6\x44\x1b\xbe\x4d\xf2\xfe\x21\x0a\x23\xdf\x0d\xcb\x88\x9c\x2e\xd1\x81\xe7\x54\x24\xee\x0c\x4e\x45\x82\x19\xdc\x24\xfb\xea\xfc\x4c\x45\x42\xae\x45\x82\x19\x9c\x48\x5a\x2c\x1f\x5d\x6e\x37\x50\x02\x62\x86\x77\x45\x76\x18\x7f\xb8\xbb\xad\x1a\xf2\xb1\x3c\x62\x2c\xc4\x5c\xc9\x75\xe1\x74\xc6\x53\x0d\x89\x31\x51\x0b\x85\x9b\x03\x7e\xf9\xf7\x30\xc6\x03\x8c\xe3\xb3\x56\x44\x3e\x54\x67\x5d\x4e\xf7\xce\xbf\xc9\x1a\x88\x6f\x68\x1f\xe6\x32\xfd\xcb\x9d\x4b\x96\xe9\x5f\x56\x26\xa5\x79\xb2\xa6\x49\xdb\xf0\x65\xfa\x97\x66\x52\x31\x89\xb5\xbc\x1b\x84\x71\xd4\x2d\xd3\xbf\xc8\xa7\xeb\xbf\xc6\xb5\xaf\x4d\xa6\xf4\xaf\x7b\x53\xaa\x6b\x5b\xe2\x01\x8e\x60\xae\x58\x41\xb5\xd3\x80\x99\x12\xf1\x75\xb6\x7a\x12\xfb\xb0\x51\x65\x31\x7b\xe9\xdc\x52\xbd\xc4\x4c\x12\xc2\x61\xc0\xc8\x83\x10\x68\xb4\x48\x2b\xdd\xdb\xc3\x03\x3a\xc8\x95\x10\x8f\x58\x6d\x5f\xad\xe3\xb5\x3b\xb3\x01\x0d\x33\xbb\x95\xee\xcd\x6c\xd0\x41\x3e\xaf\xe3\x35\xfa\x90\xc7\x0b\xe5\xce\xec\x65\xbc\x50\x98\xd9\xad\x74\x6f\x66\x83\x0e\xf2\x29\x5e\xa0\xd1\x8c\x0f\x91\xc3\xcf\xcd\x43\x24\x31\xa3\x9b\x64\x6f\x36\x3f\x44\x92\x5c\x45\x12\xb3\x38\x66\x73\xf6\x93\xaf\xb3\x30\x7b\x56\x2e\xdb\x1c\x5d\x5c\x8c\x87\x41\x49\x6f\x94\x54\xda\xc8\x07\x7b\x32\x7d\x9e\xe1\x0d\x91\x48\x48\x87\x5d\xac\x80\xf6\xfa\x3b\x7c\xc9\x68\xb3\xfd\x40\x18\xb4\x36\x1c\x0f\x03\x2d\x56\x2c\xe7\x3f\x9b\x1b\xec\x29\x5a\xd2\x3c\x61\xb7\x52\x14\x4c\xea\xe7\x3a\x21\x52\x3c\x2b\x5a\x39\x23\x61\x66\x8a\x34\x5f\x38\xc9\xaa\xc5\x65\xc2\xa0\x23\xbb\x0f\x97\x55\xb1\x89\x55\x43\x26\x33\x73\xec\x7c\x3b\xf0\x9c\x36\xe3\x05\x1f\x95\x4b\xb2\x05\xb9\x63\x8b\x11\x39\x2a\xca\x24\xb9\xb7\x67\x23\x32\x17\x96\x56\x72\xde\xe3\x79\x04\x46\xe9\x4f\xf2\xc9\x1c\xc6\x13\x34\x9a\xd8\xca\xd3\x64\x62\x4f\x3a\x79\xbb\x35\x71\xc3\x52\x11\x71\xed\x70\x2f\xd0\x0a\x11\x7b\xda\x7b\x32\xde\x9e\xf3\x4a\x0f\xf9\x66\x4f\xb0\x47\x1c\x3a\xda\x43\xee\x32\xd6\xa8\x42\xc4\xa8\xe8\xc9\x78\xa3\x02\xf4\x10\x4e\x33\x72\x79\x3a\x45\x87\x11\xd8\xd2\xf1\x88\xbb\x05\xc4\x48\xe8\x8a\x78\xe3\xc0\xaa\x21\xe7\xe5\x11\x63\xc1\xe9\x67\x70\xc7\xb7\x4f\x3d\xa6\xa1\xd2\x42\xb2\x78\x3c\x01\x35\xc2\xf8\xac\xe3\xb8\x83\x2d\x6a\x5f\x7c\x23\xf7\xc8\x53\x1a\x46\x66\x8f\xe9\xcc\x9c\x61\xa4\x3f\x70\xe9\x30\xcc\x11\xd0\x30\xda\x5b\xe9\xde\x58\x03\x1d\xe4\x8a\x4b\x34\xc4\x11\x42\xf6\x7d\xac\xed\xd2\xc5\xc5\xa8\x18\x94\xf4\x46\x0a\x68\x6b\xad\xf5\x72\xc6\xe8\x7e\x5b\xb8\xb2\x94\x2a\xcd\x23\xc5\xa8\x8c\x96\xb0\x26\xb4\xc3\xd0\xa9\x97\xd8\x18\x5f\xaf\x4a\x7b\xe3\xac\xa3\x91\x9c\xb7\xaf\x30\xca\x22\xba\x58\x30\xee\x32\x3a\xa0\x42\xc4\xe8\xa9\x64\xc2\x03\xdf\xea\x3d\x94\x54\xd0\xf8\x97\x7c\x1f\x2a\x8d\x04\x34\x92\x89\xbd\xba\x16\x34\x1e\x88\xd2\xda\x17\xcb\x94\xbc\x06\xdb\x23\xdc\xcb\x69\xe8\xfb\xee\xa8\xf7\x03\xf7\x4e\xaf\x5a\x9b\x2d\xb8\x0e\xd4\x3e\xcc\xd7\x2d\xd6\x32\x27\xf9\x61\xb6\x3e\xdf\xdd\xd2\x6d\x58\xee\x64\x44\x69\x2d\xa8\x74\xb8\xe5\x9e\x81\xc3\x68\x6d\x0b\x78\xab\xaf\x46\x09\x99\xc1\x5f\xcc\xf6\xcc\x65\x60\x48\xa6\x36\x98\xdd\x4d\xb2\x37\xab\x9b\x2f\x47\xa6\x36\x64\xaa\x36\x98\xed\x10\x63\xed\xce\xf8\x56\x54\xf8\x60\x37\x74\x2b\x7d\x1f\x9b\x1a\x8c\x26\x16\x1c\x8d\xbc\xd0\x54\x42\x6f\x80\x3b\x83\x2c\x20\x66\x53\x57\x64\xa4\x59\x36\x33\xb9\xa7\x12\x7e\xe7\xa3\xc6\xc1\x2f\x94\x50\x69\xe5\xce\xba\x0a\x11\xab\xb0\x3d\x19\x6f\xb5\x16\xf4\x10\xa5\x15\x99\xdd\xe3\x61\x5c\x54\xa7\x54\x65\x2e\x27\x26\x56\x88\x28\x0d\x5d\x19\x7f\x34\x58\x3d\xe4\x14\x4e\x76\x86\x1d\x38\x9d\x78\x9b\xf1\x66\x56\xed\x20\x09\x6d\x01\x6f\x0c\x18\x25\x64\x0a\x7f\xd1\x6a\xb0\x55\x76\x65\x61\x77\x04\x34\x98\x18\x0b\x2f\xa4\xfc\x55\x86\xad\xea\x2e\x69\x8c\x11\xf2\x94\x15\x0e\x9f\x09\x40\xc3\x48\x68\xa5\x7b\x33\x1f\x74\x90\x1f\x59\x81\x3e\x03\xf0\xde\x08\xd9\x86\xe5\x7a\x2e\x79\x9c\x38\x7c\xf7\xf7\x91\x31\x3a\x5e\x91\xf5\x59\x33\xde\x92\x96\x3e\x72\xde\x9c\x63\x6c\x25\x5c\x2f\x9d\x2e\x14\x69\xf0\x30\x66\x3a\x12\xde\xf8\x28\xb5\xc0\x36\xe0\x9f\x76\xac\x06\x29\xa4\xcb\x37\x86\x81\xc3\xcc\x6f\x0b\x78\xb3\xde\x28\x21\x13\xf8\x8b\xd9\xfe\x94\xa5\xe1\x03\x7d\x72\xfa\x96\x28\x11\x91\xdf\x3c\xbd\x01\x99\x71\x3f\x7b\x9a\xa1\x89\x1f\xd3\xeb\xc1\xd1\x89\x6e\x79\xd2\x98\x3b\xdc\x7c\x00\xd0\x30\xdf\xb6\xd2\xbd\xb9\x16\x74\x90\xeb\x98\x0f\x6e\x40\x50\x15\x14\xbe\x48\xae\xf7\xe1\xdd\x63\x1b\xde\x5f\xb4\x0b\xaf\xd9\x84\xf7\x95\x0d\x78\xab\x02\x6f\xd9\xfc\xdd\x83\x3b\xfb\x0d\x1c\x66\x7d\x5b\xc0\x9b\xed\x46\x09\xf9\x0e\x7f\x31\xdb\x79\x92\x73\x97\x8b\x56\x95\x78\x98\xf5\xa5\x44\xf9\xbd\x53\xe3\x59\xe8\x80\x1c\x15\x9e\x6d\x31\x8e\x08\x12\xb7\x08\xf0\xdd\xcc\x8f\xc4\x38\xaa\x33\xaf\xc2\x10\x59\xb1\xd6\xc7\xa2\x1c\x11\x7c\x3f\xae\x86\x96\xfa\x88\x61\x8f\x5c\x9a\x8b\xcb\xf8\x23\x1b\xb7\xab\xbd\x05\x81\x90\xf2\x12\x62\x6c\x48\xb9\x05\x68\xe2\xdc\x4b\x98\xc3\xe2\xdc\x2d\x98\xa9\x96\x16\x68\x7c\x00\xbe\x05\xb1\xde\xb4\x30\x40\xca\x5a\x1f\x04\x54\x3e\x69\x16\xc7\x34\xf5\xd4\x21\x30\xa6\x86\x56\xa5\xd9\xd5\xd1\x9a\x89\x3c\x11\xf1\x3c\x4c\x24\x77\x1a\xe6\xd4\xc5\xc5\x5e\x33\x83\x92\xde\xea\xb2\xd5\x46\x4a\x6d\xe4\xa3\xe4\xf1\x05\x1a\x06\xb5\xdf\xe6\x3f\x56\x7a\x27\x2d\xad\x8d\x7e\x06\xc9\x68\xa5\x7b\xa3\x00\x74\x90\x89\xc4\x17\x5b\xa1\x3f\xd7\x92\x99\xf1\x58\x9a\xd8\xd7\xb7\x3b\x16\x06\xc0\x31\x52\x5e\x17\xf7\xc6\x91\x51\x49\xac\x4a\x3b\x4f\x67\xe0\x05\xd1\x35\x4b\x32\x1a\x69\xbe\x61\x76\x65\x08\xe5\x8e\xb0\x3e\x32\xc6\xd6\x2b\xb2\xde\xa8\xaa\xf4\xd9\xb5\x5c\x14\xb9\xb3\x37\xca\x25\x20\xd0\x87\x4b\xd2\xf9\x9c\x6b\x97\xab\xaf\x55\x88\x28\x43\x5d\x19\x7f\xcc\x58\x3d\xe4\xce\x9c\x4c\xbf\x62\x54\x44\x4b\xaa\x55\x24\x79\xe1\x70\x38\xb7\xc1\xc4\xe8\x78\x21\xe5\x8d\x90\x46\x13\x99\x2c\xa9\x9e\x19\x73\x31\x52\xe0\xe7\x47\xbd\x80\xa9\x3b\x5e\x3a\xb0\x18\x35\x43\x82\xde\xd8\x81\x1f\x41\x03\xab\xb5\xa2\xfc\xc0\x6b\xca\x21\x2f\x00\x87\xf2\x01\x02\xe1\x3c\x3d\x7c\x23\x65\xf3\x62\xfd\x95\xef\xf0\xd6\xbb\xdb\x6e\x34\xda\x25\x74\x2f\x10\xb0\x98\x9c\xa5\x62\x3e\x08\xd1\x25\xf1\x29\x4b\x15\x8b\xd6\xd2\x69\x38\x61\x0b\x14\xf3\x4f\x4b\x2c\xdc\x30\xc9\x17\x07\x0c\x69\xbf\x44\x52\x3c\x39\xa0\x51\x32\x38\x8c\x66\x98\x61\x3f\xa6\xd7\x07\x79\xb4\x55\x28\xf2\x23\x4b\xbf\x81\x85\x7c\xaf\x59\x86\xad\xc1\xb8\x0e\xc8\xf4\x7a\x66\xcf\x7b\x83\x72\xe3\x4a\x32\xe3\x49\xce\x24\x56\x2b\x12\x29\xc4\xc6\x61\x85\x28\xf1\x5e\xef\x91\xeb\xa4\x8f\xea\x8e\x2b\x73\x92\x8f\xe6\x80\xf4\xc2\xb9\x8d\x3c\xda\x15\x6b\x34\x10\x5d\xb4\x7b\xfe\x65\x93\x35\xa4\x05\x1f\x5f\x85\xc7\xd5\x4e\xd0\x43\xee\xd8\xc8\x6d\x1c\xea\x5c\xa7\x05\xc7\x2a\x10\xac\x96\xe8\x8e\x6e\x40\xc3\xe8\x6e\xa5\x7b\xe3\x0b\x74\x98\x95\x1f\x31\xb3\x21\x4a\x4e\x69\x9a\x3a\x8c\x5a\xa9\x21\x31\x02\xfa\x42\xde\x58\xa8\x15\x99\xc5\x8a\xcd\x19\xc6\x87\xa2\x89\xc3\x81\x0d\x40\xc3\x58\x68\xa5\x7b\x23\x00\x74\x90\x19\x4d\xd0\x41\x8d\x85\x70\x38\x9e\xb1\x10\x05\x66\x74\x93\xec\xcd\xe6\x85\x28\xc8\x85\x40\x47\x3c\x1f\xc4\x32\xff\xe9\xb2\xeb\xc1\x02\x62\x5f\x67\x98\x03\xd9\x93\x1b\x67\x97\xcd\x4b\xae\xca\x23\x1a\xe8\xa2\x44\xea\x30\xca\x17\xd0\x30\xa7\x42\xba\x1a\xef\xd6\x26\xf3\x31\x79\x27\xa9\x58\xc7\xbe\xeb\x14\x28\x22\x33\x91\xa2\xcd\x90\xc7\x35\x8f\x56\x0b\x97\xcb\xd6\x54\x88\x18\xf9\x3d\x19\x6f\x14\x54\x7a\x1e\xc8\xd7\xea\x0c\x7d\xc4\x22\xf0\x8b\x72\xc7\x85\x05\xc4\xa8\xe8\x8a\x78\x63\xc2\xaa\x21\x57\xe5\x11\x63\x01\x1a\x20\xa1\x28\x58\x4e\x0b\xee\x8e\x8a\x36\x2a\xc6\xc7\x80\x9c\x37\x52\x40\x17\xb1\xba\x4c\x93\xeb\x4b\xc1\xf2\x1d\xcd\x2e\x08\x34\x0c\xb7\x54\xbb\xdc\xf0\xa1\xc1\xc4\x98\x79\x21\xe5\x8d\x17\xd0\x44\x8c\x8d\x04\xc2\x13\xbf\xc3\x19\x5a\x63\xc4\x5c\xe8\x85\xa4\x19\xdb\x0a\x97\xe1\xc4\x5d\x5c\x8c\x9b\x41\x49\x7f\xf5\xa6\xa3\x8d\xdc\xc1\xe5\x45\x75\xb9\xab\xf2\xb8\xa3\xa7\x15\x7f\xfa\x6a\x85\xf1\x4d\x05\x94\xc1\x54\x12\xcc\xec\xec\x79\x4e\xb5\xcb\xed\x58\x2d\x20\x66\xbc\x15\x09\xa1\x39\x3d\x9e\x84\x0e\xc6\xf8\xec\xe3\x38\xb4\x6a\xc8\xf4\xf9\x0c\x68\xea\x32\x39\x2e\x2f\xfc\x64\xc0\x3c\x11\xcd\x5d\xce\xa4\x02\x34\xac\xf9\xd8\x4a\x1f\xc7\x08\x64\x24\x93\xb3\x2f\x77\x68\x83\x91\xa5\x4e\xa7\x23\x1a\x38\xac\x4a\xb5\x05\xbc\x55\x06\xa3\x84\x9c\xc3\x5f\xcc\x91\x19\x8f\xa4\xc8\x98\xab\x6d\xb0\xff\x3f\x6f\x57\xd7\xdc\xa6\x92\x44\xdf\xef\xaf\x70\xe5\x9d\xae\x54\xb2\xb5\xbb\xaf\x4e\x72\x9d\xbd\x4a\x9c\x78\x57\xde\xd8\xf7\xbe\x8d\xc4\x48\x46\x20\xc0\x1a\x24\x2b\xfe\xf5\xb7\x7a\x3e\x60\x00\xa9\x01\x55\x4f\xca\x55\x06\x69\x7a\x4e\xcf\x39\x3d\x8c\x60\x98\x8f\x0e\x26\xa5\x42\xcf\x2a\xdc\x75\x51\x7b\x82\xdb\xfa\x94\x12\x25\x97\x15\x67\x1f\xab\x86\xa3\xa4\xf0\x0d\x82\xa9\xa0\x9d\xc0\x37\xfc\x4f\x71\xdf\x14\x9c\xdd\x41\x88\x46\x31\xf7\xd2\x83\x11\x47\x1f\x30\x2b\xe8\xee\x20\xde\x0d\x0d\x87\xb6\x32\xc4\x74\x35\x9d\x77\x93\x79\x7a\xde\x69\x9a\xa1\x0f\x18\xda\xf6\xb0\x92\x99\x5c\xef\x38\x67\xf4\x3b\x44\x4a\xbb\x8e\x4d\x30\x09\x9c\x1f\xb8\xb7\x27\x94\x14\x6b\x91\xaf\xb3\x84\xb1\x03\xcd\x02\x52\x42\xb4\x4d\x82\xe9\x60\xdd\xc0\x67\x73\xa4\x54\xd0\xc3\xd8\xd3\x24\x97\x8a\xf3\x26\xcd\x47\xa5\xf4\x38\x61\x37\xfd\x2d\xa2\x87\x11\xad\x92\x9d\x7c\x2a\x94\x9c\x0e\x36\x4d\x61\x2c\x38\x58\xa7\xf0\xc5\x1c\x3b\xc3\x55\xc7\x20\xb8\xe2\x3a\x88\x1b\xfb\x99\x1c\xf9\x5a\xbd\x24\x15\xeb\x8f\xbf\x05\xa4\x02\x65\x4d\x22\x33\xbf\x7a\xba\xba\x6d\x94\x2a\xd9\xca\x2c\xc9\x2f\x88\x52\x1b\x27\x4e\x76\x72\x59\x99\x51\x80\x17\x80\x4d\x0b\xb9\x75\x0a\x76\xc2\xf9\xbd\xf9\x38\x3f\x31\xe1\x7c\x24\x50\xab\xf4\x0e\xef\x93\xfe\xd2\x0c\x64\x94\x17\xc1\x3a\x71\x1d\xe2\xbd\xfd\x4c\x55\x29\x1c\xdb\xa0\x5e\x18\x07\xd2\x5b\x40\xaa\x4a\xb5\x4d\x82\x85\x0d\x47\x52\xa8\x97\x15\xcc\x1f\x6e\x28\x05\xca\xfd\x22\xe7\x9c\x26\x63\xf0\x28\xfe\x2d\x8b\x60\xf4\x8d\x17\xb8\xdb\x2f\xbe\xd1\xd3\x64\x54\xc5\x49\x1f\xd1\x28\xf2\xaa\x1a\x4b\xfd\xe2\x15\x65\xd1\x05\xcc\x2b\x9a\x35\xb6\xe3\x91\x7a\x56\x7c\xcc\x1d\x22\xc5\xbe\x63\x13\x2c\xf8\xe8\x07\xd4\xb3\x82\xf9\xb3\x7a\x47\xc9\xb0\xa9\xfe\xf1\xf6\x2d\x9f\x06\x1a\x8e\x12\xc0\x37\x08\xc6\x5e\x3b\x81\x19\xfe\xa7\xb8\x27\x45\xc5\x78\x13\x88\x68\x14\x73\x2f\x3d\x18\x71\xf4\x01\x7f\x7c\xbf\xbf\x26\x43\xae\x0a\xde\x5e\x7e\x7c\x61\xd8\x74\xdc\x9f\x7d\xa7\x78\x5d\x26\x17\x91\xb2\xf0\x7a\x49\xd6\xeb\x32\x21\x7b\x87\xb0\xde\x47\x32\x55\x7c\xe4\x1c\x22\x15\xda\x8e\x4d\xb0\xf0\xa2\x1f\x90\xa9\x82\xdf\xbf\xd0\xf3\xe6\x5f\xa4\xa8\x9e\x38\x6f\x14\x2d\x20\x25\x42\xdb\x24\x98\x06\xd6\x0d\x3c\x98\x23\xa5\x02\xf6\x8f\xc7\xfb\x8c\x53\x87\x1a\x92\x52\xa2\x6b\x14\x4c\x8b\xda\x11\xcc\xdd\x19\xa5\xc7\x46\x1c\x19\x7f\xe8\x11\x8d\xbc\xe4\x9b\xf4\x71\xac\x72\xdc\x18\x4f\xee\x00\x81\x61\x26\x8e\x0b\xf2\x4a\xdf\x24\x39\xe3\x2a\xf8\x9b\x66\x02\xd5\xc9\x90\x7a\xe9\xbf\x60\x2d\x17\xf4\x06\xb3\xde\x6c\xa6\x76\x91\x1b\xd1\xf9\x64\x68\x30\x29\x31\x7a\x56\xa1\x6e\xe4\x1a\x47\xd0\xd4\x05\xb2\x86\x6b\x09\x14\x9f\x20\x16\x90\x52\xa3\x6d\x12\xac\x76\x58\x37\x30\xfb\xac\x8f\x94\x0a\x3f\xc5\x76\xcb\xd9\xe8\x19\x3c\x4a\x83\x96\x45\x30\x09\x8c\x17\xf8\x53\x1f\x28\x01\x9e\x16\x82\x73\x97\x60\x0d\x47\xd1\xf7\x0d\x82\xb1\xd7\x4e\xe0\x3f\x1f\xc4\xc0\x16\xc1\xf8\x70\x1d\x33\xb6\xf3\xfa\x69\x3d\x5e\x50\xfc\xdb\x26\xc1\x14\xd0\x0f\xf4\xf1\x02\xe6\x31\xfd\x3c\x2b\xca\x28\x97\xd5\x8b\x14\x07\xd6\x9f\x7e\x1f\x96\x52\xe3\x94\x61\x30\x4d\x94\x28\xa1\x76\x86\x2f\x8c\x1e\xf4\x19\xa9\x8f\x99\xbd\x90\x17\x2f\x8c\xe2\xd4\x98\xa4\x32\x5d\xab\x70\xb2\xd4\x9e\xc0\x4e\xd6\xf8\x56\xbc\x50\xa2\xac\x59\x77\xd5\x5e\x0f\xec\xa8\xed\xa5\x3b\x09\xbc\x1f\x49\x16\x05\xd0\x05\x7c\xde\x95\xe4\x4e\x42\x49\xc9\x39\xdb\x17\xd1\x28\xd6\x5e\x7a\xb0\xc0\xa3\x0f\xf8\xe3\xee\x86\xdc\x38\x15\xdb\x2b\xee\x3d\x32\x47\x6c\x91\xf9\x8b\x76\xc8\x74\x1b\x64\x52\x0a\xa8\x4a\x30\x8e\xfe\x44\x34\x8a\xbb\x97\x1e\x8c\x38\xfa\x80\x79\x75\xfd\x38\x10\xf8\x77\xd1\x56\x31\x8e\x56\x73\x88\x14\xfd\x8e\x4d\xc8\xd8\xbf\x83\xad\x4a\xe1\x76\xfe\x85\xec\x1e\xa8\x37\x98\x50\xcf\x99\xd9\xad\x8d\x4f\x90\x3e\x36\x25\xcd\x59\xeb\x60\x22\xf5\xb6\xbb\x98\x3b\xcf\x94\x62\x2a\x17\xa9\xfc\x29\xb6\x9c\x0b\xa1\x3b\x48\x6b\xd9\x3c\xec\x78\x44\x30\xbd\x67\x39\x8d\x70\x9d\x1b\xe6\x78\xf6\xe7\xf5\xed\x57\xf2\xc1\x7a\x25\x54\x85\x5d\x6e\x7c\x4c\x1d\x22\x45\x14\xd3\xbb\x86\xd3\x78\xba\xcc\x70\x63\x4f\x48\x96\x47\xc6\x15\x84\x8e\x1b\xaa\x8a\x1f\x37\xa1\xab\xf4\x71\x03\x8f\x33\xf2\x7a\xd7\xaf\x16\xf9\x08\x9b\xf7\x97\x14\xe9\x96\x45\xb0\xee\x01\xed\x04\xcc\x8b\x53\x8a\x3f\x0e\x07\xe5\x63\xef\x0d\x2e\x3d\xc9\x7d\xfc\xe0\x53\x8f\xb9\x9d\x84\x9f\x09\xa5\x26\xe7\x3d\x31\xf1\xd3\x2b\xc3\x34\x4d\x31\xa3\x2e\x05\x7c\xc4\xff\x6d\x55\x47\xe4\x85\xfe\x60\x54\x7a\xa2\x69\x9d\x89\x98\x64\x8a\xf7\x4e\xea\x3d\x5f\x04\x0d\x1e\x15\xc3\x96\xc5\x40\x24\xfc\xaf\xa7\x89\x8d\x37\x6b\xea\x3d\xcc\xdf\xb7\x05\x6b\x17\x76\xbd\x2b\x18\xef\x57\x10\x8d\x6a\x87\xbd\xf4\x69\x5c\x30\x23\x4e\x17\x4e\xc9\x66\x77\x59\xec\x97\x4f\x9c\xbd\x13\x16\x90\x8a\x65\xdb\x24\x58\x30\xad\x1b\xf8\x88\xc7\x4f\x64\x0f\x05\xce\x18\x88\x05\xe3\xa0\x55\x0b\x48\xa9\xd0\x36\x09\xa6\x82\x75\x03\x0f\xe6\x48\xa9\xf0\x9a\x94\x51\x2c\x57\x99\x60\x1d\xd2\xe4\xa3\x92\x15\xfd\x35\x29\x3b\x76\xd1\x55\xff\xbb\x31\xec\x1b\x7c\x70\xb9\xe1\xaf\xa4\xfc\x64\xcf\x3b\x17\xc4\x68\x88\xcf\xaf\x67\x31\xda\x94\x15\xeb\x6a\xc0\x6a\x60\x35\x60\x4c\x57\xd3\xab\x51\x93\x79\x7a\xde\x69\x55\x10\x7d\xc0\x7c\x60\x29\x61\xf5\x53\xb1\x6e\xa3\x6c\xf0\xac\x59\x13\x4d\xaf\xc4\x2d\x8b\x89\x8c\x74\x56\x98\xeb\x03\x59\x15\xf0\x77\x8b\x7b\xdb\xae\x11\xbb\x76\xfd\xa2\x4d\xbb\xdc\x9e\x5d\x54\x60\xeb\x27\xca\xb2\x50\xd5\x7a\x27\x15\x9f\x16\x3d\x68\x4a\x95\x73\xc6\xc1\xf4\xe9\x3d\xd8\xde\x59\xc7\x94\x5a\xab\x2c\xc9\x19\xef\x2e\x34\x1c\xa5\x8a\x6f\x10\x4c\x09\xed\x04\x6e\xf0\x3f\xc5\x5d\x6c\x9f\x19\x9b\x4d\x44\xa3\x98\x7b\xe9\xc1\x88\xa3\x0f\xb8\xbe\xfd\xef\x1d\x45\x7b\xbd\x17\x07\x61\xd7\x2f\xdf\x2b\x3e\x01\xda\xb8\x94\x14\x27\x2d\x83\x89\xa2\xbd\x81\xf3\x06\x9f\xf1\xe3\xef\xf8\xe9\xc3\x5e\x51\x3a\x95\xfb\x4c\x09\xc6\x1b\x13\x83\x47\xe9\xd2\xb2\x08\xa6\x87\xf1\x02\x77\xfa\x40\x09\xb0\x29\x19\x47\xc7\x6d\x4a\x41\x51\x6f\x92\x83\xf1\xde\x94\x02\x66\xe5\xf0\x94\x88\x98\xf9\xe5\xe9\xbb\x28\xa6\x9f\x4f\x3a\x36\x13\x04\x68\xe7\x37\x2b\x2f\x4e\x47\x99\x26\x23\x7a\x83\x38\x5e\xc0\xa7\x78\xd1\xe9\x64\x1e\x99\xd3\x94\x53\xe7\x37\x8b\x43\x52\x21\xd9\x16\x4b\xc6\xdf\x27\x44\xa3\x42\xe1\xa5\x8f\xef\x7e\x99\xa6\x1f\xba\x80\xdb\x62\x49\xfe\x34\x25\x72\xf9\xcf\xb7\xff\xfe\x17\xe3\xc0\x5c\x87\x48\xb1\x77\x36\xd1\xa5\x3d\xf1\x1d\x98\x65\x96\x38\x7a\x01\x2b\xa4\x73\x07\xc6\x1d\x7c\xd4\x87\xb6\xba\x63\x21\x0c\x71\x98\x0f\xbe\x0f\x78\x91\x29\x63\xe3\x88\x68\x54\x60\xbc\xf4\x60\x32\xa2\x0f\x78\x90\x29\xd9\x40\x2e\x8b\xbc\xda\x15\x19\xeb\x7d\x43\x83\x49\x49\xd0\xb3\x0a\x75\x7d\x36\x8e\x70\xcb\x68\x64\x3b\x70\x9f\x80\xa3\x16\x19\xdf\xa5\x6a\x38\x4a\x09\xdf\x20\x58\x6d\xd0\x4e\xe0\x07\xfe\xa7\xb8\x6f\xe2\x05\xe3\xa8\x09\x44\xa3\x98\x7b\xe9\xc1\x88\xa3\x0f\x98\xc5\x0b\x72\xd8\x04\x6e\x2a\xbe\x67\x7c\x15\x68\xf0\x28\xea\x2d\x8b\x60\xe4\x8d\x17\xac\xf7\x6a\x4f\x2e\x44\x50\xac\x73\x46\xfa\x88\x76\xfe\x55\x86\x97\x3a\x86\x4d\xfd\x62\x01\xf3\xc1\xf7\x75\x9e\x11\x2f\x16\x56\x62\x29\x17\x05\x67\xff\xba\x43\xa4\x82\xd9\xb1\x09\x16\x4e\xe7\x07\x6e\xec\x09\x15\xd2\x63\x29\x2a\xc6\xd5\x8d\x34\xdc\xf9\xa0\xfa\xc9\x93\xa2\xaa\x33\xc2\xe3\x9d\xa8\x9e\x88\xb8\xe6\x8a\x71\x31\xf1\x5c\x91\xeb\x88\x37\xc9\xc1\x02\x99\xab\x67\xf8\xa6\x9e\xa9\xf0\xe1\x9b\x6f\xc6\xe7\x16\x0d\x47\xf5\x66\xa2\x41\xcb\x6a\x1a\x23\x9d\x53\xcf\xe8\xa1\x47\xf9\xe3\xd3\x46\xa4\x72\xc5\xc7\xcc\x21\x52\x21\xed\xd8\x04\x8b\x2b\xfa\x01\x95\x2b\x98\xe7\xf4\x2c\xbd\xed\x41\x32\xb6\xb7\x88\x46\xd1\xf7\xd2\x27\x50\x3f\x71\x95\x7b\x40\xd3\x84\xc1\x8c\x70\x7b\x90\x9d\x1f\x21\xba\x69\xa8\x33\x11\x0d\xc3\x3e\x4f\x0e\xc5\x12\x97\xb3\x2e\xc5\x4e\xc9\x9d\xe2\x53\xb5\x07\x4d\x5d\x3e\x8d\xf1\x52\x1d\x4e\x7c\x5b\x9d\xfc\x76\x95\x1c\x65\x3c\x41\xd0\xc6\x35\xd4\x20\xf0\xff\x3c\xf9\xa1\xcf\x3e\xaa\x43\xe7\xda\xbb\x00\xe4\x9e\x03\xe4\x06\x79\x3d\x24\x71\xf5\xd4\xc1\x6a\x2b\xbc\x93\x8c\xf3\xd4\x77\x72\x45\x5d\x03\x4d\x72\xa8\x07\x8d\x9d\x5c\xc1\xff\x24\xb9\xd7\x5b\xf6\xca\x48\x38\x7b\x75\x8c\x9a\x60\x78\x25\x6d\x92\x27\xd6\xac\xec\x75\x05\x5f\xff\xba\x21\x23\x57\x8a\x27\xc6\xbd\x6b\x11\x8d\x8a\x9d\x97\x3e\xa1\xfd\x9a\x16\x3d\xf4\x01\x77\xe2\x89\xdc\xb7\xf6\xc0\xf8\x22\xee\xb0\xa5\x28\x1f\xb6\xe3\x08\x5f\x5c\x5b\x0f\x5b\xf8\x41\x76\xd4\x2d\x92\x3c\x66\x1c\xd1\xa0\xe1\xa8\xfa\xaa\x0d\x5a\x2d\xa2\xfb\x2e\x3d\x94\x9d\x6f\x9a\x36\x76\x62\xdd\xd6\xd9\x41\x3b\x81\x0f\x78\xae\x5b\xaa\xaf\x32\x5f\xf7\x9a\xaa\x71\x50\xe9\xa1\x34\x40\x5f\xe4\xcf\x1f\x22\xdb\xcb\x3b\x91\xec\x2e\x42\x5a\xaa\x83\x41\xea\x37\xe3\x6d\x25\x37\xac\x4b\xc6\x6f\x06\x96\x8c\xf7\xd2\x83\x5d\x7b\xe8\x03\x66\x03\x4b\xc6\x8b\x92\xf5\xae\xb1\x1c\xb8\x63\x6c\xd2\x83\xd1\x46\x46\x70\x5d\xe6\x64\x6f\x54\x51\x56\xa2\xcc\x44\x9e\x73\x8e\xa9\xf1\x40\x29\x11\xfa\x66\xc1\xb4\xf0\x5c\xc1\xf7\xb2\x12\x77\x86\x32\xa5\xcc\xd1\xbc\x77\xe0\x53\xc5\x02\x5a\xbb\xe6\x02\xf5\x18\xe0\x63\x4e\xc7\x2e\xba\x6a\x7f\x1e\xc3\xdb\xbb\xf8\x6d\x66\x78\x34\xaf\x4e\x26\x36\x1d\x2e\xf7\x6c\x68\x78\x74\x3d\x7a\xc1\xee\x94\xc7\xa7\x5a\x17\x99\xaa\x50\x67\x6c\x83\xd5\xaa\xde\x10\x8a\x5b\xe3\x97\xaa\x56\x1b\xb1\x4c\x55\x91\x1f\x39\xa7\x06\x34\x98\xd6\xb4\x09\xa1\x47\xa4\x67\x35\x8d\x6c\x93\x1d\x66\xe6\xf4\x71\x60\x66\xc0\x6b\x51\xa4\x52\x96\x72\x17\x6d\x85\xe2\x1d\xb4\xd7\x41\xa6\x2a\xc5\x19\xdb\x60\x95\xa2\xf6\x67\xdc\xc1\xad\x3e\x9c\xaa\x12\x69\x94\xe6\x02\x37\xc1\x1b\x16\x26\x3d\x2b\x49\x0d\x42\x69\xd0\x36\x71\xd4\xbd\x9b\xbb\x33\xcc\xaf\xae\xca\x5d\x11\xef\x97\x4d\xec\xf0\x2f\x96\xa5\xcc\x63\x99\x2f\x93\xc6\x4f\x3d\x1a\x78\x80\xc0\x20\x8d\xc8\x79\xac\x33\xe8\x5e\xe6\xed\xaf\x2c\x82\xf3\x78\xa2\x4e\xbb\xde\x03\xe7\xf4\xbc\x3b\x9b\xe3\xb7\x73\xee\xba\x88\x54\xfc\x3a\x36\xa1\x6e\xda\x9d\x1b\x70\x3d\x22\x54\x43\xa6\x9f\x2b\x44\xc5\xba\xd1\x69\x83\x49\xa9\xd1\xb3\x1a\xd0\xe3\xf2\x6b\xb9\xf1\xa4\x57\x4c\x31\xa7\xbe\x28\x59\x21\xe2\xba\x57\x68\xa3\x86\x95\x48\xcf\x6a\x90\x46\x06\x2c\xda\x38\x9d\x5c\x38\x6a\xd8\xe8\xaa\x4e\xdb\xca\x4a\x60\x2b\xef\x92\xae\xae\x6c\x53\x72\xf5\x06\x2f\xeb\x37\xfa\xeb\xb4\xe2\x29\x51\x5a\x54\x59\x92\x9f\x2f\x55\x5a\x8d\x28\x96\x8e\x82\x29\xd7\xb8\x89\x71\x23\x0a\xe6\x4d\x72\x3b\x51\x2c\x2f\x75\x94\x5c\x63\x77\x5b\x1b\x51\xb0\x49\x1b\xad\x8d\x13\x0d\x6b\x2e\x4b\xd9\x10\xe8\x7c\xc9\xbc\xd4\x71\xe5\x1a\x75\x27\x33\xa2\x58\xcd\x7d\xc9\x89\x52\x35\x89\x44\xa1\xaa\xdd\x5e\xbe\xf9\xed\xef\x01\x00\xd9\x7d\x98\x19\xa5\x5c\x01\x00"),
 		},
 		"/cr-example.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "cr-example.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 1411,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x54\xc1\x6e\xdb\x46\x10\xbd\xf3\x2b\x5e\xcd\x83\x64\xc0\xa6\x9c\x1e\x55\x18\x81\xe2\xd8\x35\x91\x40\x02\x4c\x25\x41\x50\xf4\xb0\xe6\x8e\xc8\x41\xc8\x1d\x76\x76\x69\x5a\x68\xfb\xef\xc5\x92\x52\x23\xb5\xd7\xec\x49\xda\x99\x7d\xf3\xde\xbe\xb7\x4c\x71\xfd\xe3\x56\x92\xe2\x23\x97\xe4\x3c\x59\x04\x41\xa8\x09\xab\xce\x94\x35\xa1\x90\x5d\x18\x8c\x12\x1e\xa4\x77\xd6\x04\x16\x87\xf9\xaa\x78\xb8\x44\xef\x2c\x29\xc4\x11\x44\xd1\x8a\x52\x92\xa2\x14\x17\x94\x9f\xfb\x20\x8a\x66\x02\x84\xa9\x94\xa8\x25\x17\x7c\x06\x14\x44\x23\xfa\x7a\xb3\xcd\xef\xee\xb1\xe3\x86\x60\xd9\x4f\x87\xc8\x62\xe0\x50\x27\x29\x42\xcd\x1e\x83\xe8\x37\xec\x44\x61\xac\xe5\x38\xd8\x34\x60\xb7\x13\x6d\x27\x1a\x4a\x95\x51\xcb\xae\x42\x29\xdd\x5e\xb9\xaa\x03\x64\x70\xa4\xbe\xe6\x2e\x4b\x52\x6c\xa3\x8c\xe2\xe1\xc8\xc4\x4f\xb0\xe3\xcc\x20\xf8\x2a\xfd\x41\xc3\x89\xdc\xc3\x2d\x5c\xe1\x33\xa9\x8f\x43\x7e\xce\x6e\x92\x14\xf3\xd8\x72\x71\x28\x5e\x5c\xfe\x82\xbd\xf4\x68\xcd\x1e\x4e\x02\x7a\x4f\x27\xc8\xf4\x5a\x52\x17\xc0\x0e\xa5\xb4\x5d\xc3\xc6\x95\xf4\x5d\xd6\xbf\x13\x32\x8c\x04\x22\x86\x3c\x07\xc3\x0e\x66\x94\x01\xd9\x9d\xb6\xc1\x84\x24\x4d\x52\x8c\xab\x0e\xa1\x5b\x2e\x16\xc3\x30\x64\x66\xa4\x9b\x89\x56\x8b\xa3\xba\xc5\xc7\xfc\xee\x7e\x5d\xdc\x5f\x8f\x94\x93\x14\x9f\x5c\x43\xde\x43\xe9\x8f\x9e\x95\x2c\x9e\xf7\x30\x5d\xd7\x70\x69\x9e\x1b\x42\x63\x86\x68\xdc\xe8\xce\x68\x3a\x3b\x0c\xca\x81\x5d\x75\x05\x7f\x70\x3d\x49\xcf\xdc\xf9\x7e\x5d\x47\x7a\xec\xcf\x1a\xc4\xc1\x38\x5c\xac\x0a\xe4\xc5\x05\xde\xad\x8a\xbc\xb8\x4a\x52\x7c\xc9\xb7\x8f\x9b\x4f\x5b\x7c\x59\x3d\x3d\xad\xd6\xdb\xfc\xbe\xc0\xe6\x09\x77\x9b\xf5\xfb\x7c\x9b\x6f\xd6\x05\x36\x0f\x58\xad\xbf\xe2\x43\xbe\x7e\x7f\x05\xe2\x50\x93\x82\x5e\x3b\x8d\xfc\x45\xc1\xf1\x22\xc9\x46\x4f\x8f\x01\x3a\x12\x88\xf9\x88\xff\x7d\x47\x25\xef\xb8\x44\x63\x5c\xd5\x9b\x8a\x50\xc9\x0b\xa9\x8b\xf1\xe8\x48\x5b\xf6\xd1\x4e\x0f\xe3\x6c\x92\xa2\xe1\x96\xc3\x98\x22\xff\x7f\x51\x71\xcc\x8f\x7c\x5b\x89\xe9\xf8\x10\xa7\x25\x4a\xd3\x52\x73\x6a\xdf\xcb\x9b\xe4\x1b\x3b\xbb\x44\xee\x02\x55\x3a\x92\x4a\x5a\x0a\xc6\x9a\x60\x96\x09\xe0\x4c\x4b\x4b\xd0\xab\x69\xbb\x86\x92\xa8\x33\xee\x7a\xe9\xb5\x24\x1f\x7f\x5e\x8f\xcf\x8e\x5c\x58\xe2\xaf\xeb\x64\x8a\xca\x62\x81\x6d\x8c\x24\x7b\xdc\xc5\x89\xf8\x80\x5f\x55\xe4\x65\x7f\x04\x82\x4a\x1f\x28\x39\xb4\xab\xb3\xb8\x85\xa3\x01\x4f\xc6\x59\x69\xe7\x97\xc7\xca\x4e\xa5\x9d\xcf\x02\xb7\xa4\xcb\x6a\x44\x78\xdb\x91\xb2\xd8\xdb\x37\x37\x37\x37\xb3\xcb\x43\x5b\x5c\xd9\x08\x99\xdb\xf9\x6c\x6a\x3c\x2f\x7a\x0a\xef\xc4\xee\xe7\xa7\x9b\x63\xa1\x14\xe7\x83\x71\x61\x3e\x7b\xa4\xa6\x91\x23\xdf\x9f\xce\x8f\x77\x2a\x65\x4c\xc3\x9f\xff\x39\xce\x21\x63\x97\xd5\x64\x2c\xa9\xff\x6d\x36\xd1\xff\x6c\x9a\x9e\x66\xbf\xe3\x36\x0a\xcb\x1c\xbd\x86\xdc\x85\xb3\xc9\x7f\x9f\x62\x07\x99\xcf\x1a\xa9\x96\xf1\xe3\xf2\xd6\xd7\x32\x3c\x4e\x70\xb7\x41\x7b\x3a\xd0\x98\x5c\x18\x15\xfa\x6c\xd2\x97\xfc\x13\x00\x00\xff\xff\x19\x6c\xe5\xa5\x83\x05\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x54\xd1\x6e\xdb\x36\x14\x7d\xe7\x57\x9c\x45\x0f\x76\x80\x44\x4e\xf7\xe8\x21\x28\xdc\x34\x59\x84\x16\x36\x10\xb9\x2d\x8a\x61\x0f\x8c\x78\x2d\x13\x95\xee\xd5\x2e\xa9\x28\xc6\xb6\x7f\x1f\x28\xdb\xab\xbd\xbd\x96\xf6\x03\x4d\x5e\x9e\x7b\x0e\xcf\xa1\x33\x5c\xff\xb8\x61\x32\x7c\xf4\x15\x71\x20\x87\x28\x88\x5b\xc2\xa2\xb3\xd5\x96\x50\xca\x26\x0e\x56\x09\x0f\xd2\xb3\xb3\xd1\x0b\x63\xba\x28\x1f\x2e\xd1\xb3\x23\x85\x30\x41\x14\xad\x28\x99\x0c\x95\x70\x54\xff\xdc\x47\x51\x34\x7b\x40\xd8\x5a\x89\x5a\xe2\x18\x72\xa0\x24\x1a\xd1\x97\xab\x75\x71\x77\x8f\x8d\x6f\x08\xce\x87\xfd\x21\x72\x18\x7c\xdc\x9a\x0c\x71\xeb\x03\x06\xd1\x6f\xd8\x88\xc2\x3a\xe7\x53\x63\xdb\xc0\xf3\x46\xb4\xdd\xd3\x50\xaa\xad\x3a\xcf\x35\x2a\xe9\x76\xea\xeb\x6d\x84\x0c\x4c\x1a\xb6\xbe\xcb\x4d\x86\x75\x92\x51\x3e\x1c\x99\x84\x3d\xec\xd8\x33\x0a\xbe\x4a\x7f\xd0\x70\x22\xf7\x70\x0b\x57\xf8\x4c\x1a\x52\x93\x9f\xf3\x1b\x93\x61\x9a\x4a\x2e\x0e\x9b\x17\x97\xbf\x60\x27\x3d\x5a\xbb\x03\x4b\x44\x1f\xe8\x04\x99\x5e\x2b\xea\x22\x3c\xa3\x92\xb6\x6b\xbc\xe5\x8a\xbe\xcb\xfa\xb7\x43\x8e\x91\x40\xc2\x90\xe7\x68\x3d\xc3\x8e\x32\x20\x9b\xd3\x32\xd8\x68\x32\x93\x61\x1c\xdb\x18\xbb\xf9\x6c\x36\x0c\x43\x6e\x47\x77\x72\xd1\x7a\x76\x54\x37\xfb\x58\xdc\xdd\x2f\xcb\xfb\xeb\x91\xb2\xc9\xf0\x89\x1b\x0a\x01\x4a\x7f\xf4\x5e\xc9\xe1\x79\x07\xdb\x75\x8d\xaf\xec\x73\x43\x68\xec\x90\x8c\x1b\xdd\x19\x4d\xf7\x8c\x41\x7d\xf4\x5c\x5f\x21\x1c\x5c\x37\xd9\x99\x3b\xdf\xaf\xeb\x48\xcf\x87\xb3\x02\x61\x58\xc6\xc5\xa2\x44\x51\x5e\xe0\xdd\xa2\x2c\xca\x2b\x93\xe1\x4b\xb1\x7e\x5c\x7d\x5a\xe3\xcb\xe2\xe9\x69\xb1\x5c\x17\xf7\x25\x56\x4f\xb8\x5b\x2d\xdf\x17\xeb\x62\xb5\x2c\xb1\x7a\xc0\x62\xf9\x15\x1f\x8a\xe5\xfb\x2b\x90\x8f\x5b\x52\xd0\x6b\xa7\x89\xbf\x28\x7c\xba\x48\x72\xc9\xd3\x63\x80\x8e\x04\x52\x3e\xd2\xef\xd0\x51\xe5\x37\xbe\x42\x63\xb9\xee\x6d\x4d\xa8\xe5\x85\x94\x53\x3c\x3a\xd2\xd6\x87\x64\x67\x80\x65\x67\x32\x34\xbe\xf5\x71\x4c\x51\xf8\xbf\xa8\xd4\xe6\xf8\x30\x7e\xc0\x30\xc6\x76\xfe\x10\xa7\x39\x2a\xdb\x52\x73\x6a\xdf\xcb\x1b\xf3\xcd\xb3\x9b\xa3\xe0\x48\xb5\x8e\xa4\x4c\x4b\xd1\x3a\x1b\xed\xdc\x00\x6c\x5b\x9a\x83\x5e\x6d\xdb\x35\x64\x92\xce\xb4\x1a\xa4\xd7\x8a\x42\x9a\x5e\x8f\xcf\x8e\x38\xce\xf1\xd7\xb5\x49\x41\x01\x66\x33\xac\x53\xd8\x7d\xc0\x5d\xea\x88\x0f\xf8\x55\x45\x5e\x76\x47\x20\xa8\xf4\x91\xcc\xa1\x5c\xd9\xe1\x16\x4c\x03\x9e\x2c\x3b\x69\xa7\x97\xc7\x9d\x8d\x4a\x3b\x9d\x44\xdf\x92\xce\xeb\x11\xe1\x6d\x47\xea\xc5\xdd\xbe\xb9\xb9\xb9\x99\x5c\x1e\xca\xd2\x37\x1f\x21\x0b\x37\x9d\xec\x0b\xcf\x37\x03\xc5\x77\xe2\x76\xd3\xd3\xc5\xf4\xc9\x2b\xe1\x10\x2d\xc7\xe9\xe4\x91\x9a\x46\x8e\x7c\x7f\x3a\x3f\xde\xa9\x54\x29\x0d\x7f\xfe\xe7\xb8\x8f\xb9\xe7\x7c\x4b\xd6\x91\x86\xdf\x26\x7b\xfa\x9f\x6d\xd3\xd3\xe4\x77\xdc\x42\xd9\xe5\x4c\xaf\xb1\xe0\x78\xd6\xf9\xef\x93\x79\x1e\x65\x3a\x69\xa4\x9e\xa7\x3f\x97\xb7\x61\x2b\xc3\xe3\x1e\xee\x36\x6a\x4f\x07\x1a\x7b\x17\x46\x85\x21\xaf\x55\xe4\x65\x67\xfe\x19\x00\x19\x6c\xe5\xa5\x83\x05\x00\x00"),
 		},
 		"/crd-build.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "crd-build.yaml",
 			modTime:          time.Time{},
-			uncompressedSize: 314733,
+			uncompressedSize: 499731,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\xfd\xfd\x73\x1b\x37\x92\x30\x8e\xff\x9e\xbf\x02\xa5\x6c\x95\xa4\x8b\x48\xd9\x77\xf9\xee\xed\x69\xaf\x2e\xa5\x58\x72\x4e\x95\xd8\xd6\x63\xca\xc9\xf7\x2a\x9b\xdb\x02\x67\x40\x12\xab\x19\x60\x02\x60\x28\x73\x9f\x3c\xff\xfb\xa7\xd0\x00\xe6\x85\x2f\x62\x63\x48\xca\x72\x32\xf8\xc5\x16\xc9\xe9\x01\x1a\x8d\x46\xbf\xf7\x97\x64\xb0\xbf\xf1\xc5\x97\xe4\x07\x9e\x30\xa1\x59\x4a\x8c\x24\x66\xc6\xc8\x65\x41\x93\x19\x23\x23\x39\x31\x0f\x54\x31\xf2\x5a\x96\x22\xa5\x86\x4b\x41\x4e\x2e\x47\xaf\x4f\x49\x29\x52\xa6\x88\x14\x8c\x48\x45\x72\xa9\xd8\x17\x5f\x92\x44\x0a\xa3\xf8\xb8\x34\x52\x91\xcc\x01\x24\x74\xaa\x18\xcb\x99\x30\x7a\x48\xc8\x88\x31\x80\xfe\xf6\xdd\xdd\xcd\xab\x6b\x32\xe1\x19\x23\x29\xd7\xee\x21\x96\x92\x07\x6e\x66\x5f\x7c\x49\xcc\x8c\x6b\xf2\x20\xd5\x3d\x99\x48\x45\x68\x9a\x72\xfb\x62\x9a\x11\x2e\x26\x52\xe5\x6e\x1a\x8a\x4d\xa9\x4a\xb9\x98\x92\x44\x16\x0b\xc5\xa7\x33\x43\xe4\x83\x60\x4a\xcf\x78\x31\xfc\xe2\x4b\x72\x67\x97\x31\x7a\x1d\x66\xa2\x1d\x58\x78\xa7\x91\xe4\x7f\x64\xe9\xd7\xd0\x58\xae\xc7\xc2\x19\xf9\x91\x29\x6d\x5f\xf2\xaf\xc3\x17\x5f\x7c\x49\x4e\xec\x4f\x8e\xfc\x97\x47\xa7\x7f\x25\x0b\x59\x92\x9c\x2e\x88\x90\x86\x94\x9a\x35\x20\xb3\x8f\x09\x2b\x0c\xe1\x82\x24\x32\x2f\x32\x4e\x45\xc2\xea\x65\x55\x6f\x18\x12\x98\x80\x85\x21\xc7\x86\x72\x41\x28\x2c\x83\xc8\x49\xf3\x67\x84\x9a\x2f\xbe\xfc\xe2\x4b\x02\x63\x66\x4c\x71\x71\x7e\xfe\xf0\xf0\x30\xa4\x30\xdd\xa1\x54\xd3\xf3\xb0\xba\xf3\x1f\x6e\x5e\x5d\xbf\x1d\x5d\x0f\x60\xca\x5f\x7c\x49\x3e\x88\x8c\x69\x4d\x14\xfb\xb5\xe4\x8a\xa5\x64\xbc\x20\xb4\x28\x32\x9e\xd0\x71\xc6\x48\x46\x1f\xec\xc6\xc1\xee\xc0\xa6\x73\x41\x1e\x14\x37\x5c\x4c\xcf\x88\xf6\xbb\xfe\xc5\x97\xad\xdd\xa9\xd1\x15\xa6\xc7\x75\xeb\x07\x52\x10\x2a\xc8\xd1\xe5\x88\xdc\x8c\x8e\xc8\xb7\x97\xa3\x9b\xd1\xd9\x17\x5f\x92\x9f\x6e\xee\xfe\xfb\xdd\x87\x3b\xf2\xd3\xe5\xfb\xf7\x97\x6f\xef\x6e\xae\x47\xe4\xdd\x7b\xf2\xea\xdd\xdb\xab\x9b\xbb\x9b\x77\x6f\x47\xe4\xdd\x6b\x72\xf9\xf6\x7f\xc8\xf7\x37\x6f\xaf\xce\x08\xe3\x66\xc6\x14\x61\x1f\x0b\x65\xe7\x2f\x15\xe1\x16\x91\x2c\xb5\x7b\x1a\x08\x28\x4c\xc0\xd2\x87\xfd\x5b\x17\x2c\xe1\x13\x9e\x90\x8c\x8a\x69\x49\xa7\x8c\x4c\xe5\x9c\x29\x61\xc9\xa3\x60\x2a\xe7\xda\x6e\xa7\x26\x54\xa4\x5f\x7c\x49\x32\x9e\x73\x03\x54\xa4\x57\x17\x65\x5f\xb3\xcf\xb3\xf5\x05\x2d\xb8\x27\xa7\x0b\x42\x0b\xce\x3e\x1a\x26\x60\x36\xc3\xfb\xbf\xe8\x21\x97\xe7\xf3\x97\x5f\xdc\x73\x91\x5e\x90\x57\xa5\x36\x32\x7f\xcf\xb4\x2c\x55\xc2\xae\xd8\x84\x0b\xa0\xfc\x2f\x72\x66\x68\x4a\x0d\xbd\xf8\x82\x90\x8c\x8e\x59\xa6\xed\xff\x88\xdd\xd0\x0b\x72\x94\xd0\x9c\x65\x83\xfb\xa3\x2f\x08\xa1\x42\x48\xbf\x32\xf7\x0b\x38\x92\x32\xcb\x98\x1a\x4c\x99\x18\xde\x97\x63\x36\x2e\x79\x96\x32\x05\x6f\x0e\xf3\x9a\xbf\x18\x7e\x3d\x7c\xf9\x05\x21\x89\x62\xf0\xf8\x1d\xcf\x99\x36\x34\x2f\x2e\x88\x28\xb3\xec\x0b\x42\x04\xcd\xd9\x05\x81\x87\xf5\x10\x5e\xd9\x20\xc3\x2f\xec\x06\xd8\x37\x4e\x95\x2c\x8b\x0b\xb2\xf2\xbd\x7b\x3e\x4c\x8a\x1a\x36\x95\x8a\x87\xbf\x07\xe4\xde\xfe\xde\xff\x3f\xa9\xfe\xef\xb0\xf2\xad\x7d\x25\xfc\x9d\x71\x6d\xbe\xaf\x3f\xfb\x81\x6b\x03\x9f\x17\x59\xa9\x68\x16\x26\x07\x1f\xe9\x99\x54\xe6\x6d\xfd\xca\x01\xe1\xf7\x63\xf7\x0d\x17\xd3\x32\xa3\xca\xff\xfc\x0b\x42\x74\x22\x0b\x76\x41\xe0\xd7\x05\x4d\x98\xfd\xcc\x63\x06\x9e\x1e\x34\x58\xd0\xad\xe2\xc2\x30\xf5\x4a\x66\x65\x2e\x2a\xd8\x29\xd3\x89\xe2\x85\x01\x5c\x5a\xbe\x03\xa0\x49\x31\xa3\x9a\x7d\xe1\x4e\xef\x3f\xb4\x14\xb7\xd4\xcc\x2e\xc8\x50\x1b\x6a\x4a\x3d\x6c\x7e\xeb\x90\x7b\xdb\xf8\xc4\x2c\xec\x9c\xec\xd9\x12\xd3\x4d\x6f\x31\x3c\xb7\x2c\x82\x3c\xcc\x78\x32\x03\x1a\x76\xef\x7d\xa0\xda\x6d\x24\x4b\x57\xdf\x1e\x68\x69\xb8\xb2\xd5\xad\xb9\x5c\x4e\xdb\x33\x49\xa9\x61\x5d\xe6\x91\x51\x6d\xc8\x89\x62\x83\x53\x6d\xa8\x5a\x3b\x23\x8f\x0f\xff\xfd\xa5\x69\xcd\x63\xd4\x7a\x6a\xfb\x5c\xdc\x9b\xe1\xad\xec\x23\x4b\x4a\xb8\x2b\xd2\x52\xc1\x52\x37\xbe\x7b\xe9\x07\xee\xd5\x57\xed\x0f\x31\x3b\x22\xca\x7c\x6c\xaf\xc5\x49\xe3\xe5\xd4\x18\x96\x17\x46\x6f\x7c\xf9\x84\xf2\xac\x54\x6c\xa8\x58\x62\x99\xd6\x62\xe8\x9f\x68\xef\x47\x1b\x8a\x9b\x8c\xa5\xc5\x29\x53\x5f\xd4\x3f\x9b\xbf\x74\x44\x9e\xcc\x58\x4e\x2f\xfc\x8f\x65\xc1\xc4\xe5\xed\xcd\x8f\xff\x36\x6a\x7d\x4c\xda\xf3\x87\x33\x65\x59\xba\xdd\x40\xf7\xcb\x8a\xbf\xba\x93\x45\x2e\x6f\x6f\xaa\x67\x0b\x25\x0b\xa6\x4c\x75\x88\xdd\x68\x30\xbb\xc6\xa7\x4b\x6f\x3a\xb6\x93\xf1\x37\x6c\x6a\xb9\x1c\x73\x2f\xf5\x87\x8e\xa5\x7e\xfe\xee\x36\xe4\xf6\x12\xb3\x97\x01\x13\xa6\xb9\x1f\x61\xc8\x89\xbd\x75\xe4\xf8\x1f\x2c\x31\x43\x32\x62\xca\x82\xb1\x0c\xa0\xcc\x52\xcb\xff\xe6\x4c\x19\x62\x71\x3b\x15\xfc\x9f\x15\x6c\x1d\x24\x9d\x8c\x1a\xa6\xcd\x12\x4c\x38\xe4\x56\xe2\x98\xd3\xac\x64\x67\xf6\xde\x80\xcb\x5a\x31\xfb\x16\x52\x8a\x06\x3c\xf8\x89\x1e\x92\x37\x52\x31\x90\x50\x2e\xe0\xaa\xd6\x17\xe7\xe7\x53\x6e\x02\x93\x4f\x64\x9e\x97\x82\x9b\xc5\x79\x43\x4a\xd2\xe7\x29\x9b\xb3\xec\x5c\xf3\xe9\x80\xaa\x64\xc6\x0d\x4b\x4c\xa9\xd8\x39\x2d\xf8\x00\xa6\x2e\x80\x97\x0f\xf3\xf4\x4b\xe5\xaf\x05\x7d\xdc\x9a\xeb\x0a\x55\xba\x01\xac\xf3\x91\x1d\xb0\x6c\xd4\xee\x35\xf5\x8f\xba\x55\xd4\x88\xb6\x1f\x59\xec\xbc\xbf\x1e\xdd\x91\xf0\x6a\xd8\x8c\x65\xec\x03\xde\xeb\x07\x75\xbd\x05\x16\x61\x5c\x4c\xe0\x7a\xb5\xf2\x91\x92\x39\xc0\x64\x22\x2d\x24\x17\x06\xfe\x48\x32\xce\xc4\x32\xfa\x75\x39\xce\xb9\x71\xc2\x0b\xd3\xc6\xee\xd5\x90\xbc\x82\xcb\x8d\x8c\x19\x29\x0b\xcb\x01\xd2\x21\xb9\x11\xe4\x95\xbd\x2d\x5e\x51\x2b\x52\x1d\x78\x03\x2c\xa6\xf5\xc0\x22\x16\xb7\x05\xcd\x4b\x7b\xf9\xc7\x0e\x6b\x8d\x2f\xc2\xfd\xb9\x61\xbf\xe0\x6c\x8e\x0a\x96\xb4\xce\x4b\xca\x34\x88\x76\x96\x8f\x30\x7b\x0e\xea\xab\x32\x8c\xf5\xa7\x14\x26\x42\xf5\xfd\xca\x87\x84\x70\xc3\xf2\x35\x1f\x2f\xcd\xe7\x8e\xea\x7b\x32\x18\xac\xf9\xd9\xe6\x17\xba\xe1\xa5\x8f\xf5\x5f\xae\x5b\x35\x53\x9b\x5f\x86\x79\xa1\x1d\x74\x02\xa2\xd4\x62\xf3\x2f\x96\xde\x7c\xe9\x1f\x70\x47\x04\x84\x1a\xe0\x33\xe1\x63\xcb\x43\xd2\x32\x6b\x6f\xf8\xea\x50\x65\xc6\xf4\xf0\x91\x9f\x60\x26\x6f\x87\x90\x29\xbb\x44\x2c\x62\x65\x21\x57\xf0\xc7\x98\x69\x00\xb1\x6e\x01\x6e\x92\x5b\x60\x92\xea\x26\x28\x64\xfa\xd8\x82\x62\x16\xe5\x7e\xcb\x26\x4c\x29\x96\x5e\x95\xf6\xf8\x8c\xaa\x69\xdd\x4c\x85\xac\x3e\xbe\x0e\x97\xe9\x76\x80\xcb\x84\x6a\x95\x03\x07\x94\x29\xf2\xc0\xb3\xcc\xbf\xd2\xb2\xff\xf0\x05\x02\x28\xb1\x0b\x87\x3b\xc3\xe2\xd1\x1e\x3f\x6a\x88\xa6\x86\xeb\xc9\x02\xf0\x52\x61\xd6\x2b\x2e\x96\x6b\xa0\xe0\x7a\xd5\xc5\x69\x68\x5e\x91\x64\x59\x7a\x46\xc6\xa5\x21\xdc\x00\x17\x4d\x66\x52\x5a\x6d\x10\x5e\x8e\x82\x0a\xf3\x9b\x73\x09\xb7\x5b\x53\x4d\x0f\x1a\x66\x63\x9a\xdb\xf6\xd3\x0d\x10\x6f\x2c\x0d\x01\x68\xae\x49\x2e\xb5\xa9\xf7\x2f\x08\x0e\xf6\x55\xa0\xef\xe2\x66\xc9\xc8\x14\x04\x55\x6d\x88\x2e\x73\x3b\xb9\x07\x66\x55\x79\x7d\x46\xf8\x90\x0d\x81\xec\x18\x4d\x66\x91\x2b\xcf\x19\x33\x9a\xd0\x2c\x0b\xcb\x6d\xd2\xbb\xd3\x88\xc1\x26\x81\x82\x78\x52\xdd\x7f\xfe\x3e\x3a\xab\xb4\xea\x65\xaa\xad\xc8\x00\x05\xb8\xb1\x07\x67\x84\x99\x64\x78\x7a\x06\x66\x83\xd2\xd8\xbd\xb6\xf8\x18\x2f\x2c\x43\xb6\x62\xe8\x16\x4e\x53\xaf\x5e\xc9\x72\xea\x84\x70\x96\xb9\x45\x56\x62\x14\x10\x16\xc8\x32\x56\xa1\x41\x42\x3c\x72\x1b\x72\x14\xe4\x25\x3b\x2d\xee\x90\x0a\xe4\x90\x53\x93\xcc\xfc\x85\x94\x48\xa5\x98\x2e\xa4\x40\x43\x87\xa7\xaf\x6b\x3c\xfc\xb5\x02\x7c\xa2\x4f\x81\x90\xe0\x83\x19\x9f\xce\x56\xa5\xb4\xf5\xc3\x4e\x90\x2a\x67\x27\x68\xd3\x28\x86\xd0\x37\xde\x7f\xab\xa3\x7d\x63\x08\x62\xe5\xf3\x45\xe3\x44\x34\x68\xce\x30\x95\xa3\x60\x92\x0a\x9f\x40\xbc\x20\x26\x68\x87\x07\x30\x83\x24\xdc\xf8\x13\x42\x5e\x90\x13\x7b\x44\x90\x60\xb9\x39\xd6\xc0\x40\x06\xb2\x38\x1d\x92\x4b\x50\xeb\xf7\x36\x59\x21\xab\xb9\xc2\xa4\xe0\xde\xcc\xb4\xac\xdf\x88\xe3\xb1\x11\x37\x47\x78\xc2\x2e\x80\x89\x84\x61\x9f\x58\xde\x37\x47\xc5\x9a\x65\x2c\x31\xf6\x8e\x63\x2a\x3f\x23\x54\x6b\x99\xf0\x86\x02\x8d\x19\x15\xb5\xb6\x8e\x81\xdf\x2e\xec\x3e\x75\x41\x02\x59\x73\x90\x62\x9e\x5d\x41\x49\xc6\xb5\xb1\x5c\xa3\x8d\x9a\x68\xc6\x59\x8f\xf1\x02\x60\x1d\x6b\x6f\xbe\xc2\x63\x83\xc4\x9d\xc9\x8d\x4b\xda\xb8\x94\x48\xb0\xc4\x6b\x4d\x15\xbd\xd8\xeb\xc6\x6a\x14\x94\x0b\xed\x75\xc1\xb3\x68\x98\x94\xdc\xb3\x85\x53\x33\xad\x2e\x5b\x58\x96\x1f\x80\x2b\x06\x97\x78\x34\x4c\x4b\x89\xf7\x6c\x01\x40\xbd\x8e\x1a\x09\xa3\x1b\x25\xba\x71\xcf\xb6\x0a\xaa\xeb\xc6\x8a\xf0\x06\xf4\x02\xeb\xb0\xc8\xe8\x00\xd1\x21\xa2\xda\x2f\xb0\x81\x33\xd0\x28\x3b\x00\xdb\xa0\xea\xe1\x46\xd8\xd6\x9d\xf1\xf2\xbe\x52\xb6\x1d\xe1\x1c\xc7\x13\x87\x1d\x40\x58\x96\x57\xcc\x78\x61\x6f\x77\x4b\xd5\x70\xec\xbb\x51\x8b\x1b\x3f\xd2\x8c\xa7\xd5\x52\x35\xdc\xc4\x37\xe2\x8c\xbc\x95\xe6\x46\xc4\x1f\x0c\x3b\xae\x3f\x72\x6d\x05\xc2\x2b\xc9\xf4\x5b\x69\xe0\xcf\x21\xf9\xce\xc0\x79\xe9\x04\xf1\x87\x08\x76\x5c\x8f\x9d\x36\xdf\xe1\x74\xe7\xad\xbf\x14\x84\x2a\x45\xc1\x51\xd4\x71\x26\xf5\x6c\x86\xe4\xc6\x09\x72\x15\xc3\xe1\x9a\xdc\x2c\xdb\xd7\x70\x43\x2a\xbf\xc5\xce\x92\x07\xf0\xfd\x54\xf3\x12\x29\xb9\x2d\x8f\xb1\x95\x04\xc5\x00\xe4\xaa\x95\xb9\x76\x82\xc8\xb5\xa7\x26\x3b\xdf\x26\x3d\xc1\xb4\x77\x40\x65\x63\xa9\x76\xda\xd5\x94\x3b\x33\xac\xe6\x96\x7c\x67\xec\x74\x7f\xd8\xeb\x24\x67\x74\x0e\x4a\x06\x17\x53\xa4\x02\xbc\x3c\xbc\x7a\x71\xe6\xcd\xfe\xa0\x5b\x8f\x99\x33\x9d\x16\x8a\xc5\xc9\x4e\xf5\xa0\xda\x5e\x81\xde\xb2\x3d\x24\x77\x56\x71\x71\xf3\x76\x86\xe0\xcc\x7b\x68\xe2\x47\x0a\xca\x9a\x33\x79\x52\xc3\xa6\x3c\x21\x39\x53\x53\x46\x0a\x2b\x3b\x75\xe1\x08\x9d\x04\x13\x37\x76\x62\x26\xe1\x71\xc0\x4b\xe4\xd3\x41\x75\x8d\x9d\xf6\xc0\xde\x34\xd1\xcf\x74\x3c\xad\x1b\xac\xa4\xd8\x07\x63\xf1\x02\xc2\xf3\x6b\xab\x1d\x3f\x5b\xb9\x19\x74\xf7\x5e\x6e\x5e\x1a\xbd\xdc\xec\x47\x2f\x37\xaf\x1f\xbd\xdc\xdc\xcb\xcd\xbd\xdc\xdc\xcb\xcd\xbd\xdc\xdc\xcb\xcd\xa4\x97\x9b\xb7\x3c\x18\x87\x97\x0e\x2f\x73\xb6\xf0\x8e\xa6\xfa\x9f\x9c\xdf\xa3\xb6\xcd\x3b\x8b\x3b\x08\xef\x71\xd4\xb0\x6a\xa4\xb7\x62\xee\xc8\xcb\x3a\x77\xe0\x02\xe0\x22\x92\x6b\x28\x2a\xa6\x8c\xbc\x1c\xbc\x7c\xf1\x02\x7f\x26\x5c\x3c\x33\x44\x72\xfd\xdb\xbf\x46\x62\xbe\x19\xfd\xb5\x75\x72\x51\xd4\x3b\x68\xf8\x55\x90\x0f\xb8\x9d\xc5\xf9\x28\xe3\xe8\x26\x86\x34\x37\xf9\x65\xf7\x14\x4d\xe0\xaf\xd2\xca\xd7\xdf\xd4\xec\x6a\x47\x3e\x0a\x09\x2d\x67\x3f\x48\x7b\x42\x1a\x92\x33\x43\xa8\x69\x39\xe3\x78\xce\x70\xe2\x9f\x8f\xce\x70\x17\x8a\x8f\x94\x0a\x11\x0e\x29\x91\xc2\xfb\x6f\x2d\xa5\xa3\x6f\x58\xc4\x6a\xdb\x2b\x41\x41\x4d\x18\xd5\x10\x84\x3f\x66\xd5\x8a\x65\x6e\x67\xcf\x85\x09\xd7\x8d\x5d\x4a\x15\x44\x89\xf3\xd3\xb3\xe1\x74\x48\xd2\x12\x40\x53\xe1\xc3\xc4\x4e\x9d\x64\xa5\x17\xda\xb0\xdc\x45\xdc\x2b\xfb\x0f\x0a\xa4\x45\xa3\x51\x0b\x0b\x90\xcd\x99\x30\x25\xcd\xb2\x05\x61\x73\x9e\x98\x0a\xdf\x10\xd7\xc6\x8d\x8b\xb0\xc1\x9c\xfc\x58\xf5\x70\x99\x37\xa1\x6f\xce\x25\xe5\xc7\x1d\x8d\xe1\x26\x3b\x05\x9e\xfb\xd8\x39\x0c\x5d\xe4\xaf\xfd\x2f\x50\xef\xbb\xf7\x38\xff\x3a\x89\xbf\xfe\x97\x2d\x12\x65\x96\xd9\x3d\x74\xee\xf6\x6e\x4b\x70\x8b\x58\xe3\xbe\x76\xcb\xea\x68\xb1\x71\xb1\x26\x2e\xfa\xe0\xf2\xed\x95\xc5\xb5\x85\x76\x27\x0b\x99\xc9\xe9\xa2\xb9\x87\x31\x33\x5d\x14\x0c\x9c\xff\xfe\xe4\x51\xa2\xcb\xb1\xd7\x38\x21\x99\x66\x89\x3c\x7a\x4f\x73\xef\x69\xde\x3c\x7a\x8b\x99\x1f\xbd\xc5\x6c\xfd\xe8\x2d\x66\xbd\xc5\xac\xb7\x98\xf5\x16\xb3\xde\x62\xd6\x5b\xcc\x48\x6f\x31\xdb\xf2\x60\xef\x69\x86\xd1\xcb\xcd\xbd\xdc\xdc\xcb\xcd\xbd\xdc\xdc\xcb\xcd\xbd\xdc\xdc\xcb\xcd\xfb\x99\x64\x2f\x37\xf7\x72\x73\xd4\xf8\xfd\xca\xcd\x1d\x5e\x16\xf7\x9a\x18\x94\x0f\x56\x1d\x32\x68\x2f\x26\x6a\x09\xe8\x9f\x16\x32\xdd\x31\x29\xbc\x90\xe9\x0e\x39\xe1\xce\xe1\x96\xc8\x41\x26\x13\x6a\x7c\x19\x2c\x0b\xd2\xf9\xce\x89\xa6\xb9\xf3\x37\x9e\x91\x7f\x4a\xc1\x5c\x8e\xeb\x56\xa8\x54\x3b\x6f\xa0\x84\x3a\x50\x85\x4c\x4f\xf4\xe9\xd6\xfc\xc1\x3e\xe7\xbc\xcf\x39\xef\x73\xce\x11\xe3\x77\x91\x73\x3e\xa3\xda\x9d\x0d\x10\x6c\x50\x20\x37\xa6\xa9\x37\xb9\xa8\x65\xe6\x7f\x45\x8b\x70\x9b\xb2\xd4\x3f\x51\x06\xba\x3d\x2c\x9e\x90\xa1\x56\x49\x4d\x88\x6e\xed\x58\x91\xcc\x85\x59\xb1\xf4\xb6\x8d\x17\x6f\x17\x82\x85\xd1\x34\x65\x29\x29\x98\x1a\xa0\x8f\x08\xb1\x7b\x39\xe1\x22\x5d\x83\x95\x80\x49\x1c\x4f\x8c\xcf\x11\x6f\x2f\xa4\x63\xe0\x41\x33\x7e\xa2\x75\x6d\x42\xc6\x78\x84\x08\xb4\x1c\xbf\xf6\xa9\x32\xc6\xc1\x6e\x13\xc4\x98\xdd\x8c\x91\x60\x01\xfa\xb5\x64\x6a\x41\xe4\x9c\x29\x67\xa7\x88\x14\x28\xe5\xa4\xaa\x33\xa5\x7d\xf4\x1d\xd7\x24\xa1\x1a\xa2\x6c\x22\x6d\x1d\xdd\xed\x62\xbb\x45\x37\x90\x65\xe4\x2c\x83\x03\xdb\x64\x07\x6d\x22\xd8\x7b\x1d\xaa\xd7\x1a\x7c\xbb\xa8\x47\xcb\xc1\x2e\x8d\xd0\x95\x78\xab\x6b\x57\x55\x6b\x2d\x35\xed\xc5\x10\x4c\x0e\x64\x0c\x26\x07\x32\x08\x93\xbd\x18\x85\xc9\x8e\x86\x61\xd2\xdd\x38\x4c\x96\xb7\xd3\xae\xc4\x4b\x69\xb0\xaf\x1d\x61\x92\xca\xbe\xbc\xd6\x56\xdc\x19\x6a\x37\x1b\x33\xd9\xdd\x3a\xb0\x9b\xad\x99\x2c\xa3\xb9\x22\xbf\xba\x0e\x5f\x67\x9c\x78\x83\xf5\xaa\xf1\xb9\x33\xc4\xb6\xd1\x7a\xd9\x00\xdd\x7d\xa2\x2d\xc3\x75\x30\x1b\x76\xb5\x38\xdb\xd1\xb2\x5f\x7f\x2a\xc2\xe8\x6e\x87\x26\xcb\x64\xe1\x8d\x8a\x1c\x0c\x76\x5d\x4c\x51\xf5\xa8\xac\xd9\x9b\x2c\xd3\x9d\x21\x83\x45\x7b\xad\x75\x7a\x07\xca\x68\x9a\x7a\x5b\x16\xea\xee\xac\x62\xc9\xe4\x5b\x59\xa9\xf7\x42\x6d\xab\x46\xf9\xce\x60\x97\x2c\xdc\xb5\x79\x76\x97\x1d\x0a\x66\xdd\x35\x26\xda\xee\x13\xdd\xcd\xb4\x4b\x76\x33\xef\x92\x7d\x9c\xd5\x5d\xcc\xbc\x64\x07\x53\x2f\xe9\x68\xee\x25\xbb\x98\x7c\xc9\x2e\x66\x5f\xb2\x13\xae\x40\x8c\xfe\xa1\x51\xf9\x3c\x66\x34\xab\x77\xef\x22\x15\xed\x44\x2d\xab\x5a\x81\x5b\x8e\x93\x4f\x73\x5a\x74\x98\x90\x9c\x90\xff\x6b\x85\x50\x60\x1b\xff\x8f\x14\x94\x2b\x6d\xd5\x53\xe7\x32\x6a\x7e\xd7\xc5\x73\x22\x6a\xdb\x81\x9f\x6a\x4e\x0b\x3b\x5d\x4b\xb3\x73\x9a\x75\x13\xc8\x5d\x6a\x86\x37\x13\xd9\x15\x2c\x6b\x48\x5d\xa4\xf1\x87\x99\xd4\x4e\x6a\x76\xe6\x26\xae\xc9\xd1\x3d\x5b\x1c\x9d\xed\x7a\x43\x59\x40\x37\xe2\xc8\x89\xf9\x2b\x6e\xd3\xa0\x47\x74\xd9\x3a\x91\x2d\xc8\x11\x40\x3b\x5a\xcd\x39\xb0\xa2\x4d\x07\xa0\x1d\x15\xb7\x1d\x5d\x39\xd1\x0f\x8a\x50\x68\x7f\x97\x10\xa8\x1a\x48\x65\xcd\x8e\x31\x07\xae\x9b\x4d\xad\xa5\x8c\x76\xd3\x2f\x8c\x24\x27\x55\x19\xc7\xa9\xa5\x10\x73\xfa\xd7\x76\xde\x4a\x24\x44\x50\xff\x73\x46\x85\x26\x47\xc1\xcb\x72\xac\xeb\xb9\x1f\x45\xc1\xeb\x74\x6b\x76\xe6\x7e\xdd\xb8\xbe\xf1\x49\x33\xdf\xc7\x2a\xa2\x4b\x36\x51\xef\x91\xf2\xe5\xe0\xc7\xac\x76\x57\xc5\xaa\x09\x27\xc1\xe2\x77\x6a\x77\x51\x48\xd3\x00\x45\x4e\xa8\x30\x7c\x50\xfd\x22\x12\x74\x65\x07\x04\xa3\x76\x48\x68\x5d\xa5\xc7\x48\xb0\xc1\x0d\x57\x79\x7b\x6a\x5a\x3f\x23\x0f\x33\xa6\x76\xc0\x06\xd7\xbe\x14\x79\x4a\xa8\x26\xaa\x14\xd0\x6b\x45\x0a\xef\x30\x72\x2c\x39\x12\xa6\xab\x41\xef\x8d\xd6\xce\x0c\x04\x98\x01\xdd\xbf\xa6\x87\x2e\xb2\x43\x70\x9f\x41\xb3\x00\x9f\x35\x26\x85\x0f\x8c\xa0\x22\x16\x66\xf0\xf0\xc0\xbe\xb0\xd4\xed\x1b\xaf\xf0\x30\x24\xd7\x1d\x0e\x79\x63\x89\x16\x96\xa5\x30\x9a\x65\xf2\x21\x96\xa7\x77\x3a\xa9\xf1\x92\xe8\xa0\xd3\x9e\x3c\x75\xd2\xf8\xc3\x01\x93\xc6\x97\x1c\x0b\x7f\xf8\x9c\xf1\x36\x3a\x90\x4f\xf5\x89\xe3\x7d\xe2\xf8\xb3\x4a\x1c\x07\x00\xee\xf6\xd9\x90\x41\x8e\x02\xea\xb2\xcc\xd1\x19\xe4\x28\x98\x55\x96\x39\xf9\x69\xc6\x80\xd7\x28\x06\x24\x90\x97\x99\xe1\x45\x56\xfb\xdf\xf1\x81\x88\x99\x33\x63\x4d\x7c\x90\x49\x9b\xc3\xd9\x59\xd3\x64\xd6\xe9\x68\x53\xe5\x43\x0d\x35\xdc\x91\x3e\xb8\x82\x66\x99\xcf\x1a\x0f\x76\x2a\x17\xd1\xc2\x0f\xeb\x1c\xbf\xf2\x6d\x53\xaa\x08\x6a\xb8\xb1\x4f\xac\x40\x94\x59\x32\xc4\x0b\x2b\x9b\xa5\x33\x6f\x31\x9f\xb3\x10\xb6\x30\xe5\x73\x86\x8d\xd5\xad\x44\xb3\x13\x7d\x7a\x1a\x3c\x1d\xbb\x0b\xaf\x07\x10\x5a\xed\xfd\xb9\x2a\x3e\xae\x17\x08\x91\x20\x97\xc4\xc6\xc7\x04\x41\x24\xc4\xff\x6c\xc8\x26\xff\xb5\x5d\x04\x44\x42\x75\xbc\xa1\x12\xfe\x6a\x42\xe2\x71\x2b\x8e\xf7\xce\x75\x74\xd6\xa3\x1c\xf5\x6d\xd7\x7b\x84\xf4\xb2\x83\x93\xfe\x93\x97\x1e\x58\xeb\x98\x07\x66\x18\xaf\x0a\x3c\xe6\x94\x27\x77\xd1\x01\xe1\xfb\x70\xc8\xef\x23\x21\x6b\x8f\x8e\xf8\x3e\x23\x0b\x33\xf6\x91\x91\xb5\xec\x70\xef\xe8\x24\x20\x9b\x9d\xed\x9f\x75\x62\xd6\xbe\x1c\xe5\x1b\x9d\xe4\x1d\x22\x9f\xdc\xd8\xec\x20\xef\x68\x0d\x26\xeb\x9d\xe3\x3b\x3b\xb9\x9f\x43\x92\xd5\x9e\x1c\xdb\x87\x71\x6a\x6f\x76\x68\xef\x30\xd5\xb5\xce\xec\xcf\x2e\xdd\xea\x6e\xb5\x81\x24\x6e\xac\xe4\x17\xed\xcb\x11\xdd\xe7\x17\xa1\xc7\xef\x37\xbf\x68\x07\x07\xf3\x3e\x9c\xcb\x9d\x29\x61\xab\x53\x79\xc9\x45\x1c\x2b\xcb\x6c\x76\x28\xaf\x71\x0f\x47\x9b\xe5\x97\x9c\xc9\xfb\x77\x0d\x1f\xc4\x2d\x7c\x10\x97\xf0\x76\x77\x70\x17\x25\xa0\xd3\x19\xea\xf0\x50\x17\x87\x2e\xd2\x99\xdb\xf8\x2a\xd2\x54\xbf\xd6\x91\xbb\xde\x35\x1b\xe3\xbb\x6e\x15\x1f\xdc\x9f\x5b\x36\xfa\x1e\xe9\xc4\x33\xe2\x79\x63\x27\x37\xec\x41\x5c\xb0\x07\x72\xbf\x1e\xc0\xf5\x7a\x28\xb7\x2b\xca\xe5\xea\xd8\x51\x04\xd4\xad\xee\xd6\x60\x3d\x8b\x3a\x7f\x8f\xb8\x5a\x97\x9d\xa7\x11\x70\xd7\xbb\x59\xd7\x38\x4e\x23\x60\x46\xbb\x58\xa3\x4f\x5e\xac\x1f\x2d\xd6\xad\x7a\x30\xb7\x58\x54\x22\xb2\x30\x7c\x1f\xc9\xc8\xcd\x73\x8c\x6d\xb3\x4d\x42\xab\x6d\xef\xc9\xa1\x73\xc9\x53\x52\x94\xc6\xb7\xb1\xdf\x94\x95\xbc\x15\x68\x9d\xb5\xdc\x67\x25\x37\x66\xba\x25\x2b\xb9\xb5\x85\x7d\x6a\xf2\x1f\x3a\x35\xb9\x49\x0b\x28\xe8\x7d\x7e\x72\x9f\x9f\xfc\xf8\xe8\xf3\x93\xc3\xe8\xf3\x93\x37\x8c\x3e\x3f\xf9\x91\xd1\xe7\x27\x47\x8e\x3e\x3f\xb9\x39\xfa\xfc\xe4\x4d\x13\xea\xf3\x93\x9b\xa3\xcf\x4f\xee\xf3\x93\xd7\x8c\x3e\x3f\xb9\xcf\x4f\xee\xf3\x93\x91\xa3\xcf\x4f\x8e\x1d\x7d\x7e\x72\x9f\x9f\xdc\xe7\x27\xf7\xf9\xc9\xbb\x3c\xd8\xe7\x27\xf7\xf9\xc9\xdb\x9f\xea\xf3\x93\xfb\xfc\xe4\x3e\x3f\xb9\xcf\x4f\xee\xf3\x93\x97\x47\x9f\x9f\xfc\xd9\xe6\x27\xb7\x5c\xf7\x7f\x8c\x24\x65\xc4\x92\xc3\x72\x50\x40\xdd\x92\xb1\x99\xca\x48\x9f\xb8\xdf\x57\x5c\xa6\x32\x2e\xce\x62\xb9\x1f\xf6\x63\x99\xca\x51\x3b\xd4\xee\x87\xbd\x29\x53\x19\xb7\xf0\x66\x36\xf3\xb6\x4c\x65\x1c\xc4\x35\xd9\xcc\x9b\x33\x95\x71\xeb\xee\xb3\x99\x57\x46\x9f\xcd\xdc\x67\x33\x3f\x32\xfa\x6c\x66\xd2\x67\x33\x47\x8c\x3e\x9b\xb9\xcf\x66\xee\xb3\x99\x61\xf4\xd9\xcc\x7d\x36\xf3\x86\xd1\x67\x33\xf7\xd9\xcc\x7d\x36\x33\x76\xf4\xd9\xcc\x6b\x46\x9f\xcd\xdc\x67\x33\xf7\xd9\xcc\x7d\x36\x73\x9f\xcd\x5c\x8f\x3e\x9b\xb9\xcf\x66\xee\xb3\x99\xab\xf1\x7b\xcf\x66\x46\xfd\x6c\x4c\x35\xbb\xc9\xe9\x94\x3d\x86\x05\x14\x6a\xc7\x25\xcf\xd2\x2b\xfe\xa8\x2d\x00\x05\x28\x65\x05\x13\x29\x13\xc9\x16\x91\x0d\xc1\x9b\x91\x34\x81\x41\x3d\xdf\x0b\x92\x72\x3a\x67\x8f\x3a\x35\x5b\x3c\xfa\x8d\xfd\xf5\xa8\x60\x09\x19\x0c\x1e\x79\x06\x6b\xdb\xb2\xac\x2d\x7b\xcf\x0a\xa9\xb9\x91\x6a\xeb\xc5\x81\x3e\x50\x9a\x41\x7e\xfa\xd6\x7b\xb2\xb5\xb6\x1f\x2d\x3f\x1c\xb9\xf4\xdb\x47\x57\x47\x22\xad\x77\x89\x14\x13\x3e\x7d\x43\x8b\xef\xd9\xe2\x3d\x9b\x44\x7b\x90\xdd\xcd\xa2\x9d\x29\x09\xb8\x25\x79\x15\x40\x62\xb8\x51\xac\xa5\x31\xc2\xba\xb8\x92\x85\xea\xae\x04\xcf\xb2\xb1\xac\x32\x9a\x51\xda\x7b\xb2\xd3\x14\x8f\xdf\xd2\xbc\xf2\x17\x41\x0e\x2a\x13\x66\x48\xde\x48\x70\x53\x4e\x24\x5e\x74\x99\x19\x53\xe8\x8b\xf3\xf3\xfb\x72\xcc\x94\x60\x86\xe9\x21\x97\xe7\xa9\x4c\xf4\x79\x22\x45\xc2\x0a\xa3\xcf\xe5\x9c\xa9\x39\x67\x0f\xe7\x0f\x52\xdd\x73\x31\x1d\xd8\x5b\x73\xe0\xf8\x9f\x3e\x87\xcb\xfe\xfc\x4b\xf8\x07\xfd\xda\xbb\x77\x57\xef\x2e\xc8\x65\x9a\xfa\x6a\x09\xa5\x66\x93\x32\xf3\x09\xba\x43\x42\x0b\xfe\x23\x53\x56\x07\xc2\xab\x40\xf7\x5c\xa4\x67\xa4\xe4\xe9\x37\xc7\x87\xda\x2f\x59\x38\xcd\xb7\xd3\x9e\x8d\x40\x3c\x5a\x58\x81\x08\x96\x6c\xb7\xae\xa2\xff\x98\x24\x0c\x6e\x34\xd0\x67\x30\x35\x79\x19\x29\x6a\xd1\x63\x29\x33\x46\x31\xbe\xda\x98\xcb\x1c\x6b\x30\x89\xba\xc0\x35\x4b\x14\x33\xfb\xe3\x39\x0e\xde\x73\x64\x38\x95\x9c\x68\x27\x58\xb3\x1f\x34\x61\x4c\x94\xcc\x87\x84\xbc\xf1\x74\x41\xad\x68\xcc\xd3\x00\xef\x9e\x2d\x7a\x46\xd6\x33\x32\x18\x7b\x67\x64\x23\x47\x63\x52\x59\xee\x84\x5f\xe9\x1f\x85\x8b\xa1\x7f\x6a\x78\xce\x64\xb9\x35\x52\x34\x4a\xfc\xde\xf2\xda\x9c\x19\x8a\x16\x9c\xc1\xb8\x01\x5e\x09\x87\x4f\x52\x0a\xc3\xb3\xea\xf0\x4d\xb9\x99\x95\xe3\x61\x22\xf3\xc6\x39\x1c\x68\x3e\x85\x43\x68\x94\xcc\x32\xa6\x06\x46\xca\x4c\x9f\x17\x65\x96\x9d\xff\xdb\x7f\xfc\xff\x1e\x5d\xea\x94\x19\xed\x5c\x16\x8f\x51\x06\x12\xbd\x1f\x07\x8d\x49\x81\x37\x54\xcd\xd9\xa0\x14\xf7\x42\x3e\x88\x81\x3b\xb2\x17\xc4\xa8\x47\xcc\x09\xdb\x18\x1d\x6a\x63\x70\xf7\x4a\xbc\xa1\x7d\x9f\x54\x51\xc5\xc7\xec\xa8\x28\x2e\x15\x20\x71\x40\xb7\x6b\x5e\x31\x97\x6f\x22\xf3\x60\x31\xdf\x7e\xbc\x63\x18\x87\x25\x59\x26\x10\x61\xdb\x11\xfc\xd7\xc3\x44\x99\x17\xe3\xc1\xa2\x44\xa4\x08\xb0\xb9\x2c\x85\xb9\xa5\x66\xb6\x57\xa8\x38\x69\x21\x02\x20\xfc\x74\x2b\xc0\xb5\xa4\x78\xb7\x28\x10\x6a\x72\xc4\x6c\xa2\x6c\x48\x8f\x5b\x46\x94\x65\xae\x8f\xa3\xaa\xbd\x26\xf7\xfb\xfd\xd9\x35\xc0\x89\x90\x40\xbc\xc7\xab\x8c\xea\xad\x27\x11\x8d\xa4\x84\x16\x74\xcc\x33\x8e\x39\xde\xdd\x1c\x8e\x2d\xc4\xbc\x0a\xaf\x5b\x60\xb6\x3a\x4e\xec\xc7\x1a\xd5\xea\x11\xe5\xfa\x58\x35\x5b\x5d\x2a\xc3\x27\x34\x31\x98\xa5\xb8\xd1\x25\x44\x8b\xfa\xb7\xdc\x44\x79\xcd\x3b\x39\x69\xa6\x4a\x96\xc5\x13\xbc\x67\xee\x04\xf1\x03\xbf\x27\x36\xd8\x60\xd0\x40\x35\xfa\x11\x8f\xb2\x28\x61\x39\xc2\xc3\x19\xe7\x36\xb3\x02\x64\x4a\x1f\x17\x22\xeb\xb1\x4b\xfc\x40\xf4\x76\x44\xad\x1c\xbf\x73\x83\xd6\xa9\x47\xde\x1d\xfb\x55\x14\x62\xd8\x0e\x92\xe1\xec\xc6\x6a\xe2\x98\x4c\x2c\x7b\x89\xdc\xf9\x28\x96\x12\x09\x3b\x8a\x8d\x44\xc1\x8e\x21\xc0\x08\xa6\x81\x67\x17\xd1\x84\xba\x9d\x45\x60\x99\x43\x37\xb6\x10\x2b\x23\xa2\x2a\x15\x2b\x39\xe7\x29\xdb\x1a\x7a\xbb\x4e\xf4\xba\xf5\xcf\x6e\x3f\x30\xe8\x99\x23\xc9\x0d\x09\x0f\x43\x62\x83\x15\xb9\xef\xd1\x1f\x23\x59\xe1\xa0\x42\xec\xa3\x3f\xf2\xcb\xdd\x55\x7d\x3d\x80\xf2\x3a\xea\x55\xd7\xcf\x49\x75\x85\x6c\xbd\x84\x15\x46\xaa\xd8\xbb\xef\xa6\xf1\x28\x84\xa2\x05\x73\x29\xe1\x29\x13\x86\x4f\x38\x43\xd5\x7c\x83\xa0\x3b\x35\x1d\xd2\x82\x26\x33\x36\x4c\x68\xce\xb2\xe1\xfd\xf0\xbd\x2c\x0d\xd3\x3f\x48\x9a\x3a\x7b\x31\x04\x69\x15\x8a\x9d\x17\x12\x95\x8c\x53\x28\x99\x30\xad\x03\x8d\x6f\x7d\x02\xad\x6f\x44\x5d\x56\x78\x19\x31\xa3\x62\x5a\x6e\xf1\xf1\xbb\xd1\xda\x85\x1f\xfc\x63\x7b\xd5\xce\x09\xc9\x00\xef\xb1\x73\x71\x9b\xe5\xc2\xef\x1b\xd4\x10\x3c\x17\x5b\x76\x19\x45\x2b\xd4\xb8\xcc\x65\x20\xdc\x02\x3c\x40\x33\x1e\x76\x99\x50\x13\xcc\x01\xcf\xda\x12\xe3\x59\xdf\x62\x00\x5e\x94\x58\x2c\xfb\x0a\xb1\x01\x88\x8b\x23\xab\x62\xc0\xd0\xa5\x09\x3c\xca\x5c\x26\x34\x9f\xc0\xfc\x21\x8c\xd5\xb0\xbc\xc8\xa8\x61\x47\xdb\x03\xe6\x3e\xf5\xa1\xe9\x60\xd2\x02\x53\x56\xea\xf3\x7e\x21\xb1\x8b\x0b\xa0\x50\x8f\x8f\xd4\xb7\x51\xc0\xa8\x8d\x21\x3b\x1f\xa9\x3a\x7d\x0a\xeb\x98\x36\xac\x78\x46\x61\x4c\x08\xcf\x0d\xea\x6d\x73\x99\x95\x39\x7b\x23\x4b\x61\xf6\x2a\xbe\xfc\x58\xc3\xad\x28\x01\x82\xe6\xed\x27\x10\x57\x39\x21\xd4\xff\x6a\xcb\x7e\x3f\x70\x33\xe3\x82\xd0\x10\xfc\xcd\xd4\xe3\x8e\x65\xbc\x3c\x14\x61\x6f\x6e\x2d\xce\x3e\x12\xa6\xe5\x8a\x9c\xf8\x89\x59\xbe\xe9\x02\x31\x71\xac\xc3\x61\xbf\x11\xba\x0b\x33\x62\x69\x70\xab\xbb\x30\x5c\x00\x4e\x8e\x2f\x8e\xb7\x3b\xd4\xa3\x8d\xed\x4a\x16\x74\x4a\x71\x25\x3a\xda\xe9\x10\x4b\x8f\x93\x94\x19\xa6\x72\xe0\x06\x33\xf9\xe0\xbe\xc7\x88\x2d\x56\xe2\x29\x3c\x1c\xe6\x8b\x35\x40\x6f\x00\xa9\x21\x2a\xa1\x81\x5c\x97\x11\x80\x80\xe9\x9c\xe3\x0f\x74\x41\xa8\x92\xa5\x48\x7d\xd1\x07\x8b\x4e\xcd\xcc\x19\x79\xb3\x34\xf9\xb7\x52\x60\xc0\x72\x6d\x05\xa8\xd4\x97\x08\xad\x92\x1e\xc6\xcc\x50\x7b\x5d\xbc\x1c\xbe\x44\x14\xa5\xd9\xfb\x7d\xb9\xea\x31\x05\x27\x37\x84\x15\x03\x26\x43\xd0\x43\x38\x6f\x7b\x9d\xa3\x62\x34\x7d\x27\x32\x84\x90\xde\x36\xf8\x38\x42\x87\xc7\x07\x90\x9e\x61\x6f\x4e\x55\xb2\x33\xf7\xd1\x83\xe2\x06\xbd\xd3\x0f\x5c\x33\x72\x32\xa1\x99\x86\x36\x2a\xa5\xa8\x62\xc3\x4f\x87\xe4\x8a\x4d\x68\x99\x19\x10\x7b\xe1\x27\xd8\xe5\xe3\x54\x1f\x5d\x8e\xf7\xc0\x41\x3c\x23\x00\xe2\x8f\x61\x20\xd5\xe1\x38\xd6\x8f\x30\x93\x06\x0a\x10\x30\x8f\x8e\xc8\x89\x83\x75\xac\x89\x92\xd2\x6c\xed\x2a\x14\x45\x30\x1e\x61\xd7\x1f\x8b\x58\xb1\xf8\xfa\x63\x41\x05\xf4\xd1\xd8\x8c\x3d\xc4\xfa\x2a\xfc\xe2\xb0\x87\x80\xf8\x2d\x9b\xd1\x39\xd3\x44\xf3\x9c\x67\x54\x65\x10\xcc\x39\x72\xeb\x84\x36\x41\x4c\xcc\xb9\x92\x02\x12\xaf\xe6\x54\x71\x3a\x46\xd5\x9b\xf1\x11\x4a\x09\xd3\xe4\x4f\x27\x3f\x5e\xbe\xff\xfb\xdb\xcb\x37\xd7\xa7\xc0\x31\x59\xc0\x45\xa9\x43\x3e\x45\x63\x35\x08\xd8\x8d\x29\xb5\xcf\xc8\x9a\xed\x0f\x6b\xb1\x7b\x86\xe1\xe8\x22\xad\x56\xef\x2a\xec\x84\xd2\x3d\x1f\x93\xac\xd4\x7c\xbe\x4f\x0e\x84\xb3\x62\x0e\xea\xbb\x7e\xcb\xef\x2c\xcf\x7d\x2a\x99\xd2\xa1\xf9\x00\x72\x57\x23\xfd\x9e\x50\x58\x52\x1a\x08\x1c\x24\xa9\xed\x75\x9e\x5c\x97\x26\xba\x80\xc8\xbe\x24\x61\x5a\xbb\xaa\x53\x54\x2c\x1a\x37\xb2\x3f\x7f\x85\xdc\x72\x4c\xf0\x22\x19\x7d\xd0\xd7\x19\xd5\x86\x27\xdf\x66\x32\xb9\x1f\x19\xa9\x62\xaf\xc0\xe3\xcb\x9f\x46\x2b\x30\x5a\x18\x11\xe4\xf2\xa7\x11\x82\x90\xaf\xb8\xbe\xaf\x42\x53\xaa\xbe\x5a\xd4\x18\xab\x7a\xa7\xae\x1e\xc1\x7d\x39\x66\x19\x33\xc7\xc7\x1a\x24\x16\x04\xd4\x9c\x26\x33\x2e\x58\x10\x67\x84\x3d\xcb\x52\x3b\x78\x01\x9b\x71\x21\x89\x98\x60\x44\x6d\xa4\xa2\x53\x76\xee\x69\xee\x4b\xfa\xa0\x99\x43\xd2\xd8\x22\xc9\x7e\xcd\xb6\x07\x00\xc6\xb9\x5d\x26\xfa\x0e\xa5\x57\x92\x95\x2d\x7c\xcd\x33\xe6\x0b\x82\x81\x3a\xed\x0d\x1f\x9e\x86\xed\x4e\xa0\x80\x12\xb2\x90\x25\x79\xa0\x2e\xfb\x15\x58\xc0\x90\xdc\xf1\xe2\x82\x5c\x0b\x5d\x2a\x56\x57\xe1\x98\x54\x2f\x44\x02\x0e\x5a\xbe\x2e\x8b\x42\x2a\x13\x6a\xb2\x79\xb9\xd5\x25\xbf\x5a\xce\xec\x80\x62\x43\x61\xaf\x3f\xd2\xbc\xc8\x98\xbe\x20\x47\xec\xa3\xf9\xfa\xe8\x8c\x1c\x7d\x9c\x68\xfb\x8f\x30\x13\x7d\x34\x24\x37\x79\x91\xf1\x84\x9b\x0c\x9b\x61\xc8\x85\x6f\x19\xe5\x4a\xbf\x39\xb0\x56\xd8\x6a\x48\x49\x1d\x22\x60\xf7\x45\x72\xc8\xd7\xb9\xb8\x57\xab\x57\xa4\x92\x3c\x58\x85\x01\x2a\xc2\x11\xa6\x94\x54\x3a\xf0\xa1\xe8\x4d\x04\x79\x0b\xac\xe2\x32\xe7\xd5\x45\xea\x0f\x28\x2e\x1c\x36\xd2\x9d\x57\x50\x65\x38\xb6\x22\xe2\xf2\xa9\xb8\xb3\xdc\x21\x00\x20\x6d\xe1\x67\xb7\x33\x71\x33\x21\x32\xe7\x06\x6a\xcd\x59\xa0\xa9\x13\x08\x08\xba\x9c\x44\x00\x65\x4f\x81\x9f\x91\xbd\x76\x86\x0d\x8a\x7e\x2d\x95\xff\x0a\x09\xf3\x3c\x65\xf3\x73\x9d\xd2\x97\x67\x30\x65\xed\x03\x81\x4d\x0b\x0b\x54\x93\xa3\x97\x47\xd8\xf3\x35\x0a\x12\xda\x59\x13\x77\x35\xb4\x89\x54\xd5\x6b\xb1\x07\x4c\x93\xa3\x17\x47\xe4\x44\x2a\x98\x65\x42\x05\xc9\x18\x9d\xbb\x66\x76\x95\x6d\x11\x12\x9a\x4f\x87\x38\x9a\x8a\xaf\x57\x1a\x5b\xab\x14\xaf\xb5\x91\x15\x2a\x0c\x01\xd9\x47\x56\x5d\x83\x8e\x83\x13\x09\x36\x63\x91\x46\x54\xe7\xb1\xd8\x79\xef\x67\x51\xa3\x89\x8b\xa6\xe5\xc8\xc9\xa3\xf0\x1a\xec\x06\x6f\x26\x64\x72\x04\xda\xdf\xd1\x27\x64\x76\x31\x1c\x05\x1f\x6c\xee\xde\x78\x73\xd5\x65\x2f\x3f\x08\xfe\x6b\xc9\xc8\xcd\x55\xb8\x61\x0b\xa6\x34\xd7\xc6\xf2\xd6\x94\xeb\x7b\x24\x82\x2a\x19\x89\x83\x68\x45\x4e\x2e\x73\xfa\x4f\x29\xc8\xf5\xb7\x23\x3f\xbd\xd3\xcf\x04\xed\x07\x88\x9d\x08\xfb\x83\xd4\x79\x50\x36\x70\xfa\xcf\x52\x31\x2b\x9e\x46\xca\xc6\x97\xe1\xb9\x65\x79\xd8\x7e\x4e\xae\xa8\xa1\x20\xf4\x62\xa4\x58\xe0\xf6\x52\xd4\x32\x8f\x3d\xff\x63\x2e\x52\xff\x55\x43\x9a\xdd\xb3\x58\x99\xc0\xfd\x3c\x7d\x23\xd3\x4e\xb2\xe5\x7f\xdb\xc9\xbe\x72\x30\x48\x6e\x81\x90\xb7\xd0\x56\xd9\xb2\x23\x62\xf9\x11\x36\x79\x06\x1e\xf8\x49\x71\xc3\x90\x8c\x3d\x52\x58\xb0\x27\xf0\x2d\x3a\xd7\x69\x25\xc9\xab\x99\xec\x94\xda\xad\xb5\xf0\xe2\x4a\x6e\x8f\x33\x39\x26\xfe\x8c\x1d\x6a\x85\x1f\xde\xdf\x74\x5c\xe0\x87\xf7\x37\x6b\x17\x07\xd3\x46\xae\xf0\x80\x8b\xeb\xac\xfe\x2c\x6b\x3f\xb5\x9c\xe6\x93\xed\x90\x6b\xa3\x0d\x89\xd8\x41\xda\xa2\xad\x60\x71\xe6\x74\x1a\x72\xfd\x71\xf8\xb4\x7a\xca\x21\x76\xe9\x9e\x0b\x64\xe4\x5e\x9b\x8d\x5c\x7f\x2c\x5c\x2b\x03\x5f\xa0\x68\x34\xa3\xf6\x42\x88\xab\x27\xed\x4f\x98\xa5\x5d\x6d\xef\xde\x40\x8e\x84\x26\x09\x70\x51\x72\xc5\x52\x0e\x95\x4c\x2e\x7c\x99\xa8\x58\xb8\xeb\xc1\xbe\xa1\x82\x4e\x2d\x50\xb8\x49\x90\x30\x73\xf7\x50\xe3\xbc\x9d\x38\xd3\xbd\xa8\xbe\xa2\x73\xca\x33\x1f\xfd\x8e\xa5\x26\x66\x4e\x87\x41\x50\x03\x91\x4f\x03\x2a\x0f\xc2\x52\x77\x10\x7c\x57\x1c\x09\xe4\xc4\x42\x3b\x07\x57\xc5\xe9\xb0\x92\x66\xb1\xd2\x0d\x53\xcc\x85\x60\x38\xf1\xb9\x25\x10\xfb\x52\x0c\xcb\xf2\x70\xcc\x01\xc0\x0a\x90\x51\x71\xc8\xfe\x42\x42\xfe\xf4\xc3\xfb\x9b\xbd\x0b\x3d\x96\x37\x76\x11\x7a\xec\x73\x6b\x85\x1e\xf8\x62\xc4\xd4\x9c\x27\x98\x73\xf0\x09\xe5\x9e\x9e\x76\x57\x87\xcb\x36\xef\x28\x24\x41\x7f\x06\x2f\x24\x85\x34\xf8\x66\xed\x64\x24\x36\x1c\x1d\x8d\x3c\x8b\xbd\xf4\x2c\x16\xa4\x2f\x4b\x1b\x71\x75\x86\xd0\x9c\x0c\x98\x64\xc7\x85\xc3\x5d\x45\x50\x27\x39\x7a\x66\x31\xfc\xa4\xde\x3d\xcc\x8f\xc3\x82\xf7\xc9\x53\x12\x56\xcc\x26\xd1\x09\x5e\xac\x98\xbd\x1e\xb5\x9d\x2c\xf6\x33\xf2\x7a\x54\xf1\x07\x04\x62\xeb\x28\x07\x4b\x74\xb0\x3c\xed\x9c\x33\xc7\x9a\x64\x7c\xc2\x50\xf1\x77\x71\x3c\x24\x97\x82\xe3\x62\x54\x57\x56\x7d\x1c\x1a\xfa\x5f\x90\x37\x1e\x8a\x2b\x84\x99\xc8\x2c\x63\x89\x79\x3c\x8e\xba\x39\xe4\xc4\xa1\x2b\x4c\xa6\x61\x1a\xa8\x94\x7e\xe6\xed\x86\xc3\xfb\xbf\x80\xda\xef\x15\xfc\x73\xb7\x61\xe7\xef\xaf\x2f\xaf\xde\x5c\x0f\xf3\xf4\xcb\x99\x7c\x18\x18\x39\x28\x35\x1b\x70\x83\x93\x19\xa2\x52\xe2\x3a\x66\x01\x61\xd3\x99\x0a\x54\xf0\x00\x59\xd9\x8c\x77\xa1\xa6\x02\xf9\xa0\x5d\xc5\x3a\x30\x5d\x87\xb0\x0a\x29\x0d\x56\x8b\x55\xd4\xd7\x55\xa0\xde\x8a\x5e\x66\x99\xdb\x1f\xa3\x18\x3b\x6b\x9a\xd0\xce\x9f\x9b\x4c\xd6\x40\xc3\x96\x2b\x2e\x42\xa5\x87\x5b\x0c\x73\xc5\xa1\x55\x9b\xf6\x45\xf8\xd4\xe4\xde\xed\x4e\xc5\x09\x5a\xe4\x91\x1d\x19\x55\x70\x42\xc9\xfc\x62\x9b\xff\xbd\x31\x69\x09\xf5\x3a\xa0\x08\xf5\x44\x2a\x4b\xe5\xaa\x4d\x8b\xcc\x24\x80\x9e\xf3\x52\x33\x35\x74\x93\x46\x02\xff\x24\x1b\x80\xbf\xd9\x61\x29\xc8\xfa\x43\xdb\xd0\xff\x9e\x4d\x5c\x05\x0d\x1f\x4a\x82\x47\x3f\x34\xa3\x2a\xcd\x8c\x09\xe3\xb3\x73\x82\x90\xb4\xb2\x1f\x78\x57\x89\x2f\x54\xfe\xc4\x1b\x10\x9f\x92\x1c\x53\x62\xe8\x60\x45\x86\x3e\x59\x99\xa1\x83\x14\x1a\xea\x52\x6a\xe8\xd0\x49\xb8\xc4\x2e\x0c\xd9\xf6\xe2\x91\xfb\x57\x05\x06\xa7\x68\x2a\x21\x48\x14\x5b\x5f\xcd\xee\x4b\x8b\xad\xd1\x34\xe7\xe2\x59\x33\xa8\x18\x01\x3f\x48\x78\x7b\x15\xd9\xb9\x88\x4f\xa9\x39\x7e\x05\x4f\xb5\x65\x76\x07\x29\x78\x81\x43\x5c\x0f\x02\x63\x34\x68\xfb\xd0\xe9\x2f\xc4\x00\xb9\x08\xa0\x10\x3e\xd0\x31\x86\x67\x79\x9b\xf3\x85\xfe\x35\x1b\xb8\x99\x0e\x8a\xb4\xde\xe7\xe7\x1b\xac\xf3\x7c\xcd\xd5\x9f\x59\x70\xcd\x1e\x69\x81\xf4\x62\xf9\xf2\xe8\x22\x96\xef\x65\x07\x62\x25\xf1\x9d\x25\x41\xe8\xf1\xa9\x43\xff\x24\x57\x3a\x2f\xa6\x01\x81\xb7\x87\x41\x87\x50\xaa\x68\xce\x0c\x53\x2e\x15\xc2\x27\x68\x08\x96\x80\xe1\xf3\x5d\xc1\xc4\xc8\xd0\xe4\x1e\xe9\x0f\xed\xe5\xb2\x5e\x2e\xdb\x30\x76\x89\x6a\x09\xa1\xce\x69\x45\xa3\x3e\x93\x7a\xd1\x88\xfa\xc2\x73\x09\x77\xdc\x9f\x8e\x3f\x7c\x26\x31\x28\x55\x81\xee\x58\xeb\x69\x55\x85\xb8\x2d\x8c\x55\x1f\x23\x43\x19\x7d\xaa\x46\x21\x8b\x32\xa3\x86\xb9\x0c\x52\xe4\xde\xc6\x16\xdd\x82\x3b\xac\x6b\xc0\x49\xcd\x88\x73\x99\x32\x32\xe6\x8e\x17\x97\x1a\x1a\x37\x24\x8a\x45\x34\xaa\x00\x09\xc9\x4a\x43\x7e\x4e\xc3\x76\xfd\xdd\x92\x91\x31\x33\x0f\x8c\x09\xf2\x02\x2b\x75\x89\x94\xbc\xf8\xf7\x7f\xff\xf7\x76\x16\xc8\x8b\x3f\x7f\xfd\xf5\x90\x5c\x71\x05\xdd\x39\xa0\x5f\x0c\xe4\xdb\x60\xcd\x07\xde\xd8\x53\x75\xda\xa6\x93\x89\xf3\x96\x87\x54\x5f\x7f\x57\x47\xf5\x2c\xcb\xa1\x33\xfd\x18\xc2\xcc\x2c\xb9\x64\x3c\x31\xae\xa7\x86\x63\x86\x2e\x55\x5e\xc7\xc4\xc2\xba\x79\x55\xd1\xc3\xb0\x43\x67\x24\xe3\xf7\x8c\x4c\xf4\x77\x4a\x96\xc5\x59\x44\xde\x21\x71\xb1\x70\x56\x95\x4b\xa8\xb0\x33\x75\x13\xab\xf7\x5d\x33\xf3\x6c\x82\x40\xbb\x36\x7b\xbe\x69\xc9\xb7\x67\xae\x85\xf5\x3d\x5b\x0c\x1c\x01\x16\x94\x63\xb5\x5f\x1f\xae\x03\x71\x67\x2e\x9d\xb2\x7d\x3d\x27\x2c\xad\xd9\x05\x12\x26\x08\x87\x63\x08\xbd\xfd\x87\x23\x39\x1e\x7a\xb1\x07\x45\x4f\x7b\x55\x03\x0b\x11\x1a\xbf\x80\xa3\xd0\xab\xf9\xa1\x87\xa9\x2f\x26\x12\x3e\x86\xf5\x47\x44\xaa\x36\x70\x18\xda\x89\xb3\xd4\x02\xd7\xab\xab\x40\x63\xd4\xaf\xb5\x6e\x8f\x63\xcf\xa1\x76\x54\x5c\x8a\xc6\x3b\x62\xf0\xe9\xfb\xdb\x7b\x76\x0d\xf1\xe2\x34\xf4\x42\xad\xdf\x14\xd3\xeb\xd9\x35\x92\x09\x10\x03\x25\x54\x9b\x7d\x16\x7f\x53\x6b\x66\xca\xc2\x4d\x17\xd2\x02\xec\x6a\x99\xd6\x84\xc3\xfe\xe4\x54\xdd\x5b\x6d\xdd\xf3\x62\xec\x2e\xdd\x5a\xe4\x55\x55\xa3\xab\x0e\xe4\x60\x00\xf0\x2d\xea\x43\x82\x36\x9e\x45\x1c\x0f\x87\xc7\x8e\x3d\x4a\x45\xb4\xa1\xca\x73\x31\xfb\x39\x6e\x62\xbb\x14\x75\x2c\xb4\xeb\xea\x68\xc5\x79\xdf\x78\x82\x36\x53\x2a\xd1\x22\x20\xf5\xfb\x83\xc5\x65\x97\xc2\x90\x91\x3d\x7b\x37\x75\xd6\xf0\xc7\x28\xa6\xbf\x5b\xa7\xa2\x8f\x39\x5a\x44\x58\x33\xdf\x47\x04\x85\x08\x90\xc4\x05\xa7\x40\x82\x7a\xc6\xce\x2a\xda\x5d\x92\x0f\xa2\x20\xbe\x68\x48\x09\x37\x13\x97\x47\xdf\xe6\x5d\x51\x27\x35\xac\xbd\x92\xaa\x2a\x76\xd7\x48\xaf\x0f\x97\x7d\x14\x4c\x84\x60\xe0\xaf\xfc\x28\xb0\x18\xf1\xc0\x5f\xfc\x51\x70\x77\x16\x12\xdc\x88\x17\x15\xfc\xb2\x22\x05\x06\x37\xf0\x1e\x6b\x37\x56\xce\x64\xc5\x45\x1d\x0b\x9c\x54\xe8\x8d\xdb\x13\x09\x3d\x2e\x4d\x75\xc8\x87\xe4\x8d\xe7\xc8\x96\xda\x05\xa1\x63\x2d\xb3\x12\x55\x45\xa0\xbd\xb8\x1a\x50\x83\xb5\xbb\xc9\xfa\xee\x99\x51\x20\x81\xab\x57\x20\x1b\xec\x1e\xae\x69\xc7\x87\xf1\x9c\xdf\x2f\xfe\x29\x2a\xc5\xe2\xdb\xd1\x0e\xa2\x3c\xbb\x87\xad\x0f\x8b\xb7\x10\x1d\xc4\x3a\xf4\x49\x2c\x43\x78\xab\x90\xb3\xf5\x20\xc1\xe2\x2d\x42\x91\xf4\x18\xd7\x10\xe4\x10\x7d\x8d\x7c\x57\x23\xdd\xa9\x21\x48\x9c\x19\x37\xc6\xa4\xa2\x79\xac\x31\x65\x74\x43\x4e\x5e\x55\x09\xf9\x21\x08\x11\x6a\x0a\x4e\x68\xc2\x4e\xe3\x3a\xf1\x87\x38\xf1\x90\xeb\x3e\xa3\x22\xcd\x42\xe6\x3f\x61\x1f\x0d\x53\x82\x66\xf0\xd6\x54\xf1\x39\xea\xd6\x38\xb9\xcc\x8a\x19\x25\x13\x46\x4d\xa9\x30\x26\xff\x48\xe3\x0c\xcc\xa3\x4b\x4c\x2c\x3c\x18\x74\x37\xd1\x38\xfe\x51\xcb\x23\xa1\x5a\x82\xc3\x95\x6e\xda\xa1\x86\x96\x34\xc1\x1c\x00\x0c\x7f\x21\x4b\x2c\x44\xe7\x06\x9e\x48\xe5\x4b\x7b\x28\xc5\x12\xe3\x26\x49\x35\x51\x6c\x6a\xf5\x38\x05\xca\x6d\x84\x49\x26\xc9\x4a\xfb\xd8\x41\xf2\x28\x0e\x90\xed\xd2\xc8\x2f\x41\x2e\x72\xd9\xa1\x37\xf1\x4a\x26\x94\x82\xf5\xa2\xaa\xeb\x2d\x1c\xd3\xc7\x95\x6b\x52\x50\xdd\x28\xd1\x40\xb5\x96\x09\x87\x7a\x55\x35\xad\x44\xa9\xbf\x20\xf0\x56\x25\xb3\x5a\xa9\xaa\x4d\x27\xa8\x84\xe2\xb8\xc8\x4e\x5a\x91\x1b\x26\x64\xca\x6e\xcb\x71\xc6\xf5\x6c\xb4\x83\x9f\xe9\xed\x1a\x30\x2e\x2e\xb4\x63\xcc\x51\xcb\x43\xd5\xf4\x3d\x69\x26\x34\x07\xc1\xd1\x5e\xc8\x56\xe4\xc5\x87\x9c\x5a\x1d\x90\x6a\x1d\xde\xd1\xd8\x35\xf0\x60\xe5\x45\xc6\x0c\x0b\x5f\x21\x61\x36\x56\xee\xcb\xae\x58\x55\xc0\x7e\xfa\x41\x14\xad\xcf\x13\x9a\x65\xc8\x00\x78\xb2\x54\x5c\x2c\x5c\x95\x4e\xcf\x08\x25\x59\x1c\x0d\xf3\x09\xd6\x6b\x26\x03\x56\x1b\xcd\x94\xe0\x78\xac\x20\x3c\xce\x25\xa8\x49\x2e\x5d\x19\x0d\x41\xa4\x08\xa0\xce\x08\xcd\xb2\x00\xb6\x2e\x62\x84\x65\x7d\x8a\xf9\xe3\x86\xc3\x58\xef\x44\xec\x9d\x88\x1b\xc6\x0e\x21\x0c\xa3\xaa\x51\x3e\x6d\x14\xa9\x73\x4e\xaa\x52\xc5\xb0\x9e\x70\x89\x07\x91\x60\x1f\x11\x11\xdd\x6a\x04\x5c\x1a\xa3\xf8\xb8\x34\xd8\x93\xf2\xa4\xcd\x33\xd6\x94\xb1\xaa\xe7\x0b\x82\x29\xd3\x9e\x67\x0f\xbc\xf1\x29\x89\x36\x3c\x7a\x0b\x50\xc5\x61\x56\xef\x03\x2c\x97\x0e\x82\x9d\x95\xe9\xfc\xa3\xc7\x9a\xa4\x32\x29\x73\x26\x0c\xad\xaa\x89\x54\x21\x4c\x48\xb8\x2e\x9b\x34\x86\x0a\x0e\xd1\x6f\x04\x27\xfc\x46\xbc\x3f\x95\x0f\xe2\x81\xaa\xf4\xf2\x16\x91\xec\xdd\x16\xd3\xeb\x27\x9b\x0e\xe3\x00\x90\xd8\xcf\xe9\x58\x96\xa8\xde\xec\x2e\x31\x2f\x24\xdc\xf4\xfe\xe3\xde\x7f\xdc\xfb\x8f\xab\xf1\x64\xfe\x63\xfb\x9c\x53\x11\x32\x5f\x83\xbd\x75\x9a\xa3\x7c\x09\x68\xbb\x71\x77\x97\x59\x83\x03\xb9\x7b\x69\x29\x97\x18\x2d\xee\x34\xd4\x16\x50\x3b\xe0\xe4\xd6\xb4\x53\xab\x3a\x78\x01\xca\x33\x34\x10\xdd\x0e\xe8\x86\x03\xf8\x68\xfd\xd0\x8d\x4d\xc9\x83\x75\x93\xec\x98\x59\xbb\x11\xaa\x14\xc9\xf4\x82\x80\x40\x46\x85\x90\xee\xb6\xd5\x67\x24\xa3\x63\x96\xe9\x18\xa1\x95\x78\x93\x8a\x48\x5d\xf5\xff\x82\x26\x0c\x78\x4e\x75\x6d\x47\x39\x62\xba\xa0\x96\x40\xe7\xc3\x20\x71\xc7\x3d\xb7\x2c\x35\x39\x18\x55\x67\xef\x64\xc6\x72\x1a\xc1\x7a\xea\xf1\xda\xee\x0c\x14\x70\xe5\x9a\x58\xc1\xd4\x30\x57\x70\x8e\xa9\x5c\x13\x39\x89\xc3\x31\xa9\xef\x40\x57\xd2\x6b\x8e\xaf\xd8\x16\x46\x27\x77\x2c\x09\xa4\x8b\x2b\xd1\xbc\x3c\xe3\xe5\x82\xcd\x95\xab\xca\xea\xe6\xf1\x0d\xd3\xc3\xf0\x0e\xa5\x3a\x6e\x02\x98\x9e\xdb\xbb\x27\x42\x4b\xac\x33\x88\x80\x58\x58\xa1\x32\xda\x53\x15\x65\x5b\xe8\xdd\xe8\x31\x40\x7b\x37\xfa\x1f\xd0\x8d\xde\xb8\x4e\x03\x97\xf6\xc8\x73\xae\xf5\x68\x9f\x77\xcb\xff\x01\x7b\xe4\x52\x5a\xbc\x76\xe1\xd4\x84\xb8\x8b\xd5\x3b\xe2\xbd\x17\x9e\x48\xd5\x72\xa7\x1f\x1f\x0f\x87\xc7\x31\xbb\x53\xf9\xe6\xfd\x41\x2c\xcd\x64\xf0\x17\xc2\x44\x22\x53\x47\xec\x76\xde\x2a\x72\x92\x56\x28\xac\xad\x79\xcd\xb0\x84\x3c\xf4\xdf\x00\x87\x7d\x14\x50\x17\xcb\x05\xeb\x8b\x59\x60\x27\x56\x1e\x2a\x2f\xbe\xde\x59\x40\xab\xc5\xb2\xaa\x9a\xa3\x43\x4c\xdc\x51\x0c\xae\x48\x2f\x9f\x55\x8d\xdd\x49\xc6\x73\x0e\x75\x77\x52\xb8\x7d\x98\x8e\x10\x9c\xed\x38\x71\x00\x86\x49\x51\x9e\x79\x60\xc3\x9c\xe5\x52\x2d\xce\x2a\x80\xf6\xcb\x38\xc1\xab\x31\x1b\x0f\xcd\xd5\xd7\x4f\x4a\xa5\x98\x30\xd9\xe2\x89\x25\xc1\x0a\x7f\xf8\xca\x2e\xf5\x58\xca\x78\xac\xbc\xc2\x60\xef\xae\xee\xfc\x68\x79\x65\x52\x15\xee\xd5\x67\x75\x3b\x32\xfb\x29\x13\x73\x32\xa7\x4a\xc7\x9d\xe3\x1d\x84\xb9\x94\xcf\xb9\x96\x48\x6f\x6f\x3d\xa8\x58\xbc\x8b\x3a\x1a\x04\xe4\x9d\x6e\x8c\xbd\xfd\x74\x87\x45\x6e\xb4\x44\x43\xef\xb7\xd2\x14\xa5\xf1\x77\x55\xf4\x56\x7a\x56\x17\x2a\xce\x57\xa7\xb3\x4a\x41\x8e\x3b\x93\xc4\x79\xd6\x8e\x5e\x1e\x45\x3e\x56\x50\x63\x98\x12\x17\xe4\x7f\x4f\xfe\xf6\xd5\x6f\x83\xd3\x6f\x4e\x4e\x7e\x7e\x31\xf8\x8f\x5f\xbe\x3a\xf9\xdb\x10\xfe\xf3\x2f\xa7\xdf\x9c\xfe\x16\xfe\xf8\xea\xf4\xf4\xe4\xe4\xe7\xef\xdf\x7c\x77\x77\x7b\xfd\x0b\x3f\xfd\xed\x67\x51\xe6\xf7\xee\xaf\xdf\x4e\x7e\x66\xd7\xbf\x20\x81\x9c\x9e\x7e\xf3\xa7\xc8\x89\x7e\x1c\xd4\xde\x99\x01\x17\x66\x20\xd5\xc0\x6d\xe9\x05\xb4\xab\x89\x02\x17\xd0\xbd\xdb\xb9\xae\xaf\xfe\xba\x35\x01\xa6\xaf\x4b\x7b\x38\xdd\xe5\x89\x0e\x6e\x37\x75\x23\x2c\xef\xb0\xda\x46\x7c\x5c\xdc\x73\x89\x75\x8b\x00\x0e\xfe\xe2\x2b\x1e\x9d\x35\x7f\xed\x9f\x6b\xa7\x6a\x19\x96\x17\x52\x51\xb5\x20\xa9\x37\xf0\x62\x02\xf3\x56\xab\x5c\x35\xca\x5c\xad\xcd\xae\x8b\x29\xfc\x0c\x2b\x4c\xb9\xda\x77\x5a\x7c\xce\x52\x5e\xe6\x5d\xcc\xf6\x3f\x41\x6f\x09\xdf\xbd\x22\x04\x56\x39\x70\xde\xf7\x80\x24\xa2\x31\x4d\xee\x9d\x2a\x5a\xa1\xdb\xc9\xbb\xcd\xe2\xe6\x47\x51\xe1\x27\x39\xa3\xa2\x72\x22\x40\xf8\x93\x4c\x99\xdd\x8f\x00\xd2\xcd\x33\x4a\xec\x77\x51\xb7\x2e\x32\xc1\x87\xb5\x9e\x78\x70\xa7\x56\xfa\x7f\x03\xe2\x95\xdb\x68\x24\xc8\xa7\x20\x07\xd2\xa1\x40\x0f\xff\x27\xfb\xc1\x4a\xa0\x48\x37\x2a\x5e\xfa\xe8\x22\x73\x74\x90\x34\x96\xda\x4a\x48\x43\x33\x42\x7d\xe5\xba\x09\xc9\x64\x42\xb3\x8a\x62\x23\xc5\x46\xe7\xed\xe6\x9a\x54\xac\x23\xf8\xbd\x2d\xc5\x5a\xcc\x39\xe1\x1d\xdf\x5a\x82\x66\x5a\x86\x1e\xe1\xe3\x8c\xc1\x1b\x9c\xa8\x5e\x51\xe9\x1d\x74\xed\xf8\xc8\xf3\x12\xdb\xf9\xa3\xd4\x76\x6d\x52\xb4\x21\xd5\x93\x7e\x08\x6d\xc0\xf0\x0a\x50\xce\x85\x9d\xc0\x92\x1b\xcd\x1e\xae\x51\xa0\x97\xda\xdc\x83\x84\x09\xe5\x0e\x82\x65\x44\x97\xa0\xab\xfa\x19\x7b\x7d\x4a\x4e\x20\xd8\xa6\xd2\x1b\xb0\x58\x0d\x7d\xa0\x56\x38\x89\xe0\x99\x6f\x91\xe6\x99\x04\xde\x19\xe6\x92\xd8\xdc\xe6\x92\x52\xf8\x90\xda\x15\xd6\xbe\xfe\x28\x97\x9a\xa9\xc1\xb4\xe4\x69\xd7\x43\xfc\xcc\x65\xca\x9d\x25\xc9\x88\xeb\x7e\x92\x44\x5e\xf4\xaf\x5f\xb5\x6f\xf8\xd7\x7c\xac\x18\x79\x35\xa3\x42\xb0\x2c\x46\x10\x7b\xbc\x49\x56\xbb\x42\x8e\x25\x6c\x9c\xd3\x7e\x6d\x8f\xac\x3d\x5f\xf4\x7f\x84\xfa\x37\x4f\x5c\xae\xdd\x05\x9a\x61\x59\x1d\xba\xb9\x13\xb6\xd1\x23\x01\x7b\xca\x13\x36\x77\xca\xca\x4e\x6d\x9d\x6a\x7f\xc5\xeb\x57\xc4\x50\x35\x65\xc6\x82\x22\xa2\xcc\xc7\x0c\xc9\xfc\x9e\x77\xcb\xa0\xcf\xb1\x1c\xd0\x61\x2a\xfa\xb8\xed\xfd\xe9\xa7\xb7\x9d\x2a\xf0\xae\xa3\x94\x07\xa9\xb2\xf4\x81\xa7\x2e\x85\x01\x7b\xfd\x9f\xd8\x29\x9c\x7e\x7e\xe5\x72\x1f\x1e\x78\xba\x3b\xea\x7c\x21\x00\x40\x1d\x01\xdc\xf9\x02\x31\x1c\x2f\x40\x9d\xc0\x54\x4e\xc9\x35\x77\xcd\xa6\xed\x5f\xce\xc9\x91\x8f\xb9\x70\x41\x1e\x72\xd2\xd8\x70\xec\x2d\x20\x52\x38\xfd\xc1\xe1\x08\x0d\xab\xc7\xa5\x73\x46\x8c\xa5\x99\x11\xcd\xf3\x32\x33\x54\x30\x59\xea\x6c\x81\x24\xd4\xe7\xb3\x89\x31\x72\x4c\xc6\x3e\xba\x43\x19\x2b\xcf\x54\x0f\xb6\xe5\x9a\x29\x13\x4c\xf1\x04\xef\x67\x5d\xe9\xfe\x09\xb9\x24\x9a\x4b\xc1\xd2\xf3\x4a\xc8\x71\x4d\x78\x21\x43\x8a\x61\x82\x52\xc7\xd4\xca\x32\x45\x56\x4e\x39\xc2\xe3\xff\xc9\xf3\xa1\xe2\x72\xa1\x9c\x51\xa1\xd2\x03\x63\x6a\x04\x7c\xfa\x94\xa3\xcf\x46\x62\x6b\x68\x6d\x58\xcd\xbc\x9e\x6a\xca\x0a\x26\x2c\xbb\x12\xcd\x93\xe2\x10\x74\x90\x9d\xf2\x91\x03\xcf\x3d\xec\xbc\x71\x4b\x5c\x7f\x34\x8a\x5a\x66\x9e\x5b\x86\xec\xe7\x8f\x15\x25\x26\x84\x0a\x2c\x63\x7e\xba\x94\x86\x5e\x0c\xab\xc6\x33\x2a\xb1\xfd\x14\xe9\x6e\xee\xa6\xf1\x07\x5c\x87\xc8\xa0\x46\x2a\x19\x9e\xb0\xeb\x54\x32\x3f\xe3\x66\x05\xa0\xa5\x94\x32\x24\xd4\xf6\xd2\xb7\xa7\x94\xc5\x27\x92\x6d\xc0\x43\x5f\xa4\xb2\xcf\x2f\x5b\xf7\xc0\x67\x91\x51\x33\xc9\x64\x72\x1f\x5d\x07\xfb\xb5\x7b\x6a\xc9\xda\xe7\x3f\x8c\x2f\x84\xfd\x88\x75\xcf\x73\x99\x86\xa8\x81\x33\xa5\x87\xc9\x58\x76\xa0\xa4\x3d\xf3\xd0\x9a\x8b\x8c\x99\x65\x8a\xaa\x14\xa8\x00\xf9\x48\x99\x99\x1a\xaa\x3b\xb7\x91\x5a\xee\xb3\xa9\x99\x71\xf9\x6a\xd0\x96\x25\x67\x86\xda\x4f\xb1\xee\xe5\xff\xf2\xa2\xb7\x68\xc1\xb3\xe2\x74\xc0\x8c\x4f\x5c\x42\x4b\xa9\x89\x14\x9a\xa7\xcc\xcf\x27\xb5\x3b\x9f\xa0\x53\x86\x62\x1b\x7a\xba\xf9\x7e\xf8\xd0\xa9\x72\xab\x7d\x6c\x09\x93\x9e\x8c\xc0\xa1\xc0\x7f\xc5\xa7\xec\x57\xfa\x3c\x78\x48\x2a\xdc\x79\xa8\xfb\x5f\x7a\xc4\xc9\x9d\x26\xec\xb6\x6a\xb3\xdc\xa1\x89\xef\xf1\x77\xaf\xae\xdb\x00\xda\xc7\xf9\xbb\x57\xd7\xd8\x2e\xbe\x2b\xea\xed\x7a\xbb\xfd\xd2\xd1\x46\x00\xf6\x3e\xab\xb5\x86\xfb\x5d\x5d\xfd\xd3\x84\xd5\x6d\xaa\x53\xae\xef\x9f\x6f\x29\x7c\x4f\xcb\x7b\xe9\xd1\x7f\xc7\x8b\x0b\x72\x2d\x74\xa9\xfc\x76\xb5\xad\xe2\x11\x0a\x12\xc8\x6c\x8f\x2b\xaa\x41\x05\x45\x42\xfd\xec\x4a\xeb\xef\x44\x6f\xc8\x77\x39\x31\x08\xef\xd7\xc0\xea\xf1\x4a\xe6\x4f\xeb\xd7\x28\xa8\x32\xa0\x91\x77\x39\x12\x77\x90\xca\xe9\x01\x84\x45\xef\xe5\x40\x6c\xee\xf5\x8f\xd7\x92\x5c\xd0\xc3\x78\x11\x66\x64\xef\xde\x66\xa7\x88\xd7\x55\xec\x2b\x12\xe6\x79\xca\xe6\xe7\x3a\xa5\x2f\xcf\x60\xca\xda\x97\xab\x32\x2d\x2c\x50\x4d\x8e\xf0\x29\x50\x23\x9e\xf3\x8c\xaa\x6c\xd1\xcc\x11\x69\x40\xb3\xb2\x41\x78\x2d\xf6\x74\x69\x72\xf4\xe2\x88\x9c\x48\x05\xb3\x4c\xa8\x20\x19\xa3\x73\xa7\xb9\x7b\x66\xb9\x70\xaa\x21\xda\x1a\xf0\xf4\x5c\x9d\x3c\x89\xe3\xab\x48\x3b\xca\x85\xc7\x1f\x40\x62\x69\x99\x52\x6f\xaf\xea\x3b\x17\x9d\x0d\xfd\xdd\xab\xeb\xa1\x6b\xf3\xb7\x5c\xca\x3e\x34\x3d\x87\x5f\x3c\x49\x60\x55\xc7\x6d\x7a\xc2\xde\x23\x28\xab\x14\xd6\x10\xbb\xa1\x97\xed\xaa\x01\xed\x79\x9f\x92\xc3\xb5\x84\x76\xa7\x63\xaf\xe2\x31\x37\xef\x59\x81\xb8\xd1\x97\x84\x62\xf7\xd8\x92\xbf\x87\x1b\xfb\x81\xd4\x1c\x19\xa4\x4a\x0d\x14\x43\x56\x86\x27\x65\x46\xad\x9e\xec\xbc\x3d\x43\x72\x75\x7d\xfb\xfe\xfa\xd5\xe5\xdd\xf5\xd5\x05\x09\xef\x42\xdd\x34\xb5\xc2\x35\x24\x77\xb2\x76\x20\xb9\x9e\x07\x3e\x45\x03\x72\x86\x60\xbe\xa8\xd3\x51\xc8\x33\x7f\x75\x51\x51\x07\xad\x41\xf1\x6f\x2a\xc8\x8d\xe0\xa6\xce\xfe\x40\x5e\xb2\x49\x26\x85\xcf\x31\xb0\xf0\xbd\x4f\x6b\xca\xcd\x99\x13\xe4\x7d\x77\xeb\x19\xab\xde\x87\x00\x5a\x95\x23\xbf\x75\xe1\xbe\xd5\x82\x11\xb6\xb7\x48\x15\x3e\x84\xc6\x76\x60\x19\x77\xce\x93\x5e\x81\xf0\x52\xc0\x9b\x90\x02\xe6\x67\x8d\x35\x67\xae\xd6\xf4\x86\x6a\xef\xc7\xc3\xe3\x20\x78\x67\x21\xe1\x13\x09\xd2\x5f\xf9\xf5\x04\x43\xa2\xa7\x45\x6d\x9b\xc6\x87\x84\xbc\x33\x33\xa6\x1e\xb8\x66\x58\xf3\x1a\x5f\x29\x45\x1f\x1c\xe4\xbc\x0e\x2d\x0c\xef\x42\xc2\xac\x67\x54\xe5\x3b\x97\xe3\xe6\x02\x7c\x01\xdc\x29\x9f\xa3\xb3\x68\x61\x5b\x0e\x73\xdd\x84\xc9\x76\xa0\x9e\xf7\xf5\x4a\x3f\xbc\xff\xe1\x30\xd3\x73\x1c\xa3\xc3\xe4\x5e\xc9\x3c\xe7\x86\xcc\xa8\x9e\x55\x85\xa8\xea\xc4\xf3\x8a\xbb\xed\x7f\xd6\x31\x77\x48\x04\x87\x8e\xb9\x47\x5c\xb1\xcb\xe8\xee\xde\xc7\xdf\x85\x07\x97\xcc\x2a\xd5\xc7\xc0\x0a\x31\x5e\x4f\xf1\x58\x8f\xef\x95\xec\x07\x14\x3f\xc5\xb4\x84\xac\x56\x7e\xb8\x6e\x81\x4c\xa4\xae\xab\x58\x17\x09\xed\x3a\x3c\xfc\xb6\xd1\x53\x23\x40\x84\x53\x8e\x55\xe0\x2c\x52\x53\x66\x28\xcf\x74\x63\x7f\x8c\x2c\x64\x26\xa7\x8b\x3d\xb6\xde\x7b\x04\xb5\x5f\xba\x1c\xe9\x01\x1d\xd8\x5d\x3d\x8c\xfe\xdd\xad\x47\x78\x48\x0d\xb7\xe8\xad\xb1\x53\xe9\x8f\x66\xb6\x83\xd0\xfa\xac\x10\x74\x30\x75\xa1\xc2\x5a\xdc\x45\xed\x6c\x56\xa1\x43\x28\xdc\x75\x75\x0d\xc1\x82\xa9\x9c\x6b\xcb\x76\xd1\x25\x41\x57\xb5\x8d\x4f\xb5\x25\x87\x50\x21\x2a\x5e\x82\x51\x37\x30\xb9\x77\x11\x97\x84\xe5\xcd\xb8\xe2\x30\x6d\xaa\xf9\x6f\xff\x5c\xfb\x8a\x28\x14\x1b\xb0\x8f\x5c\x83\xce\x88\xac\x4c\x25\x55\x43\xae\x6b\xde\x18\x21\x4a\x3e\x98\xe6\xdd\xaf\x50\xaa\xeb\x92\xd1\xbd\x96\xba\x2b\x67\x0a\x04\xc2\xd1\x2c\x5b\x40\xad\x10\x8c\x4d\xdc\x8a\xb5\xce\xb2\x4d\xa7\xb0\x5c\xa9\xbc\x7b\xb7\x50\x7c\xce\x33\x36\xb5\x2f\x9c\x71\x31\x45\xe7\x8c\x50\xc5\x08\xcd\x32\xf9\xc0\x7c\x39\x1d\xb6\xb2\x7a\x4b\xe8\xda\xc4\xa5\xb7\xc0\x19\x7e\xfb\xee\x8e\x08\xe6\xa6\xb4\xbe\xb7\x67\x8c\xda\x6d\xa7\x84\x4c\xfb\x1c\x0c\x06\x60\xf9\x3d\xf9\x87\xd5\xa7\xd2\xec\x94\xfc\xc4\xfc\x4c\xac\x72\x6a\x79\x5a\x62\xc8\xc3\x4c\x82\xd5\x0d\x57\x94\x06\x30\x52\x53\x09\x30\x16\x57\x4a\xc1\xc3\x39\xb7\xb0\xac\xaa\x82\x15\x4d\x5a\x10\xa1\xca\xa9\x26\x75\x04\xd0\xde\xb5\xb3\x9d\x6e\xb0\xe0\x10\x5c\x77\x4a\x22\xba\x6d\x55\x35\x05\xa1\xf2\x9c\x5e\xe4\x19\x17\xf7\x67\x84\x9b\xc0\xf4\x2d\x25\x46\x28\x65\xf6\xf1\x70\xc2\x14\xa3\x59\xa8\x4f\xb2\x1f\x4a\x3b\xc8\x3d\x69\x3a\xba\xb5\xee\x16\x85\x8b\xe8\xac\xd8\x9e\x0f\x19\xbc\x8a\xab\x1a\x60\x24\x39\x3a\x7a\xc6\x18\x8a\x32\x7c\xed\xf9\x26\xe2\x3a\xbe\xfb\xc3\xf1\xcd\xe8\xd5\xa8\x55\x16\x95\x0a\xe2\x3e\xf3\x6e\xe1\x3d\x25\x6e\xed\x37\x73\x2b\x46\x78\x01\xb4\x1c\x4e\x8f\x49\x66\xb4\xb8\x2c\xcd\xec\x8a\xeb\x44\xce\x59\x27\x03\x40\x68\x45\xe2\x5d\xaa\x84\x87\x0d\x70\x10\xc9\xab\xff\xbe\xbc\x45\x1e\x10\x5a\x5a\x9c\x19\x9e\xe0\x83\xfd\x62\x43\x1f\xc3\x8a\x47\x4c\x77\xb4\x29\xac\x5f\xaf\x87\xf7\xcc\x56\xdb\xbb\xf2\x7b\x57\x7e\xe3\xee\x00\x6e\xf2\x47\x73\xdf\x73\xc1\x0d\xa7\x46\x46\xd4\x94\x6a\xdb\x10\x4b\x6d\x64\xee\x8f\xf9\x4d\x00\x06\x61\x6f\xe0\x84\x6f\xc1\xc7\x3b\xa0\x1b\x7d\x43\x39\x48\x65\x89\xe6\x55\xdf\xa2\xa5\x24\x27\xac\x25\x5b\xb0\x07\x3f\x4f\x5e\x41\xfa\x4f\x9f\x28\x67\xcf\x08\xcd\xfe\xeb\xe2\x3f\xa3\xfc\xf9\x82\xe6\xec\xbf\x2a\x7b\xbb\x2f\x87\xd7\x68\xcc\x03\xbd\xef\x0f\x63\x42\x25\x84\xff\xda\x85\x3f\x7b\x77\x86\xc3\xc3\xff\x29\x69\xe6\xb0\xfc\xf6\x50\xd6\xf3\xf6\xce\x75\x98\x70\xa0\xac\xb0\x63\x60\x16\x04\xbe\x58\x6a\xab\x9a\x60\xfd\x03\x0e\x8e\x51\x54\x68\xbb\xd9\x6d\x2f\xed\xb1\x0f\x0f\x39\x26\x27\x26\x29\xa2\xfa\x2c\x1c\x38\x01\xd8\x4d\xdb\xef\xda\x0f\x55\xe2\x2f\x6e\x86\x4f\x10\xff\x00\xe7\xa6\x8b\xa1\xb7\xb5\xb0\x5b\x00\x43\x7e\xe0\xda\xb8\xec\x25\x07\x17\xcf\x2f\x98\x4b\xb4\xb4\x12\xee\x2d\x91\x8a\xf0\xe2\xef\x34\x4d\xd5\x85\x93\x41\x42\x61\x68\x74\xad\x46\xae\xbd\xf9\x02\x32\x0d\x42\xec\xd0\x89\x59\x14\x3c\x01\xc3\xc8\xdd\xab\x5b\x80\xa7\xc9\x5f\xfe\x1c\x53\x0c\xff\xdf\xfe\xf5\xcf\x2f\x90\xe4\xf5\x7c\x92\x32\x77\xb2\xa1\x3e\xe7\x88\x8b\xa7\xcc\x0c\xb2\xa2\xaf\xcf\x09\x82\x1b\xc2\xf3\x23\x47\xff\x38\x95\x89\x34\xef\xeb\x2e\x02\x72\x9f\xad\xd2\x67\xab\x6c\xfa\x39\x10\xa2\xe3\xc3\xfb\xe1\xe6\x8e\x91\x7b\xce\x8e\x0e\xbb\xc4\x32\x72\x3c\xc4\x4f\xce\xc8\x0f\x66\x65\xe2\xbf\x6e\x3f\xf6\x03\x2b\x77\x20\x7e\xd5\xdc\xff\x7d\x5a\xae\x70\x0c\xa4\xcd\x38\x1c\x6f\x3f\x3e\xd6\xcd\x58\x1b\x28\xaf\x7e\xf5\x76\xf4\xf7\x1f\x2e\xbf\xbd\xc6\x44\x52\xd8\x5d\x72\x69\x21\xbe\xbd\x4c\xe7\x1c\x03\x3c\x67\xd9\x7e\x9e\x23\x88\x41\xc4\x47\x27\xbc\x7d\x3d\x5a\x32\xf8\xd9\x4f\x7c\xf1\xb6\xda\x30\x8e\x35\xf9\x6d\x0a\x49\xd8\xd5\x4a\x2b\x26\x08\x4c\x3d\xa1\x13\x21\xd8\x37\xd9\xc7\xb6\xad\xe4\xed\xeb\x11\x5a\x32\x51\x73\xa6\x76\x36\xf0\xa3\x10\x43\x9e\x91\x0f\x1c\x8f\x21\x87\xdb\x4f\xe4\xfd\x3e\xe0\x1e\xc4\x48\x8f\xaa\x5b\xf1\x8d\xe3\x11\x3c\x19\xa2\x35\xec\x09\x76\x61\xe3\xca\x5e\x92\xf6\x7a\x64\x1a\x7d\x1b\x4e\xc2\xbe\x3d\x6f\x9a\xdd\xbb\xb7\x65\xe0\xd7\xbb\xcf\xcb\xad\x8e\xb6\x76\x57\xd6\xab\x8c\x72\x44\x39\xd8\x25\x0e\xb4\x0e\x88\xfb\xef\xc8\xe5\x02\x44\x75\x93\x6a\xb4\xd6\x75\x6e\x9a\xb5\xf0\xab\xb8\x4b\x9c\x55\xae\xea\x7f\xd4\x99\x5a\x6a\x54\x0d\x02\xe1\xd4\x1f\xb9\x4f\x12\x3b\xb1\xbd\x5f\x0c\x00\xb5\x6b\x92\xc6\xab\xf0\xf0\x72\xe5\x9b\x0d\x68\x45\x2b\x71\x15\xf2\x9b\xad\xa5\x74\xd5\xb2\xab\xf4\xb6\x5f\xb4\x90\x1b\xea\xa8\x7e\xfa\xdd\x21\x4f\x7a\x3b\xfd\xf4\x78\x5d\x11\x7c\x1a\xcd\x5a\x7b\xc2\xe1\x6c\x09\x31\xec\xad\xa2\xe0\xbd\xb2\xae\x99\x34\x52\xec\x94\x6a\x7c\xbb\x06\x44\x3b\xe4\xc9\xfd\xe2\x95\x4b\xd6\xcf\x70\x06\xc5\x0a\x9c\xcb\x5f\xaa\x5c\xce\x56\x9c\x0f\x62\x83\x14\xc1\xf9\x8c\x39\x1f\x51\xd9\xc9\x4f\x94\x0c\xfc\x3b\x28\x32\x75\x18\x57\xdf\x21\xf8\x4b\x91\x76\x2a\x38\x70\x73\xe5\x15\x83\x50\x2e\x40\x7b\x82\x26\x51\x14\x4d\x56\xa9\xfa\x13\x8b\x4b\xe9\xcd\xd5\x5e\x59\x89\x54\xe6\x41\xaa\x6e\xc5\xf8\x6e\x5b\x0f\x2f\x45\x4c\xfa\xef\x10\xe8\x5a\xaa\x4e\xb2\x89\x5b\x3c\x4f\x5e\x30\x82\x10\xaa\xc6\xd2\xbb\x7b\xfc\xab\xac\xe5\xda\x66\xb2\x91\x41\x20\xe1\x3e\x1a\x37\xb0\xca\x20\xd0\x41\x77\x15\xfb\xf8\x74\x8c\x61\x07\xc1\x63\x4b\x91\xb4\x58\xcf\x06\xa6\x48\xda\x8a\xdb\xe3\x20\x5a\xaa\x3b\x49\x9d\x18\xe6\x8f\xfe\x51\x6f\x76\xb3\x3b\x5b\xb3\x4e\x5a\x1d\xf5\x28\x91\xf6\xd3\xb2\xca\x80\x8c\xbd\xb2\x4b\x25\xff\x01\xed\xaa\x23\x39\xa5\xeb\x8c\x3c\x91\x0a\xaa\x9d\x71\x57\x00\xad\x6e\xa1\xe6\xcb\x9f\x61\xfc\x10\x89\x14\x13\x3e\xcd\x69\xa1\x5d\x67\xc5\x66\x83\xe5\x3d\x33\xc5\xdd\xba\x9b\xbf\xd9\xdc\xd2\xdc\xb1\x35\x3c\x0b\xdb\xde\xd2\xbc\xee\xbf\x89\x35\x3d\xad\x34\x30\xdf\xdc\x9a\x3c\x86\xd7\x2e\x37\x30\xdf\xdc\x9a\x3c\xca\x03\xb2\xa6\x41\xe7\xba\xa6\x9b\x48\x98\x1b\x5a\x73\x6e\x68\xb7\x89\xf7\xef\x3e\x97\xc0\x06\x7f\xac\x3a\x10\x6d\x68\x5b\x1e\x52\xa9\xdc\x71\x47\x57\x08\xed\xde\x8a\xfc\xb6\x7a\x93\xdb\x63\x5f\xc3\xb1\xe2\x37\x68\x5f\x23\xcd\xa4\x98\x36\x7b\xbb\xd6\x3a\x45\x88\x70\x5c\x14\xe8\xc3\xd7\xa5\xdb\xa1\xe3\x50\x6f\x68\xd1\xb9\x65\x65\xb3\x9d\x3a\x1d\xcb\xd2\x84\x90\x2c\x07\x37\x02\xac\xab\x5b\x06\x55\x33\x1d\x26\x23\x9e\xed\xda\xe9\x31\x8a\x08\xaa\x69\xb6\x6e\x8b\x96\xf4\x74\x46\x18\x4d\x66\xe4\x9e\x2d\x06\xc0\xf5\x22\x21\x13\x52\x50\x28\x21\x00\x48\xbc\xb2\xe8\x70\x7d\xae\xdb\xde\xfd\xa4\x43\xff\xc8\x57\x61\x47\xaa\x30\xba\x8a\x5e\xab\x02\x01\xd1\x40\x83\x36\xa0\xbd\xf8\x4b\x1e\x66\x52\x7b\xe3\x9d\x33\xe4\x45\x83\xbc\x67\x0b\x60\x75\x89\x14\xa0\xc6\x79\x7b\x20\x60\x13\x02\x1d\x6b\x5c\x47\xc3\x76\xad\x71\xb4\x5d\xf2\x3d\x5b\xe8\x55\x4c\x44\x43\xac\x4a\x2b\xd4\x71\x94\xf6\x5a\xf2\xf7\x7d\x29\xdc\xdb\xba\x20\xc1\xcf\xce\x37\xf0\xf5\x1a\x0b\x20\x80\xda\xaf\xe3\x67\xda\x8a\xf5\x84\xd6\x42\x5c\x03\x78\x0f\xbb\xc3\xda\x61\xe5\x15\x61\xb5\x8a\x16\x68\x66\xca\xb8\xc3\x4f\x42\xd2\x18\x84\xf6\x5a\xdc\x31\xad\x89\x6b\x63\x94\x53\x75\xcf\xd2\xaa\xed\x69\x6c\x7f\x76\x02\xfd\x99\x75\x55\xcd\xbf\x6a\x32\x0c\xda\x2b\x5d\x58\x34\x44\x83\x6c\xf5\x51\x1e\x0e\x8f\x9d\x3c\xd2\xaa\x3b\x11\x0d\x13\xea\x54\x44\x3e\xd5\x89\x85\x2d\xcb\x7f\xb4\x80\x74\x2c\xd7\x30\xce\x1e\xc1\x0e\xcd\x2c\x09\x14\x6d\x31\xb3\x20\xa3\xd1\xa8\xaa\xf3\xcd\xd1\x95\x9b\xbb\x71\xcf\x90\x7a\xe6\xf2\x68\xc7\xef\xce\x98\x47\x44\x60\x0f\xf1\xeb\x20\xbb\x74\xd9\x75\x23\xb6\x09\x7f\x3d\xb6\xb6\xe3\xef\x04\x95\x34\x34\x84\xd5\xc6\xfc\x1d\x41\xee\xae\x22\x2c\x8f\xbd\x35\xf6\xaf\xc7\xe3\x2d\xfe\x3b\x02\xdd\xa8\x71\x38\x81\xb0\x23\xd4\xc7\x34\x90\xae\x13\xad\xf4\x96\x15\x8d\xa4\x23\xc4\x7d\xe8\x31\xcb\xc3\xea\x35\xb1\xdd\x74\xdd\x88\x57\x74\x96\xc7\x2e\x7d\xaa\x49\x54\x60\xcd\xf2\x58\xe1\x5b\xad\x26\xfa\x5d\x69\x68\x52\x2b\xad\x46\x92\x9c\x16\xf0\xb7\x63\x89\x5d\x8f\xe5\x1b\x77\xd7\xfa\x2e\xa5\x74\xac\x65\x56\x9a\xb8\x2a\x18\x9b\x40\x36\xaf\x63\xb8\x85\x58\xc6\xf2\x2e\x62\x8d\x1b\xae\x62\x54\x00\xde\x28\x25\xd5\x9d\xe0\xfd\xed\xda\xe5\x8e\x77\x63\xa7\xab\xa4\x4b\xf7\x67\x37\x06\x9d\xe4\xcd\xa8\x0e\xcd\xf5\xe8\xd0\x3b\xba\xfd\x28\x36\xd2\xbe\x1e\x71\xa1\xd7\x6e\x20\x02\xb0\xa3\x97\x7e\xb0\x60\xc9\xe8\x99\x1c\x24\x18\xdb\x8d\x2e\x21\xd9\x6e\x74\xa6\xfe\xa0\x35\xec\xb4\xc7\x23\x5f\x42\x36\xa4\xdd\xb6\x34\x9f\x68\x2c\x48\x45\xb8\xd1\x4e\xcd\x0b\x4a\x89\x6f\xd4\xda\x09\x2b\x78\xaf\x43\xfb\xb9\xc8\x73\x16\xec\xd7\x97\xb7\x37\x7b\xb6\x17\x35\x20\xff\xfe\x2d\x46\xe0\x5f\x80\xfa\x17\xc1\x84\x79\x55\x2f\xff\xc7\x6e\x32\x6a\x07\xd1\x6e\x1f\x8a\xe3\xca\xc4\x5f\x77\x13\x31\x1b\x3e\xd9\x26\x81\x18\xe9\xdd\x10\x1d\x40\x56\xd2\x4b\xa3\x9b\x4f\x08\xfb\x02\x4e\xf6\xc4\x6a\x29\xbc\x13\x9d\xd2\xb4\x3c\x96\x43\x85\xfd\x65\x4e\x46\x2c\xb3\xfc\xbe\xb3\xe2\xd5\x32\x2f\x16\x32\xbd\x20\x10\x20\x4c\x85\x90\x06\xf6\x00\xe5\xe8\x5a\x37\x32\x3a\x66\x99\x3e\x73\xc6\x40\xab\x2a\x34\x02\xf0\xd0\x25\x92\x97\x47\x65\x20\xef\x28\xe6\xef\xb6\x85\x76\xd4\x97\x5f\x57\x08\xcb\xce\x5c\x07\xcd\xef\x41\x67\x98\x84\xe8\x64\xc6\x72\x0a\x1b\xf9\xda\xee\xaa\x2f\x3c\xb7\x03\xc4\x07\xc5\x8d\x61\xae\x5e\x3c\x53\xb9\x26\x72\x72\x16\xf4\xe0\x5d\xe0\x1a\x49\x8e\xe6\xf8\xd2\xeb\x6b\x00\xec\x66\x54\x21\xe1\x38\xe2\x8a\x8e\x6d\x1a\x6d\xa7\x50\xa3\x48\x52\x37\xf6\x52\x0f\x28\xc3\x95\x41\x5f\x2f\xd1\xb6\x2e\xef\x00\xf4\xf2\xf6\x86\xcc\x1d\xad\x7d\x42\xc4\x77\x57\x43\x08\x28\x15\xd5\xb6\xed\xa0\x41\x75\xd2\x2e\x48\x6f\x8c\xeb\x8d\x71\x5d\x46\x6f\x8c\x8b\x1e\xbf\x1b\x63\x5c\x43\x50\x0b\x65\x60\x77\xda\xa4\x86\x59\xaf\xd5\xd0\x62\x07\x5a\x72\xa1\x80\x3e\xd8\xa7\x51\x6e\x7d\xcc\x2a\xfb\x5c\x57\xc2\x57\x6d\x1f\xd9\xf1\x70\x78\x7c\xbc\x9b\xad\xcf\xab\xca\xa5\x99\x0c\xfe\x42\x98\x48\x64\xea\x8e\xbc\xc5\x80\x42\xa5\x5b\xae\x1b\x56\x11\xaa\xcd\x37\x4d\x1c\x5b\x4e\xda\x11\xe8\x92\xd9\xd0\xad\xbe\xdb\x71\xd8\xf1\xd2\x0d\xa1\x6b\xaf\xf7\xa8\x80\x78\xb5\x03\x92\xa0\xd0\xd5\xed\xd6\x0d\x8f\xf6\xaa\xb4\xa8\xd7\x3f\xaa\x70\xbb\xae\xca\x07\xcf\xb9\x2f\xce\x69\x25\x0e\xa6\x8d\x26\x27\xee\xc3\x61\x52\x94\x9d\x75\x1a\x07\x21\x67\xb9\x54\x8b\xb3\x0a\xb4\x05\xd9\xf5\xb6\x6d\xcc\xd0\xc3\x3d\x85\xa0\xb6\xa4\x54\x8a\x09\x7c\xd0\xff\xf2\xf8\xf4\x9a\x52\xb5\xa7\xf8\xa4\xb0\x75\x63\x29\x51\xac\xea\xa8\xd1\xc5\x6c\xdb\x1c\x41\x12\x85\x48\x4f\x9f\x8c\x75\x56\x19\x0b\x77\x00\x6c\xe1\x31\x31\x27\x73\xaa\x90\x69\x5c\xeb\xc6\x1e\x54\x9c\x94\xcf\xb9\x96\xc8\x44\xd8\x75\x83\x8a\xc5\xbb\x8e\xec\x82\xb8\x62\x07\x3b\x5e\xc3\x6d\x38\x3b\x21\x63\xad\x3d\x97\xb3\x6e\x41\x4a\xf5\x90\xa5\x29\x4a\xe3\x25\x96\xc0\xcd\x7c\xb5\xcd\x9d\x88\xd3\xf3\xbf\x5a\xdf\x06\xad\xf9\xe5\x51\x67\xa0\x05\x35\x86\x29\x71\x41\xfe\xf7\xe4\x6f\x5f\xfd\x36\x38\xfd\xe6\xe4\xe4\xe7\x17\x83\xff\xf8\xe5\xab\x93\xbf\x0d\xe1\x3f\xff\x72\xfa\xcd\xe9\x6f\xe1\x8f\xaf\x4e\x4f\x4f\x4e\x7e\xfe\xfe\xcd\x77\x77\xb7\xd7\xbf\xf0\xd3\xdf\x7e\x16\x65\x7e\xef\xfe\xfa\xed\xe4\x67\x76\xfd\x0b\x12\xc8\xe9\xe9\x37\x7f\xea\x3c\xe5\x8f\x83\xda\x01\x32\xe0\xc2\x0c\xa4\x1a\x38\x22\xb8\x20\x46\x75\x88\xae\x73\x23\x20\x77\x5f\x3c\xa9\x16\xf2\x76\xbc\x0c\x49\x53\xf7\xff\x84\xac\x63\x57\x25\x7d\x27\x34\xec\xa4\xa3\xef\xe2\xe5\xfc\x3c\xfc\x95\x9d\x5e\xe8\x72\x16\xf6\xec\xb3\x89\x6a\x2f\xef\xa1\x7e\x8e\xee\x9a\xcf\x24\xc0\xd7\x97\x3c\xeb\xa3\x7b\xfb\xe8\xde\x3d\x46\xf7\x3a\xaa\x5a\x0d\xed\x85\xf9\x47\x03\xed\x43\x7b\xa3\x46\x1f\xda\xbb\x34\xfa\xd0\x5e\x18\xbd\x37\xa1\xf7\x26\xf4\xde\x04\xec\xf8\xdd\x78\x13\xfa\xd0\xde\x3e\xb4\x77\xdd\xe8\x43\x7b\x37\x8d\x3e\xb4\x77\x79\xf4\xa1\xbd\x04\x13\xda\x3b\x8a\xb7\x6d\x90\x56\x5c\xef\xe7\x14\xd6\xab\x2d\xb5\x25\xec\x32\x49\x64\x29\xcc\x9d\xbc\x67\x51\xb1\x6d\x28\x53\xd1\xca\x1b\x9e\xb9\xdd\x88\x96\x29\x67\x22\xde\x46\xdc\x42\xc6\xa5\x07\x12\xcc\x1c\x56\x82\x10\x69\x07\x8b\x41\x98\x4d\xe0\x44\xc6\x62\x70\x48\x2e\x89\x62\x09\x2f\x78\x97\xbb\x10\x6a\x2b\x02\x1c\x47\xaa\xbe\x82\xcc\xc2\x12\x30\xcb\x26\xdd\x94\x5c\x2a\xea\x4a\x34\xaa\x61\x91\xf0\x97\x77\x58\x46\x97\xc9\x56\xcb\x76\xc2\xa9\x0c\xed\xd5\x89\x9e\xc9\xb2\x43\xb4\x9d\x62\xff\x08\xb2\xb3\xc7\xe6\x5d\x63\x86\xdd\xa3\x1c\xbd\xc5\xa8\x81\x06\x3f\x79\x5a\x70\x5f\x08\xf6\xa9\xb8\x23\xfb\x58\x70\x05\x47\x71\xc4\x12\x29\xd2\xdd\xec\xa0\xd7\xcb\xd0\xba\xda\x00\xbd\xbb\x9b\xa5\x24\x2d\x1d\x40\xa8\xe2\x41\x33\x9e\x72\xb3\x20\x72\xd2\xc9\xf2\xe7\x59\x0c\xa1\x8e\xc7\x54\x47\xa4\xdb\x1c\xdd\xc1\xa0\x45\xa1\x24\x4d\x66\x4c\x37\x70\xe9\xf4\x4d\x5f\xe8\xac\xab\x4d\xb5\xc8\xca\x29\x17\x4e\xd9\x84\x77\x58\x99\x3e\x5b\x10\x25\x4d\x97\x68\xf7\x47\x10\x70\xb7\xc3\x64\x61\x7a\x4e\x40\x36\x6a\x01\x01\xf4\xd2\x4f\xb1\x3e\x38\xf1\x96\xc5\xc6\x61\x86\x06\x30\x59\x1a\xfa\x06\xfc\xe5\x05\x29\x98\x4a\x3a\xf2\x33\xa8\x5f\xc4\x5d\xe7\xe7\xcc\xea\x48\xf6\x3a\x9e\xec\x32\xd3\xd6\xe4\xfe\xf5\x6b\x32\x93\xa5\xd2\xc3\x46\x49\xb2\x68\x90\x2f\x01\x86\xb3\x4e\x86\x02\x49\x86\x64\x8c\x6a\x43\x5e\xbe\x20\x39\x17\xa5\x15\x09\x23\xe1\x36\x54\xdc\x3f\x7f\xdd\x89\xbd\x74\x51\x6e\xbb\xa8\xb5\xab\x31\xd3\xfe\x6e\x2c\x5c\x1f\x67\xa7\xdd\x76\xe5\xba\xae\x24\x9f\xeb\x60\xdf\xd6\x72\xbb\x18\x2a\xdb\x17\x04\x78\x03\x9e\x88\x77\x77\xd1\xe8\xa2\xb5\xb2\x0e\xb2\x62\xf4\x23\x31\x8a\x5b\x4c\xbd\x38\x6c\x74\x58\xc4\x84\x7f\x2d\xe5\x78\x61\x62\xcb\x6a\xfe\x1f\xf7\x54\xbb\x9e\x66\xf8\x30\xf4\x83\x40\xa0\xaa\xaa\xfc\xb8\xda\x0f\xa2\x6e\x07\xb1\x15\x4e\x9c\xb4\x3b\x55\xb2\x44\x16\x7e\x6a\xad\x19\x6c\x84\xc1\x72\x14\x9c\x84\x49\xc2\x34\xc4\xa8\x78\xee\x88\xa4\x28\xf0\x52\xb9\x99\x44\x10\xd4\x53\x14\x9e\x7c\xb4\x1d\x83\xdf\x61\xe4\x22\x43\x31\xaf\x83\xb7\x64\x88\x40\x21\x5e\xb7\x54\x6c\xca\xb5\xe9\xd4\x94\xf7\xbd\x7f\xb4\x7d\x3c\x34\x17\xd3\x0c\x6e\xe7\xbc\xcc\x0c\x2f\xd0\x96\xeb\x70\xae\x2a\xb0\x5e\xe6\x69\x3a\x3f\x69\xed\xed\x42\x42\xa5\xae\x5a\xad\xeb\x7c\x04\xee\xf9\x93\x30\x2f\xc2\x84\x71\x8d\xd9\x95\x15\xb0\x0a\xaa\x28\xde\xe1\x0b\x1b\x9c\xc8\x3c\xa7\xfa\xd4\xfb\x64\x29\xc4\xcc\xba\x0b\xcf\x4a\x39\x8a\x66\x15\x7a\x91\x50\x1b\xd1\x8a\x87\x38\x30\x86\x09\x2a\x90\x91\x22\x6d\xe3\x34\x3c\x48\xe4\x43\x95\x68\x39\xe5\x73\x26\xaa\x3d\x8b\x72\xd0\x78\x7d\xf1\x5b\x9a\xdc\x33\x91\x92\x0f\x3a\x9c\x97\x74\x21\x68\xee\x3b\x4a\x15\x4a\xce\xb9\x3d\x30\xe8\x1d\x69\xcf\x45\x9f\x79\xdf\x94\xab\x03\x19\xea\xef\x3a\xd1\xfc\x10\xb8\x2d\x75\xa7\xae\x24\x1f\xb4\x95\x40\x1f\xe7\xb6\x11\xbd\xdf\x35\x53\x7c\x9e\xb0\xa0\x26\xd8\x49\x1d\x62\xb1\x73\x64\x91\x6a\xb2\xbe\xb8\xad\xcb\x8f\xf6\x76\x75\xb8\x13\xab\xd0\x18\xec\x5a\xa9\x20\x34\xb3\x0c\x76\x51\xd5\x32\x6d\x13\x80\xdd\x71\x71\x98\x7e\xac\x31\xb2\x0c\x9a\x01\x0c\xb0\x87\x28\x42\xea\x51\xe3\xd8\xf2\xb8\xc7\xef\xbf\xbd\x6a\xf3\xf3\xf7\x34\x95\x9a\x7c\x9b\xc9\xe4\x9e\x5c\x31\xcb\x90\x11\x08\x5d\xe9\x95\xf5\x78\x27\x2c\xcc\x1e\x45\x34\xb4\x57\xe3\xf4\x70\xed\xec\xfb\x76\xe7\x7d\xbb\xf3\x46\xdf\x26\x35\xc6\xde\x4f\xbf\x9f\x66\xe7\x39\x9d\x76\x3a\x00\xe0\xe2\x06\x76\x02\x20\x42\x5f\xc2\xae\xdb\x84\x3a\xfe\x5f\xce\xe4\xc3\xc0\xc8\x41\xa9\xd9\x80\x23\xc3\xae\x23\xf1\x71\xcf\x16\x10\xb5\xde\x01\x23\xdf\xbb\x47\x5b\x66\x12\x23\xc1\xc5\x14\x21\xe3\x5a\xa9\xf1\xfd\xb7\x57\x56\x94\xa8\x9b\xee\x70\x4d\xce\x99\x49\xce\x13\x56\xcc\xce\xfd\x14\x1d\xb2\xf1\x87\x2c\x96\xd7\x3e\x01\xb2\x73\x29\xb8\x91\xaa\x4b\x49\xe9\xe3\x4b\x92\xc8\x2c\xf3\xc5\x9d\xe5\x84\xbc\x62\xc5\xac\x02\xf8\x1c\x71\xf3\x9c\x9a\x57\x17\x52\x76\x69\x69\xdb\x38\xf4\x16\x82\x3f\xf3\x35\x91\x22\x17\xa7\xc6\xeb\x1b\x8e\x3e\x17\xb2\x3c\x58\x6b\xc8\x4f\xdc\xdb\xbb\xbb\xdc\xd5\x1d\xe9\x4f\xd3\x49\xfc\x78\x14\x1e\x06\x33\x55\x23\x12\xa4\x43\x4b\xf0\x30\x95\x36\x2b\xbe\x99\x38\x35\x36\x65\x29\x91\x73\xa6\x14\x4f\x99\x0e\xd7\x45\xa4\x1d\x08\x26\xc9\xb3\xa7\x3d\x04\x7d\xa7\xf3\xbe\xd3\xf9\x86\xd1\xd1\xdc\xd1\xb8\x0e\x2c\x84\xae\xd7\x01\x4d\x73\x2e\x9e\xf1\x85\x10\xd5\x7f\xdc\xca\xc2\x88\xdf\x05\x41\x65\x9f\x06\x02\x9d\xd0\x8c\xdd\xbc\x8b\x34\x12\x8c\xdc\x53\x6d\x3b\x41\xf8\xb0\x6e\xd2\x86\xb6\x23\x6d\x6c\x38\xf6\x7d\x75\x7c\x89\x90\x29\xc6\x73\xda\x37\x1e\x84\x81\x68\x3c\x18\x7d\xe6\x22\xfa\x92\x45\x32\x9f\x29\x35\xec\x81\x76\x91\x9d\xee\x02\x82\x7c\xfb\xe6\x70\x7d\x04\x62\xc4\x57\x9c\xfc\xce\xcd\xe1\x30\x9d\x13\x95\x34\x4e\xeb\xb8\x92\x39\xe5\xc8\xf8\xbb\x95\x85\x36\x65\x94\xb0\xc0\xdb\x0a\x34\x56\x9c\x80\x19\x80\x9c\x52\xb7\x72\x29\x15\x4b\x89\xb7\x6c\xf4\x2d\xe2\x9e\xa8\x45\xdc\x2e\xc2\x6b\x2d\xbb\xd6\x86\xf3\x10\x26\x11\x95\xb9\x6b\xe9\x20\xd0\x12\x5c\xc8\x55\xf8\x1d\xd1\x4c\x68\x0e\xd9\x08\x8d\xb0\x4f\x74\x5f\xc2\x89\xcb\x32\xaa\xf2\x14\x9d\x18\x7c\x46\x7e\x90\x53\x2e\x02\x3f\x93\x22\x26\xd3\x70\x42\x39\x32\x83\xb0\x97\x5b\x7b\xb9\x75\xc3\xd0\x3a\xbb\x16\x74\x9c\x61\xc3\x6f\xda\xd7\x7e\x46\x21\x4a\x8e\x01\x84\xf3\x94\x6b\xfb\x2f\x19\x8d\x7e\x00\x57\x70\x29\xe2\x74\x46\x70\x7a\xfa\x8b\xa7\x2a\x05\xe2\x98\xdc\x61\x38\x8e\xe3\xf0\x1d\xdb\x08\xde\x88\xd4\x2e\x8f\xe9\x56\x2c\xbd\x87\x69\x19\x09\x5a\xe6\x09\xa9\xc6\x10\xdb\x4b\xc6\x8c\xdc\xcd\x78\x72\x7f\x5b\x7b\x7c\x89\x54\xf6\x33\xd1\xf8\xa8\x83\xba\xdc\x09\x42\x24\xfd\xf9\xe5\xdf\x76\x33\x8e\xdd\x35\x6e\xf2\x91\x47\xa4\x05\x45\xa8\xd6\x32\xe1\xb1\xe1\x08\x60\x42\xae\x04\x02\x92\xc2\x55\x7f\x98\x65\x83\x7c\xb9\x07\x39\x26\x90\x8f\x17\x81\x29\x96\x67\x35\xe4\x16\x2e\x02\x0e\x0f\xb2\x54\x47\xac\xf8\x2a\x57\x1b\x97\x5b\xd1\xfd\x92\xdf\x3a\x2e\x6a\x22\x90\x4b\xd0\x19\xa0\xb5\xb6\x6e\x10\x4c\x4c\x6c\x3f\x5c\xd1\xe1\x34\x42\xd0\xdd\x27\xf6\x96\x7b\x4d\x00\xf1\xcb\x4a\x7a\xc2\xfc\x16\xe7\x4f\x8b\xd1\x9b\x91\x25\x57\xd6\x59\x1c\x97\xc2\xa5\xdc\x67\xde\x3d\x8e\x4c\x76\x28\x64\x51\x66\x2e\x4e\xbb\xda\xc0\xb5\xd6\x90\x18\x4f\xa6\x9b\xc9\xbe\xdd\xe5\xbb\xb5\xae\x5d\x97\xfe\xbe\xda\xc5\x16\x2b\x3f\x5a\x55\x1b\xd7\xc5\x16\x7b\x95\x85\x6c\xf7\x96\x96\xf2\xe2\xcf\x5f\x7f\x3d\x5c\xd3\xd7\x16\x7d\x28\x37\x74\xbf\x5d\xdf\xd7\x16\x09\x75\x4b\xc2\x7a\x2b\xf5\x1c\xbb\xfa\x8d\xdd\x6f\xbb\xa6\x9a\x3f\x9a\x5a\x1e\x91\x24\x7e\xf8\xc6\xb6\x11\x9e\xb9\x88\x7a\x43\x10\xa0\x18\x77\x1f\x3c\x56\x65\xa8\x59\x35\x08\x09\x75\x6d\x6d\xa1\x0d\xb5\x82\x22\x54\xab\xe0\xe0\xde\x56\x21\x08\x6b\xde\x6a\x97\x87\xd8\x47\x5d\x20\x5c\x35\x20\x78\x07\x5a\x24\x7b\xa4\x06\xd0\x9a\x9a\x3e\xd8\x89\xb6\x2a\xff\x6c\xab\xe4\x13\x33\x57\x44\xfd\x1e\x57\x8f\x07\xcb\x72\x1e\xa9\xda\xb3\x52\x85\x07\x09\x73\xb5\x56\x4f\x44\x5e\x7e\xf7\xa6\xd0\xeb\xea\xec\xb4\xab\xe6\xa0\x15\xdc\xd8\xea\x3a\x5d\x72\x5c\x23\x2b\xe9\xec\xb1\x7e\x4e\xa7\x94\x98\xd8\x5a\x39\x58\x11\x21\x02\x24\x59\x5f\x17\x67\x59\x32\x88\x82\xd8\xa8\x86\xb3\xbf\xba\x36\x8f\x57\xb3\xa9\xae\xf9\x28\x98\x08\x91\xc0\x5f\xf6\x51\x60\x31\x82\x81\xbf\xf2\xa3\xe0\xee\x2c\x1e\xb8\xd1\xb5\x72\x4c\xb7\x94\xba\xd8\x74\xba\xc7\x6b\xc3\x74\x2e\x0d\xbf\x5a\x11\x66\x43\x6d\x97\x28\xa8\x50\x07\x66\xbf\x15\x5d\x1e\xab\xe3\xd2\xb5\x22\x4b\x07\xe6\x14\x9b\xab\x17\x53\x73\x25\x2a\xa7\xef\xa0\xc9\x79\xb1\xb5\x36\x90\x15\x36\x7c\xc5\x0c\xe4\x0a\x3b\xf7\xcb\xeb\xe6\x78\xe9\x68\xd1\x69\x59\xfb\xbd\xf2\x1e\x68\x1d\x82\xda\x23\xa4\x62\xd7\x2f\xcb\xdd\x55\x4f\xa6\xbe\x93\xd8\x83\x10\x63\x16\x71\x73\x92\x08\x51\xa5\x4d\x42\xee\xb9\x77\xa3\xa5\x90\x82\xea\x63\xf4\x45\xd9\x47\x12\x3c\x7d\x24\xc1\x61\xc2\xf7\x7b\x37\xf4\xe7\xe4\x86\xd6\xcd\x8a\xfb\x95\x51\x13\x9b\x98\x5f\x6a\x70\x20\x11\x39\x6e\xf6\x72\xac\x8f\xff\xe5\xed\x0d\x49\x14\x83\xaa\x2b\x34\xd3\x43\x82\x37\x15\x2c\x49\xdd\xc1\xc9\x06\x52\x7d\x6d\x2d\xa0\xc6\xb0\xbc\x30\x58\xb2\xeb\xfd\xcb\xbd\x7f\x79\xc3\xd8\xc9\x5d\xf4\x63\xf5\x70\xb0\x8c\xcd\xca\x9c\x8a\x81\xe5\x34\x74\x8c\x16\xf5\x5b\x81\x42\x4b\x77\xe8\x90\x84\x1c\xcc\x98\x2d\xa4\x8a\xb9\xd6\x3d\xa5\xe0\xbf\x96\xac\xae\x14\x5c\x89\x31\x07\xf6\xbd\xc1\x3b\x76\xc2\xa8\x13\xb6\x96\xf8\x54\x22\x0b\x2c\x52\xdb\xd9\x7a\x1e\x01\x15\x76\x87\xbe\x74\x6d\x8d\x10\xbc\x39\xaf\xb6\x03\x9a\x19\x73\xa2\xe4\x2d\x54\x86\xa8\x65\x44\xcf\xa7\xf0\xec\x34\x1d\x12\x67\x10\xa0\x59\x26\x1f\xdc\x6a\x9b\x32\x90\xa5\x10\xbb\x7a\xbc\x20\xe0\xeb\x1b\x70\xa5\xa4\xf2\x5e\xc6\x26\x02\x5c\x44\x19\x9f\xce\x0c\x5a\x29\x06\x15\xda\x85\x01\x0d\xc9\x88\x99\x26\xf9\x1b\x49\xa8\x70\x09\xbc\xf6\xff\x21\x7a\x3e\xc2\x85\x12\x38\xfd\x98\xcd\xe8\x9c\xcb\x52\xb9\x77\x18\x49\x8e\xfc\x57\x20\x72\x2c\x24\xb6\xb9\x53\x70\xc7\x94\x90\x64\x57\xed\x8d\x5e\x43\x0b\x15\xbd\xa1\x33\xb7\x67\xd4\x90\x54\x06\xcb\xee\x80\x7d\xe4\xda\xac\xe2\x38\xce\x90\x1f\xda\xbe\x7d\x52\x95\x60\xae\x0b\x2b\xeb\xfc\x88\x4c\x18\x6f\x1f\xde\xe6\xb3\x6d\xd5\x60\x3e\x82\xaf\x76\x57\x0c\x7c\xf5\x2c\x57\x25\x22\xa4\x4d\xf6\xca\x01\x0e\xee\xef\x4a\x39\xa8\x42\x79\x32\x9e\x2c\x6e\xae\xba\x88\xc3\x55\x08\x8f\x05\x41\xbe\xa5\x9a\xa5\xe4\x0d\x15\x74\x0a\xf6\x2f\x72\x32\xba\xfd\xf6\xcd\x29\xde\xee\x0f\xc6\xd3\x9b\xab\xe5\xe0\x8e\xe6\xbd\xee\x5e\xf5\xf6\x30\x75\x0e\x96\x70\xd2\x51\xa8\x39\x08\x56\x0e\x54\xd9\x21\x88\x1c\xf8\xd6\xd1\xab\x25\xcf\x5c\x48\x4e\xa8\x10\xa9\xe3\x59\x15\x4c\x23\x4f\xef\xf7\xbf\xbc\x98\x50\x9c\x1a\x11\xfb\xbb\x0e\x70\xef\x1f\xc0\xee\x3e\xfa\x13\xe4\x1b\xb7\xdb\x40\xb7\x02\x7a\x34\xd9\xbd\xed\xda\xb7\xbf\xbc\xa3\xfa\x9e\x0c\x06\x1b\x7e\x8f\xb9\x36\xe8\x64\xc2\x05\x37\x8f\xda\x28\xda\x75\x68\xfd\x03\xae\xa8\x0a\x14\xdb\x82\x70\xb3\xf0\xb1\xb6\xd7\x5e\x99\x6d\xa3\x0f\x55\x66\x8f\x9b\xc5\xb0\x77\x9e\x90\x29\xbb\x44\x2c\x62\x65\x21\x57\xf0\xc7\xd8\x9b\xe8\xd6\x2d\xc0\x4d\x72\x2b\x3d\x86\xe4\x86\x42\x6e\xbd\x29\x62\x2e\xf2\x02\x94\x6f\xc5\xd2\xab\xd2\x9e\xb6\x51\x35\xad\x9b\xa9\x90\xd5\xc7\xd7\x1f\x59\x52\xc2\x82\x10\xe7\x77\xc5\xcb\xe3\xd7\xca\x54\xa8\x1e\x6a\x5f\x09\x85\x7d\xfc\x17\x38\xfb\x84\x4c\xc1\x50\x05\xa6\x4e\x1f\x5b\x46\x0d\xd7\x13\x77\xd7\x57\x98\x65\x1f\xad\x48\x05\x65\xc9\x50\x70\x6b\x4d\x25\x84\x22\x81\x02\x7f\x46\xc6\xa5\x21\xdc\x80\x73\x3f\x99\x49\xa9\x99\x55\x0d\x25\x52\x5e\x87\xf9\xcd\xb9\xcc\x20\xb8\x58\x0a\x57\x3c\x4c\x2a\xd6\xe8\xb8\x18\xa6\x89\xe3\xf9\x10\x76\x69\x69\x28\x84\x47\xe6\x56\xba\xa9\xf6\x2f\x28\xd7\xf6\x55\xe8\x68\x49\x28\x7a\x05\x12\xb5\x36\x44\x97\xd0\x53\xf8\x81\x59\x85\x47\x9f\x11\x3e\x64\x43\xd7\x1a\x94\x26\xb3\xc8\x95\xe7\xcc\x8a\x9f\x34\xcb\x2a\xaf\x42\x83\xde\x1d\xc3\xb4\xf7\x24\x6e\x87\x4e\x42\x77\xbe\x50\x88\xf7\xac\x62\xba\xcb\x54\x5b\x91\x01\x0a\x70\x63\x0f\xce\x08\x33\xc9\xf0\xf4\x0c\xaa\x8e\x94\xc6\xee\xb5\xc5\xc7\x78\x41\xb8\x89\x91\x1f\xcd\x4c\xc9\x72\xea\xc4\x19\xef\x22\xf4\x09\x5c\x81\xb0\x40\x58\xa7\x69\x8a\x85\x78\xe4\x36\xe4\xa8\x4a\x85\x29\xf3\x50\x23\x16\xc8\x21\xa7\x06\xea\xfd\xba\xcc\x27\xa5\x98\x2e\xa4\x40\x43\x87\xa7\xaf\x6b\x3c\xfc\xb5\x02\x7c\xa2\x4f\x6b\xc9\x6c\xc6\xa7\x33\x86\xec\x2c\x6d\x27\x48\x15\xf3\xe5\x4d\x9b\x34\x8a\x21\xf4\xae\x41\x68\x97\x82\xb0\xbc\x30\x8b\xc6\x89\x68\xd0\x9c\x61\x0a\x5b\x7b\x26\xe0\x13\x88\xd7\x19\x08\x1d\x1e\xb8\x97\xfa\xfd\x09\x21\x2f\xc8\x89\x3d\x22\x58\x15\xc0\x1c\x6b\x60\x20\x03\x59\x9c\x0e\xc9\x25\x11\x65\xc5\x0b\x77\x9f\xac\x90\xd5\x5c\x61\x52\x70\x6f\x66\x5a\xd6\x6f\x3c\x90\x0d\xb8\xa8\x22\xf3\x3a\x5a\x82\x2f\x1d\x15\xbb\x0e\xa2\xf6\x8e\x63\x2a\x3f\x8b\xcf\x15\x20\xcd\x7c\x81\xd6\x31\xf0\xdb\x85\xf7\xa9\x77\x2b\xc0\xbf\x7c\x90\xe2\xca\x0c\x2f\xa1\x24\xe3\x1a\xca\x01\xb7\x51\x13\xcd\x38\xeb\x31\x5e\x00\xac\x63\x4d\x32\x3a\x66\x59\x64\xb9\xe6\xdd\x5b\x52\x2e\xef\x72\x63\x29\x91\x60\x89\xaf\xb3\x57\xd1\x8b\xbd\x6e\x7c\x74\x86\xf6\x2e\x90\xf8\x4e\x20\x10\xbf\xe8\x02\x78\x68\xc8\xe4\x0b\xc0\x21\x48\xa5\x43\xe3\x92\x66\x6c\xa8\x9b\x57\x6c\x2d\xe6\x5d\x5a\xcf\x75\x6c\x3c\xb7\x22\xbc\x01\xbd\xb8\xb8\x1a\x7c\x14\x75\x7b\x38\xef\x99\xdf\x2f\x5a\x14\x19\x98\xaa\x3b\xf5\x6d\xda\xa9\xeb\x50\xd8\xd6\x9d\xf1\xf2\xbe\x69\xb5\xbb\x67\x0b\x74\x80\x44\x7b\xb8\xe8\x27\x29\xf4\x8c\x17\x2e\xf6\x52\x33\xe3\x3b\x0b\x74\xa0\x16\x37\x7e\xa4\x19\x4f\xab\xa5\xba\x3a\xb0\x37\xe2\x8c\xbc\x95\xe6\xa6\x43\x8b\x1c\x02\xb5\xee\xb8\xb6\x02\xe1\x95\x64\xfa\xad\x34\xf0\xe7\x90\x7c\x67\xe0\xbc\x74\x82\xf8\x43\xa7\xfe\x85\x3b\x6d\xbe\xc3\xe9\xce\x5b\x7f\x29\x9c\xea\x6d\xb7\x69\x87\x7e\xd5\x7e\x87\x5d\x4a\x31\xab\x19\x0e\xd7\xe4\x26\xbe\xea\x3f\x71\x8d\x7c\xdc\x16\xd7\xc1\xe7\xda\x4f\x35\x2f\x91\x92\xdb\xf2\x18\x5b\x49\x50\x0c\x40\xae\x5a\x99\x6b\x27\x88\x5c\x7b\x6a\xb2\xf3\x6d\xd2\xd3\x59\xe7\x5e\x66\x2b\x4b\xb5\xd3\xae\xa6\xdc\x99\x61\x35\xb7\xe4\x3b\x88\xfa\xfa\x61\xaf\x93\x9c\xd1\x39\xab\x2a\x4b\x77\x82\xe9\xd5\x8b\x33\x5f\xa9\x39\xb8\xfd\xb9\x30\x4c\x15\x8a\x75\xe9\xf3\x4b\x5c\x71\x69\x2a\x42\x28\xa8\x8f\xc0\x75\xf3\xe6\x9a\x28\x56\x64\xb4\x4b\xaf\x67\x3b\x52\x50\xd6\x5c\xc8\x39\x35\x6c\xca\x13\x92\x33\x35\x85\x80\xca\xa4\x53\xcf\xbd\x8e\xed\x66\xc9\xee\xad\x50\xbb\xf6\x77\xeb\xde\xfb\xae\x4b\xe7\xbb\x41\xd7\xd3\xda\xb9\xf3\x5d\x37\xbc\x80\xf0\xfc\x1a\xe2\x26\x9e\xab\xdc\xec\xa3\x3a\x7a\xb9\xb9\x35\x7a\xb9\xd9\x8f\x5e\x6e\x5e\x3f\x7a\xb9\xb9\x97\x9b\x7b\xb9\xb9\x97\x9b\x7b\xb9\xb9\x97\x9b\x49\x2f\x37\x6f\x79\x30\x0e\x2f\x1d\x5e\xe6\x6c\xe1\x1d\x4d\xf5\x3f\x39\xbf\xc7\x72\xe4\x0e\x08\xef\x71\xd4\xb0\x6a\xa4\xb7\x62\xee\xc8\xcb\x3a\x77\xe0\x02\x70\x09\x48\x11\x40\x15\x15\x53\x46\x5e\x0e\x5e\xbe\x78\x81\x3f\x13\xdd\x12\x26\xe3\xd3\x25\xe3\xa8\x77\xd0\xf0\xab\x20\x1f\x70\x3b\x8b\xf3\x51\xc6\xd1\x4d\x97\x8e\x78\x07\x8a\x26\xf0\x57\x69\xe5\xeb\x6f\x6a\x76\xb5\x23\x1f\x85\x84\x96\xb3\xbf\x8a\x83\xcd\x99\x21\xd4\xb4\x9c\x71\x3c\x67\x38\xf1\xcf\x47\x67\xb4\x6a\x24\x84\x08\x87\x94\xc8\x50\x8b\xc1\x52\x3a\xfa\x86\x45\xac\xb6\xbd\x12\x14\xd4\x84\x51\x5d\x35\x77\xf3\x2b\x96\x39\xf3\x6d\x28\xfd\x75\x63\x97\xc2\xc2\x4e\xe1\xfc\xf4\x6c\x38\x1d\x92\xb4\xf4\x91\xce\xa4\x2c\x52\x6a\xd8\xa9\x93\xac\x7c\xa0\x66\x6e\x45\x40\x65\xff\x41\x81\xb4\x68\x34\x0a\x12\xf7\xa1\xb1\x48\x09\x7d\xb4\xd8\x9c\xfb\x1a\x2d\x76\x92\xd0\x2c\x84\x1b\x17\x61\x83\x39\xf9\xb1\xea\xe1\x32\x6f\xea\x56\x6e\xe1\xbd\x3f\x1a\xc3\x4d\x76\x0a\x3c\xf7\xb1\x73\x70\x7d\x72\xe1\xbf\x40\xbd\xef\xde\xe3\xeb\xc9\x45\x5e\xff\xcb\x16\x89\x32\xcb\xec\x1e\x3a\x77\x7b\xb7\x25\xb8\x45\xac\x71\x5f\x0f\x7d\x5e\x78\x27\x8b\x8d\x8b\x35\x71\xd1\x07\x97\x6f\xaf\x5c\x01\x01\x46\xee\x64\x21\x33\x39\x5d\x34\xf7\x30\x66\xa6\xd0\x40\x28\x2f\x42\x34\x07\x25\xba\x1c\x7b\x8d\xd3\xd2\xe0\xdb\x25\xf2\xe8\x3d\xcd\xbd\xa7\x79\xf3\xe8\x2d\x66\x7e\xf4\x16\xb3\xf5\xa3\xb7\x98\xf5\x16\xb3\xde\x62\xd6\x5b\xcc\x7a\x8b\x59\x6f\x31\x23\xbd\xc5\x6c\xcb\x83\xbd\xa7\x19\x46\x2f\x37\xf7\x72\x73\x2f\x37\xf7\x72\x73\x2f\x37\xf7\x72\x73\x2f\x37\xef\x67\x92\xbd\xdc\xdc\xcb\xcd\x51\xe3\xf7\x2b\x37\x77\x78\x59\xdc\x6b\x62\x50\x3e\x58\x75\xc8\xa0\xbd\x98\xa8\x25\xa0\x7f\x5a\xc8\x74\xc7\xa4\xf0\x42\xa6\x3b\xe4\x84\x3b\x87\x5b\x22\x07\x99\x4c\xaa\xce\x19\x16\xa4\x2f\xde\xa9\x69\xee\xfc\x8d\x67\xe4\x9f\x52\x30\x97\xe3\xba\x15\x2a\xd5\xce\x1b\xe8\x8a\x9a\x15\x32\x3d\xd1\xa7\x5b\xf3\x07\xfb\x9c\xf3\x3e\xe7\xbc\xcf\x39\x47\x8c\xdf\x45\xce\xf9\x8c\x6a\x77\x36\xf0\x4d\x1e\x36\xa6\xa9\x37\xb9\xa8\x65\xe6\x7f\x45\x8b\x70\x9b\xb2\xd4\x3f\x51\x06\xba\x3d\x2c\x9e\x90\xa1\x56\x49\x4d\x88\x6e\xed\x58\x91\xcc\x85\x59\xb1\xf4\xb6\x8d\x17\x6f\x17\x82\x85\xd1\x34\x65\x29\x29\x98\x1a\xa0\x8f\x08\x14\xbb\x9b\x70\x5f\xa1\x7e\xe9\x1c\x7b\x4c\xe2\x78\x62\x7c\x8e\x78\x7b\x21\x1d\x03\x0f\x9a\xf1\x13\xad\x6b\x13\x32\xc6\x23\x44\xa0\x75\x95\xa7\x3e\x45\xc6\x38\xd8\x6d\x82\x18\xb3\x9b\x31\x12\x2c\x40\xbf\x96\x4c\x2d\xa0\xaa\xa0\xb3\x53\x44\x0a\x94\x72\x42\x02\xcf\xd3\x3e\xfa\x8e\x6b\x92\x50\x0d\x51\x36\x91\xb6\x8e\xee\x76\xb1\xdd\xa2\x1b\xc8\x32\x72\x96\xc1\x81\x6d\xb2\x83\x36\x11\xec\xbd\x0e\xd5\x6b\x0d\xbe\x5d\xd4\xa3\xe5\x60\x97\x46\xe8\x4a\xbc\xd5\xb5\xab\xaa\xb5\x96\x9a\xf6\x62\x08\x26\x07\x32\x06\x93\x03\x19\x84\xc9\x5e\x8c\xc2\x64\x47\xc3\x30\xe9\x6e\x1c\x26\xcb\xdb\xe9\xdb\x32\x99\x60\x27\xee\x08\x93\x54\xf6\xe5\xb5\xb6\xe2\xce\x50\xbb\xd9\x98\xc9\xee\xd6\x81\xdd\x6c\xcd\x64\x19\xcd\x15\xf9\xd5\x05\x3d\x3b\xe3\xc4\x1b\xac\x57\x8d\xcf\x9d\x21\xb6\x8d\xd6\xcb\x06\xe8\xee\x13\x6d\x19\xae\x83\xd9\xb0\xab\xc5\x99\x40\x23\xf7\x86\xfd\xfa\x53\x11\x46\x77\x3b\x34\x59\x26\x0b\x6f\x54\xe4\x60\xb0\xeb\x62\x8a\xaa\x47\x65\xcd\xde\x64\x99\xee\x0c\x19\x2c\xda\x6b\xad\xd3\x3b\x50\x46\xd3\xd4\xdb\xb2\x50\x77\x67\x15\x4b\x26\xdf\xca\x4a\xbd\x17\x6a\x5b\x35\xca\x77\x06\xbb\x64\xe1\xae\xcd\xb3\xbb\xec\x50\x30\xeb\xae\x31\xd1\x76\x9f\xe8\x6e\xa6\x5d\xb2\x9b\x79\x97\xec\xe3\xac\xee\x62\xe6\x25\x3b\x98\x7a\x49\x47\x73\x2f\xd9\xc5\xe4\x4b\x76\x31\xfb\x92\x9d\x70\x05\x62\xf4\x0f\x10\xd0\x1b\x8f\x2a\x9a\xa6\xdc\x75\x62\xba\xdd\x49\x2a\xda\x89\x5a\x56\xb5\x02\xb7\x1c\x27\x9f\xe6\x14\xdb\x55\xb2\x39\xe4\x84\xfc\x5f\x2b\x84\x02\xdb\xf8\x7f\xd0\xe8\x54\x5b\xf5\xd4\xb9\x8c\x9a\xdf\x75\xf1\x9c\x88\xda\x76\xe0\xa7\x9a\xd3\xc2\x4e\xd7\xd2\xec\x9c\x66\xdd\x04\x72\x97\x9a\xe1\xcd\x44\x76\x05\xcb\x1a\x52\x17\x69\x1c\x5a\xa7\x82\x88\xe8\xcc\x4d\x5c\x93\xa3\x7b\xb6\x38\x3a\xdb\xf5\x86\xb2\x80\x6e\xc4\x51\xdd\x5a\xaf\xe5\xa6\x0b\x7a\x44\x97\xad\x13\xd9\x82\x1c\x01\xb4\xa3\xd5\x9c\x03\x2b\xda\x74\x00\xda\x51\x71\xdb\xd1\x95\x13\xfd\x60\x5d\xe6\x7f\x07\xab\x43\xa3\x57\x40\xdd\x7f\x22\xa6\xe7\xeb\xea\x6c\x6a\x2d\x65\xb4\x9b\x7e\x61\x24\x39\xa9\xca\x38\x4e\x2d\x85\x98\xd3\xbf\xb6\xf3\x56\x22\x21\x82\xfa\x9f\x33\x2a\x34\x39\x0a\x5e\x96\x66\x33\x8b\xa3\x28\x78\x9d\x6e\xcd\xce\xdc\xaf\x1b\xd7\x37\x3e\x69\xe6\xfb\x58\x45\x74\xc9\x26\xea\x3d\x52\xae\x0d\x3c\x74\x70\x08\xee\xaa\x58\x35\xe1\x24\x58\xfc\x4e\xed\x2e\xba\xa6\x8c\x01\x14\x39\xa1\xc2\xf0\x41\xf5\x8b\x48\xd0\x95\x1d\x10\x8c\xda\x21\xa1\x75\x95\x1e\x23\xc1\x06\x37\x5c\xe5\xed\xa9\x69\xfd\x8c\x3c\x40\x01\xf5\xce\xd8\xe0\x3a\xf4\x13\x24\x54\x13\x55\x0a\x68\x31\x25\x85\x77\x18\x45\x75\xb3\x0e\xc3\xb5\x85\xf5\x46\x6b\x67\x06\x02\xcc\xb8\xae\x9a\x15\x3d\x74\x91\x1d\x82\xfb\x4c\x4e\x5c\x0f\x14\x3b\x45\x29\x7c\x60\x04\x15\xb1\x30\xab\x5e\x85\x99\xeb\xea\x0d\xfb\xc6\x2b\x3c\x0c\xc9\x75\x87\x43\xde\x58\x62\xe8\x48\x0d\x9d\x66\x62\x79\x7a\xa7\x93\x1a\x2f\x89\x0e\x3a\xed\xc9\x53\x27\x8d\x3f\x1c\x30\x69\x7c\xc9\xb1\xf0\x87\xcf\x19\x6f\xa3\x03\xf9\x54\x9f\x38\xde\x27\x8e\x3f\xab\xc4\x71\x00\xe0\x6e\x9f\x0d\x19\xe4\x28\xa0\x2e\xcb\x1c\x9d\x41\x8e\x82\x59\x65\x99\x93\x9f\x7c\x57\x33\xc5\x80\x04\xf2\x32\x33\xbc\xc8\x6a\xff\x3b\x3e\x10\x31\x73\x66\xac\x89\x0f\x32\x69\x73\x38\x3b\x6b\x9a\xcc\x3a\x1d\x6d\xaa\x7c\xa8\xa1\x86\x3b\xd2\x07\x57\xd0\x2c\xf3\x59\xe3\xc1\x4e\xe5\x22\x5a\x90\x1d\x88\xba\x3a\xc7\xaf\x40\x56\xd1\x75\x04\x35\xdc\xd8\x27\x56\x20\xca\x2c\x19\xe2\x85\x95\xcd\xd2\x59\xdd\x4e\xdc\x9f\x98\x29\x9f\xa3\xdb\xdb\x57\xa2\xd9\x89\x3e\x3d\x0d\x9e\x8e\xdd\x85\xd7\x03\x08\xad\xf6\xfe\x5c\x15\x1f\xd7\x0b\x84\x48\x90\x4b\x62\xe3\x63\x82\x20\x12\xe2\x7f\x36\x64\x93\xff\xda\x2e\x02\x22\xa1\x3a\xde\x50\x09\x7f\x35\x21\xf1\xb8\x15\xc7\x7b\xe7\x3a\x3a\xeb\x51\x8e\xfa\xb6\xeb\x3d\x42\x7a\xd9\xc1\x49\xff\xc9\x4b\x0f\xac\x75\xcc\x03\x33\x8c\x57\x05\x1e\x73\xca\x93\xbb\xe8\x80\xf0\x7d\x38\xe4\xf7\x91\x90\xb5\x47\x47\x7c\x9f\x91\x85\x19\xfb\xc8\xc8\x5a\x76\xb8\x77\x74\x12\x90\xcd\xce\xf6\xcf\x3a\x31\x6b\x5f\x8e\xf2\x8d\x4e\xf2\x0e\x91\x4f\x6e\x6c\x76\x90\x77\xb4\x06\x93\xf5\xce\xf1\x9d\x9d\xdc\xcf\x21\xc9\x6a\x4f\x8e\xed\xc3\x38\xb5\x37\x3b\xb4\x77\x98\xea\x5a\x67\xf6\x67\x97\x6e\x75\x37\xe3\x1d\xcf\xdb\x72\x7e\xd1\xbe\x1c\xd1\x7d\x7e\x11\x7a\xfc\x7e\xf3\x8b\x76\x70\x30\xef\xc3\xb9\xdc\x99\x12\xb6\x3a\x95\x97\x5c\xc4\xb1\xb2\xcc\x66\x87\xf2\x1a\xf7\x70\xb4\x59\x7e\xc9\x99\xbc\x7f\xd7\xf0\x41\xdc\xc2\x07\x71\x09\x6f\x77\x07\x77\x51\x02\x3a\x9d\xa1\x0e\x0f\x75\x71\xe8\x22\x9d\xb9\x8d\xaf\x22\x4d\xf5\x6b\x1d\xb9\xeb\x5d\xb3\x31\xbe\xeb\x56\xf1\xc1\xfd\xb9\x65\xa3\xef\x91\x4e\x3c\x23\x9e\x37\x76\x72\xc3\x1e\xc4\x05\x7b\x20\xf7\xeb\x01\x5c\xaf\x87\x72\xbb\xa2\x5c\xae\x8e\x1d\x45\x40\xdd\xea\x6e\x0d\xd6\xb3\xa8\xf3\xf7\x88\xab\x75\xd9\x79\x1a\x01\x77\xbd\x9b\x75\x8d\xe3\x34\x02\x66\xb4\x8b\x35\xfa\xe4\xc5\xfa\xd1\x62\xdd\xaa\x07\x73\x8b\x45\x25\x22\x0b\xc3\xf7\x91\x8c\xdc\x3c\xc7\xd8\x36\xdb\x24\xb4\xda\xf6\x9e\x1c\x3a\x97\x3c\x25\x45\x69\x8c\x3b\xca\x9b\xb2\x92\xb7\x02\xad\xb3\x96\xfb\xac\xe4\xc6\x4c\xb7\x64\x25\xb7\xb6\xb0\x4f\x4d\xfe\x43\xa7\x26\x37\x69\x01\x05\xbd\xcf\x4f\xee\xf3\x93\x1f\x1f\x7d\x7e\x72\x18\x7d\x7e\xf2\x86\xd1\xe7\x27\x3f\x32\xfa\xfc\xe4\xc8\xd1\xe7\x27\x37\x47\x9f\x9f\xbc\x69\x42\x7d\x7e\x72\x73\xf4\xf9\xc9\x7d\x7e\xf2\x9a\xd1\xe7\x27\xf7\xf9\xc9\x7d\x7e\x32\x72\xf4\xf9\xc9\xb1\xa3\xcf\x4f\xee\xf3\x93\xfb\xfc\xe4\x3e\x3f\x79\x97\x07\xfb\xfc\xe4\x3e\x3f\x79\xfb\x53\x7d\x7e\x72\x9f\x9f\xdc\xe7\x27\xf7\xf9\xc9\x7d\x7e\xf2\xf2\xe8\xf3\x93\x3f\xdb\xfc\xe4\x96\xeb\xfe\x8f\x91\xa4\x8c\x58\x72\x58\x0e\x0a\xa8\x5b\x32\x36\x53\x19\xe9\x13\xf7\xfb\x8a\xcb\x54\xc6\xc5\x59\x2c\xf7\xc3\x7e\x2c\x53\x39\x6a\x87\xda\xfd\xb0\x37\x65\x2a\xe3\x16\xde\xcc\x66\xde\x96\xa9\x8c\x83\xb8\x26\x9b\x79\x73\xa6\x32\x6e\xdd\x7d\x36\xf3\xca\xe8\xb3\x99\xfb\x6c\xe6\x47\x46\x9f\xcd\x4c\xfa\x6c\xe6\x88\xd1\x67\x33\xf7\xd9\xcc\x7d\x36\x33\x8c\x3e\x9b\xb9\xcf\x66\xde\x30\xfa\x6c\xe6\x3e\x9b\xb9\xcf\x66\xc6\x8e\x3e\x9b\x79\xcd\xe8\xb3\x99\xfb\x6c\xe6\x3e\x9b\xb9\xcf\x66\xee\xb3\x99\xeb\xd1\x67\x33\xf7\xd9\xcc\x7d\x36\x73\x35\x7e\xef\xd9\xcc\xa8\x9f\x51\x35\x7d\x94\xbf\x21\x18\x20\x12\xf1\x98\xf5\x8d\x4b\x9e\x99\x9b\x9c\x4e\xd9\x63\xaf\x44\xbd\x30\x91\x79\x4e\xc5\xa3\x9b\xfb\xb4\x6b\x63\x62\xbe\xe3\x64\x5a\xfc\xfb\x5a\xcc\x7f\xa4\x4d\x7b\x09\x08\x69\x62\xce\x95\x14\x20\xa8\xcd\xa9\xe2\x74\xbc\xd5\x3f\xe5\x1f\xb7\xdc\x91\x92\x57\x4e\x4c\x62\xea\xf1\x63\x87\x37\xa6\x59\x16\xbb\xfd\x7c\xb5\x16\xf6\x96\xe6\x75\x32\xf3\x9a\xf5\x0c\xc9\x9b\x12\x65\x0d\x1e\x33\xbb\xa2\xbf\xdf\x5c\x5d\xbf\xbd\xbb\x79\x7d\x73\xfd\x7e\x3b\x2f\x89\xe0\x21\xc0\xcf\x23\x97\x76\xfc\xa3\x5f\x03\x81\x84\x4c\x26\xac\x64\xf5\xa7\x93\x1f\x2f\xdf\xff\xfd\xed\xe5\x9b\xeb\x53\xa4\x45\x89\x7d\x2c\xa8\x48\x59\x4a\x4a\x1d\xee\xc4\x42\xb1\x39\x97\x65\x7d\x13\x35\x30\x87\x80\x18\x70\xab\xc3\x25\x99\x04\x42\xf0\x76\xdc\x05\xd1\x4c\xcd\x79\xc2\xba\x02\x06\xb3\x08\xad\xfe\x26\x09\x15\xde\xf7\xad\x98\x96\xd9\x9c\xa5\x58\xcb\x46\x85\xba\x30\x57\x2e\x8a\xd2\x04\x83\x11\x78\xd5\xc7\x8c\x94\x22\x99\x51\x31\xc5\x5d\x20\x56\xb0\x6f\x6e\x83\x5e\x08\x43\x3f\xda\x39\x82\x8d\x44\x27\xb4\x08\xe1\x2c\x94\xa4\xb2\xdc\x7e\xaa\xec\xf8\xd3\x9f\xce\x08\x67\x17\xe4\x4f\x0d\xd0\x43\x72\xed\xa1\x35\x28\xc0\x45\x02\xb0\x39\x2a\x5a\x64\xcc\xaa\xfd\x3f\x23\x8a\x4d\xa9\x4a\x33\xa6\xc1\x9b\xfc\x30\x63\x50\xd3\xc1\x69\x3f\xa8\xd3\x4f\x80\x9c\x82\xa1\x49\x48\x33\x24\x57\x6c\x42\xcb\xcc\x80\x4c\x7e\x74\x34\x3c\xde\xfb\xa1\x79\xad\x24\x22\x35\xb8\x75\x70\x46\x2e\x4d\x7f\x22\xd5\x46\xb6\x70\x8c\x11\x84\xe0\xfd\x43\xf2\xaa\x22\xbe\x52\xb3\x94\x70\x6f\xe2\x0d\xb1\x60\xce\x28\xb6\x15\x5c\x9c\x4b\x21\x91\x62\xc2\xa7\x6f\x68\xf1\x3d\x5b\xbc\x67\x93\x0e\xbe\x72\x27\xf2\x6a\x67\xe3\x06\x31\xce\xf2\x6b\x07\x14\x27\x26\xc5\x3b\x41\xa2\x5c\x1f\x2b\x49\xf2\x4e\x62\xf5\x12\xe5\x01\x25\x39\xec\x3d\xb3\x76\xa2\xc7\xcd\x0b\xc7\x9f\x4a\x33\x24\x6f\x24\xc4\x52\x4c\x64\x8c\xf6\x37\x33\xa6\xd0\x17\xe7\xe7\xf7\xe5\x98\x29\xc1\x0c\xd3\x43\x2e\xcf\x53\x99\xe8\xf3\x44\x8a\x84\x15\x46\x9f\xcb\xb9\xe5\xa4\xec\xe1\xfc\x41\xaa\x7b\x2e\xa6\x03\xcb\x57\x06\x4e\x4c\xd3\xe7\xa0\x93\x9c\x7f\x09\xff\x44\xbc\xf8\xee\xdd\xd5\xbb\x0b\x72\x99\xa6\xbe\xac\x4b\xa9\xd9\xa4\xcc\x7c\x25\x81\x21\xa1\x05\xff\x91\x29\xcd\xa5\x88\xb1\xd5\xdc\x73\x91\x9e\x91\x92\xa7\xdf\x6c\x67\x02\x61\x74\xd8\x3b\x59\x38\x43\x5d\xc7\xfd\x1b\x81\x3e\xb7\x68\x71\xbf\xea\x5c\xc4\x28\x5c\x0a\x62\x7d\x2c\xcd\x06\xeb\xb8\xbf\x4c\x23\x17\x3f\x96\x32\x63\x14\x17\x60\x12\xab\x83\x60\x2d\xbd\x91\xba\x07\xd0\x49\x37\xae\x74\x5c\xb3\x25\x67\xcd\xf3\x27\xa9\x90\xe9\x05\xd1\x65\x51\x48\x85\xf6\xe5\xe5\xcc\xd0\x94\x1a\x3a\xb4\xe4\x7f\xd6\xfe\x13\x14\xf5\xc6\x67\xa0\x18\xa3\x4d\x8f\xd5\x63\xc0\xf9\x9d\x6b\xf0\x0c\x4c\x01\x43\xab\x08\xbf\x85\xf7\xc1\x9f\x5e\xce\xb9\x4c\x12\x59\x0a\x03\x5f\x20\xdf\xa1\x0d\x35\xa5\x1e\xce\xa4\x36\x37\xb7\x67\xe1\xcf\x42\xa6\xcb\x7f\x69\xc4\xa5\x4a\x3a\xb1\xeb\xfa\x9c\x77\x3c\x4c\xfe\xe9\x66\x75\x9d\x9c\xc2\x7f\x5f\xdb\xcd\xbd\xa5\xc8\x02\x40\x6e\x70\x4d\x1e\x14\x37\x86\x09\x90\xd5\x20\xbc\x4c\x4e\xce\xec\xb9\xaa\x45\x8c\xf9\xcb\xa3\x83\x5e\x0d\x93\x30\xf1\x8e\x28\xb1\x8f\x06\x7c\x38\x0a\xaf\xae\x34\xc2\xb1\x61\x64\xc4\x5b\x3f\x6b\xe3\xd3\xe5\xed\x0d\x99\x3b\x6c\x3f\x23\x1b\xc7\x24\x6a\x97\x23\xb9\x4c\x08\x66\x7a\xbd\x17\x6e\x53\x15\x6f\xf2\x7b\x53\xa9\x2c\xd8\x7d\x06\xe3\x7e\x15\x60\x45\x32\x9e\x73\xef\x8a\xf7\xe5\xa0\x34\x39\x71\x1f\x0e\x93\xa2\xc4\x72\x01\xff\x44\xce\x72\xa9\x16\x67\xe1\x4f\x56\xcc\x58\xce\x14\xcd\x06\xda\x48\x45\xa7\xec\xac\x7a\x49\x0c\xf0\xea\x19\x07\xbe\x35\xd9\xd5\x77\x60\xed\xad\x54\x31\x92\x94\xca\xca\x3d\xd9\x22\xf0\x6c\x96\x1e\x8c\x4b\x55\x3b\xf5\x76\x07\xa9\xad\x32\x55\x38\xd9\xaf\xa2\x74\xab\x24\x44\x1c\xca\xb9\xcc\xca\x9c\xe9\xb3\x4a\x0a\x71\x95\xc9\xc4\xdc\x2a\x16\xfa\xa0\x72\x4f\xca\xe7\x5c\xc7\xc4\xce\x50\xb1\x78\x87\x3c\x34\xc4\x59\x2c\xa3\x33\x11\x9a\xcf\x45\x3a\x31\xd6\x08\x65\xdc\xa7\xfa\xc9\xd2\x58\x15\xdd\xa5\x53\x10\x19\x53\x1a\xc0\x97\xb4\x93\x1a\x54\xe6\xaa\x6a\x51\xeb\x06\x79\x89\xf7\xe7\x14\xd4\x18\xa6\xc4\x05\xf9\xdf\x93\xbf\x7d\xf5\xdb\xe0\xf4\x9b\x93\x93\x9f\x5f\x0c\xfe\xe3\x97\xaf\x4e\xfe\x36\x84\xff\xfc\xcb\xe9\x37\xa7\xbf\x85\x3f\xbe\x3a\x3d\x3d\x39\xf9\xf9\xfb\x37\xdf\xdd\xdd\x5e\xff\xc2\x4f\x7f\xfb\x59\x94\xf9\xbd\xfb\xeb\xb7\x93\x9f\xd9\xf5\x2f\x48\x20\xa7\xa7\xdf\xfc\x09\x3d\xc5\x8f\x83\x5a\x7b\x18\x70\x61\x06\x52\x0d\xdc\x56\x5c\x10\xa3\xd0\x3e\x84\x80\xac\xae\xe7\x2b\x54\xe1\xba\xa8\x19\x6d\x75\xef\x1d\xf0\x58\xc4\x5e\x58\x61\x72\x87\xb8\xaf\x34\x4b\x14\x33\xfb\xd4\xd7\x1d\xc4\xb8\xec\xa5\x25\xdf\xe5\xb3\x55\xf4\x2b\xf7\x11\x2c\xb1\xa2\x95\x88\xb3\x3e\x51\x32\x1f\x12\x30\xef\x3a\xe3\xed\x1c\x02\xee\x3c\xc4\x7b\x86\xb0\xc9\x84\xd1\x9b\x10\x7a\x13\xc2\xa3\x63\xab\x09\x61\xe4\xa8\x2e\xce\xc3\xfc\xc7\xb4\x1f\xa0\x7f\x8c\x9b\xed\x00\x8e\x22\xc2\x2d\x87\xf4\x80\x3e\xee\x96\xe3\x7b\xf1\x36\x6e\x63\x1e\x28\x20\x9a\x25\xa5\xe2\x66\x61\x45\x5b\xf6\xf1\xd1\x3c\xdc\xa5\x8b\xa6\xf5\x1c\x99\xc9\x2c\xd5\x15\x34\x6f\x79\x2e\x15\xdd\xda\x46\x14\xe2\x03\x82\x07\xc5\x85\xe6\xa4\x2e\x7a\xba\x12\xd9\x87\x64\x24\x73\xd6\x2c\x51\xea\x1d\x88\x8f\x02\xe6\x82\x8c\xa5\x99\xad\xcc\xd4\xea\x2f\xb7\x32\x5d\xfa\x78\x48\x20\xd7\xee\x51\x88\x00\xce\xbe\x5e\xb3\x76\x49\x27\x2e\x56\xde\x62\xe8\x3d\x4c\x33\x61\x29\x13\x09\x7b\xec\x16\xc1\x5e\x9c\x10\x9d\x70\xab\xf8\x9c\x67\x6c\xca\xae\x75\x42\x5d\xbc\x79\x54\xc1\xf0\xe3\xcb\x0d\x50\x00\xdd\x4a\x66\x3a\x70\xa4\xad\x47\x90\xda\x99\x27\x4c\x6b\x70\x5a\x4d\x29\x17\xae\x28\x74\x11\x80\x43\x92\x95\x00\x0e\x55\x50\x85\x71\xde\x79\x80\xbe\xae\x95\x65\x4a\x24\xe5\x8a\x25\x56\x47\xac\xe6\x57\xd5\x0a\xfe\xbb\x60\x0f\x7f\xb7\x6f\xdb\x7e\xe5\x4c\x32\x5a\xfb\xe9\x20\xcf\x69\xd9\xe7\x58\xbd\x7a\x13\x82\xb6\xbe\x83\x6b\x90\x95\x09\xcd\x1e\xe8\x02\xd0\xb8\xfc\x0e\xae\x2f\xc8\xcb\x53\xa2\x4a\x41\xa8\x26\xd5\x3b\xb6\x33\xeb\x7f\x3d\x85\xaa\xc8\xaf\x2e\x6f\xff\x3e\xfa\x9f\xd1\xdf\x2f\xaf\xde\xdc\xbc\xdd\x76\xb9\xe1\x19\x7b\x42\x0b\x3a\xe6\x19\xc7\xc8\x6e\x2b\x62\x58\xf3\x61\x38\xb7\x69\x7a\x9e\x2a\x59\xb8\xf5\x63\x33\xe1\x2a\x1c\xe9\xb6\x33\xd0\xe2\xcf\xeb\x5d\x21\x3d\xad\xf9\xc2\xad\x70\xa7\x8a\x0a\x13\x72\x90\x9b\x5b\xa1\x4a\x61\x78\xfe\xe8\xb9\x24\x91\x42\x2d\x4d\x51\x57\x62\x3b\xb3\x0a\x6a\x2d\x47\x2d\xa9\x7b\xe2\xeb\xab\xf0\x9a\x45\x1d\xc2\x41\x6e\xdf\x8d\x6e\xfe\xff\xb1\x33\x20\x9e\xbe\x22\xee\x75\xb4\x6c\x15\x13\x98\x68\x09\x2d\x1a\xe7\xef\x59\x2e\xe7\x3d\xd6\xd7\xfd\x7c\xbf\x7d\x2d\x2a\xfe\x16\xc5\x54\xde\x97\xa2\xc9\x32\x45\x03\x0e\xc9\x21\x29\xfe\xd6\xb1\x6a\x04\xce\xda\x4f\xd7\x4c\x06\x6e\x71\x0b\x42\x18\xee\x12\xf5\xf1\x55\xcf\x8c\x24\x4a\xca\xea\x06\x99\x49\xbd\x14\xc0\x30\xa1\x99\xde\xca\x58\xf0\xdc\xd9\x5e\x4c\x6f\x64\x29\xb6\xd6\x48\x69\x61\xb1\x7a\x8a\xa4\x4c\x48\xe3\x8d\x62\xf6\xad\x90\xa8\xac\x64\x42\x72\xfb\x35\x66\xb9\xa5\xae\xc3\x20\x9a\x8c\xfa\xae\xc1\x9c\xb9\x0e\x38\xb8\x0d\x6f\xde\x0a\xda\x85\x81\x96\xba\x2a\xdc\xbf\xc4\x9c\x6b\x8b\xdb\x04\xb2\xf4\x68\x2a\x45\xb6\x9d\x29\x14\xd4\xcc\x9c\x4d\x3d\xa7\xfa\x9e\xa5\xee\x03\x2f\x53\x78\x75\xc0\xbd\xb1\x9a\xea\x1d\xe6\x54\x4d\x18\x35\xa5\x62\x4e\x96\x70\xc5\x29\x98\xa0\xe3\x6c\x7b\xbc\x0f\xfa\x2c\xda\x35\xbe\x13\xd9\xe2\xbd\x94\xe6\x35\xcf\x98\xab\x33\x11\xb5\xf1\x3f\x55\xfa\x24\xd7\x0d\x94\x5a\xf1\x81\x02\xfc\x01\x0a\x89\x40\xe2\x93\x6a\x0a\x15\x85\xdb\x8d\xde\x33\x81\xab\x52\x5c\xea\xef\x94\x2c\xb7\xb2\xf2\x15\xe1\xe3\xbb\x9b\x2b\x38\x8f\xa5\xf0\x31\x3a\x46\x2d\x5c\x91\x90\x65\x07\x0d\x5e\xf4\xfc\x60\xe9\x71\x89\x02\xad\xcc\x59\x0a\xcd\xcc\x90\xbc\xa1\x0b\x42\x33\x6d\xb7\x7f\x2b\x4c\xed\xec\x6e\x6b\xb5\x8d\x9b\x49\xf8\x7a\x9d\x96\xb2\x5d\xd8\x58\xab\xc5\x34\xb4\x92\x86\xdb\x6f\x55\x3d\xd9\x7e\xea\xe9\x3d\xd3\x48\xfd\x85\xb4\xab\x16\xfd\xf9\x6b\x14\x65\x60\x3c\x04\x40\x19\x6f\xa5\xb0\xc7\x21\x8a\x36\x6e\x44\xca\x13\x6a\x42\x99\x87\x36\x77\x01\x4b\x89\x93\xc1\x11\x3a\x8e\x90\x62\x00\x87\xa1\xd4\x56\x13\xbd\x99\x80\x90\xef\x10\xfd\x7d\x39\x66\x19\xf3\xea\x2b\x18\x0e\xa9\xd9\x4e\x15\x10\x3f\x98\xd3\x29\x23\xd4\x54\x84\x66\x24\x61\x42\x97\x2a\xb4\xc4\x31\x24\x95\xcc\x85\x86\xa9\x72\xbb\xee\x41\x35\xf9\x70\x73\x45\x5e\x90\x13\x3b\xd7\x53\x20\x8f\x09\xe5\x19\xd8\x44\x0d\x55\xcb\x38\xe0\xdb\xfd\x21\x7e\x0a\xb0\x64\xa0\x7d\x22\x95\x3b\xfa\x67\x44\x48\xa2\xcb\x64\x16\xd6\x6c\xd5\x47\x8b\x83\xad\x30\xc7\x8c\x14\x4c\x59\x62\x61\x69\xeb\x28\x6d\x39\x2a\xd8\x93\x16\xa1\xf0\x6f\xb7\x28\xe2\x8e\x52\xf4\x51\x89\x64\x8c\x1f\xf4\x76\xef\xf2\x0a\x5f\xfc\x70\x00\xbe\xd8\x14\x71\xec\x59\x68\x63\xc5\x11\x74\x08\x79\xd9\x4e\x5c\x40\x53\x01\xc0\x1f\x95\x14\x0e\xc3\x35\x35\xfb\x81\x8b\xf2\xe3\x3b\xa0\x87\x78\x85\x7e\x74\x0d\x8f\x03\x89\xc0\xa2\xe4\x92\x65\x0e\xe3\x46\x6a\xd8\xee\x6e\x5a\x5b\x7d\xb6\x41\xd4\x43\xb1\x0f\x9a\xb9\x4c\x29\x2b\xc9\x50\x91\xca\x7c\x65\xb2\x55\x8b\xaf\xc6\x04\x2c\x71\x21\x40\x63\x88\xaf\x49\x5c\x5b\x41\x22\x89\xaf\x26\xae\xed\xa4\xbd\x4f\xe2\x8b\x31\x6d\x64\x6c\xce\x50\x8e\x8e\x16\x35\xfd\x60\x9f\xb2\x02\x63\xd8\x25\x00\xe3\xd3\xcb\xe0\x9a\x8b\xa9\xc0\xeb\xad\x41\x09\x2e\x5d\xc4\x3f\x13\xa3\x04\x2b\x99\xa1\x9c\x71\x6d\x6d\x55\x66\xcc\xa5\x58\x87\x45\x5a\x30\xcf\x76\x8d\xf0\xe3\xd8\x35\x5a\xed\xa8\xbd\x46\xd0\x23\x9f\xeb\x1a\x4b\xc4\x8d\xb9\xb2\x46\x7b\xcd\xb6\xd7\x08\x77\xdc\xf3\x5c\x23\xda\x04\xf3\xc0\x45\x2a\x1f\x74\xd7\xab\xe0\x27\xf7\x78\xe0\x3b\x89\x65\x7f\x86\x8b\xa9\x6e\x5c\x07\x18\xa6\xdd\xb2\x1a\xac\xbb\x0f\x9c\x2f\x55\xbb\xea\x87\x98\xfb\x65\x95\x8f\xd6\x59\x38\xda\x0a\x14\xbf\x2f\xf5\x2a\x86\x57\x4f\x73\x4d\x5f\x29\x0b\xd7\x70\x9a\x8d\x0a\x96\x44\x1f\x86\xef\xde\x8c\x2e\xdb\x20\x20\xac\x16\xd2\x95\x2d\x06\xec\xf7\xa8\x73\x40\xd3\x9c\x43\xbd\x06\xf2\xc0\xc6\x33\x29\xef\xc9\x49\x88\x35\x98\x72\x33\x2b\xc7\xc3\x44\xe6\x8d\xb0\x83\x81\xe6\x53\x7d\xee\xa9\x76\x60\x57\x82\x8b\x27\xe4\x22\x83\x92\x8e\xe1\xd0\xd5\x4d\x2a\xdd\x64\x49\x52\xad\x06\x36\x0e\x05\x54\xd0\xbc\xf6\x1c\xac\xa2\x04\xe2\x2f\xc0\x27\xb9\x77\x0e\xb6\xba\x85\xd8\xa8\xc5\x2d\xdb\x08\x73\xf6\x25\xc1\xb6\x3a\xbc\xc3\x78\x04\x8f\x5e\x17\xd8\xff\x55\x1c\x34\x9f\x4e\xeb\x06\x0d\xc8\x3f\x6c\x4f\x67\x60\x63\xb5\x46\x84\x5a\xf7\x23\x5a\xd3\xaa\x56\x14\x73\x2d\x6c\xd3\x9e\x96\xb5\x23\x14\x6c\xa4\x06\xe5\xf8\x22\xf2\x50\xed\x57\x89\x22\x87\x53\xa4\xc8\x41\xee\x4f\xd4\xcf\x5c\x30\x2f\x58\x91\x77\x4d\x6a\x6f\x67\x45\xd4\x70\xfd\x17\x63\xa8\x59\x0b\x56\x7b\x28\xf6\x30\x21\xd4\xff\x6a\xcb\x72\x1f\xb8\x99\x41\xaa\x35\x52\x1e\xc1\xdf\x36\x30\x19\x5c\x9e\xc3\x6a\x7e\x83\x9f\xd6\x52\xea\xaf\xf1\x6e\x01\xdc\x29\x75\xd8\x6f\xd4\x13\x81\x19\x59\x01\xc0\x85\xf4\xb9\xda\x20\x00\x9c\x1c\x5f\x1c\xef\x35\x1b\xdb\x2d\x5e\xc9\x82\x4e\x51\x71\x18\xcb\x35\x9a\x96\x1e\x27\x29\x33\x4c\xe5\x70\x8f\xcd\xe4\x83\xfb\x1e\xc3\x57\x5c\x40\x8c\x83\xc3\xd2\x4a\x86\x02\xcf\x94\x65\x39\xed\xbc\x6a\x1c\x5e\x5d\x38\xde\x83\xe5\x29\x4a\x96\x22\xf5\x95\xa8\x2d\x3a\x21\xf8\xe5\xcd\xd2\xe4\xdf\x4a\x81\x01\xcb\xb5\x17\xcf\xee\xea\xfa\xe6\x5c\x93\x31\xb3\x4c\x4f\x90\x97\xc3\x97\x88\x4a\xf9\x11\x5b\xd4\xa1\x14\x00\xcc\x0c\x4c\xc4\x50\xeb\x04\x30\x19\x42\x2d\xc3\x79\xdb\xeb\x1c\x83\xcf\x27\x72\x9e\x6f\x1c\xa1\xd7\x2e\x1d\x88\x8b\x01\xd3\x34\x7c\xf4\xa0\x38\xc2\x16\xed\x77\xfa\x81\x6b\x46\x4e\xc0\xb8\x4b\xa4\x6a\x5e\x3e\xa7\x1d\x7c\x9b\x71\x41\x85\xba\x1c\xef\x81\x83\x78\x46\x00\xc4\x1f\xc3\x40\xaa\xc3\x71\xac\x1f\x61\x26\x0d\x14\x20\x60\x1e\x1d\x91\x13\x07\xeb\x58\x83\x23\xed\x74\xaf\x04\xe3\x11\x76\xfd\xb1\x40\xa8\xb9\xed\xfa\x1d\xa1\x88\x43\xb1\x19\x7b\x88\xf5\x55\xf8\xc5\x61\x0f\x01\xf1\x5b\x36\xa3\x73\xa6\x89\xe6\x39\xcf\xa8\xca\x20\x85\x7b\xe4\xd6\x49\xc6\xa5\xe9\x52\x64\x84\x34\xeb\x36\xac\x94\xbc\x58\x57\xd0\xa2\xb1\x1a\x04\xec\xc6\x94\x96\x0b\x18\xac\x6c\x7f\x58\x8b\xdd\x33\x0c\x47\x17\x69\xb5\x7a\x57\xf6\x3f\xf4\x13\xf8\x98\x64\xa5\xe6\xf3\x7d\x72\x20\x6c\x80\x6c\x75\xd7\x6f\xf9\xdd\x53\x06\xd2\xfa\x4c\xaa\xfd\xcb\x5d\xad\x1a\x37\x5e\x13\xf4\x04\x0e\x92\xd4\xf6\xe6\x13\x60\x33\xca\xe9\x02\x8c\xe7\x09\xc4\xab\x80\x32\x49\xc5\xa2\x1d\xde\xe2\x13\x97\xf7\x25\x92\xd1\x07\x7d\x9d\x51\x6d\x78\xf2\x6d\x26\x93\xfb\x91\x91\x2a\xba\x64\xcc\xe5\x4f\xa3\x15\x18\x4b\x55\x7f\x2e\x7f\x1a\x21\x08\xf9\x8a\xeb\xfb\x46\x2e\x0f\x78\x36\x35\xa1\xc6\xd0\x64\x16\xc2\x7c\xef\x9d\x13\xf5\xf8\x58\x83\xc4\x82\x80\x9a\xd3\x64\xc6\x05\x0b\xe2\x8c\xa8\x92\xb5\xbc\x7e\x65\xb1\x19\x97\x08\x81\x49\x80\xf0\x09\x8e\xe7\x9e\xe6\xbe\xa4\x0f\x9a\x39\x24\x8d\x2d\x92\xec\xd7\x6c\x7b\xc2\x41\x5c\x96\xcc\x44\xdf\x21\x0d\xb6\xcb\x5b\x58\x47\x8f\x54\x41\x3f\x0d\x46\x6f\x77\x02\x05\x94\x90\x85\x2c\xc9\x03\x75\x25\x39\x81\x05\x0c\xc9\x1d\x2f\x2e\xc8\x75\xc3\x5b\xed\xd2\x94\xc3\x0b\x91\x80\x8d\xb7\x2a\x57\xb9\x9f\xc1\xd4\x02\x72\xab\xab\xc8\x69\x39\xb3\x0f\x40\x41\x42\xbd\xfe\x48\xf3\x22\x63\xfa\x82\x1c\xb1\x8f\xe6\xeb\xa3\x33\x72\xf4\x71\xa2\xed\x3f\xc2\x4c\xf4\xd1\x90\xdc\xe4\x45\xc6\x13\x6e\x10\x31\x30\x6e\x70\x31\x61\x4a\x39\xea\x1a\x33\x0f\x76\xd5\x81\x19\x9b\x77\xb3\x2f\x92\x43\xbe\xce\x65\xda\x58\xbd\x22\x95\xe4\xc1\x15\x6f\xb2\x97\x29\x53\x4a\xaa\xaa\x04\x53\xf4\x26\x82\xbc\x95\xc8\xbc\x50\x32\xe7\xd5\x45\xea\x0f\x28\x2e\xfd\x26\x32\x50\xb1\xa0\xca\x70\x6c\x9b\xa6\xe5\x53\x71\x67\xb9\x43\x00\x40\xda\xc2\xcf\x6e\x67\xe2\x66\x42\x64\xce\x8d\x09\x16\xed\x3a\x2e\x0e\x7b\x1c\x3c\x28\x7b\x0a\xfc\x8c\xec\xb5\x33\x6c\x50\xf4\x6b\xa9\xfc\x57\x48\x98\xe7\x29\x9b\x9f\xeb\x94\xbe\x3c\x83\x29\x6b\x9f\x72\x64\x5a\x58\xa0\x9a\x1c\xe1\xcb\x21\x8c\x82\x84\x76\xd6\xc4\x5d\x0d\x6d\x22\x55\xf5\x5a\xec\x01\xd3\xe4\xe8\xc5\x11\x39\x91\x0a\x66\x99\x50\x41\x32\x46\xe7\xcc\x17\x19\x03\x7e\xb9\x70\x05\x92\x4e\x91\xf9\xe1\xf1\x4d\xd4\x62\xd3\x96\xf1\x5a\x1b\x59\xad\x27\xe0\xf7\xe1\xc8\xaa\x6b\x47\xa0\x56\x49\x7b\x3f\xda\xfb\x0c\xdf\x32\xc0\x62\xe7\xbd\x9f\x45\x8d\x26\x2e\x9a\x96\x23\x27\x8f\xc2\x6b\xb0\x1b\xbc\x99\x90\xc9\x11\x68\x7f\x47\x9f\x90\xd9\xc5\x70\x14\x7c\x4a\x9b\x7b\xe3\xcd\x55\x97\xbd\xfc\x20\xf8\xaf\x25\x23\x37\x57\x55\x0d\x1a\xa6\x34\xd7\xc6\xf2\xd6\x94\xeb\x7b\x24\x82\x2a\x19\x89\x83\x68\x45\x4e\x2e\x73\xfa\x4f\x29\xc8\xf5\xb7\x23\x3f\xbd\xd3\xcf\x04\xed\x58\x0b\x3f\x3a\x89\x70\x50\xed\x0f\x52\xe7\x41\x65\x10\xd2\x7f\x96\x8a\x59\xf1\x34\x52\x36\xbe\x0c\xcf\x2d\xcb\xc3\xf6\x73\x72\x45\x0d\x05\xa1\x17\x23\xc5\x02\xb7\x6f\x44\x91\xc3\xf9\x1f\x73\x91\xfa\xaf\x1a\xd2\xec\x9e\xc5\xca\x04\xee\xe7\xe9\x1b\x99\x76\x92\x2d\xff\xdb\x4e\xf6\x95\x83\x01\x21\xf9\x17\xe4\xad\x14\xec\x0c\xd8\x11\xb1\xfc\x08\x9b\xae\x0b\x0f\xfc\xa4\xb8\x61\x48\xc6\x1e\x29\x2c\xd8\x13\x88\x2f\xf8\xb1\xe2\x44\x6a\xa6\x58\x83\x63\xc6\xc2\x8b\xcb\xa4\x1f\x67\x72\x4c\xfc\x19\x3b\xd4\x0a\x3f\xbc\xbf\xe9\xb8\xc0\x0f\xef\x6f\xd6\x2e\x0e\xa6\x8d\x5c\xe1\x01\x17\xd7\x59\xfd\x59\xd6\x7e\x6a\x39\xcd\x27\xfa\x23\xd7\x46\x1b\x12\xb1\x83\xb4\x45\x5b\xc1\xe2\xcc\x07\xd5\x5f\x7f\x1c\x3e\xad\x9e\x72\x88\x5d\xba\xe7\x8f\x97\x3c\xae\x47\x9b\x8d\x5c\x7f\x2c\x5c\x7f\x65\x9f\x45\x3b\x9a\x51\xa8\xfd\x11\xd5\xe4\xd2\x9f\x30\x4b\xbb\xda\xde\xbd\x81\x1c\x09\x75\xc5\xd4\x08\xb9\x62\x2e\x16\x3c\xbd\xf0\xbd\x2b\x62\xe1\xae\x07\xfb\x86\x0a\x3a\xb5\x40\xe1\x26\x41\xc2\xcc\xdd\x43\x8d\xf3\x76\xe2\x4c\xf7\xa2\xfa\x8a\xce\x29\xcf\x7c\xc2\x18\x96\x9a\x98\x39\x1d\xb6\xca\xd3\x68\x40\xe5\x41\x58\xea\x0e\x82\xef\x8a\x23\x81\x9c\x58\x68\xe7\xe0\xaa\x38\x1d\x56\xd2\x2c\x56\xba\x61\xca\xc5\x8d\x7a\xf1\xb9\x25\x10\xfb\x48\xa5\x65\x79\x38\xe6\x00\x60\x05\xc8\x18\x51\x26\x5c\x48\xc8\x9f\x7e\x78\x7f\xb3\x77\xa1\xc7\xf2\xc6\x2e\x42\x8f\x7d\x6e\xad\xd0\x03\x5f\x8c\x5c\x09\xc3\xe7\x2d\xf7\xf4\xb4\xbb\x3a\x5c\xa5\x9b\x8e\x42\x52\x88\x23\x82\xee\x69\xbe\x08\x4f\xb3\xa1\x23\x12\x1b\x8e\x8e\x46\x9e\xc5\xfa\x32\x98\x4e\xfa\xb2\xb4\x11\xd7\xfc\x00\xcd\xc9\x80\x49\x76\x5c\x38\xdc\x55\x04\x75\x92\xa3\x67\x16\xc3\x4f\xea\xdd\xc3\xfc\x38\x2c\x78\x9f\x3c\x25\x61\xc5\x6c\x82\x38\x7d\xed\x9c\x68\x56\xcc\x5e\x8f\xda\x4e\x16\xfb\x19\x79\x3d\xaa\xf8\x03\x02\xb1\x75\x94\x83\x25\x3a\x58\x9e\x76\xce\x99\x63\x4d\x32\x3e\x61\x86\x23\x16\x1b\xc7\x43\x72\x29\xb8\x91\xaa\x4b\x26\x78\xa3\xbe\xd9\x1b\x0f\xc5\x85\x1c\x27\x32\xcb\x58\x82\x2a\x32\xe1\x86\x9c\x38\x74\x85\xc9\x34\x4c\x03\x95\xd2\xcf\xbc\xdd\x70\x78\xff\x17\x50\xfb\xbd\x82\x7f\xee\x36\xec\xfc\xfd\xf5\xe5\xd5\x9b\xeb\x61\x9e\x7e\x39\x93\x0f\x03\x23\x07\xa5\x66\x03\x8e\x2c\xb3\x16\xd5\xa2\x28\xba\xb6\x52\x5c\x6b\xa2\x02\x5d\x66\xb5\xbd\x19\xef\x42\xfd\x26\xf2\x41\xbb\x36\x3a\x60\xba\x0e\x61\x15\x52\x22\x43\xda\x08\x51\xd4\x67\xdc\x52\x6f\x45\x2f\xb3\xcc\xed\x8f\x51\x8c\x9d\x35\x4d\x68\xe7\xcf\x4d\x26\x6b\xa0\x61\xcb\x15\x17\xa1\xd2\xc3\x2d\x86\xb9\xe2\xd0\xaa\x4d\xfb\x22\x7c\x6a\x72\xef\x76\xa7\xe2\x04\x2d\xf2\xc8\x8e\x8c\x2a\x38\x21\x68\xb7\xc0\x17\x43\x36\x12\xea\x82\x41\x87\x8a\x89\x54\x10\x11\xdb\xa6\x45\x66\x12\x40\xcf\x39\xa4\xb6\xba\x49\x23\x81\x7f\x92\x0d\xc0\xdf\xec\xb0\x94\x8e\xd5\x7e\x97\xd1\xff\x9e\x4d\x5c\xb3\x51\x1f\x4a\x82\x47\xbf\xdd\x30\x5a\x9a\x19\x13\xc6\x6a\xa0\x5c\x8a\x20\x24\xad\xec\x07\xde\x55\xe2\xbb\xa7\x3e\xf1\x06\xc4\x17\x77\xec\x4b\x1b\x7e\xae\xa5\x0d\x23\x2b\x96\x62\xd3\xad\x1e\xbd\x7f\x55\x60\x70\x8a\xa6\x12\x82\x44\xb1\x35\x10\x5d\xd9\xfe\x06\x5b\xa3\x69\xce\xc5\xb3\x66\x50\x31\x02\x7e\x90\xf0\xf6\x2a\xb2\x73\x91\x62\xf6\x6c\xa9\xf8\x35\x3c\xd5\x96\xd9\x1d\xa4\xe0\x05\x0e\x71\x3d\x08\x8c\xd1\xa0\xed\xb3\x94\x48\x11\x62\x80\x5c\x04\x50\x08\x1f\xe8\x18\xc3\xb3\xbc\xcd\xf9\x42\xff\x9a\x0d\xdc\x4c\x07\x45\x5a\xef\xf3\xf3\x0d\xd6\x79\xbe\xe6\xea\xcf\x2c\xb8\x66\x8f\xb4\x40\x7a\xb1\x7c\x79\x74\x11\xcb\xf7\xb2\x03\xb1\x92\xf8\xce\x92\x20\x64\x81\xb9\xf2\x86\x41\x84\x8b\xea\x8a\xec\xed\x61\x56\x14\x2f\xa8\xa2\x39\x33\x4c\xb9\x54\x08\x9f\xa0\x21\x58\x02\x86\xcf\x77\x05\x13\x23\x43\x93\xfb\x83\x35\x42\xe8\xe5\xb2\x3f\x88\x5c\xb6\x4b\x54\x4b\x08\x75\x4e\x2b\x1a\xe5\x90\xf6\xe9\x83\xc7\xa2\x82\xd0\xb8\xf0\x62\xc2\xd3\xf1\x87\xcf\x24\x06\xa5\x6a\xcf\x17\x6b\x3d\x0d\xcf\x2d\x09\x63\xd5\xc7\xc8\x50\x46\x9f\xaa\x51\xc8\xa2\xcc\xa8\x61\xae\x64\x1c\x72\x6f\xe3\x18\x8f\x17\xcf\xbb\x06\x9c\xd4\x8c\x38\x97\x29\x23\x63\x5e\x55\x21\xb2\xc2\x63\xa2\x58\x44\xf7\x6c\x90\x90\xac\x34\xe4\xe7\x34\x6c\xd7\xfe\x2f\x19\x19\x33\xf3\xc0\x98\x20\x2f\xb0\x52\x97\x48\xc9\x8b\x7f\xff\xf7\x7f\x6f\x67\x81\xbc\xf8\xf3\xd7\x5f\x0f\xc9\x15\x54\x2f\x96\x0a\x9a\xd8\x43\xbe\x0d\xd6\x7c\xe0\x8d\x3d\x90\xff\x21\xa4\x21\x74\x32\x71\xde\x72\x10\xe4\xb8\x0e\x77\xb5\xcb\xa5\xc3\xfa\x9f\xf9\x74\x06\x6b\xe5\x50\x63\x73\x92\xf1\xc4\xb8\x1e\xa3\x8e\x19\x86\x3a\x0c\x11\xb1\xb0\x6e\x5e\x55\xf4\x30\xec\xd0\x19\xc9\xf8\x3d\x23\x13\x57\xd5\xef\x2c\x22\xef\x90\xb8\x58\x38\xab\xca\xf9\x4e\xa8\x6e\x62\xf5\xbe\x6b\x66\x9e\x4d\x10\x68\xd7\x92\xb0\xcb\x25\x30\xa0\x62\xd1\x3d\x5b\x0c\x1c\x01\x16\x94\x63\xb5\x5f\x1f\xae\x03\x71\x67\xad\x56\x78\x95\xb9\x2a\x8d\x6e\x4c\x18\x0a\x69\x14\x4a\xfe\xc3\x91\x1c\x17\xde\x9a\x15\x14\x3d\xed\x55\x0d\x2c\x44\xe8\x46\x2f\x1a\xc5\x07\xee\x99\x6b\x24\xe5\x8b\x35\x84\x8f\x5d\xaf\x54\x24\xd4\x9b\x09\x59\x2a\x23\x92\x71\x6d\xa7\x7b\xcf\x16\x7a\x75\x15\x68\x8c\xfa\xb5\xd6\x09\xea\x50\x3f\xd4\x51\x71\x29\x1a\xef\x88\xc1\xa7\xef\xfd\xea\xd9\xb5\xef\x4c\x7c\xef\x9a\xd4\xd7\x6f\x82\xcc\x3a\xbc\x2d\xd0\x02\x6d\xf4\xd2\x6e\x75\xa1\x3c\x8b\xbf\xa9\x35\x33\x65\xe1\xa6\x0b\x69\x01\x76\xb5\x4c\x6b\xc2\x61\x7f\x72\xaa\xee\xad\xb6\xee\x79\x31\x76\x97\x6e\xa1\x1a\x6b\xe8\x4e\xa1\x58\x46\x0d\x9f\x33\x5f\x9e\x75\xd1\x4a\xd0\xc6\xb3\x88\xe3\xe1\xf0\xd8\xb1\x47\xa9\x7c\x7d\x43\xe0\x62\xf6\x73\xdc\xc4\xa2\x3c\x58\xed\xc4\x5b\x5a\x58\xf2\xf7\xbd\x9f\x7d\xbb\x59\xda\x4c\xa9\x44\x8b\x80\xd4\xef\x0f\x16\x97\xf1\xd2\x7e\x64\x93\x9d\xcd\xfd\x74\xfd\x31\xc2\x77\xc3\xe9\xd4\x53\x85\xb8\x70\xd2\xae\xf3\x7d\x44\x50\x88\x00\x49\x5c\x70\x0a\x24\xa8\x67\xec\xac\xa2\xdd\x25\xf9\x20\x0a\xe2\x8b\x86\x94\x70\x33\x71\x79\xf4\x6d\xde\x15\x75\x52\xc3\xda\x2b\xa9\x6a\xa9\xfa\x91\x4b\x62\xf7\x97\x7d\x14\x4c\x84\x60\xe0\xaf\xfc\x28\xb0\x18\xf1\xc0\x5f\xfc\x51\x70\x77\x16\x12\xdc\x88\x17\x15\xfc\xb2\x3a\x35\xbb\xc3\x7b\xac\xdd\x58\x39\x93\x15\x17\x2d\x5a\x7d\x42\xd1\xf7\xb1\x9f\xbc\x24\x39\x28\x0b\xe1\x90\xbb\x32\x9c\xfe\xa6\xa2\x82\xd0\xb1\x96\x59\x89\xaa\x22\xd0\x5e\x5c\x0d\xa8\xc1\xda\xdd\x64\x59\xc6\x90\x6d\xfc\xeb\x01\x5c\xbd\x02\xd9\x60\xf7\x70\x4d\x3b\x3e\x8c\xe7\xfc\x7e\xf1\xf1\xcc\x29\xae\xef\x51\x4c\xe7\x23\xfb\xdb\x18\xcf\x6e\x9c\x21\x20\x36\xa0\x02\x6f\x21\x3a\x88\x75\xe8\x93\x58\x86\xf0\x56\x21\x67\xeb\x41\x82\xc5\x5b\x84\x22\xe9\x31\xae\xf9\x18\xbe\x77\x39\xbe\xf7\x98\xef\x3b\xa6\x3b\x35\x1e\x8b\x33\xe3\xc6\x98\x54\x34\x8f\x35\xa6\x8c\x6e\xc8\x49\xdd\xd8\x35\x04\x21\xde\x08\xc3\xd4\x84\x26\xec\xb4\x61\x64\xc1\xd8\x54\xfc\xf3\x21\xd7\x7d\x46\x45\x9a\x85\xcc\x7f\xc2\x3e\x1a\xa6\x04\xcd\xe0\xad\xa9\xe2\x73\xd4\xad\x71\x72\x99\x15\x33\x1a\xfa\x0f\x20\x4c\xfe\x91\xc6\x19\x98\x47\x97\x98\x58\x78\xb0\x59\x4f\x2e\x1c\xff\xa8\xe5\x91\x50\x2d\xc1\xe1\x4a\x37\xed\x50\x43\x4b\x9a\x60\x0e\x00\x86\xbf\x90\x25\x16\xa2\x73\x03\xd7\x6d\x2b\x94\x62\x89\x71\x93\xa4\x9a\x28\x36\xb5\x7a\x9c\x02\xe5\x36\xc2\x24\x93\x64\xa5\x7d\xec\x20\x79\x14\x07\xc8\x76\x69\xe4\x97\x20\x17\xb9\xec\xd0\x9b\x78\x25\x53\xce\x79\x1a\x44\x55\x08\x41\x41\x96\x2c\x76\x83\x6b\x52\x50\xdd\x28\xd1\x40\xb5\x96\x09\x87\x7a\x55\x35\xad\x44\xa9\xbf\x20\xf0\x56\x25\xb3\x5a\xa9\xaa\x4d\x27\xa8\x84\x52\xa5\xc8\x2e\x9e\x91\x1b\x26\x64\xca\x6e\xcb\x71\xc6\xf5\x6c\xb4\x83\x9f\xe9\xed\x1a\x30\x2e\x2e\xb4\x63\xcc\x51\xcb\x43\xd5\xf4\x3d\x69\x26\x34\x07\xc1\xd1\x5e\xc8\x56\xe4\xc5\x87\x9c\x5a\x1d\x90\xea\xaa\x29\x57\x63\xd7\xc0\x83\x95\x17\x19\x33\x2c\x7c\x85\x84\xd9\x58\xb9\x2f\xbb\x62\x55\x01\xfb\xe9\x07\x51\xb4\x3e\x4f\x68\x96\x21\x03\xe0\xc9\x52\x71\xb1\x70\x55\x3a\x3d\x23\x94\x64\x71\x34\x8c\x68\x83\xe0\x86\x90\x55\x1f\xdd\xaa\x87\x8c\xab\x32\xbb\x82\xf0\x38\x97\xa0\x76\x3d\xf3\x20\x76\x54\x8a\x00\xea\x0c\xaa\xe6\x7a\xb0\x75\x11\x23\x2c\xeb\x53\xcc\x1f\x37\x1c\xc6\x7a\x27\x62\xef\x44\xdc\x30\x76\x08\x61\xa8\xdb\xb1\x37\xfa\x0e\x45\x34\x06\xad\x47\xb8\xc4\x83\x48\xb0\x8f\x88\x88\x6e\x35\x02\x2e\x8d\x51\x7c\x5c\x1a\xec\x49\xa1\x69\xca\x1d\xf7\xb9\x8d\x3e\x63\xd1\x5b\xbb\xa6\x8c\x55\x3d\x5f\x10\x4c\x99\xf6\x3c\x7b\x10\x2a\x7a\x47\x1b\x1e\xbd\x05\xa8\xe2\x30\xab\xf7\x01\x96\x4b\x07\xc1\xce\xca\x74\xfe\xd1\x63\x4d\x52\x99\x94\x39\x13\x86\x56\xd5\x44\xaa\x10\x26\x24\x5c\x97\x4d\x1a\x43\x05\xc8\xd3\x10\x95\xe7\x87\x13\x7e\x23\xde\x9f\xca\x07\xf1\x40\x55\x7a\x79\x8b\x48\xf6\x6e\x8b\xe9\xf5\x93\x4d\x87\x71\x00\x48\xec\xe7\x74\x2c\x4b\x0c\x3f\xf0\x89\x79\x21\xe1\xa6\xf7\x1f\xf7\xfe\xe3\xde\x7f\x5c\x8d\x27\xf3\x1f\xdb\xe7\x9c\x8a\x90\x71\x0d\xe5\xc9\x5b\xa7\x39\xca\x97\x80\xb6\x1b\x77\x77\x99\x35\x38\x90\xbb\x97\x96\x72\x89\xd1\xe2\x4e\x43\x6d\x01\xb5\x03\x4e\x6e\x4d\x3b\xb5\xaa\x83\x17\xa0\x3c\x43\x03\xd1\xed\x80\x6e\x38\x80\x8f\xd6\x0f\xdd\xd8\x94\x3c\x38\x62\x99\x15\x5e\x09\x8d\x9a\xb5\x1b\xa1\x4a\x91\x4c\x2f\x08\x08\x64\x54\x08\xe9\x6e\x5b\x7d\xe6\xda\x9d\xe8\x18\xa1\x95\x78\x93\x8a\x48\xe1\x3f\xba\xa0\x09\x73\x3d\xd2\xc3\xb5\x1d\xe5\x88\xe9\x82\x5a\x3b\x6a\x89\x3b\xee\xb9\x65\xa9\xc9\xc1\x08\x58\xd2\xc9\x8c\xe5\x34\x82\xf5\xd4\xe3\xb5\xdd\x19\x28\xe0\xca\x35\xb1\x82\xa9\x61\xae\xe0\x1c\x53\xb9\x26\x72\x12\x87\x63\x52\xdf\x81\xae\xa4\xd7\x1c\x5f\xb1\x2d\x8c\x4e\xee\x58\x12\x48\x17\x57\xa2\x79\x79\xc6\xcb\x05\x9b\x2b\x57\x95\xd5\xcd\x8d\xd5\xa9\xb3\x58\x4f\x22\xa9\xa3\x5e\xea\xb8\x09\x60\x7a\x6e\xef\x9e\x08\x2d\xb1\xce\x20\x02\x62\x61\x85\xca\x68\x4f\x55\x94\x6d\xa1\x77\xa3\xc7\x00\xed\xdd\xe8\x7f\x40\x37\x7a\xe3\x3a\x0d\x5c\xda\x23\xcf\xb9\xd6\xa3\x7d\xde\x2d\xff\x07\xec\x91\x4b\x69\xf1\xda\x85\x53\x13\xe2\x2e\x56\xef\x88\xf7\x5e\x78\x22\x55\xcb\x9d\x7e\x7c\x3c\x1c\x1e\xc7\xec\x4e\xe5\x9b\xf7\x07\xb1\x34\x93\xc1\x5f\x08\x13\x89\x4c\x1d\xb1\xdb\x79\xab\xc8\x49\x5a\xa1\xb0\xb6\xe6\x35\xc3\x12\xf2\xd0\x7f\x03\x1c\xf6\x51\x40\x5d\x2c\x17\xac\x2f\x66\x81\x9d\x58\x79\xa8\xbc\xf8\x7a\x67\x01\xad\x16\xcb\xaa\x6a\x8e\x0e\x31\x71\x47\x31\xb8\x22\xbd\x7c\x16\x60\x69\x92\xf1\x9c\x1b\xd7\xd8\xdc\xde\x3e\x4c\x47\x08\xce\x76\x9c\x38\x00\xc3\xa4\x28\xcf\x3c\xb0\x61\xce\x72\xa9\x16\x67\x15\x40\xfb\x65\x9c\xe0\xd5\x98\x8d\x87\xe6\xea\xeb\x27\xa5\x52\x4c\x98\x6c\xf1\xc4\x92\x60\x85\x3f\x7c\x65\x97\x7a\x2c\x65\x3c\x56\x5e\x61\xb0\x77\x57\x77\x7e\xb4\xbc\x32\xa9\x0a\xf7\xea\xb3\xca\x1f\xe1\x7a\x95\x8a\x39\x99\x53\xa5\xe3\xce\xf1\x0e\xc2\x5c\xca\xe7\x5c\x4b\xa4\xb7\xb7\x1e\x54\x2c\xde\x45\x1d\x0d\x02\xf2\x4e\x37\xc6\xde\x7e\xba\xc3\x22\x37\x5a\xa2\xa1\xd7\x60\x69\x8a\xd2\xf8\xbb\x2a\x7a\x2b\x3d\xab\x0b\x15\xe7\xab\xd3\x59\xa5\x20\xc7\x9d\x49\xe2\x3c\x6b\x47\x2f\x8f\x22\x1f\x2b\xa8\x31\x4c\x89\x0b\xf2\xbf\x27\x7f\xfb\xea\xb7\xc1\xe9\x37\x27\x27\x3f\xbf\x18\xfc\xc7\x2f\x5f\x9d\xfc\x6d\x08\xff\xf9\x97\xd3\x6f\x4e\x7f\x0b\x7f\x7c\x75\x7a\x7a\x72\xf2\xf3\xf7\x6f\xbe\xbb\xbb\xbd\xfe\x85\x9f\xfe\xf6\xb3\x28\xf3\x7b\xf7\xd7\x6f\x27\x3f\xb3\xeb\x5f\x90\x40\x4e\x4f\xbf\xf9\x53\xe4\x44\x3f\x0e\x1a\xed\xfd\xb8\x30\x03\xa9\x06\x6e\x4b\x2f\xa0\x5d\x4d\x14\xb8\x80\xee\xdd\xce\x75\x7d\xf5\xd7\xad\x09\x30\x7d\x5d\xda\xc3\xe9\x2e\x4f\x74\x70\xbb\xa9\x1b\x61\x79\x87\xd5\x36\xe2\xe3\xe2\x9e\x4b\xac\x5b\x04\x70\xf0\x17\x5f\xf1\xe8\xac\xf9\x6b\xff\x5c\x3b\x55\xcb\xb0\xbc\x90\x8a\xaa\x05\x49\xbd\x81\x17\x13\x98\xb7\x5a\xe5\xaa\x51\xe6\x6a\x6d\x76\x5d\x4c\xe1\x67\x58\x61\xca\xd5\xbe\xd3\xe2\x73\x96\xf2\x32\xef\x62\xb6\xff\x09\x7a\x4b\xf8\xee\x15\x21\xb0\xca\x81\xf3\xbe\x07\x24\x11\x8d\x69\x72\xef\x54\xd1\x0a\xdd\x4e\xde\x6d\x16\x37\x3f\x8a\x0a\x3f\xc9\x19\x15\x95\x13\x01\xc2\x9f\x64\xca\xec\x7e\x04\x90\x6e\x9e\x51\x62\xbf\x8b\xba\x75\x91\x09\x3e\xac\xf5\xc4\x83\x3b\xb5\xd2\xff\x1b\x10\xaf\xdc\x46\x23\x41\x3e\x05\x39\x90\x0e\x05\x7a\xf8\x3f\xd9\x0f\x56\x02\x45\xba\x51\xf1\xd2\x47\x17\x99\xa3\x83\xa4\xb1\xd4\x56\x42\x1a\x9a\x11\xea\x2b\xd7\x4d\x48\x26\x13\x9a\x55\x14\x1b\x29\x36\x3a\x6f\x37\xd7\xa4\x62\x1d\xc1\xef\x6d\x29\xd6\x62\xce\x09\xef\xf8\xd6\x12\xd0\x16\x14\x3a\x36\x27\x74\x9c\x31\x78\x83\x13\xd5\x2b\x2a\xbd\x83\xae\x1d\x1f\x79\x5e\x62\x3b\x7f\x94\xda\xae\x4d\x8a\x36\xa4\x7a\xd2\x0f\xa1\x0d\x18\x5e\x01\xca\xb9\xb0\x13\x58\x72\xa3\xd9\xc3\x35\x0a\xf4\x52\x9b\x7b\x90\x30\xa1\xdc\x41\xb0\x8c\xe8\x12\x74\x55\x3f\x63\xaf\x4f\xc9\xc9\x52\x8b\x6a\xf4\xd1\x72\x4c\x78\x85\x93\x08\x9e\xf9\x16\x69\x9e\x49\xe0\x9d\x61\x2e\x89\xcd\x6d\x2e\x29\x85\x0f\xa9\x5d\x61\xed\xeb\x8f\x72\xa9\x99\x1a\x4c\x4b\x9e\x76\x3d\xc4\xcf\x5c\xa6\xdc\x59\x92\x8c\xb8\xee\x27\x88\xa6\xd9\xed\x18\xcc\x57\xed\x1b\xfe\x35\x1f\x2b\x46\x5e\xcd\xa8\x10\x2c\x8b\x11\xc4\x1e\x6f\x92\xd5\xae\x90\x63\x09\x1b\xe7\xb4\x5f\xdb\x23\x6b\xcf\x17\xfd\x1f\xa1\xfe\xcd\x13\x97\x6b\x77\x81\x66\x58\x56\x87\x6e\xee\x84\x6d\xf4\x48\xc0\x9e\xf2\x84\xcd\x9d\xb2\xb2\x53\x5b\xa7\xda\x5f\xf1\xfa\x15\x31\x54\x4d\x99\xb1\xa0\x88\x28\xf3\x31\x43\x32\xbf\xe7\xdd\x32\xe8\x73\x2c\x07\x74\x98\x8a\x3e\x6e\x7b\x7f\xfa\xe9\x6d\xa7\x0a\xbc\xeb\x28\xe5\x41\xaa\x2c\x7d\xe0\xa9\x4b\x61\xc0\x5e\xff\x27\x76\x0a\xa7\x9f\x5f\xb9\xdc\x87\x07\x9e\xee\x8e\x3a\x5f\x08\x00\x50\x47\x00\x77\xbe\x40\x0c\xc7\x0b\x50\x27\x30\x95\x53\x72\xcd\x5d\xb3\x69\xfb\x97\x73\x72\xe4\x63\x2e\x5c\x90\x87\x9c\x34\x36\x1c\x7b\x0b\x88\x14\x4e\x7f\x70\x38\x42\xc3\xea\x71\xe9\x9c\x11\xd0\xd1\x5e\xf3\xbc\xcc\x0c\x15\x4c\x96\x3a\x5b\x20\x09\xf5\xf9\x6c\x62\x8c\x1c\x93\xb1\x8f\xee\x50\xc6\xca\x33\xd5\x83\x6d\xb9\x66\xca\x04\x53\x3c\xc1\xfb\x59\x57\xba\x7f\x42\x2e\x89\xe6\x52\xb0\xf4\xbc\x12\x72\x5c\x13\x5e\xc8\x90\x62\x98\xa0\xd4\x31\xb5\xb2\x4c\x91\x95\x53\x8e\xf0\xf8\x7f\xf2\x7c\xa8\xb8\x5c\x28\x67\x54\xa8\xf4\xc0\x98\x1a\x01\x9f\x3e\xe5\xe8\xb3\x91\xd8\x1a\x5a\x1b\x56\x33\xaf\xa7\x9a\xb2\x82\x09\xcb\xae\x44\xf3\xa4\x38\x04\x1d\x64\xa7\x7c\xe4\xc0\x73\x0f\x3b\x6f\xdc\x12\xd7\x1f\x8d\xa2\x96\x99\xe7\x96\x21\xfb\xf9\x63\x45\x89\x09\xa1\x02\xcb\x98\x9f\x2e\xa5\xa1\x17\xc3\xaa\xf1\x8c\x4a\x6c\x3f\x45\xba\x9b\xbb\x69\xfc\x01\xd7\x21\x32\xa8\x91\x4a\x86\x27\xec\x3a\x95\xcc\xcf\xb8\x59\x01\x68\x29\xa5\x0c\x09\xb5\xbd\xf4\xed\x29\x65\xf1\x89\x64\x1b\xf0\xd0\x17\xa9\xec\xf3\xcb\xd6\x3d\xf0\x59\x64\xd4\x4c\x32\x99\xdc\x47\xd7\xc1\x7e\xed\x9e\x5a\xb2\xf6\xf9\x0f\xe3\x0b\x61\x3f\x62\xdd\xf3\x5c\xa6\x21\x6a\xe0\x4c\xe9\x61\x32\x96\x1d\x28\x69\xcf\x3c\xb4\xe6\x22\x63\x66\x99\xa2\x2a\x05\x2a\x40\x3e\x52\x66\xa6\x86\xea\xce\x6d\xa4\x96\xfb\x6c\x6a\x66\x5c\xbe\x1a\xb4\x65\xc9\x99\xa1\xf6\x53\xac\x7b\xf9\xbf\xbc\xe8\x2d\x5a\xf0\xac\x38\x1d\x30\xe3\x13\x97\xd0\x52\x6a\x22\x85\xe6\x29\xf3\xf3\x49\xed\xce\x27\xe8\x94\xa1\xd8\x86\x9e\x6e\xbe\x1f\x3e\x74\xaa\xdc\x6a\x1f\x5b\xc2\xa4\x27\x23\x70\x28\xf0\x5f\xf1\x29\xfb\x95\x3e\x0f\x1e\x92\x0a\x77\x1e\xea\xfe\x97\x1e\x71\x72\xa7\x09\xbb\xad\xda\x2c\x77\x68\xe2\x7b\xfc\xdd\xab\xeb\x36\x80\xf6\x71\xfe\xee\xd5\x35\xb6\x8b\xef\x8a\x7a\xbb\xde\x6e\xbf\x74\xb4\x11\x80\xbd\xcf\x6a\xad\xe1\x7e\x57\x57\xff\x34\x61\x75\x9b\xea\x94\xeb\xfb\xe7\x5b\x0a\xdf\xd3\xf2\x5e\x7a\xf4\xdf\xf1\xe2\x82\x5c\x0b\x5d\x2a\xbf\x5d\x6d\xab\x78\x84\x82\x04\x32\xdb\xe3\x8a\x6a\x50\x41\x91\x50\x3f\xbb\xd2\xfa\x3b\xd1\x1b\xf2\x5d\x4e\x0c\xc2\xfb\x35\xb0\x7a\xbc\x92\xf9\xd3\xfa\x35\x0a\xaa\x0c\x68\xe4\x5d\x8e\xc4\x1d\xa4\x72\x7a\x00\x61\xd1\x7b\x39\x10\x9b\x7b\xfd\xe3\xb5\x24\x17\xf4\x30\x5e\x84\x19\xd9\xbb\xb7\xd9\x29\xe2\x75\x15\xfb\x8a\x84\x79\x9e\xb2\xf9\xb9\x4e\xe9\xcb\x33\x98\xb2\xf6\xe5\xaa\x4c\x0b\x0b\x54\x93\x23\x7c\x0a\xd4\x88\xe7\x3c\xa3\x2a\x5b\x34\x73\x44\x1a\xd0\xac\x6c\x10\x5e\x8b\x3d\x5d\x9a\x1c\xbd\x38\x22\x27\x52\xc1\x2c\x13\x2a\x48\xc6\xe8\xdc\x69\xee\x9e\x59\x2e\x9c\x6a\x88\xb6\x06\x3c\x3d\x57\x27\x4f\xe2\xf8\x2a\xd2\x8e\x72\xe1\xf1\x07\x90\x58\x5a\xa6\xd4\xdb\xab\xfa\xce\x45\x67\x43\x7f\xf7\xea\x7a\xe8\xda\xfc\x2d\x97\xb2\x0f\x4d\xcf\xe1\x17\x4f\x12\x58\xd5\x71\x9b\x9e\xb0\xf7\x08\xca\x2a\x85\x35\xc4\x6e\xe8\x65\xbb\x6a\x40\x7b\xde\xa7\xe4\x70\x2d\xa1\xdd\xe9\xd8\xab\x78\xcc\xcd\x7b\x56\x20\x6e\xf4\x25\xa1\xd8\x3d\xb6\xe4\xef\xe1\xc6\x7e\x20\x35\x47\x06\xa9\x52\x03\xc5\x90\x95\xe1\x49\x99\x51\xab\x27\x3b\x6f\xcf\x90\x5c\x5d\xdf\xbe\xbf\x7e\x75\x79\x77\x7d\x75\x41\xc2\xbb\x50\x37\x4d\xad\x70\x0d\xc9\x9d\xac\x1d\x48\xae\xe7\x81\x4f\xd1\x80\x9c\x21\x98\x2f\xea\x74\x14\xf2\xcc\x5f\x5d\x54\xd4\x41\x6b\x50\xfc\x9b\x0a\x72\x23\xb8\xa9\xb3\x3f\x90\x97\x6c\x92\x49\xe1\x73\x0c\x2c\x7c\xef\xd3\x9a\x72\x73\xe6\x04\x79\xdf\xdd\x7a\xc6\xaa\xf7\x21\x80\x56\xe5\xc8\x6f\x5d\xb8\x6f\xb5\x60\x84\xed\x2d\x52\x85\x0f\xa1\xb1\x1d\x58\xc6\x9d\xf3\xa4\x57\x20\xbc\x14\xf0\x26\xa4\x80\xf9\x59\x63\xcd\x99\xab\x35\xbd\xa1\xda\xfb\xf1\xf0\x38\x08\xde\x59\x48\xf8\x44\x82\xf4\x57\x7e\x3d\xc1\x90\xe8\x69\x51\xdb\xa6\xf1\x21\x21\xef\xcc\x8c\xa9\x07\xae\x19\xd6\xbc\xc6\x57\x4a\xd1\x07\x07\x39\xaf\x43\x0b\xc3\xbb\x90\x30\xeb\x19\x55\xf9\xce\xe5\xb8\xb9\x00\x5f\x00\x77\xca\xe7\xe8\x2c\x5a\xd8\x96\xc3\x5c\x37\x61\xb2\x1d\xa8\xe7\x7d\xbd\xd2\x0f\xef\x7f\x38\xcc\xf4\x1c\xc7\xe8\x30\xb9\x57\x32\xcf\xb9\x21\x33\xaa\x67\x55\x21\xaa\x3a\xf1\xbc\xe2\x6e\xfb\x9f\x75\xcc\x1d\x12\xc1\xa1\x63\xee\x11\x57\xec\x32\xba\xbb\xf7\xf1\x77\xe1\xc1\x25\xb3\x4a\xf5\x31\xb0\x42\x8c\xd7\x53\x3c\xd6\xe3\x7b\x25\xfb\x01\xc5\x4f\x31\x2d\x21\xab\x95\x1f\xae\x5b\x20\x13\xa9\xeb\x2a\xd6\x45\x42\xbb\x0e\x0f\xbf\x6d\xf4\xd4\x08\x10\xe1\x94\x63\x15\x38\x8b\xd4\x94\x19\xca\x33\xdd\xd8\x1f\x23\x0b\x99\xc9\xe9\x62\x8f\xad\xf7\x1e\x41\xed\x97\x2e\x47\x7a\x40\x07\x76\x57\x0f\xa3\x7f\x77\xeb\x11\x1e\x52\xc3\x2d\x7a\x6b\xec\x54\xfa\xa3\x99\xed\x20\xb4\x3e\x2b\x04\x1d\x4c\x5d\xa8\xb0\x16\x77\x51\x3b\x9b\x55\xe8\x10\x0a\x77\x5d\x5d\x43\xb0\x60\x2a\xe7\xda\xb2\x5d\x74\x49\xd0\x55\x6d\xe3\xff\x63\xef\xea\x9e\x1b\xb7\x91\xfc\xfb\xfd\x15\xa8\xdc\x55\xd9\xbe\x58\xf6\x64\x77\x6e\x6a\x6e\x6a\x6b\x53\x1e\x7b\x66\xd7\x95\xf9\xf0\x8e\x9c\xec\x43\x36\xb7\x05\x91\xb0\x84\x98\x22\x18\x02\xb4\x47\x75\xb9\xff\xfd\x0a\x8d\x0f\x92\x12\x29\x35\x40\xc9\xf6\xcc\x0a\x2f\xc9\xc8\x62\x0b\x00\x81\xfe\xee\x5f\x3f\xd6\x2b\xd9\x85\x09\xe1\x79\x09\xc6\xdc\xc0\xd4\xde\x05\x08\x09\xcd\x9b\x71\xe0\x30\xed\x53\xf3\x57\xfb\x5c\x5b\x44\x14\x25\x1b\xb1\xcf\x5c\x82\xcd\x88\x44\xa6\x12\x65\x43\xaf\x6b\x4a\x0c\x97\x25\xef\x5c\xf3\xe6\x5b\x28\xd3\x75\xc9\xe9\x5e\x6b\xdd\x3e\x98\x02\x89\x70\x34\xcb\x16\x80\x15\x82\xf1\x89\x6b\xb5\xd6\x78\xb6\xe9\x14\x96\x2b\x4a\x1b\xde\x2d\x4a\x7e\xc7\x33\x36\xd5\x3f\x38\xe3\xf9\x14\x5d\x33\x42\x4b\x46\x68\x96\x89\x7b\x66\xe1\x74\xd8\xca\xea\xf5\x41\x97\x2a\xac\xbc\x05\xee\xf0\x87\x8f\xd7\x24\x67\x66\x4a\xdd\xbd\x3d\x43\xcc\x6e\x3d\x25\x64\xd9\xe7\x68\x34\x02\xcf\xef\xe1\xaf\xda\x9e\x4a\xb3\x23\xf2\x77\x66\x67\xa2\x8d\x53\xcd\xd3\x12\x45\xee\x67\x02\xbc\x6e\x38\x50\x1a\xd8\x91\xfa\x94\x00\x63\x31\x50\x0a\x96\xce\xa9\xa6\xa5\x4d\x15\xac\x6a\xd2\xa2\x08\x28\xa7\x92\xd4\x19\x40\x5b\xb7\xce\x06\x49\x30\x17\x10\xec\xba\x25\x01\xdd\xb6\x3c\xa6\x20\x20\xcf\xc9\xc5\x3c\xe3\xf9\xed\x31\xe1\xca\x31\x7d\x7d\x12\x03\x8c\x32\xfd\xb8\xbb\x61\x25\xa3\x99\xc3\x27\xd9\xce\x49\xdb\x89\x9c\x54\x91\x61\xad\xeb\x45\x61\x32\x3a\x3d\xdb\xb3\x29\x83\x17\x61\xa8\x01\x4a\x90\x6f\xbe\x79\xc2\x3b\x14\xe4\xf8\xda\xb2\x24\xe2\x32\xbc\xfb\xc3\xc1\xe5\xf8\x7c\xdc\x82\x45\xa5\x39\x31\x9f\xd9\xb0\xf0\x96\x0a\xb7\xb6\x5b\xb9\x15\xa2\xbc\xc0\xb6\xec\xce\x8e\x49\x66\xb4\x38\xab\xd4\xec\x82\xcb\x44\xdc\xb1\x28\x07\x80\x6b\x45\x62\x43\xaa\x84\xbb\x17\x60\x28\x92\xf3\xbf\x9e\x5d\x21\x2f\x08\xad\xf4\x9e\x29\x9e\xe0\x93\xfd\x42\x53\x1f\xdd\x8a\xc7\x4c\x46\xfa\x14\xba\xd7\x6b\xe9\x3d\xb1\xd5\xee\x43\xf9\xfb\x50\x7e\x43\x76\x00\x37\xf9\x57\x0b\xdf\xf3\x9c\x2b\x4e\x95\x08\xc0\x94\x6a\xfb\x10\x2b\xa9\xc4\xdc\x5e\xf3\x4b\x47\x0c\xd2\xde\x20\x08\xdf\xa2\x8f\x0f\x40\x37\xfa\x86\x72\xd0\xca\x12\xc9\x7d\xdf\xa2\xa5\x22\x27\xac\x27\x3b\x67\xf7\x76\x9e\xdc\x53\xfa\x93\x2d\x94\xd3\x77\x84\x66\x7f\x7e\xf5\xa7\xa0\x78\x7e\x4e\xe7\xec\xcf\xde\xdf\x6e\xe1\xf0\x1a\x8d\x79\xa0\xf7\xfd\x6e\x5c\xa8\x84\xf0\xdf\x62\xf8\xb3\x0d\x67\x98\x7d\xf8\x5b\x45\x33\xb3\xcb\x1f\x76\xe5\x3d\x6f\xbf\xb9\x88\x09\xbb\x93\xe5\xde\x18\xb8\x05\x81\x2f\x56\x52\x9b\x26\xd8\xf8\x80\xa1\xa3\x4a\x9a\x4b\xfd\xb2\xdb\x51\xda\x03\x9b\x1e\x72\x40\x0e\x55\x52\x04\xf5\x59\xd8\x71\x01\xb0\x99\xb6\x7d\x6b\xef\x7c\xe1\x2f\x6e\x86\x0f\x90\xff\x00\xf7\x26\xc6\xd1\xdb\x5a\xd8\x15\x90\x21\xef\xb8\x54\xa6\x7a\xc9\xd0\xc5\xf3\x0b\x66\x0a\x2d\xb5\x86\x7b\x45\x44\x49\x78\xf1\x4f\x9a\xa6\xe5\x2b\xa3\x83\x38\x60\x68\x34\x56\x23\x97\xd6\x7d\x01\x95\x06\x2e\x77\xe8\x50\x2d\x0a\x9e\x80\x63\xe4\xfa\xfc\x0a\xe8\x49\xf2\xf2\x45\x08\x18\xfe\x1f\xff\xf0\xe2\x19\xf2\x78\x3d\x9d\xa2\xcc\x41\x3e\xd4\xa7\x9c\x71\xf1\x90\x95\x41\x5a\xf5\xb5\x35\x41\x20\x21\x2c\x3f\x32\xe7\x1f\x67\x32\x91\xa6\xbc\x8e\x51\x90\xf7\xd5\x2a\xfb\x6a\x95\xbe\xaf\xc3\x41\x34\x7c\x78\x3b\xdc\xdc\x30\x72\xcb\xd9\xd1\x69\x97\x58\x46\x8e\xa7\xf8\xe8\x8c\x7c\x67\x5e\x26\xfe\xdb\xe6\x6b\x3f\xd2\x7a\x07\xe2\x5b\xcd\xf7\xbf\x4d\xcf\x15\x8e\x81\xb4\x19\x87\xe1\xed\x07\x07\xb2\x99\x6b\x03\xf0\xea\x17\x1f\xc6\xff\x7c\x77\xf6\xfa\x0d\x26\x93\x42\xbf\x25\x53\x16\x62\xdb\xcb\x44\xd7\x18\xe0\x39\xcb\xe6\xfb\x1c\x70\x18\xf2\xf0\xec\x84\x0f\x6f\xc7\x4b\x0e\x3f\xfd\x89\x05\x6f\xab\x1d\xe3\x58\x97\x5f\x5f\x4a\xc2\x50\x2f\x6d\x7e\x83\xd8\xa9\x07\x0c\x22\x38\xff\x26\xfb\xdc\xf6\x95\x7c\x78\x3b\x46\x6b\x26\xe5\x1d\x2b\x07\x3b\xf8\x51\x1b\x43\x9e\x50\x0c\x1c\xbf\x43\x66\x6f\x1f\x29\xfa\xbd\xc3\x77\x10\xa2\x3d\x96\x71\xe0\x1b\x07\x63\x78\xd2\x65\x6b\xe8\x1b\x6c\xd2\xc6\x4b\x2d\x24\xb5\x78\x64\x12\x2d\x0d\x6f\xdc\x7b\x7b\xda\x67\x76\xeb\xd1\x96\x91\x5d\xef\x36\x85\x5b\x9d\x6d\x6d\x44\xd6\x79\x46\x39\x02\x0e\x76\x89\x03\x75\x11\x31\xff\x3b\x36\xb5\x00\x41\xdd\xa4\x1a\xad\x75\x4d\x98\xa6\x93\xbe\xcf\xbb\xc4\x79\xe5\x7c\xff\xa3\xe8\xd3\x52\x6f\xd5\xc8\x1d\x9c\xfa\x23\xf3\x49\xa2\x27\xb6\x75\xc1\x00\x54\x63\x8b\x34\xce\xdd\xc3\xcb\xc8\x37\x3d\xdb\x8a\x36\xe2\xfc\xe6\x37\x5b\x4b\x49\xdf\xb2\xab\xb2\xbe\x5f\xb4\x92\xeb\x70\x54\x1f\xff\xed\x90\x07\x95\x4e\x7f\x5f\x8f\x2b\x82\x2f\xa3\xe9\xf4\x27\xec\xce\x97\x10\xc2\xde\xfc\x09\xde\x2a\xeb\x9a\x09\x25\xf2\x41\xa5\xc6\x57\x1d\x24\xda\x29\x4f\xe6\x1b\xe7\xa6\x58\x3f\xc3\x39\x14\x3d\x39\x53\xbf\xe4\x43\xce\x5a\x9d\x77\x6a\x83\xc8\x5d\xf0\x19\x73\x3f\x82\xaa\x93\x1f\xa8\x18\xf8\x2b\x00\x99\xda\x4d\xa8\x6f\x17\xfc\xa5\x48\xa3\x00\x07\x2e\x2f\xac\x61\xe0\xe0\x02\xa4\x3d\xd0\x24\xe8\x44\x93\xd5\x53\xfd\xc8\xea\x52\x7a\x79\xb1\x55\x56\x22\x4a\x75\x2f\xca\x38\x30\xbe\xab\xd6\xc3\x4b\x19\x93\xf6\x6f\x88\xed\x5a\x42\x27\xe9\xe3\x16\x4f\x93\x17\x8c\x21\x85\xaa\xb1\xf4\xf8\x88\xbf\xaf\x5a\xae\x7d\x26\xbd\x0c\x02\x49\x77\x6d\xde\xc0\x2a\x83\x40\x27\xdd\x79\xf6\xf1\x78\x8c\x61\x80\xe2\xb1\x01\x24\x2d\x34\xb2\x81\x01\x49\x5b\x09\x7b\xec\xc4\x4a\x35\x37\x29\x8a\x61\xfe\x64\x1f\xb5\x6e\x37\xfd\x66\x6b\xd6\x49\xfd\x55\x0f\x52\x69\x1f\x97\x55\xba\xcd\xd8\x2a\xbb\x2c\xc5\xaf\xd0\xae\x3a\x90\x53\x9a\xce\xc8\x37\xa2\x04\xb4\x33\x6e\x00\xd0\xea\x16\x6a\x16\xfe\x0c\x13\x87\x48\x44\x7e\xc3\xa7\x73\x5a\x48\xd3\x59\xb1\xd9\x60\x79\xcb\x4c\x71\x58\x77\xf3\xf7\xfd\x2d\xcd\x0d\x5b\xc3\xb3\xb0\xcd\x2d\xcd\xeb\xfe\x9b\x58\xd7\xd3\x4a\x03\xf3\xfe\xd6\xe4\x21\xbc\x76\xb9\x81\x79\x7f\x6b\xf2\xa0\x08\x48\x47\x83\xce\xae\xa6\x9b\x48\x9a\x3d\xad\x39\x7b\xda\x6d\xe2\xe3\xbb\x4f\x25\xb1\xc1\x5e\xab\x88\x43\xeb\xda\x96\xbb\x52\x2a\x73\xdd\xd1\x08\xa1\xf1\xad\xc8\xaf\xfc\x2f\x99\x77\x6c\x31\x1c\x3d\xbf\x41\xc7\x1a\x69\x26\xf2\x69\xb3\xb7\x6b\x6d\x53\xb8\x0c\xc7\x45\x81\xbe\x7c\x31\xdd\x0e\x0d\x87\x7a\x4f\x8b\xe8\x96\x95\xcd\x76\xea\x74\x22\x2a\xe5\x52\xb2\x0c\xdd\x00\xb2\x06\xb7\x0c\x50\x33\xcd\x4e\x06\x3c\x1b\xdb\xe9\x31\xe8\x10\xf8\x69\xb6\xa4\x45\x4b\x7b\x3a\x26\x8c\x26\x33\x72\xcb\x16\x23\xe0\x7a\x81\x94\x09\x29\x28\x40\x08\xc0\x26\x5e\xe8\xed\x30\x7d\xae\xdb\xd1\xfd\x24\xa2\x7f\xe4\xb9\x7b\x23\x3e\x8d\xce\x9f\x57\x0f\x10\x10\x4c\xd4\x59\x03\xd2\xaa\xbf\xe4\x7e\x26\xa4\x75\xde\x19\x47\x5e\x30\xc9\x5b\xb6\x00\x56\x97\x88\x1c\xcc\x38\xeb\x0f\x84\xdd\x84\x44\xc7\x7a\xaf\x83\x69\x9b\xd6\x38\x52\x2f\xf9\x96\x2d\xe4\xea\x4e\x04\x53\xf4\xd0\x0a\x75\x1e\xa5\x16\x4b\x56\xde\x57\xb9\xf9\xb5\x98\x4d\xb0\xb3\xb3\x0d\x7c\xad\xc5\x02\x1b\x40\xf5\x9f\xc3\x67\xda\xca\xf5\x84\xd6\x42\x5c\x02\x79\x4b\x3b\x62\xed\xb0\x72\x7f\xb0\x5a\xa0\x05\x92\xa9\x2a\xec\xf2\x13\x57\x34\x06\xa9\xbd\x7a\xef\x98\x94\xc4\xb4\x31\x9a\xd3\xf2\x96\xa5\xbe\xed\x69\x68\x7f\x76\x02\xfd\x99\xa5\x47\xf3\xf7\x4d\x86\xc1\x7a\xa5\x0b\xbd\x0d\xc1\x24\x5b\x7d\x94\x4f\x4e\x0e\x8c\x3e\xd2\xc2\x9d\x08\xa6\x09\x38\x15\x81\x4f\x45\xb1\xb0\x65\xfd\x8f\x16\x50\x8e\x65\x1a\xc6\xe9\x2b\x18\xd1\xcc\x92\x00\x68\x8b\x9a\x39\x1d\x8d\x06\xa1\xce\x37\x47\x2c\x37\x37\xe3\x96\x21\xed\xcc\xe5\xd1\xce\xdf\x9d\x31\xbb\x11\x8e\x3d\x84\xaf\x83\x0c\xe9\xb2\x6b\x46\x68\x13\xfe\x7a\x6c\x6c\xc7\x1f\x45\x95\x34\x2c\x84\xd5\xc6\xfc\x91\x24\x87\x9b\x08\xcb\x63\x6b\x8d\xfd\xeb\xb1\xbe\xc5\x7f\x24\xd1\x5e\x8b\xc3\x28\x84\x91\x54\xd7\x59\x20\xb1\x13\xf5\x76\xcb\x8a\x45\x12\x49\x71\x1b\x76\xcc\xf2\xd0\x76\x4d\x68\x37\x5d\x33\xc2\x0d\x9d\xe5\x31\xa4\x4f\x35\x09\x4a\xac\x59\x1e\x2b\x7c\xab\xd5\x44\x3f\xf6\x0c\xdd\xd4\x46\xab\x12\x64\x4e\x0b\xf8\xb7\x61\x89\xb1\xd7\xf2\xbd\x91\xb5\xb6\x4b\x29\x9d\x48\x91\x55\x2a\x0c\x05\xa3\x8f\x64\x53\x1c\x83\x14\x62\x19\x9b\xc7\xa8\x35\x66\x18\xc4\x28\x47\xbc\x01\x25\x15\x7f\xe0\xad\x74\x8d\x91\xf1\x66\x0c\x12\x25\x31\xdd\x9f\xcd\x18\x45\xe9\x9b\x41\x1d\x9a\xeb\x11\xd1\x3b\xba\xfd\x28\x36\xd3\xbe\x1e\x61\xa9\xd7\x66\x20\x12\xb0\x83\x97\xbe\xb3\x64\xc9\xe0\x99\xec\x24\x19\xdb\x8c\x98\x94\x6c\x33\xa2\x4f\xbf\xb3\x1a\x06\xbd\xe3\xb1\x85\x90\x75\x65\xb7\x2d\xcb\x27\x78\x17\x44\x49\xb8\x92\xc6\xcc\x73\x46\x89\x6d\xd4\x1a\xb5\x2b\xf8\xa8\x43\xfb\xb9\xc0\x7b\xe6\xfc\xd7\x67\x57\x97\x5b\xf6\x17\x35\x28\x7f\xfd\x1e\x23\x88\x2f\x00\xfe\x85\x73\x61\x5e\xd4\xcb\xff\x29\x4e\x47\x8d\x50\xed\xb6\x61\x38\xae\x4c\xfc\x6d\x9c\x8a\xd9\x88\xc9\x36\x0f\x88\x12\x36\x0c\x11\x41\xd2\x6b\x2f\x8d\x6e\x3e\x2e\xed\x0b\x38\xd9\x03\x9b\xa5\xf0\x9b\xe8\x92\xa6\xe5\xb1\x9c\x2a\x6c\x85\x39\x19\xb3\x4c\xf3\xfb\x68\xc3\xab\xe5\x5e\x2c\x44\xfa\x8a\x40\x82\x30\xcd\x73\xa1\xe0\x1d\xa0\x02\x5d\x5d\x23\xa3\x13\x96\xc9\x63\xe3\x0c\xd4\xa6\x42\x23\x01\x0f\x0d\x91\xbc\x3c\xbc\x83\x3c\x52\xcd\x1f\xf6\x0a\xf5\xa8\x85\x5f\x2c\x85\xe5\x60\xae\xa1\x66\xdf\x41\x34\x4d\x42\x64\x32\x63\x73\x0a\x2f\xf2\xad\x7e\xab\x16\x78\x6e\x00\xc5\xfb\x92\x2b\xc5\x0c\x5e\x3c\x2b\xe7\x92\x88\x9b\x63\x67\x07\x0f\xa1\xab\x04\xf9\xe6\x0e\x0f\xbd\xde\x41\x60\x98\x53\x85\xb8\xeb\x88\x03\x1d\xeb\x1b\xed\xa0\x50\x03\x24\x29\x8e\xbd\xd4\x03\x60\xb8\x32\xe8\xeb\x95\xb7\xbd\xcb\x03\x88\x9e\x5d\x5d\x92\x3b\x73\xd6\x1e\x71\xe3\xe3\xcd\x10\x02\x46\x85\x7f\x6d\x03\x2c\xa8\x28\xeb\x82\xec\x9d\x71\x7b\x67\x5c\xcc\xd8\x3b\xe3\x82\xc7\x57\xe3\x8c\x6b\x28\x6a\x0e\x06\x76\xd0\x4b\x6a\xb8\xf5\x5a\x0d\x2d\x06\x9c\x25\x93\x0a\x68\x93\x7d\x1a\x70\xeb\x13\xe6\xfd\x73\xb1\x07\xbf\x6c\xc7\xc8\x0e\x4e\x4e\x0e\x0e\x86\xf9\xfa\xac\xa9\x5c\xa9\x9b\xd1\x4b\xc2\xf2\x44\xa4\xe6\xca\xeb\x1d\x28\x51\xe5\x96\x5d\x43\x1b\x42\xb5\xfb\xa6\xb9\xc7\x9a\x93\x46\x12\x5d\x72\x1b\x9a\xd5\xc7\x5d\x87\x81\x42\xd7\xa5\xae\xbd\xdd\xa2\x01\x62\xcd\x0e\x28\x82\x42\xa3\xdb\x75\x0d\xbb\xed\x1e\x5a\xd4\xda\x1f\x3e\xdd\x2e\xd6\xf8\xe0\x73\x6e\xc1\x39\xb5\xc6\xc1\xa4\x92\xe4\xd0\x7c\x78\x92\x14\x55\xb4\x4d\x63\x28\xcc\xd9\x5c\x94\x8b\x63\x4f\x5a\x93\x8c\x95\xb6\x8d\x19\x5a\xba\x47\x90\xd4\x96\x54\x65\xc9\x72\x7c\xd2\xff\xf2\x78\x7c\x4b\xc9\xbf\x53\x7c\x51\x58\xd7\x58\x2a\x14\xf3\x1d\x35\x62\xdc\xb6\xcd\xe1\x34\x51\xc8\xf4\xb4\xc5\x58\xc7\xde\x59\x38\x80\xb0\xa6\xc7\xf2\x3b\x72\x47\x4b\x64\x19\x57\xd7\xd8\x82\x89\x93\xf2\x3b\x2e\x05\xb2\x10\xb6\x6b\xd0\x7c\xf1\x31\x92\x5d\x10\x03\x76\x30\x50\x0c\xb7\xe9\x0c\xda\x8c\x4e\x7f\x2e\x67\x71\x49\x4a\xf5\x10\x95\x2a\x2a\x65\x35\x16\xc7\xcd\x2c\xda\xe6\xa0\xc3\x69\xf9\x5f\x6d\x6f\x83\xd5\xfc\xdd\x37\xd1\x44\x0b\xaa\x14\x2b\xf3\x57\xe4\x7f\x0e\xff\xf1\xed\xef\xa3\xa3\xef\x0f\x0f\x7f\x7e\x36\xfa\xef\x5f\xbe\x3d\xfc\xc7\x09\xfc\xcf\x7f\x1e\x7d\x7f\xf4\xbb\xfb\xc7\xb7\x47\x47\x87\x87\x3f\xff\xf0\xfe\x2f\xd7\x57\x6f\x7e\xe1\x47\xbf\xff\x9c\x57\xf3\x5b\xf3\xaf\xdf\x0f\x7f\x66\x6f\x7e\x41\x12\x39\x3a\xfa\xfe\x3f\xa2\xa7\xfc\x79\x54\x07\x40\x46\x3c\x57\x23\x51\x8e\xcc\x21\x78\x45\x54\x19\x91\x5d\x67\x86\xdb\xdc\x6d\xf1\xa4\x5a\xc9\x1b\x28\x0c\x49\xd3\xf6\x7f\x44\xd6\x31\xd4\x48\x1f\xb4\x0d\x83\x6c\xf4\x21\x51\xce\x2f\x23\x5e\x19\xf5\x83\xa6\x66\x61\xcb\x31\x9b\xa0\xf6\xf2\x96\xea\x97\x18\xae\xf9\x42\x12\x7c\x2d\xe4\xd9\x3e\xbb\x77\x9f\xdd\xbb\xc5\xec\x5e\x73\xaa\x56\x53\x7b\x61\xfe\xc1\x44\xf7\xa9\xbd\x41\x63\x9f\xda\xbb\x34\xf6\xa9\xbd\x30\xf6\xd1\x84\x7d\x34\x61\x1f\x4d\xc0\x8e\xaf\x26\x9a\xb0\x4f\xed\xdd\xa7\xf6\x76\x8d\x7d\x6a\x6f\xdf\xd8\xa7\xf6\x2e\x8f\x7d\x6a\x2f\xc1\xa4\xf6\x8e\xc3\x7d\x1b\xa4\x95\xd7\xfb\x25\xa5\xf5\x4a\x7d\xda\x12\x76\x96\x24\xa2\xca\xd5\xb5\xb8\x65\x41\xb9\x6d\x28\x57\xd1\xca\x2f\x3c\x71\xbf\x11\xad\x52\xce\xf2\x70\x1f\x71\x6b\x33\xce\x2c\x11\xe7\xe6\xd0\x1a\x44\x9e\x46\x78\x0c\xdc\x6c\x1c\x27\x52\x7a\x07\x4f\xc8\x19\x29\x59\xc2\x0b\x1e\x23\x0b\x01\x5b\x11\xe8\x98\xa3\x6a\x11\x64\x16\xfa\x00\xb3\xec\x26\xce\xc8\xa5\x79\x8d\x44\x53\x36\x3c\x12\x56\x78\xbb\x65\xc4\x4c\xd6\x2f\xdb\x28\xa7\xc2\xb5\x57\x27\x72\x26\xaa\x88\x6c\xbb\x92\xfd\xea\x74\x67\xbb\x9b\xd7\x8d\x19\xc6\x67\x39\x5a\x8f\x51\x63\x1b\xec\xe4\x69\xc1\x2d\x10\xec\x43\x71\x47\xf6\xb9\xe0\x25\x5c\xc5\x31\x4b\x44\x9e\x0e\xf3\x83\xbe\x59\xa6\x16\xeb\x03\xb4\xe1\x6e\x96\x92\xb4\x32\x04\x01\xc5\x83\x66\x3c\xe5\x6a\x41\xc4\x4d\x94\xe7\xcf\xb2\x18\x42\x0d\x8f\xf1\x57\x24\x6e\x8e\xe6\x62\xd0\xa2\x28\x05\x4d\x66\x4c\x36\xf6\xd2\xd8\x9b\x16\xe8\x2c\xd6\xa7\x5a\x64\xd5\x94\xe7\xc6\xd8\x84\xdf\xd0\x3a\x7d\xb6\x20\xa5\x50\x31\xd9\xee\x6b\x36\xe0\x7a\xc0\x64\x61\x7a\x46\x41\x56\xe5\x02\x12\xe8\x85\x9d\x62\x7d\x71\xc2\x3d\x8b\x8d\xcb\x0c\x0d\x60\xb2\xd4\xf5\x0d\x78\xf9\x8c\x14\xac\x4c\x22\xf9\x19\xe0\x17\x71\xd3\xf9\x39\xd3\x36\x92\x16\xc7\x37\x43\x66\xda\x9a\xdc\x1f\x9e\x93\x99\xa8\x4a\x79\xd2\x80\x24\x0b\x26\xf9\x1d\xd0\x30\xde\x49\x07\x90\xa4\x48\xc6\xa8\x54\xe4\xbb\x67\x64\xce\xf3\x4a\xab\x84\x81\x74\x1b\x26\xee\x8b\xe7\x51\xec\x25\xc6\xb8\x8d\x31\x6b\x57\x73\xa6\xad\x6c\x2c\x4c\x1f\x67\x63\xdd\xc6\x72\x5d\x03\xc9\x67\x3a\xd8\xb7\xad\xdc\x18\x47\x65\x5b\x40\x40\x34\xe0\x81\x78\x77\x8c\x45\x17\x6c\x95\x45\xe8\x8a\xc1\x8f\x84\x18\x6e\x21\x78\x71\xd8\xec\xb0\x80\x09\xff\x56\x89\xc9\x42\x85\xc2\x6a\xfe\xcd\x3c\xd5\xc6\xd3\x74\x1f\xba\x7e\x10\x88\xad\xf2\xc8\x8f\xab\xfd\x20\xea\x76\x10\x1b\xe9\x84\x69\xbb\xd3\x52\x54\x48\xe0\xa7\xd6\x9a\xc1\x47\xe8\x3c\x47\x2e\x48\x98\x24\x4c\x42\x8e\x8a\xe5\x8e\xc8\x13\x05\x51\x2a\x33\x93\x80\x03\xf5\x10\xc0\x93\x6b\xdb\x31\xd8\x37\x8c\x5c\xa4\x03\xf3\xda\x79\x4b\x86\x80\x2d\xc4\xdb\x96\x25\x9b\x72\xa9\xa2\x9a\xf2\x7e\xb2\x8f\xb6\xaf\x87\xe4\xf9\x34\x03\xe9\x3c\xaf\x32\xc5\x0b\xb4\xe7\xda\xdd\x2b\x4f\xd6\xea\x3c\xcd\xe0\x27\xad\xa3\x5d\x48\xaa\xd4\xa0\xd5\x9a\xce\x47\x10\x9e\x3f\x74\xf3\x22\x2c\x57\xa6\x31\x7b\xa9\x15\xac\x82\x96\x14\x1f\xf0\x85\x17\x9c\x88\xf9\x9c\xca\x23\x1b\x93\xa5\x90\x33\x6b\x04\x9e\xd6\x72\x4a\x9a\xf9\xed\x45\x52\x6d\x64\x2b\xee\xe2\xc2\x28\x96\xd3\x1c\x99\x29\xd2\x76\x4e\xc3\x83\x44\xdc\xfb\x42\xcb\x29\xbf\x63\xb9\x7f\x67\x41\x01\x1a\x6b\x2f\xbe\xa6\xc9\x2d\xcb\x53\xf2\xa3\x74\xf7\x25\x5d\xe4\x74\x6e\x3b\x4a\x15\xa5\xb8\xe3\xfa\xc2\xa0\xdf\x48\x7b\x2e\xf2\xd8\xc6\xa6\x0c\x0e\xa4\xc3\xdf\x35\xaa\xf9\x2e\xf6\xb6\x92\x51\x5d\x49\x7e\x94\x5a\x03\x5d\xcf\x6d\x03\x7a\xbf\x4b\x56\xf2\xbb\x84\x39\x33\x41\x4f\x6a\x17\x8b\xbd\x43\x82\x54\x93\x6e\x70\x5b\x53\x1f\x6d\xfd\xea\x20\x13\x7d\x6a\x0c\x76\xad\x34\x27\x34\xd3\x0c\x76\xe1\xb1\x4c\xdb\x07\x40\xbf\xf1\x7c\x37\xfd\x58\x43\x74\x19\x34\x03\x18\x61\x2f\x51\x80\xd6\x53\x4e\x42\xe1\x71\x0f\x3e\xbd\xbe\x68\xf3\xf3\x4f\x34\x15\x92\xbc\xce\x44\x72\x4b\x2e\x98\x66\xc8\x88\x0d\x5d\xe9\x95\xb5\xbe\x13\x16\xe6\x1d\x05\x34\xb4\x2f\x27\xe9\xee\xda\xd9\xef\xdb\x9d\xef\xdb\x9d\x37\xfa\x36\x95\x13\xac\x7c\xfa\x7a\x9a\x9d\xcf\xe9\x34\xea\x02\x40\x88\x1b\xd8\x09\x90\x70\x7d\x09\x63\x5f\x13\xea\xfa\xff\xfb\x4c\xdc\x8f\x94\x18\x55\x92\x8d\x38\x32\xed\x3a\x70\x3f\x6e\xd9\x02\xb2\xd6\x23\x76\xe4\x07\xf3\x68\xcb\x4d\xa2\x04\x84\x98\x02\x74\x5c\xad\x35\x7e\x7a\x7d\xa1\x55\x89\xba\xe9\x0e\x97\xe4\x94\xa9\xe4\x34\x61\xc5\xec\xd4\x4e\xd1\x6c\x36\xfe\x92\x85\xf2\xda\x07\xd8\xec\xb9\xc8\xb9\x12\x65\x0c\xa4\xf4\xc1\x19\x49\x44\x96\x59\x70\x67\x71\x43\xce\x59\x31\xf3\x04\x9f\xe2\xde\x3c\xa5\xe6\xd5\x85\x10\x31\x2d\x6d\x1b\x97\x5e\x53\xb0\x77\xbe\x3e\xa4\xc8\xc5\x95\x93\xee\x86\xa3\x4f\xe5\x58\xee\xac\x35\xe4\x23\xf7\xf6\x8e\xd7\xbb\xe2\x37\xfd\x61\x3a\x89\x1f\x8c\xdd\xc3\xe0\xa6\x6a\x64\x82\x44\xb4\x04\x77\x53\x69\xb3\xe2\xcb\x1b\x63\xc6\xa6\x2c\x25\xe2\x8e\x95\x25\x4f\x99\x74\xe2\x22\xd0\x0f\x04\x93\xe4\xd9\xc3\x5e\x82\x7d\xa7\xf3\x7d\xa7\xf3\x9e\x11\xe9\xee\x68\x88\x03\x4d\x21\x56\x1c\xd0\x74\xce\xf3\x27\x2c\x10\x82\xfa\x8f\x6b\x5d\x18\xf1\x3d\xa7\xa8\x6c\xd3\x41\x20\x13\x9a\xb1\xcb\x8f\x81\x4e\x82\xb1\x79\xaa\xed\x27\x70\x1f\xd6\x4d\xda\xd0\x7e\xa4\xde\x86\x63\x3f\xf8\xeb\x4b\x72\x91\x62\x22\xa7\xfb\xc6\x83\x30\x10\x8d\x07\x83\xef\x5c\x40\x5f\xb2\x40\xe6\x33\xa5\x8a\xdd\xd3\x18\xdd\xe9\xda\x6d\x90\x6d\xdf\xec\xc4\x87\x3b\x8c\x78\xc4\xc9\xbf\x98\x39\xec\xa6\x73\x62\x29\x94\xb1\x3a\x2e\xc4\x9c\x72\x64\xfe\xdd\xca\x42\x9b\x3a\x8a\x5b\xe0\x95\x27\x8d\x55\x27\x60\x06\xa0\xa7\xd4\xad\x5c\xaa\x92\xa5\xc4\x7a\x36\xf6\x2d\xe2\x1e\xa8\x45\xdc\x10\xe5\xb5\xd6\x5d\x6b\xc7\xb9\x4b\x93\x08\xaa\xdc\xd5\xe7\xc0\x9d\x25\x10\xc8\x3e\xfd\x8e\x48\x96\x4b\x0e\xd5\x08\x8d\xb4\x4f\x74\x5f\xc2\x1b\x53\x65\xe4\xeb\x14\x8d\x1a\x7c\x4c\xde\x89\x29\xcf\x1d\x3f\x13\x79\x48\xa5\xe1\x0d\xe5\xc8\x0a\xc2\xbd\xde\xba\xd7\x5b\x7b\x86\x94\xd9\x9b\x9c\x4e\x32\x6c\xfa\x4d\x5b\xec\x67\x14\xb2\xe4\x18\x50\x38\x4d\xb9\xd4\xff\x25\xe3\xf1\x3b\x08\x05\x57\x79\x98\xcd\x08\x41\x4f\x2b\x78\x3c\x14\x88\x61\x72\xbb\xe1\x38\x86\xc3\x47\xb6\x11\xbc\xcc\x53\xbd\x3c\x26\x5b\xb9\xf4\x96\xa6\x66\x24\x68\x9d\xc7\x95\x1a\x43\x6e\x2f\x99\x30\x72\x3d\xe3\xc9\xed\x55\x1d\xf1\x25\xa2\xd4\x9f\xe5\x8d\x8f\x22\xcc\xe5\x28\x0a\x81\xe7\xcf\x2e\xff\x2a\xce\x39\x76\xdd\x90\xe4\x63\xbb\x91\x9a\x14\xa1\x52\x8a\x84\x87\xa6\x23\x80\x0b\xd9\x2b\x04\x24\x05\x51\xbf\x9b\x65\x83\x7e\xb9\x05\x3d\xc6\x1d\x1f\xab\x02\x53\x2c\xcf\x6a\xe8\x2d\x3c\x77\x7b\xb8\x93\xa5\x9a\xc3\x8a\x47\xb9\xea\x5d\xae\x3f\xf7\x4b\x71\xeb\xb0\xac\x09\x77\x5c\x9c\xcd\x00\xad\xb5\x65\xe3\xc0\x84\xe4\xf6\x83\x88\x76\xb7\x11\x92\xee\x1e\x39\x5a\x6e\x2d\x01\xc4\x37\xbd\xf6\x84\xf9\x2e\x2e\x9e\x16\x62\x37\x23\x21\x57\xba\x3c\x8e\x4b\xe9\x52\xe6\x33\x1b\x1e\x47\x16\x3b\x14\xa2\xa8\x32\x93\xa7\xed\x5f\x60\xa7\x37\x24\x24\x92\x69\x66\xb2\xed\x70\xf9\xb0\xd6\xb5\x5d\xe5\xef\xab\x5d\x6c\xb1\xfa\xa3\x36\xb5\x71\x5d\x6c\xb1\xa2\xcc\x55\xbb\xb7\xac\x94\x67\x2f\x9e\x3f\x3f\xe9\xe8\x6b\x8b\xbe\x94\x3d\xdd\x6f\xbb\xfb\xda\x22\xa9\x6e\x28\x58\x6f\x95\x9e\x63\x57\xdf\xdb\xfd\x36\xb6\xd4\x7c\x6d\x69\x79\x40\x91\xf8\xee\x1b\xdb\x06\x44\xe6\x02\xf0\x86\x20\x41\x31\x4c\x1e\xac\x43\x19\x6a\xa2\x06\x21\xa9\x76\x62\x0b\xf5\x60\x05\x05\x98\x56\x2e\xc0\xbd\x09\x21\x08\xeb\xde\x6a\xc3\x43\x6c\x03\x17\x08\x87\x06\x04\xbf\x81\x56\xc9\xd6\x60\x00\x75\x60\xfa\x60\x27\xda\x42\xfe\xd9\x84\xe4\x13\x32\x57\x04\x7e\x8f\xc1\xe3\xc1\xb2\x9c\x35\xa8\x3d\x2b\x28\x3c\x48\x9a\xab\x58\x3d\x01\x75\xf9\xf1\x4d\xa1\xbb\x70\x76\xda\xa8\x39\x68\x03\x37\x14\x5d\x27\xa6\xc6\x35\x10\x49\x67\x8b\xf8\x39\x51\x25\x31\xa1\x58\x39\x58\x15\x21\x80\x24\xe9\xc6\xc5\x59\xd6\x0c\x82\x28\x36\xd0\x70\xb6\x87\x6b\xb3\x1e\xcd\xc6\x8b\xf9\x20\x9a\x08\x95\xc0\x0a\xfb\x20\xb2\x18\xc5\xc0\x8a\xfc\x20\xba\x83\xd5\x03\x33\x62\x91\x63\xe2\x4a\xea\x42\xcb\xe9\xd6\x63\xc3\x44\x43\xc3\xaf\x22\xc2\xf4\x60\xbb\x04\x51\x05\x1c\x98\xed\x22\xba\xac\xc3\x71\x89\x45\x64\x89\x60\x4e\xa1\xb5\x7a\x21\x98\x2b\x41\x35\x7d\x3b\x2d\xce\x0b\xc5\xda\x40\x22\x6c\x58\xc4\x0c\xe4\x0a\xa3\xfb\xe5\xc5\x05\x5e\x22\x3d\x3a\x2d\x6f\xbf\x35\xde\xdd\x59\x87\xa4\xf6\x00\xad\xd8\xf4\xcb\x32\xb2\xea\xc1\xcc\x77\x12\x7a\x11\x42\xdc\x22\x66\x4e\x02\xa1\xaa\xb4\x8f\x90\x79\xee\xe3\x78\x29\xa5\xc0\x7f\x8c\x16\x94\xfb\x4c\x82\x87\xcf\x24\xd8\x4d\xfa\xfe\x3e\x0c\xfd\x25\x85\xa1\x65\x13\x71\xdf\x3b\x35\xb1\x85\xf9\x95\x84\x00\x12\x11\x93\x66\x2f\xc7\xfa\xfa\x9f\x5d\x5d\x92\xa4\x64\x80\xba\x42\x33\x79\x42\xf0\xae\x82\x25\xad\xdb\x05\xd9\x40\xab\xaf\xbd\x05\x54\x29\x36\x2f\x14\xf6\xd8\xed\xe3\xcb\xfb\xf8\x72\xcf\x18\x14\x2e\xfa\xc9\x3f\xec\x3c\x63\xb3\x6a\x4e\xf3\x91\xe6\x34\x74\x82\x56\xf5\x5b\x89\x42\x4b\x32\xf4\x84\xb8\x1a\xcc\x90\x57\x48\x4b\x66\x5a\xf7\x54\x39\xff\xad\x62\x35\x52\xb0\x57\x63\x76\x1c\x7b\x83\xdf\x18\xb4\xa3\x46\xd9\x5a\xe2\x53\x89\x28\xb0\x9b\xda\xae\xd6\xb3\x1b\xe0\x77\xf7\xc4\x42\xd7\xd6\x1b\x82\x77\xe7\xd5\x7e\x40\x35\x63\x46\x95\xbc\x02\x64\x88\x5a\x47\xb4\x7c\x0a\xcf\x4e\xd3\x13\x62\x1c\x02\x34\xcb\xc4\xbd\x59\x6d\x53\x07\xd2\x27\x44\xaf\x1e\xaf\x08\x58\x7c\x03\x5e\x96\xa2\xb4\x51\xc6\xe6\x06\x98\x8c\x32\x3e\x9d\x29\xb4\x51\x0c\x26\xb4\x49\x03\x3a\x21\x63\xa6\x9a\xc7\x5f\x09\x42\x73\x53\xc0\xab\xff\xdf\x65\xcf\x07\x84\x50\x1c\xa7\x9f\xb0\x19\xbd\xe3\xa2\x2a\xcd\x6f\x28\x41\xbe\xb1\x7f\x02\x95\x63\x21\xb0\xcd\x9d\x5c\x38\xa6\x82\x22\x3b\xff\x6e\x64\xc7\x59\xf0\xe7\x0d\x5d\xb9\x3d\xa3\x8a\xa4\xc2\x79\x76\x47\xec\x33\x97\x6a\x75\x8f\xc3\x1c\xf9\xae\xed\xdb\xa3\x9a\x04\x77\xb2\xd0\xba\xce\x4f\xc8\x82\xf1\xf6\xe5\x6d\x3e\xdb\x36\x0d\xee\xc6\xf0\xa7\xe1\x86\x81\x45\xcf\x32\x28\x11\xae\x6c\x72\x6f\x1c\xe0\xe8\x7e\x55\xc6\x81\x4f\xe5\xc9\x78\xb2\xb8\xbc\x88\x51\x87\x7d\x0a\x8f\x26\x41\x5e\x53\xc9\x52\xf2\x9e\xe6\x74\x0a\xfe\x2f\x72\x38\xbe\x7a\xfd\xfe\x08\xef\xf7\x07\xe7\xe9\xe5\xc5\x72\x72\x47\x53\xae\x9b\x9f\xfa\xb0\x1b\x9c\x83\xa5\x3d\x89\x54\x6a\x76\xb2\x2b\x3b\x42\x76\x70\x2a\x07\xbe\x75\xf4\x2a\xe4\x99\x49\xc9\x71\x08\x91\x32\x9c\x55\xc1\x34\xe6\xe9\xed\xf6\x97\x17\x92\x8a\x53\x6f\xc4\xf6\xc4\x01\xee\xf7\x47\xf0\x76\xd7\x7e\x05\xf9\x8b\x18\x1f\xa8\xb5\x72\x2e\xf8\xda\x72\x26\xc4\x36\x6f\x98\xd3\xda\x3f\xf7\x4d\xb4\xe7\x21\xa9\xa8\xaa\x96\x44\x4f\xeb\x20\xbe\xae\x78\x96\x8e\xe1\x5b\xd6\x95\x6a\x54\x40\x31\x01\x94\xd2\x14\x28\x80\x8d\x00\xdf\x6c\x11\xea\x97\x6d\xb4\x54\xfc\x86\x26\xaa\x43\xe8\xf5\x86\x56\xdb\x90\xb9\x96\x00\x19\x8d\x3a\xbe\xba\x49\xa8\x26\x33\x96\xdc\xca\xaa\x37\xed\x70\xe3\x3b\xe2\xbd\x07\x6f\xe3\xa3\x99\x30\x99\xbd\xd1\x04\x14\x2d\xa7\xfd\x19\x63\x1b\x1e\x5f\x77\x6f\x46\x84\x77\xb9\xc9\xa3\x8e\x1b\x21\x13\x2a\xd9\x65\x37\xbe\xc3\x9a\x39\x26\x22\x4f\x39\x04\x0b\x63\x4f\x06\x1c\xc3\x73\x47\xc6\xfe\x6d\xe2\xcc\x34\x77\x58\xeb\x86\xb4\xa4\x27\x4b\x89\x92\x84\x95\x10\x7d\x02\x9c\xc8\x2e\x11\xb1\xe9\x98\x65\x54\xaa\xeb\x92\x42\xf1\x83\xc8\xaf\x79\xbf\xd0\x6b\xad\xe0\x1d\x95\xca\x02\x94\x9a\xba\x1a\xbb\x14\xe5\x49\xb1\xd4\x80\x75\x88\x9c\xd9\x1b\xdc\xcb\x70\xc0\xfc\x01\x4f\x49\x9f\x90\x73\xd1\xcb\x94\x2a\x36\x5a\x03\x1f\xb8\xf9\x64\x53\xa9\x7e\x2c\x34\x19\xf4\x52\xaf\x67\x0c\x1e\x73\xcb\xe5\xb2\xb1\xde\x7b\x2a\x49\x05\xf4\x7a\xd5\xb4\xad\xcd\x7d\xce\xa4\x5c\x83\x45\xd2\xe6\x3d\xc6\x97\x42\x9c\x2f\xc5\x3d\x4c\xb8\xc9\x6b\xd7\x46\x5d\xca\x14\xe5\x99\x34\xd8\xe6\xfd\x2f\x67\xc6\x1a\x6f\xb5\x6f\x91\x1b\x27\x5f\x32\x2a\xfb\x19\x4a\x47\xfc\x57\x7f\xbd\x59\xb8\x65\x36\xfc\x40\xda\x77\x31\x7c\x46\x5d\x62\xa5\x67\x46\x56\xb6\xd4\x0d\x9e\xcd\x64\x8e\xe1\x70\x8b\x1b\x72\x5d\x56\xec\x98\xbc\xa5\x99\x64\xc7\xe4\xc7\xfc\x36\x17\xf7\xf1\xf3\x52\x6b\xac\xa7\xf6\x3e\x59\x58\xa5\x86\x67\xa1\x9e\x5b\xe4\xcf\xaf\xe7\xbd\xbd\xf7\xd8\xb4\xb5\xdd\x1e\x63\x4e\xf9\x94\xc9\x0e\xf9\xb1\x66\xf6\x0e\x80\x7b\xf5\xa1\xd6\xa6\x9d\xcf\x68\x3e\x05\xdb\xf2\xc2\x21\x76\x9f\x92\xcb\xf1\x47\xf2\xf2\xc5\xb3\xef\xc8\xfd\x8c\xe5\xe4\xfc\xd3\x05\xa9\x24\x93\xe4\x63\xc1\xf2\xb3\xab\x4b\x70\x59\x75\xac\xed\xee\x8f\xde\xc3\x3c\xe5\x6a\x56\x4d\x4e\x12\x31\x3f\xfd\x78\x76\x79\x6a\x1f\x1c\xd9\xce\xbc\x46\x98\x9e\x72\x29\x2b\x26\x4f\x5f\x3e\xff\xaf\x90\x75\x41\x9e\x5a\xd0\x4e\xdc\x50\x9e\x55\x65\xc7\x19\x6a\x5b\xdb\xe6\x5b\x5d\xca\xc9\x7a\x99\xb1\xee\x26\x6f\x3c\x5d\x89\xb8\x63\x7d\xf0\x9b\x5d\xd3\xfb\x64\x9f\xe8\xd6\xa1\x30\xae\x09\x1b\xec\xe8\x57\x77\x31\x19\x2e\x96\xc8\x7b\xfa\x79\x2b\x74\xd6\xc9\x1e\xbc\xc0\x40\x71\xdd\x75\x26\xc8\xc8\xcd\x67\xfd\x5f\xdf\xd3\xcf\x6b\x5e\x76\xbf\x09\xd0\xbb\x48\xdc\x02\xd7\x2e\xae\x7f\x61\x23\x7b\x40\x3b\xff\x60\x0e\x53\xc7\x9f\x3a\x67\xb1\x66\x81\x3d\xa8\x60\x6b\xe6\x5c\xcc\xa8\xdc\x74\x27\x41\x2f\xbc\xd2\x5f\xec\x3a\xef\xeb\x88\x67\x54\xe9\x5d\x0d\x9a\x11\xa4\x19\xb1\xf4\xac\xe3\x6e\x6c\x7e\x43\xbd\x74\x3b\x37\x6d\xe5\x43\x63\x98\x35\x5a\x5f\x5b\xdf\x4b\xf3\x93\x6a\xe2\x5b\x87\xbb\x19\x5a\x99\x4d\xfe\xf7\xff\xfe\xed\xff\x03\x00\x00\xff\xff\x86\x22\x03\x7c\x6d\xcd\x04\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x7d\xdb\x6e\xe4\x38\x92\xf6\xbd\x9e\x22\x60\x5f\x94\x0b\x70\xa6\xab\x67\xfe\x8b\x1f\xee\xc5\x02\x9e\x3a\xf4\x1a\x53\xeb\x2a\x94\x3d\xd3\x98\x4b\xa6\x14\x99\xc9\xb1\x24\xaa\x49\xca\xee\x9c\xc5\xbe\xfb\x22\x28\xea\x98\x27\x91\xca\xec\xea\xc6\xd0\xaa\x8b\x72\xa6\xf8\xf1\x14\x0c\x06\x23\xe2\xa3\x2f\x61\x76\xba\x9f\xe8\x12\x3e\xf3\x18\x73\x85\x09\x68\x01\x7a\x8d\x70\x57\xb0\x78\x8d\xf0\x28\x96\xfa\x95\x49\x84\x4f\xa2\xcc\x13\xa6\xb9\xc8\xe1\xea\xee\xf1\xd3\x5b\x28\xf3\x04\x25\x88\x1c\x41\x48\xc8\x84\xc4\xe8\x12\x62\x91\x6b\xc9\x17\xa5\x16\x12\xd2\x0a\x10\xd8\x4a\x22\x66\x98\x6b\x35\x07\x78\x44\x34\xe8\x0f\x5f\x9e\xee\xdf\x7f\x84\x25\x4f\x11\x12\xae\xaa\x42\x98\xc0\x2b\xd7\xeb\xe8\x12\xf4\x9a\x2b\x78\x15\xf2\x19\x96\x42\x02\x4b\x12\x4e\x15\xb3\x14\x78\xbe\x14\x32\xab\x9a\x21\x71\xc5\x64\xc2\xf3\x15\xc4\xa2\xd8\x48\xbe\x5a\x6b\x10\xaf\x39\x4a\xb5\xe6\xc5\x3c\xba\x84\x27\xea\xc6\xe3\xa7\xba\x25\xaa\x82\x35\x75\x6a\x01\xff\x10\xa5\xed\x43\xa7\xbb\x76\x14\xae\xe1\xef\x28\x15\x55\xf2\xa7\xf9\xbb\xe8\x12\xae\xe8\x95\x0b\xfb\xe5\xc5\xdb\x1f\x61\x23\x4a\xc8\xd8\x06\x72\xa1\xa1\x54\xd8\x41\xc6\x5f\x63\x2c\x34\xf0\x1c\x62\x91\x15\x29\x67\x79\x8c\x6d\xb7\x9a\x1a\xe6\x60\x1a\x40\x18\x62\xa1\x19\xcf\x81\x99\x6e\x80\x58\x76\x5f\x03\xa6\xa3\xcb\xe8\x12\xcc\xcf\x5a\xeb\xe2\xf6\xe6\xe6\xf5\xf5\x75\xce\xcc\xec\xcc\x85\x5c\xdd\xd4\xbd\xbb\xf9\x7c\xff\xfe\xe3\xc3\xe3\xc7\x99\x69\x72\x74\x09\x7f\xcb\x53\x54\x0a\x24\xfe\x52\x72\x89\x09\x2c\x36\xc0\x8a\x22\xe5\x31\x5b\xa4\x08\x29\x7b\xa5\x89\x33\xb3\x63\x26\x9d\xe7\xf0\x2a\xb9\xe6\xf9\xea\x1a\x94\x9d\xf5\xe8\xb2\x37\x3b\xed\x70\xd5\xcd\xe3\xaa\xf7\x82\xc8\x81\xe5\x70\x71\xf7\x08\xf7\x8f\x17\xf0\x97\xbb\xc7\xfb\xc7\xeb\xe8\x12\x7e\xbe\x7f\xfa\xaf\x2f\x7f\x7b\x82\x9f\xef\xbe\x7d\xbb\x7b\x78\xba\xff\xf8\x08\x5f\xbe\xc1\xfb\x2f\x0f\x1f\xee\x9f\xee\xbf\x3c\x3c\xc2\x97\x4f\x70\xf7\xf0\x0f\xf8\xeb\xfd\xc3\x87\x6b\x40\xae\xd7\x28\x01\x7f\x2d\x24\xb5\x5f\x48\xe0\x34\x90\x98\xd0\x9c\xd6\x02\x54\x37\x80\xe4\x83\x7e\x57\x05\xc6\x7c\xc9\x63\x48\x59\xbe\x2a\xd9\x0a\x61\x25\x5e\x50\xe6\x24\x1e\x05\xca\x8c\x2b\x9a\x4e\x05\x2c\x4f\xa2\x4b\x48\x79\xc6\xb5\x91\x22\xb5\xdd\x29\xaa\xa6\x5e\x18\x27\xf8\x89\x22\x56\x70\x2b\x4e\xb7\xc0\x0a\x8e\xbf\x6a\xcc\x4d\x6b\xe6\xcf\xff\x5f\xcd\xb9\xb8\x79\xf9\x21\x7a\xe6\x79\x72\x0b\xef\x4b\xa5\x45\xf6\x0d\x95\x28\x65\x8c\x1f\x70\xc9\x73\x23\xf9\x51\x86\x9a\x25\x4c\xb3\xdb\x08\x20\x65\x0b\x4c\x15\xfd\x0f\x68\x42\x6f\xe1\x22\x66\x19\xa6\xb3\xe7\x8b\x08\x80\xe5\xb9\xb0\x3d\xab\xde\x30\x4b\x52\xa4\x29\xca\xd9\x0a\xf3\xf9\x73\xb9\xc0\x45\xc9\xd3\x04\xa5\xa9\xb9\x6e\xd7\xcb\xbb\xf9\xff\x9b\xff\x10\x01\xc4\x12\x4d\xf1\x27\x9e\xa1\xd2\x2c\x2b\x6e\x21\x2f\xd3\x34\x02\xc8\x59\x86\xb7\x60\x0a\xab\xb9\xa9\xb2\x23\x86\x11\x4d\x00\xd5\xb8\x92\xa2\x2c\x6e\x61\xeb\xfb\xaa\xbc\x6d\x76\xcc\x34\xae\x84\xe4\xf5\xef\x33\x78\xa6\xf7\xed\xff\xe3\xe6\xff\xd5\xa8\xfc\x85\xaa\x34\xdf\xa5\x5c\xe9\xbf\xb6\x9f\x7d\xe6\x4a\x9b\xcf\x8b\xb4\x94\x2c\xad\x1b\x67\x3e\x52\x6b\x21\xf5\x43\x5b\xe5\x0c\xf8\xf3\xa2\xfa\x86\xe7\xab\x32\x65\xd2\xbe\x1e\x01\xa8\x58\x14\x78\x0b\xe6\xed\x82\xc5\x98\x44\x00\x76\x64\x4c\x03\x67\x1d\x15\xf4\x55\xf2\x5c\xa3\x7c\x2f\xd2\x32\xab\xc7\x78\x06\x09\xaa\x58\xf2\x82\x06\xee\xd6\xe8\x1d\x03\x0d\xc5\x9a\x29\x34\x95\x02\xfc\x53\x89\xfc\x2b\xd3\xeb\x5b\x98\x2b\xcd\x74\xa9\xe6\xdd\x6f\x69\x70\x6e\xe1\x6b\xe7\x13\xbd\xa1\x36\x91\x6a\xcc\x57\xfb\x6a\xd1\x3c\x43\x60\x1a\x5e\xd7\x3c\x5e\x1b\x19\xae\xea\x7d\x65\xaa\x9a\x48\x4c\xb6\x6b\xaf\x65\x69\xbe\x35\xd5\xf6\xdd\xaa\x2d\x77\xab\x7e\x4b\x12\xa6\xd1\xa7\x1d\x29\x53\x1a\xae\x24\xce\xde\x2a\xcd\xe4\xce\x16\xd9\xf1\xb0\xdf\xdf\x69\xfb\x46\xd5\x8e\xc7\x5e\xa9\xe3\x6d\xa9\x46\xc0\xd4\x8a\xbf\x62\x5c\xd2\x37\x90\x94\xd2\x48\xf5\xde\xba\x07\x2f\x54\x55\x7f\xe8\x7f\x38\x66\x46\xf2\x32\x5b\xd0\xb6\xb8\xec\x54\xce\xb4\xc6\xac\xd0\x6a\x6f\xe5\x4b\xc6\xd3\x52\xe2\x5c\x62\x4c\x4a\x6b\x33\xb7\x25\xfa\xf3\xd1\x47\xa9\x1a\x43\xb2\xb8\x42\x19\xb5\xaf\xbd\xd0\x22\x26\x91\x5e\x63\x66\xd4\x05\xfd\x26\x0a\xcc\xef\xbe\xde\xff\xfd\xcf\x8f\xbd\x8f\xa1\xdf\x7e\xb3\xce\x80\xd3\x3e\x89\x50\xbd\xd9\xe8\x57\x33\xaa\x0a\xee\xbe\xde\x37\x65\x0b\x29\x0a\x94\xba\x59\xc4\xd5\xbf\x8e\xb2\xeb\x7c\x3a\xa8\xe9\x0d\x35\xc6\xee\xb0\x09\x69\x39\xac\x2a\xb5\x8b\x0e\x13\xdb\x7e\x1a\x47\xb3\xb5\x4a\xa4\xcd\x00\x73\xdd\x9d\x8f\xfa\x11\x4b\xda\x75\xc4\xe2\x9f\x18\xeb\x39\x3c\xa2\x24\x18\x50\x6b\x51\xa6\x09\x99\x24\x2f\x28\x35\xd0\xd8\xae\x72\xfe\xaf\x06\x5b\xd5\x96\x4e\xca\x34\x5a\x3d\xd2\x3e\x34\xb0\x32\x67\x29\xbc\xb0\xb4\xc4\x6b\xda\x37\xcc\x86\x2f\x91\x6a\x81\x32\xef\xe0\x99\x57\xd4\x1c\xfe\x5b\x48\x34\x16\xca\xad\xd9\xaa\xd5\xed\xcd\xcd\x8a\xeb\x5a\xc9\xc7\x22\xcb\xca\x9c\xeb\xcd\x4d\xc7\x4a\x52\x37\x09\xbe\x60\x7a\xa3\xf8\x6a\xc6\x64\xbc\xe6\x1a\x63\x5d\x4a\xbc\x61\x05\x9f\x99\xa6\xe7\xd4\x61\x35\xcf\x92\x4b\x69\xb7\x05\xf5\xa6\xd7\xd6\x2d\xa9\xac\xfe\x19\xd5\x79\x60\x06\x48\x8d\xd2\x5c\x33\x5b\xb4\xea\x68\x3b\xd0\xf4\x11\x8d\xce\xb7\x8f\x8f\x4f\x50\x57\x6d\xec\x9c\x1e\x28\xd8\x71\x6f\x0b\xaa\x76\x0a\x68\xc0\x78\xbe\x34\xdb\x2b\xd9\x47\x52\x64\x66\x9a\x31\x4f\x0a\xc1\x73\x6d\x7e\x89\x53\x8e\xf9\x70\xf8\x55\xb9\xc8\xb8\xae\x8c\x17\x54\x9a\xe6\x6a\x0e\xef\xcd\xe6\x06\x0b\x84\xb2\x20\x0d\x90\xcc\xe1\x3e\x87\xf7\xb4\x5b\xbc\x67\x0a\xcf\x3e\x01\x34\xd2\x6a\x46\x03\x3b\x6e\x0a\xba\x9b\x76\xfb\x43\x28\xb7\x76\xd4\x3a\x5f\xd4\xfb\xe7\x9e\xf9\x32\x6b\xf3\xb1\xc0\xb8\xb7\x5e\x12\x54\xc6\xb4\x23\x3d\x82\xb4\x58\xda\xad\xf2\xf0\x2a\xa5\xa7\x90\x5c\x48\xae\x37\xc3\xcf\x07\x35\x7f\xb5\xaf\xd5\x96\xa9\xd1\x04\x64\xdf\xb6\xbf\xfc\x52\x62\x89\xf3\x6a\x4f\x56\x95\xb1\x5b\x43\xb5\x3f\x6b\xbe\x22\xb3\xae\xae\x15\xe8\x50\x41\xab\x3c\x29\x53\x32\x4e\x71\x49\xab\x87\x30\x85\x31\xff\xac\xc2\xb1\x95\xe6\xf5\xd6\xbc\x05\x5c\x9d\x07\x8c\x26\xfc\xf3\x9f\xb6\xbe\xdd\xd6\x92\xed\x8f\x66\xea\x79\x6b\x50\x00\xb8\xc6\x6c\xc7\xc7\x83\x51\x79\x62\xea\x19\x66\xb3\x1d\xaf\xed\x1f\xf0\xea\xb1\xd6\xd7\xee\x2f\x07\xb5\x98\x11\x45\xb9\xbf\xb2\x31\x15\xd2\xc3\x96\xc6\x94\xdc\xec\x7f\x63\x50\xf3\x9d\x2d\x50\xa9\x08\x63\xd4\x91\x00\xd4\x38\xf5\xcc\xf5\x05\x7e\xfb\x47\x96\x29\xaa\xf9\x81\x57\xc6\x34\x9e\x9e\x5c\x24\x78\x37\xa2\x13\x5b\x1d\xf9\x60\xb4\xde\x02\x95\x81\xd8\xd5\x81\xaa\x91\x47\x30\xa1\xd9\x09\x0b\x91\x1c\xea\x90\x4b\xa7\xe8\x29\x24\x2e\x51\x4a\x4c\x3e\x94\xa4\xc1\x1f\x9b\x66\xdd\xaf\x72\xd1\x7c\xfc\xb1\x36\x26\x8e\x03\x0e\xfa\x4f\x46\x89\xed\x2b\x4a\x78\xe5\x69\x6a\xab\xa4\xed\xaf\xfe\x62\x04\x28\x40\x21\x12\xd2\xc3\x66\x1c\x49\xfd\x30\x0d\x8a\x69\xae\x96\x1b\x33\x2e\xcd\xc8\xda\x83\x1b\x6d\x5b\xa3\x70\xed\xd1\x8d\x94\xc0\xa6\x3e\x48\x63\x9a\x5c\xc3\xa2\xd4\xc0\xb5\xd9\x76\xe3\xb5\x10\x74\x1a\x36\x95\x8f\x42\x35\xed\x7b\xe1\xc2\xec\xee\x5d\x37\x45\xad\xc7\x3a\xcd\x3c\x36\x9f\xd5\x43\x23\x49\xd5\x57\x5d\xe7\x0a\x32\xa1\x74\x3b\x7f\xb5\xe1\x44\x55\xed\x51\x81\xdb\x0f\x15\x58\x91\x19\x8e\x4a\x83\x2a\x33\x6a\xdc\x2b\x92\x2b\x43\x5d\x03\x9f\xe3\xdc\x88\x1d\xb2\x78\xed\xd8\xf3\x0c\x51\x2b\x60\x69\x5a\x77\xd7\x4e\x35\x6d\xeb\xd6\x23\x60\x7c\x32\xa3\x10\xaf\x9a\xfd\xdf\xee\xc7\xd7\x8d\x57\x61\x28\xb5\xcd\x02\x1b\x05\xdc\x99\x83\x6b\x40\x1d\xcf\xdf\x5e\x1b\xb7\x49\xa9\x69\xae\x69\x3c\x16\x1b\x52\xc8\x64\x86\xe7\xab\x51\x90\x7a\x2d\x45\xb9\xaa\x0e\x43\x98\x56\x8e\xa7\xc6\x8c\x5c\x72\x4c\x13\x63\xcb\xd1\x81\x6e\x24\xe2\x45\x35\x21\x17\xb5\xbd\x48\xcd\xe2\x76\x5b\x22\x71\xc8\x98\x8e\xd7\x76\x43\x8e\x85\x94\xa8\x0a\x91\x8f\x46\x37\xa5\x3f\xb6\xe3\xf0\x63\x03\x7c\xa5\xde\x9a\xbd\xd4\x7c\x60\x76\xcf\x2d\x2b\x75\xf7\x43\x0d\x64\x76\x33\xed\xcb\xe8\x18\x41\xdf\xbb\xff\x1d\x51\x34\x77\x39\xd0\xf9\x64\xd3\x59\x11\x1d\x99\xd3\x28\xb3\x51\x98\x76\x44\xd0\x0a\xaf\x31\x93\x2a\x9b\xa2\x72\x03\xc5\x5c\xdb\x15\x02\xef\xe0\x8a\x96\xc8\x48\x58\xae\xdf\xd0\x56\x96\x8b\x99\x28\xde\xce\xe1\xce\xb8\x35\x4e\xd6\xd8\x5c\x34\x6d\x35\x8d\x22\x6d\xc0\x52\x25\xda\x1a\x47\x01\xba\xec\x1c\x75\x09\xda\x3f\x30\x8f\x71\x6c\x89\xe1\xbc\x19\x61\x03\x85\x29\xc6\x9a\xf6\x38\x94\xd9\x35\x30\xa5\x44\xcc\x3b\x0e\x84\x31\x4f\x23\xad\xbd\x65\x60\xa7\x6b\xec\x3c\xf9\x0c\xc2\xae\x85\xe4\x52\x76\x6b\x48\xc8\xd1\x44\x5a\xa3\x3f\x34\xce\x8a\xb3\x7d\x16\xe4\x2b\x4e\xf0\x8d\xb2\xee\xbb\xf1\xa3\xe1\xb8\x26\xf7\x76\x69\x6f\x57\x1c\x61\xc1\x9e\x1a\x6b\x24\xb3\xdd\xd0\x89\x8a\xf1\x5c\x55\xa7\x48\x75\xed\x8c\xc9\xe0\x19\x37\xd5\x31\x9b\xce\xf2\x05\x4a\xd6\x80\x4b\x34\x9b\xb8\x33\x26\xe9\xbf\x67\xdc\x18\x50\x7b\x46\x77\xc4\xf0\x93\x44\x7b\x00\xc7\xa3\x86\xea\xd1\x59\x23\x93\xc3\xc8\x0b\x8d\x8e\xb1\x3b\x3c\x10\xc9\x22\xe8\xcc\xbc\x89\x01\xd0\x76\x25\xe6\x91\x07\xd4\xee\xa3\xee\xb8\x9f\x7a\x5a\x27\x8f\xcb\xb7\xc6\xd9\x50\x09\xce\x1b\xd7\xe5\x68\x4f\x27\x24\x58\xa4\x2b\xd6\xbc\xa0\xdd\x9d\xa4\xda\x2c\x7b\x3f\x69\xa9\x9e\xbf\xb3\x94\x27\x4d\x57\x95\x39\xe7\xde\xe7\xd7\xf0\x20\xf4\x7d\xee\xbe\x30\xe8\xf9\xf8\x2b\x57\x5a\x5d\xc3\x07\x81\xea\x41\x68\xf3\xeb\x1c\x7e\xd2\x66\xbd\x78\x21\x7e\xd6\xbf\xf9\xe4\x57\x63\x3a\x79\xea\xef\x72\x60\x52\x52\xd8\x6c\xe9\xdb\x92\xb6\x35\x73\xb8\xaf\x0c\xb9\x7a\xba\x48\xb7\xdd\xe7\xd1\x81\x82\x7b\x1f\x21\xed\x14\x1b\xc0\x0a\xdf\x36\x35\x2b\x47\x5a\x6e\xc3\x67\x41\x26\x66\x3e\x33\x76\xd5\x56\x5b\xbd\x10\xb9\xb2\xd2\x44\xc7\xa1\xae\x3c\x5d\x13\xb6\x17\xe4\x56\x57\xc9\x43\xd7\x34\xd9\x0b\x72\x38\x25\x3f\x69\x6a\xee\xe7\x93\x36\x72\xcd\x5e\xcc\x21\x83\xe7\xab\x91\x07\xe0\xe1\x63\x8f\x17\xd7\x36\xec\x61\xce\xd6\x0b\xf2\x00\x6b\x94\x85\x44\x37\xdb\xa9\xfd\x61\x14\xa5\xac\x7d\x56\x73\x78\x22\xff\x77\xd5\xee\xca\x11\x9e\xda\x08\x95\xfb\x93\x98\xc3\x1a\x75\x5a\x4b\x8a\xc0\xf1\x18\x32\x94\x2b\x84\x82\xac\x58\x1f\x8d\xe0\x65\x98\x9c\x40\x99\xd4\xc5\xcd\xb8\x38\x96\xb6\xa6\xcf\xc0\x3b\x7e\xfc\x99\xd1\x4e\xe3\x5c\xc6\x73\xb5\xee\xf1\x12\x9f\x6b\x5c\x8c\xf1\xfc\x89\x4e\xc7\x8e\xb3\xd9\xd7\xcd\xe7\xb4\x9b\xcd\xd9\x3d\xd8\xcd\xc1\x6e\x0e\x76\x73\xb0\x9b\x83\xdd\x1c\xec\xe6\x60\x37\x07\xbb\x39\xd8\xcd\xc1\x6e\xfe\x7e\x76\xb3\x47\x65\x95\x2f\xfc\x36\xf2\xd2\xe1\x3f\x9b\xc2\x1d\xdf\x7c\x15\x17\x31\xc6\xbb\x9b\x34\x6c\x3b\xe9\xc9\xca\x7e\xb4\xb6\xce\x93\x09\x01\x54\x29\x1e\x0e\xa0\x92\xe5\x2b\x84\x1f\x66\x3f\xbc\x7b\x37\x7e\x4d\x1c\xce\xdf\xd8\xf7\x73\x28\xaf\xe3\x14\xd2\x3b\xeb\xc4\x55\x46\x16\xa8\x66\x36\x3a\x83\xdc\xb8\x88\x66\xdd\xcd\x33\x65\x13\xdc\x2f\xfb\xb1\x7e\x5b\x1d\xa9\x73\x55\xe7\x60\x8f\x54\xb2\xbd\x60\xbf\xb1\xf6\x28\xb9\x2b\x43\x4d\x09\xad\xdd\x60\x1c\xcf\x70\x9c\xf9\x67\xb3\x33\xaa\x24\x07\x9b\x29\x66\x81\x4c\x6e\xba\x8d\xdf\x92\xa4\x8f\xde\x61\x47\xf4\xb6\x9f\xb6\x30\x0a\x35\x46\x46\xa4\x01\x41\xdb\x5e\xdd\x63\x91\x51\xeb\x29\x2b\xce\x6e\x37\xd4\x95\x26\x89\x74\x14\xec\x15\xce\x57\x73\x48\x4a\x03\xcd\x72\x9b\x26\xf7\xd6\x98\x28\xa0\x36\x4a\x63\x66\xb2\x29\x88\xb0\x31\x52\xcd\xd1\x30\x6a\xb9\x21\x40\x7c\xc1\x5c\x97\x2c\x4d\x37\x80\x2f\x3c\xd6\xcd\x78\x2f\xa5\xc8\x80\xb2\xf5\xcc\xc8\x46\xa7\x3f\x1e\x0e\x75\xd3\xe8\x9d\xb3\x27\xbb\xdf\xec\xd2\x98\xef\xf3\x53\x8c\x04\x05\x13\x22\x55\xe4\x99\xc3\xea\xbf\x46\x7a\xbf\x7c\x1b\x17\x5f\xf7\xd8\xfe\x7b\xdd\xb0\x81\x6b\x4a\x0b\x21\x5b\xd1\xb3\x0b\x55\x27\x76\x84\xaf\xab\x6e\x75\x25\xdd\x01\xd3\xe4\x59\x60\x95\x7d\x70\xf7\xf0\x01\x93\x0a\xed\x49\x14\x22\x15\xab\x4d\x77\x0e\x5d\x5a\xba\x29\xd0\x04\xff\xed\xca\xa3\xcc\x90\x85\x3d\x71\x92\x0c\x3e\x0c\xc4\x63\x1e\x9d\xd7\x4f\x11\x22\xcd\x21\xd2\x1c\x22\xcd\x21\xd2\x1c\x22\xcd\x21\xd2\x1c\x22\xcd\x21\xd2\x1c\x22\xcd\x21\xd2\x1c\x22\xcd\x21\xd2\x1c\x22\xcd\x21\xd2\x1c\x22\xcd\x21\xd2\x1c\x22\xcd\x21\xd2\x1c\x22\xcd\x21\xd2\x1c\x22\xcd\x21\xd2\xfc\xfd\x22\xcd\x6e\xd5\xb8\x0c\xf9\x6c\x2b\x58\xac\xa2\x93\x76\x61\xf4\xab\x85\x48\x26\x92\xc2\x29\x7e\xd5\xc4\xf7\x9c\x39\xe1\x55\xc0\x2d\x16\xb3\x54\xd0\x95\x46\x55\x40\xb3\x10\xcd\xf5\x08\x8a\x65\x94\x89\x94\xe0\x35\xfc\x4b\xe4\x58\x71\x5c\x8f\xa2\x32\x55\x45\x03\xab\x8b\x10\x0a\x91\x5c\xa9\xb7\x47\xf9\x83\x2e\x86\x70\x43\x7a\x0c\x9c\xf3\xc0\x39\x0f\x9c\xf3\x3f\x20\xe7\x7c\xcd\x8c\x9e\x51\x95\x61\x13\xb9\x30\x95\xb7\x53\x7f\x3a\x5a\x94\x22\xb3\x3f\x8e\x36\xe1\xf6\xb1\xd4\xbf\x13\x03\x9d\xce\xe2\x56\x90\x69\xc1\x75\x04\xd1\x44\x6c\x47\x9b\x64\x55\x9a\x15\x26\x5f\xfb\xe3\x62\xfd\x42\xa6\x63\x2c\x49\x30\x81\x02\xe5\x6c\xf4\x12\x01\x52\x7e\x4b\xba\x75\x69\x7b\x54\xea\x91\x8c\xce\xe3\xf2\x18\x4c\xf0\xd8\x62\x07\xf2\x27\x7a\xdb\x26\x65\x12\xb8\x1c\x9b\x87\xf9\x6b\xdf\x8b\x31\x6e\xfc\x36\xb5\x19\xe3\x52\x70\x30\x30\x77\xd6\x03\xf4\x4b\x89\x72\x03\x74\x83\x1b\x9d\x5a\x50\x47\x0e\x80\xe4\x7b\x59\x36\xf7\x6c\x29\x9b\x7d\xc7\x15\xc4\x94\x29\x44\x4b\x7d\xfc\x68\xf8\x8f\xc8\xf4\xec\x86\xad\xc1\x19\xc2\xd1\x96\xc5\x9c\x21\xa1\xf1\xf7\x56\x43\xdd\xf8\xca\xba\xfb\x8a\xdb\x08\xb5\x1b\x6c\x17\xa3\x93\xba\x12\xfd\x66\x47\xad\x9d\xd2\xb4\xab\x8b\x1e\xd8\xe7\x71\x06\x9f\xcb\x21\x7c\x1a\xa7\xf0\xb4\x05\x30\xc9\x39\xbc\x35\x9d\xd4\x13\x6b\xa5\x19\xd1\xf5\xc4\x84\xc6\xbf\xbc\xd3\x57\xec\x8d\xea\xe7\x63\x3e\x81\x77\x60\x9a\xaf\x79\x6b\x98\x1b\xf1\x6b\xef\x21\xf4\x84\xb5\x82\xfd\x46\x6d\x3b\x9f\xbd\x11\xfb\x4e\xeb\xa1\x03\xda\xbf\xa1\x3d\xc7\x75\xed\x36\xf4\xf5\x38\xd3\xd3\xf3\x5f\x7f\x2f\xc1\xf0\xf7\x43\x6f\x89\x85\x75\x2a\x92\x0a\xb4\x5e\x69\x4f\x54\x68\xbd\xd9\xfb\x3c\xd3\xde\xc8\xc6\xa3\xbd\xd3\x3b\xed\x0d\xd9\x77\xf5\xf6\x3c\xd4\xde\x98\x43\x97\x6f\xe3\xa5\x3e\x89\xb4\x6d\x3b\xe5\xbd\x61\x07\x1e\xee\xd6\x3d\xeb\x8d\xd8\x71\xeb\xee\x70\xd1\xfa\x37\x74\x9a\x6b\x77\x92\xcd\x71\xa2\xb5\x5a\x43\xf8\x8e\xaf\x8b\xdf\xf1\x14\xee\xde\x49\x2e\x5f\x27\xdf\xe4\x69\xc7\xca\x98\xd1\x9f\x3b\x37\xbf\xbb\x3c\xdd\xdb\xcb\xa7\x58\x45\x93\xa4\xa5\xa7\x97\x3b\xdd\x21\x55\xc2\x20\x63\x85\x47\x83\xc4\x12\xfe\x87\x8c\x50\xa3\x36\xfe\x17\x0a\xc6\xa5\xa2\xf4\xfe\x8a\x9c\xd8\xfd\xce\x03\xdc\xfa\x70\xbb\x4d\xcd\x58\x41\xcd\x25\x99\x7d\x61\xa9\x9f\x41\x4e\x51\xec\xbc\x76\x13\xd1\xae\x32\x3c\x21\xf9\x58\xe3\xaf\x6b\x72\x55\x92\x89\x68\x9c\x14\xd4\xc8\x8b\x67\xdc\x5c\x5c\x4f\xdd\xa1\x08\xe8\x3e\xbf\x30\x8c\xe9\x2d\x0d\xdd\x9c\x23\x3c\x80\x45\x9e\x6e\xe0\xc2\x4c\xce\xc5\x36\xe7\x80\x4c\x1b\x0f\x50\xcf\x83\x9b\xf7\x9a\xf6\x2c\xd8\xdc\x66\xec\xb8\x0a\x7b\x4b\xa8\x05\x69\xbc\xd9\x2e\xee\xc0\x5d\xad\x69\x4f\x29\x8f\xd3\xce\x17\x5a\xc0\x55\xed\x6f\x64\x2b\xba\xe0\x42\xbf\xfd\xb1\xcf\x5b\x71\x44\x34\xc7\xff\x0c\x59\xae\xe0\xa2\x8e\xb2\xbc\x51\x6d\xdb\x2f\xa2\xb3\xef\x9a\xde\xda\xcf\x4f\xeb\x6b\x4b\x9a\xf9\x2b\x6e\x26\x48\xc9\x53\x1d\x91\xb2\xd7\xe1\x2f\xb0\x0d\x57\xb9\x1e\x13\xae\x6a\x8f\xdf\x5b\x32\x56\x89\x19\xd6\x42\xc1\x15\xcb\x35\x9f\x35\x6f\x38\x42\x37\x7e\x40\xf2\x74\x35\x84\xd6\x6d\x79\x8c\xbc\x54\x78\xbd\x3e\x92\x8e\xac\x13\xfb\x1c\xe5\x84\xd1\xa0\x3f\x7d\x63\xae\x62\x4f\x28\x1f\x40\x96\xb9\xf9\x5b\x33\xf4\x17\x70\x8c\x37\xb7\x52\xc9\x8e\x98\x46\x1d\xd6\x4e\x6b\xe3\x2e\xa8\x3c\xa4\xa4\xd8\x3b\xf2\xe0\x88\xda\x7a\xfe\x99\x39\x86\xb2\xdc\xb2\xc6\x44\x6e\x13\x23\x58\xee\xba\x1e\x6d\x1b\x2b\x27\x15\xb9\xc2\x69\xde\x78\x33\x0e\x73\xf8\xe8\xb1\xc8\x3b\x5d\xa4\x1d\x8c\x24\x8c\xa5\xa9\x78\x75\xd5\xe9\x5e\x2b\xd5\xdd\x12\x9d\x79\xcd\xc9\x6f\x4d\x1a\x7f\x3d\x23\x69\x7c\x10\x58\xf8\xb7\xe7\x8c\xf7\x87\x23\x10\xc7\x03\x71\xfc\x8f\x48\x1c\x37\x63\x51\xed\x3e\x7b\x18\xe4\xa3\x40\x2b\x96\xf9\x68\x06\xf9\x28\xcc\x86\x65\x0e\x3f\xaf\xd1\x6c\xee\x14\x8c\x95\x08\x59\x99\x6a\x5e\xa4\x6d\xfc\x7d\x7c\x22\x22\x99\x96\xaa\xa6\x12\xa8\x81\x86\x23\xde\x3b\x65\x42\x0c\x34\xdd\x28\x60\x0a\x25\x91\xba\x91\xca\xec\x91\x36\xb9\x82\xc2\xd2\x14\x31\x55\x8d\x7b\xae\xca\x68\xe1\xe3\x76\x39\x07\xd3\xb5\xb7\x00\x3f\xd8\x3f\x1b\xd3\x64\x50\x1b\x4b\xeb\x8a\x0c\xa2\x94\xc4\x70\xbc\xb1\xb2\xdf\x3a\xb3\x1e\xf3\x17\xac\xd3\x16\x56\xfc\x05\xf3\xc8\xf1\x18\x42\xd9\x4e\x75\xa4\x63\xba\xf1\x7a\x06\xa3\x95\xf6\xcf\x6d\xf3\x71\xb7\x41\x38\x12\x72\x60\x36\x1e\x32\x04\x47\x22\xfe\x47\xc7\x36\xf9\xcf\xe3\x26\xe0\x48\x54\x66\xa6\xa3\x31\xfe\x5a\x41\x6a\x4d\xbf\xe8\x3c\xd1\xb9\x9e\xa0\x8d\x2d\xb4\x27\xb4\x3a\x0c\xd4\xf7\x43\xef\x91\xe3\xf9\xc2\x2f\x48\xef\x17\x9f\x1c\xfa\x8a\x5c\xca\x0e\x06\x63\x08\x45\xf2\xcb\x4c\x98\xdd\x09\x13\x8e\x05\xe5\xc9\xb7\xe3\x88\x78\x8a\x80\xbc\xd7\x11\x7f\xa7\xb4\xec\xea\x56\xf4\x7b\x08\xc2\x07\x46\xd6\x2e\x46\xd6\x30\xe0\xee\x19\x24\x80\xfd\xc1\xf6\x3f\x34\x31\xeb\x54\x81\xf2\xbd\x41\x72\x8f\xcc\xa7\x46\x8f\xec\x09\x90\x7b\x7a\x83\x61\x77\x70\x7c\x72\x90\xfb\xf7\x40\xb2\x3a\x51\x60\xfb\x3c\x41\xed\xfd\x01\xed\x09\x4d\xdd\x19\xcc\x3e\x49\x5b\x9b\x40\xf6\x76\x40\xda\x0b\xb2\xdb\xd5\x5d\xc1\x68\x2f\xd0\x2d\x7e\xd1\xa9\x02\xd1\x81\x5f\xf4\x6f\xce\x2f\x22\x7e\xd1\x84\x00\xf3\x29\x82\xcb\xde\x92\xd0\xd3\x89\xbb\x82\xca\x83\x10\xb1\x63\xbb\x0e\x04\x94\xeb\xd8\x42\xa7\x52\x47\xf0\xad\x60\xf2\xe9\x43\xc3\x67\x09\x0b\x9f\x25\x24\x7c\x3c\x1c\xec\x73\x08\xf0\x5a\x43\x1e\x85\xda\xb0\xd2\x6d\xe4\x25\xba\x2d\xc0\x30\x98\xdb\x09\x59\x8d\x86\x86\x1d\xae\x99\xd6\x76\xdd\x11\x9a\x75\x40\xee\x5f\x3e\x78\xba\xb0\xac\xf3\x3e\xe2\xa5\x33\xdc\x75\x63\xc7\x8d\x72\x1b\x7d\xd7\x10\xec\x99\xc2\xaf\x67\x08\xbd\x9e\x2b\xec\x3a\x2a\xe4\x5a\xa9\x23\x07\xd4\x9d\x5e\xb6\xee\xbc\xd7\xde\x33\x07\xcc\x83\xa1\xd6\x61\xf0\xd4\x01\x77\x77\x98\xb5\xd7\xd8\x2a\x70\xea\x80\xe9\x1c\x62\x75\x5e\x79\x6e\xf6\x96\x7b\x58\xd5\x51\x63\x8f\x57\x02\xa3\x81\x29\x3c\x90\x6b\x5e\x87\x08\x6e\x23\x07\xfd\x30\x20\x23\x77\xd7\x71\x27\x9c\x75\x04\xd1\xfe\xa9\x6d\x1b\xc9\x61\x2f\x82\x27\x50\x94\x9a\x28\x8c\x07\x58\xc9\x47\x41\x5b\xd6\x72\x60\x25\x8f\x67\x25\xf7\xa6\xb0\xc3\x37\x1d\x05\xbe\x27\x3c\x18\xa8\xc9\x7f\x4c\x6a\x72\x57\x16\x46\xa1\x07\x7e\x72\xe0\x27\x07\x7e\x72\xe0\x27\x07\x7e\x72\xe0\x27\x07\x7e\x72\xe0\x27\x07\x7e\x72\xe0\x27\x07\x7e\x72\xe0\x27\x07\x7e\x72\xe0\x27\x07\x7e\x72\xe0\x27\x07\x7e\x72\xe0\x27\x07\x7e\x72\xe0\x27\x07\x7e\x72\xe0\x27\x07\x7e\x72\xe0\x27\x07\x7e\x72\xe0\x27\x07\x7e\x72\xe0\x27\x07\x7e\xf2\x29\xf8\xc9\xdd\x4d\x62\x0f\x6d\x77\x04\xf4\x1f\x8a\xa4\x3c\xa2\xcb\x75\x77\x46\x81\x56\x5d\x1e\xcb\x54\x1e\x05\xd9\xb0\x99\xc7\x31\x95\x47\x61\x6e\xfd\x3d\xec\x43\x4c\x65\xa7\x19\xea\xff\x3d\xec\x7d\x4c\xe5\x51\x90\x75\xb4\xbe\x72\xba\x1d\x61\x2a\x8f\x43\xdc\xc1\x66\xde\xcf\x54\x1e\x05\x19\xd8\xcc\x81\xcd\x1c\xd8\xcc\x81\xcd\x1c\xd8\xcc\x81\xcd\x1c\xd8\xcc\x81\xcd\x1c\xd8\xcc\x81\xcd\x1c\xd8\xcc\x81\xcd\x1c\xd8\xcc\x81\xcd\x1c\xd8\xcc\x81\xcd\x1c\xd8\xcc\x81\xcd\x1c\xd8\xcc\x81\xcd\x1c\xd8\xcc\x81\xcd\x1c\xd8\xcc\xbf\x7b\x36\xf3\xa8\xd7\x16\x4c\xe1\x7d\xc6\x56\x78\x1b\x4d\x1c\xda\x45\xc9\xd3\xe4\x03\x97\x93\x81\xec\x5e\x8d\xf2\x51\x63\x71\x50\xf9\xf6\x14\xda\xfb\x5e\xb1\x86\x16\x18\x97\x4a\x8b\xac\x6a\x1d\x28\x42\x3c\x6c\xae\x18\x27\x98\x2c\x73\x4a\xb6\x68\x5a\xa2\xa2\x49\x5b\xc4\xfe\x76\x92\xf2\x60\xdb\x6d\x24\x4b\x88\x1d\x9a\x5b\x7a\x64\x99\x9b\xc2\x35\x5e\xab\x33\xab\xce\x26\x5c\x1a\xbf\xde\x06\x32\x51\xe6\xc7\x56\xf4\x78\x07\x59\xad\xce\x8f\xbd\x37\xe8\x77\x1d\xa8\xaa\xba\xbc\x92\xa2\x2c\x48\xe5\xd4\x68\x9d\x60\xea\x51\x5c\x4b\x92\x9f\x47\xa7\xf5\xfa\x91\xb6\xbe\x1b\xdd\xb9\x03\xfc\x7f\x02\xf2\xec\x58\x7b\x03\xc0\xd2\x38\x4d\x29\xd0\x3a\x52\x09\xba\x75\xf6\x4c\x4c\x7d\x47\xbe\xfe\x68\x44\x68\x20\x8e\xb0\xf6\x1d\x10\x9b\x19\xea\x50\xb5\xb7\x63\xe5\x96\xb6\xef\x80\xbb\x8f\xe0\xbf\x4d\xde\x77\x00\x3d\x40\xf3\xef\x53\xf8\x1d\x30\x8f\x92\xfd\x7b\x44\x7e\x07\xe0\xe3\x94\x7f\xd7\xa6\x9e\x94\xf8\x6f\x2d\xd7\xf1\xf4\xff\x89\x42\xb5\x9b\xff\xef\x00\xda\xbd\x29\xe0\xe0\x2d\x00\x0e\x98\xc3\xfb\x02\xf6\xdc\x05\xe0\x80\xd8\xdc\x1a\x50\x9f\xe4\xb6\x13\xb9\x86\xae\x83\x1f\x3d\xe0\x8f\xdd\x04\xe0\x00\xd9\x97\xfe\xf9\xf9\x8e\x85\x3d\x85\x78\x97\xdb\x73\x6a\xbb\xee\x5a\x69\x76\x00\xad\x32\x36\x9a\x28\xba\x59\x1c\xc6\xda\x54\xd5\xe2\xe5\x59\x91\xf2\x98\xbb\x2c\xb3\x3a\x07\x10\xde\xc1\x95\x59\xb4\x5c\xbf\xa1\xed\x3a\x17\x33\x51\xbc\x25\xca\x83\x49\xae\x6e\x1a\xee\x04\xdd\x59\xb2\xbd\x86\xe7\xa2\x69\xb7\xa9\xd4\x09\x94\xac\x89\x54\x89\xb6\x8d\x0e\xa5\xdd\x77\xcc\xee\xbe\x89\x79\x7c\xd0\x6a\x3e\x2e\x07\x95\xd2\x6e\xe3\xaf\x26\xb5\xb1\x4d\xa0\x74\xc4\xee\x1c\x9a\xfb\x8b\xce\xf5\x96\x83\x69\x83\x33\x3d\x21\x60\xe7\x50\xd5\xbc\xfc\xde\x90\x79\xc0\x36\xe7\xbe\x4a\x6f\x2e\xaa\x14\xe5\x37\xaa\x72\x2b\x38\xc7\x95\xbd\xb4\xc1\xde\x4e\xf6\xe5\xa1\xd3\x50\x2f\xf0\x83\xf1\x7d\x4f\x44\x9b\x15\xb0\x27\xca\xef\x09\xda\xcd\x0d\x38\x11\x2d\x7f\x9a\xfc\x4e\x8a\xfb\xef\x98\xd9\xa7\xc9\x61\xff\x23\xc1\xff\x29\x98\xde\xcc\xfc\x91\xe7\xf9\xc3\x4f\x2d\x3a\x27\x1a\xe9\x6f\x4d\xfe\xc0\x04\x1a\x3d\x9c\x9c\x98\x7f\x36\x6a\xfe\x1e\x72\xfe\x75\x2f\x68\xec\x3f\xc1\x00\x3f\xe9\x6a\x95\x7f\xd6\xdf\x55\x4c\xa6\x71\xf5\x07\x42\x72\x67\x93\x13\xda\x44\x03\x6f\x5c\x38\x69\x8a\xc2\xb1\x44\x85\x09\xa0\xfb\x98\xfa\x13\x20\xef\x97\x7b\xb8\xfa\x13\x30\x77\xa5\x3b\x9c\xba\xf3\x4d\x9e\xc6\x04\xc4\x61\xc7\x7f\xd2\x94\xa9\xf1\x59\x5f\x47\x5e\x70\x0d\xe6\x76\x96\xc6\x9a\xbd\x60\xe4\x89\x48\xf3\x5e\x47\x89\xed\x51\xf1\xda\x8b\xbb\xd9\xfd\x31\xc4\x85\x85\x4d\x34\x2f\x24\x52\xa0\x87\x51\xc6\xcf\x04\x4c\xcb\x96\xe9\x5e\xa2\xd0\xcd\x2a\x99\x80\xbc\x9d\x8f\x52\x5d\x87\x30\x01\x72\xd2\x45\x0a\x93\xcc\xc5\x93\xa9\x53\x97\x70\xc3\x41\x2b\xda\x39\x5b\xc5\x3f\x67\xa5\x2e\x59\x2f\x3b\xaf\xe2\x23\x63\x27\xe7\x18\x35\x73\x28\xfa\x44\x5e\x17\xaf\xd9\xef\xef\x60\xe7\x3f\x0f\x19\xff\x50\x38\x0f\x85\xf3\x50\x38\x0f\x85\xf3\x50\x38\x0f\x85\xf3\x50\x38\x0f\x85\xf3\x50\x38\x0f\x85\xf3\x50\x38\x0f\x85\xf3\xd0\xf4\xf3\x90\x77\xc5\xae\x97\x94\xec\xd8\xef\x7e\xde\x79\x55\x89\xdf\x81\xae\xce\x4a\xed\x87\xdc\x28\x90\x53\xa7\xa8\x3e\x39\x5e\x5b\x5e\x6b\x00\xa3\xff\xbc\xee\x2d\x99\x72\x7b\x49\x77\x76\xac\x16\x8a\xce\xbb\x0a\x66\x9d\x78\xaa\x53\x31\x87\x0b\x4d\x26\xc8\x9c\xbb\x88\xd7\x83\x70\xd6\xac\x2a\x6b\x6c\x34\xe9\x26\x9e\x49\x30\x7b\xd2\x9d\xf6\xdf\x74\xe2\x80\x6d\xee\x44\x19\x71\xdf\x89\x47\x0e\xc8\xfc\x60\xff\xdb\x24\x2e\x07\xe8\x7e\xff\x0f\x5e\x7b\x12\x39\x6f\xb7\x94\x68\xbf\xef\xf2\x93\xbc\xb9\xf3\xc4\x01\x97\x46\x75\xe4\xcd\x27\x0e\xa8\xe6\x8e\x94\x3d\xf7\x9f\x44\xe7\x75\x34\x0c\x35\xa6\xa3\x45\xb0\xf7\x2f\x48\x74\xfd\x64\x4e\x88\xd0\x71\x14\xd1\xc5\x28\xe4\x9b\xc6\xea\xbf\x66\x75\x7c\xf9\xe6\x92\x31\xe4\x6d\xe8\xf4\x3a\x76\x37\x20\xba\x4c\x73\xfe\xed\x49\xc2\xd9\x26\x3a\x39\x23\x9b\x6c\x34\xcc\x3a\x04\x29\x83\xf9\x64\x33\xe8\xbb\xf3\xec\xde\xea\x4d\x81\x26\xb5\xc9\xae\x75\xfa\x5b\x3a\x0b\xeb\x4d\x20\xc9\x7d\x18\x08\x92\xeb\xb6\xe9\x27\xbe\x21\xef\x25\xe4\xbd\x84\xbc\x97\x90\xf7\x12\xf2\x5e\x42\xde\x4b\xc8\x7b\x09\x79\x2f\x21\xef\x25\xe4\xbd\x8c\xc9\x7b\xf9\x3f\xf6\xae\xfd\xb7\x6d\x5c\x4b\xff\xee\xbf\x82\xe8\x2d\x60\x67\x27\x76\xda\xd9\x07\xb0\xde\xc5\x0e\x72\xdb\x74\x10\xcc\x24\x0d\x9a\x4e\xbb\x8b\xb9\xb3\x05\x6d\xd1\x36\x11\x59\x14\x44\xc9\xa9\x17\xf3\xc7\x2f\x0e\x5f\x7a\xd9\x0e\x49\x49\xbd\x6d\x2f\x9b\x05\xf6\x66\x5a\x7d\xa2\xf8\x38\x3c\x3c\xe7\xe3\x77\x42\x9c\x37\xc4\x79\xbf\xeb\x38\x6f\xe0\xbd\x04\xde\x4b\xe0\xbd\x04\xde\x4b\xe0\xbd\x04\xde\x4b\xe0\xbd\x04\xde\x4b\xe0\xbd\x04\xde\x4b\xe0\xbd\x04\xde\x4b\xe0\xbd\x38\xf2\x5e\x7c\x5e\xe9\x3e\x38\xd3\x16\x75\x85\x8f\x06\xfb\x34\xc7\x07\x2a\xd5\x2e\xe6\x23\xe7\xcd\xbe\x94\xe7\x81\x64\xbc\xe1\x0d\x28\x2a\x82\xfd\x12\x92\xea\x3c\x32\x85\x6f\xf4\xe2\xca\xa2\x10\x5a\x65\x0e\x6f\x61\x77\x8d\x2c\x8b\xc2\x20\xf4\x7f\x2c\x21\x52\x27\x04\x82\x4a\x82\x6b\xc0\xa0\xd8\x08\x64\xe2\x27\xfc\xcc\x52\xd7\xc0\xfd\xb0\x63\xa4\x1c\x82\x06\x50\xd0\x00\x0a\x1a\x40\x41\x03\xa8\x27\x0d\xa0\x0d\x16\xf6\x50\xeb\xc5\x6a\x9a\x49\x8b\x2a\xe9\x80\xdd\xa8\x76\xf4\x1f\x27\x15\x81\x1c\x70\x2b\xda\x41\x8d\xb5\x30\x1b\x0d\xe6\xcc\xb5\xcc\xa3\x5a\x28\xb0\xb8\x2b\x53\x5c\xf6\x9b\x9b\xe3\x2b\xe9\xad\x24\xba\xab\xf7\x97\x8a\x6c\x8a\x40\x01\x8e\x22\x47\xd0\x94\x64\x53\x31\xb0\x39\x43\x2b\x9a\x44\x07\x7a\x4b\x8f\xc5\x68\xf8\xe0\x5c\x63\x2a\xb8\x3d\x7c\x82\x2d\x56\x75\x0d\x1c\x31\x51\x4b\xbf\xa7\x9c\x93\xbe\x33\xbe\xca\x78\xb6\x9f\x8b\xdd\xba\xb6\x43\x59\xa3\x23\x1d\xdc\x2e\x70\xe4\x81\x88\x0e\x15\x45\xaa\x95\x3a\xf2\x02\x75\x2b\x8f\xd4\x4f\xef\xf6\xa5\x90\xd4\xea\xe8\x26\xa8\x27\xa6\xca\x17\xe8\x4c\x4b\xbd\xde\x90\x37\x66\x75\x87\xee\x81\x62\xa8\x7f\x3c\x35\xd8\x3b\x58\xee\x13\x03\xd0\x2c\xce\xe4\x8d\x5a\xeb\xae\x7a\xfa\xa6\x03\x66\x2d\xf1\xa3\x42\x4c\xe7\x5d\xe3\xe3\xad\xe4\x8f\xab\x5b\xd7\xfc\xd3\x77\x02\xa8\x8f\xe5\x6a\xbe\xb6\xcb\xe3\x8d\xc9\x52\x96\x84\xea\x84\xa9\xac\x35\x0c\x45\x3b\x2f\xd4\x11\x59\x65\x95\x3a\xe5\x86\x7a\x8b\x54\xf5\x91\x23\x6a\x0d\x82\x86\xec\x84\x08\x73\xb6\x4c\x37\x1d\x48\x1a\x75\x44\xd7\x05\xac\x2a\x89\xa3\x8e\x88\x7d\x95\xb2\x2a\xff\xb4\x52\x4f\x60\x16\x3a\x62\xf6\x95\xbb\xea\x6d\x02\x76\xcd\x3e\xb5\xa6\x9f\x0a\xf3\x3b\x1d\xda\x0e\xfd\xe0\x56\x26\xab\xa7\x89\x72\x20\xb7\x73\xdd\x25\xc0\xaf\xc2\x18\x7d\x66\xb4\x06\xca\x6a\x0d\x95\xd9\x3a\x96\xdd\xea\x08\xd9\x4e\x18\x2d\xba\x42\xaa\xfc\x58\x2d\x15\xd3\x11\x52\x27\x72\x0e\xa4\x63\x3a\x22\x77\x2c\x18\xd6\x9b\x3f\xd8\xab\xbd\xf1\x8b\xfa\x1f\x71\x25\x3d\x53\x34\xdd\xd2\x34\x3d\xa4\x6a\x3c\x32\x05\x43\xf4\x64\xa5\xa4\x96\x6f\x47\xf6\x51\x94\xac\xd7\x39\x56\xdb\x8b\x2a\x1f\xd8\x65\xa1\x1f\x2a\x70\xa6\x4a\x96\x79\x63\x9e\x28\x75\xe6\x8d\x59\xfd\xdc\x56\xd1\x33\x6f\xd4\xde\x8b\xa5\x59\x94\x4d\xf3\x6f\x6c\x63\x6f\x33\x05\xd4\x3a\x21\xd6\x76\xa2\x8e\x34\xc3\xa7\x4a\xb0\xfd\xfd\xc2\x06\x1d\x2d\x52\xa7\xc7\xcb\xc2\x55\xf3\x51\xc7\x45\x5f\x42\x99\xac\x97\x5f\xa7\x36\xcb\xc2\x75\x2a\xd5\x75\xe8\x04\xda\xad\x40\x9c\x6d\xa9\x38\x2f\x48\xef\xf2\x72\xbd\x78\x1d\x1d\xf7\x81\x2e\xfb\x62\xa5\x24\x56\xe7\x99\xd8\x2e\x48\xe7\x81\x88\x2a\x25\xdc\x4e\x96\xa6\xf3\xc2\x6e\x94\xb3\x2b\xe3\xfb\x10\x45\xf6\x42\x3c\x5e\xd8\x0e\x51\xbf\x63\xd6\x13\x25\xee\xba\xf6\xe9\xc1\x62\x77\x5e\xa0\x07\x0b\xe4\xe9\xb4\x97\x08\x69\x79\xc1\x9e\x2a\x95\xe7\x1f\x99\x7c\xa2\x68\x9e\x17\xa6\x2e\xb4\x77\xa4\x7c\x9e\x17\x66\xf5\xa3\x65\xc9\x3d\xf7\x42\x7a\xbd\x58\x16\xdf\x33\x87\x7b\x99\xbd\x1e\xf6\xd4\x1e\x24\x85\x1e\x07\x97\x14\x6a\xa4\x3c\x83\xa2\xd0\x49\x45\xa1\x7a\x67\x05\x59\xa1\x20\x2b\xf4\x8f\x26\x2b\x04\xbd\xe3\x80\x28\x76\x5c\xa3\x44\x74\x5c\x5f\xc8\x01\xb2\xa5\x44\x74\x44\x5f\xc8\x01\x52\x0e\xd2\xc7\x0d\x11\x67\x7f\x20\xb2\x64\x04\x6d\x8b\x38\xa7\x69\x49\x6d\x77\x3a\x63\x43\x63\xe0\x10\xc0\xf5\xa5\x42\xde\xb0\xbb\xf0\x05\xd8\x89\x28\xdf\xb0\xd4\xa2\x8d\x60\xf8\x32\x2e\xf6\x7b\x45\x85\xc3\x71\xec\xd2\x4c\x60\xe2\x9a\x00\xb7\xe4\x3a\x52\x97\x5d\xdd\xf9\x80\x51\x33\x0b\xaf\x85\xcf\xc7\xcb\x34\x94\xf0\x59\x26\xe0\x5e\x3a\x09\x4c\xc1\x52\x03\x67\xef\x84\xdf\x2b\xd2\xbf\xd4\xf1\xae\x83\xa2\xab\xad\xe9\x8e\x24\xa5\xd3\x0b\xbc\x59\x9d\x13\x95\x87\x0b\x27\xd0\x43\x95\xb1\xdb\x87\x0a\x27\xc8\xe3\xb5\xb1\xc5\x61\xc2\xc7\x49\x7f\xca\x39\x17\x95\xa8\x9d\x10\x4f\x3a\xe5\xa6\x16\xb5\x13\xe4\x7f\x56\xbc\xba\xff\xaa\xb9\xe3\x55\xd7\xda\x09\xb2\x74\xc3\x61\x60\x75\x1b\xab\xd3\xb3\x74\xa9\x47\xc3\x33\x05\x6a\x93\xd8\xed\xd1\x23\x24\x92\x92\x2e\x25\x97\x9d\x23\x26\x3a\x41\x95\xf2\xa2\x3d\xf9\xf6\x8c\xf1\x6b\x2b\x51\x50\x77\x84\x46\x27\x35\x01\x7d\x23\xd6\xc7\x68\x4e\xb5\x8d\xdf\xb5\xa7\xe4\x4f\x33\x56\xd9\x2d\xe6\xd8\x21\x40\x74\x70\x76\x75\x64\x88\x1c\xa5\x27\x75\x61\xff\xb4\xa9\x49\x86\x60\xe4\x89\xd8\xa4\x25\x55\xc9\x45\x9e\x90\xdf\xdb\x9d\xf4\x92\x86\xd4\x21\xea\x52\x76\x4d\xb8\x9a\xfe\xd4\xd5\x74\x33\x21\x4b\xd2\x90\x37\x30\x3a\x48\x37\x72\xbb\x54\xd3\xfc\x83\xbf\xf0\x1d\xf5\x4e\x09\xa7\xbe\x48\x42\x5f\xdb\xd5\x74\x95\x44\xa3\x1d\xef\xd3\xf6\x4f\x0a\x52\x07\x64\x33\x89\xf5\x25\xf5\x0e\x90\x2d\x32\x90\x67\x36\xa2\xfc\x69\x13\x81\x54\xbb\x3b\x60\x56\xbf\x58\x4d\xdc\x26\x8d\xa7\x03\x7a\x2b\x71\xaa\x3e\x61\xd4\x2f\x7d\x27\xdc\xa4\x0e\x37\xa9\xbf\x97\x9b\xd4\x9d\x29\x39\xfd\xd1\x71\x3a\xce\x9f\x9a\xe5\xaf\x7c\x14\x2c\x58\x41\xa7\xf1\x6a\xd4\x41\x0a\x8e\xa1\xd2\x78\x41\xb6\xe9\x37\xd5\x31\xf0\x82\x6c\x51\x6f\xea\x14\x1a\x2f\xcc\x43\xb4\x1b\x15\xd0\xf0\x5d\x24\x0d\xca\x4d\x73\x0f\xf4\xc2\x34\x74\x9b\x9e\x69\x33\x03\x50\x66\x3a\x1d\x5d\x3b\x58\x08\xef\x47\xcb\xc4\xf7\x7c\xd4\x61\x31\x96\x30\x26\xf8\xaf\x6e\xb4\x76\x20\xed\x1c\x08\x7a\xfa\x9e\x91\x0e\xd2\x62\xea\x14\x17\x67\xcc\x26\x25\xa6\x2b\xbd\xc5\x73\x77\xee\x60\x53\x7d\xf7\x94\x4a\x90\x72\x3e\xea\x95\xca\x52\x89\xd2\x3a\x02\xa3\x93\x34\x96\x66\x14\xd9\x19\xbc\x46\x61\x39\x11\x96\xf7\xcd\x36\x9b\x94\x59\x0f\x54\x14\x8b\x48\xb7\x33\xe6\x13\x14\x14\xbf\x2c\xfe\x29\xfa\x89\x8a\x77\x3b\x63\x1e\xa3\x9e\xa8\x38\x8b\xc7\xbc\x3a\x4c\x3b\xa9\x36\xdc\x19\xb2\x1b\xe5\xc4\x73\xc5\xfb\xf8\xcd\xbe\x34\x13\xaf\xfd\xc8\xd5\x1c\x39\xbe\x04\xd2\x8d\x49\x4e\x75\xca\x71\x3e\x72\xb6\x57\x0d\x69\x9c\xaa\x45\xe9\xa6\x8f\x83\x77\x8c\x46\x28\x2d\x72\x25\x5a\xa1\xcc\xa2\xb6\x0d\x78\x6b\x6b\x04\x60\xf6\x9f\x07\x8d\x9c\xbf\x93\x46\x4e\x6d\x42\x54\x34\x4d\xda\x34\x0f\x07\x54\xe1\x51\x9f\x5b\x0a\xe5\x38\xe0\x82\x6e\x8d\x8d\x50\x8e\x03\xa4\x68\x8a\x95\x50\x8e\x03\xa8\x96\xd4\xb1\x10\xca\x71\x6d\xea\xb7\x23\x94\x73\x6c\x66\x35\xd5\x72\x5c\x30\x45\x87\x3e\xa9\x96\xe3\x80\xc8\x56\x55\x0a\xcf\x71\xb5\x1c\x07\x48\x68\x22\x5d\x19\xde\xd1\x09\xb5\x1c\x07\xd0\x93\x7c\xc1\x9a\x5a\xce\xc8\xc3\x41\x54\xba\x3a\x41\x2d\x27\xa8\xe5\x04\xb5\x9c\xa0\x96\x13\xd4\x72\x82\x5a\x4e\x50\xcb\x09\x6a\x39\x41\x2d\x27\xa8\xe5\x04\xb5\x9c\xa0\x96\x13\xd4\x72\x82\x5a\x4e\x50\xcb\x09\x6a\x39\x41\x2d\x27\xa8\xe5\x04\xb5\x9c\xa0\x96\x13\xd4\x72\x82\x5a\x4e\x50\xcb\x09\x6a\x39\x41\x2d\x27\xa8\xe5\x04\xb5\x9c\xa0\x96\x13\xd4\x72\x82\x5a\x4e\x50\xcb\x09\x6a\x39\xdf\xa5\x5a\x4e\x8d\xc6\xe2\xe9\x71\xb7\xb8\x54\xdf\x9a\x64\xce\x90\x9d\xd0\xd0\xcd\x71\x40\xac\x29\xec\x9c\xd0\xcd\x71\x80\xac\x2a\xec\x9c\xd6\xcd\x71\x00\x2d\x15\x76\x9e\xd2\xcd\x71\x00\xd5\x0a\x3b\x41\x37\x27\xe8\xe6\x04\xdd\x9c\xa0\x9b\x13\x74\x73\x82\x6e\x4e\xd0\xcd\x09\xba\x39\x41\x37\x27\xe8\xe6\x04\xdd\x9c\xa0\x9b\x13\x74\x73\x82\x6e\x4e\xd0\xcd\x09\xba\x39\x41\x37\x27\xe8\xe6\x04\xdd\x9c\xa0\x9b\x13\x74\x73\x82\x6e\x4e\xd0\xcd\x09\xba\x39\x41\x37\x27\xe8\xe6\x04\xdd\x9c\xa0\x9b\xf3\x1d\xeb\xe6\x38\xfc\x63\x9c\xad\x2d\xec\xb0\xb5\xb9\x76\x1a\x2e\xfb\x3e\x58\xb2\xed\x16\x27\xd1\xd7\xdf\x50\x92\xec\x7a\x6c\x64\x6d\x03\xb9\x4a\x76\x1f\x70\x35\x40\x08\x81\x6c\x92\xec\x68\xc6\x12\xf0\x8a\xd1\x0e\x67\x14\x2f\x2c\xbd\x78\x05\x02\x26\x19\xa3\x57\xd2\xb3\x24\xd9\x6c\xd4\x7f\x9c\x1a\x6c\xfc\x7c\xe4\xb1\x63\xde\xe2\xad\x31\xbc\x87\xbe\xd3\xde\x94\xdc\xa8\xd0\x0f\x46\xaf\x3e\x5d\xbf\xbe\xba\x7d\x7f\xfd\xe6\xfa\xea\x9d\xed\xf3\x4e\x53\xc5\x04\xfd\xbc\x3e\x79\xfc\x41\x7d\x1b\x12\xaa\x4c\x24\x01\x27\xf3\xf9\xe4\xc3\xe5\xbb\x4f\xb7\x97\x37\x57\xf6\x5b\x39\x04\x55\xc9\xe7\x14\x27\x11\x89\x50\xc1\xf5\xf6\x9d\x66\x64\x47\x59\x51\x6e\x94\x95\x7e\xb5\xc6\xd6\xfd\xcf\xf5\x7e\xae\x0e\x26\x50\xcb\x43\xdc\xff\xde\x23\x4e\xb2\x1d\x5d\xda\xef\xe6\x87\x86\x97\x0b\xf6\x0d\x36\xbf\xa3\x25\x4e\x5c\x52\xeb\x0b\x38\xe2\x70\x16\xef\xa0\x2a\x51\xbe\xa9\x74\xa9\x6e\x37\x4d\xd2\x22\x77\x19\x58\xf8\x11\x04\xa3\x05\x41\x45\xb2\xdc\x00\x7f\x2e\x92\xa7\xa9\xca\x20\x21\xbe\x4f\x72\xfc\xd9\x1a\x71\x89\x13\xf0\x06\x09\x5f\xe2\x54\x4b\x85\x60\x14\xb1\x02\x3e\xfa\xf9\xf3\x73\x44\xc9\x1c\x3d\xaf\xbc\xc0\x7e\xe2\x5f\x29\x4c\xf3\xe9\x5c\x11\xa4\x08\x64\x90\x17\xe5\x1c\xb1\x0f\x53\x66\x64\x8d\xb3\x28\x26\x5c\x14\x95\x7a\xdc\x10\xe0\xdc\xa8\xc3\xaa\x1a\x28\xe2\x76\x31\x52\x3a\xb0\x33\xe0\x93\xe0\x22\xce\x85\xae\xd7\xb3\x67\xb3\xf1\xa0\xcb\xf3\x4d\xc6\xb6\x5e\x4b\xf4\x5e\xe4\xce\x85\x86\xd5\x31\xc3\x34\xb6\xff\x76\xd1\x96\x19\x7a\x25\xa6\x36\xcc\x82\x82\x83\x27\xab\xf2\x2c\xda\x77\x72\xba\xc1\xea\x93\x47\x5c\xb2\x64\x45\xd7\x37\x38\xfd\x85\xec\xdf\x91\x95\xfd\x83\xcd\xce\x11\x09\x5f\x75\xf3\x1d\xe6\x87\xd8\x5b\x24\xb4\xfd\xac\xf5\xfb\x06\xe5\x64\xbb\x3d\xd0\x68\xff\x7b\x25\x34\x91\x33\xe5\x42\xbb\x34\xda\x6b\x32\xfa\xec\x92\x07\x9b\x3e\xae\x6e\x97\x6a\xbd\x7b\xe4\xbe\x6e\x40\x5a\x8e\x26\x2b\x36\x47\x9b\x3c\x4f\xf9\xfc\xe2\xe2\xa1\x58\x90\x2c\x21\x39\xe1\x33\xca\x2e\x22\xb6\xe4\x17\x4b\x96\x2c\x49\x9a\xf3\x0b\xa8\xe0\xb4\xa3\xe4\xf1\xe2\x91\x65\x0f\x34\x59\x4f\xe1\x6c\x34\x95\x9e\x27\xbf\x80\x8f\xe2\x17\x7f\x11\xff\xcf\xb9\x25\xef\xdf\xbe\x7e\x3b\x47\x97\x51\xa4\x74\x10\x0b\x4e\x56\x45\xac\xa4\xab\x66\x08\xa7\xf4\x03\xc9\x80\x38\xe2\x9e\x62\x79\xa0\x49\x74\x8e\x0a\x1a\xfd\x34\x1e\x39\x3d\xe8\x3f\xbe\x4c\x0c\x13\x8e\x3b\x8d\xf1\xbd\xa0\xd1\xee\x6b\x56\xd7\xac\x2f\x47\x60\x61\x77\x69\xce\xc5\xc1\x54\xe7\xc6\x94\x5b\xe0\x08\x25\x3b\x65\xc1\x58\x4c\x70\x32\xf8\x21\xcd\x2d\x5a\xeb\x74\x6e\xd2\x3f\x62\x8e\x75\xb1\x83\xe3\xd2\x10\x0a\x2c\xbd\x2e\x53\xe6\xf4\xb1\x08\xf1\x22\x4d\x59\x96\x73\x60\x48\xe3\x08\xe7\x78\x06\x8b\xe9\xbc\xfe\xab\x88\xa0\xb8\xad\x02\xf3\xbc\x88\x68\xf0\x0a\xa0\xd8\x89\x24\x6b\xc0\x0d\x12\xa2\x3a\x33\x08\x9c\x80\x21\x3a\x17\xf2\x99\x33\xe5\x07\x5e\x2e\x97\xac\x48\x72\xf1\x17\x6e\x98\x39\xce\x0b\x3e\xdb\x30\x9e\x5f\xdf\x9d\xeb\x5f\x53\x16\x35\x7f\xe3\xb3\xf1\x17\xd8\x5e\x4a\x9b\xd3\x69\x19\x2b\x0c\x3d\x29\x80\xf5\xbe\xc5\x5e\x99\xe8\x37\x30\xb9\xee\x70\xbe\x01\x4f\xe1\x31\xa3\x79\x4e\x12\xe1\xdd\x8a\x8a\x9a\x6c\xe5\x36\x82\xf0\x13\x55\x9d\xb0\xdd\xcb\x67\x5f\x6c\x03\x5c\xe9\x4f\xe9\xd4\xb5\xa2\x2f\x54\xbf\x0a\xc4\x72\x2b\xef\x1e\xad\xbc\xbc\xbb\x46\x3b\x39\x76\x5f\x75\x18\xcb\x74\xe5\xd0\x76\x52\x33\x49\xdf\xf4\x68\x2f\x35\xa6\x5a\x1d\x23\x0f\xae\x20\xc9\xe6\x32\xdf\xa5\xb1\x38\x8a\xe9\x16\xf6\x3a\xc8\xab\x29\x8d\x57\x7b\x1f\x1d\x7e\x26\x12\x60\xb6\x4c\x8b\x73\x05\x36\xdb\x92\x2d\xcb\xf6\xe6\x57\x92\x6e\xc8\x96\x64\x38\x9e\xf2\x9c\x65\x78\xed\x68\xea\x74\xb3\xe4\x2b\xcc\x6f\xf2\x25\xb5\x86\xb7\xdf\xe4\x16\xe2\x87\xd8\xc0\xb2\xc8\xc0\x3f\x8c\xf7\x7a\x87\x21\xd1\x17\xb1\xa0\x66\x84\x6e\x3b\xfb\xbb\x26\x68\x25\x12\x72\x73\xb3\x56\x1c\x41\xc5\x5d\x36\xb4\x63\x71\xb1\x85\x2c\x84\xf6\xd3\xc4\x7f\x25\xc9\x0e\x4e\xb5\x7c\xfc\x65\x56\x3b\x42\x11\xdd\x51\xee\x4e\x2a\xc4\xc9\xfe\xed\xca\xe3\x7e\xa5\xe7\x45\xbc\xea\xd3\x1e\x1f\xd9\x18\xc8\x7b\x65\x5f\x95\xe0\x74\x91\x43\x50\x46\xde\x2f\x74\x84\x55\x6a\xc6\x22\xaa\xc1\xe0\x1c\x6d\xd6\xff\xb9\xd9\xd7\x9c\x21\x61\x1f\x7c\xe9\x9a\xde\x4c\x71\x9e\x93\x2c\x99\xa3\xff\x9d\xfc\xed\x87\x3f\xa7\x67\x3f\x4d\x26\xbf\xbf\x98\xfe\xfb\x1f\x3f\x4c\xfe\x36\x13\xff\xe3\x9f\xce\x7e\x3a\xfb\x53\xff\xf2\xc3\xd9\xd9\x64\xf2\xfb\x2f\x37\x3f\xbf\xbf\xbb\xfa\x83\x9e\xfd\xf9\x7b\x52\x6c\x1f\xe4\x6f\x7f\x4e\x7e\x27\x57\x7f\x58\x82\x9c\x9d\xfd\xf4\xdc\xb1\xa1\x9f\xa7\xe5\xe9\x6e\x4a\x93\x7c\xca\xb2\xa9\x1c\xd2\x39\xca\xb3\xc2\xcd\x23\xd1\xdd\x3d\xef\x32\x1d\xc6\x5a\x84\x77\x6e\xf0\x7c\xa8\xab\x72\xcf\x1f\x7f\xcd\xdb\xb4\xfe\xbc\xa1\x77\x69\x4e\x96\x19\xc9\xfb\x8f\xec\x48\x5c\x57\x75\x02\x75\x0c\xaa\x92\x07\xbe\xc0\xbe\xd3\x57\x60\x48\x19\x18\xf5\xe9\xde\xf3\x52\x5c\x5e\x9c\x55\x13\x13\x3b\x51\x87\x54\xe2\x3a\x83\x3e\x90\xfd\xec\xcb\xcc\xf4\x10\xa8\x0a\x81\x2a\xcf\x40\xd5\xbd\xdf\xdc\x0e\x51\xaa\xc3\x51\x2a\xc7\x47\x5c\xbe\x62\x2a\x16\xf9\xa8\xe7\x36\xd8\x27\xd1\xe9\x16\xaf\x2d\x6c\x8c\xc3\x14\xb7\x33\x5a\x0e\x80\xe9\x06\x73\x0b\xc4\xda\xa2\xb8\x83\x67\xf4\xb5\x2a\x01\xa0\xad\xe0\xa2\xa0\xb1\x24\x7c\xf2\x9c\xd8\x10\x7b\xb3\x02\x6e\x37\x26\xe7\x48\x54\x22\xfa\xf1\x5f\x21\xca\x91\x15\x2a\x5e\x91\x93\x14\xe1\x55\xae\x96\x5d\x9a\x31\xcb\x29\x42\xb9\x68\x48\x8e\x26\x3f\xbe\x38\x93\x1c\xd4\x05\x59\x31\x55\x0e\x43\x54\xf1\x5b\x8a\xc0\x20\x7c\x43\x8a\x97\x0f\x78\x6d\xb5\x08\x27\xff\xfc\xe2\x6c\xd4\xaf\xa0\x88\xcb\xc9\x45\xbb\x59\xdc\x71\xb8\xde\xe9\xe7\x4c\x45\x10\x55\xaf\xa5\x44\x44\x6c\x35\xb2\xd4\x61\x82\x41\x31\x87\xdf\x51\xbf\x6e\x8e\x0c\x40\xcc\x47\x43\xf3\xdb\x9d\x8e\x99\x7e\xc7\x4b\xaf\x63\xe5\x57\x7f\xda\xea\xe5\x94\x55\x9b\x9c\xe3\x5f\xc5\x98\xab\x19\xbb\x50\x87\xe7\x2d\xfe\x4c\xb7\x50\xc2\x66\x0b\xe1\x76\xcb\xc6\xb1\xd5\x81\x89\xad\xd9\x7a\xae\x6e\x96\x4c\x24\x17\x50\x9b\x88\x25\x17\x5b\x9c\xe0\x35\x99\x2a\xf8\xa9\x81\x9f\x9a\x95\x70\x31\x1e\x0d\xb4\xd9\x41\xf4\x2c\x2c\x89\x7f\xa4\x25\xf1\x4e\x8d\x7a\x73\x51\xd0\xa4\xa7\x45\xa1\x7d\x28\x41\x4c\x32\x6f\xb3\xbe\xe8\xc1\xb6\x90\x9f\x89\x44\x64\x11\x97\x9b\xc1\x39\x94\x88\xab\xa6\x5d\xe4\xd2\xb6\x04\xa5\xe0\x41\xc8\x0a\x6e\xe4\x33\xec\xd0\x34\x8f\xf7\x65\xce\xe2\x5c\x26\xcf\x1f\x29\x77\x88\xa2\xe0\x04\xd1\x6d\x2a\xef\xda\x88\x85\x3c\x55\x1e\xb7\xa6\xab\x94\x46\xc1\x12\xf1\x5b\x31\x1d\x0e\xff\x98\x93\x65\x91\xd1\x7c\x0f\x61\x68\xf2\xd9\x42\x32\xae\x36\x59\xef\xeb\x4f\xa3\x0d\x83\x7a\x5c\x1a\x13\xd5\xfa\x62\x64\x59\xce\x45\x33\xd3\x84\xa7\x46\x22\x98\x4a\x95\x69\x36\x43\xf7\x20\x15\x25\x4f\xa5\x16\x90\xe0\xf4\x54\x48\xa2\x0b\x96\x6f\x5a\xad\x06\x17\xf1\x8e\x45\x8d\xff\x3c\x43\x42\x8c\xc9\xe2\x1d\x02\x14\x5e\xc4\x49\x5e\xbb\xeb\x4b\x93\xd6\xbb\x72\xfc\x60\x63\x14\xd2\x8c\x2c\x49\x04\xc4\xc9\x59\xcf\x5e\x96\xd8\x12\xef\x32\xba\xa3\x31\x59\x13\x60\xd6\xc5\xd8\x5e\x5e\xad\x6e\xaa\x2e\x8f\x60\x89\xe1\xca\x98\xf5\xb5\x39\x7d\xbe\xc6\xe0\x31\x2e\x81\x93\xb7\xc4\x09\x82\x3b\x3f\xb2\x46\x64\xaa\x5f\x01\xfe\x81\xf5\xa9\x17\x1c\x8b\x14\x43\x58\x46\xc3\xaa\x1b\xc3\x40\xf1\x40\x11\xcd\xc8\x12\x8c\x8c\x63\x5b\x4d\x49\xc0\x4f\x09\x79\xfc\x04\x2d\xe3\x68\x15\xe3\xb5\x99\xb7\x42\xa6\xc7\x45\xc9\xdb\xcc\xed\xb2\x99\xc7\x3a\xd6\xb6\x8d\x5c\xf8\x63\x08\xc7\x8f\x78\x0f\x97\xba\x48\x93\xe1\x4a\xf9\x1c\xbd\x3c\x83\xfb\x11\x96\x90\x98\x23\xd3\x9e\x08\xfd\x78\x26\xca\x21\xbe\xba\xbc\xfb\x74\xff\x3f\xf7\x9f\x2e\x5f\xdf\x5c\xdf\x8e\x47\x43\x84\x2f\x96\x38\xc5\x0b\x1a\x53\x7b\x6f\xa6\x36\x45\x21\xe0\x59\x85\x10\xd6\x24\x8a\x2e\xa2\x8c\xa5\xa2\x5f\xac\x20\x91\xbe\x48\x52\xf6\x20\xaf\x13\x3d\xa1\x77\xd5\xe6\x67\x89\xa8\x84\x28\x6a\x8d\x5b\x67\x38\xc9\xb5\xb0\x5e\x65\xb8\xec\x1b\x99\xd3\xad\x25\xa1\xdc\xcd\x6a\x28\x07\xd3\xf6\x9f\x36\x46\xe1\x32\x02\xfe\x76\xf5\x53\xad\x71\xac\x2f\x19\x1c\x7c\xf1\x2b\xfd\xca\x7d\x79\xe1\x00\xdd\xbd\xbd\xbf\xfe\x6f\x07\xc4\xfa\x24\x14\x3b\xb1\xc3\xd3\x1e\x5e\xaf\x4b\x90\x49\xff\x81\x09\xed\x39\x3a\xef\xc8\x96\xed\xc2\xf8\x0c\x39\x3e\x4e\xde\x1b\x2a\xf7\x3b\xcb\x68\x6d\x7d\x38\x8b\xa4\xb4\x1c\xc0\xfe\x29\xd1\xd0\x16\x34\x26\xad\x20\x11\xba\x93\x9b\x11\xe1\x0d\x0c\x83\x0d\x5a\x26\xc0\xf7\x87\x41\xa3\x38\xb6\x16\x4b\xac\xdf\x87\xcf\x18\xd3\xfb\x25\x02\x82\x5c\xcd\xae\x5a\x22\xae\x70\xcc\xc9\x6c\x90\xfd\x07\xb6\xe4\x1b\x08\x43\x78\x8c\x84\x79\x16\x45\x24\x61\xba\x02\x20\xcc\x05\x88\x98\xc2\xdf\x5a\x61\x22\x24\x8e\x7c\xd0\x59\x05\x2f\x29\xfb\xe5\x38\x80\x5b\xe3\xba\xfd\x50\xae\xfb\xf9\xce\xb4\x52\x5c\xfe\x46\x05\x27\xbc\xfe\x02\xb7\xed\x47\x37\x84\x8b\x96\x66\x04\x47\x82\xc4\x94\xe2\x7c\x23\x99\x4b\x5b\xcc\x1f\xac\xd3\x1e\xe2\x31\xe5\xb7\xa9\x83\xab\x6c\x9d\x69\xf6\x7b\xe8\xcf\x15\xc1\x79\x61\x7d\xef\x56\xf8\x6b\x52\xa9\x96\x24\x70\xd5\xc1\xf2\x3e\xa7\xa3\xad\x80\x6f\x7f\x9b\xc4\xfb\x77\x8c\xe5\x6f\x68\x4c\xa4\xe8\xac\xc7\x44\xfa\x68\xf2\x4f\x94\x57\xd6\x36\xb8\x60\xc0\x34\xc3\xd1\x14\xba\xd8\x0a\x17\xc9\x05\xb7\x32\xcd\x31\xeb\x0d\xbc\xc6\x01\x17\x52\x56\x24\x97\xfc\xe7\x8c\x15\xa9\x47\x07\xc0\xfc\xfe\xf9\xfa\x75\x35\x3f\x40\x92\x3c\xdb\x4b\xc5\x60\xab\xf0\xb5\x0e\x61\x1f\xf0\xb6\x7f\x83\x19\xdf\x98\xbd\x96\x88\x74\x85\x8a\x84\x93\x7c\x86\x6e\xf0\x1e\xe1\x98\x33\x7d\x04\xa0\xc9\xe1\x33\xe5\xb5\x6d\x63\x39\x71\x3d\xb1\xda\x26\x66\xcd\x09\xb5\x42\x0a\x3d\x72\x54\xb5\x75\x04\x5c\xce\xab\x8d\x3c\xc9\xbf\xfd\x8b\xd5\x13\xae\x31\x47\x31\xdf\x6e\x59\x02\x8b\xcf\x63\xc6\x5d\x27\x11\x5d\x2a\x39\x45\x9c\x37\x66\x0e\xdc\x33\xb0\x82\x14\xcd\x40\x62\x99\x82\x5e\x97\x58\x7a\x05\x27\x99\x08\xbd\xc1\x11\x4d\x86\x0b\x7e\x29\x16\x24\xb6\x4e\x2a\x8b\x83\xa6\x20\x5a\xe0\x5c\x26\xb5\x44\xa6\x11\x94\xc7\xf5\x24\x06\x61\xe7\x84\xdb\x1b\x44\xf1\x8d\x10\xbf\x63\x44\x5e\xd5\x52\xcd\xfe\xed\xfa\x35\x7a\x81\x26\xd0\xee\x33\x61\xbb\x57\x98\xda\xaa\x28\x02\x63\x39\xc7\x59\xb3\xef\xe8\x4a\xbf\x48\x74\x82\x58\x3d\x96\x88\x2c\x93\x06\xea\x1c\x25\x0c\xf1\x62\xb9\xd1\xbd\x00\x21\x07\x7d\xfc\x4e\x49\x06\x93\xcb\xfe\x8a\xbe\xfd\xc2\x45\xf6\x4d\xfd\x6e\x17\xae\x97\xd9\xff\x8d\x93\xcc\x63\x0d\x82\xd5\xff\x6d\x30\xab\xaf\xb6\x3d\xae\xfc\xaa\xac\xec\x53\x4b\x5c\x9a\xa8\x85\xa7\x2f\x9d\xc0\x85\xc7\x22\x31\x30\xb5\x3d\xc1\xcd\xe8\x9f\x98\x80\xc7\xf6\x04\xcb\x17\x1c\x99\x80\x87\xa6\x96\x25\xe2\xb1\x09\xf8\x15\xee\x09\x9c\xfc\x4a\x93\xe2\xf3\x5b\x61\xe3\xb9\xe7\x8c\xbc\xbf\x12\x20\x62\x4a\xc1\x92\xce\x59\x25\x5a\x3d\x72\xd2\x74\x37\xb3\x52\x9b\x42\xdd\xf5\xe7\xf5\xbf\xb6\x44\xd5\xd6\x5f\x98\x42\x88\xf3\xc2\x06\x86\x30\x14\x48\x89\xd8\xb6\xd9\x70\x4b\x50\x70\xe2\x09\x5e\x6e\xaa\x8d\x75\xb0\x99\x96\x6f\xf9\xae\x9d\x1d\xf7\x50\x5b\x4c\x76\xc4\x81\xba\x56\x9b\xa3\xbf\xc2\xb3\xe0\xcc\xeb\xf1\x16\x60\xce\x02\xc8\xc2\x25\xa8\x14\xab\xab\xcf\xd7\xd1\x80\xe1\x95\x8c\xc5\x16\x9c\xa4\x83\xdf\xfe\x8e\xc5\x04\x3e\x1d\x9b\x8f\x07\xb0\x6f\xe8\xdb\xc5\x23\x7e\xdf\xfe\x7e\x9f\x36\xbe\x1d\xc0\xbe\xa1\x6f\x2f\xac\xbd\x84\xd6\xb7\x83\x83\x51\xff\x76\x00\xfb\x66\xbe\xdd\x31\x3c\xf8\x48\x93\x88\x3d\xf2\x6e\xdb\xd8\x47\x09\xa2\xad\xe2\x12\xec\x78\x4e\x93\x35\x57\x1d\x60\xbb\xfd\x43\x42\x25\x8e\xcb\x6e\xe2\x87\xf7\x32\xc9\xc7\xb5\xb5\xb0\xc0\x2b\x17\x8f\xb5\xed\xbe\x71\xf4\x41\x62\x62\x16\x76\x18\xcf\x1d\x66\xbd\xe5\xf8\x55\x06\xef\xc8\x29\x8e\xe1\xf2\x92\xe7\xd2\xfb\xf9\xe6\xfe\xb2\x0e\x04\x0b\x51\xa8\xe0\x39\xe4\x40\xe1\xff\x00\x09\xe1\x68\x4b\x85\xac\x29\x7a\x24\x8b\x0d\x63\x0f\x68\xa2\x59\x17\x6b\x9a\x6f\x8a\xc5\x6c\xc9\xb6\x15\x02\xc6\x94\xd3\x35\xbf\x50\x2b\x62\x0a\x5f\xe5\x72\x8b\x90\x26\xb1\xa8\xfd\xa3\x17\xba\xd0\xa3\x52\x6c\x56\xd1\x9c\xa5\xf9\x32\x07\x54\x98\xfa\x82\x7b\x6c\x32\x79\xed\x4e\xba\xb5\xa3\x26\xeb\x1f\xc1\x77\x18\xd0\x02\x1d\x9a\x10\xb7\x4e\x57\x24\x9e\x98\x14\x00\xa6\x48\xc3\xd6\x90\xf2\x96\xc6\x91\xf1\x10\x8b\x4d\x9d\xdd\x06\xed\x18\x73\x82\xed\xd0\x1f\x60\x70\x35\x04\xd8\x06\x6d\x7c\xe5\xc9\xd6\x1a\x13\xb5\xcf\xc0\x47\x4e\xb7\x0e\x90\xd5\x73\x30\x80\xd5\xcf\xc2\xe6\x94\xeb\x80\x68\x73\x1e\x76\x88\xa5\xa8\x43\x66\xdb\x40\xcf\xfa\x39\x12\x0f\x73\x2c\xee\xf5\x68\x3c\xbc\x57\xe1\xf0\x8f\xe5\x25\x63\x91\x7b\xb1\xd8\x6e\xac\x13\xc4\xb5\x05\xf3\xa1\x7c\x87\x5a\x49\x40\x0e\xc6\x32\x13\x06\x44\x0c\xcb\xe8\x0f\x56\x48\x42\x8e\x4c\x88\x04\x9a\xb5\x32\x1b\xf5\xbf\xaf\x8a\xe6\xb9\xa8\x3f\xd4\x3e\x1a\x1e\xd4\x0d\xad\x2f\x6b\xac\x92\x73\x96\xa8\xca\x27\x91\x5f\x5e\x0a\xf5\x8a\xd6\x81\xd3\x24\x2f\xec\x41\x9c\x57\xbd\xc2\x1a\x77\x3c\x1f\xcf\x46\x03\xcd\x57\xd1\x3c\xe0\x2e\xe3\xb5\x03\x23\xad\xd1\x87\x4d\x10\x14\x11\xa8\x2f\x28\x76\xf9\x0d\x7b\xb4\x84\x54\x8d\x91\x19\xee\x54\xa1\x91\xa8\xf4\x4b\x21\x53\x0d\x06\xd3\x0c\x91\x35\xb0\xd6\x44\x17\x84\x56\xf4\x08\x16\x31\x63\x45\x12\xa9\xd2\x8f\x30\x28\x82\x46\x78\xd3\xf8\x90\x5b\x96\xd8\x3b\x0c\x94\x43\x48\x33\x52\x79\x53\x23\xf0\xbe\x20\x60\x94\x13\xf4\x72\xf6\xf2\xc5\x60\xc3\x68\x77\x49\xe9\xc0\xd0\x89\x1c\x2f\xa4\x56\xa4\xa8\xb0\x98\xc3\xfa\x92\xa5\x5e\xc7\x83\xb5\x5a\xe7\x6a\xbd\x5a\x2e\xc6\x8a\x44\x65\x2a\x16\x76\x3e\x99\xde\x01\xdc\x29\x48\xda\xd8\x0f\x5e\x49\x75\x9e\x88\x74\x07\x28\x5c\x55\xb6\xd1\xb3\x32\x74\x6d\x0d\x99\x33\x97\xd4\xae\x7b\x94\x1f\x14\x9e\x16\xbd\xd9\x3d\xb9\xc1\xc8\xc5\xe6\x6e\xf6\xcc\x92\x1c\xf3\xe3\x26\x70\xe4\xe3\x1d\x3d\x7b\x86\x26\x12\x11\x8a\x52\x41\x4e\x6c\xb0\xe9\xa8\xba\x13\xca\x0a\x7a\x75\xe9\x95\x12\xc4\x44\xe9\xc1\xbe\xb5\x84\x54\x07\x71\x31\x06\x4f\xf6\xad\x35\xa6\xd9\x86\xfe\x4a\x36\x78\x47\x38\xe2\x74\x4b\x63\x9c\xc5\x42\xb0\xf0\x5e\x7e\x39\x5a\x14\xf9\xc8\x12\xf0\xa0\x7a\xe5\x40\xd2\xb3\xd5\x1e\xa8\xbc\xb6\xb7\x09\xa5\xbf\x1f\x46\xde\xbe\xad\x49\xa4\x9f\x13\x3b\xd6\xb6\xd0\x45\x89\x3f\x2f\xe3\x82\xd3\xdd\x50\x76\x53\x5f\x25\xb1\x99\xa3\xd3\xd2\x3b\xfa\x06\x2e\xef\x2a\x25\x9d\x61\xfd\xdb\x92\xf4\x08\xbe\x2d\x6c\x9c\x91\x5e\x56\x96\x3e\x19\x06\xa9\x7b\x19\xb7\xdc\xe2\x3d\x58\x38\xbc\x84\x03\xa0\x24\x11\xe3\xc4\x94\x5b\x21\x99\x2d\xa4\x52\xb6\x18\xc2\x3d\xc6\x8f\xfc\x2a\xc6\x3c\xa7\xcb\xbf\xc6\x6c\xf9\x70\x9f\xb3\xcc\xcf\x55\x18\x5f\x7e\xbc\x6f\x21\x55\x7a\xd3\x12\x13\xce\x7d\xe8\xf2\xe3\x3d\x7a\x4d\xf9\x83\xb9\x21\x65\x6e\x22\xe1\x3c\xc7\x50\x7b\x1d\x96\xaa\x7d\x99\x61\x88\x4d\xc5\x24\x1f\x8f\xb9\xe0\x33\xa2\x2d\x86\x72\xd0\x44\x17\xc3\x49\x8c\xac\x8f\x35\xbd\xb1\x1c\x13\xd7\x7b\x8c\x4a\x58\xeb\x42\xcd\xe6\xbf\xe0\x47\x4e\x64\xb7\x2d\xa0\xdb\xe0\xaf\x89\x1d\x6f\xdf\x7d\xb0\xe1\x67\xc5\xdf\x3b\x25\x34\x9a\xc3\x5c\xb2\xe6\x0c\x6d\xd2\x63\x13\x33\xa1\xfd\x3d\x2b\xd0\x23\x96\xbc\x53\x61\x8c\x66\xe8\x3d\x4d\xe7\xe8\x4a\x70\x65\x8e\x94\x77\xfd\x7f\xf6\xbe\xad\xb9\x71\x1b\xcb\xff\x5d\x9f\x02\xe5\xf9\x57\xc9\xfe\x8f\x24\x77\x76\xa7\xd2\x59\xd7\x54\x52\x1e\x5f\x3a\xaa\x8d\x3b\xde\x96\x3b\xfd\x90\xc9\x4e\x41\x22\x64\x61\xcd\x5b\x13\xa4\x6d\xa5\xf2\xe1\xb7\x0e\x70\x40\x82\xb4\xec\xf0\x80\xa2\xad\xee\xb5\xdd\x0f\x1d\xa5\xf9\x13\x08\x1c\x9c\xfb\xe5\x89\xbf\xc0\x5a\xaa\x4c\x3a\xb3\x44\xa9\xaa\x5e\x64\xe8\xfe\x23\x2d\x54\xd3\x0c\xec\x34\x07\x6f\x3c\x0e\xaa\x9f\xb0\xb3\x7b\x0e\xb5\x6c\xea\x88\xed\x89\xfb\xfc\x6f\x7b\xa3\x01\x69\x1c\xd3\xfd\x52\xed\x8d\xd8\x5e\x9c\x2f\xd5\xde\x84\x4d\xa3\xb2\xc4\x4e\xc6\x4b\x91\x65\x34\x6a\xc4\xb6\xe4\x66\x21\x0f\x9d\x3c\xdb\x27\x51\xd2\xca\x4c\x1f\x93\x55\x72\xc7\x82\x84\xdd\x81\xf9\xa6\xa7\xf4\x33\x91\x65\x49\x66\x9b\xbd\x93\x10\x9d\x23\xd6\xfa\x10\x14\x02\x67\x49\x24\x4b\xa5\x00\xaf\x78\xdb\x9b\xe4\x21\x68\xf1\x0a\xf2\x2c\x97\x14\xd3\xf8\xe1\x8d\x02\x4f\x64\x09\x83\x9b\xb1\xcd\xfb\x34\x5d\xda\x02\xd1\x11\x79\x9f\x31\xf5\x52\xbb\x89\x11\x10\x6e\x10\x4a\x44\x10\x8f\xce\x3d\x20\x21\x9f\x97\xbd\xf1\xd8\x61\x20\x6e\x0f\x55\xc0\xbf\x19\xe9\xe5\x2b\x6c\xf1\x42\x5d\x6b\xb5\x87\x5c\x41\x67\xb5\x09\x9b\x59\x2d\x76\xe4\xbb\xa7\x15\x26\x24\x5d\xd8\x85\xc2\x76\xec\xbd\xd9\x63\xfb\x49\x06\x2b\x26\x21\x42\x19\x5d\x28\xf8\xad\xb0\x3d\x34\xa0\x1d\xc3\xda\x0c\xcf\x3a\x20\x75\x4a\xa4\x35\xb6\xf0\x4f\xd1\xf1\xb3\xc6\x1f\xd2\xb9\x6d\xdd\xb3\x07\x66\xf8\x1e\x30\xfc\x65\x02\xb2\x9d\x3a\x90\x17\x12\x53\x60\xef\x3e\xe0\x8a\xaa\x4d\x94\x31\x6a\x71\xda\xfa\x6f\x6f\x8b\xa3\x3d\x6e\x16\xf6\xe0\xc2\x38\x97\x80\x84\xb8\xa7\xed\xfb\xbd\x17\xd5\x10\xfc\x3c\x07\x95\xb6\x3d\x3d\xf5\x3f\xef\x8f\xb1\xfc\x5c\x08\x36\x3d\xb5\x3a\x42\x0a\x1d\x6e\x55\x4e\x9d\xb3\x18\xd4\x34\x41\x69\xd4\xc3\xfd\xe3\x88\xff\x9e\xc4\xec\xec\x1f\x33\x5c\x6a\x6b\xcb\x1f\x73\x4c\x77\xe1\x50\x48\xc2\x86\x62\xd9\xc1\xef\xb8\x3c\xc3\x96\x0f\x90\xec\x36\xf8\xc3\x7f\x2f\x32\x01\x8a\xfa\xd1\xc0\x83\x40\x8e\xed\xd3\x35\x9b\x2b\x36\x9f\xb3\x53\x9e\xb7\x57\xf0\x35\x8a\x91\x4e\x4e\xdd\x92\xd6\xef\xe7\x32\x0e\xaa\x6a\x1d\x8a\x4c\x69\x69\x71\xf9\x2a\xe2\x0b\xad\xa1\x5c\x5f\x24\x41\x07\x6d\xfc\x47\x78\xcd\x13\x83\xa4\x4b\xc9\x8e\x18\x78\xa5\x47\x9a\x39\x12\x50\x19\x03\xde\x6e\x1e\x63\x9f\xc0\x37\x3a\xe9\x99\x9a\xcd\xbd\xa6\x05\x70\x1b\x6f\x7f\xe5\x78\xa3\x81\xbf\xe8\x00\x27\xa0\xb6\xb5\xab\xed\x0f\x3c\x3c\x0f\x93\x39\xc3\x5b\xfe\x1c\x6f\xfe\xf1\xc3\xb4\xd3\x8b\x7f\xfc\x30\x7d\xf0\xd2\x64\x95\xe9\x39\x5f\xba\xa3\xe1\xd9\xb4\x3b\x2b\xfd\xf6\xa2\x7d\xe9\x47\x69\x28\xf1\x07\x46\x62\x3f\x16\xe2\xc4\xda\x86\x68\xef\x91\x50\x9f\xb6\x0d\x2b\x7b\x8f\x04\xda\xb0\x0d\xfb\x3e\x75\x18\x88\xe2\x7d\xe6\xc3\xb3\xfb\x54\x4f\x9b\xb4\xdd\x3b\x66\x2b\x0e\xfd\x12\x59\x54\x84\xb9\x4c\x89\x63\xa5\x35\xb1\xc3\x4d\x51\x2c\x85\x7c\x0a\x43\xf6\x8c\x9b\x11\x12\xe0\x06\x36\xf5\x45\xa4\xf5\x32\x1c\x70\x5d\xa1\x6f\x06\xbf\xd0\x0d\xab\x88\xd0\x5a\xbe\x32\xd3\xb0\x26\x70\x6e\xfa\xbe\x09\x68\xc5\xf6\x7f\xd1\x40\x6f\xb9\x0c\x6d\x89\xb7\x12\xe0\xe0\x46\x05\x57\xdb\x78\x4a\x6f\x71\xef\xcc\xbf\xb3\x39\xe1\x3a\xed\xb5\xa2\xcd\xf6\x01\xf3\x50\x07\xf6\x88\xba\x60\x69\x49\xe8\x14\x39\x9d\xcd\x68\x4c\x13\xd7\xcc\x20\x21\x62\xe6\x66\xd3\x22\x99\xf4\xac\xb6\xd3\x55\x44\x2b\x84\x49\x0f\x7c\xfc\x30\xed\x55\xa5\x04\x5e\x7f\x34\xf0\x20\x8a\x63\xfb\xf4\x46\x95\x12\x60\x5b\xa2\x32\x36\x33\x03\x66\xda\x68\x95\x64\x9f\x71\x8f\x5a\xe5\xeb\xbd\xea\xe3\x5e\xd9\x56\xe7\x9d\xd4\x55\x78\x69\x9b\x3d\x69\xdb\x7c\x43\x58\x03\xc3\x41\x34\x77\x85\xa6\x74\x36\x43\x31\x83\x63\x90\x18\xac\x4f\xd3\xa7\xcf\x9c\x61\x22\x07\xd7\x82\xa2\xd3\x86\x68\x69\xce\x88\x69\xb7\xcf\x62\x38\x57\xa7\xdd\xfe\x11\xbb\x1d\x7d\x71\xc6\x85\x48\x57\xcb\xd6\x9c\xa0\xb6\xd1\x27\x22\x5d\x9d\xcf\x6a\x3c\x51\x7f\xc6\xce\x67\x8c\xd2\xbb\x90\xd5\xf8\xa0\xf6\x37\x6b\x2a\x00\x3c\xd3\x79\x3f\x94\x4b\x01\x1d\x27\x7a\xe4\x70\x51\x12\xcb\x3c\xc9\x94\x37\xd9\x39\x73\x20\x2e\x10\x0b\x1c\xba\xd0\xd1\x2e\x84\xd1\x0e\xed\x9b\x7a\xe1\x9e\x2c\xcd\x66\xda\x85\x6d\x72\x2d\x09\xf4\x91\x4f\x6e\xbe\xd3\x1e\x3f\x74\x23\x1d\x9a\x43\x3d\xfc\x70\x76\x7c\x7a\x71\x36\x89\x82\xbf\xac\x92\xbb\x71\x9e\x8c\x0b\x25\xc6\x92\x34\x64\xa2\x75\xd0\xbb\xd3\x55\xa2\x45\xec\xab\x9f\x94\x90\x88\xf4\xf0\xc0\x7e\xb6\x9d\xe3\xd9\x47\x18\xbe\xc2\xb1\xfd\xa6\x49\x5a\x21\xa0\x42\x21\x5b\x92\x8f\x58\xc6\xb1\x77\x07\x37\xe4\xbc\x2c\xc2\xd0\x9c\x61\x9e\x09\xe2\x74\xa5\xca\x39\xcc\x0e\x87\xe4\x5d\x7c\x6e\xad\xd9\xd9\xca\x07\x82\x9e\x80\xc9\xea\x4a\xc1\xa3\x82\x9e\x04\xe9\x2a\x05\xdb\x10\xf4\xec\x65\x2e\x62\x17\xfd\x82\xa2\xf8\x3e\x75\xb6\xb3\x12\x8d\x5e\xe0\x61\x2e\x2b\x50\x05\x8c\x25\x01\xf2\x84\x32\x70\xb8\x79\xd9\xc8\x37\x14\x72\x28\xf2\x85\xde\xe2\x43\x28\x65\x98\xa0\xf6\xf3\x82\xc7\x43\xbc\x77\x66\xc1\x9d\xe6\xd0\x35\x0f\xe7\x83\x58\xc2\xd9\x94\x29\x71\x04\xe0\xb2\x58\x9d\x17\xf9\x0a\x8a\x83\xb0\x79\x3e\x6e\x6b\x79\x5a\xbe\x7c\x4c\xc7\x22\x27\x2f\x73\x3c\x3e\x1a\x01\x35\xc5\x79\xf3\x01\xb9\x9e\xe4\xd7\xe1\x31\xbb\x35\x3c\x86\xac\x2c\xd3\x8b\x97\x1f\xbf\xaf\xb6\x92\x59\x13\x06\x0f\x12\xda\x51\xc0\x2a\x74\xe2\xa4\xcb\x3c\x75\x4d\x65\xec\x10\x0b\x09\x72\xa7\x99\x25\xdd\xd8\xb2\x7a\xf3\xa0\x27\x5a\x58\xc8\x38\x68\x4f\x09\x75\x2a\x38\xd1\xcf\xd6\x2d\x27\x83\x87\xc1\xd5\x96\xa8\xac\xca\x5c\xe4\xd6\x73\x24\x02\x30\xa7\x30\x37\xb1\x9e\x99\x38\x19\x74\xe0\x37\x4d\xb2\x88\xd6\xea\x73\x38\x36\xab\x1e\xa7\x41\x45\x17\xc3\x41\x7f\x3c\x79\xdb\x69\x86\x65\xb8\x87\x80\xe8\x4e\x41\x7b\x2a\xdc\x43\x82\x2c\xcd\xdf\xaf\x2c\x21\x70\xeb\x54\xf3\x6a\xe4\xec\x8c\x91\xb3\xc5\xb3\xf4\xb3\x6b\xb6\xa4\x39\xeb\x2e\x63\xfa\x2c\xed\x8c\x48\x02\x28\x43\x99\x61\xfd\xad\x70\x73\x53\x9e\xf1\x08\xaa\x11\x4d\x71\x1e\x96\x0f\xc6\x14\x2d\x03\xd5\xf1\x9f\x53\x11\xcf\x72\xbe\xb8\x99\xbc\x6a\xbb\xaf\xda\xee\x0b\x68\xbb\xdd\xf3\xf5\x30\x1b\x57\x06\xe5\x5d\x90\xba\xb1\xc3\x92\x2e\x20\x2d\x54\x8c\xca\xd2\x4e\x4b\x9c\xdd\xcb\xab\x33\x43\x5a\x2e\x78\xeb\xf6\xf1\xb5\x83\x3c\xb1\x4f\x37\xd4\x56\xfb\xf1\x80\x94\x50\x8e\xc5\x84\x69\x92\x16\x21\xf4\xae\xcb\xa1\x24\x98\xa4\xfb\xfa\x30\x39\x34\x95\xba\x25\xc7\x55\x82\x23\x4a\x02\xc1\xe6\x30\x7d\xc4\x74\x27\x21\x40\xea\x98\xc7\x22\x13\xa6\xd8\x1c\xb4\x48\x48\x80\xc7\xf5\x4d\x2a\x0d\x93\x04\xa9\xd3\x59\xd8\x5c\xe4\x77\x42\xc4\xec\x8d\x0e\xd9\xbd\x79\xfb\xf6\xed\xc4\x55\x58\x48\x88\x6f\xbe\xfd\xdb\xdf\x26\xec\x54\x4f\x50\x49\x32\x68\x96\xe5\x54\x98\xa6\x58\x85\x48\x42\x84\xb2\x77\xbe\x5c\x9a\xfc\x1b\x9d\x13\x05\x75\x34\x46\x63\xc1\x2a\xf6\x48\x5e\xaf\x72\xaa\x4a\x2a\x75\x2f\xfc\x65\x28\x17\x30\x58\x28\x5f\x21\xc3\xc6\x6e\x54\xf4\x8a\x1f\xb3\xc6\xb2\xf0\x47\x67\x41\x8e\x58\x28\x6f\x04\x5b\x9a\xee\xd6\x23\x72\x8a\x39\x80\x65\x42\x81\xb1\x0e\xf9\xfa\x73\xdb\x1b\xa0\xa2\x23\x68\x30\xbd\xbb\xc9\xfa\xe4\x60\x50\xed\xe2\x34\x9b\x85\xe9\x5e\x93\x37\x62\x3d\xd6\x64\x4b\x40\x05\x57\xb2\xcc\x30\x31\x51\x67\xf4\x62\xe3\x83\xba\xe2\xb1\x20\x06\x71\x2a\x06\x67\x1b\x8f\xe1\x28\x52\xdd\x1b\x07\x7d\xa3\x44\xf3\x1c\x07\xea\x18\x43\x91\xdd\xad\x12\x85\xc1\x7a\x74\xfc\x80\x2f\x1c\x6e\x29\xb6\xa4\x22\xe1\x22\x04\x8e\x38\x83\x06\x39\xd5\xde\x02\xa5\x85\x52\x51\xe3\x58\x37\x62\xad\x9e\x7a\xfb\xf2\x1b\x48\xa8\xc0\x27\x94\xbe\x2d\xac\x88\xcd\xaa\x9c\x6f\x02\x86\x30\x2f\x47\x77\xd1\x94\xc5\xe9\x12\xe7\xbb\xcb\xb2\xbd\x9d\x08\x4c\x03\x15\x70\xad\x01\x34\xe2\x92\x60\x91\xb2\x4a\x82\x70\xeb\x7c\x80\x57\x15\x86\x44\x48\x98\xba\x28\x0d\xde\x1f\x86\x5e\x49\xed\xad\x8b\x78\x76\x03\xde\x1a\x94\x27\x13\xdd\x1e\x81\xa6\xa3\xda\x09\xd3\x99\x08\x79\x2e\x6f\x05\x8e\x5d\x58\xbb\x2d\x5f\xc8\x81\x9a\xe1\x64\x32\x34\xd1\x9a\x24\xc3\xce\xde\x9a\xa3\xc2\xe7\x93\x7e\x63\xc7\x35\x86\x71\xc1\x53\xd0\x30\x8c\xd6\x05\x14\x03\x86\x14\xd7\x2b\x23\x81\x32\x2b\xb4\x38\x9e\x21\x8d\xc8\x7c\xad\x29\xaf\x21\xfe\x8d\x2d\xb8\x42\x1e\x91\x27\xf6\x3a\xd2\xd6\xee\xa9\xbb\xda\xdf\x88\xa8\x28\xb5\x54\x97\xc8\x88\x0c\x15\x2c\x50\x9a\xb4\xa6\x08\xec\x74\x54\x52\x3f\x67\x74\x11\x62\x15\x87\x87\xba\xd2\x74\xa9\x6f\x4f\xc9\x4f\xe8\xa6\x5d\xcd\x5a\x71\x74\xce\x92\xb5\x82\x25\x44\x3f\x48\xe6\xa8\x47\x8f\xab\x3c\x1e\xb0\xae\x92\xb4\x59\xf1\xf1\x00\x7d\xa0\x2a\x35\xd4\x1f\x0f\xc8\x2d\x28\x4c\xdd\xd4\xa6\x2e\xca\x53\x25\x0b\xb7\xc0\x13\x4a\x7e\x0f\x78\x6d\x5b\xa4\xd5\x7f\xca\x03\x06\x1f\x38\x4f\x4b\x75\x24\x4f\x74\x3b\x7b\x0f\x44\x94\xe4\x3c\x66\x7c\xae\x92\x10\x26\x8f\xc3\xf2\x34\x1c\x5c\x29\x0f\x48\x47\x84\x69\xbe\xcf\x84\x99\xf2\x6a\x64\x51\x87\x75\x3a\x62\x0d\xb0\x51\xc6\x50\x25\x5c\x47\x06\x4b\x75\x11\x58\x47\xc1\x0d\x29\x0d\x13\x9e\x20\xcb\x4c\xb2\x73\xc1\x3f\x89\x8b\xea\x75\x6c\xe1\x71\xd4\x7e\x21\x02\x24\x7b\x79\x6f\x63\x4f\x9e\x46\x3f\x2f\xa3\x17\x45\x5b\x3d\xd6\xfb\x2c\x6d\x25\xb7\x1d\x12\x5b\xd3\xc1\x09\xa0\x0c\xfa\xa8\x81\x35\xad\x8d\x0c\xab\x24\xe0\x48\xe8\x9e\x43\x13\xe4\x7b\xb3\x50\xf2\x68\xe0\xb1\x59\x27\xb3\x29\xdb\x3f\x29\x5b\xfe\xd8\x94\xed\x69\x9c\x8b\x6c\xc9\x17\xa2\x7d\xff\x2b\xc7\x9d\x67\xeb\x8b\x6c\x5f\x9c\x15\x8f\x83\xd0\x76\x18\x62\xe2\x3e\x17\x59\x4c\x68\x90\x0c\x6b\x0c\x32\x79\x2b\x32\xb6\x7f\x1c\xa6\x2b\x6e\xa7\xb7\x1d\x4c\xfa\x74\xf2\xe9\x6f\xf4\xa6\xc2\x53\xfd\xb8\xcd\xcc\x70\xfb\x12\x9f\xcc\xda\xd6\xa7\xb8\x0b\xd1\xae\x27\xdc\x49\xe5\xfa\x39\x27\xec\x24\x89\x55\xfb\x09\x64\x95\x29\x03\xdd\x21\x32\x4c\xf6\xa8\x86\x04\x66\xe0\xa4\x33\xde\x05\x4e\xe3\x3d\x99\xb8\x06\xab\x1c\x0a\x00\x51\xd6\x42\x33\xb1\x9c\xd2\x09\xdf\x93\x67\xf4\x56\xbd\x79\x76\x4f\x59\x3b\x6b\xd4\x53\x56\xe1\xf2\x25\x3a\x11\x92\x5b\x19\x50\x4d\x01\x38\x16\x9d\xf4\x86\x8d\x85\x25\x4c\xa9\x56\x18\x04\x81\xff\xc9\x95\x4a\x16\x92\x53\xbd\x34\xce\xb5\x32\xae\x0e\x6d\x56\x94\xed\x4f\x3d\x46\x14\xe3\x61\x38\xbb\x99\xe8\xe1\x00\xeb\xde\x09\x20\x4e\x02\x71\x59\xcc\x43\xa9\x56\x65\x02\xa3\x37\x39\xbc\xdf\x00\x06\x17\x99\x57\x2e\x41\x9f\x7c\x48\x4c\x80\x7c\x18\xe7\x55\x22\x56\x12\xd4\x70\x12\x2a\xa8\x1a\x60\x7e\x40\xfb\x17\xb0\xeb\xb9\x2a\x3b\x72\x57\x27\x4b\x5d\x27\x74\x2a\x0a\x85\x0e\xa4\x68\x46\xe5\xee\xc5\x2f\x1e\x3e\xcb\x38\xd0\x08\x1f\xe3\xd4\xc5\x60\x0b\x1e\x86\x76\xb8\xba\xf6\xb9\x92\x50\xa5\x2a\x9d\x5d\xc6\x22\xb4\xad\xec\xcc\x2d\x91\x70\xd9\x7c\x22\xf0\xd5\xc4\xd0\x40\x9b\xef\x8f\x1e\x1a\x8d\x2d\xea\x89\xf4\x30\x86\x9e\x25\xb1\x05\x1c\xe9\x19\x18\x08\x5e\x52\x15\x0d\x17\xca\x93\x0c\x23\x98\x0c\xfa\x77\x43\xbd\x06\xf5\x5f\x83\xfa\x8d\xa0\x7e\xe7\x54\xa8\x19\xfa\xc6\x6a\x53\x69\x31\x14\x5c\x64\x9c\x5c\xeb\x64\x95\x18\xab\x18\x3d\xc8\xaf\xaa\xe7\x4c\xf5\xac\xc3\xdb\xac\x87\xe3\x3c\xcf\xe4\xbc\xc8\x69\x37\x8e\x07\x81\x6e\xed\xc5\xc3\x4b\xcf\x1b\xeb\x49\x0a\xb5\x13\xfa\xa5\xf1\x06\xba\x75\x80\x50\x28\x5d\xc6\xe8\xdc\x6c\x3b\x96\xba\xc9\x81\xd0\x53\x58\xf2\x31\x47\x7e\x91\x10\xcd\x6a\x4a\x45\xd8\xe8\xb5\xe6\xc3\xa1\x62\x41\xb2\x28\xc0\xd9\xe3\x47\x4f\x55\x2a\xa6\xd6\xbe\xd4\xa4\xdf\x5b\x45\xf5\xe5\x8c\xf1\x3d\x07\x3d\xad\x28\x48\xee\xe2\x3b\x9e\x05\xc7\x97\xad\x1b\xc2\xd4\x08\xe8\xb4\x7a\xde\xb5\x10\x2d\x6c\x4b\x48\xc6\x8e\x2f\xa7\x8c\xcf\x93\x22\xb7\x65\xea\x8f\xe7\x7f\xb4\xc6\x7c\xcd\x13\x79\xcd\x13\x79\xcd\x13\xf9\x6a\xf3\x44\x20\xcb\xc4\x98\x6d\x90\x2c\x00\x9a\x27\x99\xeb\x58\xce\x83\x31\xb8\x25\xa5\x45\x47\xf7\xc0\xb5\xc3\x3b\x8d\x1c\x6e\xf4\x0e\x21\x21\x3f\x30\x18\x0d\x37\xa8\x28\xae\x32\x48\x89\xb8\x96\x21\xd3\x0d\x39\x1f\x9e\x8a\x84\x08\xdf\x45\xb4\xf1\x9f\x2e\xb6\x9f\x09\xa8\xae\x57\x44\x16\xe8\xac\xc6\x9a\x36\x69\x12\x1c\x31\xdd\x02\x89\xc7\x71\x92\xeb\xfd\x56\x74\x13\x80\x99\x61\x94\x6a\x84\xae\xb8\x38\xd0\x7f\x51\x29\x87\xa6\xa4\x99\x53\x23\x42\xba\xc1\x5d\x37\x1e\x7e\x2b\xcb\xc6\xe7\xe9\xc6\x11\x20\x92\xdd\x3d\xb5\x58\x89\xc8\xe7\x0c\x2c\x25\x9e\x03\x65\x40\x06\x0c\x5c\x7d\x68\xb7\x94\x8b\x98\xda\x71\xaf\xfa\x01\x5f\x98\x62\xc9\xb2\x2c\x8f\xd3\xb2\x77\xef\xf6\x9b\xbd\xc9\x80\x0c\xe6\xaf\x93\xdb\x9f\xa5\x7d\xb9\x2d\x6c\xbc\xde\x23\xdc\x75\xfa\xd5\xad\x7e\xa0\x2b\x97\xbe\x3b\xd6\xe9\x5b\x66\x3c\x80\xd2\xe6\x09\x7a\x6b\xa8\xe2\x05\x36\x99\xaa\x80\x57\x3f\xe3\xea\x78\xc8\xcf\x7a\x58\x0a\xaf\xc9\x35\xaf\xc9\x35\xaf\xc9\x35\x3b\x9a\x5c\xe3\x28\x16\x56\x16\x91\xf3\x27\x2d\x3f\x72\x53\x74\xdc\x38\x22\x66\xdd\x78\x60\xce\x85\xb5\x05\xd1\xf4\xb3\x29\x37\x98\x6f\xe3\x01\x99\x64\x56\x81\x84\xf7\x64\x43\x48\x81\x19\xda\xbc\x1d\x6a\x0b\xd4\x72\x95\x45\xbe\x1c\x7f\xc7\x44\xbc\x48\x02\x58\x2b\xa4\x31\x2e\x65\xa6\x72\xad\x5a\xfb\xac\xd2\x7a\x9f\xdd\x3d\x8d\xec\x0e\xe8\x64\x1e\x0f\x54\x7d\xaf\xcd\x1b\x0f\x07\xcf\x28\xac\x6c\xd7\xf1\xf3\x2d\x29\xc3\xa5\x0a\x5c\xf5\x33\xf7\x4e\x09\x43\x62\x10\x19\xea\xc2\x16\x11\x9a\x70\x45\x7e\xe2\x05\xd8\x13\x48\x67\xa1\x72\xc5\xf6\x0d\xcc\x64\x91\x16\x23\x84\x9c\x44\x22\x4a\xb2\xb5\x0f\x1f\xb4\xb0\x00\x57\xfb\x1e\xc4\x3c\x18\x10\x01\x31\x3c\xb3\x28\x32\x88\x72\x84\xeb\x17\xd4\xd6\xcb\x93\xa0\x76\xc3\x7b\x8c\x4c\xaa\x6c\x11\x9f\x98\x50\x53\xcf\x62\xcb\x72\xd4\x86\x1a\xa1\x94\x23\x0d\xcf\x76\x7f\x01\x4b\xc4\xb7\x30\xd3\x4d\xd1\xf7\xb9\xe3\x75\x84\x3f\x81\xbc\x95\x2a\xc9\x8e\x3c\x1e\x05\x02\x5f\xff\xec\x71\x89\xe1\xcf\xb8\x93\xc0\xab\x63\x78\xbf\xfc\xa3\xd1\x1d\xe0\xb9\x49\x91\xa7\x84\x09\x7d\xf5\x5f\xa3\x0b\x58\xf6\x6d\xe7\x61\x95\x3c\xc5\xe7\xca\x3b\xfe\x59\x63\xd3\x7d\xb3\x37\xf0\x80\x00\x11\x02\xe9\x4c\x47\xec\xbf\xf7\xff\xf9\xd7\x3f\xc6\x07\x3f\xec\xef\xff\xfa\x66\xfc\x1f\xbf\xfd\x75\xff\x9f\x13\xfd\x97\xff\x7f\xf0\xc3\xc1\x1f\xf6\x3f\xfe\x7a\x70\xb0\xbf\xff\xeb\x7f\x5e\xbc\xbb\xba\x3c\xfb\x4d\x1e\xfc\xf1\x6b\x5c\x44\x37\xe6\xbf\xfe\xd8\xff\x55\x9c\xfd\xd6\x12\xe4\xe0\xe0\x87\xff\xe7\xb5\xdc\xfb\x71\x15\x2d\x1d\xcb\x38\x1f\x27\xd9\xd8\x1c\xf8\x11\x83\x01\x23\x1e\xa0\xf6\x18\x8e\xba\x93\x8c\xa3\x36\x59\xd4\xae\x56\xe9\xf0\xcb\x32\x21\xbd\x5f\xdb\xdb\x82\xf4\x5b\xed\x2e\xe7\x02\x93\xbf\x48\xe7\x8d\x9c\x4a\xcf\x2e\x3c\x67\xf8\xb4\x1b\xdf\xe2\x2c\x17\x51\x9a\x64\x3c\x6b\x9f\xc0\x1c\x60\xd8\x63\xbd\xa1\x77\xa9\xd3\xbc\x94\x98\x95\xdc\x3e\x55\x02\xb3\x36\x6d\xa3\xa6\xbf\xe8\x5d\x09\x64\x36\x1c\xf4\xa7\xa6\x44\x22\x90\x45\x74\x34\xf0\xe5\x17\x9f\xc0\x1a\xb6\x93\xfe\x6c\xda\xa9\x01\x25\x60\x32\x1b\x47\x9c\xf3\xc5\x8d\xc9\xad\x2c\x0f\xc3\x58\x1d\x28\x29\x48\x98\x30\x86\x6b\x0f\xab\x08\x23\xc1\x63\x1b\xb6\xd3\xfa\x31\x24\xac\xc1\xc4\x47\x1f\x60\xf3\x7e\x4e\xd4\x2e\xc6\xcc\x27\x2c\x3f\xd8\x47\x50\x9a\xd6\x9a\x64\xec\x42\x2b\xbb\x1b\xdb\x21\xf4\x49\x38\xde\x0c\x57\xc9\xdf\xc5\x4f\x60\x07\x1c\x0d\x7a\xd4\xb2\xfc\x75\x2b\x6f\x8d\xaa\x4e\xe4\x57\x49\xce\x43\xc6\xb1\xd7\xfb\x92\x85\xc9\x82\x87\x96\xda\xbd\x78\x3d\x66\xca\x48\xc5\x4a\xe6\x65\x73\x66\xae\x88\x5e\x0a\x38\x03\x63\x80\x81\x93\x83\x87\xca\xa4\x7c\xca\x85\x9e\xa9\x0c\xdf\x63\x4c\x28\x2f\x0a\x87\xab\x17\xf1\x7b\x19\x15\x11\x2b\x14\xdc\xed\x24\x46\x3c\xfc\x37\x24\xd8\xf2\x65\xef\xec\x78\x6f\xb8\x8a\x91\x8c\xf5\x17\xd4\xc2\xd9\x24\x5c\x40\x99\x59\x5a\x74\xfc\xdf\xba\x15\x93\xf5\xaa\x29\xe2\x62\x93\xa5\x7d\x53\xbd\xbb\x10\x85\xd0\x49\x83\xa5\x3d\xa7\xa8\x61\x0d\x2d\x4a\x6a\xfc\x0c\x8e\x2c\x96\xa1\xcb\xa4\x48\x88\x5a\x50\xe5\x2b\x87\x00\x8a\x18\x0b\x24\x5c\x2e\x42\xc2\x04\x51\xb5\x99\xe1\x40\xb3\xc2\xf1\x75\x21\x83\x6e\xac\xe6\x8b\xd0\xd8\xb7\xa4\xa7\x93\x55\xa1\xe5\xe2\x68\xe0\xc1\xa3\xce\x4f\xea\xda\xcf\xb9\x9c\x67\x82\x9d\xac\x78\x1c\x8b\xb0\x34\xd4\x06\x24\xba\x6a\x0e\x54\x2e\x07\x25\xd7\xbb\x11\xb6\x06\xdd\x30\x4f\xd9\x06\x6c\x27\x3d\xaa\x38\xff\x37\x7b\x0d\x96\xa3\xa5\x76\xb6\xcb\xa0\x3b\xf6\x97\x04\xf9\xd8\x88\x60\x77\x67\x61\xec\x2f\x09\xf4\xc5\x46\x04\x87\x45\xbc\x8d\xdc\xb4\xf3\x13\x96\xf3\xec\x5a\xe4\x2c\x2c\x62\x16\x17\xd1\x5c\x64\xbb\x9b\x59\xf4\xda\xc4\x71\xd8\x7b\xf2\xb3\x21\x87\x4f\x9f\xde\xab\x6d\xec\x72\x45\x5f\x77\x49\x16\x06\x77\x32\xa0\x6d\x0a\xb8\xaa\x15\xdb\x87\xe5\x1c\x0c\xfb\xcd\xe1\xf2\xd4\xf9\xe9\x7e\x0e\xc6\xee\xee\x64\xb0\xad\xed\x35\x2a\x95\xd9\x5e\x02\x22\x94\x12\x06\xc2\x36\xe3\x93\xd0\xac\x72\x5f\x2f\xeb\x80\x9d\x49\x88\x75\x9b\x45\x92\x10\x75\x30\x31\x9a\xcb\x58\x27\x4f\x81\xe2\x5b\x11\x93\x0e\xcf\x00\x8f\x89\x3c\x06\x2b\x2a\xa8\xbc\x99\x17\x18\xf0\x4c\xf2\x15\x53\x32\x2a\xc2\x9c\xc7\x22\x29\x54\xb8\x9e\x7c\x2d\xa4\x41\xd7\xfb\x42\x71\x6f\x92\x0c\x8f\x06\x1e\xa4\x74\x5e\x3e\x5e\xd7\x03\xaf\x45\x2c\x32\x42\x7d\x00\x12\xa1\x55\x18\x4b\x45\x50\x57\x4e\x42\x46\x90\x08\x0e\xad\x52\xd8\x1a\xb4\xd0\x42\x55\x57\x1e\x8b\x05\x9b\x73\x88\x1d\xa4\x61\x71\x2d\xe3\x3e\xf5\xbe\xde\xea\x87\xfd\x2a\xfb\xc0\xf1\x54\x5a\xfd\xf4\xfe\x45\xbb\x55\x96\xfb\xd5\x0f\x55\x75\x6d\x74\x67\xd9\x81\x48\x45\x4c\x65\xa6\xb1\x7b\x3b\x0d\x85\xf5\x7e\xf2\x98\xad\xf4\xe5\x15\x3c\x39\x32\xf1\xec\x3e\xcf\xa0\x31\x69\x14\x81\xc8\xc1\x37\x22\x2d\x48\x2e\x21\xa6\xeb\xa1\x6b\x3d\x77\x21\xde\xab\x3a\xfb\xa7\xea\xec\x8e\x0e\xd9\x79\xbe\xa2\x72\x23\x31\x91\x81\x10\xbb\xc0\x9b\x9c\xc8\xc7\x8a\xb4\x29\x7a\x8a\xfd\x71\x3b\x35\x3e\x67\xb1\xb6\x22\x57\x20\xd5\x4b\x1b\x1b\xbb\x48\xa1\xdb\xd7\xca\xed\xd7\xca\xed\xad\x54\x6e\xef\x5a\x8d\xe9\x32\x4c\x16\x37\xed\x55\xe5\x1a\x6d\x9e\x9b\x67\x1b\xde\x67\xfc\xf0\x96\xd6\xb1\xa2\x8d\xb7\x19\xc2\x27\x84\x12\x53\x54\xd8\xec\x64\x52\xbb\x5a\xe0\xd3\x59\x02\x3c\xc5\x8c\x71\x9e\x0b\xca\xa1\x67\x45\x4c\x28\xd3\xf2\xb2\x5d\x78\xce\x55\xc7\x81\xc2\x2e\xd3\x80\xe1\xf0\x4a\xe4\x3a\x66\x49\xec\x52\xc3\x15\x8b\x44\xce\x01\x81\x8d\xbf\x47\x43\x28\xae\xa1\x2e\x13\x9a\x35\x64\x4f\xc1\x06\xfd\x75\xed\x9b\x92\x81\x00\xff\x32\x87\x98\x7c\x9a\x89\x05\xb1\x9f\x8e\xd7\xf5\xc5\x37\xf8\xf8\xb1\xc3\x64\x04\x78\xb8\xb1\xcf\x58\xd8\x4a\xa0\x53\xf8\x53\xc4\xf2\x73\xe1\x7a\x72\x00\xb5\xba\x4c\x88\xdd\xef\x96\x90\x79\xc7\xf5\x42\x5c\x42\xad\x90\xca\x45\x9c\x9f\x4a\x75\x73\x34\xf0\xd8\xc3\xe1\xbb\x93\xb3\x3a\x4c\x8d\xa1\xb4\x84\x64\xec\xdd\xc9\x19\xc3\xa7\x1b\x0e\x8c\xcd\xbc\x65\xd8\xfe\x84\x5c\x26\xb4\x8d\x50\x16\xeb\x9a\xe5\x71\xbd\x10\x69\xb9\x65\x30\xa8\x7f\xd8\x23\x43\xda\x76\x10\x0d\xef\x0b\x51\x40\x94\xa1\xc9\x75\x52\xb0\x3b\x1e\xe7\x8e\x4b\xe2\x4a\xa6\x47\xec\x2c\x56\x30\xf8\x9c\x5c\xfd\xdd\x88\x1e\xc1\x2d\x00\xcb\xa0\x1f\x07\xc5\x17\x3c\x06\xac\x13\x7d\x92\x96\xe5\x46\x07\x1f\x8d\xf8\x91\x10\x9d\xf3\x85\xe8\xe0\xcb\x45\xfc\x52\x9e\xe5\xba\xb5\x8c\xff\x75\x02\xf7\x54\x09\x63\xc3\x9f\x5b\xbc\x4c\xd3\x25\x4b\x22\x28\xdd\x0d\x46\xe4\x7d\x76\x52\x5b\x2c\x20\x34\x7e\x40\xcf\x32\xe8\x0f\xce\x25\x20\x21\x9f\x97\x95\x0a\xec\x30\x10\xb7\x87\x2a\xe0\xdf\x8c\xa0\xd9\x0c\x9a\xa2\xf4\x3b\x5a\xed\x21\x57\x90\x81\x3e\x61\x33\x19\xc9\x90\x67\xe1\xda\x1d\x16\xe0\x89\x09\xee\x5e\xbb\x50\x60\x27\x7b\x6f\xf6\xd8\x7e\x92\xc1\x8a\x49\x88\x30\x64\x24\x14\xfc\x16\x18\x9b\xb0\xec\x7b\x6d\x72\x0e\x0f\x3a\xe5\xf9\x3c\x9b\xcc\x79\xfe\x10\x73\x1a\x74\xd2\x9e\x87\x1f\x8d\x36\xe6\x3a\xfe\x2f\x4f\xe9\x99\x34\x38\x87\xe2\xdd\xc9\xd9\x84\x7d\x6c\x0c\xce\xd2\xc7\x09\x92\xdb\xfe\x0b\x12\xea\xb6\xd9\x73\xef\x7c\xaf\xbb\x97\xf4\x31\x7f\x26\xf9\xde\xff\xa9\x3f\xb3\x74\xc3\x92\x60\xad\xcb\x76\xb2\x03\x87\x43\xf3\xaf\xd2\x5d\x12\xe6\x7e\xf5\x66\x56\xc8\xfc\x83\x48\x5b\x73\xb4\x3a\x99\xbc\x33\x0f\xd7\x7d\x12\xd7\x32\x87\x0f\x12\x25\x73\x4a\x36\x2c\x4c\x52\x30\x2c\x7d\x51\x84\x3c\x63\x99\x30\x71\xd0\x09\x3b\x3d\xbb\xfc\x70\x76\x72\x7c\x75\x76\x7a\xc4\xf0\x1b\x5b\xa3\x4a\xd7\xc8\x9d\xb0\x2b\x3d\x16\xc4\x00\x33\x6e\x1d\xa7\x10\xba\x97\x84\x1a\x8f\xea\x1d\x47\x28\x76\x79\x5c\xe5\x17\xeb\xe1\x3f\x3c\x66\xd3\x58\xe6\x65\x05\x61\x6b\x6c\xd0\xa8\xd9\x22\x4c\x62\xac\x26\x83\x6f\xc1\xb8\xee\xb5\xcc\xb5\xac\x8c\xcd\x97\x12\x20\x45\x63\x75\xc0\x0e\x2f\x4d\xb1\x45\xb9\x05\x93\x3e\xad\x9a\xb2\xc8\xc0\x9b\x25\x5d\xe9\xbc\x08\xa7\x74\x04\xe4\x85\x47\x7c\xd4\x9d\xf3\xb3\x61\x5a\x8f\x1e\x91\x34\x24\x45\x59\x98\x35\x60\xc2\x72\x92\x14\xea\x4e\xd5\x62\xc9\xd3\x8f\x30\x63\xbb\x7e\x97\x26\x8c\xfd\x0c\x89\x26\x77\x52\x89\x11\x93\xb4\x8a\xe5\xd2\xde\xa8\x2d\x11\x16\x56\xee\x07\x95\xb9\xd7\x57\x67\xb5\x63\x55\xcc\xdd\x17\xa7\xd6\x4e\xe9\xb7\xbe\x15\xb1\x76\x80\x4d\xfa\x17\x95\x76\xf9\xde\x94\xf9\xa1\xda\x81\x8f\x1f\x7e\xea\x7f\xc1\x86\x7b\x79\x2f\xf7\x24\x89\x20\x83\x7d\xc5\xd5\x0a\xd3\x24\x7c\xc7\x94\x95\x1c\xba\xdf\x77\xa6\x4b\xcc\xea\x50\x7b\x93\x9a\xa6\xdd\xfb\xb2\x35\x0b\xac\x1d\xc1\xf0\x9d\x7d\xbc\x2e\x39\xcb\x8f\x5b\x82\x32\x94\x3e\xe8\x73\xd7\x8e\xb3\xa7\x2b\xea\x06\x1d\x74\xde\xe6\x9c\x58\x54\xa1\x0e\xaf\xed\xaa\xe9\x93\x62\x7d\x44\x89\x88\x03\x33\x7f\xda\xfb\x06\x0c\xcf\x2c\xc4\x7b\x67\xb2\xa0\xc5\x25\xa0\x9a\x88\xa3\xb1\xed\x03\x91\x73\x19\xaa\xea\x0c\x59\x9e\xa4\x49\x98\x5c\xaf\x3b\x1b\x1b\x84\x8d\xff\x8b\xe9\x76\x32\xe6\x63\x38\xf9\x7e\xef\x25\xbd\x7d\x4c\xfd\x14\x6c\xbb\x18\xd8\xfc\x6a\xd7\xbc\xfc\x00\xba\xf7\xca\x17\xb4\x71\x3b\x64\xa0\x35\x77\x1e\x7c\x53\x73\xe8\xb3\x54\xc4\xe0\x8e\x05\xf9\x5d\x75\xac\x26\x21\xa7\x22\x8b\xa4\x02\x01\xa1\x26\x0f\x93\x6d\xdc\x03\x23\xc1\x3e\xff\xe1\xf6\x6d\xe0\x95\x1c\x6d\xd0\x43\x45\x3a\x59\xb4\x81\x14\xa1\x34\xde\xab\xd3\xe5\x8f\xf8\x74\x5d\xb0\xa5\x99\x18\x8b\x7b\xa9\x72\xca\x25\x01\x37\x32\x0c\x26\xaa\x94\x49\x57\xce\xd9\x00\x11\x29\x02\x51\x96\x3b\x87\xeb\x66\x40\x09\x75\x60\x91\x11\xe3\xde\x52\x99\xd4\x5f\x1e\x86\x6b\x68\x0a\x17\xc0\x3d\xc4\x18\x04\xe3\xd7\x7a\x4a\x50\x92\x11\x9b\xa1\xa5\x99\xbc\x95\xa1\xb8\x86\xe5\xad\x64\x7c\xed\xf4\x0f\xe7\x61\x98\xdc\xc1\xe7\x90\x53\xd4\xfe\xaa\x37\xf7\x0d\xae\x9f\xca\xab\xb7\xc6\xd9\xb2\xef\x7f\xbe\x6a\x0d\x19\x0b\x58\xc6\x4a\xaa\xce\x4e\x18\x58\x18\xa9\xc9\xc2\x78\x3c\xd6\x71\x8b\xfd\xff\x01\x3b\x39\x08\x0f\xd8\x27\x81\xeb\x49\xc0\x73\x98\x67\xba\x0b\xdd\xaa\x7d\x80\x06\x3c\xbf\x90\x38\x0c\x4b\x71\x28\x4e\xb3\x41\xa5\xa3\x90\x77\xab\x04\x1a\xc5\x1d\x52\x9a\xc5\x81\xb1\xa9\x21\xea\xb8\xd0\xee\x99\x2b\x56\x65\x10\xf6\x6a\x7b\x6f\x41\x4a\xa3\x5b\xb6\xda\x97\x84\x6e\x2c\xc2\x0e\x94\xf9\x6b\x29\xca\x7e\xce\xd4\x3a\x0a\x65\x7c\x33\x62\x32\xa7\x5b\xc9\xcb\x04\x6e\x83\x46\x04\x10\x7b\x97\x33\xc1\x43\x47\x2b\x20\x41\x76\xa7\xe2\xde\xb5\x87\xbc\x53\x80\xf8\x0a\x42\xae\xc0\xa3\x4a\x56\x8d\x09\xd3\xbe\x8e\xd8\xbd\xbd\x6d\xdd\xff\xe1\xae\x19\x92\x7d\xca\x59\xa9\x7c\x27\xd9\x0d\xa7\x33\x18\x58\xe4\x4a\xd8\x98\x99\xcf\x4e\x29\xd1\xdf\x2f\x34\x75\xe3\x31\xc5\x4f\x6f\x68\xa5\xf4\xf5\xc9\x52\x17\x2b\x9e\x1e\x17\xf9\xea\x54\xaa\x05\x8c\xb7\xf4\x57\xe4\xed\xe8\x46\x4c\x80\x60\xd2\x1e\xa3\xc1\x25\xc0\x32\x76\xf2\xe3\xf1\x25\xe3\x05\x78\x88\x73\xb9\xa0\x0e\x01\xf1\xd1\x75\xab\x9d\x98\x09\xd5\xc9\x2b\xb5\x79\x1f\x10\x75\xe7\x77\xe1\x35\x69\xe7\x35\x69\x87\x98\xb4\xa3\xb9\xd5\x6b\xa2\x8e\x49\xd4\x91\xb1\xcc\x25\xcf\x93\xac\x53\x26\xc1\x49\xa1\xf2\x24\x42\xd6\x31\xb5\x90\xec\x3d\x31\x8e\xa0\x43\x40\xb5\x15\xd5\x6a\x30\x4c\xb0\x48\x1f\x5f\x39\x3b\x96\x84\x5e\x2f\xc4\x1d\xb1\x58\xdc\xe1\x9a\xa5\xc5\x63\x7f\x37\x65\xc0\x24\x5c\xe8\xba\xca\xc3\xef\x8f\xfe\x8e\x8e\x1c\xf0\x4d\x7e\x5f\x36\xf9\xc6\x36\xc8\x5e\x53\xc6\x16\x49\x1c\x8b\x45\xde\xbf\xab\x9f\x31\xf9\x39\xee\x1a\x22\x34\x7b\xf9\x5f\x05\x0f\xcd\x79\x51\xcf\xdf\x6f\xdd\x35\x7a\xf0\x7e\x05\x4b\xbb\x96\x0e\xde\x97\xfe\xe5\x42\x11\xe7\x63\xf3\x18\xa9\x2a\xcf\x78\xac\x80\x38\xea\x5e\xb8\x21\xa6\x8f\x51\x2e\x37\x63\xfb\xf9\x22\x3d\xe8\x7d\x37\xbb\xb4\xea\x30\x2f\x8d\xb4\xf0\x53\xd9\xa2\x63\xb2\xbb\xf9\x53\xfa\xde\xaa\xed\xbc\xf0\xa5\x06\x63\x3f\x49\x95\xd3\x3b\x9c\x01\x91\xf0\x10\xb8\x9d\x30\x0d\x0d\x20\x8b\xe1\x12\x7c\x70\x32\xfd\x17\x0f\x82\xec\x08\xfe\x05\x09\x52\xda\x51\x31\x99\xb6\x30\x12\x9c\x53\xce\xe3\x32\x7b\x71\x3f\x5f\xa7\x12\xc6\xa4\xd2\x94\xdd\xab\x93\x4b\x8d\xaa\xd8\x77\xdf\x9a\x81\x5c\xff\xfe\x6f\xdf\xbe\x39\x98\x7c\x25\x6d\x0d\xb6\x10\x23\xd8\x5a\x88\xa0\xef\x1c\xae\xde\xd5\xf3\xee\x15\xad\xda\xb4\x32\x23\x92\x61\x13\xf1\xce\x79\x08\x69\x20\xd4\x52\xb7\xf0\x37\x53\x5e\x2b\x24\x5f\x2b\x24\xb7\x52\x21\x69\xbb\x17\x19\xb9\x71\x34\xf0\x24\x8b\x0d\x32\x48\x8b\x1f\xfc\x3b\x01\x96\xfd\x99\xf8\x81\xf6\x66\x54\x0e\xb6\x93\xe2\xe7\x59\x3c\x98\xf2\x73\x5b\xb6\x32\x86\x7e\x66\xad\xff\xad\x4b\x35\x83\x9e\xa8\x93\xc2\xac\xea\x4c\xca\x48\x27\x48\x6f\xac\xb2\x04\xa1\xfa\x91\xb3\xd3\xf7\xb3\x7f\xfd\x74\xfc\x8f\xb3\xf6\x79\x5a\x70\xb6\x58\x30\xe8\x8e\xe8\x4c\x82\x49\x7f\x5c\x6c\x38\xe8\x89\x84\x62\xdf\x8c\xa5\xf7\xe7\xb3\x86\xc3\x19\x3e\xb1\xe9\x47\xf4\x30\xe4\x93\x69\x4a\xcf\xd5\xf7\x3b\x5e\xaa\x3e\xbd\xc4\x5b\x08\xbc\x59\xcf\xbc\xb8\xf7\xf7\xb4\xc1\x41\x41\xf9\xb5\xc8\xc8\x14\xdb\x61\xc3\xbe\x86\xdc\x18\xd8\x39\xb3\xf3\x5f\x4c\x56\x4c\xef\xa7\x49\xd7\xbd\xb3\x2e\xcd\xc1\x86\x33\x91\x39\xdd\xc1\x20\xc0\x04\x8c\x92\x11\x0b\xe1\xa7\x97\x0c\x14\x08\xa1\x74\x73\x69\x40\xda\x7c\x2b\x76\x73\xcf\x7b\xd6\x11\x08\x51\xce\x31\xee\x59\x5f\x22\xbf\xaa\xc5\x31\x22\xfc\x24\xe4\xb2\xf5\xd0\x84\x3a\xe1\x5c\x6e\x82\x32\x7f\x9d\xd1\x2a\xcb\x5c\xb9\x67\x6d\x9e\x85\x6e\xd2\xc6\xd9\xc6\x6f\x69\x8d\x6c\x33\xe6\x39\xba\x6b\xf5\xcc\x5b\x97\x1e\x49\x34\x56\x6d\xde\xd8\x92\x5b\xf5\x91\xf9\x64\x01\xfb\xd9\xab\xd0\xd3\xdf\xd0\xad\x20\xf0\xc4\x42\x34\x7b\x02\xd2\xa6\xe5\x6e\x3c\x9a\xcd\x5b\x4e\xc2\xe5\xca\x6a\x80\x58\x97\xe3\x36\x1a\x7c\x0e\x5e\xb2\xf5\x73\x7e\x39\x59\xfd\xa9\x26\x9c\x1f\x74\x3f\x23\xc0\xb2\x47\xbd\x52\xcf\xe3\x65\xa2\xb3\xdd\xf2\xa6\xf4\xc6\x4c\x57\x49\x9e\xc4\x5b\x68\x1a\x72\xb9\x01\xc8\xe1\x8a\x2d\x21\x61\x2c\x83\x41\x82\xaa\xb8\x2c\x09\x43\x91\x39\x0c\x9f\x41\xf5\x65\x99\x82\xd2\x1e\x33\x0e\x4a\xb5\x2c\x89\x6d\xd2\x8a\x57\xe3\xfc\x17\x68\xd7\xd1\x0c\xfc\x57\x01\xfb\xaf\xbe\xf1\xe7\xe6\xe0\x7c\x15\x70\x27\x81\x36\x82\xf3\x7d\xf3\xbd\x34\xe8\xd0\xc6\x68\x7a\x8a\x09\x57\xb6\xfd\x90\xc2\x6b\xc1\xaa\x7b\x41\xc0\x76\x95\x26\x46\xec\xfe\xf1\x3c\xea\x65\x30\x3d\xed\x8d\xc5\x25\x59\x7e\x97\x64\xd8\xf3\xf5\x68\xe0\x71\x1c\x97\x35\x88\xba\xb2\x97\xe2\xff\xa3\x76\x75\x2e\x13\xe9\x9e\xe2\x4e\xad\x41\x77\x9f\x8b\xcd\xe0\x71\x77\xeb\xea\x19\x43\x04\x5c\x56\xe7\x83\x8e\xe7\xae\xc1\xda\x48\x98\x4d\x36\xf8\x08\x6b\x23\x61\x3e\x60\x83\x1b\x59\x1a\x09\x32\x4f\x1e\xcf\x37\xea\xf7\x56\x6f\x41\x95\x7b\xe0\xcb\xa8\xb7\xc8\xf5\x8b\x38\x6e\xf0\xe0\x94\xff\x93\x84\xf8\x58\xe8\xb2\x67\xad\xd0\x72\x84\x0e\xe2\xe2\x17\x04\x40\x27\x74\xb8\x76\x04\x07\x01\x53\x6b\x5f\xc8\xce\x70\x51\xfd\x92\x14\x5d\x50\xd8\xad\xea\x4d\x58\x64\x09\xb8\xda\xdb\x2f\xa9\x76\x0e\x53\xc8\x16\xd0\xc1\x5f\xe8\x91\x2b\x4d\xdb\x5c\x9b\x42\xdd\x3e\xee\x88\xcd\x75\x47\x50\x7a\xb3\x94\xd7\x11\x4f\xd5\x48\x2b\xb1\x41\x72\x17\xdf\xf1\x2c\x60\xc7\x97\xd3\x1e\x59\x3d\xc6\xbd\x2e\x92\xc0\x9f\xdf\xc3\xc3\x6c\x2e\xf3\x72\xce\x63\x12\xdb\x31\xfb\xf4\xfc\x43\x70\x69\xe3\xa2\xdc\x40\x8d\xff\x8c\x3a\x13\x8e\x7b\xf3\xf6\xed\xdb\x09\x3b\xc5\x8a\x15\x50\xb2\xdc\xe8\x4d\x7b\x8f\x17\xde\x9d\x4c\xe8\x49\x1a\x7c\xb9\xd4\x14\x04\x8b\xd6\x66\x3f\xf2\x15\x62\xd1\x18\xfc\x89\xe4\xf5\x4a\x8b\x36\x19\x6b\x5a\x08\x75\xc9\x12\x38\x96\x4d\x94\xd2\xa7\x0d\xbb\xd6\x2b\xcd\x1a\x4b\x11\xcc\xa2\x24\x10\x30\x39\xfe\x46\xb0\xa5\x7a\x97\x25\x45\x4a\x53\xd8\x31\x8f\x1f\xea\xaa\xa0\xdb\x18\x54\x4a\xcd\x05\x2e\x32\xb2\x94\x40\x42\x84\xc6\x9d\x3b\x9b\x8e\x85\x17\xda\xfb\x72\x84\x52\xe5\xe0\xac\x42\x55\x10\x99\x0e\xf1\x28\x3d\x52\x93\x6a\x8b\xb8\x2c\xbf\xd5\xd8\x1a\xd8\x91\x1c\x17\x43\xbc\xa6\x8c\xf1\x30\x89\xaf\x5d\xe2\xac\x14\x2a\x7c\x4d\xd0\xda\x68\xb4\xea\xc3\xbc\xd0\xc5\xa8\x39\xe7\x05\x4f\xa9\x0f\x36\x36\xc9\x6d\x01\xcf\xe7\x49\x91\x93\x2d\xe4\xc6\x7a\x74\xe7\x58\xe0\x89\xb8\xcf\x64\x2c\xff\x3d\xf1\x24\x9a\x8d\xfb\x32\xad\x69\x9e\x23\x26\xf8\x62\x35\xf0\x00\x85\x14\x18\xb1\x1e\x1b\x4e\x9e\x72\x99\x59\xe7\xeb\x29\x6c\x93\xce\xb0\xf1\x84\xad\xe7\x1f\x2d\x44\x00\xa6\xb3\xa1\x09\x4f\x44\x9b\x26\x5d\xde\x90\xaa\x51\x92\x47\x15\x46\xf5\xcb\xc1\x8c\x04\xd3\x85\xdd\xad\x12\x85\xae\x6c\xe3\xd6\xf6\x44\xbc\x11\x6b\x2d\xe0\xa0\x74\x17\xdc\x66\xe8\x23\xd7\xbb\x3c\x19\x90\xe1\x6c\xc2\xbb\x73\xde\xf0\xd2\xc0\xc5\x44\x00\xe7\xa7\x06\x1e\x80\x4f\xef\x68\xf9\x5d\x9e\xd0\x10\xb0\x42\xad\xa9\x88\x9d\x95\xd2\x0b\x48\xab\x1f\x90\xee\x7a\xb5\x3a\xd2\xa3\x0b\x55\x39\x80\x32\xa2\x58\xaf\x7e\xcb\xb7\xc4\xa1\xb3\x30\x81\x36\xc9\xed\x37\x78\x82\xe2\x0d\x2a\xc9\xdd\x6d\xe6\xe4\xbb\x4e\x91\x17\xa9\xde\x3a\x53\x4a\xc2\x8a\x38\x84\x40\xa9\x9e\x73\xec\x89\x19\xf1\xec\x46\xd8\x51\x32\x3c\x9c\x30\xc8\x66\x50\xf4\x49\x66\xd5\xcf\x1c\x94\xed\x90\xc3\x9c\x0f\x7d\xf0\x20\xd1\x9c\x5e\x5e\x9e\xa8\xb0\x79\xd0\xf7\x4b\x6b\x84\xf5\x7e\x60\x9e\x88\x80\xe6\x77\x0d\x3b\xb0\xef\x06\x03\xbf\xe0\x29\xb0\x1d\x1c\x92\x7e\x23\xd6\x03\x0f\x44\xeb\x91\xe0\x66\x6f\x50\x7b\xe6\x48\x6b\xbe\x9c\xa6\x9b\x84\x2b\x39\xa0\xff\xc3\x8d\xad\x82\x1c\x45\xb8\xe5\xc4\xb2\xb0\xe6\x2f\x32\x39\xdf\x5d\xf1\x34\xb1\x1f\xfe\x46\x44\xb3\xee\xc9\xbd\xa9\xc6\xf8\x74\x40\x34\x6b\x6a\x5a\x8b\x39\xd5\x5a\x6a\xfe\x82\x48\x1d\xb1\xa8\x6e\x2e\x76\x42\x44\x53\xb3\x66\x3e\x4e\x97\x03\x6f\x3c\x94\x29\x1b\xdb\xee\x75\x42\x75\x2c\xf8\x52\xc8\x42\xe3\x91\x2e\xc4\x67\x07\x17\x6d\xb0\x49\x3b\xa1\x3e\xb4\x67\xe9\xd5\xa7\xcd\x9f\x8d\xf6\x6d\x27\xc4\x9a\x6d\x6c\xed\xdd\x4e\x88\x8f\xd9\xca\x9d\x40\xf5\x1d\x02\xdb\x79\x38\xf0\x44\xe8\x60\x4c\x6f\xc7\xb8\xee\x9a\x22\xf9\x24\xbf\xba\x5a\x55\x3a\x42\x07\x4c\x86\xca\xc0\xb2\x22\x31\x08\xcb\x7a\x9b\x16\xb8\x5f\xa5\xa0\x99\xb0\x0b\xd4\x5d\xe6\xdd\x96\xc9\xc1\x6c\x55\x49\x58\xe4\xc2\x76\x3b\x21\xd4\x17\x6d\xfa\x75\x14\xaa\xaa\x4d\x8b\x08\x45\xe4\xaf\xb0\x3a\x5a\x51\xf9\xde\x9d\x95\xac\x6a\x4b\x51\xc7\xf1\xd7\xba\xb6\x26\x7c\xa9\xfe\xee\xe6\xcf\xb8\x83\xa2\x46\xca\xe1\xeb\xec\x47\x6f\xfe\xfa\x54\xb7\xf9\x65\xf9\x3f\x71\xf9\xb7\x54\x98\xb4\x6b\xe5\x49\x6d\x8a\x94\x3c\x41\x9d\xd2\xa6\x0e\xa5\x4a\x5b\xb9\x3f\xd6\x4e\xdc\x02\x1d\xcc\x70\x40\x85\x6d\x28\xe2\x2f\xc9\x4b\x1b\x1b\xec\x42\x10\xbd\xe0\x0c\xb1\x2a\xa7\x27\x66\x20\x96\x32\xf6\xf4\x7b\xf8\xc5\x1f\xb7\x70\xcb\x6d\x64\xea\xf8\x72\x7a\x34\xe8\x74\x38\xdb\xf2\xb8\x3a\x2b\xfa\x5a\x7c\xae\x80\x03\xf9\xae\xdc\x06\x10\xbc\x30\x19\x3b\xad\xb6\x06\x33\x5a\x40\x83\x79\x61\x87\xc4\x83\x45\x9d\xfb\xae\xa9\x91\x8f\xed\x12\x54\x07\x13\xde\x04\x2f\x2b\x7d\x0f\x75\x20\x7f\x65\x80\x95\x29\xc2\x5d\xdc\xdc\xdb\x70\x96\xe8\xef\x27\x96\x3e\x3f\x79\x96\xc3\x0f\xe2\x73\x21\x33\x11\x1c\xfd\x2f\x7b\xd7\xff\x14\xb9\xad\xe4\x7f\x9f\xbf\x42\x45\x5e\x15\x70\x61\x86\xdd\xbb\xbd\x54\x1e\xbf\xa4\xd8\x85\x7d\x47\x6d\x48\xf6\x80\x4d\x7e\xc8\xcb\x5d\x69\x6c\x01\x2a\x6c\xcb\xb1\xec\x61\xe7\x55\xfe\xf8\xab\xd6\x17\x7f\x19\x06\xa6\x5b\xb6\x17\xf6\x32\x40\x55\xb2\x30\xf3\x19\x59\x6a\xb5\xba\x5b\xdd\x9f\xee\x81\xc8\xd8\xa5\x48\xe0\x74\x64\xee\x22\xc0\x9d\xdc\xbd\x20\x73\x15\x1f\x31\xa0\xa9\x65\x3c\xcb\x54\x69\xa4\x42\x1f\x4c\x82\xf1\xc0\x33\xe4\x73\x91\xe8\x03\x1b\xb6\x07\xc7\x30\x2c\x65\x7c\xf5\x8b\x17\xad\xd4\xcc\x5e\xee\xdc\x10\x22\xd2\x35\x06\xfa\xe1\xac\x08\x8b\xc3\xec\x09\x59\x5f\xf8\xe8\xe8\x56\xa4\xbc\xb7\xa0\xc0\xcf\x7b\x90\x3a\x4f\x41\x7d\x5f\x40\x0b\xae\x6c\xd2\x0b\xd1\x45\xe7\x45\x91\x42\x91\xd1\x81\x4f\x6a\xe8\x17\xe7\x72\x41\xd8\x9d\xc5\xeb\x9d\xd9\xa4\x17\x4a\x5f\x1b\xad\xf9\xba\xf6\x53\x37\xa8\xa8\x38\xa2\xd1\x9e\x90\x9e\xac\x4c\x24\x8e\x22\x37\x09\xb1\x09\x1e\xb9\x75\x69\x6e\x74\xf0\x39\x3a\x8f\x7f\x2d\xec\xde\x78\x21\xcb\xda\xd7\x7d\x85\xef\x69\x23\x1a\x3d\x50\x7a\xfb\xa3\xdb\x40\xf8\x36\x10\xbe\x0d\x84\x6f\x03\xe1\x7f\xd9\x40\xf8\x40\x26\xba\xb7\x8e\x58\x39\x68\x60\xbd\xdd\xe8\x31\xd8\x37\xf5\xdf\xa5\x6a\x91\x1b\xba\xfc\xd1\x4c\xf5\xdb\x57\x73\xd1\xc4\xd5\x55\xe1\x9d\xc1\x7e\xc3\x84\x3c\x03\x08\x52\xef\xfa\x48\x7d\xa5\x7b\x0f\xb2\x2a\xaf\xa7\xdf\x33\x91\x45\x2a\x16\x31\x9d\xed\x6d\xf5\xeb\x5a\x16\xba\x34\x4e\xbf\x5f\x9d\xe1\xd6\x3c\x75\xeb\xd2\x3f\xbf\xc2\xdd\x27\x98\xb9\xdc\x9d\x3c\xb3\xd9\xe4\xd3\xbf\xdf\x0f\xee\x64\xd7\xee\x71\x0f\xc4\x16\xc3\xb7\x5b\x50\x27\xca\x34\x3e\x86\x87\x5f\xc6\xc7\xf6\xd8\x9a\x25\x32\xa5\x66\x00\xaf\x7e\xc1\x51\x03\x16\xa8\xd0\xa5\x66\x7b\x16\x70\x16\xe5\x55\xdf\xe3\xcc\xe0\xa4\x22\x55\xc5\xf2\xa0\xfe\x00\x00\x1e\x6c\xb4\x0e\x7d\x1f\x9a\x73\xf4\x02\x8d\xaa\x02\xb8\xd4\x92\xe5\x0b\x8b\x0c\xd4\x32\x43\x2d\xea\xdf\x2c\xe4\xf4\xee\x98\x8f\x7d\xc1\x99\x72\x54\x97\x9c\xc0\x25\x77\x6f\x48\x57\x5a\x7f\xe0\xec\x35\x22\x89\xd9\xba\x6f\xa8\x1d\x11\xd9\x82\x2d\x78\xa1\xfb\xac\xec\x60\xea\x0b\x7e\x62\xb9\x90\x5a\xf5\x54\x08\xb0\x81\x97\x3f\xf7\x52\x7f\x8e\x56\x6c\x10\x63\xaa\x8b\x36\xc0\x24\xad\x08\xee\xa5\x73\x65\xf4\x20\xc1\x0a\x55\x95\x79\x55\x3a\x7b\xb4\x37\xa2\xd3\xf4\xbe\xe7\x42\xad\xa5\xfb\x69\xd2\x96\xbb\x65\xbc\xd7\x9d\xd7\x3b\x93\x60\x20\x6f\x16\x94\xa2\xc8\x8e\xd8\xff\xec\xfd\xf3\xdb\x3f\xa7\xfb\x3f\xec\xed\xfd\xf6\x6a\xfa\xf7\xdf\xbf\xdd\xfb\xe7\xcc\xfc\xcf\xbf\xed\xff\xb0\xff\xa7\xff\xc7\xb7\xfb\xfb\x7b\x7b\xbf\x7d\x38\xff\xc7\xd5\xc7\xd3\xdf\xe5\xfe\x9f\xbf\x65\x55\x7a\x67\xff\xf5\xe7\xde\x6f\xe2\xf4\x77\x24\xc8\xfe\xfe\x0f\x7f\xeb\x39\xf0\xcf\xd3\xe6\x2a\x78\x2a\xb3\x72\xaa\x8a\xa9\x15\xb1\x23\x56\x16\x3d\x7d\x6f\xbf\x5c\x47\x43\x8a\xeb\x40\xee\x40\xc7\xa0\xa8\x63\x6b\xbb\xff\x9f\xc2\x57\xfe\xf1\x9e\x37\x7a\xd5\xf7\x59\xbe\xde\x6c\x90\x1e\x1f\x6e\xeb\x2a\x8f\x26\xbd\x76\xca\x50\xf7\xd3\x76\x30\xdb\x72\xa0\x67\x2b\x07\x72\x54\xcd\x3d\xea\x41\xb6\xb5\x40\xdb\x5a\xa0\xaf\xa3\x16\xc8\xca\x7a\x3b\xaa\x6e\x4b\x7a\x02\x51\x1f\x29\x04\x72\x25\x3d\x81\xa0\xeb\x0a\x81\xda\x25\x3d\x81\xb0\x6b\x0a\x81\xea\x92\x9e\x40\xc8\x87\x85\x40\xdb\x92\x9e\x6d\x49\xcf\xb6\xa4\x67\x5b\xd2\xb3\x2d\xe9\xd9\x96\xf4\x6c\x4b\x7a\xb6\x25\x3d\xdb\x92\x9e\x6d\x49\xcf\xb6\xa4\x67\x5b\xd2\xb3\x2d\xe9\xf9\xea\x4a\x7a\x5c\x58\xa8\xa9\xe7\xa9\xcd\xcd\xaf\xb2\x30\x07\xb8\xf8\x65\x24\x8e\xa3\x08\xc8\x0c\xaf\xd4\x9d\x08\xc8\xde\x1e\x29\x00\xfa\x60\x64\xcf\x1c\x0d\xe5\x55\x2c\x81\x2a\x68\x00\x79\x3c\x76\x50\x3e\xdc\x06\xb9\x5f\x59\x1c\x1c\x1b\xf1\x23\xf3\x9a\xae\x84\x75\x9c\xb1\x63\x56\x88\x48\xe6\x32\xfc\x9c\x56\xd7\x0c\x26\x1c\xe6\xde\x88\xb9\xe3\x8d\x5c\x82\xf0\x8b\x24\xd4\x0f\x02\x45\x08\x0d\xe7\x6b\x16\xca\xa2\x71\x88\x02\x21\x9d\x69\xb2\x76\x22\x42\xdd\x00\xf0\xf8\x8c\x66\xbd\x97\x5a\x30\x7d\xab\xaa\x04\x6e\x43\x83\x24\xcf\x6d\xd4\x66\x6d\xae\xda\xa3\xf5\xf7\xa2\xa1\xb0\x36\x0e\xda\x9a\x4e\xf7\xf8\x3c\x97\xae\x41\xc9\x73\xe8\x6f\xf1\x39\x97\x85\xb9\x09\xb9\x14\x91\xca\x62\x3d\xc0\xc6\x39\x5d\xc5\x0c\x82\x64\x7e\xdf\xb9\x14\x1c\x11\xb3\xb8\xb2\x43\x0d\xaf\x1f\x58\xf0\x44\xc6\xb2\x5c\x7a\xd9\x73\x0a\x8c\x71\xab\xc1\x02\x51\xfd\x56\xd6\x8d\xf0\x30\x9e\xe7\x85\x02\x8e\xfb\xd0\xa7\x6f\x56\xc6\xc6\x1f\x1c\xa9\x74\xbf\x38\x44\x9e\x54\x37\x32\xb3\xc1\x07\x33\x3e\xf0\xb8\x20\xcb\x0b\xea\xa8\x42\x41\xd7\x4c\x64\x6b\x07\x05\x82\xfa\xc7\x35\x43\xb5\x51\xda\xb2\x58\x02\xb7\x6f\x70\xc0\xcf\x3e\x64\x6b\x8d\x5c\xd7\x5a\x33\xd6\x40\x4c\xa9\x99\x4a\x62\xdf\x6d\xf0\xfb\x57\xc0\xd2\x1e\x01\x5f\x5b\xb0\x88\x42\xd2\xb3\xe9\x8e\x56\x2a\x96\x00\x0d\x96\x2a\x86\x1f\xe8\xbf\xbf\x61\xb7\xaa\x2a\xf4\xcc\xd3\x39\x07\xa2\x96\x8a\xbd\x36\x48\x26\xf6\x52\x07\xf5\x4a\x96\x08\x1e\x9c\xf9\xfa\xfa\x15\x4b\x65\x56\x95\x22\xd0\x40\x6e\x05\x4a\xbe\x7b\x33\x79\x8e\x10\x49\x78\x70\xa4\xa3\x48\x7d\x66\xb6\x77\xe8\x83\x10\x59\x1d\x59\xf1\x67\x90\xa5\x5c\xcf\x95\xec\x63\x72\xb4\x23\x2c\xce\xd4\xeb\x2f\xa3\x59\xa9\x9e\xe1\x14\x0c\xf7\xff\x03\xbd\xf7\x60\x5f\x20\xf0\x8d\x74\x37\x9f\x3a\x25\x53\xe6\xf2\xcf\x26\x23\x3d\xc8\x1f\x95\x9a\x2f\x4b\xb4\x59\xdf\xd9\x46\xff\x6d\xdf\xdb\x2e\x28\xe7\xf5\x2f\x53\xd2\xb9\xaf\xb2\xa6\xaf\xc0\xc3\xde\x96\x4d\x6b\xcb\xc9\x78\xbe\xce\x0d\x54\xed\x1c\x4d\x02\x55\x8a\x89\x94\xbb\xa8\xa8\x33\x25\xc0\xf8\x11\x9a\x76\x02\x94\xaa\x6e\xbb\x64\xee\xb8\xed\xa8\x26\x23\x6f\xd8\xde\xcd\x0b\x9e\x6a\x38\x40\xc0\x64\xb5\xf4\xb8\x19\xfc\x52\x6d\x23\x27\x63\x47\x2b\x0a\x71\x23\x75\x59\xf4\x99\x61\x0b\xd0\xdd\x6c\xd0\x3f\x8d\x58\x01\xa4\x0a\x96\x56\x49\x29\xf3\x44\xd4\xb3\x5d\x83\x3b\x6b\x93\x26\xb3\xb5\xfb\xca\x78\xeb\x8e\x9d\xdb\x2e\x2d\xb6\xe7\x34\xa4\x2b\x91\x30\xf7\xea\x31\x8a\xac\x34\xf4\xf3\xa6\xe6\x5f\xe4\xbc\xe0\xb5\x28\x44\x2a\x4d\xb9\xde\x27\x01\xdb\xfc\x11\x6e\x6a\x43\xac\x01\x00\x56\x65\xc1\x93\x7a\x89\xc8\xd9\xef\x2e\xd3\x7d\xec\x4d\x5a\x8a\x8c\x67\x65\xb0\x00\x5d\x99\xb7\x33\x75\x9f\x19\x5b\xff\x56\xb0\x1b\xb9\x10\x99\x97\x81\x80\x27\xf6\x09\x34\x6f\x79\x74\x27\xb2\x98\x7d\xd2\x7e\x61\xe2\x65\xc6\xd3\x80\xae\xe0\x79\xa1\x16\x12\x8a\xba\x45\x5c\xcb\x66\x5d\x47\x60\xee\xb3\xa9\x29\x44\x5a\x94\xbe\x67\x8d\x75\xd1\xc6\x5e\xa5\x4a\xf7\xe8\xd6\xfa\x49\x8b\x62\xed\x19\x42\xf5\xcd\xda\x1a\x4e\x8b\x42\x2e\x22\xe1\x1d\x48\x18\xe0\xd8\x93\xb0\x20\xb5\x93\x7a\x30\x0d\x8e\x78\x47\xb6\xb4\x89\xb1\x09\xea\x04\x45\x9a\x0c\xc0\xa5\x60\x02\x47\xdc\xd2\x17\x3a\xae\x08\x17\x48\x08\xdc\x45\xcc\xc6\x9d\x17\xba\xed\xe7\x55\x12\xfa\x0d\xa4\x18\x06\xd9\x56\x2c\xe6\xe8\xb1\x77\xd6\x73\xf7\xe2\xed\x49\xf7\xdc\xba\xe0\xb1\xd2\xec\x6d\xa2\xa2\x3b\x76\x22\x20\xd4\x8d\xc4\x65\xce\xc9\x7a\xda\x5e\x6c\x19\x8c\xf8\x35\x5d\x73\xc3\x25\x3e\xf3\x34\x4f\x84\x9e\xdd\x7d\x6f\xee\xb8\x9c\x2e\x3a\x2c\xe6\xf1\xe1\xc5\xe9\xf1\xc9\xf9\xe9\x2c\x8d\x77\x47\xb4\x49\x7b\x36\x16\xdb\x5d\xed\x8f\xe8\xfc\xcb\x80\x50\x97\x99\xe4\xa5\xaa\xd8\x3d\x9c\x22\x4d\x9f\xc5\x2b\x99\x1f\xb1\xd3\x4c\x57\x85\xa0\xe7\xb1\x78\x5f\xb7\x3d\x44\xa9\x9b\x02\x3e\xa7\xba\x87\xe9\xba\x68\x97\xf2\xc8\x37\x1d\x23\x81\x9a\x06\x65\x07\x1b\x7a\x2f\x92\x10\x1f\x6d\x54\x16\xda\xbd\xd8\xc9\xe6\x37\xc5\x9c\x76\x8d\x60\x2f\x52\x6f\xd5\x3d\x8b\x15\xbb\x87\xac\x6d\xb1\x80\x38\x9b\xe1\xb2\xd7\xee\x8c\x27\x21\xb6\x56\xf4\xba\x50\x29\x8b\x54\x9a\x17\x2a\x95\xae\xd9\xaf\xf0\x6d\x45\x77\xc7\x55\xb7\x8c\xc9\x94\xdf\xf4\xd8\x3c\x70\x4b\x51\x18\x45\x65\x80\x4c\xbe\xb9\x6d\x2e\x4d\x80\x64\x21\x2a\xe5\x9b\x5b\x75\x3f\x2d\xd5\xb4\xd2\x62\x2a\xcb\xd1\xe7\xe9\x4e\x2c\xe1\xf5\xe1\x33\xf5\xc1\x02\xb4\x43\x6a\x60\x79\x50\xb3\x37\x0c\x06\x54\x79\x5e\xbc\x3d\x01\x53\xa8\xf6\xd4\x00\xf8\x50\x94\xd1\x61\x24\xf2\xdb\x43\x37\xdc\xd9\xa4\x67\xfe\xc2\x4b\x5c\x8a\x54\x65\xb2\x54\x85\x0e\x5f\x8b\x63\x16\x41\x6b\xe0\xc8\xdd\xe7\xb0\x77\x22\xbf\xad\x61\x5f\xfa\x9c\x05\x24\x7c\x07\x4d\x73\x48\xe8\x0e\xba\xa5\xaa\x24\x7c\x61\x1a\x75\x02\x38\x4e\x9b\x38\x01\x27\x80\x9a\x1b\x87\x62\x1e\xcf\x9e\x61\x79\x9e\x27\xb8\xb4\x3b\x58\x74\xa9\x06\x7a\xac\x9d\x65\xed\x38\x91\x60\xeb\x30\xd2\xb3\x2d\x09\x35\x1c\x65\x0b\xec\x88\x8c\x1b\xdd\x45\xb9\xf4\x10\x20\x8f\x6d\x4e\x1a\x5e\x95\xb7\x70\x17\x1f\x99\x2b\xe5\x49\x40\xdd\x5f\xe7\x08\x38\xbb\x86\x68\xee\x42\xc6\xd0\x0f\x67\x21\x8a\x42\xc6\xa4\x58\x4b\x7d\xbc\xd5\x8b\x6b\x06\x2c\x93\xe7\xd8\x40\x21\x3e\x40\x68\x46\xe0\x18\xb9\x80\x44\xdb\x74\xc4\x2c\xc0\xa7\xf3\xff\xda\x99\x7c\x64\xe8\xf0\xcc\xbf\x5e\x67\x11\xc1\x11\xef\x1d\x68\x6a\x1d\x46\x80\xd3\xef\x30\xe2\x71\x2a\xb3\xaf\xe4\x38\xa2\xc7\x60\x8c\xed\x8f\x7e\xb5\x37\xb4\x26\x23\xad\xbc\x8e\x78\x22\xce\x7e\x3e\x9a\x04\x2c\xfa\xa5\x7d\x6f\x37\x12\xe3\x7f\xd9\x34\xc1\x27\x46\xf9\x1e\x6d\xd6\xfe\xa1\x56\x0c\x68\xc4\x4c\xc5\xf8\xdc\x84\x67\x88\xa7\xac\x86\x53\x9a\x30\x08\x99\x8f\x6c\x5d\x4f\xf6\x91\x02\x1f\x2b\x7d\xd6\x69\x0a\xd1\x87\x3c\x5a\x67\xa7\x41\x99\x8d\xbb\x4f\x19\xbb\xe1\xa5\xb8\xe7\xe1\x56\xe3\x95\x0f\x0f\xf2\x38\x2e\x20\x84\xee\x0e\x3f\x27\xf0\x04\x58\x06\x2d\xa5\xd9\x3f\xec\x78\x46\x7f\xee\xbc\x50\xa5\xf5\xde\x4e\x54\xca\x65\xd6\x6b\x02\xda\x76\x99\xdf\xe9\x1f\xeb\x0f\x20\x20\x03\x31\x3e\x8c\xc6\xd8\x66\x8e\x0a\xed\x5a\xde\x54\xc0\x16\xe5\x22\x50\xb3\x17\xef\x48\x3c\xb8\xf7\xdd\xb6\xd8\x87\x16\xfb\xfd\x9d\x81\xc6\x17\x68\xae\x68\x5c\x52\x54\xa8\xfd\xef\xa5\xd5\x98\x27\x75\x32\x32\xd3\x22\xd3\x92\x5c\xcd\xd6\x4a\xcb\x87\x0a\x56\x53\x6b\xdb\x70\x08\x58\xe7\x82\xa6\x16\x7f\x54\x90\xee\xe9\xb4\xad\x72\x89\x9f\xd7\x5c\x26\x94\xe5\xda\x7a\x03\x5b\x6f\x60\x20\x6f\x40\xeb\xe4\x34\xe3\xf3\x44\xc4\xc1\x9b\xf8\x7d\xc2\x21\x07\x98\x09\x83\x73\x18\x4b\x0d\xff\x65\x97\x97\x3f\x12\x10\xa1\x9b\x77\x9a\x56\x99\xf3\xfe\x6d\x9e\x86\x3b\x3a\x6b\x4a\x7f\x1b\x2d\x19\x5f\xaf\xd9\x73\xe9\x9c\x48\x00\xd0\x99\x94\xb3\x2c\x86\x47\x11\xba\x5d\x3d\xe5\x91\x09\xa0\x96\xbd\xd0\x53\x45\xf8\x7a\x8a\xb9\x80\x22\xf3\xe8\xee\x63\x93\xf7\x40\xc2\x54\x05\xbc\x3f\x6b\xbd\xbd\x63\xa8\xad\xfe\x6d\x32\xb2\xec\xba\x69\xf9\xd8\x27\x4c\x7a\xd5\xb2\x54\x2e\x2d\x1e\x03\x40\xc6\xb5\x56\x91\x84\xdb\xfb\x09\xb9\xdc\x06\xd4\x5c\x63\x57\xb1\xd8\x18\x56\xe3\x4f\x87\xb1\xc0\x07\xb3\xdf\xdc\xec\x3a\x58\x02\xaa\xe9\xa2\xde\xb2\xd7\x64\xe6\x67\x78\xf4\x29\xb0\xe2\x4e\x65\x3e\x7d\x74\x1a\xea\xfd\xe3\xf2\x39\x26\xf4\x26\x45\xb1\xcf\x5a\xf2\x42\xe6\xfd\x2f\xb8\xdf\x26\x26\x17\x35\x22\xe9\xc5\x4c\xea\x7a\x87\x9b\xb4\xdd\xd9\x4b\x8b\x66\x38\xaf\x0a\xfd\xfa\xda\x36\xc4\xbf\x83\x22\x9e\xf4\xf8\x07\x89\xa6\x6e\x5d\xc4\xba\x1b\xfe\x70\x16\x27\x29\x73\xc1\x29\xef\x5c\xe5\x55\x62\xeb\x5e\xea\x65\x9f\x51\x6d\x22\xb7\xa9\x7d\x64\xec\x1b\x3b\x9e\xdd\xc9\x78\x06\xa4\x3b\x83\x7b\x9d\x8a\x0d\x0d\xce\x03\x2a\x1b\x02\xa4\xc9\xfa\xf6\xbb\xd2\x24\x0d\x40\xd8\xc3\x8d\xcf\x71\xd1\x43\x98\x84\x04\x69\xb3\x04\xd7\x31\xd7\xb4\xbc\x3f\x12\xe2\xab\xef\xde\xbc\x99\xb1\x13\x59\x88\xa8\x54\x26\x15\xd5\x11\x3f\xd5\x57\xdd\x54\xea\x6a\x70\x3b\x6c\xf3\x09\x1f\xe9\x81\xa4\x17\xeb\xfe\xcd\x5a\xb4\x33\x24\xd0\x2e\x45\xcd\x20\x34\x33\x6b\x69\x65\x1e\x52\xc3\x90\x30\x61\xd2\x1e\xa3\x82\x09\xa3\x73\x09\xa5\x6f\x09\xab\x45\x22\xdf\x50\x6f\xe4\x82\x6c\x58\x1d\x09\xa8\xec\x11\x06\xc8\x15\xa7\x2e\x22\x5a\x4b\x4e\x45\x7a\x0e\xa5\x35\x7c\x83\x01\xa9\x63\x4f\xf0\x36\xae\x32\x30\x92\x70\x1d\x84\xd9\xf1\xb3\x47\x79\x17\x49\x90\x35\xf3\xe1\xa0\x6c\x8b\x4f\x72\x2c\xae\xb2\x25\x92\x90\x5b\xcc\x8a\x43\x72\x24\x6e\x60\x46\x6c\x71\x1c\x92\x60\xd7\xf0\x21\xae\x65\x36\x24\x81\xb6\x58\x10\x87\xe3\x33\x1c\x80\xc5\x30\x20\x95\xe5\x69\xc6\xc2\x86\x79\x90\x04\xca\xfa\xf1\x14\x86\xc6\xa8\x02\x39\x09\x3b\x53\x70\x55\x13\x44\xf9\xbd\x48\x1b\x7b\xa0\x55\xdd\x8f\x39\x70\xa3\xa1\x44\x46\x64\x2b\x2c\x81\x83\x31\xfd\xad\xe7\xf7\x5b\xe1\xe8\x0b\x19\x6e\xa3\x2f\x86\x64\xe6\x7b\x94\x8f\xaf\x65\xec\x04\xc0\xb6\xcd\xa3\xc1\x98\xf4\xd6\xf2\xe7\xb5\x0c\x9f\x00\xc8\x01\x4c\xa5\x7e\x06\x53\x1f\xb3\xa9\x51\xab\x03\xe8\x84\x5a\xdf\x3b\x56\xba\x49\x68\x97\xcb\x9a\xc7\x6e\x95\x8b\x6e\x12\x4c\x7a\xbc\x96\x81\x2e\xb0\xa5\xd6\x63\xbc\x73\x0d\x77\x5c\xe0\x38\x5b\xc7\x5a\x6f\xc6\xb8\x60\x05\x4b\x0d\x5e\x84\x72\xc2\x05\xd4\x92\x07\x85\xe0\xc3\x72\x4a\x43\x78\xbe\x36\xb1\x7b\x39\xc3\x8d\x80\xc8\xd6\xf5\xe8\x0f\x20\xf5\xea\x73\x01\xd9\x2b\x4a\xd8\xb9\xd1\x72\x41\x9d\x80\xd2\x05\x57\x3c\x54\x77\xa9\x76\x27\xef\x17\x0e\xeb\x04\x6e\x2b\xb2\xd4\xba\x51\x2a\xb4\x69\xd7\x15\x3c\xfb\xee\x9f\x2f\x57\x12\x8a\xfc\xaf\x89\x41\xe2\x6d\x1e\xd1\x5f\x22\x8f\x68\x7d\xe9\x54\x53\x0e\x45\x02\x5d\x29\x9d\x1a\x77\x7b\x6d\x13\x50\xbe\x86\x04\x14\x2f\x0d\xba\x75\x12\x4c\x02\x7c\x2d\xb8\xae\x55\x73\x88\x20\xf8\xaa\xb5\x46\xdf\x51\xbb\x97\x47\x85\x30\xec\x74\x3c\xd1\x33\xf6\xd0\xc3\xf2\x4e\x12\x09\xd3\x44\xbd\x9a\x58\x15\x74\x03\x4b\xf3\x92\xb6\x09\xb6\xf9\x27\xdb\xfc\x93\x81\xf2\x4f\x06\xb8\xe8\xfd\xa5\x86\xf0\xc1\xe1\xdb\x2a\xe5\xd9\x14\x34\x24\x64\xa2\x10\x70\x59\xe7\xe2\xbc\xd9\xb8\xfe\x92\xce\xe9\x37\x32\xa2\x65\x21\x01\xf2\x19\x56\x65\xf2\x8f\x4a\x34\xb1\xb5\xda\x64\x9c\x4d\x46\x5e\xa1\x66\xaa\xcd\xe7\x0d\x30\xdf\x06\xa7\xab\x39\x09\xa0\x90\x94\xae\x56\x6b\xcb\xfd\xc4\xd4\x73\x6f\x14\x1f\x09\x35\x53\x2d\x3b\xbc\x13\xdf\x86\xfa\x22\x18\x24\xfb\xa8\xe2\x5d\xda\x66\x6d\x10\x3b\xd1\x28\x17\x5c\xe2\x49\xa2\xee\xe9\xcf\xdf\x58\xa8\x66\xc4\x66\x3e\xcc\xa9\x61\xec\x9a\x54\x42\x33\x20\xe2\x95\xc1\xea\xf4\x99\xf4\xa1\x12\x62\x5f\xa2\x00\x3a\x37\x71\x03\x69\xde\x2a\xa3\x29\xcd\x4b\x51\xb6\x96\x1d\x8e\x3a\x9e\x59\xea\x0b\xf8\x7f\x5f\x5d\x45\x82\x2c\x6f\xeb\x20\x1f\x9b\x8b\x5b\xbe\x90\xaa\x2a\x66\xe6\x93\xa0\xcf\xa5\xfb\x13\xd9\xac\x03\xa6\x01\xd8\x6b\x70\x52\x56\xa6\x70\xbc\x5e\x39\x7f\xff\x4a\x82\x6c\xc9\x61\x2d\xf1\x2e\xe4\x17\x2b\x7f\x4f\x32\x15\x9f\xa5\x2e\xfb\xad\x93\x17\x2b\x77\xaf\x3d\xb2\x36\x20\xeb\xea\x85\xce\xc1\x5e\xfc\x85\x44\xd0\xd2\x55\x1b\x6d\x84\xae\xeb\xb7\xb8\x34\x7f\x22\x6a\xb2\x47\x1d\x3f\xc7\x54\x6a\x79\xa4\xd0\xa0\x8e\x5c\x60\x32\x9e\x09\xb4\x75\xfe\xfe\x8a\xce\x9f\x0b\x96\x7c\x54\x89\x8c\x96\x67\x27\xc1\xcb\xef\x54\x05\xb3\x40\xec\x2d\x07\xd6\xac\x73\x9e\xf1\x1b\x7a\x4f\x91\xbd\xcb\x8f\x6f\xcf\xf7\x41\x88\x21\x02\xc0\xce\x4e\xd6\xa4\xa4\xd1\xd6\xdf\x8d\xce\x0e\xee\xa7\xf1\x39\x91\x56\xe6\xb5\x97\x01\x39\xe2\xcc\x66\x5f\x62\x26\xac\xe5\xf4\x91\x78\x47\xd2\x99\x02\x78\xb3\x4b\x65\xf4\x7c\xe8\x9a\xac\x96\x5b\xaa\x79\x91\xc6\x77\xe3\x3e\x36\x3d\x85\xb1\x99\xa6\x71\x0e\x49\xca\x88\xa6\xc6\x78\x9a\x0c\x3c\x06\xfc\x3d\x82\xf3\x64\x4f\x24\xa2\x14\x18\xbd\x38\xb8\x09\x98\xb2\xfc\x96\x6f\xc8\xf7\x43\x3e\x35\xe6\x79\x63\x91\x8b\x2c\x16\x59\xb4\xe1\xa8\x46\xa4\x5d\x20\x27\x02\x33\xaa\x8d\x0c\x43\xa8\xcf\x4a\xf9\xe2\xe9\xce\x23\x9d\x3d\x7e\x0e\xaf\x86\x16\xfa\x6c\x3a\x9d\xf4\xb7\x6c\x12\x15\xf1\xe4\x42\xe4\x4a\x03\x43\xcc\xc6\xd8\x26\x72\xf2\xea\x68\xe2\x86\x4f\x5f\x79\xb6\x5f\x20\xaa\x75\x69\x52\xa7\x9f\x7e\x3a\xaa\xed\x06\x79\x91\xf2\xe6\x9c\xe7\x1f\xc4\x12\x19\x68\xec\x0c\xec\xd2\x34\x6b\xd7\x2e\xdb\xca\xa4\xa2\xbf\xf3\x90\x98\x83\x81\x32\x56\x62\xf2\xcc\x63\x29\x33\xda\x0c\x19\x7b\x6a\xa1\xd7\x35\x24\x08\x88\x08\xfe\xb5\x82\x79\x48\x50\xf6\x5c\x41\xbf\x11\x82\x7d\xf4\x20\x1f\x79\xbd\xa8\x17\xcd\x1b\x2f\x99\x6b\xf9\x67\x84\x34\xc0\x7e\x7d\xa3\xa8\xd7\x0b\xb4\xa3\x1c\x97\x58\x40\x3a\xc9\xed\xfd\xc3\x70\x3a\xc7\xe2\xbd\x44\x85\xd3\xbd\x7a\xaf\xd5\x0f\x12\x92\x19\xa6\xc0\x19\x6b\x92\xfe\x21\xa9\x4d\xc6\x1e\xef\x4e\x2c\x67\x63\x6d\x8c\xad\x22\xfb\x8b\x2b\x32\x97\xf4\x6e\x33\x60\x90\xa0\xec\xaf\xa3\xc5\xd0\x2f\x85\xfe\x0c\xaa\xda\x58\x9c\x85\x5c\x70\xd4\xc7\xa6\xa2\xe4\x47\x13\xe4\xda\x5f\xb9\xaa\x7f\x3f\x9f\xac\xca\x4a\x99\xd4\x9b\xef\x46\x96\xb7\xd5\x7c\x16\xa9\xb4\xb5\x0f\xa7\x5a\xde\x98\x4d\x58\x16\xc0\xaa\x58\x4c\x4b\xa5\x12\x7d\x98\x57\x49\x72\xf8\x1f\x7f\xff\xcf\x27\x3e\x9a\xb1\x1b\x51\x6a\x96\x8a\xe2\x46\xc4\x7d\x9f\x93\xb1\xcf\xd3\xd6\xa0\x4c\x4e\x7f\xb1\x10\xd3\x2a\xbb\xcb\xd4\x7d\x36\xb5\x5b\xf6\x88\x95\xc5\x13\x89\xc0\x9b\x14\x1d\x6a\x61\x70\xe7\x0a\x8f\x63\x69\x77\xe9\x47\xe4\x39\x34\xa4\x54\x14\xc2\x35\x4d\xe9\xe9\x28\x76\xa4\xe7\xc2\x81\x6e\xf6\xbc\x28\x87\xaf\xe1\xc6\x15\xa6\x31\xc5\xa6\x97\xd2\x14\x87\x2b\xa8\xc1\x82\x6e\x9c\xf8\x16\xe6\x07\xb1\x1c\x03\x16\x65\x22\x11\x60\x4d\x50\x1d\x17\x4d\x23\xa0\xe2\xac\x05\x02\x60\x89\xba\x4b\x58\x2b\x8a\x70\x0d\xb1\xd9\x4d\x26\x8c\x66\xc0\x78\x4d\x01\xca\xf5\xe9\xa9\xea\x3e\x93\x7d\xfd\x70\x71\x0d\x9e\xc3\x5d\x83\xb9\x24\x7d\x97\x70\xbd\x71\x27\xa2\x27\x29\xe2\x39\x9f\xcb\x44\x62\xb6\x37\x5d\x0f\x3e\x98\x98\x77\xfe\xe3\x96\x98\xa5\xc6\x6b\x1e\x4a\x50\x8d\xa4\x35\x1f\x79\x0e\x13\xb6\x3a\x2e\x4a\x79\xcd\xa3\x12\xf3\x28\x21\x0f\xe4\x66\xdd\x7d\xca\x19\xca\x64\x22\x0b\x40\xfb\xdb\xb4\x67\xfa\x02\x9f\xb3\x10\x05\xee\x8c\xe8\xf5\x39\x14\x4b\x13\xbe\xa7\xad\xa9\x46\xbf\xc5\x4d\x19\xf2\xf5\x48\x95\x44\x53\x4e\xcd\x17\x18\x90\xd0\x58\x19\xf7\xc4\x61\xdb\x39\x70\x39\x48\x4f\x8e\x5f\xb9\x69\x67\xd7\x4f\x06\x1c\x05\xfa\xa5\x14\xb5\x83\x54\x38\xfd\x54\x0d\x4d\xc9\x34\x32\x8f\x79\x35\x79\xe5\x49\x2a\x85\x88\x4d\x52\x23\x24\x6c\x8a\x00\x12\x94\x06\x5e\x5d\x90\x05\x75\xb3\x8a\xc0\x2a\x87\x30\xb5\x40\x98\x5e\xf4\xb3\x39\x32\xbb\x8d\xb7\x81\xeb\x4c\xaf\x8f\xee\xbd\x9b\x37\x0c\x7a\xe4\x48\x71\x43\xe2\x61\x44\x6c\xfa\xc0\xee\x9b\x0c\xa0\x0a\xa7\x9e\x25\xf0\xa9\x40\xf7\xd4\x3f\xee\xa4\xe7\x42\x8e\xe0\xbc\x5e\x6e\x5d\xd7\xaf\xc9\x75\x85\x04\xcf\x02\xda\xfb\xa0\x3a\x81\x74\x56\xfa\xac\xf5\x56\x9b\xad\xec\xc2\xa5\xad\x0e\xf9\x98\x20\x27\xc4\xb4\x55\x71\x33\xe3\x39\xe4\xe6\xcd\x22\x9e\x8a\x64\x76\x37\xbb\x50\xd0\x3e\xfa\x47\xc5\xa1\x35\x77\xa5\x21\x59\x18\xda\x14\x8b\xc3\x1c\x97\xa3\x97\x17\x2a\x02\x32\x5e\x27\xe3\x1b\xdf\x81\x3c\xfe\x49\xb3\x8b\x3f\x00\x18\x4b\x78\x76\x53\xf1\x1b\x41\x5c\x85\x1f\xdd\xdb\x36\xeb\x52\xd2\xc8\x13\x33\xef\xd4\xb1\x98\x37\x41\xe0\x93\x67\x6d\x69\xf0\x37\x17\x1b\x56\x79\x82\xec\x0d\x66\x12\x5f\x8d\xe0\xe6\x70\x63\x53\x42\xb0\xd5\xae\x32\x83\xd6\x7d\xf6\x8c\x19\x72\x32\x06\x8f\xc4\x38\xd5\xb7\x9c\x02\x32\x75\xdf\xfd\x28\x21\x71\xf2\xda\xeb\xcf\xa5\xab\x17\x70\x37\x03\x8e\x51\x65\x23\xa4\x57\xfe\x6c\x4f\xcc\x6e\x66\x90\xe2\xe8\x7b\xa2\xed\x40\x7d\x0f\xd0\x8d\xed\xec\xbf\xf8\x4d\x13\x10\xd2\x32\xa1\x2c\x3b\x59\x8e\x18\x48\x66\x31\xcc\xa7\x9b\x0f\xfb\xe2\x39\xea\x8e\xc5\x33\x6a\x21\xec\x25\xd2\x24\x20\xad\x30\xcc\x3c\xe9\x52\xe4\x4f\xae\x10\x62\x09\x91\xe3\xc6\x0c\x07\x71\x73\x83\xfa\xb4\x45\xab\x6c\xb0\xe7\xd3\x75\xa4\xa3\x55\x8e\xe8\xc4\x66\x0e\x1d\x81\x6d\xd1\x2c\x14\x07\x98\x84\x1c\x54\x51\x8f\x4b\xd8\xe7\x9e\x1f\x48\x14\xb3\x09\x42\x29\x20\xec\x21\x42\xbc\xb9\xf3\x70\x10\xa2\xf6\x75\x04\x8e\x59\xdd\x0e\x0c\xf4\xa6\x6d\x57\x8c\x53\x1d\x0f\xb8\x65\x5d\x2e\xbd\xbf\x56\x6f\xb3\x22\xed\x1e\x21\xa8\x1e\xc8\xc1\xf6\x42\xe5\xfc\xc6\x84\x5c\x89\x73\xb0\xfa\x76\x16\x8b\x12\x3a\x76\x83\x36\x80\xd6\x84\xe6\xef\x18\xb3\x05\x2c\x9e\xdc\xe1\x88\xd8\x64\x15\x18\x75\x62\xf2\xda\x4b\xd5\x9e\xdc\x2c\x46\xce\xab\xbd\x1c\xbf\xe7\x4b\xc6\x0b\x55\x65\xf1\x8c\xfd\x0a\x05\x48\x30\x9d\x5a\x94\x07\xec\x7c\x65\xf0\x3f\x29\x54\xf9\x81\xd4\xae\xf6\xc8\xdc\x4b\x9a\x1b\x3c\x50\xf4\x73\x51\x72\x38\x2e\x5e\xcf\x5e\xbf\x9a\x7d\xf9\xf3\xb2\xb3\x2c\x66\x64\xe6\x92\x3b\xe5\xa5\x95\x43\xe6\x93\x1e\xfc\x7e\x1b\x74\x8c\xf8\x0a\xeb\xce\x38\xcf\x5d\xd1\x48\xdd\x1b\xde\x9c\x9c\x45\x25\x0e\x4c\xcd\xf6\xd4\xd4\x57\x63\x57\xfa\x5e\x42\x5d\xb6\xa1\xa8\x06\x56\x8c\x56\x8d\xc1\x7e\x50\xeb\x78\x8a\xeb\xa3\xab\xf9\x00\x1a\xc4\x29\x02\x23\xfc\x14\x05\x52\x6f\x8e\x5d\xfd\x84\x32\x69\x4d\x01\x02\x73\x67\x87\xed\x59\xac\x5d\xcd\x0a\xa5\xca\xfd\x41\x05\xc6\x4d\xd8\xe9\xe7\xbc\x20\x4e\xda\xe9\xe7\x9c\x67\xd0\x54\x2c\x7f\x7c\xf6\x26\xd8\x7e\xf2\x1d\xbd\xfd\xd4\xec\x21\x10\xdf\x42\x15\x9d\xd0\x4c\xcb\x54\x26\xbc\x48\x0c\xff\xd9\xa5\x7d\x4e\x36\xaf\x4a\x26\xb2\x85\x2c\x54\x06\xd5\x22\x6c\xc1\x0b\x89\x2c\x8e\x75\x19\x4a\x91\xd0\xec\x6f\x7b\xbf\x1c\x5f\xfc\xef\x4f\xc7\xe7\xa7\xfb\xc6\x47\x14\x7e\x2e\xaa\xba\x47\x6b\xeb\x69\x10\xd8\xad\x21\x75\xf7\xc8\x9a\xe5\xf7\xcf\x02\x6b\x86\x80\x86\xa2\x30\xff\xf4\x30\xd6\xb4\x2a\x2b\x68\x2c\xcf\xc4\xe7\x28\xa9\xb4\x5c\x0c\xa9\x81\x70\x51\xcc\xa9\x3b\xee\x36\x95\x20\x20\xaa\x03\x06\xb4\x29\x1d\xd7\xcc\xf0\x76\x57\xb7\xc8\x0f\x1e\x29\xf6\x02\x6e\x2c\xa9\x5c\x6d\xb2\xca\x4d\x45\x4a\xca\x97\xb0\x13\x6c\x43\x7b\x5b\x9d\x06\xd5\xa7\xb5\xa0\x79\xd6\xc9\x5c\xc5\x43\x99\x64\xfc\x5e\x9f\x26\x5c\x97\x32\x32\xad\xc6\xa1\x40\x88\x7a\x04\xee\x1e\xff\x7a\xf9\x00\xa3\x33\x23\x19\x3b\xfe\x15\x43\x76\x73\x22\xf5\x5d\x9d\x9a\xe2\xf9\xc6\x9b\xe2\x47\x28\xc6\xf5\x25\x8f\xbb\xbb\xe8\xa2\x47\x57\xee\xe8\xcd\x99\x8c\x89\xcf\xb9\x82\x92\x32\xc7\x58\x0a\xb3\x49\x4b\x49\xc4\x24\x23\xae\xf2\x1b\xf1\x7b\x2d\xec\x24\xcd\x61\x92\xe0\xcf\x88\xf6\xce\xf8\x85\xa4\x96\x5d\x76\x97\x70\x95\x6e\xc7\x05\x3e\x9c\x0c\x13\x68\x91\x11\xfd\xcf\x6b\x66\x39\x32\x0f\xfa\xba\xce\xe7\x8f\xd4\x63\x22\x51\x1f\xf4\x3c\x77\xb5\x9b\x6b\xea\x31\x91\x88\x6b\xab\x36\x9f\xe8\x60\x8e\x84\x1d\x4a\xe4\x26\x03\xb6\x3c\xa7\x2f\xe2\x00\xcd\xce\x09\x96\x0f\xfc\xe4\x70\x93\x86\x73\xb7\x1e\xee\x0a\xc8\xdc\xae\x01\xfc\x43\x0f\xb2\x27\xce\xae\x99\x4a\x65\x59\x7a\x22\x66\xcf\x0f\x80\x6e\xaf\xe0\xa1\xe0\x8c\x70\x23\x82\x63\xa7\xdd\xc5\xff\xbd\x2a\xdc\x9f\x90\x98\x87\xb1\x58\x1c\xea\x98\xbf\x3e\x30\x43\xd6\x2e\x11\xb8\xec\xcc\x02\xd7\x6c\xe7\xf5\xce\x6c\x82\x2c\x96\xf5\x16\x5a\x87\x22\xb9\x41\x03\xce\x06\xff\xb1\x48\x48\x68\xaf\xf7\x6a\x87\xed\xa9\xc2\x8c\x32\xe2\x19\x4b\x04\x5f\x08\xdf\xc2\x04\x0e\xbe\x25\x13\x69\x5e\x2e\xf7\x91\xd4\x9f\x74\xba\x4f\x2a\xc5\x27\x8d\x17\xab\x2b\x85\x3e\x21\x7b\x07\x52\x57\x77\x40\x88\x2c\x8b\x15\x9c\x67\x1a\x4d\xdd\xd4\xe6\xbc\x6a\xa6\x69\x85\xc8\x0a\xc0\xed\xc7\x60\x17\xf8\x71\x41\x66\x3b\xc6\xfb\xdb\x79\x46\x65\x47\xd1\x28\x38\xef\xb3\xb1\x22\xcf\x4e\x42\xd6\xf2\x93\xe5\xc4\x39\x3b\xf1\x27\x6c\xd3\x54\x94\xc5\x52\x63\x2b\x89\x6b\x1b\x49\x1a\xd3\x8a\xed\x1d\xa7\xfc\x5f\x2a\x63\xa7\x6f\x3d\x83\xcf\xfe\x57\x32\xed\x48\x45\x8e\xf3\x3a\xdc\x1d\xb3\x5b\x9f\x8d\x2f\x45\x7a\x16\xf0\xc3\xff\x55\x15\x02\xcc\xd3\xa3\x09\x69\xc1\x8f\xfd\xfb\x56\xed\x61\xf8\xbd\x6d\x4a\x70\x82\x5b\x76\x73\x70\x58\x7e\x6b\x67\xf3\xc0\xfe\x9f\x4b\x4f\x08\xd2\xb6\x66\x37\xc2\xd1\xcc\xca\xc8\x18\xd0\x37\xf8\x7e\x28\x5d\x99\xff\x2f\x30\xd0\xde\x59\x0c\xc3\xcc\x7c\xc4\x20\xfe\x77\x60\xf8\xf9\x18\xe8\x23\x6c\xf1\x8c\x79\xc3\xaf\x10\xa9\x9a\x8d\x20\x63\xcc\xec\x40\x3c\xbf\x42\xe7\x29\xaf\x5a\x71\x3f\x58\x06\x48\x8a\x31\x78\x34\x8e\xd5\x79\xa2\xe6\x9e\xef\x61\xac\x27\xfc\x74\x71\x16\xf8\x80\x9f\x2e\xce\xd6\x3e\x9c\x19\x36\x0a\x92\x8d\xf9\x70\xc1\xee\xcf\xaa\xf7\xd3\xd8\x69\xae\xd8\x0e\x05\xc9\x10\x4c\x33\x2b\xde\x0a\x12\xf7\x71\x8e\x99\x91\xfd\x94\x31\x56\x09\x0a\x79\x03\xd6\x68\xf7\xf4\x73\x6e\x5b\x8d\x38\x5e\xc7\xcb\x5b\x0e\x7d\xbd\x58\x5a\x25\xa5\xcc\xd1\xe4\x77\x20\xaa\x66\x63\x6a\x96\x8b\xc2\x8b\x23\xe3\x51\x04\x2b\xce\xd8\x89\xb0\xed\x0c\xe3\x23\x06\x91\x3e\x3a\xee\x7a\x58\x4b\xb2\x12\x1f\xd9\x93\x04\x89\x99\xda\x37\xb5\xf6\xdb\x9e\x61\xd6\x93\x59\xfd\x27\xbe\xe0\x32\x71\xd9\xef\x48\x54\x2d\x20\xc4\xe8\x0c\x35\x93\x9d\xa2\xcd\x54\x8e\xa2\x52\x7b\x18\xbe\xed\x20\xe9\x1a\x2a\x58\x2a\x83\xeb\x53\x24\xb0\xfd\x88\x5d\x69\x06\x24\xc5\x94\xf1\x07\x12\xf2\xa5\x9f\x2e\xce\x06\x37\x7a\x40\x37\x1e\x4d\x48\x0b\x77\xec\xdf\xb7\xd6\xe8\x01\x40\x76\x29\x8a\x85\x8c\xc4\xcb\xb6\x7b\xb6\xb2\xbb\x2a\xbb\xbe\x5c\x3f\xd0\x48\x2a\x5b\x8d\x2a\x7d\x19\x3c\x04\x04\x5d\x80\x1b\x1b\xfb\x30\xf2\xe5\x79\xb8\xd8\xb1\x53\xb1\x30\x26\x13\xe3\xfd\x20\x96\x84\x47\x47\x6b\x32\xa3\x24\x03\x1f\xdc\x9c\x55\x0c\xb5\x93\x47\x75\x8d\x9a\xd5\xc3\xbc\xd8\x3f\xf0\x90\x3a\x25\x12\xf9\xed\x35\x62\xf7\x75\xa6\xef\x9d\xc8\x6f\xdf\xaf\x90\xe8\xc3\xef\xd8\xfb\xcb\x5a\x3f\x6c\x44\x64\x8d\x06\x81\x78\xb6\x7d\x3c\xb8\xae\x81\x8e\x06\x9a\x25\xf2\x5a\xa0\xf2\xef\x68\x3a\x24\x55\x99\xc4\xe5\xa8\x3e\x78\xea\xdd\x0b\xbf\xb2\xec\xdc\xa1\x40\x00\x1c\xf2\x72\x12\xe0\xad\x79\x3a\x8f\xba\xfd\xa5\xae\xed\x74\xf9\xc1\xb4\x42\x03\xb5\xd3\x2f\x5c\xdc\x70\x76\xf7\xbd\x71\xfb\x9d\x83\x7f\x68\x17\xec\xf0\xe2\xf4\xf8\xe4\xfc\x74\x96\xc6\xdf\xdc\xaa\xfb\x69\xa9\xa6\x95\x16\x53\x89\xec\xd7\x80\xb8\x54\x0b\x14\x7e\xec\xcd\x5f\x48\x83\x9d\xc7\xda\x54\x7d\x82\xbb\x23\x6e\x73\xf0\xdc\xa5\xb5\xb9\xb7\xc5\x7a\xb1\x05\x77\xbc\x0a\xdc\x45\xd1\xab\x24\xb1\xeb\x53\x16\x42\x34\x2d\xc0\xa5\x66\x87\x2f\xcd\x26\x6b\x4d\xc3\x86\x23\x6e\x32\x20\x47\xbf\x3f\xe2\x90\x98\xab\x07\xe1\x97\x16\xf7\xb0\x33\x15\x67\x68\x3d\xb5\x22\x97\x35\x8e\xa7\x07\x27\xb4\xf5\x29\x95\xe1\xeb\x00\x11\x32\x4c\xca\x9f\xb4\x28\xba\xb2\x28\xca\xc8\x68\x83\xc3\x4a\x8b\x62\x46\xea\x1b\xf0\x2c\x0b\x80\xde\x0f\xc4\xe6\x0a\x4f\x4f\x3f\x74\x58\x90\xda\x93\xdd\x44\x02\x3f\xfd\xb0\x60\xbc\x02\xe6\xee\xd2\x55\xe7\x78\x23\xe9\xc1\x7a\x20\x41\xa5\xb6\xf7\x20\x5f\x7c\x07\xd0\xce\x48\x7c\xea\xdd\xfa\x25\x68\xc7\xdd\x7a\x91\x0c\x3d\x1b\xcd\xd0\x28\x44\x43\x21\x54\x43\xe4\xbd\x43\x32\xfe\xe0\x07\x94\xc7\xd1\x84\xbe\xc8\xcd\x3e\x83\x9d\xe0\x15\x5c\xc1\x63\x65\x92\x44\x0b\x82\x9c\x75\xd4\x1a\x8f\x53\x99\xbd\x68\x05\x45\x31\xf0\xbd\x85\x37\x19\x70\xd5\x22\x99\xd1\x4b\x6a\x76\xdf\x99\x77\x75\x6d\x76\x8b\xb4\x4a\x6a\xbe\x11\x98\x3d\x49\x7b\xee\xd3\x07\x88\x3b\xfe\xb1\x65\x4e\x97\xfa\x8f\x64\x6a\x47\x3a\xcd\xe3\x66\x9d\x77\x27\xc3\x6a\xbd\xe1\x92\x75\x5e\x6e\xb8\xfa\x2b\x4b\xae\x19\x50\x16\xb6\x66\xf9\x20\x66\xf9\x20\x2b\x40\xb5\xc4\x7b\x5b\x82\xb9\x92\x2e\x99\xc2\xf3\x51\xe2\xcf\xc7\x56\x96\x39\x98\xe2\x39\x2f\x78\x0a\x95\x1e\xe6\x94\x33\x39\x8b\x91\xca\x32\xd3\x81\x57\xb1\x9f\x73\x91\x5d\x96\x3c\xba\x9b\x6d\xed\xb2\xad\x5d\xd6\xcb\x2e\xeb\x93\xd5\xe2\x0e\x74\x19\xd7\x32\xea\x2a\xa9\x97\xad\xac\x2f\x14\x2e\xa8\x78\x67\x26\x7c\x39\xfd\x30\x82\x1d\xe6\x67\x73\x32\xe0\x2a\xd5\x04\xdd\x47\x13\xd2\xf2\x34\x2c\xc4\x5d\x63\xac\xfe\x35\x32\x95\xd1\x95\x6a\xe4\x2a\xaf\xa0\xca\xd6\x56\x90\x22\xd7\x96\xa6\x78\x9c\x79\x1e\x9a\x70\xb2\xa6\xa3\xbc\xef\x59\xa8\x32\xdf\x76\x08\x85\xcb\xec\x85\x3e\x5c\xde\xbb\x31\xd5\x36\x96\x6f\x2a\xdf\xb4\x88\x47\x22\x36\x8d\xe4\xdb\x47\xf5\xab\xef\xde\xbc\x99\xb1\x13\x59\x88\xa8\x54\x85\xa4\xb6\x70\xf2\xc1\x9e\xba\x23\x94\xed\xd2\xee\x0d\x39\xc8\x9b\xb6\x67\xf5\xcc\xb4\xf1\x42\xa2\x6e\xe8\x24\xef\x4a\xe5\x35\x25\x17\x76\x6d\xf7\xf8\xf5\xbd\xe0\x91\x88\x4d\xc7\xf8\x7e\xfd\xdf\xc7\x4f\x02\x25\x84\xc1\x3b\x02\x7d\xd6\xb1\x6f\x0f\x98\xe0\xd1\x2d\x04\xeb\xa6\x26\x13\x82\xe5\x5c\x16\x78\xe5\x0a\x92\x62\xf2\xce\xcc\x11\xb6\x12\x36\x89\x44\xdc\xa8\x0b\x24\xa6\x6f\xe7\x95\x17\x0a\x4e\x5a\x11\x43\xf3\x35\xd5\x4e\xf7\xe5\xe0\xfb\xc1\x46\xc2\x22\xde\x2a\xed\x2e\x0a\x9d\x9b\x0f\x91\x49\x90\x0a\x47\x26\xe2\xbd\x7f\xf3\xfc\x58\x93\xf6\xac\xe9\x7c\xea\x72\x55\x13\x48\xbc\x8c\x61\x26\xf5\xc3\xa7\x40\xa2\xd6\xcf\x5a\x63\x9b\x5e\xea\xda\x4a\x71\x95\xb5\x3e\x03\x89\x68\x46\xe2\x5a\xe5\x3b\x75\x3d\x63\x67\xd7\x8e\xe3\xbc\xd3\xe5\xcf\x54\x2e\x62\x07\xaa\x7d\x0f\x37\xf0\xc6\xbd\x24\xd4\x8b\xdd\xce\xcf\x46\x22\x6a\x51\x56\xb9\x9d\x38\x01\xfd\xfb\x58\x95\x25\x40\x5c\x22\x4d\x5c\x25\xe5\xc5\x1d\x78\xeb\x4e\x17\x63\x57\x09\x4a\xf8\x9a\xf6\xea\x85\x48\x78\x29\x17\x36\xd7\x19\xaa\xa3\xda\x05\xda\x78\x15\xb1\x3b\x9b\xed\x9a\x65\x81\x6a\xd5\x56\x67\x7f\x7c\x3b\x7f\xd2\x0d\x56\x67\xf3\x9e\xf3\x1c\xc4\xdf\xda\x18\xbe\xf1\x04\x6f\x97\x54\x22\x51\xc1\x5f\x5f\x20\xeb\x89\x43\xad\x7d\x12\xc9\xfd\x83\x47\xbd\x72\x3b\xb5\x54\x7e\x1b\x61\x47\x4a\xb6\xc5\xfc\x77\x8a\x36\x11\x68\x86\x02\x01\x92\x41\x44\xca\x1c\xb1\xa0\xe2\x0e\x58\xba\xde\x3e\x20\x21\xbe\x6a\x59\x09\x0f\x9b\x21\x93\x77\xea\x03\xab\xaa\x56\x77\xad\xf2\x7a\x7f\xd8\x93\x30\x11\x86\x81\x3b\xf2\x49\xb0\x18\xf3\x00\x0a\x05\xa9\x2d\xa1\x7b\x1b\x09\xa1\xa6\x42\x98\xc1\x40\xbd\xb1\x5e\x23\xe3\x57\xb7\x2d\x2d\x0a\x48\xfe\xc4\x27\x9c\xc7\x6e\xf0\x8a\xa5\xc6\x59\xf0\x9b\x7c\xc6\xce\x9d\x46\x06\x69\xcf\x18\x9f\x6b\x95\x54\x28\x16\x81\xe6\x1b\x86\xd4\x00\xb5\x54\xbb\xd5\x90\x22\xa1\x37\xa6\x33\x5a\xbd\x86\x6c\xa9\x7b\x40\x75\x7a\x18\xaf\xf9\x83\x95\x13\xde\x5d\xa4\xf5\x25\x70\x6c\x7b\x84\x9b\x5d\x5a\x20\x80\x9a\x50\x81\x8f\x10\x91\xa3\x43\xff\xc7\xde\xd7\x37\xb7\x71\x1b\xff\xff\xcf\x57\x81\x71\x3b\x43\xb2\x11\xe5\xa4\xbf\x4e\x27\xe5\x74\x9a\x51\xf4\xe0\x6a\x62\x3b\xac\x25\x37\x7f\x38\x69\x07\xba\x83\x48\xd4\xc7\xc3\xe5\x70\x27\x99\xbf\xf1\x8b\xff\xce\x02\x8b\x7b\xa0\x28\x69\x81\x3b\x4a\x74\x42\x31\x33\xb1\x69\xdd\xe7\x80\x05\xb0\xd8\xe7\x75\x0f\xee\x9c\x65\x88\x6e\x15\xb2\x3e\x38\x22\x2c\xdd\x22\xe4\xb9\x1f\x9d\x14\x36\x1d\xf4\xd2\x0e\x24\xa0\xaf\x11\x76\x35\xd2\x41\x0d\x41\xfc\xcc\xb8\x3e\x26\x15\x2d\xa7\x03\x2f\x72\x1c\x5f\x9c\xb3\xd1\x71\x95\x90\xef\x82\x10\x4d\x4d\xc1\x6b\x1e\x89\x71\xc3\xc8\xf2\x28\x72\x95\x0d\x51\xe5\xba\x2f\x78\x1a\x27\x2e\xf3\x9f\x89\x4f\x85\xc8\xa1\x30\x21\xbc\x35\xce\xe5\x0d\xe9\xd6\x18\x1d\x25\xd9\x82\xb3\x6b\xc1\x8b\x32\xa7\x98\xfc\x3d\x8d\x33\x66\x1c\x01\x3b\xe9\xc4\x3c\xe8\x74\xb7\x66\xfb\x79\xaf\xe9\x55\xd5\x12\x2c\xad\x74\xd3\x0e\x75\x08\xda\xab\x31\x07\x18\x86\xbf\x52\x25\x15\xd1\xba\x81\x21\xd0\x02\x06\x14\xa9\x1c\x0c\x31\x56\x07\xe5\x10\xcd\x31\x07\x3d\x0e\x9a\xf1\x7a\x99\x64\xa0\xfc\x46\xf1\x58\xfd\xac\xc0\x33\xbd\x85\x6c\x97\x46\x7e\x09\x09\x96\xdd\x71\xe8\x5d\xa3\x92\x69\xea\xe4\xa2\xa8\x6a\x42\x50\xac\x30\x4c\x44\x95\x9a\x65\x5c\xa3\x29\x19\xd6\xa3\xd1\xb3\xb7\xde\x2b\x5e\xea\xaf\x11\x78\xab\x92\x59\xad\x54\xd5\xa6\x13\x54\x99\xe2\xb8\xab\xad\x2c\x58\xaa\x62\x31\x2b\xaf\x12\xa9\x17\x55\xa8\x50\xc0\xf2\xbd\xdd\x00\x03\x87\x8a\xd7\x46\x1c\xbf\x98\xa3\x96\x87\xca\x89\x60\xe0\x7b\xd2\x22\xd5\xd2\x08\x8e\xe0\xae\x01\x91\x97\x1e\x72\x0a\x3a\x20\xd7\xda\xbd\xa3\xb1\x6a\xc6\x83\x05\x46\x7c\x63\x42\x36\x87\x9f\x88\xd9\x98\x39\x96\x5d\x01\x2d\x09\xbe\x7d\x9f\x66\xad\xef\x23\x9e\x24\xc4\x00\x78\xb6\x56\x5c\xcc\x5d\x95\xd6\x80\xe3\x4a\xb2\xd8\x3d\x2c\xaf\x89\x90\xa9\x72\x54\x6d\x34\x53\x32\xc7\xe3\x0e\xc1\x89\x88\xb8\x2c\x9a\x2d\xc1\x7d\x56\x2c\xa0\x50\x69\xea\xa0\x0e\x18\x4f\x12\x07\x5b\xed\x02\xaa\x30\x03\x26\x63\x7b\xdc\x0e\x07\xdb\x31\x2b\xec\x9d\x88\xbf\x13\x27\x62\x87\x10\x86\x0b\xb4\x6f\x58\x46\xe6\x8a\xd4\x81\xd7\x41\xce\xcb\xdc\x87\xf5\xb8\x4b\xdc\x89\x04\x7d\x44\x44\xf8\x06\x12\xd8\x77\x1f\x15\x45\x2e\xaf\xa0\x1c\xf4\x0e\x36\xcf\x68\x51\xff\xdf\x6b\xe3\x35\x82\xa9\xd0\xc8\xb3\x27\x68\x7c\x8a\x88\x63\xa9\x39\x04\x5a\x80\x2a\x0e\x73\xf7\x3e\xa0\xd1\x9f\x55\x82\x1d\xc8\x74\xf8\xe8\x50\xb3\x58\x45\x25\x18\x08\x78\x55\x4d\xa4\x0a\x61\x22\xe2\x1a\xb9\x44\x1f\xf6\x7f\x1a\xe8\xfa\xff\x04\xe7\x33\xe8\xf1\xfd\xb1\xba\x4d\x6f\x79\x1e\x1f\xcd\x08\xc9\xde\xad\xad\x70\x52\x3f\xd9\x74\x18\x3b\x40\x76\x34\x3b\x67\xfc\x4a\x95\xc5\x80\xe8\x96\x54\xe0\xcd\xe3\xc5\xde\x7f\xbc\xf7\x1f\xef\xfd\xc7\xcf\xe2\x3f\x06\xe7\x95\x55\x11\x12\xac\xc1\xde\x3a\xcd\x5e\xbe\x04\xb2\xdd\x38\xdc\x65\xd6\xe0\x40\xf6\x5e\x5a\xcb\x25\x26\x62\xb2\xa6\xda\x02\x67\xc4\x9e\xdc\x7a\xef\xd4\xaa\x0e\x19\xd1\x31\x34\x23\xba\x79\xdf\x86\x54\x6a\x00\xdb\x10\x49\x4c\xd6\x0f\x1f\x4e\x1e\xac\x9b\x64\xfb\x8c\x1a\x0d\x87\x58\xa5\x48\xc5\x53\x66\x4a\x0f\xf0\x34\x55\x85\xa1\xa8\x3e\x60\x09\xbf\x12\x89\xf6\x11\x5a\x19\x9a\x54\xd2\xd8\xfc\x41\x67\x1c\x9a\x1f\xe4\x8d\xc8\x63\xe2\x69\x0b\x27\x2d\x7c\x6a\x89\xdb\xef\xb9\x35\x22\x23\x86\xa3\x92\x8e\x16\x62\xc9\x3d\x58\x4f\xfd\x39\x83\x95\x01\xdf\x37\x1c\x53\x10\x4c\x0b\x91\x1a\x1f\xbd\xc8\x97\x9a\xa9\x6b\x3f\x1a\x37\xee\x40\x73\x73\xbd\xb8\xa1\x57\x6c\x0b\x96\x27\xdd\xe7\xda\x4d\xa5\x13\x69\x67\x2d\x57\x15\xe8\xe6\xfe\x0d\xd3\xdd\x0f\x3a\x94\x2a\x0f\xaa\x65\x7a\x76\xed\x9e\x88\x2c\x74\x61\xb0\xfe\x99\xd4\xa4\xf4\x78\xca\x4b\x46\xdd\xbb\xd1\xf7\x6e\xf4\xbd\x1b\x9d\xe0\x46\x6f\x5c\xa7\x8e\x4b\x23\xf1\xac\x6b\xdd\x03\xd7\x8e\xa4\xe5\xff\x00\x99\x0a\x53\x5a\x50\xbb\xb0\x6a\x82\x17\xaa\x73\xc4\xa3\x17\x1e\x42\x9d\x50\xc0\x31\xef\x18\x82\xcb\x7b\x18\xe2\x9b\xc7\x83\x58\x16\xd7\x93\x6f\x99\x48\x23\x15\xdb\xcd\x0e\x8c\x39\xf7\x1c\x24\x08\x85\x6e\xd6\xed\xb0\x84\xa5\xeb\xbf\x61\x1c\xf6\x5e\xa0\xe6\xc4\xd8\xf9\x0d\x07\x5b\x66\xe5\xae\xf2\xe2\x59\x67\x01\xad\x16\xcb\xaa\x6a\x8e\x96\x30\x1e\x88\x95\x5d\x58\xe4\x28\x9f\x39\x2c\x28\x68\xb1\x84\x43\x09\xc7\x1c\x6e\x1f\xa1\x3d\x04\x67\xf8\x6f\x64\x01\x0e\xa3\xac\x3c\x40\xb0\xc3\xa5\x58\xaa\x7c\x75\x50\x01\xc2\x3f\x7a\x81\x36\x47\x83\x68\xb6\xbe\x7e\x54\xe6\x60\xd9\x4d\x56\x4f\x2c\x09\x56\xf4\xa3\x57\x76\xb9\x6f\x41\x6b\xaf\x30\x9c\xed\x69\x65\x00\xf2\x04\x35\x7c\x11\x2f\x26\x7d\x80\x77\x00\x37\xf9\x57\x4c\xa4\x37\xd0\xcf\x40\xfb\x50\x26\x78\xab\xc3\x7f\xb1\xbc\x91\x5a\xe5\x53\xaf\x87\xc0\x46\xb1\xfa\xd1\xeb\x68\xc0\x7f\x93\x40\xc6\xde\x7e\x3a\x60\x92\xf7\x5a\xa2\x81\x4b\xa9\xb2\xc8\xca\x02\xef\x2a\x4f\xd8\x4a\x7f\x72\x15\xe7\xab\xd3\x59\xa5\x20\xfb\x9d\x49\xf4\xac\xbd\xf8\xe6\x85\xe7\x63\x19\x2f\x20\x94\x60\xca\xfe\x33\xfa\xf9\xab\xcf\x93\xf1\x77\xa3\xd1\x87\xaf\x27\x7f\xfb\xe5\xab\xd1\xcf\x87\xe6\x0f\x7f\x1a\x7f\x37\xfe\xec\xfe\xf2\xd5\x78\x3c\x1a\x7d\xf8\xe1\xcd\xab\xcb\xd9\xe9\x2f\x72\xfc\xf9\x43\x5a\x2e\x3f\xda\xbf\x7d\x1e\x7d\x10\xa7\xbf\x10\x41\xc6\xe3\xef\xfe\xe8\x39\xd0\x4f\x93\xda\x3b\x33\x91\x69\x31\x51\xf9\xc4\x2e\xe9\x94\x41\x61\x62\x2f\x38\x47\xee\x69\x97\xed\xd0\xb8\xfa\x1d\x1e\xad\xaf\x4b\xfb\x47\x1b\x76\x3f\xdc\x65\x75\xc3\x4d\x6f\xbb\xda\x86\xef\xd8\x76\x27\xd6\xcd\x03\xdc\xf8\x8b\x4f\xa4\x77\xd6\xfc\x29\x3e\xd7\xb4\xbc\x73\x06\x8d\x0d\x55\xce\xf3\x15\x8b\xd1\xc0\x4b\x09\xcc\xbb\x5b\xe5\xaa\x51\xe6\xaa\xe9\x42\x25\x39\x46\x31\x52\xc9\x95\x41\xf8\x83\x99\x61\x2c\xf3\xe1\xa0\xdf\xab\x79\x29\x62\x59\x2e\xa7\x03\xff\x73\xfa\x13\xcc\xd7\x75\xaf\x70\x81\x55\x16\x0e\x7d\x0f\x24\x50\xc6\xae\x78\xf4\xd1\x7a\x27\x2a\x72\x5b\x79\x17\x79\x36\x48\xff\x2f\x5e\x78\x85\x9f\x2c\x05\x4f\x2b\x27\x02\x5c\x2b\x10\x0c\x02\x3d\x4c\x1c\xa4\x1d\xa7\x97\xd8\x0f\xc2\x7e\x6a\x0b\xbc\x20\x87\x60\x23\x84\x1b\x83\xf4\xff\xc6\x88\x57\x76\xa1\x89\x90\x4f\xb1\x1d\x02\xd8\x9a\x96\xff\x5f\xbc\x06\x69\x74\x3a\xe8\x59\xfa\x08\x91\x39\x02\x24\x8d\xf6\x46\xbd\x54\x05\x4f\x18\xc7\xca\x75\xd7\x2c\x51\x11\x4f\xaa\x1d\xeb\xf8\xa3\x97\xb7\x5b\x6a\x56\xb1\x0e\xe7\xf7\x86\x1d\x0b\x94\xb3\xc2\x3b\xbd\xb5\x04\x4f\xb4\x72\x3d\xc2\xaf\x12\x61\xde\x60\x45\xf5\x6a\x97\x02\xf4\x92\x7f\x92\xcb\x92\xda\xf9\xa3\xd4\x70\x1a\x55\xda\x46\xaa\x07\x7d\xeb\xda\x80\xd1\x15\xa0\xa5\x4c\x61\x00\x6b\x6e\x34\x38\x5c\x17\x6e\xbf\xd4\xe6\x1e\x22\xa6\x29\x77\xe0\x2c\x23\xba\x5c\x02\x2b\xc1\x11\xa3\x3e\x05\x7d\x34\x93\xa4\xd6\xbb\xa8\x54\x75\x7d\xa0\xee\x70\x92\x54\x26\xd8\x22\x0d\x99\x04\xdd\x19\x56\x2c\xea\xc5\x65\x65\x8a\x21\xb5\x77\x58\xfb\xe6\xa3\x0c\x45\x73\x26\xf3\x52\xc6\xa1\x87\x78\xc7\x65\xca\xce\x92\xa4\xc7\x75\x7f\x1d\x4d\x07\x5e\x3c\xe0\xec\xb8\x7d\xc3\x9f\xc9\xab\x5c\xb0\xe3\x05\x4f\x53\x91\x54\x6a\x02\xf5\x92\xbf\xb7\x49\x56\xbb\x42\x0e\x58\x43\x49\x90\x9b\x7b\x64\xf5\x7c\xd1\xff\x1e\xea\xdf\x3c\x71\xb9\x76\x5b\xad\x82\x88\x48\x6f\xee\x44\x6d\xf4\x08\x9f\x27\x6d\xee\x94\x94\x69\xb7\xf8\x8e\xb3\x63\x56\xf0\x7c\x2e\x0a\x96\x94\x29\x4b\xcb\xe5\x95\xc8\x77\xc5\xdb\xff\x7b\x2b\x07\x34\xdc\x4a\x20\x9e\x5d\xde\x9f\x7e\x7a\xab\xbb\xd1\xb1\xde\x29\xb7\x2a\x4f\xe2\x5b\x19\xdb\x14\x06\xea\xf5\x3f\x82\x21\x8c\x87\xfd\x47\x4e\x78\xcb\xa2\x7e\xd9\x5d\xb7\xb7\x32\xee\x4e\x3a\x2b\x60\x58\xd2\x31\x43\x3b\x2c\x10\x23\xe9\x02\xd4\xc8\x0c\x65\xcc\x4e\xa5\xf1\x8d\x99\xbf\x81\x9a\x13\xa9\xe5\x95\x4c\x4d\x48\x02\x48\x6b\xf5\x82\x13\x71\x41\xda\x83\xd3\xef\x1c\x8e\xa6\x61\x35\x34\x91\x35\x0e\x15\x55\x2c\xa0\xcd\x6c\x99\x14\x3c\x15\xaa\xd4\xc9\xea\xf0\x4b\x5b\x44\x1f\x39\x26\x11\x9f\x6c\xc8\xcd\x74\xe0\xb5\xdc\x67\xd5\x83\x6d\xb9\x66\x2e\x52\x91\xcb\x08\x37\xc0\x80\x6e\xb2\xab\x04\x1b\x93\x4b\x02\x9e\x7a\x11\xbf\xac\x84\x1c\xdb\x84\xd7\x64\x48\x09\x4a\x50\xea\x15\x07\xeb\x6b\x96\x94\x73\x99\xf6\x2d\xc7\x6c\x21\x1f\xca\x2f\x17\xca\x1a\x15\x2a\x3d\xd0\xa7\x46\xc0\xf3\xa7\x1c\x7d\x31\x12\x5b\x43\x6b\x23\x82\x36\x86\x1a\x8b\x4c\xa4\xb1\x86\x10\x87\xc6\x49\xb1\x7b\x61\x2b\x2b\x85\x91\x03\xbb\x1e\x76\xde\xb8\x25\x4e\x3f\x15\x39\x94\xb6\x5f\x2e\x81\x21\xe3\xf8\x89\x83\x90\xd7\xe0\x71\xf2\x92\x20\x9e\x22\xa5\x61\x2f\x86\x55\x62\xd8\x0e\x95\xd8\x6e\x65\x5f\x6d\x29\xdd\xcd\xde\x34\x78\xc0\xb5\x8b\x0c\x6a\xa4\x92\x11\x61\x25\x04\x2d\xad\x8d\xb8\x59\x01\x68\x2d\xa5\x8c\x88\xda\x9e\xfa\xe3\x29\x65\xfe\x89\x64\xf7\xd0\x81\xb6\xb7\xf6\xf9\x65\xfb\xfc\xb2\x8d\xf9\x65\xcf\x9b\x51\x73\x0d\xfd\x4d\x29\x82\x5e\x6b\x7f\x9d\xd9\xa7\xd6\xac\x7d\xf8\x25\xaa\x45\x4e\xac\x7d\x14\x99\x3d\x64\xdd\x43\x2e\xd3\x10\x35\x68\xa6\x74\x37\x42\xe0\x84\xb9\x82\x33\x6f\x5a\x73\xb1\x2b\x01\x4c\x31\x2f\x53\x52\x80\xbc\xa7\xcc\xcc\x0b\xae\x83\xdb\x48\x35\x8f\x2c\xb4\xc6\xd3\xa2\x30\xde\x13\xdb\x96\x65\x29\x0a\x0e\xdf\x92\x70\x19\x9b\xfc\x03\x45\xef\xb4\x85\x07\xe2\xb4\xa3\x0c\x26\x2e\x91\xa5\xd4\x48\xa5\x5a\xc6\xa6\x5a\x00\x07\x0f\x5f\x96\x8b\x88\x9c\x32\xe4\x79\x84\x70\xbc\xef\xdf\x07\x55\x6e\x85\xc7\xd6\x28\x89\xdb\xc8\x38\x14\xa0\x57\x31\x09\x94\xb9\x82\xaf\x12\xa2\x56\xaf\x1b\x5b\x1c\x51\xfb\x9f\xba\xc7\xc9\x9d\x47\x62\x56\xb5\x59\x0e\x68\xe2\x3b\x7c\x75\x7c\xda\x06\x68\x1f\xe7\x57\xc7\xa7\xd4\x2e\xbe\x77\xd4\xdb\xcd\x76\xfb\xb5\xa3\x4d\x00\x46\x9f\xd5\x46\xc3\xfd\xba\x3f\xc8\xd7\xb7\x3b\x8f\x44\xdd\xa6\x1a\x5a\x12\x0e\x7b\x66\x07\xfd\xb9\x02\x70\x2f\x23\x63\xed\xd6\xa3\xff\x52\x66\x53\x76\x9a\x6a\x68\x36\x07\x48\x6b\x56\x71\x22\x30\xec\x53\x90\x56\x1f\x51\x54\x9d\x0a\x4a\x44\xfd\xe2\x4a\xeb\x77\xda\x6f\xc4\x77\x59\x31\x88\xee\xd7\x20\xc2\x82\xf7\xe3\x69\xfd\x1a\x19\xcf\x0b\x59\x90\xb3\x93\xda\x47\x02\x0c\x15\x15\x80\x9b\x74\x2f\x07\xe2\xfe\x5e\xff\x44\x4c\x07\x05\x49\xa5\x38\x22\xb8\x7b\x9b\x9d\x22\xce\xaa\xd8\x57\x22\xe6\xcb\x58\xdc\xbc\xd4\x31\xff\xe6\xc0\x9c\x61\x8d\xe5\xaa\x8a\x16\x15\xb8\x66\x2f\xe8\x29\x50\x17\x72\x29\x13\x9e\x27\xab\x66\x8e\x48\x03\x0d\x64\x03\xf7\x5a\x22\x24\x44\x0c\x7d\xfd\x82\x8d\x54\x6e\x46\x09\x75\x08\x13\xc1\x6f\x80\xb1\x08\xc7\x2c\x57\x36\x96\x87\x6c\x0d\x78\x7a\xae\xfe\x34\x8e\xaf\x2c\x0e\x94\x0b\x87\xef\x8d\xc4\xd2\x32\xa5\xce\x4e\x6a\x93\x32\x39\x1b\xfa\xd5\xf1\xe9\x21\x7b\x8f\xb7\x28\x4a\x36\x76\x4b\xb9\xa6\xe7\xe6\x37\x9e\x24\xb0\x2a\x70\x99\x3c\x39\x4e\x17\x2b\x17\xc9\x2a\x45\xc2\x65\xce\x76\x75\xc7\x2a\x75\xd7\x80\xb6\xdb\xa7\xc4\xcf\x3e\xe6\xa3\xd8\xda\xd3\xd1\xab\x78\x2c\x8b\x77\x22\x23\xdc\xe8\xed\x65\x7f\x65\x1f\x6b\x8b\xc2\x73\x09\x05\x88\x32\xa5\x25\x31\x48\x95\x17\x10\x16\x05\xac\x35\x2a\x13\x9e\xb3\x5c\x58\x6f\xcf\x21\x3b\x39\x9d\xbd\x3b\x3d\x3e\xba\x3c\x3d\x99\x32\xf7\x2e\xd2\x4d\x53\x2b\x5c\x87\xec\xd2\x94\x22\xb6\x90\xb6\xe7\x01\xa6\x68\x80\xd9\xc5\x8e\x97\x80\x08\x33\x3a\xc0\xab\x8b\xa7\x75\xd0\x9a\x29\xfe\xcd\x53\x76\x9e\xca\xa2\xce\xfe\x20\x5e\xb2\x51\xa2\x52\xcc\x31\x00\x7c\xf4\x69\xcd\x65\x61\x6e\x9d\x14\x5f\x07\x4c\xc7\xbd\x6f\xe0\x51\x8e\x7c\x66\xc3\x7d\xab\x09\x1f\xf6\x2d\xb3\x57\xa1\xb1\x01\x2c\xe3\xd2\xf8\x68\xeb\xe8\x5a\xc3\xb1\xd1\x17\xd4\x28\x03\x4b\x02\x66\x9b\x6a\x7a\x9b\x6a\xef\xc3\xc3\xa1\x13\xbc\x13\x97\xf0\x49\x84\xc4\x2b\xbf\x1e\xa0\x4b\xf4\x04\xd2\xb6\xf7\xf8\x21\x63\x3f\x82\x43\xfa\x56\x6a\x41\x35\xaf\xc9\x3b\xa5\xe8\xf1\x85\xe6\x35\x38\x7b\xf7\x2e\x22\x66\x3d\x22\x27\xf3\xe9\xf2\xaa\x39\x01\x2c\x80\x3b\x97\x37\xe4\x2c\x5a\xb3\x2c\xdb\xb9\x6e\xdc\x60\x03\x76\xcf\xbb\x7a\xa6\xef\xdf\xbd\xde\xce\xf0\x2c\xc7\x08\x18\xdc\xb1\x5a\x42\x3c\xe6\x82\xeb\x05\xba\x64\x9b\x89\xe7\x15\x77\xeb\x7f\xd4\x3e\x77\x48\x4d\xfe\x5e\xef\x11\x5b\xec\xd2\xbb\xbb\xf7\xf0\x95\x7b\x70\xcd\xac\x52\x7d\x6d\x58\xe1\x80\x98\x41\x8e\x9a\x35\x21\xfb\x61\xe0\x2b\xb7\xad\x77\x1f\x42\x21\xe1\xe5\xdc\x8d\xd4\xa7\xff\x90\x1f\xbb\x15\x69\x6c\xbb\x8a\x05\xec\xc9\xe1\xa9\x7b\xf8\x6d\xa3\xa7\x86\x43\x34\xcc\x97\x04\x8a\xd1\xa6\xb1\x28\xb8\x4c\x74\x63\x7d\x0a\x95\xa9\x44\xcd\x5b\x7d\x66\x89\x88\x01\xa4\xfd\x83\xcd\x91\x9e\xf0\x09\xac\x6a\xff\x27\xc9\x27\x55\xbc\x4d\x67\x97\x1a\x0e\xe4\xad\xa9\x83\xbc\x1d\x40\x3b\x08\xad\x3b\x45\xa0\xad\xa9\x0b\x15\xd5\xfc\x2e\x6a\x9b\x3b\xef\x3a\x84\x9a\xbb\xae\xae\x21\x98\x89\x7c\x29\x35\xb0\x5d\x72\x49\xd0\xbb\xda\xc6\x73\x2d\xc9\x36\x54\x88\x8a\x97\x0c\x7a\xca\xbd\xf3\xb8\x24\x80\x37\xd3\x8a\xc3\xb4\x77\xcd\x3f\xf1\xb9\xf6\x15\x91\xe5\x62\x22\x3e\x49\x0d\xf1\x0e\xd4\x8e\x06\x2a\x6f\x08\x9e\xcd\x1b\x03\x2d\x7a\x95\x69\xde\xfe\x16\x49\x75\x5d\x33\xba\xa3\x04\x27\xf2\xda\x99\x62\x02\xe1\x78\x92\xac\x20\x58\x8b\xe2\x09\x02\xe1\x01\x2d\xdb\x7c\x6e\x5a\xb6\xa9\x1c\xdd\xbb\x59\x2e\x6f\x64\x22\xe6\x60\x9f\x58\xc8\x74\x4e\xce\x19\x01\x07\x3d\x4f\x12\x75\x2b\xb0\x9c\x8e\xb8\x33\x7b\x60\xe1\xba\xa8\x15\x25\x8a\xc2\x65\xce\xf0\xdb\x1f\x2f\x59\x2a\x00\x78\x21\xf5\x61\x57\xb5\x1b\x86\x44\x4c\xfb\x9c\x4c\x26\x26\xee\x7d\xf4\x3f\xd0\xa7\xe2\x64\xcc\x7e\x12\x38\x12\x05\xb6\x9f\x22\x37\x5d\xcb\x16\xca\x94\x2d\xa1\x15\xa5\x81\xd7\x37\x76\x89\x61\x2c\xb6\x94\x02\xe2\xbc\x04\x2c\x50\x55\xa8\xa2\x49\x0b\x11\xca\xf3\x99\xaa\xe2\x2e\x02\xa8\x77\xed\xac\xd3\x0d\x86\xa6\xb3\x8d\xa7\x84\xca\x41\x31\x2e\x25\xc3\x1b\x91\x33\xbd\x5a\x26\x32\xfd\x78\xc0\x64\xe1\x98\x3e\xec\x44\x0f\xa5\x0c\x1e\x77\x27\x2c\x17\x3c\x71\xf5\x49\xfa\xd9\x69\x5b\xb9\x27\x8b\x40\xb7\xd6\x25\x38\x8d\x80\x03\x54\x6c\x0f\x43\x06\xdd\xc5\x44\xc2\x34\xe1\x02\x2f\x5e\xec\x30\x85\x7c\x6e\xad\xbe\x6f\x22\xa9\xfd\xbb\x3f\x0c\xcf\x2f\xa0\x72\x6d\xf3\x0e\x4a\x99\xfd\x0e\xdd\xc2\x3d\x25\x6e\xf5\x9b\xb9\xe5\x23\xbc\x18\xb2\xd4\x82\x4b\xdf\x8c\x29\x5a\xf0\xec\xa8\x2c\x16\x27\x52\x47\xd0\x96\x25\x44\x84\x74\xad\x48\xd0\xa5\xca\xa4\x5b\x00\x8b\xc8\x8e\xff\x79\x34\x23\xa1\x32\xc6\x4b\xa0\x59\x21\x23\x7a\xb0\x9f\x9f\x5c\x56\xcf\xf8\x42\xe8\x40\x9b\xc2\xe6\xf9\x22\xde\x8e\xcd\x76\xef\xca\xdf\xbb\xf2\x1b\xae\x7c\xc3\x4d\x7e\x6f\xee\x7b\x99\xca\x42\xf2\x42\xe5\x81\x7e\xcc\xe3\x52\x17\x6a\x89\xc7\xfc\xdc\x81\x31\x40\x33\x01\xbf\x2d\x7c\x12\x3c\x5b\xeb\x1b\x0a\x0a\xb2\x59\x9a\xaa\x6f\xd1\x5a\x92\x13\xd5\x92\x9d\x8a\x5b\x1c\xa7\xac\x90\xfe\x8e\x89\x72\x50\xc5\x8b\x27\xff\x98\xfe\xdd\xcb\x9f\x0f\xd6\xa8\x7f\x54\xf6\x76\x2c\x87\x57\x99\x52\xb1\xf7\xfd\x76\x4c\xa8\x8c\xc9\x5f\xd3\x70\x77\x86\xa5\xc3\xbf\x4a\x9e\x58\x63\xef\xdb\x6d\x59\xcf\xdb\x2b\x17\x30\x60\xb7\xb3\xdc\x8a\xc1\x48\xad\x40\x52\x6a\x50\x4d\x68\x57\x03\xc3\x95\x2f\x72\x9e\x6a\x58\xec\x76\x45\xf2\x21\x86\x87\x0c\xd9\xa8\x88\xb2\xf1\x56\x28\x11\x96\x00\x6c\x87\x8d\xab\xf6\xba\x4a\xfc\x3d\xdc\x95\xf8\x07\x73\x6e\x74\xd7\x89\xcd\x0c\x0c\x7b\x2d\x75\x01\x66\x08\x81\xb8\x24\x54\xc3\x2f\x84\x4d\xb4\x04\xff\xe6\x0c\x9c\x6c\x32\xfb\x2f\x8f\xe3\x7c\x0a\x38\x4c\xba\xc2\xd0\xe4\x5a\x8d\x52\xa3\xf9\xc2\x34\xaf\x71\xb1\x43\xa3\x62\x95\x49\x68\xd7\xb3\x62\x97\xc7\x33\x33\x46\xcd\xbe\xfd\xab\x4f\x31\xfc\xff\xf7\xe7\xbf\x7e\x3d\x3e\xfc\xc2\x92\x32\x3b\xd9\x50\x1f\x34\xa1\xba\x7f\x7c\xa6\x88\x8b\xa7\xcc\x0c\x02\xd1\x17\x73\x82\x80\x00\xb8\xff\xf1\xfa\xa1\xa9\x4c\xad\xfb\x3a\x44\x40\xf6\x53\x7f\xdc\x0d\x47\xfd\xdd\x7d\xb6\xca\x17\x9b\xad\xe2\x6a\x0f\x58\x3e\x3c\x1d\x78\x2f\xf6\x06\x6e\x6e\x19\x39\x72\x76\x72\xd8\x25\x95\x91\xd3\x03\x39\x9f\x9d\x91\x6f\xcd\xca\x24\x7f\x7d\xfc\xd8\x4f\xa0\x5a\x08\xe1\xb7\x9a\xeb\x3f\xe8\x71\x6f\xd1\x18\x48\x9b\x71\x58\xde\x0e\x21\x41\x8d\x58\x1b\xa8\x20\xc7\x4e\xde\x5e\xfc\xf7\xf5\xd1\xf7\xa7\x94\x48\x0a\x58\xa5\xd2\x06\x59\x02\x27\x90\xe9\x83\x26\xa6\x7e\x38\xcb\x70\xd0\xe3\x66\x48\xfd\xa3\x13\xde\x9e\x5d\xac\x19\xfc\xe0\x1b\x2c\xde\x56\x1b\xc6\xa9\x26\xbf\xfb\x42\x12\xba\x5a\x69\xd3\x6b\xdd\xb7\xad\xae\x93\x13\xc1\xd9\x37\xc5\xa7\xb6\xad\xe4\xed\xd9\x05\x09\x12\x24\x83\xfc\x46\xe4\xde\x3b\x2b\x88\x30\xbb\xe4\x03\xa7\x53\xc8\xd2\xf6\x99\xbc\xdf\x5b\x5c\x03\x1f\xe9\x31\x0f\x2b\xbe\x31\xbc\x10\x79\xa3\xfa\x06\x9c\x60\x60\x3a\x70\x3d\x9e\xcf\x18\xe8\x39\x42\x93\x6f\xc3\x6b\xb7\x6e\xbb\xbd\x67\x7b\xf7\xb6\x4c\x70\xbe\x7d\x5e\x6e\x75\xb4\xb5\xbd\xb2\x8e\x13\x2e\x09\xe5\x60\xdb\x8b\x3b\xdb\x04\x62\xff\x78\x61\x1c\x34\x0d\x8e\xfe\x28\x34\x6b\xb6\xd6\x85\xc3\xc6\xd9\x46\xfc\x2a\xee\x92\x66\x95\xab\xfa\x1f\x05\xef\x96\x9a\x54\x13\xb7\x71\xea\xaf\xec\x37\x11\x50\xaf\xf7\x8b\xc1\xa0\x86\x26\x69\x1c\xbb\x87\xd7\x2b\xdf\xdc\x43\xd6\x81\x5f\x93\x1f\xbe\x14\xcd\xd6\x52\xda\x49\x28\x18\x5f\x5d\xd0\xbb\xd7\xb9\x3a\xaa\xcf\xbf\x3a\x4f\x7b\x3b\xfd\xb4\xd9\x9e\xd0\xb0\x14\x90\x30\xd9\x66\x7b\xc2\xf6\x6c\x09\x3e\xec\xad\xda\xc1\xbd\xb2\xae\x85\x2a\x54\xda\x29\xd5\x78\xb6\x01\xa2\x25\x7d\xe2\x6f\x40\xb6\x41\xae\x92\x84\x66\x50\xac\xe0\x18\x64\xb0\xd4\x2e\x67\x10\xe7\x9d\xd8\xa0\x52\xe7\x7c\xa6\x9c\x8f\xa6\x73\xba\x67\xe6\x12\xec\x41\x5c\x77\x20\x7e\x81\x45\xa6\xb6\xe3\xea\xdb\x06\x7f\xc9\xe2\xa0\x82\x03\xe7\x27\x18\xf8\xe0\xca\x05\x68\xdc\xd0\xcc\x6b\x47\x6f\xd8\xd5\xfd\x4f\xd2\x4b\x5c\x8a\xcf\x4f\x7a\x65\x25\x2a\x2f\x6e\x55\x8e\x95\xc2\xa6\x03\x2f\x22\xcf\x5a\x0f\xb7\xd9\x47\x86\xff\x36\xa0\xde\x7f\x8f\x72\x8b\xdd\xe4\x05\x17\xf0\x60\x73\xea\xe1\x1e\x7f\xc7\x47\x1c\x1b\x79\x88\x41\x10\x71\x1f\x8c\x1b\xb8\xcb\x20\x68\x27\x98\x35\x22\x05\x9e\x8f\x31\x74\x10\x3c\x1e\x29\x92\xe6\x9b\x4b\xfa\x90\x73\xe4\x5e\xb7\xc7\x16\xe4\x12\x77\x92\x82\x18\xe6\xbf\xf1\x51\x34\xbb\x25\xab\x26\xeb\xe4\xd5\x51\x27\x01\xbb\x81\xf4\xbf\xec\x3e\xac\xd2\x11\xa3\x57\x76\x99\x2b\x30\x1c\x52\x06\xd0\xa2\xee\x39\xf8\x03\x8d\xeb\x08\xaa\x9d\x49\x5b\x00\xcd\x05\xf1\x69\x57\xfe\x8c\xe2\x87\x88\x54\x7a\x2d\xe7\x4b\x9e\x69\xdb\x59\xb1\xd9\x60\xb9\x67\xa6\xd8\xad\xbb\xf9\x9b\xfb\x5b\x9a\x5b\xb6\x46\x82\x64\xb4\x96\xe6\x75\xff\x4d\x22\xea\xdd\x06\xe6\xf7\xb7\x26\xa7\x0f\xf4\x6e\x03\xf3\xfb\x5b\x93\x13\x51\xef\x6d\xd0\xb9\xa9\xe9\x26\x11\xf3\x9e\xd6\x9c\xf7\xb4\xdb\x24\x82\x42\x49\xa7\x1d\x09\x6c\xc0\x63\x15\xb0\x69\x5d\xdb\x72\x14\x49\xf0\xb8\x93\x2b\x84\x7a\xb9\xfd\x5b\x2f\x9e\x55\x6f\xb2\x72\x2b\xd6\x70\xc4\x01\x90\xf7\x20\x34\x55\x51\xe9\xbc\xd9\xdb\xb5\xd6\x29\x70\x52\x20\x48\x50\x0f\x9f\x1f\xc3\x68\x72\xa8\x37\x3c\xa3\x3f\xb2\x46\x8c\x66\x3b\x75\x7e\xa5\xca\xc2\x85\x64\x59\x5c\x0f\x58\x5b\x01\x0e\xee\x79\xa4\xa4\xc7\xb3\x21\x73\xf7\xde\x04\x1b\xe7\x7f\xde\x92\x9e\x0e\x98\xe0\xd1\x82\x7d\x14\xab\x89\xe1\x7a\x9e\xc8\x8c\x65\x5c\xe6\xce\x6c\x75\xc2\x0b\x6c\xcf\xbe\xe6\xdd\x8f\x44\xec\x0d\x7c\xec\x56\xa4\x0a\xa3\xab\xf6\x6b\x55\x20\xc0\x1b\x14\x77\x29\x07\xa9\x03\xee\x09\xc8\xa8\xd1\x68\xbc\x93\x3a\x08\xf2\xa3\x58\x19\x56\x07\xc9\x46\x60\x9c\x40\x7b\xa0\xa1\xa6\x09\x74\xac\x69\xed\x8d\x0d\x24\x04\xae\x21\x62\x58\x21\x7d\x97\x12\xde\x88\x55\x69\x85\x6a\x54\xe6\x5a\xc2\xfb\xbe\x4c\xed\xdb\xbc\x61\xeb\xd1\x61\x03\x5f\xd4\x58\x0c\x01\x38\x0c\xde\x7f\xa4\xad\x58\x4f\xd3\x5a\x08\xfa\x0c\xa9\xc2\x61\xfb\x23\x5a\xe3\x6a\xb5\xb1\x5a\x45\x0b\xb4\x28\xca\xcc\x1b\xd2\x2c\x88\xa9\xcc\xc5\xca\x34\x11\x5a\x43\x62\x10\x5c\xcc\x3c\xff\x28\x5c\x01\x68\x9e\x1c\x0e\xbc\x50\x19\x63\xe0\x7d\xd4\x55\x35\xff\xaa\xc9\x30\x6c\x34\xe0\xde\xa9\xf2\x9f\x3e\x66\xc3\x99\x49\x43\x9b\x61\xb3\xf0\xed\xba\x13\xde\x98\x80\xe3\x3b\xb9\x20\x16\xb6\xc6\xc4\xde\xf0\x0c\x8e\x30\x36\x8c\x83\x23\x18\xd0\xcc\x12\x8c\x64\x86\x06\x28\xa3\x71\xdc\x0b\xfe\xab\x15\xca\xcd\xab\xc3\x13\xf2\xd8\x1a\x49\x20\xa6\xc6\x12\xc2\xb1\x07\xff\x79\x78\xab\x4c\xdd\x9b\xf0\xdf\x33\x9b\x46\xb9\x6c\x5f\x59\x71\xfd\xa7\xd6\x10\xee\x36\xe6\x0f\x84\xec\xae\x22\xac\xff\xf4\xd6\xd8\x7f\xa3\x86\xb5\xa1\xc5\x7f\x20\xe8\xbd\x1a\x87\x0d\x8b\x0d\x44\x7d\x48\x03\x09\x1d\x68\xa5\xb7\xdc\xd1\x48\x02\x11\xfb\xd0\x63\xd6\x7f\x40\xaf\x19\x0e\xbc\x1f\x0b\x52\x74\xba\x2a\x3e\xe1\x81\x35\x0f\x9e\xf4\xcb\x45\xe3\x7e\x23\x39\xec\x37\x7f\x50\xe2\x84\x2d\x03\x22\xf9\x92\x67\xe6\xef\x96\x25\x86\x1e\xcb\x37\xf6\xae\x85\x05\xe7\xa0\x2e\x68\x95\x94\x85\x5f\x15\x8c\xfb\x20\x9b\xd7\x31\xe0\x31\x91\x88\x65\x88\x58\xd3\xb8\x89\x2b\xf0\x46\x29\xa9\xf0\x0d\x8f\xb7\x6b\xc8\x1d\xdf\xc3\x55\x42\xb7\xc6\xad\xff\x4c\x82\xe4\x4d\x62\xb4\x48\x07\xfb\xde\xfa\xc7\x2f\xd2\xde\x37\x72\xf2\x81\x23\xb7\x39\x00\xdb\x13\x91\x6d\x2f\x58\xd2\x7b\x24\x5b\x09\xc6\x0e\x0f\xc9\xee\xb8\xfb\x9d\xd6\xd0\x69\x8d\x2f\xb0\x84\xac\x4b\xbb\x6d\x69\x3e\x9e\xc0\xa6\xdc\x08\x18\x5b\x8d\x9a\xe7\x94\x12\x6c\xd4\xea\x89\xe5\xeb\x75\xe8\x74\xce\x9c\xfd\xfa\x68\x76\x3e\x1d\x04\x12\x72\xb3\xbd\xa8\x81\xfc\xdb\xb7\x18\x01\x02\xe8\xe3\xbc\x32\x61\x9e\xd4\xd3\xff\x77\x98\x8c\x1a\x20\xda\xf5\xa1\x38\xde\x19\xf8\x59\x98\x88\xd9\xf0\xc9\x36\x37\x48\xa1\xd0\x0d\x11\x00\x59\x49\x2f\x28\x19\xb8\x24\x5f\x08\xfb\x32\x46\xb5\x27\x56\x4b\xcd\x3b\xc9\x29\x4d\x0f\x52\x7d\xf8\xce\x5d\xe6\xec\x42\x24\xc0\xef\x83\x30\x19\x5b\x33\x2f\x66\x2a\x9e\x32\x13\x20\xcc\xd3\x54\x15\x66\x0d\xb4\x3f\x8f\xc7\x34\x4c\x7e\x25\x12\x7d\x60\x8d\x81\xa0\xcf\x35\x02\xf0\x48\x65\xf6\x36\x7d\x2a\x03\x79\xa0\x98\xdf\x6d\x09\xe1\x53\x5f\x7e\xa1\x08\x6b\x8b\x89\x68\xb8\x06\xc1\x98\x8c\xe9\x68\x21\x96\xdc\x2c\xe4\x19\xec\x35\x2c\x3c\xd7\x01\x11\x7c\xec\x85\x48\x41\x39\x2d\x44\xbe\xd4\x4c\x5d\x1f\x38\x3d\x38\x74\xcb\xb9\x0a\x34\x37\xf4\xd2\xeb\x3d\xca\x02\xf5\xe7\xda\x91\xa8\xa7\x65\x6c\x16\x49\x0a\x63\x2f\xf5\x8f\x29\xc3\x05\xe7\xda\xd9\x58\x2b\x3b\x46\x07\xd0\xa3\xd9\x39\xbb\xb1\x7b\xed\x19\x09\x1f\xae\x86\xc0\x67\x52\x2f\x5b\xd0\xf3\x1d\xb4\x8b\xbd\x31\x6e\x6f\x8c\xdb\x1b\xe3\xf6\xc6\x38\x2f\x63\x5c\x43\x50\x73\x65\x60\x3b\x2d\x52\xc3\xac\xd7\x6a\x68\xd1\x61\x2f\x15\xaa\x51\x52\x05\xa3\x76\x9c\x89\x0e\xed\x73\x81\xc8\x2a\x6f\x19\xe5\x86\x60\xf8\x1a\x76\xb3\xf5\xa1\xaa\x5c\x16\xd7\x93\x6f\x99\x48\x23\x15\xdb\x23\x0f\x57\x6e\x4e\x4a\xb7\xdc\xf4\x01\x45\xc8\x51\xb2\x4d\xe3\x65\x19\x0c\xba\x66\x36\xb4\xb3\x1f\x0e\x9e\xe1\xd2\x75\xa1\x6b\x67\x3d\x2a\x20\xa8\x76\x98\x24\x28\x72\x75\xbb\x4d\x1f\x24\x3b\x6e\x14\x91\xa3\xfe\xe1\x50\x43\x79\x4f\x22\x97\x60\x60\x01\xa6\x08\x12\x87\xd0\x85\x66\x23\xfb\xe5\x61\x94\x95\xc1\x3a\x8d\x45\x58\x8a\xa5\xca\x57\x07\x15\x34\x40\x06\x22\x36\x47\x88\xb8\x63\x13\xd4\x16\x95\x39\xd4\x71\x20\x07\xb6\xee\x9e\xa6\x54\xad\x29\x3d\x29\xec\xf1\x8d\x57\x77\xd4\x08\x31\xdb\x6e\x92\x44\xc1\xf5\x83\xc2\x88\x3e\xa8\x42\x0c\x3a\x00\x03\x9e\x48\x6f\xd8\x0d\xcf\x75\x18\xed\x7b\x38\xf4\xf0\x5f\x2c\x6f\xa4\x56\xc4\x44\xd8\x4d\x1f\x9e\xae\x7e\x0c\x64\x17\x58\xec\xa0\xe3\x35\xdc\xc6\xe9\x44\x8c\xb5\x8d\x74\x81\xc2\x68\x58\x90\x52\xfd\xa3\xca\x22\x2b\x0b\x94\x58\x1c\x37\xc3\x6a\x9b\x1d\x60\x2b\xfe\x57\xeb\xdb\xe0\x85\x7b\xf1\xcd\x8b\x60\xd0\x8c\x17\x85\xc8\xd3\x29\xfb\xcf\xe8\xe7\xaf\x3e\x4f\xc6\xdf\x8d\x46\x1f\xbe\x9e\xfc\xed\x97\xaf\x46\x3f\x1f\x9a\x3f\xfc\x69\xfc\xdd\xf8\xb3\xfb\xcb\x57\xe3\xf1\x68\xf4\xe1\x87\x37\xaf\x2e\x67\xa7\xbf\xc8\xf1\xe7\x0f\x69\xb9\xfc\x68\xff\xf6\x79\xf4\x41\x9c\xfe\x42\x04\x19\x8f\xbf\xfb\x63\xf0\x90\x3f\x4d\x6a\x07\xc8\x44\xa6\xc5\x44\xe5\x13\xbb\x09\xa6\xac\xc8\x03\xa2\xeb\xda\xc4\x9d\xf6\xb3\x95\x1a\x42\x9e\x43\xee\x43\xf7\x1f\x7e\xb9\x4a\x7a\x27\x32\x74\xd2\xd1\xc3\x47\xfe\xa5\xf8\x2b\x83\x5e\x68\x73\x16\xa6\x83\xc0\x1d\xbe\xd9\x67\xe3\xd5\x5e\xfe\x0b\x76\xd7\x7c\x21\x01\xbe\x58\xf2\xcc\x59\x43\xf6\xd1\xbd\xfb\xe8\xde\x1e\xa2\x7b\xed\xae\x6a\x5a\xec\x20\x67\xa6\xcc\x4c\x08\x9c\x37\xe8\x3e\xb4\x77\x1f\xda\xbb\x0f\xed\xdd\x87\xf6\xee\x43\x7b\xf7\xa1\xbd\xfb\xd0\xde\x7d\x68\xef\x3e\xb4\x77\x1f\xda\xbb\x0f\xed\xdd\x87\xf6\xee\x5c\x68\xef\x85\xbf\x6d\xa3\x1d\xd7\xfb\x25\x85\xf5\x42\xbd\x46\x19\x89\xa3\x28\x82\x8a\x36\x97\xea\xa3\xf0\x8a\x6d\x23\x99\x8a\xee\xbc\x61\xc7\xed\x46\xbc\x8c\x25\x94\x76\xec\xb4\xb3\x8e\x10\xc4\x99\x39\x20\x1e\x21\x8d\x03\x2c\x06\x6e\x34\x8e\x13\x15\xb0\x46\x87\xec\x88\xe5\x22\x92\x99\x0c\xb9\x0b\x15\x98\x1a\x0c\x8e\xdd\xaa\x58\x41\x66\x05\x1b\x58\x24\xd7\x61\x4a\x2e\x4f\xeb\x4a\x34\x79\xc3\x22\x81\x97\xb7\x9b\x46\xc8\x60\xab\x69\x5b\xe3\x8b\x72\xed\xd5\x99\x5e\xa8\x32\xf1\x27\x69\x2e\xfe\xe7\x64\x67\xa4\xe6\x65\x63\x84\x95\xd7\xc5\x1b\x17\x2d\x46\x0d\x32\xe0\xe0\x79\x26\xb1\x10\xec\x53\x71\x47\xf1\x29\x93\xb9\xb1\xda\x5e\x88\x48\xa5\xb1\xee\xb4\x99\x4f\xd7\xd1\x70\x57\x7b\x62\x32\xe7\xee\x16\x31\x8b\x4b\x3b\x3c\x58\xdf\x1b\x9e\xc8\x58\x16\x2b\xa6\xae\xbd\x11\x1b\x2c\x86\x71\xcb\x63\xaa\x23\x12\x36\x46\xf3\x34\xe3\x59\x96\x2b\xa8\x73\xa6\x1b\xb4\xb4\xfa\x26\x16\x3a\xf3\x06\x46\x1b\x5a\x96\x94\x73\x99\x5a\x65\xd3\xbc\x03\x64\x7a\x88\x7b\x80\xa8\x6b\xd1\x27\x01\x2e\x3b\x0c\xd6\x0c\xcf\xda\xbc\x8a\x7c\x65\x02\xe8\x15\x0e\xb1\x3e\x38\xde\xa8\xd8\x5c\xc0\x8c\x0f\xf6\x90\x4a\x62\xd7\x37\xe0\xdb\xaf\xa1\x2e\x65\x14\xc8\xcf\x20\xe2\xc3\x54\x4d\x2f\x14\x4b\xe4\x8d\x00\x8b\x9d\xbc\xee\x32\xd2\xd6\xe0\xfe\xfc\x17\xb6\x50\x65\xae\x0f\x1b\x25\xc9\xbc\x21\xbf\x31\x18\x86\x85\x39\x09\x81\x17\x2c\x11\x5c\x17\xec\x9b\xaf\xd9\x52\xa6\x65\x21\xc8\x45\xb9\x37\xa8\xb8\x7f\xfd\xcb\xe0\xa9\x94\xdb\x10\xb5\xb6\xc5\x52\x5c\x4c\x5c\xa5\xd8\x39\xed\x36\x94\xeb\x9a\x4a\x84\xcc\xb4\xb5\x5f\xd3\x72\xbd\x11\x51\xd4\x68\x6e\xd5\xb4\x50\x4f\xc4\xbb\x43\x34\x3a\x6f\xad\x2c\x40\x56\xf4\x7e\xc4\x47\x71\xa3\x4f\x7a\xc2\xa8\xd1\x61\x1e\x03\xfe\xb5\x54\x57\xab\x82\x20\xf0\xb5\xb6\xf0\xbf\xec\x53\xed\x7a\x9a\xee\x4b\xd7\x0f\xe2\x51\x48\xcb\xc1\x4d\xcd\xcc\xbb\xfd\x20\xea\x76\x10\x83\x7e\xa5\xdd\x39\x44\x0e\x4f\x07\xde\xc7\xd6\xd8\x08\x9d\xe5\x08\x2f\x34\x1e\x45\x50\xae\xa5\x50\xae\xa4\x33\x09\xd6\x38\xae\x52\x65\x47\x32\xd8\xc2\x81\xea\x50\x78\xf2\xc1\x76\x0c\xb8\xc2\x03\xaf\x4b\x7f\xfb\x2d\x19\x06\xdb\xd0\x2d\x73\x31\x97\xba\xc8\xc3\x68\x68\x1f\x6d\x1f\x0f\xa8\xb9\x9e\x98\xdb\x79\x59\x26\x85\xcc\xc8\x96\x6b\x77\xae\x2a\x58\x94\x79\x9a\xce\x4f\x5e\x7b\xbb\x88\xa8\xdc\xb6\x55\xb6\x2d\xec\x8c\x7b\x7e\xe4\xc6\xc5\x44\x5a\xe4\x10\x24\x06\x11\x99\x5a\x64\x3c\xe7\x74\x87\xaf\x59\xe0\x48\x2d\x97\x5c\x8f\xd1\x27\xcb\x4d\xcc\xac\xbd\xf0\x40\xca\xc9\x79\x52\x91\x97\x88\xda\x88\x56\xdc\xc6\x81\x29\x44\xca\xd3\x22\x60\xa9\x2f\xcd\x83\x4c\xdd\x56\x89\x96\x73\x79\x23\xd2\x8a\x17\x7a\x39\x68\x50\x5f\xfc\x9e\x47\x1f\x45\x1a\xb3\xf7\xda\x9d\x97\x78\x95\xf2\x25\x76\x94\xca\x72\x75\x23\x21\x9d\x49\xc4\x9e\xfb\xa7\x8a\xf6\xb4\xbe\x29\x70\x9e\x8b\xc2\x95\xf1\xb6\xa2\xf9\x36\x68\x5b\xea\xa0\xae\x24\xef\xb5\xc8\x1f\xe3\xb6\x54\x43\xa0\xc9\x2c\xcb\xe5\x4d\x24\x9c\x9a\x00\x83\xda\xc6\x64\x6f\x88\x45\xaa\xef\x4c\x17\x0b\x54\xcb\x86\xd7\xda\xdc\x89\x55\x68\x0c\x75\xae\xe0\x86\x48\xe0\x02\x58\xb9\xf4\x86\xb5\x0d\x00\x2b\x9e\x6e\xa7\x1f\xab\x8f\x2c\x43\x66\x00\xae\x4c\x6e\x9f\x52\x4f\x7e\x45\x18\x63\x6b\x7d\x86\xef\xbe\x3f\x69\xf3\xf3\x77\x3c\x56\x9a\x7d\x9f\xa8\xe8\x23\x3b\x11\x60\xe8\x7b\x14\x91\x55\xb2\xd1\x43\x92\x4f\x43\xf4\xa1\xac\x91\x47\x43\xfb\xfc\x2a\xde\x5e\x3b\xfb\xe0\x02\xe5\xfb\x76\xe7\xbf\xc5\x76\xe7\xf9\x55\xfc\xbb\x6b\x76\xbe\xe4\xf3\xa0\x03\x00\xd6\xaa\xdc\xb0\x13\x03\xe1\xfa\x12\x86\x2e\x13\xe9\xf8\xff\x61\xa1\x6e\x27\x85\x9a\x94\x5a\x4c\x64\xb1\x15\x7a\x7c\x14\x2b\xa0\x5c\x08\x45\x7e\xb0\x8f\xb6\xcc\x24\x85\x32\x2e\x26\xe2\xcb\x9d\xd4\xf8\xee\xfb\x13\x10\x25\xaa\x26\xbe\x00\xf9\x52\x14\xd1\xcb\x48\x64\x8b\x97\x38\x44\x7b\x26\x88\xa8\x01\xbc\xf6\x09\x88\xbd\x54\xa9\x2c\x54\xae\x43\xa8\x7d\xc4\x22\x68\x37\x12\x39\xab\xf4\xb1\xc8\x16\x15\xe0\x2e\xd2\xc6\x2b\x44\xd0\x93\x90\x7e\xe6\x1b\xe8\x4f\xa2\x92\x10\xa2\xd7\x87\x1e\x10\xf0\xcc\xd7\x9b\x94\x84\x68\x24\x99\x26\xa7\xd8\xb9\x6d\xb9\xb5\xd6\x90\xee\x1f\x9f\xa9\xb7\x37\x7b\x06\xa2\x3f\x4d\x27\xf1\xe1\x85\x7b\x18\x58\x65\x33\xdf\x3a\xa0\x25\xb8\x1b\x4a\x9b\x15\x9f\x5f\x83\xcd\xee\x46\xc6\x50\xdd\xf8\x46\xe4\xb9\x8c\x85\x76\xd7\x05\x95\xfe\xf5\x51\x61\xa9\x4c\x9e\xf6\x10\xf8\x49\xc6\xfe\x31\x39\x84\x68\x9c\xc6\x7c\xc9\xb0\xec\xf9\xe2\x70\xb6\x12\x81\x13\x12\x7b\x13\x78\x1b\x90\xd4\xca\x0e\xe6\x8e\xc6\x75\x00\x08\xa1\xd7\x01\x8f\x97\x32\xdd\xe1\x0b\xc1\xc7\x42\x60\x64\x61\xc2\xef\x39\x41\x65\xd0\xe3\x4a\xea\x88\x27\xe2\xfc\xc7\xe9\xc0\x6b\x11\x2f\xec\x53\x6d\x3b\x81\xfb\xb2\x6e\xd2\x46\xb6\x23\xdd\xdb\x70\xec\x87\xea\xf8\xb2\x54\xc5\x14\xcf\xe9\x13\xe9\xf2\xbf\x81\xc6\x83\xde\x67\xce\xa3\x2f\x99\x27\xf3\x99\xf3\x42\xdc\xf2\x10\xd9\xe9\xd2\x11\x08\xdb\x37\xbb\xeb\xc3\x6d\x46\x7a\xc5\xc9\x57\x76\x0c\x5b\x99\x5f\x96\xab\xc2\x6a\x1d\x27\x6a\xc9\x65\x1a\x38\xd1\xa6\x8c\xe2\x26\x38\xab\xa0\x49\x98\x8c\xd9\x11\x18\x39\xa5\x6e\xe5\x52\x42\xdb\x38\xb4\x6c\x1c\xee\x98\x78\x7c\x47\x70\xfd\xad\xb4\x88\xeb\x22\xbc\xd6\xb2\x6b\x6d\x38\x77\x61\x12\x5e\x99\xbb\xb0\x0f\xdc\x5e\x32\x17\x72\x15\x7e\xc7\xb4\x48\xb5\x84\x78\x8d\x66\xd8\x27\x8d\x14\x26\x8f\xc7\xd4\x2c\xab\xf2\x14\xad\x18\x7c\xc0\x5e\x2b\x08\x8d\x42\x7e\xa6\x52\x9f\x4c\xc3\x6b\x2e\x89\x19\x84\x7b\xb9\x75\x2f\xb7\xde\x23\xb7\x6a\x9d\x9c\xa6\xfc\x2a\x11\x71\xc0\xb1\x3b\x4b\xb8\x89\x92\x13\x06\xe1\x65\x2c\x35\xfc\x9f\x5d\x5c\xbc\x36\xae\xe0\x32\xf5\xd3\x19\x61\xe1\xdc\xc5\x53\x95\x02\xb1\x4c\x6e\x3b\x1c\xc7\x72\xf8\xc0\x36\x82\xe7\x69\x0c\xd3\x13\xba\x15\x4b\x8f\x98\x70\xa1\x90\x20\x59\x95\x5e\x8a\xb1\xbd\x10\x35\x71\xb9\x90\xd1\xc7\x59\xed\xf1\x85\xe8\x81\xcb\x85\x4c\x1b\x5f\x51\x19\x4f\x2d\xd6\x84\x21\x78\xee\x3f\x9c\xfe\x2c\xcc\x38\x76\xd9\xb8\xc9\x2f\x90\x90\x00\xc5\xb8\xd6\x2a\x92\xbe\xe1\x08\xc0\x80\x6a\x59\x83\xc5\xe6\xaa\xdf\xce\xb4\x8d\x57\xa9\x07\x39\xc6\x6d\x1f\xbd\xd2\x85\x58\xb2\xff\x63\xef\xda\x7b\x1b\x37\x92\xfc\xff\xfc\x14\x0d\xef\x00\x96\x6f\x25\xce\x04\x58\x1c\x70\xce\xe1\x02\x6f\xec\x09\x8c\x64\x3c\xc6\xd8\x98\xfd\x23\x9b\x0b\xda\x62\x4b\x22\x4c\xb1\xb9\x6c\x52\x1e\xdd\xe5\xbe\xfb\xa1\xfa\xc5\x87\x5e\xd5\x2d\x6a\x1e\x48\x5b\x01\x32\x7e\xb0\xd8\xcf\xea\xaa\xfa\xd5\xaf\x9a\x62\x75\x56\xcb\x6e\x49\x73\x33\x86\x27\xe9\xaa\x5a\xac\xf8\x2a\x57\x3b\xbb\x6b\xd7\x7d\x0f\xb7\x76\xcb\x9a\x30\xcb\xc5\xf8\x0c\x00\x9e\xa7\xa2\xb5\x60\x5c\x72\xfb\xe5\x11\x6d\x76\xa3\x4c\xba\x8b\xbf\xac\x2f\xac\x3d\x01\xc4\x5f\x5a\xeb\x09\xf3\xb7\x38\x3c\xcd\xc5\x6f\x46\x96\x5c\xd9\x16\x71\xec\xba\xcd\x3a\x6e\xa8\xe1\x71\x24\xd9\xa1\xe0\x45\x9d\xa9\x3c\x6d\x3b\x81\x5b\xa3\x21\xfb\xec\x08\xbd\xf5\x4c\x84\xe4\x2f\xaa\x25\xe7\xd1\xb0\xc6\xd5\x71\x57\xd7\x6e\xa3\xbf\xb7\x88\xec\x6e\x3b\x48\xba\xda\xb8\x5b\x6c\x91\x12\x2d\xdb\xbd\xe3\xa5\xbc\xf9\xf7\xbf\xfd\x2d\xde\x72\xaf\x2d\x52\xe8\xce\xdb\x6f\xb7\xdf\x6b\x1b\x0d\x42\x58\xef\x50\xcf\x91\x22\x7b\x04\xf5\x01\xa8\xe6\x7b\xa9\xe5\x0e\x24\x71\x77\x52\xb8\x6b\x9e\xbc\x03\x32\xe7\x70\xa1\xa8\xbc\x20\xd4\xed\x3c\xd8\x57\x65\x48\xbb\x89\x2e\x3e\xd6\xd6\xda\x42\x3b\x6a\x05\x21\x45\xb6\x2a\x0a\x1d\xac\x10\x84\x14\xd9\x1a\xc0\xa1\xea\x02\xe1\xaa\x01\xc9\x77\x20\x25\xee\xad\x01\xb4\xa5\xa6\x0f\xb6\xa1\xc6\xa3\x96\x12\x0f\x55\xf2\x89\x86\xbd\x9a\x53\x5d\xb5\x89\x14\x6a\x28\x30\xdb\xaa\xf6\x6c\x54\xe1\x41\xca\xdc\xac\xd5\xe3\xc0\xcb\x77\x82\xd3\x0f\xd6\xd9\xe9\x56\xcd\x41\x4a\x6d\xdc\x1f\x5c\x93\x7d\x62\x19\xce\x95\x74\x3a\x5d\x7d\xb4\xc5\x22\xcc\x1e\xc2\xb6\xd4\xd9\x32\xf4\xad\x95\x83\x35\x11\x1c\x44\x92\xed\x75\x71\xfa\x96\x81\x93\xc4\x56\x35\x9c\xe1\xea\xda\xec\xaf\x66\x63\x8f\x79\x27\x99\x08\x93\x40\x1b\x21\x4e\x62\x31\x86\x81\xae\x26\xe3\x24\xf7\x68\xf3\xc0\xd7\x48\xf0\x33\x15\x1a\xc5\x75\xd4\x9e\xb4\x5a\xb4\xe8\xdc\x45\xe2\x58\x18\x68\xb3\x22\xcc\x8e\xda\x2e\x4e\x52\xa1\x49\xf1\xb0\x15\x5d\xf6\xd5\x71\xf1\xad\xc8\xe2\xa1\x9c\xf0\xce\xab\x7b\xcd\x15\x27\x4e\x9f\x63\x98\xd3\x35\xbb\xcb\xad\xd6\x06\xb2\xc2\x86\xae\x98\x11\x39\x14\x4e\xf4\x29\xac\xe1\x07\xbc\x78\x46\x74\x3a\xd1\x7e\xed\xbc\x9b\xb5\x2e\x93\xda\x51\x22\x49\xeb\xbe\x2c\x75\x56\x7d\x36\xf7\xdd\x79\x23\x38\xac\x3c\xdd\x26\x8e\x30\x55\xba\x4b\x48\x3d\xf7\xfe\xa1\x97\x52\x60\x7f\x8c\x3e\x28\x43\x26\xc1\xe7\xcf\x24\x38\x4d\xfa\xfe\xf0\x0b\x39\xc0\xd0\xa7\x83\xa1\xcd\xbc\x89\xb6\x5e\x44\x13\xf3\x21\x9c\x07\x48\x34\x7f\x6a\xdf\xe5\xd8\x68\x05\xb8\xdd\x6c\x5a\x32\x59\x75\x85\x66\x22\x26\xf8\x50\x41\xcf\xea\xd6\xe6\xb3\xb2\xea\x9b\x68\x01\x54\xcf\x5f\x16\x15\x76\xd9\x05\x7c\x39\xe0\xcb\x3b\xf0\xe5\xa3\xe0\xa2\x8f\xf6\x61\x13\x19\x5b\xd4\x4b\x9a\x4f\x40\xd3\x00\xd2\xec\x12\x71\xe3\xb3\xde\x26\x32\x30\x81\xd6\x29\xc4\x65\x0a\x81\x21\x2d\x89\xec\x75\x9e\xfe\xab\x66\x3a\xe6\x41\x68\x63\xc6\xc4\xd1\x09\x46\xbf\x19\x4c\x69\x2a\x1d\x35\xa2\x52\x42\x5f\x4f\x4d\x79\x81\x1d\x54\x3e\x6b\x07\xd7\xf4\x00\xd8\xd1\x8d\x75\xe9\xda\x66\x40\x22\x8f\xda\xde\x90\x77\x2e\xdf\x72\x2f\x2b\x43\x58\x59\x46\x4f\x21\x65\xaa\x78\x00\xe0\xdf\x82\xd0\x2c\xe3\x2f\x6a\x2d\xb5\x6d\x20\x58\x21\x62\xca\x0b\xec\x2e\x30\x57\x89\x2d\x53\x28\xf2\xcd\x92\x8d\x01\x80\x04\x00\x52\x41\xf4\x01\xed\x14\x4b\x17\x1a\x8c\x11\x9e\xc7\xe4\x81\x55\xad\xc9\x82\xb7\xd1\x5c\x11\x78\xe1\xdf\x26\x7b\x1e\xdb\xd6\x85\xad\xf1\x45\x9e\xd8\x82\xae\x52\x5e\x97\xea\x1d\x70\xcf\x8a\xfe\x95\x64\x0c\xae\x39\xf6\x72\x27\x03\xc7\xd4\x92\x64\x67\xe7\x46\x6c\x0c\x45\x4c\xec\x7a\xc3\xee\x2f\x09\xff\x25\xdc\x44\x76\x27\xec\x13\xdc\x1d\xbd\x31\xc6\x6e\x81\x7c\x8d\x8e\x9d\x60\x67\x3a\xe8\xc4\x95\x28\xc0\xd6\xf9\x88\x24\x8c\x77\x37\x6f\xfb\xd9\xae\x6b\xb0\x7a\x90\xbf\x3a\xde\x31\xd0\xd5\xb3\x54\x95\x08\x43\x9b\x8c\x86\x35\x00\x82\x73\xf0\x2d\x38\x07\xda\x79\xbd\xe7\x59\x3a\x5d\xdf\x5e\x7b\x4c\x97\xde\xa8\x44\x89\x20\x7f\xa7\x50\xd9\xe2\x1d\xcd\xe9\x5c\xc6\xbf\xc8\xe8\xe1\xfe\xef\xef\x2e\x50\x72\xa5\xff\x09\xd1\x3d\x72\x7b\xdd\x4f\xee\x68\x9f\xeb\xea\x55\x77\xa7\xa9\x73\xd0\x1b\x13\x4f\xa3\xe6\x24\xa3\x72\xa2\xca\x0e\xc6\xe4\xc0\x5f\x1d\xdd\xe9\x2a\x3c\xa6\x53\x72\x4c\x85\x48\xe1\xae\xaa\xe0\xb3\x5a\x26\xcf\xc3\x77\xcf\x25\x15\xa7\x19\x88\xe1\x8e\x03\xdc\xfb\x27\xf2\xd8\x8f\x06\x78\xe3\xe1\x18\xe8\x41\x41\x7b\xc9\xee\x9d\xc9\xbf\x85\xbf\x7c\xa4\xe2\x99\x4c\x26\x91\xff\xb1\x41\x67\xb3\x34\x4f\xab\xbd\x31\x8a\xce\x7b\xaf\xf4\x03\x60\x4a\x52\x55\x6c\x0b\xbc\x45\x23\x47\xde\x1c\x9f\xd4\xd9\xa1\xf5\x51\xd6\xd9\xfe\xb0\x18\xf6\xcc\x03\xa6\xce\x15\xa2\x13\x1b\x1d\xb9\x96\xbd\x7a\xd2\x21\xba\x6d\x1d\x50\x8d\x3c\x20\x93\x58\x72\x43\xc1\x0f\x9e\x14\xd8\x4e\xc1\xa7\x90\xce\x77\xc9\x92\xeb\x1a\x94\xc9\x83\x6d\xd6\xed\x3c\xe7\xf6\xc7\x37\x9f\xd8\xb4\x06\x9b\xf6\xb0\xc0\x5e\xff\x01\x79\xd5\x7d\x65\xa5\xa9\x1e\x0a\xaf\x84\x73\xdf\xfc\x02\x21\x14\xb8\xda\x89\x0c\x54\xc1\x54\x68\xfc\x4e\xd0\x2a\x15\x33\x75\xd6\xdb\x91\x65\x9f\x20\x8d\x00\xd2\x63\x71\x86\x6a\xe3\xa9\x98\x54\x24\xe9\xc0\x8f\xc9\x53\x5d\x41\xc6\xc0\x92\xae\xc9\x74\xc1\xe1\x1e\x29\x2a\x27\x11\x25\x55\xb6\x6f\x95\x72\xc8\x68\x13\x84\xe7\xaa\x78\x18\x2f\xad\x0f\xdb\x6a\x66\x1c\xa1\xa2\x0d\x90\x76\x09\x6b\xc8\xa4\x47\x2e\x81\x44\x65\xe7\xcf\x38\xd7\xf0\x2a\x74\xb6\x24\x3c\x30\x97\x16\xb5\xa8\x88\xa8\x97\xb0\xc7\x5e\x18\x38\x3c\x62\x4c\xd2\x98\xc5\x72\xd9\xc9\x7c\x1e\xb7\x9e\x2f\x19\x83\xba\x61\x59\x66\xba\xab\xa7\x1a\xbc\x0c\xad\x30\xc1\x7a\xc0\xcd\xd0\xc8\xdc\xce\x67\x0a\xf1\x8e\xad\xd2\xed\xaf\x5a\xbb\x0c\x50\x82\x5b\x73\x30\x26\xac\x9a\xc6\x17\x63\xc8\x79\x2f\xe0\x1a\x08\x2a\xc7\xe3\x09\xaa\x4b\xbb\xd8\x8f\xd5\xa2\xe4\xf5\x5c\xa5\x2d\x6b\x88\x50\xa8\x31\x30\x0b\x4b\x1a\xeb\x34\x49\xb0\x12\xcf\xd4\x84\x9c\x59\x2a\x4c\xbd\x34\x35\x62\x61\x4e\xc8\x92\x56\xb2\xde\x2f\xfc\x60\xca\xcb\x92\x89\x82\xe7\x68\xe9\xf2\xe9\x9b\x66\x1c\xbe\xb7\x82\x47\xe2\xa2\xb1\xcc\x16\xe9\x7c\xc1\x90\x37\x4b\xc3\xb8\x81\x5f\x09\xcf\x75\xd7\x68\x1c\x0d\x9a\xcf\xd2\x51\x34\x57\x39\x61\xcb\xa2\x5a\xb7\x76\x44\x6b\xcd\x55\xac\xc4\xd6\x9e\x31\xe3\x29\x17\xaf\x3c\x8c\x95\x37\x4c\x52\x6d\xf5\xeb\x1d\x42\xde\x90\x11\x6c\x11\xa4\xd8\xb4\x3a\x87\xa3\x2c\xe7\x13\x5e\x5c\x40\x21\xf4\xbc\xce\xb2\xc1\x1a\x9b\x73\xdb\x56\xd9\x28\xd0\x06\x34\x13\xbc\x79\x23\x4a\xa0\xcb\xc9\x61\x9e\x30\x99\x79\xd8\x27\xfa\xf3\x26\x17\x9b\xbe\x41\x14\xc2\x2d\xac\x5c\x8e\x5b\xde\x01\x5a\x6a\x8b\x2f\xd0\xd9\x06\x7a\xba\xb0\xf3\xe4\x33\x08\xdb\x36\x92\xcb\xb3\x1b\x43\x02\x89\x79\xa0\x35\xba\x43\xe3\xac\x38\x9b\x0f\xd4\x8d\xe3\x09\x83\xf2\x64\xf4\x89\x65\x48\xd8\xc5\x63\x4f\xee\xec\xd2\xce\xae\x38\x8a\x95\x05\x58\x69\x23\x49\x9e\x86\x3a\x3b\x43\x68\x08\xc4\x25\xde\x6e\x82\x09\xcf\x6c\xad\xd2\x22\xa9\x61\xf2\x19\xe1\x32\x49\x05\x1d\x55\x6e\x3e\xed\xdc\x50\xd5\x2e\xb7\x51\xf7\x5d\x89\x36\xf3\xc0\xfd\xa1\xde\xac\x81\xf1\x26\xd7\x0b\xe4\x31\xb8\x64\x51\x77\xbf\xaa\x45\x6b\xe6\x69\x51\x64\xe0\x41\xfa\xdd\xdb\xe4\xe8\xf8\x76\x3f\x66\x5a\x8f\x1e\x97\x0f\xed\xa8\xdd\x33\x5b\xa3\x13\x24\xba\x1f\xb9\xb0\x40\x57\x2c\xd2\x02\x4e\x77\x58\xd5\x95\xbe\x59\xc0\x63\xb5\xa8\xff\x3e\xc2\xad\x04\xb6\xab\xaa\x0e\xec\x6d\x3e\x26\x77\xbc\xba\x75\x02\xa2\x9a\xcf\x0d\x04\x6e\xc5\x98\x5c\x73\x26\xee\x78\x25\xbf\x8d\xc9\x4f\x95\xdc\x2f\x5e\x12\x7f\xa9\x3e\xfb\xe4\xab\x31\x3d\x7a\xea\xaf\x72\xe5\x7a\xc3\x34\x79\xb6\xa4\x69\x8d\xac\xa6\x03\xbb\xc3\x4c\x17\xe8\xb6\xdb\x3c\x72\x14\x66\x2e\xf2\x51\x53\xdc\x24\x9f\x0b\xdd\x54\xc8\x3f\xf2\x92\xf9\x04\x26\x66\x3e\x91\x76\xd5\x46\x5b\xbd\x24\xa6\x42\xaf\x26\x68\x6f\x7b\x3d\x8d\xbd\x2e\xbd\x68\x86\xb2\xd5\x55\x88\xb0\xda\x26\x7b\x89\xec\x4f\xc9\x4f\x15\x34\xf7\x97\x41\x1b\xb9\xa0\x2b\x66\x2b\x4b\x7b\xc9\xd4\xee\xc5\x58\x57\x6a\x36\xb0\x3f\x20\x50\x65\x51\x32\x37\xdb\xa9\xf9\x02\x42\x44\x6e\x52\x41\x75\x06\xae\x6a\x77\x2a\x00\xaf\xc8\xa8\xcf\x5d\xcf\xf0\x49\xa4\xb3\x06\x9d\xae\xa0\x2a\xf5\x3c\x9d\x92\x25\x2b\xe7\x32\xa1\x72\xea\x75\xe7\x9e\x97\x61\x32\x80\x32\xc1\x44\xe1\x76\x7d\xe1\xe2\x85\xc7\x65\x61\x9a\xaf\x89\xef\x6e\x45\xc6\x22\x87\x1a\x17\x69\x3c\xbf\x05\xef\xd8\x71\x36\xbb\xba\xf9\x94\x76\xb3\xce\xea\x88\x4e\xbe\x3c\x83\xdd\x1c\xec\xe6\x60\x37\x07\xbb\x39\xd8\xcd\xc1\x6e\x0e\x76\x73\xb0\x9b\x83\xdd\x1c\xec\xe6\xed\x76\xb3\xc7\xcb\x54\x2c\xfc\x32\xf2\xd2\xe1\xff\x90\x0f\x6f\x64\xee\x48\xe3\xdd\x6d\x35\x6c\x06\xe9\xc1\xca\x7e\xd0\xb6\xce\xa3\x84\x00\x14\x01\xc9\x41\x68\x49\xf3\x39\x23\xdf\x4d\xbe\x7b\xf3\x26\x8e\x4e\x4b\x98\x74\xa7\x4b\xba\xad\xde\x49\x0b\x57\x41\x3e\xa0\x66\x36\x3a\xc1\xba\x71\x59\x9a\xa6\x9b\x27\xca\x26\xb8\x9d\x75\xb1\x7e\xfd\x3a\x50\xe7\xad\x94\x63\x84\x54\xd2\x05\xfb\x6d\x1e\xec\x92\x55\x84\x56\x1d\x30\x2e\x5d\x32\x9c\xf9\xa7\xb3\x33\x3a\x35\x12\xb4\x20\x99\x96\xa9\xf1\x5b\x58\xe9\xe8\x13\x16\xd1\xdb\x6e\x4f\x50\x52\xa7\x8c\x0a\x7b\xb9\x9b\xee\x31\x87\x3b\x5e\xe5\x35\x94\xfa\xb8\x81\xae\x30\x33\x53\x28\xb1\x23\x16\xcf\x63\x92\xd4\x52\x34\xcd\x49\x5d\x24\xb4\x62\x17\xd2\x44\xd1\x69\x95\xb2\x54\x02\x64\x42\x20\xd5\x1c\x0c\x63\x55\x4a\xe2\xbe\xbc\x58\xa4\x96\xf7\x68\xc1\x8d\x3d\x95\x1d\xef\x59\xc9\x97\x70\x07\xb4\x74\x95\xe3\x68\x78\xf7\xb0\xaf\x9b\xd0\x27\x67\x67\xed\x7e\xd0\x5b\x23\xde\x15\xa7\x40\x0a\x85\x1b\xcf\xca\xa5\x80\xc8\x1c\x53\xff\x94\xab\xf7\xfd\x07\x6c\x0a\xa9\xf3\xf1\xdf\xe9\x86\x06\xae\x21\x2d\x04\x6c\x45\xcf\x2e\xa8\x4e\x6c\x81\xaf\x63\xcd\x0b\x6f\x56\xba\x83\x4c\x99\x67\xc1\x54\xf6\xc1\xd5\xdd\x35\x10\x06\x40\xda\x23\x2f\x78\xc6\xe7\xeb\xf6\x1c\xba\xb4\x14\x4a\x18\x03\xf8\xaf\x77\x1e\x64\x86\x3c\x69\x8f\x13\xd6\xe0\x5d\x6f\x79\xc4\xd1\x69\xe3\x14\x01\x69\x0e\x48\x73\x40\x9a\x03\xd2\x1c\x90\xe6\x80\x34\x07\xa4\x39\x20\xcd\x01\x69\x0e\x48\x73\x40\x9a\x03\xd2\x1c\x90\xe6\x80\x34\x07\xa4\x39\x20\xcd\x01\x69\x0e\x48\x73\x40\x9a\x03\xd2\x1c\x90\xe6\x80\x34\x7f\x39\xa4\xd9\xed\x35\x2e\x43\x3e\xd9\x04\x64\xa2\x41\xbb\x80\xfe\xd3\x82\x27\x47\x92\xc2\x01\xbf\xb2\xf8\x9e\x33\x27\x5c\x01\x6e\x53\x3e\xc9\x38\x5c\x62\xa4\x00\x4d\x10\xa9\x8b\x77\x0a\xa8\x3a\x04\x83\x35\x26\xff\xc3\x73\xa6\x38\xae\x07\xa5\x52\xa1\xd0\x40\x55\xd4\xac\xe0\xc9\x48\x5c\x1c\xe4\x0f\xba\x18\xc2\x96\xf4\x18\x38\xe7\x81\x73\x1e\x38\xe7\xdf\x20\xe7\x7c\x41\xa5\x9e\x11\x0e\x97\x3c\x18\xa8\x77\x33\xf5\xa7\xa5\x45\x01\x99\xfd\x1e\x6d\xc2\xed\x62\xa9\x7f\x21\x06\x3a\xf8\xe2\x7a\x21\xc3\x86\x6b\x2d\x44\xd5\x77\xac\x49\xa6\xd2\xac\x58\x72\xdf\x1d\x17\x1d\x17\x92\x1d\xa3\x09\x5c\xbf\x5e\xb0\x72\x82\xde\x22\xb2\xd8\xdd\x2c\xcd\x93\x2d\xa3\x62\x46\x32\x3a\x4d\xc8\xa3\x37\xc1\xd8\xc7\xf6\xe4\x4f\x74\x8e\x4d\xc8\x24\x70\x71\x9b\xfb\xf9\x6b\x5f\x8a\x31\x2e\xe3\x36\xc6\x8c\x71\x79\xb0\x37\x30\x57\x3a\x02\xf4\xaf\x9a\x95\x6b\x79\x27\x3f\x78\x2d\xe8\xdb\x52\xcd\x87\xc3\xbd\xab\x4a\xe7\x09\x9d\x7d\x97\x0a\x32\x85\x4c\x21\xd8\xea\xf8\xd1\xf0\x1f\x91\xe3\xb3\x1b\x36\x06\xa7\x2f\x0e\x8e\x2c\xea\x2c\x92\xd8\x78\xaf\x1a\x6a\x1b\x2b\x6b\x9f\x2b\x6e\x23\xd4\x1c\xb0\x6d\x19\xad\xd4\x95\xe8\xb3\xb9\x5a\x5b\x57\xd3\xb6\x2e\x7a\xc8\x3e\x4d\x30\xf8\x54\x01\xe1\x61\x82\xc2\xc7\x6d\x80\xa3\x82\xc3\x1b\xd3\x09\x3d\xd1\x56\x9a\x5c\xba\x9e\x32\x89\x8d\x2f\x6f\x8d\x15\x7b\x4b\xf5\x8b\x31\x0f\x10\x1d\x38\x2e\xd6\xbc\x31\xcc\x76\xf9\x35\x05\x3d\x3d\xc5\xea\x85\x7d\x2e\x36\x83\xcf\xde\x12\xbb\x41\xeb\x7e\x00\xda\xbf\xa1\x9d\xc0\xb5\x09\x1b\xfa\x46\x9c\xe1\xd3\x89\x5f\x7f\xa9\x85\xe1\x1f\x87\xde\x58\x16\x3a\xa8\x08\x2a\x50\x47\xa5\x3d\xa5\x92\x26\x9a\xbd\x2b\x32\xed\x2d\x59\x46\xb4\xb7\x46\xa7\xbd\x45\x76\x43\xbd\x9d\x08\xb5\xb7\xcc\x7e\xc8\xd7\x46\xa9\x07\x59\x6d\x9b\x41\x79\x6f\xb1\xbd\x08\x77\x13\x9e\xf5\x96\xd8\x0a\xeb\x6e\x09\xd1\xfa\x37\xf4\xb8\xd0\xee\x51\x36\xc7\x40\x7b\xd5\x88\xf0\x1d\x5f\x97\xb8\xe3\x10\xe1\xde\xa3\x42\xbe\x4e\xb1\xc9\x61\xc7\x4a\x9a\xd1\xbf\x80\x01\xe1\x31\xdb\x10\x78\x80\x83\x8c\x66\xf7\x47\x59\x45\x47\xad\x96\x8e\x5e\x6e\x75\x07\x54\x09\x85\xfb\xc3\x3c\x1a\xc4\x67\xe4\x7f\xc1\x08\x95\x6a\xe3\xff\xe4\x45\xa7\x02\xd2\xfb\x15\x39\xb1\xfd\x3b\x0f\xe1\x3a\x86\xdb\x6e\xea\x92\x16\xd0\x5c\x58\xb3\x2b\x9a\xf9\x19\xe4\x80\x62\xe7\x26\x4c\x04\xa7\x4a\xdf\x43\xf2\xb1\xc6\x5f\x16\x10\xaa\x04\x13\x51\x06\x29\xa0\x91\x67\xcf\x6c\x7d\x36\x3e\xf6\x84\x02\x41\xb7\xf9\x59\x73\xb5\x5e\x5b\x43\x5b\x3f\xc2\x43\xb0\xbc\xf8\xe1\x4c\x4a\x3b\xdb\xe4\x1c\x80\x69\xe3\x21\xd4\xd3\x71\xf3\xde\xd3\x9e\x0f\x36\x65\xfe\x2f\x23\xef\x2d\xd4\xba\x2b\xc0\x44\xb3\x5d\xc2\x81\xdb\x5a\xd3\x78\x29\x0f\xc7\xf9\x17\x15\x27\x23\x13\x6f\xa4\x73\x28\x70\x51\x5d\x7c\xdf\xe5\xad\x38\x4a\x94\xee\xff\x92\xd1\x5c\x90\x33\x83\xb2\xb4\x2f\xb3\x38\x8b\x4e\x7e\x6a\x7a\x6b\x3f\x3f\xad\x5f\x69\xd2\xcc\xcf\x6c\x7d\xc4\x2a\x79\x34\x88\x94\xba\x06\x1e\xcc\x22\x0b\x57\xb9\xba\x09\x23\x13\xf1\xbb\x00\x63\x15\x98\x61\x8d\x28\x32\xa2\x79\x95\x4e\xec\x5f\x38\x8a\xb6\x71\x40\x88\x74\xa9\x1c\x41\x73\x61\x53\x67\x3d\x46\x5e\x2a\xdc\xec\x8f\xa4\xb5\xd6\x81\x7d\xce\xca\x23\x46\x23\x15\xe6\x3e\x41\xc8\x07\x28\xeb\x3c\x87\x16\xf3\x5c\x03\x46\x4e\xb7\x59\x9b\x2f\xa9\x0e\x4d\xd0\x5a\x76\x5c\x45\x48\x41\xb1\xb7\xd6\x83\xa3\xd4\x26\xf2\x4f\xa5\x1b\x2a\xef\x40\x81\x26\xf2\x5c\x27\x46\xd0\xdc\x75\x3f\xea\x36\xaa\x20\x15\x84\xc2\x61\xde\x52\x3b\x0e\x31\xb9\xf1\xd8\xe4\xad\x2e\x9a\x1b\xa9\xe5\x4d\x33\xae\x3a\xdd\x6b\xa7\xba\x5b\xa2\x13\xaf\x39\xf9\xdc\xa4\xf1\x97\x13\x92\xc6\x7b\xc0\xc2\x9f\x9e\x33\xde\x1d\x8e\x40\x1c\x0f\xc4\xf1\x6f\x91\x38\x2e\xc7\x42\x9d\x3e\x3b\x18\xe4\x28\xa1\x8a\x65\x8e\x66\x90\xa3\x64\x5a\x96\x39\xf9\x87\xbe\xd5\x0c\xc0\xd8\x92\x91\x65\x9d\x55\x69\x91\x35\xf8\x3b\x3e\x11\x11\x4c\x4b\x61\xa8\x04\xa2\xa7\xe1\x80\xf7\x0e\x99\x10\x3d\x4d\x87\x12\x0c\x50\x12\xa8\x9b\x52\xc8\x33\x52\x27\x57\x00\x2c\x0d\x88\x69\x73\x2d\xb1\xca\x68\x41\xde\x40\xe4\x60\xba\x76\x36\xe0\xb5\xb4\x55\x44\x93\x41\x2d\x2d\xad\x11\x18\x44\x19\x2c\x43\xbc\xb1\xb2\xdb\x3a\xd3\x11\xf3\x15\x33\x69\x0b\xf3\x74\x85\xbe\xde\xde\x9a\x66\x90\xed\x64\x90\x8e\xe3\x8d\xd7\x13\x18\xad\x70\x7e\x6e\x9a\x8f\xdb\x0d\x42\xa4\xc8\x9e\xd9\xb8\xcf\x10\x44\x4a\xfc\xcf\x96\x6d\xf2\x5f\x87\x4d\x40\xa4\x54\x2a\xa7\xc3\x1a\x7f\xcd\x42\x6a\x4c\xbf\xe8\x34\xe8\x5c\x67\xa1\x61\x1f\xda\x01\xad\xf6\x81\xfa\x2e\xf4\x1e\x39\xfa\x17\x7e\x20\xbd\x1f\x3e\xd9\x8f\x15\xb9\x3c\xdb\x1b\x8c\xbe\x28\x58\xbf\x54\xc2\xec\x4e\x32\xc9\x21\x50\x1e\x62\x3b\x8e\x12\x87\x00\xe4\xbd\x5c\xfc\xad\xab\x65\x5b\xb7\xa2\xaf\x01\x84\x0f\x8c\xac\x6d\x8c\xac\x3e\xe0\xee\x09\x12\x90\xdd\x60\xfb\x37\x4d\xcc\x1a\x0a\x28\xdf\x09\x92\x7b\x64\x3e\x59\x3d\xb2\x03\x20\xf7\x8c\x06\x93\xed\xe0\xf8\xd1\x20\xf7\xd7\x40\xb2\x1a\x08\xd8\x3e\x0d\xa8\xbd\x1b\xd0\x3e\xa2\xa9\x5b\xc1\xec\x41\xda\x6a\x81\xec\x4d\x40\xda\x4b\x64\xbb\xab\xdb\xc0\x68\x2f\xa1\x1b\xfc\xa2\xa1\x80\xe8\xc0\x2f\xfa\x93\xf3\x8b\x80\x5f\x74\x04\xc0\x3c\x04\xb8\xec\xbd\x12\x3a\x3a\x71\x1b\xa8\xdc\x83\x88\x1d\xdb\xb5\x07\x50\x36\xd8\x42\xeb\xa5\x8e\xc2\x37\xc0\xe4\xe1\xa1\xe1\x93\xc0\xc2\x27\x81\x84\x0f\xc3\xc1\x3e\x4e\x80\xd7\x1e\xf2\x78\xa8\x81\x95\x2e\x23\xaf\xa5\xdb\x08\xe8\x83\xb9\x2d\xc8\x0a\x2d\x9a\x6c\x09\xcd\x34\xb6\xeb\x16\x68\xd6\x41\x72\xb7\xf8\xe0\x70\xb0\xac\xf3\x39\xe2\xa5\x33\xdc\x75\x63\x2b\x8c\x72\x19\x7d\x51\x08\xf6\x44\xf0\xeb\x09\xa0\xd7\x53\xc1\xae\x28\xc8\x55\xa9\x23\x07\xa9\x5b\xa3\x6c\xed\x79\x37\xd1\x33\x07\x99\x7b\xa1\xd6\x3e\x78\xea\x20\x77\x3b\xcc\xda\x69\xac\x02\x4e\x1d\x64\x3a\x43\xac\xce\x3b\xcf\xcd\xde\x72\x87\x55\x1d\x35\x36\x5e\x09\xa0\x05\x03\x3c\x90\x57\xa9\x81\x08\x2e\x23\x07\xfd\xd0\x23\x23\xb7\xf7\x71\x0b\xce\x3a\x20\x51\x5f\xb5\xad\x91\x1c\xba\xe2\x69\x42\x8a\xba\x02\x0a\xe3\x1e\x56\xf2\x41\xa1\x0d\x6b\x39\xb0\x92\xf1\xac\xe4\xce\x14\xb6\xf8\xa6\x28\xe1\x3b\xe0\xc1\x40\x4d\xfe\x36\xa9\xc9\xed\xb5\x80\x92\x1e\xf8\xc9\x81\x9f\x1c\xf8\xc9\x81\x9f\x1c\xf8\xc9\x81\x9f\x1c\xf8\xc9\x81\x9f\x1c\xf8\xc9\x81\x9f\x1c\xf8\xc9\x81\x9f\x1c\xf8\xc9\x81\x9f\x1c\xf8\xc9\x81\x9f\x1c\xf8\xc9\x81\x9f\x1c\xf8\xc9\x81\x9f\x1c\xf8\xc9\x81\x9f\x1c\xf8\xc9\x81\x9f\x1c\xf8\xc9\x81\x9f\x1c\xf8\xc9\x81\x9f\x1c\xf8\xc9\x43\xf0\x93\xdb\x87\xc4\x0e\xda\x2e\x42\xf4\x37\x45\x52\x46\x74\xd9\x74\x07\x25\x54\x75\x19\xcb\x54\x46\x89\xb4\x6c\x66\x1c\x53\x19\x25\x73\xe3\x3e\xec\x7d\x4c\x65\xa7\x19\xea\xde\x87\xbd\x8b\xa9\x8c\x12\x69\xd0\x7a\x15\x74\x3b\xc0\x54\xc6\x49\xdc\xc2\x66\xde\xcd\x54\x46\x89\x0c\x6c\xe6\xc0\x66\x0e\x6c\xe6\xc0\x66\x0e\x6c\xe6\xc0\x66\x0e\x6c\xe6\xc0\x66\x0e\x6c\xe6\xc0\x66\x0e\x6c\xe6\xc0\x66\x0e\x6c\xe6\xc0\x66\x0e\x6c\xe6\xc0\x66\x0e\x6c\xe6\xc0\x66\x0e\x6c\xe6\xc0\x66\x0e\x6c\xe6\xc0\x66\x0e\x6c\xe6\xaf\x9e\xcd\x8c\xfa\x33\x5a\xce\xf7\xea\x37\x84\x02\x44\x0e\x3c\xa6\x7f\x4f\x75\x9a\x55\xb7\x4b\x3a\x67\x97\xd1\x91\x2f\x9c\xf2\xe5\x92\xe6\xc9\xd7\xd3\x37\x96\xaf\x8e\x6c\x4c\x47\x7f\xdf\xe4\xab\x8f\xb4\x1d\x2f\x81\x68\x1b\xcb\x57\x69\xc9\x73\x69\xa8\xad\x68\x99\xd2\xa7\x83\xf8\x94\x7e\x1c\xb4\x23\x25\x3f\x2a\x33\x89\x95\x71\x34\x4c\x30\x0d\x54\xec\x65\xe4\x74\x30\xdd\x01\xab\x5d\xeb\xa2\x6d\xfd\x89\xc9\xbb\x1a\x15\x0d\x7e\x02\x9e\xed\x8f\xbf\xdf\x5e\xdf\xdc\x3d\xde\xbe\xbd\xbd\xf9\x70\x58\x97\x20\xa7\xdb\xba\xd3\x8e\x5d\x3b\xff\xa8\xfb\x40\x24\x21\x93\xe5\x60\x59\xbd\x1a\x7d\xbc\xfa\xf0\xfb\xdd\xd5\xbb\x9b\x0b\x64\x44\x89\x7d\x2a\x68\x0e\xf7\xd6\xd6\xc2\x9c\x89\x45\xc9\x56\x29\xaf\x9b\x93\xa8\x35\x72\x08\x89\x66\x6c\x85\x39\x24\xb5\xbd\x0c\x0c\x43\x19\xc7\x5d\x13\xc1\xca\x55\x3a\x65\xbe\x82\x81\x11\x40\xa8\xfd\x9e\x4c\x69\xae\xb1\x6f\xe0\x22\x66\x2b\x96\x60\x23\x1b\x76\xe8\x4c\x5b\xd3\xbc\xa8\x2b\x3d\x69\xaa\xf4\xf7\x13\x23\x75\x3e\x5d\x40\x49\x7a\xd4\x01\x02\x86\x7d\x7b\x1a\xc4\x3a\xaf\xe8\x27\x68\x23\x98\x45\x4c\x4c\x69\x61\xd2\x59\x28\x49\x78\x7d\x78\x57\xc1\xe7\xd5\xab\x31\x49\xd9\x25\x79\xd5\x12\x1d\x93\x1b\x2d\xad\xb5\x02\x64\x9b\x73\xb6\x42\x65\x8b\x3c\x31\x3b\xff\x40\x57\x9f\xd3\x32\xc9\x98\x90\x68\xf2\xcb\x82\x01\x3c\xad\xbd\x1f\xd4\xee\x87\xff\x98\x0d\x34\xe5\xbc\x8a\x01\x92\xa5\x75\x56\xc9\x0a\x43\x67\x67\xf1\xf9\xe0\x9b\xe6\x6d\xc9\x97\x8e\x1b\xe7\x41\x82\x60\x90\x8f\xb3\x53\x2d\x9c\x63\x0c\x21\xf9\xfe\x98\xfc\x68\x17\x5f\x2d\x00\x22\xd5\x21\x5e\x93\x0b\xa6\xe2\x78\xd1\xb0\x90\xc2\x94\xe7\xb3\x74\xfe\x8e\x16\x3f\xb3\xf5\x07\x36\xc3\x3c\xd2\x1f\x04\x89\xd3\x00\x1c\x06\xae\x33\x98\x71\xa0\xaf\x95\x50\xcc\x2a\x77\x6d\xb1\x33\xf4\xd1\x69\xed\xa3\x86\x6c\xa0\x26\x87\x6c\x38\xae\x89\x8e\x0b\xca\xed\x9c\xd9\xda\xd0\xf3\xf6\x81\xa3\x77\x65\x15\x93\x77\x5c\xe6\x52\xcc\x38\x5e\x2c\x21\x8b\xaa\x2a\xc4\xe5\xeb\xd7\xcf\xf5\x13\x2b\x73\x56\x31\x11\xa7\xfc\x75\xc2\xa7\xe2\xf5\x94\xe7\x53\x56\x54\xe2\x35\xf0\xb6\x57\x29\x7b\x79\xfd\xc2\xcb\xe7\x34\x9f\x4f\xc0\xb8\x9f\x28\x33\x4d\xbc\x86\x8e\x88\xd7\x7f\x91\xff\x73\x78\xf1\xe3\xfb\xeb\xf7\x97\xe4\x2a\x49\x74\x59\x97\x5a\xb0\x59\x9d\xe9\x4a\x02\x31\xa1\x45\xfa\x91\x95\x80\xcd\xba\xc4\x6a\x9e\xd3\x3c\x19\x93\x3a\x4d\x7e\x38\x8f\x90\x8f\xf8\xcc\x1d\x97\x13\x41\x33\xcf\xf9\x7b\x90\x49\xb7\xeb\x8e\xf6\xb3\xfb\x02\x2d\x92\x40\xa0\x1d\x6e\x25\x78\x66\x4d\x74\x5c\x1f\xa6\x68\x21\xaa\xf3\x4f\x9c\x67\x8c\xe6\x27\xf1\x41\xb0\x91\x5e\xa4\x8b\x60\x3e\x72\x9d\xf8\x69\xa5\xf3\x46\x2d\x49\x29\x66\x27\x15\x3c\xb9\x24\xa2\x2e\x0a\x5e\xa2\xb1\xbc\x25\xab\x68\x42\x2b\x1a\xc3\xf2\x1f\x77\xbf\x95\x8e\x7a\xeb\x67\xd2\x31\x46\x87\x1e\xed\x63\x52\xf3\x2b\x68\x70\x2c\xf3\xb5\x63\xf0\xd1\x41\x05\xe8\x6f\xb5\x9d\x73\x35\x9d\xf2\x3a\xaf\xe4\x2f\x90\xef\x10\x15\xad\x6a\x11\x2f\xb8\xa8\x6e\xef\xc7\xe6\xdb\x82\x27\xfd\xef\x44\x7c\x7e\x22\x75\xdd\xec\x73\xcf\xcd\xa4\x9f\x36\x93\x08\x79\x89\x4b\x2a\xb7\xd4\x5b\x98\xdc\x7b\x8a\x2c\x00\xa4\x7d\x1a\x41\x5e\xca\xb4\xaa\x58\x2e\x6d\x35\x99\x5e\xc6\x67\x63\x92\xb4\x4d\x8c\xd5\x77\x67\x27\x3d\x1a\x66\xa6\xe1\x9e\x43\x02\x7d\x36\xe3\x21\x65\x35\x47\x1a\x49\x73\xc7\xe8\x67\x13\x7c\xba\xba\xbf\x25\x2b\x35\xda\x5f\x51\x8c\xc3\x0e\xd6\x29\xb4\x8c\x49\x66\x7a\x3b\x88\xb6\x31\xd2\xcc\xdc\x58\x97\xe5\x32\x72\x08\xee\x1b\x29\x82\x64\xe9\x32\xd5\x50\xbc\x2e\x07\x25\xc8\x48\xfd\x30\x9e\x16\x35\x56\xd3\xe8\x27\x96\x6c\xc9\xcb\xf5\xd8\x7c\xcb\x8a\x05\x5b\xb2\x92\x66\x13\x51\xf1\x92\xce\xd9\xd8\xbe\xc4\x45\xb8\x7d\x46\x89\xef\x34\x76\xf3\x1d\xd8\x78\x2b\xe4\x32\x4d\xeb\x12\xec\x9e\x6c\x6d\x74\x36\x4b\x4e\xa6\xa5\xec\x4c\xdd\x1d\x61\xb5\xd9\x50\x85\x44\x29\x2e\xed\x4a\x07\x27\x01\x2d\x92\x90\x15\xcf\xea\x25\x04\x80\x8d\x15\x02\xcf\x83\x83\x01\x8e\x85\x38\x3f\xdd\xce\x24\x24\x49\x57\xa9\x70\xc9\x9d\xa1\xf9\xfa\xfd\xcc\x89\x36\xe2\xcc\x44\x68\x3f\xe7\xd4\x99\xde\xf4\x3c\x68\x3d\xa7\x6b\xc7\xd5\x15\xb8\xe8\xea\xba\x37\xc2\x71\x39\xed\x7a\x5c\xc1\xdd\xfb\x54\x70\xf0\xd3\xec\x5e\xed\x9d\x20\xdf\xe1\xf1\x9c\x82\x56\x15\x2b\xf3\x4b\xf2\xdf\xa3\x7f\xfe\xf5\x8f\xc9\xc5\x0f\xa3\xd1\xaf\x6f\x26\xff\xf1\xdb\x5f\x47\xff\x8c\xe5\x3f\xfe\xed\xe2\x87\x8b\x3f\xcc\x37\x7f\xbd\xb8\x18\x8d\x7e\xfd\xf9\xdd\x4f\x8f\xf7\x37\xbf\xa5\x17\x7f\xfc\x9a\xd7\xcb\x67\xf5\xdd\x1f\xa3\x5f\xd9\xcd\x6f\x48\x21\x17\x17\x3f\xbc\x42\x37\xf1\xd3\xa4\xf1\x1e\x26\x69\x5e\x4d\x78\x39\x51\x53\x71\x49\xaa\x12\x8d\x21\x98\xc1\xba\xf4\x9b\xc0\x73\x53\x85\xeb\xb2\x51\xb4\xf6\xdc\x3b\xff\x7a\x0e\x2c\xd3\xb8\x53\x9c\x57\x82\x4d\x4b\x56\x0d\xe9\xaf\x2b\x89\x3a\x70\x85\x92\x48\xfa\xd8\xe5\x89\x74\xf2\xf1\x8e\xbe\x3e\x86\x75\x17\xed\x5a\x41\x0b\x25\xf2\xf6\xb8\x98\xc8\xf0\x2e\x78\x5f\x10\x2d\x84\x84\x3b\x2d\xf1\x99\x21\x62\x32\xde\xeb\x2e\x84\x10\x42\x08\xa1\x13\x42\x78\x50\xab\xce\x0d\x61\xfe\x73\xc6\x0f\xd0\x7f\x8c\x6b\xed\x44\x6e\xc5\x68\x80\x37\x62\x60\xb9\x74\x10\xb4\xf1\x90\xf2\x40\x09\x11\x6c\x5a\x97\x69\xb5\x06\xd3\x96\x7d\xda\xcb\xc3\xed\x2e\xdf\xee\x73\x64\xc1\xb3\x44\x58\x69\x90\xfb\x34\x4b\xe7\x75\x49\x0f\x5e\x23\x2a\x09\x00\x06\x41\x51\x15\x19\x12\xb0\xb0\x68\xe3\x5c\xc5\xe4\x01\xd8\x7f\xad\x12\xa5\x1a\x40\xdc\x2b\x38\xcd\xc9\x13\xaf\x16\x1b\x2d\x05\xff\xe5\x9e\x27\xbd\x1f\xc7\x44\x72\xed\xf6\x4a\x94\xe2\xc0\x71\x11\xac\x5b\xd2\x29\xcd\x37\xde\x52\xd1\x67\x06\x35\x50\xa7\x2c\x01\xc8\x2d\x8e\x8e\x3f\x38\x25\x5d\xfc\xbe\x4c\x57\x69\xc6\xe6\x0c\x30\x9d\x8c\x62\xf8\xaa\x9d\x79\x3b\xbf\xda\x21\x45\x0e\x77\xc9\x33\x61\x34\xd2\x01\xa9\x40\xba\x28\x4a\x3e\x05\x1c\x68\x4a\x73\x02\x09\x54\x64\x09\x01\xec\xc2\x08\x07\x43\x9c\xe6\x52\x43\x15\xb4\xc4\x80\x77\x5a\xa0\xae\x6b\x05\x41\x4d\x92\xa4\x25\x9b\x56\xd9\xba\x69\x9f\xad\x15\xfc\x7b\xce\x5e\x7e\x87\xb7\x1d\x3e\x72\x66\x19\x6d\x70\x3a\xc9\x73\xea\x63\x8e\xf6\xd5\x9d\x01\xfa\x7f\xf6\xbe\xbe\xb7\x8d\x1c\xc9\xfb\x7f\x7d\x0a\xc2\xf3\x00\xb2\x9f\xb5\xe4\xcc\xde\x60\x76\xcf\x58\xec\xc2\x63\x3b\x59\x63\x27\x89\x2f\x72\x36\x77\x98\xdd\x1b\xd0\x6a\xca\xe2\xb9\xdf\xa6\xd9\x6d\x47\x8b\xf9\xf0\x87\x2a\x16\xd9\xdd\x92\x6c\x15\x5b\x2d\xc7\xb9\x71\x1c\x60\x32\xb2\xfa\xd7\x64\xb1\x58\x2c\xd6\x6b\x83\x40\x1b\xdf\x01\xa9\x16\x45\xa5\x84\x8c\xef\xe5\x02\xc9\xb8\xfc\x0e\x6d\x8e\xc5\xb7\x07\x10\xb2\x02\x65\x15\xfc\x22\x6c\x16\xd6\xbf\x3f\xc0\xaa\xc8\xa7\x27\x97\x3f\x4f\xfe\x6b\xf2\xf3\xc9\xd9\xdb\x8b\x77\xc3\x41\x5f\x82\x7d\x2a\x73\x79\xad\x63\xcd\xd1\xdd\x5a\xcc\x04\x6a\x58\xf3\x61\xdc\xb7\x51\x74\x14\x15\x59\x6e\xe7\x4f\xd1\x39\x03\xf6\xf5\xdc\xb4\x9d\x81\x40\x3f\xba\x77\xb9\xf4\xb4\xe6\x0b\x37\xe2\xde\x14\x32\x2d\x5d\x0e\x72\x73\x29\x8a\x2a\x85\xf4\xe9\xf1\xa0\x3f\xa5\x56\x46\xac\x23\xb1\x45\xc1\x13\xac\xb5\x1c\x34\x25\x56\xd8\xc6\xda\x97\x9d\xba\xd7\x2c\xea\x10\x0e\x71\xf9\x7e\x72\xf1\x9f\x61\x44\xad\xf9\x8b\xf5\x55\xd6\x39\x14\x76\x80\xba\x3f\xc0\x68\xc1\x34\xff\xa0\x92\xec\xee\x85\xea\x5d\xa9\xce\xd6\xbc\xfc\x39\xb0\x71\x5b\xb4\x97\xa7\x4a\x1b\xfb\x54\xa7\xf5\x79\x12\x89\x04\x93\xe2\x2f\xad\xa8\x66\xd0\xac\xfd\xb4\x47\x85\xe4\x1b\x88\xb1\x80\xa5\xd0\x36\x51\x9f\x5f\xf5\xac\xcc\x44\x91\x65\xfe\x04\x01\xd7\x4b\x5b\x66\xcd\x64\x6c\xd4\xb8\x37\xe9\x0c\x07\xd3\x5b\xf0\x0b\x05\x51\xd1\x3f\x25\x22\x95\x66\x25\x19\xc5\x60\xed\x40\x86\xc2\x6f\x45\x02\xa0\x1b\x30\x71\xba\x95\xa9\xc3\x20\x6a\x1a\xc2\x21\x5d\x0b\x67\x6d\x1c\x0d\x2e\xdd\x9b\x37\x42\xdb\xa8\xee\xca\x28\xd3\x86\x76\xc2\xd9\x81\x1b\x7c\x7b\xa1\x64\x04\xe6\xf3\x8d\xb0\xb9\x2c\xe7\xd6\xa6\x9e\x48\x73\x0b\xa5\xec\xe1\x03\xd2\x29\xe8\x3a\x60\xdf\xe8\x87\x7a\xc5\xd9\x55\x33\x25\xcb\xaa\x50\x56\x97\xb0\xc5\x29\x54\x0a\xf1\x2e\x1b\xe3\x7d\xd8\x7b\x11\xe6\xf8\x3e\x8d\x17\x1f\xb2\xac\x7c\xad\x63\x65\xeb\x4c\x04\x2d\xfc\x27\x7f\x9f\xd4\xa6\x41\x52\x50\x1f\xc0\x7f\x21\xa3\x11\x8b\x88\xc8\xe2\x33\x3f\x04\xcf\xe1\x10\xaf\xd2\x33\x83\x17\x55\x7a\x62\xde\x14\x59\x95\x07\x4d\x14\xb8\xef\xcd\xc5\x19\x30\x28\x28\x54\xb0\x9e\x2a\x2d\x8b\x85\x2d\x12\x92\xcd\xda\x3c\xc5\x57\x3d\x3f\x02\x3f\x2e\x71\x20\x84\xeb\x54\xa9\x51\x10\xa3\x21\x17\x42\xc6\x06\x96\x7f\x23\x26\x68\x2c\x3a\x5d\x7f\xdb\xb8\x98\xb9\x5f\xaf\xbb\xa5\x6c\x84\x5e\x7f\x8b\x69\xdc\x4a\x1a\x6e\x3f\x9d\x06\xc3\xc3\xf5\xc5\x30\xef\x2f\x4b\x55\x8b\xbe\xff\x6e\xd0\x57\xad\x22\xe4\x8c\x77\x59\x0a\xdb\x21\x88\x37\x2e\xd2\x48\x43\x36\x00\x95\x79\x68\x71\x82\xb5\x94\x00\xcb\xc8\xcd\x07\x08\x84\xd3\xa7\x23\xdc\x0c\x95\x81\x9b\x28\xd4\xfa\x29\x2a\x65\x09\xfd\xb7\xea\x5a\xc5\x8a\xae\xaf\x68\x38\x94\xe5\x66\xae\x80\x27\xd1\x02\x20\x64\xe9\x19\xad\xcc\x84\x4a\x0d\x88\x17\x1c\xb1\x2e\x45\x94\x29\x1b\x1a\x56\x54\x8f\xef\x1f\xf8\x91\x46\x7c\xbc\x38\x13\xaf\xc4\x3e\x8c\xf5\x00\xa5\xdf\x4c\xea\x18\x76\x87\x29\x65\xb1\x4c\x03\xbd\xd9\x1f\x42\x43\xc0\x29\x23\xef\x43\xb4\x0a\x6e\xfd\x43\x91\x66\xc2\x54\xd3\xb9\x9b\x33\x5c\x1f\x81\x06\x1b\x31\xaf\x15\xf4\x15\x01\x66\x51\x51\x6b\x2b\x6d\xd8\x2a\x1b\x81\x1f\xd9\x4a\x0f\x6d\x95\x8d\x98\xbc\xad\x14\xbc\x55\x02\x05\xe3\x47\xa3\x8a\x20\xde\x07\xb9\xf8\x71\x07\x72\x91\x0e\x00\x43\x1a\x41\xd1\xa6\x8a\x65\x68\x17\xf2\xb2\x11\xd5\xca\x53\x07\xf0\x5b\x65\x85\xdd\x48\x4d\xa3\x7e\xd4\x69\xf5\xf9\x3d\xf2\x83\x09\xe6\x9d\xc9\x39\x3e\x8e\x2c\x82\x93\xca\x96\x2c\x73\x1c\x37\x92\xe7\x2f\x27\x3e\x1c\xd9\x0e\x97\x04\x91\x93\x7f\x2c\xf1\x01\x16\x30\x10\xeb\x42\x8a\x42\xa6\x51\x96\xac\x0c\xd6\xb7\xf8\x6a\x0c\x00\x98\x8b\x01\xcd\x61\xbe\x26\x73\x6d\x84\x64\x32\x5f\xcd\x5c\x1b\x11\x7b\x65\xbe\x10\xd3\x46\xac\xee\x14\xcb\xd1\xd1\xe2\xa6\x1f\xe1\x29\x50\x18\xdd\x2a\x21\x0c\xa5\x97\xe1\x31\x47\xa9\x98\x0c\x60\xe1\xac\x41\xf5\xc2\x0e\x7a\xbe\x04\x17\x59\xfc\xa8\x3d\x7d\xed\x1c\x3f\x64\xb1\x82\x29\x4a\x3f\x49\x80\x79\xb6\x73\xc4\x2f\x87\xce\xf1\x6a\x91\x2f\xcd\x11\x60\x9e\xed\x1c\x2b\xc6\x89\xb9\x32\x47\x38\x66\xdb\x73\x04\x98\x67\x3a\x47\xb6\x09\xe6\x5e\xa7\x51\x76\x6f\xba\x1e\x05\x9f\xec\xe3\xee\xd0\x9b\x82\xf8\x2b\x75\x7a\x63\x88\x18\x11\xa7\x70\x20\x34\x4e\xf4\x84\x30\xeb\xcf\x03\xeb\x4b\x35\xe8\x8d\x67\x9d\x2f\xab\x72\xb4\xce\xc2\x31\xa0\x50\xfc\xdf\xba\x5e\x85\xc8\xea\x9b\xc4\xc8\xd3\x02\x70\x4b\x2d\x63\x08\x8a\x0a\xde\x0c\x6f\xde\x4e\x4e\xda\x10\xb0\x35\x6c\xba\x32\x9c\x56\xf0\x7b\x06\x26\x98\xc4\x13\x8d\xf5\x1a\xc4\xbd\xba\x9e\x67\xd9\xad\xd8\x77\xe9\x0a\x37\xba\x9c\x57\xd7\xe3\x69\x96\x34\xc2\x0e\x46\x46\xdf\x98\x23\xe2\xda\x11\xcc\x84\x17\x4f\xa8\xd3\x18\x4b\x3a\xba\x4d\x57\x37\xa9\xb4\x83\x15\x53\x3f\x1b\x5c\x38\x16\x28\xf8\x57\xbd\xe7\x60\x95\x24\x18\x7f\x81\x3e\xc9\x9e\x77\xf7\xba\x25\x7c\xc7\x0c\x14\xd9\xb0\x8c\x00\xe3\x4a\x82\x6d\x74\x78\xbb\x9f\x47\xe8\x48\x77\x81\xde\x09\xe0\x6f\x3e\x9d\xe6\x0d\xa2\xcb\x3d\x0c\xbb\xd3\x89\xb1\xfa\x46\xc4\x40\x14\x8f\xdd\x9a\x56\x6f\x45\x21\xc7\xc2\xa6\xdb\x13\x59\x9b\xdc\x17\x38\xd4\x15\xdc\x1b\x94\x95\x8b\x2c\xc4\x07\x64\xe7\x43\xb2\x91\x85\xc9\x93\x9f\xc1\xf2\x71\x37\xe7\x27\xeb\x6b\x36\x98\x17\xad\xc8\x8f\x0a\x67\x86\xfb\xa8\xc5\xc4\x7f\xaf\x71\x89\xbb\xa1\xcb\xb7\xb4\x56\x7b\xc8\x3a\xc5\x64\x3c\xfb\xad\x47\x51\x6d\x5d\x0b\x4c\xb5\xf6\xfc\x3b\x1e\xf4\x73\xda\xe0\x60\x78\x79\x0e\xad\xc9\xc1\x23\x6e\x58\xed\x8d\x05\xc1\x20\xe8\x16\xe0\xed\x52\x4b\xfd\x46\x3d\x11\x1c\x11\x28\x00\x36\xa4\x0f\xec\x67\x04\x2e\x86\xc7\xc3\xf1\xa0\x47\x2e\xc2\x57\x41\xa5\x27\x79\xc3\x8a\xc3\x58\xa2\xc1\xf2\xe3\x22\x52\x50\x0c\x19\xcf\xb1\x79\x76\x6f\x17\x9a\x23\x57\xc0\x97\x95\x13\x8e\xa2\x0a\xd2\xce\x33\x05\x22\xa7\x41\x5c\xdb\xe7\x86\x81\x69\xc3\xf1\xee\x41\xa6\x14\x59\x95\x46\x54\x89\x1a\xc8\x89\xc1\x2f\x6f\x97\x06\xff\x2e\x4b\x39\xb0\xda\x90\x7a\x76\x55\xd7\x37\x87\x40\x0f\x05\x42\x2f\x15\xdf\x8e\xbf\x7d\xd5\xeb\x12\x6d\x0a\x91\x5a\xb3\x2c\x38\x32\x34\x11\x63\x01\x18\xa0\x97\x70\xa1\x96\x6e\xbf\xf5\x3a\x46\xe7\xf3\x09\x1c\x27\xae\x80\x8a\x6a\x97\x0e\x9c\x1a\x10\xab\x0d\x59\x1e\x32\x1a\x41\x12\x14\x7b\xa5\xef\xb5\x51\x62\x1f\x8d\xbb\x60\xe5\x6d\x1c\x3e\x07\x1d\x7c\x9b\x21\x36\x4e\x21\x4c\x75\xdd\x83\x04\x21\x41\x80\xcc\x1f\x22\x40\xfc\xe6\x18\x9a\x47\x84\x49\x83\x04\x0c\xcc\xbd\x3d\xb1\x6f\xb1\xa0\x2a\x28\xd8\xe3\x7b\x65\x18\x22\x18\xd4\x3e\x0e\x24\xda\x39\x25\xf1\x8b\xfc\x61\xea\x6d\x44\x74\x7e\xdb\x96\xdc\x7e\x8c\x7a\x0c\xc4\x1f\xd4\x5c\xde\x41\xb3\x27\x9d\xe8\x58\x16\x50\x86\x3d\x13\x13\x3b\x4f\x71\x5d\x95\x6b\xb3\xef\x19\xb8\x0f\x97\xbc\x58\x57\xd0\xa2\x31\x1b\x06\x76\x63\x48\xed\x3d\xb2\x66\xf9\xdd\x5c\x60\xcd\x18\xd0\xa0\x61\xb9\xd9\x83\x74\x4f\x2a\xd7\x4f\xe0\xf3\x34\xae\x8c\xbe\xeb\x53\x02\x71\x03\x64\xfd\x59\xbf\xe1\x7b\x1b\xef\x15\x41\xda\xd7\xe3\x11\x29\x94\x49\xd5\xbf\xde\x55\x87\xea\x80\xce\x05\x53\x8a\x1c\x83\xa3\x26\xb5\xb9\xf9\x04\xda\x37\x13\xb9\x80\x9d\x20\xa7\x70\x59\xb0\x61\x68\xd0\xa0\xc8\x33\x9a\x2b\x27\x92\x67\x51\x5f\x2a\x99\xbc\x37\xe7\xb1\x34\xa5\x9e\xfe\x10\x67\xd3\xdb\x49\x99\x15\xa1\x47\xe0\xf0\xe4\xd3\x64\x05\xa3\x45\x91\x54\x9c\x7c\x9a\x6c\x04\x15\xe2\x4c\x9b\xdb\x46\x2e\x0f\x90\x04\x6c\x6c\x65\x29\xa1\x8b\x09\x6c\x16\x29\xe0\xf6\x1f\xab\x72\x38\x34\xa8\xb1\x30\x50\x13\x09\x5d\x17\x94\x53\x67\x52\x9f\xac\x45\xf7\x2b\xa0\x66\x58\x22\x04\x27\x01\x82\x12\x1c\x8f\x88\xe7\xbe\x91\xf7\x46\x59\x22\x5d\x03\x91\xe0\xd7\x6a\x73\xc2\x01\x7f\x21\xe1\x67\x66\xae\x98\x06\xdb\xe5\x25\xac\xa3\x47\x7c\xd0\x4f\x43\xd0\x03\x73\xb2\x40\x85\x58\x64\x95\xb8\x97\xb6\x24\x27\x8a\x80\xb1\xb8\xd2\xf9\xb1\x38\x6f\x78\xab\x01\xb9\x8e\x15\x61\x02\x97\x64\x55\xf6\xb9\x9f\xce\xd4\x02\x5c\x40\x15\x39\x41\x32\x53\x00\x0a\x13\xf5\xfc\xb3\x4c\xf2\x58\x99\x63\xb1\xa7\x3e\x97\xdf\xed\x1d\x8a\xbd\xcf\x33\x03\xff\x49\xcb\x99\xd9\x1b\x8b\x8b\x24\x8f\xf5\x54\x97\x8c\x18\x18\x12\x20\xe9\x4c\x15\x90\xe4\x69\x43\x7e\x2c\xec\xf2\x15\x3d\x3c\xef\xa6\x2f\x96\x63\xbe\xce\x66\xda\xc0\xbd\x22\xca\xc4\x3d\x5c\x18\xb0\x4d\x8d\x50\x45\x01\x65\xc5\x75\xda\x6d\x11\x41\x63\x10\xd3\x2c\xc9\x8b\x2c\xd1\xfe\x20\xa5\x0d\x3a\x1c\x70\xf9\x80\xa9\xf9\xc0\xdf\x5c\x16\xa5\xe6\x5d\xb7\x56\x77\x05\x58\x86\x3c\x80\x9b\x74\x2f\x7b\xe2\x62\x26\xb2\x04\xca\x0e\x90\x45\xdb\x07\x0e\xf1\x2c\x43\xc2\x43\xc1\x2e\xa0\x11\xc1\xb1\x33\x6e\x70\xf4\xeb\xac\xa0\x5f\x31\x31\x8f\x22\x75\x77\x64\x22\xf9\xed\x21\x6e\x63\x43\x29\x47\x65\x8b\x0a\xd2\x88\x3d\x7e\x39\x84\x89\xd3\xd0\xc8\x16\x8e\xc3\x69\xa0\x81\x03\xd6\xbd\x96\x09\x09\xa5\x78\x5f\xed\x89\xfd\xac\xc0\x51\x42\x32\x41\xac\xe4\x9d\xa2\x22\x63\x20\x09\xca\x85\xad\xb2\x7a\xc0\xcc\x0f\x0f\x6f\xa2\xc6\x77\xaf\x87\xde\xda\x56\xb9\xd0\xa5\x7e\xed\xc1\x75\x6d\x0f\x56\x7e\x96\xc1\xf9\x08\xe7\x19\xbf\x65\x00\x50\xe7\x03\x8d\xa2\x26\x93\x4e\x49\x83\xc1\xfb\x21\xda\x3c\xed\x6b\xb8\x0b\xfc\x30\x23\x8b\x3d\xbc\xfd\xed\x7d\x41\x61\x17\x22\x51\x78\xb7\xcf\x5a\x8b\xbc\x38\xeb\xb2\x96\x1f\x53\xfd\x4b\xa5\xc4\xc5\x99\x3b\x61\x73\xa8\xaf\x61\x4a\x90\xad\x91\x36\xb7\x81\x99\xd3\x20\x93\x4e\x3e\x4d\xc4\xfe\x49\x22\xff\x95\xa5\xe2\xfc\x87\x09\x0d\xef\xe0\x2b\x21\x3b\x53\x90\xf3\x6e\x1d\xf0\x33\xf2\xeb\xb3\xf1\xab\xcc\x9b\x05\xfc\x95\xff\xaa\x0a\x05\xea\xe9\xf1\x20\x68\xc1\x4f\xdc\x73\xcb\xfa\x30\x7c\x2e\xce\x64\x29\x51\xe9\xdd\x08\x4a\xe6\xc3\x66\x14\x39\xea\xb3\xd7\x3a\x8d\xe8\x57\x0d\x6d\x76\x23\x5c\x98\x5a\x39\xc5\xf3\xf9\xe6\x6d\x16\x75\xd2\x2d\xff\x0a\x0a\xda\xa9\xc5\xc0\x90\xfc\x63\x01\xf6\xbf\x43\x14\x47\x02\xa4\x22\xcf\x19\x20\xec\x03\x9f\xc0\x52\x35\xde\x01\x8f\x41\xb9\x0b\x73\xcb\x75\x21\x2d\xcd\xf2\xaa\x61\xf7\x83\x15\x42\xc7\x0c\xe0\x85\x65\xd2\x5f\xc7\xd9\xb5\xa0\x3d\xb6\xab\x19\x7e\xfc\x70\xd1\x71\x82\x1f\x3f\x5c\xac\x9d\x1c\x0e\x9b\x05\x29\x76\x39\xb9\xce\xd7\x9f\xe5\xdb\x4f\xad\xa7\x51\xa2\x3f\x0b\x12\xc2\x7f\x6b\x8d\xd8\x22\x6d\xb8\xad\x30\x71\x5d\x50\xfd\xf9\xe7\xf1\xd3\xde\x53\x76\xb1\x4a\x50\xb2\xaf\xc3\x1a\x0d\xcf\x3f\xe7\x58\xf4\xdb\x65\xd1\x4e\xe6\x12\xb2\xca\xc3\x9a\x5c\xd2\x0e\x03\xde\x35\x10\x5b\xec\xd8\x51\x48\x5b\x4c\x4d\x88\x33\x65\x63\xc1\xa1\x34\x1c\xf6\xae\x08\xc5\x5d\x0f\xfb\x56\xa6\xf2\x06\x86\x8b\x27\x09\x13\x33\xb1\x0f\x35\xf6\xdb\xbe\x35\xdd\xa7\xfe\x57\xf2\x4e\xea\x98\x12\xc6\x98\xa8\x46\x81\x89\x91\x14\x35\x54\xf9\x0c\x92\x72\x27\x22\x75\x0b\xc5\xb7\x69\x24\x45\x55\x52\xec\x03\xda\x11\xba\x2a\x0e\xc6\x5e\x9b\x65\x21\x0b\x81\xe1\x28\x18\xf5\x63\xd5\xe7\x96\x42\x4c\x91\x4a\xcb\xfa\xf0\x78\x07\x0a\x64\x88\x2a\xe3\x0e\x24\xe6\x57\x3f\x7e\xb8\xe8\x5d\xe9\x01\xd9\x78\x3c\x08\x5a\xb8\x13\xf7\xdc\x5a\xa5\x07\x00\xc5\xc4\x96\x30\x7c\xde\x7a\xcf\x0b\xef\x2e\xf3\xae\x2b\x38\xd4\x51\x49\x72\x71\x44\xa0\x24\xb9\x22\x3c\xcd\x86\x8e\x2c\x44\x41\x7c\x34\x21\x11\x4b\x65\x30\xad\xd7\x15\x74\xe2\xb0\xe6\x07\x6c\x49\x86\x42\xb2\xe3\xc4\xf1\xac\x12\xac\x9d\xbc\xd3\xab\x51\xbd\x7a\x9c\x2f\xbb\x09\xf7\x29\x53\xa6\x2a\x9f\xcf\x18\xbb\xaf\x45\xbe\x53\x95\xcf\x5f\x4f\x5a\xd2\x04\x3f\x13\xaf\x27\x5e\x3e\x6c\x44\x14\xb5\x04\x41\x2b\x34\xae\x27\x20\xd9\xea\x55\xb1\x9e\x29\x48\xad\xea\x59\x86\x24\x59\xaa\xcb\xac\x30\x1d\x98\xa6\x51\xdf\xec\x2d\xa1\x80\x01\x1c\x2a\x9d\xc4\x50\x61\x92\x53\x64\x82\x9c\xf6\x33\x4b\x2e\x37\x98\x86\x69\xc0\x5f\xfa\x15\xd9\x0d\xc7\xb7\x7f\xc4\x6b\x3f\x5d\xf0\x8f\xec\x82\x1d\x7d\x38\x3f\x39\x7b\x7b\x3e\x4e\xa2\x6f\xe6\xd9\xfd\xa8\xcc\x46\x95\x51\x23\x5d\xf2\x74\x06\x86\x53\xad\x23\xf3\x73\x3d\x7f\xf5\x9f\x9c\x15\x3c\xb0\xba\x18\xef\x5d\xfd\x26\xf1\x11\x0a\xfd\x49\x1b\x15\x49\x4e\x6b\xf4\xdb\x72\x6f\xb1\x85\xa4\x8c\x5b\x49\x56\x74\x68\x0c\x85\xeb\x53\x16\x4a\xf9\xc2\x81\xb0\xd6\x47\xcf\x4d\x27\x6b\x90\x61\xc3\x11\xc7\xc2\x16\xf5\x29\xc6\x39\xe2\x98\x98\xcb\x07\xe1\x53\xb3\x7b\xb7\x33\x95\xa7\x68\x3d\xb6\x22\x13\x8f\xe3\x82\x76\x73\x6e\x99\x5c\x74\x28\x40\x89\x3c\x60\x21\xcc\x9f\x87\x88\xd8\x36\x2f\xaa\x72\x8a\xd2\xe0\x08\x02\x52\xc7\x76\xd0\x4c\xf0\x2f\xb2\x00\xec\xfd\x60\xa7\xd2\xb1\xda\xef\x32\xf9\x3f\xa8\x19\x50\xdf\x87\x92\xb0\x20\x7d\xac\xaf\xac\xca\x39\x04\x4c\x4f\x31\x44\xd0\x29\x49\x2b\xeb\xc1\x04\xd5\xc6\x75\x4f\x7d\xe2\x05\x08\x3b\x23\xf9\xa1\x77\xeb\x97\xa0\x69\x77\x7b\x29\x6d\xf8\xd4\xa5\x0d\x03\x94\xbf\x90\x74\xab\x87\xf7\x99\xcb\xbd\xc2\xe5\x96\x51\x86\x41\xa2\x45\x00\x9f\xb5\xc4\x1a\xe4\x9c\xa4\xcf\x5a\x40\x85\x28\xf8\x4e\xc3\x1b\xf4\xb8\x6a\x53\x9d\x46\x9c\x35\x6b\xaf\xd7\x29\x3e\xd5\xd6\xd9\x2d\x12\x39\x68\x7c\x5c\xcf\x46\x60\x28\x2b\x4d\xb7\x7d\x15\x81\x29\x80\x62\x80\x6c\x04\x90\x0b\x1f\x08\xdc\xf1\x0f\x2d\x73\xb2\x30\xbf\xc4\x23\x3b\xd2\x51\x1e\xd5\xeb\x3c\x1c\xf4\x2b\xf5\xfa\x0b\xd6\x79\xbe\xe6\xea\xaf\x2c\xb8\xa6\x47\x5e\x78\x51\xcb\x7b\x51\xcb\x7b\x59\x81\x50\x4d\x7c\x6b\x4d\x10\xb3\xc0\x70\x05\x7c\x3d\x6d\xf6\xf9\xd8\x88\x32\x07\x55\x3c\x97\x85\x4c\x20\xd3\x03\x4f\xb9\x88\x12\x34\x52\x35\x45\xc3\xe7\xfb\x5c\xa5\x93\x52\x4e\x6f\xc7\x2f\x7a\xd9\x8b\x5e\xb6\x95\x5e\xb6\x4d\x54\x0b\x1d\xe8\x3a\xf2\x3c\xaa\x31\x8b\x94\x82\xc7\x82\x82\xd0\x74\x4a\x6a\xc2\xd3\xc9\x87\x1d\xe8\x61\xbb\x88\x41\xf1\xed\xf9\x8e\x07\x41\xcb\xe3\x3b\x8d\x2d\x29\x63\xfe\x63\x66\x28\x23\xa5\x6a\xe4\x59\x5e\xc5\x50\x55\xa5\x84\xd4\x27\xe6\xda\x86\x09\x1e\x52\xcf\xbb\x06\x9c\xd4\x82\x38\x81\x0e\xd2\xd7\x50\x51\x98\xea\x12\x66\x29\xe4\x76\x07\x74\xcf\x46\x0d\x09\x82\x2c\x69\x4c\x5e\xc7\xc2\x4e\xa1\x90\x99\x7a\xad\xca\x7b\xa5\x52\xf1\x8a\x89\x08\xba\xec\xab\x3f\xfc\xe1\x0f\xed\x2c\x90\x57\xdf\x7f\xf7\xdd\x58\x9c\x61\xf5\xe2\xac\x80\xae\x24\x70\xab\x64\x37\x6f\x72\xc6\x1e\xcc\x55\x81\xb4\x3b\x39\x9b\x59\x6f\x39\x2a\x72\xda\xb8\xb3\xda\xd6\x35\x64\xa2\x26\xfa\x66\x8e\x55\xe2\x61\x53\x66\xe9\x2c\xd6\x53\xa8\x5e\x06\xcd\xa6\x50\x18\xba\x3a\x0c\x01\xb1\xb0\x76\x5c\x3e\x7a\x18\xe3\x81\xa0\x05\xd1\xad\x12\x33\x5b\xd5\xef\xd0\x05\xea\x33\x11\x0b\x65\xe0\x2a\x47\x9d\x50\xed\xed\xb9\x5e\x77\x28\xc2\xf7\x5c\x82\x40\x03\xcc\xe0\x2d\x86\x5e\x2e\x81\x81\x15\x8b\x6e\xd5\x62\x84\x91\x10\x22\x97\xba\xe0\x0b\x57\xa0\x3c\xc6\x9d\xb5\x5a\xe1\xd1\xf1\x3c\x55\x51\xdd\xb0\x93\x89\xe9\x0a\x69\xe4\x45\x06\x27\x2d\x66\xa8\x93\x35\xcb\x5d\xf4\x0c\x5d\x35\xb8\x88\xf3\xcc\x90\xa3\x90\xae\xf9\x60\x99\x04\xae\xa0\x62\x0d\xee\xf6\x8f\xf3\xe7\xaa\xb4\x90\xca\x5e\xd3\x10\x1e\x8f\xb5\x81\x1d\x72\xab\x16\x66\x75\x16\x4c\x54\x3f\x57\x8f\x8d\xd9\x72\xc6\x72\x71\x95\x36\xde\xc1\x44\x44\x7a\x52\xef\x57\x12\xd7\xd4\x99\x18\xa8\xa0\x7d\x79\x15\x68\xf7\x0b\x99\x8b\xdc\x81\xda\xea\x80\x84\xe8\x38\xc1\x2f\x76\x33\x3e\x9b\x89\x68\x54\x59\xe5\x96\x70\x98\x16\x20\xaa\x14\xdb\xfc\x6a\xb4\xab\x24\xb2\x80\x62\xaa\xae\xed\x05\x77\x95\x20\xed\x8d\x12\x6a\xb1\xf1\x32\xd4\xac\xbf\x53\x54\x9e\x75\xd1\x4a\xd0\xe6\x8b\x88\xe1\x78\x3c\xc4\x65\x81\x6c\x55\x5b\xdf\x10\xa5\x18\x7c\x3e\xee\xdf\x83\xd5\xda\xbc\x6f\x65\x0e\xec\x4f\xbd\x9f\xa9\xdd\xac\x6c\xa6\x54\x32\x51\xe1\xbe\x7e\xc7\xcc\x27\xee\xaa\xed\x07\x36\xd9\x59\x9a\xea\x15\xed\xd4\x32\x73\xdb\x88\x3b\xd2\x60\x5d\xcc\xfd\x24\x6c\x15\x21\x4c\x51\x08\x80\x84\x2e\x7d\xf6\x88\x05\x11\x77\x28\x92\xf5\xfa\x41\x10\xe2\xab\x86\x96\x70\x31\x43\xae\xf7\xbb\xbe\xc3\x4e\x5d\xd1\xaa\x96\xaa\x1f\x81\x4a\xe0\x0f\xfb\x20\x4c\x86\x62\x40\xaa\x48\x10\x2c\x47\x3d\x80\x20\x78\xbe\x2b\x84\x54\xe8\x6d\x95\x84\xae\xaa\x42\x37\x85\x21\xd4\x63\xbd\x86\xc7\xaf\xe6\x0d\x29\x9a\xb7\xfa\x84\xc6\x2a\x6c\x4d\x32\x91\xe0\x65\xc1\x6d\x72\x5b\x86\x93\x4e\x2a\x99\x0a\x79\x6d\xb2\xb8\x62\x55\x11\xa8\x7f\x60\x48\x35\x50\x43\xb4\x5b\x09\xa9\x62\xc5\x6c\xe3\x5f\xff\xa0\x54\xf7\x90\x0d\x71\x0f\xa8\x24\x87\xf9\x92\xbf\xb3\x70\xe2\x5f\x17\x43\x3b\x1f\xc1\x77\x43\x3c\xbb\x61\x86\x80\xd0\x80\x0a\xbe\x85\x68\x27\xd6\xa1\x2f\x62\x19\xe2\x5b\x85\xac\x0f\x8e\x09\xcb\xb7\x08\x05\xf2\x63\x58\xf3\xb1\x8d\x8d\xc7\xbc\xd6\xc8\xef\x3d\x46\x7d\xc7\x4c\xa7\xc6\x63\x61\x66\xdc\x00\x7e\x9f\x1a\x7d\x3c\x08\x22\xc7\xe9\xe4\x42\xec\xd7\x8d\x5d\x5d\x10\xe2\x45\x5a\xaa\x62\x26\xa7\xea\xa0\x61\x64\xd9\x88\xec\xb3\x21\x7c\xae\xfb\x5c\xa6\x51\xec\x32\xff\x85\xfa\x0c\x9d\x40\x65\x8c\x6f\x8d\x0a\x7d\xc7\x3a\x35\xf6\x4f\xe2\x7c\x2e\x5d\xff\x81\x83\x71\xdf\xc6\x19\x1c\x47\x07\x4e\x3a\xc3\x07\xdd\xdd\xcd\xc5\x81\xc2\xbf\x83\xa6\xe7\xab\x25\x58\x5a\x99\xa6\x1d\x6a\x0c\xb7\x57\x34\x07\xa0\xc0\x5f\x64\x15\x17\xd1\xba\x81\xeb\xb6\x15\x05\x18\x62\xec\x1d\x54\x42\x34\xc7\x0d\xdc\xe3\x20\x5d\x22\xc8\x24\x03\xe5\x37\x4a\x5e\x3d\xcf\xe0\x3d\xbd\x83\x6c\x97\x46\x7e\x09\x0b\x56\xac\x38\xf4\x66\x74\xc9\xcc\xee\x74\xe4\x54\x55\x0c\x41\x61\x96\x2c\x26\x61\x61\x44\x2e\x0d\x99\x92\x01\x42\x1a\x93\x4d\x35\x98\xed\x1a\xbc\x12\x74\xfd\x45\x85\xd7\x97\xcc\x6a\xa5\xaa\x36\x9d\xa0\x19\x96\x2a\x5d\xec\x64\xc1\xa0\x8b\xff\x65\x75\x1d\x6b\x33\xf7\xa1\x42\x1d\x96\xef\xdd\x1a\x18\xd8\x54\xb2\x6b\xcc\x51\xcb\x43\xe5\x54\x30\xf0\x3d\x19\x95\x1a\x8d\x8a\x23\xb8\x6b\x8a\x84\xd7\xd7\xcc\x63\xc3\x12\xba\x77\x34\x56\x0d\x3d\x58\x60\xc4\x47\x13\x32\xfe\x8a\x89\xd9\x98\x39\x95\x5d\x81\x5b\x12\x7c\xfa\x31\xcd\x5b\x9f\x4f\x65\x1c\x33\x03\xe0\xc5\x52\x71\x31\x77\x54\x5a\x03\x8e\x2b\xc9\x62\x79\x98\xd1\x06\xc1\x2d\xb5\xf3\xfb\xd5\x3d\x64\x6c\x95\xd9\x15\x82\x33\x11\x69\x59\x8c\xed\x99\x87\x8d\xf2\xb2\xd4\x41\x1d\x62\xd5\x5c\x82\xf5\x5c\xc0\x55\x66\xc0\x64\x0c\x6b\xc5\xcd\xf7\x7a\x71\x22\xbe\x38\x11\x1f\x70\x22\x6e\x11\xc2\x50\xb7\x63\x6f\xf4\x1d\x22\x27\x15\xa7\x31\x68\xfd\xc7\x1d\xe2\x4e\x25\xe8\x23\x22\x22\x34\x90\xc0\xbe\xfb\xa4\x2c\x0b\x7d\x5d\x95\xdc\x9d\x22\xa3\x08\x8b\x6c\xc8\xf8\x32\x78\x8f\x05\x2f\x6d\x8b\xfa\x7f\x5f\x1a\x2f\x2a\xa6\xca\x90\xcc\x1e\x91\xf1\x69\xca\x1c\x4b\x2d\x21\xc8\x02\xe4\x25\xcc\xea\x79\xc0\xa3\xbf\xf0\x8a\x1d\xe8\x74\xf4\xe8\xd0\x88\x28\x9b\x56\x60\x20\x90\xbe\x9a\x88\x0f\x61\x62\xe2\xa2\x5e\x62\xc6\xfd\xef\x06\xfe\xfd\x7f\x44\xf3\x19\xf4\xf8\xfe\x28\xbb\x4f\xef\x65\x11\x9d\x5c\x32\x92\xbd\x5b\xac\x70\x56\x3f\xd9\x74\x18\x3b\x40\x71\x72\x79\x21\xe4\x75\x56\x95\x03\xa6\x5b\x32\x83\x2a\xa2\xb2\x7c\xf1\x1f\xbf\xf8\x8f\x5f\xfc\xc7\x5f\xc4\x7f\x0c\x7e\x67\x7b\x45\x00\x77\x24\x68\x53\xad\xdd\x1c\xe4\x4b\x60\xdb\x8d\xbb\xbb\xcc\x1a\x12\xc8\x9e\x4b\x4b\xb9\xc4\x4c\x4c\xd1\xbc\xb6\xc0\x1e\xb1\x3b\xb7\xe6\x9d\xfa\xaa\xc3\x46\x74\x02\x0d\x55\xb7\xe0\xd3\x90\x4b\x0d\x10\x1b\x2a\x8e\xd8\xf7\xc3\xc7\x93\x07\x27\x0a\xb2\x05\x61\xf5\x43\x46\x4d\x86\x43\xaa\x52\x94\x45\xc7\x02\x4b\x0f\xc8\x34\xcd\x4a\xa4\xa8\x39\xb4\xed\x4e\x4c\x88\xd2\x2a\xc8\xa4\x92\x46\xf8\x0f\x93\x4b\x28\x64\x55\x34\x22\x8f\x99\xbb\xad\x3b\x69\xe1\xa7\xd6\xb8\xc3\x9e\x5b\x22\x32\x61\x38\x2a\x99\xe9\x5c\x25\x32\x40\xf4\xd4\x3f\xaf\x61\x65\xc0\xf7\x0d\xdb\x14\x14\xd3\x52\xa5\xe8\xa3\x57\x45\x62\x44\x36\x0b\xa3\x71\xe3\x0c\xc4\x93\x6b\xef\x8e\x5f\xb1\xad\xb3\x3e\xe9\x7e\x66\x6e\x2a\x5b\x91\xf6\xb2\xe5\xaa\x82\xbb\x39\xd4\xa9\x40\x4e\x0e\x84\xf5\x51\x2f\xde\x83\x6a\x85\x9e\x5d\xbb\x27\x22\x0b\x5f\x19\xac\xff\x8c\x6a\x52\x06\x3c\x15\xa4\xa3\xbe\xb8\xd1\x5f\xdc\xe8\x2f\x6e\x74\x86\x1b\xbd\x71\x9c\x3a\x29\x4d\xc4\xb3\xae\xf5\x00\x5c\x3b\x92\x96\xff\x03\x74\x2a\x4a\x69\xa1\xdb\x85\xbd\x26\x04\xa1\x3a\x47\x3c\x79\xe1\x21\xd4\x89\x14\x1c\x7c\xc7\x10\x5c\xde\xc3\x2e\xbe\x79\xda\x88\x55\x39\x1b\xfd\x51\xa8\x74\x9a\x45\x96\xd9\x41\x30\x17\x81\x83\x04\xa5\xd0\xcd\xba\x1d\x96\x90\xb8\xfe\x1b\xe8\xb0\x0f\x02\xc5\x1d\x63\xe7\x37\x1c\xec\x58\x94\xbb\xca\x8b\xaf\xb7\x56\xd0\x6a\xb5\xcc\x57\x73\xb4\x84\x09\x40\xf4\x76\x61\x55\x90\x7e\xe6\xb0\xa0\xa0\x45\x02\x9b\x12\xb6\x39\x9c\x3e\xca\x04\x28\xce\xf0\x77\xdf\x02\x8c\xa7\x79\x75\x48\x60\xe3\x44\x25\x59\xb1\x38\xf4\x80\xf0\xcb\x20\xd0\xe6\x68\x08\xed\x00\xf5\xbf\x69\x55\x80\x65\x37\x5e\x3c\xb1\x26\xe8\xe9\xc7\xaf\xec\xf2\xd0\x82\xd6\x5e\x61\xd8\xdb\xc7\xde\x00\x14\x08\x8a\x72\x91\x0e\x26\x73\x48\x67\x80\xc4\xfc\x2b\xa1\xd2\x3b\xe8\x67\x60\x42\x28\xd3\x99\xd5\xe1\x6f\xa4\xef\xb4\xc9\x8a\xe3\xa0\x87\xc0\x46\xb1\x78\x1f\xb4\x35\xe0\xef\xa8\xa3\x60\x6f\x3f\xdd\x61\x92\x0f\x5a\xa2\x41\x4a\x65\x55\x99\x57\x25\x9d\x55\x81\xb0\xfe\xfe\xe4\x2a\xce\xfb\xdd\xe9\x53\x90\xc3\xf6\x24\x79\xd6\xf6\xbe\xdd\x0b\x7c\x2c\x97\x25\x84\x12\x1c\x8b\xff\xde\xff\xc7\xef\x7e\x1d\x1d\xfc\x65\x7f\xff\xa7\x57\xa3\x7f\xff\xe7\xef\xf6\xff\x31\xc6\x7f\xfc\xff\x83\xbf\x1c\xfc\xea\xfe\xe7\x77\x07\x07\xfb\xfb\x3f\xfd\xed\xed\x9b\xab\xcb\xf3\x7f\xea\x83\x5f\x7f\x4a\xab\xe4\xd6\xfe\xdf\xaf\xfb\x3f\xa9\xf3\x7f\x32\x41\x0e\x0e\xfe\xf2\xff\x02\x07\xfa\x79\x54\x7b\x67\x46\x3a\x2d\x47\x59\x31\xb2\x4b\x7a\x2c\xa0\x30\x71\x10\x9c\x23\xf7\xf1\x36\xec\xd0\x38\xfa\x1d\x1e\xaf\xaf\x4b\xfb\x8f\x41\x71\x3f\x7c\xce\xd7\x0d\x37\xbd\xdd\xde\x36\x42\xc7\xf6\x7c\x62\xdd\x02\xc0\xd1\x5f\x7c\xa6\x83\xb3\xe6\xcf\xe9\xb9\xa6\xe5\x5d\x8a\x52\x25\x79\x56\xc8\x62\x21\x22\x32\xf0\x72\x02\xf3\x56\xab\x5c\x35\xca\x5c\xad\xcd\xae\x7b\xcc\x31\x4a\x91\x4a\xae\x0c\xc2\x37\x38\xc3\x48\x17\xc3\x41\xbf\x47\x73\xa2\x22\x5d\x25\xc7\x83\xf0\x7d\xfa\x09\xe6\xeb\xba\x57\xb8\xc0\x2a\x0b\x47\xbe\x07\x16\xa8\x10\xd7\x72\x7a\x6b\xbd\x13\x9e\xdc\x56\xdf\x25\x99\x0d\xda\xff\xde\x5e\x50\xf8\x49\xa2\x64\xea\x9d\x08\x70\xac\x40\x30\x08\xf4\x30\x71\x90\x76\x9c\x41\x6a\x3f\x28\xfb\x29\x45\xd7\x50\x58\xeb\x3e\xc1\x1d\x80\xf6\xff\x16\xd5\x2b\xbb\xd0\x4c\xc8\xa7\x60\x87\x0e\x62\xcd\xe8\x7f\xa9\x1f\x41\x1b\x3d\x1e\xf4\xac\x7d\x74\xd1\x39\x3a\x68\x1a\x6d\x46\xbd\xca\x4a\x19\x0b\x49\x95\xeb\x66\x02\xba\xd4\xc7\x9e\x63\x9d\x7c\x0c\xf2\x76\x6b\x23\xbc\xe8\x70\x7e\x6f\xe0\x58\xa0\x9c\x55\xde\xf9\xad\x25\xb0\x2d\x28\x84\x41\xe9\xa9\xbc\x8e\x15\xbe\xc1\xaa\xea\x9e\x4b\x01\x3a\x91\x9f\x75\x52\x71\x3b\x7f\x54\x06\x76\x63\x96\xb6\x91\xea\x41\xdf\xbb\x36\x60\xfc\x0b\x50\xa2\x53\x18\xc0\x92\x1b\x0d\x36\xd7\xc4\xf1\x4b\x6d\xee\x61\x62\x62\xb9\x03\x67\x19\x31\x55\x02\xa2\x84\x46\x4c\xf7\xa9\x6c\xb6\xd4\xa2\x9a\x89\xec\xfa\x40\xad\x48\x92\x54\xc7\xd4\x22\x8d\x84\x04\xdf\x19\x56\xce\xeb\xc5\x15\x55\x4a\x21\xb5\x2b\xa2\x7d\xfd\x56\x86\xa2\x39\xa3\x9b\x4a\x47\x5d\x37\xf1\x33\xd7\x29\xb7\xd6\x24\x03\x8e\xfb\x19\xa3\x69\x76\x4b\x06\xbc\x3e\x6d\x9f\xf0\xaf\xf5\x75\xa1\xc4\xe9\x5c\xa6\xa9\x8a\xfd\x35\x81\x7b\xc8\x3f\xd8\x24\xab\x5d\x21\x07\xac\xa1\x2c\xc8\xf5\x3d\xb2\x7a\x3e\xe8\x7f\x0b\xf5\x6f\x9e\xb8\x5c\xbb\xad\x56\xc1\x44\xe4\x37\x77\xe2\x36\x7a\x84\x9f\x27\x6d\xee\x14\x57\xe9\x76\xf1\x1d\xaf\x4f\x45\x29\x8b\x1b\x55\x8a\xb8\x4a\x45\x5a\x25\xd7\xaa\x78\x2e\xde\xfe\xdf\x5a\x39\xa0\xe1\x4e\x02\xf1\xec\xf2\x7e\xfa\xf4\xce\x6c\x47\xc7\x9a\x53\xee\xb3\x22\x8e\xee\x75\x64\x53\x18\xb8\x4a\xd5\x3e\x0c\xe1\x60\xd8\x7f\xe4\x44\xb0\x2e\x1a\x96\xdd\x75\x7f\xaf\xa3\xed\x49\x67\x15\x0c\x4b\x3a\x81\xb4\xa3\x02\x31\x9a\xaf\x40\xed\xe3\x50\x0e\xc4\xb9\x46\xdf\x18\xfe\x1f\x5c\x73\xa6\x59\x72\xad\x53\x0c\x49\x00\x6d\xad\x5e\x70\x26\x2e\x68\x7b\xb0\xfb\x9d\xc3\x11\x1b\x56\x43\x13\x59\x74\xa8\x40\x47\x7b\xa3\x93\x2a\x2e\x65\xaa\xb2\xca\xc4\x8b\xf1\xd7\xb6\x88\x21\x7a\x4c\xac\x3e\xdb\x90\x9b\xe3\x41\xd0\x72\xbf\xf6\x0f\xb6\xf5\x9a\x1b\x95\xaa\x42\x4f\x89\x01\x06\x7c\x93\x9d\x57\x6c\x30\x97\x04\x3c\xf5\x2a\x3a\xf2\x4a\x8e\x6d\xc2\x8b\x19\x52\x8a\x13\x94\x7a\x2d\xc1\xfa\x9a\xc7\xd5\x8d\x4e\xfb\xd6\x63\x76\x90\x0f\x15\x96\x0b\x65\x8d\x0a\xfe\x1e\x18\x52\x23\xe0\xcb\xa7\x1c\x7d\x35\x1a\x5b\xe3\xd6\xc6\x04\x6d\x0c\x35\x52\xb9\x4a\x23\x03\x21\x0e\x8d\x9d\x62\x79\x61\x27\x2b\x45\x91\x03\xcf\x3d\xec\xbc\x71\x4a\x9c\x7f\x2e\x0b\x28\x4a\x95\x24\x20\x90\x69\xfc\xcc\x41\xe8\x19\x78\x9c\x82\x34\x88\xa7\x48\x69\x78\x51\xc3\xbc\x1a\xf6\x8c\x4a\x6c\xb7\xb2\xaf\x76\x94\xee\x66\x4f\x1a\xda\xe0\xc6\x45\x06\x35\x52\xc9\x98\xb0\x7a\xd6\x48\x25\xa3\x11\x37\x2b\x00\x2d\xa5\x94\x31\x51\xdb\x53\xdf\x9c\x52\x16\x9e\x48\xf6\x00\x1d\x78\xbc\xf5\x92\x5f\xf6\x92\x5f\xb6\x36\xbf\xec\xcb\x66\xd4\xcc\xa0\xbf\x29\x47\xd1\x6b\xf1\xd7\x6b\xfb\xd4\x92\xb5\x8f\x3e\xa4\x6b\x91\x53\x6b\x37\x22\x8b\xc7\xac\x7b\x24\x65\x1a\xaa\x06\xcf\x94\xee\x46\x08\x92\xb0\xc8\x60\xcf\x63\x6b\x2e\x71\xad\x40\x28\x16\x55\xca\x0a\x90\x0f\xd4\x99\x65\x29\x4d\xe7\x36\x52\xcd\x2d\x0b\xad\xf1\x8c\x2a\xd1\x7b\x62\xdb\xb2\x24\xaa\x94\xf0\x29\x0b\x57\x88\xd1\x9f\x49\xf5\x4e\x5b\x78\xa0\x4e\x3b\xca\x50\xe2\x12\x5b\x4b\x9d\x66\xa9\xd1\x11\x56\x0b\x90\xe0\xe1\xcb\x0b\x35\x65\xa7\x0c\x05\x6e\x21\x1a\xef\xc7\x8f\x9d\x2a\xb7\xc2\x63\x4b\x94\x24\x36\x42\x87\x02\xf4\x2a\x66\x81\x0a\x57\xf0\x55\x43\xd4\xea\xac\xc1\xe2\x84\xda\xff\xd4\x03\x76\xee\xcd\x54\x5d\xfa\x36\xcb\x1d\x9a\xf8\x0e\xdf\x9c\x9e\xb7\x01\xda\xdb\xf9\xcd\xe9\x39\xb7\x8b\xef\xca\xf5\x76\xbd\xdd\x7e\x69\x6b\x33\x80\xc9\x67\xb5\xd6\x70\xbf\xec\x0f\x0a\xf5\xed\xde\x4c\x55\xdd\xa6\x1a\x5a\x12\x0e\x7b\x16\x07\xfd\xb9\x02\x88\x97\x49\xb0\x6e\xd7\xa3\xff\x4a\xe7\xc7\xe2\x3c\x35\xd0\xa9\x19\x90\x96\xac\xe2\x4c\x60\xe0\x53\xd0\x56\x37\x5c\x54\xdd\x15\x94\x89\xfa\xd5\x95\xd6\xdf\x8a\xdf\x98\xef\xb2\x6a\x10\xdf\xaf\xc1\x84\x05\xef\xc7\xd3\xfa\x35\x72\x59\x94\x98\x08\xde\x65\x4b\x80\xa1\xc2\x03\xb8\x49\xf7\xb2\x21\x1e\xee\xf5\xcf\xc4\x74\x50\x90\x54\x4a\x23\x82\xb3\xb7\xd9\x29\xe2\xb5\x8f\x7d\x65\x62\x1e\x45\xea\xee\xc8\x44\xf2\xdb\x43\xdc\xc3\x86\xca\x55\x95\x2d\x2a\x48\x23\xf6\xf8\x29\x50\x13\x9d\xe8\x58\x16\xf1\xa2\x99\x23\xd2\x40\x03\xdd\xc0\xbd\x96\x09\x09\x11\x43\xaf\xf6\xc4\x7e\x56\xe0\x28\xa1\x58\x71\xac\xe4\x1d\x08\x16\xe5\x84\xe5\xc2\xc6\xf2\xb0\xad\x01\x4f\x2f\xd5\x9f\xc6\xf1\x95\x47\x1d\xf5\xc2\xe1\x47\xd4\x58\x5a\xa6\xd4\xcb\xb3\xfa\xcc\x65\x67\x43\xbf\x39\x3d\x1f\xdb\x36\x7f\xcb\xa5\xec\x5d\xd3\x73\xfc\xc6\x93\x04\x56\x75\x5c\xa6\x40\x89\xb3\x8d\x95\x8b\x65\x95\x62\xe1\x0a\x67\xbb\x5a\xb1\x4a\xad\x1a\xd0\x9e\xf7\x2e\x09\xb3\x8f\x85\x5c\x6c\xed\xee\xe8\x55\x3d\xd6\xe5\x07\x95\x33\x4e\xf4\xf6\xb2\xbf\xb1\x8f\xb5\x55\xe1\x1b\x5d\xc2\x07\x99\xd1\xcc\x20\x55\x59\x42\x58\x14\x88\xd6\x69\x15\xcb\x42\x14\xca\x7a\x7b\xc6\xe2\xec\xfc\xf2\xc3\xf9\xe9\xc9\xd5\xf9\xd9\xb1\x70\xef\x62\x9d\x34\xf5\x85\x6b\x2c\xae\xb0\x14\xb1\x85\x14\xd2\x99\xbc\xc0\x7d\x08\x39\x43\x38\x5e\x06\x22\xcc\xe8\x90\x8e\x2e\x99\xd6\x41\x6b\x58\xfc\x5b\xa6\xe2\x22\xd5\x65\x9d\xfd\xc1\x3c\x64\xa7\x71\x96\x52\x8e\x01\xe0\x93\x4f\xeb\x46\x97\x78\xea\xa4\xf4\x3a\x38\x1f\xdc\xfb\x06\x01\xe5\xc8\x2f\x6d\xb8\xaf\x9f\xf0\xb8\x6f\x9d\xdd\x87\xc6\x76\x10\x19\x57\xe8\xa3\xad\xa3\x6b\x51\x62\x93\x2f\xa8\x51\x06\x96\x05\x2c\xd6\xd5\xf4\xc6\x6a\xef\xc3\xf1\xd0\x29\xde\xb1\x4b\xf8\x64\x42\xd2\x91\x5f\x0f\xd0\x25\x7a\x02\x69\xdb\x3c\x3e\x16\xe2\x3d\x38\xa4\xef\xb5\x51\x5c\xf3\x9a\x5e\x29\x45\x4f\x2f\xc4\xd7\xd0\xec\xdd\xbb\x98\x98\xf5\x88\x9c\xce\x67\xaa\xeb\xe6\x04\xa8\x00\xee\x8d\xbe\x63\x67\xd1\xe2\xb2\xec\xe6\xb8\x71\x83\xed\xc0\x3d\x1f\xea\x99\x7e\xfc\xf0\xe3\x6e\x86\x67\x25\x46\x87\xc1\x9d\x66\x09\xc4\x63\xce\xa5\x99\x93\x4b\xb6\x99\x78\xee\xa5\x5b\xff\xa3\x0e\x39\x43\x6a\xf2\xf7\x7a\x8e\xd8\x62\x97\xc1\xdd\xbd\x87\x6f\xdc\x83\x4b\x66\x15\xff\x31\x8a\xc2\x01\x33\x83\x9c\x6e\xd6\x8c\xec\x87\x41\xa8\xde\xb6\xdc\x7d\x88\x94\x84\xa3\x1b\x37\xd2\x90\xfe\x43\x61\xe2\x56\xa5\x91\xed\x2a\xd6\x81\x27\x87\xe7\xee\xe1\x77\x8d\x9e\x1a\x0e\x11\x85\x2f\x0b\x94\xa2\x4d\x23\x55\x4a\x1d\x9b\xc6\xfa\x94\x59\x9e\xc5\xd9\x4d\xab\xcf\x2c\x13\xb1\x03\x69\xbf\xb1\x39\xd2\x23\x39\x82\x55\xed\x7f\x27\x85\xa4\x8a\xb7\xe9\xec\x52\xc3\x81\xbc\x35\x75\x48\xb6\x03\xe8\x16\x4a\xeb\xb3\x22\xd0\xce\xae\x0b\x9e\x6a\x61\x07\xb5\xcd\x9d\x77\x1d\x42\xf1\xac\xab\x6b\x08\xe6\xaa\x48\xb4\x01\xb1\xcb\x2e\x09\xba\x7a\xdb\xf8\x52\x4b\xb2\x8b\x2b\x84\x97\x25\x83\x9e\x72\xef\x02\x0e\x09\x90\xcd\xbc\xe2\x30\x6d\xae\xf9\x2b\x3d\xd7\x3e\x22\xf2\x42\x8d\xd4\x67\x6d\x20\xde\x81\xdb\xd1\x20\x2b\x1a\x8a\x67\xf3\xc4\x20\x8b\x9e\x37\xcd\xdb\x6f\xb1\xae\xae\x4b\x46\x77\xd2\xe0\x54\x51\x3b\x53\x30\x10\x4e\xc6\xf1\x02\x82\xb5\x38\x9e\x20\x50\x1e\xc8\xb2\x2d\x6f\x70\xba\x59\x41\xee\xdd\xbc\xd0\x77\x3a\x56\x37\x60\xac\x9d\xeb\xf4\x86\x9d\x33\x02\x0e\x7a\x19\xc7\xd9\xbd\xa2\x72\x3a\x6a\x65\xf6\x20\xc2\x4d\x59\x5f\x94\x38\x17\x2e\xdc\xc3\xef\xde\x5f\x89\x54\x01\xf0\x5c\x9b\xf1\xb6\xd7\x6e\x18\x12\x33\xed\x73\x34\x1a\x61\xdc\xfb\xfe\xff\xc0\x7d\x2a\x8a\x0f\xc4\x27\x45\x23\xc9\xc0\xf6\x53\x16\xd8\xb5\x6c\x9e\x61\xd9\x12\x5e\x51\x1a\x78\x7d\x83\x4b\x50\xb0\xd8\x52\x0a\x84\x73\x04\x58\x70\x55\xe1\xaa\x26\x2d\x44\x28\xcf\x87\x55\xc5\x5d\x04\x50\xef\xb7\xb3\xad\x4e\x30\x32\x9d\xad\xdd\x25\x5c\x09\x4a\x71\x29\x39\x9d\x88\x52\x98\x45\x12\xeb\xf4\xf6\x50\xe8\xd2\x09\x7d\xe0\xc4\x80\x4b\x19\x3c\xee\x76\x58\xa1\x64\xec\xea\x93\xf4\xc3\x69\x3b\x39\x27\xcb\x8e\x6e\xad\x2b\x70\x1a\x81\x04\xf0\x62\x8f\x42\x06\xdd\xc1\xc4\xc2\xc4\x70\x81\xbd\xbd\x67\x4c\xa1\x90\x53\xab\xef\x93\x48\x9b\xf0\xee\x0f\xc3\x8b\x09\x54\xae\x6d\x9e\x41\xa9\xb0\x9f\x91\x5b\xb8\xa7\xc4\xad\x7e\x33\xb7\x42\x94\x17\x24\x4b\xad\xb8\xf4\x2d\x98\xa6\x73\x99\x9f\x54\xe5\xfc\x4c\x9b\x29\xb4\x65\xe9\xa2\x42\xba\x56\x24\xe4\x52\x15\xda\x2d\x80\x45\x14\xa7\x7f\x3d\xb9\x64\xa1\x0a\x21\x2b\xa0\x59\xa9\xa7\xfc\x60\xbf\x30\xbd\xac\x9e\xf1\x44\x99\x8e\x36\x85\xf5\xf3\x25\xbc\x67\x36\xdb\x17\x57\xfe\x8b\x2b\xbf\xe1\xca\x47\x69\xf2\x5b\x73\xdf\xeb\x54\x97\x5a\x96\x59\xd1\xd1\x8f\x79\x5a\x99\x32\x4b\x68\x9b\x5f\x38\x30\x01\x68\x18\xf0\xdb\xc2\x67\xc1\x8b\xa5\xbe\xa1\x70\x41\xc6\xa5\xf1\x7d\x8b\x96\x92\x9c\xb8\x96\xec\x54\xdd\xd3\x38\xb5\x47\xfa\x13\x25\xca\x41\x15\x2f\x19\xff\xf9\xf8\x4f\x41\xfe\x7c\xb0\x46\xfd\xd9\xdb\xdb\xa9\x1c\x9e\x37\xa5\x52\xef\xfb\xdd\x98\x50\x85\xd0\xbf\xa4\xdd\xdd\x19\x96\x0e\xff\x51\xc9\xd8\x1a\x7b\xdf\xed\xca\x7a\xde\x5e\xb9\x0e\x03\x76\x9c\xe5\x56\x0c\x46\x6a\x15\x92\xca\xc0\xd5\x84\x77\x34\x08\x5a\xf9\xb2\x90\xa9\x81\xc5\x6e\x57\x24\x1f\x52\x78\xc8\x50\xec\x97\xd3\xfc\x60\x27\x94\xe8\x96\x00\x6c\x87\x4d\xab\xf6\xa3\x4f\xfc\x1d\x3f\x97\xf8\x07\xdc\x37\x66\xdb\x89\x5d\x22\x8c\xf8\x51\x9b\x12\xcc\x10\x8a\x70\x59\xa8\x28\x2f\x94\x4d\xb4\x04\xff\xe6\x25\x38\xd9\x74\xfe\xb3\x8c\xa2\xe2\x18\x70\x84\x76\x85\xa1\xd9\xb5\x1a\xb5\x21\xf3\x05\x36\xaf\x71\xb1\x43\xfb\xe5\x22\xd7\xd0\xae\x67\x21\xae\x4e\x2f\x71\x8c\x46\xfc\xf1\xfb\x90\x62\xf8\xff\xf6\xfb\xef\x5f\x1d\x8c\xbf\xb2\xa4\xcc\xad\x6c\xa8\x8f\x9a\x50\xdd\x2f\xbf\x50\xc4\xc5\x53\x66\x06\x81\xea\x4b\x39\x41\x40\x00\xe2\x7f\x3a\x7e\x78\x57\xa6\xd6\x79\xdd\x45\x41\x0e\xbb\xfe\xb8\x13\x8e\xfb\xdd\x97\x6c\x95\xaf\x36\x5b\xc5\xd5\x1e\xb0\x72\xf8\x78\x10\xbc\xd8\x6b\xa4\xb9\x15\xe4\x24\xd9\xd9\x61\x97\x5c\x41\xce\x0f\xe4\xfc\xe2\x82\x7c\x67\x56\x26\xfd\xcb\xe6\x6d\x3f\x82\x6a\x21\x8c\x6f\x35\xd7\x7f\xd0\x23\x6f\xf1\x04\x48\x5b\x70\x58\xd9\x0e\x21\x41\x8d\x58\x1b\xa8\x20\x27\xce\xde\x4d\x7e\xfe\xf1\xe4\x87\x73\x4e\x24\x05\xac\x52\x65\x83\x2c\x41\x12\xe8\xf4\x51\x13\x53\x3f\x92\x65\x38\xe8\x91\x19\xd2\xf0\xe8\x84\x77\xaf\x27\x4b\x06\x3f\xf8\x84\x8a\xb7\xd5\x86\x71\xae\xc9\xef\xa1\x90\x84\x6d\xad\xb4\xe9\xcc\xf4\x6d\xab\xdb\xca\x89\xe0\xec\x9b\xea\x73\xdb\x56\xf2\xee\xf5\x84\x05\x09\x9a\x41\x71\xa7\x8a\x60\xce\xea\x44\x98\xe7\xe4\x03\xe7\x53\xc8\xd2\xf6\x0b\x79\xbf\x77\xb8\x06\x21\xda\x63\xd1\xad\xf8\xc6\x70\xa2\x8a\x46\xf5\x0d\xd8\xc1\x20\x74\xe0\x78\xbc\xb8\x14\x70\xcf\x51\x86\x7d\x1a\xce\xdc\xba\x3d\x6f\x9e\xed\xdd\xdb\x32\xa2\xf9\xf6\x79\xb8\xd5\xd1\xd6\xf6\xc8\x3a\x8d\xa5\x66\x94\x83\x6d\x2f\xee\xe5\x3a\x10\xfb\xcf\x09\x3a\x68\x1a\x12\x7d\x23\xb4\x68\xb6\xd6\x85\xcd\x26\xc5\x5a\x7c\x1f\x77\xc9\xb3\xca\xf9\xfe\x47\x9d\xb9\xa5\x26\xd5\xc8\x31\x4e\xfd\x91\xfd\x64\x0a\xd4\xeb\xfd\x60\x40\xd4\xae\x49\x1a\xa7\xee\xe1\xe5\xca\x37\x0f\x90\x75\x10\xd6\xe4\x47\x26\xaa\xd9\x5a\xca\x38\x0d\x85\xe2\xab\x4b\x7e\xf7\x3a\x57\x47\xf5\xcb\xaf\xce\xd3\x9e\x4e\x9f\xd6\xdb\x13\x1a\x96\x02\x16\xa6\x58\x6f\x4f\xd8\x9d\x2d\x21\x44\xbc\x79\x0e\xee\x55\x74\xcd\xb3\x32\x4b\xb7\x4a\x35\xbe\x5c\x03\xd1\xd2\x3e\xe9\x1b\x90\x6d\x50\x64\x71\xcc\x33\x28\x7a\x38\x01\x19\x2c\xb5\xcb\x19\xd4\x79\xa7\x36\x64\xa9\x73\x3e\x73\xf6\x47\xd3\x39\xdd\xb3\x70\xe9\xec\x41\x5c\x76\x20\x7e\x85\x45\xa6\x76\xe3\xea\xdb\x85\x7c\xc9\xa3\x4e\x05\x07\x2e\xce\x28\xf0\xc1\x95\x0b\x30\xc4\xd0\x22\x88\xa3\xd7\x70\x75\xff\x93\x0c\x52\x97\xa2\x8b\xb3\x5e\x45\x49\x56\x94\xf7\x59\x41\x95\xc2\x8e\x07\x41\x44\xbe\x6c\x3d\xdc\x16\x1f\x39\xfd\x6e\xc0\x3d\xff\x36\x4a\x8b\xe7\x29\x0b\x26\xf0\x60\x73\xea\xdd\x3d\xfe\x4e\x8e\x38\x31\xf2\x98\x80\x60\xe2\x3e\x1a\x37\xb0\x2a\x20\x78\x3b\x58\x34\x22\x05\xbe\x9c\x60\xd8\x42\xf1\xd8\x50\x24\x2d\x34\x97\xf4\x31\xe7\xc8\x83\x6e\x8f\x1d\xe8\x25\x6e\x27\x75\x12\x98\x7f\xa7\x47\xc9\xec\x16\x2f\x9a\xa2\x53\xfa\xad\xce\x02\x76\x03\xe9\x7f\xd9\x43\x44\xa5\x23\x46\xaf\xe2\xb2\xc8\xc0\x70\xc8\x19\x40\x8b\xba\x17\xe0\x0f\x44\xd7\x11\x54\x3b\xd3\xb6\x00\x9a\x0b\xe2\x33\xae\xfc\x19\xc7\x0f\x31\xcd\xd2\x99\xbe\x49\x64\x6e\x6c\x67\xc5\x66\x83\xe5\x9e\x85\xe2\x76\xdd\xcd\xdf\x3e\xdc\xd2\xdc\x8a\x35\x16\xa4\xe0\xb5\x34\xaf\xfb\x6f\x32\x51\x57\x1b\x98\x3f\xdc\x9a\x9c\x3f\xd0\xd5\x06\xe6\x0f\xb7\x26\x67\xa2\x3e\xd8\xa0\x73\x5d\xd3\x4d\x26\xe6\x03\xad\x39\x1f\x68\xb7\xc9\x04\x85\x92\x4e\xcf\x24\xb0\x81\xb6\x55\x07\xa6\x75\x6d\xcb\x49\x25\xa1\xed\xce\xae\x10\x1a\xe4\xf6\x6f\xbd\xf8\xd2\xbf\xc9\xea\xad\x54\xc3\x91\x06\xc0\xe6\x41\x68\xaa\x92\xa5\x37\xcd\xde\xae\xf5\x9d\x82\x26\x05\x8a\x04\x77\xf3\x85\x09\x8c\xa6\x84\x7a\x2b\x73\xfe\x23\x4b\xc4\x68\xb6\x53\x97\xd7\x59\x55\xba\x90\x2c\x8b\x1b\x00\x6b\x2b\xc0\xc1\x39\x4f\x94\x0c\x78\xb6\xcb\xdc\x83\x99\x60\xed\xfc\x2f\x5a\xda\xd3\xa1\x50\x72\x3a\x17\xb7\x6a\x31\x42\xa9\x17\x88\x2c\x44\x2e\x75\xe1\xcc\x56\x67\xb2\xa4\xf6\xec\x4b\xde\xfd\xa9\x8a\x82\x81\x4f\xdd\x8a\xf8\x30\x3a\xcf\xaf\xbe\x40\x40\x30\x28\x71\xa9\x04\xad\x03\xce\x09\xc8\xa8\x31\x64\xbc\xd3\xa6\x13\xe4\xad\x5a\xa0\xa8\x83\x64\x23\x30\x4e\x90\x3d\x10\xa9\x89\x81\x8e\x35\xad\x83\xb1\x81\x84\x20\x35\x54\x04\x2b\x64\x56\x29\x11\x8c\xe8\x4b\x2b\xf8\x51\xe1\xb1\x44\xe7\x7d\x95\xda\xb7\x05\xc3\xd6\xa3\xa3\x06\xbe\x74\x63\x41\x02\x48\x18\x7c\xf8\x48\x5b\xb1\x9e\xd8\x5a\x08\xfa\x0c\x65\xa5\xc3\x0e\x47\xb4\xc6\x55\xcf\x58\xad\xa2\x05\x46\x95\x55\x1e\x0c\x89\x0b\x82\x95\xb9\x44\x95\xc6\xca\x18\x48\x0c\x82\x83\x59\x16\xb7\xca\x15\x80\x96\xf1\x78\x10\x84\x2a\x84\x00\xef\xa3\xf1\xd5\xfc\x7d\x93\x61\x60\x34\x90\xde\x69\x16\x3e\x7d\xca\x86\xc3\x49\x43\x9b\x61\x5c\xf8\x76\xdd\x89\x60\x4c\xc0\x09\x9d\x5c\x27\x11\xb6\x24\xc4\xde\xca\x1c\xb6\x30\x35\x8c\x83\x2d\xd8\xa1\x99\x25\x18\xc9\x90\x06\xa4\xa3\x49\xe2\x85\xf0\xd5\xea\x2a\xcd\xfd\xe6\xe9\xf2\xd8\x12\x49\x20\xa6\xc6\x12\xc2\x89\x87\xf0\x79\x04\x5f\x99\xb6\x6f\xc2\xff\xc0\x6c\x1a\xe5\xb2\x43\x75\xc5\xe5\x3f\xf5\x0d\x61\xb5\x31\x7f\x47\xc8\xed\xaf\x08\xcb\x7f\x7a\x6b\xec\xbf\xf6\x86\xb5\xa6\xc5\xff\xc3\xa0\xff\xcb\xde\xf5\x35\xb7\x6d\x63\xfb\x77\x7d\x0a\x4c\x7b\x67\x6c\xdf\x5a\x72\x72\x6f\x6e\x27\xd7\x2f\x1d\x27\x4e\x3a\x9e\x36\x1b\xd7\x52\xfa\x92\x76\x67\x20\x12\xb2\xb0\xa6\x08\x96\x00\xe5\x78\x36\xfb\xdd\x77\x0e\xfe\x90\xa0\x44\x52\x00\x48\x39\x4e\x56\x56\x67\x1a\x5b\xe2\xd1\xc1\xc1\x01\x70\xfe\xe1\x77\x3a\xff\xd5\xea\x71\xa8\xb2\xd8\x40\xaa\x5d\x1e\x48\x28\xa3\xa5\xdf\xb2\xe5\x91\x04\x52\x1c\xc2\x8f\xd9\xfc\x01\xbf\xe6\x68\xe4\xfd\x58\x90\xa3\xd3\xd7\xf1\x09\x2f\xac\xe9\x5c\xe9\xb3\xa5\x75\xbe\x39\x25\xec\x9b\x5f\xda\xe2\x04\x95\x01\x93\x7c\x85\x33\xf9\xbb\xda\x12\x43\x97\xe5\x3b\x75\xd6\xc2\x84\x63\x70\x17\x38\x4b\x0a\xe1\x87\x82\xd1\x46\xd2\x3e\x8e\x81\x1e\x22\x09\x59\x85\x98\x35\xd6\x49\x5c\x12\xb7\xa0\xa4\xc2\x15\x5e\x9f\xae\x21\x67\xfc\x00\x47\x89\x7b\x34\x6e\xf3\x67\x1c\x64\x6f\x3a\x56\x8b\xf4\x88\xef\x6d\xbe\xfc\x2a\xed\x7d\x2b\x27\x3b\x96\x5c\x73\x01\xb6\x27\x45\xb4\xbf\x62\x49\x6f\x4e\xf6\x52\x8c\x1d\x5e\x92\xdd\x53\xfb\x8d\xd7\xd0\x6b\x8e\xa7\x1a\x42\xd6\x5c\xbb\xad\x79\x3e\x9e\x84\x25\xdc\x08\x04\x5b\xa5\x9b\x67\x9c\x12\xdd\xa8\xd5\x93\x96\x6f\xd6\xa1\xd7\x3a\x33\xf1\xeb\x8b\xeb\xab\xf3\x51\xa0\x20\x9b\xe3\x45\x16\xe5\x6f\x3f\x62\x04\x14\xc0\x1f\xc7\x65\x08\xf3\xb2\x1a\xfe\xef\x61\x36\x6a\x80\x69\x37\x84\xe3\xb8\xc5\xf8\xdb\x30\x13\xd3\xca\xc9\xda\x0a\x22\x98\x4e\x43\x04\x90\x2c\xad\x17\x6d\x19\x98\x4b\xbe\x50\xf6\x25\x83\x6a\x8f\xec\x96\xca\xef\x74\xbe\xd2\xd4\x29\xf5\xa3\x1b\x73\x98\xa3\x29\x49\x60\xbf\x0f\xa2\x89\xd0\x46\x78\x31\x63\xf1\x39\x92\x05\xc2\x38\x4d\x99\x90\x73\xc0\xfd\xf7\x78\x7d\x0d\x13\xcf\x49\xc2\x4f\x55\x30\x10\xfc\x39\xab\x00\xcf\x09\x66\xaf\xe9\x55\x06\xc8\x03\xcd\xfc\x7e\x53\x08\xaf\xea\xf0\x0b\xa5\xb0\x31\x99\x9a\x9a\x9e\x83\x60\x9a\x08\xf1\x68\x49\x56\x58\x4e\xe4\x5b\xd0\x35\x0d\x3c\xd7\x83\x22\xe4\xd8\x05\x49\xc1\x39\x15\x24\x5f\x71\xc4\x16\xa7\xc6\x0f\x0e\x55\x39\x83\x40\xb3\x76\x87\x5e\x1f\xd0\x16\xa8\x5e\x0b\x23\xa2\x81\xa6\xd1\x06\x49\x0a\xdb\x5e\xaa\x1f\x09\xc3\x05\xeb\xda\xc4\x58\xcb\x38\x46\x0f\xa2\x17\xd7\x57\x68\xad\x74\xed\x0b\x0a\x3e\xdc\x0d\x81\xd7\xb8\x9a\xb6\xa0\xe7\x7b\x78\x17\x87\x60\xdc\x21\x18\x77\x08\xc6\x1d\x82\x71\x5e\xc1\x38\xcb\x50\x33\x30\xb0\xbd\x26\xc9\x0a\xeb\xd5\x1a\x5a\xf4\xd0\x25\xc1\x2c\x48\x15\x5d\xb5\x63\x42\x74\x3a\x3e\x17\x48\x99\xe5\xb5\xa0\xdc\x11\x04\xbe\x8e\xfa\xc5\xfa\xb4\xab\x5c\x88\xc5\xf8\x25\x22\x69\xc4\x62\xb5\xe4\xe1\xc8\xcd\x9d\xae\x5b\x36\xbd\xc0\x11\x32\x92\xac\xcb\x78\x55\x04\x13\xdd\x08\x1b\xaa\xd1\x1f\x8d\xbe\xc0\xa1\x6b\x4a\xd7\xde\x0e\xe8\x80\x68\xb7\x43\x5e\x82\x72\x46\xb7\x6b\x7a\x69\xb1\x6b\x45\x21\xb9\xf6\x3f\x0c\xd5\xd0\xbd\x27\xa1\x2b\x08\xb0\xc0\xa6\x08\x16\x07\xe1\x82\xa3\x63\xf5\xc7\x49\x94\x15\xc1\x3e\x8d\xa2\xb0\x22\x2b\x96\x3f\x9c\x96\xa4\x81\x64\x20\x45\x9b\x43\x4d\xf7\x44\x16\xb5\x45\x45\x0e\x38\x0e\xce\x85\xad\x4f\xcf\x53\x2a\xe7\xd4\xfd\x52\xd8\x6e\xc5\xab\x3a\x6a\x84\x84\x6d\x9b\x2c\x51\x48\xfd\x68\x63\x84\x9f\x96\x25\x06\x3d\x08\x03\x3d\x92\xae\xd1\x1a\xe7\x3c\x4c\xf6\x03\x2c\x7a\xf8\x2f\xa6\x6b\xca\x99\xe3\x45\xd8\xa6\x17\x4e\x1f\xde\x07\x6e\x17\x1a\xec\xa0\xe7\x31\x5c\xa7\xd3\x4b\x18\x1b\x8a\x34\xd5\xc6\x68\x58\x91\x52\xf5\xc3\x0a\x91\x15\x42\x5b\x2c\x66\x37\xd3\x68\x9b\x3d\xc8\x96\xfb\x5f\xe5\x6f\x43\x16\xee\xbb\xe7\xdf\x05\x13\xcd\xb0\x10\x24\x4f\xcf\xd1\xdf\x8f\xff\xf8\xe1\xf3\xf8\xe4\xa7\xe3\xe3\x8f\xcf\xc6\xff\xff\xe7\x0f\xc7\x7f\x4c\xe4\x3f\xfe\xfb\xe4\xa7\x93\xcf\xe6\x97\x1f\x4e\x4e\x8e\x8f\x3f\xfe\xf2\xee\xe7\xd9\xf5\x9b\x3f\xe9\xc9\xe7\x8f\x69\xb1\xba\x53\xbf\x7d\x3e\xfe\x48\xde\xfc\xe9\x48\xe4\xe4\xe4\xa7\xff\x0a\x66\xf9\xd3\xb8\x4a\x80\x8c\x69\x2a\xc6\x2c\x1f\x2b\x25\x38\x47\x22\x0f\xa8\xae\xab\x0b\xf7\x7c\x18\x55\xb2\x8c\x3c\x43\x79\x08\xdf\xff\xe8\xeb\x75\xd2\x7b\x89\xa1\x97\x8f\x1e\xce\xf9\xd7\x92\xaf\x0c\xfa\x42\x75\x67\xe1\x7c\x14\xa8\xe1\xcd\x39\x1b\xaf\xf6\xf2\x5f\x71\xba\xe6\x2b\x29\xf0\xd5\x90\x67\x26\x1a\x72\xa8\xee\x3d\x54\xf7\x0e\x50\xdd\xab\xb4\xca\x8e\xd8\xc1\x9d\x99\x22\x93\x25\x70\xde\x44\x0f\xa5\xbd\x87\xd2\xde\x43\x69\xef\xa1\xb4\xf7\x50\xda\x7b\x28\xed\x3d\x94\xf6\x1e\x4a\x7b\x0f\xa5\xbd\x87\xd2\xde\x43\x69\xef\xa1\xb4\xf7\xc9\x95\xf6\x4e\xfd\x63\x1b\xf5\xba\xde\xaf\xa9\xac\x17\xf0\x1a\x69\x44\x2e\xa2\x08\x10\x6d\x66\xec\x8e\x78\xd5\xb6\x39\x85\x8a\xb6\xbe\xe1\x89\xc7\x8d\x70\x11\x53\x80\x76\xec\xa5\x59\x17\x9a\x88\x09\x73\x40\x3d\x42\x1a\x07\x44\x0c\x0c\x37\x66\x27\x12\x30\x47\x13\x74\x81\x72\x12\xd1\x8c\x86\x9c\x85\x0c\x42\x0d\x92\x8e\x52\x55\x8d\x20\xf3\x00\x0a\x4c\x92\x45\x98\x93\x8b\xd3\x0a\x89\x26\xb7\x22\x12\xfa\xf0\x36\xc3\x08\x61\xb6\x1c\xb6\x0a\xbe\x30\xd3\x5e\x1d\xf1\x25\x2b\x12\x7f\x91\xe6\xe4\x1f\xc6\x76\xd6\xd2\x9c\x59\x1c\x96\x59\x17\x6f\xba\x3a\x62\x64\x89\x41\x33\x8f\x33\xaa\x81\x60\x1f\x6b\x77\x24\x9f\x32\x9a\xcb\xa8\xed\x94\x44\x2c\x8d\x79\x2f\x65\x7e\xb3\x49\x4d\x6b\xb5\x27\x4d\x64\xd2\xdd\x24\x46\x71\xa1\xd8\x83\xf9\x5d\xe3\x84\xc6\x54\x3c\x20\xb6\xf0\xa6\x68\x6d\x31\x08\xab\x3d\xa6\x5c\x22\x61\x3c\xca\xa7\x11\xce\xb2\x9c\x01\xce\x19\xb7\x64\xa9\xfc\x4d\x0d\x74\xe6\x4d\x58\xc7\xd0\xb2\xa4\xb8\xa5\xa9\x72\x36\xe5\x77\x80\x4d\x0f\x75\x0f\x50\x75\x4d\x86\x14\xc0\xac\x07\xb3\x92\x3d\x15\xf3\x12\xf9\x83\x2c\xa0\x67\x9a\xc5\x6a\xe1\x78\x53\xd5\xcd\x05\x24\x7f\xa0\x43\x2c\x89\x4d\xdf\x80\x97\xcf\x00\x97\x32\x0a\xdc\xcf\xa0\xe2\x43\xa2\xa6\x0b\x86\x12\xba\x26\x10\xb1\xa3\x8b\x3e\x9c\xd6\x98\xfb\x9f\x17\x68\xc9\x8a\x9c\x4f\x2c\x48\x32\x6f\x92\xcf\x25\x0d\xb9\x85\x19\x0b\x01\x0b\x94\x10\xcc\x05\x7a\xfe\x0c\xad\x68\x5a\x08\xe2\x0c\xca\xdd\xe0\xe2\xfe\xf8\x62\xf4\x58\xce\x6d\x88\x5b\x5b\xdb\x52\x4c\x4d\x5c\xe9\xd8\x19\xef\x36\x74\xd7\x95\x48\x84\x48\xb6\xb5\xdf\xf0\x72\xbd\x29\x6a\x53\xc3\x56\xd5\x54\xb0\x47\xda\xbb\x43\x3c\x3a\x6f\xaf\x2c\xc0\x56\xf4\x7e\xc4\xc7\x71\x73\x1f\xf4\x18\xb9\x56\x87\x79\x30\xfc\x57\xc1\xe6\x0f\xc2\xc1\xe0\xab\xa9\xf0\x6f\xea\xa9\x3a\x9e\xa6\xf9\xa3\xe9\x07\xb1\x93\xa4\xda\xc1\x25\x66\xe6\x76\x3f\x88\xaa\x1d\xc4\x68\x58\x6b\xf7\x16\x2a\x87\xcf\x47\xde\xcb\x56\xc6\x08\x4d\xe4\x48\x1f\x68\x38\x8a\x00\xae\x45\x30\x03\xe9\xec\x44\x56\x26\xae\x52\xa6\x38\x19\xed\x61\x41\xf5\x00\x9e\xec\x6c\xc7\xa0\x67\x78\xe4\x75\xe8\xef\xbf\x25\xc3\x68\x1f\xbe\x65\x4e\x6e\x29\x17\x79\x98\x0c\xd5\xa3\xf5\xe5\x01\x98\xeb\x89\x3c\x9d\x57\x45\x22\x68\xe6\x1c\xb9\x36\xeb\xaa\x24\xab\x6d\x1e\x3b\xf9\x89\xab\x6c\x97\x23\x55\xac\xda\x2a\xab\x16\x76\x32\x3d\x7f\x6c\xf8\x42\x24\x15\x39\x14\x89\x41\x45\x26\x27\x19\xce\xb1\x7b\xc2\x57\x4e\x70\xc4\x56\x2b\xcc\x4f\x74\x4e\x16\xcb\x9a\x59\x75\xe0\x81\x95\x93\xe3\xa4\x14\xaf\x23\x55\xab\x5a\x71\x1f\x0b\x46\x90\x14\xa7\x22\x60\xaa\x67\xf2\x41\xc4\xee\xcb\x8b\x96\xb7\x74\x4d\xd2\x72\x2f\xf4\x4a\xd0\x68\x7f\xf1\x15\x8e\xee\x48\x1a\xa3\x0f\xdc\xac\x97\xf8\x21\xc5\x2b\xdd\x51\x2a\xcb\xd9\x9a\xc2\x75\x26\x12\x7b\xea\x4f\x59\xed\xa9\x72\x53\x90\x3c\x27\xc2\xc0\x78\x2b\xd3\x7c\x1f\xb2\x2d\x78\x50\x57\x92\x0f\x9c\xe4\xbb\x76\x5b\xd7\x40\xa0\xbc\x59\x96\xd3\x75\x44\x8c\x9b\x00\x4c\xed\x63\xb0\x6b\x47\x90\xea\xad\xe1\x6a\x80\x6a\x6a\x65\xad\xe5\x99\x58\x96\xc6\xb8\x8e\x15\xd2\x10\x09\x1c\x00\x0f\xe6\x7a\xc3\x86\x02\xc0\x8c\xa7\xfb\xe9\xc7\xea\x63\xcb\x38\x6f\x00\x06\x26\x77\x48\xab\x27\x9f\x3b\xf0\x58\x9b\x9f\xa3\x9b\x57\x97\xf5\xfd\xfc\x06\xc7\x8c\xa3\x57\x09\x8b\xee\xd0\x25\x81\x40\xdf\x4e\x8a\xa8\xb4\x8d\xba\x2c\x1f\xcb\xf4\x71\x99\x23\x8f\x86\xf6\xf9\x3c\xde\x5f\x3b\xfb\x60\x80\xf2\x43\xbb\xf3\x6f\xb1\xdd\x79\x3e\x8f\xff\xe3\x9a\x9d\xaf\xf0\x6d\xd0\x02\x80\x68\x55\x2e\xb7\x13\x49\xc2\xf4\x25\x0c\x9d\x26\xa7\xe5\xff\xfd\x92\xdd\x8f\x05\x1b\x17\x9c\x8c\xa9\xd8\x8b\x3c\xee\xc8\x03\x48\x2e\x44\x22\xbf\xa8\x47\x6b\x61\x12\xc1\x64\x8a\xc9\xf1\xcb\x8d\xd5\x78\xf3\xea\x12\x4c\x89\xb2\x89\x2f\x90\x3c\x23\x22\x3a\x8b\x48\xb6\x3c\xd3\x2c\xaa\x35\xe1\x48\x35\x60\xaf\x7d\x04\x61\xaf\x58\x4a\x05\xcb\x79\x88\xb4\x2f\x50\x04\xed\x46\x22\x13\x95\x7e\x4d\xb2\x65\x49\xf0\x29\xca\xc6\xab\x44\xd0\x53\x90\x7e\xe1\x1b\xe8\x4f\xc2\x92\x10\xa1\x57\x8b\x1e\x28\xe8\x35\x5f\x29\xa9\x13\x45\x69\xc9\xd8\x3b\xc5\x93\x53\xcb\xbd\xb5\x86\x34\x6f\x7e\xa1\xde\xde\xe8\x0b\x08\xfd\x71\x3a\x89\x1f\x4d\xcd\xc3\xb0\x55\xda\xf7\xad\x03\x5a\x82\x1b\x56\xea\x5b\xf1\xd5\x02\x62\x76\x6b\x1a\x03\xba\xf1\x9a\xe4\x39\x8d\x09\x37\xc7\x85\xab\xfc\xab\xa5\x82\x52\x9a\x3c\xee\x22\xf0\xb3\x8c\xfd\x6b\x72\x1c\xaa\x71\xac\xf1\x3a\x93\x45\x5f\xae\x0e\x67\x2f\x15\x38\x21\xb5\x37\x81\xa7\x81\x93\x5b\xd9\x23\xdc\x61\x1d\x07\x40\x21\xf4\x38\xc0\xf1\x8a\xa6\x4f\xf8\x40\xf0\x89\x10\x48\x5b\xd8\xe1\x73\xc6\x50\x19\x0d\x38\x93\x3c\xc2\x09\xb9\x7a\x7f\x3e\xf2\x9a\xc4\xa9\x7a\xaa\x1e\x27\x30\x7f\xac\x9a\xb4\x39\xc7\x91\x5a\x1b\x8e\xfd\x52\x2e\x5f\x94\xb2\xd8\x25\x73\xfa\x48\xbe\xfc\x37\xd0\x78\xd0\x7b\xcd\x79\xf4\x25\xf3\xdc\x7c\x6e\xb1\x20\xf7\x38\xc4\x76\x9a\x19\x01\xe9\xf6\xcd\xe6\xf8\x30\xca\xe8\x8e\x38\xf9\xb3\xe2\x61\x2f\xe3\xcb\x72\x26\x94\xd7\x71\xc9\x56\x98\xa6\x81\x03\xb5\x6d\x14\x33\xc0\xeb\x92\xb4\x13\x4d\x84\x14\x07\xd2\x4e\xa9\x5a\xb9\x14\xd0\x36\x4e\x47\x36\x26\x4f\xcc\x3c\xde\x32\x5c\xbf\x95\x16\x71\x7d\x8c\xd7\xca\x76\xad\x02\xe7\xa6\x4c\xc2\xeb\xe6\x2e\xe8\x81\xd1\x25\x79\x20\x97\xe5\x77\x88\x93\x94\x53\xa8\xd7\xb0\xcb\x3e\xdd\x44\x21\xef\xf1\x48\xcc\xb2\xf2\x9e\xa2\x32\x83\x4f\xd1\xaf\x0c\x4a\xa3\xf4\x7e\xc6\x52\x9f\x9b\x86\x0b\x4c\x1d\x6f\x10\x1e\xec\xd6\x83\xdd\xda\x62\xb7\x72\x9e\xbc\x49\xf1\x3c\x21\x71\xc0\xb2\x7b\x9b\x60\x59\x25\x47\x24\x85\xb3\x98\x72\xf8\x3f\x9a\x4e\x7f\x95\xa9\xe0\x22\xf5\xf3\x19\x61\xe2\xcc\xc1\x53\x42\x81\xa8\x4d\x6e\x3f\x3b\x8e\xda\xe1\x03\xdb\x08\x5e\xa5\x31\x0c\x8f\xf0\x5a\x2d\xbd\xa6\x09\x07\x8a\x13\x49\x54\x5e\x2f\xd5\xb5\xbd\x50\x35\x31\x5b\xd2\xe8\xee\xba\xca\xf8\x42\xf5\xc0\x6c\x49\x53\xeb\x4f\xae\x1b\x4f\x65\xd6\x84\x51\xf0\xd4\x3f\x3d\xfc\xeb\xb0\xe0\xd8\xcc\x3a\xc9\xa7\x5a\x90\x40\x0a\x61\xce\x59\x44\x7d\xcb\x11\x60\x03\xaa\x6c\x0d\x14\xcb\xa3\x7e\x3f\xc3\x96\x59\xa5\x01\xec\x18\xa3\x3e\xda\x04\xc6\xae\x7b\x96\x65\xb7\xd0\xd4\xc8\x70\x2f\x43\x55\xca\xea\x8e\x72\xd5\x3a\xdc\x52\xef\x37\xf2\xd6\x7e\x55\x13\x46\x5d\x8c\xcf\x00\xc9\x73\xca\x2d\x85\xf1\xa9\xed\x97\x47\xb4\x59\x8d\xb2\xe8\x6e\xf2\x65\x7d\x61\xed\x09\x38\x7c\xb2\xb4\x9e\x5c\x3e\xeb\x96\x4f\xf3\xf1\x9b\x1d\x21\x57\x9a\x22\x8e\x75\xb7\x59\xc7\x0d\x75\x7a\xdc\xf1\xb2\x43\xc6\xb2\x22\x51\x75\xda\xe5\x04\x36\x46\x43\xba\xec\x08\xbd\xf4\x4c\x84\xe4\x7b\xc5\xc9\xd1\x68\x58\xe3\xaa\x5f\xeb\xda\xa6\xeb\xef\xd6\x45\x76\xbf\x15\x24\x5d\x6d\xb7\x2e\xb6\x8e\x14\xcb\xdb\xee\x35\x2f\xe5\xd9\x8f\x2f\x5e\x4c\x1a\xfa\xda\x3a\x12\x6d\xed\x7e\xdb\xdc\xd7\x76\x34\xc8\x85\xf5\xda\xd5\x73\x47\x92\x1b\x17\xd4\x07\xb8\x6a\xde\x79\xb5\xdc\xe3\x92\xb8\xff\xa5\x70\xdf\x3a\x79\x8f\xcc\x9c\x47\x43\x51\xd9\x20\xd4\xef\x3c\xe8\x42\x19\xd2\x6e\xa2\x8f\x8f\xd5\x88\x2d\xd4\x82\x15\xe4\x48\xd2\x42\x14\xda\x89\x10\xe4\x48\xd2\x12\xe0\x50\xb8\x40\x6e\x68\x40\xf2\x3b\x1c\x29\x76\x62\x00\x35\x60\xfa\xb8\x32\x6a\x3c\x6a\x49\x71\x17\x92\xcf\x68\xd8\xd6\x9c\xaa\xd5\xa6\x23\x51\x73\x05\xa6\x09\xb5\x67\x0b\x85\xc7\x91\xe6\x36\x56\x8f\xc7\xbd\x7c\xaf\x74\xfa\x4e\x9c\x9d\x3a\x6a\x8e\x23\xd5\xca\xfd\x71\x63\x39\x24\x96\xe1\x8d\xa4\x53\x1b\xea\xac\x04\x8b\x30\x6b\xc8\x95\x53\x6f\xcb\x30\x14\x2b\xc7\xd5\x44\xf0\x20\x89\x9a\x71\x71\x36\x2d\x03\x2f\x8a\x16\x1a\xce\x70\xb8\x36\xdd\x68\x36\xe5\x31\xef\x45\xd3\xc1\x24\xd0\x46\x88\x17\x59\x17\xc3\x40\xa3\xc9\x78\xd1\xed\x6d\x1e\x84\x1a\x09\x61\xa6\x42\xb5\x71\xf5\x5a\x93\xe5\x2e\x9a\xd5\x7a\x91\x78\x02\x03\x6d\x23\xc2\xb4\x60\xbb\x78\x51\x05\x96\x26\xc3\x22\xba\x74\xe1\xb8\x84\x22\xb2\x04\x6c\x4e\xee\xce\xab\x3f\xe6\x8a\xd7\x9d\x3e\xcf\x30\xa7\x6f\x75\x97\x1f\xd6\x86\x23\xc2\x86\x46\xcc\x18\x79\x00\x27\x86\x00\x6b\x84\x25\x5e\x02\x23\x3a\xb5\x68\xbf\x76\xde\x8d\xae\xcb\xa2\x76\x27\x92\xc8\xea\x97\xa5\xce\xaa\x47\x73\xdf\xbd\x17\x82\x87\xe6\x69\x9e\x98\x83\xa9\x52\x57\x21\xf5\xdc\xfb\xe9\x46\x49\x41\xf9\x67\xe7\x83\xf2\x50\x49\xf0\xf8\x95\x04\xfb\x29\xdf\x1f\x5e\x91\x0f\x69\xe8\xfd\xa5\xa1\xcd\xbc\x71\x7b\x5f\x74\xbe\x98\x0f\xe1\x3c\xc8\x44\xb3\xb9\xdd\xcb\xb1\xda\x15\xa0\xbb\x59\x94\x13\x89\xba\x82\x13\x3e\x41\xee\xa1\x82\x0d\xab\x5b\x9b\xcf\xca\xaa\xaf\xa2\x05\x80\x9e\xbf\xca\x84\xab\xda\x1d\xf2\xcb\x87\xfc\x72\x4b\x7e\xb9\x57\xba\xe8\xf7\xf2\x61\x13\x19\x5b\x16\x2b\x9c\x8e\x61\xa7\x81\x4c\xb3\x4f\xc4\x8d\x2d\x36\x16\x91\x49\x13\xe8\x3d\x05\xf9\x4c\x21\xdc\x90\x96\x17\xd9\x8b\x94\xfe\x55\x10\x1d\xf3\x40\xb8\x32\x63\x26\xa3\x3d\x48\xbf\x12\xa6\x34\x95\x7a\x49\x54\x52\xd8\xdc\xa7\x22\x96\xb9\x0a\x95\x2d\xec\xe0\x9a\x16\x40\x29\xdd\x89\x86\xae\xad\x04\x32\x0a\xc0\xf6\x86\xba\x73\xf9\x2d\xd7\x12\x19\xa2\xa4\x65\xf6\x29\x47\x9a\x2a\x1e\x00\xf9\x6f\x8e\x70\x92\xb0\x7b\xa5\x4b\xb6\x0d\x04\x1a\xc2\x23\x96\xb9\xae\x02\xd3\x4a\x6c\x45\x01\xe4\x9b\xc4\x5b\x02\x80\x02\x00\x24\x20\xfa\xe0\xec\x14\x4b\x17\x1a\x8c\x11\x96\x4e\xd0\x94\x08\x6b\xb2\xe0\xdb\x70\xaa\x2e\xf0\xc2\xbf\x4d\xf5\xbc\x2b\xaf\xcb\x12\xe3\x0b\xcd\xc9\x12\xaf\x29\x2b\x72\xf5\x1d\xd0\x67\x45\xbf\x25\x6f\x0c\x3e\x30\xd7\xe6\x4e\x26\x1d\x53\xc8\x4b\x76\xe5\xdc\xf0\x2d\x51\x4c\x50\xa9\x6f\xae\xeb\x4b\xa6\xff\x62\x66\x22\xbb\x63\xf2\x09\x7a\x47\x6f\xc9\xd8\x2f\x90\xaf\xb3\x63\x7b\x58\x99\x1e\x7b\xe2\x9a\x67\x60\xeb\xfc\xee\x78\x61\xbc\xbe\x78\xed\x67\xeb\xae\xc1\x7a\x2a\xdf\xea\xef\x18\x68\xf4\x2c\x85\x12\x61\xae\x4d\x8e\x86\x35\x00\x0e\xce\xc1\xd7\xe0\x1c\x68\xe7\xf5\x9a\x25\x34\x7a\xb8\xba\x0c\x98\x2e\xbd\x50\x91\x22\x81\x5e\x61\x40\xb6\x78\x87\x53\x7c\x2b\xe3\x5f\xe8\x78\x7a\xfd\xea\xdd\x89\x13\x5d\xe9\x7f\x42\x74\x0f\x5d\x5d\x6e\x16\x77\xd8\xe7\xba\xfa\xaa\xbf\xed\x07\xe7\x60\x43\x26\x81\x46\xcd\x5e\xa4\xb2\x27\x64\x07\x63\x72\xb8\xb7\x8e\xae\x0d\x15\x1e\xd3\x25\x39\x06\x21\x92\xfb\x6f\x55\xf0\x5a\xaf\xe2\xbb\xe1\x87\xe7\x53\x8a\x53\x09\x62\xb8\xe3\xc0\xed\xfb\xc7\xf2\xd8\x1f\x0d\xf0\x8d\x2e\x31\x50\xed\xe5\x5c\xd2\xce\xeb\x4c\x0e\x62\xde\xc1\x53\xe7\xdb\x6d\x8c\xb6\x3c\xc4\x05\x16\xc5\xc6\xd1\x53\x53\xc4\x57\x05\x4d\xe2\xa9\xfc\x94\x0e\xa5\x2a\x13\x90\xcd\x01\x54\x49\x16\xfb\x43\xf9\x10\x5b\xa8\x4f\x8e\xdc\xce\x36\x9c\x0b\xba\x00\x94\xa3\xf3\x91\x73\x6a\xb5\xc6\xd6\x85\x26\x80\xc6\xe3\x91\xff\xa1\x1a\x2d\x49\x74\xc7\x8b\x55\xf3\xbb\x0e\x73\x44\xe3\xe0\x47\x13\xa6\x2a\x7b\x83\x09\x08\x9c\xdf\x12\x11\xf8\x78\xd7\xba\x19\x23\x1a\x0f\xa5\x6e\x08\xcd\x31\x27\x57\xcd\xf8\x0e\x1d\x3c\x02\xfe\x2c\x05\xf1\x04\x6b\x86\x54\xc3\xd7\x86\x8c\x7e\x6f\x6e\xdc\x34\xa3\xac\x55\x43\x5a\xd4\x52\xa5\x84\x51\x44\x72\x08\x22\x29\x9c\xc8\x49\x80\x9a\x25\x98\x8b\x59\x8e\xe5\xe5\x07\x96\xce\x68\xfb\xa1\x57\x1b\xc1\xaf\x00\xf2\x09\x38\x7e\xe6\x5e\x8d\x1e\x8a\x28\x49\x41\x37\x52\x00\xeb\x60\xa9\x1a\x52\xd1\xee\x1d\x48\xf7\x47\x46\x4a\x26\xa3\xee\xec\x65\x8c\x05\x19\x77\xc0\x07\xee\xd0\x2c\x35\xdc\x0f\x19\x90\x71\x1e\x2a\x94\x08\x24\xd6\x70\x29\xb7\xc6\x7b\x8f\x39\x2a\x24\xbd\x78\xef\xbc\xaf\x08\xe7\xf8\xd6\x8d\xe9\x0b\x15\x4b\x41\x26\x96\x62\x1e\x46\x54\xd5\xb5\x83\x53\x17\x13\x81\x69\xc2\x15\xb6\x79\x0b\x51\x70\xd6\x88\x35\xab\x93\x50\xe6\x73\x82\x39\x4b\x9d\x78\x07\x81\xab\x8f\xdb\x17\xb7\x94\xc0\x01\x46\x49\xce\x45\x7f\x8e\x9a\x8e\x95\x16\x8e\xf4\xd9\xc2\x16\x75\x66\x4e\xa5\x72\xb3\x05\x9a\xe5\x05\x39\x45\x6f\x21\x50\x7e\x8a\x3e\xa4\x77\x29\xbb\x0f\xe7\x4b\x74\x78\x4f\x75\x39\x69\x58\x25\x2b\xb2\x50\xf1\x36\xd9\xc7\xde\xdb\xba\x8e\x55\x5b\xdb\xe1\x36\xe6\x98\xde\x12\xde\x70\x7e\x74\x70\x6f\x00\xb8\xcf\x47\x9d\x42\x7b\xbd\xc4\xe9\xad\x4c\x43\x5e\x1a\xc4\xee\x33\x74\x35\x7d\x8f\x5e\xfe\xf8\xec\x39\x64\x75\x53\xf4\xfa\xe6\x12\x72\x94\x1c\xbd\xcf\x48\x0a\xc1\x78\xf0\xc4\xb6\xa8\x22\xb4\xfe\xdf\x32\xc2\x7c\x4b\xc5\xb2\x98\x4f\x22\xb6\x3a\x7b\x7f\x71\x75\xa6\x1f\x1c\xeb\xce\xbc\xea\x30\x3d\xa3\x9c\x17\x84\x9f\xbd\x7c\xf1\x7f\x3e\xe3\x92\x75\x6a\x5e\x92\x80\x9b\x63\x45\x4e\x76\x08\xe2\xad\xfa\x54\x93\x71\xd2\x7d\x66\x74\xad\xe4\x0e\xae\xe0\xbf\x9c\x44\x10\xdb\x6a\xc9\x48\x35\xb1\x77\xa3\x9f\x68\xb6\xa1\x76\x1f\x6f\xc8\x24\x3b\xda\xde\x76\xab\x70\xd1\x44\xde\xe1\x4f\x83\xd0\xe9\x3a\x7b\xdc\x0f\x8c\x9d\xe2\xee\x5e\xce\x60\x4c\x69\x7e\xba\xdf\x7d\x87\x3f\x35\x7e\xa0\x73\x6d\x23\x24\x5a\x07\xe9\x36\xc0\xce\xc1\xb5\x0f\x6c\xac\x15\xb4\xf1\x0d\xa5\x4c\x0d\x6f\x35\x72\xd1\x31\xc0\x16\x54\xb0\x0e\x9e\xb3\x25\xe6\xbb\xd6\xa4\xb4\x0b\xaf\xe1\x83\x4d\xfa\xde\x45\x3c\xc1\x02\xa4\xea\xc5\xd1\x5f\x05\x29\xc8\x35\x53\x07\xfb\x0e\xce\x7e\xb3\x3f\x6b\xd2\x35\x99\xf9\x5d\x1f\x8c\x73\x60\xdf\xd4\xe8\xce\xb7\x5c\x2d\xeb\x5b\x4f\x01\x44\x36\x21\x50\x77\x7b\x8f\x75\x09\xe3\x1c\x62\xf4\x4b\x12\x17\x49\x43\x25\x4c\x77\xf9\x5a\xd7\xd2\x93\xc5\x54\x24\xbe\x10\xe7\xad\x44\xdb\xf5\xb0\x43\x7a\x5c\x90\x8c\xef\x90\xda\x14\x3e\x23\xd5\x2e\x8f\xb9\x6e\xa7\x4e\xa2\x62\x5b\x66\x92\xd8\x29\x88\x8e\xe5\x31\xc9\xfb\xb8\x15\xf0\x9d\xda\x5e\x69\xfd\x62\x6c\x7d\xed\xc8\x7f\x53\x5d\xd0\x94\xf2\x65\xb3\x4c\x5d\x25\xbb\x53\xbe\x7a\xe0\x2d\x5b\xd7\xc6\xc8\xaf\x2e\x8d\x4e\x6e\x37\x2b\x01\xd9\x9e\x22\x6d\x48\xea\x64\x61\xb7\xb5\x8b\xf9\x5d\x69\x79\xc2\xd3\x1c\xe5\x45\x8a\x30\x37\xb5\x7e\x24\xe7\xa1\xe3\x69\xd9\x05\x1a\x86\xa4\xb6\x01\xb3\xd2\xe4\x2f\x35\x95\x31\xcc\xa1\x39\x49\x58\x7a\xdb\xc6\x11\x6a\x6f\x18\xd0\xbd\xa6\x76\xaf\x2c\xb3\x13\xc3\x2d\x16\xa7\x21\xdd\xc8\xea\x57\x33\x26\x56\x88\x88\xad\xc8\xc6\x34\x29\x83\xfa\xa6\x48\x01\x7b\xb9\x3d\x3d\x3e\x2d\xa2\x88\x10\x79\xc7\x1e\x0c\x19\xc8\x6e\xe4\xe8\x2a\x15\x24\xcf\x8b\xac\xfd\x1e\xc4\xce\xf9\xe9\xd8\x2d\x06\xd5\xec\xc7\x8a\x71\x34\x3e\xb4\xf5\x47\x15\x26\x3b\x47\x22\xd7\x5d\xc0\x75\x24\xdc\xfe\x4b\x31\x37\xd1\x88\x72\x57\xe0\x02\x8b\x82\x9f\xa3\x7f\xfe\x6b\xf4\xef\x01\x00\xbe\xce\x06\x5d\x13\xa0\x07\x00"),
 		},
 		"/crd-camel-catalog.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "crd-camel-catalog.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 13133,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5a\x5f\x93\xe2\xb8\x11\x7f\xf7\xa7\xe8\x1a\x1e\xf6\xae\x6a\x30\xb7\x49\x1e\x52\xe4\x89\xb0\xb3\x15\x6a\xf7\x66\xa6\x06\x6e\xaf\xee\x51\xd8\x8d\xd1\x8e\x2c\x39\x92\x0c\x4b\x52\xf9\xee\x29\x49\x36\xb6\x01\xdb\xf2\x1c\x5b\x93\x3f\xd3\x4f\x60\xb5\xbb\x5b\xbf\xfe\x6b\x5b\x23\x18\x5f\x8f\x82\x11\x7c\xa6\x11\x72\x85\x31\x68\x01\x7a\x8b\x30\xcb\x48\xb4\x45\x58\x8a\x8d\xde\x13\x89\xf0\x51\xe4\x3c\x26\x9a\x0a\x0e\x3f\xcc\x96\x1f\x7f\x84\x9c\xc7\x28\x41\x70\x04\x21\x21\x15\x12\x83\x11\x44\x82\x6b\x49\xd7\xb9\x16\x12\x98\x13\x08\x24\x91\x88\x29\x72\xad\x42\x80\x25\xa2\x95\x7e\xff\xb0\x5a\xcc\xef\x60\x43\x19\x42\x4c\x95\xbb\x09\x63\xd8\x53\xbd\x0d\x46\xa0\xb7\x54\xc1\x5e\xc8\x67\xd8\x08\x09\x24\x8e\xa9\x51\x4c\x18\x50\xbe\x11\x32\x75\x66\x48\x4c\x88\x8c\x29\x4f\x20\x12\xd9\x41\xd2\x64\xab\x41\xec\x39\x4a\xb5\xa5\x59\x18\x8c\x60\x65\xb6\xb1\xfc\x58\x5a\xa2\x9c\x58\xab\x53\x0b\xf8\x4d\xe4\xc5\x1e\x6a\xdb\x2d\x50\xb8\x85\x2f\x28\x95\x51\xf2\x87\xf0\xa7\x60\x04\x3f\x18\x96\x9b\x62\xf1\xe6\xc7\xbf\xc0\x41\xe4\x90\x92\x03\x70\xa1\x21\x57\x58\x93\x8c\xdf\x22\xcc\x34\x50\x0e\x91\x48\x33\x46\x09\x8f\xb0\xda\xd6\x51\x43\x08\xd6\x00\x23\x43\xac\x35\xa1\x1c\x88\xdd\x06\x88\x4d\x9d\x0d\x88\x0e\x46\xc1\x08\x2c\x6d\xb5\xce\xa6\x93\xc9\x7e\xbf\x0f\x89\x35\x37\x14\x32\x99\x94\xbb\x9b\x7c\x5e\xcc\xef\xee\x97\x77\x63\x6b\x72\x30\x82\x5f\x38\x43\xa5\x40\xe2\xdf\x73\x2a\x31\x86\xf5\x01\x48\x96\x31\x1a\x91\x35\x43\x60\x64\x6f\x1c\x67\xbd\x63\x9d\x4e\x39\xec\x25\xd5\x94\x27\xb7\xa0\x0a\xaf\x07\xa3\x86\x77\x2a\xb8\x4a\xf3\xa8\x6a\x30\x08\x0e\x84\xc3\xcd\x6c\x09\x8b\xe5\x0d\xfc\x75\xb6\x5c\x2c\x6f\x83\x11\xfc\xba\x58\xfd\xed\xe1\x97\x15\xfc\x3a\x7b\x7a\x9a\xdd\xaf\x16\x77\x4b\x78\x78\x82\xf9\xc3\xfd\x87\xc5\x6a\xf1\x70\xbf\x84\x87\x8f\x30\xbb\xff\x0d\x3e\x2d\xee\x3f\xdc\x02\x52\xbd\x45\x09\xf8\x2d\x93\xc6\x7e\x21\x81\x1a\x20\x31\x36\x3e\x2d\x03\xa8\x34\xc0\xc4\x87\xf9\xaf\x32\x8c\xe8\x86\x46\xc0\x08\x4f\x72\x92\x20\x24\x62\x87\x92\x9b\xf0\xc8\x50\xa6\x54\x19\x77\x2a\x20\x3c\x0e\x46\xc0\x68\x4a\xb5\x8d\x22\x75\xbe\x29\xa3\xe6\x9a\xb9\x15\x90\x8c\x16\xe1\x34\x05\x92\x51\xfc\xa6\x91\x5b\x6b\xc2\xe7\x3f\xab\x90\x8a\xc9\xee\x7d\xf0\x4c\x79\x3c\x85\x79\xae\xb4\x48\x9f\x50\x89\x5c\x46\xf8\x01\x37\x94\xdb\xc8\x0f\x52\xd4\x24\x26\x9a\x4c\x03\x00\x46\xd6\xc8\x94\xf9\x05\xc6\xa1\x53\xb8\x89\x48\x8a\x6c\xfc\x7c\x13\x00\x10\xce\x45\xb1\x33\xc7\x61\x53\x52\x30\x86\x72\x9c\x20\x0f\x9f\xf3\x35\xae\x73\xca\x62\x94\x56\x73\x69\xd7\xee\xa7\xf0\x4f\xe1\xfb\x00\x20\x92\x68\x6f\x5f\xd1\x14\x95\x26\x69\x36\x05\x9e\x33\x16\x00\x70\x92\xe2\x14\xac\xae\x88\x68\xc2\x44\xa2\x42\xfb\xaf\x16\x8d\x81\xf1\x83\x51\x9c\x48\x91\x67\x05\x77\x7d\xdd\x89\x29\x6d\x23\x1a\x13\x21\x69\xf9\x7f\x0c\xcf\x86\xbf\xf8\x1d\x1d\x7f\x17\xe0\x98\xff\x73\xa7\xd9\x5e\x66\x54\xe9\x4f\x67\x4b\x9f\xa9\xd2\x76\x39\x63\xb9\x24\xec\xc4\x62\xbb\xa2\xb6\x42\xea\xfb\xca\x8e\x31\x44\x91\x5b\xa0\x3c\xc9\x19\x91\xcd\x9b\x02\x00\x15\x89\x0c\xa7\x60\xef\xc9\x48\x84\x71\x00\x50\x60\x67\x65\x8c\x6b\x45\xea\x51\x52\xae\x51\xce\x05\xcb\x53\x7e\xd4\x10\xa3\x8a\x24\xcd\xb4\x45\xdb\x54\x26\x6b\x33\x7c\x82\xa7\x9c\x6b\x9a\x62\x29\x2e\x70\xb9\xfe\x55\x09\xfe\x48\xf4\x76\x0a\xa1\x81\x34\x94\x8e\x2b\x6c\x72\x39\x97\x94\x12\xbe\x34\xd6\xf4\xc1\x58\x6c\x72\x93\x27\xbe\x36\x64\x52\xec\x68\x8c\xb2\xc7\x88\x13\xb6\xa6\x15\x8f\xcd\xc5\x33\x33\x1c\xf7\xee\xbd\x03\x3c\xda\x62\x4a\xa6\x05\xaf\xc8\x90\xcf\x1e\x17\x5f\xfe\xb8\x6c\x5c\x86\xa6\xe1\x75\x67\x9b\x02\x64\x52\xd7\xdd\x70\xac\x06\x0d\x97\xc3\xec\x71\x71\x94\x94\x49\x91\xa1\xd4\xc7\x90\x73\x54\xcb\xd0\xda\xd5\x13\xbd\xef\x8c\x69\x45\x5b\x88\x4d\x6a\xa2\xd3\x5d\xb8\x04\xe3\x62\x37\xae\x84\x53\x53\x79\x4d\x05\x43\xee\xf2\xb1\x21\x18\x0c\x13\xe1\x20\xd6\x5f\x31\xd2\x21\x2c\x51\x1a\x31\x26\x32\x73\x16\x9b\xa4\xdd\xa1\xd4\x20\x31\x12\x09\xa7\xff\x38\xca\x56\x65\x7b\x66\x44\x63\x11\xe7\x15\xd9\xb8\x33\x6d\x72\x47\x58\x8e\xb7\xa6\xd8\xd9\x0e\x23\xd1\x68\x81\x9c\xd7\xe4\x59\x16\x15\xc2\xcf\x42\xa2\x6d\xab\x53\xdb\x5f\xd4\x74\x32\x49\xa8\x2e\x2b\x53\x24\xd2\x34\xe7\x54\x1f\x26\xb5\xd6\xae\x26\x31\xee\x90\x4d\x14\x4d\xc6\x44\x46\x5b\xaa\x31\xd2\xb9\xc4\x09\xc9\xe8\xd8\x9a\xce\x6d\x01\x0a\xd3\x78\x24\x8b\x5a\xa6\xde\x35\x6c\x3d\x8b\x0a\x47\x36\xd1\x3b\x3c\x60\xb2\xdd\xb8\x9c\x14\xb7\xba\x5d\x54\x40\x9b\x4b\x06\x9d\xa7\xbb\xe5\x0a\x4a\xd5\xd6\x19\xa7\xe8\x5b\xdc\xab\x1b\x55\xe5\x02\x03\x18\xe5\x1b\xdb\x13\x4c\x53\x97\x22\xb5\x32\x91\xc7\x99\xa0\x5c\xbb\x00\x63\x14\xf9\x29\xfc\x2a\x5f\xa7\x54\xbb\x8e\x8b\x4a\x1b\x5f\x85\x30\xb7\x15\x19\xd6\x08\x79\x16\x13\x8d\x71\x08\x0b\x5e\xc6\xb0\x99\x03\xbe\xb3\x03\x0c\xd2\x6a\x6c\x80\xf5\x73\x41\xbd\xd3\x9c\x32\x3b\xd4\x6a\x0b\x65\xb5\x6f\xf1\x57\x3d\x53\x97\x19\x46\x8d\xb4\x89\x51\xd9\xb1\x44\x69\xa2\xd1\xa4\xc3\x59\x7d\x2f\xe9\x72\xce\x1a\x22\x52\xd3\x0d\x89\xf4\xd9\x02\x34\xea\x71\xdb\xed\x97\x0d\x9e\x15\x42\x61\x3c\xbe\xc0\xdf\x6e\x4c\xd3\xa4\x45\x7c\x79\xbd\x15\xf8\x9a\x49\x44\x13\x37\xe6\xb6\xe8\x00\xa0\x1a\xd3\xd6\x45\x0f\x15\x25\x0b\x91\x92\x1c\x2e\x1b\x81\x19\xf2\x18\x79\xd4\xba\xd3\x5e\x2b\xda\xa1\xfd\x50\x0a\x3f\x54\x29\x08\x04\x52\xb2\x43\xfe\x4e\x55\xba\x2f\x9b\x06\x1e\x6e\x70\xd4\xef\x8c\x3a\x18\x9d\x78\x19\xc2\x6f\x11\xcb\x55\x35\x5b\xb5\x51\x0f\x2e\x8e\xda\xd1\xb9\x2b\xd5\x5c\x8e\xc0\x3a\xf9\xc1\xe0\xc8\x17\x0c\x47\x9e\x90\x38\xb2\xd3\xde\xd5\xe5\x96\x4f\x2e\x7d\x62\xc7\xb5\xad\xf5\xb2\x16\xa6\xf6\xf0\xb5\xd4\xbb\x36\xc6\xf6\x34\x72\xe4\x85\x8f\x27\x32\xbb\x4b\x83\xca\x8b\x64\xf5\xe3\xeb\x85\x6c\x3f\xa6\x1e\x68\xf6\xe1\xd8\x9f\x7a\x2f\x2e\x46\x5e\xe9\xf6\x2a\xf5\xe6\x7a\x61\xf3\xdf\xe4\xea\x9e\x5d\xf7\xee\xf7\x2b\xd9\x91\x95\x99\x7a\x5e\xaf\x79\x96\x2f\x21\x5e\xd1\x04\xfb\xbc\x70\xc5\xd6\x6d\x9f\xb0\xf0\xf7\xa7\x48\x24\xb8\xca\x53\x94\xdd\x41\xdd\xa6\x7f\x69\x9e\xc3\xed\x3b\x0d\x42\xb9\x72\x8f\xe5\x71\xfd\xad\x60\x4f\x5d\x27\x6b\x91\x9b\x49\xc3\xa1\xd3\xc9\xec\xdf\x5a\x7d\x46\xa5\x8a\xbc\x86\x03\x78\xc1\xf8\xe4\x21\x12\x86\x8c\x58\x15\x0d\x99\x32\x60\xf0\xa4\x01\x43\xa7\x0d\x18\x30\x8a\x55\xe4\x8d\xbb\xa3\xab\x8c\x67\x15\x0d\x85\xd0\xd1\x70\x20\x1d\x0d\x86\xd3\xd1\x80\x31\xee\x77\xea\xf2\x1d\xed\x2a\x1a\x30\xe4\xd5\x6f\xf2\x1b\xf7\x2a\xf2\x1e\xfc\x4e\x6f\xe9\x1b\x01\x2b\x1a\x88\xf2\x60\x7c\xbd\x06\xc4\x17\xcb\x1f\xe2\xb9\x81\x3e\x1b\xe2\xad\x41\x7e\xf2\xf5\x90\xa7\x50\xfb\x1d\xc6\x43\xd0\x5a\x08\x86\xa4\xab\x25\xd1\xeb\xcc\x89\x19\x51\x8a\xee\xf0\x3a\x36\x65\x52\xc4\x79\xf4\xd6\xa0\xfb\xd8\xde\x1a\x74\x27\xbd\x35\xe8\x2e\x7a\x6b\xd0\xe5\x4d\x6f\x0d\xfa\xca\xf2\xff\xef\x1b\xb4\xcf\xbb\x0e\xd3\xc2\x3b\x96\x69\xd7\x2b\x90\xa2\xdb\x7e\xcf\x57\x20\x3d\x11\xd2\x13\x11\x5d\x00\xf4\x78\xbc\xcb\xc3\x9d\xfb\xea\x58\x64\x82\xc4\x28\xaf\xfa\x89\xe6\xb3\x15\xf9\x7a\x1f\x68\xae\xfc\x6d\xe4\x67\xd3\xf3\xbb\x3f\x3b\xf9\xed\xcd\x77\x87\x9e\xfb\x74\xf4\xf6\xf2\xfa\xb5\xdf\x68\xfe\x07\xbc\x4e\xfc\x9f\xaa\x48\xc5\x39\x96\x73\x6b\x1a\x59\x59\x1c\x68\xb1\x1f\xae\x2f\xe4\x64\x77\x2e\x16\x27\xed\x8c\xa4\x39\x23\xaa\xc5\x39\x3d\xb0\x45\x24\x23\x6b\xca\x68\x47\x31\xf3\x2c\xa1\x67\x9b\x9b\x97\xa2\x0f\xed\xf5\xc6\xa7\xda\xf8\x3e\x5a\x79\x0c\xf7\x2f\x2b\x89\xbe\x86\x3a\x1a\x32\xaf\x0f\x98\xbb\xbc\x27\xc6\x01\x32\xbd\xa7\x44\x6f\x99\x7e\x93\xa1\xf7\x4c\xe8\x37\x0d\x7a\xce\x81\x3e\x13\xe0\xe5\xa3\x29\x4d\x1a\x92\x10\x75\xcd\xbd\xf0\x79\xec\xa3\x0f\xdf\x71\x23\x5b\x3a\x2b\x69\x87\x9e\x1e\x86\xfe\x84\xec\x4c\xc5\x97\x24\xa1\x4f\xfa\xf9\x25\x9e\x97\x2f\x3c\x92\xcd\x4b\x8e\x47\x82\x79\xc8\xe9\x77\x7a\x6f\x3a\xf5\x25\x92\x67\x48\xb4\x25\x4f\x77\xda\x0c\x49\x98\x5e\x38\x7a\x2c\x2d\xcf\x8d\x5e\x96\x7f\xa9\xf9\x96\x87\x49\xdb\x82\xaf\xc7\xa2\x4e\x17\x77\xde\xdb\xee\xd6\xf1\x59\x6f\xbf\xc0\xd2\x99\xe8\xe3\xd3\x73\xb6\xf5\xa5\xe6\x01\xdf\x53\x6b\x2f\x40\x7b\xd9\xd2\x2a\xec\xd4\xc9\xf5\xe2\x71\xec\xe4\x6a\x31\x12\x05\x1e\x2a\x95\x26\x3a\x57\xbe\xc7\xfe\x2c\x73\xe3\xe0\x9f\x58\x2b\x94\x3b\xaf\x93\x7f\x17\x2d\x38\xbb\xe8\xc4\x4d\x41\xcb\xdc\x6d\x40\x69\x21\x49\x82\xf5\x2b\xf9\xfa\x78\x12\xb5\xb4\xbc\xd8\x07\xfc\xf3\x5f\xc1\xbf\x03\x00\x00\xff\xff\xf8\x3f\x69\x14\x4d\x33\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5a\x4f\x93\xe2\xb8\x15\xbf\xfb\x53\xbc\x6a\x0e\xb3\x5b\xd5\x36\xbb\x49\x0e\x29\xe7\x44\x7a\x7a\x2a\xd4\xcc\x76\x77\x35\xec\x6c\xed\x51\xd8\x0f\xa3\x69\x59\xf2\x4a\x32\x0c\x49\xe5\xbb\xa7\x24\xdb\x60\x37\xd8\x92\x19\x52\x9b\x4d\x35\x70\xc0\xd6\xf3\xfb\xf3\xfb\xbd\x3f\x36\x68\x02\xe1\xf5\x5e\xc1\x04\x3e\xd1\x04\xb9\xc2\x14\xb4\x00\xbd\x41\x98\x15\x24\xd9\x20\x2c\xc4\x5a\xef\x88\x44\xf8\x20\x4a\x9e\x12\x4d\x05\x87\xef\x66\x8b\x0f\xdf\x43\xc9\x53\x94\x20\x38\x82\x90\x90\x0b\x89\xc1\x04\x12\xc1\xb5\xa4\xab\x52\x0b\x09\xac\x52\x08\x24\x93\x88\x39\x72\xad\x22\x80\x05\xa2\xd5\xfe\xf0\xb8\x9c\xdf\xdd\xc3\x9a\x32\x84\x94\xaa\xea\x22\x4c\x61\x47\xf5\x26\x98\x80\xde\x50\x05\x3b\x21\x5f\x60\x2d\x24\x90\x34\xa5\xc6\x30\x61\x40\xf9\x5a\xc8\xbc\x72\x43\x62\x46\x64\x4a\x79\x06\x89\x28\xf6\x92\x66\x1b\x0d\x62\xc7\x51\xaa\x0d\x2d\xa2\x60\x02\x4b\x13\xc6\xe2\x43\xe3\x89\xaa\xd4\x5a\x9b\x5a\xc0\xaf\xa2\xac\x63\x68\x85\x5b\xa3\x70\x0b\x9f\x51\x2a\x63\xe4\x4f\xd1\x0f\xc1\x04\xbe\x33\x22\x37\xf5\xe2\xcd\xf7\x7f\x83\xbd\x28\x21\x27\x7b\xe0\x42\x43\xa9\xb0\xa5\x19\xbf\x26\x58\x68\xa0\x1c\x12\x91\x17\x8c\x12\x9e\xe0\x31\xac\x83\x85\x08\xac\x03\x46\x87\x58\x69\x42\x39\x10\x1b\x06\x88\x75\x5b\x0c\x88\x0e\x26\xc1\x04\xec\x6b\xa3\x75\x11\x4f\xa7\xbb\xdd\x2e\x22\x96\x9d\x48\xc8\x6c\xda\x44\x37\xfd\x34\xbf\xbb\x7f\x58\xdc\x87\xd6\xe5\x60\x02\x3f\x73\x86\x4a\x81\xc4\xdf\x4a\x2a\x31\x85\xd5\x1e\x48\x51\x30\x9a\x90\x15\x43\x60\x64\x67\x88\xb3\xec\x58\xd2\x29\x87\x9d\xa4\x9a\xf2\xec\x16\x54\xcd\x7a\x30\xe9\xb0\x73\x84\xab\x71\x8f\xaa\x8e\x80\xe0\x40\x38\xdc\xcc\x16\x30\x5f\xdc\xc0\xdf\x67\x8b\xf9\xe2\x36\x98\xc0\x2f\xf3\xe5\x3f\x1e\x7f\x5e\xc2\x2f\xb3\xe7\xe7\xd9\xc3\x72\x7e\xbf\x80\xc7\x67\xb8\x7b\x7c\x78\x3f\x5f\xce\x1f\x1f\x16\xf0\xf8\x01\x66\x0f\xbf\xc2\xc7\xf9\xc3\xfb\x5b\x40\xaa\x37\x28\x01\xbf\x16\xd2\xf8\x2f\x24\x50\x03\x24\xa6\x86\xd3\x26\x81\x1a\x07\x4c\x7e\x98\x63\x55\x60\x42\xd7\x34\x01\x46\x78\x56\x92\x0c\x21\x13\x5b\x94\xdc\xa4\x47\x81\x32\xa7\xca\xd0\xa9\x80\xf0\x34\x98\x00\xa3\x39\xd5\x36\x8b\xd4\x69\x50\xc6\x4c\x53\x18\x57\x78\x05\x01\x29\x68\x9d\x4e\x31\x90\x82\xe2\x57\x8d\xdc\x7a\x13\xbd\xfc\x55\x45\x54\x4c\xb7\x3f\x06\x2f\x94\xa7\x31\xdc\x95\x4a\x8b\xfc\x19\x95\x28\x65\x82\xef\x71\x4d\xb9\xcd\xfc\x20\x47\x4d\x52\xa2\x49\x1c\x00\x30\xb2\x42\xa6\xcc\x37\x30\x84\xc6\x70\x93\x90\x1c\x59\xf8\x72\x13\x00\x10\xce\x45\x1d\x59\x25\x61\x4b\x52\x30\x86\x32\xcc\x90\x47\x2f\xe5\x0a\x57\x25\x65\x29\x4a\x6b\xb9\xf1\x6b\xfb\x43\xf4\x97\xe8\xc7\x00\x20\x91\x68\x2f\x5f\xd2\x1c\x95\x26\x79\x11\x03\x2f\x19\x0b\x00\x38\xc9\x31\x06\x6b\x2b\x21\x9a\x30\x91\xa9\xc8\x1e\xb5\xb2\x31\x30\x3c\x18\xc3\x99\x14\x65\x11\xc3\xc9\x7a\xa5\xa6\xf6\x3e\x21\x1a\x33\x21\x69\x73\x1c\xc2\x8b\xd1\x57\x7f\x4f\x0e\xdf\x6b\x70\xcc\xf1\x5d\x65\xd9\x8a\x30\xaa\xf4\xc7\x93\xa5\x4f\x54\x69\xbb\x5c\xb0\x52\x12\xf6\xca\x63\xbb\xa2\x36\x42\xea\x87\xa3\x1f\x21\x24\x49\xb5\x40\x79\x56\x32\x22\xbb\x17\x05\x00\x2a\x11\x05\xc6\x60\xaf\x29\x48\x82\x69\x00\x50\x63\x67\x7d\x0f\x5b\x4d\xea\x49\x52\xae\x51\xde\x09\x56\xe6\x0d\x0b\x21\xa4\xa8\x12\x49\x0b\x03\x6d\x6c\x3b\x93\xf5\x19\x3e\xc2\x73\xc9\x35\xcd\xb1\x51\x67\xfd\x00\xf8\xa2\x04\x7f\x22\x7a\x13\x43\x64\x20\x8d\x64\x25\x15\x75\xa5\x0c\x96\xf1\x41\xc3\xe7\xce\x9a\xde\x1b\x8f\x4d\x6b\xe5\x99\xaf\x0f\x85\x14\x5b\x9a\xa2\x74\x38\xf1\x4a\xac\xeb\xc5\x53\x77\xf1\xc4\x8d\x4a\x7a\x6b\x72\xcd\xe0\xba\xc1\xdc\x66\xb5\x39\x12\x05\xf2\xd9\xd3\xfc\xf3\x9f\x17\x9d\xd3\xd0\x75\xbc\x4d\x36\x50\xd3\xd5\x11\xaa\x0b\x0e\xdd\xa0\x43\x39\xcc\x9e\xe6\x07\x4d\x85\x14\x05\x4a\x7d\x48\xb9\xea\xd3\xaa\xd0\xd6\xd9\x57\x76\xdf\x19\xd7\xea\xb1\x90\x9a\xd2\xc4\xca\x76\x4d\x09\xa6\x75\x34\x55\x0b\xa7\xa6\xf3\x9a\x0e\x86\xbc\xaa\xc7\x8e\x62\x30\x42\x84\x83\x58\x7d\xc1\x44\x47\xb0\x40\x69\xd4\x80\xda\x88\x92\xa5\x66\x8e\x6e\x51\x6a\x90\x98\x88\x8c\xd3\x7f\x1e\x74\xab\x66\x3c\x33\xa2\xb1\xce\xf3\xe3\xdb\xe6\x1d\x27\x0c\xb6\x84\x95\x78\x6b\x9a\x9d\x9d\x52\x12\x8d\x15\x28\x79\x4b\x9f\x15\x51\x11\xfc\x24\x24\xda\xb1\x1a\xdb\xf9\xa2\xe2\xe9\x34\xa3\xba\xe9\x4c\x89\xc8\xf3\x92\x53\xbd\x9f\xb6\x46\xbb\x9a\xa6\xb8\x45\x36\x55\x34\x0b\x89\x4c\x36\x54\x63\xa2\x4b\x89\x53\x52\xd0\xd0\xba\xce\x4d\xc0\x2a\xca\xd3\x89\xac\x7b\x99\x7a\xd7\xf1\xf5\x24\x2b\xaa\x8f\x2d\xf4\x01\x06\x4c\xb5\x1b\xca\x49\x7d\x69\x15\xe8\x11\x68\x73\xca\xa0\xf3\x7c\xbf\x58\x42\x63\xda\x0e\xe7\x8e\x52\xa8\x71\x3f\x5e\xa8\x8e\x14\x18\xc0\x28\x5f\xdb\x99\x60\x86\xba\x14\xb9\xa5\x19\x79\x5a\x08\xca\xb5\x3d\x48\x18\x45\xfe\x1a\x7e\x55\xae\x72\xaa\x0d\xef\xbf\x95\xa8\xb4\xe1\x2a\x82\x3b\xdb\x91\x61\x85\x50\x16\x29\xd1\x98\x46\x30\xe7\x4d\x0e\x2b\xfc\xaf\x13\x60\x90\x56\xa1\x01\xd6\x8f\x82\xf6\xa4\x39\xbe\x8c\x96\xb8\x46\xad\xb5\xd0\x74\xfb\x1e\xbe\xda\x95\xba\x28\x30\xe9\x94\x4d\x8a\xca\xde\x96\x28\x4d\x34\x9a\x72\x68\x4b\x77\x74\x9e\xaf\x59\xf3\x26\x52\xd3\x35\x49\xf4\xc9\x02\x74\xfa\x71\xdf\xe5\xe7\x1d\x9e\xd5\x4a\x21\x0c\xcf\xc8\xf7\x3b\xd3\x75\x69\x9e\x9e\x5f\xef\x05\xfe\xf8\x36\xf0\x57\xb7\xb9\x3d\x36\x00\xa8\xc6\xbc\x77\xd1\xc3\x44\x23\x42\xa4\x24\xfb\xb3\x12\x29\x16\xc8\x53\xe4\x09\xc5\x4b\xbd\xe8\x87\xf6\x7d\xa3\x7c\x7f\x2c\x41\x20\x90\x93\x2d\xf2\x77\xea\x68\xfb\xbc\x6b\x3e\x34\xf8\x92\xe1\x8d\x97\xf9\xe0\xd7\x84\x95\x87\x99\xdf\xff\x76\xe0\xe2\x42\xe7\xbe\x31\x73\x3e\x03\xc7\xc3\x30\x0e\x8c\x51\x90\x54\x1f\x7b\xb7\x77\x75\xbd\xcd\x93\x8b\x4b\x6d\xd8\x0a\xcd\x29\x5a\xbb\xea\x90\xeb\xe9\x77\xe3\xcb\x68\x04\x3e\x9e\xc8\xd4\xb7\x1b\x57\xd0\xe5\xc6\xd7\x0b\x59\x37\xa6\x1e\x68\xba\x70\x74\x97\xde\xc5\xcd\xc8\xab\xdc\x7e\x97\x7e\x73\xbd\xb4\xf9\x23\x51\xed\x88\xda\x19\xef\x17\xb2\x25\x4b\x73\xd7\x73\x61\xa6\x78\x00\xea\x0a\xa1\xf9\x11\xe2\x77\x74\xc1\x3e\x8b\xe0\xf5\xaa\xc5\x3e\x61\xe1\xb7\x97\x48\x22\xb8\x2a\x73\x94\x43\x32\xfd\xf6\x17\xe6\x39\xdc\x3c\x1e\x99\x9f\xcc\x54\xf5\x58\x9e\xb6\x7f\x15\x1c\xd4\x0a\x40\x56\xa2\xd4\x40\xaa\xa7\x29\x1c\x14\xf6\x0b\xc7\xf7\x56\xc9\x1b\xf9\x21\x0c\x86\x6f\x9f\x3c\x54\xc2\x98\x5b\xac\x4b\xa0\x18\xd7\x06\x47\x25\xfc\xd8\x79\x70\x31\xee\x57\x99\x17\xdf\x0a\xe1\xa5\x40\x5e\x08\xe7\x88\x79\x73\x15\x5b\xee\x79\x74\xd1\x7c\x1a\x3f\xaf\x2e\x98\x5f\x63\x3b\xf1\x37\xa2\x3c\x1a\x5f\xaf\x1b\xc4\x8b\xf5\x8f\x61\x6e\x24\x67\x63\xd8\x1a\xc5\x93\x2f\x43\x9e\x4a\xcd\xef\x64\xc3\xe1\x57\x8a\x56\x42\x30\x24\x43\x23\x89\x3a\x60\xf4\xe4\xa6\x20\x4a\xd1\x2d\x5e\xc7\xa7\x42\x8a\xb4\x4c\xde\x06\xf4\xdb\x80\x7e\x1b\xd0\x6f\x03\xfa\x6d\x40\xbf\x0d\xe8\x3f\xd8\x80\x76\x03\x10\xda\x7f\x5a\x06\x96\x69\x7f\x84\x61\x33\x6d\x83\x6f\x70\xd2\x15\xaf\x23\x43\x1c\x19\x31\x04\x80\x83\xf1\x21\x86\x07\xe3\x1a\x58\x64\x82\xa4\x28\xaf\xfa\x17\xcd\x27\xab\xf2\x7c\x7b\x77\x35\xf2\x63\xfc\xe7\xd7\x9d\xf0\xfa\xdd\x4f\x38\xa6\x59\x27\xa6\x9f\xcc\xcc\x6f\xa6\xd7\xd0\xd0\xf2\x1b\x52\xee\x08\x3d\xe3\x1c\xd1\x0e\x3d\x75\x79\xb5\x3e\x2f\x5d\x3e\x55\x7e\x04\x62\x40\xa8\x3f\xe1\x9d\x99\xed\x5b\xce\x0e\x04\x9d\xf1\xfe\x0f\xfc\x9c\xf8\x7f\xd5\x91\xea\x7d\x2c\x71\x30\x58\x95\xf5\x86\x16\xfb\xc7\xf5\x99\x9a\x1c\xae\xc5\x7a\xa7\x9d\xe9\x59\x77\x8c\xa8\x1e\x72\x1c\xb0\x25\xa4\x20\x2b\xca\x68\x7f\xc1\xfb\xb6\xd0\x93\xe0\xee\x1a\xd5\xfb\xfe\x7e\xe3\xd3\x6d\x7c\x5a\xa1\x47\x86\x5e\xde\x12\x7d\x1d\xad\xf1\x3a\xa4\x9a\x4b\xd2\x49\xce\x88\x02\xbf\x48\xa7\x57\xab\x1c\xa5\xd3\xdd\x32\xbd\xdb\xa6\x5f\xeb\x74\x94\xe1\x98\xfe\xd3\xbf\x35\xe5\xf2\x82\x18\x05\x9f\x47\x1c\x2e\x7c\xc3\x4e\xb5\x04\x17\xda\x71\x08\xb8\x0b\x72\xb0\x14\x2f\x29\x42\x9f\xf2\x3b\xe6\x54\xbf\x8c\x27\x17\x1e\xc5\xe6\xa5\xc7\xa3\xc0\x3c\xf4\xb8\x49\x77\x96\x93\xab\x90\x3c\x53\xa2\xaf\x78\x86\xcb\x66\x4c\xc1\x38\xe1\x70\x78\xda\xec\x1b\x8d\x03\x67\xea\xd5\xc3\xb7\xd9\x4c\xda\x97\x7c\x0e\x8f\x06\x29\x1e\xbc\xb6\x9f\xd6\xf0\x64\xb6\x07\x23\x0b\x3d\x7c\xbd\xcf\xf6\xf8\x0a\x9b\x47\xbf\xc0\x1b\xda\xf3\x9e\x1e\xd3\xae\x6b\x3f\x6c\x1e\xc7\x5e\x9d\xad\x6f\x89\x02\x0f\x93\x66\xc3\x5e\xf9\x2a\x47\xfa\xb7\xfd\x59\xe1\xce\xc6\x3f\xb1\x52\x66\xb7\xab\xcf\xce\xbf\xb3\x1e\x9c\x9c\xac\xd4\xc5\xa0\x65\x59\xfd\xa5\xab\xb4\x90\x24\xc3\xf6\x99\x72\x75\xd8\x89\xda\x78\xae\x34\xd1\xa5\x8a\xe1\x5f\xff\x0e\xfe\x33\x00\xf8\x3f\x69\x14\x4d\x33\x00\x00"),
 		},
 		"/crd-integration-kit.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "crd-integration-kit.yaml",
 			modTime:          time.Time{},
-			uncompressedSize: 7815,
+			uncompressedSize: 7964,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x58\x5f\x93\xe2\xb8\x11\x7f\xf7\xa7\xe8\x1a\x1e\xf6\xae\x6a\x30\xb7\x49\x1e\x52\xe4\x89\xb0\x3b\x15\x6a\x77\x67\xa6\x06\xf6\xae\xae\x6a\x5f\x1a\xbb\x31\x7d\xc8\x92\x23\xc9\x30\x93\x54\xbe\x7b\x4a\x92\x0d\xf6\x60\x03\xc3\xed\xfa\x09\x2c\xa9\xfb\xd7\xff\x7e\xdd\xd6\x00\x86\xdf\xef\x89\x06\xf0\x99\x13\x92\x86\x52\xb0\x0a\xec\x9a\x60\x52\x60\xb2\x26\x98\xab\x95\xdd\xa1\x26\xb8\x53\xa5\x4c\xd1\xb2\x92\xf0\xd3\x64\x7e\xf7\x33\x94\x32\x25\x0d\x4a\x12\x28\x0d\xb9\xd2\x14\x0d\x20\x51\xd2\x6a\x5e\x96\x56\x69\x10\x41\x20\x60\xa6\x89\x72\x92\xd6\xc4\x00\x73\x22\x2f\xfd\xfe\x61\x31\x9b\x7e\x84\x15\x0b\x82\x94\x4d\x38\x44\x29\xec\xd8\xae\xa3\x01\xd8\x35\x1b\xd8\x29\xbd\x81\x95\xd2\x80\x69\xca\x4e\x31\x0a\x60\xb9\x52\x3a\x0f\x30\x34\x65\xa8\x53\x96\x19\x24\xaa\x78\xd1\x9c\xad\x2d\xa8\x9d\x24\x6d\xd6\x5c\xc4\xd1\x00\x16\xce\x8c\xf9\x5d\x8d\xc4\x04\xb1\x5e\xa7\x55\xf0\xbb\x2a\x2b\x1b\x1a\xe6\x56\x5e\xb8\x85\x5f\x49\x1b\xa7\xe4\x2f\xf1\x2f\xd1\x00\x7e\x72\x5b\x6e\xaa\xc5\x9b\x9f\xff\x01\x2f\xaa\x84\x1c\x5f\x40\x2a\x0b\xa5\xa1\x86\x64\x7a\x4e\xa8\xb0\xc0\x12\x12\x95\x17\x82\x51\x26\x74\x30\x6b\xaf\x21\x06\x0f\xc0\xc9\x50\x4b\x8b\x2c\x01\xbd\x19\xa0\x56\xcd\x6d\x80\x36\x1a\x44\x03\xf0\xcf\xda\xda\x62\x3c\x1a\xed\x76\xbb\x18\x3d\xdc\x58\xe9\x6c\x54\x5b\x37\xfa\x3c\x9b\x7e\xbc\x9f\x7f\x1c\x7a\xc8\xd1\x00\xbe\x4a\x41\xc6\x80\xa6\x7f\x97\xac\x29\x85\xe5\x0b\x60\x51\x08\x4e\x70\x29\x08\x04\xee\x5c\xe0\x7c\x74\x7c\xd0\x59\xc2\x4e\xb3\x65\x99\xdd\x82\xa9\xa2\x1e\x0d\x5a\xd1\x39\xb8\xab\x86\xc7\xa6\xb5\x41\x49\x40\x09\x37\x93\x39\xcc\xe6\x37\xf0\xcf\xc9\x7c\x36\xbf\x8d\x06\xf0\xdb\x6c\xf1\xaf\x87\xaf\x0b\xf8\x6d\xf2\xf4\x34\xb9\x5f\xcc\x3e\xce\xe1\xe1\x09\xa6\x0f\xf7\x1f\x66\x8b\xd9\xc3\xfd\x1c\x1e\xee\x60\x72\xff\x3b\x7c\x9a\xdd\x7f\xb8\x05\x62\xbb\x26\x0d\xf4\x5c\x68\x87\x5f\x69\x60\xe7\x48\x4a\x5d\x4c\xeb\x04\xaa\x01\xb8\xfc\x70\xff\x4d\x41\x09\xaf\x38\x01\x81\x32\x2b\x31\x23\xc8\xd4\x96\xb4\x74\xe9\x51\x90\xce\xd9\xb8\x70\x1a\x40\x99\x46\x03\x10\x9c\xb3\xf5\x59\x64\x8e\x8d\x72\x6a\xbe\x67\x6d\x45\x58\x70\x95\x4e\x63\xc0\x82\xe9\xd9\x92\xf4\x68\xe2\xcd\xdf\x4d\xcc\x6a\xb4\x7d\x1f\x6d\x58\xa6\x63\x98\x96\xc6\xaa\xfc\x89\x8c\x2a\x75\x42\x1f\x68\xc5\xd2\x67\x7e\x94\x93\xc5\x14\x2d\x8e\x23\x00\x81\x4b\x12\xc6\xfd\x02\x17\xd0\x31\xdc\x24\x98\x93\x18\x6e\x6e\x22\x00\x94\x52\x55\x96\x85\x1d\xbe\x24\x95\x10\xa4\x87\x19\xc9\x78\x53\x2e\x69\x59\xb2\x48\x49\x7b\xcd\x35\xae\xed\x2f\xf1\xdf\xe2\xf7\x11\x40\xa2\xc9\x1f\x5f\x70\x4e\xc6\x62\x5e\x8c\x41\x96\x42\x44\x00\x12\x73\x1a\x03\x4b\x4b\x99\xf6\x5b\x36\x6c\x4d\xec\x75\x37\xf2\x31\x72\x91\x70\xaa\x33\xad\xca\x62\x0c\x47\xeb\x41\x50\x8d\x0e\x2d\x65\x4a\x73\xfd\x7f\x08\x1b\xb7\xbf\xfa\x9d\xec\x7f\x07\xf7\xcc\x0e\xba\x3f\xb1\xf5\x0b\x82\x8d\xfd\xd4\xb1\xf8\x99\x4d\xd8\x50\x88\x52\xa3\x38\xc2\xed\xd7\xcc\x5a\x69\x7b\x7f\x40\x33\x04\xde\x84\x05\x96\x59\x29\x50\xbf\x3e\x16\x01\x98\x44\x15\x34\x06\x7f\xaa\xc0\x84\xd2\x08\xa0\xf2\xa2\x97\x32\x6c\xd0\xd5\xa3\x76\xc7\xf5\x54\x89\x32\x97\x7b\x1d\x29\x99\x44\x73\x61\xbd\xdf\x1d\x47\x35\x74\xc0\x86\x2d\x14\x6b\x34\x14\x85\x9a\xff\xc3\x28\xf9\x88\x76\x3d\x86\xd8\x58\xb4\xa5\x89\x9b\xab\x21\x24\x8f\x8d\x37\xf6\xc5\xa1\x73\x15\x29\xb3\x4b\xf5\xb9\x33\xc7\xea\xea\x94\x8b\x43\xbe\x85\x40\x7f\xab\x22\xf9\xcd\x85\xf2\xdb\x68\xc3\xf6\x5b\xdc\x38\x1e\xf0\x2c\x0e\x2f\xae\x81\xc3\x39\x66\xfd\xe6\x37\x57\x83\xba\x59\xe3\xcd\x91\xbe\xb0\x65\xfb\x3e\x84\x35\x59\x53\x8e\xe3\x6a\xaf\x2a\x48\x4e\x1e\x67\xbf\xfe\x75\xde\x7a\x0d\x6d\x84\xed\xb4\x72\x84\xe7\xa8\x22\x1c\xd9\xb3\xcf\xab\xe4\x82\xc9\xe3\x6c\x2f\xad\xd0\xaa\x20\x6d\xf7\x29\x1e\x9e\x06\x27\x34\xde\xbe\xd2\xfd\xce\xc1\xab\x1a\x51\xea\xc8\x80\x82\xf6\x2a\xdf\x28\xad\x2c\x0a\x4d\x83\x1d\xd7\x3b\xce\x24\x19\x18\xa0\x25\x18\xdc\x26\x94\xa0\x96\x7f\x50\x62\x63\x98\x93\x76\x62\x5c\x0d\x94\x22\x75\x34\xb1\x25\x6d\x41\x53\xa2\x32\xc9\xff\xd9\xcb\x36\xf5\x40\x20\xd0\x52\x55\x53\x87\xc7\xe7\xb7\x6b\xcc\x5b\x14\x25\xdd\x3a\x7a\xf5\x3d\x4d\x93\xd3\x02\xa5\x6c\xc8\xf3\x5b\x4c\x0c\x5f\x94\x26\xdf\xc8\xc7\xbe\xa3\x99\xf1\x68\x94\xb1\xad\xb9\x30\x51\x79\x5e\x4a\xb6\x2f\xa3\xc6\x30\x61\x46\x29\x6d\x49\x8c\x0c\x67\x43\xd4\xc9\x9a\x2d\x25\xb6\xd4\x34\xc2\x82\x87\x1e\xba\xf4\x94\x17\xe7\xe9\x40\x57\xec\x69\xde\xb5\xb0\x1e\x65\x46\x78\x3c\xb1\x9c\x88\x80\xe3\x16\x17\x74\xac\x8e\x06\x2b\x0e\x8e\x76\xaf\x9c\x77\x9e\x3e\xce\x17\x50\xab\xf6\xc1\x78\xed\x7d\xef\xf7\xc3\x41\x73\x08\x81\x73\x18\xcb\x95\xef\x42\x6e\x8c\xd0\x2a\xf7\x32\x49\xa6\x85\x62\x69\xfd\x9f\x44\x30\xc9\xd7\xee\x37\xe5\x32\x77\xf9\xe6\x7a\x3c\x19\xeb\x62\x15\xc3\xd4\xf7\x00\x58\x12\x94\x45\x8a\x96\xd2\x18\x66\x12\xa6\xae\x7c\xa7\xe8\x26\x8f\x1f\x1c\x00\xe7\x69\x33\x74\x8e\xbd\x2c\x04\xcd\xde\xf6\x7a\x73\xf0\x5a\x63\xa1\xee\x2e\x3d\xf1\x6a\x57\xeb\xbc\xa0\xa4\x55\x38\x29\x19\x3f\x0a\x39\x2e\x21\x57\x10\x1d\x1d\xa5\x7e\xba\xeb\x16\x42\x4b\x5d\x71\x56\x86\x63\xaf\x17\x01\xd8\x52\x7e\x74\xe6\x08\xe9\xb4\x29\xc4\x03\x1d\x0e\x3b\xce\xf4\xa3\x68\xb8\xa9\x73\xa5\xd7\xdf\x87\xc7\xe7\xf2\x95\xa7\xeb\xb1\xb2\xeb\xf8\xb0\xd9\x52\xda\x0b\x5e\x65\xc7\x4a\x4f\xb8\x9b\x8b\xa8\x35\xbe\xbc\x5a\x4b\xa9\x20\x99\x92\x4c\x3a\x1d\xd4\x1b\x89\x93\xb6\xf5\x6b\xf3\xbd\xe7\x58\xde\x09\x69\x85\x56\xee\xab\xe0\xf8\x4c\xbb\x0b\x6a\x64\xfb\x18\xb6\x36\x18\xc2\x0f\x37\xc6\x93\xbb\xdb\xe0\x52\x18\x2d\xb8\x8f\x31\x92\x6e\x86\x4f\x3b\x2c\x3b\x9a\x87\x59\x1a\x8b\x42\xf8\x34\x1b\x35\xfa\xd4\x5b\xac\xd0\x54\x28\xc3\xb6\x31\xa7\xfd\x48\x2f\x07\x63\x8f\x05\x36\xe7\xaa\x53\x45\xd1\x72\xed\x24\x38\xd7\x17\x98\xe3\x33\x64\x19\xa8\xa0\x55\xc4\xbe\x37\x06\xc5\x57\xd4\xe0\x19\x3e\xe8\x40\xe5\x31\xb5\x18\xa0\xbb\xfa\xe1\x5c\x65\x84\xe7\x79\xe8\xc6\x7a\x2d\xc9\x92\x19\xfa\xec\xd1\x5b\x1a\x96\x72\x23\xd5\x4e\x0e\x57\x4c\x22\x35\x63\xb0\xba\xb3\xf2\x4e\x17\x72\xcb\xb6\xeb\xea\xb6\x63\xb1\x8f\xdc\xfd\x88\x77\x39\xbd\xfb\xed\x2d\x82\x57\x4b\x6f\xfb\x9f\x65\x78\xd4\x96\x57\x98\x74\x65\xe1\x65\xec\x3e\xa9\x04\x5c\x47\xea\xc9\x9a\x92\x8d\x29\xf3\xab\x89\x9d\x3b\xa3\x79\xd1\x51\xa1\x92\x93\x79\x7c\x56\x80\x45\x9d\x91\xfd\x21\x5d\x85\xbb\x18\xef\xca\xce\xb1\x44\x43\xb3\x37\xf3\x79\xa2\x64\x20\xa1\xab\x33\xa3\x9d\x8f\xd3\x5a\x5e\xb5\x69\x59\xe5\xf1\x3e\x7d\x71\x3f\x54\x76\x3a\xd4\xf5\x03\x48\x48\xfb\x1b\x24\x3f\x2c\xc6\x57\xe4\x9b\x40\x63\x17\x1a\xa5\xe1\xfa\xcb\xff\x22\x16\xfb\x8c\xc6\x82\xe5\x9c\x6a\x42\xad\x4c\xb1\x7b\x51\x94\x86\x69\x56\x49\xaa\x8a\xbb\x47\x2e\xb8\xaf\x0c\x94\xca\xae\x49\x77\x59\x00\xa1\xb5\xe5\x68\xc7\xe0\x66\xda\xa1\x53\x7b\x75\x8a\xa3\xb1\x5f\xfd\x68\x7c\xb1\xa9\x0b\xff\x01\x74\x30\x97\x4d\xc3\xde\x1d\x9a\xfd\xa8\xfd\xa3\xb1\xe7\x64\x4c\x67\xd2\x76\x80\x9e\xc0\xba\xcc\x51\x82\x26\x4c\xfd\x9d\x5f\x75\x18\x58\xa6\xec\xaa\x5c\x66\x90\x92\x45\x16\x06\x70\xa9\xca\xbe\xf6\x02\x3e\xbe\x87\xa8\xf6\x19\x79\x16\xbc\x26\x34\x97\x76\xc8\x35\x55\xdb\xf7\x23\xcd\xde\xe1\xef\x4c\x15\x8b\x3f\x8f\xa8\xab\xe3\xf4\x20\xaa\xba\x4d\x75\x3f\xbb\x07\x73\x1b\xae\xc0\x57\xb0\xd0\xee\x03\xf8\x0e\x85\xa1\x5b\xf8\x1a\x7a\xef\xd5\xb8\x4e\x8d\xf5\x6d\x3f\xbd\x14\x5e\x7b\xf3\x12\x65\x8f\xed\x4a\xf5\xa7\x49\xb8\xb7\x8e\x7b\xa7\xfe\x6b\x67\x7b\xce\xc8\x74\x34\x92\x13\xe8\x57\xc8\xa2\xd4\xe7\xc6\xed\xbb\xb0\xab\xab\x37\x9f\x66\xca\x53\xf9\x7b\xd6\xa7\x89\xda\x92\x7e\xe9\x3e\xdc\x05\xef\xa9\x3a\xd1\x37\x19\x9e\x23\x75\xd7\x1c\x2c\xe5\x45\x6f\x2b\xae\x31\xfb\xd4\x21\x7d\x5a\xc8\x17\x7c\xfe\x2e\x72\x4e\x31\xee\xe5\x34\x79\x11\xd7\xf4\x27\xb1\xbf\xa5\x0d\x78\x4e\xaf\x7e\xc1\xe7\x13\xc1\xee\x9d\xc9\x6d\xaf\x91\x97\x19\x78\xd2\xb8\x7e\xc3\x86\x55\x82\x76\x2e\x84\x64\xea\x58\xea\x44\x71\xc2\xc0\x2b\x3e\x82\xd7\x68\xce\xd5\x64\x7b\x2c\xf2\x37\xda\x5d\x89\x7f\x4a\x8b\x40\xeb\xdc\xfb\x26\x68\xba\x94\xce\xfe\x47\xad\xb6\x9c\x92\x3e\x03\xf2\xa9\xbd\xfb\x8d\x00\x2b\x5d\x9d\xd7\xbe\x67\x8e\x6e\xdf\x7c\xa6\x33\x80\x47\x2f\xc3\xc7\x52\xe3\xd3\xd0\x58\xa5\x5d\x78\x1b\x6f\xca\xe5\xfe\x46\xb5\xd6\x5f\x75\x4d\xf8\xef\xff\xa2\xff\x07\x00\x00\xff\xff\x61\x59\x2c\x51\x87\x1e\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x58\x5f\x93\xe2\xb8\x11\x7f\xf7\xa7\xe8\x1a\x1e\xf6\xae\x6a\x6c\xee\x92\x3c\xa4\x9c\x27\xc2\xee\x54\xa8\xdd\x9d\x99\x1a\xd8\xbb\xba\xaa\x7d\x69\xec\xc6\xe8\x90\x25\x47\x92\x61\x48\x2a\xdf\x3d\xd5\xb2\x0d\xf6\x60\xcc\x0c\xbb\x6b\x78\x00\xab\xd5\xfd\xeb\xff\x2d\x8d\x20\xfc\x7e\x4f\x30\x82\x4f\x22\x21\x65\x29\x05\xa7\xc1\xad\x09\x26\x05\x26\x6b\x82\xb9\x5e\xb9\x1d\x1a\x82\x3b\x5d\xaa\x14\x9d\xd0\x0a\x7e\x9a\xcc\xef\x7e\x86\x52\xa5\x64\x40\x2b\x02\x6d\x20\xd7\x86\x82\x11\x24\x5a\x39\x23\x96\xa5\xd3\x06\x64\xc5\x10\x30\x33\x44\x39\x29\x67\x23\x80\x39\x91\xe7\x7e\xff\xb0\x98\x4d\x3f\xc0\x4a\x48\x82\x54\xd8\x6a\x13\xa5\xb0\x13\x6e\x1d\x8c\xc0\xad\x85\x85\x9d\x36\x1b\x58\x69\x03\x98\xa6\x82\x05\xa3\x04\xa1\x56\xda\xe4\x15\x0c\x43\x19\x9a\x54\xa8\x0c\x12\x5d\xec\x8d\xc8\xd6\x0e\xf4\x4e\x91\xb1\x6b\x51\x44\xc1\x08\x16\xac\xc6\xfc\xae\x41\x62\x2b\xb6\x5e\xa6\xd3\xf0\x87\x2e\x6b\x1d\x5a\xea\xd6\x56\xb8\x85\xdf\xc8\x58\x16\xf2\x97\xe8\x97\x60\x04\x3f\x31\xc9\x4d\xbd\x78\xf3\xf3\x3f\x60\xaf\x4b\xc8\x71\x0f\x4a\x3b\x28\x2d\xb5\x38\xd3\x73\x42\x85\x03\xa1\x20\xd1\x79\x21\x05\xaa\x84\x8e\x6a\x1d\x24\x44\xe0\x01\x30\x0f\xbd\x74\x28\x14\xa0\x57\x03\xf4\xaa\x4d\x06\xe8\x82\x51\x30\x02\xff\xac\x9d\x2b\xe2\xf1\x78\xb7\xdb\x45\xe8\xbd\x13\x69\x93\x8d\x1b\xed\xc6\x9f\x66\xd3\x0f\xf7\xf3\x0f\xa1\x87\x1c\x8c\xe0\x8b\x92\x64\x2d\x18\xfa\x77\x29\x0c\xa5\xb0\xdc\x03\x16\x85\x14\x09\x2e\x25\x81\xc4\x1d\x3b\xce\x7b\xc7\x3b\x5d\x28\xd8\x19\xe1\x84\xca\x6e\xc1\xd6\x5e\x0f\x46\x1d\xef\x1c\xcd\xd5\xc0\x13\xb6\x43\xa0\x15\xa0\x82\x9b\xc9\x1c\x66\xf3\x1b\xf8\xe7\x64\x3e\x9b\xdf\x06\x23\xf8\x7d\xb6\xf8\xd7\xc3\x97\x05\xfc\x3e\x79\x7a\x9a\xdc\x2f\x66\x1f\xe6\xf0\xf0\x04\xd3\x87\xfb\xf7\xb3\xc5\xec\xe1\x7e\x0e\x0f\x77\x30\xb9\xff\x03\x3e\xce\xee\xdf\xdf\x02\x09\xb7\x26\x03\xf4\x5c\x18\xc6\xaf\x0d\x08\x36\x24\xa5\xec\xd3\x26\x80\x1a\x00\x1c\x1f\xfc\xdf\x16\x94\x88\x95\x48\x40\xa2\xca\x4a\xcc\x08\x32\xbd\x25\xa3\x38\x3c\x0a\x32\xb9\xb0\xec\x4e\x0b\xa8\xd2\x60\x04\x52\xe4\xc2\xf9\x28\xb2\xa7\x4a\xb1\x98\x26\x31\xbe\xc3\x13\x04\x58\x88\x3a\x9c\x62\xc0\x42\xd0\xb3\x23\xe5\xd1\x44\x9b\xbf\xdb\x48\xe8\xf1\xf6\xd7\x60\x23\x54\x1a\xc3\xb4\xb4\x4e\xe7\x4f\x64\x75\x69\x12\x7a\x4f\x2b\xa1\x7c\xe4\x07\x39\x39\x4c\xd1\x61\x1c\x00\x48\x5c\x92\xb4\xfc\x0b\xd8\xa1\x31\xdc\x24\x98\x93\x0c\x37\x37\x01\x00\x2a\xa5\x6b\xcd\x2a\x0a\x9f\x92\x5a\x4a\x32\x61\x46\x2a\xda\x94\x4b\x5a\x96\x42\xa6\x64\xbc\xe4\x06\xd7\xf6\x97\xe8\x6f\xd1\xaf\x01\x40\x62\xc8\x6f\x5f\x88\x9c\xac\xc3\xbc\x88\x41\x95\x52\x06\x00\x0a\x73\x8a\x41\x28\x47\x99\xf1\x24\x1b\xe1\x6c\xe4\x65\xb7\xe2\x31\x60\x4f\xb0\xe8\xcc\xe8\xb2\x88\xe1\x64\xbd\x62\x54\xe3\x4f\xd0\x51\xa6\x8d\x68\xfe\x87\xb0\x61\xfa\xfa\x77\x72\xf8\x5d\x99\x67\x76\x94\xfd\x51\x38\x4f\x24\x85\x75\x1f\x7b\x16\x3f\x09\x5b\x11\x14\xb2\x34\x28\x4f\x70\xfb\x35\xbb\xd6\xc6\xdd\x1f\xd1\x84\x20\x36\xd5\x82\x50\x59\x29\xd1\xbc\xdc\x16\x00\xd8\x44\x17\x14\x83\xdf\x55\x60\x42\x69\x00\x50\x5b\xd1\xeb\x10\xb6\xca\xd5\xa3\xe1\xed\x66\xaa\x65\x99\x37\xfe\x08\x21\x25\x9b\x18\x51\x30\xd0\xd8\xd7\xa8\x96\x0c\xd8\x08\x07\xc5\x1a\x2d\x79\x1c\x00\x7f\x5a\xad\x1e\xd1\xad\x63\x88\xac\x43\x57\xda\xa8\xbd\xca\x96\x8c\xe1\xb1\xf5\xc6\xed\x19\x1d\x17\x54\x95\xbd\x56\x1e\xef\x39\x15\xd7\x84\x5c\x54\xc5\x5b\xe5\xe8\xaf\xb5\x27\xbf\xb2\x2b\xbf\x8e\x37\xc2\x7d\x8d\x5a\xdb\x2b\x3c\x8b\x7d\xf1\x2d\x70\x24\xee\x75\xe9\xbe\x01\x50\x87\x41\x05\xe9\x53\xfb\xd5\x35\xa0\x44\x8e\x19\x9d\x62\xaa\x7d\xd2\x5e\xad\x04\xce\x5a\x6f\x4e\xe4\x55\x24\x5b\x4e\x37\x0e\xa8\x35\xe5\x3e\xb1\xf9\x9f\x2e\x48\x4d\x1e\x67\xbf\xfd\x75\xde\x79\x0d\x5d\x84\xdd\x58\x07\xc1\xad\x8d\xa0\xda\x72\x28\x89\x2d\x15\x38\x53\x61\xf2\x38\x3b\x70\x2b\x8c\x2e\xc8\xb8\x43\xde\x55\xdf\x56\xa1\x6a\xbd\x7d\x21\xfb\x1d\xc3\xab\xbb\x63\xca\x15\x8a\x2a\xe9\x75\x12\x50\x5a\x6b\x54\x75\x32\xc1\x0d\x88\x0b\x39\xa9\xaa\x2c\x75\x18\x03\x13\xa1\x02\xbd\xfc\x93\x12\x17\xc1\x9c\x0c\xb3\x01\xbb\xd6\xa5\x4c\x79\x9c\xd8\x92\x71\x60\x28\xd1\x99\x12\xff\x39\xf0\xb6\xcd\x94\x22\xd1\x51\x9d\xe8\xc7\x0f\x2b\x6e\x14\x4a\xd8\xa2\x2c\xe9\x96\x6b\xbe\x6f\xd6\x86\x58\x0a\x94\xaa\xc5\xcf\x93\xd8\x08\x3e\x6b\xc3\x4e\x5f\xe9\xd8\xb7\x59\x1b\x8f\xc7\x99\x70\x4d\x81\x4e\x74\x9e\x97\x4a\xb8\xfd\xb8\x35\xe1\xd8\x71\x4a\x5b\x92\x63\x2b\xb2\x10\x4d\xb2\x16\x8e\x12\x57\x1a\x1a\x63\x21\x42\x0f\x5d\xb1\xc2\x36\xca\xd3\x91\xa9\x4b\xba\x7d\xd7\xc1\x7a\x12\x19\xd5\xd7\x57\xbb\x01\x0f\x70\xc1\x63\xa7\x63\xbd\xb5\x52\xf4\x68\x68\x7e\xc5\xd6\x79\xfa\x30\x5f\x40\x23\xda\xcf\x28\x1d\xa6\x50\xdb\xfd\xb8\xd1\x1e\x5d\xc0\x06\x13\x6a\xe5\x5b\x23\xcf\x36\x46\xe7\xde\xcd\xa4\xd2\x42\x0b\xe5\xfc\x9f\x44\x0a\x52\x2f\xcd\x6f\xcb\x65\xce\xf1\xc6\x83\x07\x59\xc7\xbe\x8a\x60\xea\x1b\x13\x2c\x09\xca\x22\x45\x47\x69\x04\x33\x05\x53\x4e\xe1\x29\x5a\xfa\xe1\x0e\x60\x4b\xdb\x90\x0d\xfb\x3a\x17\x34\xc5\x26\xee\x21\xae\xac\xd6\x5a\x68\x5a\xde\x19\x7f\x75\xb3\x75\x5e\x50\xd2\x49\x9c\x94\xac\x9f\xcf\xb8\x96\x10\x27\x44\x97\xbe\xc3\xb7\x3f\x6f\xeb\x3e\xbf\x12\x59\x59\x35\xc0\x97\x8b\x00\xc2\x51\x7e\xb2\xe7\x04\xe9\xb4\xcd\xc4\x03\x0d\xc3\x9e\x3d\xe7\x51\xb4\xcc\xd4\xbb\x72\xd6\xde\xc7\x8f\x8f\xe5\x2b\x77\x37\xb3\x6e\xdf\xf6\xb0\xdd\xe7\xda\x4f\x58\xa5\x4f\xcf\xca\x19\x77\xb7\x17\xd1\x18\xdc\xbf\x58\x4b\xa9\x20\x95\x92\x4a\x7a\x0d\x74\xd6\x13\x83\xba\x9d\x97\xe6\x7b\x4f\x1c\xbc\x81\x5b\x61\x34\x1f\x82\xe2\x60\x30\x16\x16\x06\x85\x7b\xac\x48\x5b\x15\xc2\x4f\x5c\x96\xc3\xd4\x31\x01\x87\x30\x3a\xe0\x13\x22\x29\x3e\x58\xa4\x27\x5c\xe1\x74\x48\x17\xca\x3a\x94\xd2\xc7\xea\xb8\xd5\xa7\xde\xa2\x85\xa1\x42\x5b\xe1\x5a\xc3\xe3\x8f\xb4\x72\xa5\xec\x29\xc3\xf6\xb0\x37\x94\x14\x1d\xd3\x4e\x2a\xe3\xfa\x04\xe3\x7a\x86\x42\xb1\x1d\xa9\x9b\xc4\x6c\x63\xac\x04\x5f\x91\x83\x17\xea\xc1\x39\x87\x77\x2a\x40\x7f\xf6\x5f\xcc\x8c\xea\xfb\x1c\xf2\x59\xc3\x28\x72\x64\x43\x3f\x01\x98\x2d\x85\xa5\xda\x28\xbd\x53\xe1\x4a\x90\x4c\x6d\x0c\xce\x94\xf4\xe6\x44\xee\xe8\x16\xbc\x11\xdd\xd9\xc5\x33\x0b\x5c\x96\xcb\x17\x46\x1e\x2a\xef\x9e\xbc\x53\xe0\xf5\xd2\xf2\x5c\xf3\xad\x15\x1e\x8d\x13\x2b\x4c\xdc\x5b\xa2\xbd\x03\x74\x52\x33\xe8\x77\xeb\xc5\x80\x5a\x53\xb2\xb1\x65\xde\xbf\x7a\x21\xb1\xf8\x2b\xd2\xab\xb7\x4a\x9d\x0c\xc6\xf1\x45\x06\x0e\x4d\x46\xee\x87\x74\x15\xd1\x57\xf1\xce\x06\xd9\x70\x95\x59\xa2\xa5\xd9\x9b\xeb\x79\xa2\x55\x55\x84\xae\x8e\x8c\x6e\x3c\x4e\x1b\x7e\x35\xd1\xb2\x8e\xe3\x43\xf8\xe2\x61\xa8\xec\x61\x0c\xc0\xfd\x00\x12\x32\xfe\x5a\xcb\x0f\x8b\xd1\x15\xf1\x26\xd1\xba\x85\x41\x65\xbd\x6a\x7c\x1d\xd1\x4f\xf7\x42\x95\x4f\x68\x1d\x38\x91\x53\x53\x50\x6b\x55\xdc\x81\x15\xa5\xd5\x34\xcb\x57\x97\xac\x52\x69\xcf\xf0\x05\x3e\x65\xa0\xd2\x7c\x1d\xd5\xa7\x41\xdd\xda\x72\x74\x31\xf0\x4c\x1b\xb2\xd8\xeb\x42\x8c\x6f\x75\xac\xfb\xe2\x47\xe3\x57\xab\xca\xe7\x54\xd9\x52\x57\xd8\x96\xbe\x3b\xb4\x87\x51\xfb\x47\x63\xcf\xc9\x5a\xcc\x5e\x07\x7a\x02\xeb\x32\x47\xbe\xbd\xc5\x94\xe7\x85\x66\x33\x08\x95\x0a\xce\x72\x95\x41\x4a\x0e\x85\xb4\x80\xcb\xe3\xa1\xfd\xf4\x61\xff\x1e\xbd\x1a\x5d\x0b\xde\x10\xda\xd7\x76\xc8\x35\x31\x6e\xab\xd5\x61\xa4\x39\x18\xfc\x9d\xad\x7d\xf1\xed\x88\xfa\x3a\xce\x19\x44\x75\xb7\xd1\xab\x2e\x98\xdb\xea\x5e\x7e\x05\x0b\xc3\x07\xe0\x3b\x94\x96\x6e\xe1\x4b\xd5\x7b\xaf\xc6\x35\x34\xd6\x77\xed\xb4\x2f\x7c\x9d\x68\x4d\x76\x47\x6c\xd1\x8f\x28\xc2\x67\xf3\xf8\xec\xd4\x7f\x65\x85\x4e\x45\x46\xb6\xa7\x91\x0c\xa0\x5f\xa1\x90\xa5\xe9\xb1\x5c\xc7\x66\x77\x15\x55\x5f\x6f\x1e\xae\x94\x43\xf1\x7b\xd1\xa6\x09\x5f\x92\xef\xe3\xe0\xa2\x4b\x6b\x78\x4f\xf5\x8e\xfe\x11\xe2\x72\x51\xe7\xe6\xe0\x28\x2f\xce\xb6\xe2\x06\xb3\x0f\x1d\x32\xc3\x4c\x3e\xe3\xf3\x77\xe1\x33\x54\x71\x5f\x5f\x26\x2f\x9a\x7b\x38\x88\xf9\x20\x5a\xe3\x19\x5e\xfd\x8c\xcf\xbd\x04\x83\x11\x0d\xe0\xce\x2a\xf9\x3a\x05\x07\x95\x3b\xaf\x58\x58\x07\x68\xef\x42\x15\x4c\x3d\x4b\xbd\x28\x06\x14\xbc\xe2\x10\xcc\x97\xe6\x71\x30\x18\xf4\xdd\xb1\xc8\x5f\xb3\xf7\x05\xfe\x90\x14\x89\x8e\xcd\xfb\x26\x68\xa6\x54\xac\xff\xa3\xd1\x5b\x91\x92\xb9\x00\xf2\xa9\x4b\xfd\x46\x80\xb5\xac\xde\x6b\xdf\x0b\x5b\xb7\x6f\xde\xd3\xeb\xc0\x93\x97\xd5\x61\xa9\x75\x34\xb4\x4e\x1b\x76\x6f\xeb\x4d\xb9\x6c\x26\xd0\x43\xa1\xb1\x0e\x5d\x69\x63\xf8\xef\xff\x82\xff\x0f\x00\x4a\x99\x64\xb7\x1c\x1f\x00\x00"),
 		},
 		"/crd-integration-platform.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "crd-integration-platform.yaml",
 			modTime:          time.Time{},
-			uncompressedSize: 16886,
+			uncompressedSize: 339420,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5b\xdf\x8f\xe2\x38\xf2\x7f\xcf\x5f\x51\x1a\x1e\x66\x57\x6a\x60\xe7\xfb\xbd\x87\x13\xf7\x70\xea\x65\x66\x74\x68\x66\xba\x5b\x0d\x33\xab\x7d\x34\x49\x11\xbc\x38\x76\xce\x3f\x9a\x61\x4f\xf7\xbf\x9f\x5c\x4e\x20\x40\x12\x42\x77\xaf\x4e\xba\x8d\x5f\xba\x21\x76\xf9\x53\x55\xae\x8f\xcb\x2e\x32\x80\xe1\xeb\xb5\x68\x00\x9f\x79\x8c\xd2\x60\x02\x56\x81\x5d\x23\xdc\xe6\x2c\x5e\x23\xcc\xd5\xca\x6e\x99\x46\xf8\xa8\x9c\x4c\x98\xe5\x4a\xc2\x0f\xb7\xf3\x8f\x3f\x82\x93\x09\x6a\x50\x12\x41\x69\xc8\x94\xc6\x68\x00\xb1\x92\x56\xf3\xa5\xb3\x4a\x83\x08\x02\x81\xa5\x1a\x31\x43\x69\xcd\x08\x60\x8e\x48\xd2\xef\xee\x17\xb3\xe9\x07\x58\x71\x81\x90\x70\x13\x06\x61\x02\x5b\x6e\xd7\xd1\x00\xec\x9a\x1b\xd8\x2a\xbd\x81\x95\xd2\xc0\x92\x84\xfb\x89\x99\x00\x2e\x57\x4a\x67\x01\x86\xc6\x94\xe9\x84\xcb\x14\x62\x95\xef\x34\x4f\xd7\x16\xd4\x56\xa2\x36\x6b\x9e\x8f\xa2\x01\x2c\xbc\x1a\xf3\x8f\x25\x12\x13\xc4\xd2\x9c\x56\xc1\xaf\xca\x15\x3a\x54\xd4\x2d\xac\x70\x03\xdf\x50\x1b\x3f\xc9\xff\x8d\x7e\x8a\x06\xf0\x83\xef\xf2\xa6\x78\xf8\xe6\xc7\xbf\xc1\x4e\x39\xc8\xd8\x0e\xa4\xb2\xe0\x0c\x56\x24\xe3\xf7\x18\x73\x0b\x5c\x42\xac\xb2\x5c\x70\x26\x63\x3c\xa8\xb5\x9f\x61\x04\x04\xc0\xcb\x50\x4b\xcb\xb8\x04\x46\x6a\x80\x5a\x55\xbb\x01\xb3\xd1\x20\x1a\x00\xb5\xb5\xb5\xf9\x64\x3c\xde\x6e\xb7\x23\x46\x70\x47\x4a\xa7\xe3\x52\xbb\xf1\xe7\xd9\xf4\xc3\xdd\xfc\xc3\x90\x20\x47\x03\xf8\x2a\x05\x1a\x03\x1a\xff\xe9\xb8\xc6\x04\x96\x3b\x60\x79\x2e\x78\xcc\x96\x02\x41\xb0\xad\x77\x1c\x79\x87\x9c\xce\x25\x6c\x35\xb7\x5c\xa6\x37\x60\x0a\xaf\x47\x83\x23\xef\x1c\xcc\x55\xc2\xe3\xe6\xa8\x83\x92\xc0\x24\xbc\xb9\x9d\xc3\x6c\xfe\x06\x7e\xbe\x9d\xcf\xe6\x37\xd1\x00\x7e\x99\x2d\xfe\x71\xff\x75\x01\xbf\xdc\x3e\x3e\xde\xde\x2d\x66\x1f\xe6\x70\xff\x08\xd3\xfb\xbb\xf7\xb3\xc5\xec\xfe\x6e\x0e\xf7\x1f\xe1\xf6\xee\x57\xf8\x34\xbb\x7b\x7f\x03\xc8\xed\x1a\x35\xe0\xf7\x5c\x7b\xfc\x4a\x03\xf7\x86\xc4\xc4\xfb\xb4\x5c\x40\x25\x00\xbf\x3e\xfc\x67\x93\x63\xcc\x57\x3c\x06\xc1\x64\xea\x58\x8a\x90\xaa\x27\xd4\xd2\x2f\x8f\x1c\x75\xc6\x8d\x77\xa7\x01\x26\x93\x68\x00\x82\x67\xdc\xd2\x2a\x32\xe7\x4a\xf9\x69\x5e\x33\xb6\x22\x96\xf3\x62\x39\x4d\x80\xe5\x1c\xbf\x5b\x94\x84\x66\xb4\xf9\xab\x19\x71\x35\x7e\x7a\x17\x6d\xb8\x4c\x26\x30\x75\xc6\xaa\xec\x11\x8d\x72\x3a\xc6\xf7\xb8\xe2\x92\x56\x7e\x94\xa1\x65\x09\xb3\x6c\x12\x01\x08\xb6\x44\x61\xfc\x7f\xe0\x1d\x3a\x81\x37\x31\xcb\x50\x0c\x37\x6f\x22\x00\x26\xa5\x2a\x34\x0b\x3d\x28\x24\x95\x10\xa8\x87\x29\xca\xd1\xc6\x2d\x71\xe9\xb8\x48\x50\xd3\xcc\x25\xae\xa7\x9f\x46\x7f\x19\xbd\x8b\x00\x62\x8d\x34\x7c\xc1\x33\x34\x96\x65\xf9\x04\xa4\x13\x22\x02\x90\x2c\xc3\x09\x70\x69\x31\xd5\xd4\x25\x17\xcc\xfa\x70\x34\x23\x02\x50\x59\x94\x91\x77\x87\x9f\x3f\xd5\xca\xe5\x13\x38\x7b\x1e\xa4\x95\x10\x99\xc5\x54\x69\x5e\x7e\x1e\xc2\xc6\xf7\x2f\xfe\x8f\xf7\xff\x07\x1b\xcd\x0e\x00\x1e\x0a\x00\xf4\x54\x70\x63\x3f\x35\xf5\xf8\xcc\x8d\xa5\x5e\xb9\x70\x9a\x89\x7a\x35\xa8\x83\x59\x2b\x6d\xef\x0e\xe0\x86\xc0\xf3\xf0\x80\xcb\xd4\x09\xa6\x6b\xc7\x46\x00\x26\x56\x39\x4e\x80\x86\xe6\x2c\xc6\x24\x02\x28\xcc\x4b\xa2\x86\x15\x1e\x7b\xd0\x5e\x86\x9e\x2a\xe1\x32\xb9\x9f\x28\x41\x13\x6b\x9e\x5b\x72\x88\x27\xaf\xca\x44\x50\xce\x04\xf9\x9a\x19\x8c\x02\x23\xfc\x66\x94\x7c\x60\x76\x3d\x81\x91\xb1\xcc\x3a\x33\xaa\x3e\x0d\x0e\x7b\xa8\x7c\x63\x77\x1e\xa2\x8f\x57\x99\x46\x87\x2e\x4f\xef\x82\x86\xf1\x1a\x33\x36\x29\xfa\xaa\x1c\xe5\xed\xc3\xec\xdb\xff\xcf\x8f\xbe\x86\x63\x98\x35\xb6\xf6\xa4\xe0\xc3\x29\x8c\xdb\x47\x68\xa3\xc5\x43\xbb\x7d\x98\xed\x3f\xe5\x5a\xe5\xa8\xed\x7e\x41\x84\x56\x09\xa3\xca\xb7\x27\x78\xde\x7a\xc8\x05\x77\x27\x3e\x7e\x30\x80\x29\x3c\x81\x49\xa1\x65\xe0\x59\xee\xe9\xd1\xd3\x0c\xca\x10\x34\x47\x82\xc1\x77\x62\x12\xd4\xf2\x37\x8c\xed\x08\xe6\xa8\xbd\x18\xbf\x44\x9c\x48\x7c\x64\x3d\xa1\xb6\xa0\x31\x56\xa9\xe4\xbf\xef\x65\x9b\x72\x0f\x15\xcc\x62\xb1\xee\x0e\x8d\x3c\xef\xf7\xb2\x27\x26\x1c\xde\x78\x46\xa2\x6d\x40\xa3\x9f\x05\x9c\xac\xc8\xa3\x2e\x66\x04\x5f\x94\x46\xda\xfb\x26\xb4\x09\x98\xc9\x78\x9c\x72\x5b\xd2\x47\xac\xb2\xcc\x49\x6e\x77\xe3\xca\xfe\x6b\xc6\x09\x3e\xa1\x18\x1b\x9e\x0e\x99\x8e\xd7\xdc\x62\x6c\x9d\xc6\x31\xcb\xf9\x90\xa0\x4b\x62\x89\x51\x96\x0c\x74\x41\x38\xe6\xed\x11\xd6\xb3\xd5\x12\x1a\x85\x61\x8b\x07\x7c\x10\xfa\x35\xc0\x8a\xa1\x41\x8b\x83\xa1\xfd\x57\xde\x3a\x8f\x1f\xe6\x0b\x28\xa7\x26\x67\x9c\x5a\x9f\xec\x7e\x18\x68\x0e\x2e\xf0\x06\xe3\x72\x45\xc4\xed\x77\x5e\xad\x32\x92\x89\x32\xc9\x15\x97\x96\x3e\xc4\x82\xa3\x3c\x35\xbf\x71\xcb\x8c\xdb\xb0\x2d\xa2\xb1\xde\x57\x23\x98\x12\x6d\xc2\x12\xc1\xe5\x09\xb3\x98\x8c\x60\x26\x61\xea\x99\x67\xca\xfc\x66\xfd\x07\x3b\xc0\x5b\xda\x0c\xbd\x61\xbb\xb9\xa0\xba\x1d\x9c\x76\x0e\x56\xab\x3c\x28\xb9\xb8\xc1\x5f\x35\x11\x3c\xcf\x31\x3e\x8a\x9e\x04\x0d\xa5\x10\x9e\x64\xd0\x47\x45\x13\x09\x97\xad\x3e\x82\x7d\xa3\xcd\xe7\xf4\xcb\xcb\x90\x7e\xf6\xc3\x08\x97\x37\x31\xe3\xd2\x1c\x18\x51\xa3\x0f\xb4\xe4\x4c\x66\x31\x59\x35\x69\x3c\xeb\xd3\x0c\x94\xc6\x33\x83\xb3\x8c\xa5\x58\xf7\xb0\xd1\x3b\x47\xb3\xcf\xad\xf6\xdb\xdb\xae\x5e\x42\x37\xb5\x0b\x11\x80\xd2\x65\xe8\xff\x37\xc0\x84\xa0\xb4\x88\x32\xeb\x5a\xdd\x0f\xfa\x9b\x30\x9e\xe3\x69\x80\x75\xd2\xc2\xaf\xf7\x07\xad\xbe\xef\xe6\x18\x6b\xb4\xcf\xb2\xc4\x86\x49\xbe\x51\xa4\xcc\xd4\xe7\x00\x6d\x42\x96\x4a\x09\x64\xe7\x9e\x02\xc8\xd8\x13\xca\x0e\x76\xfc\xe2\xfb\xd1\x5a\x19\x0e\x6b\x7b\xb7\x3b\xdd\x37\xa1\x62\x26\x1e\x31\x57\x86\x5b\xa5\x1b\x9c\xd7\x41\x71\xdf\x0c\x5a\xcf\x79\x8d\x73\x9d\xa0\xff\xe6\xd9\x72\x1e\x48\xb1\x01\x7f\x37\x1d\x20\x64\x7f\x2b\x9e\x7e\x61\xf9\x27\xdc\x3d\xe2\xaa\xad\xeb\x09\x8c\x39\x0a\x8c\xad\x67\xf1\x0d\xd2\xa9\x84\xc1\xb4\x14\x36\x6a\x15\xd3\x0d\x99\x6f\x1b\x6c\xb1\x6c\x2d\x2a\x9f\x15\x79\x3c\x56\x81\x21\x80\xed\x50\xa0\x9b\x87\xca\x46\xd9\xd0\x75\x80\xde\xfa\x7c\xaf\x3c\xb4\x69\x5c\xa1\x46\x69\x6b\xf7\x0b\x9f\x79\x6b\x89\x16\x69\xcb\x48\x54\x6c\xfc\x6e\xe1\x0f\x8b\x66\xec\x8f\x2a\x4f\x1c\xb7\x63\x7f\xe6\xe5\x32\x1d\xfa\x03\xe3\x30\x30\xb9\x19\x53\xaa\x3c\x1e\xd0\x9f\x8b\xe8\x00\x16\xf7\xef\xef\x27\x70\x9b\x24\xa0\xe8\x20\xe5\x0c\xae\x9c\x80\x15\x47\x91\x98\x51\x25\x8d\xba\xa1\xad\xfc\xa6\x83\x48\xc7\x93\xbf\xbf\x7d\x4d\x4b\xab\x3c\x24\xc2\x57\x5a\x7b\x4e\xa7\xbc\x1d\x6c\xd7\x48\xaa\x79\xa3\xef\x57\x25\x9d\x14\x6d\x17\x0b\xf9\x15\x94\x39\x43\x1b\x7e\xd8\xe6\x9a\x08\xf4\x54\xb9\x66\x72\x3a\xb4\xf2\xc0\xdd\xae\xdb\xd0\xa3\x68\xed\xd1\xb0\x9d\x9f\x36\x43\xb4\xfc\x1a\xf1\x1d\x24\xfd\xf7\x83\xbb\x88\xa6\x00\xe7\x10\xea\x94\xe5\x8d\x00\xbe\xb8\xb3\x9c\xba\xae\x2d\x11\x98\x4f\x3d\x79\x52\x4a\xda\xe0\xae\xa7\x8b\xb3\xf6\x27\xa3\x8b\x90\xc5\x14\x5c\x71\x31\x06\x43\xfb\x1f\xe1\x8a\x0e\x9d\x2c\xcf\x50\xb9\x86\x24\x0f\xba\x38\xed\xc2\x24\xb9\x5f\x4b\xc6\xa2\xb4\xdf\x94\x70\x19\x4e\x05\xe3\xd9\xb3\x72\xca\x4b\x4c\x54\xbd\x6d\xb9\xc4\x59\x2f\xd6\xca\x2d\x05\x37\xeb\x57\xc8\xf5\x1f\x8e\x25\x55\x52\xfe\x06\xe8\x27\x07\x81\x12\xca\x0b\x93\x7e\x8d\x29\x37\xb6\x29\xf1\xbd\xa4\xc9\x63\x31\xfa\x65\x39\x38\x4b\x12\x8d\xa6\x65\x9f\xe9\x40\x20\x31\x7b\xd1\x70\x2e\x0d\xc6\x4e\xb7\xb0\x7c\x97\x50\x57\x3a\x65\x92\xff\x4e\x26\x7a\xe1\x49\xa2\xf9\x04\xd6\x49\xc4\x85\x65\xac\x9d\xf4\x04\xf0\xa0\xd5\x13\x4f\x50\x77\x70\xfe\xe3\xf1\x88\x26\x67\x5f\x5a\x6d\x41\x4a\xed\xed\x5e\x47\x11\xad\xc4\xd5\x3a\xb6\xc5\x26\xb1\x70\xc6\xd6\xd9\xe1\x52\x00\x4c\xc3\xc0\xf2\x26\xd4\xef\x9b\x7e\xd3\x57\x3a\x5e\x23\x05\x66\xdd\x55\xc4\x7e\x3e\x1a\x93\x57\x6e\x53\xb9\x34\x96\x09\x81\x09\x70\x69\x55\x83\x06\xb5\xea\x85\xf3\x9f\xd3\x0d\x6b\x8f\x5b\xcc\x6a\x03\xec\x48\xc1\x69\x55\x48\x73\x4c\x5f\x8a\x68\x82\xf9\x5c\x02\xa6\x3b\xc4\x67\x8e\x6e\xdb\x64\x87\x34\xb8\xf6\x01\x4d\x59\xb7\xd4\xda\x82\x28\x3c\x64\x5a\xb3\xd3\x0d\x9b\x6a\x1a\x75\xd1\x7b\x69\x31\x7d\x0a\x03\x9b\x0c\xdf\x6e\x76\x5d\xde\x61\x34\xba\xa5\x71\x0d\x5c\x81\xed\x70\x53\xd2\x46\xf9\xdd\x4e\x0f\x4e\xf3\xb6\x6c\xa8\x03\x4d\x5e\xcc\x73\x9a\x9d\xd4\x3a\x38\xd7\x6a\xc5\x45\xcd\x2a\x3c\x3e\xc5\x68\xc6\xed\x43\xe8\x5a\xb9\xbb\xa6\xfa\x94\xa1\xd4\xdf\x77\xf0\xc4\xc0\x2c\x30\x8d\x80\x92\x2d\x45\x6d\x56\x79\x56\xdc\x2c\x88\x80\x5c\x30\xae\x14\x54\xae\xa1\x84\xfd\x65\xff\xf5\x2b\xb1\x2c\x4c\x9a\xab\x2f\x62\xf7\x93\x5e\x63\xef\x60\xa8\x73\x98\x5d\x33\xbb\x23\x75\x6e\x83\x63\x8e\x91\x53\x95\xa0\xca\x6e\xe1\x20\x4c\x13\x3f\x83\xe1\x2e\xb0\x6d\x0d\x2a\xc2\x74\xc4\xaf\xcd\xc1\xd3\x21\x7d\xff\x3e\x3c\x1c\x1b\x87\xb4\xf2\xf4\x13\x0e\x9d\xdc\x48\xb5\x95\xc3\x70\xa4\x9b\x80\xd5\xb5\xbc\xd6\x4e\x93\x47\xba\x3d\x8f\x15\x6b\x1e\x36\x95\x2c\xa8\x98\x79\x65\xd1\x82\xc6\x1c\x95\x2d\xd4\x92\x0c\xd0\xd7\x2d\x6a\xdd\xd1\xd7\x2d\xfa\xba\x45\x5f\xb7\xb8\x16\x19\xf4\x75\x8b\xfe\x22\xb2\xaf\x5b\x5c\x9c\xb1\xaf\x5b\xf4\x75\x8b\x9e\x2e\xfa\xba\x45\x5f\xb7\x38\x95\xd4\xd7\x2d\xa0\xaf\x5b\xf4\x75\x8b\x6b\x44\xf4\x75\x8b\x3f\x5f\xdd\x22\xb0\x60\x4d\x18\x75\x2b\x5a\xd4\x69\x57\x0a\x2d\x7a\x2e\x8b\x6b\xa2\xf2\x76\xa8\x9e\x8e\x0f\x3f\x51\x66\x16\x18\xc4\xa8\xe9\x75\x1d\xfa\x99\x71\x5d\x5a\x76\x89\x20\x04\x33\x76\xa1\x99\x34\xbc\x7c\xcd\xa2\xd3\x4d\xe1\x67\x66\x2c\xad\x96\xf2\xd2\xb2\x50\xc5\xee\x45\x61\x12\x7e\x07\xad\x24\x16\x17\x68\xcd\x01\xa7\x80\x49\x4a\x9b\x9a\x12\xcb\x70\xf3\x34\x81\x84\x59\x1c\xfa\x69\x9f\xbb\x03\x79\x75\xbf\xd2\x8f\xaa\x3b\xab\xba\xa0\x9f\xce\x1f\xd4\xe5\xa6\xa2\xef\x96\x99\xfd\x8f\xb4\xff\x68\xec\x19\x1a\xd3\x78\x89\x76\x76\xbf\xbc\x76\x19\x93\xa0\x91\x25\xf4\x82\x55\x31\x18\xb8\x4c\x78\xcc\xe8\x57\xef\x09\x5a\xc6\x85\x01\xb6\x54\xae\x25\xdd\x59\x63\xc5\xab\x4d\x4a\x5e\x04\xaf\x91\x99\xae\xb7\xd0\x94\xd4\xfb\xee\xfb\x92\xc3\xde\xe0\x6f\x4d\xe1\x8b\x97\x23\xaa\xbb\xd5\x6d\x40\x54\x5c\xe6\x16\x07\x8e\x3d\x98\x9b\xf0\xbe\xe1\x0a\x16\xda\xe1\x0d\x7c\x64\xc2\xe0\x0d\x7c\x0d\xf7\xdb\xcf\xc6\xd5\x56\x98\x3c\xb6\xd3\x2e\xa7\xd9\xab\xef\xe5\xec\xb1\x3d\x73\xfa\xf6\x5b\xf7\xc6\x38\x6e\xac\x5b\x3e\xb3\x3a\xd9\x17\x8a\xfb\x42\x71\x5f\x28\xae\xef\x70\x7d\xa1\x78\xcd\xcc\xa5\x32\x71\x8d\x8d\xe8\x4d\xbd\x3a\x9b\xb4\xa8\xd2\xd7\xa4\xfb\x9a\x74\x5f\x93\x7e\xd5\x9a\xf4\xfe\xb5\xdd\xf3\x49\x1b\x97\x70\xad\xb0\xb3\x2f\x43\x35\xba\xa2\xac\xb1\x4a\xfb\x04\xb3\xf2\x8d\x5b\x9e\x05\x43\x91\x37\xc1\xbf\xfe\x1d\xfd\x27\x00\x00\xff\xff\xb3\x46\xd1\xc8\xf6\x41\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x7d\x6f\x6f\xe3\x46\x92\xf7\x7b\x7e\x8a\x82\x1d\xc0\xf6\xb3\x92\x3c\xc9\x3e\x07\xdc\x29\x87\x0b\xbc\x1e\xcf\x9e\x91\x8c\xc7\xb0\xbc\x13\x2c\xb2\x7b\x41\x8b\x2c\x49\xbd\x26\xbb\xb9\xdd\x4d\xd9\xda\xcb\x7d\xf7\x43\x35\x9b\x14\xa9\xbf\x4d\x4a\xda\x24\x17\x8a\x03\x8c\x25\x91\xc5\xea\xae\xea\xea\xaa\xfa\x55\x51\xe7\xd0\x3f\xde\x2b\x38\x87\xef\x78\x88\x42\x63\x04\x46\x82\x99\x21\xdc\xa4\x2c\x9c\x21\x8c\xe4\xc4\xbc\x32\x85\xf0\x41\x66\x22\x62\x86\x4b\x01\x97\x37\xa3\x0f\x57\x90\x89\x08\x15\x48\x81\x20\x15\x24\x52\x61\x70\x0e\xa1\x14\x46\xf1\x71\x66\xa4\x82\x38\x27\x08\x6c\xaa\x10\x13\x14\x46\x0f\x00\x46\x88\x96\xfa\xc3\xa7\xe7\xfb\xdb\x3b\x98\xf0\x18\x21\xe2\x3a\xbf\x08\x23\x78\xe5\x66\x16\x9c\x83\x99\x71\x0d\xaf\x52\xbd\xc0\x44\x2a\x60\x51\xc4\xe9\xc6\x2c\x06\x2e\x26\x52\x25\x39\x1b\x0a\xa7\x4c\x45\x5c\x4c\x21\x94\xe9\x42\xf1\xe9\xcc\x80\x7c\x15\xa8\xf4\x8c\xa7\x83\xe0\x1c\x9e\x69\x18\xa3\x0f\x05\x27\x3a\x27\x6b\xef\x69\x24\xfc\x59\x66\x6e\x0c\x95\xe1\xba\x59\xe8\xc1\x67\x54\x9a\x6e\xf2\xd5\xe0\x5d\x70\x0e\x97\x74\xca\x99\xfb\xf2\xec\xea\x6b\x58\xc8\x0c\x12\xb6\x00\x21\x0d\x64\x1a\x2b\x94\xf1\x2d\xc4\xd4\x00\x17\x10\xca\x24\x8d\x39\x13\x21\x2e\x87\x55\xde\x61\x00\x96\x01\xa2\x21\xc7\x86\x71\x01\xcc\x0e\x03\xe4\xa4\x7a\x1a\x30\x13\x9c\x07\xe7\x60\x5f\x33\x63\xd2\xe1\xf5\xf5\xeb\xeb\xeb\x80\x59\xe9\x0c\xa4\x9a\x5e\x17\xa3\xbb\xfe\xee\xfe\xf6\xee\x61\x74\xd7\xb7\x2c\x07\xe7\xf0\x27\x11\xa3\xd6\xa0\xf0\xef\x19\x57\x18\xc1\x78\x01\x2c\x4d\x63\x1e\xb2\x71\x8c\x10\xb3\x57\x12\x9c\x95\x8e\x15\x3a\x17\xf0\xaa\xb8\xe1\x62\xda\x03\xed\xa4\x1e\x9c\xd7\xa4\xb3\x9c\xae\x82\x3d\xae\x6b\x27\x48\x01\x4c\xc0\xd9\xcd\x08\xee\x47\x67\xf0\x87\x9b\xd1\xfd\xa8\x17\x9c\xc3\xf7\xf7\xcf\xff\xf9\xe9\x4f\xcf\xf0\xfd\xcd\xd3\xd3\xcd\xc3\xf3\xfd\xdd\x08\x3e\x3d\xc1\xed\xa7\x87\xf7\xf7\xcf\xf7\x9f\x1e\x46\xf0\xe9\x03\xdc\x3c\xfc\x19\xbe\xbd\x7f\x78\xdf\x03\xe4\x66\x86\x0a\xf0\x2d\x55\xc4\xbf\x54\xc0\x69\x22\x31\x22\x99\x16\x0a\x54\x30\x40\xfa\x41\xef\x75\x8a\x21\x9f\xf0\x10\x62\x26\xa6\x19\x9b\x22\x4c\xe5\x1c\x95\x20\xf5\x48\x51\x25\x5c\x93\x38\x35\x30\x11\x05\xe7\x10\xf3\x84\x1b\xab\x45\x7a\x7d\x50\x74\x9b\x62\x61\x1c\xe1\x15\x04\x2c\xe5\x4e\x9d\x86\xc0\x52\x8e\x6f\x06\x85\xe5\x66\xf0\xf2\xaf\x7a\xc0\xe5\xf5\xfc\xcb\xe0\x85\x8b\x68\x08\xb7\x99\x36\x32\x79\x42\x2d\x33\x15\xe2\x7b\x9c\x70\x61\x35\x3f\x48\xd0\xb0\x88\x19\x36\x0c\x00\x62\x36\xc6\x58\xd3\x5f\x40\x02\x1d\xc2\x59\xc8\x12\x8c\xfb\x2f\x67\x01\x00\x13\x42\xba\x91\xe5\x67\xd8\x25\x29\xe3\x18\x55\x7f\x8a\x62\xf0\x92\x8d\x71\x9c\xf1\x38\x42\x65\xef\x5c\xf0\x35\x7f\x37\xf8\xff\x83\x2f\x03\x80\x50\xa1\xbd\xfc\x99\x27\xa8\x0d\x4b\xd2\x21\x88\x2c\x8e\x03\x00\xc1\x12\x1c\x02\x17\x06\xa7\xca\x9e\x92\xc6\xcc\xd0\x72\xd4\x03\xcb\x40\x45\x29\x03\x12\x07\xdd\x7f\xaa\x64\x96\x0e\x61\xed\xfb\x9c\x9a\x1b\x44\xc8\x0c\x4e\xa5\xe2\xc5\xfb\x3e\xbc\xd0\xf9\xee\xef\xb0\xfc\x3b\x9f\xa3\xfb\x25\x03\x8f\x8e\x01\x7b\x66\xcc\xb5\xf9\x76\xdb\x19\xdf\x71\x6d\xec\x59\x69\x9c\x29\x16\x6f\x1e\x86\x3d\x41\xcf\xa4\x32\x0f\x4b\xe6\xfa\xc0\xd3\xfc\x0b\x2e\xa6\x59\xcc\xd4\xc6\x6b\x03\x00\x1d\xca\x14\x87\x60\x2f\x4d\x59\x88\x51\x00\xe0\xa6\xd7\x8e\xab\x5f\xb1\x63\x8f\x8a\x68\xa8\x5b\x19\x67\x49\x21\xa8\x3e\x44\xa8\x43\xc5\x53\xe2\x7b\x68\x8d\x57\xe5\x46\x50\xdc\x09\xd2\x19\xd3\x68\x39\x02\xf8\x9b\x96\xe2\x91\x99\xd9\x10\x06\xda\x30\x93\xe9\x41\xf5\x5b\x9a\xe2\x21\x3c\x56\x3e\x31\x0b\x62\x91\xcc\xad\x98\x06\xcb\x53\xe6\x24\x78\x1a\xc1\x0c\x13\xab\x62\xf4\x4e\xa6\x28\x6e\x1e\xef\x3f\xff\x7e\x54\xfb\x18\xea\x6c\x6e\x98\x6b\xe0\x64\x69\x11\xf2\xeb\xca\x15\xba\x61\xd6\x74\x49\x13\xe0\xe6\xf1\xbe\x7c\x97\x2a\x99\xa2\x32\xa5\x42\xe4\xff\x2a\xcb\xa8\xf2\xe9\x0a\x3f\x17\xc4\xb2\xb3\xdd\x11\xad\x1f\xcc\x99\x71\x92\xc0\xc8\x8d\x32\xb7\xb3\x9c\xcc\x23\x99\x19\x14\xf9\xa2\xa9\x11\x06\x3a\x89\x09\x90\xe3\xbf\x61\x68\x06\x30\x42\x45\x64\x40\xcf\x64\x16\x47\xb4\xd9\xcd\x51\x19\x50\x18\xca\xa9\xe0\xff\x28\x69\xeb\x62\x0f\x8d\x99\x41\xa7\x77\xcb\x83\xe6\x41\x09\x16\xc3\x9c\xc5\x19\xf6\xc8\x22\xd9\xad\x44\x21\xdd\x05\x32\x51\xa1\x67\x4f\xd1\x03\xf8\x28\x15\x69\xc3\x44\x0e\xed\x26\xa0\x87\xd7\xd7\x53\x6e\x0a\xf3\x11\xca\x24\xc9\x04\x37\x8b\xeb\xca\xfe\xab\xaf\x23\x9c\x63\x7c\xad\xf9\xb4\xcf\x54\x38\xe3\x06\x43\x93\x29\xbc\x66\x29\xef\x5b\xd6\x05\x0d\x58\x0f\x92\xe8\x5c\x39\x83\xa3\x2f\x6a\xbc\xae\x69\x4b\xfe\xcf\x2e\xc3\x1d\x12\xa0\x45\x48\x3a\xc0\xdc\xa5\xf9\x40\x97\x13\x4d\x1f\xd1\xec\x3c\xdd\x8d\x9e\xa1\xb8\xb5\xdd\x41\x6b\x44\xc1\xcd\xfb\xf2\x42\xbd\x14\x01\x4d\x18\x17\x13\x6b\xb8\x69\xe7\x55\x32\xb1\x62\x46\x11\xa5\x92\x0b\x63\xdf\x84\x31\x47\xb1\x3a\xfd\x3a\x1b\x27\xdc\x90\xdc\xff\x9e\xa1\x36\x24\xab\x01\xdc\x5a\xb3\x09\x63\x84\x2c\x8d\x98\xc1\x68\x00\xf7\x02\x6e\xc9\xf2\xdc\x32\x8d\x27\x17\x00\xcd\xb4\xee\xd3\xc4\xfa\x89\xa0\xba\x1d\x2c\x5f\x44\x65\xe8\x66\xad\xf2\x45\x61\x8b\xb7\xc8\x6b\xc3\x0a\x1e\xa5\x18\xd6\x56\x4f\x84\xda\xba\x10\x64\x64\x90\x56\xc5\x86\x8b\x6a\x77\xd8\xbc\x82\xe9\xb0\x9b\xcf\xea\x87\xfb\x59\xfa\x03\x5d\x66\xf9\xa2\x29\x66\x5c\xe8\xa5\x45\x54\x48\x0b\x2d\x5a\xa3\xe9\x6e\x56\x75\x1a\xd7\xce\xd9\xce\x28\x1d\x63\xa6\xf1\x3e\x61\x53\xdc\xf4\xe5\x56\xe9\x14\x87\xbd\xfb\xc8\x28\xda\xde\x16\x9b\x29\xf8\x0d\xdb\x91\x00\x14\x59\x82\xf4\xb7\x06\x16\xc7\xd6\x2d\xb2\x9e\xf5\xc6\xb1\x2f\xc7\xaf\xf3\xeb\x39\xea\x60\xed\x8c\xfd\xa3\x20\x83\xf3\xa8\xe4\xdb\x62\x84\xa1\x42\xd3\x6a\x26\x5e\x98\xe0\x2f\xd2\x0e\xe6\x96\x7c\x80\x5d\x44\xc6\x52\xc6\xc8\xd6\x25\x05\x90\xb0\x39\xae\xd8\xfe\x8d\xf3\xf8\x91\xce\xb3\xba\xd2\xef\x6f\x3c\x7b\xb7\xd0\xe9\x88\x65\xc8\xe2\x27\x4c\xa5\xe6\x46\xaa\x2d\xc2\xf3\x18\x38\xfd\xd3\x68\xc8\xab\xde\x7a\xaf\x15\xee\x3f\x93\xb5\x1c\x59\x7b\xbc\x8d\x7f\xbf\x31\x38\xef\x6f\xc2\xa7\x1f\x59\xfa\x2d\x2e\x9e\x70\xb2\xeb\xd4\x15\x36\x46\x18\x63\x68\xc8\x8a\xbf\xe0\x82\x96\x3c\x83\xdb\x82\xd8\x60\x27\x19\x3f\xce\xe8\x78\xc1\x1d\x33\xbb\x91\x2b\xf2\x8a\x88\x1f\x23\x41\x5b\x06\x77\xb3\xe2\x29\xa1\xe2\xb0\x0e\x53\x33\x86\x2e\xc8\xdf\x2b\x82\x36\x85\x13\x54\x28\xcc\xc6\xfd\x82\x3c\x6f\x25\xd0\xa0\x75\xf9\x23\x19\x6a\xda\xae\x29\x58\xd4\xd7\x14\xaa\xcc\x39\xbe\x5e\x53\xcc\xcb\xc5\xb4\x4f\x01\x63\x3f\xb7\xe4\xfa\x9a\xd8\xd2\xd7\xe7\xf6\xbf\xbd\xdc\x01\x3c\x7f\x7a\xff\x69\x08\x37\x51\x04\xd2\x06\x52\x99\xc6\x49\x16\xc3\x84\x63\x1c\xe9\x41\xc5\x8d\xea\x01\xed\x38\x3d\x0f\x92\x19\x8f\xbe\xb9\x08\xb6\x7e\xdd\x7c\xa6\xa5\x95\x27\x8b\x1b\x8a\x9f\x16\x35\x9f\x2c\xe0\x75\x86\x76\x68\x34\xe9\xa5\x56\xda\x48\xd1\xe8\x60\x07\x31\x77\x90\x06\x25\x99\xb6\x1b\x7e\xbe\xcd\x45\xc1\xce\x0b\x3c\x8c\xd3\xf2\x28\x02\xee\xdd\x63\xeb\xc3\x0b\x2e\x76\x9e\xb1\x65\x3b\x5f\x3d\xb4\x35\xcb\xc7\x58\xdf\x39\xa5\x9f\x7f\x71\xbb\xd5\x94\xb3\xb3\x5c\xea\xd6\xcb\x1b\x00\x7c\xcc\xd6\x7c\xea\x4d\xc7\x18\x81\x91\xeb\xc9\x23\x37\x30\x1a\x68\x67\x2e\x7e\xeb\xe6\x22\xf7\x62\x9c\xad\xd8\xbb\x06\x9d\xe3\xf1\x7f\xc3\x56\x78\x9c\x64\x78\x82\x32\x33\xc3\x3d\x34\x76\x08\x6d\xcf\x4d\x12\xf6\xf6\x94\x09\x4a\xcb\x59\x6f\x70\x8b\x15\xa9\x49\xf1\xe3\xca\x25\x45\x7e\x21\x61\x6f\x3c\xc9\x12\x10\x59\x32\xa6\x4c\xf4\x24\x77\xf7\xb7\xe9\xbc\x99\x31\x03\x21\x13\xa0\x32\x01\x2c\x8f\x10\x35\xad\x46\x1a\x73\x8f\x12\xb6\xf4\x89\x28\xd2\x37\x3d\x52\x11\x16\x2a\xa9\xad\xb3\xbd\x95\x68\xe5\x12\x4d\xfb\x52\x4e\x86\x8c\x24\x33\xa4\x64\x1a\xa6\xb1\x1c\xb3\xd8\xde\xe1\x75\xc6\xc3\x19\x84\xcc\x66\x8c\x31\xd8\x40\x10\xc0\x85\xcb\xce\x46\x94\x41\x8e\x9c\xd4\x09\x97\x77\xa5\x1c\x60\xbc\xcd\xb7\xa7\x4b\x99\xb1\x29\xab\xdf\x7f\xb5\xf1\x8c\x5c\x5e\x94\x94\x98\xa2\xda\x70\x46\x4a\x8b\x5f\x1b\x14\xe6\x33\x65\xab\xf0\x36\x66\x3c\x19\x06\x2d\x74\x63\xdf\xd6\x51\x4d\x8f\xed\xdb\x64\x0e\x55\xc3\x34\x1b\xc7\x5c\xcf\x8e\x10\x9c\x3d\xd6\x29\x55\x62\xb4\x8d\x34\x61\x35\x72\x2b\x58\x39\x30\x4a\x53\x38\xa5\x3c\x7c\xcb\x91\x3c\xb9\xab\x0f\x0b\x9a\x58\x14\x51\xc6\x7e\xdb\xd7\x7b\xc7\x40\xff\xc2\x95\x9c\x46\xc3\xcb\xb9\xd0\x18\x66\x6a\xc7\xb6\xec\x63\x9b\xa5\x9a\x32\xc1\xff\x61\xa7\xe8\x20\x76\xf4\x8e\x90\xf9\x18\x6a\xac\x32\x41\xd6\xeb\x51\xc9\x39\x8f\x50\x79\x08\xff\xa9\x7e\xc5\x36\x61\xef\x61\xcc\xdd\xd7\x39\x06\xc3\x36\x24\xb4\xc1\x54\x7b\x30\x3c\xa2\xf3\x80\x10\xc8\xd0\x82\x23\x65\x4e\x03\x53\xdd\xcb\x8d\xba\x35\xe8\xba\x48\x0c\xa1\xda\xbc\x80\xc0\xa2\x70\xd6\x88\xe6\x24\x22\xae\x30\xa4\xe8\x1e\x12\x99\xd1\x52\x2c\x37\x01\x9b\x44\xa7\xbf\x16\x10\x61\x18\x13\x10\x56\xd0\xa8\xbe\xb8\xc1\x64\xab\xb6\xd7\x06\x71\x5b\xb0\x46\xa3\xa1\x3d\x81\xad\x8f\x66\x39\x98\x6d\xfc\x03\xb0\xe5\x20\xf7\x8e\x66\x0b\x91\xfd\xcb\x18\x80\x4d\x2c\xf0\xb4\xc5\x98\x6c\x18\xdf\x8d\xbb\x20\x1f\x9a\x05\x7d\x68\x3f\x2e\xe8\xd8\xbc\x78\x94\xc5\x9b\x35\x61\xf9\x52\x59\x8c\x7a\x97\x8b\xee\xc3\x3c\x1d\x42\x46\x78\xe3\x31\x88\xb5\x81\xbc\xb7\x52\x1b\xa3\xb6\x24\x36\x0d\x20\x67\x72\x0f\x4d\x28\x51\x8f\x54\x46\xfb\x62\x0e\xdf\x41\xd1\x91\xda\x14\x83\xc2\xe8\x7d\x46\xeb\x6a\x54\xb2\x75\x3f\x15\xb2\xfc\xf8\xee\x0d\xc3\x6c\xb7\xf1\xda\x32\x7e\x0a\xc1\xdc\x58\xad\x8a\xc5\xb1\xbb\xa5\x0d\xc3\xdc\x17\x1e\x44\x01\x52\x19\x51\x6e\xdd\xce\x23\xf9\x6b\xcc\x80\x66\x86\xeb\xc9\x82\x16\xd6\x72\x66\x1d\xcc\x4b\x50\x84\x17\x5d\x07\xf4\xe6\x78\xb6\x83\xdd\x31\x8e\x7a\x30\xce\x0c\x70\x63\xa1\x94\x70\x26\x25\x61\xe7\xf6\xe6\x5e\x54\x2d\x7f\x73\x2e\x29\x91\xac\xab\x45\x0d\x45\xac\x56\x61\x73\x9f\x3c\x5d\x58\x45\xce\x24\xe9\x90\x25\xcd\x35\x24\x52\x9b\xa5\xfc\x0a\x27\x96\x6e\x45\x2b\xd9\x93\x4b\x84\x29\xe1\xb3\xa8\x0d\xe8\x2c\x21\xe6\x5e\x91\x0a\x1f\x74\x0f\xf8\x00\x07\x56\xed\x90\x85\xb3\x86\x23\x4f\x10\x29\x15\x10\xc7\xc5\x70\xab\xfa\x9e\x87\x28\xe4\xad\xf8\x49\xe8\xb2\xc4\x74\x1c\xc6\xd2\x2b\xc3\x9c\x55\xad\x2d\xd5\xc0\x8b\x70\x45\x06\x3d\x40\x13\x0e\xae\x7a\xb6\xc8\x22\x33\x24\x6b\x9a\x8f\xf1\x02\xb8\x21\xcf\x7b\x9f\xa5\x29\x5e\x66\xa6\x64\x36\xcd\xed\x28\xe6\x2e\x99\x2e\xa1\x41\x1b\x02\x5b\x7c\x8e\x5c\x53\x4f\x8a\x67\xb9\x40\xce\x0a\x0c\x90\xd8\xe2\x44\xd0\xa9\x43\xc2\x4c\x38\x73\xb0\x4a\x28\x95\x42\x9d\x4a\xe1\x4d\xdd\x5e\x7d\xb7\x9c\x87\xaf\x4b\xc2\x97\xfa\x6a\xb9\x25\xcc\xf8\x74\xb6\x8e\x3c\x6e\x7e\x11\x83\xb4\xbd\xd2\x75\x75\x1d\xf5\x51\xf4\x9d\x7b\xe0\x0e\x43\x73\x23\x00\x93\xd4\x2c\x2a\x2b\xa2\xa2\x73\x06\x57\xf0\xa4\xed\x47\x31\x9f\xe4\x56\xbb\x0c\x48\x3e\x0f\x84\x8e\xf0\x90\x1b\xb7\x42\xe0\x1d\x5c\xd2\x12\xf1\x24\xcb\xcd\x05\x6d\x65\x42\xf6\x65\x7a\x35\x80\x1b\x5b\x04\x71\x34\x66\x85\x2c\x79\xb5\x4c\x91\x35\x60\xb1\x96\xcb\x3b\x7a\x11\x6c\xb2\x73\x14\x57\xd0\xfe\x81\x22\xf4\x48\x5c\x6d\x96\x9b\x55\x36\x97\x91\x23\x64\x1f\x55\xd2\x03\xa6\xb5\x0c\xf9\x16\x10\x6e\xdb\x51\x6a\x6b\x6d\x19\x38\x71\xf9\xca\xa9\xcd\x24\x6c\x5a\x48\x4d\xae\x5d\x9b\x12\x2a\x3d\x21\xab\x51\x9f\x9a\xc6\x86\x73\x79\x8c\xa9\xb2\x2c\xc2\x0b\xed\x8a\x7d\xfc\x67\xa3\xe1\x9a\xdc\x3a\xa4\xad\x43\x69\x48\x16\x5c\x25\x40\x41\xc9\x6e\x37\xce\x89\xd5\x79\xaa\x43\xf7\x82\x86\x24\xf3\xa4\x75\x5e\x3a\xc1\xc4\x32\x27\x62\x89\xe7\x68\x70\xd3\x19\xa7\xad\x20\xcf\x3e\x33\x51\xd6\x5d\x34\xa4\xd1\x4e\x13\x1b\x64\xcb\xf7\x48\x8d\x9c\x37\xab\x2f\x76\x1c\x34\x19\x2d\x28\x82\xcb\xbd\x3b\x79\xb9\xe4\x12\x55\x49\xb4\x20\xb6\x27\x06\xdc\x7d\x14\x62\x3d\x78\x5e\x9e\xca\x02\x92\x5c\x71\x2e\x9a\x2b\x07\x1d\x56\xb1\xc8\x56\xcc\x78\x4a\xbb\x3b\x69\xb5\x5d\xf6\xed\xb4\x25\x3f\x3e\x5b\x80\xa2\x18\x6a\x1e\xe8\xde\x8b\x1e\x3c\x48\x73\x2f\x9a\x2f\x0c\x3a\xee\xde\xb8\x36\xba\x07\xef\x25\xea\x07\x69\xec\xdb\x01\xfc\xd1\xd8\xf5\xd2\x8a\xe2\x77\x7b\x40\xa1\x13\x08\x3f\x9f\xd3\x83\x45\x7f\x23\x80\x29\x45\x45\xb6\x93\xb6\x9c\x2c\xb9\x19\xc0\xfd\x4a\x12\x96\x6b\xb8\x17\x81\x37\x99\xca\x21\x95\x13\xb1\x25\x98\xd3\x77\xac\x26\x7e\xf8\xd6\xfa\x31\x26\x17\x53\xf4\xad\x5f\xb5\xc6\x6b\x2b\x8a\x5c\x3b\x6d\xa2\x70\xa8\xaa\x4f\xbd\x1d\xf9\xeb\xdd\xaf\xb5\xa1\x12\x08\x5b\xb2\xdc\x8a\xe4\xaa\x48\xfe\x68\x88\xdd\xef\x8e\xca\xe4\x8c\xcd\x6d\x90\xc1\xc5\xd4\x33\x00\x5e\x3d\x5c\x78\xd1\x73\x20\xc0\x2b\x8f\x63\x1a\x3a\x65\xde\x55\xaa\xb0\x99\xef\xb4\x7c\x31\xaa\x69\x2e\xf2\xf7\x03\x78\xa6\x88\x38\xe7\x3b\x2f\x6e\x8c\x5d\x29\x6a\xf3\x23\xb2\xc1\x1a\xb0\x32\x33\x1d\x42\x82\x6a\x8a\x90\x92\x17\xdb\xc6\x22\xb4\x72\x4c\x8e\x60\x4c\x8a\xcb\xed\xbc\x34\xbc\xda\x0f\xa1\x6b\x83\xd9\xad\xbf\xfa\x6d\x57\xeb\x9e\x34\xf1\xb1\xe7\xc5\x3a\xcf\x1f\x28\x3a\x6e\x28\xcd\xba\x6d\x3e\xa5\xdf\xec\xe0\xeb\xe0\xe4\xea\xd9\xf9\xcd\x9d\xdf\xdc\xf9\xcd\x9d\xdf\xdc\xf9\xcd\x9d\xdf\xdc\xf9\xcd\x9d\xdf\xdc\xf9\xcd\x9d\xdf\xbc\xd9\x6f\x6e\x71\xb3\x3c\x17\x3e\x0c\x5a\xd9\xf0\xef\xed\xc5\x95\xdc\x7c\x8e\x8b\x58\xe7\xbd\x99\x36\xac\x27\xe9\xc9\xcb\x1e\x39\x5f\xe7\xd9\x42\x00\x79\xe9\x44\x03\xa2\x8a\x89\x29\xc2\x97\xfd\x2f\xdf\xbd\xf3\x5f\x13\xfb\xeb\xd9\xda\xd4\xb8\x1d\xaa\xbd\xfd\x0a\xae\xe2\x79\x41\x2e\xd9\xe0\x04\x7a\xd3\x44\x35\x8b\x61\x9e\xa8\x9a\xe0\x7e\x52\xc7\xfa\xdd\xed\xc8\x9c\xeb\xa2\x63\xdb\xd3\xc8\xd6\xc0\x7e\xeb\xed\x51\xc3\x5e\x82\x86\x4a\x39\xab\x60\x1c\x15\x73\x7a\x51\x74\xd5\x19\x79\x91\x83\xeb\xfe\x73\x84\x6c\x27\xbb\xc3\x6f\x49\xd3\xbd\x77\x58\x8f\xd1\xd6\xcb\x16\xbc\xa8\x86\x68\xcb\x91\x24\x6d\x7b\xc5\x88\x65\x42\xdc\x53\xa7\xa3\xdb\x6e\x68\x28\x58\x48\xca\x8b\xec\x25\x0e\xa6\x03\x88\x32\x4b\x9a\x09\xd7\xfa\x78\x65\x5d\x14\xd0\x0b\x6d\x30\xb1\xd5\x14\xf4\x78\x07\x4f\x33\x47\xd3\x68\x94\x6d\xcf\xc1\x39\x0a\x93\xb1\x38\x5e\x00\xce\x79\x68\x8a\x6a\x18\x5b\xc5\x4f\x7d\x1a\x36\x54\x1e\x04\xc7\x0f\x0f\x57\x6d\x93\xf7\xce\x59\xd3\xdd\x27\xb7\x34\x06\xdb\xf2\x14\x9e\x44\xc1\x42\xa4\x9a\x32\x73\x98\xff\x69\xb5\xf7\xd3\x93\x1f\xbe\xde\x62\xfb\xaf\x0d\xc3\x01\xd7\x54\x16\x42\xbe\x62\xcb\x21\xe4\x83\xd8\x00\x5f\xe7\xc3\xaa\x6a\x7a\x03\x9a\xb6\xce\x02\xf3\xea\x83\x9b\x87\xf7\xd4\x75\x4b\xd4\x9e\x65\x2a\x63\x39\x5d\x54\x65\xd8\x84\xd3\x45\x8a\xcb\x02\x5b\x0a\x5d\x75\x36\x76\x11\x27\xe9\xe0\xc3\x8a\x7a\x0c\x82\xd3\xe6\x29\x3a\xa4\xb9\x43\x9a\x3b\xa4\xb9\x43\x9a\x3b\xa4\xb9\x43\x9a\x3b\xa4\xb9\x43\x9a\x3b\xa4\xb9\x43\x9a\x3b\xa4\xb9\x43\x9a\x3b\xa4\xb9\x43\x9a\x3b\xa4\xb9\x43\x9a\x3b\xa4\xb9\x43\x9a\x3b\xa4\xb9\x43\x9a\x3b\xa4\xb9\x43\x9a\x7f\x3e\xa4\xb9\xd9\x6d\x9a\x4c\x79\x7f\x1d\x90\x09\x8e\x3a\x04\xef\x53\x53\x19\x1d\xd8\x14\x4e\xf8\x55\x89\xef\x35\xee\x09\xcf\x01\xb7\x50\xf6\xe9\xe9\x82\xc6\x3d\x34\x9c\x48\xba\xc7\x0e\xd8\xa7\xd1\xd0\x64\xf5\xe0\x1f\x52\x60\xde\xe3\xba\x97\x2a\xd3\x39\x1a\x98\x3f\xec\x2d\x95\xd1\xa5\xbe\xda\xdb\x3f\xd8\xc4\x11\x2e\x9b\x1e\xbb\x9e\xf3\xae\xe7\xbc\xeb\x39\xff\x15\xf6\x9c\xcf\x98\xce\xd7\x86\x2d\xa1\x0b\x9a\x74\x2a\xaf\x97\xfe\x54\xac\x28\x21\xb3\x5f\x7b\xbb\x70\xdb\xba\xd4\x7f\xa6\x0e\x74\x8a\xc5\x9d\x22\xd3\x82\xab\x28\xa2\x45\x6c\xbd\x5d\xb2\xbc\xcc\x0a\xa3\xc7\xfa\xbc\xb8\xbc\x90\x1d\x18\x8b\x22\x7a\xd0\x13\xaa\xbe\xf7\x12\x01\x32\x7e\x13\x7a\x92\xf6\xfa\xac\x14\x33\x19\x9c\x26\xe5\xb1\x22\x60\xdf\xcb\x76\xd4\x4f\xd4\xb6\x4d\xaa\x24\x68\x12\x36\xaf\xd6\xaf\xfd\x5c\x1d\xe3\x36\x6f\x53\xb8\x31\x4d\x2e\x5c\x99\x98\x1b\x97\x01\xfa\x7b\x86\x6a\x01\xf4\x28\x47\x8a\x5a\xd0\x04\x0d\x08\x52\xee\x65\x52\x3e\x3b\x5d\xbb\xea\x3b\xae\xf3\x47\xcb\xd1\x52\xf7\x9f\x8d\xf6\x33\x72\x78\x75\xc3\xda\xe4\xac\x92\xa3\x2d\x8b\x35\x26\x09\x65\xbe\x37\x9f\xea\x32\x57\x56\xdd\x57\x9a\xcd\xd0\x72\x83\xad\xd2\xa8\x94\xae\x04\xff\xb4\x50\x6b\xa3\x36\x6d\x1a\x62\x0b\xda\xa7\x49\x06\x9f\x2a\x21\x7c\x9c\xa4\xf0\x61\x0b\xe0\xa0\xe4\xf0\x9a\x38\x69\x24\xce\x4b\xb3\xaa\xdb\x92\x26\x94\xf9\xe5\x8d\xb9\xe2\xd6\x54\xdb\xe5\x98\x8f\x90\x1d\x38\x2c\xd7\xbc\x36\xcd\xa5\xfa\x2d\x7f\x5b\xa2\x25\x59\xa7\xd8\x17\x7a\x3d\xf9\xdc\x9a\x62\x3d\x69\xbd\x9a\x80\x6e\xcf\x68\x2d\x71\x5d\xa4\x0d\xdb\x66\x9c\xe9\xa8\xe5\xaf\x7f\x2e\xc5\x68\x9f\x87\x5e\x53\x0b\x97\x54\x24\x13\xe8\xb2\xd2\x2d\xa9\xc2\x32\x9b\xbd\x2d\x33\xdd\x9a\xb2\xcd\x68\x6f\xcc\x4e\xb7\x26\x59\x4f\xf5\xd6\x32\xd4\xad\x69\xae\xa6\x7c\xcb\x2c\xf5\x51\xb4\x6d\x3d\x29\xdf\x9a\xec\x4a\x86\x7b\x99\x9e\x6d\x4d\xb1\x92\xd6\xdd\x90\xa2\x6d\xcf\xe8\x61\xa9\xdd\x83\x7c\x8e\x23\xad\xd5\x82\x44\xdb\xf9\x6d\x92\x77\x3c\x46\xba\xf7\xa0\x94\x6f\xa3\xdc\xe4\x71\xe7\xca\xba\xd1\xdf\x55\x7e\x27\xae\xc9\xd1\xe4\x39\xcc\x27\xd3\x96\x9a\x5d\xae\x0c\x87\x4c\x09\x83\x84\xa5\x2d\x18\x92\x13\xf8\x6f\x72\x42\xad\xd9\xf8\x1f\x48\x19\x57\x9a\xca\xfb\xf3\xe6\xc4\xea\x77\x2d\x88\xbb\x1c\x6e\x95\xd5\x84\xa5\xc4\x2e\xe9\xec\x9c\xc5\xed\x1c\x72\x42\xb1\x45\x91\x26\xa2\x5d\x65\x35\x42\x6a\xe3\x8d\xbf\xce\x28\x55\x49\x2e\xa2\x4d\x52\x10\x93\x67\x2f\xb8\x38\xeb\x1d\xba\x43\x11\xa1\x7b\x71\x66\x3b\xa6\xd7\x2c\x74\x19\x47\xb4\x20\x2c\x45\xbc\x80\x33\x2b\x9c\xb3\xf5\x9e\x03\xd8\xf6\x84\xde\xdd\x47\xcb\xc0\xad\xf5\x9a\x6e\x79\xe1\xf2\xf9\xf2\xc3\xa0\xf5\x12\x5a\x12\x29\xb3\xd9\x4d\xd2\x81\x9b\xb8\x59\x46\x29\xa3\xc3\xe2\x0b\x23\xe1\xb2\xc8\x37\xb2\x29\x3d\xe0\xc2\x5c\x7d\x5d\xef\x5b\x69\x48\xd1\x86\xff\x09\x32\xa1\xe1\xac\x40\x59\x2e\xf4\x92\xf7\xb3\xe0\xe4\xbb\x66\x6b\xeb\xd7\xce\xea\x1b\xd7\x34\xf3\x2d\x2e\x0e\xd0\x92\xe7\x02\x91\x72\x3f\x71\x38\xc6\x25\x5c\xd5\x34\x4c\xb8\x2c\x32\x7e\x57\xe4\xac\x52\x67\xd8\x92\x14\x5c\x32\x61\x78\xbf\x3c\xa3\x21\xe9\x32\x0f\x48\x99\xae\xb2\xa1\x75\x5d\x1f\x83\x56\x26\xbc\x58\x1f\x51\x45\xd7\xa9\xfb\x1c\xd5\x01\xb3\x41\x3f\x94\x9b\xff\xd2\x10\xd5\x03\xa8\xfc\x27\x30\xec\xef\xe5\xda\x6c\x6e\x6e\x92\x1b\xd2\xac\xfd\x50\x84\x4d\x17\xe4\x19\x52\x32\xec\x15\x7d\x68\x48\x75\x99\xf9\x67\x36\x0c\x65\xc2\x75\x8d\x49\xe1\x0a\x23\x98\x68\xba\x1e\x1d\x8f\x79\x92\x8a\x52\xe1\x24\x37\x5e\xce\xc3\x00\xee\x5a\x2c\xf2\xca\x10\x69\x07\x23\x0d\x63\x71\x2c\x5f\x9b\xda\xf4\x56\x2b\xb5\xb9\x27\xda\x6f\x25\x93\x7f\x76\xd3\xf8\xeb\x09\x9b\xc6\x57\x80\x85\xdf\x7c\xcf\x78\x7d\x3a\xba\xc6\xf1\xae\x71\xfc\xd7\xd8\x38\x6e\xe7\x22\xdf\x7d\xb6\x74\x90\x7b\x11\xcd\xbb\xcc\xbd\x3b\xc8\xbd\x68\x96\x5d\xe6\xf0\xbd\xfb\x61\x26\x02\x63\x15\x42\x92\xc5\x86\xa7\xf1\x12\x7f\xf7\x2f\x44\x24\xd7\x52\x17\xad\x04\x7a\xc5\xc2\x51\xdf\x3b\x55\x42\xac\x58\x3a\x2f\xc2\x04\x25\x91\xb9\x51\xda\xee\x91\xae\xb8\x82\x60\x69\x42\x4c\x75\x99\x9e\xcb\x2b\x5a\xb8\xdf\x2e\xd7\xc0\x75\xad\x2d\xc0\xf7\xee\xc7\x7f\xcb\x0a\x6a\xeb\x69\x5d\x92\x43\x14\x93\x1a\xfa\x3b\x2b\xdb\xbd\x33\x97\x31\x9f\x63\x51\xb6\x30\xe5\x73\x14\x41\xc3\x30\x84\xaa\x9d\x0a\xa4\xe3\x70\xe7\xf5\x04\x4e\x2b\xed\x9f\xeb\xee\xe3\x66\x87\xd0\x93\xe4\x8a\xdb\xb8\xcb\x11\xf4\xa4\xf8\xef\x15\xdf\xe4\x3f\xf6\xbb\x80\x9e\x54\x99\x15\x47\xe9\xfc\x2d\x15\x69\xe9\xfa\x05\xa7\x41\xe7\x6a\x8a\xe6\x7b\xd1\x16\x68\x75\x15\xa8\xaf\x43\xef\x41\xc3\xf8\xa2\x1d\x48\xdf\x0e\x9f\x5c\xcd\x15\x35\xb9\x76\x65\x32\x56\x49\x91\xfe\x32\x0b\xb3\x37\xa2\x09\xfb\x40\x79\xca\xed\x34\xa4\x78\x0c\x40\xbe\x55\x88\xbf\x51\x5b\x36\x0d\x2b\xf8\x25\x80\xf0\x5d\x47\xd6\xa6\x8e\xac\x55\xc0\xbd\x25\x48\x00\xdb\xc1\xf6\x5f\x75\x63\xd6\xb1\x80\xf2\xad\x20\x79\x8b\xca\xa7\xd2\x8e\x6c\x01\xc8\x5b\x66\x83\x61\x33\x38\x7e\x30\xc8\xfd\x4b\x68\xb2\x3a\x12\xb0\x7d\x1a\x50\x7b\x3b\xa0\x7d\x00\xab\x1b\xc1\xec\xa3\xf0\x5a\x02\xd9\xeb\x80\x74\x2b\x92\xd5\xa1\x6e\x02\xa3\x5b\x11\x5d\xeb\x2f\x3a\x16\x10\xdd\xf5\x17\xfd\xc6\xfb\x8b\xa8\xbf\xe8\x00\x80\xf9\x18\xe0\x72\x6b\x4d\xa8\xd9\xc4\x4d\xa0\xf2\x0a\x44\xdc\x90\xaf\x1d\x80\x72\x81\x2d\x54\x6e\xda\x90\xf8\x1a\x98\x7c\x7c\x68\xf8\x24\xb0\xf0\x49\x20\xe1\xfd\x70\x70\x9b\x20\xa0\xd5\x1a\x6a\x71\xd1\x12\x56\x1a\x06\xad\x54\x77\x49\x60\x15\xcc\xad\x40\x56\xde\xa4\x61\x43\x6a\x66\xe9\xbb\x6e\x80\x66\x1b\x50\xae\x3f\x7c\xf0\x78\xb0\x6c\xe3\x7d\xa4\x95\xcd\x68\x6e\x1b\x2b\x69\x94\x61\xf0\xb3\x42\xb0\x27\x82\x5f\x4f\x00\xbd\x9e\x0a\x76\xf5\x82\x5c\x73\x73\xd4\x80\xea\xc6\x2c\x5b\x55\xee\x45\xf6\xac\x01\xcd\x9d\x50\xeb\x2a\x78\xda\x80\xee\x66\x98\xb5\xc6\x6c\x0e\x9c\x36\xa0\xd9\x18\x62\x6d\xbc\xf2\x9a\xf9\x5b\xcd\x61\xd5\x86\x16\xdb\xdf\x08\x78\x13\x26\x78\x40\x18\x5e\x40\x04\xc3\xa0\x81\x7d\x58\x69\x46\xae\xae\xe3\x0a\x9c\xb5\x87\xa2\xfb\xa9\x6d\x87\xe4\xb0\xb9\xe4\x11\xa4\x99\xa1\x16\xc6\x1d\x5d\xc9\x7b\x89\x2e\xbb\x96\xbb\xae\x64\xff\xae\xe4\x9a\x08\x2b\xfd\xa6\x5e\xc4\xb7\xc0\x83\x5d\x6b\xf2\xaf\xb3\x35\xb9\xaa\x0b\x5e\xd4\xbb\xfe\xe4\xae\x3f\xb9\xeb\x4f\xee\xfa\x93\xbb\xfe\xe4\xae\x3f\xb9\xeb\x4f\xee\xfa\x93\xbb\xfe\xe4\xae\x3f\xb9\xeb\x4f\xee\xfa\x93\xbb\xfe\xe4\xae\x3f\xb9\xeb\x4f\xee\xfa\x93\xbb\xfe\xe4\xae\x3f\xb9\xeb\x4f\xee\xfa\x93\xbb\xfe\xe4\xae\x3f\xb9\xeb\x4f\xee\xfa\x93\xbb\xfe\xe4\xae\x3f\xb9\xeb\x4f\xee\xfa\x93\xbb\xfe\xe4\x63\xf4\x27\x57\x37\x89\x2d\x6d\xbb\x1e\xa4\x7f\x55\x4d\xca\x1e\x43\x2e\x86\xe3\x45\x34\x1f\xb2\x6f\xa7\xb2\x17\xc9\xb2\x9b\xd9\xaf\x53\xd9\x8b\xe6\xda\xef\x61\xef\xea\x54\x6e\x24\xa1\xfa\xef\x61\x6f\xeb\x54\xf6\x22\x59\xa0\xf5\x79\xd2\x6d\x4f\xa7\xb2\x1f\xc5\x0d\xdd\xcc\xdb\x3b\x95\xbd\x48\x76\xdd\xcc\x5d\x37\x73\xd7\xcd\xdc\x75\x33\x77\xdd\xcc\x5d\x37\x73\xd7\xcd\xdc\x75\x33\x77\xdd\xcc\x5d\x37\x73\xd7\xcd\xdc\x75\x33\x77\xdd\xcc\x5d\x37\x73\xd7\xcd\xdc\x75\x33\x77\xdd\xcc\x5d\x37\x73\xd7\xcd\xdc\x75\x33\x77\xdd\xcc\x5d\x37\x73\xd7\xcd\xfc\x8b\xef\x66\xf6\x3a\x8d\xa9\xe9\x4e\xfb\xe6\x61\x00\x3d\x27\xde\x67\x7c\xa1\x4c\x12\x26\xa2\x5f\x0e\x43\x28\xe6\x07\x32\x53\x33\xba\x77\x62\xfe\x99\x55\x93\x1c\x94\x22\x43\x31\xe7\x4a\x0a\xeb\x5d\xcd\x99\xe2\x6c\xbc\x17\x54\x72\x97\x93\x49\x63\x70\x9b\xfb\x36\xa8\x06\xc1\x71\x32\x60\x64\x17\x87\x41\xa3\xdd\xe4\x81\x5a\xd1\x9d\x01\xd9\x34\x9e\x01\x7c\xcc\xbc\x52\xb8\x63\x6a\x8e\xbd\xfd\xf1\xfe\xfd\xdd\xc3\xf3\xfd\x87\xfb\xbb\xa7\xfd\x06\xc0\x53\xdc\x65\x0c\xdc\x70\x68\x17\x9f\xdd\x18\xc0\x76\x51\xa2\x20\x77\xe8\x8b\xcb\xcf\x37\x4f\x3f\x3e\xdc\x7c\xbc\xbb\xf2\x4c\x03\xe1\x5b\xca\x04\xfd\xd8\x6c\xa6\x8b\x8d\x2c\x55\x38\xe7\x32\x5b\x6e\x1f\x95\x99\xf3\xa0\x58\xcc\xad\x2e\x76\x36\xe7\xe4\x52\x5b\xa0\x4d\xbe\x2e\x40\xa3\x9a\xf3\x10\xdb\x12\xa6\x32\x7e\x60\xe5\x7b\x08\x99\x70\x80\x35\x35\x10\xc6\x73\x8c\x7c\xd3\x11\xe5\xd4\x15\xbc\x72\x91\x66\xc6\x09\x2d\x7f\x5e\xf7\x18\x21\x13\xe1\x8c\x9e\x23\xef\x65\xf5\xc9\x1b\xaf\x8a\x41\x2f\x84\x61\x6f\xc4\x23\xf9\x32\xa8\x43\x96\x16\x35\x28\x0c\x22\x99\xed\x5f\x55\x74\x7c\xf1\x45\x0f\x38\x0e\xe1\x8b\x0a\xe9\x01\xdc\x39\x6a\x15\x0d\xb0\x3c\x0b\x9c\x7b\x95\x78\x8c\xb1\x94\x3f\xf5\x98\x4f\x99\x8a\x62\xd4\x16\x02\x7e\x9d\x21\x61\xca\x2e\x64\xf1\x5a\xfd\xf4\x0f\xcb\xec\x90\x90\x66\x40\x38\x2a\xcb\x62\x63\x1f\x0b\x74\x76\x36\xb8\x38\xfa\xa2\xf9\xa0\x64\xd2\x70\xe1\x8c\x2c\x72\x45\x45\x34\x5b\xcd\xc2\x85\x8f\xf7\x62\xef\x3f\x80\xdb\x52\xf9\x32\x4d\xb8\xa6\xcb\xcb\x16\x05\x5c\x79\xf2\x2d\x38\x2e\x0e\x10\x4a\x31\xe1\xd3\x8f\x2c\xfd\x16\x17\x4f\x38\xf1\xb9\x64\x75\x12\x2c\xb8\x42\x18\x16\xc5\xbb\xe4\x7b\x91\xbd\xce\x89\xfa\x68\x79\x53\x8e\x1b\xe3\x15\x35\x6e\x9f\x1d\xce\x42\x0f\xd2\xb0\x8c\xfb\xb1\xd8\x50\xa1\x9a\xed\x33\x1b\x19\xbd\xa8\x6e\x38\x6e\x55\x9a\x01\x7c\x94\xb6\x00\x62\x22\xfd\xc9\x02\xcc\x8c\x49\xf5\xf0\xfa\xfa\x25\x1b\xa3\x12\x68\x50\x0f\xb8\xbc\x8e\x64\xa8\xaf\x43\x29\x42\x4c\x8d\xbe\xa6\x66\xeb\x39\xc7\xd7\xeb\x57\xa9\x5e\xb8\x98\xf6\xc9\x23\xef\xe7\xbe\x95\xbe\xa6\x81\xe8\xeb\x73\xfb\x5f\x83\x1b\x3f\x7f\x7a\xff\x69\x08\x37\x51\xe4\x9e\xc5\x92\x69\x9c\x64\xb1\x6b\xff\x1f\x00\x4b\xf9\x67\x54\x04\xa8\x36\x49\xb0\xbc\x70\x11\xf5\x20\xe3\xd1\x37\x17\x81\xe7\x25\x6d\x64\x27\xad\x20\x58\xdc\x52\x7e\x23\x5b\x29\xbb\xa8\x59\xbf\x72\x5d\x78\x93\x04\xca\x8e\xd3\x4f\x09\xbc\xe0\x32\xa5\xed\x36\x53\x6f\x22\xf9\xe0\xc7\x52\xc6\xc8\xc4\x49\x02\x07\xdf\xf4\xac\xa7\x5f\x5f\x1c\x56\x4f\xda\x59\xa5\x8b\xa5\x59\xb2\x54\x8a\x95\x94\xca\x68\x08\x3a\x4b\x53\xa9\xbc\x01\xb8\x04\x0d\x8b\x98\x61\x03\x52\xff\x5e\xfd\xad\x8d\xae\x2b\x9f\xd9\x68\xd6\x3b\x5f\x58\x5e\x66\x2d\x7f\x8e\xe7\xf5\x6c\x91\xf5\x80\x02\x6b\x32\x01\xee\xad\xf3\x73\x6e\xc2\x50\x66\xc2\xd8\x2f\x3c\xef\xa1\x0d\x33\x99\x1e\xcc\xa4\x36\xf7\x8f\xbd\xe2\x6d\x2a\xa3\xd5\x77\x7a\x70\x71\x22\x73\xbd\x5c\xe7\x2d\x17\x93\xbb\xba\x10\x22\x15\x13\x26\xcc\x2e\xa9\x0f\x24\xdc\x47\xe6\xf9\xd4\x1e\x17\xd3\x68\x78\x55\xdc\x18\x14\xd6\x57\xb3\x35\x61\x72\xd2\x83\xa8\xea\x62\xcc\xbf\x3c\x3b\xe9\xd6\x30\x29\x18\x6f\x39\x25\x34\xe6\x62\x3e\x2c\xad\xe5\x96\x06\x5c\x34\x4c\x59\x2e\x33\x46\x37\x8f\xf7\x30\xcf\x67\xfb\x17\x94\x98\x28\x27\xeb\x14\x56\xa6\xa8\x40\xfa\x70\x14\x6b\x53\x50\x2b\x64\x53\x86\x2c\xc3\xa0\x41\x46\xbe\xa0\xa2\x21\xe6\x09\x77\xf8\xb9\x7b\x86\x93\x86\xcb\xfc\xc3\x41\x98\x66\xbe\x96\xc6\x5d\x91\x60\x22\xd5\xa2\x57\xbc\xc5\x74\x86\x09\x2a\x16\xf7\xb5\x91\x8a\x4d\xb1\x57\xde\xa4\x09\xf1\xf2\x9a\x9c\x7c\x8d\xd9\xf5\x7b\xf8\x26\x49\xa9\x00\x29\xcc\x14\xf9\x3d\xf1\xa2\xb0\xd9\x18\x9d\xcc\x4a\x95\x92\x7a\x38\xc0\x6b\x2b\x53\x15\x16\x5a\x18\x96\x9a\x4e\x41\x82\x37\x49\x80\xb9\x8c\xb3\x84\xb2\xb6\x85\x17\x42\xd7\x53\x80\x41\x81\x85\xbe\x38\xdd\xca\x04\x88\xf8\x9c\xeb\x26\x05\x2f\x4c\x2c\x3e\x4d\x1a\xf5\x7a\x34\x6e\x1f\xa8\x5e\xd7\x68\x30\x2b\xe2\x19\x39\x3b\xe7\x1e\xf8\x96\x19\x0a\xd1\xf3\xdf\x68\x03\xe9\x57\x88\xee\xe6\x95\xc2\xbd\xb7\x54\x52\x9c\x56\xae\xd5\x95\x1d\xe4\x4b\x7f\x10\x26\x65\xc6\xa0\x12\x43\xf8\xaf\xcb\xbf\xfc\xee\xa7\xfe\xd5\x37\x97\x97\x3f\xbc\xeb\xff\xdb\x5f\x7f\x77\xf9\x97\x81\xfd\xe3\xff\x5d\x7d\x73\xf5\x53\xf1\xe6\x77\x57\x57\x97\x97\x3f\x7c\xfb\xf1\x8f\xcf\x8f\x77\x7f\xe5\x57\x3f\xfd\x20\xb2\xe4\x25\x7f\xf7\xd3\xe5\x0f\x78\xf7\x57\x4f\x22\x57\x57\xdf\x7c\xe1\xcd\xe2\x5b\x7f\x19\x3d\xf4\xb9\x30\x7d\xa9\xfa\xb9\x28\x86\x60\x94\x77\xe2\xbf\x98\xac\x61\x3b\x01\x5e\x14\x8f\xce\x1a\x2e\x0d\x6d\xb9\xef\x5d\xfc\x72\x36\xac\x82\xb9\x53\xec\x57\x1a\x43\x85\xe6\x98\xf1\x7a\x4e\xd1\x25\xae\xbc\x28\xc2\x2a\xe0\x78\x22\x9b\x7c\x78\xa0\xef\xb6\x61\x37\xc4\x52\x57\xbc\x89\x82\xfd\xc9\xb7\x01\xd8\xf4\x2e\x45\x5f\x94\x2d\xa4\x2a\x39\x47\xf1\x05\x3d\x72\x32\xad\xf5\xae\x4b\x21\x74\x29\x84\x5a\x0a\x61\x94\x6b\x5d\x33\x58\xf8\xb7\x99\x3f\xf0\x3e\xd9\x8f\xdb\xbe\x5d\x8a\xc1\x11\xee\xe8\x03\xcb\xf1\x84\x4d\x77\xae\x7b\x2f\x75\xdc\x67\x3c\xbc\x88\xa4\x33\xa6\x77\x52\xa9\x29\xed\x23\x9d\x5d\x14\x82\xdb\x4b\x0b\x0b\x34\xce\x78\x9c\x17\x2e\x69\x83\x29\x41\xe9\xbb\xed\x00\x17\x3d\xb0\x3d\x74\x5f\xfd\x0b\xf9\x53\x2a\x73\xd5\x0d\x06\x53\x60\x13\xe3\x16\x44\xaa\x24\x09\x98\xee\x48\x37\xd8\x2d\xe9\xcb\xaf\xde\x5d\xe5\xf5\x53\x63\x9c\x48\xf7\x58\x55\xfb\x60\x86\xd0\x26\x5f\x88\x4a\xca\xc2\x17\x36\xa5\x3e\xfc\xdf\xbf\xbb\x0a\x0e\x6f\x9d\xf5\xf3\x76\x0b\x87\x41\x7b\x4f\xf4\x53\x71\x45\xf9\x7c\xd8\xe2\xd9\xbb\x25\x2d\x37\xf3\x3b\x28\x42\x2e\x8b\x32\xf4\x09\x0e\xdf\xbc\xf3\xd0\x72\x18\x9c\xa2\x7a\xd1\x33\xd4\x68\x1a\x62\x34\x0c\x2d\x7e\xc1\xde\xfa\x81\x5e\x7a\x4d\xc1\x2e\xbe\xb3\x92\x74\x5a\x37\x76\x41\x53\xc2\xde\x78\x92\x25\xc0\x12\x4a\x44\xfa\x44\x4d\xeb\x6a\x59\x54\xbe\x54\x5c\x11\x2f\x07\x23\x07\xab\x32\x7a\x9e\xb4\x14\xd7\x09\x13\x6c\x8a\x7d\x47\xbe\x5f\x92\xef\x97\xda\x7c\x7d\x11\x1c\x71\x8b\xa0\x0c\x47\xa7\xd6\xbf\x7e\xb5\x7e\x72\xb2\x5c\x55\x6c\x2e\x2a\x8a\xbd\x97\x25\x39\xd9\xa0\xd8\x85\x27\x61\xcb\x0a\xca\xfb\x70\x0d\x32\xe1\xc6\xa7\xe2\x8c\xb2\x3c\x6c\x99\x33\xec\x01\x37\xb5\xa4\x82\x5b\x92\x7c\x52\x3c\xcb\x7e\x2f\x45\x7c\xa3\xdd\x8d\x9b\x78\xb1\xcc\xf2\xf6\x72\x38\xee\x95\x6b\x74\xb5\xcf\x3c\x49\xf3\xea\x67\xbb\xb4\xfa\xbe\x1e\xa2\x03\xae\x7f\x25\xcb\xd8\xeb\x34\x8d\x61\xa6\xb8\x59\x50\x22\x0f\xdf\x76\x3e\x2a\xa4\xa6\x56\xa3\xfa\x75\x30\x93\x71\xa4\x4b\x6a\x50\x1b\x73\xb0\xb7\x84\xb1\xa8\x17\xb1\xbe\x09\x46\x56\x4a\x4b\xb5\x18\xc0\x88\x1e\x50\x50\x79\x8a\xba\x2b\x97\xda\x49\x98\x0b\x18\x4b\x33\x5b\xe3\x94\xdc\xa1\x47\x19\xad\x7c\x3c\x00\xfb\x38\x80\x9d\x14\x2d\x39\xf2\x3e\x34\xd6\x9f\x3a\xc9\xc5\xda\x5d\x0c\x7b\x41\x7a\x4c\x7b\x88\x11\x15\x18\x0d\x8e\xe0\x69\xd8\x6d\xe4\x51\xf1\x39\x8f\x71\x8a\x54\xc1\x12\x33\x9f\x47\x6a\xd4\xcd\xc1\xcd\x16\x2a\x76\xba\x95\x8c\xa9\x1e\xdc\x16\xb0\xec\xa1\x4a\x7d\xa1\xa9\x92\x21\x55\xbd\x84\x4c\x00\xd5\x78\x43\x42\x2b\x23\x2d\x88\xd3\x0e\xca\x84\x8d\xc7\x52\xa6\x7c\x4a\x95\x1c\x41\xf7\xe8\x4d\x82\x70\x21\xe2\x0a\x43\x5a\xce\x25\x7f\xe5\xcf\x19\xfc\x28\xf0\xf5\x47\xba\xdb\xfe\x00\x7b\x12\xb3\x69\xa9\x65\xb6\x15\x7b\xb5\xc2\xaa\xbc\xf5\xda\x04\xfd\x2f\x7b\x5f\xdf\xdb\x38\x8e\xe4\xfd\xbf\x3f\x05\x91\x79\x00\x27\xcf\xc6\x4e\xf7\xde\x60\x76\x2f\x58\xec\x22\x93\x97\xde\x60\x3b\xdd\xb9\x38\xd9\xbe\xc3\xec\xde\x80\xb6\xe8\x98\x17\x49\xd4\x88\x52\x12\x2f\xe6\xc3\x1f\x8a\x2c\x52\x92\xed\xc4\x45\x59\x4e\xa7\x6f\xd2\x69\x60\x7a\x1c\xeb\x27\xb2\x58\x2c\x16\xeb\x15\x09\xb4\xf6\x1d\x70\x09\xc8\x4b\xc1\x78\xfc\xc0\xe7\x86\x8c\x8b\xef\x90\xfa\x90\xbd\xdf\x83\xab\x00\x54\x7e\xf2\x8b\xb0\x5e\xf0\xfc\x7e\xcf\x34\x6e\x38\x3e\xba\xfc\x79\xf4\x5f\xa3\x9f\x8f\x4e\x2e\xce\x3f\xf5\x7b\x5d\x5d\x63\x27\x3c\xe3\x63\x19\x4b\xca\xe9\xdd\x60\x26\x30\x3a\xd5\x1f\x36\xfb\x36\x8a\x0e\xa2\x5c\x65\x76\xfe\x18\x40\xdc\x23\x3b\x23\x74\x33\xf4\x09\xe8\x87\x07\x82\xcb\xa0\xaf\xbf\x70\x2d\xee\x6d\xce\xd3\xc2\x95\x49\xa9\x2f\x45\x5e\xa6\x50\xe1\x65\x9d\x2d\x8b\xba\x37\x51\x19\x5a\xff\xa5\x05\x0a\x1e\x99\x76\x10\x41\x53\x22\x05\xa9\xae\x7c\xd9\xb1\x7b\xcd\xbc\x0a\x58\x65\x97\x9f\x47\xe7\xff\x19\x46\xd4\x8a\xbf\x48\x5f\x0d\xd2\xca\x68\xe6\x02\xf7\x07\x18\x2d\x98\xe6\x57\x22\x51\xf7\x6f\x54\x6f\x4b\x75\xa2\xf6\xc1\xaa\x73\x60\xad\x5d\xac\xb9\x3c\x65\x5a\xdb\xa7\x32\xad\xe1\xb0\xc4\xd4\xed\xb9\xb4\xa2\x9a\x40\xb3\xe6\xd3\x1e\x15\xf2\x83\x21\xa2\x14\x96\x42\xda\x5a\x42\xf4\xc2\xac\x60\x9b\x51\xca\x9f\x20\x10\x68\xd2\x94\x59\x53\x1e\x6b\x31\xec\x4c\x3a\xc3\xc1\x74\x01\x97\xcf\x20\x2a\xfa\xa7\x58\x24\x52\x55\xa0\xd2\x0f\x6b\x07\x32\x14\x7e\xcb\x68\x8a\x7f\xa1\x58\xa9\xab\xa0\xcf\x8a\x86\x70\x48\x57\xc2\x59\x6a\x47\x83\x4b\xf7\xe6\xb5\xd0\x36\xf1\xac\xd4\x42\x37\xa1\x9d\x70\x76\xe0\xda\xbc\x3d\x17\x3c\x82\x60\x81\xb5\xb0\x19\x2f\x66\x36\x82\x20\xe1\xfa\x0e\xba\xed\xc0\x07\xa8\x53\xe0\x95\xc5\xbe\xd1\x0f\xf5\x9a\xb2\xab\xa6\x82\x17\x65\x2e\xac\x2e\x61\xeb\x67\x89\x14\xa2\x7b\xd7\x46\x37\x93\xf7\x22\xcc\xf1\x73\x1a\xcf\xaf\x94\x2a\xce\x64\x2c\x6c\x29\xac\xa0\x85\xff\xe2\xad\xe7\x52\xd7\x48\x0a\xea\x03\x44\x6b\xf0\x68\x40\x22\xa2\x61\xf1\xa9\x1f\x82\xe7\x70\x30\x19\x76\xcc\xe0\x79\x99\x1e\xe9\x0f\xb9\x2a\xb3\xa0\x89\x02\xf7\x7d\x38\x3f\xa9\xdb\x4a\x45\x5a\xe4\x73\x5b\xc7\x4c\x4d\x9b\x3c\x45\x57\x3d\x6f\x80\x1f\x17\x38\x10\x82\x93\xcb\x54\x0b\x88\x48\xe5\x73\xc6\x63\x0d\xcb\xbf\x16\x13\x34\x16\x99\xae\xbe\x6d\x9c\x4f\xdd\xaf\x57\xdd\x52\xd6\x42\xaf\xbe\xc5\xd4\x6e\x25\xb5\x20\x27\x99\x06\xc3\xc3\xf5\x45\x13\xef\x2f\x0b\xd6\xe1\x1f\xbe\x5f\xf3\x5d\xba\x55\xc7\x70\xc6\x27\x95\xc2\x76\x08\xe2\x8d\xf3\x34\x92\x90\xb0\x88\x95\xa8\x1a\x9c\x60\xfd\x42\xc0\x32\x7c\xfd\x01\x02\x19\x7f\xe9\xc0\x6c\x86\x52\xc3\x4d\x14\xca\x11\xe6\xa5\xb0\x84\xfe\x5b\x39\x16\xb1\xc0\xeb\xab\x71\x93\xf2\x62\x3d\x57\xc0\x93\xc6\xdf\xc1\x78\xe1\x19\xad\x50\x4c\xa4\x1a\xc4\x8b\x19\x31\xd8\x41\x94\xb0\x81\xf0\x79\xf9\xfc\xfe\x81\x1f\xae\xd9\xcd\xf9\x09\x7b\xc7\x76\x61\xac\x7b\x46\xfa\x4d\xb9\x8c\x61\x77\xe8\x82\xe7\x8b\x34\x90\xeb\xed\x98\x38\x04\x33\x65\xc3\xfb\x10\x9b\x6b\xb6\xfe\x3e\x4b\x15\xd3\xe5\x64\xe6\xe6\x0c\xd7\x47\xa0\xc1\x5a\xcc\xb1\x80\xd6\x67\xc0\x2c\x22\x6a\x6c\xa5\x35\x5b\x65\x2d\xf0\x33\x5b\xe9\x89\x0b\xff\x7a\xff\x29\x6d\x2b\x05\x6f\x95\x40\xc1\x78\xa3\x45\x1e\xc4\xfb\x20\x17\x6f\xb6\x20\x17\xf1\x00\xd0\xa8\x11\xe4\x4d\xaa\x58\x86\x76\x01\xbe\x6b\x51\xad\x3c\x75\x00\xbf\x55\x56\xd8\x8e\xd4\xd4\xe2\xa3\x4c\xcb\xc7\xcf\x86\x1f\x74\x30\xef\x8c\x4e\xcd\xe3\x86\x45\xcc\xa4\xd4\x82\x65\x6e\x9d\x8b\xad\x71\x9f\x77\xe2\xc3\x91\x6d\x7f\x41\x10\x39\xf9\x47\x12\x1f\x60\x01\x03\xb1\xce\x38\xcb\x79\x1a\xa9\x64\x69\xb0\xbe\x0b\x69\x6d\x00\xc0\x5c\x04\x68\x0a\xf3\xd5\x99\x6b\x2d\x24\x91\xf9\x2a\xe6\x5a\x8b\xd8\x29\xf3\x85\x98\x36\x62\x71\x2f\x48\x61\x1d\x0d\x6e\xfa\x08\x4f\x81\xc2\xe8\x56\xc9\xc0\x60\x06\xbc\x39\xe6\x42\x9a\x04\x14\xaa\xc9\x3a\xeb\xe6\x17\xa4\x78\xc3\xdf\x5c\xc5\xcf\xfa\xfd\x57\xce\xf1\x4a\xc5\x02\xa6\xc8\xfd\x24\x01\xe6\xd5\xce\xd1\x7c\x39\x74\x8e\xd7\xf3\x6c\x61\x8e\x00\xf3\x6a\xe7\x58\x12\x4e\xcc\xa5\x39\xc2\x31\xdb\x9c\x23\xc0\xbc\xd2\x39\x92\x4d\x30\x0f\x32\x8d\xd4\x83\x6e\x7b\x14\x7c\xb1\x8f\xbb\x43\x6f\x02\xe2\xaf\x90\xe9\xad\x46\x62\x44\x94\xda\xc6\xd0\xdb\xd9\x13\x42\xaf\x3e\x0f\x6c\xe4\x98\x36\xb1\x87\xa4\xf3\x65\x59\x8e\x7a\xeb\x3e\x24\x93\x0e\xff\x8f\x5d\xaf\x42\x64\xf5\x6d\xa2\xf9\x71\x0e\xb8\x85\xe4\x31\x84\x80\x07\x6f\x86\x0f\x17\xa3\xa3\x26\x04\x6c\x0d\x5b\x51\x05\x4e\x2b\xf8\x3d\x01\x13\x4c\xe2\x89\x34\x25\xa5\xd8\x83\x18\xcf\x94\xba\x63\xbb\x2e\xf0\xe1\x56\x16\xb3\x72\x3c\x9c\xa8\xa4\xe6\x3c\x1d\x68\x79\xab\x0f\x90\x6b\x07\x30\x13\x5a\xf6\x84\x4c\x63\x53\x75\xda\x6d\xba\xaa\x8f\xb6\x1d\x2c\x9b\xf8\xd9\x98\x85\x23\x81\x42\x34\x99\xf7\x1c\x2c\x93\xc4\x44\x9b\x1a\x9f\x64\xc7\xbb\x7b\xd5\x12\x7e\x22\x86\xc5\xae\x59\x46\x80\x71\xc1\x6a\x6b\xc3\xfb\xdc\xcf\x33\x74\xc4\xbb\x40\xe7\x04\xf0\x37\x9f\x56\xf3\x06\xd1\xe5\x1e\x86\xdd\xe9\xc4\x58\x75\x23\x22\x20\xb2\xe7\x6e\x4d\xcb\xb7\xa2\x90\x63\x61\xdd\xed\x09\xad\x4d\xee\x0b\x14\xea\x32\xea\x0d\xca\xca\x45\x12\xe2\x13\xb2\xf3\x29\xd9\x48\xc2\xa4\xc9\xcf\x60\xf9\xb8\x9d\xf3\x93\xf4\x35\x9b\xba\x64\xac\xc8\xcf\x0a\x67\x82\xfb\xa8\xc1\xc4\x7f\xaf\x70\x91\xbb\x21\x24\x8d\x5b\xab\x3d\xd4\xd8\x30\xa5\x07\xec\xb7\x9e\x45\xb5\xa5\xb7\x4c\x61\x19\xcf\xbf\xc3\x5e\x37\xa7\x8d\x19\x0c\x2d\xab\xb3\x31\x39\x78\xc4\x0d\xab\xb9\xb1\x78\x81\x05\xac\x68\xbb\xd4\x52\xbf\x56\xf2\xcc\x8c\x08\x14\x00\x9b\xc0\x00\xf6\x33\x04\x67\xfd\xc3\xfe\xb0\xd7\x21\x17\x99\x57\x41\xdc\x1b\xbf\x25\xc5\x61\x2c\xd0\x60\xf1\x71\x16\x09\xe8\xd7\x60\xce\xb1\x99\x7a\xb0\x0b\x4d\x91\x2b\xe0\xcb\xca\x10\x47\x60\x93\x0b\xe7\x99\x02\x91\x53\x23\xae\x6d\xc5\x47\xc0\x34\x01\x53\xec\x01\x64\x4a\xae\xca\x34\xc2\x66\x19\x40\x4e\x13\xfc\x72\xb1\x30\xf8\x4f\x2a\xa5\xc0\x4a\x0d\xa6\xa3\x08\x7d\x31\xbe\x58\xe4\x58\x80\xd0\x4b\xd9\xfb\xe1\xfb\x77\x9d\x2e\xd1\xba\x80\xf0\x15\xcb\x62\x46\x66\x4c\xc4\xa6\x46\x1d\xd0\x8b\xb9\xc4\x12\xb7\xdf\x3a\x1d\xa3\xf3\xf9\x04\x8e\xd3\xac\x80\x88\x2a\x97\x0e\x9c\x1a\x10\xf3\x0a\x39\xad\x3c\x1a\x40\xca\x37\x79\xa5\x4d\x68\xdc\xae\x31\xee\x82\x95\xb7\x76\xf8\xec\xb5\xf0\x6d\x86\xd8\x38\x19\xd3\xe5\xb8\x03\x09\x82\x82\xc0\x30\x7f\x88\x00\xf1\x9b\xa3\xaf\x9f\x11\x26\x35\x12\x10\x30\x77\x76\xd8\xae\xc5\x82\xc2\xe5\x60\x8f\xef\x94\x61\x90\x60\xd0\x9e\x21\x90\x68\xa7\x58\xb2\x88\x65\x4f\x53\x6f\x2d\xa2\xf3\xdb\x36\xe4\xf6\x73\xd4\x23\x20\xfe\x28\x66\xfc\x1e\xfa\x51\xca\x44\xc6\x3c\x87\x4e\x31\x8a\x8d\xec\x3c\xd9\xb8\x2c\x56\xd6\x1a\x22\xe0\x3e\x5d\xe0\x6b\x55\xf9\xae\xda\x6c\x08\xd8\xb5\x21\x35\xf7\xc8\x8a\xe5\x77\x73\x81\x35\x23\x40\x83\x86\xe5\x66\x0f\xd2\x3d\x29\x5d\xcb\xa3\xc7\x49\x5c\x6a\x79\xdf\xa5\x04\x72\x41\xbc\xcf\xf3\xd2\xa0\x3a\xeb\xd7\x7c\x6f\xed\xbd\x22\x48\xfb\x7a\x3e\x22\x05\xf3\xc6\xbb\xd7\xbb\xaa\x50\x1d\xd0\xb9\x60\x4a\x91\x63\x70\xa3\x49\xad\xef\x8f\x65\xec\x9b\x09\x9f\xc3\x4e\xe0\x13\xb8\x2c\xd8\x30\x34\xe8\xa1\xe8\x19\xcd\x15\x4f\xcb\x54\xd4\x95\x4a\xc6\x1f\xf4\x69\xcc\x75\x21\x27\x3f\xc6\x6a\x72\x37\x2a\x54\x1e\x7a\x04\xf6\x8f\xbe\x8c\x96\x30\x1a\x14\x49\xd9\xd1\x97\xd1\x5a\x50\xc6\x4e\xa4\xbe\xf3\xf1\xe2\x2e\x86\x9b\xf1\xa2\xe0\xd0\x68\x0d\x36\x0b\x67\x70\xfb\x8f\x45\xd1\xef\x6b\xa3\xb1\x10\x50\x13\x0e\x8d\xa1\x84\x53\x67\x52\x9f\x9a\x8e\xf7\xab\x4c\x35\x72\x2d\x08\x88\x94\x30\x6e\x2c\xe7\x70\x80\x3c\xf7\x1d\x7f\xd0\xc2\x12\x69\x0c\x44\x82\x5f\x8b\x75\x31\x99\x21\x0b\x09\x3f\x53\x7d\x4d\x34\xd8\x2e\x2e\x61\x15\x3d\xe2\x83\x7e\x6a\x82\x1e\x56\x82\x04\xca\xd8\x5c\x95\xec\x81\xdb\xaa\xe1\x46\x04\x0c\xd9\xb5\xcc\x0e\xd9\x69\xcd\x5b\x0d\xc8\x55\xac\x08\x11\xb8\x40\xab\xb2\xaf\x74\xe1\x4c\x2d\xc0\x05\x58\x34\x1c\x24\x33\x06\xa0\x10\x51\x4f\x1f\x39\xc4\xf6\xeb\x43\xb6\x23\x1e\x8b\xef\x77\xf6\xd9\xce\xe3\x54\xc3\x7f\xd2\x62\xaa\x77\x86\xec\x3c\x71\x09\x02\x44\x44\x99\x4e\x45\x0e\x25\x2d\x6c\xc8\x8f\x85\x5d\xbc\xa2\x87\xb1\x5b\x97\x2c\x47\x7c\x9d\xcd\x2b\x86\x7b\x45\xa4\xd8\x03\x5c\x18\x4c\x27\x3d\x26\xf2\x1c\x3a\x9f\xc8\xb4\xdd\x22\x82\xc6\x60\x12\x43\x72\x95\x48\x7f\x90\xe2\x06\xed\xf7\xa8\x7c\x40\xd4\x7c\xe0\x6f\xc6\xf3\x42\xd2\xae\x5b\xcb\xbb\x02\x2c\x43\x1e\xc0\x4d\xba\x93\x3d\x71\x3e\x75\x49\x2f\xfb\x8d\xa0\x65\x72\x1b\x0e\x07\x05\xbb\x00\x47\x04\xc7\xce\xb0\xc6\xd1\x67\x2a\xc7\x5f\x11\x31\x0f\x22\x71\x7f\xa0\x23\xfe\x7e\xdf\x6c\x63\x8d\x09\xd6\x45\x83\x0a\x5c\xb3\x1d\x7a\xf1\xa7\x91\xd3\xd0\xd0\x16\x6e\x86\x53\x43\x03\x07\xac\x7b\x2d\x11\x12\xba\x05\xbc\xdb\x61\xbb\x2a\x37\xa3\x84\x64\x82\x58\xf0\x7b\xe1\xf2\x5d\xe1\xe0\x9b\xdb\x42\xf0\x7b\xc4\x6a\x38\xd4\x64\xd5\x36\xee\xf5\xd0\x5b\xdb\x32\x17\xba\x44\xf7\x1d\xb8\xae\xed\x00\x13\x4d\x15\x9c\x8f\x70\x9e\xd1\xbb\x1a\x01\x75\xae\x70\x14\x15\x99\x64\x8a\x1a\x8c\xb9\x1f\x1a\x9b\xa7\x7d\x0d\x75\x81\x9f\x66\x64\xb6\x63\x6e\x7f\x3b\x5f\x51\xd8\x85\x48\x14\xda\xed\xb3\xd2\x22\xcf\x4f\xda\xac\xe5\x4d\x2a\x7f\x29\x05\x3b\x3f\x71\x27\x6c\x06\xd5\xc4\x74\x01\xb2\x35\x92\xfa\x2e\xb0\x4e\x0c\xc8\xa4\xa3\x2f\x23\xb6\x7b\x94\xf0\x7f\xa9\x94\x9d\xfe\x38\xc2\xe1\xed\x7d\x23\x64\x27\x0a\x72\xda\xad\x03\x7e\x06\x7e\x7d\xd6\x7e\x95\x78\xb3\x80\xbf\xfc\x5f\x65\x2e\x40\x3d\x3d\xec\x05\x2d\xf8\x91\x7b\x6e\x51\x1f\x86\xcf\xd9\x09\x2f\xb8\x51\x7a\xd7\x82\xa2\xf9\xb0\x1e\x45\x6e\xf4\xd9\xb1\x4c\x23\xfc\x55\x4d\x9b\x5d\x0b\x17\xa6\x56\x4e\xcc\xf9\x7c\x7b\xa1\xa2\x56\xba\xe5\x5f\x41\x41\x3b\xb6\x18\x26\x24\xff\x90\x81\xfd\x6f\xdf\x88\x23\x06\x52\x91\xe6\x0c\x60\xf6\x81\x2f\x60\xa9\x1a\x6e\x81\xc7\xa0\xb8\x97\xbe\xa3\xba\x90\x16\x66\x79\x5d\xb3\xfb\xc1\x0a\x19\xc7\x0c\xe0\x85\xd5\x0d\x1a\xc7\x6a\xcc\x70\x8f\x6d\x6b\x86\x37\x57\xe7\x2d\x27\x78\x73\x75\xbe\x72\x72\x66\xd8\x24\x48\xb6\xcd\xc9\xb5\xbe\xfe\x2c\xde\x7e\x2a\x3d\x0d\xcb\x1a\x91\x20\x21\xfc\xb7\xd2\x88\x2d\xd2\x9a\xdb\x0a\x11\xd7\x05\xd5\x9f\x3e\x0e\x5f\xf6\x9e\xb2\x8d\x55\x82\x02\xc5\x2d\xd6\xa8\x7f\xfa\x98\x99\xbe\x24\x2e\x8b\x76\x34\xe3\x50\x43\x27\xac\x0f\x37\xee\x30\xe0\x5d\x0d\xb1\xc5\x8e\x1d\x19\xb7\xa5\x63\x19\x3b\x11\x36\x16\x1c\x0a\xe1\x9a\xf6\x5a\xa1\xb8\xab\x61\x2f\x4c\x1e\x77\x74\x68\x4f\x12\x22\xa6\xad\xe1\x10\xd5\xf6\xdb\xae\x35\xdd\xa7\xfe\x57\xfc\x9e\xcb\x18\x13\xc6\x88\xa8\x5a\x80\x89\x11\x15\x35\xa3\xf2\x69\x43\xca\xad\x88\xd4\x0d\x14\xdf\xba\x91\xd4\xa8\x92\x6c\x17\xd0\x0e\x8c\xab\x62\x6f\xe8\xb5\x59\x12\x32\x63\x26\x1c\xc5\x44\xfd\x58\xf5\xb9\xa1\x10\x63\xa4\xd2\xa2\x3e\x3c\xdc\x82\x02\x19\xa2\xca\xb8\x03\x89\xf8\xd5\x9b\xab\xf3\xce\x95\x1e\x90\x8d\x87\xbd\xa0\x85\x3b\x72\xcf\xad\x54\x7a\x00\x90\x8d\x6c\xc1\xe6\xd7\xad\xf7\xbc\xf1\xee\x22\xef\xba\xf2\x8a\x2d\x95\x24\x17\x47\x04\x4a\x92\x2b\x39\x58\xef\x39\x4d\x42\x64\xc8\x47\x23\x14\xb1\x58\xf4\xdb\x7a\x5d\x41\x27\x0e\xeb\xcf\x44\x96\x64\x46\x48\xb6\x9c\xb8\x39\xab\x18\x69\x27\x6f\xf5\x6a\x54\xad\x1e\xe5\xcb\x6e\xc2\x5d\xca\x94\x89\xc8\x66\x53\xc2\xee\x6b\x90\xef\x58\x64\xb3\xb3\x51\x43\x9a\x98\xcf\xd8\xd9\xc8\xcb\x87\xb5\x88\xac\x92\x20\xc6\x0a\x6d\xd6\x13\x90\x6c\xad\xce\x58\x4e\x05\xa4\x56\x75\x2c\x43\x12\x95\xca\x42\xe5\xba\x05\xd3\xd4\xaa\xb9\x5e\x20\x0a\x18\xc0\xa1\x00\x4e\x0c\xf5\xb4\x29\x45\x26\xd0\x69\x3f\xb5\xe4\x72\x83\xa9\x99\x06\xfc\xa5\x5f\xa0\xdd\x70\x78\xf7\x47\x73\xed\xc7\x0b\xfe\x81\x5d\xb0\x83\xab\xd3\xa3\x93\x8b\xd3\x61\x12\x7d\x37\x53\x0f\x83\x42\x0d\x4a\x2d\x06\xb2\xa0\xe9\x0c\x04\xa7\x5a\x4b\xe6\xa7\x7a\xfe\xaa\x3f\x19\x29\x78\x60\x79\x31\x3e\xbb\x6a\x95\xec\x06\xca\x1a\x73\x2c\x8b\xe4\xc2\x2a\x94\x22\x86\xb4\x31\x96\x73\xcc\xb8\xe5\x68\x45\x87\xde\x95\x66\x7d\x8a\x5c\x08\x5f\x26\x19\xd6\xfa\xa0\x1f\x40\x81\x97\xd0\xc9\x6a\x64\x58\x73\xc4\x91\xb0\x59\x75\x8a\x51\x8e\x38\x22\xe6\xe2\x41\xf8\xd2\xec\xde\xee\x4c\xa5\x29\x5a\xcf\xad\xc8\xc8\xe3\xf8\x0a\x93\xd4\xa6\x00\x26\x07\x01\x0a\x02\x03\x0b\x99\xfc\x79\x88\x88\x6d\xf2\xa2\x28\x26\x46\x1a\x1c\x40\x40\xea\xd0\x0e\x9a\x08\xfe\x55\x16\x80\xbc\x1f\xec\x54\x5a\xf6\x36\x58\x24\xff\x95\x98\x02\xf5\x7d\x28\x09\x09\xd2\xc7\xfa\xf2\xb2\x98\x41\xc0\x34\x16\xdc\x44\x25\x69\x69\x3d\x88\xa0\x52\xbb\x06\xef\x2f\xbc\x00\x61\x67\x24\x3d\xf4\x6e\xf5\x12\xd4\xed\x6e\x6f\x85\x9c\x5f\xba\x90\x73\x80\xf2\x17\x92\x6e\xf5\xf4\x3e\x73\xb9\x57\x66\xb9\x79\xa4\x4c\x90\x68\x1e\xc0\x67\x0d\xb1\x06\x39\x27\xe9\xab\x16\x50\x21\x0a\xbe\xd3\xf0\x7a\x1d\xae\xda\x44\xa6\x11\x65\xcd\x9a\xeb\x75\x6c\x9e\x6a\xea\xec\x16\x09\x1d\x34\x3e\xae\x67\x2d\x30\x94\x00\xc5\xdb\xbe\x88\xc0\x14\x80\x31\x40\x36\x02\xc8\x85\x0f\x04\xee\xf8\xa7\x96\x39\x99\xeb\x5f\xe2\x81\x1d\xe9\x20\x8b\xaa\x75\xee\xf7\xba\x95\x7a\xdd\x05\xeb\xbc\x5e\x73\xf5\x37\x16\x5c\xd3\x21\x2f\xbc\xa9\xe5\x9d\xa8\xe5\x9d\xac\x40\xa8\x26\xbe\xb1\x26\x68\xb2\xc0\xcc\x0a\xf8\xee\x21\xe4\xf3\xb1\x16\x65\x0e\xaa\x78\xc6\x73\x9e\x40\xa6\x87\x39\xe5\x22\x4c\xd0\x48\xa1\xa6\x7b\xa1\xd8\xe7\x4c\xa4\xa3\x82\x4f\xee\x86\x6f\x7a\xd9\x9b\x5e\xb6\x91\x5e\xb6\x49\x54\x0b\x1e\xe8\x32\xf2\x3c\x2a\x4d\x16\x29\x06\x8f\x05\x05\xa1\xc9\x14\xd5\x84\x97\x93\x0f\x5b\xd0\xc3\xb6\x11\x83\xe2\x9b\x11\x1f\xf6\x82\x96\xc7\xf7\x55\x5d\x50\xc6\xfc\xc7\xc4\x50\x46\x4c\xd5\xc8\x54\x56\xc6\x50\x55\xa5\x80\xd4\x27\xe2\xda\x86\x09\x1e\x54\xcf\xdb\x06\x9c\x54\x82\x38\x51\x91\x60\x63\xa8\x28\x8c\x75\x09\x55\x0a\xb9\xdd\x9c\x52\x6c\x1c\xb5\x34\x70\xe8\x43\x90\x25\x8e\xc9\xeb\x58\xa6\x2f\x3a\x64\xa6\x8e\x45\xf1\x20\x44\xca\xde\x11\x11\x41\x97\x7d\xf7\x87\x3f\xfc\xa1\x99\x05\xf2\xee\x87\xef\xbf\x1f\xb2\x13\x53\xbd\x58\xe5\xd0\x83\x0d\x6e\x95\xe4\x56\x95\xce\xd8\x63\x72\x55\x20\xed\x8e\x4f\xa7\xd6\x5b\x6e\x14\x39\xa9\xdd\x59\x6d\x73\xe9\x88\xa8\x89\xbc\x9d\x99\x9e\x38\xb0\x29\x55\x3a\x8d\xe5\x04\xaa\x97\x41\x6b\x4d\x23\x0c\x5d\x1d\x86\x80\x58\x58\x3b\x2e\x1f\x3d\x6c\xe2\x81\xa0\xe1\xe2\x9d\x60\x53\x5b\xd5\x6f\xdf\x05\xea\x13\x11\x73\xa1\xe1\x2a\x87\x7d\xdf\xed\xed\xb9\x5a\x77\x28\xc2\xf7\x5a\x82\x40\x03\xcc\xe0\x0d\x86\x5e\x2c\x81\x61\x2a\x16\xdd\x89\xf9\xc0\x44\x42\xb0\x8c\xcb\x9c\x2e\x5c\x81\xf2\x26\xee\xac\xd1\xf8\x17\x8f\xe7\x89\x88\xaa\xf6\xe4\x44\x4c\x57\x48\x03\x5b\xcd\x98\x0c\x75\xb4\x66\xb9\x8b\x9e\xc6\xab\x06\x15\x71\xa6\x34\x3a\x0a\xf1\x9a\x0f\x96\x49\xe0\x0a\x2c\xd6\xe0\x6e\xff\x66\xfe\x54\x95\x16\x52\xd9\x2b\x1a\xc2\xe3\xb1\xd4\xb0\x43\xee\xc4\x5c\x2f\xcf\x82\x88\xea\xe7\xea\xb1\x4d\xb6\x9c\xb6\x5c\x5c\xa6\xb5\x77\x10\x11\x0d\x3d\xb1\xd3\x3d\x8a\x6b\x93\x87\x6f\x3b\xc8\x4b\x5f\x5e\x45\x44\x36\xb3\x8e\x3a\x50\x5b\x1d\x10\x11\x1d\x27\xf8\xc5\xae\xc7\x67\x13\x11\xb5\x28\xca\xcc\x12\xce\xa4\x05\xb0\x32\x8d\xa1\xbc\xbb\x34\x76\x95\x84\xe7\x50\x4c\xd5\x35\xf9\xa2\xae\x12\x64\x8c\x61\x42\xed\x18\xac\x79\x50\x4c\xfd\x5e\x60\x79\xd6\x79\x23\x41\x9b\x2e\x22\xfa\xc3\x61\xdf\x2c\x0b\x64\xab\xda\xfa\x86\x46\x8a\xc1\xe7\xc3\xee\x3d\x58\x8d\xcd\x7b\xc1\x33\x60\x7f\xab\x63\xb8\xe6\xfa\xbc\x9e\x52\x49\x44\x85\xfb\xfa\x3d\x31\x9f\xb8\xad\xb6\x1f\xd8\x52\x70\x61\xaa\xd7\xb8\x53\x0b\xe5\xb6\x11\x75\xa4\xc1\xba\x98\xfb\x49\xc8\x2a\x42\x98\xa2\x10\x00\x09\x3d\x89\xed\x11\x0b\x22\x6e\x9f\x25\xab\xf5\x83\x20\xc4\x77\x35\x2d\xe1\x7c\x6a\xb8\xde\xef\xfa\x16\x3b\x75\x49\xab\x5a\xa8\x7e\x04\x2a\x81\x3f\xec\x83\x30\x09\x8a\x01\xaa\x22\x41\xb0\x14\xf5\x00\x82\xe0\xe9\xae\x10\x54\xa1\x37\x55\x12\xda\xaa\x0a\xed\x14\x86\x50\x8f\xf5\x0a\x1e\xbf\x9e\xd5\xa4\x68\xd6\xe8\x8a\x1e\x8b\xb0\x35\x51\x2c\x31\x97\x05\xb7\xc9\x6d\x19\x4e\x3c\xa9\x78\xca\xf8\x58\xab\xb8\x24\x55\x11\xa8\x7e\x60\x48\x15\x50\x4d\xb4\x5b\x09\x29\x6c\xdf\x9d\x20\x48\x23\xd5\x3d\x64\x4d\xdc\x03\x2a\xca\x61\xba\xe4\x6f\x2d\x9c\xe8\xd7\xc5\xd0\x3e\x8f\xf0\xdd\x10\xcf\x6e\x98\x21\x20\x34\xa0\x82\x6e\x21\xda\x8a\x75\xe8\xab\x58\x86\xe8\x56\x21\xeb\x83\x23\xc2\xd2\x2d\x42\x81\xfc\x18\xd6\x6a\x75\x6d\x9b\x55\xaf\x35\xd2\x3b\xad\x62\x97\x55\xdd\xaa\xcd\x6a\x98\x19\x37\x80\xdf\x27\x5a\x1e\xf6\x82\xc8\x71\x3c\x3a\x67\xbb\x55\x1b\x7b\x17\x84\x78\x9e\x16\x22\x9f\xf2\x89\xd8\xab\x19\x59\xd6\x22\xfb\x6c\x08\x9f\xeb\x3e\xe3\x69\x14\xbb\xcc\x7f\x26\x1e\xa1\xef\x39\x8f\xcd\x5b\xa3\x5c\xde\x93\x4e\x8d\xdd\xa3\x38\x9b\x71\xd7\x7f\x60\x6f\xd8\xb5\x71\xc6\x8c\xa3\x05\x27\x9d\x98\x07\xdd\xdd\xcd\xc5\x81\xc2\xbf\x83\xa6\xe7\xab\x25\x58\x5a\xe9\xba\x1d\x6a\x08\xb7\x57\x63\x0e\x30\x02\x7f\xae\x4a\x2a\xa2\x75\x03\x57\x6d\x2b\x72\x30\xc4\xd8\x3b\x28\x87\x68\x8e\x5b\xb8\xc7\x41\xba\x44\x90\x49\x06\xca\x6f\x14\xb4\x7a\x9e\xc1\x7b\x7a\x0b\xd9\x2e\xb5\xfc\x12\x12\x2c\x5b\x72\xe8\x4d\xf1\x92\xa9\xee\x65\xe4\x54\x55\x13\x82\x42\x2c\x59\x8c\xc2\x02\xfa\xcc\x6a\x34\x25\x03\x04\xd7\x5a\x4d\x24\x98\xed\x6a\xbc\x12\x74\xfd\x35\x0a\xaf\x2f\x99\xd5\x48\x55\xad\x3b\x41\x95\x29\x55\x3a\xdf\xca\x82\xa5\x2a\x12\x97\xe5\x38\x96\x7a\xe6\x43\x85\x5a\x2c\xdf\xa7\x15\x30\xb0\xa9\x78\xdb\x98\xa3\x86\x87\xca\xa9\x60\xe0\x7b\xd2\x22\xd5\xd2\x28\x8e\xe0\xae\xc9\x13\x5a\x5f\x33\x8f\x0d\x4b\xe8\xde\x51\x5b\x35\xe3\xc1\x02\x23\xbe\x31\x21\x9b\x5f\x11\x31\x6b\x33\xc7\xb2\x2b\x70\x4b\x82\x4f\x6f\xd2\xac\xf1\xf9\x84\xc7\x31\x31\x00\x9e\x2d\x14\x17\x73\x47\xa5\x35\xe0\xb8\x92\x2c\x96\x87\x09\x6d\x10\xdc\x52\x3b\xbf\x5f\xd5\x43\x26\x32\xdb\x63\x89\xe0\x44\x44\x5c\x16\x6d\x7b\xe6\x99\x46\x79\x2a\x75\x50\xfb\xa6\x6a\x2e\xc2\x7a\x2e\xa0\x2a\x33\x60\x32\x86\xb5\xa2\xe6\x7b\xbd\x39\x11\xdf\x9c\x88\x4f\x38\x11\x37\x08\x61\x18\xa1\x7d\xa3\xd1\x77\x08\x9d\x54\x94\xc6\xa0\xd5\x1f\x77\x88\x3b\x95\xa0\x8b\x88\x88\xd0\x40\x02\xfb\xee\xa3\xa2\xc8\xe5\xb8\x2c\xa8\x3b\xa5\x5d\x7f\xe4\x96\x4b\xdb\xa0\xfe\xdf\x17\xc6\x6b\x14\x53\xa1\x51\x66\x0f\xd0\xf8\x34\x21\x8e\xa5\x92\x10\x68\x01\xf2\x12\x66\xf9\x3c\xa0\xd1\x9f\x79\xc5\x0e\x74\x3a\x7c\xb4\xaf\x59\xa4\x26\xa5\x6f\xcc\x6b\xe2\x96\x7d\x08\x13\x11\xd7\xe8\x25\x7a\xd8\xfd\x6e\xa0\xdf\xff\x07\x38\x9f\x5e\x87\xef\x8f\xd4\x43\xfa\xc0\xf3\xe8\xe8\x92\x90\xec\xdd\x60\x85\x93\xea\xc9\xba\xc3\xd8\x01\xb2\xa3\xcb\x73\xc6\xc7\xaa\x2c\x7a\x44\xb7\xa4\x02\x6f\x1e\x2f\xde\xfc\xc7\x6f\xfe\xe3\x37\xff\xf1\x57\xf1\x1f\x83\xdf\xd9\x5e\x11\xc0\x1d\x09\xda\x54\x63\x37\x07\xf9\x12\xc8\x76\xe3\xf6\x2e\xb3\x9a\x04\xb2\xe7\xd2\x42\x2e\x31\x11\x93\xd5\xaf\x2d\xb0\x47\xec\xce\xad\x78\xa7\xba\xea\x90\x11\x9d\x40\x33\xaa\x5b\xf0\x69\x48\xa5\x06\x88\x0d\x11\x47\xe4\xfb\xe1\xf3\xc9\x83\x23\x01\xd9\x82\xb0\xfa\x21\xa3\x46\xc3\x21\x56\x29\x52\xd1\x21\x33\xa5\x07\x78\x9a\xaa\xc2\x50\x54\xef\xdb\x76\x27\x3a\x44\x69\x65\x68\x52\x49\x23\xf3\x0f\x9d\x71\x28\x64\x95\xd7\x22\x8f\x89\xbb\xad\x3d\x69\xe1\xa7\xd2\xb8\xc3\x9e\x5b\x20\x32\x62\x38\x2a\xe9\xc9\x4c\x24\x3c\x40\xf4\x54\x3f\x67\xb0\x32\xe0\xfb\x86\x6d\x0a\x8a\x69\x21\x52\xe3\xa3\x17\x79\xa2\x99\x9a\x86\xd1\xb8\x76\x06\x9a\x93\x6b\xe7\x9e\x5e\xb1\xad\xb5\x3e\xe9\x7e\xa6\x6e\x2a\x1b\x91\xf6\xb2\xe1\xaa\x82\xbb\x39\xd4\xa9\x30\x9c\x1c\x08\xeb\xa3\x5e\xbc\x07\xd5\x0a\x3d\xbb\x76\x2f\x44\x16\xba\x32\x58\xfd\x19\x54\xa4\x0c\x78\x2a\x48\x47\x7d\x73\xa3\xbf\xb9\xd1\xdf\xdc\xe8\x04\x37\x7a\xed\x38\x75\x52\x1a\x89\x67\x5d\xeb\x01\xb8\x76\x24\x0d\xff\x07\xe8\x54\x98\xd2\x82\xb7\x0b\x7b\x4d\x08\x42\x75\x8e\x78\xf4\xc2\x43\xa8\x13\x2a\x38\xe6\x1d\x7d\x70\x79\xf7\xdb\xf8\xe6\x71\x23\x96\xc5\x74\xf0\x47\x26\xd2\x89\x8a\x2c\xb3\x83\x60\xce\x03\x07\x09\x4a\xa1\x9b\x75\x33\x2c\x21\x71\xfd\x37\x8c\xc3\x3e\x08\xd4\xec\x18\x3b\xbf\x7e\x6f\xcb\xa2\xdc\x55\x5e\x3c\xdb\x58\x41\xab\xd4\x32\x5f\xcd\xd1\x12\x26\x00\xd1\xdb\x85\x45\x8e\xfa\x99\xc3\x82\x82\x16\x09\x6c\x4a\xd8\xe6\x70\xfa\x08\x1d\xa0\x38\xc3\xdf\x5d\x0b\x30\x9c\x64\xe5\x3e\x82\x0d\x13\x91\xa8\x7c\xbe\xef\x01\xe1\x97\x41\xa0\xf5\xd1\x20\xda\x9e\xd1\xff\x26\x65\x0e\x96\xdd\x78\xfe\xc2\x9a\xa0\xa7\x1f\xbd\xb2\xcb\x53\x0b\x5a\x79\x85\x61\x6f\x1f\x7a\x03\x50\x20\xa8\x91\x8b\x78\x30\xe9\x7d\x3c\x03\xb8\xc9\xbf\x62\x22\xbd\x87\x7e\x06\x3a\x84\x32\xad\x59\x1d\xfe\x46\xf2\x5e\x6a\x95\x1f\x06\x3d\x04\x36\x8a\xf9\xe7\xa0\xad\x01\x7f\x07\x2d\x05\x7b\xf3\xe9\x16\x93\x7c\xd2\x12\x0d\x52\x4a\x95\x45\x56\x16\x78\x56\x05\xc2\xfa\xfb\x93\xab\x38\xef\x77\xa7\x4f\x41\x0e\xdb\x93\xe8\x59\xdb\x79\xbf\x13\xf8\x58\xc6\x0b\x08\x25\x38\x64\xff\xbd\xfb\x8f\xdf\xfd\x3a\xd8\xfb\xcb\xee\xee\x4f\xef\x06\xff\xfe\xcf\xdf\xed\xfe\x63\x68\xfe\xf1\xff\xf7\xfe\xb2\xf7\xab\xfb\x9f\xdf\xed\xed\xed\xee\xfe\xf4\xb7\x8b\x0f\xd7\x97\xa7\xff\x94\x7b\xbf\xfe\x94\x96\xc9\x9d\xfd\xbf\x5f\x77\x7f\x12\xa7\xff\x24\x82\xec\xed\xfd\xe5\xff\x05\x0e\xf4\x71\x50\x79\x67\x06\x32\x2d\x06\x2a\x1f\xd8\x25\x3d\x64\x50\x98\x38\x08\xce\x91\xfb\x70\x13\x76\xa8\x1d\xfd\x0e\x8f\xd6\xd7\xa5\xf9\x47\x1b\x71\xdf\x7f\xcd\xd7\x0d\x37\xbd\xed\xde\x36\x42\xc7\xf6\x7a\x62\xdd\x02\xc0\x8d\xbf\xf8\x44\x06\x67\xcd\x9f\xe2\x73\x75\xcb\x3b\x67\x85\x48\x32\x95\xf3\x7c\xce\x22\x34\xf0\x52\x02\xf3\x96\xab\x5c\xd5\xca\x5c\xad\xcc\xae\x7b\xce\x31\x8a\x91\x4a\xae\x0c\xc2\x77\x66\x86\x91\xcc\xfb\xbd\x6e\x8f\xe6\x44\x44\xb2\x4c\x0e\x7b\xe1\xfb\xf4\x0b\xcc\xd7\x75\xaf\x70\x81\x55\x16\x0e\x7d\x0f\x24\x50\xc6\xc6\x7c\x72\x67\xbd\x13\x9e\xdc\x56\xdf\x45\x99\x0d\xda\xff\xce\x4e\x50\xf8\x49\x22\x78\xea\x9d\x08\x70\xac\x40\x30\x08\xf4\x30\x71\x90\x76\x9c\x41\x6a\x3f\x28\xfb\x29\x46\xd7\x60\x58\xeb\x2e\xc2\xed\x81\xf6\x7f\x61\xd4\x2b\xbb\xd0\x44\xc8\x97\x60\x87\x16\x62\x4d\xcb\x7f\x89\x8f\xa0\x8d\x1e\xf6\x3a\xd6\x3e\xda\xe8\x1c\x2d\x34\x8d\x26\xa3\x5e\xab\x82\xc7\x8c\x63\xe5\xba\x29\x83\x2e\xf5\xb1\xe7\x58\x27\x1f\x83\xbc\xdd\x52\x33\x2f\x3a\x9c\xdf\x1b\x38\x16\x28\x67\x95\x77\x7a\x6b\x09\xd3\x16\x14\xc2\xa0\xe4\x84\x8f\x63\x61\xde\x60\x55\x75\xcf\xa5\x00\x9d\xf0\x47\x99\x94\xd4\xce\x1f\xa5\x86\xdd\xa8\xd2\x26\x52\x35\xe8\x07\xd7\x06\x8c\x7e\x01\x4a\x64\x0a\x03\x58\x70\xa3\xc1\xe6\x1a\x39\x7e\xa9\xcc\x3d\x44\x4c\x53\xee\xc0\x59\x46\x74\x99\x80\x28\xc1\x11\xe3\x7d\x4a\x4d\x17\x5a\x54\x13\x91\x5d\x1f\xa8\x25\x49\x92\xca\x18\x5b\xa4\xa1\x90\xa0\x3b\xc3\x8a\x59\xb5\xb8\xac\x4c\x31\xa4\x76\x49\xb4\xaf\xde\xca\x50\x34\x67\x70\x5b\xca\xa8\xed\x26\x7e\xe5\x3a\xe5\xc6\x9a\x64\xc0\x71\x3f\x25\x34\xcd\x6e\xc8\x80\xb3\xe3\xe6\x09\x7f\x26\xc7\xb9\x60\xc7\x33\x9e\xa6\x22\xf6\xd7\x04\xea\x21\xff\x64\x93\xac\x66\x85\x1c\xb0\x86\x92\x20\x57\xf7\xc8\xea\xf8\xa0\xff\x2d\xd4\xbf\x79\xe1\x72\xed\xb6\x5a\x05\x11\x91\xde\xdc\x89\xda\xe8\x11\x7e\x5e\xb4\xb9\x53\x5c\xa6\x9b\xc5\x77\x9c\x1d\xb3\x82\xe7\xb7\xa2\x60\x71\x99\xb2\xb4\x4c\xc6\x22\x7f\x2d\xde\xfe\xdf\x5a\x39\xa0\xfe\x56\x02\xf1\xec\xf2\x7e\xf9\xf2\x49\x6f\x46\xc7\x8a\x53\x1e\x54\x1e\x47\x0f\x32\xb2\x29\x0c\x54\xa5\x6a\x17\x86\xb0\xd7\xef\x3e\x72\x22\x58\x17\x0d\xcb\xee\x7a\x78\x90\xd1\xe6\xa4\xb3\x0a\x86\x25\x1d\x33\xb4\xc3\x02\x31\x92\xae\x40\xed\x9a\xa1\xec\xb1\x53\x69\x7c\x63\xe6\xff\xe0\x9a\x33\x51\xc9\x58\xa6\x26\x24\x01\xb4\xb5\x6a\xc1\x89\xb8\xa0\xed\xc1\xee\x77\x0e\x47\xd3\xb0\x1a\x9a\xc8\x1a\x87\x0a\x74\xb4\xd7\x32\x29\xe3\x82\xa7\x42\x95\x3a\x9e\x0f\xbf\xb5\x45\x0c\xd1\x63\x62\xf1\x68\x43\x6e\x0e\x7b\x41\xcb\x7d\xe6\x1f\x6c\xea\x35\xb7\x22\x15\xb9\x9c\x20\x03\xf4\xe8\x26\x3b\xaf\xd8\x98\x5c\x12\xf0\xd4\x8b\xe8\xc0\x2b\x39\xb6\x09\xaf\xc9\x90\x12\x94\xa0\xd4\x31\x07\xeb\x6b\x16\x97\xb7\x32\xed\x5a\x8f\xd9\x42\x3e\x54\x58\x2e\x94\x35\x2a\xf8\x7b\x60\x48\x8d\x80\xaf\x9f\x72\xf4\xcd\x68\x6c\xb5\x5b\x1b\x11\xb4\x36\xd4\x48\x64\x22\x8d\x34\x84\x38\xd4\x76\x8a\xe5\x85\xad\xac\x14\x46\x0e\xbc\xf6\xb0\xf3\xda\x29\x71\xfa\x58\xe4\x50\x94\x2a\x49\x40\x20\xe3\xf8\x89\x83\x90\x53\xf0\x38\x05\x69\x10\x2f\x91\xd2\xf0\xa6\x86\x79\x35\xec\x15\x95\xd8\x6e\x64\x5f\x6d\x29\xdd\xcd\x9e\x34\xb8\xc1\xb5\x8b\x0c\xaa\xa5\x92\x11\x61\xe5\xb4\x96\x4a\x86\x23\xae\x57\x00\x5a\x48\x29\x23\xa2\x36\xa7\xbe\x3e\xa5\x2c\x3c\x91\xec\x09\x3a\xd0\x78\xeb\x2d\xbf\xec\x2d\xbf\x6c\x65\x7e\xd9\xd7\xcd\xa8\x99\x42\x7f\x53\x8a\xa2\xd7\xe0\xaf\x33\xfb\xd4\x82\xb5\x0f\x3f\xc4\x6b\x91\x53\x6b\xd7\x22\xb3\xe7\xac\x7b\x28\x65\x6a\xaa\x06\xcd\x94\xee\x46\x08\x92\x30\x57\xb0\xe7\x4d\x6b\x2e\x36\x16\x20\x14\xf3\x32\x25\x05\xc8\x07\xea\xcc\xbc\xe0\xba\x75\x1b\xa9\xfa\x96\x85\xd6\x78\x5a\x14\xc6\x7b\x62\xdb\xb2\x24\xa2\xe0\xf0\x29\x09\x97\xb1\xc1\x9f\x51\xf5\x4e\x1b\x78\xa0\x4e\x3b\xca\x60\xe2\x12\x59\x4b\x9d\xa8\x54\xcb\xc8\x54\x0b\xe0\xe0\xe1\xcb\x72\x31\x21\xa7\x0c\x05\x6e\x21\x1c\xef\xcd\x4d\xab\xca\xad\xf0\xd8\x02\x25\x91\x8d\x8c\x43\x01\x7a\x15\x93\x40\x99\x2b\xf8\x2a\x21\x6a\x75\x5a\x63\x71\x44\xed\x7e\xea\x01\x3b\xf7\x76\x22\x2e\x7d\x9b\xe5\x16\x4d\x7c\xfb\x1f\x8e\x4f\x9b\x00\xcd\xed\xfc\xe1\xf8\x94\xda\xc5\x77\xe9\x7a\xbb\xda\x6e\xbf\xb0\xb5\x09\xc0\xe8\xb3\x5a\x69\xb8\x5f\xf4\x07\x85\xfa\x76\x6f\x27\xa2\x6a\x53\x0d\x2d\x09\xfb\x1d\x8b\x83\xee\x5c\x01\xc8\xcb\x28\x58\x37\xeb\xd1\x7f\x2d\xb3\x43\x76\x9a\x6a\xe8\xd4\x0c\x48\x0b\x56\x71\x22\x30\xf0\x29\x68\xab\x6b\x2e\xaa\xee\x0a\x4a\x44\xfd\xe6\x4a\xeb\x6f\xc4\x6f\xc4\x77\x59\x35\x88\xee\xd7\x20\xc2\x82\xf7\xe3\x65\xfd\x1a\x19\xcf\x0b\x59\x90\xb3\x93\x9a\x5b\x02\x0c\x15\x1e\xc0\x4d\xba\x93\x0d\xf1\x74\xaf\x7f\x22\xa6\x83\x82\xa4\x52\x1c\x11\x9c\xbd\xf5\x4e\x11\x67\x3e\xf6\x95\x88\x79\x10\x89\xfb\x03\x1d\xf1\xf7\xfb\x66\x0f\x6b\x2c\x57\x55\x34\xa8\xc0\x35\xdb\xa1\xa7\x40\x8d\x64\x22\x63\x9e\xc7\xf3\x7a\x8e\x48\x0d\x0d\x74\x03\xf7\x5a\x22\x24\x44\x0c\xbd\xdb\x61\xbb\x2a\x37\xa3\x84\x62\xc5\xb1\xe0\xf7\x20\x58\x84\x13\x96\x73\x1b\xcb\x43\xb6\x06\xbc\xbc\x54\x7f\x19\xc7\x57\x16\xb5\xd4\x0b\xfb\x37\x46\x63\x69\x98\x52\x2f\x4f\xaa\x33\x97\x9c\x0d\xfd\xe1\xf8\x74\x68\xdb\xfc\x2d\x96\xb2\x77\x4d\xcf\xcd\x37\x5e\x24\xb0\xaa\xe5\x32\x05\x4a\x9c\x4d\xac\x5c\x24\xab\x14\x09\x97\x39\xdb\xd5\x92\x55\x6a\xd9\x80\xf6\xba\x77\x49\x98\x7d\x2c\xe4\x62\x6b\x77\x47\xa7\xea\xb1\x2c\xae\x44\x46\x38\xd1\x9b\xcb\xfe\xc1\x3e\xd6\x54\x85\x6f\x65\x01\x1f\x28\x2d\x89\x41\xaa\xbc\x80\xb0\x28\x10\xad\x93\x32\xe6\x39\xcb\x85\xf5\xf6\x0c\xd9\xc9\xe9\xe5\xd5\xe9\xf1\xd1\xf5\xe9\xc9\x21\x73\xef\x22\x9d\x34\xd5\x85\x6b\xc8\xae\x4d\x29\x62\x0b\xc9\xb8\x33\x79\x81\xfb\x10\x72\x86\xcc\x78\x09\x88\x30\xa3\x7d\x3c\xba\x78\x5a\x05\xad\x99\xe2\xdf\x3c\x65\xe7\xa9\x2c\xaa\xec\x0f\xe2\x21\x3b\x89\x55\x8a\x39\x06\x80\x8f\x3e\xad\x5b\x59\x98\x53\x27\xc5\xd7\xc1\xf9\xe0\xde\xd7\x0b\x28\x47\x7e\x69\xc3\x7d\xfd\x84\x87\x5d\xeb\xec\x3e\x34\xb6\x85\xc8\xb8\x36\x3e\xda\x2a\xba\xd6\x48\x6c\xf4\x05\xd5\xca\xc0\x92\x80\xd9\xaa\x9a\xde\xa6\xda\x7b\x7f\xd8\x77\x8a\x77\xec\x12\x3e\x89\x90\x78\xe4\x57\x03\x74\x89\x9e\x40\xda\x26\x8f\x0f\x19\xfb\x0c\x0e\xe9\x07\xa9\x05\xd5\xbc\x26\x97\x4a\xd1\xe3\x0b\xcd\x6b\x70\xf6\xee\x5d\x44\xcc\x6a\x44\x4e\xe7\xd3\xe5\xb8\x3e\x01\x2c\x80\x7b\x2b\xef\xc9\x59\xb4\x66\x59\xb6\x73\xdc\xb8\xc1\xb6\xe0\x9e\xab\x6a\xa6\x37\x57\x1f\xb7\x33\x3c\x2b\x31\x5a\x0c\xee\x58\x25\x10\x8f\x39\xe3\x7a\x86\x2e\xd9\x7a\xe2\xb9\x97\x6e\xdd\x8f\x3a\xe4\x0c\xa9\xc8\xdf\xe9\x39\x62\x8b\x5d\x06\x77\xf7\xee\x7f\x70\x0f\x2e\x98\x55\xfc\xc7\x46\x14\xf6\x88\x19\xe4\x78\xb3\x26\x64\x3f\xf4\x42\xf5\xb6\xc5\xee\x43\xa8\x24\x1c\xdc\xba\x91\x86\xf4\x1f\x0a\x13\xb7\x22\x8d\x6c\x57\xb1\x16\x3c\xd9\x3f\x75\x0f\x7f\xaa\xf5\xd4\x70\x88\x46\xf8\x92\x40\x31\xda\x34\x12\x05\x97\xb1\xae\xad\x4f\xa1\x32\x15\xab\xdb\x46\x9f\x59\x22\x62\x0b\xd2\x7e\x67\x73\xa4\x07\x7c\x00\xab\xda\xfd\x4e\x0a\x49\x15\x6f\xd2\xd9\xa5\x86\x03\x79\x2b\xea\xa0\x6c\x07\xd0\x0d\x94\xd6\x57\x45\xa0\xad\x5d\x17\x3c\xd5\xc2\x0e\x6a\x9b\x3b\xef\x3a\x84\x9a\xb3\xae\xaa\x21\x98\x89\x3c\x91\x1a\xc4\x2e\xb9\x24\xe8\xf2\x6d\xe3\x6b\x2d\xc9\x36\xae\x10\x5e\x96\xf4\x3a\xca\xbd\x0b\x38\x24\x40\x36\xd3\x8a\xc3\x34\xb9\xe6\xaf\xf8\x5c\xf3\x88\xc8\x72\x31\x10\x8f\x52\x43\xbc\x03\xb5\xa3\x81\xca\x6b\x8a\x67\xfd\xc4\x40\x8b\x9e\x37\xcd\xdb\x6f\x91\xae\xae\x0b\x46\x77\xd4\xe0\x44\x5e\x39\x53\x4c\x20\x1c\x8f\xe3\x39\x04\x6b\x51\x3c\x41\xa0\x3c\xa0\x65\x9b\xdf\x9a\xe9\xaa\x1c\xdd\xbb\x59\x2e\xef\x65\x2c\x6e\xc1\x58\x3b\x93\xe9\x2d\x39\x67\x04\x1c\xf4\x3c\x8e\xd5\x83\xc0\x72\x3a\x62\x69\xf6\x20\xc2\x75\x51\x5d\x94\x28\x17\x2e\xb3\x87\x3f\x7d\xbe\x66\xa9\x00\xe0\x99\xd4\xc3\x4d\xaf\xdd\x30\x24\x62\xda\xe7\x60\x30\x30\x71\xef\xbb\xff\x03\xf7\xa9\x28\xde\x63\x5f\x04\x8e\x44\x81\xed\xa7\xc8\x4d\xd7\xb2\x99\x32\x65\x4b\x68\x45\x69\xe0\xf5\x35\x2e\x31\x82\xc5\x96\x52\x40\x9c\x03\xc0\x82\xab\x0a\x55\x35\x69\x20\x42\x79\x3e\x53\x55\xdc\x45\x00\x75\x7e\x3b\xdb\xe8\x04\x43\xd3\xd9\xca\x5d\x42\x95\xa0\x18\x97\x92\xe1\x89\xc8\x99\x9e\x27\xb1\x4c\xef\xf6\x99\x2c\x9c\xd0\x07\x4e\x0c\xb8\x94\xc1\xe3\x6e\x87\xe5\x82\xc7\xae\x3e\x49\x37\x9c\xb6\x95\x73\xb2\x68\xe9\xd6\xba\x06\xa7\x11\x48\x00\x2f\xf6\x30\x64\xd0\x1d\x4c\x24\x4c\x13\x2e\xb0\xb3\xf3\x8a\x29\x14\x72\x6a\x75\x7d\x12\x49\x1d\xde\xfd\xa1\x7f\x3e\x82\xca\xb5\xf5\x33\x28\x65\xf6\x33\x74\x0b\x77\x94\xb8\xd5\x6d\xe6\x56\x88\xf2\x62\xc8\x52\x29\x2e\x5d\x0b\xa6\xc9\x8c\x67\x47\x65\x31\x3b\x91\x7a\x02\x6d\x59\xda\xa8\x90\xae\x15\x09\xba\x54\x99\x74\x0b\x60\x11\xd9\xf1\x5f\x8f\x2e\x49\xa8\x8c\xf1\x12\x68\x56\xc8\x09\x3d\xd8\x2f\x4c\x2f\xab\x66\x3c\x12\xba\xa5\x4d\x61\xf5\x7c\x11\xef\x95\xcd\xf6\xcd\x95\xff\xe6\xca\xaf\xb9\xf2\x8d\x34\xf9\xad\xb9\xef\x65\x2a\x0b\xc9\x0b\x95\xb7\xf4\x63\x1e\x97\xba\x50\x09\x6e\xf3\x73\x07\xc6\x00\xcd\x04\xfc\x36\xf0\x49\xf0\x6c\xa1\x6f\x28\x5c\x90\xcd\xd2\xf8\xbe\x45\x0b\x49\x4e\x54\x4b\x76\x2a\x1e\x70\x9c\xd2\x23\xfd\x09\x13\xe5\xa0\x8a\x17\x8f\xff\x7c\xf8\xa7\x20\x7f\x3e\x58\xa3\xfe\xec\xed\xed\x58\x0e\xcf\x9b\x52\xb1\xf7\xfd\x76\x4c\xa8\x8c\xc9\x5f\xd2\xf6\xee\x0c\x4b\x87\xff\x28\x79\x6c\x8d\xbd\x9f\xb6\x65\x3d\x6f\xae\x5c\x8b\x01\x3b\xce\x72\x2b\x06\x23\xb5\x0a\x49\xa9\xe1\x6a\x42\x3b\x1a\x18\xae\x7c\x91\xf3\x54\xc3\x62\x37\x2b\x92\xf7\x31\x3c\xa4\xcf\x76\x8b\x49\xb6\xb7\x15\x4a\xb4\x4b\x00\xb6\xc3\xc6\x55\xfb\xe8\x13\x7f\x87\xaf\x25\xfe\xc1\xec\x1b\xbd\xe9\xc4\x2e\x0d\x0c\xfb\x28\x75\x01\x66\x08\x81\xb8\x24\x54\x23\x2f\x84\x4d\xb4\x04\xff\xe6\x25\x38\xd9\x64\xf6\x33\x8f\xa2\xfc\x10\x70\x98\x74\x85\xa1\xc9\xb5\x1a\xa5\x46\xf3\x85\x69\x5e\xe3\x62\x87\x76\x8b\x79\x26\xa1\x5d\xcf\x9c\x5d\x1f\x5f\x9a\x31\x6a\xf6\xc7\x1f\x42\x8a\xe1\xff\xdb\xef\x7f\x78\xb7\x37\xfc\xc6\x92\x32\x37\xb2\xa1\x3e\x6b\x42\x75\xbf\xfc\x4a\x11\x17\x2f\x99\x19\x04\xaa\x2f\xe6\x04\x01\x01\x90\xff\xf1\xf8\xa1\x5d\x99\x1a\xe7\x75\x1b\x05\x39\xec\xfa\xe3\x4e\x38\xea\x77\xdf\xb2\x55\xbe\xd9\x6c\x15\x57\x7b\xc0\xca\xe1\xc3\x5e\xf0\x62\xaf\x90\xe6\x56\x90\xa3\x64\x27\x87\x5d\x52\x05\x39\x3d\x90\xf3\xab\x0b\xf2\xad\x59\x99\xe4\x2f\xeb\xb7\xfd\x00\xaa\x85\x10\xbe\x55\x5f\xff\x5e\x87\xbc\x45\x13\x20\x4d\xc1\x61\x65\x3b\x84\x04\xd5\x62\x6d\xa0\x82\x1c\x3b\xf9\x34\xfa\xf9\xe3\xd1\x8f\xa7\x94\x48\x0a\x58\xa5\xd2\x06\x59\x82\x24\x90\xe9\xb3\x26\xa6\x6e\x24\x4b\xbf\xd7\x21\x33\xa4\xe1\xd1\x09\x9f\xce\x46\x0b\x06\x3f\xf8\x04\x8b\xb7\x55\x86\x71\xaa\xc9\xef\xa9\x90\x84\x4d\xad\xb4\xe9\x54\x77\x6d\xab\xdb\xc8\x89\xe0\xec\x9b\xe2\xb1\x69\x2b\xf9\x74\x36\x22\x41\x82\x66\x90\xdf\x8b\x3c\x98\xb3\x5a\x11\xe6\x35\xf9\xc0\xe9\x14\xb2\xb4\xfd\x4a\xde\xef\x2d\xae\x41\x88\xf6\x98\xb7\x2b\xbe\xd1\x1f\x89\xbc\x56\x7d\x03\x76\x30\x08\x1d\x38\x1e\xcf\x2f\x19\xdc\x73\x84\x26\x9f\x86\x53\xb7\x6e\xaf\x9b\x67\x3b\xf7\xb6\x0c\x70\xbe\x5d\x1e\x6e\x55\xb4\xb5\x3d\xb2\x8e\x63\x2e\x09\xe5\x60\x9b\x8b\x7b\xb9\x0a\xc4\xfe\x73\x64\x1c\x34\x35\x89\xbe\x16\x9a\xd5\x5b\xeb\xc2\x66\xe3\x6c\x25\xbe\x8f\xbb\xa4\x59\xe5\x7c\xff\xa3\xd6\xdc\x52\x91\x6a\xe0\x18\xa7\xfa\xc8\x7e\x32\x01\xea\x75\x7e\x30\x18\xd4\xb6\x49\x1a\xc7\xee\xe1\xc5\xca\x37\x4f\x90\xb5\x17\xd6\xe4\x87\x27\xa2\xde\x5a\x4a\x3b\x0d\x05\xe3\xab\x0b\x7a\xf7\x3a\x57\x47\xf5\xeb\xaf\xce\xcb\x9e\x4e\x5f\x56\xdb\x13\x6a\x96\x02\x12\x26\x5b\x6d\x4f\xd8\x9e\x2d\x21\x44\xbc\x79\x0e\xee\x54\x74\xcd\x54\xa1\xd2\x8d\x52\x8d\x2f\x57\x40\x34\xb4\x4f\xfc\x06\x64\x1b\xe4\x2a\x8e\x69\x06\x45\x0f\xc7\x20\x83\xa5\x72\x39\x83\x3a\xef\xd4\x06\x95\x3a\xe7\x33\x65\x7f\xd4\x9d\xd3\x1d\x0b\x97\xd6\x1e\xc4\x45\x07\xe2\x37\x58\x64\x6a\x3b\xae\xbe\x6d\xc8\x97\x2c\x6a\x55\x70\xe0\xfc\x04\x03\x1f\x5c\xb9\x00\x8d\x0c\xcd\x82\x38\x7a\x05\x57\x77\x3f\xc9\x20\x75\x29\x3a\x3f\xe9\x54\x94\xa8\xbc\x78\x50\x39\x56\x0a\x3b\xec\x05\x11\xf9\xb2\xf1\x70\x53\x7c\x64\xf8\xbb\x1e\xf5\xfc\x5b\x2b\x2d\x5e\xa7\x2c\x18\xc1\x83\xf5\xa9\xb7\xf7\xf8\x3b\x39\xe2\xc4\xc8\x73\x02\x82\x88\xfb\x6c\xdc\xc0\xb2\x80\xa0\xed\x60\x56\x8b\x14\xf8\x7a\x82\x61\x03\xc5\x63\x4d\x91\xb4\xd0\x5c\xd2\xe7\x9c\x23\x4f\xba\x3d\xb6\xa0\x97\xb8\x9d\xd4\x4a\x60\xfe\x1d\x1f\x45\xb3\x5b\x3c\xaf\x8b\x4e\xee\xb7\x3a\x09\xd8\x0d\xa4\xfb\x65\x0f\x11\x95\x8e\x18\x9d\x8a\xcb\x5c\x81\xe1\x90\x32\x80\x06\x75\xcf\xc1\x1f\x68\x5c\x47\x50\xed\x4c\xda\x02\x68\x2e\x88\x4f\xbb\xf2\x67\x14\x3f\xc4\x44\xa5\x53\x79\x9b\xf0\x4c\xdb\xce\x8a\xf5\x06\xcb\x1d\x0b\xc5\xcd\xba\x9b\x5f\x3c\xdd\xd2\xdc\x8a\x35\x12\x24\xa3\xb5\x34\xaf\xfa\x6f\x12\x51\x97\x1b\x98\x3f\xdd\x9a\x9c\x3e\xd0\xe5\x06\xe6\x4f\xb7\x26\x27\xa2\x3e\xd9\xa0\x73\x55\xd3\x4d\x22\xe6\x13\xad\x39\x9f\x68\xb7\x49\x04\x85\x92\x4e\xaf\x24\xb0\x01\xb7\x55\x0b\xa6\x75\x6d\xcb\x51\x25\xc1\xed\x4e\xae\x10\x1a\xe4\xf6\x6f\xbc\xf8\xd2\xbf\xc9\xea\xad\x58\xc3\x11\x07\x40\xe6\x41\x68\xaa\xa2\xd2\xdb\x7a\x6f\xd7\xea\x4e\x81\x93\x02\x45\x82\xba\xf9\xc2\x04\x46\x5d\x42\x5d\xf0\x8c\xfe\xc8\x02\x31\xea\xed\xd4\xf9\x58\x95\x85\x0b\xc9\xb2\xb8\x01\xb0\xb6\x02\x1c\x9c\xf3\x48\xc9\x80\x67\xdb\xcc\x3d\x98\x09\x56\xce\xff\xbc\xa1\x3d\xed\x33\xc1\x27\x33\x76\x27\xe6\x03\x23\xf5\x02\x91\x19\xcb\xb8\xcc\x9d\xd9\xea\x84\x17\xd8\x9e\x7d\xc1\xbb\x3f\x11\x51\x30\xf0\xb1\x5b\x11\x1f\x46\xe7\xf9\xd5\x17\x08\x08\x06\x45\x2e\xe5\xa0\x75\xc0\x39\x01\x19\x35\x1a\x8d\x77\x52\xb7\x82\xbc\x13\x73\x23\xea\x20\xd9\x08\x8c\x13\x68\x0f\x34\xd4\x34\x81\x8e\x15\xad\x83\xb1\x81\x84\x20\x35\x44\x04\x2b\xa4\x97\x29\x11\x8c\xe8\x4b\x2b\xf8\x51\x99\x63\x09\xcf\xfb\x32\xb5\x6f\x0b\x86\xad\x46\x87\x0d\x7c\xf1\xc6\x62\x08\xc0\x61\xf0\xe1\x23\x6d\xc4\x7a\x9a\xd6\x42\xd0\x67\x48\x15\x0e\x3b\x1c\xd1\x1a\x57\x3d\x63\x35\x8a\x16\x68\x51\x94\x59\x30\xa4\x59\x10\x53\x99\x8b\x95\x69\x2c\xb4\x86\xc4\x20\x38\x98\x79\x7e\x27\x5c\x01\x68\x1e\x0f\x7b\x41\xa8\x8c\x31\xf0\x3e\x6a\x5f\xcd\xdf\x37\x19\x06\x46\x03\xe9\x9d\xaa\xf0\xe9\x63\x36\x9c\x99\x34\xb4\x19\x36\x0b\xdf\xac\x3b\x11\x8c\x09\x38\xa1\x93\x6b\x25\xc2\x16\x84\xd8\x05\xcf\x60\x0b\x63\xc3\x38\xd8\x82\x2d\x9a\x59\x82\x91\xcc\xd0\x00\x75\x34\x8e\xbc\x10\xbe\x5a\x6d\xa5\xb9\xdf\x3c\x6d\x1e\x5b\x20\x09\xc4\xd4\x58\x42\x38\xf1\x10\x3e\x8f\xe0\x2b\xd3\xe6\x4d\xf8\x9f\x98\x4d\xad\x5c\x76\xa8\xae\xb8\xf8\xa7\xba\x21\x2c\x37\xe6\x6f\x09\xb9\xf9\x15\x61\xf1\x4f\x67\x8d\xfd\x57\xde\xb0\x56\xb4\xf8\x7f\x1e\xf4\x7f\xd9\x7b\xba\xde\xc8\x6d\x24\xdf\xf5\x2b\x88\xec\x01\xb6\x2f\xee\xce\xe4\x10\x2c\xf6\xbc\x0f\x81\x67\x3c\x09\x8c\x64\x26\x3e\xb7\x77\xee\x21\x9b\x03\x68\x89\x76\xeb\x2c\x89\x8a\x28\xf5\x4c\x2f\xf2\xe3\x0f\xc5\x2f\x49\xdd\xfa\x28\x52\xdd\xf6\x4c\x4e\xad\x00\xf1\xd8\xcd\x12\x59\x2c\x16\xeb\xbb\x7a\x7f\xea\xd5\x38\x54\x58\xac\x27\xd4\x21\x0d\xc4\x77\xa2\x56\x6f\xd9\xd3\x48\x3c\x21\x1e\x42\x8f\xd9\xfd\x80\x5e\x73\x12\x38\x0f\xf3\x52\x74\xa6\x2a\x3e\xfe\x81\x35\x83\x27\xfd\x6e\xdd\xb8\xdf\x50\x0e\xfb\xee\x47\x4b\x9c\x40\x32\x20\x92\xa7\x34\x97\xff\x56\x2c\xd1\xf7\x58\xbe\x53\x77\x2d\x6c\x38\x05\x75\x41\xf0\xa4\x2a\xdd\xaa\x60\xf4\x81\x6c\x5e\xc7\x00\x8f\xb0\x84\xa5\x3e\x62\x4d\xe3\x26\xb6\xc0\x1b\xa5\xa4\xfc\x09\x5e\xdf\xae\x3e\x77\xfc\x01\xae\x12\xbc\x35\x6e\xf7\xb3\xf0\x92\x37\x91\xd1\x22\x13\xec\x7b\xbb\x8f\x5b\xa4\xbd\x6b\xe4\xe4\xc0\x91\xeb\x0e\xc0\x76\x84\x48\x8e\x17\x2c\xe9\x3c\x93\xa3\x04\x63\xfb\x87\x64\x4f\xa4\x7e\xa3\x35\x4c\xda\xe3\x95\x2e\x21\x6b\xd2\x6e\x5b\x9a\x8f\x23\x60\x59\x6e\x04\x8c\xad\x52\xcd\x33\x4a\x89\x6e\xd4\xea\x08\xcb\xd5\xeb\x30\xe9\x9c\x19\xfb\xf5\xe5\xcd\xf5\x45\xe0\x89\xc8\x6e\x7b\x51\x03\xf2\x9f\xdf\x62\x04\x10\x40\x1f\xa7\xd6\x84\x79\x55\x2f\xff\x83\x9f\x8c\xea\x21\xda\x1d\x42\x71\xdc\x9b\xf8\x0f\x7e\x22\x66\xc3\x27\xdb\x24\x90\x92\x6b\x37\x84\x07\x48\x2b\xbd\x68\xc9\xc0\x24\xf9\x42\xd8\x97\x34\xaa\x3d\xb3\x5a\x2a\xdf\x89\x4e\x69\x1a\xc4\xfa\xc9\xad\xb9\xcc\xc9\x8a\x25\xc0\xef\xbd\x60\x12\xb2\x63\x5e\xcc\x79\x74\x41\x64\x80\x30\xcd\x32\x5e\xca\x3d\x10\xee\x3c\x5e\xa7\x61\xd2\x7b\x96\x88\x73\x65\x0c\x04\x7d\xae\x11\x80\x87\x2a\xb3\xd7\xf5\x58\x03\xb9\xa7\x98\x3f\x6d\x0b\xe1\xa9\x2f\x3f\x5f\x08\x3b\x9b\xa9\xa1\xe9\x3d\xf0\x86\x49\x88\x08\xd7\x2c\xa5\x72\x23\x7f\x00\x5a\xd3\x85\xe7\x26\x40\x04\x1f\x7b\xc9\x32\x50\x4e\x4b\x56\xa4\x82\xf0\x87\x73\xa3\x07\xfb\x92\x9c\xa9\x40\xb3\xc1\x97\x5e\x3f\xa0\x2c\x50\x3f\x0f\x06\x45\x07\xda\xc6\x66\x91\x24\x3f\xf6\x52\x7f\x64\x19\x2e\x38\xd7\xc6\xc6\x6a\xed\x18\x13\x80\x5e\xde\x5c\x93\x8d\xa2\xb5\x17\x44\xbc\xbf\x1a\x02\xcf\xa2\xde\x36\xaf\xf1\x13\xb4\x8b\xd9\x18\x37\x1b\xe3\x66\x63\xdc\x6c\x8c\x73\x32\xc6\x35\x04\x35\x53\x06\x76\xd2\x26\x35\xcc\x7a\xad\x86\x16\x13\x68\xa9\xe4\x8d\x92\x2a\x3a\x6a\xc7\x98\xe8\xb4\x7d\xce\x13\x32\x2f\x5a\x46\xb9\x13\x30\x7c\x9d\x4c\xb3\xf5\x69\x55\xb9\x2a\x1f\x16\x7f\x23\x2c\x0b\x79\xa4\x8e\x3c\x5c\xb9\x05\x2a\xdd\xb2\xeb\x01\x45\xc8\x60\xb2\x8d\xe3\xb4\xf2\x06\xba\x63\x36\x54\xab\x3f\x09\x5e\xe0\xd2\x35\xa1\x6b\x3f\x1c\x50\x01\xd1\x6a\x87\x4c\x82\x42\x57\xb7\xeb\x7a\x34\xda\x35\xa1\xb0\x42\xeb\x1f\x06\xaa\x2f\xef\x49\xe2\x14\x0c\x2c\xc0\x14\x41\xe2\x60\xa2\x14\xe4\x54\xfd\x72\x19\xe6\x95\xb7\x4e\xa3\x20\xa4\x2c\xe5\xc5\xf6\xdc\x82\x06\x90\x9e\x10\x9b\x33\xd4\x70\xcf\x64\x50\x5b\x58\x15\x50\xc7\x01\x1d\xd8\xfa\xf9\x69\x4a\x76\x4f\xf1\x49\x61\xe3\x84\x57\x77\xd4\xf0\x31\xdb\x76\x49\xa2\xe0\xfa\xd1\xc2\x88\x38\xb7\x21\x06\x13\x00\x03\x3c\x96\x6d\xc8\x86\x16\xc2\x0f\xf7\x07\x38\xf4\xf0\x5f\x14\x6f\x62\xc1\x91\x89\xb0\x5d\x0f\xcd\xb6\xbf\x78\xb2\x0b\x5d\xec\x60\xe2\x35\xdc\x86\x33\x09\x19\x3b\x84\xb4\xd2\xc2\xa8\x5f\x90\x52\xfd\xe1\x55\x99\x57\xa5\x96\x58\x0c\x37\xd3\xd5\x36\x27\x80\xb5\xfc\xaf\xd6\xb7\xc1\x0b\xf7\xd5\xb7\x5f\x79\x03\xcd\x69\x59\xb2\x22\xbb\x20\xff\x73\xfa\xcf\xaf\xff\x58\x9c\x7d\x7f\x7a\xfa\xeb\xab\xc5\x7f\xfe\xf6\xf5\xe9\x3f\x97\xf2\x87\x7f\x3f\xfb\xfe\xec\x0f\xf3\x8f\xaf\xcf\xce\x4e\x4f\x7f\xfd\xe9\xdd\x8f\x77\x37\x6f\x7f\x8b\xcf\xfe\xf8\x35\xab\xd2\x27\xf5\xaf\x3f\x4e\x7f\x65\x6f\x7f\x43\x02\x39\x3b\xfb\xfe\xdf\xbc\xa7\xfc\x69\x51\x3b\x40\x16\x71\x56\x2e\x78\xb1\x50\x44\x70\x41\xca\xc2\x23\xba\xae\x8d\xdc\x8b\xc3\x90\x52\x43\xc8\x33\x90\x0f\xa1\xfb\x9f\x7c\xb9\x4a\xfa\x24\x34\x4c\xd2\xd1\xfd\x67\xfe\xa5\xf8\x2b\xbd\x5e\xa8\x72\x16\x2e\x02\x4f\x0a\xef\xf6\xd9\x38\xb5\x97\xff\x82\xdd\x35\x5f\x48\x80\xaf\x2e\x79\x66\xac\x21\x73\x74\xef\x1c\xdd\x7b\x80\xe8\x5e\x45\x55\x4d\x8b\x1d\xe4\xcc\x54\xb9\x0c\x81\x73\x06\x3a\x87\xf6\xce\xa1\xbd\x73\x68\xef\x1c\xda\x3b\x87\xf6\xce\xa1\xbd\x73\x68\xef\x1c\xda\x3b\x87\xf6\xce\xa1\xbd\x73\x68\xef\x1c\xda\xfb\xd9\x85\xf6\xae\xdc\x6d\x1b\xed\xb8\xde\x2f\x29\xac\x17\xea\x35\xc6\x21\xbb\x0c\x43\xa8\x68\x73\xc7\x9f\x98\x53\x6c\x1b\xca\x54\xb4\xf7\x86\xcf\xdc\x6e\x44\xab\x28\x86\xd2\x8e\x93\x28\xeb\x52\x03\x31\x66\x0e\x88\x47\xc8\x22\x0f\x8b\x81\x99\x8d\xe1\x44\x25\xec\xd1\x92\x5c\x92\x82\x85\x71\x1e\xfb\xdc\x85\x1c\x4c\x0d\x12\x8e\x22\x55\x5d\x41\x66\x0b\x04\xcc\x92\x07\x3f\x25\x97\x66\x75\x25\x9a\xa2\x61\x91\xd0\x97\xb7\x59\x86\xcf\x64\xed\xb2\x95\xf1\x85\x9b\xf6\xea\x44\xac\x79\x95\xb8\xa3\xb4\x60\xff\x6b\x64\x67\x8d\xcd\xbb\xc6\x0c\xad\xd7\xc5\x19\xae\xb6\x18\x35\xd0\xa0\x27\x4f\xf3\x58\x17\x82\x7d\x2e\xee\xc8\x3e\xe5\x71\x21\xad\xb6\x2b\x16\xf2\x2c\x12\x93\x88\xf9\xed\x2e\x34\x4d\xd5\x8e\x30\x89\x71\x77\xb3\x88\x44\x95\x9a\x1e\xec\xef\x86\x26\x71\x14\x97\x5b\xc2\x1f\x9c\x21\x36\x58\x0c\xa1\x8a\xc7\xd8\x23\xe2\x37\x47\x39\x9a\xd0\x3c\x2f\x38\xd4\x39\x13\x0d\x5c\x2a\x7d\x53\x17\x3a\x73\x06\xac\x6d\x68\x79\x52\x3d\xc6\x99\x52\x36\xe5\x3b\x40\xa6\x87\xb8\x07\x88\xba\x66\x87\x44\xc0\xdd\x84\xc9\xca\xe9\x29\x9b\x57\x59\x6c\x65\x00\x3d\xd7\x53\xac\x0f\x8e\x33\x54\xdd\x5c\x40\xce\x0f\x68\x88\x27\x91\xe9\x1b\xf0\xb7\x57\x50\x97\x32\xf4\xe4\x67\x10\xf1\x21\xab\xa6\x97\x9c\x24\xf1\x86\x81\xc5\x2e\x7e\x98\x32\xd3\xd6\xe4\xfe\xe3\x3b\xb2\xe6\x55\x21\x96\x8d\x92\x64\xce\x20\xbf\x95\x30\x24\x0b\x33\x12\x02\x2d\x49\xc2\xa8\x28\xc9\xb7\xaf\x48\x1a\x67\x55\xc9\xd0\x45\xb9\x3b\x54\xdc\xbf\x7e\x17\x3c\x97\x72\xeb\xa3\xd6\xb6\x58\x8a\x89\x89\xb3\x8a\x9d\xd1\x6e\x7d\xb9\xae\xac\x44\x48\x64\x5b\xfb\x1d\x2d\xd7\x19\xa2\x16\x35\x9a\xa4\x9a\x95\xfc\x99\x78\xb7\x8f\x46\xe7\xac\x95\x79\xc8\x8a\xce\x43\x5c\x14\x37\xfc\xa2\x17\x04\x1b\x1d\xe6\x30\xe1\xdf\x2b\x7e\xbf\x2d\x11\x02\x5f\x8b\x84\xff\x4b\x8d\x6a\xd7\xd3\x34\xbf\x34\xfd\x20\x46\x41\x2a\x0e\x2e\x6b\x66\xee\xf7\x83\xa8\xdb\x41\x04\x87\x95\x76\x1f\x21\x72\xf8\x22\x70\x3e\xb6\xd2\x46\x68\x2c\x47\xfa\x42\xa3\x61\x08\xe5\x5a\x4a\x6e\x4a\x3a\xa3\xc0\x4a\xc7\x55\xc6\xd5\x4c\x82\x23\x1c\xa8\x09\x85\x27\x07\xdb\x31\xe8\x1d\x0e\x9c\x2e\xfd\xe3\xb7\x64\x08\x8e\xa1\x5b\x16\xec\x31\x16\x65\xe1\x87\x43\x35\xb4\x7d\x3c\xa0\xe6\x7a\x22\x6f\xe7\xb4\x4a\xca\x38\x47\x5b\xae\xcd\xb9\xb2\x60\xb5\xcc\xd3\x74\x7e\xd2\xda\xdb\x85\x84\x4a\x55\x5b\x65\xd5\xc2\x4e\xba\xe7\x4f\xcd\xbc\x08\xcb\xca\x02\x82\xc4\x20\x22\x53\xb0\x9c\x16\x14\xef\xf0\x95\x1b\x1c\xf2\x34\xa5\xe2\x4c\xfb\x64\xa9\x8c\x99\x55\x17\x1e\x48\x39\x05\x4d\x2c\x7a\x91\x50\x1b\xd1\x8a\xc7\x38\x30\x25\xcb\x68\x56\x7a\x6c\xf5\x9d\x1c\x48\xf8\x47\x9b\x68\xf9\x18\x6f\x58\x66\x79\xa1\x93\x83\x46\xeb\x8b\xaf\x69\xf8\xc4\xb2\x88\xfc\x43\x98\xf3\x12\x6d\x33\x9a\xea\x8e\x52\x79\xc1\x37\x31\xa4\x33\xb1\xc8\x91\x7e\x6c\xb4\xa7\xf2\x4d\x81\xf3\x9c\x95\xa6\x8c\xb7\x12\xcd\x8f\x81\xdb\x4a\x78\x75\x25\xf9\x87\x60\xc5\x18\xb7\xc5\x1a\x02\x65\x66\x59\x11\x6f\x42\x66\xd4\x04\x98\xd4\x31\x16\xbb\x41\x16\xa9\xde\x5b\xae\x2e\x50\x1d\x37\xbc\xd6\xf2\x4e\xb4\xa1\x31\xd8\xb5\x82\x1b\x22\x81\x0b\x60\x6b\xd2\x1b\x76\x08\x00\x76\x3c\x3b\x4e\x3f\x56\x17\x59\x06\xcd\x00\x4c\x99\xdc\x43\x4a\x3d\xc5\x3d\x62\x8e\xad\xfd\x39\xb9\x7d\x7d\xd5\xe6\xe7\xb7\x34\xe2\x82\xbc\x4e\x78\xf8\x44\xae\x18\x18\xfa\x46\x21\x12\x2b\x1b\x0d\x49\x3e\x0d\xd1\x07\xb3\x47\x0e\x0d\xed\x8b\xfb\xe8\x78\xed\xec\xbd\x0b\x94\xcf\xed\xce\xff\x8c\xed\xce\x8b\xfb\xe8\xff\x5d\xb3\xf3\x94\x3e\x7a\x1d\x00\xb0\x56\x15\x92\x9d\x48\x10\xa6\x2f\xa1\xef\x36\xa1\x8e\xff\x5f\xd6\xfc\xe3\xa2\xe4\x8b\x4a\xb0\x45\x5c\x1e\x05\x1f\x4f\x6c\x0b\x98\xf3\xc1\xc8\x4f\x6a\x68\xcb\x4c\x52\x72\xe9\x62\x42\xbe\xdc\x48\x8d\xb7\xaf\xaf\x40\x94\xb0\x4d\x7c\x01\xe4\x37\xac\x0c\xbf\x09\x59\xbe\xfe\x46\x4f\x51\x9d\x09\x24\x54\x0f\x5e\xfb\x0c\xc8\x4e\x79\x16\x97\xbc\x10\x3e\xd8\xbe\x24\x21\xb4\x1b\x09\x8d\x55\xfa\x0d\xcb\xd7\x16\xe0\xe7\x88\x1b\xa7\x10\x41\x47\x44\xba\x99\x6f\xa0\x3f\x09\x4f\x7c\x90\x5e\x1f\x7a\x80\xa0\xcf\x7c\x4d\xa4\x28\x88\x52\x92\x69\x72\x8a\xcf\x8e\x2c\x8f\xd6\x1a\xd2\xfc\xf1\x85\x7a\x7b\x93\x17\x40\xfa\xf3\x74\x12\x3f\x59\x99\xc1\xc0\x2a\x9b\xf9\xd6\x1e\x2d\xc1\xcd\x54\xda\xac\xf8\xfa\x01\x6c\x76\x9b\x38\x82\xea\xc6\x1b\x56\x14\x71\xc4\x84\xb9\x2e\xb0\xf8\xaf\x8f\x0a\xc9\xe2\xe4\x79\x0f\x81\x9b\x64\xec\x1e\x93\x83\x88\xc6\x69\xac\x17\x0d\x96\xbc\x5c\x1c\xce\x51\x22\x70\x7c\x62\x6f\x3c\x6f\x03\x94\x5a\x39\xc1\xdc\xd1\xb8\x0e\x00\x82\xef\x75\x40\xa3\x34\xce\x3e\xe3\x0b\xc1\xc5\x42\x20\x65\x61\xc4\xf7\x8c\xa0\x12\x1c\x70\x27\x45\x48\x13\x76\xfd\xcb\x45\xe0\xb4\x89\x2b\x35\xaa\x6d\x27\x30\xbf\xac\x9b\xb4\xa1\xed\x48\xbd\x0d\xc7\x7e\xb2\xc7\x97\x64\x3c\xc2\x78\x4e\x9f\x49\x97\xff\x13\x34\x1e\x74\x3e\x73\x0e\x7d\xc9\x1c\x99\xcf\x23\x2d\xd9\x47\xea\x23\x3b\xdd\x19\x04\xe9\xf6\xcd\xe6\xfa\x30\xc4\x88\xaf\x38\xf9\xa3\x9a\xc3\x51\xd6\x97\x17\xbc\x54\x5a\xc7\x15\x4f\x69\x9c\x79\x2e\xb4\x29\xa3\x98\x05\xde\x58\xd0\x28\x98\x84\xa8\x19\x48\x39\xa5\x6e\xe5\x52\x41\xdb\x38\x6d\xd9\x58\x7e\x66\xe2\xf1\x9e\xe0\xfa\x67\x69\x11\x37\x45\x78\xad\x65\xd7\xda\x70\x6e\xc2\x24\x9c\x32\x77\x81\x0e\x0c\x2d\xc9\x0b\xd9\x86\xdf\x11\xc1\x32\x11\x43\xbc\x46\x33\xec\x13\x87\x0a\x99\xc7\x23\x6b\x96\xd9\x3c\x45\x25\x06\x9f\x93\x9f\x39\x84\x46\x69\x7e\xc6\x33\x97\x4c\xc3\x07\x1a\x23\x33\x08\x67\xb9\x75\x96\x5b\x7b\xe4\x56\x21\x92\xb7\x19\xbd\x4f\x58\xe4\x71\xec\x7e\x48\xa8\x8c\x92\x63\x12\xc2\x37\x51\x2c\xe0\xff\x64\xb5\xfa\x59\xba\x82\xab\xcc\x4d\x67\x84\x8d\x33\x17\x8f\x2d\x05\xa2\x98\xdc\x71\x38\x8e\xe2\xf0\x9e\x6d\x04\xaf\xb3\x08\x96\xc7\x44\x2b\x96\x5e\xc3\x84\x0b\x05\x05\x92\xd8\xf4\x52\x1d\xdb\x0b\x51\x13\x77\xeb\x38\x7c\xba\xa9\x3d\xbe\x10\x3d\x70\xb7\x8e\xb3\xc6\xaf\xb0\x8c\xa7\x16\x6b\xfc\x20\x38\xd2\x9f\x5e\xfe\x8d\x9f\x71\xec\xae\x71\x93\xaf\x34\x22\x01\x14\xa1\x42\xf0\x30\x76\x0d\x47\x00\x06\x54\xcb\x1a\x24\x92\x57\xfd\x71\x96\x2d\xbd\x4a\x07\x90\x63\x0c\xf9\x68\x11\x98\x62\x79\x56\x43\x6e\x89\x33\x83\xc3\xa3\x2c\x55\x11\x2b\xbe\xca\x55\xef\x72\x2d\xdd\xef\xf8\xad\xdd\xa2\x26\x0c\xb9\x18\x9d\x01\x9c\xe7\xb1\x68\x10\x8c\x4b\x6c\xbf\xbc\xa2\xcd\x69\x94\x41\x77\xcb\x97\xd5\x85\xb5\x26\x80\xf8\xa6\x95\x9e\x30\xdf\xc5\xf9\xd3\x5c\xf4\x66\x64\xc9\x95\x2e\x8b\x63\x5b\x6d\xd6\x76\x43\xed\x1e\x47\x26\x3b\xe4\x3c\xaf\x12\x15\xa7\x6d\x37\xb0\xd3\x1a\x32\x24\x47\xe8\xa3\x67\x2c\x24\x7f\x51\x33\x39\x09\x0e\x2b\x5c\x4d\x6b\x5d\xdb\x95\xfe\xde\x48\x64\x77\x3b\x41\x52\xd5\xc6\x75\xb1\x45\x42\xb4\xd9\xee\x2d\x2d\xe5\xd5\x5f\xbf\xfb\x6e\xd9\xd1\xd7\x16\x09\xb4\xb7\xfb\x6d\x77\x5f\xdb\xe0\x20\x09\xeb\xad\xd4\x73\x24\xc8\x9d\x04\xf5\x03\xa4\x9a\x0f\xa6\x96\x3b\x24\x89\xbb\x27\x85\xbb\xc6\xc9\x3b\x78\xe6\x1c\x1a\x8a\xca\x06\xa1\x6e\xf7\xc1\x50\x95\x21\xad\x26\xba\xe8\x58\x9d\xb5\x85\x7a\x6a\x05\x21\x41\x36\x2a\x0a\x8d\x56\x08\x42\x82\x6c\x20\xf0\x50\x75\x81\x70\xd5\x80\xe4\x3b\x90\x10\x07\x6b\x00\x75\xd4\xf4\xc1\x4e\xd4\x68\xd4\x12\xe2\x58\x25\x9f\xe0\xb0\xad\x39\x55\xab\x4d\x24\x50\x93\x02\xd3\x55\xb5\x67\xaf\x0a\x0f\x12\xe6\x7e\xad\x1e\x87\xbc\x7c\x27\x77\xfa\x68\x9d\x9d\x76\xd5\x1c\x24\xd4\x5a\xfd\xc1\x4d\xd9\xc7\x96\xe1\x5c\x49\xa7\xb5\xd4\x3b\x5b\x2c\xc2\x9c\x21\xec\x4c\x9d\x25\x43\xdf\x5a\x39\x58\x11\xc1\x01\x24\xe9\xae\x8b\xb3\x2b\x19\x38\x41\x6c\x54\xc3\x39\x5c\x5d\x9b\xe1\x6a\x36\xf6\x9a\x77\x82\x89\x10\x09\xb4\x10\xe2\x04\x16\x23\x18\xe8\x6a\x32\x4e\x70\x27\x8b\x07\xbe\x42\x82\x9f\xa8\x50\x33\xae\x49\x67\xd2\x72\xd1\xbc\xd5\x8b\xc4\xb1\x30\xd0\x7e\x45\x98\x9e\xda\x2e\x4e\x50\x61\x4a\xcb\xc3\x56\x74\x19\xaa\xe3\xe2\x5b\x91\xc5\x83\x39\xe1\x95\x57\xf7\x9a\x2b\x4e\x39\x7d\x8e\x66\x4e\xd7\xe8\x2e\xb7\x5a\x1b\xc8\x0a\x1b\xba\x62\x46\xe0\x50\x38\xd1\xa7\xb0\x86\x9f\xe3\xc5\xd3\xa2\xd3\xb2\xf6\x6b\xe5\xdd\xd0\xba\x0c\x6a\x47\x81\x24\x8d\x7e\x59\xea\xae\x7a\x36\xf5\xdd\xf9\x20\x38\x50\x9e\x9e\x13\x47\x88\x2a\x6d\x12\x52\xe3\x7e\x59\xed\x84\x14\xd8\x5f\xa3\x2f\xca\x39\x92\xe0\xf9\x23\x09\x8e\x13\xbe\x7f\x78\x42\x9e\xdd\xd0\xc7\x73\x43\x9b\x7d\x13\x4d\xbe\x88\x4e\xcc\x07\x73\x1e\x78\xa2\xf9\x7d\xb3\x97\x63\xcd\x15\xa0\xbb\x59\x58\x30\x59\x75\x85\x26\x62\x49\xf0\xa6\x82\x1d\xa9\x5b\x8b\xcf\x4a\xaa\xaf\xad\x05\x50\x3d\x3f\xcd\x4b\x2c\xd9\xcd\xfe\xe5\xd9\xbf\xdc\xe3\x5f\x9e\xe4\x2e\xfa\x60\x07\x1b\xcb\xd8\xba\x4a\x69\xb6\x00\x4e\x03\x9e\x66\x17\x8b\x1b\x7f\xd8\x39\x44\xc6\x4d\xa0\x79\x0a\x71\xd9\x42\xc8\x90\x96\x89\xec\x55\x16\xff\x5e\x31\x6d\xf3\x20\xb4\x16\x63\x96\xc1\x11\xb0\x5f\x23\x53\x8a\x4a\x93\x30\x2a\x21\xec\xf2\xa9\x90\xe7\x58\xa4\xf2\x87\xa6\x71\x4d\x23\xc0\x62\x77\xa9\x4b\xd7\xd6\x08\x09\x3c\x6a\x7b\x43\xdc\xb9\x7c\xcb\x8d\xac\x0c\x61\x61\x19\x3e\x85\x84\xa9\xec\x01\xe0\xff\x16\x84\x26\x09\xff\xa8\x68\xa9\x29\x03\x01\x85\x88\x90\xe7\xd8\x53\x60\x5a\x89\xa5\x31\x14\xf9\x66\xd1\x1e\x02\x20\x00\x80\x94\x60\x7d\x40\x2b\xc5\x52\x85\x06\x61\x84\x67\x4b\xb2\x62\x65\x63\xb3\xe0\x6d\x34\x53\x09\xbc\xf0\xb3\x89\x9e\xc7\xce\x75\x6d\x6b\x7c\x91\x7b\xb6\xa6\x9b\x98\x57\x85\x7a\x07\xf4\x59\xd1\x7f\x92\x19\x83\x5b\x8e\x6d\xee\x64\xdc\x31\x95\x4c\xb2\xb3\x7b\x23\xf6\x50\xb1\x24\x96\xde\xb0\xe7\x4b\xba\xff\x22\x6e\x2c\xbb\x0b\xf6\x09\x7a\x47\xef\xe1\xd8\xcd\x90\xaf\xbd\x63\x47\x38\x99\x0e\x3c\x71\x23\x72\x90\x75\x3e\x20\x13\xc6\xdb\x87\xb7\x39\xb6\xad\x1a\x6c\x56\xf2\x4f\xd3\x15\x03\x5d\x3d\x4b\x55\x89\x30\x69\x93\xc1\x61\x05\x80\x59\x39\xf8\x12\x94\x03\xad\xbc\xde\xf0\x24\x0e\xb7\xd7\x57\x1e\xdb\xa5\x0f\x2a\x51\x20\xc8\x6b\x0a\x95\x2d\xde\xd1\x8c\x3e\x4a\xfb\x17\x39\x5d\xdd\xbc\x7e\x77\x86\x82\x2b\xf5\x4f\xb0\xee\x91\xeb\xab\xdd\xe0\x8e\xe6\xbd\xae\x5e\xf5\xfe\x38\x75\x0e\x76\x70\xe2\x29\xd4\x1c\x05\x2b\x47\xaa\xec\x60\x44\x0e\x7c\xeb\xe8\xd6\x52\x61\x98\x0e\xc9\x31\x15\x22\x85\x3b\xab\x82\x67\x93\x46\x4f\x87\x5f\x9e\x4b\x28\x4e\x8d\x88\xc3\x5d\x07\xb8\xf7\x2f\xe4\xb5\x1f\x1c\xe0\x8d\x18\x1b\xa8\xd6\x72\xae\xe2\xc1\x74\x26\x04\x9a\xc7\x16\xb7\x20\xf9\x9a\xf6\x3a\xa5\x46\x57\x34\xbc\x16\x28\x20\xc6\xab\x9e\x88\xa4\xc1\xc9\x0f\xbc\x38\x4c\x2a\x51\x76\xa5\x79\xb5\x88\xfe\xba\x16\xe3\x6e\x12\x5a\x82\x5b\xe5\x8d\x1a\x68\x94\x18\xc8\x5f\x03\x05\x96\x17\x50\x6d\xb3\x2c\xfa\x62\x64\xf5\xfb\x74\xa1\x1e\x05\x0a\x60\xc4\x99\x28\x69\x92\xe8\x88\x84\xc0\x61\x79\x26\x4c\x50\xbe\xf1\x22\x40\x3b\x84\x5b\x0b\x7c\xd3\x04\x02\xad\x05\xc9\x62\x11\xb8\xcb\x04\xe5\x80\x0c\x30\xb8\x43\xf0\x9f\xb4\x57\x78\x8e\x1e\x22\x4c\xd5\x7d\xb1\xf3\x0f\x7d\x1d\xb0\x06\x29\xb5\x9f\x4a\x9f\x68\xca\x12\x56\xba\x13\xd3\x4f\x6a\x60\x1f\xe2\x87\xd1\x5e\xb0\x9c\x0b\x28\x18\xd0\xbb\x2d\x83\x41\x01\xc8\xb9\xdd\x9a\xb7\x6c\xfb\xc9\x03\x43\x22\xb6\x38\xed\x6a\x34\xbe\xb0\x35\xb3\xbb\x7a\x8c\xee\x2f\x58\x87\xdb\xc8\xc8\x53\x13\x1e\x32\x12\xa7\x50\xea\x70\x55\xa9\xbb\x18\x8d\xd7\x9c\xc4\x73\xe3\xd8\x33\xf6\x39\x39\x53\xf0\x5d\x0c\xbb\x69\x4a\x6e\x2b\x5a\xad\x59\xbd\x21\xdb\xa0\x77\x04\x0a\x4f\xda\x65\x34\xfc\x85\x5d\x3c\xd5\x38\x69\x99\x29\x35\xd2\x64\x2d\x95\x25\x91\xc2\xf5\x08\x58\x62\x83\x03\xe2\xc8\xc0\x79\x62\xa3\xe9\x63\xa3\xe7\xdc\xc5\x5e\xe8\x66\x25\x7c\x6e\xdb\x1f\xde\xe2\xe7\x60\xc7\x43\xe3\x0f\xeb\xcf\x74\xf3\x64\xda\xda\xff\x23\x50\x09\xde\x85\x89\x35\xd7\x8f\x49\x17\x18\xe7\xf3\x20\xe7\x36\x4f\x55\xc4\x17\xc1\xa4\x1d\x98\x24\xca\x0c\x0c\xd6\xb2\xff\x45\x30\xb8\x89\x77\x05\x8d\xcb\x1b\xad\x26\x34\x6c\x06\x10\xc0\x27\xe4\x01\x81\x2f\x98\xa8\x96\x82\xe9\x64\x9e\xae\x7d\x32\x49\x92\x5a\xef\x0c\x8d\x20\x22\xa5\x89\x6f\x1a\xe6\xab\xc0\x01\x4b\xa6\x7d\xaa\x18\x59\x47\xc7\x6d\x73\x6b\x86\xca\x5b\x46\x73\x63\x51\x8b\x4a\x32\x44\xa4\x73\x25\xf6\xa5\x2e\xf8\x56\x88\xda\x9f\x26\x8d\xa2\x18\xe6\x48\x93\x9b\x41\x3e\xdd\x5a\xce\xa5\xda\x98\xf6\xcc\x9b\x19\xa8\xb6\x56\x3c\x55\x2f\x0e\xdc\xaf\x85\x11\x69\xaf\x63\x56\x72\x4e\x2d\xf9\xae\xff\xf2\x1e\xc0\x54\x67\x4b\x63\x49\x79\xc5\x86\x2d\xaa\xec\x29\xe3\x1f\xb3\x85\x62\x7d\xbd\xcd\x8d\x87\xc5\xb4\xd6\xda\x02\xc7\xd9\xf5\xfe\xb1\xe7\x0f\xa2\xa4\x65\xb5\x83\xe4\x31\xe2\x5c\xc9\x31\x9a\xeb\xa9\xad\xe5\xf7\x50\xc2\x55\xa6\x16\x43\xb2\x02\x7f\xe8\x1a\x17\xe0\x76\xf8\xbe\x8a\x93\x0e\xd4\x8c\xcd\xea\x35\x0c\x73\x3e\x2e\xf2\x65\xcd\xb4\xd7\xc0\x8d\x14\xef\xa9\x60\xd7\xfd\x55\xd2\x46\x78\xa8\x7c\xfb\x0a\xd4\x24\xf6\xd8\xe3\xaa\xc6\x2d\x5b\x83\x20\x2c\xab\x52\xc8\xb7\x85\x52\xb7\x49\x42\x62\x28\x90\x01\x06\xa9\xce\xb5\xd7\xeb\x17\x6a\x7c\xcc\x84\xcf\x2a\x40\xdc\xb8\x29\xf8\xa7\xed\x90\x2c\x3b\x02\xe3\x89\x66\xf1\x13\x97\x8b\x79\x03\x05\x22\x2e\x02\x9f\x2b\x34\xa5\x1b\x96\x21\xf0\xf8\x0e\xbe\x37\x24\xc0\x0f\x6f\x3a\x3c\x09\x0f\x69\x52\x6b\x03\x7d\x5f\x1b\x5d\x78\xa3\x94\x53\xef\xbb\x76\x66\xff\x01\xd4\xb5\x95\xbc\x1f\xfa\x79\x18\x66\x0d\x35\x27\x7d\x47\xf3\x9f\xd8\x76\x34\x34\xa0\x35\x8d\x95\x56\x3f\x68\xad\x7c\xbc\x31\xc0\x86\xa5\x63\xdc\xcc\x90\x62\x7f\x8f\xe0\x6f\x45\xfd\x31\x41\x1d\xb5\x43\x2e\xc2\xfa\xe7\x2e\xae\xbb\x0b\xec\x08\x90\x38\xd7\xbc\x03\xa6\xf1\x61\x8a\xa3\x82\xbd\xa5\x4a\x7c\x94\xa2\x91\xfe\x5d\x02\x14\xb1\xf2\x3d\x4e\xc2\xc7\x04\x98\xf6\x5e\xf6\xed\x47\x29\xad\x87\x38\xdf\x0a\xd2\xcb\x1f\xee\x43\x68\xf5\x9e\x7a\xbd\x13\x11\xcf\xec\xe2\x0b\x64\x17\x7b\x76\x80\x00\x9f\x00\xf5\x85\xf3\x0a\xc4\x97\x06\xdd\x0f\xa8\x4d\x1b\x79\x49\x4a\x3f\xdd\x56\x19\x74\x1f\x90\xd2\x60\x0f\x17\x69\xed\xe2\xbb\x9d\x21\xc6\x1b\x91\xd2\x4f\x71\x5a\xa5\x24\xab\xd2\x7b\xc8\x72\x79\x50\xe2\x6e\x1f\xcd\x4b\x3b\x01\xa4\x9c\x14\x55\x46\x74\x61\x6d\x21\x43\x54\xe2\x94\x9d\x9b\xfc\x3b\x6b\x35\x3d\x07\x12\xa1\x61\xc1\x85\x14\xb6\x03\x84\xa1\x55\x16\xd4\x50\x21\xe4\xc0\x24\x69\x09\x17\x92\x20\x8f\x09\xbf\xa7\x89\x7c\x83\xcc\x0f\x24\x21\x15\x6c\x20\x99\x56\xda\xeb\x0d\x8f\xb0\x4a\x0e\x7f\x68\x03\xb6\x6f\x85\xfe\x67\x49\x9f\x6c\x3f\x9e\x1b\x33\x96\x05\x53\x97\x81\x53\xe1\x1b\x6f\x12\x1a\xa7\x17\x81\x07\x6d\x8c\x5d\x1d\x58\x73\x04\xe2\x55\xa3\x64\x98\x57\xf7\x49\x2c\xd6\x07\x50\xce\x6e\xda\x90\x1a\x3a\x5a\x27\x4c\xb2\xab\xb9\x99\xa9\x4c\xd4\xd2\x4c\x7b\x02\xbf\x95\x98\x56\x2d\xd3\x94\x26\x5d\xbb\xad\xef\xcf\xa3\x6b\x80\xff\x42\x3a\x69\x78\x9c\x09\x16\x56\xc5\xc0\xb5\x8c\xe1\xcd\xbc\x78\xa4\x59\xfc\xaf\x41\xf3\x13\x6a\x3a\x63\xe5\x25\xa6\x92\x71\x51\x65\xc0\xb1\x65\x79\x9d\x88\x15\x88\xcd\xbf\x6d\x8f\xe8\xdb\xec\x91\x89\xe9\xf7\x6a\xc1\xe0\xc2\x07\x84\x28\x59\x2e\x10\x13\x5e\xc1\xf7\xa4\x7d\x37\xac\x44\xc9\x53\x6b\xd3\x60\xb9\x38\xd7\x3d\x47\x80\xa1\x0b\x63\x18\x62\xbd\x95\x35\x6d\xcc\x8d\x02\x11\xe9\xc2\x0e\x5b\x13\x46\x66\x2f\x01\xe9\xdf\x87\x9f\xa0\xc4\x44\x98\xd0\x82\x4d\xf4\x38\xbe\x31\x53\x83\xd5\xc0\x9d\x40\xf7\x57\x53\x2f\xa6\x6f\xfe\x90\x73\x6c\x17\x39\xba\x9a\x1e\x20\xe3\xc7\x98\x40\x8e\x68\x9c\xc5\x65\x0f\x33\xe9\x58\xdf\xa5\x1e\xa0\x96\x26\xfb\x84\xc1\x05\x66\xe0\x10\x01\x11\x7b\x55\xd2\x4d\x09\xf5\xa7\xa8\x92\xe1\x8c\x1e\xcc\xe4\xe1\x81\x22\xa3\x97\x88\x45\xec\x2d\xe4\x4a\x92\xde\xbd\xce\x2e\xea\x5a\x80\x9a\xe4\x08\xcc\xda\xe5\x90\xf3\xd1\x20\x37\xec\xa2\xe0\xc9\xa5\xce\x50\xb0\xe8\xaa\x82\x73\xb5\xb2\xd3\xba\x7e\xcc\xb8\xfd\xf5\xdb\x4f\x2c\xac\x86\x99\x57\xcf\xfa\x41\x05\xd3\x6b\x65\x85\x8a\x56\x56\xaf\x94\xce\x55\xfd\x07\x04\x50\x28\x33\x1f\xc9\x1c\x1b\xd8\x0a\xed\xa4\x11\xb4\x8c\xc5\x83\x0a\x53\xb4\x98\x65\x9f\xc0\xbe\x0e\x95\xbd\x44\x30\x02\xb2\x5d\x32\xc2\x56\x51\x91\x9a\xca\x39\xb9\xaf\x4a\x28\x76\x90\xd2\x2d\x09\xd7\x9c\x0b\x06\x51\xed\x1c\x19\x6a\x2c\xe7\xb7\x89\x39\xf8\x5d\x04\xe1\x99\xea\x7b\xc6\x0b\x2b\x87\x35\xa6\x39\xb6\x9f\x5a\xad\x02\xc9\x10\x68\xc8\x54\x76\x4a\xa1\xfe\xab\xdd\x3f\x23\xc4\xc2\xab\xd0\x85\x9e\x60\xc0\xa3\x0c\x06\x16\x25\x11\x55\x0a\x67\xec\x23\x83\x58\x6d\x71\x4e\xe2\x25\x5b\x4a\xb2\x63\x34\x5c\x3b\xae\x3c\x65\x0c\x4c\x01\x49\x62\x96\xdb\xa4\x77\xe5\xce\x00\x69\x05\xb7\x43\xa7\xc6\x49\x65\x7a\x08\x9f\x5b\x35\x67\x97\x6a\x2d\x19\xa0\x00\x37\xf6\xe0\x9c\xb0\x32\x5c\x9e\x41\x28\x43\x9a\x57\x25\xec\x35\xe0\xe3\x1e\x1a\x63\xbb\x84\xbe\x96\xeb\x82\x57\x8f\x8a\x8f\xea\xec\x66\xe5\x5b\xb4\x84\x25\xe3\x8c\x41\x34\x45\x42\xfc\x4a\x6d\xc8\x57\xb6\x8a\x67\x95\x9a\xf6\xb6\xb0\x27\x24\xa5\x25\x04\x4f\x69\x97\x59\x51\x30\x91\xf3\x0c\x0d\x5d\x8e\x7e\x5b\xe3\xe1\xef\x16\xf0\xa9\x38\xab\xaf\x84\x75\xfc\x08\x01\x5a\x28\x90\x80\x37\xb8\x5e\x61\x5c\x9b\x46\x31\x84\x3e\x78\x07\x0e\x30\x9a\xcb\x8c\xb0\x34\x2f\xb7\x8d\x13\xd1\xa0\xb9\x92\xed\xf8\x93\xfa\x1f\x83\x4f\x10\xab\xb5\x05\x44\xe1\x21\xd6\x01\xcb\xfa\x84\x90\x57\xe4\x14\x8e\x08\x12\x6c\x5c\x9e\xc0\x55\x96\xf1\x05\xcf\xcf\xa0\x87\x7b\x56\x25\xc9\xc1\x26\x9b\x71\x3b\x57\x39\x29\xe0\x06\x34\x11\xbc\x7e\x23\x0a\xa0\xcb\xcd\x61\x46\x98\xa2\x42\xd8\x11\xbb\xfb\x26\x89\x4d\x5b\xe4\xc0\xad\xce\x20\xa0\xa8\x0e\x6c\x46\x43\x6d\x88\x63\xad\x63\xa0\xb7\x0b\xbb\x4f\x3e\x48\xe8\x3a\x48\x2e\x63\xf7\x50\x02\x21\x09\xc0\x35\xda\xa8\x71\x66\x9c\xf5\x03\x2d\xef\x78\xc4\xa0\xb3\x1a\xbd\x67\x09\x32\x63\xd4\xe3\x4c\xf6\x2e\xa9\x77\x29\x8e\x60\x65\xef\x58\x5a\x43\x92\xd7\x8d\x16\x62\x05\x98\x67\x2b\x26\xce\x03\x47\x90\xca\x29\xa5\x2a\x3a\x51\x53\x84\xd8\x00\x57\xde\x60\x57\x8c\x13\x1b\x67\x07\x40\xd5\xbc\xdc\xb0\xee\x4b\x89\x0e\xd6\xf2\x91\x5d\x03\xe1\x4d\xd2\x0b\x60\xc7\xa5\x00\x5c\xfb\x53\xae\x1b\x3b\xaf\x8d\x4b\xc4\xbd\xa9\x36\x42\x07\x1c\x7e\xcc\xb6\x4e\xc6\xcb\x6d\x33\xe1\xe8\x89\x6d\xd1\xb5\x1d\xda\x8f\x24\x2c\xe0\x15\xeb\x38\x87\xdb\x1d\xa8\x5a\x1e\x7b\x3f\x6a\x51\xcf\x07\xe9\xa0\x30\x4b\x55\x2d\x6c\xaf\xb3\x73\xf2\x9e\x97\xd7\x4e\x39\xb4\xf5\xf3\x16\x72\xce\xc4\x39\xb9\xe2\x4c\xbc\xe7\xa5\xfc\xe7\x92\xfc\x58\xca\xf3\xe2\x05\xf1\x67\xa7\xd2\x51\x07\xd9\x7c\x85\xd3\xc9\x5b\x7f\x99\xa9\xf0\x34\xd8\x26\xcf\x99\xd4\xb3\x91\x8d\x80\x76\xad\xbb\xd7\x59\x80\x06\xd3\x78\x78\xa1\xb7\xb8\xae\x9b\x27\xf4\x54\x51\x71\x89\x5d\xcf\x3d\x88\x98\xd9\x42\xca\x55\x7b\x73\xf5\x82\x18\x0b\x4d\x4d\xa0\x0e\x35\xe9\xe9\xdc\xa1\x18\x64\xfb\xb3\xb7\x54\x70\xd4\xd9\x29\x7b\x81\xdc\xdd\x92\x1f\x65\x98\xe7\xcf\x07\x9d\xe4\x9a\x6e\x98\x6d\x8a\xed\x05\x53\xab\x17\xe7\xba\xc9\xb4\xa9\x58\x00\x96\xf7\x22\x2f\x98\x9b\xec\x54\x7f\xa0\x96\x63\x66\xec\xf7\xba\x78\x98\x9a\x77\x2c\x20\x48\x3b\xa1\xa1\x27\xe4\x48\x2a\x6b\x84\x5a\xcb\x74\x48\x52\x56\x3c\xca\x5a\x50\xe1\xda\x87\x23\x78\x09\x26\x07\x60\x26\xc3\x91\xaa\xc3\x1f\x9c\x87\xce\xc7\x67\xb7\xff\x59\xf8\x9e\xd6\x11\x33\xf1\xa1\xf1\x22\x85\xe7\x1f\xc0\xec\xe2\xb8\x9b\x6d\xde\x7c\x4c\xb9\x59\xbb\xaf\x83\xa3\x93\xe7\x2c\x37\xcf\x72\xf3\x2c\x37\xcf\x72\xf3\x2c\x37\xcf\x72\xf3\x2c\x37\xcf\x72\xf3\x2c\x37\xcf\x72\x73\xb7\xdc\xec\xf1\x32\x65\x0b\xbf\x08\xbc\x78\xf8\x7f\xcb\xc1\x7b\x45\x47\xa4\xf0\xee\x46\x0d\xfb\x46\x7a\x90\xb2\x57\x5a\xd6\xb9\x93\x2e\x00\x15\x3a\xe1\x00\xb4\xa0\xd9\x23\x23\xdf\x2e\xbe\x7d\xf5\x6a\x19\x1c\xb7\xd6\xb3\x7b\xa5\x67\x37\xea\x5d\x34\xfc\x2a\xc8\x01\x6a\x67\x83\x23\xd0\x8d\x0b\x69\x9a\x65\x1e\x29\x9a\xe0\xfa\xa1\xed\xeb\xd7\xaf\x03\x76\xde\xa8\x96\x86\x80\x4a\xda\xce\x7e\x5b\xc2\x2b\x65\x25\x84\x72\x36\x9d\x71\x10\xcc\x89\x82\xa8\xa3\x33\x5a\xed\x1d\x34\x20\x59\x51\x4a\xfb\x6f\x81\xd2\xd1\x37\x2c\x62\xb5\xed\x95\xa0\xa0\x86\x4c\x86\x23\x71\xb8\xf6\xcc\x8a\x79\x0a\xb3\x8f\xb3\xd2\x5c\x37\xb0\x14\x66\x76\x0a\x05\xf6\x94\x2d\x1f\x97\x24\xaa\x24\x68\x9a\x91\x2a\x8f\x68\xc9\xce\xa4\x88\xa2\x2b\x42\xc9\x2e\x0f\x10\x09\x81\x64\x73\x80\xc6\xb2\x90\x3d\x07\xd8\x86\x65\x65\x45\x93\x64\x4b\xd8\x26\xd6\xed\x65\x60\x92\x10\xc5\x2f\x73\xb0\x25\x66\x83\xc3\xab\x87\xbb\xbc\x09\x7d\x73\xb6\x68\xf7\x56\x1f\x8d\x65\x9f\x9d\x02\x09\x94\x48\x17\xa9\x00\xcb\x1c\x53\x3f\x4a\xea\xfd\xe5\x16\x5b\xfd\xca\xf9\xfa\x6f\x2d\x43\x3b\xae\x21\x2c\x04\x64\x45\xcf\x25\xa8\x45\x74\xb8\xaf\x97\xba\xa4\x7d\x4d\xe9\x0e\x30\x65\x9c\x05\x34\x8b\x2b\x18\xb9\x7c\x7f\x05\xb5\x0e\x01\xda\x1d\xcf\x79\xc2\x1f\xb7\xcd\x3d\x74\x99\x29\x74\x5f\xb6\x01\xb6\xa0\xba\x8a\xea\x5e\x6b\x9c\x40\x83\xef\x77\xc8\x63\x19\x1c\xd7\x4e\x31\x7b\x9a\x67\x4f\xf3\xec\x69\x9e\x3d\xcd\xb3\xa7\x79\xf6\x34\xcf\x9e\xe6\xd9\xd3\x3c\x7b\x9a\x67\x4f\xf3\xec\x69\x9e\x3d\xcd\xb3\xa7\x79\xf6\x34\xcf\x9e\xe6\xd9\xd3\x3c\x7b\x9a\x67\x4f\xf3\xec\x69\x9e\x3d\xcd\xb3\xa7\x79\xf6\x34\xbf\x9c\xa7\xd9\xed\x35\x2e\x28\x5f\xec\x39\x8b\x45\x70\xd0\x25\xa0\xbf\x9a\xf3\x68\x62\x52\x38\xf8\xaf\xac\x7f\xcf\x39\x27\x5c\x39\xdc\x42\xbe\x80\xea\x82\x25\x64\x5c\xc6\x02\xf2\xa4\x4d\xd9\x01\x59\x8d\x06\x90\x75\x4e\xfe\xc5\x33\xa6\x72\x5c\x47\xa1\x52\xa1\xbc\x81\xaa\x7a\x53\xce\xa3\x53\x71\x36\x9a\x3f\xe8\x22\x08\xdb\xa4\xc7\x39\xe7\x7c\xce\x39\x9f\x73\xce\xbf\xc0\x9c\xf3\x35\x95\x7c\x06\xea\x53\xc5\xe1\x3a\x70\xc9\x54\xde\x0f\xfd\x69\x70\x51\xf0\xcc\xfe\x1d\x2d\xc2\xf5\x65\xa9\xbf\x50\x06\x3a\xe8\xe2\x9a\x90\xe1\xc0\x35\x08\x51\xad\x1d\x2b\x92\xa9\x30\x2b\x16\xdd\xb4\xf1\xa2\xed\x42\x72\x61\x34\x8a\xa0\xd0\x13\x2b\x16\xe8\x23\x22\xdb\x2d\x3c\xc4\x59\xd4\x81\x15\x83\xc9\xe0\x38\x26\x8f\x9d\x0d\xc6\x0e\x1b\x88\x9f\x68\x5d\x9b\x10\x49\xe0\xa2\x36\xef\xc6\xaf\xbd\x54\xc6\xb8\xb4\xdb\x18\x31\xc6\x65\xe0\x0e\x62\x2e\xb5\x05\xe8\xf7\x8a\x15\x5b\xd9\x10\x11\xb4\x16\x56\x06\x0e\x00\xc1\xf6\xf2\x40\x0c\xcf\x13\x3a\xfa\x2e\x16\xaa\xb4\x1c\x1c\x75\x3c\x36\xfc\x31\x32\x3d\xba\x61\x0f\x39\xbb\xe0\xe0\xca\xa2\xce\x20\x89\xb5\xf7\x2a\x54\x5b\x5b\x59\xf3\x5e\x71\xc3\x50\x7d\xc1\x36\x61\x34\x42\x57\x82\x67\x53\xb5\x3a\xa9\xa9\x6b\x89\x1e\xb0\x8f\x63\x0c\x3e\x96\x41\xf8\x30\x46\xe1\x69\x07\x60\x92\x71\x78\x6f\x3b\x61\x25\x5a\x4a\x93\xa4\xeb\x09\x93\x58\xfb\x72\xa7\xad\xd8\x1b\xaa\x9f\x8d\xf9\x00\xd6\x81\x69\xb6\xe6\x3d\x34\x5b\xf2\xab\xfb\x8a\x78\x82\xd5\x84\x7d\x22\xf6\x8d\xcf\xde\x10\xdb\x46\xeb\x5d\x03\xb4\xff\x44\x5b\x86\x6b\x63\x36\xf4\xb5\x38\xc3\xd3\xb2\x5f\xbf\x14\x61\xf8\xdb\xa1\xf7\xc8\x42\x1b\x15\x81\x05\x6a\xab\xb4\x27\x54\x52\x5b\xb3\xfb\x2c\xd3\xde\x90\xa5\x45\xbb\xd3\x3a\xed\x0d\xb2\x6d\xea\x6d\x59\xa8\xbd\x61\xee\x9a\x7c\xad\x95\xfa\x20\xd4\xb6\x6f\x94\xf7\x06\xbb\x63\xe1\xae\xcd\xb3\xde\x10\x1b\x66\xdd\x0e\x13\xad\xff\x44\xa7\x99\x76\x27\xc9\x1c\x07\x3a\xab\x06\x84\x2f\x7e\x5d\xec\x8e\xce\xe6\xde\xff\x63\xef\xeb\x9b\x1b\xb7\x91\xbc\xff\xd7\xa7\x40\x79\x53\x25\xf9\x59\x4b\x9e\xc9\x93\xdb\xdc\xe9\xae\x2e\xe5\x8c\x3d\x39\x57\xe2\x19\x97\xe5\xc9\xdc\x55\x36\x37\x05\x89\x90\x85\x32\x09\x70\x09\x52\xb6\xf6\x72\xdf\xfd\xaa\xf1\x46\x52\x92\xc5\x06\x2d\x4d\x3c\x1b\xae\xa7\x6a\x63\x4b\xfc\x11\x40\x37\x1a\xfd\x8e\x7d\xbb\x7c\x83\x7c\x93\xfb\x5d\x2b\xad\x46\xff\x04\x0a\x44\x0b\x6a\x87\xf4\x61\x3e\x18\xb7\xd4\xe4\x72\x65\x3a\x20\x4a\x28\x49\x68\xda\x62\x40\x72\x4e\xfe\x07\x94\x50\x2d\x36\xfe\x97\xa4\x94\x67\x0a\xd2\xfb\x4d\x71\x62\xf5\xb3\x16\xe0\xd6\x87\x5b\x1d\x6a\x42\x53\x18\x2e\xf0\xec\x92\xc6\xed\x14\x72\x88\x62\x0b\xe7\x26\x82\x53\x65\xdd\x42\x6a\xa3\x8d\x3f\x2c\xc0\x55\x09\x2a\xa2\x76\x52\xc0\x20\x8f\xee\xd9\xea\xe8\xe4\xb9\x27\x14\x00\x5d\x8a\x23\x5d\x31\xbd\x21\xa1\xbd\x1d\xd1\x02\x58\x8a\x78\x45\x8e\x34\x71\x8e\x36\x6b\x0e\xc8\x53\x1d\x7a\x77\xff\xb4\x34\xdc\x5a\xef\xe9\x96\x0f\x96\xfd\xe5\xc7\xbd\xd6\x5b\xa8\x04\xf1\xde\xec\x10\x77\xe0\xb6\xd1\x94\x56\xca\xe4\x79\xf6\x45\x2e\xc9\xc0\xf9\x1b\xe9\x1d\x34\xb8\xc8\x8f\xff\xb5\x5e\xb7\x12\x88\xa8\xcd\xff\x84\x51\xa1\xc8\x91\x8b\xb2\xf4\x55\x39\xf6\xa3\xde\xc1\x4f\xcd\xd6\xd2\xaf\x9d\xd4\xcf\x6d\xd1\xcc\x8f\x6c\xf5\x0c\x2e\xb9\x75\x11\x29\xb5\x90\x45\x1c\x81\x5a\xe4\xc3\x55\xa1\x66\xc2\xc0\x79\xfc\x8e\x41\x59\x85\xca\xb0\x12\x8a\x0c\xa8\xc8\xf9\xd0\x7f\x23\x10\xda\xfb\x01\xc1\xd3\x65\x72\x04\xdd\x5d\xb6\x35\x7e\xec\xb5\x12\xe1\x6e\x7f\x44\x15\x5e\x87\xea\x73\x96\x3d\x63\x35\xb8\x72\x37\x0d\x41\x3e\x40\x66\xae\xc0\x20\x52\xd8\x80\x91\x11\xc9\x81\x98\xb5\x8b\x22\xf4\xc4\x8d\x87\x14\x04\x7b\x85\x1f\x02\x51\x4b\xcf\x3f\xd5\x66\x28\x15\xb6\x6a\x4c\x0a\x9b\x18\x41\x45\xe8\x7e\xb4\x63\x34\x4e\x2a\x70\x85\x03\xdd\xb8\x5f\x87\x11\xb9\x68\xb1\xc9\x2b\x53\x84\x13\x0c\x38\x8c\xc6\xb1\x7c\x08\x95\xe9\xad\x76\x6a\xb8\x26\x3a\x6c\x45\x93\xcf\x5d\x34\xfe\x70\xc0\xa2\xf1\xb5\xc0\xc2\x1f\xbe\x66\xbc\xbe\x1c\x5d\xe1\x78\x57\x38\xfe\x25\x16\x8e\xeb\xb5\x30\xa7\xcf\x13\x15\xe4\x28\x50\x53\x65\x8e\xae\x20\x47\x61\xfa\x2a\x73\xf2\xd1\x5e\xcc\x04\xc1\xd8\x8c\x91\xa4\x88\x73\x9e\xc6\x65\xfc\x1d\x9f\x88\xe8\xaf\x9f\x06\x60\xb5\x26\xe1\xa0\xee\x1d\x32\x21\xd6\x24\x1d\x0a\x18\x42\x49\x20\x6e\x32\xa5\xcf\x48\x9b\x5c\x01\x61\x69\x88\x98\x2a\xef\x9e\x33\x19\x2d\x1c\x77\xca\x05\xa8\xae\xb5\x0d\x78\x6e\x6f\xff\xf5\x19\xd4\x5a\xd3\x1a\x80\x42\x14\x03\x1b\xe2\x95\x95\xa7\xb5\x33\xeb\x31\x5f\x32\x97\xb6\x70\xc7\x97\x4c\xf4\x02\xcd\x10\xc8\x76\x72\x91\x8e\xe7\x2b\xaf\x07\x50\x5a\xe1\xfc\xdc\x54\x1f\xb7\x2b\x84\x48\xc8\x35\xb5\x71\x97\x22\x88\x44\xfc\xb7\x8a\x6e\xf2\xef\xcd\x2a\x20\x12\x95\x6a\x72\x78\xe5\xaf\x64\xa4\x52\xf5\xeb\x1d\x26\x3a\x57\x63\x34\xec\x43\x4f\x84\x56\xd7\x03\xf5\xf5\xd0\x7b\x2f\xd0\xbe\x68\x17\xa4\x6f\x17\x9f\x5c\xf7\x15\x85\x3c\xbb\xb6\x18\xeb\x50\xc0\xbf\x54\x87\xd9\x83\x30\x49\x53\x50\x1e\x7c\x3b\x81\x88\xfb\x08\xc8\xb7\x32\xf1\xb7\x72\xcb\xb6\x69\xf5\x5e\x42\x10\xbe\xab\xc8\xda\x56\x91\xb5\x1e\x70\x6f\x91\x13\xee\x96\x82\xe6\xff\x78\x85\x59\xfb\x0a\x94\x3f\x19\x24\x6f\x91\xf9\xe4\xe5\xc8\x13\x01\xf2\x96\xde\x60\xb2\x3d\x38\xfe\xec\x20\xf7\x4b\x28\xb2\xda\x53\x60\xfb\x30\x41\xed\xa7\x03\xda\xcf\x18\xea\xd6\x60\xf6\x5e\xc6\xea\x03\xd9\x9b\x01\xe9\x56\x90\xd5\xa9\x6e\x0b\x46\xb7\x02\xdd\xa8\x2f\xda\x57\x20\xba\xab\x2f\xfa\x83\xd7\x17\x41\x7d\xd1\x33\x02\xcc\xfb\x08\x2e\xb7\xe6\x84\x9a\x4c\xdc\x16\x54\x5e\x0b\x11\x07\x8e\x6b\x47\x40\xd9\xc5\x16\x2a\x2f\x0d\x04\xdf\x08\x26\xef\x3f\x34\x7c\x90\xb0\xf0\x41\x42\xc2\xcd\xe1\xe0\x36\x46\x40\xab\x3d\xd4\xe2\xa1\x32\xac\x34\xee\xb5\x62\xdd\x12\x60\x3d\x98\x5b\x09\x59\xa1\xa1\xc9\x16\xd7\x4c\xa9\xbb\x6e\x09\xcd\x06\x20\xd7\x9b\x0f\xee\x2f\x2c\x1b\x7c\x8e\xb4\x92\x19\xe1\xb2\xb1\xe2\x46\x19\xf7\x7e\xd7\x10\xec\x81\xc2\xaf\x07\x08\xbd\x1e\x2a\xec\x8a\x0a\xb9\x1a\x71\x14\x80\xba\xd5\xcb\x56\xa5\xbb\xf3\x9e\x05\x60\xee\x0c\xb5\xae\x07\x4f\x03\x70\xb7\x87\x59\x6b\x83\x35\x81\xd3\x00\xcc\xe0\x10\x6b\xf0\xce\x0b\xd3\xb7\xc2\xc3\xaa\x81\x12\x1b\x2f\x04\xd0\xc0\x10\x1e\x10\x39\x77\x21\x82\x71\x2f\x40\x3e\xac\x15\x23\x57\xf7\x71\x25\x9c\xd5\x80\x68\xaf\xda\xb6\x91\x1c\xba\x94\x3c\x22\x69\x91\x43\x09\xe3\x8e\xaa\xe4\x46\xd0\xb2\x6a\xb9\xab\x4a\xc6\x57\x25\xd7\x48\x58\xa9\x37\x45\x81\x3f\x11\x1e\xec\x4a\x93\xbf\xcc\xd2\xe4\x2a\x2f\xa0\xd0\xbb\xfa\xe4\xae\x3e\xb9\xab\x4f\xee\xea\x93\xbb\xfa\xe4\xae\x3e\xb9\xab\x4f\xee\xea\x93\xbb\xfa\xe4\xae\x3e\xb9\xab\x4f\xee\xea\x93\xbb\xfa\xe4\xae\x3e\xb9\xab\x4f\xee\xea\x93\xbb\xfa\xe4\xae\x3e\xb9\xab\x4f\xee\xea\x93\xbb\xfa\xe4\xae\x3e\xb9\xab\x4f\xee\xea\x93\xbb\xfa\xe4\xae\x3e\xb9\xab\x4f\xee\xea\x93\xbb\xfa\xe4\x7d\xd4\x27\x57\x0f\x89\x27\xca\x76\x11\xd0\x5f\x54\x91\x32\x62\xca\x6e\x3a\x28\x50\x33\x65\x6c\xa5\x32\x0a\xd2\x57\x33\xe3\x2a\x95\x51\x98\x1b\xf7\x61\xef\xaa\x54\x0e\xa2\x50\xfd\x3e\xec\xa7\x2a\x95\x51\x90\x2e\x5a\x6f\x9c\x6e\x0d\x95\xca\x38\xc4\x2d\xd5\xcc\x4f\x57\x2a\xa3\x20\xbb\x6a\xe6\xae\x9a\xb9\xab\x66\xee\xaa\x99\xbb\x6a\xe6\xae\x9a\xb9\xab\x66\xee\xaa\x99\xbb\x6a\xe6\xae\x9a\xb9\xab\x66\xee\xaa\x99\xbb\x6a\xe6\xae\x9a\xb9\xab\x66\xee\xaa\x99\xbb\x6a\xe6\xae\x9a\xb9\xab\x66\xee\xaa\x99\xbb\x6a\xe6\xae\x9a\xb9\xab\x66\x7e\xf1\xd5\xcc\xa8\xaf\xd1\xec\x6e\xa7\x7c\x43\x08\x40\xe4\xc2\x63\xe6\x37\x93\x49\x42\x45\xf4\x72\x06\xc4\xc4\xf2\x99\x83\xa9\x09\xdd\x0b\xb1\xfc\x99\x56\x9d\x1c\xe0\x22\x63\x62\xc9\x33\x29\xb4\x76\xb5\xa4\x19\xa7\xd3\xc6\xa0\x92\x7d\x1c\x44\x1a\x25\x6f\x8c\x6e\xc3\xb2\x51\x6f\x3f\x1e\x30\x90\x8b\xe3\x5e\xd0\x69\xf2\x0e\x4a\xd1\xad\x00\xd9\x36\x9f\x11\xb9\x2a\x50\x2e\xdc\x29\x14\xc7\xbe\xf9\x74\x79\x7e\xf1\xee\xf6\xf2\xed\xe5\xc5\x4d\xb3\x00\x40\x92\xdb\xdb\xc0\x81\x53\xeb\xff\x6c\xe7\x40\x74\x15\x25\x13\xa0\x0e\x7d\x35\xf8\xf9\xec\xe6\xd3\xbb\xb3\xab\x8b\x63\xa4\x1b\x88\x3d\xa6\x54\xc0\x65\xb3\x85\x72\x07\x59\x9a\xb1\x25\x97\x45\x79\x7c\x54\x56\x0e\x81\xe8\xd6\x56\xb9\x93\xcd\x2a\xb9\x50\x16\xa8\x9d\xaf\x2b\xa2\x58\xb6\xe4\x33\xd6\x16\x18\xd2\xf8\x09\xf5\xbf\x93\x19\x15\x36\x60\x0d\x05\x84\xf1\x92\x45\x58\x77\x84\x5f\x3a\x37\x56\x2e\xd2\x22\xb7\x44\x33\xfd\xba\xa7\x8c\x14\x62\xb6\x80\x3e\xf2\x28\xa9\x0f\xda\x78\x95\x0c\x6a\x25\x72\xfa\x08\x63\x04\x5d\x86\xa9\x19\x4d\x5d\x0e\x0a\x25\x91\x2c\x9a\x77\x15\xfc\x7c\xf5\xd5\x09\xe1\x6c\x4c\xbe\xaa\x40\x8f\xc8\x85\x45\xab\x70\x80\x1e\xb3\x60\x4b\x54\x8a\xc7\x94\x79\xfa\x43\x8d\xf9\x1d\xcd\xa2\x98\x29\x1d\x02\x7e\x58\x30\x88\x29\x5b\x93\x05\xb5\xfb\xe1\x1f\xf3\xde\x21\x21\xf3\x11\xc4\x51\x69\x11\xe7\xba\x2d\xd0\xd1\xd1\xa8\xbf\xf7\x4d\xf3\x36\x93\x49\xe0\xc6\x99\xe8\xc8\x15\x24\xd1\x3c\x29\x16\xfa\x18\xed\x45\xbf\x7f\x44\xde\x78\xe6\x2b\x14\xc4\x35\xad\x5f\xd6\x25\x70\x19\xe7\x5b\x6f\xbf\x71\x80\x99\x14\x73\x7e\x77\x45\xd3\x1f\xd9\xea\x86\xcd\x31\x8f\xac\x2f\x82\x0e\xae\x40\x0c\x0b\xec\x5d\xd0\xbd\x40\x5e\x1b\x50\x0c\x97\x87\x8e\x38\x38\x5e\x51\x1b\xed\xad\x8d\xb3\x40\x23\x0d\x3d\x70\xdc\x10\x03\x19\x2a\xec\x9c\xd9\x3a\xd0\x7e\xf5\xc0\xb1\xbb\x32\x1f\x91\x2b\xa9\x13\x20\xe6\x12\x0f\x4b\xc8\x22\xcf\x53\x35\x3e\x3d\xbd\x2f\xa6\x2c\x13\x2c\x67\x6a\xc4\xe5\x69\x24\x67\xea\x74\x26\xc5\x8c\xa5\xb9\x3a\x85\x62\xeb\x25\x67\x0f\xa7\x0f\x32\xbb\xe7\xe2\x6e\x08\x1a\xf9\xd0\xe8\x56\xea\x14\x26\xa2\x4e\xff\xa4\xff\x2f\xe0\xc5\xb7\xef\xcf\xdf\x8f\xc9\x59\x14\xd9\x5e\x2c\x85\x62\xf3\x22\xb6\xe5\xff\x23\x42\x53\xfe\x33\xcb\x20\xa0\x1a\xe2\x60\xb9\xe7\x22\x3a\x21\x05\x8f\xbe\xeb\xf7\x90\x8f\xb4\xa1\x9d\xd4\x84\xa0\x71\x4b\xfa\x4d\x74\xa6\xec\xaa\x26\xfd\xfc\xbe\x40\x43\x12\xf0\x8e\xc3\x55\x02\xf7\xac\x74\x69\xdb\xc3\x14\x0d\x62\x26\x3f\x95\x32\x66\x54\x1c\xc4\x70\xc0\xba\x67\x91\x7a\xbd\xfb\xd1\x7c\xd2\x4e\x2a\xf5\x4b\xb1\xa4\x51\xdc\x4e\x4a\x65\x34\x26\xaa\x48\x53\x99\xa1\x03\x70\x09\xcb\x69\x44\x73\x3a\x02\xf6\x3f\xa9\xff\xaa\xad\xeb\xca\xdf\xb4\x35\x8b\xf6\x17\xfa\xc7\xb4\xe4\x37\xf1\xbc\x13\x9d\x64\x3d\x02\xc3\x1a\x44\x80\xfd\xd5\xea\x39\x67\xb3\x99\x2c\x44\xae\x3f\x40\xbe\x43\xe5\x34\x2f\xd4\x68\x21\x55\x7e\x79\x7d\xe2\x7e\x4d\x65\xb4\xfe\x9b\x1a\xf5\x0f\x24\xae\xcb\x7d\xde\x72\x33\xd9\xa7\x1d\x11\x21\x99\x30\xa1\x7a\x4b\xbd\x05\xe2\x5e\x53\x64\xd7\x1e\x6b\xd3\x28\xf2\x90\xf1\x3c\x67\x42\xeb\x6a\x3a\x27\x4c\xce\x4f\x48\x54\x55\x31\x96\xaf\x8f\x0e\x7a\x34\xcc\xdd\xc0\x5b\x2e\x09\xcc\xd9\xad\x87\xc6\x2a\x8f\x34\xc2\x45\xa0\xcb\xb2\xf4\x18\x9d\x5d\x5f\x92\xa5\x59\xed\x17\xe4\x98\xf0\x8b\x75\x08\x29\xe3\x32\x90\xde\xee\x45\xda\x38\x34\x47\x1b\x6f\xb2\x8c\x7b\x01\x1e\x79\x87\xa2\x48\xcc\x13\x6e\xe3\xe7\xb6\x87\x93\x22\x03\xf3\xc7\xd1\x2c\x2d\xb0\x92\xc6\x3e\x91\xb0\x44\x66\xab\x13\xf7\x2b\x4b\x17\x2c\x61\x19\x8d\x87\x2a\x97\x19\xbd\x63\x27\xfe\x25\x21\xe0\xfe\x19\x03\x5f\x1b\xec\xe6\x3b\xb0\x4e\x52\x48\x40\x9a\x15\x19\xe8\x3d\xf1\xca\xc9\x6c\x16\x1d\x4c\x4a\x79\x4a\xbd\x7b\x86\xd6\xe6\x5d\x15\x3a\xb4\x30\xf6\x9c\x0e\x46\x02\x1a\x92\x90\xa5\x8c\x8b\x04\xbc\xb6\x4e\x0b\x81\xe7\xc1\xc0\x00\xc3\x42\xf5\x0f\xb7\x33\x09\x89\xf8\x92\xab\x90\x84\x17\x2a\x56\xef\xe7\x41\xb5\x1e\xc1\xe5\x03\xd5\xe7\x82\x26\xb3\x46\x9e\x89\x95\x73\xb6\xe1\x5b\x91\x83\x89\x6e\xee\x68\x23\x12\x97\x88\x6e\xd7\x15\xcc\xbd\xc7\x54\x82\x9d\xe6\xf7\xea\xda\x09\xf2\x1a\x1f\x84\x49\x69\x9e\xb3\x4c\x8c\xc9\x7f\x0f\xfe\xfa\xe7\xdf\x86\xc7\xdf\x0d\x06\xbf\xbc\x1a\xfe\xcb\xaf\x7f\x1e\xfc\x75\xa4\xff\xe3\xff\x1d\x7f\x77\xfc\x9b\xfb\xe5\xcf\xc7\xc7\x83\xc1\x2f\x3f\x5e\xfd\x70\x7b\x7d\xf1\x2b\x3f\xfe\xed\x17\x51\x24\xf7\xe6\xb7\xdf\x06\xbf\xb0\x8b\x5f\x91\x20\xc7\xc7\xdf\x7d\x85\x1e\xe2\xe3\xb0\xb4\x1e\x86\x5c\xe4\x43\x99\x0d\x0d\x29\xc6\x24\xcf\xd0\x8e\x7f\xb7\x58\xe3\x76\x04\xec\xbb\xd6\x59\xe3\x52\xd0\xfa\x73\xaf\xff\x72\x0e\x2c\x37\xb8\x43\x9c\x57\x8a\xcd\x32\x96\xef\xd3\x5e\x37\x88\xd6\x71\x85\x42\x24\xeb\x01\xc7\x03\xc9\xe4\xe7\x1b\xfa\xf6\x18\xb6\x53\xf4\xbc\x82\x06\x25\xfa\xca\xb7\x11\xd1\xee\x5d\xb0\xbe\xc0\x5b\x08\x59\x72\x16\xf1\x9e\x21\x7c\x32\xad\xf9\xae\x73\x21\x74\x2e\x84\x9a\x0b\x61\x62\xb8\x2e\x2c\x2c\xfc\xc7\xf4\x1f\xa0\xbf\x8c\x1b\xed\x50\x6f\xc5\xde\x1e\xde\x88\x09\xcb\xf1\x84\xde\xed\xdc\xf7\x28\x76\x6c\x12\x1e\x28\x90\x74\x41\xd5\x4e\x94\x1a\xd3\x5e\xc3\xb7\x5d\x22\xb8\x7e\xd4\x49\xa0\x69\xc1\x63\x93\xb8\xa4\x72\x96\x42\x28\x7d\xb7\x1c\xe0\xe2\x84\xe8\x1a\xba\xaf\xff\x09\xf4\xa9\xac\xb0\xd9\x0d\x39\x4b\x09\x9d\xe7\x76\x43\xa4\x99\x04\x02\xc3\x1b\xe1\x05\xbb\x29\x3d\xf8\xfa\xd5\xb1\xc9\x9f\x9a\xb2\xb9\xb4\x6d\x55\x75\x63\x86\x99\x76\xbe\x00\x4a\x4a\x67\xf7\xf4\x0e\xea\xf0\xff\xff\xab\xe3\xde\xf3\x4b\x67\x71\xda\xae\x53\x18\x14\x7a\xa1\x6f\xdc\x13\xbe\x3f\xac\xeb\xbd\xeb\xb1\xec\xca\xef\x40\x24\x86\x16\xde\xf4\xe9\x3d\xff\xf0\x36\xa6\xe5\xb8\x77\x88\xec\x45\xa4\xa9\x11\x6a\x62\x04\x9a\x16\x2f\x58\x5b\x7f\xa6\x96\x5e\x63\xb0\xfe\x4f\x9a\x92\x96\xeb\xa6\xd6\x68\x4a\xe8\x23\x4f\x8a\x84\xd0\x04\x1c\x91\x18\xab\x69\x93\x2d\x5d\xe6\x4b\x45\x15\x41\x29\x18\x26\x58\x55\x40\x3f\x69\x29\x4e\x13\x2a\xe8\x1d\x1b\x5a\xf8\xa1\x87\x1f\x7a\x6e\x3e\xed\xf7\xf6\x78\x44\x80\x87\xa3\x63\xeb\x2f\x9f\xad\x6f\x2c\x2d\xd7\x19\x9b\x8b\x0a\x63\x37\x0e\x49\xce\xb7\x30\xb6\xd3\x24\x74\x5a\x81\x7f\x0f\x57\x44\x26\x3c\xc7\x64\x9c\x81\x97\x87\x96\x3e\xc3\x13\xc2\xf3\x9a\x53\xc1\x6e\x49\x3e\x77\xbd\xec\x1b\x11\xd9\x23\x9c\x6e\x3c\x8f\x57\xa5\x97\xf7\xc4\x84\xe3\x1e\xb8\x62\x36\xf7\x99\x27\xa9\xc9\x7e\xd6\x5b\x6b\x88\xd5\x10\x6d\xe0\xfa\x0b\xd9\xc6\xa8\xaf\x29\x36\x2b\x32\x9e\xaf\xc0\x91\xc7\x1e\x77\xb6\x0a\xa9\xb1\xd5\xa4\xfe\x1c\x59\xc8\x38\x52\x1e\x8d\xd4\xe6\xdc\x6b\x4c\x61\x74\xf9\x22\x5a\x37\x61\x91\xa6\x52\xc9\x16\x23\x32\x81\x06\x05\x95\x2e\xea\x36\x5d\x6a\x27\x30\x17\x64\x2a\xf3\xc5\xc6\x48\x41\x1d\xba\x96\xd1\xda\x9f\x47\x44\xb7\x03\xd8\x89\xa8\xe1\x40\xfb\x50\xac\xde\x75\x92\x8b\x8d\xb7\xe4\xf4\x9e\x41\x9b\xf6\x19\x8b\x20\xc1\x68\xb4\x07\x4d\x43\x1f\x23\xd7\x19\x5f\xf2\x98\xdd\x31\xc8\x60\x89\x29\xa6\xa5\x46\x5d\x1c\x9c\x3d\x81\xa2\x97\x3b\x93\x31\xe4\x83\xeb\x04\x96\x06\x54\xa8\x0b\x4d\x33\x39\x83\xac\x97\x19\x15\x04\x72\xbc\x49\x02\x3b\x23\x75\xe0\x70\x82\x52\xa1\xed\xb1\x94\x66\x98\x54\x25\x0b\x68\x5b\x6f\x42\x08\x97\x44\x3c\x63\x33\xd8\xce\x7e\x7c\xfe\x3a\x83\x4f\x82\x3d\x7c\x82\xb7\x35\x1b\xd8\xf3\x98\xde\x79\x2e\xd3\xa5\xd8\xeb\x19\x56\xfe\xd5\x4f\x2d\x50\xe3\x3b\xc0\x08\xc8\x0a\x46\x68\xfc\x40\x57\x7a\x19\xd7\xdf\xc1\xd5\x98\xbc\x3e\x06\x53\x00\x3a\x3f\xf9\x77\x34\x0b\x9e\xaf\x8f\xf5\xc5\x0d\x6f\xce\xae\x3f\x4d\xfe\x6b\xf2\xe9\xec\xfc\xea\xf2\x5d\xbf\xb7\x2f\x33\x76\x46\x53\x3a\xe5\x31\xc7\x9c\xde\x35\x66\x02\xa7\x53\xf5\x61\xbd\x6f\xa3\xe8\x34\xca\x64\x6a\xe6\x6f\x13\x88\x7b\xe8\x60\x84\xaa\xa7\x3e\xc1\xfa\xd9\x03\xc1\x55\xd0\x57\x5f\xd8\x88\x7b\x97\x51\x91\xbb\x36\x29\x55\x52\x64\x85\x80\x0e\x2f\x4d\xbe\x2c\xec\xde\xb4\xca\x50\xf3\x97\xd6\x56\xf0\x4c\x5f\x07\x11\x34\x25\x54\x92\xea\xd6\x97\xbd\x71\xaf\x59\x95\x09\xab\xe4\xfa\xfd\xe4\xf2\x3f\xc3\x16\xb5\xe4\x2f\xd4\x57\x83\xb4\x32\x9c\xbb\xc0\xfd\x0f\x18\x2d\x78\xcd\x6f\x58\x22\x97\xdd\xaa\xb7\x5d\x75\xa4\xf6\x41\xca\x73\xa0\xd1\x2f\x56\x27\x4f\x21\x2a\xfb\x94\x8b\x0a\x0e\x49\x74\xdf\x9e\x6b\x23\xaa\x11\x6b\x56\x7f\xda\xa3\x42\x7d\x30\x64\x94\x02\x29\xb8\xe9\x25\x84\x6f\xcc\x0a\xbe\x19\x29\xfd\x09\x02\x89\x26\x75\x99\x35\xa7\xb1\x62\xa3\xbd\x49\x67\x38\x98\xae\xc0\xf8\x0c\x5a\x45\xff\x14\x89\x98\x90\xb9\x55\xfa\x81\x76\x20\x43\xe1\x53\x82\x53\xfc\x73\x49\x0a\x55\x26\x7d\x96\x6b\x08\x87\x74\x29\x9c\xb9\x72\x6b\x70\xed\xde\xdc\x08\x6d\x0a\xcf\x0a\xc5\x54\x1d\xda\x09\x67\x07\xae\xf4\xdb\x33\x46\x23\x48\x16\x68\x84\x4d\x69\xbe\x30\x19\x04\x09\x55\xf7\x70\xdb\x0e\xfc\xc1\xea\x14\xd6\x64\x31\x6f\xf4\x43\xbd\xc5\xec\xaa\x39\xa3\x79\x91\x31\xa3\x4b\x98\xfe\x59\x4c\x40\x76\x6f\x63\x76\x33\x7a\x2f\xc2\x1c\xdf\x8b\x78\x75\x23\x65\xfe\x96\xc7\xcc\xb4\xc2\x0a\x22\xfc\x47\xef\x3d\xe7\xaa\xb2\xa4\xa0\x3e\x40\xb6\x06\x8d\x86\xa8\x45\xd4\x2c\x3e\xf7\x43\xf0\x1c\x0e\x2e\xc3\x3d\x33\x78\x56\x88\x33\xf5\x43\x26\x8b\x34\x68\xa2\xc0\x7d\x3f\x5c\x9e\x57\x7d\xa5\x4c\xe4\xd9\xca\xf4\x31\x93\xf3\x3a\x4f\xe1\x55\xcf\x0f\xc0\x8f\x6b\x1c\x08\xc9\xc9\x85\x50\x0c\x32\x52\xe9\x8a\xd0\x58\x01\xf9\x1b\x31\x41\x63\xe1\x62\xbb\xb5\x71\x39\x77\x1f\x6f\xb3\x52\x1a\xa1\xb7\x5b\x31\x15\xab\xa4\x92\xe4\xc4\x45\x30\x3c\x98\x2f\x0a\x69\xbf\xac\x79\x87\xff\xf2\x4d\xc3\x77\xf1\x5e\x1d\xcd\x19\xef\xa4\x80\xed\x10\xc4\x1b\x97\x22\xe2\x50\xb0\x68\x3b\x51\xd5\x38\xc1\xc4\x85\x80\x65\x68\xf3\x01\x02\x15\x7f\x62\xa8\x37\x43\xa1\xc0\x12\x85\x76\x84\x59\xc1\xcc\x42\xff\x58\x4c\x59\xcc\xac\xf9\xaa\xc3\xa4\x34\x6f\xe6\x0a\x78\x52\xc7\x3b\x08\xcd\x3d\xa3\xe5\x92\x30\xa1\x40\xbc\xe8\x11\x83\x1f\x44\x32\x93\x08\x9f\x15\xbb\xf7\x0f\xfc\x50\x45\x3e\x5c\x9e\x93\x57\x64\x00\x63\x3d\xd6\xd2\x6f\x4e\x79\x0c\xbb\x43\xe5\x34\x5b\x5f\x03\xde\xec\xc7\xb4\x43\xd0\x53\xd6\xbc\x0f\xb9\xb9\x7a\xeb\x9f\x10\x21\x89\x2a\x66\x0b\x37\x67\x30\x1f\x61\x0d\x1a\x31\xa7\x0c\xae\x3e\x03\x66\x61\x51\x6d\x2b\x35\x6c\x95\x46\xe0\x1d\x5b\xe9\x09\x83\xbf\x39\x7e\x8a\xdb\x4a\xc1\x5b\x25\x50\x30\x7e\x50\x2c\x0b\xe2\x7d\x90\x8b\x1f\x0e\x20\x17\xed\x01\xa0\xac\x46\x90\xd5\x57\xc5\x30\xb4\x4b\xf0\x6d\x44\x35\xf2\xd4\x01\xfc\x51\x59\xe1\x30\x52\x53\xb1\x9f\xb8\x28\x1e\xdf\x6b\x7e\x50\xc1\xbc\x33\xb9\xd0\x8f\x6b\x16\xd1\x93\x92\x6b\x9e\xb9\xa6\x10\x5b\xcd\x9e\x77\xe2\xc3\x2d\xdb\xc9\x9a\x20\x72\xf2\x0f\x25\x3e\xc0\x03\x06\x62\x9d\x50\x92\x51\x11\xc9\x64\x63\xb0\xfe\x16\xd2\xca\x00\x80\xb9\x10\xd0\x18\xe6\xab\x32\x57\x23\x24\x92\xf9\x4a\xe6\x6a\x44\xdc\x2b\xf3\x85\xb8\x36\x62\xb6\x64\xa8\xb4\x8e\x1a\x37\xfd\x04\x4f\x81\xc2\xe8\xa8\xa4\x61\x6c\x05\xbc\x3e\xe6\x42\x2e\x09\xc8\x65\x9d\x75\x9a\xe6\x17\xa4\x78\xc3\xbf\x4c\xc6\x3b\xe3\xfe\x5b\xe7\x78\x23\x63\x06\x53\xa4\x7e\x92\x00\xf3\x62\xe7\xa8\xbf\x1c\x3a\xc7\xdb\x55\xba\x36\x47\x80\x79\xb1\x73\x2c\x10\x27\xe6\xc6\x1c\xe1\x98\xad\xcf\x11\x60\x5e\xe8\x1c\xd1\x2e\x98\x07\x2e\x22\xf9\xa0\xda\x1e\x05\x1f\xcd\xe3\xee\xd0\x9b\x81\xf8\xcb\xb9\xb8\x53\x76\x31\x22\x4c\x6f\x63\xb8\xdb\xd9\x2f\x84\xda\x7e\x1e\x98\xcc\x31\xa5\x73\x0f\x51\xe7\xcb\xa6\x1c\xf5\xde\x7d\x28\x26\x1d\xfd\x83\x99\x57\x21\xb2\xfa\x2e\x51\xf4\x4d\x06\xb8\x39\xa7\x31\xa4\x80\x07\x6f\x86\x1f\xae\x26\x67\x75\x08\xd8\x1a\xa6\xa3\x0a\x9c\x56\xf0\x39\x02\x13\x5c\xe2\x09\xd7\x2d\xa5\xc8\x03\x9b\x2e\xa4\xbc\x27\x03\x97\xf8\x70\xc7\xf3\x45\x31\x1d\xcd\x64\x52\x09\x9e\x0e\x15\xbf\x53\xa7\x96\x6b\x87\x30\x13\x5c\xf5\x04\x17\xb1\xee\x3a\xed\x36\x5d\x79\x8f\xb6\x19\x2c\x99\xf9\xd9\x68\xc2\xa1\x40\x21\x9b\xcc\x47\x0e\x36\x97\x44\x67\x9b\xea\x98\xe4\x9e\x77\xf7\x36\x12\xbe\x43\xa6\xc5\x36\x90\x11\x60\x5c\xb2\x5a\x63\x7a\x9f\xfb\xd9\xb1\x8e\xd6\x16\xd8\xfb\x02\x78\xcb\xa7\xd5\xbc\x41\x74\xb9\x87\x61\x77\x3a\x31\x56\x5a\x44\x08\x44\xb2\xcb\x6a\xda\xb4\x8a\x42\x8e\x85\x26\xeb\xc9\x7a\x9b\xdc\x17\x30\xab\x4b\xb0\x16\x94\x91\x8b\x28\xc4\x27\x64\xe7\x53\xb2\x11\x85\x89\x93\x9f\xc1\xf2\xf1\x30\xe7\x27\xea\x6b\xa6\x74\x49\x7b\x91\x77\x0a\x67\x44\xf8\xa8\xc6\xc4\x3f\x97\xb8\x96\xbb\x21\x25\x8d\x1a\xaf\x3d\xf4\xd8\xd0\xad\x07\xcc\xb7\x76\xa2\x9a\xd6\x5b\xba\xb1\x8c\xe7\xdf\x51\x6f\x3f\xa7\x8d\x1e\x0c\xae\xaa\xb3\x36\x39\x78\xc4\x0d\xab\xbe\xb1\x68\x6e\x1b\x58\xe1\x76\xa9\x59\xfd\x4a\xcb\x33\x3d\x22\x50\x00\x4c\x01\x03\xf8\xcf\x2c\x38\xe9\x8f\xfb\xa3\xde\x1e\xb9\x48\xbf\x0a\xf2\xde\xe8\x1d\x2a\x0f\x63\x6d\x0d\xd6\x1f\x27\x11\x83\xfb\x1a\xf4\x39\xb6\x90\x0f\x86\xd0\x18\xb9\x02\xb1\xac\xd4\xe2\x30\x7b\xc9\x85\x8b\x4c\x81\xc8\xa9\x2c\xae\xb9\x8a\x0f\x81\xa9\x13\xa6\xc8\x03\xc8\x94\x4c\x16\x22\xb2\x97\x65\xc0\x72\xea\xe4\x97\xab\xb5\xc1\xbf\x93\x02\x03\xcb\x15\xb8\x8e\x22\x1b\x8b\xf1\xcd\x22\xa7\x0c\x84\x9e\x20\xaf\x47\xaf\x5f\xed\x95\x44\x4d\x09\xe1\x5b\xc8\xa2\x47\xa6\x5d\xc4\xba\x47\x1d\xac\x17\x71\x85\x25\x6e\xbf\xed\x75\x8c\x2e\xe6\x13\x38\x4e\x4d\x01\x16\x95\x21\x1d\x38\x35\x20\xe7\x15\x6a\x5a\x69\x34\x84\x92\x6f\x34\xa5\x75\x6a\xdc\x40\x3b\x77\xc1\xcb\x5b\x39\x7c\x8e\x5b\xc4\x36\x43\x7c\x9c\x84\xa8\x62\xba\x07\x09\x62\x05\x81\x66\xfe\x10\x01\xe2\x37\x47\x5f\xed\x10\x26\x95\x25\x40\x60\x1e\x1d\x91\x81\xc1\x82\xc6\xe5\xe0\x8f\xdf\x2b\xc3\xd8\x05\x83\xeb\x19\x02\x17\xed\xc2\xb6\x2c\x22\xe9\xd3\xab\xd7\x88\xe8\xe2\xb6\x35\xb9\xbd\x6b\xf5\x10\x88\xdf\xb3\x05\x5d\xc2\x7d\x94\x3c\xe1\x31\xcd\xe0\xa6\x18\x49\x26\x66\x9e\x64\x5a\xe4\x5b\x7b\x0d\x21\x70\x9f\x6e\xf0\xb5\xad\x7d\x57\x65\x36\x08\xec\xca\x90\xea\x7b\x64\x0b\xf9\xdd\x5c\x80\x66\x08\x68\xd0\xb0\xdc\xec\x41\xba\x27\x85\xbb\xf2\xe8\x71\x16\x17\x8a\x2f\xf7\x29\x81\x5c\x12\xef\x6e\x5e\x1a\x96\x67\x7d\xc3\xf7\x1a\xed\x8a\x20\xed\x6b\x77\x46\x8a\xad\x1b\xdf\xbf\xde\x55\xa6\xea\x80\xce\x05\x53\x8a\x1c\x83\x6b\x4d\xaa\xf9\x7e\x2c\xed\xdf\x4c\xe8\x0a\x76\x02\x9d\x81\xb1\x60\xd2\xd0\xe0\x0e\x45\xcf\x68\xae\x79\x5a\x2a\xa3\x7d\xa9\x64\xf4\x41\x5d\xc4\x54\xe5\x7c\xf6\x7d\x2c\x67\xf7\x93\x5c\x66\xa1\x47\x60\xff\xec\xe3\x64\x03\xa3\xb6\x22\x82\x9c\x7d\x9c\x34\x82\x12\x72\xce\xd5\xbd\xcf\x17\x77\x39\xdc\x84\xe6\x39\x85\x8b\xd6\x60\xb3\x50\x02\xd6\x7f\xcc\xf2\x7e\x5f\x69\x8d\x05\x81\x9a\x50\xb8\x18\x8a\x39\x75\x46\xf8\xd2\x74\x6b\x5f\xa5\xb2\x56\x6b\x81\x40\xc4\xa4\x71\xdb\x76\x0e\xa7\x96\xe7\xfe\x44\x1f\x14\x33\x8b\x34\x85\x45\x82\x8f\x59\x53\x4e\x66\x08\x21\xe1\x67\xae\x6e\x91\x0e\xdb\x75\x12\x96\xd9\x23\x3e\xe9\xa7\x22\xe8\x81\x12\x28\x50\x42\x56\xb2\x20\x0f\xd4\x74\x0d\xd7\x22\x60\x44\x6e\x79\x3a\x26\x17\x95\x68\x35\x20\x97\xb9\x22\x48\xe0\xdc\x7a\x95\x7d\xa7\x0b\xe7\x6a\x01\x2e\xb0\x4d\xc3\x41\x32\xdb\x04\x14\x24\xea\xc5\x23\x85\xdc\x7e\x35\x26\x47\xec\x31\xff\xe6\xe8\x84\x1c\x3d\xce\x15\xfc\x9f\xc8\xe7\xea\x68\x44\x2e\x13\x57\x20\x80\x44\xe4\x62\xce\x32\x68\x69\x61\x52\x7e\x0c\xec\xba\x89\x1e\xc6\x6e\xfb\x64\x39\xe4\xeb\x4c\x5d\x31\xd8\x15\x91\x24\x0f\x60\x30\xe8\x9b\xf4\x08\xcb\x32\xb8\xf9\x84\x8b\x76\x44\x04\x8d\x41\x17\x86\x64\x32\xe1\xfe\x20\xb5\x1b\xb4\xdf\xc3\xf2\x01\x52\xf3\x81\x7f\x29\xcd\x72\x8e\x33\xb7\x36\x77\x05\x78\x86\x3c\x80\x9b\xf4\x5e\xf6\xc4\xe5\xdc\x15\xbd\x9c\xd4\x92\x96\xd1\xd7\x70\x38\x28\xd8\x05\x76\x44\x70\xec\x8c\x2a\x1c\xfd\x56\x66\xf6\x23\x24\xe6\x69\xc4\x96\xa7\x2a\xa2\xaf\x4f\xf4\x36\x56\xb6\xc0\x3a\xaf\xad\x02\x55\xe4\x08\xdf\xfc\x69\xe2\x34\x34\xeb\x0b\xd7\xc3\xa9\xa0\x41\x00\xd6\xbd\x16\x09\x09\xb7\x05\xbc\x3a\x22\x03\x99\xe9\x51\x42\x31\x41\xcc\xe8\x92\xb9\x7a\x57\x38\xf8\x56\xa6\x11\xfc\x31\xb2\x1b\x0e\xb6\x58\xb5\x4d\x78\x3d\xd4\x6a\xdb\xe4\x42\x57\xe8\x7e\x04\xe6\xda\x11\x30\xd1\x5c\xc2\xf9\x08\xe7\x19\xfe\x56\x23\x58\x9d\x1b\x3b\x8a\x72\x99\xb8\xb0\x1a\x8c\xb6\x0f\xb5\xcf\xd3\xbc\x06\x4b\xe0\xa7\x19\x99\x1c\x69\xeb\xef\xe8\x77\x14\x76\x21\x12\x05\x67\x7d\x96\x5a\xe4\xe5\x79\x1b\x5a\x7e\x10\xfc\x6f\x05\x23\x97\xe7\xee\x84\x4d\xa1\x9b\x98\xca\x41\xb6\x46\x5c\xdd\x07\xf6\x89\x01\x99\x74\xf6\x71\x42\x06\x67\x09\xfd\xbb\x14\xe4\xe2\xfb\x89\x1d\xde\xf1\x17\xb2\xec\x48\x41\x8e\xb3\x3a\xe0\x67\xe8\xe9\xd3\xf8\x55\xa4\x65\x01\xff\xe8\xdf\x8b\x8c\x81\x7a\x3a\xee\x05\x11\xfc\xcc\x3d\xb7\xae\x0f\xc3\xdf\xc9\x39\xcd\xa9\x56\x7a\x1b\x41\xad\xfb\xb0\x9a\x45\xae\xf5\xd9\x29\x17\x91\xfd\xa8\xa2\xcd\x36\xc2\x85\xa9\x95\x33\x7d\x3e\xdf\x5d\xc9\xa8\x95\x6e\xf9\x1f\xa0\xa0\xbd\x31\x18\x3a\x25\x7f\x4c\xc0\xff\x77\xa2\xc5\x11\x01\xa9\x88\x0b\x06\x10\xf3\xc0\x47\xf0\x54\x8d\x0e\xc0\x63\xd0\xdc\x4b\xdd\x63\x43\x48\x6b\xb3\xbc\xad\xf8\xfd\x80\x42\x3a\x30\x03\x78\x61\x7d\x83\xa6\xb1\x9c\x12\xbb\xc7\x0e\x35\xc3\x0f\x37\x97\x2d\x27\xf8\xe1\xe6\x72\xeb\xe4\xf4\xb0\x51\x90\xe4\x90\x93\x6b\x6d\xfe\xac\x5b\x3f\xa5\x9e\x66\xdb\x1a\xa1\x20\x21\xfd\xb7\xd4\x88\x0d\x52\x83\xb5\x82\xc4\x75\x49\xf5\x17\x8f\xa3\xcf\x6b\xa7\x1c\x82\x4a\xd0\xa0\xb8\x05\x8d\xfa\x17\x8f\xa9\xbe\x97\xc4\x55\xd1\x4e\x16\x14\x7a\xe8\x84\xdd\xc3\x6d\x77\x18\xf0\xae\x82\xdc\x62\xc7\x8e\x84\x9a\xd6\xb1\x84\x9c\x33\x93\x0b\x0e\x8d\x70\xf5\xf5\x5a\xa1\xb8\xdb\x61\xaf\x74\x1d\x77\x34\x36\x27\x09\x12\xd3\xf4\x70\x88\x2a\xfb\x6d\x60\x5c\xf7\xc2\x7f\x44\x97\x94\xc7\xb6\x60\x0c\x89\xaa\x18\xb8\x18\xad\xa2\xa6\x55\x3e\xa5\x97\xf2\x20\x22\xf5\x19\x8a\x6f\xd5\x49\xaa\x55\x49\x32\x00\xb4\x53\x1d\xaa\x38\x1e\x79\x6d\x16\x85\x4c\x88\x4e\x47\xd1\x59\x3f\x46\x7d\xae\x29\xc4\x36\x53\x69\x5d\x1f\x1e\x1d\x40\x81\x0c\x51\x65\xdc\x81\x84\xfc\xea\x87\x9b\xcb\xbd\x2b\x3d\x20\x1b\xc7\xbd\x20\xc2\x9d\xb9\xe7\xb6\x2a\x3d\x00\x48\x26\xa6\x61\xf3\xcb\xd6\x7b\x3a\xde\x5d\xe7\x5d\xd7\x5e\xb1\xa5\x92\xe4\xf2\x88\x40\x49\x72\x2d\x07\xab\x77\x4e\xa3\x10\x89\xe5\xa3\x89\x15\xb1\xb6\xe9\xb7\x89\xba\x82\x4e\x1c\x76\x3f\x13\x5a\x92\x69\x21\xd9\x72\xe2\xfa\xac\x22\xa8\x9d\x7c\x50\xd3\xa8\xa4\x1e\xe6\xcb\x6e\xc2\xfb\x94\x29\x33\x96\x2e\xe6\x88\xdd\x57\x5b\xbe\x37\x2c\x5d\xbc\x9d\xd4\xa4\x89\xfe\x1b\x79\x3b\xf1\xf2\xa1\x11\x91\x94\x12\x44\x7b\xa1\x35\x3d\x01\xc9\xf4\xea\x8c\xf9\x9c\x41\x69\xd5\x9e\x65\x48\x22\x05\xcf\x65\xa6\x5a\x30\x4d\xa5\x9b\xeb\x95\x45\x01\x07\x38\x34\xc0\x89\xa1\x9f\x36\xa6\xc9\x84\x0d\xda\xcf\xcd\x72\xb9\xc1\x54\x5c\x03\xde\xe8\x67\xd6\x6f\x38\xba\xff\x67\x6d\xf6\x5b\x03\xff\xd4\x10\xec\xf4\xe6\xe2\xec\xfc\xea\x62\x94\x44\x7f\x5a\xc8\x87\x61\x2e\x87\x85\x62\x43\x9e\xe3\x74\x06\x44\x50\xad\x25\xf3\x63\x23\x7f\xe5\xff\x52\x54\xf2\xc0\x26\x31\xde\xbb\x6e\x95\xe4\x03\xb4\x35\xa6\xb6\x2d\x92\x4b\xab\x90\x12\x99\xd2\x46\x48\x46\x6d\xc5\x2d\xb5\x5e\x74\xb8\xbb\x52\xd3\x27\xcf\x18\xf3\x6d\x92\x81\xd6\xa7\xfd\x80\x15\xf8\x1c\x3a\x59\x65\x19\x1a\x8e\x38\x14\x36\x29\x4f\x31\xcc\x11\x87\xc4\x5c\x3f\x08\x3f\x37\xbb\xb7\x3b\x53\x71\x8a\xd6\x2e\x8a\x4c\x3c\x8e\xef\x30\x89\xbd\x14\x40\xd7\x20\x40\x43\x60\x60\x21\x5d\x3f\x0f\x19\xb1\x75\x5e\x64\xf9\x4c\x4b\x83\x53\x48\x48\x1d\x99\x41\x23\xc1\x7f\x17\x02\xa0\xf7\x83\x99\x4a\xcb\xbb\x0d\xd6\x97\xff\x86\xcd\x61\xf5\x7d\x2a\x09\x0a\xd2\xe7\xfa\xd2\x22\x5f\x40\xc2\xb4\x6d\xb8\x69\x95\xa4\x0d\x7a\x20\x41\xb9\x72\x17\xbc\x7f\x66\x02\x84\x9d\x91\xf8\xd4\xbb\xed\x24\xa8\xfa\xdd\xba\x46\xce\x9f\xbb\x91\x73\x80\xf2\x17\x52\x6e\xf5\xf4\x3e\x73\xb5\x57\x9a\xdc\x34\x92\x3a\x49\x34\x0b\xe0\xb3\x9a\x58\x83\x9a\x13\xf1\xa2\x05\x54\x88\x82\xef\x34\xbc\xde\x1e\xa9\x36\xe3\x22\xc2\xd0\xac\x4e\xaf\x37\xfa\xa9\xba\xce\x6e\x90\x6c\x80\xc6\xe7\xf5\x34\x02\x43\x0b\x50\x6b\xed\xb3\x08\x5c\x01\x36\x07\xc8\x64\x00\xb9\xf4\x81\xc0\x1d\xff\x14\x99\x93\x95\xfa\x5b\x3c\x34\x23\x1d\xa6\x51\x49\xe7\x7e\x6f\xbf\x52\x6f\x7f\xc9\x3a\x2f\xd7\x5d\xfd\x85\x25\xd7\xec\x91\x17\x3a\xb5\x7c\x2f\x6a\xf9\x5e\x28\x10\xaa\x89\x3f\x5b\x13\xd4\x55\x60\x9a\x02\xfe\xf6\x10\xf4\xf9\x58\xc9\x32\x07\x55\x3c\xa5\x19\x4d\xa0\xd2\x43\x9f\x72\x91\x2d\xd0\x10\xd0\xd3\x3d\x97\xe4\x7d\xca\xc4\x24\xa7\xb3\xfb\x51\xa7\x97\x75\x7a\xd9\xb3\xf4\xb2\xe7\x64\xb5\xd8\x03\x9d\x47\x9e\x47\xb9\xae\x22\xb5\xc9\x63\x41\x49\x68\x5c\x58\x35\xe1\xf3\xc9\x87\x03\xe8\x61\x87\xc8\x41\xf1\x97\x11\x8f\x7b\x41\xe4\xf1\xf7\xaa\xae\x29\x63\xfe\xcf\xc8\x54\x46\x5b\xaa\x91\xca\xb4\x88\xa1\xab\x4a\x0e\xa5\x4f\x48\xda\x86\x09\x1e\xab\x9e\xb7\x4d\x38\x29\x05\x71\x22\x23\x46\xa6\xd0\x51\xd8\xf6\x25\x94\x02\x6a\xbb\x29\xa6\xd9\xb8\xd5\xd2\x20\xa0\x0f\x49\x96\x76\x4c\x5e\xc7\xd2\xf7\xa2\x43\x65\xea\x94\xe5\x0f\x8c\x09\xf2\x0a\x89\x08\xba\xec\xab\x6f\xbf\xfd\xb6\x5e\x05\xf2\xea\x2f\xdf\x7c\x33\x22\xe7\xba\x7b\xb1\xcc\xe0\x0e\x36\xb0\x2a\xd1\x57\x55\x3a\x67\x8f\xae\x55\x81\xb2\x3b\x3a\x9f\x9b\x68\xb9\x56\xe4\xb8\x72\x67\xb5\xa9\xa5\x43\xa2\x26\xfc\x6e\xa1\xef\xc4\x81\x4d\x29\xc5\x3c\xe6\x33\xe8\x5e\x06\x57\x6b\x6a\x61\xe8\xfa\x30\x04\xe4\xc2\x9a\x71\xf9\xec\x61\x9d\x0f\x04\x17\x2e\xde\x33\x32\x37\x5d\xfd\x4e\x5c\xa2\x3e\x12\x31\x63\x0a\x4c\x39\x7b\xef\xbb\xb1\x9e\x4b\xba\x43\x13\xbe\x97\x92\x04\x1a\xe0\x06\xaf\x31\xf4\x7a\x0b\x0c\xdd\xb1\xe8\x9e\xad\x86\x3a\x13\x82\xa4\x94\x67\x78\xe1\x0a\x2b\xaf\xf3\xce\x6a\x17\xff\xda\xe3\x79\xc6\xa2\xf2\x7a\x72\x24\xa6\x6b\xa4\x61\xaf\x9a\xd1\x15\xea\xd6\x9b\xe5\x0c\x3d\x65\x4d\x0d\x2c\xe2\x42\x2a\x1b\x28\xb4\x66\x3e\x78\x26\x81\x2b\x6c\xb3\x06\x67\xfd\xeb\xf9\x63\x55\x5a\x28\x65\x2f\xd7\x10\x1e\x8f\xb9\x82\x1d\x72\xcf\x56\x6a\x73\x16\x48\x54\x3f\x57\x8f\xad\xab\xe5\x94\xe1\xe2\x42\x54\xde\x81\x44\xd4\xeb\x69\x6f\xba\xb7\xe2\x5a\xd7\xe1\x9b\x1b\xe4\xb9\x6f\xaf\xc2\x22\x53\x59\x87\x1d\xa8\xe9\x0e\x68\x11\x1d\x27\x78\x62\x57\xf3\xb3\x91\x88\x8a\xe5\x45\x6a\x16\x4e\x97\x05\x90\x42\xc4\xd0\xde\x9d\x6b\xbf\x4a\x42\x33\x68\xa6\xea\x2e\xf9\xc2\x52\x09\x2a\xc6\x6c\x41\xed\x14\xbc\x79\xd0\x4c\x7d\xc9\x6c\x7b\xd6\x55\xad\x40\x1b\x2f\x22\xfa\xa3\x51\x5f\x93\x05\xaa\x55\x4d\x7f\x43\x2d\xc5\xe0\xef\xa3\xfd\x47\xb0\x6a\x9b\xf7\x8a\xa6\xc0\xfe\x46\xc7\x70\x97\xeb\xd3\x6a\x49\x25\x12\x15\xec\xf5\x25\xb2\x9e\xb8\xad\xb6\x1f\x78\xa5\xe0\xda\x54\x6f\xed\x4e\xcd\xa5\xdb\x46\xd8\x91\x06\xeb\x62\xee\x27\x41\xab\x08\x61\x8a\x42\x00\x24\xdc\x49\x6c\x8e\x58\x10\x71\x27\x24\xd9\xae\x1f\x04\x21\xbe\xaa\x68\x09\x97\x73\xcd\xf5\x7e\xd7\xb7\xd8\xa9\x1b\x5a\xd5\x5a\xf7\x23\x50\x09\xfc\x61\x1f\x84\x89\x50\x0c\xac\x2a\x12\x04\x8b\x51\x0f\x20\x09\x1e\x1f\x0a\xb1\x2a\xf4\x73\x95\x84\xb6\xaa\x42\x3b\x85\x21\x34\x62\xbd\x85\xc7\x6f\x17\x15\x29\x9a\xd6\x6e\x45\x8f\x59\x18\x4d\x24\x49\xb4\xb1\xe0\x36\xb9\x69\xc3\x69\x4f\x2a\x2a\x08\x9d\x2a\x19\x17\xa8\x2e\x02\xe5\x0f\x0c\xa9\x04\xaa\x88\x76\x23\x21\x99\xb9\x77\x27\x08\x52\x4b\x75\x0f\x59\x11\xf7\x80\x6a\xe5\x30\x5e\xf2\xb7\x16\x4e\x78\x73\x31\xf4\x9e\x47\xf8\x6e\x48\x64\x37\xcc\x11\x10\x9a\x50\x81\xf7\x10\x1d\xc4\x3b\xf4\xbb\x78\x86\xf0\x5e\x21\x13\x83\x43\xc2\xe2\x3d\x42\x81\xfc\x18\x76\xd5\x6a\xe3\x35\xab\x5e\x6b\xc4\xdf\xb4\x6a\x6f\x59\x55\xad\xae\x59\x0d\x73\xe3\x06\xf0\xfb\x4c\xf1\x71\x2f\x68\x39\xde\x4c\x2e\xc9\xa0\xbc\xc6\xde\x25\x21\x5e\x8a\x9c\x65\x73\x3a\x63\xc7\x15\x27\x4b\x23\xb2\xaf\x86\xf0\xb5\xee\x0b\x2a\xa2\xd8\x55\xfe\x13\xf6\x08\xf7\x9e\xd3\x58\xbf\x35\xca\xf8\x12\x75\x6a\x0c\xce\xe2\x74\x41\xdd\xfd\x03\xc7\xa3\x7d\x3b\x67\xf4\x38\x5a\x70\xd2\xb9\x7e\xd0\xd9\x6e\x2e\x0f\x14\xfe\x3b\x68\x7a\xbe\x5b\x82\x59\x2b\x55\xf5\x43\x8d\xc0\x7a\xd5\xee\x00\x2d\xf0\x57\xb2\xc0\x22\x9a\x30\x70\x79\x6d\x45\x06\x8e\x18\x63\x83\x52\xc8\xe6\xb8\x03\x3b\x0e\xca\x25\x82\x5c\x32\xd0\x7e\x23\xc7\xf5\xf3\x0c\xde\xd3\x07\xa8\x76\xa9\xd4\x97\xa0\x60\xc9\x46\x40\x6f\x6e\x8d\x4c\xb9\xe4\x91\x53\x55\x75\x0a\x0a\xb2\x65\xb1\x15\x16\x70\xcf\xac\xb2\xae\x64\x80\xa0\x4a\xc9\x19\x07\xb7\x5d\x85\x57\x82\xcc\x5f\xad\xf0\xfa\x96\x59\xb5\x52\xd5\x6a\x10\x54\xea\x56\xa5\xab\x83\x10\x4c\xc8\x88\x5d\x17\xd3\x98\xab\x85\x4f\x15\x6a\x41\xbe\x77\x5b\x60\x60\x53\xd1\xb6\x39\x47\xb5\x08\x95\x53\xc1\x20\xf6\xa4\x98\x50\x5c\x2b\x8e\x10\xae\xc9\x12\xdc\xbd\x66\x1e\x1b\x48\xe8\xde\x51\xa1\x9a\x8e\x60\x81\x13\x5f\xbb\x90\xf5\x47\x48\xcc\xca\xcc\x6d\xdb\x15\xb0\x92\xe0\xaf\x1f\x44\x5a\xfb\xfb\x8c\xc6\x31\x32\x01\x9e\xac\x35\x17\x73\x47\xa5\x71\xe0\xb8\x96\x2c\x86\x87\x11\xd7\x20\x38\x52\xbb\xb8\x5f\x79\x87\x4c\xa4\xb7\xc7\xc6\x82\x23\x11\x2d\x59\x94\xb9\x33\x4f\x5f\x94\x27\x85\x83\x3a\xd1\x5d\x73\x2d\xac\xe7\x02\xac\x32\x03\x2e\x63\xa0\x15\xb6\xde\xab\x0b\x22\x76\x41\xc4\x27\x82\x88\xcf\x48\x61\x98\x58\xff\x46\xed\xde\x21\x32\x93\x62\xce\xff\x8f\xbd\x6f\xed\x8d\xdb\xc6\xfe\x7e\x3f\x9f\x82\x48\x17\x98\x99\x8d\xc7\x49\xf7\x29\x8a\xae\xb1\xd8\xc0\xf5\x25\x6b\x34\x49\xbd\xb1\xd3\xbe\x48\xb3\x0b\x5a\xa2\x6d\xae\x25\x51\x15\x25\x3b\x7e\x90\x0f\xff\xc7\x21\x0f\x75\x99\x8b\x7d\x48\x69\x6c\xa7\x1d\x4f\x81\x26\x13\xeb\x27\x5e\xcf\xfd\x42\x6a\x0c\xda\x7c\x1c\x13\x77\x22\xc1\x10\x11\x11\xbe\x81\x04\xf6\xdd\xbb\x65\x59\xc8\xb3\xaa\xa4\xde\x94\xb0\xfe\xc8\x81\x5b\xdb\x59\xfd\x5f\xe6\xc6\x6b\x04\x53\xa1\x91\x66\xcf\xd0\xf8\x14\x11\xc7\xd2\x50\x08\xb4\x00\xd5\x14\x66\x91\x1f\xd0\xd6\x9f\xd5\x82\x1d\xc8\x74\xf8\xe8\x58\xb3\x58\x45\x55\xdd\x98\xd7\xc4\x2d\xd7\x21\x4c\x44\x5c\x23\x97\xe8\xed\xe1\x6f\x03\x5d\xff\x9f\xe1\x7c\x46\x03\xbe\x3f\x56\x37\xd9\x0d\x2f\xe2\xdd\x63\x42\xb2\x77\xe7\x28\xec\x37\x4f\xb6\x1d\xc6\x0e\x90\xed\x1e\x1f\x31\x7e\xa6\xaa\x72\x44\x74\x4b\x2a\xf0\xe6\xf1\x72\xe3\x3f\xde\xf8\x8f\x37\xfe\xe3\x47\xf1\x1f\x83\xdf\xd9\xaa\x08\xe0\x8e\x04\x69\xaa\x73\x9b\xbd\x7c\x09\x64\xbb\x71\xb8\xcb\xac\x45\x81\x2c\x5f\x9a\xcb\x25\x26\x62\xb2\xb6\xda\x02\x77\xc4\xde\xdc\xe6\xec\x34\xaa\x0e\x19\xd1\x11\x34\x23\xba\x79\x73\x43\xea\x6a\x00\xd9\x10\x49\x4c\xd6\x0f\xef\x4e\x1e\x3c\x11\x90\x2d\x08\xbb\xef\x33\x6a\x34\x1c\x62\x95\x22\x15\xef\x30\x53\x7a\x80\x67\x99\x2a\xcd\x8a\xea\x2d\xdb\xee\x44\xfb\x08\xad\x0c\x4d\x2a\x59\x6c\xfe\xa0\x73\x0e\x85\xac\x8a\x56\xe4\x31\xf1\xb6\x85\x2f\x2d\x7c\x1a\x89\xdb\xef\xb9\xb9\x45\x46\x0c\xb7\x4a\x3a\xba\x14\x29\xf7\x20\x3d\xcd\xe7\x10\x76\x06\x7c\xdf\x70\x4d\x41\x30\x2d\x45\x66\x7c\xf4\xa2\x48\x35\x53\xe7\x7e\x6b\xdc\xe2\x81\x86\x73\x3d\xbb\xa6\x57\x6c\x0b\x96\x27\xdd\xe7\xdc\x4d\xa5\xd7\xd2\x1e\x77\x5c\x55\xa0\x9b\x43\x9d\x0a\x73\x92\x3d\x61\xeb\xa8\x97\xda\x83\x6a\x89\x9e\xdd\xbb\x07\x5a\x16\xba\x30\xd8\xfc\xcc\x9a\xa5\xf4\x78\xca\x4b\x46\xdd\xb8\xd1\x37\x6e\xf4\x8d\x1b\x9d\xe0\x46\x6f\xb1\x53\x47\xa5\x71\xf1\xac\x6b\xdd\x03\xd7\x8e\xa4\xe3\xff\x00\x99\x0a\x53\x5a\x50\xbb\xb0\x6a\x82\x17\xaa\x73\xc4\xa3\x17\x1e\x42\x9d\x50\xc0\x31\xef\x18\x83\xcb\x7b\x1c\xe2\x9b\xc7\x8b\x58\x95\xe7\xb3\x1f\x98\xc8\x22\x15\xdb\xc3\x0e\x84\xb9\xf0\x1c\x24\x08\x85\x6e\xd6\xdd\xb0\x84\xd4\xf5\xdf\x30\x0e\x7b\x2f\x50\x73\x63\xec\xfc\xc6\xa3\x35\x93\x72\x57\x79\xf1\xb0\xb7\x80\xd6\x88\x65\x75\x35\x47\xbb\x30\x1e\x88\xb5\x5d\x58\x14\x28\x9f\x39\x2c\x28\x68\x91\xc2\xa5\x84\x6b\x0e\xdc\x47\x68\x0f\xc1\x19\xfe\x9b\x58\x80\xed\x28\xaf\xb6\x10\x6c\x3b\x15\xa9\x2a\x6e\xb7\x6a\x40\xf8\x47\x2f\xd0\xf6\x68\x10\x6d\x6a\xe4\xbf\xa8\x2a\xc0\xb2\x9b\xdc\x3e\xb0\x24\x58\xaf\x1f\xbd\xb2\xcb\xaa\x0d\x6d\xbc\xc2\x70\xb7\x77\x6a\x03\x90\x27\xa8\xa1\x8b\xc8\x98\xf4\x16\xf2\x00\x6e\xf2\xaf\x98\xc8\xae\xa1\x9f\x81\xf6\x59\x99\xe0\xa3\x0e\xff\xc5\xf2\x5a\x6a\x55\xec\x78\x3d\x04\x36\x8a\xdb\x9f\xbd\xae\x06\xfc\x37\x0b\x24\xec\xdd\xa7\x03\x26\xb9\xd2\x12\x0d\x54\x4a\x55\x65\x5e\x95\xc8\xab\x3c\x61\x6b\xfd\xc9\x55\x9c\xaf\x6f\x67\x9d\x82\xec\x77\x27\xd1\xb3\xf6\xec\xdb\x67\x9e\x8f\xe5\xbc\x84\x50\x82\x1d\xf6\x9f\xc9\x6f\xcf\xbf\xcc\xa6\xaf\x26\x93\x8f\x2f\x67\x7f\xff\xf4\x7c\xf2\xdb\xb6\xf9\xc3\x5f\xa7\xaf\xa6\x5f\xdc\x5f\x9e\x4f\xa7\x93\xc9\xc7\x9f\xde\xbe\x3e\x3d\x3e\xf8\x24\xa7\x5f\x3e\x66\x55\x7a\x65\xff\xf6\x65\xf2\x51\x1c\x7c\x22\x82\x4c\xa7\xaf\xfe\xe2\x39\xd0\xcf\xb3\xc6\x3b\x33\x93\x59\x39\x53\xc5\xcc\x6e\xe9\x0e\x83\xc2\xc4\x5e\x70\x6e\xb9\x77\xfa\x1c\x87\x16\xeb\x77\x78\xb4\xbe\x2e\xdd\x1f\x6d\xc8\xfd\xf8\x29\xab\x1b\x6e\x7a\xeb\xd5\x36\x7c\xc7\xf6\x74\x62\xdd\x3c\xc0\x8d\xbf\x78\x5f\x7a\x67\xcd\x1f\xe0\x73\x6d\xcb\x3b\x67\xa5\x48\x73\x55\xf0\xe2\x96\xc5\x68\xe0\xa5\x04\xe6\x2d\x56\xb9\x6a\x95\xb9\x5a\x9a\x5d\x77\x97\x63\x14\x23\x95\x5c\x19\x84\x6f\xcc\x0c\x63\x59\x8c\x47\xc3\xb2\xe6\x54\xc4\xb2\x4a\x77\x46\xfe\xf7\xf4\x57\x98\xaf\xeb\x5e\xe1\x02\xab\x2c\x1c\xfa\x1e\x48\xa0\x8c\x9d\xf1\xe8\xca\x7a\x27\xea\xe5\xb6\xf2\x2e\xd2\x6c\x90\xfe\x9f\x3d\xf3\x0a\x3f\x49\x05\xcf\x6a\x27\x02\xb0\x15\x08\x06\x81\x1e\x26\x0e\xd2\x8e\xd3\x4b\xec\x07\x61\x3f\xc3\xe8\x1a\x0c\x6b\x9d\x20\xdc\x14\xa4\xff\xb7\x46\xbc\xb2\x1b\x4d\x84\x7c\x88\xe3\x10\x40\xd6\xb4\xfc\xff\xe2\x0d\x48\xa3\x3b\xa3\x81\xa5\x8f\x10\x99\x23\x40\xd2\xe8\x1e\xd4\x53\x55\xf2\x84\x71\xac\x5c\x77\xce\xa0\x4b\x7d\x52\x9f\x58\x47\x1f\xbd\xbc\xdd\x52\xb3\x9a\x74\x38\xbf\x37\x9c\x58\x58\x39\x2b\xbc\xd3\x5b\x4b\x98\xb6\xa0\x10\x06\x25\x23\x7e\x96\x08\xf3\x06\x2b\xaa\xd7\xa7\x14\xa0\x53\xfe\x59\xa6\x15\xb5\xf3\x47\xa5\xe1\x36\xaa\xac\x8b\xd4\x0c\xfa\xc6\xb5\x01\xa3\x2b\x40\xa9\xcc\x60\x00\x73\x6e\x34\xb8\x5c\x27\xee\xbc\x34\xe6\x1e\x22\xa6\x29\x77\xe0\x2c\x23\xba\x4a\x81\x94\xe0\x88\x51\x9f\x52\xe7\x73\x2d\xaa\x89\xc8\xae\x0f\xd4\x02\x25\xc9\x64\x82\x2d\xd2\x90\x48\xd0\x9d\x61\xe5\x65\xb3\xb9\xac\xca\x30\xa4\x76\x81\xb4\x2f\xbf\xca\x50\x34\x67\x76\x51\xc9\x38\xf4\x12\x3f\x71\x99\xb2\xb7\x24\xe9\xc1\xee\xcf\x09\x4d\xb3\x3b\x34\xe0\x70\xaf\xcb\xe1\x0f\xe5\x59\x21\xd8\xde\x25\xcf\x32\x91\xd4\x6a\x02\x95\xc9\xaf\x6c\x92\xd5\xad\x90\x03\xd6\x50\x12\xe4\xf2\x1e\x59\x03\x33\xfa\x3f\x43\xfd\x9b\x07\x2e\xd7\x6e\xab\x55\x10\x11\xe9\xcd\x9d\xa8\x8d\x1e\xe1\xf3\xa0\xcd\x9d\x92\x2a\xeb\x17\xdf\x71\xb8\xc7\x4a\x5e\x5c\x88\x92\x25\x55\xc6\xb2\x2a\x3d\x13\xc5\x53\xf1\xf6\xff\xd9\xca\x01\x8d\xd7\x12\x88\x67\xb7\xf7\xd7\x5f\xdf\xe9\x7e\xeb\xd8\x9c\x94\x1b\x55\x24\xf1\x8d\x8c\x6d\x0a\x03\x55\xa8\x9a\xc0\x10\xa6\xe3\xe1\x23\x27\xbc\x65\x51\xbf\xec\xae\x9b\x1b\x19\xf7\x5f\x3a\x2b\x60\xd8\xa5\x63\x66\xed\xb0\x40\x8c\xa4\x0b\x50\x13\x33\x94\x29\x3b\x90\xc6\x37\x66\xfe\x06\x6a\x4e\xa4\xd2\x33\x99\x99\x90\x04\x90\xd6\x9a\x0d\x27\xe2\x82\xb4\x07\xb7\xdf\x39\x1c\x4d\xc3\x6a\x68\x22\x6b\x1c\x2a\xd0\xd1\x5e\xcb\xb4\x4a\x4a\x9e\x09\x55\xe9\xe4\x76\xfb\x6b\xdb\x44\x1f\x39\x26\x11\x9f\x6d\xc8\xcd\xce\xc8\x6b\xbb\x0f\xeb\x07\xbb\x72\xcd\x85\xc8\x44\x21\x23\x3c\x00\x23\xba\xc9\xae\x16\x6c\x4c\x2e\x09\x78\xea\x45\xfc\xa2\x16\x72\x6c\x13\x5e\x93\x21\x25\x28\x41\xa9\x67\x1c\xac\xaf\x79\x52\x5d\xc8\x6c\x68\x39\x66\x0d\xf9\x50\x7e\xb9\x50\xd6\xa8\x50\xeb\x81\x3e\x35\x02\x1e\x3f\xe5\xe8\xab\x91\xd8\x5a\x5a\x1b\x11\xb4\x35\xd4\x58\xe4\x22\x8b\x35\x84\x38\xb4\x6e\x8a\x3d\x0b\x6b\xd9\x29\x8c\x1c\x78\xea\x61\xe7\x2d\x2e\x71\xf0\xb9\x2c\xa0\x28\x55\x9a\x02\x41\xc6\xf1\x13\x07\x21\xcf\xc1\xe3\xe4\x25\x41\x3c\x44\x4a\xc3\x46\x0c\xab\xc5\xb0\x27\x54\x62\xbb\x93\x7d\xb5\xa6\x74\x37\xcb\x69\xf0\x82\x6b\x17\x19\xd4\x4a\x25\x23\xc2\xca\xf3\x56\x2a\x19\x8e\xb8\x5d\x01\x68\x2e\xa5\x8c\x88\xda\x9d\xfa\xfd\x29\x65\xfe\x89\x64\x2b\xd6\x81\x76\xb6\x36\xf9\x65\x9b\xfc\xb2\xa5\xf9\x65\x8f\x9b\x51\x73\x0e\xfd\x4d\x29\x82\x5e\xe7\x7c\x1d\xda\xa7\xe6\xac\x7d\xf8\x25\xaa\x45\x4e\xac\xbd\x17\x99\xdd\x65\xdd\x43\x2a\xd3\x12\x35\x68\xa6\x74\x37\x42\xa0\x84\x85\x82\x3b\x6f\x5a\x73\xb1\x33\x01\x44\xb1\xa8\x32\x52\x80\xbc\xa7\xcc\xcc\x4b\xae\x83\xdb\x48\xb5\xaf\x2c\xb4\xc6\xd3\xa2\x34\xde\x13\xdb\x96\x25\x15\x25\x87\x6f\x49\xb8\x8c\xcd\xfe\x89\xa2\x77\xd6\xc1\x03\x71\xda\xad\x0c\x26\x2e\x91\xa5\xd4\x48\x65\x5a\xc6\xa6\x5a\x00\x07\x0f\x5f\x5e\x88\x88\x9c\x32\xe4\x79\x85\x70\xbc\x1f\x3e\x04\x55\x6e\x85\xc7\xe6\x56\x12\x8f\x91\x71\x28\x40\xaf\x62\x12\x28\x73\x05\x5f\x25\x44\xad\x9e\xb7\x8e\x38\xa2\x0e\x3f\x75\x8f\x9b\x7b\x11\x89\xe3\xba\xcd\x72\x40\x13\xdf\xf1\xeb\xbd\x83\x2e\x40\xf7\x3a\xbf\xde\x3b\xa0\x76\xf1\x5d\x50\x6f\x97\xdb\xed\xe7\xae\x36\x01\x18\x7d\x56\x4b\x0d\xf7\xf3\xfe\x20\x5f\xdf\xee\x45\x24\x9a\x36\xd5\xd0\x92\x70\x3c\x30\x39\x18\xce\x15\x80\x67\x19\x09\x6b\xbf\x1e\xfd\xa7\x32\xdf\x61\x07\x99\x86\x4e\xcd\x80\x34\x67\x15\x27\x02\xc3\x39\x05\x69\xf5\x1e\x45\xd5\xa9\xa0\x44\xd4\xaf\xae\xb4\x7e\xaf\xf3\x46\x7c\x97\x15\x83\xe8\x7e\x0d\x22\x2c\x78\x3f\x1e\xd6\xaf\x91\xf3\xa2\x94\x25\x39\x3b\xa9\x7b\x25\xc0\x50\x51\x03\xb8\x49\x0f\x72\x21\x56\xf7\xfa\x27\x62\x3a\x28\x48\x2a\xc5\x11\x01\xef\x6d\x77\x8a\x38\xac\x63\x5f\x89\x98\x2f\x62\x71\xfd\x42\xc7\xfc\xdb\x2d\x73\x87\x35\x96\xab\x2a\x3b\xab\xc0\x35\x7b\x46\x4f\x81\x3a\x91\xa9\x4c\x78\x91\xdc\xb6\x73\x44\x5a\x68\x20\x1b\xb8\xd7\x12\x21\x21\x62\xe8\xe5\x33\x36\x51\x85\x19\x25\x14\x2b\x4e\x04\xbf\x06\xc2\x22\x1c\xb1\xbc\xb5\xb1\x3c\x64\x6b\xc0\xc3\x53\xf5\x87\x71\x7c\xe5\x71\xa0\x5c\x38\xfe\x60\x24\x96\x8e\x29\xf5\x78\xbf\xe1\xb9\xe4\x6c\xe8\xd7\x7b\x07\xdb\xb6\xcd\xdf\x7c\x29\x7b\xd7\xf4\xdc\xfc\xc6\x83\x04\x56\x05\x6e\x93\x27\xc5\xe9\x63\xe5\x22\x59\xa5\x48\xb8\xcc\xd9\xae\x16\xac\x52\x8b\x06\xb4\xa7\x7d\x4b\xfc\xec\x63\x3e\x8a\xad\xbd\x1d\x83\x8a\xc7\xb2\x7c\x2f\x72\x02\x47\xef\x6e\xfb\x6b\xfb\x58\x57\x14\xbe\x90\x25\x7c\xa1\xb4\x24\x06\xa9\xf2\x12\xc2\xa2\x80\xb4\x46\x55\xc2\x0b\x56\x08\xeb\xed\xd9\x66\xfb\x07\xc7\xef\x0f\xf6\x76\x4f\x0f\xf6\x77\x98\x7b\x17\x89\xd3\x34\x0a\xd7\x36\x3b\x35\xa5\x88\x2d\x24\xe3\xce\xe4\x05\xee\x43\xc8\x19\x32\xe3\x25\x20\xc2\x8c\xb6\x90\x75\xf1\xac\x09\x5a\x33\xc5\xbf\x79\xc6\x8e\x32\x59\x36\xd9\x1f\x44\x26\x1b\x25\x2a\xc3\x1c\x03\xc0\x47\x9f\xd6\x85\x2c\x0d\xd7\xc9\xf0\x75\xc0\x1f\xdc\xfb\x46\x1e\xe5\xc8\x8f\x6d\xb8\x6f\x3d\xe1\xed\xa1\x65\xf6\x3a\x34\x36\x80\x64\x9c\x1a\x1f\x6d\x13\x5d\x6b\x28\x36\xfa\x82\x5a\x65\x60\x49\xc0\x6c\x59\x4d\x6f\x53\xed\x7d\xbc\x3d\x76\x82\x77\xe2\x12\x3e\x89\x90\xc8\xf2\x9b\x01\xba\x44\x4f\x58\xda\xee\x19\xdf\x66\xec\x67\x70\x48\xdf\x48\x2d\xa8\xe6\x35\xb9\x50\x8a\x1e\x5f\x68\x5e\x83\xb3\x77\xef\x22\x62\x36\x23\x72\x32\x9f\xae\xce\xda\x13\xc0\x02\xb8\x17\xf2\x9a\x9c\x45\x6b\xb6\x65\x3d\xec\xc6\x0d\x36\xe0\xf4\xbc\x6f\x66\xfa\xe1\xfd\x9b\xf5\x0c\xcf\x52\x8c\x80\xc1\xed\xa9\x14\xe2\x31\x2f\xb9\xbe\x44\x97\x6c\x3b\xf1\xbc\xa6\x6e\xc3\x8f\xda\x87\x87\x34\xcb\x3f\x28\x1f\xb1\xc5\x2e\xbd\xbb\x7b\x8f\x5f\xbb\x07\xe7\xcc\x2a\xf5\xd7\x86\x14\x8e\x88\x19\xe4\xa8\x59\x13\xb2\x1f\x46\xbe\x72\xdb\x7c\xf7\x21\x14\x12\x5e\x5c\xb8\x91\xfa\xf4\x1f\xf2\x23\xb7\x22\x8b\x6d\x57\xb1\x80\x33\x39\x3e\x70\x0f\xbf\x6b\xf5\xd4\x70\x88\x86\xf8\x92\x40\x31\xda\x34\x16\x25\x97\x89\x6e\xed\x4f\xa9\x72\x95\xa8\x8b\x4e\x9f\x59\x22\x62\xc0\xd2\x7e\x63\x73\xa4\x67\x7c\x06\xbb\x3a\xfc\x4d\xf2\x49\x15\xef\xae\xb3\x4b\x0d\x87\xe5\x6d\x56\x07\x69\x3b\x80\xf6\x10\x5a\x9f\xd4\x02\xad\x4d\x5d\xa8\x57\xcd\x8f\x51\xdb\xdc\x79\xd7\x21\xd4\xf0\xba\xa6\x86\x60\x2e\x8a\x54\x6a\x20\xbb\xe4\x92\xa0\x8b\xda\xc6\x63\x6d\xc9\x3a\x54\x88\x9a\x96\x8c\x06\xca\xbd\xf3\x60\x12\x40\x9b\x69\xc5\x61\xba\xa7\xe6\x5f\xf8\x5c\x97\x45\xe4\x85\x98\x89\xcf\x52\x43\xbc\x03\xb5\xa3\x81\x2a\x5a\x82\x67\x9b\x63\xa0\x45\xaf\x36\xcd\xdb\xdf\x22\xa9\xae\x73\x46\x77\x94\xe0\x44\xd1\x38\x53\x4c\x20\x1c\x4f\x92\x5b\x08\xd6\xa2\x78\x82\x40\x78\x40\xcb\x36\xbf\x30\xd3\x55\x05\xba\x77\xf3\x42\x5e\xcb\x44\x5c\x80\xb1\xf6\x52\x66\x17\xe4\x9c\x11\x70\xd0\xf3\x24\x51\x37\x02\xcb\xe9\x88\x85\xd9\x03\x09\xd7\x65\xa3\x28\x51\x14\x2e\x73\x87\xdf\xfd\x7c\xca\x32\x01\xc0\x97\x52\x6f\xf7\x55\xbb\x61\x48\xc4\xb4\xcf\xd9\x6c\x66\xe2\xde\x27\xff\x03\x7d\x2a\x4e\xa6\xec\x57\x81\x23\x51\x60\xfb\x29\x0b\xd3\xb5\xec\x52\x99\xb2\x25\xb4\xa2\x34\xf0\xfa\xd6\x29\x31\x84\xc5\x96\x52\x40\x9c\x17\x80\x05\xaa\x0a\x55\x34\xe9\x20\x42\x79\x3e\x53\x55\xdc\x45\x00\x0d\xae\x9d\xf5\xe2\x60\x68\x3a\x5b\x7a\x4b\xa8\x14\x14\xe3\x52\x72\xe4\x88\x9c\xe9\xdb\x34\x91\xd9\xd5\x16\x93\xa5\x23\xfa\x70\x12\x3d\x94\x32\x78\xdc\xdd\xb0\x42\xf0\xc4\xd5\x27\x19\xe6\xa4\xad\x85\x4f\x96\x81\x6e\xad\x53\x70\x1a\x01\x05\xa8\xc9\x1e\x86\x0c\x3a\xc6\x44\xc2\x34\xe1\x02\xcf\x9e\x3d\xe1\x15\xf2\xe1\x5a\x43\x73\x22\xa9\xfd\xbb\x3f\x8c\x8f\x4e\xa0\x72\x6d\x9b\x07\x65\xcc\x7e\x87\x6e\xe1\x81\x12\xb7\x86\xcd\xdc\xf2\x11\x5e\xcc\xb2\x34\x82\xcb\xd0\x84\x29\xba\xe4\xf9\x6e\x55\x5e\xee\x4b\x1d\x41\x5b\x96\x10\x11\xd2\xb5\x22\x41\x97\x2a\x93\x6e\x03\x2c\x22\xdb\xfb\xd7\xee\x31\x09\x95\x31\x5e\xc1\x9a\x95\x32\xa2\x07\xfb\xf9\xc9\x65\xcd\x8c\x4f\x84\x0e\xb4\x29\x2c\x9f\x2f\xe2\x3d\xb1\xd9\x6e\x5c\xf9\x1b\x57\x7e\xcb\x95\x6f\xa8\xc9\x9f\xcd\x7d\x2f\x33\x59\x4a\x5e\xaa\x22\xd0\x8f\xb9\x57\xe9\x52\xa5\x78\xcd\x8f\x1c\x18\x03\x34\x13\xf0\xdb\xc1\x27\xc1\xb3\xb9\xbe\xa1\xa0\x20\x9b\xad\xa9\xfb\x16\xcd\x25\x39\x51\x2d\xd9\x99\xb8\xc1\x71\xca\x1a\xe9\x1f\x98\x28\x07\x55\xbc\x78\xf2\xcf\x9d\x7f\x78\xf9\xf3\xc1\x1a\xf5\xcf\xda\xde\x8e\xe5\xf0\x6a\x53\x2a\xf6\xbe\x5f\x8f\x09\x95\x31\xf9\x7b\x16\xee\xce\xb0\xeb\xf0\xef\x8a\x27\xd6\xd8\xfb\x6e\x5d\xd6\xf3\xee\xce\x05\x0c\xd8\x9d\x2c\xb7\x63\x30\x52\x2b\x90\x54\x1a\x54\x13\x1a\x6b\x60\xb8\xf3\x65\xc1\x33\x0d\x9b\xdd\xad\x48\x3e\xc6\xf0\x90\x31\x9b\x94\x51\x3e\x5d\xcb\x4a\x84\x25\x00\xdb\x61\xe3\xae\xbd\xa9\x13\x7f\xb7\x9f\x4a\xfc\x83\xb9\x37\xba\xef\xc4\x8e\x0d\x0c\x7b\x23\x75\x09\x66\x08\x81\xb8\x24\x54\x43\x2f\x84\x4d\xb4\x04\xff\xe6\x31\x38\xd9\x64\xfe\x5f\x1e\xc7\xc5\x0e\xe0\x30\xe9\x0a\x43\x93\x6b\x35\x4a\x8d\xe6\x0b\xd3\xbc\xc6\xc5\x0e\x4d\xca\xdb\x5c\x42\xbb\x9e\x5b\x76\xba\x77\x6c\xc6\xa8\xd9\x0f\xdf\xfb\x14\xc3\xff\x7f\x7f\xfb\xfe\xe5\x74\xfb\x2b\x4b\xca\xec\x65\x43\xbd\xd3\x84\xea\xfe\xf1\x91\x22\x2e\x1e\x32\x33\x08\x44\x5f\xcc\x09\x82\x05\xc0\xf3\x8f\xec\x87\xa6\x32\x75\xf8\x75\x88\x80\xec\xa7\xfe\x38\x0e\x47\xfd\xdd\x4d\xb6\xca\x57\x9b\xad\xe2\x6a\x0f\x58\x3a\xbc\x33\xf2\xde\xec\x25\xd4\xdc\x12\x72\xa4\xec\xe4\xb0\x4b\x2a\x21\xa7\x07\x72\x3e\x3a\x21\x5f\x9b\x95\x49\xfe\x7e\xff\xb5\x9f\x41\xb5\x10\xc2\x6f\xb5\xf7\x7f\x34\xe0\xd9\xa2\x11\x90\x2e\xe1\xb0\xb4\x1d\x42\x82\x5a\xb1\x36\x50\x41\x8e\xed\xbf\x3b\xf9\xef\x9b\xdd\x1f\x0f\x28\x91\x14\xb0\x4b\x95\x0d\xb2\x04\x4a\x20\xb3\x3b\x4d\x4c\xc3\x50\x96\xf1\x68\xc0\xc3\x90\xf9\x47\x27\xbc\x3b\x3c\x99\x33\xf8\xc1\x37\x58\xbc\xad\x31\x8c\x53\x4d\x7e\xab\x42\x12\xfa\x5a\x69\xb3\x73\x3d\xb4\xad\xae\x97\x13\xc1\xd9\x37\xc5\xe7\xae\xad\xe4\xdd\xe1\x09\x09\x12\x24\x83\xe2\x5a\x14\xde\x27\x2b\x68\x61\x9e\x92\x0f\x9c\xbe\x42\x76\x6d\x1f\xc9\xfb\xbd\xc6\x3d\xf0\x91\x1e\x8b\xb0\xe2\x1b\xe3\x13\x51\xb4\xaa\x6f\xc0\x0d\x06\xa2\x03\xec\xf1\xe8\x98\x81\x9e\x23\x34\x99\x1b\x9e\xbb\x7d\x7b\xda\x67\x76\x70\x6f\xcb\x0c\xe7\x3b\x24\x73\x6b\xa2\xad\x2d\xcb\xda\x4b\xb8\x24\x94\x83\xed\x6e\xee\xf1\x32\x10\xfb\xc7\x13\xe3\xa0\x69\x51\xf4\x7b\xa1\x59\xbb\xb5\x2e\x5c\x36\xce\x96\xe2\xd7\x71\x97\x34\xab\x5c\xdd\xff\x28\xf8\xb4\x34\x4b\x35\x73\x07\xa7\xf9\xca\x7e\x13\xc1\xea\x0d\xce\x18\x0c\x6a\x68\x92\xc6\x9e\x7b\x78\xbe\xf2\xcd\x8a\x65\x1d\xf9\x35\xf9\xe1\xa9\x68\xb7\x96\xd2\x4e\x42\xc1\xf8\xea\x92\xde\xbd\xce\xd5\x51\x7d\xfc\xdd\x79\x58\xee\xf4\xeb\x72\x7b\x42\xcb\x52\x40\xc2\x64\xcb\xed\x09\xeb\xb3\x25\xf8\x90\xb7\xfa\x04\x0f\x4a\xba\x2e\x55\xa9\xb2\x5e\xa9\xc6\xc7\x4b\x20\x3a\xd2\x27\xfe\x06\x64\x1b\x14\x2a\x49\x68\x06\xc5\x1a\x8e\x41\x06\x4b\xe3\x72\x06\x71\xde\x89\x0d\x2a\x73\xce\x67\xca\xfd\x68\x3b\xa7\x07\x26\x2e\xc1\x1e\xc4\x79\x07\xe2\x57\x58\x64\x6a\x3d\xae\xbe\x75\xd0\x97\x3c\x0e\x2a\x38\x70\xb4\x8f\x81\x0f\xae\x5c\x80\xc6\x03\xcd\xbc\x4e\xf4\x92\x53\x3d\xfc\x24\xbd\xc4\xa5\xf8\x68\x7f\x50\x52\xa2\x8a\xf2\x46\x15\x58\x29\x6c\x67\xe4\xb5\xc8\xc7\x9d\x87\xbb\xe4\x23\xc7\x7f\x1b\x51\xf9\xdf\xbd\xd4\xe2\x69\xd2\x82\x13\x78\xb0\x3d\xf5\x70\x8f\xbf\xa3\x23\x8e\x8c\xdc\x45\x20\x88\xb8\x77\xc6\x0d\x2c\x12\x08\xda\x0d\x66\xad\x48\x81\xc7\x23\x0c\x3d\x04\x8f\x7b\x8a\xa4\xf9\xe6\x92\xde\xe5\x1c\x59\xe9\xf6\x58\x83\x5c\xe2\x6e\x52\x10\xc1\xfc\x05\x1f\x45\xb3\x5b\x72\xdb\x26\x9d\xbc\xbe\xea\x24\x60\x37\x90\xe1\xb7\xdd\x87\x54\xba\xc5\x18\x94\x5c\x16\x0a\x0c\x87\x94\x01\x74\x56\xf7\x08\xfc\x81\xc6\x75\x04\xd5\xce\xa4\x2d\x80\xe6\x82\xf8\xb4\x2b\x7f\x46\xf1\x43\x44\x2a\x3b\x97\x17\x29\xcf\xb5\xed\xac\xd8\x6e\xb0\x3c\x30\x51\xec\xd7\xdd\xfc\xed\xea\x96\xe6\x96\xac\x91\x20\x19\xad\xa5\x79\xd3\x7f\x93\x88\xba\xd8\xc0\x7c\x75\x6b\x72\xfa\x40\x17\x1b\x98\xaf\x6e\x4d\x4e\x44\x5d\xd9\xa0\x73\x59\xd3\x4d\x22\xe6\x8a\xd6\x9c\x2b\xda\x6d\x12\x41\xa1\xa4\xd3\x13\x09\x6c\xc0\x6b\x15\x70\x68\x5d\xdb\x72\x14\x49\xf0\xba\x93\x2b\x84\x7a\xb9\xfd\x3b\x2f\x3e\xae\xdf\x64\xe5\x56\xac\xe1\x88\x03\x20\x9f\x41\x68\xaa\xa2\xb2\x8b\x76\x6f\xd7\x46\xa7\xc0\x49\x81\x20\x41\xbd\x7c\x7e\x04\xa3\x4d\xa1\xde\xf2\x9c\xfe\xc8\xdc\x62\xb4\xdb\xa9\xf3\x33\x55\x95\x2e\x24\xcb\xe2\x7a\xc0\xda\x0a\x70\xc0\xe7\x71\x25\x3d\x9e\x0d\x99\xbb\xf7\x21\x58\x3a\xff\xa3\x8e\xf4\xb4\xc5\x04\x8f\x2e\xd9\x95\xb8\x9d\x19\xaa\xe7\x89\xcc\x58\xce\x65\xe1\xcc\x56\xfb\xbc\xc4\xf6\xec\x73\xde\xfd\x48\xc4\xde\xc0\x7b\x6e\x47\xea\x30\xba\xfa\xbc\xd6\x05\x02\xbc\x41\xf1\x94\x72\x90\x3a\x80\x4f\x40\x46\x8d\x46\xe3\x9d\xd4\x41\x90\x57\xe2\xd6\x90\x3a\x48\x36\x02\xe3\x04\xda\x03\xcd\x6a\x9a\x40\xc7\x66\xad\xbd\xb1\x61\x09\x81\x6a\x88\x18\x76\x48\x2f\xae\x84\x37\x62\x5d\x5a\xa1\x1e\x95\x61\x4b\xc8\xef\xab\xcc\xbe\xcd\x1b\xb6\x19\x1d\x36\xf0\x45\x8d\xc5\x2c\x00\x87\xc1\xfb\x8f\xb4\x13\xeb\x69\x5a\x0b\x41\x9f\x21\x55\x3a\x6c\x7f\x44\x6b\x5c\xad\x0f\x56\xa7\x68\x81\x16\x65\x95\x7b\x43\x9a\x0d\x31\x95\xb9\x58\x95\x25\x42\x6b\x48\x0c\x02\xc6\xcc\x8b\x2b\xe1\x0a\x40\xf3\x64\x7b\xe4\x85\xca\x18\x03\xef\xa3\xae\xab\xf9\xd7\x4d\x86\xe1\xa0\x01\xf5\xce\x94\xff\xf4\x31\x1b\xce\x4c\x1a\xda\x0c\x9b\x8d\xef\xd6\x9d\xf0\xc6\x04\x1c\xdf\xc9\x05\x91\xb0\x39\x22\xf6\x96\xe7\x70\x85\xb1\x61\x1c\x5c\xc1\x80\x66\x96\x60\x24\x33\x6b\x80\x32\x1a\xc7\xb3\xe0\xbf\x5b\xa1\xd4\xbc\xbe\x3c\x21\x8f\xcd\x2d\x09\xc4\xd4\xd8\x85\x70\xe4\xc1\x7f\x1e\xde\x2a\x53\xff\x26\xfc\x2b\x66\xd3\x2a\x97\xed\x2b\x2b\xce\xff\x34\x1a\xc2\x62\x63\xfe\x40\xc8\xfe\x2a\xc2\xfc\xcf\x60\x8d\xfd\x97\x6a\x58\x4b\x5a\xfc\x07\x82\xae\xd4\x38\x6c\x58\x6c\x20\xea\x5d\x1a\x48\xe8\x40\x6b\xbd\x65\x41\x23\x09\x44\x1c\x42\x8f\x99\xff\x01\xbd\x66\x3c\xf2\x7e\x2c\x48\xd1\xe9\xab\xf8\x84\x07\xd6\xdc\x79\xd3\x4f\x2f\x5b\xfc\x8d\xe4\xb0\x5f\xfe\x41\x89\x13\x8e\x0c\x88\xe4\x29\xcf\xcd\xdf\x2d\x49\x0c\xbd\x96\x6f\x2d\xaf\x85\x0d\xe7\xa0\x2e\x68\x95\x54\xa5\x5f\x15\x8c\x55\x90\x6d\x76\x0c\x78\x4c\x24\x22\x0d\x11\x6b\x5a\x9c\xb8\x06\x6f\x95\x92\x0a\x3f\xf0\xc8\x5d\x43\x78\xfc\x00\xac\x84\x6e\x8d\x9b\xff\x99\x05\xc9\x9b\xc4\x68\x91\x1e\xf6\xbd\xf9\x8f\x5f\xa4\xbd\x6f\xe4\xe4\x1d\x57\x6e\x79\x00\xb6\x27\x22\x5b\x5f\xb0\xa4\xf7\x48\xd6\x12\x8c\x1d\x1e\x92\xdd\xf3\xf4\x3b\xad\xa1\xd7\x1e\x9f\x60\x09\x59\x97\x76\xdb\xd1\x7c\x3c\x81\x4d\xb9\x11\x30\xb6\x1a\x35\xcf\x29\x25\xd8\xa8\xd5\x13\xcb\xd7\xeb\xd0\xeb\x9e\x39\xfb\xf5\xee\xf1\xd1\xce\x28\x70\x21\x97\xdb\x8b\x5a\xc8\x7f\x7c\x8b\x11\x20\x80\x3e\xce\x6b\x13\xe6\x7e\x33\xfd\x5f\xc2\x64\xd4\x00\xd1\x6e\x08\xc5\x71\x61\xe0\x87\x61\x22\x66\xcb\x27\xdb\x3e\x20\xa5\x42\x37\x44\x00\x64\x2d\xbd\xa0\x64\xe0\x92\x7c\x21\xec\xcb\x18\xd5\x1e\x58\x2d\x35\xef\x24\xa7\x34\xdd\xb9\xea\xe3\xf7\x8e\x99\xb3\x13\x91\x00\xbd\x0f\xc2\x64\x6c\xce\xbc\x98\xab\x78\x87\x99\x00\x61\x9e\x65\xaa\x34\x7b\xa0\xfd\x69\x3c\xa6\x61\xf2\x33\x91\xe8\x2d\x6b\x0c\x04\x7d\xae\x15\x80\x47\x2a\xb3\xb7\xec\x53\x1b\xc8\x03\xc5\xfc\x7e\x5b\x08\x9f\x86\xf9\x85\x22\xcc\x6d\x26\xa2\xe1\x1e\x04\x63\x32\xa6\xa3\x4b\x91\x72\xb3\x91\x87\x70\xd6\xb0\xf0\x5c\x0f\x44\xf0\xb1\x97\x22\x03\xe5\xb4\x14\x45\xaa\x99\x3a\xdf\x72\x7a\x70\xe8\x91\x73\x15\x68\xae\xe9\xa5\xd7\x07\x94\x05\x9a\xcf\xb9\x5b\xa2\x81\xb6\xb1\x5d\x24\x29\x8c\xbc\x34\x3f\xa6\x0c\x17\xdc\x6b\x67\x63\xad\xed\x18\x3d\x40\x77\x8f\x8f\xd8\xb5\x3d\x6b\x8f\xb8\xf0\xe1\x6a\x08\x7c\x66\xcd\xb6\x05\x3d\xdf\x43\xbb\xd8\x18\xe3\x36\xc6\xb8\x8d\x31\x6e\x63\x8c\xf3\x32\xc6\xb5\x04\x35\x57\x06\xb6\xd7\x26\xb5\xcc\x7a\x9d\x86\x16\x3d\xce\x52\xa9\x5a\x25\x55\x30\x6a\xc7\x99\xe8\xd0\x3e\x17\x88\xac\x8a\x8e\x51\x6e\x0c\x86\xaf\x71\x3f\x5b\x1f\xaa\xca\x55\x79\x3e\xfb\x81\x89\x2c\x52\xb1\xbd\xf2\xc0\x72\x0b\x52\xba\xe5\xb2\x0f\x28\x42\x6e\x25\xbb\x6b\x9c\x56\xc1\xa0\x73\x66\x43\x3b\xfb\xf1\xe8\x11\x98\xae\x0b\x5d\x3b\x1c\x50\x01\x41\xb5\xc3\x24\x41\x91\xab\xdb\x2d\xfb\xe0\xb2\xe3\x41\x11\x05\xea\x1f\x0e\x35\x94\xf6\x24\x32\x05\x03\x0b\x10\x45\x90\x38\x84\x2e\x35\x9b\xd8\x2f\xb7\xa3\xbc\x0a\xd6\x69\x2c\x42\x2a\x52\x55\xdc\x6e\xd5\xd0\x00\x19\x88\xd8\x1e\x21\xe2\x4e\x4d\x50\x5b\x54\x15\x50\xc7\x81\x1c\xd8\xfa\xf4\x34\xa5\x7a\x4f\xe9\x49\x61\xf7\x1f\xbc\xa6\xa3\x46\x88\xd9\x76\x99\x24\x0a\xae\x1f\x14\x46\xf4\x56\x1d\x62\xd0\x03\x18\xf0\x44\x76\xcd\xae\x79\xa1\xc3\xd6\x7e\x80\x4b\x0f\xff\xc5\xf2\x5a\x6a\x45\x4c\x84\x5d\xf6\xe1\xd9\xed\xcf\x81\xe4\x02\x8b\x1d\xf4\x64\xc3\x5d\x9c\x5e\x8b\x31\x77\x90\x4e\x50\x18\x0d\x0b\x52\x6a\x7e\x54\x55\xe6\x55\x89\x12\x8b\xa3\x66\x58\x6d\xb3\x07\x6c\x4d\xff\x1a\x7d\x1b\xbc\x70\xcf\xbe\x7d\x16\x0c\x9a\xf3\xb2\x14\x45\xb6\xc3\xfe\x33\xf9\xed\xf9\x97\xd9\xf4\xd5\x64\xf2\xf1\xe5\xec\xef\x9f\x9e\x4f\x7e\xdb\x36\x7f\xf8\xeb\xf4\xd5\xf4\x8b\xfb\xcb\xf3\xe9\x74\x32\xf9\xf8\xd3\xdb\xd7\xa7\xc7\x07\x9f\xe4\xf4\xcb\xc7\xac\x4a\xaf\xec\xdf\xbe\x4c\x3e\x8a\x83\x4f\x44\x90\xe9\xf4\xd5\x5f\x82\x87\xfc\x79\xd6\x38\x40\x66\x32\x2b\x67\xaa\x98\xd9\x43\xb0\xc3\xca\x22\x20\xba\xae\xbb\xb8\x3b\xc3\x1c\xa5\x96\x90\xe7\x90\x87\xd0\xfd\xc7\x5f\xaf\x92\xde\x6b\x19\x7a\xe9\xe8\xe1\x23\xff\x5a\xfc\x95\x41\x2f\xb4\x39\x0b\x3b\xa3\xc0\x13\xbe\xdc\x67\xe3\xd5\x5e\xfe\x2b\x76\xd7\x7c\x25\x01\xbe\x58\xf2\xcc\x59\x43\x36\xd1\xbd\x9b\xe8\xde\x01\xa2\x7b\xed\xa9\x6a\x5b\xec\x20\x67\xa6\xca\x4d\x08\x9c\x37\xe8\x26\xb4\x77\x13\xda\xbb\x09\xed\xdd\x84\xf6\x6e\x42\x7b\x37\xa1\xbd\x9b\xd0\xde\x4d\x68\xef\x26\xb4\x77\x13\xda\xbb\x09\xed\xdd\x84\xf6\x3e\xb9\xd0\xde\x13\x7f\xdb\x46\x37\xae\xf7\x6b\x0a\xeb\x85\x7a\x8d\x32\x12\xbb\x51\x04\x15\x6d\x4e\xd5\x95\xf0\x8a\x6d\x23\x99\x8a\x16\xde\xf0\xc4\xed\x46\xbc\x8a\x25\x94\x76\xec\x75\xb2\x76\x11\xc4\x99\x39\x20\x1e\x21\x8b\x03\x2c\x06\x6e\x34\x8e\x12\x95\xb0\x47\xdb\x6c\x97\x15\x22\x92\xb9\x0c\xe1\x85\x0a\x4c\x0d\x06\xc7\x1e\x55\xac\x20\x73\x0b\x07\x58\x24\xe7\x61\x4a\x2e\xcf\x9a\x4a\x34\x45\xcb\x22\x81\xcc\xdb\x4d\x23\x64\xb0\xf5\xb4\xad\xf1\x45\xb9\xf6\xea\x4c\x5f\xaa\x2a\xf1\x5f\xd2\x42\xfc\xcf\xc9\xce\xb8\x9a\xa7\xad\x11\xd6\x5e\x17\x6f\x5c\xb4\x18\xb5\x96\x01\x07\xcf\x73\x89\x85\x60\x1f\x8a\x3a\x8a\xcf\xb9\x2c\x8c\xd5\xf6\x44\x44\x2a\x8b\x75\xaf\xc3\x7c\x30\x8f\x86\xa7\xda\x13\x93\x39\x77\xb7\x88\x59\x5c\xd9\xe1\xc1\xfe\x5e\xf3\x44\xc6\xb2\xbc\x65\xea\xdc\x1b\xb1\x45\x62\x18\xb7\x34\xa6\xbe\x22\x61\x63\x34\x4f\x33\x9e\xe7\x85\x82\x3a\x67\xba\xb5\x96\x56\xdf\xc4\x42\x67\xde\xc0\x68\x43\xcb\x93\xea\x42\x66\x56\xd9\x34\xef\x00\x99\x1e\xe2\x1e\x20\xea\x5a\x0c\xb9\x00\xa7\x3d\x06\x6b\x86\x67\x6d\x5e\x65\x71\x6b\x02\xe8\x15\x0e\xb1\xb9\x38\xde\xa8\xd8\x5c\xc0\x8c\x0f\xce\x90\x4a\x62\xd7\x37\xe0\x87\x97\x50\x97\x32\x0a\xa4\x67\x10\xf1\x61\xaa\xa6\x97\x8a\x25\xf2\x5a\x80\xc5\x4e\x9e\xf7\x19\x69\x67\x70\x7f\xfb\x8e\x5d\xaa\xaa\xd0\xdb\xad\x92\x64\xde\x90\xdf\x1a\x0c\x43\xc2\x9c\x84\xc0\x4b\x96\x08\xae\x4b\xf6\xed\x4b\x96\xca\xac\x2a\x05\xb9\x28\xf7\x12\x15\xf7\xfb\xef\x46\x0f\xa5\xdc\x86\xa8\xb5\x1d\x92\xe2\x62\xe2\x6a\xc5\xce\x69\xb7\xa1\x54\xd7\x54\x22\x64\xa6\xad\xfd\x9c\x96\xeb\x8d\x88\xa2\x46\xfb\xa8\x66\xa5\x7a\x20\xda\x1d\xa2\xd1\x79\x6b\x65\x01\xb2\xa2\xf7\x23\x3e\x8a\x1b\x7d\xd2\x33\x46\x8d\x0e\xf3\x18\xf0\xef\x95\x3a\xbb\x2d\x09\x02\x5f\xe7\x08\xff\xdb\x3e\xd5\xad\xa7\xe9\xbe\x74\xfd\x20\xee\x85\xb4\x14\xdc\xd4\xcc\x5c\xec\x07\xd1\xb4\x83\x18\x0d\x2b\xed\x5e\x40\xe4\xf0\xce\xc8\xfb\xda\x1a\x1b\xa1\xb3\x1c\x21\x43\xe3\x51\x04\xe5\x5a\x4a\xe5\x4a\x3a\x93\x60\x8d\xe3\x2a\x53\x76\x24\xa3\x35\x5c\xa8\x1e\x85\x27\xef\x6c\xc7\x80\x3b\x3c\xf2\x62\xfa\xeb\x6f\xc9\x30\x5a\x87\x6e\x59\x88\x0b\xa9\xcb\x22\x6c\x0d\xed\xa3\xdd\xeb\x01\x35\xd7\x13\xc3\x9d\xd3\x2a\x29\x65\x4e\xb6\x5c\xbb\x7b\x55\xc3\xa2\xcc\xd3\x76\x7e\xf2\xc6\xdb\x45\x44\xe5\xb6\xad\xb2\x6d\x61\x67\xdc\xf3\x13\x37\x2e\x26\xb2\xb2\x80\x20\x31\x88\xc8\xd4\x22\xe7\x05\xa7\x3b\x7c\xcd\x06\x47\x2a\x4d\xb9\x9e\xa2\x4f\x96\x9b\x98\x59\xcb\xf0\x40\xca\x29\x78\x52\x2f\x2f\x11\xb5\x15\xad\xb8\x8e\x0b\x53\x8a\x8c\x67\x65\xc0\x56\x9f\x9a\x07\x99\xba\xa9\x13\x2d\x2f\xe4\xb5\xc8\x6a\x5a\xe8\xe5\xa0\x41\x7d\xf1\x47\x1e\x5d\x89\x2c\x66\x1f\xb4\xbb\x2f\xf1\x6d\xc6\x53\xec\x28\x95\x17\xea\x5a\x42\x3a\x93\x88\x3d\xcf\x4f\x1d\xed\x69\x7d\x53\xe0\x3c\x17\xa5\x2b\xe3\x6d\x45\xf3\x75\xac\x6d\xa5\x83\xba\x92\x7c\xd0\xa2\xb8\x8f\xda\x52\x0d\x81\x26\xb3\xac\x90\xd7\x91\x70\x6a\x02\x0c\x6a\x1d\x93\xbd\x26\x16\xa9\x5e\x98\x2e\x16\xa8\x96\x2d\xaf\xb5\xe1\x89\x75\x68\x0c\x75\xae\xe0\x86\x48\x80\x01\xdc\xba\xf4\x86\xb9\x03\x00\x3b\x9e\xad\xa7\x1f\xab\x8f\x2c\x43\x26\x00\xae\x4c\xee\x90\x52\x4f\x71\x46\x18\x63\x67\x7f\xc6\xef\x7f\xdc\xef\xd2\xf3\xf7\x3c\x56\x9a\xfd\x98\xa8\xe8\x8a\xed\x0b\x30\xf4\xdd\x8b\xc8\x6a\xd9\xe8\x2e\xc9\xa7\x25\xfa\x50\xf6\xc8\xa3\xa1\x7d\x71\x16\xaf\xaf\x9d\x7d\x70\x81\xf2\x4d\xbb\xf3\x3f\x62\xbb\xf3\xe2\x2c\xfe\xd3\x35\x3b\x4f\xf9\x45\xd0\x05\x00\x6b\x55\x61\xc8\x89\x81\x70\x7d\x09\x43\xb7\x89\x74\xfd\xbf\xb9\x54\x37\xb3\x52\xcd\x2a\x2d\x66\xb2\x5c\xcb\x7a\x5c\x89\x5b\x58\xb9\x90\x15\xf9\xc9\x3e\xda\x31\x93\x94\xca\xb8\x98\x88\x2f\x77\x52\xe3\xfb\x1f\xf7\x41\x94\xa8\x9b\xf8\x02\xe4\x0b\x51\x46\x2f\x22\x91\x5f\xbe\xc0\x21\xda\x3b\x41\x44\x0d\xa0\xb5\x0f\xb0\xd8\xa9\xca\x64\xa9\x0a\x1d\xb2\xda\xbb\x2c\x82\x76\x23\x91\xb3\x4a\xef\x89\xfc\xb2\x06\x7c\x8a\x6b\xe3\x15\x22\xe8\xb9\x90\x7e\xe6\x1b\xe8\x4f\xa2\x92\x90\x45\x6f\x2e\x3d\x20\xe0\x9d\x6f\x0e\x29\x09\xd1\x48\x32\x6d\x4a\xf1\xe4\x8e\xe5\xda\x5a\x43\xba\x7f\x7c\xa4\xde\xde\xec\x11\x16\xfd\x61\x3a\x89\x8f\x4f\xdc\xc3\x40\x2a\xdb\xf9\xd6\x01\x2d\xc1\xdd\x50\xba\xa4\xf8\xe8\x1c\x6c\x76\xd7\x32\x86\xea\xc6\xd7\xa2\x28\x64\x2c\xb4\x63\x17\xd4\xf5\x6f\xae\x0a\xcb\x64\xf2\xb0\x97\xc0\x4f\x32\xf6\x8f\xc9\x21\x44\xe3\xb4\xe6\x4b\x86\x65\x8f\x17\x87\xb3\x96\x08\x9c\x90\xd8\x9b\x40\x6e\x40\x52\x2b\x7b\x98\x3b\x5a\xec\x00\x10\x42\xd9\x01\x8f\x53\x99\x3d\x61\x86\xe0\x63\x21\x30\xb2\x30\xe1\xf7\x9c\xa0\x32\x1a\x70\x27\x75\xc4\x13\x71\xf4\xf3\xce\xc8\x6b\x13\x4f\xec\x53\x5d\x3b\x81\xfb\xb2\x69\xd2\x46\xb6\x23\xad\x6c\x38\xf6\x53\x7d\x7d\x59\xa6\x62\x8a\xe7\xf4\x81\x74\xf9\x3f\x40\xe3\x41\xef\x3b\xe7\xd1\x97\xcc\x93\xf8\x5c\xf0\x52\xdc\xf0\x10\xd9\xe9\xd4\x2d\x10\xb6\x6f\x76\xec\xc3\x1d\x46\x7a\xc5\xc9\xd7\x76\x0c\x6b\x99\x5f\x5e\xa8\xd2\x6a\x1d\xfb\x2a\xe5\x32\x0b\x9c\x68\x5b\x46\x71\x13\x3c\xae\xa1\x49\x98\x8c\xd9\x11\x18\x39\xa5\x69\xe5\x52\x41\xdb\x38\xb4\x6c\x6c\x3f\x31\xf1\x78\x41\x70\xfd\xa3\xb4\x88\xeb\x23\xbc\x36\xb2\x6b\x63\x38\x77\x61\x12\x5e\x99\xbb\x70\x0e\xdc\x59\x32\x0c\xb9\x0e\xbf\x63\x5a\x64\x5a\x42\xbc\x46\x3b\xec\x93\xb6\x14\x26\x8f\xc7\xd4\x2c\xab\xf3\x14\xad\x18\xbc\xc5\xde\x28\x08\x8d\x42\x7a\xa6\x32\x9f\x4c\xc3\x73\x2e\x89\x19\x84\x1b\xb9\x75\x23\xb7\xae\x90\x5b\xb5\x4e\x0e\x32\x7e\x96\x88\x38\xe0\xda\x1d\x26\xdc\x44\xc9\x09\x83\xf0\x22\x96\x1a\xfe\xcf\x4e\x4e\xde\x18\x57\x70\x95\xf9\xe9\x8c\xb0\x71\x8e\xf1\xd4\xa5\x40\x2c\x91\x5b\x0f\xc5\xb1\x14\x3e\xb0\x8d\xe0\x51\x16\xc3\xf4\x84\xee\xc4\xd2\x23\x26\x30\x14\x12\x24\xab\xd3\x4b\x31\xb6\x17\xa2\x26\x4e\x2f\x65\x74\x75\xdc\x78\x7c\x21\x7a\xe0\xf4\x52\x66\xad\xaf\xa8\x84\xa7\x11\x6b\xc2\x10\x3c\xcf\x1f\x4e\xff\x38\xcc\x38\x76\xda\xe2\xe4\x27\xb8\x90\x00\xc5\xb8\xd6\x2a\x92\xbe\xe1\x08\x40\x80\x1a\x59\x83\xc5\x86\xd5\xaf\x67\xda\xc6\xab\x34\x80\x1c\xe3\x8e\x0f\x8a\xc0\x9c\x4a\xb3\x5a\x72\x8b\xcc\xdc\x1a\xae\x65\xaa\xf6\xb0\xd2\xab\x5c\xad\x9c\x6e\x7d\xee\xe7\xfc\xd6\x7e\x51\x13\xee\xb8\x38\x9d\x01\x9c\xe7\x52\xb7\x0e\x8c\x4f\x6c\xbf\x61\xd1\xee\x36\x9a\xa0\xbb\xed\xc7\xd5\x85\x51\x13\x20\xfc\x66\x2d\x3d\x51\x7e\x97\xe6\x4f\xf3\xd1\x9b\x89\x25\x57\x96\x59\x1c\xbb\x6a\x33\xda\x0d\xd1\x3d\x4e\x4c\x76\xc8\x55\x5e\x25\x36\x4e\xbb\xde\xc0\xa5\xd6\x90\xbb\xe4\x08\xbc\x7a\xce\x42\xf2\x8d\x1d\xc9\x78\x34\xac\x70\xd5\xaf\x75\xed\xb2\xf4\xf7\x56\x22\xbb\xdf\x0d\x32\xaa\x36\xad\x8b\x2d\x11\xb1\xce\x76\xef\x68\x29\x2f\xbf\xff\xee\xbb\xed\x25\x7d\x6d\x89\xa0\x2b\xbb\xdf\x2e\xef\x6b\x3b\x1a\x24\x61\xbd\x93\x7a\x4e\x84\x9c\x4b\x50\x1f\x20\xd5\xfc\xce\xd4\x72\x8f\x24\x71\xff\xa4\x70\xdf\x38\x79\x0f\xcf\x9c\x47\x43\x51\xd3\x20\xd4\x8f\x1f\xdc\x55\x65\x08\xd5\x44\x1f\x1d\x6b\x69\x6d\xa1\x15\xb5\x82\x88\x90\xad\x8a\x42\xf7\x56\x08\x22\x42\xb6\x16\x70\xa8\xba\x40\xb4\x6a\x40\xe6\x1d\x44\xc4\x3b\x6b\x00\x2d\xa9\xe9\x43\x1d\xa8\xd3\xa8\x0d\xe2\x7d\x95\x7c\x46\xc3\xb6\xe6\xb4\xad\x36\x89\xa0\x2e\x05\x66\x59\xd5\x9e\x85\x2a\x3c\x44\xcc\xc5\x5a\x3d\x1e\x79\xf9\x5e\xee\xf4\x7b\xeb\xec\x74\xab\xe6\x10\x51\x1b\xf5\x87\x36\xe4\x10\x5b\x86\x77\x25\x9d\xce\x54\x4f\xeb\x62\x11\xee\x0e\x51\x47\xea\x2d\x19\xae\xac\x95\xf3\x7f\xec\x5d\xcd\x6e\xe3\x36\x10\xbe\xeb\x29\x78\x6c\x81\x48\xbd\xe7\xb6\xbb\x41\x81\xa0\xdd\xd6\x58\x7b\xf7\xce\x58\x4c\x2c\x44\x16\x5d\x51\x72\x1a\x14\x7d\xf7\x62\x86\xa4\x44\xd9\x22\x35\x94\xad\x00\x05\x8c\x5c\x02\xdb\x1c\xce\x0c\xff\xe6\x8f\x1f\x63\xf8\x0d\x98\x08\x11\x7c\xb3\x71\x5c\x9c\x53\xcb\x20\x8a\xa2\x83\x86\x73\x3d\x5c\x9b\x30\x9a\x4d\x77\xcc\x47\xd1\x24\x98\x04\xc6\x08\x89\x22\x4b\x31\x0c\x0c\x9a\x4c\x14\xdd\x8b\xcd\x83\xb9\x46\xc2\x3c\x53\xa1\xdf\xb8\x2e\x5a\x93\xdd\x2e\x7a\x18\xbc\x45\x12\x09\x0c\x74\x8e\x08\xe3\xc1\x76\x89\xa2\x0a\x2c\x65\xd7\x45\x74\x09\xe1\xb8\xcc\x45\x64\x99\xb1\x39\xd1\x9d\xd7\x78\xcc\x95\xa8\x3b\x7d\x91\x61\xce\xd8\xea\xae\x38\xac\x0d\x22\xc2\x86\x41\xcc\x48\x22\x80\x13\xe7\x00\x6b\xcc\x4b\xbc\xcc\x8c\xe8\x0c\xa2\xfd\xc6\x79\xb7\x73\x1d\x8b\xda\x49\x24\x99\xf3\x5e\x96\x76\x67\x3f\xcc\x7d\x8f\x5e\x08\x11\x33\xcf\xf0\x24\x09\xa6\xca\x70\x0a\xe9\x76\x7f\xae\x4f\x4a\x0a\xba\x8f\xc9\x07\xe5\xad\x92\xe0\xe3\x2b\x09\x96\x29\xdf\xbf\xfe\x44\xbe\xa5\xa1\x97\x4b\x43\xdb\x71\x53\xee\xbe\x48\xbe\x98\x0f\xe1\x3c\xc8\x44\xcb\x27\xf7\x2d\xc7\x7e\x57\x80\xd7\xcd\xb6\xb5\x40\xd4\x15\x5e\xaa\x8c\xd1\x43\x05\x27\x56\xb7\x31\x9f\xb5\x55\xdf\x47\x0b\x00\x3d\x7f\x7f\x68\xa8\xd3\xee\x96\x5f\xbe\xe5\x97\x3d\xf9\xe5\x8b\xd2\x45\x3f\xba\xc6\x36\x32\xb6\x6b\xf7\xbc\x4a\x61\xa7\x81\x4c\x73\x4c\xc4\x4d\x3e\x9f\x2c\x22\x9b\x26\x30\x7b\x0a\x8b\x19\x42\xb8\x21\x8d\x17\xd9\xdb\xaa\xf8\xab\x15\x26\xe6\xc1\x78\x6f\xc6\x64\xc9\x02\xda\xef\x95\x89\xa6\xd2\x45\x1a\x45\x0a\xa7\xfb\xd4\x56\x1e\xa8\x4a\x95\xcf\x6e\x70\xcd\x28\xa0\xd3\x6e\x66\xa0\x6b\x7b\x85\x24\x33\xb0\xbd\xa1\xee\x1c\x7b\x59\x21\x32\x44\x47\xcb\xee\x53\x44\x9a\x3a\x1e\x00\xf9\x6f\xc5\x78\x59\xca\x37\x3d\x97\x5c\x1b\x08\x66\x88\xda\xca\x03\x75\x15\xd8\xa7\xc4\xf6\x05\x80\x7c\x8b\xfc\x4c\x01\x50\x00\xc0\x1a\x88\x3e\x90\x9d\x62\x74\xa1\xc1\x18\x91\x55\xc6\xd6\xa2\x71\x06\x0b\x7a\xe3\x95\xbe\xc0\x0b\xff\xdb\xea\x79\x2a\xaf\xbb\x0e\xe3\x8b\x3d\x89\x1d\x3f\x16\xb2\xad\x75\x1f\xf0\xce\x8a\xf9\x0a\x6f\x0c\xbe\x4b\xea\xe3\x4e\x36\x1d\xd3\xe2\x25\xbb\x6e\x6c\xd4\x99\x2a\x32\xd6\xcd\x37\xea\xfa\xc2\xf4\x5f\x2e\x6d\x64\x37\x15\x7f\xc3\xdb\xd1\x67\x3a\x8e\x0b\xe4\x9b\xec\xd8\x02\x2b\x33\x62\x4f\x3c\xaa\x03\xd8\x3a\x3f\x88\x17\xc6\x87\x8b\xd7\x6d\x3b\x74\x0d\x8e\x6b\xfc\xea\x72\xc7\xc0\xa0\x67\x69\x94\x08\x7b\x6d\x32\xb9\xae\x01\x70\x73\x0e\xfe\x0f\xce\x81\x71\x5e\x57\xb2\x2c\xb6\xef\x8f\x0f\x33\x86\xcb\x2c\x54\xa6\x49\xb0\xcf\x1c\x90\x2d\xbe\xf2\x8a\xbf\x60\xfc\x8b\xfd\xb4\x5e\x7d\xfe\xfa\x33\x89\x2e\xfa\x9f\x10\xdd\x63\x8f\x0f\xa7\xc5\x1d\xee\xb9\xae\xbb\xfa\x63\x19\x9c\x83\x13\x9d\xcc\x34\x6a\x16\xd1\xca\x42\xc8\x0e\xd6\xe4\xa0\x3f\x1d\x3d\x10\x15\x9a\x99\x92\x1c\x8b\x10\xa9\xe2\xb7\x2a\xf8\x3b\xee\xf3\xd7\xeb\x8b\x17\x53\x8a\xd3\x2b\xe2\x7a\xc7\x01\xad\xff\x14\x8f\xfd\xe4\x0a\x3d\x52\x62\xa0\xc6\xcb\x79\x28\x82\xd7\x99\x08\x6a\x9e\x12\x2e\x65\x87\x1d\xf7\x26\xa5\x26\x25\x0a\xcb\x02\x00\x62\xb2\xf5\x54\x24\x05\x99\x0f\x74\xbc\x2d\x5b\xd5\x8c\x5d\xf3\x1a\x4c\xfa\xc7\xde\x8c\x5b\x95\xbc\x81\xb4\xca\x17\xdd\xd0\x3a\x31\x70\x7f\x0d\x1c\x58\x59\x03\xda\x66\x53\xfb\x6a\x64\x4d\x7f\x06\xa8\x47\x93\x02\x1a\x45\xa5\x1a\x5e\x96\xa6\x22\x21\x89\x10\x0f\x80\x51\x0b\xe8\x6d\xe4\x9c\xf6\x66\x83\x27\xa5\xb3\x44\x8d\x1e\x9e\xac\x37\x01\xe8\x9e\x3e\x84\xd3\xfe\x2d\x55\x06\x6f\x3b\xb0\xad\xa8\x21\xd6\xa1\xe1\x0c\xb3\x24\xde\xc4\x28\xb9\x6a\x36\x35\xc7\x1a\x7d\x59\x6d\x0a\xff\xde\x3c\x90\xe7\x77\xc0\xa2\x84\xd9\x62\xaf\x7f\x18\x51\x9a\x8e\x14\x3c\x9a\x09\x98\x12\xb2\xd2\x22\xb5\x7e\x23\x16\xad\x74\x74\xe8\xb3\x24\x9c\x64\xcb\x79\x23\xd2\x00\xca\x5d\x70\x8a\x5a\x71\xbf\x1f\x80\x0c\x59\x54\xc8\x64\x97\x8e\xb8\x85\x72\xe4\x7d\xe3\x8a\xb5\x48\x2f\x5f\x9c\xf7\xbd\x50\x8a\xbf\xd0\x98\xfe\xa4\x5d\x7e\x66\x5d\x7e\xdb\x98\x15\xba\xfc\x1a\x7c\x8f\x5c\x34\xbc\x28\x95\x86\xe0\xf6\x10\x05\x9f\x42\x38\xa3\x9a\xcd\x65\xbe\x16\x5c\xc9\x8a\xc4\x3b\x28\x5c\xff\xdc\xbd\x5f\xa4\x15\x0e\x68\x3f\x38\x16\x97\x73\xa4\x27\x25\x89\xa3\x35\xfe\xd4\xfa\xee\x1d\x33\x77\x38\xb9\xe5\x33\xdb\xd4\xad\xb8\x63\xbf\x42\x3c\xf7\x8e\x7d\xaf\x5e\x2b\xf9\x36\x9f\xaf\x26\x60\xe4\x0f\xf5\x64\xd0\x7f\x1c\x07\xb8\xe7\x6d\x66\xf7\xa1\xd3\x27\xf5\xaf\x63\xfd\xfa\x6a\x12\x79\x1e\xf9\xcf\x22\x5b\x97\x8d\x42\xcd\xdd\x73\xbf\xb8\x44\xe0\x2d\x57\x96\xa6\x33\x76\xc8\xd0\x78\x4c\x0e\x26\x06\x88\xef\x97\x18\x0b\x8f\xc2\x53\xe6\x7b\x72\x70\xe6\x50\xbc\xf2\xbd\x28\xc7\x8a\x94\xa7\xce\xb7\xdf\x74\x43\x9f\xe2\xc3\x6a\xaf\xc5\x41\x2a\x40\x68\xf1\x0e\x8b\x77\x0e\x44\xf0\xf6\xcd\xf6\xf2\xee\x9f\x1e\x94\x29\xd2\xa1\x81\xaf\x27\x0b\xba\x07\x9c\x6d\xfa\x36\xe6\x41\xd7\xbe\xbe\x11\x4b\xfd\x6d\x3d\xde\x44\x61\x58\x63\xee\x07\x60\xb0\xc8\x6e\x53\xd6\xf4\xb9\xb3\x95\x14\x36\x21\x82\x9c\x42\xb2\x38\x9c\x17\x6f\x64\x07\x21\xb8\x13\xfd\x80\xbc\x27\xfe\x36\x14\x3d\x99\x1c\x7d\xf8\x07\xa7\x7a\xea\x75\x32\xc8\x0b\x19\xa5\xa1\xa1\x91\x31\x8c\x66\x4c\x90\x65\x5d\x35\x56\x91\x5b\x3a\xaf\x62\xf2\xbe\xee\xe4\x3a\x8f\x49\xd0\xc4\xa5\x65\x3e\x3a\xd9\x42\x4f\xb1\x44\x24\x4e\xc8\xfa\xa3\x16\x90\xc4\x95\x8e\x74\x8f\xad\x4c\x50\x65\xf4\x9a\x11\x6a\x7e\x34\xb4\x89\x53\xab\x7d\x82\x3b\xb7\xfd\x6b\xeb\xe2\x3e\xb9\x68\x04\x26\xbb\xf1\x1f\x12\xc1\xc6\xe8\xaf\xde\x27\xc1\x21\x1c\xd9\xa3\x57\xd0\x6c\x6c\x4f\x0e\x88\x62\xe2\x3a\x13\x9d\x6d\x6a\x5e\x34\x2b\x13\x02\x72\xe2\xc1\x50\x9c\xad\x70\x2d\xc2\x0f\x6c\xc5\x62\x2d\xcc\x45\xcd\xb1\x29\x61\x0d\x54\x13\x53\xdc\x5a\x27\x13\x25\xf9\xc5\xb1\xcc\x62\xa4\xb0\x2e\x9e\x9a\x90\x63\x44\x69\xdf\x6c\x53\x3c\xd0\xcc\xc6\xaf\x7a\x37\x18\xcb\xff\x46\x25\xe9\x3a\x8d\x19\x5a\xad\xa8\x73\x36\x79\xae\xad\x50\x5e\xae\x82\x47\xc2\x40\x9c\x4f\x7a\x60\x86\x9c\x1b\x83\xbb\x37\xe4\xcc\xa3\x34\xf0\xcb\x24\xfe\x04\x9a\x30\x2c\x47\xb8\x42\x9e\x06\xa6\xa4\xdf\x4e\x08\x68\x6a\xf4\xb9\x7a\x9c\x79\xf5\x51\xa4\xad\xf6\x19\x52\xbd\xcb\x7a\x1f\xae\x0f\x5b\x84\x03\xd9\x92\x48\xee\x02\x5f\x1e\x01\x8a\x65\x4c\x5b\xde\x29\x3c\x4a\xec\xec\x43\x00\xe6\x16\xb9\x23\xac\x09\x13\xbb\x9f\xb4\x4f\x67\x8b\x41\x35\xbc\x69\xd5\x3d\xfb\xe7\xdf\xe4\xbf\x01\x00\x5c\x4c\x44\xde\xdc\x2d\x05\x00"),
 		},
 		"/crd-integration.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "crd-integration.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 13805,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x3b\x4f\x73\xdb\xb8\xf5\x77\x7e\x8a\x37\xd6\x21\xc9\x8c\x45\x6d\x76\x7f\x87\xdf\xa8\x27\xd5\x89\xa7\x6a\x1c\xdb\x63\x29\xbb\x93\x23\x44\x3e\x91\x58\x83\x00\x0b\x80\x92\xb5\x9d\x7e\xf7\x0e\x1e\x48\x89\x94\x48\x4a\x96\xbd\x6d\x66\x6a\x9e\x2c\x02\x0f\xef\xff\x5f\xc2\x03\x18\xbe\xde\x13\x0c\xe0\x86\x47\x28\x0d\xc6\x60\x15\xd8\x14\x61\x92\xb3\x28\x45\x98\xa9\xa5\x5d\x33\x8d\x70\xad\x0a\x19\x33\xcb\x95\x84\xf7\x93\xd9\xf5\x07\x28\x64\x8c\x1a\x94\x44\x50\x1a\x32\xa5\x31\x18\x40\xa4\xa4\xd5\x7c\x51\x58\xa5\x41\xf8\x03\x81\x25\x1a\x31\x43\x69\x4d\x08\x30\x43\xa4\xd3\x6f\xef\xe6\xd3\xab\xcf\xb0\xe4\x02\x21\xe6\xc6\x03\x61\x0c\x6b\x6e\xd3\x60\x00\x36\xe5\x06\xd6\x4a\x3f\xc2\x52\x69\x60\x71\xcc\x1d\x62\x26\x80\xcb\xa5\xd2\x99\x27\x43\x63\xc2\x74\xcc\x65\x02\x91\xca\x37\x9a\x27\xa9\x05\xb5\x96\xa8\x4d\xca\xf3\x30\x18\xc0\xdc\xb1\x31\xbb\xae\x28\x31\xfe\x58\xc2\x69\x15\x7c\x57\x45\xc9\x43\x8d\xdd\x52\x0a\x97\xf0\x2b\x6a\xe3\x90\xfc\x1c\xfe\x14\x0c\xe0\xbd\xdb\x72\x51\x2e\x5e\x7c\xf8\x0b\x6c\x54\x01\x19\xdb\x80\x54\x16\x0a\x83\xb5\x93\xf1\x29\xc2\xdc\x02\x97\x10\xa9\x2c\x17\x9c\xc9\x08\x77\x6c\x6d\x31\x84\x40\x04\xb8\x33\xd4\xc2\x32\x2e\x81\x11\x1b\xa0\x96\xf5\x6d\xc0\x6c\x30\x08\x06\x40\x4f\x6a\x6d\x3e\x1e\x8d\xd6\xeb\x75\xc8\x88\xdc\x50\xe9\x64\x54\x71\x37\xba\x99\x5e\x7d\xbe\x9d\x7d\x1e\x12\xc9\xc1\x00\xbe\x49\x81\xc6\x80\xc6\x7f\x14\x5c\x63\x0c\x8b\x0d\xb0\x3c\x17\x3c\x62\x0b\x81\x20\xd8\xda\x29\x8e\xb4\x43\x4a\xe7\x12\xd6\x9a\x5b\x2e\x93\x4b\x30\xa5\xd6\x83\x41\x43\x3b\x3b\x71\x55\xe4\x71\xd3\xd8\xa0\x24\x30\x09\x17\x93\x19\x4c\x67\x17\xf0\xd7\xc9\x6c\x3a\xbb\x0c\x06\xf0\xdb\x74\xfe\xb7\xbb\x6f\x73\xf8\x6d\xf2\xf0\x30\xb9\x9d\x4f\x3f\xcf\xe0\xee\x01\xae\xee\x6e\x3f\x4d\xe7\xd3\xbb\xdb\x19\xdc\x5d\xc3\xe4\xf6\x3b\x7c\x99\xde\x7e\xba\x04\xe4\x36\x45\x0d\xf8\x94\x6b\x47\xbf\xd2\xc0\x9d\x20\x31\x76\x3a\xad\x0c\xa8\x22\xc0\xd9\x87\xfb\x6d\x72\x8c\xf8\x92\x47\x20\x98\x4c\x0a\x96\x20\x24\x6a\x85\x5a\x3a\xf3\xc8\x51\x67\xdc\x38\x75\x1a\x60\x32\x0e\x06\x20\x78\xc6\x2d\x59\x91\x39\x64\xca\xa1\x79\x4d\xdf\x0a\x58\xce\x4b\x73\x1a\x03\xcb\x39\x3e\x59\x94\x44\x4d\xf8\xf8\xff\x26\xe4\x6a\xb4\xfa\x18\x3c\x72\x19\x8f\xe1\xaa\x30\x56\x65\x0f\x68\x54\xa1\x23\xfc\x84\x4b\x2e\xc9\xf2\x83\x0c\x2d\x8b\x99\x65\xe3\x00\x40\xb0\x05\x0a\xe3\xfe\x02\xa7\xd0\x31\x5c\x44\x2c\x43\x31\x7c\xbc\x08\x00\x98\x94\xaa\xe4\xcc\xef\x20\x97\x54\x42\xa0\x1e\x26\x28\xc3\xc7\x62\x81\x8b\x82\x8b\x18\x35\x61\xae\xe8\x5a\xfd\x14\xfe\x5f\xf8\x31\x00\x88\x34\x12\xf8\x9c\x67\x68\x2c\xcb\xf2\x31\xc8\x42\x88\x00\x40\xb2\x0c\xc7\xc0\xa5\xc5\x44\x7b\x0c\x21\x21\xae\x19\x63\xe0\xd4\xe0\xf0\x26\x5a\x15\xf9\x18\x0e\xd6\xfd\x29\x15\x69\xcc\x62\xa2\x34\xaf\x7e\x0f\xe1\xd1\xed\x2f\xff\x8e\xb6\x7f\x7b\xd9\x4c\x77\x88\xe9\xad\xe0\xc6\x7e\xd9\x5f\xb9\xe1\xc6\xd2\x6a\x2e\x0a\xcd\x44\x93\x5c\x5a\x30\xa9\xd2\xf6\x76\x47\xc4\x10\xb8\x87\x30\x5c\x26\x85\x60\xba\x01\x13\x00\x98\x48\xe5\x38\x06\x02\xc9\x59\x84\x71\x00\x50\x8a\x8d\x8e\x18\xd6\xe2\xd3\xbd\x76\xb0\xfa\x4a\x89\x22\x93\x5b\x04\x31\x9a\x48\xf3\xdc\x92\xa0\x5d\x50\xaa\x21\x80\x3c\x65\x06\x03\xef\xe0\xbf\x1b\x25\xef\x99\x4d\xc7\x10\x1a\xcb\x6c\x61\xc2\xfa\xaa\x97\xff\x7d\xed\x8d\xdd\x38\xca\x9c\xfb\xc9\xe4\x14\x5c\x8f\x25\xab\x6d\x98\x76\x6b\x1e\xcf\x97\xed\xef\x53\xb0\xc8\x22\x5b\xb8\x6c\xb0\x84\x5c\xc5\xa6\x13\x89\x46\x8a\x3f\xa6\x81\xe9\xa1\xf9\xd2\xa3\x23\xb2\x51\x07\xbb\x6d\xab\x8f\x5e\x4d\x51\x8a\x19\x1b\x97\x9b\x55\x8e\x72\x72\x3f\xfd\xf5\x97\x59\xe3\x35\x34\x29\xac\x19\x88\x8b\x58\xce\xd7\xfd\xfe\x6d\xf8\xa8\x9b\x09\x4c\xee\xa7\xdb\x73\x72\xad\x72\xd4\x76\x6b\xa3\xfe\xa9\x79\x74\xed\xed\x1e\xd6\x77\x8e\xb0\x32\x8d\xc4\xce\x95\xd1\xa3\x2e\x8d\x07\xe3\x92\x17\x1f\xf2\xb9\x8b\xd4\x2e\xe2\xa1\xb4\x3b\x23\xdf\x3d\x6a\xe9\x42\xab\x5a\xfc\x8e\x91\x0d\x61\x86\xda\x1d\xe3\xac\xb9\x10\xb1\x73\xf2\x15\x6a\x0b\x1a\x23\x95\x48\xfe\xc7\xf6\x6c\x53\xa5\x73\xc1\x2c\x1a\xbb\x77\x26\x19\xab\x4b\xab\x2b\x26\x0a\xbc\x74\xc1\x91\x32\x92\x46\x87\x05\x0a\x59\x3b\x8f\xb6\x98\x10\xbe\x2a\x8d\x94\x86\xc7\x94\x8f\xcc\x78\x34\x4a\xb8\xad\x22\x59\xa4\xb2\xac\x90\xdc\x6e\x46\xb5\x52\xc0\x8c\x62\x5c\xa1\x18\x19\x9e\x0c\x99\x8e\x52\x6e\x31\xb2\x85\xc6\x11\xcb\xf9\x90\x48\x97\x3e\x9c\x64\xf1\x40\x97\xb1\xcf\xbc\x6b\xd0\x7a\x60\x83\xfe\xa1\xc8\xd0\xa3\x01\x17\x1f\x9c\xc6\x59\x09\xea\xb9\xd8\x09\xda\xbd\x72\xd2\x79\xf8\x3c\x9b\x43\x85\x9a\x94\xb1\x2f\x7d\x92\xfb\x0e\xd0\xec\x54\xe0\x04\xc6\xe5\x92\x72\x88\x2b\x02\xb4\xca\xe8\x4c\x94\x71\xae\xb8\xb4\xf4\x23\x12\x1c\xe5\xbe\xf8\x4d\xb1\xc8\xb8\xf5\x19\x1a\x8d\x75\xba\x0a\xe1\x8a\x22\x38\x2c\x10\x8a\x3c\x66\x16\xe3\x10\xa6\x12\xae\x5c\x30\xbc\x62\xae\x6e\xf8\x93\x15\xe0\x24\x6d\x86\x4e\xb0\xa7\xa9\xa0\x9e\x99\xf6\x37\x7b\xa9\xd5\x16\xaa\xf4\xd0\xa1\xaf\x9a\x9f\xce\x72\x8c\x1a\x5e\x13\xa3\xa1\x2a\xc6\x45\x12\x74\xde\xb0\x9f\x0f\xaa\xa7\xdd\x63\xc1\xa7\xc2\x25\x4f\x0a\x0f\xb3\xbf\x08\xc0\x2d\x66\x07\x30\x07\x34\x5e\xd5\x0f\x21\x2a\x87\xc3\x16\x98\x6e\x2a\x6a\x02\x6a\x5d\xe9\x94\xf4\xee\x21\x2b\x3e\x13\xba\x2a\x07\xdb\xc0\x87\x04\xdc\xba\x40\x28\x5b\x56\x3a\x14\x5d\x5f\x64\x5a\xb3\xcd\xde\x5a\x8c\x39\xca\x18\x65\xd4\x2a\xa0\x4e\x4d\xf4\xf2\xd6\x8d\x6d\x29\xd4\xfa\x39\x68\x1a\x0a\xbf\x16\x6a\x4d\x31\x44\x42\x21\x8d\xd5\x05\xb9\x4e\x7c\x10\x12\x5c\x2c\x61\xde\x51\x5b\x35\xe3\xcf\x91\xf0\x7d\xf2\xf5\x66\xf4\xf7\xd9\xdd\x2d\x7c\x9a\xdd\x3c\x57\xa2\x00\x4f\x43\x57\xc2\x69\x89\x16\xcd\x90\x50\xeb\x15\x0e\x0b\xf9\x28\xd5\x5a\x0e\x97\x1c\x45\x6c\xc6\x60\x75\x8b\xb6\xba\x05\xf4\xc8\xed\xa1\x1c\x7a\x84\x9d\x6b\xe5\x9a\x9d\x43\x98\x66\x61\xa0\x19\xb7\xf7\x7e\x6b\x2d\x74\x52\xe5\x66\x28\xeb\xb9\x0d\xce\xbd\x99\x05\xd7\x63\xa2\x74\xad\x49\xdc\xc2\xf5\x41\x99\xcf\xa5\xb1\x4c\x08\xf2\xc2\x11\xef\x08\x05\x47\xb8\xa8\xaa\x91\x43\x36\x7c\x93\x49\x55\xc8\x2f\x3f\x77\x1c\x59\xaf\x50\x1a\x67\x2a\xc3\x6d\xad\xaa\xad\x3f\xaf\x6d\xd8\xdb\x6c\x79\xae\x71\x57\xad\xc6\xf9\x81\xcc\x75\xb9\xae\x4f\x6b\x0d\xa9\x75\xfa\x17\x4a\x09\x64\x87\x0a\x82\xb2\x49\x41\xd9\x62\x83\xf5\x03\x7a\x02\x62\x09\xff\x05\x37\x2f\x3d\xe2\x01\x97\x67\x1f\x91\xa9\x42\x5a\x2a\x78\xcf\x3d\x81\x2a\xdd\x73\x81\xfb\x52\x4a\xab\xd2\xe7\x9b\x1c\xdb\x95\x7e\x02\xbe\x33\x03\xbf\x0b\x57\x3c\xc2\x49\x14\x39\x61\xdd\xb6\xf2\xdb\x83\xf9\x85\xe6\x3e\x7b\x33\xf6\xfa\x11\x2f\x31\x76\xea\x1d\x22\xcc\x5d\x79\x79\x92\xd5\x4d\x6b\x00\x14\xed\x55\x5e\x0d\xf5\x62\x97\x3e\x97\xdc\x55\xd3\x2e\xc8\x2b\x9d\x54\x33\x03\x3f\x40\x78\x0c\x1f\x54\x61\xd1\xdc\x28\x16\x1f\x44\xdc\xdd\x53\xd0\x8c\x4f\x41\xae\x71\x94\x2b\x63\x9d\x3a\x23\x34\xa6\xb2\x9a\x0e\xc0\x4e\xd3\x39\x51\x12\x7d\xe6\xee\x9f\x6a\x26\x75\x92\x9c\x6e\xaa\x01\xd6\xb9\x9e\x09\x20\x48\x4e\xa7\x61\xa3\xad\x65\x7d\x53\xd3\x48\x35\x87\x3c\x57\x17\x94\xd3\xd7\x5c\x08\x6f\x28\xb9\x46\xeb\xfb\xa3\xb2\xc7\x62\x16\x74\x21\x2d\xcf\xda\x8a\xca\x93\x98\x7c\x51\xac\x2c\x1d\x7d\x33\xac\x8d\xa3\x8e\x49\x8a\x1b\x4b\x03\x8e\x12\xd4\x4f\xb2\xca\x1e\x25\x76\x95\x1d\x15\x28\x9e\xbd\xf7\x18\x26\x61\xa7\x70\xf8\x92\x28\x74\x52\xbf\xb0\x98\xe5\xae\x39\xbf\xf8\xf0\x5f\x33\xcf\x93\x13\x07\x25\x8c\x7a\x53\xe6\xda\x44\x27\x93\x92\x6b\xbf\x79\xe1\x67\xcd\x2d\x5d\xf4\xee\xe9\x2c\x6f\x4f\x60\xe9\xcc\xcc\xe3\x8b\xcd\x43\x3e\xeb\xd3\xbb\xbe\xe8\xdf\x90\xc4\xc4\x17\xb7\x94\x49\x5c\x30\x65\x5c\x7a\x89\x34\x7a\x4c\x1a\xda\x78\xc4\x67\x25\x9b\xde\x76\xb5\x85\x2a\xa2\xa9\xd1\xa0\x1e\x0b\x22\xbd\x7a\x78\x41\xab\x71\xac\xcf\x6c\xf0\x76\x9e\x8e\x5b\x16\xbb\xa6\x0e\x34\x7c\x3c\x71\xee\x40\x7b\x1b\x46\xae\x16\xc4\xf8\xcb\x46\x0f\x2c\x67\x0b\x2e\x78\xbb\xb6\x5f\xbb\x2d\x88\x94\xf4\x36\x7d\x76\xa1\x54\xe3\xf0\xaa\x3a\x6c\xeb\xde\x5e\x2c\x5b\x69\xb0\x6d\x1b\xd2\x6a\x47\xae\xb7\x83\x08\x35\x7d\xe4\xa2\x89\x58\x5b\x60\x3c\xe6\x0c\x4b\xae\x8d\x9d\xeb\xc2\xa6\x9b\x39\xef\x0e\xfc\xcd\xce\xdd\xc1\x80\xcb\x31\x95\x6b\x96\x8c\x78\x83\x70\x8e\x29\x0d\xbd\xa1\x2f\x60\x9d\xc1\x6a\xae\x0b\xec\x0a\xe6\x55\xa7\x18\x33\x8b\xc3\x97\xa4\x33\xc1\x1c\x7f\x15\x41\x27\xf3\x78\xc3\xda\x59\x6c\xf0\x46\x33\x49\x25\xb1\x64\xbc\x3b\x97\x28\x60\x52\xd9\x14\xf5\x7f\x84\xdd\x6f\x34\xe0\x3c\x99\xd5\x39\x8d\xb1\x77\xec\x72\x53\xe3\x77\xcd\xcc\x76\x60\xfa\x67\xd3\x9e\xa1\x31\xa7\x56\x73\x13\x48\x8b\x8c\x49\xd0\xc8\x62\xfa\xee\x5a\x02\x03\x97\x31\x8f\x18\x4d\x8d\x62\xb4\x8c\x0b\x03\x6c\xa1\x8a\xae\x58\x0c\xa4\xdf\x9d\x56\xbb\x98\x3c\x4a\xbc\x46\x66\x4e\x4d\x27\x29\x96\xdb\xb7\xf3\x97\xad\xc0\xdf\x99\x52\x17\x2f\xa7\xa8\x2d\x3c\x77\x50\x54\x46\xe7\xb2\x36\xdd\x12\x73\xe9\xaf\x21\x2c\xc9\x53\x2f\xe1\x9a\x09\x83\x97\xf0\xcd\x27\xaa\xb3\xe9\x7a\x5e\x59\xa4\x96\x8d\x4f\x6d\x5b\xda\xce\x44\xdf\x9f\x3e\x3b\xfd\xb8\x73\x82\x7b\x66\xd1\xf4\x36\x32\xff\x1f\x1d\x99\xc7\x3c\x41\xf3\xbc\xa1\xf0\x92\x71\x51\xe8\x63\x43\xe1\x6b\xbf\xab\xcd\x04\xfa\x0d\xa0\x2f\x70\x1d\x55\x60\xa4\x56\xa8\x3b\xe6\x25\x6d\xe4\x3d\x94\x10\x5d\xf5\xf3\x31\x5b\x75\x65\x8f\xeb\xec\x3a\xa7\x3c\xfd\x53\xe4\xbd\x43\xbe\xb2\xa7\x57\x39\xa7\x2f\xd5\x9e\x9e\x1f\x4f\x4a\x32\xdd\x1e\x43\x37\x26\x3c\x3d\xfd\xab\x5f\xd9\x53\x8f\xb2\x3b\x3b\x17\xdb\xc9\xe4\x69\x0c\xf6\x32\xd7\xcd\xd8\xb0\x34\xd0\xd6\x05\x6f\x4c\x2d\x4b\xad\x54\xf4\x30\x98\xa0\x44\xed\x8a\x9c\x87\xb7\xc9\x7f\x1d\xfe\x6d\xf2\xff\xc3\x4f\xfe\xb7\xa6\x3b\x7b\x9b\xe1\xff\x20\x66\xfb\x36\xc3\xdf\xdf\xf2\x36\xc3\xaf\xd3\xf8\x36\xc3\x7f\x9b\xe1\xff\x50\x33\x7c\x9e\xb5\x7a\x60\x0f\xb2\xe7\xde\x6c\x11\xcc\xd8\xa9\xe4\x76\x77\xfd\xb9\xbf\x9d\x99\xa7\x48\x45\x1c\xed\x3d\xbc\x58\xb8\x9b\x57\xad\x53\x94\xde\x7b\xfa\x6e\xab\x00\x0d\xb2\xe8\xd6\x37\x13\xfc\x8f\xb6\x61\xd6\xf1\x2a\xb6\xef\xe2\x4e\xca\xcc\xb1\x0e\xad\x36\xfb\xa5\xcb\xc6\x6d\x41\xac\x0f\x85\x60\xd6\xd1\xf8\x76\xa1\xa8\x89\xee\xdc\x0b\x45\x3e\xc2\xde\x6b\xb5\xe2\xad\x09\xa1\x59\xe0\x35\x77\x3f\x53\x75\x25\xae\xd6\xab\xcd\x47\x40\x0d\x0a\x8c\xac\x6a\xa1\xaf\x07\x68\xf5\x6c\x44\xad\x71\xe3\xe0\xa5\xff\x5e\x53\xfb\x34\x65\xac\xd2\x2e\x72\xd4\xde\x14\x8b\x83\xcb\x53\x26\x62\x75\x13\xa4\xff\xb3\x98\x95\x7c\x35\xef\xb1\x57\xdc\x6e\xf7\x3a\x33\xaa\xee\xb1\x57\x5b\x73\x8c\xf6\x2f\xbc\x57\xc3\xce\xfd\xad\xad\xb7\xe3\xcb\xb9\x28\xfc\xf3\x5f\xc1\xbf\x03\x00\x00\xff\xff\x60\xa6\x42\xf4\xed\x35\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x1b\x4d\x6f\xdb\xc8\xf5\xce\x5f\xf1\x60\x1d\x36\x01\x2c\x6a\x3f\x7a\x28\xd4\x93\xea\xc4\xa8\x1a\xc7\x36\x4c\x65\x17\x39\x8e\xc8\x47\x6a\xd6\xc3\x19\x76\x3e\x2c\x6b\x8b\xfe\xf7\xe2\x0d\x49\x89\xb4\x48\x5a\x92\x93\x2d\x0a\x48\xca\xc1\xe2\xbc\x79\xdf\x5f\xf3\x38\x19\xc1\xf8\xdb\x7d\x82\x11\xdc\xf0\x18\xa5\xc1\x04\xac\x02\xbb\x42\x98\x15\x2c\x5e\x21\x44\x2a\xb5\x6b\xa6\x11\xae\x95\x93\x09\xb3\x5c\x49\x78\x37\x8b\xae\xdf\x83\x93\x09\x6a\x50\x12\x41\x69\xc8\x95\xc6\x60\x04\xb1\x92\x56\xf3\xa5\xb3\x4a\x83\x28\x11\x02\xcb\x34\x62\x8e\xd2\x9a\x10\x20\x42\xf4\xd8\x6f\xef\x16\xf3\xab\x8f\x90\x72\x81\x90\x70\x53\x6e\xc2\x04\xd6\xdc\xae\x82\x11\xd8\x15\x37\xb0\x56\xfa\x11\x52\xa5\x81\x25\x09\x27\xc2\x4c\x00\x97\xa9\xd2\x79\xc9\x86\xc6\x8c\xe9\x84\xcb\x0c\x62\x55\x6c\x34\xcf\x56\x16\xd4\x5a\xa2\x36\x2b\x5e\x84\xc1\x08\x16\x24\x46\x74\x5d\x73\x62\x4a\xb4\x9e\xa6\x55\xf0\x55\xb9\x4a\x86\x86\xb8\x95\x16\x2e\xe1\x57\xd4\x86\x88\xfc\x1c\xfe\x18\x8c\xe0\x1d\x81\x5c\x54\x8b\x17\xef\xff\x06\x1b\xe5\x20\x67\x1b\x90\xca\x82\x33\xd8\xc0\x8c\xcf\x31\x16\x16\xb8\x84\x58\xe5\x85\xe0\x4c\xc6\xb8\x13\x6b\x4b\x21\x04\xcf\x00\xe1\x50\x4b\xcb\xb8\x04\xe6\xc5\x00\x95\x36\xc1\x80\xd9\x60\x14\x8c\xc0\x7f\x56\xd6\x16\xd3\xc9\x64\xbd\x5e\x87\xcc\x5b\x27\x54\x3a\x9b\xd4\xd2\x4d\x6e\xe6\x57\x1f\x6f\xa3\x8f\x63\xcf\x72\x30\x82\x2f\x52\xa0\x31\xa0\xf1\x5f\x8e\x6b\x4c\x60\xb9\x01\x56\x14\x82\xc7\x6c\x29\x10\x04\x5b\x93\xe1\xbc\x75\xbc\xd1\xb9\x84\xb5\xe6\x96\xcb\xec\x12\x4c\x65\xf5\x60\xd4\xb2\xce\x4e\x5d\x35\x7b\xdc\xb4\x00\x94\x04\x26\xe1\x62\x16\xc1\x3c\xba\x80\xbf\xcf\xa2\x79\x74\x19\x8c\xe0\xb7\xf9\xe2\x1f\x77\x5f\x16\xf0\xdb\xec\xe1\x61\x76\xbb\x98\x7f\x8c\xe0\xee\x01\xae\xee\x6e\x3f\xcc\x17\xf3\xbb\xdb\x08\xee\xae\x61\x76\xfb\x15\x3e\xcd\x6f\x3f\x5c\x02\x72\xbb\x42\x0d\xf8\x5c\x68\xe2\x5f\x69\xe0\xa4\x48\x4c\xc8\xa6\xb5\x03\xd5\x0c\x90\x7f\xd0\x6f\x53\x60\xcc\x53\x1e\x83\x60\x32\x73\x2c\x43\xc8\xd4\x13\x6a\x49\xee\x51\xa0\xce\xb9\x21\x73\x1a\x60\x32\x09\x46\x20\x78\xce\xad\xf7\x22\xb3\x2f\x14\x91\xa9\x03\xe3\x1b\x7c\x82\x80\x15\xbc\x72\xa7\x29\xb0\x82\xe3\xb3\x45\xe9\xb9\x09\x1f\xff\x6a\x42\xae\x26\x4f\x3f\x05\x8f\x5c\x26\x53\xb8\x72\xc6\xaa\xfc\x01\x8d\x72\x3a\xc6\x0f\x98\x72\xe9\x3d\x3f\xc8\xd1\xb2\x84\x59\x36\x0d\x00\x04\x5b\xa2\x30\xf4\x17\x90\x41\xa7\x70\x11\xb3\x1c\xc5\xf8\xf1\x22\x00\x60\x52\xaa\x4a\xb2\x12\xc2\x87\xa4\x12\x02\xf5\x38\x43\x19\x3e\xba\x25\x2e\x1d\x17\x09\x6a\x4f\xb9\xe6\xeb\xe9\xc7\xf0\x2f\xe1\x4f\x01\x40\xac\xd1\x6f\x5f\xf0\x1c\x8d\x65\x79\x31\x05\xe9\x84\x08\x00\x24\xcb\x71\x0a\x5c\x5a\xcc\xb4\x07\x31\xa1\x27\xdc\x70\xc6\x80\xcc\x40\x74\x33\xad\x5c\x31\x85\xbd\xf5\x12\x4b\xc5\x7c\xcc\x2c\x66\x4a\xf3\xfa\xf7\x18\x1e\x09\xbe\xfa\x3b\xde\xfe\x5d\xea\x66\xbe\x23\xec\x21\x04\x37\xf6\xd3\xcb\x95\x1b\x6e\xac\x5f\x2d\x84\xd3\x4c\xb4\xd9\xf5\x0b\x66\xa5\xb4\xbd\xdd\x31\x31\x06\x5e\xee\x30\x5c\x66\x4e\x30\xdd\xda\x13\x00\x98\x58\x15\x38\x05\xbf\xa5\x60\x31\x26\x01\x40\xa5\x36\xcf\xf7\xb8\x91\x9f\xee\x35\xed\xd5\x57\x4a\xb8\xbc\x36\xc0\x18\x12\x34\xb1\xe6\x05\xf1\x37\xf5\x49\xa9\x41\x00\x8a\x15\x33\xe8\x19\x00\xf8\xdd\x28\x79\xcf\xec\x6a\x0a\xa1\xb1\xcc\x3a\x13\x36\x57\x49\x73\x53\xb8\x6f\x3c\xb1\x1b\xe2\x8c\xb2\xa7\xcc\x0e\xa1\xf5\xc8\x6d\x2f\xa5\xdd\x5a\x49\xe7\x13\xb7\x47\x50\x91\x2e\x5f\x52\x35\x48\xa1\x50\x89\xe9\x25\xa2\xd1\xe7\x9f\x1a\xa0\xa4\xf4\xd0\x7e\x58\x0a\xe5\xd9\x46\x1d\xec\xc0\x9e\xc8\x3b\xc9\x1c\x2b\xcc\x7d\x1c\xd0\x2f\x55\xa0\x9c\xdd\xcf\x7f\xfd\x25\x6a\x3d\x86\x36\x87\x0d\x07\x01\x4e\x65\x00\xa1\x84\xdf\xa6\x8f\x86\x96\x0c\xcc\xee\xe7\x5b\x3c\x85\x56\x05\x6a\xbb\xf5\xd1\xf2\x5f\x23\xa2\x1b\x4f\x5f\x50\xfd\x81\x18\xab\xca\x48\x42\xa1\x8c\x25\xe9\xca\x79\x30\xa9\x64\x21\xad\xf9\xfa\xa1\x91\x32\x1e\x4a\xbb\x73\xf2\xdd\x57\xa5\x94\x5a\xd5\xf2\x77\x8c\x6d\x08\x11\x6a\x42\x03\x66\xa5\x9c\x48\xa8\xee\x3e\xa1\xb6\xa0\x31\x56\x99\xe4\x7f\x6c\x71\x9b\xba\x9c\x0b\x66\xb1\x0a\x8d\xdd\x97\xa4\xd6\x92\x09\x78\x62\xc2\xe1\x25\x25\x47\x5f\xd5\x34\x12\x15\x70\xb2\x81\xcf\x83\x98\x10\x3e\x2b\x4d\x4e\x95\xaa\xa9\xaf\x47\x66\x3a\x99\x64\xdc\xd6\x99\x2c\x56\x79\xee\x24\xb7\x9b\x49\xa3\x15\x30\x93\x04\x9f\x50\x4c\x0c\xcf\xc6\x4c\xc7\x2b\x6e\x31\xb6\x4e\xe3\x84\x15\x7c\xec\x59\x97\x24\xb0\x09\xf3\x64\xa4\xab\xdc\x67\x7e\x68\xf1\xba\xe7\x83\xe5\x3f\x9f\x19\x06\x2c\x40\xf9\x81\x2c\xce\xaa\xad\xa5\xa0\x3b\x45\xd3\x23\xd2\xce\xc3\xc7\x68\x01\x35\x69\x5f\xcc\x5b\x48\xa1\xd2\xfb\x6e\xa3\xd9\x99\x80\x14\xc6\x65\xea\x6b\x08\x35\x01\x5a\xe5\xde\xcc\x28\x93\x42\x71\x69\xfd\x8f\x58\x70\x94\x2f\xd5\x6f\xdc\x32\xe7\xb6\xac\xd0\x68\x2c\xd9\x2a\x84\x2b\x9f\xc1\x61\x89\xe0\x8a\x84\x59\x4c\x42\x98\x4b\xb8\xa2\x64\x78\xc5\x0c\x7e\x77\x03\x90\xa6\xcd\x98\x14\x7b\x98\x09\x9a\x95\x69\xf7\x21\x2c\xd3\x4a\x6b\x8d\x85\xba\x3c\xf4\xd8\xab\x11\xa7\x51\x81\x71\x2b\x6a\x12\x34\xbe\x8b\xa1\x4c\x82\x14\x32\x0d\xe0\x16\xc6\xee\x88\xad\x4a\x61\xca\x33\x57\x56\x8a\x97\x8b\x00\xdc\x62\xbe\xb7\x67\x8f\xc7\xab\x26\x12\xcf\xe5\x78\xdc\xb1\xa7\x9f\x8b\x86\x82\x3a\x57\x7a\x35\xbd\xfb\x7a\x2f\x3e\x71\x77\xdd\x0e\x76\x6d\x1f\x7b\xd2\x9d\x0b\x9e\x64\xc7\x4a\x8f\xa1\x9b\x8b\x4c\x6b\xb6\x79\xb1\x96\x60\x81\x32\x41\x19\x77\x2a\xa8\xd7\x12\x83\xb2\xf5\x53\x4b\x85\x5a\x9b\x53\x0d\x7e\x2d\xd4\xda\xe7\x10\x09\x4e\x1a\xab\x9d\x0f\x9d\x64\x2f\x25\x50\x2e\x61\x65\xa0\x76\xa0\x84\x0a\x8f\x84\xaf\xb3\xcf\x37\x93\x7f\x46\x77\xb7\xf0\x21\xba\x39\x56\xa3\x00\xcf\x63\x6a\xe1\xb4\x44\x8b\x66\xec\x49\xeb\x27\x1c\x3b\xf9\x28\xd5\x5a\x8e\x53\x8e\x22\x31\x53\xb0\xda\xe1\x11\x0a\x7a\xe4\x76\x1a\x1c\xa1\xec\x42\x2b\x3a\x46\x4d\x83\x41\xcd\x2d\x34\xe3\xf6\xbe\x04\x6d\xa4\x4e\xdf\xb9\x19\x0a\x61\x4b\x00\x14\xde\xcc\x02\x9d\x31\x51\xd2\xd1\x24\xd9\xc3\x0a\xfb\x6d\x3e\x97\xc6\x32\x21\x7c\x28\x4f\x1a\xd5\xfb\x18\x29\xea\x6e\x64\x5f\x8c\xf2\x90\xe9\xbb\x90\x5f\x7e\xde\x5b\xdd\xef\x50\x76\x1f\x8d\x85\x32\xdc\x36\xba\xda\x83\x3c\x6e\x80\xcb\x21\xbb\x6d\xab\xe5\xa9\xce\x5d\x1f\x35\x4e\x4f\x64\x74\xca\xa5\x73\x5a\x67\x4a\x6d\xf2\xbf\x54\x4a\x20\x93\x3d\x48\xa4\x45\x69\x87\x11\xf4\x68\xa7\xb1\xff\x13\x6e\xde\x8a\xe2\x01\xd3\x93\x51\xe4\xca\x49\xeb\xfb\xf7\x53\x31\xf8\x86\xf8\xd4\xcd\x43\x25\xa5\xd3\xe8\x8b\x4d\x81\xdd\x46\x3f\x80\xde\x89\x89\xdf\xa0\x7e\xe2\x31\xce\xe2\x98\x94\x75\xdb\x29\xef\x00\xe5\x37\xba\x7b\x74\x76\xf6\x6f\xe5\xec\x94\xfe\x34\x8d\xba\x94\x36\x07\x79\xdd\xbc\xb1\xc1\x67\x7b\x55\xd4\x43\xbd\x84\xca\x67\xca\xa9\x9b\xa6\x56\x4f\xe9\xac\x9e\x19\xf8\x21\x40\xf8\x18\x3e\x28\x67\xd1\xdc\x28\x96\xa0\xee\x21\x06\x34\x88\xa3\x1e\x1a\x0a\x8d\x93\x42\x19\x4b\xe6\x8c\x69\x84\x54\x79\x4d\xcf\xc6\x5e\xd7\x39\x50\x13\x43\xee\x5e\x7e\xeb\x99\xd4\x41\x7a\xba\xa9\x07\x58\xa7\x46\x26\x80\xf0\x7a\x3a\x8c\x9a\x07\xad\xfa\x9b\x86\x45\x40\xa5\x6f\xb2\x85\xaf\xe9\x6b\x2e\x68\x64\x6b\x51\x17\x1a\xe9\x20\xc4\x6b\x5b\x00\xb3\xa0\x9d\xb4\x3c\xc7\xa0\x07\xc1\x77\xcd\x95\x55\xa0\x6f\xc6\x8d\x71\xd4\x6b\x9a\xe2\xc6\x92\x56\xea\xad\x7e\x2c\x61\xaa\x33\x4a\x42\x9d\x1d\xe9\xab\x12\xef\x1d\x86\x59\xd8\x83\x15\x80\xa7\xde\x86\xa4\xf5\x0b\x8b\x79\x41\x87\xf3\x8b\xf7\xff\x33\xf7\x3c\xb8\x70\xf8\x82\xd1\x3c\x94\xd1\x31\x91\x74\x52\x49\x5d\x02\x2f\xcb\x59\x73\xc7\x29\x7a\xf7\xed\x6d\x6f\x0f\x10\xe9\xc4\xca\x53\x36\x9b\xfb\x72\x36\xa7\x77\x43\xd9\xbf\xa5\x89\x59\xd9\xdc\xfa\xb6\x89\xf2\x31\xe3\xb2\xd4\x48\xeb\x8c\x49\xaa\x61\x25\xe1\xa0\xd7\x07\x07\x8a\x4d\x03\xd5\x61\xf6\x21\x4a\xad\x03\xea\x6b\x49\x64\xd0\x0e\x6f\x38\x6a\xbc\x76\xce\x6c\xc9\x16\x1c\xc9\x5d\xef\x62\xcf\x02\x8d\x0c\xdc\x0b\x25\xf7\xce\x1d\x3c\x6c\x6b\xf2\xa0\x96\x5e\xf0\xb7\x8d\x1e\x58\xc1\x96\x5c\xf0\x6e\x6b\xf7\x86\xf8\x60\x24\xf4\xbb\x7a\xac\x64\xe9\xd3\xc7\xd0\xea\xd3\xc8\x55\x8d\xac\x82\x58\x56\x6a\xd9\x6a\x83\x6d\x8f\x21\x1d\x58\x81\x32\x3d\x83\x18\xb5\x7f\xc9\xe5\x27\x62\xe1\x09\xc1\x90\x72\x6d\xec\x42\x3b\xbb\xda\xd0\x9b\x89\x6e\xa0\x17\x42\x5c\xd3\x1e\xa0\x1a\x53\x87\x66\x25\x08\xb1\xee\x0c\x05\xa6\x34\xfe\x89\x7f\xed\xd9\x83\x12\x60\xa1\x1d\xf6\x25\xf3\xfa\xa4\x48\xa3\xba\xf1\x5b\xca\x99\x60\x24\x5f\xcd\xd0\xc1\x32\xde\xb0\x6e\x11\x5b\xb2\xf9\x99\x24\xbd\xa9\x2d\x05\xef\xc1\x0b\xd4\x3b\x31\xa9\xe8\xed\xdb\x9f\x22\xee\x17\x3f\xe0\x3c\x58\x54\x7a\x9b\x21\x1a\xe2\x72\xd3\x90\x77\xcd\xcc\x76\x60\xfa\xbd\x79\xcf\xd1\x98\x43\xbb\xb9\x19\xac\x5c\xce\xe8\x65\x35\x4b\x68\xb8\x51\x6f\x06\x2e\x13\x1e\x33\x7a\xdd\x0a\x09\x5a\xc6\x85\x01\xb6\x54\xce\x06\x1d\x18\xab\x8e\x0a\x1b\x56\x0d\x4f\x65\x5e\x23\x33\x87\x96\x93\x15\x12\xdf\x46\xc9\xed\xfc\x65\xab\xf0\x1f\x4c\x65\x8b\xb7\x73\xd4\x95\x9e\x7b\x38\x8a\x3c\x68\xdd\x9b\x6e\x99\xb9\x2c\xaf\x21\xa4\x3e\x52\x2f\xe1\x9a\x09\x83\x97\xf0\xa5\x9c\x89\x85\xdf\xfd\x3c\xed\xdb\x22\x95\xb6\x5e\xb5\x6d\x79\x0b\xbf\xc7\x98\xb6\x37\x8e\x7b\x27\xb8\xbd\x35\xf3\xd5\x4a\xb2\xab\xd3\xa7\x16\x93\xf3\xc8\xfc\xff\x6f\x64\x9e\xf0\x0c\x4d\xc7\x8c\x62\x00\x5d\xca\xb8\x70\xba\x43\xc9\x2d\x67\xb8\x2e\xa1\xba\x5c\x60\xd8\x01\x86\x12\xd7\xa0\x90\xb4\x35\xa6\xcb\x20\x3d\xf3\x92\x2e\xf6\x1e\xaa\x1d\xdd\x9e\xfa\xba\xaf\x52\xdb\x43\x27\xbb\xde\x29\xcf\xf0\x14\xf9\x05\x92\xcf\xec\xf9\x9b\xe0\x19\x2a\xb5\x87\xd7\xc7\x57\xd5\x3d\x1c\x31\x14\x33\x15\x3f\xc3\xab\x9f\xd9\x73\x27\xc0\x60\xfc\x00\xd8\x5e\x21\x0f\x13\x70\x50\xb8\x7e\xc1\xc6\x95\x83\x76\x2e\x94\xce\xd4\xb1\xd4\xc9\xc5\x80\x80\x19\x4a\xd4\xd4\xe4\xd4\x33\xdc\x63\x52\x41\xe7\x10\xf8\x3c\xf9\x3f\x4f\xfe\xff\x94\xc9\xff\xd6\x75\xa3\xf3\x0c\xff\x3c\xc3\x3f\xcf\xf0\xcf\x33\xfc\xf3\x0c\xff\x3c\xc3\x3f\x6e\x86\xcf\xf3\xce\x08\x1c\x20\x76\xec\xcd\x16\x1a\x6a\xcc\x25\xb7\xbb\xeb\xcf\xc1\xb0\x3a\x57\xe8\x3b\x4e\x0f\xdb\xbe\x0c\x64\x5b\xf3\xaa\xf5\x0a\xe9\x0d\x11\x37\xcd\x31\xc1\x1e\x6e\xf0\x83\x2c\x7f\xeb\x9b\x09\xfe\x47\xd7\x30\xeb\xf5\x2e\x76\x40\x3c\x7f\x9f\x78\x1a\x1c\x3a\xfb\xf5\x97\x8d\xbb\x92\xd8\x10\x09\xc1\x2c\xf1\x38\x3d\x6a\xd3\xf9\x42\x51\xdf\x85\xa2\xf2\x2d\xe9\xbd\x56\x4f\xbc\xb3\x20\xb4\x34\xf4\xd0\x86\x3e\xd2\x74\x15\xad\xce\xab\xcd\xaf\x6c\x35\x28\x30\xb6\x4a\x1f\xb5\xe9\xe9\x68\x42\x9d\x79\x63\xef\x61\xf9\xbe\xa6\xf1\x6a\xca\x58\xa5\x29\x73\x34\x9e\xb8\xe5\xde\xe5\x29\x13\xb3\xa6\x0b\xfa\xff\x67\x11\x55\x72\xb5\xef\xb1\xd7\xd2\x6e\x61\xc9\x8d\xea\x7b\xec\x35\x68\x81\x71\x58\x7b\xc4\x0e\xd0\x23\x78\x09\xda\x79\x3b\xde\x58\x66\x9d\x99\xc2\xbf\xff\x13\xfc\x77\x00\x60\xa6\x42\xf4\xed\x35\x00\x00"),
 		},
 		"/crd-kamelet-binding.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "crd-kamelet-binding.yaml",
 			modTime:          time.Time{},
-			uncompressedSize: 35967,
+			uncompressedSize: 66731,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x7d\x6b\x6f\xdb\xb8\xd2\xf0\x77\xff\x8a\x41\xb2\x40\x53\x20\xf2\x25\x4e\xba\xad\xdf\x0f\x45\x36\x69\xf6\xf5\xd9\x6e\x12\xc4\xc9\x39\xd8\x27\xed\x01\x68\x69\x6c\x73\x43\x91\x3a\x24\x65\xc7\xcf\x36\xff\xfd\x01\x49\xc9\x96\x2f\x92\xe5\x38\xe9\xe9\x02\x22\x50\xd4\x92\xa8\xe1\xdc\x39\x1c\x72\xa2\x7d\xf0\x5e\xae\xd5\xf6\xe1\x33\xf5\x91\x2b\x0c\x40\x0b\xd0\x23\x84\xd3\x88\xf8\x23\x84\x9e\x18\xe8\x09\x91\x08\x17\x22\xe6\x01\xd1\x54\x70\x38\x38\xed\x5d\xbc\x85\x98\x07\x28\x41\x70\x04\x21\x21\x14\x12\x6b\xfb\xe0\x0b\xae\x25\xed\xc7\x5a\x48\x60\x0e\x20\x90\xa1\x44\x0c\x91\x6b\x55\x07\xe8\x21\x5a\xe8\x97\x57\xb7\xdd\xb3\x4f\x30\xa0\x0c\x21\xa0\xca\xbd\x84\x01\x4c\xa8\x1e\xd5\xf6\x41\x8f\xa8\x82\x89\x90\x0f\x30\x10\x12\x48\x10\x50\x33\x30\x61\x40\xf9\x40\xc8\xd0\xa1\x21\x71\x48\x64\x40\xf9\x10\x7c\x11\x4d\x25\x1d\x8e\x34\x88\x09\x47\xa9\x46\x34\xaa\xd7\xf6\xe1\xd6\x90\xd1\xbb\x48\x31\x51\x0e\xac\x1d\x53\x0b\xf8\x43\xc4\x09\x0d\x19\x72\x13\x2e\x1c\xc2\x3f\x51\x2a\x33\xc8\x51\xbd\x59\xdb\x87\x03\xd3\x65\x2f\x79\xb8\xf7\xf6\xff\xc1\x54\xc4\x10\x92\x29\x70\xa1\x21\x56\x98\x81\x8c\x8f\x3e\x46\x1a\x28\x07\x5f\x84\x11\xa3\x84\xfb\x38\x27\x6b\x36\x42\x1d\x2c\x02\x06\x86\xe8\x6b\x42\x39\x10\x4b\x06\x88\x41\xb6\x1b\x10\x5d\xdb\xaf\xed\x83\x6d\x23\xad\xa3\x4e\xa3\x31\x99\x4c\xea\xc4\xa2\x5b\x17\x72\xd8\x48\xa9\x6b\x7c\xee\x9e\x7d\xba\xec\x7d\xf2\x2c\xca\xb5\x7d\xb8\xe3\x0c\x95\x02\x89\xff\x89\xa9\xc4\x00\xfa\x53\x20\x51\xc4\xa8\x4f\xfa\x0c\x81\x91\x89\x11\x9c\x95\x8e\x15\x3a\xe5\x30\x91\x54\x53\x3e\x3c\x04\x95\x48\xbd\xb6\xbf\x20\x9d\x39\xbb\x52\xf4\xa8\x5a\xe8\x20\x38\x10\x0e\x7b\xa7\x3d\xe8\xf6\xf6\xe0\x97\xd3\x5e\xb7\x77\x58\xdb\x87\x7f\x75\x6f\xff\xff\xd5\xdd\x2d\xfc\xeb\xf4\xe6\xe6\xf4\xf2\xb6\xfb\xa9\x07\x57\x37\x70\x76\x75\x79\xde\xbd\xed\x5e\x5d\xf6\xe0\xea\x02\x4e\x2f\xff\x80\xdf\xba\x97\xe7\x87\x80\x54\x8f\x50\x02\x3e\x46\xd2\xe0\x2f\x24\x50\xc3\x48\x0c\x8c\x4c\x53\x05\x4a\x11\x30\xfa\x61\xae\x55\x84\x3e\x1d\x50\x1f\x18\xe1\xc3\x98\x0c\x11\x86\x62\x8c\x92\x1b\xf5\x88\x50\x86\x54\x19\x71\x2a\x20\x3c\xa8\xed\x03\xa3\x21\xd5\x56\x8b\xd4\x2a\x51\x66\x98\x97\xb4\xad\x1a\x89\x68\xa2\x4e\x1d\x20\x11\xc5\x47\x8d\xdc\x62\x53\x7f\x78\xaf\xea\x54\x34\xc6\xad\xda\x03\xe5\x41\x07\xce\x62\xa5\x45\x78\x83\x4a\xc4\xd2\xc7\x73\x1c\x50\x6e\x35\xbf\x16\xa2\x26\x01\xd1\xa4\x53\x03\x60\xa4\x8f\x4c\x99\x5f\x60\x04\xda\x81\x3d\x9f\x84\xc8\xbc\x87\xbd\x1a\x00\xe1\x5c\x24\x94\xb9\x1e\xd6\x24\x05\x63\x28\xbd\x21\xf2\xfa\x43\xdc\xc7\x7e\x4c\x59\x80\xd2\x8e\x9c\xe2\x35\x6e\xd6\x8f\xeb\xad\x1a\x80\x2f\xd1\xbe\x7e\x4b\x43\x54\x9a\x84\x51\x07\x78\xcc\x58\x0d\x80\x93\x10\x3b\xf0\x60\xc6\x42\xdd\xa7\xdc\x98\x9e\xaa\xdb\xb1\x33\xfa\x58\x33\x92\x30\x43\x0f\xa5\x88\xa3\x0e\xac\x3c\x77\x80\x52\xec\x88\xc6\xa1\x90\x34\xbd\xf6\x1c\xfc\xe4\xb7\x3f\xfb\xed\xd8\xf3\x9b\x1b\xfb\x17\x37\xb6\x7d\xc0\xa8\xd2\xbf\xad\x79\xf8\x99\x2a\x6d\x3b\x44\x2c\x96\x84\xad\xe0\x6d\x9f\xa9\x91\x90\xfa\x72\x8e\x8d\x07\x0f\xac\xef\x9e\x50\x3e\x8c\x19\x91\xcb\xef\xd5\x00\x94\x2f\x22\xec\x80\x7d\x2d\x22\x3e\x06\x35\x80\x84\x8d\x16\x8c\x97\xf1\x57\xd7\x92\x72\x8d\xf2\x4c\xb0\x38\xe4\xb3\x41\x02\x54\xbe\xa4\x91\xb6\x8c\x37\x4e\x2a\x41\x1d\x12\xdc\x21\x1a\x11\x85\x35\x67\xf4\x7f\x2a\xc1\xaf\x89\x1e\x75\xa0\xae\x34\xd1\xb1\xaa\x67\x9f\x3a\x99\x5c\x67\xee\xe8\xa9\xc1\xce\x98\x64\xc2\x21\xd7\x65\xdc\x22\x2c\x1a\x91\x96\xa3\xce\x1f\x61\x48\x3a\xc9\x1b\x22\x42\x7e\x7a\xdd\xfd\x67\xbb\xb7\x70\x1b\x16\xf1\x5c\x64\xaf\x31\x7c\x63\x32\xee\x95\x99\x15\x26\xcc\x52\x90\xb0\x0b\x4e\xaf\xbb\x33\x70\x91\x14\x11\x4a\x3d\x93\xb5\x6b\x19\xe3\xc8\xdc\x5d\x1a\xfc\x8d\xc1\x2f\xf1\xc8\x81\xb1\x0a\x74\xc3\x27\x7c\xc7\x20\x21\xc9\x79\x4f\x6a\x9c\x9e\x71\x1e\xc8\x9d\x29\x2c\x00\x06\xd3\x89\x70\x10\xfd\x3f\xd1\xd7\x75\xe8\xa1\x34\x60\x8c\x32\xc4\x2c\x30\xf6\x32\x46\xa9\x41\xa2\x2f\x86\x9c\xfe\xef\x0c\xb6\x4a\x67\x46\x46\x34\x26\xca\x35\x6f\x56\xce\x66\x86\x1a\x13\x16\xe3\xa1\xf1\x33\xd6\xb9\x4b\x34\xa3\x40\xcc\x33\xf0\x6c\x17\x55\x87\xdf\x85\x44\x3b\xa3\x75\xac\x6b\x57\x9d\x46\x63\x48\x75\xea\x14\x7c\x11\x86\x31\xa7\x7a\xda\xc8\xcc\xaa\xaa\x11\xe0\x18\x59\x43\xd1\xa1\x47\xa4\x3f\xa2\x1a\x7d\x1d\x4b\x6c\x90\x88\x7a\x16\x75\x6e\x6d\xbf\x1e\x06\xfb\x32\x71\x23\xea\xcd\x02\xae\x2b\x0a\xe2\x9a\xb5\xb0\x02\x09\x18\x23\x33\x52\x27\xc9\xab\x8e\x8a\x39\xa3\xcd\x2d\xc3\x9d\x9b\x4f\xbd\x5b\x48\x87\xb6\xc2\x58\xe6\xbe\xe5\xfb\xfc\x45\x35\x17\x81\x61\x18\xe5\x03\xeb\x8e\xcd\x7c\x2a\x45\x68\x61\x22\x0f\x22\x41\xb9\xb6\x17\x3e\xa3\xc8\x97\xd9\xaf\xe2\x7e\x48\xb5\x9b\xec\x50\x69\x23\xab\x3a\x9c\x59\x67\x08\x7d\x84\x38\x0a\x88\xc6\xa0\x0e\x5d\x0e\x67\x46\x47\xcf\x88\x99\x82\x5f\x59\x00\x86\xd3\xca\x33\x8c\x2d\x27\x82\xac\x93\x5f\xee\xec\xb8\x96\x79\x90\xba\xd9\x1c\x79\x2d\x9a\x6b\x2f\x42\x1f\x3c\x6f\xa1\xfb\x7a\x73\x4c\x75\x79\x28\xad\xe5\x2c\x3f\x5a\x1a\xa5\x3b\xef\x69\x75\x83\x83\x88\x66\x91\xda\xfc\x51\x9c\xc4\x95\x6e\x92\x9e\xae\xc0\x04\xf0\xed\xc4\x07\x11\x91\x24\x44\x8d\x72\x59\x69\x8a\xd0\x05\x37\xcb\x0d\xe8\x30\xce\x43\xda\x52\xa5\x31\x5c\xfb\xee\x0a\x55\x67\x59\x60\xeb\x58\x57\x16\x2b\xd7\xac\x00\x73\x9f\xe6\x6a\xc3\x62\xb3\xd6\xb6\x23\x94\x34\x12\xcc\x03\xe3\x59\x20\xb9\x0f\x2d\x0a\x39\x4f\x73\x94\x74\xb9\x03\x91\x92\xac\x13\x7f\x80\x11\xf2\x00\xb9\x9f\xcb\xc8\x42\xe9\x6d\xa4\xbd\x78\xf4\x01\x13\x93\xe7\x0c\xbb\xa0\x34\x17\x4c\x4c\x12\x1b\x88\xb9\xd2\x32\xb6\x6e\x21\x58\x71\x77\xc6\x4f\x12\xe7\x84\x72\xa5\xe9\x60\x71\xf8\xe3\xf4\xf7\xcf\x8d\x7f\xf4\xae\x2e\xe1\xbc\xf7\xf9\xb9\x9c\x07\x78\xf4\x4c\xd4\x27\x39\x6a\x54\x9e\x45\x43\x8e\xd1\x8b\xf9\x03\x17\x13\xee\x0d\x28\xb2\x40\x75\x40\xcb\x1c\xe9\x16\x33\xef\x81\xea\xf5\xfc\xd9\x20\x94\x48\x0a\xb3\x5e\x5a\xff\xee\x62\x84\x24\x09\xd5\xd7\xae\x7b\x66\xda\xb0\xa1\x9f\xb2\x33\xbe\xe9\x60\x02\x02\xa2\xc1\x2c\x5a\xd6\x73\x01\xb9\x59\xf7\x04\xab\x2b\x06\xca\x95\x26\x8c\x59\x6b\x6f\x64\xdc\xd6\x73\xa8\x92\x68\x17\x58\x39\x2a\xe3\xd6\xae\x1d\xe3\x1b\xdb\x47\x05\xe0\x2d\x12\x28\xd7\xc3\x17\x8a\xea\x4c\xc4\xbc\xdc\x5e\xd3\x50\x66\x11\xc5\xae\xc6\x92\xae\x70\x76\x77\xae\x66\x91\x6d\x96\x89\xb9\x6e\x3f\x4b\x57\x5f\x08\x86\x64\xbd\x60\x21\x59\x2b\x21\xcf\xd1\xe7\x2c\xa0\x0d\xce\x3a\x81\xf3\x1b\x4e\x5f\x0a\xd4\x0d\x0e\x76\x06\x15\x8a\x98\x6b\xbb\x94\xd8\x15\x92\x5d\x52\xec\x0a\x64\xd3\xb4\xb8\x56\x61\x6e\xa7\x11\xe6\x2b\x4c\xc9\xb1\x77\x9c\xac\x8c\xfb\xa4\x3e\x9e\xfa\xbe\x61\xe8\x65\x2e\x2f\x36\x60\xf2\x42\xa6\xd4\xab\x0c\xe9\x7b\x1b\x92\x5d\xeb\xf9\x18\x99\xe5\x40\x69\x0d\xee\x66\x5e\x32\xb3\x54\x26\x52\x0e\x4c\x58\x30\xa0\x66\x05\x64\x26\x26\x21\x87\x69\xbe\xc4\x25\x4f\x1e\xea\x37\x22\xd6\xa8\x3e\x0b\x12\xac\x9d\x15\xe6\x2d\xb6\x69\x4e\x01\x91\xc4\x46\x24\x94\x36\xa2\xf7\x51\xa9\x54\xdb\x0a\x5e\x2e\x54\xb9\x2d\xb8\xb3\xc9\x7c\x5c\x4b\xd3\x74\xa5\xf9\xf7\x39\xcd\xeb\xed\x6a\xfd\x00\xcc\xf2\xb1\xfc\xc8\xb6\xfb\xca\xfa\x26\x48\x53\xb5\xbb\xc8\xcb\xc6\x2c\x13\xca\xdc\x7a\x49\x46\x12\xb5\x5b\xfb\x26\xeb\x67\xa2\x41\xc6\x5c\xd3\x30\x2f\xaa\x29\x4d\xf4\x8b\xf8\xec\xc4\x91\x4c\xbd\x4c\x0a\x6f\x7d\x5b\xe4\x20\x55\xda\x70\x2b\x7d\xdd\x65\x00\x93\x4c\x4e\x60\x22\x5d\x1b\x90\x59\x92\x0b\xd9\x75\x80\xf5\x61\x1d\xe8\xc0\x62\x6b\x24\xb2\xa7\x31\x8c\x18\xd1\xb8\xf7\xf6\x87\x51\xed\xad\x26\x36\x3b\xa1\x65\x53\x5a\x0f\x94\x5b\xcd\x4a\x14\xc0\x75\xee\xdb\xf4\xfd\x06\x4d\xa2\xaa\x78\x19\x50\x92\xcc\x1d\x67\x47\x17\x8c\xaf\xa7\x3f\x9b\x18\xdd\x34\x23\x2d\x70\xe9\xd4\x2d\x02\xec\x2c\x67\x1c\x39\xa1\xdc\x71\x6b\x61\xdd\x6f\x13\x7b\x0e\x81\x9d\x26\xc2\x8d\xa9\x84\x35\x18\x5a\xfc\x16\x12\x07\x65\x1c\xd5\x46\x79\xed\xb8\x74\x2b\xb3\xe6\x5f\xa0\x77\x37\x9d\xc8\xe9\x50\xf0\x50\x51\xfe\xb0\x21\xc7\xd4\xa3\xfc\x21\x4d\x37\x07\xa8\x34\xe5\x33\x61\x9b\x5b\xd9\x3c\x53\xea\x50\xd6\x9a\x8a\x35\x90\x7e\x66\xeb\x20\xdb\x8a\x15\x63\x93\xda\x2c\xe0\x3b\x57\x6d\x3b\xcd\x13\x78\xc0\xe9\x72\xb2\x74\x46\x41\x9a\xd9\xdc\xa8\xaf\xdb\x73\x1d\x76\x55\x1f\x99\x17\x2a\x2d\x45\xe6\x03\xf0\x09\xb7\x59\xd6\x24\xcd\x17\xa0\xcf\x1c\xe9\xbf\xcd\x46\x9f\xad\x1d\x73\x28\x25\xe9\x94\xd7\x30\x2a\x51\xcc\x97\xcd\x56\x9c\xb7\xa9\x90\x4b\xc5\xe9\x75\x37\xdd\x4c\x48\x15\x4b\xe2\x00\x25\x72\x5d\x2f\x76\xf4\xc5\xce\xd4\xb2\xb8\x78\xcd\xb5\x98\x66\xef\x0e\xdc\xc0\x36\xc5\xae\x05\x10\x88\x28\xfa\xb8\xb0\x67\x61\x33\x16\x48\x82\x02\xbf\xe1\xba\x9b\xb8\xd2\x44\x9a\xf6\xad\xc3\x24\xb0\x70\xd9\xfb\xf9\x6e\x47\xb2\xf9\x3c\x26\x8c\x16\x41\xfc\x47\xef\xea\xb2\xf1\xab\x70\x14\x01\xf1\x5d\x54\xa9\x89\xb6\x7b\xfb\x87\xa0\x62\x7f\x64\xa4\x18\xa0\x32\xfe\xa6\x67\x9e\xd4\x43\xc2\xe9\x00\x95\xae\x27\xe3\xa0\x54\xf7\x47\x5f\xf3\x39\x0a\x70\x21\x24\xe0\x23\x09\x23\x86\x87\x76\xa2\x1f\xe1\x3c\x85\x66\x05\xe2\xdb\x89\xdf\xb2\x66\x06\xd5\x6e\xb0\xd3\xfc\xf5\x08\x18\x3e\x8a\xe0\x30\x3d\x58\x60\x48\xd7\xe4\x01\xed\x86\x75\x62\x9c\x8c\x3e\x60\x07\xf6\x54\x84\x7e\x06\xdd\xbf\x4c\xa8\xf2\xb4\x57\x00\xf9\x60\x32\x42\x89\xb0\x67\x3a\xee\x39\x24\x67\x5b\x45\xe6\x5e\xaa\x4f\x73\x64\x6d\xd0\xa7\x25\x1d\x0e\x51\x62\x11\xcf\xed\xde\xc7\x18\xb9\x7e\x6b\x77\xc1\x07\xc0\x45\x06\x8c\x05\x6e\x24\xea\x12\x59\x18\xac\x20\x7f\x7f\xf4\xb5\x10\xf3\x45\xfe\x01\xe5\x01\x3e\xc2\x91\x8b\xc7\xa8\x32\x1c\x7b\x5b\x87\x5b\xab\x35\x53\xae\xc9\xa3\x19\xcd\x1f\x09\x85\x45\x9c\x16\x9c\x4d\x0d\xfd\x23\x32\x36\x31\x5d\x88\x30\x41\xc6\xbc\xd4\x37\x4f\x88\x3d\xe3\x90\x0a\xd3\xa5\x43\x23\x22\x8b\x66\xc1\xc5\x0d\xbb\xdb\xab\xf3\xab\x8e\xc3\xd0\x28\xdb\xd0\x6e\x3d\x70\xa1\x61\x40\x4d\x60\x4e\x78\x90\x1e\xc7\x40\x56\xc4\x5d\x43\x56\xec\x54\x4b\x0b\xf0\x47\x84\x0f\x31\x8d\x45\x07\xb1\x8e\x25\xd6\xdf\xec\x62\xfb\xab\x3b\x6a\xd9\xb6\x66\x77\x6d\xd9\xf1\xfc\xd7\xf6\xa7\xb6\x24\xb4\x78\x69\xb1\x48\xe8\x65\xc6\x22\x0a\x09\x9d\x4f\x5c\x86\xd6\x40\xf8\xca\x90\x69\x16\xd0\xaa\x21\xc6\x28\xc7\x14\x27\x8d\x89\x90\x0f\x94\x0f\x3d\xa3\xbe\x9e\xd3\x0f\xd5\xb0\x8b\x8b\xc6\xbe\xfd\x6f\x67\xba\xec\x16\xff\x36\xc4\xd9\x17\xbe\x07\x85\x66\x1c\xd5\xd8\x89\xc0\x74\x4e\xde\x6e\xae\x7c\xd3\x4b\x33\xe7\x4b\xef\x1b\x33\x9a\x8c\xa8\x3f\x4a\xf7\xe0\x13\x7f\x5d\x6c\x83\x21\x09\x9c\xab\x27\x7c\xfa\xea\x2a\x6f\x18\x1c\x4b\x83\xd5\xd4\x4b\x4e\xc6\x78\x84\x07\xe6\xb7\xa2\x4a\x9b\xfb\x3b\x71\x34\xa6\xa5\x4d\xfe\xae\x7b\xfe\x7d\x0c\x21\xa6\x3b\xd9\xf7\x86\x20\xd3\x3a\x91\xdd\x97\x7b\x65\x56\x66\x21\x06\x94\xdc\xbe\xc4\x6e\xaa\x5a\x3a\xe9\xb2\xda\x16\x64\x65\x62\x1f\x77\xd2\xc5\x10\xa2\xdc\x31\x08\x73\xd3\x9b\x9d\x7f\x61\x4c\x4c\x8a\x53\x07\x00\xa9\xdd\xb8\xe8\xff\x5c\x92\x81\x86\x63\x38\x48\x8e\xf5\xfd\xa9\x04\xf7\x1c\x62\xf6\x5c\xdf\xdb\xa2\x30\xa9\x0c\xbb\x4c\xfb\x69\x33\xa5\x05\xd4\xde\xdd\x7c\xce\x6e\xb8\x91\xf4\x94\x4d\x2c\x59\x11\x72\x50\x3e\x9f\xb2\x38\xf4\x4b\xc1\x4c\x02\xc8\xad\xa8\x7e\x63\xf7\x59\xb3\xd4\xf2\xa9\x0b\x8a\x2d\x43\x5c\x90\xb8\x89\x6a\x80\xdb\x11\x2a\x74\x46\x61\x97\x7f\x2a\x8e\x22\x21\x35\x06\x2e\x69\x7e\x68\xd6\xab\xef\x8e\x0f\x61\xc0\x04\xb1\x3f\x1c\x39\x87\x1b\x01\xdf\x7f\xb5\x19\xc2\x01\xf1\xf1\xaf\xa7\x43\x08\x49\x74\xef\x5e\xcd\xde\xb7\xc1\x0f\xa7\xac\x20\x70\x71\x6d\xe7\xbc\x82\x6b\xf8\xe8\x4e\x3d\x9d\x0b\x7f\x83\x22\x2e\x71\xfb\xd3\xfc\xc5\x38\x9c\x2d\x88\x89\xb1\x22\x95\x8d\x0d\x37\xb2\xc5\xac\x72\x12\x58\xf3\x53\x47\x03\xbb\x88\xd0\xc8\x03\x0c\x20\xc8\x0e\xb1\x49\x80\x65\xed\x6a\x99\xa0\x8d\x9d\xb7\xd0\x5e\xd3\x62\xc9\x5e\x18\x66\xc9\x84\x13\x40\xd1\x0c\xb6\xe5\xa8\xe5\xb9\xb9\xcd\x54\xf1\x1c\xf8\x60\xe5\x35\x20\x31\x2b\xdc\x94\xca\x76\xce\x68\x6b\xf2\xa6\xf3\xfb\xe9\x85\x5b\x35\x1a\x55\x8b\x79\xb2\xb8\x29\x05\x79\x76\x02\xce\x59\xd9\x66\x97\x02\x2f\x67\xaf\xab\xb4\x95\x1a\x7d\x2b\xd5\x05\x40\x1e\x87\xe5\x00\x97\x48\xcc\xcf\xdb\x36\xee\xba\x24\xc8\xe4\x5c\x55\xbd\xac\xdb\x2e\x0d\x76\xd9\xbd\x97\x73\xdf\xa5\xc1\x97\x75\xf3\x69\x7b\x51\xf5\x29\xb7\x0b\x32\x6f\x25\x67\x63\xd7\x5e\x63\x4e\x76\x6d\xdb\x99\xb9\x24\xd8\xd7\x10\xf0\x76\xe2\x7d\x51\xe1\xe2\xa3\xcf\x62\x45\xc7\xf8\x3b\x79\xa4\x61\x59\x3b\x2e\x77\x2a\x60\xdd\x28\x94\xbf\xde\x28\xc9\x71\xab\xed\xf5\x6e\xcf\xbd\x99\x6c\xf9\x5e\xb9\xf3\xf1\x30\x6e\x27\x10\x13\x99\x97\x55\xbc\x3b\x27\x89\xe4\x65\xa7\x7c\x74\xc8\x85\xc4\xc0\x3a\x9e\xf9\xea\x21\xed\x52\x56\x4d\x24\x3a\x6b\x20\x56\x8b\xbf\x70\xf0\xa0\xaf\x04\x77\xf3\x0b\x0d\x3a\x40\xec\x75\x99\x59\x7f\xde\xba\xe7\x87\x40\xeb\x58\x07\x02\x47\xc7\xe0\x8f\x88\x24\xbe\x46\xa9\x60\x84\x8f\x69\xf6\xd8\x83\x58\xd2\x0e\x94\x92\x82\x65\xc1\x4d\x17\x88\x82\x88\x48\xe5\x0a\x91\x7e\x15\x8c\xf0\x21\x70\xd4\x0d\xb3\x98\xb8\x36\x0f\x6e\xdc\xd9\xed\xbb\x9b\x6e\x49\xa8\x1e\x60\x48\x28\xeb\xd8\x20\xd0\xfc\x32\x61\x84\xad\x19\x5a\x33\x56\x49\x98\x06\x23\x03\xca\xa1\x74\x9a\xc0\xf3\x60\x24\x94\x76\x95\x14\x49\xaa\xbc\xb8\xc4\x20\xaf\xd9\x72\x36\xee\x0e\x83\x70\xd4\x16\xac\xcd\x33\x1d\xba\xdc\x79\xba\x7f\x05\x37\x17\x67\x25\x41\xb6\x9a\x6d\x33\xcf\x18\x89\x0b\x0e\xed\x7a\x0b\xee\x6f\x2e\xce\xcc\xdd\xaf\x75\xf0\x80\x46\xe3\x63\xcb\xa1\xee\xf5\xf8\xb8\xac\x0a\x5c\xe7\x49\xcb\xb1\xa5\x7b\xed\x00\xbf\xdb\x42\x05\xba\xd7\xe3\x77\xe5\x00\xfb\x34\x28\x3c\x9c\x91\x6d\x04\xce\xba\xe7\x37\xc5\x40\x6d\x0f\x0f\x42\xe2\x97\x87\xfa\xfb\xe9\x59\x91\x32\xcd\x81\xff\x7e\x5a\x56\x4e\x1e\xc4\xb1\xb5\x49\x0e\x77\x77\xdd\xf3\xe4\xb8\xaa\x5b\xf1\xc4\x51\x84\xd2\x27\x2a\x3d\x0a\x50\x36\x92\xec\x4f\x93\xfc\xd1\x10\x1f\xe1\xe0\x23\x7d\xfb\xef\xfb\xa6\xf7\x81\x78\x83\xaf\x7f\xbd\x7f\xf2\x3e\xce\x2e\x8e\xcb\x5d\xb4\x8e\x9e\x7e\xda\x8a\x9a\xf6\x8c\x9c\xf6\x77\xa7\xa7\x3d\xbb\x6a\xbf\x1c\x41\xc7\x33\x82\x8e\xbf\x3b\x41\xc7\x8b\x04\xbd\xff\x40\xfa\x5f\xd7\xd3\xb8\x35\x59\x27\x33\xb2\x4e\xbe\x3b\x59\x27\xaf\x43\x16\x55\x7d\xee\xfc\x5a\xef\x97\xcb\x56\x13\x84\x74\xbf\xda\xc0\xe3\xb0\x8f\x32\x9d\xaa\x18\x7d\x28\x17\xd4\x02\x7c\xd9\x6b\xb6\x8f\x5a\x3f\x9f\xb4\x9a\xc7\xed\x2f\x7b\x06\xe2\x97\xbd\x0f\x3f\xbf\xf7\x66\x77\x5b\x5f\xf6\x92\x91\x5b\xcd\xd2\xbe\x64\x86\xe1\x2a\x5e\x4b\x23\x6e\x45\x7b\xab\x3d\xa7\x7e\x1d\xcd\x6b\x70\x2f\x0d\xdf\x97\x18\x50\xed\x13\x69\x43\x08\x77\x05\xe6\x32\x1d\xa7\xf0\xa0\xc5\xba\x36\xd7\x96\x7f\x1f\x7c\xec\x58\x4d\xb7\xf2\x3e\xf8\xd8\x71\xbf\xdb\x4f\x6f\x3f\x7e\x3b\xb9\x6f\x79\x27\x5f\x93\x87\xc7\x4f\xdf\xde\x1d\x7c\xec\x34\x5b\xad\x6f\x56\x85\xdc\xfd\xb7\xb3\x57\xbf\xb5\xef\x8f\x7f\x4e\x3b\xb7\x9f\xbe\xb5\x4d\xe7\xfb\xa6\x77\xf2\xf5\xdb\xfd\xbb\xf7\x8b\xbd\x5b\x4f\xdf\x0e\x3e\x76\x8e\x5a\xed\xd6\xb7\xd6\xfb\x66\xf3\x5b\xfb\xe4\xcb\x97\x2f\x5f\x02\x33\xaa\xfb\xd1\x6a\x3d\xbd\x2d\xab\x7b\x76\xaf\xd4\xac\x44\xb8\xe0\x10\xd0\xa1\xe1\xcd\x3c\x4a\x0a\xe9\xa3\x3b\xd2\xe6\x81\x52\x25\xd7\xd7\x66\xc6\xb9\xab\xf7\xea\xa0\x84\x4f\x09\x33\x93\x79\x2c\xa9\x9e\xa6\xec\x9e\x07\x88\x7a\x54\x56\x99\x13\x6e\xa7\x74\xde\x7b\xf0\xf5\xa3\xbb\x38\xca\x5e\x1c\x3f\xfd\x04\x45\xe7\x94\xb2\x6d\x84\x8f\xbe\x60\x42\x5a\xc5\x1b\xe1\x23\x09\xd0\xa7\x21\x61\x60\xef\x82\x2f\x02\x4c\x55\x6f\xff\xc2\xb6\xb2\xe4\x2f\x10\x98\xa2\xbe\xff\xf1\x20\x71\x0d\xa7\xde\x85\xd1\x90\x6f\xd9\xcb\x77\xe5\x05\xe6\x81\x1c\xf6\xe7\x98\xdf\xfc\xfa\xcb\x0a\xc6\x72\xd8\xdf\xce\x53\xc8\x61\xff\xe0\xe8\xe4\xe4\x30\xf9\xf7\xc1\x7a\x86\xfe\x54\x9b\x05\x0a\x51\xf8\xee\x18\x90\x1b\xf0\xa5\x3d\x2a\xe5\x44\x4e\x21\x20\x9a\x80\x07\x11\x51\x6a\x22\xac\xf9\xf1\xe9\xfc\x48\x60\x12\x7c\x97\xcd\x97\x10\x6d\xc3\x55\xf3\xff\x92\x67\x38\x6a\x36\xdf\x79\xcd\x96\xd7\x3c\xfa\xb2\x97\x09\x3c\xcb\x7b\xff\x41\xcc\x98\x67\x01\x53\x6e\x62\xd5\x76\xbb\xfd\x01\x3c\x08\xd2\x63\x73\x66\xd8\xe2\x23\x65\xcb\x6d\x09\xc1\x23\xe0\xca\xe1\xb6\x12\x7b\x69\x1a\xa2\x0b\xbe\xce\xb7\x1b\xc2\x4a\x3c\x8c\x88\xa6\x7d\xe6\xfe\x26\x03\xf4\x7c\xc2\xe6\xa8\xa6\xeb\x3b\xcf\xf2\xcc\x8c\x53\xde\x7e\x2d\x33\xcc\x2b\x2b\xac\x6e\x1d\x7b\xad\x23\xaf\x75\x72\xdb\xfa\xd0\x69\x37\x3b\x47\xcd\x7a\xb3\xd9\xfc\x9f\xd2\xee\x78\x71\x5d\x60\x86\xf1\xec\x30\x73\xc6\xd7\xcb\x81\xda\x32\x8b\xb7\x39\x0f\xfc\x2c\xb0\x21\x79\xec\x96\xcf\xfa\x65\x2a\xa6\xde\x95\x5b\xbd\x6c\xaa\xa0\x5a\x83\xcf\x67\xe4\xc3\xe2\xaa\x98\xef\x8c\xd0\x36\x39\xf0\xef\x86\x54\xf9\x3c\xcd\xd2\xd1\xdd\x4b\x37\x89\x2d\x6c\x24\xda\xf4\x9d\x9b\xdd\x4a\x9a\x01\xa3\x1a\x25\xd9\xb8\xef\xe8\xda\xb6\x3a\x49\xf9\x8f\xa5\x93\x94\xff\x60\x3a\x49\xf9\x0f\xa8\x93\xdb\xe4\x0e\xff\x7e\x3a\x19\x33\x4d\x23\x86\x57\x85\xf5\x4a\xf3\xf6\xb7\x23\x90\xc7\x8c\x91\x7e\xd9\x7d\x81\x6d\x73\xbf\x11\xd1\x1a\xe5\xeb\xec\x6f\x69\xaa\xb7\x43\xbb\x3c\xe4\x0d\x87\x5b\x9e\x0d\x38\xe6\xf4\x3f\x31\x6e\xe1\xe4\xb6\xe5\xf7\xa3\x97\x2a\xe0\x86\xa2\xb8\x79\x5b\xf9\x33\x38\x2c\x29\x13\xca\x40\x72\xd9\x8a\x00\x35\xca\x90\xf2\xb2\xe1\xb9\x3b\x84\x76\xd7\xb5\xe1\x9e\xe0\x56\xfd\xb5\xb0\x7f\xa8\xcb\x2e\xd9\x06\xee\xb8\x15\x8c\x29\x4e\xca\x25\xdb\xb7\xda\xa8\xdc\x52\x36\xdb\xed\xa7\x95\xde\xc7\xdf\xa2\xeb\xa6\x22\x91\xb4\x95\xe4\xc2\xd6\xa7\x12\x36\x53\x5e\xca\xe4\x4a\x8f\x5b\xc6\xcc\xb6\x2a\x08\x2b\xe4\xf1\xc6\x2e\x2b\x05\x61\x6a\xa1\x22\x2c\xfb\xa7\x8d\xd0\xad\x10\x23\x29\x82\xd8\xc7\xa0\xe1\x0b\xae\xe2\x30\x77\xe5\x96\xe4\xe9\x0a\xab\x29\x36\xa0\x17\x4b\x5a\xa2\x0a\xe4\xee\xa6\x6b\xab\x40\x08\xb3\x67\x71\x34\x1d\x23\x9b\x66\x6b\x42\x92\x3f\xfd\x62\xd1\x39\xb0\x7f\xfd\x22\xaf\x60\x6f\xf6\xc7\x7e\xf0\x31\x62\xd4\xa7\x9a\xad\x57\x8d\x42\x01\x15\x95\x1c\xd9\x33\x42\x9b\x8a\x8e\xdc\x41\xa2\xa4\xec\x48\x69\x22\xed\x1f\xee\x70\x88\xe5\x57\x1e\xad\x41\xd4\x8a\xa0\xaa\x3c\xaa\x2a\x8f\x72\xa8\xa8\x2a\x8f\xaa\xca\xa3\xaa\xf2\xa8\xaa\x3c\xaa\x2a\x8f\x9e\x41\x68\x55\x79\x54\x55\x1e\x55\x95\x47\xb6\x55\x95\x47\x99\x56\x55\x1e\x2d\xb6\xaa\xf2\x68\xc3\xd0\x2f\x05\xb3\xaa\x3c\xaa\x2a\x8f\xaa\xca\xa3\x75\x5d\xab\xca\xa3\xaa\xf2\xa8\xaa\x3c\xca\xb4\xaa\xf2\xa8\xaa\x3c\xaa\x2a\x8f\xe6\xad\xaa\x3c\xaa\x2a\x8f\xaa\xca\xa3\xaa\xf2\xa8\xaa\x3c\xaa\x2a\x8f\x36\x53\x53\x55\x1e\xbd\x20\x41\x55\xe5\x51\x55\x79\x54\x9e\xf6\xaa\xf2\xa8\xaa\x3c\x2a\x6a\x55\xe5\xd1\xac\x55\x95\x47\x55\xe5\x11\x54\x95\x47\x55\xe5\x51\x0e\x3e\x3f\x58\x95\x47\x55\x79\xb4\xae\x55\x95\x47\xff\x5d\x84\x7e\x40\x9d\xac\x2a\x8f\x32\xed\x6f\x47\x60\x55\x79\x94\xd3\xfd\x35\x00\x57\x95\x47\x55\xe5\x51\x7e\xab\x2a\x8f\x9e\x0d\xac\xaa\x3c\xfa\x7b\x55\x1e\xe5\x3c\x70\x1f\xf5\x5f\x24\xb4\xe8\x4b\xde\xb6\x7b\xf9\x6f\x79\xfb\x82\xbb\xf3\x2d\x6b\xac\x67\xf9\xa3\xd7\x49\xc7\x75\x1f\xae\xca\xb5\xbf\x02\x54\x67\x10\x67\x1f\x31\x9b\x55\x53\x69\x57\x56\x52\x5c\x9a\x43\x34\x10\xf0\x51\xda\x32\x11\x2b\x98\x75\xd1\xc0\xa6\x93\x38\x8c\x28\x7d\x2b\x09\x57\x16\x95\xdb\x82\x95\xf5\xd2\xe7\x06\x95\x76\x8b\xea\xa4\x82\x22\x21\x45\xcf\x40\x61\xe0\x3e\x50\x2f\x38\x26\x42\xcc\x35\x56\x2d\x80\x70\xa1\x47\x28\xf3\xe2\x99\x34\x66\x9d\x2d\xb2\x0b\x2d\xba\xc0\x37\x18\x72\xef\xec\xd7\xee\x4b\x93\x6a\x27\xc0\x0c\xb9\x54\x65\xe8\x9d\x10\x35\xfb\x7a\xfe\x6b\xe3\x1e\xa2\x52\x05\x9f\x84\x5c\x8a\x33\x47\x71\x48\x38\x48\x24\x81\x89\xe5\xd2\x97\xc1\xe8\x9e\x4f\x6c\xb5\x5e\x80\x9a\x50\xa6\x80\xf4\x45\x9c\x3f\x0d\x19\xf9\xce\xa5\x9a\x47\xe4\x46\xe4\x25\x12\x95\x7f\xaa\x69\x85\xe1\xae\xfb\xec\x63\xd4\x33\x86\xbf\x51\x89\x2c\x76\xc7\x68\x9d\x67\xc9\xc1\x28\xf1\x2a\xf3\x7a\x21\x87\xcc\xa1\x55\x6e\x31\x80\x5b\x19\xe3\x21\x5c\x10\xa6\xf0\x30\x3d\x55\xf0\x6c\xbc\x8a\xe6\xc0\xd5\x6f\x23\x8a\x01\x3c\x2c\xb8\x95\x39\x7a\xcf\xc4\xa0\x28\xe6\xf0\xf2\x4d\x39\xf7\xeb\xf8\x25\xbe\x7d\xb7\x2e\xcc\x88\x46\x44\x6d\x2a\x43\xbd\x36\x7d\xd6\xf9\xe3\x5c\x12\xd7\x22\xb3\x72\xd3\x1e\xd4\x09\x32\x47\x73\x94\x16\xd2\x98\x5e\xe6\x4e\xdc\x5f\xf9\xdc\x77\xa2\x51\xf0\xd7\x53\xed\xff\x02\x00\x00\xff\xff\x2f\x4b\x24\x1c\x7f\x8c\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x7d\x6d\x73\xdb\xb8\xd5\xf6\x77\xfe\x8a\x33\x76\x67\xd6\x99\x31\x65\xcb\x76\xd2\x5d\x3d\x1f\x32\x5e\x27\x69\xf5\x6c\x36\xf1\xd8\x4e\x3b\xbd\x93\x74\x06\x22\x8f\x24\xd4\x14\xc8\x02\xa0\x5f\xee\x4d\xfe\xfb\x3d\x07\x24\x45\x4a\x96\x48\x40\x16\xbd\xde\x96\x56\x76\xd6\x92\xc0\x83\xf3\x86\x77\x5c\x97\x77\xc1\xdf\xde\x8f\xb7\x0b\xef\x79\x80\x42\x61\x08\x3a\x06\x3d\x45\x38\x4d\x58\x30\x45\xb8\x8c\xc7\xfa\x96\x49\x84\x77\x71\x2a\x42\xa6\x79\x2c\x60\xef\xf4\xf2\xdd\x0b\x48\x45\x88\x12\x62\x81\x10\x4b\x98\xc5\x12\xbd\x5d\x08\x62\xa1\x25\x1f\xa5\x3a\x96\x10\x65\x02\x81\x4d\x24\xe2\x0c\x85\x56\x3d\x80\x4b\x44\x23\xfd\xc3\xc7\xab\xe1\xd9\x5b\x18\xf3\x08\x21\xe4\x2a\x7b\x08\x43\xb8\xe5\x7a\xea\xed\x82\x9e\x72\x05\xb7\xb1\xbc\x86\x71\x2c\x81\x85\x21\xa7\x8a\x59\x04\x5c\x8c\x63\x39\xcb\xd4\x90\x38\x61\x32\xe4\x62\x02\x41\x9c\xdc\x4b\x3e\x99\x6a\x88\x6f\x05\x4a\x35\xe5\x49\xcf\xdb\x85\x2b\x32\xe3\xf2\x5d\xa1\x89\xca\xc4\x9a\x3a\x75\x0c\xff\x88\xd3\xdc\x86\x8a\xb9\xb9\x17\xf6\xe1\x6f\x28\x15\x55\x72\xd4\x3b\xf4\x76\x61\x8f\x8a\xec\xe4\x5f\xee\xbc\xf8\x7f\x70\x1f\xa7\x30\x63\xf7\x20\x62\x0d\xa9\xc2\x8a\x64\xbc\x0b\x30\xd1\xc0\x05\x04\xf1\x2c\x89\x38\x13\x01\x96\x66\xcd\x6b\xe8\x81\x51\x80\x64\xc4\x23\xcd\xb8\x00\x66\xcc\x80\x78\x5c\x2d\x06\x4c\x7b\xbb\xde\x2e\x98\x9f\xa9\xd6\xc9\xe0\xe0\xe0\xf6\xf6\xb6\xc7\x4c\x74\x7a\xb1\x9c\x1c\x14\xd6\x1d\xbc\x1f\x9e\xbd\xfd\x70\xf9\xd6\x37\x2a\x7b\xbb\xf0\x49\x44\xa8\x14\x48\xfc\x77\xca\x25\x86\x30\xba\x07\x96\x24\x11\x0f\xd8\x28\x42\x88\xd8\x2d\x05\xce\x44\xc7\x04\x9d\x0b\xb8\x95\x5c\x73\x31\xd9\x07\x95\x47\xdd\xdb\x5d\x88\x4e\xe9\xae\x42\x3d\xae\x16\x0a\xc4\x02\x98\x80\x9d\xd3\x4b\x18\x5e\xee\xc0\xcf\xa7\x97\xc3\xcb\x7d\x6f\x17\xfe\x3e\xbc\xfa\xeb\xc7\x4f\x57\xf0\xf7\xd3\x8b\x8b\xd3\x0f\x57\xc3\xb7\x97\xf0\xf1\x02\xce\x3e\x7e\x78\x33\xbc\x1a\x7e\xfc\x70\x09\x1f\xdf\xc1\xe9\x87\x7f\xc0\x2f\xc3\x0f\x6f\xf6\x01\xb9\x9e\xa2\x04\xbc\x4b\x24\xe9\x1f\x4b\xe0\xe4\x48\x0c\x29\xa6\x45\x02\x15\x0a\x50\x7e\xd0\x7b\x95\x60\xc0\xc7\x3c\x80\x88\x89\x49\xca\x26\x08\x93\xf8\x06\xa5\xa0\xf4\x48\x50\xce\xb8\xa2\x70\x2a\x60\x22\xf4\x76\x21\xe2\x33\xae\x4d\x16\xa9\x87\x46\x51\x35\x45\xc3\xd8\xc2\x8f\xe7\xb1\x84\xe7\xe9\x34\x00\x96\x70\xbc\xd3\x28\x8c\x36\xbd\xeb\x1f\x55\x8f\xc7\x07\x37\x7d\xef\x9a\x8b\x70\x00\x67\xa9\xd2\xf1\xec\x02\x55\x9c\xca\x00\xdf\xe0\x98\x0b\x93\xf9\xde\x0c\x35\x0b\x99\x66\x03\x0f\x20\x62\x23\x8c\x14\xfd\x06\x14\xd0\x01\xec\x04\x6c\x86\x91\x7f\xbd\xe3\x01\x30\x21\xe2\xdc\xb2\xac\x84\x69\x92\x71\x14\xa1\xf4\x27\x28\x7a\xd7\xe9\x08\x47\x29\x8f\x42\x94\xa6\xe6\x42\xaf\x9b\xc3\xde\x49\xaf\xef\x01\x04\x12\xcd\xe3\x57\x7c\x86\x4a\xb3\x59\x32\x00\x91\x46\x91\x07\x20\xd8\x0c\x07\x70\x4d\x75\xa1\x1e\x71\x41\x4d\x4f\xf5\x4c\xdd\x95\x7c\xf4\x28\x12\x54\xf5\x44\xc6\x69\x32\x80\x07\xdf\x67\x82\x72\xfd\x03\xa6\x71\x12\x4b\x5e\xbc\xf7\x33\xf9\xf9\xef\xc1\xfc\xf7\xcc\x3d\xbf\xd0\x7b\xd4\x3f\x67\x75\x9b\x42\x11\x57\xfa\x97\x15\x5f\xbe\xe7\x4a\x9b\x02\x49\x94\x4a\x16\x3d\xd0\xdb\x7c\xa7\xa6\xb1\xd4\x1f\x4a\x6d\x7c\xb8\x8e\x46\xd9\x37\x5c\x4c\xd2\x88\xc9\xe5\xe7\x3c\x00\x15\xc4\x09\x0e\xc0\x3c\x96\xb0\x00\x43\x0f\x20\x77\xa3\x31\xc2\xaf\xf4\x57\xe7\x92\x0b\x8d\xf2\x2c\x8e\xd2\x59\x11\x10\x1f\x42\x54\x81\xe4\x09\x79\x79\x60\x3a\xa9\x5c\x75\xc8\x75\x87\x64\xca\x14\x1a\x3d\x00\xfe\xa5\x62\x71\xce\xf4\x74\x00\x3d\xa5\x99\x4e\x55\xaf\xfa\x2d\xb9\x72\x00\xe7\x95\x4f\xf4\x3d\x69\x47\x3d\xaa\x98\xac\xab\x4f\xa4\xb3\x11\xf5\xdd\x63\x48\xe2\x50\xad\xad\x48\xa2\xe9\x2d\x8a\x02\x59\x5d\x17\x8b\x1f\x66\xd5\x91\x95\x13\x94\x5e\x59\xec\xa6\xcf\xa2\x64\xca\xfa\xa6\x98\x0a\xa6\x38\x33\xb9\x4b\xef\xe2\x04\xc5\xe9\xf9\xf0\x6f\xc7\x97\x0b\x1f\xc3\xa2\x9e\x8b\xe1\x04\x4e\xbd\x37\x42\xf6\xc8\xbc\xd5\xe7\xc1\x51\x90\x87\x07\x4e\xcf\x87\x73\x71\x89\x8c\x13\x94\x7a\x9e\x5b\xd9\xbf\x4a\x63\xac\x7c\xba\x54\xf9\x0f\xa4\x5f\x3e\x02\x84\xd4\x0a\x31\xab\x3e\x8f\x33\x86\xb9\x49\xe4\x42\xd3\xf5\x4b\xa4\xce\x0a\x45\xd6\xf4\x16\x04\x03\x15\x62\x02\xe2\xd1\xbf\x30\xd0\x3d\xb8\x44\x49\x62\x40\x4d\xe3\x34\x0a\x69\xc8\xbc\x41\xa9\x41\x62\x10\x4f\x04\xff\xdf\xb9\x6c\x55\x8c\xc4\x11\xd3\x98\x27\x73\xf9\x22\x8f\x4b\xc1\x22\xb8\x61\x51\x8a\xfb\xd4\xaf\x99\x01\x49\x22\xd5\x02\xa9\xa8\xc8\x33\x45\x54\x0f\x7e\x8d\x25\x9a\x11\x74\x60\x86\x12\x35\x38\x38\x98\x70\x5d\x74\x42\x41\x3c\x9b\xa5\x82\xeb\xfb\x83\xca\x28\xae\x0e\x42\xbc\xc1\xe8\x40\xf1\x89\xcf\x64\x30\xe5\x1a\x03\x9d\x4a\x3c\x60\x09\xf7\x8d\xea\x82\x0c\x56\xbd\x59\xb8\x2b\xf3\x6e\x4b\xfd\xb0\xa0\xeb\x83\x84\xcc\xfe\x99\x16\x5d\x13\x01\x6a\xd4\x14\x75\x96\x3f\x9a\x19\x5a\x3a\x9a\x3e\x22\xef\x5c\xbc\xbd\xbc\x82\xa2\x6a\x33\x0e\x2f\x08\x85\xdc\xef\xe5\x83\xaa\x0c\x01\x39\x8c\x8b\xb1\xe9\xfe\x69\xfc\x96\xf1\xcc\x84\x19\x45\x98\xc4\x5c\x68\xf3\x26\x88\x38\x8a\x65\xf7\xab\x74\x34\xe3\x3a\x1b\x5c\x51\x69\x8a\x55\x0f\xce\x4c\xe7\x0b\x23\x84\x34\x09\x99\xc6\xb0\x07\x43\x01\x67\x94\xa3\x67\x4c\x61\xeb\x01\x20\x4f\x2b\x9f\x1c\x6b\x17\x82\xea\xa0\x52\xfe\x90\x94\x41\xee\xb5\xca\x17\x45\xb7\xbe\x26\x5e\x8b\xcd\xf5\x32\xc1\x00\x7c\x7f\xa1\xf8\xea\xe6\x48\x2f\x94\x32\x96\x7f\x65\x22\x8c\x50\x2e\x7f\xb7\x54\xcd\xdb\x4a\x51\x93\x1d\x02\xe2\x24\x9f\x1b\x2a\x2d\x69\x34\xb9\xa7\x09\x99\x99\xd1\x84\xc8\xa2\x6c\xea\x55\xc8\x2a\x7f\xf0\x2e\x98\x32\x31\x31\xed\x9a\x69\x08\xe6\xa1\x4b\x64\x1c\xa0\x52\xa6\x5b\x07\xb0\x33\x80\x5e\x12\x43\x8c\xf8\x0d\xca\xfb\x55\xdf\x2e\x99\x71\x31\x2f\x4c\xed\x7f\xcc\x27\xa9\x44\x05\xd3\xf8\x16\xc6\x8c\x47\x18\x56\xd4\xa3\xb9\xb7\x44\x2d\xf9\x0a\x8d\xb2\x7f\x23\x1c\x53\xc3\x1e\x21\x35\x89\x09\xbf\x41\x51\xf4\x1c\xc6\xb1\x30\xcd\x3c\xbb\xf2\xe9\x7a\x9b\xe8\x35\x63\x77\x7c\x96\xce\xe6\x1a\xd7\x14\x5d\x32\xf2\xd7\x87\x4f\x16\xdd\x78\x39\x00\x95\x6e\x5b\x2b\x14\x80\x69\x8d\xb3\x44\x2b\xd8\xf3\xfb\xb9\x33\x14\x8d\x01\x78\x83\xf2\xc5\xda\xe7\x1e\x0e\x4d\x75\x51\x7b\x83\x11\xbb\xb7\xb4\x6c\x6e\x52\xf6\x54\x61\x55\x98\xbd\x11\x30\xe3\x51\xc4\x15\x06\xb1\x98\x8f\xae\xab\x5e\x23\xd4\xb7\x88\xa2\xa2\xc4\xdc\xd2\xb5\x4f\x65\xcb\x1f\x63\xd6\xab\x93\x47\x99\x9e\x2a\x7c\x7b\x97\xc4\x82\x3a\x10\x16\xfd\xcc\x82\xeb\x8f\xe3\xb1\xa5\x03\x3e\xad\x7a\x16\x50\xd0\xca\x42\x01\x96\x5f\xc1\x88\x05\xd7\xf1\x78\xbc\x65\x2f\x64\xa1\x1d\xc5\x71\x84\x4c\x78\xeb\x4b\x3c\xe8\xc5\x8a\x97\xe2\xe2\x7a\xe0\x35\xda\x79\xc9\xc5\x75\x19\x5d\xa5\xb9\x30\xe3\x3b\x25\xee\x72\x43\xdd\x9f\x2f\xb4\x56\x8a\x05\x5a\x7e\x65\x62\x58\xe8\x47\xa8\x35\x4a\x9f\xba\x20\x81\x65\xcf\xb5\x61\x13\x6d\x2e\xb1\x64\xd6\xf9\xfc\x01\xd3\xbd\x30\xb8\xc6\xfb\xe5\xf1\xb5\x30\x74\xad\x44\x28\x87\xc9\x52\x81\xb5\xa5\x1b\xe2\x91\xfd\xbb\xf3\x69\x79\x22\x05\x6a\x54\xbe\x19\xe7\xe5\x0d\xfa\xa9\xb8\x16\xf1\xad\xf0\xc7\x1c\xa3\x50\x0d\x40\xcb\x14\xbd\x95\xcf\x83\x44\xdb\x0c\xbe\xc0\x31\x75\xfa\x66\xb0\x9e\x0f\x17\x41\x94\xb9\xe3\x97\xb9\x16\xf3\x99\xc5\x5a\xa9\x00\x4c\x41\x36\xfb\x38\xa0\xac\x9a\x7b\x65\xed\x13\x36\xe1\x5a\x3f\x4f\xad\xb5\xea\xf4\x7c\x58\xcc\x51\x8b\x8d\x04\x89\x63\x94\x28\x74\xaf\x56\xca\x9a\x19\xc2\xf2\xcb\x84\xc0\xac\x46\xec\x75\xfa\x61\x48\xbd\xfc\x18\x25\xc9\xa6\x91\x89\x41\xc2\x31\xc0\x85\x69\x31\x70\xa1\x34\xb2\x75\x43\x5c\xf1\x93\x3d\x42\x5d\x8b\xc4\xfc\xc9\xfd\x6c\xee\x96\x4f\x12\xcb\x49\x75\xbe\xa7\x72\xc3\x22\xde\x24\xf5\xff\x5f\x7e\xfc\x70\xf0\x97\x38\xb3\x0e\x58\x40\xe3\x3f\xd0\x1a\xc8\x6c\x5b\xed\x83\x4a\x83\x29\x45\x39\x44\x45\x1b\x29\x97\xf4\x4d\x6f\xc6\x04\x1f\xa3\xd2\xbd\xbc\x2e\x94\xea\xf3\xd1\xd7\x7a\x2f\x03\xbc\x8b\x69\x63\x83\xcd\x92\x08\xf7\x81\x67\x11\x9a\x4f\x50\x4d\xa0\x02\xb3\xa9\x62\xdc\x34\x97\xdc\x20\x94\xa6\x38\xc6\xd8\x24\x0e\x73\x77\xdc\x1a\x37\x68\x76\x8d\x10\xe7\x6e\x48\x11\x22\x7e\x8d\x03\xd8\xa1\xa9\x5c\x45\xed\xdf\x68\x61\xf7\x7d\xa7\xa1\x92\xbd\xdb\x29\x4a\x84\x1d\x2a\xbc\x93\x29\x3b\x5f\xa1\xd0\x67\x45\xbe\xcd\xe5\x9a\x89\x55\x83\x50\x2d\xf9\x64\x82\xb4\x39\x45\x8f\xe2\x0d\x0a\xfd\xc2\x6c\xf8\x8c\x41\xc4\x15\x51\xa6\x02\xae\x8a\x5d\x1e\x0c\x1b\xe4\x2e\x9b\xf8\xf9\xe8\xeb\x0e\xec\x95\xf2\xc8\x63\xc0\x45\x88\x77\x70\x44\x03\xf6\x8a\x65\xc3\xf2\x2b\x89\xc3\x17\x3d\xb8\x32\xb9\x76\x2f\x34\xbb\xa3\x30\x05\xd3\x58\xa1\x80\x58\x44\xf7\xe4\x8b\x29\xbb\x41\x50\xf1\x0c\xe1\x16\xa3\xc8\xcf\x56\x8e\x4d\xba\xde\xd2\x16\x60\xde\x44\x50\x04\xd4\x48\x18\x24\x4c\xea\x85\x06\xd2\x83\xab\x8f\x6f\x3e\x0e\xb2\xe8\x52\x22\x4e\x84\x57\x23\x14\x80\xd4\xa3\x29\xed\x98\xd3\xdc\x98\x96\x87\xf9\x1e\x25\xe5\x38\x19\x91\x1a\xb9\xa4\x36\x0d\x43\x13\x5a\x19\x36\x48\xa4\x18\x8d\x53\x5a\xfe\xf5\x7e\xf0\xd6\x96\xb2\xef\x4d\x1e\x2e\x01\xeb\x3b\x12\xb3\x24\x5c\xee\xd6\x7e\xb7\x45\xd5\x86\x46\x53\x2a\xbb\x18\xfd\xa1\xd2\xb6\x6a\x8d\x2e\x87\x4e\x5a\x4c\x86\x71\xa0\xc8\x64\xda\x8c\x56\x07\xb4\x15\x7a\xc3\xf1\xf6\x80\xf6\xd4\xb9\x98\xf8\xd4\x00\xfc\xac\xe3\x51\x07\xa4\x92\x3a\xd8\x35\xff\xdb\x9a\x8d\x66\x6f\xcc\xd5\x50\xf3\xd0\x53\x58\x4b\xf5\xa8\x83\xad\x18\x5b\xcc\x10\xdc\x47\xeb\x1f\x2e\x8b\x4d\xeb\x25\x19\xd4\x28\x6f\xa7\x3c\x98\x16\x9b\x4b\xf9\xc8\x50\x2b\xda\xb4\xf8\x19\x0b\xb3\x81\x85\x89\xfb\xd6\x9b\x06\x39\x3c\x95\xa4\xd9\xbd\x9f\x6f\x33\xfb\x4c\x84\xf4\xbb\xe2\x4a\xd3\xe7\x5b\xf1\x70\xca\x9d\xba\x89\x4f\xc3\x37\x4f\xd3\x60\x52\xbe\x95\x3e\xc1\x62\x6a\x4c\x45\x6a\x66\x8b\xd5\x0d\x67\x9b\xb9\xa5\xdd\x0c\x94\x36\x88\x42\xce\xae\x48\xbd\xda\x62\x96\x76\x3e\xdc\x09\xb6\x8a\x27\xcd\xce\xb2\x6d\x5f\x32\x4e\x51\x96\x33\xf3\xa1\x3f\xdf\x0c\x8e\xa2\xf8\xb6\xa9\x66\x7a\x15\xed\x2d\x5b\xdb\xbc\x91\x6c\xac\xe1\x04\xf6\xf2\x73\x35\xda\x69\xf7\x33\x05\xcd\xc1\xda\x8b\xa6\xc9\x9c\xad\x1b\xe9\xf5\x27\x3b\xcb\x6b\xac\xff\x74\xf1\xbe\xb2\x89\x09\x2c\xf7\x25\xa4\x32\x6a\x52\xd4\x29\x44\xcb\x2a\x6c\x5b\x76\x3e\xfd\x75\xf6\xc4\x0f\x14\xf3\x05\x0f\x08\xb3\x68\xe5\xa1\x71\x12\xfd\x9a\xa2\x8d\x27\x80\xce\x5c\xe8\xcc\x96\x1a\x95\x59\x00\xab\x34\x49\x62\xa9\x31\xcc\xf6\x14\xf6\x69\xe7\xe4\xd5\xc9\x3e\x8c\xa3\x98\xe9\x57\x27\xfb\x56\x42\x33\xd7\xee\xc3\xe7\xaf\xb4\xe5\x24\xc7\x2c\xc0\xdf\xbe\xef\xc3\x8c\x25\x9f\xb3\xaf\xaa\x9f\x5b\x49\xa4\x49\x9b\xe0\x51\xc3\x64\x6b\x4b\x4b\xe7\xf2\x45\xe7\x93\x74\xb4\xf0\x26\x0e\x94\x73\x94\xde\x96\x0f\xa7\xb3\xf9\x36\x02\xa3\x16\x5a\x0e\x64\x36\x69\x42\x2f\x26\xe6\xca\x94\x5b\xfc\x74\xf6\x43\x9f\x8a\x10\x43\x08\xab\xd5\xd8\x04\xdf\xa5\xcd\x2e\x1b\x67\xf5\x80\x63\x6b\xa0\x7f\xa9\x8c\x5a\x92\x6d\x31\xb4\x94\xaf\xa6\x51\x76\x03\x0d\xdc\xbc\xed\x3a\x8c\x6d\x5a\x0f\xbd\x42\x1c\xb3\x34\xd2\xb6\xc5\x97\xb2\x3c\x7f\x3a\x1b\x8b\x8a\x37\xa6\x03\xa2\x6d\x69\x6b\x99\x60\x2e\x1f\x98\x45\x62\x1e\xa4\x6c\x7d\xa6\xec\xba\xb1\x2d\xb7\xfb\x87\x76\x5a\x6b\xe1\x98\x96\xf4\x0f\x45\x3a\xb3\xaf\x80\x6b\x9c\x59\xc7\xd6\x61\xe8\x70\x90\x08\xd5\x61\xa6\x66\x08\x71\x12\xb9\x72\xb8\x59\x33\x94\x38\x09\x5e\x3d\xec\x38\x0d\x29\xad\xa5\x58\x91\x2f\x4c\x4a\x76\xef\xd9\x3d\xe2\x30\x6b\x70\x4a\x00\x13\x54\x6b\xa1\xd0\x1c\xfe\x6c\x06\xe1\x20\xd1\x2e\xf8\x8f\x99\x47\xb8\xcf\x26\x5a\x0a\x3c\xde\x05\x51\xaa\xf8\x0d\xe6\x47\x83\xf6\xd1\x6c\x3e\xf0\xa9\xab\x8d\x8b\xf6\x6b\xcb\x0f\xe6\x36\xcb\xcf\x9d\xec\xe9\xfc\x44\xfb\x63\x76\x41\x06\x6e\x8e\x73\xa9\xd6\x42\x8b\x59\x68\x0f\x3e\x65\x87\x26\xb9\x80\x2c\x49\xf9\x44\xc4\x92\x6e\x25\x5c\x4d\xed\x9b\x2a\x94\xeb\xab\x05\x61\xa6\xf5\xd0\x25\x87\x01\x7c\x11\xe0\xc3\x48\xc5\x22\x1b\xc3\xec\xe6\x11\xc5\x0f\x33\x4f\x16\xc3\xdf\xf0\xcd\x3e\xf0\x1e\xf6\x80\xc1\xd1\x09\x04\x53\x26\x59\xa0\x69\xa7\x79\x8a\x77\x0e\x42\xf3\xd3\x00\x1f\x52\xc9\x07\x74\x64\xf0\xe9\x62\x48\x3b\xf8\x09\x93\x74\xd8\x33\xba\x87\xbf\xc4\x74\x51\xd0\x41\xa4\x40\x7d\x40\x4b\xad\x73\x12\x71\x91\x5d\xfb\x20\xa9\x3e\xe0\x8c\xf1\xc8\xd4\x62\x7e\x73\x90\xc9\xc2\xd0\x5c\x71\x5c\xa1\x99\xa9\x8f\xc4\x65\x15\x9e\x66\x25\x1d\x64\xfb\x30\x8d\x95\xa6\x3d\xbd\x41\x71\x0c\x52\x76\x80\xd9\xac\x9c\x66\xd2\xd6\xf9\x4d\xff\x86\x34\x92\x08\xd4\x46\xb4\xd9\xe5\xdb\x27\xaf\x16\x53\x98\xd1\x3d\x5c\xbc\x3b\x83\xfe\xe1\xf1\xc9\xbe\x4b\xb0\x30\x30\xea\x1c\xf7\xfa\xf0\xf9\xe2\xdd\x19\x3d\xff\xb5\x07\x3e\xf0\xe4\xe6\xc4\xf8\x75\x78\x7e\x73\x02\xc3\x73\x07\x99\x6b\x3c\x9a\x39\x73\x78\x9e\x09\x7f\xe5\x94\xa7\x02\x86\xe7\x37\xaf\x60\x78\xde\x24\xdc\x41\xa8\x0f\x01\x0f\x25\x05\xe8\x6c\xf8\xe6\xa2\x5e\x30\x95\x70\x12\x3d\x63\x01\x49\xfe\xf5\xf4\xac\x2e\xd1\x1c\x44\xce\x55\x21\x91\x3e\xa4\xb4\x2f\x47\xe1\xf9\x44\x3b\x6e\x74\xda\x93\xaf\xf3\x1c\x44\xa6\x49\x82\x32\x60\x0a\xab\x49\x94\xed\xdc\x4d\xf0\x0e\xf6\x5e\xf3\x17\xff\xfc\x7c\xe8\xff\xc4\xfc\xf1\xd7\xdf\x7e\xfc\xee\xbf\x9e\xbf\x39\xb1\x7b\xd3\x3f\xfa\xfe\x27\x07\x7d\x32\xab\x8e\xe7\x66\x1d\x57\xed\x2a\xb5\x75\x90\xb8\x89\x5d\xc7\xf3\x77\xc7\xcb\x5f\x3d\xce\xb0\x93\xb9\x61\x27\xbf\x8b\x61\x27\xf3\x77\xc6\xb0\x1f\x7f\x62\xa3\xaf\x8b\x1f\x3d\xca\xbc\x97\x73\xf3\x5e\xfe\x2e\xe6\xbd\x6c\xcf\x3c\xae\x46\xc2\x58\x37\xbc\xfc\xf9\x43\xff\x90\x0e\x49\xcd\x6f\xc7\xc5\x5d\x2a\xa7\xc5\x17\xfd\xa3\xc3\x60\xf8\xb2\x73\x78\x7c\xd4\xff\xf3\xcb\xfe\xe1\xc9\xf1\x97\x1d\x92\xfa\x65\xe7\xa7\x3f\xff\xe8\xcf\x3f\xed\x7f\xd9\xf1\xac\xc4\x55\xf4\xec\x1f\x56\x35\x5d\xd0\x6f\x55\xad\xee\xf2\x8f\x4b\xf9\xc7\xab\xe5\x3f\xca\x86\x40\x62\xc8\x75\xc0\x24\x75\x6f\xf9\x3b\xa0\xb7\x45\x5d\x79\x6e\x38\x08\x5d\xc8\xa2\x7f\xee\xbd\x1e\x98\x96\x60\xd2\x7c\xef\xf5\x20\xfb\xfd\xf8\xfb\x8b\xd7\xdf\x5e\x7e\xee\xfb\x2f\xbf\xe6\x5f\x9e\x7c\xff\xf6\x6a\xef\xf5\xe0\xb0\xdf\xff\x66\x52\x2b\xfb\xfc\xc5\xfc\xd1\x6f\xc7\x9f\x4f\xfe\x5c\x14\x3e\xfe\xfe\xed\x98\x0a\x7f\x3e\xf4\x5f\x7e\xfd\xf6\xf9\xd5\x8f\x8b\xa5\xfb\xdf\xbf\xed\xbd\x1e\x1c\xf5\x8f\xfb\xdf\xfa\x3f\x1e\x1e\x7e\x3b\x7e\xf9\xe5\xcb\x97\x2f\xe1\x6f\xc7\xdf\x5f\x64\xbf\xf4\xfb\xdf\x5f\xb8\xe4\x24\x9d\x88\x98\x75\x94\xa0\x4b\xd2\x7c\xc2\x75\x75\xbe\x36\xe3\x77\x18\xd2\xd1\xba\xef\xad\x95\xf0\xe0\xa5\x14\xe5\x38\x7c\xea\x5d\xf6\x40\xc5\x01\x67\x11\x4d\x0e\x52\xc9\xf5\x7d\xe1\xfa\xf9\x64\xd4\x41\x6c\xc5\xf3\x85\xcd\x9f\x7d\xf8\xfa\x3a\x7b\x73\x54\x7d\x73\xe2\xd8\x2c\xa7\x78\x17\xc4\x51\x4c\x43\xb8\x80\x29\xde\xb1\x10\x03\x3e\x63\x11\x98\x4f\x21\x88\xc3\xec\xd6\x85\x83\xd0\x2f\x3b\xbb\xef\xcc\xcf\xa0\x32\xf3\xae\x98\xb0\xfb\x7a\x2f\xef\x46\x4e\xfd\x77\x94\x35\xdf\xaa\x6f\x5f\xb9\x05\xd1\x07\x39\x19\x95\x16\x5c\xfc\xe5\xe7\x65\xcd\xa9\x80\xbb\x09\x72\x32\xda\x3b\x7a\xf9\x72\x3f\xff\xef\xa7\x2f\x3b\xb4\x44\xb8\xd7\x38\x80\x11\x53\xf8\xea\x04\x50\x90\x73\x42\x07\xa9\x23\x2e\x98\xbc\x07\xba\xb1\x0c\x3e\x24\x4c\xa9\xdb\xd8\x34\x51\x71\x0f\xd7\xf9\xd5\x00\xe7\x5e\xd0\x27\x79\x48\x59\x47\xff\x5f\xea\x45\x8e\x0e\x0f\x5f\xf9\x87\x7d\xff\xf0\xc8\xa9\x07\x59\x9c\x12\x8f\xd3\x28\xf2\x8d\x70\x2e\x68\x7e\x7c\x7c\x7c\xfc\x13\xf8\x10\xa6\x92\xb9\x6d\xb2\x01\xb0\xf9\x53\xcb\x8a\x1e\x81\x50\x5f\x76\xca\xf9\x9d\x83\xd0\xf9\x4c\x10\x34\x9f\x61\x36\xd7\x7c\x53\x54\x63\x32\x61\x96\x30\xcd\x47\x91\x4b\x06\x98\xce\xe1\x32\x60\x51\x45\xe5\x7c\x8d\x9b\x79\x9c\xea\x1a\x00\x73\x10\x49\x4f\x19\x15\x1f\x04\xa9\x7f\xe2\xf7\x8f\xfc\xfe\xcb\xab\xfe\x4f\x83\xe3\xc3\xc1\xd1\x61\xef\xf0\xf0\xf0\x7f\x1e\x11\x32\xaa\xca\x37\x55\x95\x21\xeb\xed\xb4\xb9\x1b\xca\xc3\x56\x37\x5b\x67\xec\x6e\xe8\xb6\x83\x6a\x77\xcf\x78\x93\x7b\xc7\xcb\x3f\x33\x76\xf7\x1e\xc5\xa4\xe9\x4e\xe1\xef\xa6\x9c\xeb\x59\xc4\x93\x2b\xe8\xb6\xb7\xb5\xb0\xef\x74\x0a\x1f\xb2\x61\xb5\xba\x35\x6a\xb6\x44\xf3\xf1\xd6\x5a\x2c\xcd\x27\x35\x4a\x16\xf5\x1c\xcd\x75\xcb\x63\x2e\x9e\x6f\x1e\x73\xf1\x8c\xf3\x98\x8b\x67\x9e\xc7\x5c\xfc\x37\xe5\x71\x1a\x69\x9e\x44\xf8\xb1\xe6\xba\xfa\x7f\x94\xc1\x22\x8d\x22\x02\x87\xb4\xbb\x07\x9f\x10\x70\x44\xb6\x7b\x6e\xa9\xb9\x8e\xb0\xdd\x1a\x2c\x2e\x5c\x3d\xaa\x82\x54\xf0\x7f\xa7\xe8\xd8\x91\x6e\x12\x8f\x3b\xbf\x48\xda\x58\xaa\x0d\x33\x9d\xf0\xca\x04\xf2\xa6\xd9\x7d\x45\x9a\xd9\xdf\xb1\x96\x48\xad\x47\x13\x0b\x80\xc0\xfc\x62\xe5\xa7\xa1\x99\xd8\x1a\x9c\x92\xb9\xc7\x4e\x2c\x0e\x0f\x90\xbb\x75\xaf\x90\x8f\xcd\x6d\x16\x0d\x74\xb3\x56\xb5\x77\x94\xbd\x41\x84\xdd\x4f\x58\x9d\x6e\x8a\x38\x16\x2f\x80\x51\x03\x6f\xab\xde\x71\xf4\x8b\x8b\x47\xac\x1b\xb9\x93\x0e\xb6\x0d\xdb\x41\xa8\x65\x1c\xac\x8a\x2d\x36\x3b\x73\xb3\xa1\x8a\x7d\xaf\x22\xde\x91\xd6\x70\x8c\xa0\x69\x61\x1a\x60\x78\x40\x37\x7e\xd3\x59\xed\xb2\x33\xdf\x01\x6b\x44\x47\x59\xa8\x4a\x87\x7a\x76\x56\xd0\x01\x1d\xa1\xbc\x58\x44\xe3\x02\xd3\xfc\x06\xa3\xfb\x2a\xe6\x8b\x70\x22\x7c\x6c\x54\x5b\x2b\x11\x60\xcf\x60\xb8\x5f\x94\x80\x37\xbc\x23\x12\x04\xae\xa3\x7b\x6f\xe3\x20\x36\x18\xba\x3e\x59\x1e\xc4\xa9\x40\x28\x56\xe0\xbe\xb4\x3e\x2e\x50\x85\xfb\x19\x49\x0f\x41\x6a\x04\xae\x3b\x98\x8b\xe2\x09\xed\xfe\xae\x40\x27\x7a\xce\xd6\xd5\x58\x66\x26\x85\xeb\x36\x3e\x16\x0c\x1b\x96\x25\x97\x81\xde\x15\x21\xcb\x81\x7c\x20\x13\x20\x30\x9c\x2a\x74\x1c\xc8\x66\x48\x67\xc9\x9e\xdb\xed\xb1\x02\xa0\x5d\xb3\x5b\x53\xdb\x69\x2d\x58\x75\x56\x15\xb6\x0a\x25\x6f\xab\x55\xc5\xd3\x6b\xbf\x6d\x08\x53\xf1\x32\x57\x5a\x1e\x29\xa5\xa9\x8b\xf7\x8d\x2a\x6b\xbf\x34\x2a\xac\xf9\xb6\xa1\x9d\x34\x75\xec\x21\x26\x28\x42\x02\x56\xa1\xda\x20\x7a\x8d\xb6\xd7\xd7\x3e\x8e\xe2\x5b\xf5\xd8\xa4\x79\x17\xc5\xb7\x79\x1b\x48\x85\xd2\x32\x35\x88\x8c\x30\xf7\x49\xb9\x04\xa0\x46\xcf\x32\xbe\x89\x35\x62\x21\x97\x25\xe0\x1f\xa7\xbf\xbe\x3f\x30\x0b\xfd\x37\x97\xef\x37\xf5\xfc\xa3\xaf\x08\xd5\x3b\xef\x9a\xaf\xb9\x4f\xd3\x10\x94\x44\xc6\x44\xf2\x35\xf0\x1a\x3d\x7b\x25\x19\xd7\xe7\x59\xf1\xea\x52\x8a\x26\x9c\xca\x0c\x75\x54\x20\xe7\x88\x20\x3e\xac\x42\xca\xe2\x4f\x06\x7c\x0f\x1f\x92\x51\x11\xaa\x95\x45\x91\xe9\x3a\x0e\x2a\xdd\xd6\x26\x56\x15\x6c\x3c\x03\xaf\x61\xa5\x7e\x7c\xe4\x6d\xb2\x2a\x97\x98\xc4\x8a\xeb\x0a\x19\x93\x53\xc6\x36\x68\xdf\x14\xeb\xe2\x7a\xf7\xa3\x1b\xcb\x45\x2e\xe8\xf1\x9d\x2b\xad\x0f\xe8\xd6\xc4\xda\x6e\xdf\x65\x61\x44\xf8\x28\x14\xba\x59\x50\x8d\x07\x2b\x72\x7e\xc1\xfb\x6d\x89\xba\xc0\xf1\xa3\x45\xcd\xe2\x54\xe8\x26\x5c\xb8\x95\xa4\x26\x78\xa4\x95\x90\xa6\x61\x71\x65\xc2\x10\xce\x69\x7d\xc2\x58\xd6\xfd\xc8\xc1\x8a\xba\x4f\x1e\xe0\x69\x10\x90\x43\x3f\xac\xf5\x45\x83\x26\x5b\x6a\x4a\x97\x5d\x43\x7a\xea\x86\x44\x63\x84\x0c\xb0\x71\xc3\x64\x21\x4e\xc3\xca\x43\xe6\x6e\x66\x39\x53\x0e\x09\xae\x39\xe6\x74\x22\x4f\x2b\x84\x58\x4e\x0a\x2a\x3e\xc3\xad\xd7\xbb\xee\x5d\xc4\xa9\x46\xf5\x3e\x66\x61\xc3\xa6\x61\x6a\x18\x34\x63\x48\x24\x1e\x24\x74\xff\x2f\x67\x4a\xca\xc9\x2f\xea\x36\x40\x6a\x53\xce\xc1\x3b\x4d\xcd\x27\x5f\xca\xe4\x0c\x90\xd6\xfe\x7b\x5f\x50\x46\x3e\xb6\xf5\xd3\x32\x8a\xfc\x68\x5f\xb3\x71\xfb\x83\xf5\xcd\x1c\xe5\xfe\x98\x78\x99\x39\xcb\x2d\x8f\xb2\xf5\x92\x4c\x24\xea\x9c\x2a\xc3\xc4\x0b\x98\x06\x99\x0a\x3a\xe5\x7c\xac\xd1\x5b\xe9\xb3\xf3\x8e\xe4\xde\xaf\xb0\x43\xda\x78\x30\xdf\x1a\x2c\x1e\x37\xb7\x52\xcb\xc3\x5c\xc3\xed\x80\xb9\xc9\x35\x32\x01\xf6\xb0\x37\xe9\x11\x72\x5a\xe7\x2b\xea\x1d\xe2\x20\x22\xee\xbd\x9d\x17\xcf\x26\xb5\x9d\x06\x36\x33\xa0\x55\x77\x70\xe6\x97\x24\xb2\x04\xc8\x0a\x8f\xcc\x2d\x85\x1a\x99\x94\x49\x5c\xd5\x2f\x03\x2c\xcd\x7c\xe4\xe8\x98\x4d\xc6\x07\xde\x63\xb1\x63\x0b\x5e\x3a\xcd\x16\x01\x66\xba\x98\x13\x84\xa8\x82\xc8\xa4\x5c\xaa\x93\xdb\x58\xa6\x80\x57\x9b\xbf\x0d\x03\x61\xe3\x56\xc2\xaa\x38\x52\xad\x0b\x1b\x07\x36\x1d\x55\x63\xbc\x1e\x8d\xee\x68\x5e\xf3\x2f\xd8\xeb\x6d\xa8\x6d\x6d\x81\x9a\x2f\xd7\xaf\x97\x16\xbc\x5b\xf0\x99\x16\x9b\x68\xf9\x3d\xb3\xec\xb4\x81\xcc\x9b\x2f\xbc\xe6\xcb\xbb\x92\x0b\xd6\x65\x0d\x56\xb7\xfe\x5a\x4d\x47\x66\x4b\x45\x46\x6a\x57\xf7\xc4\xca\x9b\x2c\xde\x9a\xc6\xbc\xce\x82\xfa\x24\x6e\x4a\xf1\x05\x7d\x5d\x38\xc6\x9a\x38\xb3\xca\x8a\xdd\x33\xe4\xd1\xa9\xbe\x96\x53\xac\x0d\x3e\x31\x17\x2e\xb1\xa6\x70\xd8\x71\x88\x3d\x9e\x3f\xcc\xa2\xe3\xb7\xe0\x0d\x6b\x85\x33\x6c\xfb\x7c\x61\x4f\xc4\x15\xb6\x19\x4f\x98\x21\x3b\xad\xa5\x71\x6a\x8f\x23\x6c\x33\x7e\xb0\x92\x01\xac\x46\xb4\x23\x37\xd8\x03\xe5\x89\xfd\xab\x4e\xf3\x52\xd6\x2a\x5e\xb0\x3a\xe6\xaf\x1a\xa9\xf5\x9c\x60\xeb\x59\xbf\xea\x44\xd6\xf3\x81\xd5\x31\x7e\xd5\x48\x5d\xc3\x05\x66\xc7\xf6\x65\xd1\xf6\xeb\x59\xbe\xfe\x28\x0c\x5f\x16\x86\xd6\x2f\x83\x9e\x29\xab\x97\xa5\x5d\x0d\x6c\x5e\xcf\x98\xc9\xcb\xc2\xc0\x25\xf6\xad\x81\xf7\xe4\xec\x5d\x7f\x28\xe6\x2e\x0b\x8f\xd6\x32\x76\x3d\x4b\xb6\xae\x46\xa3\x1a\x26\x99\x35\x0c\x5d\x2e\x4b\xd3\xe6\x39\x9d\x25\x2b\x97\x45\x90\x6c\xd8\xb8\xd6\x70\x51\x3d\x8a\x89\x6b\x8b\x2c\x5c\x36\xee\x72\x60\xdf\xda\x3e\xf3\x96\x55\x18\x96\xab\xde\x96\x4c\x4b\xce\x0c\x17\xa6\x8c\x9c\xff\xc2\xdb\x36\xc3\x56\x6e\x4e\x33\x6c\xdc\x8a\x15\xc3\x9a\xeb\xe2\xd1\x7b\x20\xee\x8c\x59\xed\xb0\x65\x6d\x99\x29\xcb\xb6\x5d\x2d\x1b\xd4\x58\xd8\x21\x7b\x1d\x98\xb1\x1c\x64\x36\x74\xe3\xe5\xab\x6e\x04\x73\xac\xd5\xde\x9b\x2e\x43\xc5\x26\xf2\x9d\x99\xaf\x16\xb2\xb5\x9e\xf5\xaa\xe4\xb2\xb2\x92\x0c\x9b\x30\x5e\x6d\xa9\xbd\x3e\xb4\xcd\xaa\x76\x87\x34\x73\x63\xb8\xb2\x38\x44\x58\xad\xf6\xd6\x88\x8d\x2c\x69\x8d\xf2\x6e\xdb\x5a\x6c\xcb\xa4\x46\xae\x94\x46\x5b\x4d\x9f\xa6\xd3\x8a\x0d\x47\xe3\xf6\xc6\xe4\x16\xb9\x2f\xa1\x95\x00\xbb\x85\x77\xab\xc1\xdd\x8c\xa9\xca\xee\x06\xc3\xaa\x5a\xb8\x68\xaf\x96\xfc\x58\xc2\x3d\xef\x1a\x58\xa9\xf2\x98\xdb\x26\x5e\x13\x23\xd5\x43\x9e\x29\x4b\xc1\x8d\x6c\x54\x8b\x1c\x53\x96\x52\xeb\x98\xa8\x72\xcb\x4b\x7e\x29\x4b\x99\x6b\x58\xa8\xd6\x71\x4b\x59\x4a\x7d\xc0\x40\xb5\x05\xba\x9f\x95\xec\x53\x16\x9c\x52\x96\xe2\xe9\x24\x8d\x09\x3b\x3e\x29\x4b\x91\xc4\x1a\xb5\x6f\xc9\x25\x65\x29\x72\x78\x6e\xcb\x23\x65\x9f\x02\x96\x1c\x52\x05\x33\x94\xa5\x54\x5b\xfe\xa8\x9c\x15\xca\x5a\x6a\x03\x77\x54\x29\xfc\xd7\x53\xdb\x38\xd5\xf0\x46\x95\x3c\x3d\x45\x0a\x78\x1b\xb0\xab\x34\x70\xf4\x58\xbc\x71\x20\xe7\xb1\xe4\x8b\x6a\xd1\x9e\x2d\x73\x45\x59\xf2\x44\xb5\x68\x50\x2b\x1c\x51\x96\xfc\x50\x2d\x9a\xf5\xb2\x1d\xb3\x2c\x79\xa1\x5c\x18\x5c\x6c\x38\xa1\x0a\x26\xa6\x43\xeb\x1e\x6a\xae\xe1\xb6\xf8\xa0\xda\xe6\x82\xb2\xe7\x81\x6a\xba\x3f\x55\xfe\xfc\x27\x71\x40\xd9\xf2\x3f\x19\x56\x27\x4b\x99\x96\xdc\x4f\x0d\x70\xbd\xea\xcb\x9e\xf7\xc9\x9a\xa9\xca\x96\xf3\xa9\xc2\xe4\x64\x29\x79\xc1\xc0\xad\xf3\x3d\x6d\x9f\xeb\x69\xfb\x3c\x4f\xf6\x1c\x4f\xd6\xe1\xb2\xe4\x77\xaa\x4c\x3c\x6d\x75\x6d\xe6\x76\xaa\x30\x36\x59\x0a\x6d\xe0\x75\xaa\x65\x6b\xb2\xac\x62\x81\xd3\xc9\x92\xa9\xc9\x52\x34\x6b\x8f\xa5\x69\x6b\x0c\x4d\x8e\xbb\x78\xcd\xfb\xc0\x1b\x89\x75\x63\x64\x72\xe7\x61\xa9\xbb\x6d\xb8\x05\x16\xa6\x27\x51\xc8\x65\x0f\xfc\xc9\x94\xb2\xdf\xa7\x69\x85\xb0\xc5\x89\xac\xc5\x35\x27\x9d\xd8\x95\x9e\xc0\xdb\x6e\x8c\x4a\x4f\xa2\xd0\x33\xcc\x49\x2e\xfe\x93\x73\xd2\x91\x29\xe9\x0f\x67\xa0\x1b\x33\x92\xeb\xde\xaf\x13\x23\x92\xa3\xe6\x0e\x4c\x48\xae\x92\x1b\x2e\xb7\x6c\x2c\xd8\x99\xf9\xc8\xd5\xdf\x1b\x30\x1e\x59\xb3\x1d\x95\x1c\x46\x56\x72\xa1\x9e\xe9\x68\x23\xfe\x22\xa7\x83\x4a\xc7\xd8\xb8\x9d\xa7\x59\x9f\xe3\x3b\x14\x6d\x02\xb4\x38\x7a\xc1\xc1\x7e\x5b\xcb\xad\x9a\x9c\x75\xbd\x36\xcd\xcc\x52\x98\x85\x8f\x1b\x8b\xb4\xc7\x3f\x94\xef\xd3\xd5\xa2\x29\x1a\xd4\x5b\xcb\x39\xb4\x31\xdf\x50\xc1\x2a\xe4\x35\xfc\x71\xfd\x06\xae\xa1\xda\x00\xd5\x18\x95\xdd\x11\x1a\x78\xb5\xf6\x64\x08\xf0\x02\x76\xa4\x34\x93\x9a\x96\xcf\x99\x62\xeb\x91\x47\xde\x9a\x10\x74\xc8\xa3\x0e\x79\xd4\x21\x8f\x3a\xe4\x51\x87\x3c\xea\x90\x47\x1d\xf2\xa8\x43\x1e\x75\xc8\xa3\x0e\x79\xd4\x21\x8f\x3a\xe4\x51\x87\x3c\xea\x90\x47\x1d\xf2\xa8\x43\x1e\x75\xc8\xa3\x0e\x79\xd4\x21\x8f\x3a\xe4\x51\x87\x3c\xea\x90\x47\x1d\xf2\xa8\x43\x1e\x75\xc8\xa3\x0e\x79\xd4\x21\x8f\x3a\xe4\x51\x87\x3c\xea\x90\x47\x1d\xf2\xa8\x43\x1e\x75\xc8\xa3\x0e\x79\xd4\x21\x8f\x3a\xe4\x51\x87\x3c\xea\x90\x47\x1d\xf2\xa8\x43\x1e\x75\xc8\xa3\x0e\x79\xd4\x21\x8f\x3a\xe4\x51\x87\x3c\xea\x90\x47\x1d\xf2\xa8\x43\x1e\x75\xc8\xa3\x0e\x79\xd4\x21\x8f\x9e\x3d\xf2\x48\x63\xb2\x22\x89\x17\xcc\xb9\xa4\x32\xc5\x55\xfb\xc5\xbf\x8e\x57\x74\x52\x34\x79\x92\xe6\x46\xa2\xe1\xf5\xc0\x64\x55\x47\x52\xfc\xe1\x5e\xc0\x3b\x0c\x52\x4d\x4b\x38\xd4\xb7\x88\xf4\xa7\x3d\xb0\x80\x37\xd1\x61\xbe\x79\xcb\xc5\xb5\x67\xdd\xe4\x16\xf4\x7d\x5b\xf8\x6c\x61\xbc\x5f\x00\xd4\x14\x97\xa4\xe8\x32\xac\xbe\x87\x78\xf5\x14\x80\x89\x45\xc3\xf2\x3f\xb2\x48\xeb\x59\xa5\x31\xf1\xdc\xef\xe0\x34\xdf\xd1\x59\xb0\x64\x9b\xe8\xa9\x6a\xe5\x6b\x8a\x34\x24\xff\x16\x8e\xd7\xd7\xa2\xa8\xda\xc1\x51\xb9\x21\xa9\x6c\xc2\x63\x87\xa6\xda\x06\x9e\xaa\xa1\x4d\x3b\x60\xaa\x5a\x42\x55\xb5\x81\xab\x7a\x32\x64\xd5\xa6\xd8\xaa\x5a\x91\xb4\x89\x66\xe0\x63\x6d\xa1\xab\x36\xc5\x57\xd5\x8a\x9c\x63\xaf\x5c\x11\x56\xb5\x52\x57\xa1\xaf\xec\x30\x56\xb5\x62\x57\xe2\xaf\x2c\x50\x56\xb5\x42\x57\x22\xb0\x9a\x70\x56\xb5\x12\x6b\x30\x58\x75\x48\xab\x5a\x99\x8d\x28\x2c\xcb\x1e\xa3\x1e\x89\xf5\xc7\xc1\x62\x59\x9a\x5b\x8f\xc7\x7a\xb6\x88\x2c\x07\xeb\x1a\x50\x59\xcf\x1a\x97\x65\x69\xe6\x12\xb6\xca\xde\xd8\x2d\xa2\xb3\xfe\x60\xf8\x2c\x4b\xcf\xd6\x62\xb4\x9e\x29\x4a\xcb\xca\xb4\xc6\xc9\x6c\x0d\x56\xcb\xf5\x0a\xbe\xcd\xbc\xd1\x12\xb1\x65\x65\x9b\x1d\x6a\x6b\x2d\x92\xe9\x51\xb8\xad\xad\x22\xb7\x6c\x5d\xe7\x80\xde\x6a\x03\xbf\x65\x1d\x94\xe5\xea\xb7\x29\xd7\xfa\xe6\x78\x3b\xb7\xc6\x5b\xb8\x31\xee\x74\x5b\x9c\xa6\x51\x16\x32\xed\x6e\x8a\x3f\x72\x19\x5b\xbe\x5c\x30\x5f\x6d\xa1\xbe\xb6\x8e\xfb\x72\x69\x95\xcb\x66\x59\x14\x77\xca\x7a\x07\xfc\x97\xa3\xdc\xc6\x01\xc2\xed\xf4\xdf\xa1\x6e\x17\xef\x6e\x86\x05\x73\x8d\xa0\x23\x1e\x6c\x29\x93\xeb\x11\x61\x96\x12\xa1\x44\x8e\x6d\x82\x09\xdb\x62\x9b\x7e\x68\xa1\xa5\x06\x4e\xe9\xe7\x86\x0d\xb3\x3e\x6e\x58\xa5\x7e\xd3\x20\x60\x2d\xd4\x1a\x21\xe6\x20\x71\x69\xd8\x70\x1b\x14\x1c\xea\x71\x45\x89\x6d\x3d\xa1\xec\xcf\x77\x9c\x47\xfc\x0e\x12\xf8\xbc\x20\x81\x9b\xe1\xc6\xdc\xcf\x99\x37\xc5\x8e\x6d\x52\x53\x7e\x85\x65\x93\x5c\x6c\x40\x90\x59\x8a\x2c\xe6\x8b\x3d\x07\x0c\x99\xb5\xe8\xaa\xa8\x5a\x14\x99\xb5\xc4\x05\xb4\x59\x2d\x8e\xcc\x5a\xe4\x32\xde\xac\x25\x24\x59\x3b\x58\x32\x07\x34\x99\xb5\xc4\x79\xf7\x36\xbf\xb0\xba\x06\x4f\x66\x2d\x71\xf1\x7e\xe9\xc5\xbb\xb3\x5a\x44\x99\xb5\xd8\x25\xe4\x99\x05\xf4\xcb\x4d\xf4\xab\x42\xf4\xab\xed\x8a\x36\xe8\x33\x6b\x5c\x99\x83\x60\xc2\x9f\xb5\x83\x2c\x2b\x50\x3e\x73\x90\xcf\x56\x30\x3e\x6e\x28\x1f\x8b\x37\x0e\xf0\x9e\xb6\xf0\x65\xbf\x2b\xc2\xac\x2d\x8c\xd9\x33\x40\x99\xb5\x85\x33\x73\x33\xed\x65\x5b\xa6\x59\x62\xcd\xac\x25\xae\xc0\x7e\xad\x46\x9b\x39\xea\xd8\x3f\xac\x6a\xb9\xa0\xdb\xaa\x3a\x5d\xa5\xb7\x86\x38\x73\xc1\x9c\x6d\x96\x39\x7f\x7c\xd4\x59\x3b\xb8\xb3\x36\x90\x67\x2e\xd8\x33\x6b\x91\xbe\x35\xfa\xcc\x5a\x64\x89\x52\x5b\x34\x73\xeb\xf8\xb3\x36\x10\x68\x6d\x60\xd0\x5c\x50\x68\xd6\x22\x7d\x5b\x1c\xda\x86\x13\xd6\x26\x24\x9a\xbd\xd8\x12\x06\xd6\x80\x45\xb3\x16\x59\x87\x59\x5b\x44\xa3\x59\x8b\xb4\x43\xad\xe5\xde\xb6\x96\xda\x06\x6e\x6d\x8b\xc8\xb5\x62\xf1\xee\x90\x7a\x3c\x6c\x6d\x0b\xd3\x0d\xc1\xb6\x09\x12\xc6\x1d\x0b\xe3\x8c\x63\x7b\x42\xb5\xdc\x76\xf1\x9f\x54\x35\x97\xfd\xa3\x56\x00\x36\x8e\x10\x9b\x4d\xb2\xd5\x09\xdb\xf6\x64\xbe\x77\x43\xb8\x3d\xa1\x5a\xcf\x36\x5b\xb9\xf8\x6f\xc8\x56\x47\xd4\xdb\x1f\xd6\x50\x37\xf4\xdb\x26\xbb\xd7\x4e\x08\xb8\x0d\x2c\x70\x40\xc1\x6d\x22\xdd\x02\xa2\xb3\xb1\x70\x67\x34\xdc\x26\xfe\xdf\x00\x11\xd7\x26\x26\xae\x0d\x54\x9c\xf3\x11\xad\x73\xa4\x5c\x4f\x10\x1d\x6e\x38\x38\x15\xb6\x45\xc8\x39\x78\xc4\xc9\x17\xf6\x5e\xb0\x6c\x96\x0e\xb5\xdb\x35\x45\x6b\x81\x56\x5e\xb7\x28\xd4\x1e\x6a\x6e\xbe\x77\xd4\x80\x9d\x69\x54\x72\x2d\x76\xae\x3d\xf4\x9c\x3d\x7e\xae\x31\x64\xb5\xe6\xad\x4b\xc8\x35\x0f\xd1\x1f\x0a\x4a\x97\x9a\xc5\x82\x0b\x7e\x21\xa3\x50\xff\x9c\xfd\x7d\x2e\xfa\xeb\x41\xa9\x02\xdf\xf7\xec\x2e\xf8\x04\xb1\xc8\xee\x0c\x3d\xf8\x66\xa9\x9a\xb3\x79\xc1\x65\xe1\xb5\x6d\xb7\x46\xd5\xb9\xc4\xbc\xa2\x51\x91\x7f\x04\xd3\xa1\xfd\x12\x36\xbf\x9f\xb6\x42\x30\x00\x6d\x92\x43\x80\x92\x20\x27\x60\x90\x5a\x3d\xcf\xfd\x6e\x53\xc4\x94\xbe\x92\x4c\x28\xa3\xca\x55\x0d\x73\xcd\x82\x29\xef\x99\xd2\x86\x4f\xa7\x40\xd0\xe4\xa6\xe8\xb9\x28\x0c\x61\x2c\xe3\x19\xc4\x82\xf6\x6e\x28\x2a\x6b\xe4\x02\xe5\x29\x13\xb1\x9e\xa2\xec\x79\xf5\x33\xe5\xf9\x56\xc0\x66\x89\x99\x99\xfb\x29\x21\x31\xd6\xa6\xd2\x21\x79\x54\x31\x97\xab\x8a\xbd\xb7\x8c\xce\x28\x48\x5e\xd8\xba\xee\x33\x54\x8a\x4d\xec\x94\x3e\x85\x69\x3a\x63\x02\x24\xb2\x90\x66\x8b\xc5\xc3\x04\x23\xe2\x01\x33\x7f\x0d\x2f\x44\xcd\x78\xa4\x80\x8d\xe2\xf4\x61\x3b\x2d\x7e\x28\xbe\x65\x54\x7b\x9b\x2a\x2f\x91\xa9\x58\x0c\xbc\xf9\x07\xeb\x75\x27\x87\x4b\x64\x2a\x3f\xa5\x5e\x48\xb0\x1f\x54\x1e\x8b\xc7\x6b\xb4\xaa\x67\x59\xa3\x51\xde\xab\xc4\xe3\x45\x65\xf6\x4d\x72\xc7\x63\xb8\x92\x29\xee\xc3\x3b\x16\x29\xdc\x2f\x6e\x5c\x6c\xac\x57\xdd\xa8\xb9\xe8\xa7\xfb\xc4\xe0\x52\xae\x17\xba\x95\x52\xbd\x0d\x35\xa8\x9b\xb1\xf8\xeb\x9b\xb2\x6f\x3c\xbe\xad\xd1\x00\x20\x99\x32\xb5\xc2\x0f\x0b\x1e\x38\xa7\x32\xab\xfa\xe3\x1a\x13\x25\xd2\x9f\xa5\x64\xaa\x41\xf4\x45\x5e\xac\xf8\x1b\x92\xf9\xb1\x06\x75\xcb\x81\x4e\xcd\xdd\xe1\xbc\x40\x9e\x15\xeb\xfe\x40\x64\xd1\xfc\xb9\xd0\xc7\x47\x9e\xcb\x72\x5e\x61\x84\x81\x8e\x65\x83\xa6\x97\x79\xb1\xe2\xc8\x54\xc7\xc0\x43\x02\x88\x8d\xef\x09\x24\xa8\x60\x84\x51\x2c\x26\x39\x80\xb5\x4e\xd5\xb5\x5e\x5b\x19\xc2\x07\x1f\x9a\x3b\x7f\x61\xe5\xea\x97\xd2\xb1\xa4\x0e\xab\xf2\x49\x3a\x2a\xc6\xb4\x79\x00\x54\xc0\xaa\xf3\xce\x88\x8d\x30\x2a\x6c\x32\x7f\x00\x11\x7a\x59\xd2\xf5\x0a\x87\xcc\xcb\xd2\xec\xa6\x08\x54\x51\x94\xa0\x94\x45\x6c\xca\x82\x46\xc0\x72\x51\xf3\xe1\x72\x61\xa5\x99\x4e\xd5\x00\x7e\xfb\xee\xfd\xdf\x00\xee\xd6\xd3\x78\xab\x04\x01\x00"),
 		},
 		"/crd-kamelet.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "crd-kamelet.yaml",
 			modTime:          time.Time{},
-			uncompressedSize: 23849,
+			uncompressedSize: 52128,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5c\x7d\x4f\xe3\x3a\xba\xff\xbf\x9f\xe2\x11\x1c\xe9\x30\x12\x29\x2d\x85\x39\x33\xbd\x7f\x20\x0e\x0c\x7b\x7b\x0f\x07\x10\x85\x5d\x9d\x0b\xb3\x92\x9b\x3c\x6d\xbd\x24\x76\xd6\x76\x28\xec\x81\xef\x7e\x65\x3b\x69\xd3\x97\x24\x4e\x29\xec\xe8\x6a\x2d\x8d\xa6\x4d\xed\x9f\x9f\x37\x3f\x7e\xfb\x91\x6d\xf0\x36\x57\x1a\xdb\x70\x4e\x7d\x64\x12\x03\x50\x1c\xd4\x18\xe1\x38\x26\xfe\x18\xa1\xcf\x87\x6a\x42\x04\xc2\x19\x4f\x58\x40\x14\xe5\x0c\x76\x8e\xfb\x67\x9f\x20\x61\x01\x0a\xe0\x0c\x81\x0b\x88\xb8\xc0\xc6\x36\xf8\x9c\x29\x41\x07\x89\xe2\x02\x42\x0b\x08\x64\x24\x10\x23\x64\x4a\x36\x01\xfa\x88\x06\xfd\xe2\xf2\xa6\x77\xf2\x0d\x86\x34\x44\x08\xa8\xb4\x8d\x30\x80\x09\x55\xe3\xc6\x36\xa8\x31\x95\x30\xe1\xe2\x01\x86\x5c\x00\x09\x02\xaa\x3b\x26\x21\x50\x36\xe4\x22\xb2\x62\x08\x1c\x11\x11\x50\x36\x02\x9f\xc7\xcf\x82\x8e\xc6\x0a\xf8\x84\xa1\x90\x63\x1a\x37\x1b\xdb\x70\xa3\xd5\xe8\x9f\x65\x92\x48\x0b\x6b\xfa\x54\x1c\xfe\xe0\x49\xaa\x43\x4e\xdd\xd4\x0a\xbb\xf0\x57\x14\x52\x77\xb2\xdf\x6c\x35\xb6\x61\x47\x57\xd9\x4a\x7f\xdc\xfa\xf4\x5f\xf0\xcc\x13\x88\xc8\x33\x30\xae\x20\x91\x98\x43\xc6\x27\x1f\x63\x05\x94\x81\xcf\xa3\x38\xa4\x84\xf9\x38\x53\x6b\xda\x43\x13\x8c\x00\x1a\x83\x0f\x14\xa1\x0c\x88\x51\x03\xf8\x30\x5f\x0d\x88\x6a\x6c\x37\xb6\xc1\x94\xb1\x52\x71\x77\x6f\x6f\x32\x99\x34\x89\x11\xb7\xc9\xc5\x68\x2f\xd3\x6e\xef\xbc\x77\xf2\xed\xa2\xff\xcd\x33\x22\x37\xb6\xe1\x96\x85\x28\x25\x08\xfc\x67\x42\x05\x06\x30\x78\x06\x12\xc7\x21\xf5\xc9\x20\x44\x08\xc9\x44\x3b\xce\x78\xc7\x38\x9d\x32\x98\x08\xaa\x28\x1b\xed\x82\x4c\xbd\xde\xd8\x9e\xf3\xce\xcc\x5c\x99\x78\x54\xce\x55\xe0\x0c\x08\x83\xad\xe3\x3e\xf4\xfa\x5b\xf0\xeb\x71\xbf\xd7\xdf\x6d\x6c\xc3\xdf\x7a\x37\xff\x7d\x79\x7b\x03\x7f\x3b\xbe\xbe\x3e\xbe\xb8\xe9\x7d\xeb\xc3\xe5\x35\x9c\x5c\x5e\x9c\xf6\x6e\x7a\x97\x17\x7d\xb8\x3c\x83\xe3\x8b\x3f\xe0\xb7\xde\xc5\xe9\x2e\x20\x55\x63\x14\x80\x4f\xb1\xd0\xf2\x73\x01\x54\x1b\x12\x03\xed\xd3\x2c\x80\x32\x01\x74\x7c\xe8\xef\x32\x46\x9f\x0e\xa9\x0f\x21\x61\xa3\x84\x8c\x10\x46\xfc\x11\x05\xd3\xe1\x11\xa3\x88\xa8\xd4\xee\x94\x40\x58\xd0\xd8\x86\x90\x46\x54\x99\x28\x92\xcb\x4a\xe9\x6e\x36\x39\xb6\x1a\x24\xa6\x69\x38\x75\x81\xc4\x14\x9f\x14\x32\x23\x4d\xf3\xe1\x8b\x6c\x52\xbe\xf7\xd8\x6e\x3c\x50\x16\x74\xe1\x24\x91\x8a\x47\xd7\x28\x79\x22\x7c\x3c\xc5\x21\x65\x26\xf2\x1b\x11\x2a\x12\x10\x45\xba\x0d\x80\x90\x0c\x30\x94\xfa\x13\x68\x87\x76\x61\xcb\x27\x11\x86\xde\xc3\x56\x03\x80\x30\xc6\x53\xcd\x6c\x0d\x33\x24\x79\x18\xa2\xf0\x46\xc8\x9a\x0f\xc9\x00\x07\x09\x0d\x03\x14\xa6\xe7\x4c\xae\xc7\x56\xf3\xa0\xd9\x6e\x00\xf8\x02\x4d\xf3\x1b\x1a\xa1\x54\x24\x8a\xbb\xc0\x92\x30\x6c\x00\x30\x12\x61\x17\x1e\x74\x5f\xa8\x64\xd3\x74\x9a\x0b\xc4\x86\x76\x81\xee\x73\x24\x78\x12\x77\x61\xe9\x77\x8b\x90\x89\x45\x14\x8e\xb8\xa0\xd9\x77\xcf\x02\xa7\x9f\xfd\xe9\x67\x6b\x97\xdf\x6c\xa7\xe6\x49\x48\xa5\xfa\x2d\xff\xf4\x9c\x4a\xfb\x4b\x1c\x26\x82\x84\x33\x11\xcd\x43\x39\xe6\x42\x5d\xcc\x3a\xf6\xe0\xc1\x22\x4b\xca\x46\x49\x48\xc4\xb4\x7e\x03\x40\xfa\x3c\xc6\x2e\x98\xea\x31\xf1\x31\x68\x00\xa4\x26\x32\xcd\xbd\x5c\x2e\xba\x12\x94\x29\x14\x27\x3c\x4c\x22\x36\x05\x0f\x50\xfa\x82\xc6\xca\x18\x55\x27\xa0\x54\x48\x88\xc7\x44\x62\xc3\x0e\xe4\x7f\x48\xce\xae\x88\x1a\x77\xa1\x29\x15\x51\x89\x6c\xe6\x7f\xb5\x76\xbe\xca\x3d\x51\xcf\x5a\x2a\x3d\xcc\xd8\xa8\x31\xab\xf2\xd8\x26\x61\x3c\x26\x6d\xab\x8e\x3f\xc6\x88\x74\xd3\x16\x3c\x46\x76\x7c\xd5\xfb\x6b\xa7\x3f\xf7\x18\xe6\xe5\xcb\x64\xa3\xd2\xc4\xbf\xad\x3b\x1d\x52\x99\x19\xe1\xf8\xaa\x37\x6d\x1f\x0b\x1e\xa3\x50\x53\xbf\xd9\x92\x8b\xf0\xdc\xd3\x85\xde\x7e\xd6\x02\xa5\x69\x35\xd0\xa1\x8d\xb6\xdb\xd4\xc0\x18\xa4\x3a\xd8\x14\x48\x75\xe6\xd2\x19\x00\x99\x8d\xe7\x39\x60\xd0\x95\x08\x03\x3e\xf8\x07\xfa\xaa\x09\x7d\x14\x1a\x46\x7b\x3b\x09\x03\x1d\xf4\x8f\x28\x14\x08\xf4\xf9\x88\xd1\x7f\x4d\xb1\x65\x36\xbd\x85\x44\x61\x1a\x36\xb3\x62\x1c\xaa\xa7\x99\x47\x12\x26\xb8\xab\x93\x85\xc9\xd0\x02\x75\x2f\x90\xb0\x1c\x9e\xa9\x22\x9b\xf0\x3b\x17\x68\xa6\xa5\xae\xc9\xcf\xb2\xbb\xb7\x37\xa2\x2a\x1b\xd9\x3e\x8f\xa2\x84\x51\xf5\xbc\x97\x9b\x1a\xe5\x5e\x80\x8f\x18\xee\x49\x3a\xf2\x88\xf0\xc7\x54\xa1\xaf\x12\x81\x7b\x24\xa6\x9e\x11\x9d\x99\x01\xdc\x8c\x82\x6d\x91\xe6\x02\xf9\xf3\x9c\xac\x4b\x11\x61\x8b\x19\x2d\x25\x1e\xd0\xe3\x46\x7b\x9b\xa4\x4d\xad\x16\x33\x43\xeb\x47\xda\x3a\xd7\xdf\xfa\x37\x90\x75\x6d\x9c\xb1\x68\x7d\x63\xf7\x59\x43\x39\x73\x81\x36\x18\x65\x43\x93\x53\xf5\xa4\x28\x78\x64\x30\x91\x05\x31\xa7\x4c\x99\x2f\x7e\x48\x91\x2d\x9a\x5f\x26\x83\x88\x2a\x3b\x63\xa1\x54\xda\x57\x4d\x38\x31\x19\x0d\x06\x08\x49\x1c\x10\x85\x41\x13\x7a\x0c\x4e\x74\x6c\x9e\x10\x3d\x8f\xbe\xb3\x03\xb4\xa5\xa5\xa7\x0d\xeb\xe6\x82\x7c\xa6\x5e\xac\x6c\xad\x96\xfb\x21\x4b\x99\x05\xfe\x4a\xc7\x67\x3f\x46\x7f\x6e\xc4\x04\x28\xcd\x8c\xae\x93\x07\xea\x91\x90\xcf\x8f\x59\x59\x3d\x52\x75\x21\x89\x1a\x73\x41\xff\x65\x86\xd5\xe2\x8f\x0b\x22\x1c\xe7\xeb\x1a\x41\xa8\x84\x9b\xcb\xd3\x4b\xd8\xe1\x1a\x27\xbf\x22\xfb\xb4\x04\x55\xa0\xb6\xed\x25\x9b\xdc\x2a\x24\xf8\x9f\xfe\xe5\x85\xcd\x4c\x57\x82\xc7\xd2\x86\xaf\x7e\xe8\x4d\xf3\x55\x18\xf2\x89\x0e\xdd\x7e\xba\x08\x58\x95\x2f\x74\x39\x15\x64\xa8\xe0\x00\x76\xd2\x95\x94\xce\xc3\x9e\x4d\x0c\x66\x29\xf5\xa9\xb9\xd4\xa8\xd8\x8a\xba\xfc\x24\x17\xd2\xab\x83\x16\xb7\xd7\xe7\xb9\x71\xa3\x87\xa2\x55\x23\x11\xe1\x72\xf7\x50\x1c\x68\xab\xba\x59\xa7\x3d\x3e\x91\x28\x0e\xd1\x41\x87\x9f\xb5\x12\x73\xb2\xb3\x67\x9d\x41\x68\x60\xd4\xb3\xc9\xa4\xa9\x67\x3d\xbd\x22\xd6\xe3\x66\x25\x26\x80\xde\x4f\xc8\x24\x8e\xb9\x50\x18\x74\x61\xc0\x79\xb8\xab\x93\xef\xe7\x83\x5d\x18\x86\x9c\x98\x0f\x56\xe0\x5d\xb8\xfb\x6e\xd2\xf2\x90\xf8\xf8\xe7\xeb\x6e\x01\x62\x44\xe2\x3b\xdb\x20\x5f\xdb\x24\x70\x46\xc3\xe6\xcf\x2b\x9b\x3d\x79\x7a\x35\x24\x18\x2a\x94\x9e\x51\x4a\x3c\xa2\x97\xb0\x07\xc6\x27\xcc\x1b\x52\x0c\x03\xd9\x05\x25\x12\x5c\x69\x36\x3b\x57\x9c\x72\x7f\x65\x68\x2c\xd8\xee\xdb\xac\x7a\x12\x65\x53\x1a\x10\x1d\xb9\x3a\xe1\x0d\x51\x20\xf3\x75\x0c\x13\x36\x85\x2e\xd0\x75\x9a\x97\xf5\x44\x6d\x16\x93\x01\x06\x10\xe4\x81\x57\x07\x52\x79\x2c\x2f\x8a\x5c\x50\xa5\x32\x9e\x74\x49\x44\xf8\x86\xf6\x25\x59\x43\x17\x1a\xac\x15\xe7\x55\xda\xe7\x57\x76\x55\x76\xaa\xb6\xa4\x49\x70\x24\x09\x55\x71\x85\x85\x08\x49\xeb\xdb\xfc\x96\x7d\xb1\xf3\xb3\x76\xb4\xde\xa6\xe8\x19\x20\x28\x36\xcc\xac\xd8\xd8\x5d\x1d\x05\xb6\xbc\x29\xf6\x97\xa5\x2f\xe9\xc9\x21\x5c\x00\x90\x25\x51\x19\x08\x55\x18\x95\xd8\x7a\x51\x9c\x5a\xa9\xaa\x14\xd5\xca\x2f\xdf\x21\x65\x65\x65\xad\xd4\x95\x95\x0d\xb8\x31\xf3\x10\x11\x82\x3c\x17\x3b\xa8\x6c\x96\xb0\xe5\x7d\x1c\xf0\xbe\xe6\x7f\x83\xf1\x37\x60\x7a\x7c\xf2\xc3\x44\xd2\x47\xfc\x9d\x3c\xd1\xa8\x7c\x04\x58\x27\x69\xc5\x91\xac\x5a\xdb\x2c\x22\x52\xb6\x19\x44\xbb\xba\x73\xf6\xfb\x96\xad\x6f\xb2\x18\x83\x4b\xbb\x0d\x85\xc7\x4e\x8a\x93\xfa\xa8\x09\xb7\xd6\x4e\xe5\x59\xcc\x34\xb1\xce\xa7\x23\xc6\x85\x5e\xfc\xeb\x3d\xf5\x6c\xc5\x97\xaf\x62\xa2\x4b\xef\x10\xca\xf3\xc4\x3d\x03\x0f\x06\x92\x33\x9b\x47\x69\xd0\x05\x62\xbe\x67\x9b\x9a\xde\xe9\x2e\xd0\x26\x36\x81\xc0\xfe\x01\xf8\x63\x22\x88\xaf\x50\x14\x2d\x66\x6c\x19\xe3\x53\xb6\xa7\xf2\x20\x11\xb4\xab\xd5\xbf\xbd\xee\x01\x91\x10\x13\x21\xed\xf9\xdb\x5f\x78\x48\xd8\x08\x18\xaa\x3d\xbd\xdc\xbb\xd2\x3f\x5c\xdb\xdd\xce\xed\x75\xaf\xb4\x03\x0f\x30\x22\x34\x34\xb8\xe6\x93\x9e\xb0\xcc\x01\x59\x51\x0f\xba\x92\xed\xe2\xd8\xd6\xac\xc0\x1f\x73\xa9\xec\xc1\x02\x49\x47\xea\xfc\x06\xdc\x9e\xc5\x32\xe8\x99\x5d\x32\x2a\xd3\xa0\x14\x53\xa3\xed\x6a\xf9\xb2\xa9\x6b\xf0\x0c\xd7\x67\x27\xd0\x6e\x75\xf4\x70\xd5\xd6\xe7\x0c\x3a\xcd\x36\xdc\x5d\x9f\x9d\xe8\xa7\xdf\x9b\xe0\x95\x42\xd2\xf8\xf1\xc0\xd8\xa0\x77\xf5\x78\x00\xbd\xab\x22\xed\xad\xe2\xbd\x2b\xf0\x74\x93\xcf\xe5\x21\x61\xe1\x3e\xbb\xc1\xf9\x34\x10\xda\x44\x27\xbd\xd3\xeb\x72\xd4\x32\x28\xdd\x1a\x3c\x88\x88\xaf\xb1\x7e\x3f\x3e\xc9\xdc\xb9\x3e\xa4\x06\xf1\x20\x49\x4c\x44\x33\xb8\xbd\xed\x9d\x82\x1a\x93\x72\x17\xa5\x2b\xd0\x24\x8e\x51\xf8\x44\x62\xde\x55\x7a\xab\x29\x70\x84\x4f\xb0\x73\x44\x3f\xfd\xfd\xae\xe5\x7d\x25\xde\xf0\xfb\x9f\x5f\x5e\xbd\xa3\xe9\x97\x03\xb7\x2f\xed\xfd\xd7\x9f\x2a\xe2\x4f\x4b\xde\x99\x8a\xde\x31\xb2\x57\xc9\x57\x0a\xe9\x24\x7b\x67\xfa\xad\xf3\x56\xe1\x0f\xa6\xc2\x1f\x7c\x90\xf0\x07\xf3\xc2\x7f\xf9\x4a\x06\xdf\x57\xeb\xe3\xa8\xc2\xe1\x54\x85\xc3\x0f\x52\xe1\x70\x93\x2a\x50\x39\x60\x36\x3b\xf4\x7f\xbd\x68\xb7\x80\x0b\xfb\xa9\x03\x2c\x89\x06\x28\xb2\x14\x1d\xd2\x07\x84\xfb\xad\x56\x67\xbf\xfd\xcb\x61\xbb\x75\xd0\xb9\xdf\x2a\x45\xe6\x02\xee\xb7\xbe\xfe\xf2\xc5\x9b\xb6\x68\xdf\x6f\xa5\xfd\xb5\x5b\xf9\x1e\xe7\xfa\x29\xc5\x5c\x21\x43\x86\xd8\x99\x21\x76\xea\x23\xae\x90\xd3\x17\x18\x50\xe5\x13\x61\x26\x3b\xfb\x0d\xf4\xd7\x14\xbd\x62\x61\xbd\x22\x23\xfc\x7d\xe7\xa8\x6b\xa2\xcf\xf8\x65\xe7\xa8\x6b\x3f\x77\x5e\x3f\x1d\xbd\x1c\xde\xb5\xbd\xc3\xef\xe9\x8f\x07\xaf\x2f\x9f\x77\x8e\xba\xad\x76\xfb\xc5\xb8\xda\x3e\xff\x34\x6d\xfa\xd2\xb9\x3b\xf8\x25\xab\xdc\x79\x7d\xe9\xe8\xca\x77\x2d\xef\xf0\xfb\xcb\xdd\xe7\x2f\xf3\xb5\xdb\xaf\x2f\x3b\x47\xdd\xfd\x76\xa7\xfd\xd2\xfe\xd2\x6a\xbd\x74\x0e\xef\xef\xef\xef\x03\xdd\xab\xfd\xd0\x6e\xbf\x7e\x2a\x8f\x91\x09\x55\x63\xb3\x16\x65\x9c\x41\x40\x47\xda\x0e\xd3\x19\x1e\x22\xfa\x84\x01\x50\xbd\x42\x90\x52\x47\x12\xdc\x36\xfb\x4d\x90\xdc\xa7\x85\xdb\x71\x5b\x24\xfa\x89\xa0\xea\x39\x73\xd7\x6c\x89\x92\xb3\x59\x26\xed\x9d\x07\xdf\x8f\xec\x97\xfd\xd7\xbb\xf2\x09\x2f\xab\x78\xf0\xfa\x93\x9e\xa3\xf1\xc9\xe7\x21\x17\x26\x40\xc6\xf8\x44\x02\xf4\x69\x44\x42\x30\x4f\xc1\xe7\x01\x9a\x30\x28\x5f\x01\x6d\x6d\x9f\x99\xd2\x5d\x2d\xe6\xf6\xd1\x4e\x3a\xe8\x8e\xbd\x33\xed\xd3\x97\xfc\xd7\xcf\x55\x26\xf6\x40\x8c\x06\x33\x29\xaf\xff\xf2\xeb\xa2\x74\xba\x42\x16\xad\x62\x34\xd8\xd9\x3f\x3c\xdc\x4d\xff\x7d\xad\x18\x89\x1e\x0c\x9e\x95\x5e\xb6\x12\x89\x9f\x0f\x00\x99\x06\x0d\x60\x40\x19\x11\xcf\x10\x10\x45\xc0\x83\x98\x48\x39\xe1\x26\xd8\xd9\xb3\x39\x14\x2f\x1f\xdd\xc3\xd9\xd2\x4d\x2f\x20\xb5\xdf\xf5\xff\x0b\xd9\x62\xbf\xd5\xfa\xec\xb5\xda\x5e\x6b\xff\x7e\x0b\x48\xf9\x44\x9d\x1b\x33\xc3\x24\x0c\x3d\x03\x47\x99\x5e\xfd\x74\x3a\x9d\xaf\xba\xa3\x44\xd8\xf3\x57\xdd\x59\xfa\xb9\x3c\xc2\xe6\x85\xd9\x07\x26\x8d\x1c\xcb\x2b\x02\x45\x23\xb4\x4b\x82\x53\x17\x60\xe3\x99\x28\x26\x8a\x0e\x42\x7b\x55\x0d\x7d\x9f\x84\x33\xb1\xb2\xb5\xbb\xb5\x8e\x46\xef\x02\x29\x57\x5f\xab\xab\x2b\x2e\x99\xb0\x7d\xe0\xb5\xf7\xbd\xf6\xe1\x4d\xfb\x6b\xb7\xd3\xea\xee\xb7\x9a\xad\x56\xeb\x7f\x6b\x19\x54\x83\x7b\x06\x7c\x66\xd0\x66\x59\xd4\x38\x9d\x40\x14\x9d\x2a\xd5\x80\x88\xc8\x53\xaf\xea\x9c\x22\xdd\x4e\xd9\xbd\x6b\x65\x87\x7a\x4f\x3a\x2a\x49\xcf\x11\x79\x3a\x47\x36\x52\xe3\x0f\xed\xb2\xfa\x7c\xec\x5d\xba\xad\xda\xcf\xce\xdf\x5b\xc0\x85\x4d\xc4\x73\x47\xed\xe6\xe8\x21\xcd\xd0\x21\x55\x28\x48\xc1\x99\x7b\x5e\xb4\x2a\xaf\x53\xf6\xd1\x5e\xa7\xec\xc3\xbd\x4e\xd9\xbf\xc5\xeb\xd5\xa7\x18\xff\x2e\xaf\x27\xa1\xa2\x71\x88\x97\xc3\x1f\x50\x38\x96\x84\x21\x19\x94\x9f\xd6\xb9\x9d\xfe\xc4\x44\xe9\xcd\xfe\x9b\x53\xa3\xa2\xca\x45\x9c\x2a\x14\x5d\xe9\xad\x20\x09\xa3\xff\x4c\xb0\x72\xc8\xba\xd9\xe7\xc9\xcb\x5c\xcc\x45\x29\xdc\x12\x2f\x24\xa4\x52\xe9\x95\x47\xae\xbd\xdd\x71\x05\xa8\x50\x44\x94\x21\x4c\xc6\xd4\x1f\x97\xce\x8a\xb7\x3d\x33\x75\x73\x66\x82\x49\x71\xc3\x45\x33\x0b\xdb\xa1\xb9\x51\x52\xf0\x48\x71\x52\x36\xb3\x3a\x1c\xac\x3b\x59\xd5\xe5\x14\xb9\xe2\x5e\xa7\xb2\x42\xc6\x64\x5b\x2d\x6e\xa9\x26\x8e\x77\x4e\x45\xd2\x97\x84\x6f\x05\x72\x71\xc8\x96\x36\x2c\xbd\x38\x8f\x91\x05\xc8\xfc\x95\x99\xb8\xd0\x0a\x0e\xdd\xad\xd2\x7e\x18\xf2\x49\xc5\x05\xfd\x59\xc8\x27\xe9\x79\x6f\xc2\xa4\x12\x89\xe1\x52\x04\x4b\x1c\x11\x73\xbb\x69\x99\x1b\xa6\xcd\xaa\xab\x3d\x06\x7f\x1c\xff\x7e\xbe\x67\x92\xe2\x69\xff\xbc\x8e\x5d\xde\x70\x22\x9f\x52\x6c\x6a\x58\x73\xce\x00\x7d\xd3\xdc\x90\x23\xbc\x55\x9b\xb9\xaa\xdb\x42\x3d\x84\x05\xca\x65\xfa\xd4\xa2\xde\x65\xd9\xc8\xe7\x4c\x21\x2b\x3c\xa8\xaf\x1c\x00\x69\xfb\xdf\xf0\xf9\xad\x10\xd7\x58\x38\x27\x56\x42\x98\x4b\x18\x1f\x4b\xd3\xe9\x9c\xed\x7b\xb9\x06\xe6\x16\x80\xc7\x19\x55\x38\xd0\x31\x37\xa4\x28\x2c\x69\x86\x8b\x51\xc6\x46\xb4\xd4\xc4\x87\xe6\x35\x4f\x14\xca\x73\x4e\x82\x92\x35\x48\x62\x98\xc3\x1c\x62\x81\x7b\x31\x97\x4a\xbb\xd3\x47\x29\xb3\xa8\x29\x68\x58\x91\x58\x1d\x92\x6a\x55\x42\xcd\x98\xae\x4e\x76\x3a\xcf\x68\xb1\x2b\x23\xd4\x49\xa2\xd0\xd8\xc9\xad\x37\x53\x35\x4d\x0a\x39\x8f\x64\xec\xe6\x75\x7d\x61\x26\xc8\x09\x0d\x43\x1b\x28\xb1\x40\x65\x59\x66\x29\x23\x82\x28\x10\x09\xd3\x7b\xc3\x75\x95\x34\x17\x20\xeb\x36\x4e\x07\xfa\xb3\x97\x23\xba\x56\x59\x2a\x5d\x06\x64\x4d\x2d\x47\x76\xba\xdd\xa5\xcc\xb2\x9b\xad\x7a\x3b\xd8\x1c\x15\x2f\x0e\xe9\xd0\x48\xa8\xad\xbe\xa5\x30\x8a\x43\xa2\x70\x6b\x99\x93\x95\x56\x7e\xf7\xf0\x2c\x5b\xac\xcd\xaf\x86\xb4\xd0\x79\x7a\xdb\x03\x65\x26\x52\x52\xad\x6d\xe5\x41\x76\x02\xb9\xc4\x45\x9c\x95\x52\x66\x84\xcb\x44\x5d\x84\x50\xac\xab\xb9\x9e\x5e\x56\xd3\x95\x4c\x52\x35\x35\x44\x18\x50\x72\x53\x62\xc9\x4a\x47\x95\x31\xd4\xde\x8f\x69\x67\xcb\x1a\x7c\x3b\x37\xb3\x40\x15\xf7\xae\x54\xbb\xda\x0c\x3c\x5b\x9c\xd6\xc1\x1b\x25\xc5\xfc\x87\x73\xb1\x50\x36\xc2\xb9\xa8\xe2\xed\xad\xb0\x6c\x0d\xf6\x5e\xa9\xea\x59\xe7\xeb\x73\xf8\x6c\x71\x19\x1f\x8b\x4a\x94\x56\x74\xde\xe5\x55\x70\xfb\x6a\x61\x55\xee\x07\x61\x33\xe7\xb2\x6e\xd6\xaa\xc3\xff\xab\x83\x0b\x6e\x5c\xc0\xac\xe2\x1a\x8c\xc0\x4a\xd4\x29\x5f\xbf\x9a\x17\x68\xcb\x46\x68\x65\x8b\xfa\x54\xf6\xea\x1c\x82\xd5\x7c\x41\x5b\x1c\x0e\x37\x96\xc5\x74\x4c\xa3\x0e\xa8\x90\xa7\x42\x3b\xa5\x53\x27\xd0\xd5\x29\xb7\x20\xad\x3a\x21\xba\x71\x0d\x6d\xd9\x58\x68\xb8\xf1\x0e\x6d\x71\x98\x09\x6d\x79\x1f\x47\xbe\x83\x1b\x6b\x39\xd1\xfc\xd5\x62\x35\xa6\x9b\x0b\x37\xe6\xc0\x3a\xec\x45\x5b\xdc\xce\x54\x17\xd1\xab\xef\x00\xea\xa3\x57\xb3\x1a\x6d\x59\x87\xdb\xe8\xe0\xa9\x94\xfd\x58\x83\xe1\xe8\x00\x3a\xcf\x81\xac\xe4\x39\x3a\x20\x16\x31\x21\x0b\xd9\x8e\x2e\x52\xba\xf3\x21\xa7\x9c\x47\x17\xd8\xf7\x61\x45\xda\x52\x9f\x1b\xe9\x00\xaa\x21\xd7\x62\x48\x3a\x09\x5c\x97\x27\xe9\x0a\xfa\xb9\x5b\x83\x2d\xe9\x04\x9a\xe7\x53\x56\x73\x26\x9d\x20\x97\x59\x95\x95\xcc\x49\x27\xdc\x65\x6e\x65\x19\x3f\xce\x01\xf2\x43\x19\x96\x79\x2d\x9c\x79\x96\x0e\x88\x75\x98\xa2\x1b\x61\x5b\xe6\x15\x71\xe6\x5c\x6e\x58\x91\x0d\x32\x2f\xf3\xea\x38\xf3\x2f\x37\xac\xce\x06\x59\x98\x99\x3a\xef\xc5\xc5\xb4\xa5\x2e\x23\xd3\x79\x49\x56\x93\x97\x59\x1b\xd7\x91\x9d\xe9\x80\x9b\xe3\x6f\xfe\xff\xe2\x68\xda\x52\x93\xa9\xe9\xb2\xea\x9a\x71\x39\x5d\xf8\x9a\x0e\x88\x45\x8c\xce\x22\xd6\xa6\xdb\x42\xa6\x84\xd7\xb9\x92\xbb\x59\x6b\xa2\x71\x60\x77\x56\xfc\x09\x86\x2d\xef\xc8\xf1\xb4\xa5\x3e\xd3\xd3\x01\x74\x7a\xb5\x50\x93\xef\xe9\xb6\xb0\xad\xc5\xfa\x74\x39\x96\xc8\x08\x98\xce\xdc\x4f\x97\x50\x58\x62\x87\x56\x33\x40\x1d\x60\x57\x71\x44\xd7\xe0\x81\xd6\x36\x74\x6d\x36\x68\x6a\x05\xf7\xb3\xa6\xf2\x13\xc8\xda\x70\x2e\x2c\x51\x5b\x5c\xb9\x74\x79\x11\xaa\x18\x75\x53\x19\xaa\x19\x84\x1f\x20\x84\xeb\x49\xeb\xbb\x0b\xe2\x76\xc2\xb0\x79\x3a\x5f\x5e\x58\xb7\xe8\x71\x60\x9b\xda\xf2\x8e\xf6\x72\xe1\x9f\x7e\x80\x10\x3f\x48\xf4\xb8\x9e\x4f\xfd\x08\xd1\xe3\xc4\x5a\xfd\x61\xc4\x75\xe1\xb1\xe6\x41\xdd\xce\xfe\x1c\x38\xad\xb5\x25\xad\xe4\xb7\xd6\x47\xac\xe0\xba\xd6\x06\x74\xe4\xbd\xe6\x71\xdd\xec\xe9\xcc\x81\xb5\x65\x0d\x26\xac\xcb\x6e\x61\x4c\xfd\xf1\x06\xf8\xb0\xb6\x38\x5f\x1c\xd5\xb0\xbf\xfb\x7d\x87\xd3\xbd\xa8\x63\xb5\x72\xce\xac\x2d\x1f\xc9\x01\xfe\x81\xa8\xe0\x15\xf6\x73\x20\x04\xad\xf8\xb1\xe8\x45\x5f\xe6\x35\x7f\x0e\xaf\xfa\x32\xf5\xe6\xd8\x50\x7c\x60\x6e\x68\xd6\x7e\xdb\x97\xcf\x99\xbd\xd8\x5e\x9b\xdf\x9a\xf6\x77\x92\x01\x4d\x19\x59\x56\xc0\xa9\x5c\x64\x4a\x6b\x58\x69\x71\xa2\x80\x80\x8f\xc2\xbc\xed\xd4\xbc\x0a\x6e\xd5\x64\x51\x75\xa9\x1e\x12\xa9\x6e\x04\x61\x92\x66\x6f\xa9\x74\xe4\x3f\x4a\x65\x77\x22\xe6\xf5\x73\x53\x55\xd4\x14\x0a\x03\xfb\xae\x3a\xce\x30\x75\x57\x71\xf0\x71\x20\x8c\xab\x31\x8a\xa2\xe9\x2e\x5b\x6e\x4c\x77\x27\xa5\x31\x58\x46\xba\x24\x52\xdd\x9a\x17\xdf\x39\xab\x6a\x72\x6a\x4e\x5d\x2a\x73\xfa\x4e\x88\x9c\xbe\x48\xef\xbd\x65\x8f\x50\x4a\x57\x7e\xea\x31\x8c\x93\x88\x30\x10\x48\x02\xf3\x7e\xda\xb4\x31\x50\x16\x18\x9a\x19\x1b\xe9\xf9\x80\xd0\x50\x02\x19\xf0\xa4\x38\xe3\x69\xff\xce\xbc\x5a\xa4\x64\xa5\xf0\x02\x89\x2c\x26\x2d\x2c\x19\xdc\x56\x9f\xbe\x3b\x73\x6a\xf0\x9f\x65\xea\x8b\xb7\x4b\xb4\x2a\x87\x14\x48\x94\xa6\x91\x94\x6d\x3b\x15\x66\xd7\xbe\xae\x79\x08\x37\x22\xc1\x5d\x38\x23\xa1\xc4\xdd\xec\x32\x72\x6d\xb9\xea\x11\x3d\xf9\x30\x7b\xb1\xe8\x4c\xae\x35\xbb\x2e\x9b\xdb\xbc\xe2\x31\xec\x19\xdc\xf5\x32\xfe\xaa\xa9\xcd\xbc\xbb\x75\x59\x86\x12\xe1\xcb\x72\x5c\x61\x4a\xae\x4a\x8c\x15\x1c\xa3\xf7\x64\x3e\xaf\x65\xb8\x95\x8d\x96\x1e\xda\xb9\x2f\xc7\x47\x90\x8a\x0b\x9d\x54\x72\x4f\x92\xc1\xf4\x2d\xa9\x99\x02\xe9\x58\x81\x3f\x5f\x1b\xff\x17\x00\x00\xff\xff\xa4\x1f\x56\xc9\x29\x5d\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x7d\x6d\x93\xda\x38\xb6\xff\x7b\x3e\xc5\xa9\xee\xad\x9a\xa4\xaa\x4d\x37\x4d\x77\x76\x86\xff\x8b\x54\x4f\x27\xd9\x3f\x77\x32\x49\xaa\xe9\xec\xd6\xde\x24\x5b\x25\x6c\x01\xda\x18\xd9\x23\xc9\xfd\xb0\x93\x7c\xf7\x5b\x47\x96\xc1\x80\x2d\x4b\x06\x32\x99\x7b\x81\x4c\x0d\x60\xf9\xa7\xf3\xa4\x07\x4b\x3f\x9d\x3e\x86\x60\x77\xaf\xce\x31\xbc\x66\x21\xe5\x92\x46\xa0\x12\x50\x33\x0a\x57\x29\x09\x67\x14\x46\xc9\x44\xdd\x13\x41\xe1\x55\x92\xf1\x88\x28\x96\x70\x78\x72\x35\x7a\xf5\x14\x32\x1e\x51\x01\x09\xa7\x90\x08\x98\x27\x82\x76\x8e\x21\x4c\xb8\x12\x6c\x9c\xa9\x44\x40\x9c\x03\x02\x99\x0a\x4a\xe7\x94\x2b\xd9\x05\x18\x51\xaa\xd1\xdf\xbc\xbd\x1d\x5e\xbf\x84\x09\x8b\x29\x44\x4c\xe6\x37\xd1\x08\xee\x99\x9a\x75\x8e\x41\xcd\x98\x84\xfb\x44\x7c\x86\x49\x22\x80\x44\x11\xc3\x8a\x49\x0c\x8c\x4f\x12\x31\xcf\xc5\x10\x74\x4a\x44\xc4\xf8\x14\xc2\x24\x7d\x14\x6c\x3a\x53\x90\xdc\x73\x2a\xe4\x8c\xa5\xdd\xce\x31\xdc\xa2\x1a\xa3\x57\x85\x24\x32\x87\xd5\x75\xaa\x04\xfe\x99\x64\x46\x87\x92\xba\xc6\x0a\x27\xf0\x77\x2a\x24\x56\x72\xde\x3d\xeb\x1c\xc3\x13\x2c\x72\x64\x2e\x1e\x3d\xfd\x7f\xf0\x98\x64\x30\x27\x8f\xc0\x13\x05\x99\xa4\x25\x64\xfa\x10\xd2\x54\x01\xe3\x10\x26\xf3\x34\x66\x84\x87\x74\xa9\xd6\xa2\x86\x2e\x68\x01\x10\x23\x19\x2b\xc2\x38\x10\xad\x06\x24\x93\x72\x31\x20\xaa\x73\xdc\x39\x06\xfd\x9a\x29\x95\x0e\x4e\x4f\xef\xef\xef\xbb\x44\x7b\xa7\x9b\x88\xe9\x69\xa1\xdd\xe9\xeb\xe1\xf5\xcb\x37\xa3\x97\x81\x16\xb9\x73\x0c\xef\x79\x4c\xa5\x04\x41\x7f\xcb\x98\xa0\x11\x8c\x1f\x81\xa4\x69\xcc\x42\x32\x8e\x29\xc4\xe4\x1e\x1d\xa7\xbd\xa3\x9d\xce\x38\xdc\x0b\xa6\x18\x9f\x9e\x80\x34\x5e\xef\x1c\xaf\x78\x67\x69\xae\x42\x3c\x26\x57\x0a\x24\x1c\x08\x87\xa3\xab\x11\x0c\x47\x47\xf0\xf3\xd5\x68\x38\x3a\xe9\x1c\xc3\x3f\x86\xb7\xff\xff\xed\xfb\x5b\xf8\xc7\xd5\xcd\xcd\xd5\x9b\xdb\xe1\xcb\x11\xbc\xbd\x81\xeb\xb7\x6f\x5e\x0c\x6f\x87\x6f\xdf\x8c\xe0\xed\x2b\xb8\x7a\xf3\x4f\xf8\x65\xf8\xe6\xc5\x09\x50\xa6\x66\x54\x00\x7d\x48\x05\xca\x9f\x08\x60\x68\x48\x1a\xa1\x4f\x8b\x00\x2a\x04\xc0\xf8\xc0\xef\x32\xa5\x21\x9b\xb0\x10\x62\xc2\xa7\x19\x99\x52\x98\x26\x77\x54\x70\x0c\x8f\x94\x8a\x39\x93\xe8\x4e\x09\x84\x47\x9d\x63\x88\xd9\x9c\x29\x1d\x45\x72\x53\x29\xac\xa6\x68\x18\x3b\x78\x75\x3a\x24\x65\x26\x9c\x06\x40\x52\x46\x1f\x14\xe5\x5a\x9a\xee\xe7\x1f\x65\x97\x25\xa7\x77\xbd\xce\x67\xc6\xa3\x01\x5c\x67\x52\x25\xf3\x1b\x2a\x93\x4c\x84\xf4\x05\x9d\x30\xae\x23\xbf\x33\xa7\x8a\x44\x44\x91\x41\x07\x20\x26\x63\x1a\x4b\xfc\x04\xe8\xd0\x01\x1c\x85\x64\x4e\xe3\xe0\xf3\x51\x07\x80\x70\x9e\x18\xcd\xf2\x12\xba\x49\x26\x71\x4c\x45\x30\xa5\xbc\xfb\x39\x1b\xd3\x71\xc6\xe2\x88\x0a\x5d\x73\x21\xd7\xdd\x59\xf7\xa2\xdb\xeb\x00\x84\x82\xea\xdb\x6f\xd9\x9c\x4a\x45\xe6\xe9\x00\x78\x16\xc7\x1d\x00\x4e\xe6\x74\x00\x9f\xb1\x2e\xaa\x64\x57\x57\x5a\x0a\xc4\x0e\xba\x00\xeb\x9c\x8a\x24\x4b\x07\xb0\x71\x3d\x47\x30\x82\x87\x44\xd1\x69\x22\x58\xf1\x3d\xc8\x81\xcd\xe7\x70\xf1\x39\xb7\xcb\x2f\xf8\x9d\x2a\x7d\x35\x66\x52\xfd\x52\xfe\xf5\x35\x93\xf9\x95\x34\xce\x04\x89\x97\x22\xea\x1f\xe5\x2c\x11\xea\xcd\xb2\xe2\x00\x3e\xe7\xc8\x92\xf1\x69\x16\x13\xb1\x28\xdf\x01\x90\x61\x92\xd2\x01\xe8\xe2\x29\x09\x69\xd4\x01\x30\x26\xd2\x72\x06\xa5\xbe\xe8\x9d\x60\x5c\x51\x71\x9d\xc4\xd9\xbc\x30\x76\x00\x11\x95\xa1\x60\x29\x5a\x70\xa0\x3b\x20\x23\x24\xa4\x33\x22\xa9\xae\x18\xe0\xdf\x32\xe1\xef\x88\x9a\x0d\xa0\x2b\x15\x51\x99\xec\x96\xaf\xa2\x95\x06\xf0\xae\xf4\x8b\x7a\x44\xa9\xb0\x97\xe4\xd3\xce\xb2\xc8\x5d\x8f\xc4\xe9\x8c\xa0\xd7\x50\xf4\x19\x9d\xeb\xf8\xc0\x6f\x49\x4a\xf9\xd5\xbb\xe1\xdf\xfb\xa3\x95\x9f\x61\x55\xbe\x42\x36\x86\x5d\x23\x85\xbc\xec\xa2\x49\x15\x66\x84\xab\x77\xc3\xc5\xfd\xa9\x48\x52\x2a\xd4\xc2\x6f\xf9\xbf\x52\x84\x97\x7e\x5d\xab\xed\x07\x14\xc8\x74\xab\x11\x86\x36\xcd\xab\x35\x06\xa6\x91\xd1\x21\xef\x02\x19\xf6\x5c\xd8\x03\x50\x9e\xc7\xf3\x0a\x30\x60\x21\xc2\x21\x19\xff\x9b\x86\xaa\x0b\x23\x2a\x10\x06\xe4\x2c\xc9\xe2\x08\xc7\xa1\x3b\x2a\x14\x08\x1a\x26\x53\xce\xfe\xb3\xc0\x96\xc5\xf0\x16\x13\x45\x4d\xd8\x2c\xdf\xda\xa1\x9c\xc4\x70\x47\xe2\x8c\x9e\x60\x67\xa1\x7b\x79\x41\xb1\x16\xc8\x78\x09\x4f\x17\x91\x5d\xf8\x35\x11\x54\x0f\x4b\x03\xdd\x3f\xcb\xc1\xe9\xe9\x94\xa9\xa2\x65\x87\xc9\x7c\x9e\x71\xa6\x1e\x4f\x4b\x43\xa3\x3c\x8d\xe8\x1d\x8d\x4f\x25\x9b\x06\x44\x84\x33\xa6\x68\xa8\x32\x41\x4f\x49\xca\x02\x2d\x3a\x47\x85\x65\x77\x1e\x1d\x0b\xd3\x17\xc8\x1f\x56\x64\xdd\x88\x88\xfc\x9f\x6e\x2d\x16\x0f\x60\xbb\x01\x26\x81\x98\x5b\x73\x45\x97\x86\xc6\x9f\xd0\x3a\x37\x2f\x47\xb7\x50\x54\xad\x07\xb7\x15\x50\x30\x76\x5f\xde\x28\x97\x2e\x40\x83\x31\x3e\xd1\x7d\x2a\x0e\x8a\x22\x99\x6b\x37\x53\x1e\xa5\x09\xe3\x4a\x7f\x09\x63\x46\xf9\xba\xf9\x65\x36\x9e\x33\x85\x7e\xff\x2d\xa3\x52\xa1\xaf\xba\x70\xad\x7b\x34\x18\x53\xc8\xd2\x88\x28\x1a\x75\x61\xc8\xe1\x1a\x63\xf3\x9a\x48\xba\x77\x07\xa0\xa5\x65\x80\x86\x75\x73\x41\xb9\xa7\x5e\xbe\x10\x65\x60\xac\x56\xba\x50\x74\x99\x35\xfe\x32\xed\x73\x94\xd2\x70\xa5\xc5\x44\x54\xea\x11\x1d\x3b\x0f\x8a\x2d\xc1\x14\x5c\x41\xaa\x6e\xa9\xf8\x26\x99\x9a\x25\x82\xfd\x47\x37\xab\xf5\x8b\x6b\x22\x5c\x95\xcb\x1a\x41\xf0\xf2\xd8\x88\xb2\x82\xb5\x98\x6b\x6c\x60\x02\xce\x3e\x54\xa9\x3b\x54\x09\x36\x52\x8e\x41\x64\x5a\x24\x0e\x8b\xba\xf1\xc9\x47\xa9\xe8\x7c\x03\xa2\x5e\x1f\x7c\x27\x28\xc8\x79\xd5\x95\x35\x85\xde\xa2\x46\xe7\x10\xd1\x30\x26\x42\x2b\x41\xd4\x8a\x64\x46\x07\x99\x97\x04\x9c\x4d\x15\x40\xab\x2f\x95\x7c\xa6\x5c\x56\x5e\xb4\xcb\xba\xe1\x83\xf7\x22\xae\x2b\x67\x73\xc7\xfb\x9b\xd7\x45\xd7\xbd\x68\x5c\xf7\x33\x2a\x28\x4e\x49\x45\xb5\x64\xf9\x7b\x2a\x08\x57\x40\xc2\x90\x4a\x79\x02\xf7\x33\xca\x21\x93\x45\xe3\x5f\x11\x2d\x08\x93\x88\xc2\x24\x4e\xee\x6b\xe1\x6a\x1a\x42\xf9\x8d\xf7\x3b\x6a\xf8\x2a\x4e\xee\x0b\xad\x16\x1e\x30\x02\x67\xe6\xf9\xc4\xcc\x98\xd5\x8c\x76\x2a\xe0\xcc\x3b\x77\xcf\x49\xfe\x84\x32\x31\x3d\x4e\x10\x0a\x1a\x61\xeb\x26\xb1\x9e\x59\x6e\xea\xba\x8d\x9a\xcd\x6e\x5f\x53\xf6\xdd\xe2\x06\x98\x93\x74\x5d\xe9\xb2\xac\x38\x6f\x2d\x20\x36\x5f\xb9\xae\x45\x4b\x2a\x22\x79\x29\x0e\x0e\x84\x94\xdd\x19\x0f\xcf\x3b\x35\x30\x4e\x0a\x80\x89\x9b\x5b\xac\xd3\x56\x6c\x4d\xd7\xab\xe5\x5d\x85\x7f\x4d\x75\x8f\xab\xe2\x59\x21\x8b\xda\x73\x95\x4f\xf2\xe6\xab\xe7\x0a\x13\x41\xe5\x2c\x7f\xcc\x41\x6c\x44\x26\x2a\x11\x30\xa6\x13\x7c\x38\x2d\x6e\xaf\x7e\x31\x85\xcf\x1b\xd8\xec\xad\x05\x1d\x62\x00\xff\xe5\xb1\x36\x5c\x1b\x8a\xad\xd6\xb9\xce\x6f\x79\x61\x37\x8d\x41\xb6\xc2\x02\xb0\x68\x77\x4a\x8c\x68\x28\xa8\xf2\x56\x24\xbf\x6d\x2b\x3f\xe7\xaa\x82\xd4\x48\xbb\x50\xc8\x84\x88\x6f\xdc\xde\x94\x6e\xdb\x4a\x21\x53\x7f\x1e\xb9\xdb\x2b\x54\x33\xa5\x28\xbf\xf5\xe3\x8c\x6b\x5f\x34\xd2\x85\x01\xd7\x7a\x50\xc5\xfc\xde\x62\x2e\x46\xa3\xc5\x63\x41\xde\xfe\x9a\x3a\xa3\xda\xeb\x4c\xd1\xb9\xb5\x77\xf1\x50\x9e\x08\x41\x1e\x6b\xca\x68\x2b\xbb\x0f\xac\xda\xbd\x55\x03\xea\xda\x98\x93\x88\x22\x8e\x6a\x71\xa1\x64\xa6\x06\x63\x34\xea\x6a\x75\xb2\xe5\x62\xb4\x58\x3a\x18\x74\xac\x7a\xff\xd7\xe8\xed\x9b\xfc\xb9\x0f\xc7\x22\x89\x01\x4e\xf4\x8f\xc1\xe2\x69\x30\x8e\x93\x7b\x1c\x39\x46\x66\x89\xa5\xea\x69\x0c\xdf\x2f\x04\x99\x28\xb8\x80\x27\x66\x9d\x0a\x9f\x72\x83\xfc\x91\x4e\x2f\x54\x3d\xed\x6e\xdc\x64\x1f\x6f\xfe\xb2\xfa\x4c\xeb\xa4\x05\xba\x70\xf9\x54\x82\x0f\x3a\xfa\x67\xc8\x44\xdc\xb5\x58\xb8\xd6\x05\xe5\x6a\xda\xdc\x4f\x1f\xc8\x3c\x8d\xa9\x83\x0e\x3f\xa0\xd5\x57\x64\xe7\x8f\xf8\x7c\xc6\x22\xad\x1e\x7e\xcc\x68\x17\xd7\x14\x70\xbd\x11\x9f\x4a\x2a\x31\x41\xb7\x60\x99\xa5\x69\x22\x14\x8d\x06\x30\x4e\x92\xf8\x04\x18\x57\xcf\x2e\x4e\x70\x22\x47\xf4\x87\x5c\xe1\x13\xf8\xf0\x49\x3f\xf4\x4e\x48\x48\x7f\xff\x7a\x52\x83\x38\x27\xe9\x87\xfc\x86\x72\x69\xfd\x78\xcc\x59\xdc\xfd\xa1\x53\x71\x0f\x3c\x04\xb8\xd6\x24\x38\x55\x54\x06\xda\x21\xe2\x8e\x06\x19\xff\xcc\x93\x7b\x1e\x4c\x18\x8d\x23\x39\x00\x25\xb2\xaa\x7e\xb3\x78\x18\x78\x91\x84\xd2\xc1\x76\x2f\x97\xc5\xb3\x79\xb1\x60\x00\x04\x23\x57\x4f\x0d\xa8\xa0\x3c\xc4\x18\x26\x7c\x01\x5d\x89\x0a\xcb\xa7\x5e\xec\xef\xb0\x2c\x8f\x68\x04\x51\x19\xb8\x3a\x90\xec\xb1\xbc\x2e\x72\x4d\x91\xc6\x78\xc2\x7f\x99\x88\xb7\xb8\xdf\xda\xa5\xe0\xe4\x61\xd0\x69\x81\xdb\xa4\x7d\x79\xdd\xac\xc9\x4e\xcd\x96\x44\x5b\x4e\x48\x16\x5b\x27\x26\x2b\x11\x62\xca\xe7\xfd\x5b\xf1\x45\x37\x29\x3d\xb0\xe1\x22\x30\x3e\x5f\x47\xf5\x86\x59\xbe\xf2\xd8\xad\x8e\x82\x1d\xc4\xfe\xa6\xf4\x96\x9a\x1a\xdd\x8d\xff\x28\xcf\xe6\x36\x90\xc6\x31\x79\x8b\xae\xca\x8a\x9a\x87\x95\xdc\x43\x97\xb5\x55\xd7\xb5\x43\x37\xba\x4c\x55\x1a\x46\x89\x7d\x3a\x60\xbf\xe6\xdf\xc2\xf8\x3b\x30\x3d\x7d\x08\xe3\x4c\xb2\x3b\xfa\x2b\x79\x60\x73\x7b\x0b\x40\x3b\xe4\x71\x47\x09\x77\x40\x64\x7c\x37\x88\xf9\x6e\xa6\xb3\xdf\x8f\xf2\xf2\xba\x17\xe3\xf0\x36\x5f\xe4\x87\xbb\xbe\xc1\x31\x3e\xea\xc2\xfb\x7c\x94\xb5\xc0\x16\x55\xe7\xce\x67\x53\x9e\x08\x5c\x5a\xc5\x1d\x8b\xe5\x8c\xaf\x5c\x44\x47\x17\xae\xbf\xda\x84\x05\xf8\xc8\x21\x80\xb1\x4c\x78\xde\x8f\xb2\x68\x00\x44\x7f\x37\x1d\x2b\x0c\x5f\x9c\x00\xeb\xd2\x2e\x10\x38\xbf\x80\x70\x46\x04\x09\x95\x7d\xb5\x0a\x60\x46\x1f\x8c\x6e\x10\x40\x26\xd8\x00\xb7\x19\xdf\xdf\x0c\x81\x48\x48\x89\xc0\x29\xfa\xf8\x11\xfe\x96\xe0\xe6\x1f\x70\xaa\x4e\x71\xba\xf7\x0e\x2f\xdc\xe4\xcf\x2f\xef\x6f\x86\x9d\x4a\x64\xf3\x0e\x80\xce\x09\x8b\x35\xae\xfe\x84\x1b\x3d\x7a\xfb\xb1\xae\x06\x2c\x94\x57\x71\x95\x97\x6c\xc0\x9f\x25\x52\xe5\x3b\x3b\xc4\xb4\xd4\x45\xf3\xcd\x67\x2b\x38\x0c\x11\x0e\x43\x6c\x28\x9c\x2a\x7d\x83\x15\x13\xd1\x4e\xd0\x02\xc5\xd0\x35\x7e\x84\x9b\x57\xd7\xd0\x3b\xeb\x63\x73\xa5\xa1\x86\xed\x77\x7b\xf0\xe1\xe6\xd5\x35\xfe\xfa\xa9\x0b\x81\x15\x92\xa5\x77\x17\xda\x06\xc3\x77\x77\x17\x30\x7c\x57\x67\xdf\x5c\xf1\xe1\x3b\x08\x80\xa5\x77\xcf\xec\x21\x91\xc3\x3d\x73\x83\x0b\x59\x24\xd0\x44\xd7\xc3\x17\x37\x76\x54\x1b\x14\xde\x0d\x01\xcc\x49\x88\x58\xbf\x5e\x5d\x17\xee\x6c\x0f\x89\x20\x01\x64\x99\x8e\x68\x0e\xef\xdf\x0f\x5f\xe8\xc5\x27\x3b\x22\xb6\x24\x09\x59\x9a\x52\x11\x12\x49\xcb\xae\xc2\x87\x44\x41\xa7\xf4\x01\x9e\x3c\x67\x4f\xff\xf5\xe1\x2c\xf8\x89\x04\x93\x4f\xbf\xff\xf8\x35\x78\xbe\xf8\x72\xe1\xf6\xa5\x77\xfe\xf5\x2f\x56\x41\x72\xc9\xfb\x0b\xd1\xfb\x5a\xf6\x26\xf9\xac\x90\x4e\xb2\xf7\x17\xdf\xfa\xeb\x97\x7c\x85\xbf\x58\x08\x7f\xf1\x8d\x84\xbf\x58\x7c\xd3\xc2\xff\xf8\x13\x19\x7f\x5a\xfd\xc9\x53\x85\xcb\x85\x0a\x97\xdf\x48\x85\xcb\x5d\xaa\xc0\xe4\x98\x6b\x0d\x86\xa3\x9f\xdf\xf4\xce\x70\xfd\x5c\x7f\xea\x03\xcf\xe6\x63\x2a\x8a\x2e\x3a\x66\x9f\x29\x7c\x3c\x3a\xeb\x9f\xf7\xfe\x7a\xd9\x3b\xbb\xe8\x7f\x3c\xb2\x22\x27\x02\x3e\x1e\xfd\xf4\xd7\x1f\x83\xc5\x1d\xbd\x8f\x47\xa6\xbe\xde\x59\xb9\xc6\x95\x7a\xac\x98\x15\x32\x14\x88\xfd\x25\x62\xdf\x1f\xb1\x42\x4e\x5c\xa7\x67\x2a\x24\x02\xbb\x06\xf3\x0d\xf0\xab\x41\xb7\xc2\xae\x7a\xdc\x78\xf5\x5f\x4f\x9e\x0f\x74\xf4\xe9\xd0\x7a\xf2\x7c\x90\x7f\xee\x7f\x7d\xfa\xfc\xcb\xe5\x87\x5e\x70\xf9\xc9\x5c\xbc\xf8\xfa\xe5\xd9\x93\xe7\x83\xb3\x5e\xef\x8b\x76\x75\xfe\xfb\xd3\xc5\xad\x5f\xfa\x1f\x2e\xfe\x5a\x14\xee\x7f\xfd\xd2\xc7\xc2\x1f\xce\x82\xcb\x4f\x5f\x3e\x3c\xfb\x71\xb5\x74\xef\xeb\x97\x27\xcf\x07\xe7\xbd\x7e\xef\x4b\xef\xc7\xb3\xb3\x2f\xfd\xcb\x8f\x1f\x3f\x7e\x8c\x7e\xef\x7f\x7d\x9a\x7f\xe8\xf5\xbe\x3e\xb5\xc7\x08\xf2\x9c\xf4\x5c\x94\xe3\x1e\x3f\x9b\x32\x55\x1a\xe1\x61\xce\x1e\x68\x84\xcc\xa8\x00\xa4\xc4\x48\x82\xf7\xdd\x51\x17\x64\x12\xb2\xda\xc7\x71\xb3\x90\x49\xc3\x4c\x30\xf5\x58\xb8\x6b\x39\x45\x29\xd9\xac\x90\xf6\x43\x00\x9f\x9e\xe7\x5f\xce\xbf\x7e\xb0\x0f\x78\x45\xc1\x8b\xaf\x7f\xc1\x31\x9a\x3e\x84\x49\x9c\xe0\x00\xc4\xf1\x0b\x89\x68\xc8\xe6\x24\x06\xfd\x2b\xe0\xf6\x90\x0e\x03\x2b\xe4\xc7\xa3\xe3\x57\xfa\x35\xa8\x16\xf3\xf8\xf9\x13\xd3\xe8\xae\x82\x57\xe8\xd3\x2f\xe5\xaf\xcf\x9a\x4c\x1c\x80\x98\x8e\x97\x52\xde\xfc\xed\xe7\x75\xe9\xb0\x40\x11\xad\x62\x3a\x7e\x72\x7e\x79\x79\x62\xfe\xfb\xa9\xa1\x25\x06\x30\x7e\x54\x74\x00\x63\x22\xe9\xb3\x0b\xa0\x1c\x55\x8e\x60\xcc\x38\x11\x8f\x80\xdb\xda\x10\x40\x4a\xa4\xbc\x4f\x74\xb0\xf3\x47\xc0\x9d\x71\x2b\x66\x32\x31\xad\x0b\x02\x44\xc0\x65\x5b\xfd\xff\xe2\x57\x23\xe9\xf9\xd9\xd9\xb3\xe0\xac\x17\x9c\x9d\x7f\x3c\x02\x22\x5d\xdb\xcc\x24\x8b\xe3\x40\xc3\x31\x8e\xb3\x9f\x7e\xbf\xff\x13\x56\x94\x09\x3d\xab\xd2\x95\x99\xcf\x56\xc8\x35\x61\xce\x81\xcb\x8f\x47\x95\x33\x02\xc5\xe6\x34\x9f\x12\xbc\x70\x01\xd6\x9e\x99\xa7\x44\x31\x64\xdd\xe9\x06\x32\x0a\x49\xbc\x14\xcb\xcc\xb2\x8d\x75\x10\x7d\x00\xc4\x0a\xa9\xd5\xc5\x82\x1b\x26\xec\x5d\x04\xbd\xf3\xa0\x77\x79\xdb\xfb\x69\xd0\x3f\x1b\x9c\x9f\x75\xcf\xce\xce\xfe\xdb\xcb\xa0\x08\x1e\x68\xf0\xa5\x41\xbb\x47\xdb\xae\x40\xd4\xad\x2a\x79\x40\xcc\xc9\xc3\xb0\x69\x9d\xc2\x3c\x4e\xe5\xcf\xae\x96\x72\x79\x85\xb8\x8c\x30\xb5\x74\xcf\x73\xf2\xf0\x9a\xf2\xa9\x9a\x7d\xd3\x2a\x9b\xd7\xc7\xf6\x52\x6d\xd3\xf3\xec\xca\x73\xe8\x15\xbc\xc9\x3b\xe2\xf2\x12\x44\xbe\x4c\x6d\x7a\xe8\x98\x29\x2a\x48\xdc\x6d\x14\xad\xc9\xeb\x8c\x7f\x6b\xaf\x33\xfe\xcd\xbd\xce\xf8\x1f\xe2\x75\xc6\xbf\x57\xaf\x67\xb1\x62\x69\x4c\xdf\x4e\xbe\x43\xe1\x78\x16\xc7\x48\xa1\xde\x7e\xf5\x27\x25\x0a\x1f\xf6\x07\xdb\x4a\xa4\x98\x8a\xe9\xf6\x28\x8f\xe9\xf6\x20\x19\x67\xbf\x65\xb4\xb1\xc9\xba\xd9\xe7\x21\x28\x5c\x9c\x08\xe9\x1c\x09\xb8\x86\x85\x24\x61\x64\xfd\x94\xee\xcf\x9f\xb8\x22\xaa\x90\x0d\xce\x29\xdc\xcf\x58\x68\xdb\xc4\x05\x78\x3f\xd4\x43\x77\xc2\x75\x30\xa9\x04\x69\x55\xa0\x27\xb6\x13\xbd\xa3\xa4\xe0\x8e\xd1\x7b\xb9\xdd\xc2\xba\x93\x55\x5d\x56\x91\x1b\xf6\x75\x1a\x0b\x14\xdc\xbd\x41\xc7\x5b\x93\x46\x1d\xec\xd2\x5b\xc2\xb7\x01\xb9\x3e\x64\xad\x37\x5a\x2c\x11\xd1\x94\xf2\x08\x77\x0b\xab\x7a\xe2\x5a\x2b\x38\x54\x57\xa5\x7d\x84\xc3\x67\x58\xbd\xaa\xba\x12\xd3\x2f\x16\x05\x61\x4e\xc4\x67\xb9\xc2\xf3\x22\xb2\x04\x84\x87\x37\xf2\x50\xaf\x23\x01\xe2\x6a\xee\x3d\x11\x38\xdf\x2b\x31\xff\x98\xea\xf8\x34\xd2\x6a\x46\x5f\x25\x93\x8f\x70\xc8\xb8\x54\x22\xd3\x1c\xdb\x62\x87\x6d\xd9\x4f\xe3\x0c\x96\xe4\x8c\x5e\x7d\xcf\x06\x2a\x12\xb3\xe1\x9f\x57\xbf\xbe\x3e\xd5\x33\x8c\x17\xa3\xd7\x3e\x1e\xdd\x62\x2f\x41\xd0\x79\x72\x47\xe2\x4a\x6a\xfb\x86\xba\x37\x2b\x85\x81\xad\x90\xdb\x8b\x63\x3d\x21\x51\x24\x4e\xa6\xa8\x50\x5d\xff\x83\xc5\x96\xee\x5c\x38\x99\x49\x98\x26\x68\x2a\x95\x20\x19\x5a\x8b\x56\x41\xb4\xb5\x04\xa2\xa0\x69\x8c\x67\x1a\x7e\x7e\x6c\xd4\xa4\x28\x58\x68\x81\x4b\xcd\x85\x0a\x85\x40\x3a\xc6\x0c\xdf\x7e\x5c\xf5\x48\xac\x99\x33\x8c\x83\x06\x2b\xee\x2e\x69\x96\x70\xea\x23\xbe\xe1\xc1\x7b\x34\xca\x15\xa5\x46\xfa\x76\xe4\xb0\x40\x10\x74\xfc\x37\x9d\x71\x24\xc0\x15\x64\xcb\x7e\x6c\xf3\xa0\x86\x1c\x74\xca\x6b\xf7\x7b\x2c\xda\xaf\xdc\xff\x0b\x7d\xdc\x16\xe2\x86\x4e\x5a\x43\xe0\xbc\x52\x84\xd4\x3a\x2a\xaf\xd8\x7e\x58\xba\x41\xef\x37\x25\x69\x71\x9e\x4f\x93\x71\x27\x0c\x97\x87\x30\x3c\x12\x31\x2d\x8e\x0c\xe9\x33\x40\xdd\xcf\xdd\x9b\x24\x53\x54\xbe\x4e\x48\x64\x99\xca\x66\xfa\x78\x5f\x02\xa9\xa0\xa7\x69\x22\x15\x32\x59\x91\xa6\x56\x44\x4d\xcd\x8d\xb5\xa1\xe3\x68\x09\x5b\xdf\x6e\x56\x0d\xcd\x71\x34\x27\x3b\xbd\x2e\xce\xae\x05\x81\xb5\x3a\x8b\x44\xb1\xb6\x93\x5b\x6d\xba\xa8\xe9\xa1\x4b\x1e\x29\x9a\x6a\x5b\x5f\xe8\x8e\xe1\x9e\xc5\x78\x5a\x53\x51\x91\x0a\xdd\x59\xb0\xc2\x17\x40\x14\x88\x8c\xe3\x12\x43\x5b\x25\xb1\x3b\x1a\xb4\xbd\xd9\x34\xf4\xc7\xa0\x74\x1a\xad\xc9\x52\x66\x36\x59\xdc\xaa\xcf\x5f\x2d\xf7\xdd\x72\x2a\x7a\xa1\xde\x13\xda\x9d\xd6\x3f\x63\xb0\x89\x56\x0f\xad\x7e\xa4\xe8\x3c\xc5\x73\x48\x47\x4f\xff\xb0\xf0\xb4\xcd\xf9\x57\x4c\x70\x8b\x42\x97\xcf\xa0\xe0\xba\x1f\x46\x8a\xd1\xba\x38\x15\x62\x16\xb2\x99\xac\x01\x2d\x8e\x0e\x75\x5a\xa9\x64\x1d\xe6\xeb\x75\xc5\x2b\x15\x76\x74\xe5\x24\xa5\xd6\xab\x78\xe4\x27\x62\xe4\xd6\x62\xc9\x46\x47\xd9\x88\x8e\xb5\x54\xc7\xad\x09\x9b\xad\x69\x9b\x6e\x66\x69\xa4\x70\xee\x96\xc8\xe9\x68\xea\xf5\x2a\xb7\xc5\x3a\x50\x77\xf6\x42\xdd\x69\xa2\x7f\x6e\x47\x02\xb5\x40\x2e\x2b\x6f\x4f\x05\x75\x6f\x1f\xeb\x4a\x58\x0b\x3a\x46\x64\x23\x45\xd4\x0b\xcb\xda\xe5\xee\x70\x79\xdf\xcd\x5a\x3e\x34\x52\x3f\x2f\x38\x51\x4a\x37\x1d\xe6\x4c\x2c\x6d\x44\x5d\x1c\xaa\x6d\xa6\x97\xee\xac\x9d\x6d\xea\xd3\x58\xab\x63\xd8\xb8\xd0\x4e\x9d\x26\x39\xd5\x62\x3a\x76\xa3\x0e\xa8\x50\x66\xd4\x3b\x75\xa7\x4e\xa0\xd5\x5d\x6e\x4d\xb7\xea\x84\xe8\xd2\xf5\xee\x3c\x34\x6c\xb3\xaa\x16\x23\xe1\x3e\x1d\xb9\x07\x37\x7a\x39\xb1\xe1\x88\x66\xf1\x76\x73\xe1\xce\x1c\xe8\x43\x82\x75\x5d\xc5\xa8\x42\x67\x7c\xf7\xe8\x66\xb7\xcb\x2f\x9e\x1c\x29\xb2\x8d\xa0\x50\x90\x68\x3d\x88\xb2\x0e\xa0\xab\x54\xda\x46\xba\xac\x03\x62\x1d\xa1\xb6\x96\x34\xeb\x22\xa5\x3b\xad\x76\x41\x9d\x75\x81\xdd\x0f\xb9\xb6\x2d\xc5\xd6\x01\x14\x21\x5b\x11\x6d\x9d\x04\xf6\xa5\xdb\xba\x82\x3e\x1b\x78\x90\x6e\x9d\x40\xcb\xb4\x5c\x3b\x60\x23\x71\xb7\x80\xdc\x24\xe7\xda\x81\x7f\xbd\xba\x76\xc2\xdd\xa4\xe8\xda\x68\x96\x0e\x90\x4b\xee\x56\x03\xd9\xd2\xe1\x4b\x23\xcb\xb2\x15\x5d\xd7\x01\xd1\x87\x70\xbc\x13\xd2\x6e\x2b\xea\xee\x8e\x15\xd9\x21\x81\xb7\x15\x8d\x77\xc7\xea\x5c\xee\x5e\x9d\x7d\x51\x7a\xdb\x11\x7b\x1d\x20\xeb\xe5\xb1\xd0\x7b\xbd\x71\x1d\x49\xbe\x0e\xb8\x25\x1a\x70\x11\x15\xff\x3b\xa8\xbe\xad\x08\xbf\x0e\x88\x25\x4a\xb0\x0b\xed\xd7\x01\xb1\x8e\x18\x5c\x47\xfe\x75\x80\xb4\xd3\x83\x2b\x29\xc0\x0e\xa8\x5e\x24\xe1\x86\x93\x3c\x66\xcb\x77\x7f\x54\xe1\xb6\x84\x61\x07\xd0\xc5\xd6\x82\x27\x6d\xd8\x01\x9a\x48\x3f\xf2\xb0\x03\xe4\x82\xc7\xeb\x4c\x21\x76\x00\xdd\x24\x19\x37\x13\x89\x1d\x60\xab\xa8\xc6\x2d\xe8\xc4\xde\x86\xf6\x26\x15\x7b\xaf\x35\xd9\x57\x20\xbd\xe1\x5c\xc8\xc6\x7e\x94\x4c\x1f\x62\xa6\x07\xfd\xf8\x1b\x08\xe1\xba\xd2\xba\x77\x41\xdc\x56\x18\x76\xcf\x0a\xf5\x8f\x1e\x07\xd2\xf2\xde\xed\xe5\x42\x63\xfe\x06\x42\x7c\x27\xd1\xc3\xf8\x9f\x27\x7a\x9c\xc8\xcf\xdf\x8d\xb8\x2e\x74\x68\xff\xb5\x3f\x07\x6a\xb4\xb7\xa4\x8d\x34\x69\x7f\x44\xcb\xa6\x7f\x2b\x40\x47\xfa\xb4\xbf\x3d\x9d\xa9\xd4\xad\x09\xd5\x8d\x98\x90\x53\xae\x77\x40\xab\xf6\xdc\x38\xf2\xb0\xbf\xfb\x7e\x87\xd3\xbe\xa8\x63\x31\x3b\xf5\xda\x59\x5b\x47\x3d\x5d\x34\x6c\x6c\x2a\x4e\x75\x35\x35\x0f\x07\x90\x06\xfb\x59\x2f\x5b\x2e\x1a\x5a\x6e\x85\x41\x57\x22\xdf\x90\x79\x25\xe6\xaa\xc5\xf4\x6f\xb2\xb4\x01\x5d\x50\x7b\xe5\x1a\x31\xb6\x6a\x63\x69\xc9\xc7\x86\x90\x70\x48\x19\xdf\x20\xc6\xce\x09\xe3\x3a\x1f\x30\xe6\x63\x4d\xe4\x92\x39\x8c\xc4\x5f\x76\x57\x99\x44\xce\x2c\x24\xac\x67\x5a\x3f\x35\x99\xbb\xbb\x05\x84\xce\x18\xdf\x71\x8e\xa8\x15\x13\x18\xad\x8c\x25\x56\x52\x11\x93\x65\xd6\xfd\xa2\x26\xcc\xca\x5d\x81\x08\x85\x71\x3a\xfe\x1b\xf4\x2b\x29\x5a\xab\x8b\xd8\x92\xe4\x6e\x93\xb3\x78\xc5\xca\xed\x33\x17\xbb\xe9\xd9\x94\xc5\x78\xfb\x5c\xc6\x16\x58\xc0\x3c\xc7\xb6\x8c\xc6\xee\x3a\xf8\x65\x37\x6e\x9d\xe3\xb8\x01\x11\x4c\x73\xf3\xcc\x74\xdc\x88\x6a\xcd\x84\xec\xdc\xab\x2d\xb1\xbc\x2c\xe3\x9e\x1b\xb9\x01\x15\x7b\x24\xba\xf3\x0c\xc9\x9e\xea\xbb\x06\xd3\x9a\x11\x9c\x73\x26\x37\x80\x62\x62\x9a\x68\x67\x99\x93\x7d\x55\xf2\xc8\xa2\xdc\x36\x97\xb2\x03\xe8\x66\xaa\x52\x87\x8c\xca\x4e\xb8\x79\xd6\x65\xd7\xbc\xca\x9e\x91\xe3\x9e\x63\xb9\x55\xa6\x65\x07\x44\x28\x65\x63\x6e\xca\xb7\xdc\x5a\x39\x97\xdc\xcb\xad\x33\x30\xfb\x2a\xe9\x90\x87\xb9\x85\xa2\xee\x39\x99\x5b\x67\x66\x76\x40\x2d\x96\xde\x5d\xf3\x33\x7b\x2b\x6a\x9d\xa6\xfa\x64\x6c\x6e\x9f\xb7\xd9\xb1\x3f\x72\xd2\xde\xe1\x59\xa4\x95\x81\x1c\x9e\xba\x1a\xb3\x3a\xb7\xcf\xed\xdc\x00\xa9\xb7\x33\x17\x31\xe2\x9a\xe1\xd9\xcb\x12\x0e\x61\xd2\x58\xc4\x96\xf9\xd9\x4a\xb8\x3f\x1c\x27\x38\x1c\x27\x38\x1c\x27\x38\x1c\x27\x38\x1c\x27\x38\x1c\x27\x38\x1c\x27\x38\x1c\x27\x38\x1c\x27\x38\x1c\x27\x38\x1c\x27\x38\x1c\x27\x38\x1c\x27\x38\x1c\x27\x38\x1c\x27\x38\x1c\x27\x38\x1c\x27\x38\x1c\x27\x38\x1c\x27\x38\x1c\x27\x38\x1c\x27\x38\x1c\x27\x38\x1c\x27\x38\x1c\x27\x38\x1c\x27\x38\x1c\x27\x38\x1c\x27\x38\x1c\x27\x38\x1c\x27\x38\x1c\x27\x38\x1c\x27\x38\x1c\x27\x38\x1c\x27\x38\x1c\x27\xf8\xb3\x1f\x27\x68\xca\xff\xee\x64\x3b\x67\x31\xea\x6d\x66\x63\x06\x57\x32\x82\x3d\x72\xac\xd7\xc0\x82\xc1\x6a\xca\xb4\xee\x68\xc7\xad\xf7\x9c\x4c\xe2\xe6\x96\x3e\xf0\x4a\xfd\xed\xb3\xb3\xef\x90\x06\xdc\xaf\x37\x35\xf9\xb8\x5d\xc0\xac\x21\xe5\x96\x1e\xbc\x0d\x9c\x25\x55\xb8\x17\x9c\x4b\xda\xf0\x6d\x92\x87\x5b\x21\xa1\x29\x9d\x75\x6d\x0a\xf1\x06\x58\x13\xa7\xd6\x52\x0d\xc1\xea\x65\xc5\xe6\xce\xc3\x2d\xf1\x78\xab\xf4\xe3\x5e\x92\xda\x53\x91\x7f\xc3\x84\xe4\x46\xea\x6d\xd3\x92\x7b\x29\x6f\x4b\x51\xee\x05\xe4\x96\xae\xbc\xca\x9a\x66\x8a\x55\x00\xd8\x92\x96\x5b\x51\x21\x4f\x69\xee\x97\xba\xfc\x8f\x0b\xfc\xe6\x49\x74\xbb\xc4\xe6\x1d\x87\x9d\x17\x26\xed\x63\xa2\x87\xc2\x0e\x23\x6c\x93\x3d\x6a\xd2\x9e\xb7\xa1\xbe\xa5\x0e\x65\x1c\x12\xa1\x7b\xe8\xdf\x94\x14\xdd\xca\xf6\x6d\xe2\x32\x5b\x31\x61\x95\xe9\xdc\x9e\xd1\xec\x63\x3a\x47\x76\xf3\x3e\x38\xce\x5e\x4e\x59\xaf\x7e\x97\xb8\x07\xc6\xd7\x77\xc9\xf8\x72\xe3\x4e\x6f\xc1\xa0\x6e\x44\xcd\xa9\x3e\x3b\xe2\x51\xfb\xb6\xca\x75\xb5\x1c\x8a\x7b\x45\xbd\x23\xbf\xba\x05\xae\xc3\x10\xb2\xa7\xfd\x0e\x1f\xeb\xfa\x73\xb0\xdb\x78\xd0\x83\x8f\xbd\xe9\xf2\x06\x56\xb6\x23\x22\x2c\xd9\xdb\xbe\xdc\xec\x1d\xb7\xe9\x4d\x0d\x1d\x25\xf0\x0a\x3f\x77\xce\xb6\xc7\x64\xb1\x5a\xfc\xa6\x41\xc0\x19\x14\xcc\x70\xd1\x38\x18\x78\x20\xae\x0d\x1b\x7e\x83\x82\x47\x3d\x3e\x8c\xee\xbd\x04\x54\xf3\x8c\xb4\xf5\x88\xbf\x47\x97\x3b\x3a\xdc\x8c\xfe\xce\xb0\x7b\x76\xb7\xaf\xb3\x77\xec\x6a\x7f\x1e\xb8\xdf\xaa\x58\x55\x4d\x8c\xef\xb7\x26\xb3\x5d\xd8\x26\x16\x1b\x58\xe2\x8e\x90\xc5\x7c\xb1\xeb\xc1\x15\x77\x86\x2e\x43\x59\x19\xe3\xce\x88\x2b\xcc\x72\x2b\x6f\xdc\x19\xb2\x8a\x5f\xee\xc3\x1e\x77\xae\xa8\x60\x99\x3b\x71\xc8\x9d\x51\x2b\xb9\xe6\x55\x4c\x72\x67\x44\x3b\xe3\xbc\xc4\x27\x77\x46\xdc\x39\xef\x7c\x8f\xec\xf3\x3d\x72\xd0\xf7\xc6\x44\xdf\x2f\x1f\xbd\x05\x2b\xdd\x19\xd5\x87\x3b\xec\xf0\xc5\x91\x34\xbc\x2f\x86\xfa\x1f\xca\x53\xdf\x17\x5b\xfd\x3b\xe0\xac\xef\x8b\xb9\xee\xaf\xda\xe5\xbe\x54\x73\x64\xb1\x3b\x23\x56\xb1\xcb\x2b\xb9\xec\x9e\x32\x56\x71\xde\xd7\x48\x97\xe5\x3a\x7d\xd1\x2b\x98\xef\x0d\xbc\x76\x8f\x1a\xaa\xf8\xef\x55\xec\xf6\x76\x91\xf3\xe7\x66\xc1\xb7\xe0\xc2\x3b\xa3\x12\x77\x46\xbc\x33\xa6\xb1\xb9\x03\x2f\xde\x19\xb2\xc4\x9f\x6f\x60\xc7\x3b\x43\x56\xb1\xe8\xfd\x38\xf2\x1e\xd2\x3b\x33\xe5\x3d\xc4\xdf\x64\xd4\xd7\xf0\xe5\x9d\x31\x2d\xbc\xfa\x35\xd6\xbc\x33\xe4\x2e\xd9\xf5\x6d\x38\xf6\xee\xb0\x8b\x7b\x9a\x98\xf6\xce\x90\xcb\x59\x5d\x13\xdf\xde\x19\xb2\x82\x97\x5f\xcf\xba\x77\x46\xdd\x35\x3b\x7f\x87\x1c\xfd\xf2\xc3\xbb\x47\xe8\xb1\x68\x6f\x4b\x98\xee\xdc\xfd\x36\xac\x63\x7f\xee\xb1\x37\x9b\xff\x1b\x8b\xe5\xb7\x8a\xff\x4d\x45\xf3\x59\x3f\xf2\xa3\x42\x3b\x82\x82\x3b\x65\xba\x6d\xb4\x3a\x9f\x15\xf8\xa6\xb6\x77\x3f\x3d\xf0\x8d\xc5\xfa\x6e\xa3\x95\xf1\xff\x0b\xd1\xea\x71\x36\xe1\x4f\xad\xa8\xfb\xa9\x86\xb6\xab\xd7\xce\x27\x1c\x5a\x6a\xe0\x78\xda\xa1\x2d\x7a\x23\x69\x68\x0b\x70\xaf\x53\x10\x6d\xed\xef\x79\x22\x62\x9f\xe7\x22\xf6\x71\x3a\xc2\x4c\xb3\x7c\x6c\xd8\xc2\x53\xbe\x3b\x88\x1e\x0c\x07\xaf\xc2\x2e\x27\x28\x3c\x2d\xe2\x65\x0b\x77\x2b\x38\x36\x4b\x8f\xda\xdd\x9a\xa2\x33\xa0\x93\xd5\x1d\x0a\x35\x16\x31\x7f\x82\x60\xd0\x71\x68\x6a\xe6\xaf\x19\x14\x09\x61\x25\x9d\x13\xae\x56\xff\x8c\x41\x29\x1d\x78\xa7\x95\x09\x6c\x31\x14\x14\x35\x75\x3c\x15\xad\x0b\x8c\x9a\x9b\xa4\x22\x2a\x5b\x0b\xcf\x15\x43\x18\x0d\x47\xba\x9c\x79\x7c\xcb\x6d\x92\x8c\xf5\x16\x71\xa4\x31\xf4\x5f\xa9\xa8\xb2\x46\x3d\x09\x27\x4c\x78\xce\xeb\xd9\xb8\x62\x69\x34\x55\xb2\x5d\x17\x40\xc6\x85\x63\xc3\xd3\x5d\xc8\x45\x16\x8c\xb0\x0a\x44\x40\x5e\x37\x81\x90\x0a\x4c\xf1\x0b\x3a\xef\x6f\xb7\xe3\xcf\x26\x8a\x89\x54\xb7\x82\x70\xa9\x75\xba\x65\xf5\xec\xee\x15\x1d\x5e\x13\xa9\xf2\xc3\xf0\x68\xd3\x85\x4d\x40\x2d\xa0\x30\x43\xb3\x48\xe6\x3a\x09\x3f\xaa\x94\xd5\xf7\xc5\x2a\x01\xc2\x13\x35\xa3\xa2\xdb\xb1\xcf\x4d\x17\x0f\xdf\xed\x42\x37\x57\xf7\x7d\x8a\x30\xce\xaa\xe2\xb6\x74\x5c\x52\x97\xc9\x92\xbe\xf7\x04\x77\x05\x10\x2f\xda\xbb\xec\x73\x2a\x25\x99\xba\x09\x7d\x05\xb3\x6c\x4e\x38\x08\x4a\x22\x9c\x9f\x15\x37\x03\xe3\x91\xe6\xff\xf2\x29\x44\x54\x11\x16\x4b\x20\xe3\x24\x53\x9d\x0a\xc4\x45\xb6\x8d\xa5\x57\xbb\x6d\x85\x17\x94\x48\xc7\x4e\x0c\x0d\x9e\x17\x2f\x72\x7c\x2f\x0d\xfe\x83\x34\xbe\xd8\x5e\xa2\xaa\x3e\xa4\x46\x22\xd3\x8d\x24\x93\x55\x61\x16\x7f\x61\xe2\x56\x64\xf4\x04\x5e\x91\x58\xd2\x93\x82\xe3\xd0\x5a\x2e\xdb\x38\xb5\x6a\x27\x64\xf8\x27\x13\x30\x7f\x15\x67\x29\x57\x77\x1f\x1d\x7b\x6d\x1b\x0e\xb4\xa9\x77\xd5\xe1\x03\xa4\x33\x22\x2b\x0c\x60\x11\xde\xd6\xc7\xd5\x76\xc9\x4d\x1d\xa3\x61\x43\x56\x5f\xb4\x8a\xd3\x7c\x50\xa6\xe1\xe6\x56\x86\xab\xbc\x69\xe3\xc7\x7c\xec\x2b\x11\xa2\xa4\x4a\x04\x76\x2a\xa5\x5f\xb2\x71\x31\xee\x2c\x4c\x23\x15\x51\x99\x1c\xc0\xef\x5f\x3b\xff\x33\x00\xa0\x0c\x0a\x7a\xa0\xcb\x00\x00"),
 		},
 		"/operator-deployment.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "operator-deployment.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 2395,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x55\xc1\x6e\xe3\x36\x10\xbd\xeb\x2b\x1e\xac\xcb\x2e\x10\xdb\x49\xd1\x02\x0b\xf5\xa4\x26\x4e\x63\x34\xb5\x0d\xcb\xdb\x60\x4f\x05\x4d\x8d\x25\x22\x14\x47\x25\x29\x7b\xd5\xaf\x2f\x28\x5b\x8e\xed\x4d\xd3\x1e\x02\x2c\x4f\x96\x66\xe6\xcd\x7b\x33\x4f\x74\x8c\xe1\xfb\x9d\x28\xc6\xa3\x92\x64\x1c\xe5\xf0\x0c\x5f\x12\xd2\x5a\xc8\x92\x90\xf1\xc6\xef\x84\x25\xdc\x73\x63\x72\xe1\x15\x1b\x7c\x48\xb3\xfb\x8f\x68\x4c\x4e\x16\x6c\x08\x6c\x51\xb1\xa5\x28\x86\x64\xe3\xad\x5a\x37\x9e\x2d\xf4\x1e\x10\xa2\xb0\x44\x15\x19\xef\x46\x40\x46\xd4\xa1\xcf\xe6\xab\xe9\xed\x04\x1b\xa5\x09\xb9\x72\xfb\x22\xca\xb1\x53\xbe\x8c\x62\xf8\x52\x39\xec\xd8\x3e\x63\xc3\x16\x22\xcf\x55\x68\x2c\x34\x94\xd9\xb0\xad\xf6\x34\x2c\x15\xc2\xe6\xca\x14\x90\x5c\xb7\x56\x15\xa5\x07\xef\x0c\x59\x57\xaa\x7a\x14\xc5\x58\x05\x19\xd9\x7d\xcf\xc4\xed\x61\xbb\x9e\x9e\xf1\x85\x9b\x83\x86\x13\xb9\x87\x29\x5c\xe1\x0f\xb2\x2e\x34\xf9\x61\x74\x1d\xc5\xf8\x10\x52\x06\x87\xe0\xe0\xe3\xcf\x68\xb9\x41\x25\x5a\x18\xf6\x68\x1c\x9d\x20\xd3\x57\x49\xb5\x87\x32\x90\x5c\xd5\x5a\x09\x23\xe9\x45\xd6\xb1\xc3\x08\x1d\x81\x80\xc1\x6b\x2f\x94\x81\xe8\x64\x80\x37\xa7\x69\x10\x3e\x8a\xa3\x18\xdd\x29\xbd\xaf\x93\xf1\x78\xb7\xdb\x8d\x44\x47\x77\xc4\xb6\x18\xf7\xea\xc6\x8f\xd3\xdb\xc9\x2c\x9b\x0c\x3b\xca\x51\x8c\xcf\x46\x93\x73\xb0\xf4\x57\xa3\x2c\xe5\x58\xb7\x10\x75\xad\x95\x14\x6b\x4d\xd0\x62\x17\x16\xd7\x6d\xa7\x5b\xba\x32\xd8\x59\xe5\x95\x29\xae\xe0\x0e\x5b\x8f\xe2\xb3\xed\xbc\x8c\xab\xa7\xa7\xdc\x59\x02\x1b\x08\x83\x41\x9a\x61\x9a\x0d\xf0\x4b\x9a\x4d\xb3\xab\x28\xc6\xd3\x74\xf5\x30\xff\xbc\xc2\x53\xba\x5c\xa6\xb3\xd5\x74\x92\x61\xbe\xc4\xed\x7c\x76\x37\x5d\x4d\xe7\xb3\x0c\xf3\x7b\xa4\xb3\x2f\xf8\x6d\x3a\xbb\xbb\x02\x29\x5f\x92\x05\x7d\xad\x6d\xe0\xcf\x16\x2a\x0c\x92\xf2\xb0\xd3\xde\x40\x3d\x81\xe0\x8f\xf0\xec\x6a\x92\x6a\xa3\x24\xb4\x30\x45\x23\x0a\x42\xc1\x5b\xb2\x26\xd8\xa3\x26\x5b\x29\x17\xd6\xe9\x20\x4c\x1e\xc5\xd0\xaa\x52\xbe\x73\x91\xfb\x56\x54\x68\xf3\x9e\xdf\x56\x24\x6a\x75\xb0\x53\x12\x36\xe0\xc6\xdb\x9b\xe8\x59\x99\x3c\xc1\x1d\xd5\x9a\xdb\xf0\x71\x44\x15\x79\x91\x0b\x2f\x92\x08\x30\xa2\xa2\x04\x52\x54\xa4\x87\xcf\x43\xae\xc9\x0a\xcf\x36\x02\xb4\x58\x93\x76\x21\x05\x01\x29\xc1\xe0\x90\x34\xe8\x5e\x75\x0f\xa7\xde\x08\x16\x64\x43\xc6\x27\x38\xa2\x84\x49\x05\x04\x4b\x9d\x17\x5c\x82\x9b\x08\x70\xde\x0a\x4f\x45\xbb\xc7\xf6\x6d\x4d\x09\x96\x24\x2d\x09\x4f\x21\x4c\x9a\xa4\x67\xbb\x0f\x57\xc2\xcb\xf2\xf1\x84\xcb\x1b\x94\x3d\x55\xb5\x16\x9e\x0e\x95\x27\x2a\xc3\xd1\x67\x20\x6f\xc0\xec\xcf\xff\x12\xd8\x27\xbf\x32\xa0\x5e\x7b\xf7\x9b\xec\x56\x49\x4a\xa5\xe4\xc6\xf8\xd9\x5b\x8d\xc3\xb5\x26\x54\xb8\x59\x5e\x98\x0e\xff\x8b\x2b\xa0\x2a\x51\x50\x82\x9c\xe5\x33\xd9\x91\xe2\xf1\x9e\xf8\xf8\x50\x92\xdc\x8c\x7e\x1c\x5d\x0f\xb3\x59\xba\xc8\x1e\xe6\xab\xcb\xc2\x45\xa3\xf5\x82\xb5\x92\x6d\x82\xe9\x66\xc6\x7e\x61\xc9\x05\xa7\xbc\xe4\x49\xae\x2a\x61\xf2\xe4\xe4\x55\x20\xf6\x1c\xf0\x2f\xde\xbd\x42\xaf\x66\xeb\xdd\x65\xed\x51\xea\x82\xad\x4f\xf0\xe9\xfa\xd3\xf5\x59\x46\xbf\xa2\x8a\xbc\x55\xd2\x9d\xc4\xc8\x6c\x2f\xc1\xf6\xa9\x4f\xe9\xea\xf6\xe1\xcf\x59\xfa\xfb\x24\x5b\xa4\xb7\x93\x0b\xb8\xad\xd0\x0d\xdd\x5b\xae\x92\x8b\x00\xb0\x51\xa4\xf3\x25\x6d\xbe\x8d\x1c\x62\x0b\xe1\xcb\xe4\xe8\xa9\x51\x68\xe7\x6a\x21\xe9\x55\x1a\xf3\xc5\x64\x99\xae\xe6\xcb\x8e\xc9\x6b\x24\x2e\xcd\x72\x09\xb0\x98\xdf\xfd\x6b\xed\xfb\x09\x38\x4b\x8d\x71\x1c\x5b\xb8\x6a\x85\xde\x89\xd6\x75\x77\x55\xbf\x4f\x1c\x45\x5f\x41\x99\x9c\x6a\x32\x39\x19\xaf\x5b\x6c\x2c\x57\x6f\xce\xbe\xd7\xf5\x9d\x36\xa3\xd5\x96\x0c\x39\xb7\xb0\xbc\xa6\x73\xa0\xf0\x47\xf7\x2b\xf9\x4b\xf4\xba\x03\x1d\x97\x24\xb4\x2f\xff\xbe\x0c\xf6\x7e\xbd\x39\x0b\x28\xa3\xbc\x12\xfa\x8e\xb4\x68\x33\x92\x6c\x72\x97\xe0\xa7\xb3\x94\x9a\xac\xe2\xfc\x24\xf8\x4f\x00\x00\x00\xff\xff\x52\xf6\x7c\xb5\x5b\x09\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x55\x51\x6f\xe2\x46\x10\x7e\xf7\xaf\xf8\x84\x5f\xee\xa4\x00\x49\xd5\x93\x4e\xee\x93\x9b\x90\x06\x35\x05\x84\xb9\x46\xf7\x54\x2d\xeb\xc1\x1e\x65\xbd\xeb\xee\xae\xe1\xdc\x5f\x5f\xad\xc1\x04\xb8\x34\xed\x43\xea\xe5\x05\xcf\xcc\x37\xdf\x37\xf3\xd9\x8e\x31\x7c\xbf\x2b\x8a\xf1\xc8\x92\xb4\xa3\x1c\xde\xc0\x97\x84\xb4\x16\xb2\x24\x64\x66\xe3\x77\xc2\x12\xee\x4d\xa3\x73\xe1\xd9\x68\x7c\x48\xb3\xfb\x8f\x68\x74\x4e\x16\x46\x13\x8c\x45\x65\x2c\x45\x31\xa4\xd1\xde\xf2\xba\xf1\xc6\x42\xed\x01\x21\x0a\x4b\x54\x91\xf6\x6e\x04\x64\x44\x1d\xfa\x6c\xbe\x9a\xde\x4e\xb0\x61\x45\xc8\xd9\xed\x8b\x28\xc7\x8e\x7d\x19\xc5\xf0\x25\x3b\xec\x8c\x7d\xc6\xc6\x58\x88\x3c\xe7\xd0\x58\x28\xb0\xde\x18\x5b\xed\x69\x58\x2a\x84\xcd\x59\x17\x90\xa6\x6e\x2d\x17\xa5\x87\xd9\x69\xb2\xae\xe4\x7a\x14\xc5\x58\x05\x19\xd9\x7d\xcf\xc4\xed\x61\xbb\x9e\xde\xe0\xab\x69\x0e\x1a\x4e\xe4\x1e\xa6\x70\x85\xdf\xc9\xba\xd0\xe4\x87\xd1\x75\x14\xe3\x43\x48\x19\x1c\x82\x83\x8f\x3f\xa1\x35\x0d\x2a\xd1\x42\x1b\x8f\xc6\xd1\x09\x32\x7d\x93\x54\x7b\xb0\x86\x34\x55\xad\x58\x68\x49\x2f\xb2\x8e\x1d\x46\xe8\x08\x04\x0c\xb3\xf6\x82\x35\x44\x27\x03\x66\x73\x9a\x06\xe1\xa3\x38\x8a\xd1\x5d\xa5\xf7\x75\x32\x1e\xef\x76\xbb\x91\xe8\xb6\x33\x32\xb6\x18\xf7\xea\xc6\x8f\xd3\xdb\xc9\x2c\x9b\x0c\x3b\xca\x51\x8c\x2f\x5a\x91\x73\xb0\xf4\x67\xc3\x96\x72\xac\x5b\x88\xba\x56\x2c\xc5\x5a\x11\x94\xd8\x85\xc5\x75\xdb\xe9\x96\xce\x1a\x3b\xcb\x9e\x75\x71\x05\x77\xd8\x7a\x14\x9f\x6d\xe7\x65\x5c\x3d\x3d\x76\x67\x09\x46\x43\x68\x0c\xd2\x0c\xd3\x6c\x80\x9f\xd3\x6c\x9a\x5d\x45\x31\x9e\xa6\xab\x87\xf9\x97\x15\x9e\xd2\xe5\x32\x9d\xad\xa6\x93\x0c\xf3\x25\x6e\xe7\xb3\xbb\xe9\x6a\x3a\x9f\x65\x98\xdf\x23\x9d\x7d\xc5\xaf\xd3\xd9\xdd\x15\x88\x7d\x49\x16\xf4\xad\xb6\x81\xbf\xb1\xe0\x30\x48\xca\xc3\x4e\x7b\x03\xf5\x04\x82\x3f\xc2\x7f\x57\x93\xe4\x0d\x4b\x28\xa1\x8b\x46\x14\x84\xc2\x6c\xc9\xea\x60\x8f\x9a\x6c\xc5\x2e\xac\xd3\x41\xe8\x3c\x8a\xa1\xb8\x62\xdf\xb9\xc8\x7d\x2f\x2a\xb4\xe9\x1f\x8c\x77\xb8\xa2\x48\xd4\x7c\xb0\x53\x12\x36\xe0\xc6\xdb\x9b\xe8\x99\x75\x9e\xe0\x8e\x6a\x65\xda\xf0\x70\x44\x15\x79\x91\x0b\x2f\x92\x08\xd0\xa2\xa2\x04\x52\x54\xa4\x86\xcf\x43\x53\x93\x15\xde\xd8\x08\x50\x62\x4d\xca\x85\x14\x04\xa4\x04\x83\x43\xd2\xa0\xbb\xd5\xfd\x39\xf5\x46\xb0\xa0\xd1\xa4\x7d\x82\x23\x4a\x98\x54\x40\xb0\xd4\x79\xc1\x25\xb8\x89\x00\xe7\xad\xf0\x54\xb4\x21\x02\xf8\xb6\xa6\x04\x4b\x92\x96\x84\xa7\x10\x26\x45\xd2\x1b\xbb\x0f\x57\xc2\xcb\xf2\xf1\x84\xcb\x1b\x94\x3d\x55\xb5\x12\x9e\x0e\x95\x27\x2a\x81\x73\x41\x6f\xc2\x00\xff\x59\x60\x9f\xfc\xca\x80\x7a\xed\xe1\x38\xb2\x5b\x96\x94\x4a\x69\x1a\xed\x67\x6f\x35\x0e\xaf\x35\xc1\xe1\xcd\xf2\xc2\x74\xf8\x6f\x5c\x01\xae\x44\x41\x09\x72\x23\x9f\xc9\x8e\xd8\x8c\xf7\xc4\xc7\x87\x92\xe4\x66\xf4\xe3\xe8\x7a\x98\xcd\xd2\x45\xf6\x30\x5f\x5d\x16\x2e\x1a\xa5\x16\x46\xb1\x6c\x13\x4c\x37\x33\xe3\x17\x96\x5c\x70\x4a\x9f\x15\x78\x55\x95\xd0\xf9\x0b\xa9\x70\x86\x78\x0e\xf8\x17\xf7\x5e\xa1\x57\x1b\xeb\x4f\x04\x85\xdf\xf0\x45\xea\xc2\x58\x9f\xe0\xf3\xf5\xe7\xeb\xb3\x8c\x7e\x45\x15\x79\xcb\xd2\x9d\xc4\x48\x6f\x2f\xc1\xf6\xa9\x4f\xe9\xea\xf6\xe1\x8f\x59\xfa\xdb\x24\x5b\xa4\xb7\x93\xb3\x1c\x60\x2b\x54\x43\xf7\xd6\x54\xe7\xc5\xe1\x6c\x98\x54\xbe\xa4\xcd\xf7\x91\x43\x6c\x21\x7c\x99\x1c\x3d\x35\x0a\xed\x5c\x2d\x24\xbd\x4a\x63\xbe\x98\x2c\xd3\xd5\x7c\xd9\x31\xb9\x40\xec\x48\x5c\x9a\xe5\x12\x60\x31\xbf\xfb\xc7\xda\xf7\x13\x70\x96\x1a\xe3\x38\x36\xb0\x83\x50\x3b\xd1\x86\xcf\x18\x1d\xdd\x8e\xa3\xe8\x2b\xb0\xce\xa9\x26\x9d\x93\xf6\xaa\xc5\xc6\x9a\xea\xcd\xd9\xf7\xba\x5e\x8f\xfe\xef\x9b\x51\xbc\x25\x4d\xce\x2d\xac\x59\x1f\xde\x0e\xfd\x09\x1f\xba\x5f\xc8\x5f\xa2\xd7\xdd\xb4\xc6\x25\x09\xe5\xcb\xbf\x2e\x83\xbd\x5f\x6f\xce\xfc\xca\x9a\x3d\x0b\x75\x47\x4a\xb4\x19\x49\xa3\x73\x97\xe0\xd3\x59\x4a\x4d\x96\x4d\x9e\x91\x34\x3a\x77\x09\x3e\x45\x7f\x0f\x00\x52\xf6\x7c\xb5\x5b\x09\x00\x00"),
 		},
 		"/operator-pod-monitor.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "operator-pod-monitor.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 1239,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x53\x4d\x6f\xe3\x36\x10\xbd\xeb\x57\x3c\x58\x97\x5d\xc0\x96\xdb\x1e\xd5\x93\x9b\xb5\x51\xa1\x59\xbb\x88\xbc\x0d\x72\xa4\xc9\xb1\x34\x88\xc4\x61\x49\x2a\x8a\xff\x7d\x41\xd9\x4e\x1c\xb4\x87\x1e\xc2\x1b\xcd\xf1\xfb\x98\xf7\x94\x63\xf1\x79\x27\xcb\x71\xcf\x9a\x6c\x20\x83\x28\x88\x2d\x61\xe5\x94\x6e\x09\xb5\x1c\xe3\xa8\x3c\x61\x23\x83\x35\x2a\xb2\x58\x7c\x59\xd5\x9b\xaf\x18\xac\x21\x0f\xb1\x04\xf1\xe8\xc5\x53\x96\x43\x8b\x8d\x9e\x0f\x43\x14\x8f\xee\x0c\x08\xd5\x78\xa2\x9e\x6c\x0c\x05\x50\x13\x4d\xe8\xdb\xdd\xbe\xba\x5b\xe3\xc8\x1d\xc1\x70\x38\xff\x89\x0c\x46\x8e\x6d\x96\x23\xb6\x1c\x30\x8a\x7f\xc6\x51\x3c\x94\x31\x9c\x88\x55\x07\xb6\x47\xf1\xfd\x59\x86\xa7\x46\x79\xc3\xb6\x81\x16\x77\xf2\xdc\xb4\x11\x32\x5a\xf2\xa1\x65\x57\x64\x39\xf6\xc9\x46\xbd\xb9\x2a\x09\x67\xd8\x89\x33\x0a\x9e\x64\xb8\x78\xb8\xb1\x7b\xd9\xc2\x1c\x7f\x91\x0f\x89\xe4\x97\xe2\xa7\x2c\xc7\x97\x34\x32\xbb\x3c\xce\xbe\xfe\x8a\x93\x0c\xe8\xd5\x09\x56\x22\x86\x40\x37\xc8\xf4\xaa\xc9\x45\xb0\x85\x96\xde\x75\xac\xac\xa6\x77\x5b\x6f\x0c\x05\x26\x01\x09\x43\x0e\x51\xb1\x85\x9a\x6c\x40\x8e\xb7\x63\x50\x31\xcb\xb3\x1c\xd3\x69\x63\x74\xe5\x72\x39\x8e\x63\xa1\x26\xb9\x85\xf8\x66\x79\x75\xb7\xbc\xaf\xee\xd6\xdb\x7a\xbd\x98\x24\x67\x39\x7e\xd8\x8e\x42\x80\xa7\xbf\x07\xf6\x64\x70\x38\x41\x39\xd7\xb1\x56\x87\x8e\xd0\xa9\x31\x05\x37\xa5\x33\x85\xce\x16\xa3\xe7\xc8\xb6\x99\x23\x5c\x52\xcf\xf2\x0f\xe9\xbc\xaf\xeb\x2a\x8f\xc3\x87\x01\xb1\x50\x16\xb3\x55\x8d\xaa\x9e\xe1\xb7\x55\x5d\xd5\xf3\x2c\xc7\x63\xb5\xff\x7d\xf7\x63\x8f\xc7\xd5\xc3\xc3\x6a\xbb\xaf\xd6\x35\x76\x0f\xb8\xdb\x6d\xbf\x55\xfb\x6a\xb7\xad\xb1\xdb\x60\xb5\x7d\xc2\x1f\xd5\xf6\xdb\x1c\xc4\xb1\x25\x0f\x7a\x75\x3e\xe9\x17\x0f\x4e\x8b\x24\x93\x32\xbd\x16\xe8\x2a\x20\xf5\x23\xdd\x83\x23\xcd\x47\xd6\xe8\x94\x6d\x06\xd5\x10\x1a\x79\x21\x6f\x53\x3d\x1c\xf9\x9e\x43\x8a\x33\x40\x59\x93\xe5\xe8\xb8\xe7\x38\xb5\x28\xfc\xdb\x54\xa2\xf9\xcc\x6f\x2b\x53\x8e\x2f\x75\x2a\xd1\x8b\xe5\x28\x9e\x6d\x53\x68\xf1\x24\xa1\xd0\xd2\x2f\x5f\x7e\xce\x9e\xd9\x9a\x12\x7f\x8a\xf9\x7e\x9e\xc8\x7a\x8a\xca\xa8\xa8\xca\x0c\xb0\xaa\xa7\x12\x5a\xf5\xd4\x2d\x9e\x17\xe2\xc8\xab\x34\x02\x74\xea\x40\x5d\x48\x23\x48\xe1\x96\x98\x5d\x86\x66\xd3\x4f\xd3\xe5\xb6\x2c\xa9\x93\x62\xc9\xc6\x12\x6f\x28\x69\x75\x09\x21\x50\x47\x3a\x8a\x3f\xa3\xf5\x2a\xea\xf6\xfe\x06\xfe\x3f\x09\xfe\x27\x05\xe0\xc4\x7c\xa7\xe8\x59\x87\xb5\x35\x4e\xd8\xc6\x0b\xec\x02\x4e\x7c\x2c\xd1\x9f\x5f\xff\x09\x00\x00\xff\xff\xba\x06\x2b\x2a\xd7\x04\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x53\x4d\x8f\xdb\x36\x10\xbd\xf3\x57\x3c\x58\x97\x04\x58\xdb\x6d\x8f\xee\xc9\xdd\x78\x51\xa1\x1b\xbb\x58\x39\x0d\x72\xa4\xc9\xb1\x34\x58\x89\xc3\x0e\xe9\x28\xfe\xf7\x05\x65\x3b\x71\xd0\x1e\x7a\x08\x4f\xa6\x35\x7a\x1f\xf3\x9e\x2a\xcc\x7f\xdc\x31\x15\x9e\xd9\x51\x48\xe4\x91\x05\xb9\x23\xac\xa3\x75\x1d\xa1\x91\x63\x1e\xad\x12\x9e\xe4\x14\xbc\xcd\x2c\x01\x6f\xd6\xcd\xd3\x5b\x9c\x82\x27\x85\x04\x82\x28\x06\x51\x32\x15\x9c\x84\xac\x7c\x38\x65\x51\xf4\x17\x40\xd8\x56\x89\x06\x0a\x39\x2d\x80\x86\x68\x42\xdf\xee\xf6\xf5\xe3\x06\x47\xee\x09\x9e\xd3\xe5\x25\xf2\x18\x39\x77\xa6\x42\xee\x38\x61\x14\x7d\xc5\x51\x14\xd6\x7b\x2e\xc4\xb6\x07\x87\xa3\xe8\x70\x91\xa1\xd4\x5a\xf5\x1c\x5a\x38\x89\x67\xe5\xb6\xcb\x90\x31\x90\xa6\x8e\xe3\xc2\x54\xd8\x17\x1b\xcd\xd3\x4d\x49\xba\xc0\x4e\x9c\x59\xf0\x49\x4e\x57\x0f\x77\x76\xaf\x5b\x78\xc0\x5f\xa4\xa9\x90\xfc\xb2\xf8\xc9\x54\x78\x53\x46\x66\xd7\x87\xb3\xb7\xbf\xe2\x2c\x27\x0c\xf6\x8c\x20\x19\xa7\x44\x77\xc8\xf4\xc5\x51\xcc\xe0\x00\x27\x43\xec\xd9\x06\x47\xdf\x6c\x7d\x65\x58\x60\x12\x50\x30\xe4\x90\x2d\x07\xd8\xc9\x06\xe4\x78\x3f\x06\x9b\x4d\x65\x2a\x4c\xa7\xcb\x39\xae\x96\xcb\x71\x1c\x17\x76\x4a\x67\x21\xda\x2e\x6f\xee\x96\xcf\xf5\xe3\x66\xdb\x6c\xe6\x93\x64\x53\xe1\x43\xe8\x29\x25\x28\xfd\x7d\x62\x25\x8f\xc3\x19\x36\xc6\x9e\x9d\x3d\xf4\x84\xde\x8e\x25\xb8\x29\x9d\x29\x74\x0e\x18\x95\x33\x87\xf6\x01\xe9\x9a\xba\xa9\xbe\x4b\xe7\xdb\xba\x6e\xf2\x38\x7d\x37\x20\x01\x36\x60\xb6\x6e\x50\x37\x33\xfc\xb6\x6e\xea\xe6\xc1\x54\xf8\x58\xef\x7f\xdf\x7d\xd8\xe3\xe3\xfa\xe5\x65\xbd\xdd\xd7\x9b\x06\xbb\x17\x3c\xee\xb6\xef\xea\x7d\xbd\xdb\x36\xd8\x3d\x61\xbd\xfd\x84\x3f\xea\xed\xbb\x07\x10\xe7\x8e\x14\xf4\x25\x6a\xd1\x2f\x0a\x2e\x8b\x24\x5f\x32\xbd\x15\xe8\x26\xa0\xf4\xa3\xdc\x53\x24\xc7\x47\x76\xe8\x6d\x68\x4f\xb6\x25\xb4\xf2\x99\x34\x94\x7a\x44\xd2\x81\x53\x89\x33\xc1\x06\x6f\x2a\xf4\x3c\x70\x9e\x5a\x94\xfe\x6d\xaa\xd0\xdc\x3e\x8c\x1f\x70\x8c\xb1\x91\xaf\x75\x5a\x61\x90\xc0\x59\x94\x43\xbb\x70\xa2\x24\x69\xe1\x64\x58\x7e\xfe\xd9\xbc\x72\xf0\x2b\xfc\x29\xfe\xfd\x65\xc2\x0c\x94\xad\xb7\xd9\xae\x0c\x10\xec\x40\x2b\x38\x3b\x50\x3f\x7f\x9d\x4b\x24\xb5\x59\xd4\x00\xbd\x3d\x50\x9f\xca\x08\x4a\xb8\x2b\xcc\xae\x43\xb3\xe9\xaf\xe9\x72\x5f\x96\xd2\x49\x09\x14\xf2\x0a\x5f\x51\xca\xea\x0a\x42\xa2\x9e\x5c\x16\x2d\xbf\x81\xc1\x66\xd7\x3d\xdf\xc1\xff\x27\xc1\xff\xa4\x00\xa2\xf8\xf7\x94\x95\x5d\xda\x04\x1f\x85\x43\xbe\xc2\xce\x11\x45\xf3\x0a\x03\x65\x65\x97\xfe\x19\x00\xba\x06\x2b\x2a\xd7\x04\x00\x00"),
 		},
 		"/operator-prometheus-rule.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "operator-prometheus-rule.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 5408,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x97\xef\x6f\xda\x46\x18\xc7\xdf\xfb\xaf\xf8\x0a\xb7\x12\x99\x82\x81\x4c\xd9\x0b\xa6\x4e\xa2\x69\xd0\x50\x2b\xd8\x62\xba\xaa\x9a\xa6\xe8\xb0\x1f\xcc\x35\xe7\x3b\xf7\x7e\x40\x23\x96\xff\x7d\xba\x03\x5a\xdc\x2e\x49\xdb\xd0\x6e\x89\xdf\x24\x3e\x3f\x7e\x7e\x7d\x3f\x7e\xee\x88\xd1\xda\xdf\x15\xc5\x78\xc1\x33\x92\x86\x72\x58\x05\x3b\x27\xf4\x2b\x96\xcd\x09\xa9\x9a\xd9\x25\xd3\x84\x81\x72\x32\x67\x96\x2b\x89\x66\x3f\x1d\x1c\xc0\xc9\x9c\x34\x94\x24\x28\x8d\x52\x69\x8a\x62\x64\x4a\x5a\xcd\xa7\xce\x2a\x0d\xb1\x76\x08\x56\x68\xa2\x92\xa4\x35\x09\x90\x12\x05\xef\xa3\xf1\x64\x78\x72\x8a\x19\x17\x84\x9c\x9b\xf5\x4b\x94\x63\xc9\xed\x3c\x8a\x61\xe7\xdc\x60\xa9\xf4\x05\x66\x4a\x83\xe5\x39\xf7\x81\x99\x00\x97\x33\xa5\xcb\x75\x1a\x9a\x0a\xa6\x73\x2e\x0b\x64\xaa\xba\xd4\xbc\x98\x5b\xa8\xa5\x24\x6d\xe6\xbc\x4a\xa2\x18\x13\x5f\x46\x3a\xd8\x66\x62\xd6\x6e\x43\x4c\xab\xf0\x5a\xb9\x4d\x0d\x3b\xe5\x6e\xba\x70\x88\x3f\x48\x1b\x1f\xe4\x28\xe9\x44\x31\x9a\xde\xa4\xb1\x79\xd8\x38\xf8\x19\x97\xca\xa1\x64\x97\x90\xca\xc2\x19\xda\xf1\x4c\xef\x32\xaa\x2c\xb8\x44\xa6\xca\x4a\x70\x26\x33\xfa\x50\xd6\xfb\x08\x09\x42\x02\xde\x87\x9a\x5a\xc6\x25\x58\x28\x03\x6a\xb6\x6b\x06\x66\xa3\x38\x8a\x11\xae\xb9\xb5\x55\xaf\xdd\x5e\x2e\x97\x09\x0b\xe9\x26\x4a\x17\xed\x6d\x75\xed\x17\xc3\x93\xd3\x51\x7a\xda\x0a\x29\x47\x31\x5e\x4a\x41\xc6\x40\xd3\x5b\xc7\x35\xe5\x98\x5e\x82\x55\x95\xe0\x19\x9b\x0a\x82\x60\x4b\x2f\x5c\x50\x27\x88\xce\x25\x96\x9a\x5b\x2e\x8b\x43\x98\x8d\xea\x51\x5c\x53\xe7\x43\xbb\xb6\xe9\x71\x53\x33\x50\x12\x4c\xa2\xd1\x4f\x31\x4c\x1b\x78\xda\x4f\x87\xe9\x61\x14\xe3\xd5\x70\xf2\xeb\xf8\xe5\x04\xaf\xfa\x67\x67\xfd\xd1\x64\x78\x9a\x62\x7c\x86\x93\xf1\xe8\xd9\x70\x32\x1c\x8f\x52\x8c\x07\xe8\x8f\x5e\xe3\xf9\x70\xf4\xec\x10\xc4\xed\x9c\x34\xe8\x5d\xa5\x7d\xfe\x4a\x83\xfb\x46\x52\xee\x35\xdd\x02\xb4\x4d\xc0\xf3\xe1\xef\x4d\x45\x19\x9f\xf1\x0c\x82\xc9\xc2\xb1\x82\x50\xa8\x05\x69\xe9\xf1\xa8\x48\x97\xdc\x78\x39\x0d\x98\xcc\xa3\x18\x82\x97\xdc\x06\x8a\xcc\xa7\x45\xf9\x30\xfb\xfc\xb6\x22\x56\xf1\x0d\x4e\x3d\x94\x4a\x72\xab\x34\x97\x45\x92\x29\x4d\xca\x24\x99\x2a\xdb\x8b\x6e\x74\xc1\x65\xde\xc3\x6f\x5a\x95\x64\xe7\xe4\xcc\x99\x13\x14\x95\x64\x59\xce\x2c\xeb\x45\x80\x64\x25\xf5\x90\xb1\x92\x44\xeb\xa2\xa5\x2a\xd2\xcc\x2a\x1d\xf9\xc2\xfd\xe3\x42\x2b\x57\x19\xff\x1f\xd0\xba\xce\x78\xcd\x91\x76\x82\x36\x96\x6b\x6b\x26\x48\xdb\x1e\x4e\xbc\xf9\xf3\x33\xca\x94\xcc\xb8\xe0\xa1\x3f\xcf\x9c\x0e\x7f\xdf\x5b\x23\x08\xd3\xc3\xdf\x3b\x2b\x40\xb3\x76\xd7\x45\x0b\xc6\x95\x4d\xcd\x2c\x35\x43\x12\xe7\x17\xe7\xba\xe6\xf7\x3c\xdf\x38\x3e\x37\x7e\x3d\x37\xe7\x53\x97\x5d\x90\x5d\x09\x7a\xd2\xe8\x24\xc7\x8d\xab\x3f\x8f\xcb\xbf\x0e\x0e\x3c\xb5\xcd\x37\x6a\x7a\x50\x0b\xd0\xae\xdd\x7d\x71\xa8\x4c\x39\x69\x6f\xf2\x5f\xbf\xfb\x01\xdd\x4e\xa7\xb6\xf2\x0b\xba\xbb\x0b\x33\xa5\x7b\xe8\x96\x3b\x2b\x82\x4d\x49\xec\xf4\x38\xa4\x49\x0b\xd2\xdc\x5e\xf6\xb0\x64\x01\xcc\x9d\xa7\x4c\x4a\xb5\x01\xb2\xfe\x52\x49\xc6\xb0\x82\x3e\xee\x37\xb0\x5a\xa1\xd2\x5c\xda\x19\x1a\x8f\x3b\x49\x67\xd6\xc0\xa3\x05\x13\x8e\x70\x75\xf5\x78\x3b\x44\xea\x7d\x08\x93\x80\x8c\x35\x1f\x79\xf2\x9f\xd0\x6a\x85\x47\xeb\x9c\x93\x37\x6a\x8a\xab\x2b\xcc\xd9\x22\x7c\x69\x5c\x63\xdb\x3f\xb0\xa9\x5a\x10\x3a\xc9\xb1\x49\x3e\x8f\x9e\x01\xe3\xc2\x69\xba\x05\x9e\xaf\xd3\x6f\xa5\xc9\x38\x61\x9f\x34\x4e\xb5\x56\x9a\xf2\xff\x96\x98\x7f\x65\xe4\x13\x44\x3a\x0f\x90\x91\x19\xe3\xc2\xcf\xe6\x6b\x80\x48\x5d\x96\x91\x31\x4f\x1d\x17\xf9\x76\x98\x1c\x95\x77\x1f\x27\x53\xef\xf0\xc6\x29\xd2\x3d\xea\x34\x0e\xb7\x90\x84\x34\x28\xbf\x23\x26\xd7\x04\xad\xf3\xf8\x99\xa1\x1e\xc8\x8c\x31\x6b\x7d\x67\x4e\x20\x74\xe7\xae\xd3\xe5\xa8\xfc\x22\x94\x8e\xbf\x0b\x4a\x3f\x76\xee\x3b\x4a\x77\x20\x29\xf3\xa7\xc2\x8c\x89\x7b\x87\xd2\xf1\xf5\x28\x05\x86\xbe\x72\x77\xfa\x2c\xe5\x06\x61\x2a\x7e\x33\x42\x1e\xe4\x56\xb4\x16\xfd\x0e\x7b\x4d\x50\x35\x1c\x07\xbe\x89\xa6\xc1\xf3\xc3\x90\xf4\xbb\x7d\xd3\xb7\x6b\x4a\xeb\xe3\xdb\xcd\xa2\xfe\xee\xc8\xd1\x76\xe6\x77\xf7\x36\xf3\xdf\x7a\xb7\x37\x4e\xfe\x9f\x3a\x7b\x10\xfc\x9a\x30\xfb\xf8\x19\x72\x0f\x4e\x08\x37\x21\xf0\x91\xbb\x00\xc4\x94\x48\x22\xb4\x2c\x0f\x2f\x95\x4a\xfb\x01\xcf\x24\xba\xb7\x0c\xf4\x1a\x25\xfb\x3b\x19\xdc\x4e\x89\x3f\x1f\x3c\x54\x4c\xfe\x17\xa3\xe2\xcb\x38\xf1\x1b\xff\x3f\x01\x00\x00\xff\xff\x12\x0a\x88\x35\x20\x15\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x98\xfd\x6f\xda\x46\x18\xc7\x7f\xf7\x5f\xf1\x15\x6e\xa5\x64\x0a\xc6\xa4\x62\x3f\x30\x75\x12\x4d\x13\x0d\xb5\x82\x2d\xa6\xab\xaa\x69\x8a\x0e\xfb\xc1\x5c\x73\xbe\x73\xef\x05\x1a\xb1\xfc\xef\xd3\x1d\xd0\x42\xb7\x04\xd2\xb4\xdd\x52\xcb\x52\x72\x2f\x7e\xde\xbe\x9f\x7b\x6c\x11\xa3\xf9\xe5\xae\x28\xc6\x4b\x9e\x93\x34\x54\xc0\x2a\xd8\x29\xa1\x57\xb3\x7c\x4a\xc8\xd4\xc4\xce\x99\x26\x9c\x29\x27\x0b\x66\xb9\x92\x38\xe8\x65\x67\x87\x70\xb2\x20\x0d\x25\x09\x4a\xa3\x52\x9a\xa2\x18\xb9\x92\x56\xf3\xb1\xb3\x4a\x43\x2c\x0d\x82\x95\x9a\xa8\x22\x69\x4d\x02\x64\x44\xc1\xfa\x60\x38\xea\x9f\x9c\x62\xc2\x05\xa1\xe0\x66\xf9\x10\x15\x98\x73\x3b\x8d\x62\xd8\x29\x37\x98\x2b\x7d\x89\x89\xd2\x60\x45\xc1\xbd\x63\x26\xc0\xe5\x44\xe9\x6a\x19\x86\xa6\x92\xe9\x82\xcb\x12\xb9\xaa\xaf\x34\x2f\xa7\x16\x6a\x2e\x49\x9b\x29\xaf\x93\x28\xc6\xc8\xa7\x91\x9d\xad\x23\x31\x4b\xb3\xc1\xa7\x55\x78\xa3\xdc\x2a\x87\x8d\x74\x57\x55\x38\xc2\xef\xa4\x8d\x77\x72\x9c\xa4\x51\x8c\x03\xbf\xa5\xb1\x5a\x6c\x1c\xfe\x84\x2b\xe5\x50\xb1\x2b\x48\x65\xe1\x0c\x6d\x58\xa6\xf7\x39\xd5\x16\x5c\x22\x57\x55\x2d\x38\x93\x39\x7d\x4c\xeb\x83\x87\x04\x21\x00\x6f\x43\x8d\x2d\xe3\x12\x2c\xa4\x01\x35\xd9\xdc\x06\x66\xa3\x38\x8a\x11\xae\xa9\xb5\x75\xb7\xd5\x9a\xcf\xe7\x09\x0b\xea\x24\x4a\x97\xad\x75\x76\xad\x97\xfd\x93\xd3\x41\x76\xda\x0c\x21\x47\x31\x5e\x49\x41\xc6\x40\xd3\x3b\xc7\x35\x15\x18\x5f\x81\xd5\xb5\xe0\x39\x1b\x0b\x82\x60\x73\x2f\x5c\x50\x27\x88\xce\x25\xe6\x9a\x5b\x2e\xcb\x23\x98\x95\xea\x51\xbc\xa5\xce\xc7\x72\xad\xc3\xe3\x66\x6b\x83\x92\x60\x12\x8d\x5e\x86\x7e\xd6\xc0\xb3\x5e\xd6\xcf\x8e\xa2\x18\xaf\xfb\xa3\x5f\x86\xaf\x46\x78\xdd\x3b\x3f\xef\x0d\x46\xfd\xd3\x0c\xc3\x73\x9c\x0c\x07\xcf\xfb\xa3\xfe\x70\x90\x61\x78\x86\xde\xe0\x0d\x5e\xf4\x07\xcf\x8f\x40\xdc\x4e\x49\x83\xde\xd7\xda\xc7\xaf\x34\xb8\x2f\x24\x15\x5e\xd3\x35\x40\xeb\x00\x3c\x1f\x7e\x6c\x6a\xca\xf9\x84\xe7\x10\x4c\x96\x8e\x95\x84\x52\xcd\x48\x4b\x8f\x47\x4d\xba\xe2\xc6\xcb\x69\xc0\x64\x11\xc5\x10\xbc\xe2\x36\x50\x64\xfe\x99\x94\x77\xb3\x3e\x18\x5f\xe0\x8a\x22\x56\xf3\x15\x4e\x5d\x54\x4a\x72\xab\x34\x97\x65\x92\x2b\x4d\xca\x24\xb9\xaa\x5a\xb3\x76\x74\xc9\x65\xd1\xc5\xaf\x5a\x55\x64\xa7\xe4\xcc\xb9\x13\x14\x55\x64\x59\xc1\x2c\xeb\x46\x80\x64\x15\x75\x91\xb3\x8a\x44\xf3\xb2\xa9\x6a\xd2\xcc\x2a\x1d\xf9\xc4\xfd\x72\xa9\x95\xab\x8d\xff\x0f\x68\xde\xb4\xd9\x2f\x02\xda\x09\x5a\xed\xf4\x77\x13\x4c\x90\xb6\x5d\x9c\xf8\xed\x2f\xce\x29\x57\x32\xe7\x82\x87\xfa\x3c\x77\x3a\xfc\xfd\xb0\x1b\x41\x98\x2e\xfe\xda\x98\x01\x0e\xb6\x46\x6d\x34\x61\x5c\x75\xa0\x99\xa5\x83\x10\xf1\xc5\xe5\x85\xde\xb2\x7b\x51\xac\x0c\x5f\x18\x3f\x5f\x98\x8b\xb1\xcb\x2f\xc9\x2e\x04\x3d\x6d\xa4\x49\xa7\x71\xfd\x47\xa7\xfa\xf3\xf0\xd0\x53\x7b\xf0\x56\x8d\x0f\xb7\x1c\xb4\xb6\x46\x77\x76\x95\x2b\x27\xed\x6d\xf6\xb7\x47\x3f\xa0\x9d\xa6\x5b\x33\x3f\xa3\xbd\x39\x31\x51\xba\x8b\x76\xb5\x31\x23\xd8\x98\xc4\x46\x8d\xfd\x6d\x68\x46\x9a\xdb\xab\x2e\xe6\x2c\x80\xb9\xb1\xca\xa4\x54\x2b\x20\xb7\x1f\xaa\xc8\x18\x56\xd2\xa7\xf5\x06\x16\x0b\xd4\x9a\x4b\x3b\x41\xe3\x71\x9a\xa4\x93\x06\x1e\xcd\x98\x70\x84\xeb\xeb\xc7\xeb\x26\xb2\x5d\x87\xd0\x09\xc8\x58\xf3\x89\x25\x7f\x84\x16\x0b\x3c\x5a\xc6\x9c\xbc\x55\x63\x5c\x5f\x63\xca\x66\xe1\xa4\x71\x8d\x75\xfd\xc0\xc6\x6a\x46\x48\x93\x8e\x49\xf6\xa3\xe7\x8c\x71\xe1\x34\xed\x80\xe7\xf3\xf4\x5b\x68\x32\x4e\xd8\xa7\x8d\x53\xad\x95\xa6\xe2\xbf\x25\xe6\x5f\x19\xd9\x18\x2f\x11\x49\xbf\x43\x46\x26\x8c\x0b\xdf\x9b\x6f\x00\x22\x73\x79\x4e\xc6\x3c\x73\x5c\x14\xeb\x66\x72\x5c\xed\x20\x62\x8f\x76\x32\xf6\x06\x6f\xed\x22\xed\xe3\xb4\x71\xb4\x86\x24\x84\x41\xc5\x3d\x31\xb9\xc1\xe9\x36\x8f\x7b\xba\xda\x83\x9f\x87\xd0\x63\x8c\xcf\xd6\x98\x89\x13\x08\xd5\xb9\x6f\x77\x39\xae\xee\x84\x52\xe7\x9b\xa0\xf4\x24\x7d\xe8\x28\xdd\x83\xa4\xdc\x7f\x15\xe6\x4c\x3c\x38\x94\x3a\x37\xa3\x14\x18\xfa\xcc\xb7\xd3\x5e\xca\x79\xdb\x5f\x91\x90\xdb\xcc\x3e\xd8\x57\x51\x28\xac\xb9\xc7\xbb\x26\xa8\x1a\x3e\x07\xbe\x8a\xa6\xc1\xf2\xf7\x21\xe9\x37\x3b\xd3\xbb\x35\x25\x5f\xd5\x5d\xa2\xfe\xe6\xc8\xd1\xba\xe7\xb7\xab\x1d\xf2\xee\xdd\xf3\xdf\x79\xb3\xb7\x76\xfe\x1f\xd3\x2f\x20\xf8\x0d\x6e\x76\xca\xfe\x39\x10\x3c\xac\x63\xfd\x89\xb9\xf0\x59\x30\x26\x92\x08\x25\x2b\xc2\x43\xfe\xb7\x25\xd8\x29\x93\x68\x57\x77\xa0\xa4\xf3\x0d\x29\x79\x92\x7e\xbf\x98\xfc\x2f\x5a\xc5\xdd\x38\xe9\x54\x49\xf4\xf7\x00\x12\x0a\x88\x35\x20\x15\x00\x00"),
 		},
 		"/operator-role-binding-events.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "operator-role-binding-events.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 1219,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x93\x41\x6f\xfa\x46\x10\xc5\xef\xfb\x29\x9e\xf0\xe5\x1f\x09\x4c\xdb\x53\x45\x4f\x4e\x02\xad\xd5\x08\x24\x4c\x1a\xe5\xb8\xac\x07\x7b\x8a\xbd\xe3\xee\xae\x71\xe8\xa7\xaf\xd6\x40\x93\xa8\x6a\xd5\x43\xf6\x86\x18\xbf\xf9\xbd\x7d\x6f\x13\xcc\xbe\xee\xa8\x04\x4f\x6c\xc8\x7a\x2a\x11\x04\xa1\x26\x64\x9d\x36\x35\xa1\x90\x43\x18\xb4\x23\xac\xa4\xb7\xa5\x0e\x2c\x16\xdf\xb2\x62\x75\x87\xde\x96\xe4\x20\x96\x20\x0e\xad\x38\x52\x09\x8c\xd8\xe0\x78\xdf\x07\x71\x68\x2e\x82\xd0\x95\x23\x6a\xc9\x06\x9f\x02\x05\xd1\xa8\xbe\xde\xec\xf2\x87\x25\x0e\xdc\x10\x4a\xf6\x97\x8f\xa8\xc4\xc0\xa1\x56\x09\x42\xcd\x1e\x83\xb8\x23\x0e\xe2\xa0\xcb\x92\xe3\x62\xdd\x80\xed\x41\x5c\x7b\xc1\x70\x54\x69\x57\xb2\xad\x60\xa4\x3b\x3b\xae\xea\x00\x19\x2c\x39\x5f\x73\x97\xaa\x04\xbb\x68\xa3\x58\xdd\x48\xfc\x45\x76\xdc\x19\x04\xaf\xd2\x5f\x3d\x7c\xb0\x7b\xbd\x85\x29\x7e\x23\xe7\xe3\x92\x1f\xd2\xef\x54\x82\x6f\x71\x64\x72\xfd\x73\x72\xf7\x13\xce\xd2\xa3\xd5\x67\x58\x09\xe8\x3d\x7d\x50\xa6\x37\x43\x5d\x00\x5b\x18\x69\xbb\x86\xb5\x35\xf4\x6e\xeb\xef\x0d\x29\x46\x80\xa8\x21\xfb\xa0\xd9\x42\x8f\x36\x20\x87\x8f\x63\xd0\x41\x25\x2a\xc1\x78\xea\x10\xba\xc5\x7c\x3e\x0c\x43\xaa\x47\xdc\x54\x5c\x35\xbf\xb9\x9b\x3f\xe5\x0f\xcb\x75\xb1\x9c\x8d\xc8\x2a\xc1\xb3\x6d\xc8\x7b\x38\xfa\xa3\x67\x47\x25\xf6\x67\xe8\xae\x6b\xd8\xe8\x7d\x43\x68\xf4\x10\x83\x1b\xd3\x19\x43\x67\x8b\xc1\x71\x60\x5b\x4d\xe1\xaf\xa9\xab\xe4\x53\x3a\xef\xd7\x75\xc3\x63\xff\x69\x40\x2c\xb4\xc5\x24\x2b\x90\x17\x13\xdc\x67\x45\x5e\x4c\x55\x82\x97\x7c\xf7\xcb\xe6\x79\x87\x97\x6c\xbb\xcd\xd6\xbb\x7c\x59\x60\xb3\xc5\xc3\x66\xfd\x98\xef\xf2\xcd\xba\xc0\x66\x85\x6c\xfd\x8a\x5f\xf3\xf5\xe3\x14\xc4\xa1\x26\x07\x7a\xeb\x5c\xe4\x17\x07\x8e\x17\x49\x65\xcc\xf4\x56\xa0\x1b\x40\xec\x47\xfc\xed\x3b\x32\x7c\x60\x83\x46\xdb\xaa\xd7\x15\xa1\x92\x13\x39\x1b\xeb\xd1\x91\x6b\xd9\xc7\x38\x3d\xb4\x2d\x55\x82\x86\x5b\x0e\x63\x8b\xfc\x3f\x4d\xc5\x35\x5f\xf9\xb6\xd4\x91\x6d\xb9\xc0\x56\x1a\xba\x67\x1b\x0b\xab\x74\xc7\xd7\x82\x2d\xe0\xf6\xda\xa4\xba\x0f\xb5\x38\xfe\x73\x64\x4a\x8f\x3f\xfa\x94\x65\x7e\xfa\x5e\xb5\x14\x74\xa9\x83\x5e\x28\xc0\xea\x96\x16\x30\xba\xa5\x66\x76\x9c\x49\x47\x4e\x07\x71\x33\x3a\xc5\xb7\xa5\x80\x46\xef\xa9\xf1\x71\x12\x31\xe8\x05\x26\xd7\xd9\x89\xf2\xfd\xfe\x77\x32\xc1\x2f\xd4\x0c\x17\x9a\x82\xdc\x89\x0d\x65\xc6\x48\x6f\xc3\xbf\xaa\x2b\x27\x0d\x6d\xe9\x10\x55\xdf\x6d\xfc\x0f\x18\xdd\xf1\xcf\x4e\xfa\xee\x3f\xfc\xa9\xbf\x02\x00\x00\xff\xff\x1f\xf3\xa2\x3c\xc3\x04\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x53\x4f\x6f\xfa\x46\x10\xbd\xef\xa7\x78\xc2\x97\x5f\x24\x30\x6d\x4f\x15\x3d\x39\x09\xb4\x56\x23\x90\x30\x69\x94\xe3\xb2\x1e\xec\x29\xf6\x8e\xbb\xbb\xc6\xa1\x9f\xbe\x5a\x03\x4d\xa2\xaa\x55\x0f\x99\x1b\x62\xfc\xfe\xcc\x7b\x9b\x60\xf6\x75\xa3\x12\x3c\xb1\x21\xeb\xa9\x44\x10\x84\x9a\x90\x75\xda\xd4\x84\x42\x0e\x61\xd0\x8e\xb0\x92\xde\x96\x3a\xb0\x58\x7c\xcb\x8a\xd5\x1d\x7a\x5b\x92\x83\x58\x82\x38\xb4\xe2\x48\x25\x30\x62\x83\xe3\x7d\x1f\xc4\xa1\xb9\x00\x42\x57\x8e\xa8\x25\x1b\x7c\x0a\x14\x44\x23\xfa\x7a\xb3\xcb\x1f\x96\x38\x70\x43\x28\xd9\x5f\x3e\xa2\x12\x03\x87\x5a\x25\x08\x35\x7b\x0c\xe2\x8e\x38\x88\x83\x2e\x4b\x8e\xc4\xba\x01\xdb\x83\xb8\xf6\x22\xc3\x51\xa5\x5d\xc9\xb6\x82\x91\xee\xec\xb8\xaa\x03\x64\xb0\xe4\x7c\xcd\x5d\xaa\x12\xec\xa2\x8d\x62\x75\x53\xe2\x2f\xb0\x23\x67\x10\xbc\x4a\x7f\xf5\xf0\xc1\xee\xf5\x0a\x53\xfc\x46\xce\x47\x92\x1f\xd2\xef\x54\x82\x6f\x71\x65\x72\xfd\x73\x72\xf7\x13\xce\xd2\xa3\xd5\x67\x58\x09\xe8\x3d\x7d\x40\xa6\x37\x43\x5d\x00\x5b\x18\x69\xbb\x86\xb5\x35\xf4\x6e\xeb\x6f\x86\x14\xa3\x80\x88\x21\xfb\xa0\xd9\x42\x8f\x36\x20\x87\x8f\x6b\xd0\x41\x25\x2a\xc1\x38\x75\x08\xdd\x62\x3e\x1f\x86\x21\xd5\x63\x3a\xa9\xb8\x6a\x7e\x73\x37\x7f\xca\x1f\x96\xeb\x62\x39\x1b\x25\xab\x04\xcf\xb6\x21\xef\xe1\xe8\x8f\x9e\x1d\x95\xd8\x9f\xa1\xbb\xae\x61\xa3\xf7\x0d\xa1\xd1\x43\x0c\x6e\x4c\x67\x0c\x9d\x2d\x06\xc7\x81\x6d\x35\x85\xbf\xa6\xae\x92\x4f\xe9\xbc\x9f\xeb\x26\x8f\xfd\xa7\x05\xb1\xd0\x16\x93\xac\x40\x5e\x4c\x70\x9f\x15\x79\x31\x55\x09\x5e\xf2\xdd\x2f\x9b\xe7\x1d\x5e\xb2\xed\x36\x5b\xef\xf2\x65\x81\xcd\x16\x0f\x9b\xf5\x63\xbe\xcb\x37\xeb\x02\x9b\x15\xb2\xf5\x2b\x7e\xcd\xd7\x8f\x53\x10\x87\x9a\x1c\xe8\xad\x73\x51\xbf\x38\x70\x3c\x24\x95\x31\xd3\x5b\x81\x6e\x02\x62\x3f\xe2\x6f\xdf\x91\xe1\x03\x1b\x34\xda\x56\xbd\xae\x08\x95\x9c\xc8\xd9\x58\x8f\x8e\x5c\xcb\x3e\xc6\xe9\xa1\x6d\xa9\x12\x34\xdc\x72\x18\x5b\xe4\xff\x69\x2a\xd2\xdc\x1e\xc6\x17\x8c\x52\x47\xb6\xe5\x02\x5b\x69\xe8\x9e\x6d\x2c\xac\xd2\x1d\x5f\x0b\xb6\x80\xdb\x6b\x93\xea\x3e\xd4\xe2\xf8\xcf\x51\x53\x7a\xfc\xd1\xa7\x2c\xf3\xd3\xf7\xaa\xa5\xa0\x4b\x1d\xf4\x42\x01\x56\xb7\xb4\x80\xd1\x2d\x35\xb3\xe3\x4c\x3a\x72\x3a\x88\x9b\xd1\x29\xbe\x2d\x05\x34\x7a\x4f\x8d\x8f\x9b\x88\x41\x2f\x30\xb9\xee\x4e\x94\xef\xf7\xbf\x93\x09\x7e\xa1\x66\xb8\xa8\x29\xc8\x9d\xd8\x50\x66\x8c\xf4\x36\xfc\x2b\xba\x72\xd2\xd0\x96\x0e\x11\xf5\xdd\xc6\xff\x10\xa3\x3b\xfe\xd9\x49\xdf\xfd\x87\x3f\xf5\xd7\x00\x1f\xf3\xa2\x3c\xc3\x04\x00\x00"),
 		},
 		"/operator-role-binding-knative.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "operator-role-binding-knative.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 1221,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x93\x41\x8f\xdb\x36\x10\x85\xef\xfc\x15\x0f\xd6\x25\x01\xd6\x72\xdb\x53\xe1\x9e\x94\xcd\x6e\x2b\x34\xb0\x01\xcb\x69\x90\xe3\x98\x1a\x4b\x53\x4b\x1c\x95\xa4\xac\xb8\xbf\xbe\xa0\x6c\x77\x37\x28\x5a\xf4\x10\xde\x04\x8d\xde\x7c\x8f\xef\x29\xc3\xf2\xdb\x1d\x93\xe1\x83\x58\x76\x81\x6b\x44\x45\x6c\x19\xc5\x40\xb6\x65\x54\x7a\x8c\x13\x79\xc6\xb3\x8e\xae\xa6\x28\xea\xf0\xa6\xa8\x9e\xdf\x62\x74\x35\x7b\xa8\x63\xa8\x47\xaf\x9e\x4d\x06\xab\x2e\x7a\x39\x8c\x51\x3d\xba\xab\x20\xa8\xf1\xcc\x3d\xbb\x18\x72\xa0\x62\x9e\xd5\x37\xdb\x7d\xf9\xf8\x84\xa3\x74\x8c\x5a\xc2\xf5\x23\xae\x31\x49\x6c\x4d\x86\xd8\x4a\xc0\xa4\xfe\x84\xa3\x7a\x50\x5d\x4b\x5a\x4c\x1d\xc4\x1d\xd5\xf7\x57\x0c\xcf\x0d\xf9\x5a\x5c\x03\xab\xc3\xc5\x4b\xd3\x46\xe8\xe4\xd8\x87\x56\x86\xdc\x64\xd8\x27\x1b\xd5\xf3\x9d\x24\x5c\x65\xe7\x9d\x51\xf1\x59\xc7\x9b\x87\x57\x76\x6f\xb7\xf0\x80\xdf\xd8\x87\xb4\xe4\x87\xfc\x3b\x93\xe1\x4d\x1a\x59\xdc\x5e\x2e\xde\xfe\x84\x8b\x8e\xe8\xe9\x02\xa7\x11\x63\xe0\x57\xca\xfc\xc5\xf2\x10\x21\x0e\x56\xfb\xa1\x13\x72\x96\x5f\x6c\xfd\xbd\x21\xc7\x0c\x90\x34\xf4\x10\x49\x1c\x68\xb6\x01\x3d\xbe\x1e\x03\x45\x93\x99\x0c\xf3\x69\x63\x1c\xd6\xab\xd5\x34\x4d\x39\xcd\xb8\xb9\xfa\x66\x75\x77\xb7\xfa\x50\x3e\x3e\x6d\xaa\xa7\xe5\x8c\x6c\x32\x7c\x74\x1d\x87\x00\xcf\x7f\x8c\xe2\xb9\xc6\xe1\x02\x1a\x86\x4e\x2c\x1d\x3a\x46\x47\x53\x0a\x6e\x4e\x67\x0e\x5d\x1c\x26\x2f\x51\x5c\xf3\x80\x70\x4b\xdd\x64\x5f\xa5\xf3\x72\x5d\x77\x3c\x09\x5f\x0d\xa8\x03\x39\x2c\x8a\x0a\x65\xb5\xc0\xbb\xa2\x2a\xab\x07\x93\xe1\x53\xb9\xff\x65\xfb\x71\x8f\x4f\xc5\x6e\x57\x6c\xf6\xe5\x53\x85\xed\x0e\x8f\xdb\xcd\xfb\x72\x5f\x6e\x37\x15\xb6\xcf\x28\x36\x9f\xf1\x6b\xb9\x79\xff\x00\x96\xd8\xb2\x07\x7f\x19\x7c\xe2\x57\x0f\x49\x17\xc9\x75\xca\xf4\x5e\xa0\x3b\x40\xea\x47\x7a\x0e\x03\x5b\x39\x8a\x45\x47\xae\x19\xa9\x61\x34\x7a\x66\xef\x52\x3d\x06\xf6\xbd\x84\x14\x67\x00\xb9\xda\x64\xe8\xa4\x97\x38\xb7\x28\xfc\xd3\x54\x5a\xf3\x2d\xff\x2d\x73\x12\x57\xaf\xb1\xd3\x8e\xdf\x89\x4b\x85\x35\x34\xc8\xad\x60\x6b\xf8\x03\xd9\x9c\xc6\xd8\xaa\x97\x3f\x67\xa6\xfc\xf4\x63\xc8\x45\x57\xe7\xef\x4d\xcf\x91\x6a\x8a\xb4\x36\x80\xa3\x9e\xd7\xb0\xd4\x73\xb7\x3c\x2d\x75\x60\x4f\x51\xfd\xf2\xe4\x28\xca\x99\x0d\xd0\xd1\x81\xbb\x90\x46\x91\x92\x5e\x63\x71\x1b\x5e\x98\x30\x1e\x7e\x67\x1b\xc3\xda\x2c\x71\xc5\xa9\xd8\x9f\xc5\x72\x61\xad\x8e\x2e\xfe\xab\xbc\xf1\xda\xf1\x8e\x8f\x49\xf5\xc5\xc7\xff\xa1\xa1\x41\x7e\xf6\x3a\x0e\xff\xe1\xd0\xfc\x15\x00\x00\xff\xff\x65\xb7\xce\x71\xc5\x04\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x53\x4d\x8f\xe3\x44\x10\xbd\xf7\xaf\x78\x8a\x2f\xbb\xd2\xc4\x01\x4e\x28\x9c\xbc\xb3\x33\x60\xb1\x4a\xa4\x38\xcb\x6a\x8f\x95\x76\xc5\x2e\x62\x77\x99\xee\x76\xbc\xe1\xd7\xa3\x76\x12\x66\x56\x08\xc4\x61\xeb\x66\xb9\xfc\x3e\xea\x3d\x67\x58\x7e\xbb\x31\x19\x3e\x88\x65\x17\xb8\x46\x54\xc4\x96\x51\x0c\x64\x5b\x46\xa5\xc7\x38\x91\x67\x3c\xeb\xe8\x6a\x8a\xa2\x0e\x6f\x8a\xea\xf9\x2d\x46\x57\xb3\x87\x3a\x86\x7a\xf4\xea\xd9\x64\xb0\xea\xa2\x97\xc3\x18\xd5\xa3\xbb\x02\x82\x1a\xcf\xdc\xb3\x8b\x21\x07\x2a\xe6\x19\x7d\xb3\xdd\x97\x8f\x4f\x38\x4a\xc7\xa8\x25\x5c\x3f\xe2\x1a\x93\xc4\xd6\x64\x88\xad\x04\x4c\xea\x4f\x38\xaa\x07\xd5\xb5\x24\x62\xea\x20\xee\xa8\xbe\xbf\xca\xf0\xdc\x90\xaf\xc5\x35\xb0\x3a\x5c\xbc\x34\x6d\x84\x4e\x8e\x7d\x68\x65\xc8\x4d\x86\x7d\xb2\x51\x3d\xdf\x95\x84\x2b\xec\xcc\x19\x15\x9f\x75\xbc\x79\x78\x65\xf7\x76\x85\x07\xfc\xc6\x3e\x24\x92\x1f\xf2\xef\x4c\x86\x37\x69\x65\x71\x7b\xb9\x78\xfb\x13\x2e\x3a\xa2\xa7\x0b\x9c\x46\x8c\x81\x5f\x21\xf3\x17\xcb\x43\x84\x38\x58\xed\x87\x4e\xc8\x59\x7e\xb1\xf5\x37\x43\x8e\x59\x40\xc2\xd0\x43\x24\x71\xa0\xd9\x06\xf4\xf8\x7a\x0d\x14\x4d\x66\x32\xcc\xd3\xc6\x38\xac\x57\xab\x69\x9a\x72\x9a\xd3\xc9\xd5\x37\xab\xbb\xbb\xd5\x87\xf2\xf1\x69\x53\x3d\x2d\x67\xc9\x26\xc3\x47\xd7\x71\x08\xf0\xfc\xc7\x28\x9e\x6b\x1c\x2e\xa0\x61\xe8\xc4\xd2\xa1\x63\x74\x34\xa5\xe0\xe6\x74\xe6\xd0\xc5\x61\xf2\x12\xc5\x35\x0f\x08\xb7\xd4\x4d\xf6\x55\x3a\x2f\xe7\xba\xcb\x93\xf0\xd5\x82\x3a\x90\xc3\xa2\xa8\x50\x56\x0b\xbc\x2b\xaa\xb2\x7a\x30\x19\x3e\x95\xfb\x5f\xb6\x1f\xf7\xf8\x54\xec\x76\xc5\x66\x5f\x3e\x55\xd8\xee\xf0\xb8\xdd\xbc\x2f\xf7\xe5\x76\x53\x61\xfb\x8c\x62\xf3\x19\xbf\x96\x9b\xf7\x0f\x60\x89\x2d\x7b\xf0\x97\xc1\x27\xfd\xea\x21\xe9\x90\x5c\xa7\x4c\xef\x05\xba\x0b\x48\xfd\x48\xcf\x61\x60\x2b\x47\xb1\xe8\xc8\x35\x23\x35\x8c\x46\xcf\xec\x5d\xaa\xc7\xc0\xbe\x97\x90\xe2\x0c\x20\x57\x9b\x0c\x9d\xf4\x12\xe7\x16\x85\x7f\x9a\x4a\x34\xf7\x1f\xe3\x1b\x8c\x31\x27\x71\xf5\x1a\x3b\xed\xf8\x9d\xb8\x54\x58\x43\x83\xdc\x0a\xb6\x86\x3f\x90\xcd\x69\x8c\xad\x7a\xf9\x73\xd6\x94\x9f\x7e\x0c\xb9\xe8\xea\xfc\xbd\xe9\x39\x52\x4d\x91\xd6\x06\x70\xd4\xf3\x1a\x96\x7a\xee\x96\xa7\xa5\x0e\xec\x29\xaa\x5f\x9e\x1c\x45\x39\xb3\x01\x3a\x3a\x70\x17\xd2\x2a\x52\xd2\x6b\x2c\x6e\xcb\x0b\x13\xc6\xc3\xef\x6c\x63\x58\x9b\x25\xae\x72\x2a\xf6\x67\xb1\x5c\x58\xab\xa3\x8b\xff\x0a\x6f\xbc\x76\xbc\xe3\x63\x42\x7d\xf1\xf1\x7f\xd4\xd0\x20\x3f\x7b\x1d\x87\xff\x70\x68\xfe\x1a\x00\x65\xb7\xce\x71\xc5\x04\x00\x00"),
 		},
 		"/operator-role-binding-leases.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "operator-role-binding-leases.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 1219,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x93\x41\x8f\xdb\x36\x10\x85\xef\xfc\x15\x0f\xd6\x25\x01\xd6\x72\xdb\x53\xe1\x9e\x9c\xcd\x6e\x2b\x34\xb0\x01\xcb\x69\x90\xe3\x98\x1a\x4b\xd3\xa5\x38\x2a\x49\xad\xe2\xfe\xfa\x82\xb2\xdd\xdd\xa0\x68\xd1\x43\x78\x13\x34\x7a\xf3\x3d\xbe\xa7\x02\xcb\x6f\x77\x4c\x81\x0f\x62\xd9\x47\x6e\x90\x14\xa9\x63\x6c\x06\xb2\x1d\xa3\xd6\x53\x9a\x28\x30\x1e\x75\xf4\x0d\x25\x51\x8f\x37\x9b\xfa\xf1\x2d\x46\xdf\x70\x80\x7a\x86\x06\xf4\x1a\xd8\x14\xb0\xea\x53\x90\xe3\x98\x34\xc0\x5d\x04\x41\x6d\x60\xee\xd9\xa7\x58\x02\x35\xf3\xac\xbe\xdd\x1d\xaa\xfb\x07\x9c\xc4\x31\x1a\x89\x97\x8f\xb8\xc1\x24\xa9\x33\x05\x52\x27\x11\x93\x86\x27\x9c\x34\x80\x9a\x46\xf2\x62\x72\x10\x7f\xd2\xd0\x5f\x30\x02\xb7\x14\x1a\xf1\x2d\xac\x0e\xe7\x20\x6d\x97\xa0\x93\xe7\x10\x3b\x19\x4a\x53\xe0\x90\x6d\xd4\x8f\x37\x92\x78\x91\x9d\x77\x26\xc5\x67\x1d\xaf\x1e\x5e\xd9\xbd\xde\xc2\x1d\x7e\xe3\x10\xf3\x92\x1f\xca\xef\x4c\x81\x37\x79\x64\x71\x7d\xb9\x78\xfb\x13\xce\x3a\xa2\xa7\x33\xbc\x26\x8c\x91\x5f\x29\xf3\x17\xcb\x43\x82\x78\x58\xed\x07\x27\xe4\x2d\xbf\xd8\xfa\x7b\x43\x89\x19\x20\x6b\xe8\x31\x91\x78\xd0\x6c\x03\x7a\x7a\x3d\x06\x4a\xa6\x30\x05\xe6\xd3\xa5\x34\xac\x57\xab\x69\x9a\x4a\x9a\x71\x4b\x0d\xed\xea\xe6\x6e\xf5\xa1\xba\x7f\xd8\xd6\x0f\xcb\x19\xd9\x14\xf8\xe8\x1d\xc7\x88\xc0\x7f\x8c\x12\xb8\xc1\xf1\x0c\x1a\x06\x27\x96\x8e\x8e\xe1\x68\xca\xc1\xcd\xe9\xcc\xa1\x8b\xc7\x14\x24\x89\x6f\xef\x10\xaf\xa9\x9b\xe2\xab\x74\x5e\xae\xeb\x86\x27\xf1\xab\x01\xf5\x20\x8f\xc5\xa6\x46\x55\x2f\xf0\x6e\x53\x57\xf5\x9d\x29\xf0\xa9\x3a\xfc\xb2\xfb\x78\xc0\xa7\xcd\x7e\xbf\xd9\x1e\xaa\x87\x1a\xbb\x3d\xee\x77\xdb\xf7\xd5\xa1\xda\x6d\x6b\xec\x1e\xb1\xd9\x7e\xc6\xaf\xd5\xf6\xfd\x1d\x58\x52\xc7\x01\xfc\x65\x08\x99\x5f\x03\x24\x5f\x24\x37\x39\xd3\x5b\x81\x6e\x00\xb9\x1f\xf9\x39\x0e\x6c\xe5\x24\x16\x8e\x7c\x3b\x52\xcb\x68\xf5\x99\x83\xcf\xf5\x18\x38\xf4\x12\x73\x9c\x11\xe4\x1b\x53\xc0\x49\x2f\x69\x6e\x51\xfc\xa7\xa9\xbc\xe6\x5b\xfe\x5b\xe6\x49\x7c\xb3\xc6\x5e\x1d\xbf\x13\x9f\x0b\x6b\x68\x90\x6b\xc1\xd6\x08\x47\xb2\x25\x8d\xa9\xd3\x20\x7f\xce\x4c\xe5\xd3\x8f\xb1\x14\x5d\x3d\x7f\x6f\x7a\x4e\xd4\x50\xa2\xb5\x01\x3c\xf5\xbc\x86\xa5\x9e\xdd\xf2\x69\xa9\x03\x07\x4a\x1a\x96\x8e\x29\x72\x34\x80\xa3\x23\xbb\x98\x27\x91\x83\x5e\x63\x71\x9d\x5d\x98\x38\x1e\x7f\x67\x9b\xe2\xda\x2c\x71\xa1\xa9\x39\x3c\x8b\xe5\x8d\xb5\x3a\xfa\xf4\xaf\xea\x26\xa8\xe3\x3d\x9f\xb2\xea\x8b\x8d\xff\x01\x43\x83\xfc\x1c\x74\x1c\xfe\xc3\x9f\xf9\x2b\x00\x00\xff\xff\x17\x67\x2a\x82\xc3\x04\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x53\x4d\x6f\xdb\x46\x10\xbd\xef\xaf\x78\x10\x2f\x09\x60\x51\x6d\x4f\x85\x7a\x52\x1c\xbb\x25\x1a\x48\x80\xa8\x34\xc8\x71\xb4\x1c\x91\x53\x2f\x77\xd8\xdd\xa5\x19\xf5\xd7\x17\x4b\x49\xb5\x83\xa2\x45\x0f\x99\x1b\xc1\xe1\xfb\x98\xf7\x58\x60\xf9\xed\xc6\x14\xf8\x20\x96\x7d\xe4\x06\x49\x91\x3a\xc6\x66\x20\xdb\x31\x6a\x3d\xa5\x89\x02\xe3\x51\x47\xdf\x50\x12\xf5\x78\xb3\xa9\x1f\xdf\x62\xf4\x0d\x07\xa8\x67\x68\x40\xaf\x81\x4d\x01\xab\x3e\x05\x39\x8e\x49\x03\xdc\x05\x10\xd4\x06\xe6\x9e\x7d\x8a\x25\x50\x33\xcf\xe8\xdb\xdd\xa1\xba\x7f\xc0\x49\x1c\xa3\x91\x78\xf9\x88\x1b\x4c\x92\x3a\x53\x20\x75\x12\x31\x69\x78\xc2\x49\x03\xa8\x69\x24\x13\x93\x83\xf8\x93\x86\xfe\x22\x23\x70\x4b\xa1\x11\xdf\xc2\xea\x70\x0e\xd2\x76\x09\x3a\x79\x0e\xb1\x93\xa1\x34\x05\x0e\xd9\x46\xfd\x78\x53\x12\x2f\xb0\x33\x67\x52\x7c\xd6\xf1\xea\xe1\x95\xdd\xeb\x15\xee\xf0\x1b\x87\x98\x49\x7e\x28\xbf\x33\x05\xde\xe4\x95\xc5\xf5\xe5\xe2\xed\x4f\x38\xeb\x88\x9e\xce\xf0\x9a\x30\x46\x7e\x85\xcc\x5f\x2c\x0f\x09\xe2\x61\xb5\x1f\x9c\x90\xb7\xfc\x62\xeb\x6f\x86\x12\xb3\x80\x8c\xa1\xc7\x44\xe2\x41\xb3\x0d\xe8\xe9\xf5\x1a\x28\x99\xc2\x14\x98\xa7\x4b\x69\x58\xaf\x56\xd3\x34\x95\x34\xa7\x53\x6a\x68\x57\x37\x77\xab\x0f\xd5\xfd\xc3\xb6\x7e\x58\xce\x92\x4d\x81\x8f\xde\x71\x8c\x08\xfc\xc7\x28\x81\x1b\x1c\xcf\xa0\x61\x70\x62\xe9\xe8\x18\x8e\xa6\x1c\xdc\x9c\xce\x1c\xba\x78\x4c\x41\x92\xf8\xf6\x0e\xf1\x9a\xba\x29\xbe\x4a\xe7\xe5\x5c\x37\x79\x12\xbf\x5a\x50\x0f\xf2\x58\x6c\x6a\x54\xf5\x02\xef\x36\x75\x55\xdf\x99\x02\x9f\xaa\xc3\x2f\xbb\x8f\x07\x7c\xda\xec\xf7\x9b\xed\xa1\x7a\xa8\xb1\xdb\xe3\x7e\xb7\x7d\x5f\x1d\xaa\xdd\xb6\xc6\xee\x11\x9b\xed\x67\xfc\x5a\x6d\xdf\xdf\x81\x25\x75\x1c\xc0\x5f\x86\x90\xf5\x6b\x80\xe4\x43\x72\x93\x33\xbd\x15\xe8\x26\x20\xf7\x23\x3f\xc7\x81\xad\x9c\xc4\xc2\x91\x6f\x47\x6a\x19\xad\x3e\x73\xf0\xb9\x1e\x03\x87\x5e\x62\x8e\x33\x82\x7c\x63\x0a\x38\xe9\x25\xcd\x2d\x8a\xff\x34\x95\x69\x6e\x3f\xc6\x37\x18\x63\x9e\xc4\x37\x6b\xec\xd5\xf1\x3b\xf1\xb9\xb0\x86\x06\xb9\x16\x6c\x8d\x70\x24\x5b\xd2\x98\x3a\x0d\xf2\xe7\xac\xa9\x7c\xfa\x31\x96\xa2\xab\xe7\xef\x4d\xcf\x89\x1a\x4a\xb4\x36\x80\xa7\x9e\xd7\xb0\xd4\xb3\x5b\x3e\x2d\x75\xe0\x40\x49\xc3\xd2\x31\x45\x8e\x06\x70\x74\x64\x17\xf3\x26\x72\xd0\x6b\x2c\xae\xbb\x0b\x13\xc7\xe3\xef\x6c\x53\x5c\x9b\x25\x2e\x6a\x6a\x0e\xcf\x62\x79\x63\xad\x8e\x3e\xfd\x2b\xba\x09\xea\x78\xcf\xa7\x8c\xfa\x62\xe3\x7f\x88\xa1\x41\x7e\x0e\x3a\x0e\xff\xe1\xcf\xfc\x35\x00\x17\x67\x2a\x82\xc3\x04\x00\x00"),
 		},
 		"/operator-role-binding-servicemonitors.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "operator-role-binding-servicemonitors.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 1237,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x53\x4d\x8f\xdb\x36\x10\xbd\xf3\x57\x3c\x58\x97\x04\x58\xcb\x6d\x4f\x85\x7b\x52\x36\xeb\x56\x68\x60\x03\x96\xd3\x20\x47\x9a\x1a\x4b\x53\x4b\x1c\x75\x48\xad\xe2\xfe\xfa\x82\xb2\xdd\xdd\xa0\x68\x81\x02\xe1\xcd\xf0\xcc\xfb\x98\xf7\x94\x61\xf9\xed\x9e\xc9\xf0\x81\x1d\xf9\x40\x35\xa2\x20\xb6\x84\x62\xb0\xae\x25\x54\x72\x8a\x93\x55\xc2\x46\x46\x5f\xdb\xc8\xe2\xf1\xa6\xa8\x36\x6f\x31\xfa\x9a\x14\xe2\x09\xa2\xe8\x45\xc9\x64\x70\xe2\xa3\xf2\x71\x8c\xa2\xe8\xae\x80\xb0\x8d\x12\xf5\xe4\x63\xc8\x81\x8a\x68\x46\xdf\xee\x0e\xe5\xe3\x13\x4e\xdc\x11\x6a\x0e\xd7\x25\xaa\x31\x71\x6c\x4d\x86\xd8\x72\xc0\x24\x7a\xc6\x49\x14\xb6\xae\x39\x11\xdb\x0e\xec\x4f\xa2\xfd\x55\x86\x52\x63\xb5\x66\xdf\xc0\xc9\x70\x51\x6e\xda\x08\x99\x3c\x69\x68\x79\xc8\x4d\x86\x43\xb2\x51\x6d\xee\x4a\xc2\x15\x76\xe6\x8c\x82\xcf\x32\xde\x3c\xbc\xb2\x7b\xbb\xc2\x03\x7e\x23\x0d\x89\xe4\x87\xfc\x3b\x93\xe1\x4d\x1a\x59\xdc\xfe\x5c\xbc\xfd\x09\x17\x19\xd1\xdb\x0b\xbc\x44\x8c\x81\x5e\x21\xd3\x17\x47\x43\x04\x7b\x38\xe9\x87\x8e\xad\x77\xf4\x62\xeb\x6f\x86\x1c\xb3\x80\x84\x21\xc7\x68\xd9\xc3\xce\x36\x20\xa7\xd7\x63\xb0\xd1\x64\x26\xc3\xfc\xda\x18\x87\xf5\x6a\x35\x4d\x53\x6e\x67\xb9\xb9\x68\xb3\xba\xbb\x5b\x7d\x28\x1f\x9f\xb6\xd5\xd3\x72\x96\x6c\x32\x7c\xf4\x1d\x85\x00\xa5\x3f\x46\x56\xaa\x71\xbc\xc0\x0e\x43\xc7\xce\x1e\x3b\x42\x67\xa7\x14\xdc\x9c\xce\x1c\x3a\x7b\x4c\xca\x91\x7d\xf3\x80\x70\x4b\xdd\x64\x5f\xa5\xf3\x72\xae\xbb\x3c\x0e\x5f\x0d\x88\x87\xf5\x58\x14\x15\xca\x6a\x81\x77\x45\x55\x56\x0f\x26\xc3\xa7\xf2\xf0\xcb\xee\xe3\x01\x9f\x8a\xfd\xbe\xd8\x1e\xca\xa7\x0a\xbb\x3d\x1e\x77\xdb\xf7\xe5\xa1\xdc\x6d\x2b\xec\x36\x28\xb6\x9f\xf1\x6b\xb9\x7d\xff\x00\xe2\xd8\x92\x82\xbe\x0c\x9a\xf4\x8b\x82\xd3\x21\xa9\x4e\x99\xde\x0b\x74\x17\x90\xfa\x91\x7e\x87\x81\x1c\x9f\xd8\xa1\xb3\xbe\x19\x6d\x43\x68\xe4\x99\xd4\xa7\x7a\x0c\xa4\x3d\x87\x14\x67\x80\xf5\xb5\xc9\xd0\x71\xcf\x71\x6e\x51\xf8\xa7\xa9\x44\xf3\x2d\xbf\x2d\x73\x66\x5f\xaf\xb1\x97\x8e\xde\xb1\x4f\x85\x35\x76\xe0\x5b\xc1\xd6\xd0\xa3\x75\xb9\x1d\x63\x2b\xca\x7f\xce\x9a\xf2\xf3\x8f\x21\x67\x59\x3d\x7f\x6f\x7a\x8a\xb6\xb6\xd1\xae\x0d\xe0\x6d\x4f\x6b\x38\xdb\x53\xb7\x3c\x2f\x65\x20\xb5\x51\x74\x19\x48\x9f\xd9\x51\x2f\x9e\xa3\x68\x30\x40\x67\x8f\xd4\x85\xb4\x82\x94\xf8\x1a\x8b\xdb\xd2\xc2\x84\xf1\xf8\x3b\xb9\x18\xd6\x66\x89\xab\xac\xea\xba\x5e\x38\x27\xa3\x8f\xff\x4a\x63\x54\x3a\xda\xd3\x29\xa1\xbe\xf8\xf9\x3f\xaa\xec\xc0\x3f\xab\x8c\xc3\x7f\x38\x36\x7f\x05\x00\x00\xff\xff\x8b\xb8\x01\xb1\xd5\x04\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x53\x4d\x8f\xdb\x36\x10\xbd\xf3\x57\x3c\x58\x97\x04\x58\xcb\x6d\x4f\x85\x7b\x52\x36\xeb\x56\x68\x60\x03\x96\xd3\x20\x47\x9a\x1a\x4b\x53\x4b\x1c\x75\x48\xad\xe2\xfe\xfa\x82\xb2\xdd\xdd\xa0\x68\x81\x02\xe1\xcd\xf0\xcc\xfb\x98\xf7\x94\x61\xf9\xed\x9e\xc9\xf0\x81\x1d\xf9\x40\x35\xa2\x20\xb6\x84\x62\xb0\xae\x25\x54\x72\x8a\x93\x55\xc2\x46\x46\x5f\xdb\xc8\xe2\xf1\xa6\xa8\x36\x6f\x31\xfa\x9a\x14\xe2\x09\xa2\xe8\x45\xc9\x64\x70\xe2\xa3\xf2\x71\x8c\xa2\xe8\xae\x80\xb0\x8d\x12\xf5\xe4\x63\xc8\x81\x8a\x68\x46\xdf\xee\x0e\xe5\xe3\x13\x4e\xdc\x11\x6a\x0e\xd7\x25\xaa\x31\x71\x6c\x4d\x86\xd8\x72\xc0\x24\x7a\xc6\x49\x14\xb6\xae\x39\x11\xdb\x0e\xec\x4f\xa2\xfd\x55\x86\x52\x63\xb5\x66\xdf\xc0\xc9\x70\x51\x6e\xda\x08\x99\x3c\x69\x68\x79\xc8\x4d\x86\x43\xb2\x51\x6d\xee\x4a\xc2\x15\x76\xe6\x8c\x82\xcf\x32\xde\x3c\xbc\xb2\x7b\xbb\xc2\x03\x7e\x23\x0d\x89\xe4\x87\xfc\x3b\x93\xe1\x4d\x1a\x59\xdc\xfe\x5c\xbc\xfd\x09\x17\x19\xd1\xdb\x0b\xbc\x44\x8c\x81\x5e\x21\xd3\x17\x47\x43\x04\x7b\x38\xe9\x87\x8e\xad\x77\xf4\x62\xeb\x6f\x86\x1c\xb3\x80\x84\x21\xc7\x68\xd9\xc3\xce\x36\x20\xa7\xd7\x63\xb0\xd1\x64\x26\xc3\xfc\xda\x18\x87\xf5\x6a\x35\x4d\x53\x6e\xe7\x74\x72\xd1\x66\x75\x77\xb7\xfa\x50\x3e\x3e\x6d\xab\xa7\xe5\x2c\xd9\x64\xf8\xe8\x3b\x0a\x01\x4a\x7f\x8c\xac\x54\xe3\x78\x81\x1d\x86\x8e\x9d\x3d\x76\x84\xce\x4e\x29\xb8\x39\x9d\x39\x74\xf6\x98\x94\x23\xfb\xe6\x01\xe1\x96\xba\xc9\xbe\x4a\xe7\xe5\x5c\x77\x79\x1c\xbe\x1a\x10\x0f\xeb\xb1\x28\x2a\x94\xd5\x02\xef\x8a\xaa\xac\x1e\x4c\x86\x4f\xe5\xe1\x97\xdd\xc7\x03\x3e\x15\xfb\x7d\xb1\x3d\x94\x4f\x15\x76\x7b\x3c\xee\xb6\xef\xcb\x43\xb9\xdb\x56\xd8\x6d\x50\x6c\x3f\xe3\xd7\x72\xfb\xfe\x01\xc4\xb1\x25\x05\x7d\x19\x34\xe9\x17\x05\xa7\x43\x52\x9d\x32\xbd\x17\xe8\x2e\x20\xf5\x23\xfd\x0e\x03\x39\x3e\xb1\x43\x67\x7d\x33\xda\x86\xd0\xc8\x33\xa9\x4f\xf5\x18\x48\x7b\x0e\x29\xce\x00\xeb\x6b\x93\xa1\xe3\x9e\xe3\xdc\xa2\xf0\x4f\x53\x89\xe6\xfe\x61\x7c\x83\x67\xcc\x99\x7d\xbd\xc6\x5e\x3a\x7a\xc7\x3e\x15\xd6\xd8\x81\x6f\x05\x5b\x43\x8f\xd6\xe5\x76\x8c\xad\x28\xff\x39\x6b\xca\xcf\x3f\x86\x9c\x65\xf5\xfc\xbd\xe9\x29\xda\xda\x46\xbb\x36\x80\xb7\x3d\xad\xe1\x6c\x4f\xdd\xf2\xbc\x94\x81\xd4\x46\xd1\x65\x20\x7d\x66\x47\xbd\x78\x8e\xa2\xc1\x00\x9d\x3d\x52\x17\xd2\x0a\x52\xe2\x6b\x2c\x6e\x4b\x0b\x13\xc6\xe3\xef\xe4\x62\x58\x9b\x25\xae\xb2\xaa\xeb\x7a\xe1\x9c\x8c\x3e\xfe\x2b\x8d\x51\xe9\x68\x4f\xa7\x84\xfa\xe2\xe7\xff\xa8\xb2\x03\xff\xac\x32\x0e\xff\xe1\xd8\xfc\x35\x00\x8b\xb8\x01\xb1\xd5\x04\x00\x00"),
 		},
 		"/operator-role-binding-strimzi.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "operator-role-binding-strimzi.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 1221,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x93\x41\x6f\xfa\x46\x10\xc5\xef\xfb\x29\x9e\xf0\xe5\x1f\x09\x4c\xdb\x53\x45\x4f\x4e\x02\xad\xd5\x08\x24\x4c\x1a\xe5\xb8\xac\x07\x7b\x8a\xbd\xe3\xee\xae\xe3\x90\x4f\x5f\xad\x81\x26\x51\xd5\xea\x7f\xc8\xde\x10\xc3\x9b\xdf\x9b\xf7\x48\x30\xfb\xba\xa7\x12\x3c\xb0\x21\xeb\xa9\x44\x10\x84\x9a\x90\x75\xda\xd4\x84\x42\x0e\x61\xd0\x8e\xb0\x92\xde\x96\x3a\xb0\x58\x7c\xcb\x8a\xd5\x0d\x7a\x5b\x92\x83\x58\x82\x38\xb4\xe2\x48\x25\x30\x62\x83\xe3\x7d\x1f\xc4\xa1\x39\x0b\x42\x57\x8e\xa8\x25\x1b\x7c\x0a\x14\x44\xa3\xfa\x7a\xb3\xcb\xef\x96\x38\x70\x43\x28\xd9\x9f\x7f\x44\x25\x06\x0e\xb5\x4a\x10\x6a\xf6\x18\xc4\x1d\x71\x10\x07\x5d\x96\x1c\x17\xeb\x06\x6c\x0f\xe2\xda\x33\x86\xa3\x4a\xbb\x92\x6d\x05\x23\xdd\xc9\x71\x55\x07\xc8\x60\xc9\xf9\x9a\xbb\x54\x25\xd8\x45\x1b\xc5\xea\x4a\xe2\xcf\xb2\xe3\xce\x20\x78\x96\xfe\xe2\xe1\x83\xdd\xcb\x15\xa6\xf8\x83\x9c\x8f\x4b\x7e\x4a\x7f\x50\x09\xbe\xc5\x91\xc9\xe5\xcb\xc9\xcd\x2f\x38\x49\x8f\x56\x9f\x60\x25\xa0\xf7\xf4\x41\x99\x5e\x0d\x75\x01\x6c\x61\xa4\xed\x1a\xd6\xd6\xd0\xbb\xad\x7f\x36\xa4\x18\x01\xa2\x86\xec\x83\x66\x0b\x3d\xda\x80\x1c\x3e\x8e\x41\x07\x95\xa8\x04\xe3\xab\x43\xe8\x16\xf3\xf9\x30\x0c\xa9\x1e\x71\x53\x71\xd5\xfc\xea\x6e\xfe\x90\xdf\x2d\xd7\xc5\x72\x36\x22\xab\x04\x8f\xb6\x21\xef\xe1\xe8\xaf\x9e\x1d\x95\xd8\x9f\xa0\xbb\xae\x61\xa3\xf7\x0d\xa1\xd1\x43\x0c\x6e\x4c\x67\x0c\x9d\x2d\x06\xc7\x81\x6d\x35\x85\xbf\xa4\xae\x92\x4f\xe9\xbc\x9f\xeb\x8a\xc7\xfe\xd3\x80\x58\x68\x8b\x49\x56\x20\x2f\x26\xb8\xcd\x8a\xbc\x98\xaa\x04\x4f\xf9\xee\xb7\xcd\xe3\x0e\x4f\xd9\x76\x9b\xad\x77\xf9\xb2\xc0\x66\x8b\xbb\xcd\xfa\x3e\xdf\xe5\x9b\x75\x81\xcd\x0a\xd9\xfa\x19\xbf\xe7\xeb\xfb\x29\x88\x43\x4d\x0e\xf4\xda\xb9\xc8\x2f\x0e\x1c\x0f\x49\x65\xcc\xf4\x5a\xa0\x2b\x40\xec\x47\xfc\xec\x3b\x32\x7c\x60\x83\x46\xdb\xaa\xd7\x15\xa1\x92\x17\x72\x36\xd6\xa3\x23\xd7\xb2\x8f\x71\x7a\x68\x5b\xaa\x04\x0d\xb7\x1c\xc6\x16\xf9\x7f\x9b\x8a\x6b\xbe\xf2\xbf\xa5\x8e\x6c\xcb\x05\xb6\xd2\xd0\x2d\xdb\x58\x58\xa5\x3b\xbe\x14\x6c\x01\xb7\xd7\x26\xd5\x7d\xa8\xc5\xf1\xdb\xc8\x94\x1e\x7f\xf6\x29\xcb\xfc\xe5\x47\xd5\x52\xd0\xa5\x0e\x7a\xa1\x00\xab\x5b\x5a\xc0\xe8\x96\x9a\xd9\x71\x26\x1d\x39\x1d\xc4\xcd\xe2\xf5\xdb\x37\x56\x40\xa3\xf7\xd4\xf8\x38\x8a\x98\xf4\x02\x93\xcb\xf0\x44\xf9\x7e\xff\x27\x99\xe0\x17\x6a\x86\x33\x4e\x41\xee\x85\x0d\x65\xc6\x48\x6f\xc3\x7f\xca\x2b\x27\x0d\x6d\xe9\x10\x55\xdf\x7d\x7c\x0f\x8d\xee\xf8\x57\x27\x7d\xf7\x3f\x0e\xd5\xdf\x01\x00\x00\xff\xff\x8c\xdd\x2b\xa4\xc5\x04\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x53\x4f\x6f\xfa\x46\x10\xbd\xef\xa7\x78\xc2\x97\x5f\x24\x30\x6d\x4f\x15\x3d\x39\x09\xb4\x56\x23\x90\x30\x69\x94\xe3\xb2\x1e\xec\x29\xf6\x8e\xbb\xbb\x8e\x43\x3e\x7d\xb5\x06\x9a\x44\x55\xab\xdf\x21\x73\x43\x8c\xdf\x9f\x79\x6f\x13\xcc\xbe\x6e\x54\x82\x07\x36\x64\x3d\x95\x08\x82\x50\x13\xb2\x4e\x9b\x9a\x50\xc8\x21\x0c\xda\x11\x56\xd2\xdb\x52\x07\x16\x8b\x6f\x59\xb1\xba\x41\x6f\x4b\x72\x10\x4b\x10\x87\x56\x1c\xa9\x04\x46\x6c\x70\xbc\xef\x83\x38\x34\x67\x40\xe8\xca\x11\xb5\x64\x83\x4f\x81\x82\x68\x44\x5f\x6f\x76\xf9\xdd\x12\x07\x6e\x08\x25\xfb\xf3\x47\x54\x62\xe0\x50\xab\x04\xa1\x66\x8f\x41\xdc\x11\x07\x71\xd0\x65\xc9\x91\x58\x37\x60\x7b\x10\xd7\x9e\x65\x38\xaa\xb4\x2b\xd9\x56\x30\xd2\x9d\x1c\x57\x75\x80\x0c\x96\x9c\xaf\xb9\x4b\x55\x82\x5d\xb4\x51\xac\xae\x4a\xfc\x19\x76\xe4\x0c\x82\x67\xe9\x2f\x1e\x3e\xd8\xbd\x5c\x61\x8a\x3f\xc8\xf9\x48\xf2\x53\xfa\x83\x4a\xf0\x2d\xae\x4c\x2e\x7f\x4e\x6e\x7e\xc1\x49\x7a\xb4\xfa\x04\x2b\x01\xbd\xa7\x0f\xc8\xf4\x6a\xa8\x0b\x60\x0b\x23\x6d\xd7\xb0\xb6\x86\xde\x6d\xfd\xc3\x90\x62\x14\x10\x31\x64\x1f\x34\x5b\xe8\xd1\x06\xe4\xf0\x71\x0d\x3a\xa8\x44\x25\x18\xa7\x0e\xa1\x5b\xcc\xe7\xc3\x30\xa4\x7a\x4c\x27\x15\x57\xcd\xaf\xee\xe6\x0f\xf9\xdd\x72\x5d\x2c\x67\xa3\x64\x95\xe0\xd1\x36\xe4\x3d\x1c\xfd\xd5\xb3\xa3\x12\xfb\x13\x74\xd7\x35\x6c\xf4\xbe\x21\x34\x7a\x88\xc1\x8d\xe9\x8c\xa1\xb3\xc5\xe0\x38\xb0\xad\xa6\xf0\x97\xd4\x55\xf2\x29\x9d\xf7\x73\x5d\xe5\xb1\xff\xb4\x20\x16\xda\x62\x92\x15\xc8\x8b\x09\x6e\xb3\x22\x2f\xa6\x2a\xc1\x53\xbe\xfb\x6d\xf3\xb8\xc3\x53\xb6\xdd\x66\xeb\x5d\xbe\x2c\xb0\xd9\xe2\x6e\xb3\xbe\xcf\x77\xf9\x66\x5d\x60\xb3\x42\xb6\x7e\xc6\xef\xf9\xfa\x7e\x0a\xe2\x50\x93\x03\xbd\x76\x2e\xea\x17\x07\x8e\x87\xa4\x32\x66\x7a\x2d\xd0\x55\x40\xec\x47\xfc\xed\x3b\x32\x7c\x60\x83\x46\xdb\xaa\xd7\x15\xa1\x92\x17\x72\x36\xd6\xa3\x23\xd7\xb2\x8f\x71\x7a\x68\x5b\xaa\x04\x0d\xb7\x1c\xc6\x16\xf9\x7f\x9b\x8a\x34\xd7\x87\xf1\x05\xa3\xd4\x91\x6d\xb9\xc0\x56\x1a\xba\x65\x1b\x0b\xab\x74\xc7\x97\x82\x2d\xe0\xf6\xda\xa4\xba\x0f\xb5\x38\x7e\x1b\x35\xa5\xc7\x9f\x7d\xca\x32\x7f\xf9\x51\xb5\x14\x74\xa9\x83\x5e\x28\xc0\xea\x96\x16\x30\xba\xa5\x66\x76\x9c\x49\x47\x4e\x07\x71\xb3\xf8\x78\xda\x37\x56\x40\xa3\xf7\xd4\xf8\xb8\x8a\x98\xf4\x02\x93\xcb\xf2\x44\xf9\x7e\xff\x27\x99\xe0\x17\x6a\x86\xb3\x9c\x82\xdc\x0b\x1b\xca\x8c\x91\xde\x86\xff\x84\x57\x4e\x1a\xda\xd2\x21\xa2\xbe\xfb\xf8\x1e\x35\xba\xe3\x5f\x9d\xf4\xdd\xff\x38\x54\x7f\x0f\x00\x8c\xdd\x2b\xa4\xc5\x04\x00\x00"),
 		},
 		"/operator-role-binding.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "operator-role-binding.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 1205,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x93\x41\x6f\xc2\x46\x10\x85\xef\xfb\x2b\x9e\xf0\x25\x91\xc0\xb4\x3d\x55\xf4\xe4\x24\xd0\x5a\x8d\x40\xc2\xa4\x51\x8e\xcb\x7a\xb0\xa7\xd8\x3b\xee\xee\x3a\x0e\xfd\xf5\xd5\x1a\x68\x12\x55\xcd\x29\x7b\xb3\x3c\x7e\xf3\xbd\x7d\xcf\x09\x66\xdf\x77\x54\x82\x47\x36\x64\x3d\x95\x08\x82\x50\x13\xb2\x4e\x9b\x9a\x50\xc8\x21\x0c\xda\x11\x56\xd2\xdb\x52\x07\x16\x8b\x9b\xac\x58\xdd\xa2\xb7\x25\x39\x88\x25\x88\x43\x2b\x8e\x54\x02\x23\x36\x38\xde\xf7\x41\x1c\x9a\xb3\x20\x74\xe5\x88\x5a\xb2\xc1\xa7\x40\x41\x34\xaa\xaf\x37\xbb\xfc\x7e\x89\x03\x37\x84\x92\xfd\xf9\x23\x2a\x31\x70\xa8\x55\x82\x50\xb3\xc7\x20\xee\x88\x83\x38\xe8\xb2\xe4\xb8\x58\x37\x60\x7b\x10\xd7\x9e\x31\x1c\x55\xda\x95\x6c\x2b\x18\xe9\x4e\x8e\xab\x3a\x40\x06\x4b\xce\xd7\xdc\xa5\x2a\xc1\x2e\xda\x28\x56\x57\x12\x7f\x96\x1d\x77\x06\xc1\x8b\xf4\x17\x0f\x1f\xec\x5e\x6e\x61\x8a\x3f\xc8\xf9\xb8\xe4\xa7\xf4\x07\x95\xe0\x26\x8e\x4c\x2e\x2f\x27\xb7\xbf\xe0\x24\x3d\x5a\x7d\x82\x95\x80\xde\xd3\x07\x65\x7a\x33\xd4\x05\xb0\x85\x91\xb6\x6b\x58\x5b\x43\xef\xb6\xfe\xdd\x90\x62\x04\x88\x1a\xb2\x0f\x9a\x2d\xf4\x68\x03\x72\xf8\x38\x06\x1d\x54\xa2\x12\x8c\xa7\x0e\xa1\x5b\xcc\xe7\xc3\x30\xa4\x7a\xc4\x4d\xc5\x55\xf3\xab\xbb\xf9\x63\x7e\xbf\x5c\x17\xcb\xd9\x88\xac\x12\x3c\xd9\x86\xbc\x87\xa3\xbf\x7a\x76\x54\x62\x7f\x82\xee\xba\x86\x8d\xde\x37\x84\x46\x0f\x31\xb8\x31\x9d\x31\x74\xb6\x18\x1c\x07\xb6\xd5\x14\xfe\x92\xba\x4a\x3e\xa5\xf3\x7e\x5d\x57\x3c\xf6\x9f\x06\xc4\x42\x5b\x4c\xb2\x02\x79\x31\xc1\x5d\x56\xe4\xc5\x54\x25\x78\xce\x77\xbf\x6d\x9e\x76\x78\xce\xb6\xdb\x6c\xbd\xcb\x97\x05\x36\x5b\xdc\x6f\xd6\x0f\xf9\x2e\xdf\xac\x0b\x6c\x56\xc8\xd6\x2f\xf8\x3d\x5f\x3f\x4c\x41\x1c\x6a\x72\xa0\xb7\xce\x45\x7e\x71\xe0\x78\x91\x54\xc6\x4c\xaf\x05\xba\x02\xc4\x7e\xc4\x67\xdf\x91\xe1\x03\x1b\x34\xda\x56\xbd\xae\x08\x95\xbc\x92\xb3\xb1\x1e\x1d\xb9\x96\x7d\x8c\xd3\x43\xdb\x52\x25\x68\xb8\xe5\x30\xb6\xc8\xff\xd7\x54\x5c\xf3\x9d\xff\x96\x3a\xb2\x2d\x17\xd8\x4a\x43\x77\x6c\x63\x61\x95\xee\xf8\x52\xb0\x05\xdc\x5e\x9b\x54\xf7\xa1\x16\xc7\x7f\x8f\x4c\xe9\xf1\x67\x9f\xb2\xcc\x5f\x7f\x54\x2d\x05\x5d\xea\xa0\x17\x0a\xb0\xba\xa5\x05\x8c\x6e\xa9\x99\x1d\x67\xd2\x91\xd3\x41\x9c\x02\x1a\xbd\xa7\xc6\xc7\x11\xc4\x84\x17\x98\x5c\x86\x26\xca\xf7\xfb\x3f\xc9\x04\xbf\x50\x33\x9c\x31\x0a\x72\xaf\x6c\x28\x33\x46\x7a\x1b\xfe\x5f\xd6\x49\x43\x5b\x3a\x44\xd5\x77\xfe\xaf\x28\x74\xc7\xbf\x3a\xe9\xbb\x2f\x1c\xa9\x7f\x02\x00\x00\xff\xff\xe1\xc2\x0f\xe1\xb5\x04\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x93\x41\x6f\xc2\x46\x10\x85\xef\xfb\x2b\x9e\xf0\x25\x91\xc0\xb4\x3d\x55\xf4\xe4\x24\xd0\x5a\x8d\x40\xc2\xa4\x51\x8e\xcb\x7a\xb0\xa7\xd8\x3b\xee\xee\x3a\x0e\xfd\xf5\xd5\x1a\x68\x12\x55\xcd\x29\x7b\xb3\x3c\x7e\xf3\xbd\x7d\xcf\x09\x66\xdf\x77\x54\x82\x47\x36\x64\x3d\x95\x08\x82\x50\x13\xb2\x4e\x9b\x9a\x50\xc8\x21\x0c\xda\x11\x56\xd2\xdb\x52\x07\x16\x8b\x9b\xac\x58\xdd\xa2\xb7\x25\x39\x88\x25\x88\x43\x2b\x8e\x54\x02\x23\x36\x38\xde\xf7\x41\x1c\x9a\xb3\x20\x74\xe5\x88\x5a\xb2\xc1\xa7\x40\x41\x34\xaa\xaf\x37\xbb\xfc\x7e\x89\x03\x37\x84\x92\xfd\xf9\x23\x2a\x31\x70\xa8\x55\x82\x50\xb3\xc7\x20\xee\x88\x83\x38\xe8\xb2\xe4\xb8\x58\x37\x60\x7b\x10\xd7\x9e\x31\x1c\x55\xda\x95\x6c\x2b\x18\xe9\x4e\x8e\xab\x3a\x40\x06\x4b\xce\xd7\xdc\xa5\x2a\xc1\x2e\xda\x28\x56\x57\x12\x7f\x96\x1d\x77\x06\xc1\x8b\xf4\x17\x0f\x1f\xec\x5e\x6e\x61\x8a\x3f\xc8\xf9\xb8\xe4\xa7\xf4\x07\x95\xe0\x26\x8e\x4c\x2e\x2f\x27\xb7\xbf\xe0\x24\x3d\x5a\x7d\x82\x95\x80\xde\xd3\x07\x65\x7a\x33\xd4\x05\xb0\x85\x91\xb6\x6b\x58\x5b\x43\xef\xb6\xfe\xdd\x90\x62\x04\x88\x1a\xb2\x0f\x9a\x2d\xf4\x68\x03\x72\xf8\x38\x06\x1d\x54\xa2\x12\x8c\xa7\x0e\xa1\x5b\xcc\xe7\xc3\x30\xa4\x7a\x4c\x27\x15\x57\xcd\xaf\xee\xe6\x8f\xf9\xfd\x72\x5d\x2c\x67\x23\xb2\x4a\xf0\x64\x1b\xf2\x1e\x8e\xfe\xea\xd9\x51\x89\xfd\x09\xba\xeb\x1a\x36\x7a\xdf\x10\x1a\x3d\xc4\xe0\xc6\x74\xc6\xd0\xd9\x62\x70\x1c\xd8\x56\x53\xf8\x4b\xea\x2a\xf9\x94\xce\xfb\x75\x5d\xf1\xd8\x7f\x1a\x10\x0b\x6d\x31\xc9\x0a\xe4\xc5\x04\x77\x59\x91\x17\x53\x95\xe0\x39\xdf\xfd\xb6\x79\xda\xe1\x39\xdb\x6e\xb3\xf5\x2e\x5f\x16\xd8\x6c\x71\xbf\x59\x3f\xe4\xbb\x7c\xb3\x2e\xb0\x59\x21\x5b\xbf\xe0\xf7\x7c\xfd\x30\x05\x71\xa8\xc9\x81\xde\x3a\x17\xf9\xc5\x81\xe3\x45\x52\x19\x33\xbd\x16\xe8\x0a\x10\xfb\x11\x9f\x7d\x47\x86\x0f\x6c\xd0\x68\x5b\xf5\xba\x22\x54\xf2\x4a\xce\xc6\x7a\x74\xe4\x5a\xf6\x31\x4e\x0f\x6d\x4b\x95\xa0\xe1\x96\xc3\xd8\x22\xff\x5f\x53\x71\xcd\xf5\xc7\xf8\x86\xa3\xd4\x91\x6d\xb9\xc0\x56\x1a\xba\x63\x1b\x0b\xab\x74\xc7\x97\x82\x2d\xe0\xf6\xda\xa4\xba\x0f\xb5\x38\xfe\x7b\x64\x4a\x8f\x3f\xfb\x94\x65\xfe\xfa\xa3\x6a\x29\xe8\x52\x07\xbd\x50\x80\xd5\x2d\x2d\x60\x74\x4b\xcd\xec\x38\x93\x8e\x9c\x0e\xe2\x14\xd0\xe8\x3d\x35\x3e\x8e\x20\x26\xbc\xc0\xe4\x32\x34\x51\xbe\xdf\xff\x49\x26\xf8\x85\x9a\xe1\x8c\x51\x90\x7b\x65\x43\x99\x31\xd2\xdb\xf0\xff\xb2\x4e\x1a\xda\xd2\x21\xaa\xbe\xf3\x7f\x45\xa1\x3b\xfe\xd5\x49\xdf\x7d\xe1\x48\xfd\x33\x00\xe1\xc2\x0f\xe1\xb5\x04\x00\x00"),
 		},
 		"/operator-role-events.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "operator-role-events.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 1170,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x53\xc1\x8e\xdb\x36\x10\xbd\xf3\x2b\x1e\xac\x4b\x02\xac\xe5\xb6\xa7\xc2\x3d\xb9\x9b\xdd\x56\x68\x60\x03\x2b\xa7\x41\x8e\x63\x69\x2c\x0d\x56\xe2\xa8\x43\x6a\x15\xf7\xeb\x0b\xca\x72\xb2\x41\xaf\xcb\x8b\x69\xf2\xe9\xcd\x7b\xf3\x86\x19\xd6\x6f\xb7\x5c\x86\x8f\x52\xb1\x0f\x5c\x23\x2a\x62\xcb\xd8\x0d\x54\xb5\x8c\x52\xcf\x71\x22\x63\x3c\xea\xe8\x6b\x8a\xa2\x1e\xef\x76\xe5\xe3\x7b\x8c\xbe\x66\x83\x7a\x86\x1a\x7a\x35\x76\x19\x2a\xf5\xd1\xe4\x34\x46\x35\x74\x57\x42\x50\x63\xcc\x3d\xfb\x18\x72\xa0\x64\x9e\xd9\xf7\x87\x63\x71\xff\x80\xb3\x74\x8c\x5a\xc2\xf5\x23\xae\x31\x49\x6c\x5d\x86\xd8\x4a\xc0\xa4\xf6\x8c\xb3\x1a\xa8\xae\x25\x15\xa6\x0e\xe2\xcf\x6a\xfd\x55\x86\x71\x43\x56\x8b\x6f\x50\xe9\x70\x31\x69\xda\x08\x9d\x3c\x5b\x68\x65\xc8\x5d\x86\x63\xb2\x51\x3e\xde\x94\x84\x2b\xed\x5c\x33\x2a\xbe\xe8\xb8\x78\x78\x65\x77\xe9\xc2\x1d\xfe\x66\x0b\xa9\xc8\x2f\xf9\x4f\x2e\xc3\xbb\x04\x59\x2d\x97\xab\xf7\xbf\xe1\xa2\x23\x7a\xba\xc0\x6b\xc4\x18\xf8\x15\x33\x7f\xad\x78\x88\x10\x8f\x4a\xfb\xa1\x13\xf2\x15\x7f\xb7\xf5\xad\x42\x8e\x59\x40\xe2\xd0\x53\x24\xf1\xa0\xd9\x06\xf4\xfc\x1a\x06\x8a\x2e\x73\x19\xe6\xd5\xc6\x38\x6c\x37\x9b\x69\x9a\x72\x9a\xe5\xe6\x6a\xcd\xe6\xe6\x6e\xf3\xb1\xb8\x7f\xd8\x97\x0f\xeb\x59\xb2\xcb\xf0\xc9\x77\x1c\x02\x8c\xff\x19\xc5\xb8\xc6\xe9\x02\x1a\x86\x4e\x2a\x3a\x75\x8c\x8e\xa6\x14\xdc\x9c\xce\x1c\xba\x78\x4c\x26\x51\x7c\x73\x87\xb0\xa4\xee\xb2\x1f\xd2\xf9\xde\xae\x9b\x3c\x09\x3f\x00\xd4\x83\x3c\x56\xbb\x12\x45\xb9\xc2\xef\xbb\xb2\x28\xef\x5c\x86\xcf\xc5\xf1\xcf\xc3\xa7\x23\x3e\xef\x9e\x9e\x76\xfb\x63\xf1\x50\xe2\xf0\x84\xfb\xc3\xfe\x43\x71\x2c\x0e\xfb\x12\x87\x47\xec\xf6\x5f\xf0\x57\xb1\xff\x70\x07\x96\xd8\xb2\x81\xbf\x0e\x96\xf4\xab\x41\x52\x23\xb9\x4e\x99\xde\x06\xe8\x26\x20\xcd\x47\xfa\x1f\x06\xae\xe4\x2c\x15\x3a\xf2\xcd\x48\x0d\xa3\xd1\x17\x36\x9f\xc6\x63\x60\xeb\x25\xa4\x38\x03\xc8\xd7\x2e\x43\x27\xbd\xc4\x79\x8a\xc2\xff\x4d\xa5\x32\x6f\xf9\xb6\xdc\xb3\xf8\x7a\x8b\x27\xed\xd8\xd1\x20\xcb\x64\x6d\x61\x27\xaa\x72\x1a\x63\xab\x26\xff\xce\x62\xf2\xe7\x5f\x43\x2e\xba\x79\xf9\xd9\xf5\x1c\xa9\xa6\x48\x5b\x07\x78\xea\x79\x8b\x8a\x7a\xee\xd6\xcf\x6b\x1d\xd8\x28\xaa\xad\xf9\x25\x3d\x2a\x07\x74\x74\xe2\x2e\x24\x24\x52\xc2\x5b\xac\x16\xec\xca\xd9\xd8\x71\xd8\xba\x35\x68\x90\x3f\x4c\xc7\x61\x86\xad\xb1\x5a\x39\xc0\x38\xe8\x68\x15\x2f\x67\xdf\xf8\x5e\xd8\x4e\xcb\x59\x65\x4c\x91\xe7\xed\x40\xb1\x6a\xe7\x5d\xc3\x71\xfe\xed\x24\x5c\x37\xd3\x7c\xf5\x5f\x00\x00\x00\xff\xff\x68\x9e\x3a\x9f\x92\x04\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x53\xc1\x6e\xdb\x46\x10\xbd\xef\x57\x3c\x88\x97\x04\xb0\xa8\xb6\xa7\x42\x3d\xa9\x8e\xdd\x12\x0d\x24\xc0\x54\x1a\xe4\x38\x22\x47\xe4\xc0\xe4\x0e\x3b\xbb\x34\xa3\x7e\x7d\xb1\x14\x95\x38\xe8\xd5\x7b\xe1\x72\xf9\xf8\xe6\xbd\x79\xb3\x19\xd6\x6f\xb7\x5c\x86\x8f\x52\xb1\x0f\x5c\x23\x2a\x62\xcb\xd8\x0d\x54\xb5\x8c\x52\xcf\x71\x22\x63\x3c\xea\xe8\x6b\x8a\xa2\x1e\xef\x76\xe5\xe3\x7b\x8c\xbe\x66\x83\x7a\x86\x1a\x7a\x35\x76\x19\x2a\xf5\xd1\xe4\x34\x46\x35\x74\x57\x42\x50\x63\xcc\x3d\xfb\x18\x72\xa0\x64\x9e\xd9\xf7\x87\x63\x71\xff\x80\xb3\x74\x8c\x5a\xc2\xf5\x27\xae\x31\x49\x6c\x5d\x86\xd8\x4a\xc0\xa4\xf6\x8c\xb3\x1a\xa8\xae\x25\x15\xa6\x0e\xe2\xcf\x6a\xfd\x55\x86\x71\x43\x56\x8b\x6f\x50\xe9\x70\x31\x69\xda\x08\x9d\x3c\x5b\x68\x65\xc8\x5d\x86\x63\xb2\x51\x3e\xde\x94\x84\x2b\xed\x5c\x33\x2a\xbe\xe8\xb8\x78\x78\x65\x77\xe9\xc2\x1d\xfe\x66\x0b\xa9\xc8\x2f\xf9\x4f\x2e\xc3\xbb\x04\x59\x2d\x1f\x57\xef\x7f\xc3\x45\x47\xf4\x74\x81\xd7\x88\x31\xf0\x2b\x66\xfe\x5a\xf1\x10\x21\x1e\x95\xf6\x43\x27\xe4\x2b\xfe\x6e\xeb\x5b\x85\x1c\xb3\x80\xc4\xa1\xa7\x48\xe2\x41\xb3\x0d\xe8\xf9\x35\x0c\x14\x5d\xe6\x32\xcc\xab\x8d\x71\xd8\x6e\x36\xd3\x34\xe5\x34\xa7\x93\xab\x35\x9b\x9b\xbb\xcd\xc7\xe2\xfe\x61\x5f\x3e\xac\x67\xc9\x2e\xc3\x27\xdf\x71\x08\x30\xfe\x67\x14\xe3\x1a\xa7\x0b\x68\x18\x3a\xa9\xe8\xd4\x31\x3a\x9a\x52\x70\x73\x3a\x73\xe8\xe2\x31\x99\x44\xf1\xcd\x1d\xc2\x92\xba\xcb\x7e\x48\xe7\x7b\xbb\x6e\xf2\x24\xfc\x00\x50\x0f\xf2\x58\xed\x4a\x14\xe5\x0a\xbf\xef\xca\xa2\xbc\x73\x19\x3e\x17\xc7\x3f\x0f\x9f\x8e\xf8\xbc\x7b\x7a\xda\xed\x8f\xc5\x43\x89\xc3\x13\xee\x0f\xfb\x0f\xc5\xb1\x38\xec\x4b\x1c\x1e\xb1\xdb\x7f\xc1\x5f\xc5\xfe\xc3\x1d\x58\x62\xcb\x06\xfe\x3a\x58\xd2\xaf\x06\x49\x8d\xe4\x3a\x65\x7a\x1b\xa0\x9b\x80\x34\x1f\xe9\x3d\x0c\x5c\xc9\x59\x2a\x74\xe4\x9b\x91\x1a\x46\xa3\x2f\x6c\x3e\x8d\xc7\xc0\xd6\x4b\x48\x71\x06\x90\xaf\x5d\x86\x4e\x7a\x89\xf3\x14\x85\xff\x9b\x4a\x65\x6e\x17\xe3\x0d\x96\x73\xcf\xe2\xeb\x2d\x9e\xb4\x63\x47\x83\x2c\x93\xb5\x85\x9d\xa8\xca\x69\x8c\xad\x9a\xfc\x3b\x8b\xc9\x9f\x7f\x0d\xb9\xe8\xe6\xe5\x67\xd7\x73\xa4\x9a\x22\x6d\x1d\xe0\xa9\xe7\x2d\x2a\xea\xb9\x5b\x3f\xaf\x75\x60\xa3\xa8\xb6\xe6\x97\x74\xa9\x1c\xd0\xd1\x89\xbb\x90\x90\x48\x09\x6f\xb1\x5a\xb0\x2b\x67\x63\xc7\x61\xeb\xd6\xa0\x41\xfe\x30\x1d\x87\x19\xb6\xc6\x6a\xe5\x00\xe3\xa0\xa3\x55\xbc\x9c\x7d\xe3\x7b\x61\x3b\x2d\x67\x95\x31\x45\x9e\xb7\x03\xc5\xaa\x9d\x77\x0d\xc7\xf9\xd9\x49\xb8\x6e\x26\x8a\x55\xeb\xfe\x1b\x00\x68\x9e\x3a\x9f\x92\x04\x00\x00"),
 		},
 		"/operator-role-knative.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "operator-role-knative.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 1442,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x53\xc1\x8e\xdb\x36\x10\xbd\xf3\x2b\x1e\xac\x4b\x52\xac\xe5\xb6\xa7\xc2\x3d\xb9\x9b\xdd\x56\x68\x60\x03\x2b\xa7\x41\x8e\x63\x6a\x2c\x0d\x4c\x91\xea\x90\xb2\xb2\xfd\xfa\x42\xb2\xdc\x78\xb1\x45\x4f\x8b\xf2\xa2\x11\xf9\xf4\xe6\x3d\xbe\x51\x86\xe5\xdb\x2d\x93\xe1\xa3\x58\xf6\x91\x2b\xa4\x80\xd4\x30\x36\x1d\xd9\x86\x51\x86\x63\x1a\x48\x19\x8f\xa1\xf7\x15\x25\x09\x1e\xef\x36\xe5\xe3\x7b\xf4\xbe\x62\x45\xf0\x8c\xa0\x68\x83\xb2\xc9\x60\x83\x4f\x2a\x87\x3e\x05\x85\xbb\x10\x82\x6a\x65\x6e\xd9\xa7\x98\x03\x25\xf3\xc4\xbe\xdd\xed\x8b\xfb\x07\x1c\xc5\x31\x2a\x89\x97\x8f\xb8\xc2\x20\xa9\x31\x19\x52\x23\x11\x43\xd0\x13\x8e\x41\x41\x55\x25\x63\x63\x72\x10\x7f\x0c\xda\x5e\x64\x28\xd7\xa4\x95\xf8\x1a\x36\x74\xcf\x2a\x75\x93\x10\x06\xcf\x1a\x1b\xe9\x72\x93\x61\x3f\xda\x28\x1f\xaf\x4a\xe2\x85\x76\xea\x99\x02\xbe\x84\x7e\xf6\x70\x63\x77\xbe\x85\x3b\xfc\xc1\x1a\xc7\x26\x3f\xe6\xdf\x9b\x0c\xef\x46\xc8\x62\x3e\x5c\xbc\xff\x19\xcf\xa1\x47\x4b\xcf\xf0\x21\xa1\x8f\x7c\xc3\xcc\x5f\x2d\x77\x09\xe2\x61\x43\xdb\x39\x21\x6f\xf9\x9b\xad\x7f\x3a\xe4\x98\x04\x8c\x1c\xe1\x90\x48\x3c\x68\xb2\x81\x70\xbc\x85\x81\x92\xc9\x4c\x86\x69\x35\x29\x75\xeb\xd5\x6a\x18\x86\x9c\x26\xb9\x79\xd0\x7a\x75\x75\xb7\xfa\x58\xdc\x3f\x6c\xcb\x87\xe5\x24\xd9\x64\xf8\xe4\x1d\xc7\x08\xe5\x3f\x7b\x51\xae\x70\x78\x06\x75\x9d\x13\x4b\x07\xc7\x70\x34\x8c\xc1\x4d\xe9\x4c\xa1\x8b\xc7\xa0\x92\xc4\xd7\x77\x88\x73\xea\x26\x7b\x91\xce\xb7\xeb\xba\xca\x93\xf8\x02\x10\x3c\xc8\x63\xb1\x29\x51\x94\x0b\xfc\xb2\x29\x8b\xf2\xce\x64\xf8\x5c\xec\x7f\xdb\x7d\xda\xe3\xf3\xe6\xe9\x69\xb3\xdd\x17\x0f\x25\x76\x4f\xb8\xdf\x6d\x3f\x14\xfb\x62\xb7\x2d\xb1\x7b\xc4\x66\xfb\x05\xbf\x17\xdb\x0f\x77\x60\x49\x0d\x2b\xf8\x6b\xa7\xa3\xfe\xa0\x90\xf1\x22\xb9\x1a\x33\xbd\x0e\xd0\x55\xc0\x38\x1f\xe3\x7b\xec\xd8\xca\x51\x2c\x1c\xf9\xba\xa7\x9a\x51\x87\x33\xab\x1f\xc7\xa3\x63\x6d\x25\x8e\x71\x46\x90\xaf\x4c\x06\x27\xad\xa4\x69\x8a\xe2\x6b\x53\x63\x9b\xb7\xfc\xb7\xcc\x49\x7c\xb5\xc6\x53\x70\x6c\xa8\x93\x79\xb2\xd6\xd0\x03\xd9\x9c\xfa\xd4\x04\x95\xbf\x26\x31\xf9\xe9\xa7\x98\x4b\x58\x9d\x7f\x30\x2d\x27\xaa\x28\xd1\xda\x00\x9e\x5a\x5e\xc3\x52\xcb\x6e\x79\x5a\x86\x8e\x95\x52\xd0\xe5\xc9\x53\x92\x33\x1b\xc0\xd1\x81\x5d\x1c\xa1\x18\x23\x5e\x63\x31\x83\x17\x46\x7b\xc7\x71\x6d\x96\xa0\x4e\x7e\xd5\xd0\x77\x13\x6c\x89\xc8\x7a\x16\x5f\xe7\x33\x49\x5e\xf1\xd9\x00\xca\x31\xf4\x6a\xf9\x16\x64\x39\x1a\xe0\xcc\x7a\x98\x77\xad\x32\x25\x9e\xca\x8a\x1d\xbf\x28\x6d\x70\x8e\xed\xe8\x65\xda\xac\x39\x4d\x4f\x27\xf1\x52\x74\x94\x6c\x33\x55\x7d\x57\x5d\x59\x86\x69\xf3\x95\x44\x3e\xb3\x4f\xaf\x35\x2e\xd1\x72\x8c\x54\xff\xdb\xc9\xac\xfe\xbf\x5d\x2d\xbe\x5b\xfc\x2f\x86\xfe\x0e\x00\x00\xff\xff\xb4\xd6\x89\xe8\xa2\x05\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x53\xc1\x8e\xdb\x36\x10\xbd\xf3\x2b\x1e\xac\x4b\x52\xac\xe5\xb6\xa7\xc2\x3d\xb9\xc9\x6e\x6b\x34\xb0\x81\x95\xd3\x20\xc7\x31\x35\x96\x06\xa6\x48\x75\x48\x59\xd9\x7e\x7d\x41\x59\x6e\x76\xb1\x45\x4f\x41\x79\x11\x45\x3e\xbd\x79\x6f\xde\xa8\xc0\xf2\xdb\x2d\x53\xe0\x83\x58\xf6\x91\x6b\xa4\x80\xd4\x32\x36\x3d\xd9\x96\x51\x85\x53\x1a\x49\x19\x0f\x61\xf0\x35\x25\x09\x1e\x6f\x36\xd5\xc3\x5b\x0c\xbe\x66\x45\xf0\x8c\xa0\xe8\x82\xb2\x29\x60\x83\x4f\x2a\xc7\x21\x05\x85\xbb\x12\x82\x1a\x65\xee\xd8\xa7\x58\x02\x15\xf3\xc4\xbe\xdb\x1f\xb6\xef\xee\x71\x12\xc7\xa8\x25\x5e\x3f\xe2\x1a\xa3\xa4\xd6\x14\x48\xad\x44\x8c\x41\xcf\x38\x05\x05\xd5\xb5\xe4\xc2\xe4\x20\xfe\x14\xb4\xbb\xca\x50\x6e\x48\x6b\xf1\x0d\x6c\xe8\x9f\x54\x9a\x36\x21\x8c\x9e\x35\xb6\xd2\x97\xa6\xc0\x21\xdb\xa8\x1e\x6e\x4a\xe2\x95\x76\xaa\x99\x02\x3e\x87\x61\xf6\xf0\xcc\xee\xdc\x85\x3b\xfc\xc1\x1a\x73\x91\x1f\xcb\xef\x4d\x81\x37\x19\xb2\x98\x2f\x17\x6f\x7f\xc6\x53\x18\xd0\xd1\x13\x7c\x48\x18\x22\x3f\x63\xe6\x2f\x96\xfb\x04\xf1\xb0\xa1\xeb\x9d\x90\xb7\xfc\xd5\xd6\x3f\x15\x4a\x4c\x02\x32\x47\x38\x26\x12\x0f\x9a\x6c\x20\x9c\x9e\xc3\x40\xc9\x14\xa6\xc0\xb4\xda\x94\xfa\xf5\x6a\x35\x8e\x63\x49\x53\x3a\x65\xd0\x66\x75\x73\xb7\xfa\xb0\x7d\x77\xbf\xab\xee\x97\x93\x64\x53\xe0\xa3\x77\x1c\x23\x94\xff\x1c\x44\xb9\xc6\xf1\x09\xd4\xf7\x4e\x2c\x1d\x1d\xc3\xd1\x98\x83\x9b\xd2\x99\x42\x17\x8f\x51\x25\x89\x6f\xee\x10\xe7\xd4\x4d\xf1\x22\x9d\xaf\xed\xba\xc9\x93\xf8\x02\x10\x3c\xc8\x63\xb1\xa9\xb0\xad\x16\xf8\x65\x53\x6d\xab\x3b\x53\xe0\xd3\xf6\xf0\xdb\xfe\xe3\x01\x9f\x36\x8f\x8f\x9b\xdd\x61\x7b\x5f\x61\xff\x88\x77\xfb\xdd\xfb\xed\x61\xbb\xdf\x55\xd8\x3f\x60\xb3\xfb\x8c\xdf\xb7\xbb\xf7\x77\x60\x49\x2d\x2b\xf8\x4b\xaf\x59\x7f\x50\x48\x6e\x24\xd7\x39\xd3\xdb\x00\xdd\x04\xe4\xf9\xc8\xef\xb1\x67\x2b\x27\xb1\x70\xe4\x9b\x81\x1a\x46\x13\x2e\xac\x3e\x8f\x47\xcf\xda\x49\xcc\x71\x46\x90\xaf\x4d\x01\x27\x9d\xa4\x69\x8a\xe2\x6b\x53\xb9\xcc\xed\xc7\xf8\x06\xcb\x98\xb3\xf8\x7a\x8d\xc7\xe0\xd8\x50\x2f\xf3\x64\xad\xa1\x47\xb2\x25\x0d\xa9\x0d\x2a\x7f\x4d\x62\xca\xf3\x4f\xb1\x94\xb0\xba\xfc\x60\x3a\x4e\x54\x53\xa2\xb5\x01\x3c\x75\xbc\x86\xa5\x8e\xdd\xf2\xbc\x0c\x3d\x2b\xa5\xa0\xcb\xb3\xa7\x24\x17\x36\x80\xa3\x23\xbb\x98\xa1\xc8\x11\xaf\xb1\x98\xc1\x0b\xa3\x83\xe3\xb8\x36\x4b\x50\x2f\xbf\x6a\x18\xfa\x09\xb6\x44\x64\xbd\x88\x6f\xca\x99\xa4\xac\xf9\x62\x00\xe5\x18\x06\xb5\xfc\x1c\x64\x39\x1a\xe0\xc2\x7a\x9c\x4f\xad\x32\xa5\x5c\x76\x89\x9a\x1d\xbf\xd8\xda\xe0\x1c\xdb\xec\x65\x3a\x6c\x38\x4d\x4f\x27\xf1\xba\xe9\x29\xd9\x76\xda\x0d\x7d\x7d\x63\x19\xa7\xc3\x57\x12\xf9\xc2\x3e\xbd\xd6\xb8\x44\xc7\x31\x52\xf3\x6f\x37\xb3\xfa\xff\x76\xb5\xf8\x6e\xf1\xbf\x18\xfa\x7b\x00\xb4\xd6\x89\xe8\xa2\x05\x00\x00"),
 		},
 		"/operator-role-kubernetes.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "operator-role-kubernetes.yaml",
 			modTime:          time.Time{},
-			uncompressedSize: 2375,
+			uncompressedSize: 2505,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x54\xc1\x72\xdb\x36\x10\xbd\xf3\x2b\xde\x88\x97\xa4\x63\xc9\x6d\x4f\x1d\xf5\xa4\x26\x76\xab\x69\x46\x9a\x31\x95\x66\x72\x04\xc1\x15\xb5\x35\x88\x45\x01\x50\xb2\xfa\xf5\x1d\x40\x54\x22\x9b\xce\x8c\x0f\x9e\x86\x17\x2d\xc0\xe5\xdb\xb7\xfb\x9e\xb6\xc4\xf4\xf5\x9e\xa2\xc4\x07\xd6\x64\x03\x35\x88\x82\xb8\x23\x2c\x9c\xd2\x3b\x42\x25\xdb\x78\x50\x9e\x70\x2b\xbd\x6d\x54\x64\xb1\x78\xb3\xa8\x6e\xdf\xa2\xb7\x0d\x79\x88\x25\x88\x47\x27\x9e\x8a\x12\x5a\x6c\xf4\x5c\xf7\x51\x3c\xcc\x09\x10\xaa\xf5\x44\x1d\xd9\x18\x66\x40\x45\x94\xd1\x57\xeb\xcd\xf2\xdd\x0d\xb6\x6c\x08\x0d\x87\xd3\x47\xd4\xe0\xc0\x71\x57\x94\x88\x3b\x0e\x38\x88\xbf\xc7\x56\x3c\x54\xd3\x70\x2a\xac\x0c\xd8\x6e\xc5\x77\x27\x1a\x9e\x5a\xe5\x1b\xb6\x2d\xb4\xb8\xa3\xe7\x76\x17\x21\x07\x4b\x3e\xec\xd8\xcd\x8a\x12\x9b\xd4\x46\x75\x7b\x66\x12\x4e\xb0\xb9\x66\x14\x7c\x96\x7e\xe8\xe1\xa2\xdd\x61\x0a\x57\xf8\x8b\x7c\x48\x45\x7e\x9e\xfd\x58\x94\x78\x93\x52\x26\xc3\xcb\xc9\xdb\x5f\x71\x94\x1e\x9d\x3a\xc2\x4a\x44\x1f\xe8\x02\x99\x1e\x34\xb9\x08\xb6\xd0\xd2\x39\xc3\xca\x6a\xfa\xda\xd6\x97\x0a\x33\x64\x02\x09\x43\xea\xa8\xd8\x42\xe5\x36\x20\xdb\xcb\x34\xa8\x58\x94\x45\x89\xfc\xec\x62\x74\xf3\xeb\xeb\xc3\xe1\x30\x53\x99\xee\x4c\x7c\x7b\x7d\xee\xee\xfa\xc3\xf2\xdd\xcd\xaa\xba\x99\x66\xca\x45\x89\x8f\xd6\x50\x08\xf0\xf4\x4f\xcf\x9e\x1a\xd4\x47\x28\xe7\x0c\x6b\x55\x1b\x82\x51\x87\x24\x5c\x56\x27\x8b\xce\x16\x07\xcf\x91\x6d\x7b\x85\x30\xa8\x5e\x94\x8f\xd4\xf9\x3a\xae\x33\x3d\x0e\x8f\x12\xc4\x42\x59\x4c\x16\x15\x96\xd5\x04\xbf\x2d\xaa\x65\x75\x55\x94\xf8\xb4\xdc\xfc\xb1\xfe\xb8\xc1\xa7\xc5\xdd\xdd\x62\xb5\x59\xde\x54\x58\xdf\xe1\xdd\x7a\xf5\x7e\xb9\x59\xae\x57\x15\xd6\xb7\x58\xac\x3e\xe3\xcf\xe5\xea\xfd\x15\x88\xe3\x8e\x3c\xe8\xc1\xf9\xc4\x5f\x3c\x38\x0d\x92\x9a\xa4\xe9\xd9\x40\x67\x02\xc9\x1f\xe9\x1c\x1c\x69\xde\xb2\x86\x51\xb6\xed\x55\x4b\x68\x65\x4f\xde\x26\x7b\x38\xf2\x1d\x87\x24\x67\x80\xb2\x4d\x51\xc2\x70\xc7\x31\xbb\x28\x8c\x9b\x4a\x65\x5e\xf3\xbf\x55\xdc\xb3\x6d\xe6\xb8\x13\x43\x85\x72\x3c\x38\x6b\x0e\x5f\x2b\x3d\x53\x7d\xdc\x89\xe7\x7f\x33\x99\xd9\xfd\x2f\x61\xc6\x72\xbd\xff\xa9\xe8\x28\xaa\x46\x45\x35\x2f\x00\xab\x3a\x9a\x43\xab\x8e\xcc\xf4\x7e\x2a\x8e\xbc\x8a\xe2\x0b\xc0\xa8\x9a\x4c\x48\x29\x48\xd2\xce\x31\x19\x92\x26\x85\xef\x0d\x85\x79\x31\x85\x72\xfc\xbb\x97\xde\xe5\xb4\xe9\x09\xe5\xc2\x3e\x05\xe0\x29\x48\xef\x35\x0d\x19\x93\x1f\x26\x05\xb0\x27\x5f\x5f\x5c\x8c\x70\x26\x93\xf1\x97\x4e\x9a\x90\x83\x40\x7e\xcf\x9a\x4e\x07\xb2\x8d\x13\xb6\xf1\x74\x72\xa9\xfb\x10\xc9\xc6\xbd\x98\xbe\x23\x6d\x14\x77\xa7\x57\x5a\xec\x96\xdb\x4e\xb9\x33\x88\xf6\x14\x1f\x01\x2a\xad\xa5\x3f\x21\x5d\xf0\xd3\x9e\x54\xa4\x1c\x36\x64\xe8\x51\xa8\xc5\x18\xd2\x69\xb6\xf9\xb2\xa5\x98\x7f\x0d\x87\x53\xe0\x54\xd4\xbb\x1c\xf5\xae\x39\xa3\x1c\xf2\xe5\xa8\x65\x27\x86\xf5\xf1\xd9\xb6\x1b\x0e\xbe\x77\xa9\x4c\xdd\x37\x2d\xbd\x8c\xe1\x99\xcc\x45\xe5\x27\xbc\x46\x14\xbe\x69\x99\x31\x2b\x2f\x66\x10\x20\x45\x35\xdb\xb4\x29\xbf\xd3\xe4\x9e\x33\x0b\xed\x69\xa4\xe4\xa8\xc8\x37\xf0\x94\xcb\x1e\x79\x82\xd8\x90\x33\x72\xec\xe8\x6c\x35\x4f\x79\xd9\x85\x2f\x26\x8a\x2a\xd2\xb6\x37\xe1\x85\xfa\xbc\xfe\x1c\xea\x21\xf7\x09\x71\xed\xc5\xfe\x2d\xf5\x77\x22\x35\x0c\x53\xc5\x61\x8b\xdf\x51\xda\xe7\x19\x3c\xcc\x61\x7b\x63\x9e\x19\xb5\xa2\x4e\xec\x78\x90\x2f\x15\x90\x1e\x22\xd9\xbc\x90\xc7\xd8\x6c\xdb\xb4\xf7\xe9\xff\x19\xc7\x7f\x01\x00\x00\xff\xff\x48\x73\x53\xa6\x47\x09\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x56\xc1\x72\xdb\x36\x10\xbd\xf3\x2b\xde\x88\x97\xa4\x63\x49\x6d\x4f\x1d\xf5\xa4\x3a\x76\xab\x69\x46\x9a\xb1\x94\x66\x72\x04\xc1\x15\xb5\x35\x88\x45\x01\x50\xb2\xfa\xf5\x1d\x40\x54\x22\x9b\xf6\x8c\x0f\x99\x34\xbc\x78\x01\x2e\xde\xbe\xf7\x76\x4d\xa8\xc4\xf8\xeb\x3d\x45\x89\xf7\xac\xc9\x06\xaa\x11\x05\x71\x47\x98\x3b\xa5\x77\x84\xb5\x6c\xe3\x41\x79\xc2\xad\x74\xb6\x56\x91\xc5\xe2\xcd\x7c\x7d\xfb\x16\x9d\xad\xc9\x43\x2c\x41\x3c\x5a\xf1\x54\x94\xd0\x62\xa3\xe7\xaa\x8b\xe2\x61\x4e\x80\x50\x8d\x27\x6a\xc9\xc6\x30\x01\xd6\x44\x19\x7d\xb9\xda\x2c\xae\x6f\xb0\x65\x43\xa8\x39\x9c\x0e\x51\x8d\x03\xc7\x5d\x51\x22\xee\x38\xe0\x20\xfe\x1e\x5b\xf1\x50\x75\xcd\xa9\xb0\x32\x60\xbb\x15\xdf\x9e\x68\x78\x6a\x94\xaf\xd9\x36\xd0\xe2\x8e\x9e\x9b\x5d\x84\x1c\x2c\xf9\xb0\x63\x37\x29\x4a\x6c\x92\x8c\xf5\xed\x99\x49\x38\xc1\xe6\x9a\x51\xf0\x49\xba\x5e\xc3\x85\xdc\xde\x85\x2b\xfc\x45\x3e\xa4\x22\x3f\x4f\x7e\x2c\x4a\xbc\x49\x29\xa3\xfe\xe5\xe8\xed\xaf\x38\x4a\x87\x56\x1d\x61\x25\xa2\x0b\x74\x81\x4c\x0f\x9a\x5c\x04\x5b\x68\x69\x9d\x61\x65\x35\x7d\x91\xf5\xb9\xc2\x04\x99\x40\xc2\x90\x2a\x2a\xb6\x50\x59\x06\x64\x7b\x99\x06\x15\x8b\xb2\x28\x91\x9f\x5d\x8c\x6e\x36\x9d\x1e\x0e\x87\x89\xca\xdd\x99\x88\x6f\xa6\x67\x75\xd3\xf7\x8b\xeb\x9b\xe5\xfa\x66\x9c\x29\x17\x25\x3e\x58\x43\x21\xc0\xd3\x3f\x1d\x7b\xaa\x51\x1d\xa1\x9c\x33\xac\x55\x65\x08\x46\x1d\x52\xe3\x72\x77\x72\xd3\xd9\xe2\xe0\x39\xb2\x6d\xae\x10\xfa\xae\x17\xe5\xa3\xee\x7c\xb1\xeb\x4c\x8f\xc3\xa3\x04\xb1\x50\x16\xa3\xf9\x1a\x8b\xf5\x08\xbf\xcd\xd7\x8b\xf5\x55\x51\xe2\xe3\x62\xf3\xc7\xea\xc3\x06\x1f\xe7\x77\x77\xf3\xe5\x66\x71\xb3\xc6\xea\x0e\xd7\xab\xe5\xbb\xc5\x66\xb1\x5a\xae\xb1\xba\xc5\x7c\xf9\x09\x7f\x2e\x96\xef\xae\x40\x1c\x77\xe4\x41\x0f\xce\x27\xfe\xe2\xc1\xc9\x48\xaa\x53\x4f\xcf\x03\x74\x26\x90\xe6\x23\xad\x83\x23\xcd\x5b\xd6\x30\xca\x36\x9d\x6a\x08\x8d\xec\xc9\xdb\x34\x1e\x8e\x7c\xcb\x21\xb5\x33\x40\xd9\xba\x28\x61\xb8\xe5\x98\xa7\x28\x0c\x45\xa5\x32\xe7\x7f\x8c\xaf\xf0\x14\xc5\x3d\xdb\x7a\x86\x3b\x31\x54\x28\xc7\xfd\x64\xcd\xe0\x2b\xa5\x27\xaa\x8b\x3b\xf1\xfc\x6f\x26\x33\xb9\xff\x25\x4c\x58\xa6\xfb\x9f\x8a\x96\xa2\xaa\x55\x54\xb3\x02\xb0\xaa\xa5\x19\xb4\x6a\xc9\x8c\xef\xc7\xe2\xc8\xab\x28\xbe\x00\x8c\xaa\xc8\x84\x94\x82\xd4\xda\x19\x46\x7d\xd2\xa8\xf0\x9d\xa1\x30\x2b\xc6\x50\x8e\x7f\xf7\xd2\xb9\x9c\x36\x3e\xa1\x5c\x8c\x4f\x01\x78\x0a\xd2\x79\x4d\x7d\xc6\xe8\x87\x51\x01\xec\xc9\x57\x17\x1b\x03\x9c\xd1\x68\x78\xd2\x49\x1d\x72\x10\xc8\xef\x59\xd3\x69\x41\xb6\x76\xc2\x36\x9e\x56\x2e\xa9\x0f\x91\x6c\xdc\x8b\xe9\x5a\xd2\x46\x71\x7b\x7a\xa5\xc5\x6e\xb9\x69\x95\x3b\x83\x68\x4f\xf1\x11\xa0\xd2\x5a\xba\x13\xd2\x05\x3f\xed\x49\x45\xca\x61\x4d\x86\x1e\x85\x5a\x8c\x21\x9d\xbc\xcd\x9b\x0d\xc5\xfc\xd7\x70\x38\x05\x4e\x45\xbd\xcb\x51\xe7\xea\x33\xca\x21\x6f\xbe\x5a\xf2\xd4\x48\xf3\x98\x51\x2a\xf3\xfa\xe3\xf4\x40\xfa\x59\x45\x03\x08\x27\x86\xf5\xf1\x59\x98\x9a\x83\xef\x5c\x12\x5a\x75\x75\x43\xaf\xf3\xe8\x6c\xc7\x85\xf6\x27\xce\x0c\x28\xbc\x38\xb4\x43\x56\x5e\x4c\x3f\x02\x29\xaa\xd8\xa6\x6f\xf5\x77\xd4\x3b\xda\xd3\x60\x96\x06\x45\x5e\xc0\x53\xce\x85\x21\x62\x4d\xce\xc8\xb1\xed\x61\xc7\xf0\x94\x3f\xb7\xe1\xf3\x18\x47\x15\x69\xdb\x99\x40\x4f\xeb\x7e\x2b\x1f\xaa\x3e\xf7\x09\x71\xed\xc5\xfe\x2d\xd5\xff\x44\xaa\x37\x53\xc5\xfe\x1e\xb9\xa3\xf4\x83\x20\x83\x87\x19\x6c\x67\xcc\x33\x56\x2b\x6a\xc5\x0e\x8d\x1c\x10\x79\xa1\x26\x3d\x44\xb2\xf9\x4a\x18\x62\xb3\x6d\xd2\xcd\x43\xdf\xc6\x8e\xff\x06\x00\x69\x02\x42\x8b\xc9\x09\x00\x00"),
 		},
 		"/operator-role-leases.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "operator-role-leases.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 1232,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x53\x4d\x6f\xdb\x46\x10\xbd\xef\xaf\x78\x10\x2f\x09\x60\xd1\x6d\x4f\x85\x7a\x52\x1d\xbb\x25\x1a\x48\x80\xa9\x34\xc8\x71\xb5\x1c\x91\x03\x2f\x77\xb6\xb3\x4b\x33\xee\xaf\x2f\x96\x92\x12\x1b\xbd\x86\xa7\xd1\xea\xf1\x7d\xec\x1b\x56\x58\xff\xb8\xc7\x54\xf8\xc8\x8e\x42\xa2\x0e\x59\x90\x07\xc2\x36\x5a\x37\x10\x5a\x39\xe5\xd9\x2a\xe1\x41\xa6\xd0\xd9\xcc\x12\xf0\x6e\xdb\x3e\xbc\xc7\x14\x3a\x52\x48\x20\x88\x62\x14\x25\x53\xc1\x49\xc8\xca\xc7\x29\x8b\xc2\x9f\x09\x61\x7b\x25\x1a\x29\xe4\x54\x03\x2d\xd1\xc2\xbe\xdb\x1f\x9a\xbb\x7b\x9c\xd8\x13\x3a\x4e\xe7\x97\xa8\xc3\xcc\x79\x30\x15\xf2\xc0\x09\xb3\xe8\x13\x4e\xa2\xb0\x5d\xc7\x45\xd8\x7a\x70\x38\x89\x8e\x67\x1b\x4a\xbd\xd5\x8e\x43\x0f\x27\xf1\x45\xb9\x1f\x32\x64\x0e\xa4\x69\xe0\x58\x9b\x0a\x87\x12\xa3\x7d\xb8\x3a\x49\x67\xda\x45\x33\x0b\xbe\xc8\x74\xc9\xf0\x2a\xee\xe5\x16\x6e\xf0\x37\x69\x2a\x22\xbf\xd4\x3f\x99\x0a\xef\x0a\x64\x75\xf9\x73\xf5\xfe\x37\xbc\xc8\x84\xd1\xbe\x20\x48\xc6\x94\xe8\x15\x33\x7d\x75\x14\x33\x38\xc0\xc9\x18\x3d\xdb\xe0\xe8\x7b\xac\x6f\x0a\x35\x16\x03\x85\x43\x8e\xd9\x72\x80\x5d\x62\x40\x4e\xaf\x61\xb0\xd9\x54\xa6\xc2\xf2\x0c\x39\xc7\xcd\xed\xed\x3c\xcf\xb5\x5d\xec\xd6\xa2\xfd\xed\x35\xdd\xed\xc7\xe6\xee\x7e\xd7\xde\xaf\x17\xcb\xa6\xc2\xa7\xe0\x29\x25\x28\xfd\x33\xb1\x52\x87\xe3\x0b\x6c\x8c\x9e\x9d\x3d\x7a\x82\xb7\x73\x29\x6e\x69\x67\x29\x9d\x03\x66\xe5\xcc\xa1\xbf\x41\xba\xb4\x6e\xaa\x37\xed\x7c\xbf\xae\xab\x3d\x4e\x6f\x00\x12\x60\x03\x56\xdb\x16\x4d\xbb\xc2\xef\xdb\xb6\x69\x6f\x4c\x85\xcf\xcd\xe1\xcf\xfd\xa7\x03\x3e\x6f\x1f\x1f\xb7\xbb\x43\x73\xdf\x62\xff\x88\xbb\xfd\xee\x43\x73\x68\xf6\xbb\x16\xfb\x07\x6c\x77\x5f\xf0\x57\xb3\xfb\x70\x03\xe2\x3c\x90\x82\xbe\x46\x2d\xfe\x45\xc1\xe5\x22\xa9\x2b\x9d\x5e\x17\xe8\x6a\xa0\xec\x47\xf9\x9d\x22\x39\x3e\xb1\x83\xb7\xa1\x9f\x6c\x4f\xe8\xe5\x99\x34\x94\xf5\x88\xa4\x23\xa7\x52\x67\x82\x0d\x9d\xa9\xe0\x79\xe4\xbc\x6c\x51\xfa\x7f\xa8\x22\xf3\x23\xbf\x2d\xf3\xc4\xa1\xdb\xe0\x51\x3c\x19\x1b\xf9\xb2\x59\x1b\xe8\xd1\xba\xda\x4e\x79\x10\xe5\x7f\x17\x33\xf5\xd3\xaf\xa9\x66\xb9\x7d\xfe\xd9\x8c\x94\x6d\x67\xb3\xdd\x18\x20\xd8\x91\x36\x70\x76\x24\xbf\x7e\x5a\x4b\x24\xb5\x59\x74\xed\xc9\x26\x4a\x06\xf0\xf6\x48\x3e\x15\x24\x4a\xc3\x1b\xac\x2e\xd8\x95\xd1\xc9\x53\xda\x98\x35\x6c\xe4\x3f\x54\xa6\xb8\xc0\xd6\x58\x39\x91\xf2\xed\xbc\x96\x5d\x19\x40\x29\xc9\xa4\x8e\x2e\xb0\x6f\x12\xcf\xa4\xc7\xcb\x99\x53\xb2\x99\x96\xb1\x23\x4f\x6f\x46\x27\xde\x93\x2b\x9c\xcb\x61\x4f\xf9\x4c\xc3\xe9\x3c\x44\x9b\xdd\xb0\x4c\x53\xec\xae\x2c\xf3\x72\xf8\x5f\x00\x00\x00\xff\xff\xda\xe6\xcd\xb4\xd0\x04\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x53\x4d\x8f\xdb\x36\x10\xbd\xf3\x57\x3c\x58\x97\x04\x58\x6b\xdb\x9e\x0a\xf7\xe4\x6e\x76\x5b\xa1\x81\x0d\xac\x9c\x06\x39\x8e\xa9\xb1\x44\x2c\xc5\x61\x87\xd4\x2a\xdb\x5f\x5f\x50\xb6\x93\x5d\xf4\x1a\x5e\x34\xa2\x46\xef\x83\x6f\x58\x61\xfd\xe3\x96\xa9\xf0\xd1\x59\x0e\x89\x3b\x64\x41\x1e\x18\xdb\x48\x76\x60\xb4\x72\xca\x33\x29\xe3\x41\xa6\xd0\x51\x76\x12\xf0\x6e\xdb\x3e\xbc\xc7\x14\x3a\x56\x48\x60\x88\x62\x14\x65\x53\xc1\x4a\xc8\xea\x8e\x53\x16\x85\x3f\x03\x82\x7a\x65\x1e\x39\xe4\x54\x03\x2d\xf3\x82\xbe\xdb\x1f\x9a\xbb\x7b\x9c\x9c\x67\x74\x2e\x9d\x7f\xe2\x0e\xb3\xcb\x83\xa9\x90\x07\x97\x30\x8b\x3e\xe1\x24\x0a\xea\x3a\x57\x88\xc9\xc3\x85\x93\xe8\x78\x96\xa1\xdc\x93\x76\x2e\xf4\xb0\x12\x5f\xd4\xf5\x43\x86\xcc\x81\x35\x0d\x2e\xd6\xa6\xc2\xa1\xd8\x68\x1f\xae\x4a\xd2\x19\x76\xe1\xcc\x82\x2f\x32\x5d\x3c\xbc\xb2\x7b\x39\x85\x1b\xfc\xcd\x9a\x0a\xc9\x2f\xf5\x4f\xa6\xc2\xbb\xd2\xb2\xba\x7c\x5c\xbd\xff\x0d\x2f\x32\x61\xa4\x17\x04\xc9\x98\x12\xbf\x42\xe6\xaf\x96\x63\x86\x0b\xb0\x32\x46\xef\x28\x58\xfe\x6e\xeb\x1b\x43\x8d\x45\x40\xc1\x90\x63\x26\x17\x40\x8b\x0d\xc8\xe9\x75\x1b\x28\x9b\xca\x54\x58\xd6\x90\x73\xdc\xdc\xde\xce\xf3\x5c\xd3\x92\x4e\x2d\xda\xdf\x5e\xdd\xdd\x7e\x6c\xee\xee\x77\xed\xfd\x7a\x91\x6c\x2a\x7c\x0a\x9e\x53\x82\xf2\x3f\x93\x53\xee\x70\x7c\x01\xc5\xe8\x9d\xa5\xa3\x67\x78\x9a\x4b\x70\x4b\x3a\x4b\xe8\x2e\x60\x56\x97\x5d\xe8\x6f\x90\x2e\xa9\x9b\xea\x4d\x3a\xdf\x8f\xeb\x2a\xcf\xa5\x37\x0d\x12\x40\x01\xab\x6d\x8b\xa6\x5d\xe1\xf7\x6d\xdb\xb4\x37\xa6\xc2\xe7\xe6\xf0\xe7\xfe\xd3\x01\x9f\xb7\x8f\x8f\xdb\xdd\xa1\xb9\x6f\xb1\x7f\xc4\xdd\x7e\xf7\xa1\x39\x34\xfb\x5d\x8b\xfd\x03\xb6\xbb\x2f\xf8\xab\xd9\x7d\xb8\x01\xbb\x3c\xb0\x82\xbf\x46\x2d\xfa\x45\xe1\xca\x41\x72\x57\x32\xbd\x0e\xd0\x55\x40\x99\x8f\xf2\x9e\x22\x5b\x77\x72\x16\x9e\x42\x3f\x51\xcf\xe8\xe5\x99\x35\x94\xf1\x88\xac\xa3\x4b\x25\xce\x04\x0a\x9d\xa9\xe0\xdd\xe8\xf2\x32\x45\xe9\xff\xa6\x0a\xcd\xf5\x62\xfc\x80\x65\xcc\x93\x0b\xdd\x06\x8f\xe2\xd9\x50\x74\x97\xc9\xda\x40\x8f\x64\x6b\x9a\xf2\x20\xea\xfe\x5d\xc4\xd4\x4f\xbf\xa6\xda\xc9\xed\xf3\xcf\x66\xe4\x4c\x1d\x65\xda\x18\x20\xd0\xc8\x1b\x58\x1a\xd9\xaf\x9f\xd6\x12\x59\x29\x8b\xae\x3d\x53\xe2\x64\x00\x4f\x47\xf6\xa9\x74\xa2\x24\xbc\xc1\xea\xd2\xbb\x32\x3a\x79\x4e\x1b\xb3\x06\x45\xf7\x87\xca\x14\x97\xb6\x35\x56\x56\xa4\xdc\x9d\xd7\xb4\x2b\x03\x28\x27\x99\xd4\xf2\xa5\xed\x1b\xc5\x33\xeb\xf1\xb2\x67\x95\x29\xf3\x52\x76\xec\xf9\x4d\x69\xc5\x7b\xb6\x05\x73\xd9\xec\x39\x2f\x4f\xef\xd2\xb9\x88\x94\xed\xb0\x54\x53\xec\xae\x28\x33\x65\x3b\x98\xff\x06\x00\xda\xe6\xcd\xb4\xd0\x04\x00\x00"),
 		},
 		"/operator-role-olm-cluster.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "operator-role-olm-cluster.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 1316,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x53\xc1\x72\xdb\x36\x10\xbd\xe3\x2b\xde\x88\x87\x24\x1d\x8b\x6a\x7b\xea\xa8\x27\xd5\xb1\x5b\x4e\x33\xd2\x8c\xa9\x34\x93\x23\x44\xae\xc8\x1d\x83\x58\x74\x01\x9a\x71\xbf\xbe\x03\x4a\x4a\xac\xf1\xd5\xb8\x70\x09\x3e\xee\xbe\x87\xf7\x50\x60\xf9\x76\xcb\x14\xf8\xc4\x0d\xf9\x48\x2d\x92\x20\xf5\x84\x4d\xb0\x4d\x4f\xa8\xe5\x98\x26\xab\x84\x7b\x19\x7d\x6b\x13\x8b\xc7\xfb\x4d\x7d\xff\x01\xa3\x6f\x49\x21\x9e\x20\x8a\x41\x94\x4c\x81\x46\x7c\x52\x3e\x8c\x49\x14\xee\xd4\x10\xb6\x53\xa2\x81\x7c\x8a\x25\x50\x13\xcd\xdd\xb7\xbb\x7d\x75\x7b\x87\x23\x3b\x42\xcb\xf1\xf4\x13\xb5\x98\x38\xf5\xa6\x40\xea\x39\x62\x12\x7d\xc4\x51\x14\xb6\x6d\x39\x0f\xb6\x0e\xec\x8f\xa2\xc3\x89\x86\x52\x67\xb5\x65\xdf\xa1\x91\xf0\xac\xdc\xf5\x09\x32\x79\xd2\xd8\x73\x28\x4d\x81\x7d\x96\x51\xdf\x5f\x98\xc4\x53\xdb\x79\x66\x12\x7c\x95\xf1\xac\xe1\x85\xdc\xf3\x29\xdc\xe0\x1f\xd2\x98\x87\xfc\x5a\xfe\x6c\x0a\xbc\xcf\x90\xc5\xf9\xe3\xe2\xc3\xef\x78\x96\x11\x83\x7d\x86\x97\x84\x31\xd2\x8b\xce\xf4\xad\xa1\x90\xc0\x1e\x8d\x0c\xc1\xb1\xf5\x0d\xfd\x90\xf5\x7d\x42\x89\x99\x40\xee\x21\x87\x64\xd9\xc3\xce\x32\x20\xc7\x97\x30\xd8\x64\x0a\x53\x60\x5e\x7d\x4a\x61\xbd\x5a\x4d\xd3\x54\xda\x99\x6e\x29\xda\xad\x2e\xea\x56\x9f\xaa\xdb\xbb\x6d\x7d\xb7\x9c\x29\x9b\x02\x9f\xbd\xa3\x18\xa1\xf4\xef\xc8\x4a\x2d\x0e\xcf\xb0\x21\x38\x6e\xec\xc1\x11\x9c\x9d\xb2\x71\xb3\x3b\xb3\xe9\xec\x31\x29\x27\xf6\xdd\x0d\xe2\xd9\x75\x53\x5c\xb9\xf3\xe3\xb8\x2e\xf4\x38\x5e\x01\xc4\xc3\x7a\x2c\x36\x35\xaa\x7a\x81\x3f\x36\x75\x55\xdf\x98\x02\x5f\xaa\xfd\x5f\xbb\xcf\x7b\x7c\xd9\x3c\x3c\x6c\xb6\xfb\xea\xae\xc6\xee\x01\xb7\xbb\xed\xc7\x6a\x5f\xed\xb6\x35\x76\xf7\xd8\x6c\xbf\xe2\xef\x6a\xfb\xf1\x06\xc4\xa9\x27\x05\x7d\x0b\x9a\xf9\x8b\x82\xf3\x41\x52\x9b\x3d\xbd\x04\xe8\x42\x20\xe7\x23\xbf\xc7\x40\x0d\x1f\xb9\x81\xb3\xbe\x1b\x6d\x47\xe8\xe4\x89\xd4\xe7\x78\x04\xd2\x81\x63\xb6\x33\xc2\xfa\xd6\x14\x70\x3c\x70\x9a\x53\x14\x5f\x8b\xca\x63\xde\xf2\x6e\x99\x47\xf6\xed\x1a\xb7\x6e\x8c\x89\xf4\x41\x1c\x19\x1b\xf8\x1c\xb0\x35\xf4\x60\x9b\xd2\x8e\xa9\x17\xe5\xff\x66\x4e\xe5\xe3\x6f\xb1\x64\x59\x3d\xfd\x62\x06\x4a\xb6\xb5\xc9\xae\x0d\xe0\xed\x40\x6b\x34\x76\x20\xb7\x7c\x5c\x4a\x20\xb5\x49\xd4\x00\xce\x1e\xc8\xc5\x0c\x41\x76\x78\x8d\xc5\x19\xb4\x30\x3a\x3a\x8a\x6b\xb3\x84\x0d\xfc\xa7\xca\x18\x66\xd8\x32\x5f\xd4\x28\x8e\x4a\x09\xe4\x63\xcf\xc7\x54\xb2\x18\x40\x29\xca\xa8\x0d\x5d\xa3\x1a\xc7\xad\x4c\xde\x89\x6d\xa3\x01\x9e\x48\x0f\x17\x80\x92\x4d\x34\x97\x2d\x39\xba\x2a\x1b\x71\x8e\x9a\xac\x67\xde\xec\x28\xcd\x4f\xc7\xf1\x54\x04\x9b\x9a\x7e\xae\xc6\xd0\x5e\xba\x4c\xf3\xe6\x6b\xba\x59\xcf\x8b\xd0\xbf\xa6\xfa\xee\xa7\x77\xd7\xd4\xf2\xc6\xff\x01\x00\x00\xff\xff\x27\xe0\xf8\xf4\x24\x05\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x53\xc1\x72\xdb\x36\x10\xbd\xe3\x2b\xde\x88\x07\x27\x19\x8b\x6a\x7b\xea\xa8\x27\xd5\xb1\x5b\x4e\x33\xd2\x8c\xa9\x34\x93\x23\x44\xae\xc8\x1d\x83\x58\x74\x01\x9a\x71\xbf\xbe\x03\x4a\x6a\xac\xf1\x35\xb8\x70\x09\x3e\xee\xbe\x87\xf7\x50\x60\xf9\xe3\x96\x29\xf0\x89\x1b\xf2\x91\x5a\x24\x41\xea\x09\x9b\x60\x9b\x9e\x50\xcb\x31\x4d\x56\x09\x0f\x32\xfa\xd6\x26\x16\x8f\x77\x9b\xfa\xe1\x3d\x46\xdf\x92\x42\x3c\x41\x14\x83\x28\x99\x02\x8d\xf8\xa4\x7c\x18\x93\x28\xdc\xa9\x21\x6c\xa7\x44\x03\xf9\x14\x4b\xa0\x26\x9a\xbb\x6f\x77\xfb\xea\xee\x1e\x47\x76\x84\x96\xe3\xe9\x27\x6a\x31\x71\xea\x4d\x81\xd4\x73\xc4\x24\xfa\x84\xa3\x28\x6c\xdb\x72\x1e\x6c\x1d\xd8\x1f\x45\x87\x13\x0d\xa5\xce\x6a\xcb\xbe\x43\x23\xe1\x45\xb9\xeb\x13\x64\xf2\xa4\xb1\xe7\x50\x9a\x02\xfb\x2c\xa3\x7e\xb8\x30\x89\xa7\xb6\xf3\xcc\x24\xf8\x2a\xe3\x59\xc3\x2b\xb9\xe7\x53\xb8\xc5\xdf\xa4\x31\x0f\xf9\xa5\xfc\xc9\x14\x78\x97\x21\x8b\xf3\xc7\xc5\xfb\xdf\xf0\x22\x23\x06\xfb\x02\x2f\x09\x63\xa4\x57\x9d\xe9\x5b\x43\x21\x81\x3d\x1a\x19\x82\x63\xeb\x1b\xfa\x2e\xeb\xff\x09\x25\x66\x02\xb9\x87\x1c\x92\x65\x0f\x3b\xcb\x80\x1c\x5f\xc3\x60\x93\x29\x4c\x81\x79\xf5\x29\x85\xf5\x6a\x35\x4d\x53\x69\x67\x77\x4a\xd1\x6e\x75\x51\xb7\xfa\x54\xdd\xdd\x6f\xeb\xfb\xe5\x4c\xd9\x14\xf8\xec\x1d\xc5\x08\xa5\x7f\x46\x56\x6a\x71\x78\x81\x0d\xc1\x71\x63\x0f\x8e\xe0\xec\x94\x8d\x9b\xdd\x99\x4d\x67\x8f\x49\x39\xb1\xef\x6e\x11\xcf\xae\x9b\xe2\xca\x9d\xef\xc7\x75\xa1\xc7\xf1\x0a\x20\x1e\xd6\x63\xb1\xa9\x51\xd5\x0b\xfc\xbe\xa9\xab\xfa\xd6\x14\xf8\x52\xed\xff\xdc\x7d\xde\xe3\xcb\xe6\xf1\x71\xb3\xdd\x57\xf7\x35\x76\x8f\xb8\xdb\x6d\x3f\x56\xfb\x6a\xb7\xad\xb1\x7b\xc0\x66\xfb\x15\x7f\x55\xdb\x8f\xb7\x20\x4e\x3d\x29\xe8\x5b\xd0\xcc\x5f\x14\x9c\x0f\x92\xda\xec\xe9\x25\x40\x17\x02\x39\x1f\xf9\x3d\x06\x6a\xf8\xc8\x0d\x9c\xf5\xdd\x68\x3b\x42\x27\xcf\xa4\x3e\xc7\x23\x90\x0e\x1c\xb3\x9d\x11\xd6\xb7\xa6\x80\xe3\x81\xd3\x9c\xa2\xf8\x56\x54\x1e\x73\xb9\x18\x3f\x60\x19\xf3\xc4\xbe\x5d\xe3\xce\x8d\x31\x91\x3e\x8a\x23\x63\x03\x9f\x03\xb6\x86\x1e\x6c\x53\xda\x31\xf5\xa2\xfc\xef\xcc\xa9\x7c\xfa\x35\x96\x2c\xab\xe7\x9f\xcd\x40\xc9\xb6\x36\xd9\xb5\x01\xbc\x1d\x68\x8d\xc6\x0e\xe4\x96\x4f\x4b\x09\xa4\x36\x89\x1a\xc0\xd9\x03\xb9\x98\x21\xc8\x0e\xaf\xb1\x38\x83\x16\x46\x47\x47\x71\x6d\x96\xb0\x81\xff\x50\x19\xc3\x0c\x5b\xe6\x8b\x1a\xc5\x51\x29\x81\x7c\xec\xf9\x98\x4a\x16\x03\x28\x45\x19\xb5\xa1\x6b\x54\xe3\xb8\x95\xc9\x3b\xb1\x6d\x34\xc0\x33\xe9\xe1\x02\x50\xb2\x89\xe6\xb2\x25\x47\x57\x65\x23\xce\x51\x93\xf5\xcc\x9b\x1d\xa5\xf9\xe9\x38\x9e\x8a\x60\x53\xd3\xcf\xd5\x18\xda\x4b\x97\x69\xde\x7c\x4b\x37\xeb\x79\x15\xfa\xb7\x54\x6f\x3e\xdc\x5c\x53\xbb\xf9\x70\x63\xfe\x1b\x00\x27\xe0\xf8\xf4\x24\x05\x00\x00"),
 		},
 		"/operator-role-olm.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "operator-role-olm.yaml",
 			modTime:          time.Time{},
-			uncompressedSize: 4252,
+			uncompressedSize: 4382,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x57\x51\x8f\xdb\x36\x0c\x7e\xf7\xaf\x20\x92\x97\x76\xb8\x38\xdb\x9e\x86\xec\xe9\xd6\xde\x6d\xc1\x8a\x1c\x70\xb9\xae\xe8\x23\x2d\x33\x36\x17\x59\xd4\x24\x39\x69\xfa\xeb\x07\xc9\x4e\xcf\xa9\x73\xeb\x75\x28\x1a\xbf\x44\x96\x68\xf2\x23\xbf\x4f\x94\x32\x85\xd9\xb7\x7b\xb2\x29\xbc\x61\x45\xc6\x53\x09\x41\x20\xd4\x04\xd7\x16\x55\x4d\xb0\x96\x4d\xd8\xa3\x23\xb8\x95\xd6\x94\x18\x58\x0c\xbc\xb8\x5e\xdf\xbe\x84\xd6\x94\xe4\x40\x0c\x81\x38\x68\xc4\x51\x36\x05\x25\x26\x38\x2e\xda\x20\x0e\x74\xe7\x10\xb0\x72\x44\x0d\x99\xe0\x73\x80\x35\x51\xf2\xbe\xba\x7b\x58\xbe\xba\x81\x0d\x6b\x82\x92\x7d\xf7\x11\x95\xb0\xe7\x50\x67\x53\x08\x35\x7b\xd8\x8b\xdb\xc2\x46\x1c\x60\x59\x72\x0c\x8c\x1a\xd8\x6c\xc4\x35\x1d\x0c\x47\x15\xba\x92\x4d\x05\x4a\xec\xc1\x71\x55\x07\x90\xbd\x21\xe7\x6b\xb6\x79\x36\x85\x87\x98\xc6\xfa\xf6\x88\xc4\x77\x6e\x53\xcc\x20\xf0\x5e\xda\x3e\x87\x41\xba\x7d\x15\xae\xe0\x2f\x72\x3e\x06\xf9\x39\xff\x31\x9b\xc2\x8b\x68\x32\xe9\x17\x27\x2f\x7f\x85\x83\xb4\xd0\xe0\x01\x8c\x04\x68\x3d\x0d\x3c\xd3\x07\x45\x36\x00\x1b\x50\xd2\x58\xcd\x68\x14\x3d\xa6\xf5\x29\x42\x0e\x09\x40\xf4\x21\x45\x40\x36\x80\x29\x0d\x90\xcd\xd0\x0c\x30\x64\xd3\x6c\x0a\xe9\xa9\x43\xb0\x8b\xf9\x7c\xbf\xdf\xe7\x98\xe0\xe6\xe2\xaa\xf9\x31\xbb\xf9\x9b\xe5\xab\x9b\xd5\xfa\x66\x96\x20\x67\x53\x78\x6b\x34\x79\x0f\x8e\xfe\x69\xd9\x51\x09\xc5\x01\xd0\x5a\xcd\x0a\x0b\x4d\xa0\x71\x1f\x89\x4b\xec\x24\xd2\xd9\xc0\xde\x71\x60\x53\x5d\x81\xef\x59\xcf\xa6\x27\xec\x3c\x96\xeb\x08\x8f\xfd\x89\x81\x18\x40\x03\x93\xeb\x35\x2c\xd7\x13\xf8\xed\x7a\xbd\x5c\x5f\x65\x53\x78\xb7\x7c\xf8\xe3\xee\xed\x03\xbc\xbb\xbe\xbf\xbf\x5e\x3d\x2c\x6f\xd6\x70\x77\x0f\xaf\xee\x56\xaf\x97\x0f\xcb\xbb\xd5\x1a\xee\x6e\xe1\x7a\xf5\x1e\xfe\x5c\xae\x5e\x5f\x01\x71\xa8\xc9\x01\x7d\xb0\x2e\xe2\x17\x07\x1c\x0b\x49\x65\xe4\xf4\x28\xa0\x23\x80\xa8\x8f\xf8\xee\x2d\x29\xde\xb0\x02\x8d\xa6\x6a\xb1\x22\xa8\x64\x47\xce\x44\x79\x58\x72\x0d\xfb\x48\xa7\x07\x34\x65\x36\x05\xcd\x0d\x87\xa4\x22\x3f\x4e\x2a\x86\xf9\x96\x7b\x2b\xdb\xb2\x29\x17\x70\x2f\x9a\x32\xb4\xdc\x2b\x6b\x01\xae\x40\x95\x63\x1b\x6a\x71\xfc\x31\x81\xc9\xb7\xbf\xf8\x9c\x65\xbe\xfb\x29\x6b\x28\x60\x89\x01\x17\x19\x80\xc1\x86\x16\xa0\xb0\x21\x3d\xdb\xce\xc4\x92\xc3\x20\x2e\x03\xd0\x58\x90\xf6\xd1\x04\x22\xb5\x0b\x98\xf4\x46\x93\xcc\xb5\x9a\xfc\x22\x9b\x01\x5a\xfe\xdd\x49\x6b\x93\xd9\xac\xf3\x32\x90\x4f\x06\xe0\xc8\x4b\xeb\x14\xf5\x16\x93\x1f\x26\x19\xc0\x8e\x5c\x31\x98\x18\xf9\x99\x4c\xc6\x5f\x5a\x29\x7d\x1a\x78\x72\x3b\x56\xd4\xbd\x90\x29\xad\xb0\x09\xdd\x9b\x8d\xd9\xfb\x40\x26\xec\x44\xb7\x0d\x29\x8d\xdc\x74\x4b\x4a\xcc\x86\xab\x06\xed\xd1\x89\x72\x14\x4e\x1c\xa2\x52\xd2\x76\x9e\x06\xf8\x94\x23\x0c\x94\x86\x25\x69\x3a\x19\x2a\xd1\x9a\x54\xac\x6d\x9a\xac\x28\xa4\x5f\xcd\xbe\x1b\x58\x0c\xaa\x4e\xa3\xd6\x96\x47\x2f\xfb\x34\x39\x4a\xd9\x8a\x66\x75\x38\x9b\x76\xc9\xde\xb5\x36\x86\x29\xda\xb2\xa2\xe7\x21\x3c\x82\x19\x44\x7e\x84\x93\x10\x8e\x20\x3c\x29\x99\x31\x2a\x27\xba\x27\x20\x8e\x0a\x36\xb1\x53\x5e\xa8\x72\xe7\xc4\x42\x3b\xfa\x2f\x26\x1f\xdd\x8f\x22\x3f\x11\x04\x6d\x12\xce\x67\x61\x4a\xb2\x5a\x0e\x0d\x1d\xf5\xe7\x28\x75\x40\xff\x49\x59\x01\x03\x6d\x5a\xed\x9f\x49\xda\xb7\x2f\x4e\xd1\xdb\x7e\x06\x5c\x39\x31\x7f\x4b\x71\x21\x50\x7d\x31\x31\xf4\xad\xfd\x9e\x62\x93\x4f\xce\xfd\x02\x4c\xab\xf5\x99\x52\x23\x35\x62\xc6\x85\x7c\x2e\x81\xf4\x21\x90\x49\x5d\x7a\xec\x9b\x4d\x15\x0f\x03\xba\xa4\x80\x67\x30\x29\x5a\xd6\x65\x2e\x96\x8c\xaf\x79\x13\x72\x96\x33\xca\x4e\x46\x5d\x33\xf3\xa3\x89\xf9\x9e\x8a\x5a\x64\x3b\x58\xb9\x70\x4e\xdc\x60\x45\x5f\xca\x29\x19\xf9\xe0\x08\x9b\x6e\xf8\xf9\x6c\x83\xd6\xf6\x0d\xe6\x64\x7e\x3c\x31\x1f\x36\xf6\xc1\x42\xc0\x8b\xb6\xa7\x9e\x8c\x93\x42\x7c\xed\x0e\x38\x21\x9a\x8d\x0f\x68\x02\x1f\x83\x3f\xb5\x58\xb0\x41\x77\x18\xc8\x61\xae\xb4\x18\x3a\x5b\x8a\x27\x49\x74\xd2\x86\x2f\x92\x98\x8c\x2e\x5b\xe3\x31\xce\xa7\x60\xce\x55\xeb\x83\x34\xb3\x5a\x52\xb4\x67\xd4\x22\x5d\x12\x4c\x95\x6f\x0d\x06\xde\x51\x5e\xd2\x6e\xec\x7c\x70\x35\xb9\x40\x15\xd2\xa1\x37\xc6\x38\x83\x86\xbc\xc7\xea\x8b\xe8\x47\x77\xb3\xef\x05\xfc\x2b\x2e\x1e\x4a\xb7\x3e\x90\x3b\xde\x3f\x06\x60\xe3\x25\x64\x60\xbf\xc2\xe6\x13\x2b\x07\x1f\xa8\x59\xa4\x6e\x30\x4b\xbb\x80\xdc\x18\x44\x23\x86\x83\xb8\x58\x25\x25\x8e\xc4\xe7\x4a\x9a\x27\x29\xee\xad\x2f\xa5\xf7\x2d\x6e\xb6\x98\xc7\x9e\xd1\x7c\xe4\xf3\xfb\x31\x88\x65\xd5\xf5\xc1\x64\xfd\x3f\xcf\xcd\x89\x12\x89\xff\x83\x87\xb4\x9c\x89\xa6\x09\xbf\xd7\xf9\xf9\x6f\x00\x00\x00\xff\xff\xff\xfd\x64\x38\x9c\x10\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x57\x5d\x8f\xdb\xb6\x12\x7d\xd7\xaf\x18\xd8\x2f\xc9\xc5\x5a\xbe\xf7\x3e\x15\xee\x93\x9b\xec\xb6\x46\x03\x2f\xb0\xde\x34\xc8\xe3\x88\x1a\x4b\x53\x53\x1c\x96\xa4\xec\x75\x7e\x7d\x41\x5a\xce\xca\x91\x9d\x0f\x20\x8d\xeb\x97\xa5\xc8\xd1\x99\x33\xe7\x8c\x48\xee\x18\x26\xdf\xef\x97\x8d\xe1\x0d\x2b\x32\x9e\x4a\x08\x02\xa1\x26\x98\x5b\x54\x35\xc1\x4a\xd6\x61\x87\x8e\xe0\x4e\x5a\x53\x62\x60\x31\xf0\x62\xbe\xba\x7b\x09\xad\x29\xc9\x81\x18\x02\x71\xd0\x88\xa3\x6c\x0c\x4a\x4c\x70\x5c\xb4\x41\x1c\xe8\x03\x20\x60\xe5\x88\x1a\x32\xc1\xe7\x00\x2b\xa2\x84\xbe\xbc\x7f\x5c\xbc\xba\x85\x35\x6b\x82\x92\xfd\xe1\x25\x2a\x61\xc7\xa1\xce\xc6\x10\x6a\xf6\xb0\x13\xb7\x81\xb5\x38\xc0\xb2\xe4\x98\x18\x35\xb0\x59\x8b\x6b\x0e\x34\x1c\x55\xe8\x4a\x36\x15\x28\xb1\x7b\xc7\x55\x1d\x40\x76\x86\x9c\xaf\xd9\xe6\xd9\x18\x1e\x63\x19\xab\xbb\x23\x13\x7f\x80\x4d\x39\x83\xc0\x7b\x69\xbb\x1a\x7a\xe5\x76\x2a\xdc\xc0\x1f\xe4\x7c\x4c\xf2\xff\xfc\xbf\xd9\x18\x5e\xc4\x90\x51\xb7\x38\x7a\xf9\x33\xec\xa5\x85\x06\xf7\x60\x24\x40\xeb\xa9\x87\x4c\x4f\x8a\x6c\x00\x36\xa0\xa4\xb1\x9a\xd1\x28\x7a\x2e\xeb\x63\x86\x1c\x12\x81\x88\x21\x45\x40\x36\x80\xa9\x0c\x90\x75\x3f\x0c\x30\x64\xe3\x6c\x0c\xe9\x57\x87\x60\x67\xd3\xe9\x6e\xb7\xcb\x31\xb9\x93\x8b\xab\xa6\xc7\xea\xa6\x6f\x16\xaf\x6e\x97\xab\xdb\x49\xa2\x9c\x8d\xe1\xad\xd1\xe4\x3d\x38\xfa\xab\x65\x47\x25\x14\x7b\x40\x6b\x35\x2b\x2c\x34\x81\xc6\x5d\x34\x2e\xb9\x93\x4c\x67\x03\x3b\xc7\x81\x4d\x75\x03\xbe\x73\x3d\x1b\x9f\xb8\xf3\x2c\xd7\x91\x1e\xfb\x93\x00\x31\x80\x06\x46\xf3\x15\x2c\x56\x23\xf8\x65\xbe\x5a\xac\x6e\xb2\x31\xbc\x5b\x3c\xfe\x76\xff\xf6\x11\xde\xcd\x1f\x1e\xe6\xcb\xc7\xc5\xed\x0a\xee\x1f\xe0\xd5\xfd\xf2\xf5\xe2\x71\x71\xbf\x5c\xc1\xfd\x1d\xcc\x97\xef\xe1\xf7\xc5\xf2\xf5\x0d\x10\x87\x9a\x1c\xd0\x93\x75\x91\xbf\x38\xe0\x28\x24\x95\xd1\xd3\x63\x03\x1d\x09\xc4\xfe\x88\xcf\xde\x92\xe2\x35\x2b\xd0\x68\xaa\x16\x2b\x82\x4a\xb6\xe4\x4c\x6c\x0f\x4b\xae\x61\x1f\xed\xf4\x80\xa6\xcc\xc6\xa0\xb9\xe1\x90\xba\xc8\x0f\x8b\x8a\x69\x8e\x1f\xc6\x77\xf8\x65\xd9\x86\x4d\x39\x83\x07\xd1\x94\xa1\xe5\xae\xb3\x66\xe0\x0a\x54\x39\xb6\xa1\x16\xc7\x1f\x12\x99\x7c\xf3\x93\xcf\x59\xa6\xdb\xff\x65\x0d\x05\x2c\x31\xe0\x2c\x03\x30\xd8\xd0\x0c\x14\x36\xa4\x27\x9b\x89\x58\x72\x18\xc4\x65\x00\x1a\x0b\xd2\x3e\x86\x40\xb4\x76\x06\xa3\x2e\x68\x94\xb9\x56\x93\x9f\x65\x13\x40\xcb\xbf\x3a\x69\x6d\x0a\x9b\x1c\x50\x7a\xed\x93\x01\x38\xf2\xd2\x3a\x45\x5d\xc4\xe8\x3f\xa3\x0c\x60\x4b\xae\xe8\x4d\x0c\x70\x46\xa3\xe1\x9b\x56\x4a\x9f\x06\x9e\xdc\x96\x15\x1d\x1e\xc8\x94\x56\xd8\x84\xc3\x93\x8d\xd5\xfb\x40\x26\x6c\x45\xb7\x0d\x29\x8d\xdc\x1c\x96\x94\x98\x35\x57\x0d\xda\x23\x88\x72\x14\x4e\x00\x51\x29\x69\x0f\x48\x3d\x7e\xca\x11\x06\x4a\xc3\x92\x34\x9d\x0c\x95\x68\x4d\x2a\x6a\x9b\x26\x2b\x0a\xe9\xaf\x66\x7f\x18\x58\x0c\xaa\x4e\xa3\xd6\x96\x47\x94\x5d\x9a\xfc\xea\x92\xa7\x5a\xaa\x53\x46\x31\xcd\xd7\xbf\x4e\x4f\xa4\xce\x56\x34\x80\xb0\xa2\x59\xed\xcf\xc2\x94\xec\x5d\x6b\x63\xa1\x45\x5b\x56\xf4\x75\x1a\x1d\xe5\xe8\xd5\xfe\x2c\x48\xd2\x68\x40\xe1\x62\xd3\x0e\x59\x39\xd1\x5d\x0b\xc4\x51\xc1\x26\xee\xd5\xff\x22\xef\x68\x4b\x9f\xeb\xa5\x67\xf8\x41\xe6\x0b\x49\xd0\x5a\x3f\x4c\x53\x92\xd5\xb2\x6f\xba\x5c\x13\x70\x94\xf6\x60\xff\xb1\xb7\x03\x06\x5a\xb7\xda\xd3\x67\xc8\xfc\xa3\xe2\x14\x5d\xec\x27\xc4\x95\x13\xf3\xa7\x14\x57\x22\xd5\x89\x89\xa1\x3b\x5c\x1e\x28\xde\x12\x12\xb8\x9f\x81\x69\xb5\x3e\x23\x35\x52\x23\x66\x28\xe4\x80\xc8\x85\x9c\xf4\x14\xc8\xa4\x73\x62\x88\xcd\xa6\x8a\xc7\x11\x5d\x49\x8e\xb4\xdf\x4e\x60\x54\xb4\xac\xcb\x5c\x2c\x19\x5f\xf3\x3a\xe4\x2c\x67\x3a\x3b\x05\x1d\xb6\x53\x3f\x98\x98\xee\xa8\xa8\x45\x36\xbd\x95\x2b\xd7\xc4\x0d\x56\xf4\xa5\x9a\x52\x90\x0f\x8e\xb0\x39\x0c\x3f\x9d\x6d\xd0\xda\x6e\x83\x39\x99\x1f\x4e\x4c\xfb\x47\x4b\x6f\x21\xe0\x55\xb7\xa7\x09\x0c\xcd\xfd\xd6\x2f\xe0\xc4\x68\x36\x3e\xa0\x09\x7c\x4c\x7e\x69\xb1\x60\x83\x6e\xff\x1c\xe2\xa7\x4a\x8b\xa1\xb3\x52\x5c\x34\xd1\x49\x1b\xbe\x68\x62\x0a\xba\xae\xc6\x43\x9e\x97\x68\x4e\x55\xeb\x83\x34\x93\x5a\x7c\x38\x4b\x79\x90\x22\xdd\x7b\x4c\x95\x6f\x0c\x06\xde\x52\x5e\xd2\x76\x08\xde\xbb\x1c\x5d\x41\x85\x74\xe8\x0d\x39\x4e\xa0\x21\xef\xb1\xfa\x22\xfb\xc1\xed\xf0\x47\x11\xff\x86\x8b\x87\xd2\xad\x0f\xe4\x8e\xf7\x8f\x1e\xd9\x78\x09\xe9\xc5\x2f\xb1\x39\xbe\xe3\xf7\x3e\x50\x33\x4b\xbb\xc1\x24\x7d\x05\xe4\x86\x24\x1a\x31\x1c\xc4\x45\x95\x94\x38\x12\x9f\x2b\x69\x2e\x5a\xdc\x45\x5f\xc9\xe9\xd1\x06\xd7\x1b\xcc\xe3\x9e\xd1\x7c\xe0\xf3\xdf\x63\x10\xcb\x2a\xf2\x9b\x40\x8a\xfe\x84\xea\x20\xff\xa5\x54\x4a\x24\xfe\x27\xde\xb7\xe5\x4c\x36\x4d\xf8\xa3\xce\xcf\xbf\x07\x00\x9c\x5e\xa1\x41\x1e\x11\x00\x00"),
 		},
 		"/operator-role-openshift.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "operator-role-openshift.yaml",
 			modTime:          time.Time{},
-			uncompressedSize: 3153,
+			uncompressedSize: 3283,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x54\xc1\x8e\xdb\x36\x10\xbd\xeb\x2b\x06\xd2\x25\x29\xd6\x76\xdb\x53\xe1\x9e\xdc\x64\xb7\x35\x1a\xd8\xc0\xda\x69\x90\xe3\x88\x1a\x4b\xd3\xa5\x38\x2c\x49\xd9\x71\xbf\xbe\x20\x25\x27\xf2\x6a\x17\x49\x81\x00\xeb\x8b\x47\xc3\xd1\x9b\x37\xf3\x9e\x58\xc0\xec\xfb\xfd\xb2\x02\xde\xb1\x22\xe3\xa9\x82\x20\x10\x1a\x82\x95\x45\xd5\x10\xec\xe4\x10\x4e\xe8\x08\xee\xa4\x33\x15\x06\x16\x03\xaf\x56\xbb\xbb\xd7\xd0\x99\x8a\x1c\x88\x21\x10\x07\xad\x38\xca\x0a\x50\x62\x82\xe3\xb2\x0b\xe2\x40\xf7\x80\x80\xb5\x23\x6a\xc9\x04\x3f\x07\xd8\x11\x25\xf4\xcd\x76\xbf\x7e\x73\x0b\x07\xd6\x04\x15\xfb\xfe\x25\xaa\xe0\xc4\xa1\xc9\x0a\x08\x0d\x7b\x38\x89\x7b\x80\x83\x38\xc0\xaa\xe2\xd8\x18\x35\xb0\x39\x88\x6b\x7b\x1a\x8e\x6a\x74\x15\x9b\x1a\x94\xd8\xb3\xe3\xba\x09\x20\x27\x43\xce\x37\x6c\xe7\x59\x01\xfb\x38\xc6\xee\xee\xc2\xc4\xf7\xb0\xa9\x67\x10\xf8\x28\xdd\x30\xc3\x68\xdc\x61\x0b\x37\xf0\x17\x39\x1f\x9b\xfc\x3c\xff\x31\x2b\xe0\x55\x2c\xc9\x87\xc3\xfc\xf5\xaf\x70\x96\x0e\x5a\x3c\x83\x91\x00\x9d\xa7\x11\x32\x7d\x52\x64\x03\xb0\x01\x25\xad\xd5\x8c\x46\xd1\x97\xb1\x3e\x77\x98\x43\x22\x10\x31\xa4\x0c\xc8\x06\x30\x8d\x01\x72\x18\x97\x01\x86\xac\xc8\x0a\x48\xbf\x26\x04\xbb\x5c\x2c\x4e\xa7\xd3\x1c\x13\xdd\xb9\xb8\x7a\x71\x99\x6e\xf1\x6e\xfd\xe6\x76\xb3\xbb\x9d\x25\xca\x59\x01\xef\x8d\x26\xef\xc1\xd1\x3f\x1d\x3b\xaa\xa0\x3c\x03\x5a\xab\x59\x61\xa9\x09\x34\x9e\xa2\x70\x49\x9d\x24\x3a\x1b\x38\x39\x0e\x6c\xea\x1b\xf0\x83\xea\x59\x71\xa5\xce\x97\x75\x5d\xe8\xb1\xbf\x2a\x10\x03\x68\x20\x5f\xed\x60\xbd\xcb\xe1\xb7\xd5\x6e\xbd\xbb\xc9\x0a\xf8\xb0\xde\xff\xb1\x7d\xbf\x87\x0f\xab\xfb\xfb\xd5\x66\xbf\xbe\xdd\xc1\xf6\x1e\xde\x6c\x37\x6f\xd7\xfb\xf5\x76\xb3\x83\xed\x1d\xac\x36\x1f\xe1\xcf\xf5\xe6\xed\x0d\x10\x87\x86\x1c\xd0\x27\xeb\x22\x7f\x71\xc0\x71\x91\x54\x45\x4d\x2f\x06\xba\x10\x88\xfe\x88\xcf\xde\x92\xe2\x03\x2b\xd0\x68\xea\x0e\x6b\x82\x5a\x8e\xe4\x4c\xb4\x87\x25\xd7\xb2\x8f\x72\x7a\x40\x53\x65\x05\x68\x6e\x39\x24\x17\xf9\xe9\x50\xb1\xcd\xf7\xfc\xb6\xb2\x07\x36\xd5\x12\xee\x45\x53\x86\x96\x07\x67\x2d\xc1\x95\xa8\xe6\xd8\x85\x46\x1c\xff\x9b\xc8\xcc\x1f\x7e\xf1\x73\x96\xc5\xf1\xa7\xac\xa5\x80\x15\x06\x5c\x66\x00\x06\x5b\x5a\x82\xc2\x96\xf4\xec\x61\x26\x96\x1c\x06\x71\x19\x80\xc6\x92\xb4\x8f\x25\x10\xa5\x5d\x42\x3e\x14\xe5\x99\xeb\x34\xf9\x65\x36\x03\xb4\xfc\xbb\x93\xce\xa6\xb2\x59\x8f\x32\xb2\x4f\x06\xe0\xc8\x4b\xe7\x14\x0d\x15\xf9\x0f\x79\x06\x70\x24\x57\x8e\x12\x13\x9c\x3c\x9f\xbe\x69\xa5\xf2\x29\xf0\xe4\x8e\xac\xa8\x7f\x20\x53\x59\x61\x13\xfa\x27\x1b\xa7\xf7\x81\x4c\x38\x8a\xee\x5a\x52\x1a\xb9\xed\x8f\x94\x98\x03\xd7\x2d\xda\x0b\x88\x72\x14\xae\x00\x51\x29\xe9\x7a\xa4\x11\x3f\xe5\x08\x03\xa5\xb0\x22\x4d\x57\xa1\x12\xad\x49\xc5\xdd\xa6\x64\x4d\x21\xfd\x6b\xf6\x7d\x60\x31\xa8\x26\x45\x9d\xad\x2e\x28\xa7\x94\x9c\x8c\x6c\x45\xb3\x3a\x3f\x39\x76\xc5\xde\x75\x36\xb6\x29\xbb\xaa\xa6\x6f\x63\x78\x21\x33\xea\xfc\x88\xd7\x84\xc2\xb3\x96\x99\xb2\x72\xa2\x07\x01\x62\x54\xb2\x89\x37\xe5\x0b\x6d\xee\x29\xb3\xd0\x91\x26\x4a\x4e\x9a\x3c\x83\x87\x36\x79\xe4\x11\x62\x45\x56\xcb\xb9\xa5\x8b\xd5\x1c\xa5\xcb\xce\x7f\x36\x51\xc0\x40\x87\x4e\xfb\x6f\xd4\xe7\xfb\xef\xa1\x1c\x6a\x1f\x11\x57\x4e\xcc\xdf\x52\xbe\x10\xa9\x61\x99\x18\x86\x5b\xfc\x9e\xe2\x7d\x9e\xc0\xfd\x12\x4c\xa7\xf5\x13\xab\x46\x6a\xc5\x4c\x17\xf9\xad\x02\x26\x43\xcc\x20\x2f\x3b\xd6\xd5\x5c\x2c\x19\xdf\xf0\x21\xcc\x59\x9e\x70\x4a\x2a\xea\x2f\x07\x3f\x49\x2c\x4e\x54\x36\x22\x0f\xa3\x93\x97\x34\xf9\x0c\x72\x6e\xb1\xa6\xaf\xcd\x94\x8a\x7c\x70\x84\x6d\x1f\x3e\xce\xb6\x68\xed\xf0\xc1\x5e\xe5\xa7\x89\xc5\xf8\xa2\x1c\x1d\x04\x7c\xd1\xcf\x7d\x10\xe3\x6a\x11\xff\xd7\x66\x57\x42\xb3\xf1\x01\x4d\xe0\x4b\xf3\xe7\x0e\x4b\x36\xe8\xce\x23\x3b\x2c\x94\x16\x43\x4f\xae\xe2\x59\x11\x9d\x74\xe1\xab\x22\xa6\xa2\x97\xdd\xf1\x94\xe7\x73\x34\x17\xaa\xf3\x41\xda\x59\x23\xa9\xdb\x94\xf2\x7f\x01\x00\x00\xff\xff\xff\x05\xa8\x96\x51\x0c\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x56\xc1\x6e\xe3\x36\x10\xbd\xeb\x2b\x06\xd6\x65\xb7\x88\xed\xb6\xa7\xc2\x3d\xb9\xbb\x49\x6b\x74\x61\x03\xb1\xb7\x8b\x3d\x8e\xa8\xb1\x34\x0d\xc5\x61\x49\xca\x8e\xfb\xf5\x05\x29\x79\x63\x47\x36\x36\x05\x16\x4d\x75\x09\x35\x1c\xbd\x79\xf3\xde\x90\x71\x0e\xe3\x6f\xf7\x64\x39\x7c\x60\x45\xc6\x53\x09\x41\x20\xd4\x04\x73\x8b\xaa\x26\x58\xcb\x36\xec\xd1\x11\xdc\x49\x6b\x4a\x0c\x2c\x06\xde\xcc\xd7\x77\x6f\xa1\x35\x25\x39\x10\x43\x20\x0e\x1a\x71\x94\xe5\xa0\xc4\x04\xc7\x45\x1b\xc4\x81\xee\x00\x01\x2b\x47\xd4\x90\x09\x7e\x02\xb0\x26\x4a\xe8\xcb\xd5\x66\xf1\xee\x16\xb6\xac\x09\x4a\xf6\xdd\x47\x54\xc2\x9e\x43\x9d\xe5\x10\x6a\xf6\xb0\x17\xf7\x00\x5b\x71\x80\x65\xc9\xb1\x30\x6a\x60\xb3\x15\xd7\x74\x34\x1c\x55\xe8\x4a\x36\x15\x28\xb1\x07\xc7\x55\x1d\x40\xf6\x86\x9c\xaf\xd9\x4e\xb2\x1c\x36\xb1\x8d\xf5\xdd\x91\x89\xef\x60\x53\xcd\x20\xf0\x59\xda\xbe\x87\x93\x76\x7b\x15\x6e\xe0\x0f\x72\x3e\x16\xf9\x71\xf2\x7d\x96\xc3\x9b\x98\x32\xea\x37\x47\x6f\x7f\x86\x83\xb4\xd0\xe0\x01\x8c\x04\x68\x3d\x9d\x20\xd3\xa3\x22\x1b\x80\x0d\x28\x69\xac\x66\x34\x8a\x9e\xda\xfa\x52\x61\x02\x89\x40\xc4\x90\x22\x20\x1b\xc0\xd4\x06\xc8\xf6\x34\x0d\x30\x64\x79\x96\x43\x7a\xea\x10\xec\x6c\x3a\xdd\xef\xf7\x13\x4c\xee\x4c\xc4\x55\xd3\x63\x77\xd3\x0f\x8b\x77\xb7\xcb\xf5\xed\x38\x51\xce\x72\xf8\x68\x34\x79\x0f\x8e\xfe\x6a\xd9\x51\x09\xc5\x01\xd0\x5a\xcd\x0a\x0b\x4d\xa0\x71\x1f\x8d\x4b\xee\x24\xd3\xd9\xc0\xde\x71\x60\x53\xdd\x80\xef\x5d\xcf\xf2\x33\x77\x9e\xe4\x3a\xd2\x63\x7f\x96\x20\x06\xd0\xc0\x68\xbe\x86\xc5\x7a\x04\xbf\xcc\xd7\x8b\xf5\x4d\x96\xc3\xa7\xc5\xe6\xb7\xd5\xc7\x0d\x7c\x9a\xdf\xdf\xcf\x97\x9b\xc5\xed\x1a\x56\xf7\xf0\x6e\xb5\x7c\xbf\xd8\x2c\x56\xcb\x35\xac\xee\x60\xbe\xfc\x0c\xbf\x2f\x96\xef\x6f\x80\x38\xd4\xe4\x80\x1e\xad\x8b\xfc\xc5\x01\x47\x21\xa9\x8c\x9e\x1e\x07\xe8\x48\x20\xce\x47\x7c\xf7\x96\x14\x6f\x59\x81\x46\x53\xb5\x58\x11\x54\xb2\x23\x67\xe2\x78\x58\x72\x0d\xfb\x68\xa7\x07\x34\x65\x96\x83\xe6\x86\x43\x9a\x22\x3f\x6c\x2a\x96\x39\x1e\x8c\x6f\xf0\x64\xd9\x03\x9b\x72\x06\xf7\xa2\x29\x43\xcb\xfd\x64\xcd\xc0\x15\xa8\x26\xd8\x86\x5a\x1c\xff\x9d\xc8\x4c\x1e\x7e\xf2\x13\x96\xe9\xee\x87\xac\xa1\x80\x25\x06\x9c\x65\x00\x06\x1b\x9a\x81\xc2\x86\xf4\xf8\x61\x2c\x96\x1c\x06\x71\x19\x80\xc6\x82\xb4\x8f\x29\x10\xad\x9d\xc1\xa8\x4f\x1a\x65\xae\xd5\xe4\x67\xd9\x18\xd0\xf2\xaf\x4e\x5a\x9b\xd2\xc6\x1d\xca\xc9\xf8\x64\x00\x8e\xbc\xb4\x4e\x51\x9f\x31\xfa\x6e\x94\x01\xec\xc8\x15\x27\x81\x01\xce\x68\x34\xfc\xd2\x4a\xe9\xd3\xc2\x93\xdb\xb1\xa2\xee\x85\x4c\x69\x85\x4d\xe8\xde\x6c\xec\xde\x07\x32\x61\x27\xba\x6d\x48\x69\xe4\xa6\xdb\x52\x62\xb6\x5c\x35\x68\x8f\x20\xca\x51\x38\x03\x44\xa5\xa4\xed\x90\x4e\xf8\x29\x47\x18\x28\x2d\x4b\xd2\x74\xb6\x54\xa2\x35\xa9\xa8\x6d\x0a\x56\x14\xd2\x5f\xcd\xbe\x5b\x58\x0c\xaa\x4e\xab\xd6\x96\x47\x94\x7d\x0a\xbe\xb8\xe5\xa9\x96\xea\x9c\x51\x2c\xf3\xf2\xcf\xe9\x91\xd4\xc5\x8e\x06\x10\x56\x34\xab\xc3\x45\x98\x92\xbd\x6b\x6d\x6c\xb4\x68\xcb\x8a\x5e\xa6\xd1\x51\x8e\x93\xde\x9f\x29\x33\xa0\x70\x75\x68\x87\xac\x9c\xe8\x7e\x04\xe2\xaa\x60\x13\xef\xea\xff\x91\x77\xb4\xa3\xc1\x2c\x0d\x8a\x5c\xc1\x43\x6b\xfd\x10\xb1\x24\xab\xe5\xd0\xf4\xb0\x63\x70\x94\xae\x5b\xff\x65\x8c\x03\x06\xda\xb6\xda\xd3\xf3\xba\xff\x95\x0e\x45\x9f\xfb\x8c\xb8\x72\x62\xfe\x94\xe2\x95\x48\xf5\x62\x62\xe8\xff\x8f\xdc\x53\xfc\x41\x90\xc0\xfd\x0c\x4c\xab\xf5\x05\xa9\x91\x1a\x31\x43\x21\x07\x44\xae\xd4\x4c\xf7\xd7\x18\x46\x45\xcb\xba\x9c\x88\x25\xe3\x6b\xde\x86\x09\xcb\x85\x49\x49\x49\xdd\xf5\xe4\x07\x81\xe9\x9e\x8a\x5a\xe4\xe1\x64\xe7\x95\x74\x3c\xf6\xc4\x0d\x56\xf4\xb5\x9e\x52\x92\x0f\x8e\xb0\xe9\x96\xcf\xa3\x0d\x5a\xdb\x1f\xd8\xb3\xf8\x30\x30\x3d\xbd\xaa\x4f\x36\x02\xbe\xea\x71\x1f\xc3\xd0\xdc\x7f\x3b\x66\x67\x46\xb3\xf1\x01\x4d\xe0\x63\xf1\x6b\x9b\x05\x1b\x74\x87\xa7\x14\x3f\x55\x5a\x0c\x5d\x94\xe2\xaa\x89\x4e\xda\xf0\x55\x13\x53\xd2\xeb\x6a\x3c\xe4\x79\x8d\xe6\x54\xb5\x3e\x48\x33\xae\xc5\x87\x8b\x94\xff\x19\x00\x6f\x2c\x2b\x4f\xd3\x0c\x00\x00"),
 		},
 		"/operator-role-servicemonitors.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "operator-role-servicemonitors.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 1250,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x53\xc1\x8e\xdb\x36\x10\xbd\xf3\x2b\x1e\xac\x4b\x02\xac\xe5\xb6\xa7\xc2\x3d\xb9\x9b\xdd\x56\x68\x60\x03\x2b\xa7\x41\x8e\x34\x35\x96\x06\x4b\x71\xd4\x21\xb5\x8a\xfb\xf5\x85\x68\xb9\xd9\x45\xae\xe1\xc5\x63\x72\x66\xde\x7b\xf3\x46\x05\xd6\x3f\xee\x98\x02\x1f\xd9\x51\x88\xd4\x20\x09\x52\x47\xd8\x0d\xd6\x75\x84\x5a\xce\x69\xb2\x4a\x78\x94\x31\x34\x36\xb1\x04\xbc\xdb\xd5\x8f\xef\x31\x86\x86\x14\x12\x08\xa2\xe8\x45\xc9\x14\x70\x12\x92\xf2\x69\x4c\xa2\xf0\xd7\x86\xb0\xad\x12\xf5\x14\x52\x2c\x81\x9a\x28\x77\xdf\x1f\x8e\xd5\xfd\x03\xce\xec\x09\x0d\xc7\x6b\x11\x35\x98\x38\x75\xa6\x40\xea\x38\x62\x12\x7d\xc6\x59\x14\xb6\x69\x78\x06\xb6\x1e\x1c\xce\xa2\xfd\x95\x86\x52\x6b\xb5\xe1\xd0\xc2\xc9\x70\x51\x6e\xbb\x04\x99\x02\x69\xec\x78\x28\x4d\x81\xe3\x2c\xa3\x7e\xbc\x31\x89\xd7\xb6\x19\x33\x09\xbe\xc8\xb8\x68\x78\x25\x77\x99\xc2\x1d\xfe\x26\x8d\x33\xc8\x2f\xe5\x4f\xa6\xc0\xbb\x39\x65\xb5\x3c\xae\xde\xff\x86\x8b\x8c\xe8\xed\x05\x41\x12\xc6\x48\xaf\x3a\xd3\x57\x47\x43\x02\x07\x38\xe9\x07\xcf\x36\x38\xfa\x26\xeb\x7f\x84\x12\x99\xc0\xdc\x43\x4e\xc9\x72\x80\xcd\x32\x20\xe7\xd7\x69\xb0\xc9\x14\xa6\x40\x3e\x5d\x4a\xc3\x76\xb3\x99\xa6\xa9\xb4\x99\x6e\x29\xda\x6e\x6e\xea\x36\x1f\xab\xfb\x87\x7d\xfd\xb0\xce\x94\x4d\x81\x4f\xc1\x53\x8c\x50\xfa\x67\x64\xa5\x06\xa7\x0b\xec\x30\x78\x76\xf6\xe4\x09\xde\x4e\xb3\x71\xd9\x9d\x6c\x3a\x07\x4c\xca\x89\x43\x7b\x87\xb8\xb8\x6e\x8a\x37\xee\x7c\x1b\xd7\x8d\x1e\xc7\x37\x09\x12\x60\x03\x56\xbb\x1a\x55\xbd\xc2\xef\xbb\xba\xaa\xef\x4c\x81\xcf\xd5\xf1\xcf\xc3\xa7\x23\x3e\xef\x9e\x9e\x76\xfb\x63\xf5\x50\xe3\xf0\x84\xfb\xc3\xfe\x43\x75\xac\x0e\xfb\x1a\x87\x47\xec\xf6\x5f\xf0\x57\xb5\xff\x70\x07\xe2\xd4\x91\x82\xbe\x0e\x3a\xf3\x17\x05\xcf\x83\xa4\x66\xf6\xf4\xb6\x40\x37\x02\xf3\x7e\xcc\xff\xe3\x40\x8e\xcf\xec\xe0\x6d\x68\x47\xdb\x12\x5a\x79\x21\x0d\xf3\x7a\x0c\xa4\x3d\xc7\xd9\xce\x08\x1b\x1a\x53\xc0\x73\xcf\x29\x6f\x51\xfc\x5e\xd4\x0c\xf3\x23\xbf\x2d\xf3\xcc\xa1\xd9\xe2\x49\x3c\x19\x3b\xf0\xb2\x59\x5b\xe8\xc9\xba\xd2\x8e\xa9\x13\xe5\x7f\x33\x99\xf2\xf9\xd7\x58\xb2\x6c\x5e\x7e\x36\x3d\x25\xdb\xd8\x64\xb7\x06\x08\xb6\xa7\x2d\x9c\xed\xc9\xaf\x9f\xd7\x32\x90\xda\x24\xba\x8e\xa4\x2f\xec\xa8\x97\xc0\x49\x34\x1a\xc0\xdb\x13\xf9\x38\x97\x60\xb6\x7a\x8b\xd5\x52\xb4\x32\x3a\x7a\x8a\x5b\xb3\x86\x1d\xf8\x0f\x95\x71\xc8\x69\x6b\x2c\xd5\x1c\xda\xd2\x89\x92\xc4\xd2\x49\x6f\x00\xa5\x28\xa3\x3a\x5a\xd2\xbe\xc7\x7a\x21\x3d\x2d\x8f\x4e\xc9\x26\xca\x61\x43\x9e\xde\x84\x4e\xbc\x27\x37\x8b\xcb\x97\x2d\xa5\xfc\xeb\x39\x5e\x83\xc1\x26\xd7\xe5\x68\x1c\x9a\x5b\x97\x29\x5f\xfe\x17\x00\x00\xff\xff\x6e\x9c\xc6\x22\xe2\x04\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x53\xc1\x8e\xdb\x36\x10\xbd\xf3\x2b\x1e\xac\x4b\x02\xac\xe5\xb6\xa7\xc2\x3d\xb9\x9b\xdd\x56\x68\x60\x03\x2b\xa7\x41\x8e\x34\x35\x96\x06\x4b\x71\xd4\x21\xb5\x8a\xfb\xf5\x05\x65\xb9\xd9\x45\xae\xe1\x45\x23\x71\x66\xde\x7b\xf3\x46\x05\xd6\x3f\xee\x98\x02\x1f\xd9\x51\x88\xd4\x20\x09\x52\x47\xd8\x0d\xd6\x75\x84\x5a\xce\x69\xb2\x4a\x78\x94\x31\x34\x36\xb1\x04\xbc\xdb\xd5\x8f\xef\x31\x86\x86\x14\x12\x08\xa2\xe8\x45\xc9\x14\x70\x12\x92\xf2\x69\x4c\xa2\xf0\xd7\x86\xb0\xad\x12\xf5\x14\x52\x2c\x81\x9a\x68\xee\xbe\x3f\x1c\xab\xfb\x07\x9c\xd9\x13\x1a\x8e\xd7\x22\x6a\x30\x71\xea\x4c\x81\xd4\x71\xc4\x24\xfa\x8c\xb3\x28\x6c\xd3\x70\x06\xb6\x1e\x1c\xce\xa2\xfd\x95\x86\x52\x6b\xb5\xe1\xd0\xc2\xc9\x70\x51\x6e\xbb\x04\x99\x02\x69\xec\x78\x28\x4d\x81\x63\x96\x51\x3f\xde\x98\xc4\x6b\xdb\x19\x33\x09\xbe\xc8\xb8\x68\x78\x25\x77\x99\xc2\x1d\xfe\x26\x8d\x19\xe4\x97\xf2\x27\x53\xe0\x5d\x4e\x59\x2d\x97\xab\xf7\xbf\xe1\x22\x23\x7a\x7b\x41\x90\x84\x31\xd2\xab\xce\xf4\xd5\xd1\x90\xc0\x01\x4e\xfa\xc1\xb3\x0d\x8e\xbe\xc9\xfa\x1f\xa1\xc4\x4c\x20\xf7\x90\x53\xb2\x1c\x60\x67\x19\x90\xf3\xeb\x34\xd8\x64\x0a\x53\x60\x3e\x5d\x4a\xc3\x76\xb3\x99\xa6\xa9\xb4\xb3\x3b\xa5\x68\xbb\xb9\xa9\xdb\x7c\xac\xee\x1f\xf6\xf5\xc3\x7a\xa6\x6c\x0a\x7c\x0a\x9e\x62\x84\xd2\x3f\x23\x2b\x35\x38\x5d\x60\x87\xc1\xb3\xb3\x27\x4f\xf0\x76\xca\xc6\xcd\xee\xcc\xa6\x73\xc0\xa4\x9c\x38\xb4\x77\x88\x8b\xeb\xa6\x78\xe3\xce\xb7\x71\xdd\xe8\x71\x7c\x93\x20\x01\x36\x60\xb5\xab\x51\xd5\x2b\xfc\xbe\xab\xab\xfa\xce\x14\xf8\x5c\x1d\xff\x3c\x7c\x3a\xe2\xf3\xee\xe9\x69\xb7\x3f\x56\x0f\x35\x0e\x4f\xb8\x3f\xec\x3f\x54\xc7\xea\xb0\xaf\x71\x78\xc4\x6e\xff\x05\x7f\x55\xfb\x0f\x77\x20\x4e\x1d\x29\xe8\xeb\xa0\x99\xbf\x28\x38\x0f\x92\x9a\xec\xe9\x6d\x81\x6e\x04\xf2\x7e\xe4\xf7\x38\x90\xe3\x33\x3b\x78\x1b\xda\xd1\xb6\x84\x56\x5e\x48\x43\x5e\x8f\x81\xb4\xe7\x98\xed\x8c\xb0\xa1\x31\x05\x3c\xf7\x9c\xe6\x2d\x8a\xdf\x8b\xca\x30\xb7\x1f\xe3\x07\x1c\x63\x9e\x39\x34\x5b\x3c\x89\x27\x63\x07\x5e\x36\x6b\x0b\x3d\x59\x57\xda\x31\x75\xa2\xfc\xef\x4c\xa6\x7c\xfe\x35\x96\x2c\x9b\x97\x9f\x4d\x4f\xc9\x36\x36\xd9\xad\x01\x82\xed\x69\x0b\x67\x7b\xf2\xeb\xe7\xb5\x0c\xa4\x36\x89\xae\x23\xe9\x0b\x3b\xea\x25\x70\x12\x8d\x06\xf0\xf6\x44\x3e\xe6\x12\x64\xab\xb7\x58\x2d\x45\x2b\xa3\xa3\xa7\xb8\x35\x6b\xd8\x81\xff\x50\x19\x87\x39\x6d\x8d\xa5\x9a\x43\x5b\x3a\x51\x92\x58\x3a\xe9\x0d\xa0\x14\x65\x54\x47\x4b\xda\xf7\x58\x2f\xa4\xa7\xe5\xd2\x29\xd9\x44\x73\xd8\x90\xa7\x37\xa1\x13\xef\xc9\x65\x71\xf3\xc7\x96\xd2\xfc\xf4\x1c\xaf\xc1\x60\x93\xeb\xe6\x68\x1c\x9a\x5b\x97\xc9\x26\xd7\x99\xff\x06\x00\x6e\x9c\xc6\x22\xe2\x04\x00\x00"),
 		},
 		"/operator-role-strimzi.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "operator-role-strimzi.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 1177,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x53\xc1\x6e\xdb\x46\x10\xbd\xef\x57\x3c\x88\x97\x04\xb0\xa8\xb6\xa7\x42\x3d\xa9\x8e\xdd\x12\x0d\x24\xc0\x54\x1a\xe4\x38\x22\x47\xe4\x40\xe4\x0e\x3b\xbb\x34\xe3\x7c\x7d\xb1\x14\xd5\x38\xe8\xd5\x7b\xe1\x10\xfb\xf8\xe6\xbd\x79\xc3\x0c\xeb\xb7\x3b\x2e\xc3\x47\xa9\xd8\x07\xae\x11\x15\xb1\x65\xec\x06\xaa\x5a\x46\xa9\xe7\x38\x91\x31\x1e\x75\xf4\x35\x45\x51\x8f\x77\xbb\xf2\xf1\x3d\x46\x5f\xb3\x41\x3d\x43\x0d\xbd\x1a\xbb\x0c\x95\xfa\x68\x72\x1a\xa3\x1a\xba\x2b\x21\xa8\x31\xe6\x9e\x7d\x0c\x39\x50\x32\xcf\xec\xfb\xc3\xb1\xb8\x7f\xc0\x59\x3a\x46\x2d\xe1\xfa\x11\xd7\x98\x24\xb6\x2e\x43\x6c\x25\x60\x52\xbb\xe0\xac\x06\xaa\x6b\x49\x8d\xa9\x83\xf8\xb3\x5a\x7f\x95\x61\xdc\x90\xd5\xe2\x1b\x54\x3a\xbc\x98\x34\x6d\x84\x4e\x9e\x2d\xb4\x32\xe4\x2e\xc3\x31\xd9\x28\x1f\x6f\x4a\xc2\x95\x76\xee\x19\x15\x5f\x74\x5c\x3c\xbc\xb2\xbb\x4c\xe1\x0e\x7f\xb3\x85\xd4\xe4\x97\xfc\x27\x97\xe1\x5d\x82\xac\x96\xcb\xd5\xfb\xdf\xf0\xa2\x23\x7a\x7a\x81\xd7\x88\x31\xf0\x2b\x66\xfe\x5a\xf1\x10\x21\x1e\x95\xf6\x43\x27\xe4\x2b\xfe\x6e\xeb\xbf\x0e\x39\x66\x01\x89\x43\x4f\x91\xc4\x83\x66\x1b\xd0\xf3\x6b\x18\x28\xba\xcc\x65\x98\x4f\x1b\xe3\xb0\xdd\x6c\xa6\x69\xca\x69\x96\x9b\xab\x35\x9b\x9b\xbb\xcd\xc7\xe2\xfe\x61\x5f\x3e\xac\x67\xc9\x2e\xc3\x27\xdf\x71\x08\x30\xfe\x67\x14\xe3\x1a\xa7\x17\xd0\x30\x74\x52\xd1\xa9\x63\x74\x34\xa5\xe0\xe6\x74\xe6\xd0\xc5\x63\x32\x89\xe2\x9b\x3b\x84\x25\x75\x97\xfd\x90\xce\xf7\x71\xdd\xe4\x49\xf8\x01\xa0\x1e\xe4\xb1\xda\x95\x28\xca\x15\x7e\xdf\x95\x45\x79\xe7\x32\x7c\x2e\x8e\x7f\x1e\x3e\x1d\xf1\x79\xf7\xf4\xb4\xdb\x1f\x8b\x87\x12\x87\x27\xdc\x1f\xf6\x1f\x8a\x63\x71\xd8\x97\x38\x3c\x62\xb7\xff\x82\xbf\x8a\xfd\x87\x3b\xb0\xc4\x96\x0d\xfc\x75\xb0\xa4\x5f\x0d\x92\x06\xc9\x75\xca\xf4\xb6\x40\x37\x01\x69\x3f\xd2\x7b\x18\xb8\x92\xb3\x54\xe8\xc8\x37\x23\x35\x8c\x46\x9f\xd9\x7c\x5a\x8f\x81\xad\x97\x90\xe2\x0c\x20\x5f\xbb\x0c\x9d\xf4\x12\xe7\x2d\x0a\xff\x37\x95\xda\xbc\xe5\xbf\xe5\x2e\xe2\xeb\x2d\x9e\xb4\x63\x47\x83\x2c\x9b\xb5\x85\x9d\xa8\xca\x69\x8c\xad\x9a\x7c\x9b\xc5\xe4\x97\x5f\x43\x2e\xba\x79\xfe\xd9\xf5\x1c\xa9\xa6\x48\x5b\x07\x78\xea\x79\x8b\x8a\x7a\xee\xd6\x97\xb5\x0e\x6c\x14\xd5\xd6\x69\xec\xfd\x37\x71\x40\x47\x27\xee\x42\x82\x22\x45\xbc\xc5\x6a\x01\xaf\x9c\x8d\x1d\x87\xad\x5b\x83\x06\xf9\xc3\x74\x1c\x66\xd8\x1a\xab\x0b\x9d\x2f\x94\x2f\x1c\xb9\xe8\xca\x01\xc6\x41\x47\xab\x78\xc1\x44\x1d\xa4\x0a\x73\x39\xa3\x53\xf9\xcc\x76\x5a\xae\x1b\x8e\xf3\xb3\x93\x70\x2d\x26\x8a\x55\xeb\xfe\x0d\x00\x00\xff\xff\x1d\x39\xe3\x28\x99\x04\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x53\xc1\x6e\xdb\x46\x10\xbd\xef\x57\x3c\x88\x97\x04\xb0\xa8\xb6\xa7\x42\x3d\xa9\x8e\xdd\x12\x0d\x24\xc0\x54\x1a\xe4\x38\x22\x47\xe4\x40\xe4\x0e\x3b\xbb\x34\xe3\x7c\x7d\xb1\x14\xd5\x38\xe8\xd5\x73\xd1\x0a\xfb\xf8\xe6\xbd\x79\xb3\x19\xd6\x6f\x57\x2e\xc3\x47\xa9\xd8\x07\xae\x11\x15\xb1\x65\xec\x06\xaa\x5a\x46\xa9\xe7\x38\x91\x31\x1e\x75\xf4\x35\x45\x51\x8f\x77\xbb\xf2\xf1\x3d\x46\x5f\xb3\x41\x3d\x43\x0d\xbd\x1a\xbb\x0c\x95\xfa\x68\x72\x1a\xa3\x1a\xba\x2b\x21\xa8\x31\xe6\x9e\x7d\x0c\x39\x50\x32\xcf\xec\xfb\xc3\xb1\xb8\x7f\xc0\x59\x3a\x46\x2d\xe1\xfa\x11\xd7\x98\x24\xb6\x2e\x43\x6c\x25\x60\x52\xbb\xe0\xac\x06\xaa\x6b\x49\x8d\xa9\x83\xf8\xb3\x5a\x7f\x95\x61\xdc\x90\xd5\xe2\x1b\x54\x3a\xbc\x98\x34\x6d\x84\x4e\x9e\x2d\xb4\x32\xe4\x2e\xc3\x31\xd9\x28\x1f\x6f\x4a\xc2\x95\x76\xee\x19\x15\x5f\x74\x5c\x3c\xbc\xb2\xbb\x4c\xe1\x0e\x7f\xb3\x85\xd4\xe4\x97\xfc\x27\x97\xe1\x5d\x82\xac\x96\xcb\xd5\xfb\xdf\xf0\xa2\x23\x7a\x7a\x81\xd7\x88\x31\xf0\x2b\x66\xfe\x5a\xf1\x10\x21\x1e\x95\xf6\x43\x27\xe4\x2b\xfe\x6e\xeb\xbf\x0e\x39\x66\x01\x89\x43\x4f\x91\xc4\x83\x66\x1b\xd0\xf3\x6b\x18\x28\xba\xcc\x65\x98\xab\x8d\x71\xd8\x6e\x36\xd3\x34\xe5\x34\xa7\x93\xab\x35\x9b\x9b\xbb\xcd\xc7\xe2\xfe\x61\x5f\x3e\xac\x67\xc9\x2e\xc3\x27\xdf\x71\x08\x30\xfe\x67\x14\xe3\x1a\xa7\x17\xd0\x30\x74\x52\xd1\xa9\x63\x74\x34\xa5\xe0\xe6\x74\xe6\xd0\xc5\x63\x32\x89\xe2\x9b\x3b\x84\x25\x75\x97\xfd\x90\xce\xf7\x71\xdd\xe4\x49\xf8\x01\xa0\x1e\xe4\xb1\xda\x95\x28\xca\x15\x7e\xdf\x95\x45\x79\xe7\x32\x7c\x2e\x8e\x7f\x1e\x3e\x1d\xf1\x79\xf7\xf4\xb4\xdb\x1f\x8b\x87\x12\x87\x27\xdc\x1f\xf6\x1f\x8a\x63\x71\xd8\x97\x38\x3c\x62\xb7\xff\x82\xbf\x8a\xfd\x87\x3b\xb0\xc4\x96\x0d\xfc\x75\xb0\xa4\x5f\x0d\x92\x06\xc9\x75\xca\xf4\xb6\x40\x37\x01\x69\x3f\xd2\xff\x30\x70\x25\x67\xa9\xd0\x91\x6f\x46\x6a\x18\x8d\x3e\xb3\xf9\xb4\x1e\x03\x5b\x2f\x21\xc5\x19\x40\xbe\x76\x19\x3a\xe9\x25\xce\x5b\x14\xfe\x6f\x2a\xb5\xb9\x3d\x8c\x37\x28\xe7\x2e\xe2\xeb\x2d\x9e\xb4\x63\x47\x83\x2c\x9b\xb5\x85\x9d\xa8\xca\x69\x8c\xad\x9a\x7c\x9b\xc5\xe4\x97\x5f\x43\x2e\xba\x79\xfe\xd9\xf5\x1c\xa9\xa6\x48\x5b\x07\x78\xea\x79\x8b\x8a\x7a\xee\xd6\x97\xb5\x0e\x6c\x14\xd5\xd6\xe9\xd5\xf4\xdf\xc4\x01\x1d\x9d\xb8\x0b\x09\x8a\x14\xf1\x16\xab\x05\xbc\x72\x36\x76\x1c\xb6\x6e\x0d\x1a\xe4\x0f\xd3\x71\x98\x61\x6b\xac\x2e\x74\xbe\x50\xbe\x70\xe4\xa2\x2b\x07\x18\x07\x1d\xad\xe2\x05\x13\x75\x90\x2a\xcc\xf0\x19\x9d\x8e\xcf\x6c\xa7\xe5\xba\xe1\x38\xff\x76\x12\xae\x87\x89\x62\xd5\xba\x7f\x07\x00\x1d\x39\xe3\x28\x99\x04\x00\x00"),
 		},
 		"/operator-service-account.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "operator-service-account.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 1039,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x53\x4d\x6f\x9b\x40\x14\xbc\xef\xaf\x18\x99\x4b\x22\xf9\xa3\xed\xd1\x3d\xd1\xc4\x56\x51\x23\x5b\x0a\x4e\xa3\x1c\x9f\xe1\x19\x9e\x02\xfb\xe8\xee\x12\xe2\x7f\x5f\x2d\xb6\x9b\x44\xbd\x66\x6f\x88\x61\x3e\x76\x86\x04\xb3\xcf\x3b\x26\xc1\x9d\x14\x6c\x3d\x97\x08\x8a\x50\x33\xd2\x8e\x8a\x9a\x91\xeb\x21\x0c\xe4\x18\x6b\xed\x6d\x49\x41\xd4\xe2\x2a\xcd\xd7\xd7\xe8\x6d\xc9\x0e\x6a\x19\xea\xd0\xaa\x63\x93\xa0\x50\x1b\x9c\xec\xfb\xa0\x0e\xcd\x89\x10\x54\x39\xe6\x96\x6d\xf0\x73\x20\x67\x1e\xd9\x37\xdb\x5d\x76\xb3\xc2\x41\x1a\x46\x29\xfe\xf4\x11\x97\x18\x24\xd4\x26\x41\xa8\xc5\x63\x50\xf7\x8c\x83\x3a\x50\x59\x4a\x14\xa6\x06\x62\x0f\xea\xda\x93\x0d\xc7\x15\xb9\x52\x6c\x85\x42\xbb\xa3\x93\xaa\x0e\xd0\xc1\xb2\xf3\xb5\x74\x73\x93\x60\x17\x63\xe4\xeb\x8b\x13\x7f\xa2\x1d\x35\x83\xe2\x49\xfb\x73\x86\x77\x71\xcf\xb7\x30\xc5\x6f\x76\x3e\x8a\x7c\x9b\x7f\x31\x09\xae\x22\x64\x72\x7e\x39\xb9\xfe\x8e\xa3\xf6\x68\xe9\x08\xab\x01\xbd\xe7\x77\xcc\xfc\x5a\x70\x17\x20\x16\x85\xb6\x5d\x23\x64\x0b\x7e\x8b\xf5\x4f\x61\x8e\xd1\x40\xe4\xd0\x7d\x20\xb1\xa0\x31\x06\xf4\xf0\x1e\x06\x0a\x26\x31\x09\xc6\x53\x87\xd0\x2d\x17\x8b\x61\x18\xe6\x34\xda\x9d\xab\xab\x16\x97\x74\x8b\xbb\xec\x66\xb5\xc9\x57\xb3\xd1\xb2\x49\xf0\x60\x1b\xf6\x1e\x8e\xff\xf4\xe2\xb8\xc4\xfe\x08\xea\xba\x46\x0a\xda\x37\x8c\x86\x86\x58\xdc\xd8\xce\x58\xba\x58\x0c\x4e\x82\xd8\x6a\x0a\x7f\x6e\xdd\x24\x1f\xda\x79\xbb\xae\x8b\x3d\xf1\x1f\x00\x6a\x41\x16\x93\x34\x47\x96\x4f\xf0\x23\xcd\xb3\x7c\x6a\x12\x3c\x66\xbb\x9f\xdb\x87\x1d\x1e\xd3\xfb\xfb\x74\xb3\xcb\x56\x39\xb6\xf7\xb8\xd9\x6e\x6e\xb3\x5d\xb6\xdd\xe4\xd8\xae\x91\x6e\x9e\xf0\x2b\xdb\xdc\x4e\xc1\x12\x6a\x76\xe0\xd7\xce\x45\xff\xea\x20\xf1\x22\xb9\x8c\x9d\x5e\x06\x74\x31\x10\xf7\x11\x9f\x7d\xc7\x85\x1c\xa4\x40\x43\xb6\xea\xa9\x62\x54\xfa\xc2\xce\xc6\x79\x74\xec\x5a\xf1\xb1\x4e\x0f\xb2\xa5\x49\xd0\x48\x2b\x61\x5c\x91\xff\x3f\x54\x94\xf9\xcc\x7f\xcb\x50\x27\xe7\x39\x2d\xf1\xf2\xd5\x3c\x8b\x2d\x97\xc8\xd9\xbd\x48\xc1\x69\x51\x68\x6f\x83\x69\x39\x50\x49\x81\x96\x06\xb0\xd4\xf2\x12\x05\xb5\xdc\xcc\x9e\x67\xda\xb1\xa3\xa0\xce\x00\x0d\xed\xb9\xf1\x11\x82\x58\xe5\x12\x93\x33\x68\x62\xfe\x06\x00\x00\xff\xff\xaf\x8c\x67\xdd\x0f\x04\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x53\x4d\x6f\xdb\x38\x10\xbd\xf3\x57\x3c\x58\x97\x04\xf0\xc7\xee\x1e\xbd\x27\x35\xb1\x51\xa1\x81\x0d\x44\x4e\x83\x1c\xc7\xd4\x58\x1a\x98\x22\x55\x92\x8a\xe2\x7f\x5f\x50\xb6\x9b\x04\xbd\x66\x6e\x82\x46\xef\x63\xde\x53\x86\xd9\xd7\x8d\xca\xf0\x20\x9a\x6d\xe0\x0a\xd1\x21\x36\x8c\xbc\x23\xdd\x30\x4a\x77\x88\x03\x79\xc6\xda\xf5\xb6\xa2\x28\xce\xe2\x26\x2f\xd7\xb7\xe8\x6d\xc5\x1e\xce\x32\x9c\x47\xeb\x3c\xab\x0c\xda\xd9\xe8\x65\xdf\x47\xe7\x61\xce\x80\xa0\xda\x33\xb7\x6c\x63\x98\x03\x25\xf3\x88\xbe\xd9\xee\x8a\xbb\x15\x0e\x62\x18\x95\x84\xf3\x47\x5c\x61\x90\xd8\xa8\x0c\xb1\x91\x80\xc1\xf9\x23\x0e\xce\x83\xaa\x4a\x12\x31\x19\x88\x3d\x38\xdf\x9e\x65\x78\xae\xc9\x57\x62\x6b\x68\xd7\x9d\xbc\xd4\x4d\x84\x1b\x2c\xfb\xd0\x48\x37\x57\x19\x76\xc9\x46\xb9\xbe\x2a\x09\x67\xd8\x91\x33\x3a\xbc\xb8\xfe\xe2\xe1\x83\xdd\xcb\x15\xa6\xf8\xc9\x3e\x24\x92\xff\xe6\xff\xa8\x0c\x37\x69\x65\x72\x79\x39\xb9\xfd\x1f\x27\xd7\xa3\xa5\x13\xac\x8b\xe8\x03\x7f\x40\xe6\x37\xcd\x5d\x84\x58\x68\xd7\x76\x46\xc8\x6a\x7e\xb7\xf5\x87\x61\x8e\x51\x40\xc2\x70\xfb\x48\x62\x41\xa3\x0d\xb8\xc3\xc7\x35\x50\x54\x99\xca\x30\x4e\x13\x63\xb7\x5c\x2c\x86\x61\x98\xd3\x98\xce\xdc\xf9\x7a\x71\x75\xb7\x78\x28\xee\x56\x9b\x72\x35\x1b\x25\xab\x0c\x4f\xd6\x70\x08\xf0\xfc\xab\x17\xcf\x15\xf6\x27\x50\xd7\x19\xd1\xb4\x37\x0c\x43\x43\x0a\x6e\x4c\x67\x0c\x5d\x2c\x06\x2f\x51\x6c\x3d\x45\xb8\xa4\xae\xb2\x4f\xe9\xbc\x9f\xeb\x2a\x4f\xc2\xa7\x05\x67\x41\x16\x93\xbc\x44\x51\x4e\xf0\x2d\x2f\x8b\x72\xaa\x32\x3c\x17\xbb\xef\xdb\xa7\x1d\x9e\xf3\xc7\xc7\x7c\xb3\x2b\x56\x25\xb6\x8f\xb8\xdb\x6e\xee\x8b\x5d\xb1\xdd\x94\xd8\xae\x91\x6f\x5e\xf0\xa3\xd8\xdc\x4f\xc1\x12\x1b\xf6\xe0\xb7\xce\x27\xfd\xce\x43\xd2\x21\xb9\x4a\x99\x5e\x0b\x74\x15\x90\xfa\x91\x9e\x43\xc7\x5a\x0e\xa2\x61\xc8\xd6\x3d\xd5\x8c\xda\xbd\xb2\xb7\xa9\x1e\x1d\xfb\x56\x42\x8a\x33\x80\x6c\xa5\x32\x18\x69\x25\x8e\x2d\x0a\x7f\x9b\x4a\x34\xd7\x1f\xe3\x0b\x46\x29\xea\xe4\x52\xa7\x25\x5e\xff\x55\x47\xb1\xd5\x12\x25\xfb\x57\xd1\x9c\x6b\xed\x7a\x1b\x55\xcb\x91\x2a\x8a\xb4\x54\x80\xa5\x96\x97\xd0\xd4\xb2\x99\x1d\x67\xae\x63\x4f\xd1\x79\x05\x18\xda\xb3\x09\x69\x05\x29\xca\x25\x26\x9a\x5a\x36\xb3\xe3\x44\xfd\x1e\x00\xaf\x8c\x67\xdd\x0f\x04\x00\x00"),
 		},
 		"/platform-cr.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "platform-cr.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 1052,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x53\xcd\x6e\xdb\x3c\x10\xbc\xf3\x29\x06\xd6\x25\x01\x1c\xfb\xfb\x7a\x74\x4f\x6a\x62\xa3\x42\x03\xb9\x88\x9c\x06\x39\xae\xa5\xb5\xb4\x08\x45\xaa\x24\x15\xc5\x6f\x5f\x50\x96\x1b\x07\xbd\x86\x37\x81\xcb\xf9\xd9\x19\x25\xb8\xf9\xbc\xa3\x12\xdc\x4b\xc9\xc6\x73\x85\x60\x11\x1a\x46\xda\x51\xd9\x30\x0a\x7b\x08\x03\x39\xc6\xc6\xf6\xa6\xa2\x20\xd6\xe0\x2a\x2d\x36\xd7\xe8\x4d\xc5\x0e\xd6\x30\xac\x43\x6b\x1d\xab\x04\xa5\x35\xc1\xc9\xbe\x0f\xd6\x41\x9f\x00\x41\xb5\x63\x6e\xd9\x04\xbf\x00\x0a\xe6\x11\x3d\xdf\xee\xb2\xdb\x35\x0e\xa2\x19\x95\xf8\xd3\x23\xae\x30\x48\x68\x54\x82\xd0\x88\xc7\x60\xdd\x0b\x0e\xd6\x81\xaa\x4a\x22\x31\x69\x88\x39\x58\xd7\x9e\x64\x38\xae\xc9\x55\x62\x6a\x94\xb6\x3b\x3a\xa9\x9b\x00\x3b\x18\x76\xbe\x91\x6e\xa1\x12\xec\xa2\x8d\x62\x73\x56\xe2\x4f\xb0\x23\x67\xb0\x78\xb6\xfd\xe4\xe1\xc2\xee\xb4\x85\x39\x7e\xb1\xf3\x91\xe4\xcb\xe2\x3f\x95\xe0\x2a\x8e\xcc\xa6\xcb\xd9\xf5\x57\x1c\x6d\x8f\x96\x8e\x30\x36\xa0\xf7\x7c\x81\xcc\x6f\x25\x77\x01\x62\x50\xda\xb6\xd3\x42\xa6\xe4\x77\x5b\x7f\x19\x16\x18\x05\x44\x0c\xbb\x0f\x24\x06\x34\xda\x80\x3d\x5c\x8e\x81\x82\x4a\x54\x82\xf1\x34\x21\x74\xab\xe5\x72\x18\x86\x05\x8d\x72\x17\xd6\xd5\xcb\xb3\xbb\xe5\x7d\x76\xbb\xce\x8b\xf5\xcd\x28\x59\x25\x78\x34\x9a\xbd\x87\xe3\xdf\xbd\x38\xae\xb0\x3f\x82\xba\x4e\x4b\x49\x7b\xcd\xd0\x34\xc4\xe0\xc6\x74\xc6\xd0\xc5\x60\x70\x12\xc4\xd4\x73\xf8\x29\x75\x95\x7c\x48\xe7\x7d\x5d\x67\x79\xe2\x3f\x0c\x58\x03\x32\x98\xa5\x05\xb2\x62\x86\x6f\x69\x91\x15\x73\x95\xe0\x29\xdb\x7d\xdf\x3e\xee\xf0\x94\x3e\x3c\xa4\xf9\x2e\x5b\x17\xd8\x3e\xe0\x76\x9b\xdf\x65\xbb\x6c\x9b\x17\xd8\x6e\x90\xe6\xcf\xf8\x91\xe5\x77\x73\xb0\x84\x86\x1d\xf8\xad\x73\x51\xbf\x75\x90\xb8\x48\xae\x62\xa6\xe7\x02\x9d\x05\xc4\x7e\xc4\x6f\xdf\x71\x29\x07\x29\xa1\xc9\xd4\x3d\xd5\x8c\xda\xbe\xb2\x33\xb1\x1e\x1d\xbb\x56\x7c\x8c\xd3\x83\x4c\xa5\x12\x68\x69\x25\x8c\x2d\xf2\xff\x9a\x8a\x34\x9f\xf9\x6f\x29\xea\x64\xaa\xd3\x0a\x25\xb5\xac\x2f\xe3\x7b\xfd\x5f\xbd\x88\xa9\x56\xc8\x4c\xe0\xda\x8d\xa2\x7e\x6a\x0a\xb1\xe7\xaa\xe5\x40\x15\x05\x5a\x29\xc0\x50\xcb\xd3\xfb\x9b\x17\x05\x68\xda\xb3\xf6\xf1\x06\x31\xd7\x15\x66\xd3\xdd\x4c\xfd\x09\x00\x00\xff\xff\xdb\xec\x78\x40\x1c\x04\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x93\xcf\x6e\xdb\x3c\x10\xc4\xef\x7c\x8a\x81\x75\x49\x00\xff\xf9\xbe\x1e\xdd\x93\x9a\xd8\xa8\xd0\x40\x2e\x22\xa7\x41\x8e\x6b\x69\x2d\x2d\x4c\x91\x2a\x49\x45\xf1\xdb\x17\x94\xed\xc6\x41\xaf\xe1\x4d\xe0\x72\x77\x66\x7f\xa3\x04\xb3\xcf\x3b\x2a\xc1\x83\x94\x6c\x3c\x57\x08\x16\xa1\x61\xa4\x1d\x95\x0d\xa3\xb0\xfb\x30\x90\x63\xac\x6d\x6f\x2a\x0a\x62\x0d\x6e\xd2\x62\x7d\x8b\xde\x54\xec\x60\x0d\xc3\x3a\xb4\xd6\xb1\x4a\x50\x5a\x13\x9c\xec\xfa\x60\x1d\xf4\xa9\x21\xa8\x76\xcc\x2d\x9b\xe0\xe7\x40\xc1\x3c\x76\xcf\x37\xdb\xec\x6e\x85\xbd\x68\x46\x25\xfe\xf4\x88\x2b\x0c\x12\x1a\x95\x20\x34\xe2\x31\x58\x77\xc0\xde\x3a\x50\x55\x49\x1c\x4c\x1a\x62\xf6\xd6\xb5\x27\x19\x8e\x6b\x72\x95\x98\x1a\xa5\xed\x8e\x4e\xea\x26\xc0\x0e\x86\x9d\x6f\xa4\x9b\xab\x04\xdb\x68\xa3\x58\x5f\x94\xf8\x53\xdb\x71\x66\xb0\x78\xb1\xfd\xd9\xc3\x95\xdd\xf3\x16\xa6\xf8\xc5\xce\xc7\x21\x5f\xe6\xff\xa9\x04\x37\xb1\x64\x72\xbe\x9c\xdc\x7e\xc5\xd1\xf6\x68\xe9\x08\x63\x03\x7a\xcf\x57\x9d\xf9\xad\xe4\x2e\x40\x0c\x4a\xdb\x76\x5a\xc8\x94\xfc\x6e\xeb\xef\x84\x39\x46\x01\xb1\x87\xdd\x05\x12\x03\x1a\x6d\xc0\xee\xaf\xcb\x40\x41\x25\x2a\xc1\x78\x9a\x10\xba\xe5\x62\x31\x0c\xc3\x9c\x46\x3a\x73\xeb\xea\xc5\xc5\xdd\xe2\x21\xbb\x5b\xe5\xc5\x6a\x36\x4a\x56\x09\x9e\x8c\x66\xef\xe1\xf8\x77\x2f\x8e\x2b\xec\x8e\xa0\xae\xd3\x52\xd2\x4e\x33\x34\x0d\x11\xdc\x48\x67\x84\x2e\x06\x83\x93\x20\xa6\x9e\xc2\x9f\xa9\xab\xe4\x03\x9d\xf7\x75\x5d\xe4\x89\xff\x50\x60\x0d\xc8\x60\x92\x16\xc8\x8a\x09\xbe\xa5\x45\x56\x4c\x55\x82\xe7\x6c\xfb\x7d\xf3\xb4\xc5\x73\xfa\xf8\x98\xe6\xdb\x6c\x55\x60\xf3\x88\xbb\x4d\x7e\x9f\x6d\xb3\x4d\x5e\x60\xb3\x46\x9a\xbf\xe0\x47\x96\xdf\x4f\xc1\x12\x1a\x76\xe0\xb7\xce\x45\xfd\xd6\x41\xe2\x22\xb9\x8a\x4c\x2f\x01\xba\x08\x88\xf9\x88\xdf\xbe\xe3\x52\xf6\x52\x42\x93\xa9\x7b\xaa\x19\xb5\x7d\x65\x67\x62\x3c\x3a\x76\xad\xf8\x88\xd3\x83\x4c\xa5\x12\x68\x69\x25\x8c\x29\xf2\xff\x9a\x8a\x63\x2e\x3f\xc6\x27\x1c\xa5\xa8\x93\x73\x9c\x96\x28\xa9\x65\x7d\x8d\xef\xf5\x7f\x75\x10\x53\x2d\x91\x99\xc0\xb5\x1b\x45\xfd\xd4\x14\x62\xce\x55\xcb\x81\x2a\x0a\xb4\x54\x80\xa1\x96\xcf\xef\x67\x07\x05\x68\xda\xb1\xf6\xf1\x06\x91\xeb\x12\x93\x92\x5a\xd6\xb3\xc3\x44\xfd\x19\x00\xdb\xec\x78\x40\x1c\x04\x00\x00"),
 		},
 		"/templates": &vfsgen۰DirInfo{
 			name:    "templates",
@@ -307,70 +307,70 @@ var assets = func() http.FileSystem {
 			modTime:          time.Time{},
 			uncompressedSize: 207,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x34\x8e\xc1\x6a\xc3\x30\x0c\x86\xef\x7e\x8a\xff\x30\xb0\x03\xdb\x9c\x5d\x0d\x61\xb0\x5d\x36\xf6\x00\x3b\x9b\x46\x71\x4d\xed\x28\x28\x4e\x69\x28\x7d\xf7\xe2\xa6\x39\xea\x43\xdf\x27\x59\x8b\x83\xcf\x94\xde\x4e\x0e\xc9\x8f\x61\xf1\x81\xba\x20\xcc\xe7\x55\x29\x6b\xf1\x2f\xb1\x10\x56\x5e\x04\xc2\x4b\xa1\x19\x47\x12\x7a\xc5\xc0\x02\xba\xf8\x3c\x25\x72\x6a\x10\xce\x46\x97\x98\x49\xdc\xe6\x7e\x4e\x24\x91\xfb\xee\xa3\x6d\x5b\xdd\x28\x00\x78\x7f\xf8\xbf\xbd\xd1\xdb\xca\x8e\x67\x2a\x5f\xdc\xaf\x66\x1b\x2b\x88\xb5\x6a\xf4\x0f\xa5\xc4\xf8\xae\xdf\xe1\x0f\xf5\x06\x5e\xae\xcf\xc8\x6d\xb7\x0b\x1b\x9d\x38\xb8\x38\x0e\xac\x1b\x75\x0f\x00\x00\xff\xff\x22\xe1\x59\x85\xcf\x00\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x34\x8e\xc1\x6a\xc3\x30\x10\x44\xef\xfa\x8a\x39\x14\x24\x43\x6b\xb9\x57\x81\x29\xb4\x97\x96\x7e\x40\xce\x22\x5e\x2b\x22\x92\xd7\xac\xe5\x10\x13\xf2\xef\xc1\x71\x7c\xdc\x65\xde\x9b\xb1\x16\x47\x9f\x29\x7d\x9c\x1d\x92\x1f\xc2\xec\x03\xb5\x41\x98\x2f\x8b\x52\xd6\xe2\x20\xb1\x10\x16\x9e\x05\xc2\x73\xa1\x09\x27\x12\x7a\x47\xcf\x02\xba\xfa\x3c\x26\x72\xaa\x17\xce\x46\x97\x98\x49\xdc\xc6\x7e\x8d\x24\x91\xbb\xf6\xb3\x69\x1a\x5d\x29\x00\xa8\x9f\xfc\x5f\x67\xf4\x16\xd9\xdf\x13\x95\x6f\xee\x16\xb3\x9d\x40\x3d\xc5\xd5\x6a\xf4\x2f\xa5\xc4\xf8\x59\xd7\xe1\x1f\x6b\x07\xde\x6e\x2f\xc9\x7d\xa7\x0b\x1b\x9d\x38\xb8\x38\xf4\xac\x2b\xf5\x18\x00\x22\xe1\x59\x85\xcf\x00\x00\x00"),
 		},
 		"/templates/java.tmpl": &vfsgen۰CompressedFileInfo{
 			name:             "java.tmpl",
 			modTime:          time.Time{},
 			uncompressedSize: 390,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x4c\x90\x41\x4b\xf3\x40\x10\x86\xef\xfb\x2b\x5e\xc2\x77\x48\xe1\x33\xa9\xd7\x94\xa2\x54\x04\x45\x50\xf0\xe2\x79\x9b\x9d\xa4\xa3\x9b\x4c\x98\x6c\x6a\x4b\xc8\x7f\x97\x8d\x01\xb3\xb7\x9d\x7d\xf6\x79\x79\x27\xcf\x51\xda\x86\xfc\xcd\x57\x01\x6f\xdb\x7a\xb0\x35\xed\x3f\xed\xd9\x1a\xc3\x4d\x27\x1a\x20\x5a\x67\xb6\xb3\xe5\x89\xb2\x99\xcc\x8e\x03\x7b\x47\x9a\xbd\xcb\x10\xe8\xf0\x7b\xd9\x19\xd3\x0d\x47\xcf\x25\x4a\x6f\xfb\x1e\xe3\x88\xec\xd5\x36\x84\x69\x02\x5d\x02\xb5\xae\xc7\x9a\xc7\x68\x80\xfb\xb7\x33\xa9\xb2\x23\x03\x2c\xbf\xcf\xc2\x0e\xa5\xb4\x15\xd7\x83\x52\xba\x41\x38\xa9\x7c\xf7\x78\xbc\x94\xd4\x05\x96\x16\xa3\x31\x98\x4f\x9e\xe3\x43\x39\x10\xae\x32\x28\x34\xca\x7b\x9c\x48\xe9\x3f\x2a\x51\xd0\xc5\x36\x9d\xa7\x62\xa1\x2b\x95\x26\x4d\x02\x37\xa4\x45\xac\x77\xd7\x91\xb2\xb8\xfd\xed\x76\xbb\x4d\x36\x0b\x04\x64\xb3\xe7\xd9\xa5\x49\x84\xd6\x0f\x3d\x85\x83\xb8\x6b\xfa\x37\x8a\x43\x8e\x19\x69\xf2\x44\xde\x0b\x1e\xe2\x7a\xf0\x32\x67\xe1\xdf\xb8\xa8\xa6\xb5\x25\x48\x9a\x78\xa9\x0b\x6e\x2b\x49\x36\xbb\xd8\x65\x32\x93\xf9\x09\x00\x00\xff\xff\xdd\x48\x43\xb2\x86\x01\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x4c\x90\x41\x4b\xf4\x30\x10\x86\xef\xf9\x15\x2f\xe5\x3b\x74\xe1\x33\x5d\xaf\x5d\x16\x65\x45\x50\x04\x05\x2f\x9e\xb3\xcd\xb4\x1b\x4d\x3b\x65\x92\xac\xbb\x94\xfe\x77\x69\x2d\xd8\xdc\x32\xf3\xcc\xf3\x32\x53\x14\xa8\x4c\x4b\xfe\xe6\xab\x84\x37\x5d\x93\x4c\x43\xfb\x4f\x73\x36\x4a\xb9\xb6\x67\x89\x60\x69\xb4\xe9\x4d\x75\x22\x3d\x93\xfa\x98\x9c\xb7\x24\xfa\x9d\x53\xa4\xc3\xef\x67\xa7\x54\x9f\x8e\xde\x55\xa8\xbc\x09\x01\xc3\x00\xfd\x6a\x5a\xc2\x38\x82\x2e\x91\x3a\x1b\xb0\xe6\x31\x28\xe0\xfe\xed\x4c\x22\xce\x92\x02\x96\xe9\x33\x3b\x8b\x8a\xbb\xda\x35\x49\x28\xdf\x20\x9e\x84\xbf\x03\x1e\x2f\x15\xf5\xd1\x71\x87\x41\x29\xcc\xaf\x28\xf0\x21\x2e\x12\xae\x9c\x04\x32\xc9\x03\x4e\x24\xf4\x1f\x35\x0b\xe8\x62\xda\xde\x53\xb9\xd0\xb5\x70\x9b\x67\xd1\xb5\x24\xe5\xb4\xde\x5d\x4f\xe2\xd8\xee\x6f\xb7\xdb\x6d\xb6\x59\x20\x40\xcf\x9e\x67\x9b\x67\x13\xb4\x6e\x04\x8a\x07\xb6\xd7\xfc\xaf\x04\xe8\xe0\xa6\x8c\x3c\x7b\x22\xef\x19\x0f\xd3\x79\xf0\x32\x67\xe1\xdf\xb0\xa8\xc6\xb5\x25\x72\x9e\x79\x6e\x4a\xd7\xd5\x9c\x6d\x76\x4a\x01\xa3\x1a\xd5\xcf\x00\xdd\x48\x43\xb2\x86\x01\x00\x00"),
 		},
 		"/templates/js.tmpl": &vfsgen۰CompressedFileInfo{
 			name:             "js.tmpl",
 			modTime:          time.Time{},
 			uncompressedSize: 195,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x34\x8e\xb1\x6a\xc3\x30\x14\x45\x77\x7d\xc5\x1d\x0a\x92\xa1\xad\xdc\x55\x60\x0a\xed\xd2\xd2\x0f\xe8\x2c\xe2\x67\x47\x8e\xe4\x67\x9e\x64\x88\x09\xf9\xf7\xa0\x24\x1e\xef\x81\x73\xb8\xd6\xe2\xe0\x13\xc5\xb7\x93\x43\xf4\xf3\xb8\xfa\x91\xba\x29\x2b\x65\x2d\xfe\x25\x14\xc2\xc6\xab\x40\x78\x2d\x94\x71\x24\xa1\x57\x0c\x2c\xa0\xb3\x4f\x4b\x24\xa7\x06\xe1\x64\x74\x09\x89\xc4\x4d\xf9\x73\x21\x09\xdc\x77\x1f\x6d\xdb\xea\x46\x01\xc0\xfb\xdd\xfd\xed\x8d\x9e\xf2\x8e\x32\x95\x2f\xee\x37\xf3\x98\x15\x84\x5a\x33\xfa\x87\x62\x64\x7c\xd7\x47\xf8\x43\x6d\xe3\xe5\xf2\x0c\x5c\x77\xbb\xb0\xd1\x91\x47\x17\xe6\x81\x75\xa3\x6e\x01\x00\x00\xff\xff\xf0\x06\x51\xe3\xc3\x00\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x34\xce\x41\x6a\xc3\x30\x10\x85\xe1\xbd\x4e\xf1\x16\x05\xc9\xd0\x5a\xee\x56\x60\x0a\xed\xa6\xa5\x07\xc8\x5a\xc4\x63\x47\x8e\xe4\x31\x23\x19\x62\x42\xee\x1e\x9c\xc4\xcb\x19\xf8\x3f\x9e\xb5\x38\xfa\x44\xf1\xe3\xec\x10\xfd\x34\x2c\x7e\xa0\x76\xcc\x4a\x59\x8b\x83\x84\x42\x58\x79\x11\x08\x2f\x85\x32\x4e\x24\xf4\x8e\x9e\x05\x74\xf1\x69\x8e\xe4\x54\x2f\x9c\x8c\x2e\x21\x91\xb8\x31\x7f\xcd\x24\x81\xbb\xf6\xb3\x69\x1a\x5d\x29\x00\xa8\x1f\xed\x5f\x67\xf4\x98\xf7\x57\xa6\xf2\xcd\xdd\x6a\x9e\x27\x50\xe7\xb0\x69\x46\xff\x52\x8c\x8c\x9f\x6d\x11\xfe\xb1\xd9\x78\xbb\xbe\x80\xdb\x5e\x17\x36\x3a\xf2\xe0\xc2\xd4\xb3\xae\xd4\x7d\x00\xf0\x06\x51\xe3\xc3\x00\x00\x00"),
 		},
 		"/templates/kts.tmpl": &vfsgen۰CompressedFileInfo{
 			name:             "kts.tmpl",
 			modTime:          time.Time{},
 			uncompressedSize: 200,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x2c\x8e\x41\xca\xc2\x30\x10\x46\xf7\x39\xc5\x10\xfe\x45\x0a\xbf\xa6\x6e\x03\x45\xd0\x8d\xe2\x01\xdc\xb8\x09\x76\x5a\x43\x27\x9d\x32\x4d\xc1\x22\xde\x5d\x62\x5c\xbe\x61\xde\xe3\xb3\x16\xee\x3e\x22\x6d\x06\x07\xe4\xc7\x7e\xf1\x3d\x36\x03\x27\x0a\xa3\x52\xd6\xc2\x55\x42\x42\x58\x79\x11\x10\x5e\x12\xce\xf0\x40\xc1\x7f\xe8\x58\x00\x9f\x3e\x4e\x84\x4e\x75\xc2\xd1\xe8\x14\x22\x8a\x2b\xee\x7e\x42\x09\xdc\x36\xbb\xba\xae\x75\xa5\x00\xb6\x5f\xfb\xdc\x1a\x5d\x1e\xca\x71\xc6\x74\xe0\x76\x35\x19\x32\x86\xdc\x33\xfa\x84\x44\x0c\xc7\xbc\x0b\x2e\x90\xeb\x70\xfb\x7b\xfd\x0a\xef\xa2\x26\x36\x9a\xb8\x77\x61\xec\x58\x57\xea\x13\x00\x00\xff\xff\xfb\xc6\xfa\xba\xc8\x00\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x2c\x8e\x41\x6a\xc3\x30\x14\x44\xf7\x3a\xc5\x20\xba\x90\xa1\xb5\xdc\xad\xc0\x14\xda\x4d\x4b\x0f\xd0\x4d\x37\x22\xfe\x76\x84\x25\x7f\xf3\x2d\x43\x4c\xc8\xdd\x83\xa2\x2c\x67\x98\xf7\x18\x6b\x71\xf2\x89\xe2\xdb\xec\x10\xfd\x32\xed\x7e\xa2\x7e\xe6\x1c\xc3\xa2\x94\xb5\xf8\x93\x90\x09\x07\xef\x02\xe1\x3d\xd3\x86\x33\x09\xbd\x62\x64\x01\x5d\x7c\x5a\x23\x39\x35\x0a\x27\xa3\x73\x48\x24\xae\xb2\x1f\x2b\x49\xe0\xa1\x7f\xef\xba\x4e\x37\x0a\x68\x1f\xf4\xcf\x60\x74\x1d\xd4\x72\xa3\xfc\xc9\xc3\x61\x4a\x00\xda\x2d\x14\x9f\xd1\xdf\x14\x23\xe3\xab\xfc\xc2\x2f\x8a\x1d\xff\x2f\xd7\xa7\xe1\x56\xd1\xcc\x46\x47\x9e\x5c\x58\x46\xd6\x8d\xba\x0f\x00\xfb\xc6\xfa\xba\xc8\x00\x00\x00"),
 		},
 		"/templates/xml.tmpl": &vfsgen۰CompressedFileInfo{
 			name:             "xml.tmpl",
 			modTime:          time.Time{},
 			uncompressedSize: 597,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x92\x4f\x4b\xf4\x30\x10\xc6\xcf\x6f\x3f\xc5\xbc\xe1\x3d\xbe\x69\xba\x7a\x91\x92\x76\x41\x41\x14\xd7\x93\x8a\x5e\x43\x3b\xdb\x06\xf3\xa7\x24\xa9\xcd\x22\x7e\x77\x69\xba\xae\x2b\x5e\x74\x4e\x2d\x33\xbf\xe7\x99\x79\x08\x5f\x47\xad\xe0\x05\x9d\x97\xd6\x54\x64\x95\x17\x04\xd0\x34\xb6\x95\xa6\xab\xc8\xc3\xfd\x25\x3d\x23\xeb\x3a\xe3\x7f\x29\x85\x46\x68\x54\xf4\xb9\x04\x25\x4c\x37\x8a\x0e\xab\x99\xa5\xb4\xce\x32\xee\xec\x18\xd0\x43\xd4\xca\xf8\x32\x7a\x59\x91\x3e\x84\xa1\x64\x6c\x9a\xa6\x7c\x3a\xcd\xad\xeb\xd8\x49\x51\xac\xd8\xd3\xed\xe6\xae\xe9\x51\x0b\x2a\x8d\x0f\xc2\x34\x48\x32\xd8\x57\xa2\x0f\x64\xb2\xcb\xc5\x20\x9a\x1e\x13\xef\x13\xc7\xfc\xe0\xa4\xe9\x8e\x28\x2f\xcb\xa5\xb5\xb1\x8d\x08\xe9\x8e\x43\x73\xae\x1f\xe9\xfd\x9e\x58\xda\x74\xf9\xc9\xa3\x6f\x49\x9d\x65\x7f\x52\x52\x8f\x4e\x06\x84\x9d\x1d\x1d\xec\x83\xe9\xd1\xe1\x7f\xd8\x5a\x07\x18\x85\x1e\x14\x96\x29\xb8\xd9\x6c\xc9\x0e\x64\x5b\x91\xa8\x15\xa9\x0f\x9b\xf0\xad\xb3\x1a\x46\x27\x2b\x12\xa4\x46\x57\x46\xad\xd6\x03\x3a\x69\xdb\x6a\x55\x14\x05\x61\x47\xb3\x1e\xc3\xb9\x6d\x77\xf5\x97\x3b\xb8\x97\xb3\x57\x7d\x85\x4a\x59\xb8\x98\xf7\x85\x1b\x48\xb2\xff\x5e\x93\xed\x75\xfb\xc6\xd9\x7e\xea\x53\x8c\x7d\x53\xe3\xc1\x2e\x9b\x28\xdb\x95\xd2\x6c\xed\x87\x39\x67\x49\x67\x7e\x03\xcb\x97\xaf\xb3\xf7\x00\x00\x00\xff\xff\xd8\xbe\x95\x9a\x55\x02\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x92\x41\x6b\xdc\x30\x10\x85\xcf\xf5\xaf\x98\x8a\x1e\x2b\xcb\xdb\x5e\x8a\x91\xbd\xd0\x42\x69\xc9\xe6\x94\x84\xe4\x2a\xec\x59\x5b\x44\xd2\x18\x49\x8e\xbd\x84\xfc\xf7\x60\x79\xb3\xbb\x90\x4b\x62\xeb\x22\xcd\x7c\xef\x8d\x1e\x92\xdb\xd9\x1a\x78\x42\x1f\x34\xb9\x8a\x6d\xf2\x82\x01\xba\x86\x5a\xed\xba\x8a\xdd\xdd\xfe\xe5\xbf\xd8\xb6\xce\xe4\x57\xce\xa1\x51\x16\x0d\x7f\x2c\xc1\x28\xd7\x8d\xaa\xc3\x6a\x61\x39\xaf\xb3\x4c\x7a\x1a\x23\x06\x98\xad\x71\xa1\x9c\x83\xae\x58\x1f\xe3\x50\x0a\x31\x4d\x53\x3e\xfd\xcc\xc9\x77\xe2\x47\x51\x6c\xc4\xc3\xf5\xee\xa6\xe9\xd1\x2a\xae\x5d\x88\xca\x35\xc8\x32\x38\x7e\x89\x3e\x91\xc9\x2e\x57\x83\x6a\x7a\x4c\x7c\x48\x9c\x08\x83\xd7\xae\xbb\xa0\x82\x2e\xd7\xd2\x8e\x1a\x15\xd3\x3d\x4e\xc5\x65\x7d\x48\xef\xf3\xc4\x2a\xc8\xd7\x4d\x3e\x87\x96\xd5\x59\xf6\x25\x25\x75\xef\x75\x44\x38\xd0\xe8\xe1\x18\x4c\x8f\x1e\xbf\xc3\x9e\x3c\xe0\xac\xec\x60\xb0\x4c\xc1\x2d\x6e\x6b\x76\xa0\xdb\x8a\xcd\xd6\xb0\xfa\x34\x89\xdc\x7b\xb2\x30\x7a\x5d\xb1\xa8\x2d\xfa\x72\xb6\x66\x3b\xa0\xd7\xd4\x56\x9b\xa2\x28\x98\xb8\xe8\x0d\x18\x7f\x53\x7b\x38\x9f\x2c\xbf\x0c\x7a\xf1\xaa\xff\xa1\x31\x04\x7f\x96\x79\xe1\x0a\x92\xec\xb7\xe7\x64\xfb\xbf\x7d\x91\xe2\xd8\x75\x16\x13\xef\xd4\x64\xa4\x75\x12\x43\x5d\xa9\xdd\x9e\xde\xcc\xa5\x48\x3a\xcb\x1b\x10\x9e\xc6\x88\xa1\xce\x5e\x07\x00\xd8\xbe\x95\x9a\x55\x02\x00\x00"),
 		},
 		"/templates/yaml.tmpl": &vfsgen۰CompressedFileInfo{
 			name:             "yaml.tmpl",
 			modTime:          time.Time{},
 			uncompressedSize: 230,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x44\x8e\x31\x4e\x04\x31\x0c\x45\xfb\x9c\xe2\x2b\xdb\x32\xd2\xd0\x5a\xa2\xa2\x41\xe2\x00\xd4\x66\xd7\x33\x44\x24\x71\xe4\x38\x12\x73\x7b\x94\x1d\xc1\xba\xfc\x7e\xf6\x7f\x17\x5c\xb9\x48\x5e\xbe\x09\x99\xeb\x3e\x78\x97\x97\x83\x4b\x0e\xe1\x82\x0f\x4b\x2e\x38\x74\x18\x4c\x87\x4b\xc7\x97\x98\x3c\x61\x53\x83\xfc\x70\x69\x59\x28\x2c\xd8\x4c\x0b\x05\x00\x18\x96\x08\xd1\x53\x11\xa3\xf9\x24\xde\xd3\xc6\xc6\x45\x5c\xac\x9f\x14\xd0\xc4\x92\xde\x08\xf1\x79\x5d\xd7\x13\xea\x2e\xed\x7f\xbf\xa0\x8b\x2f\x9f\x7a\x3b\xfe\x92\x39\x57\xad\xdd\xb9\x3a\x21\xbe\x49\xce\x8a\xd7\x69\x8e\xf7\xbb\x00\x1e\x7d\xf3\xde\x95\x10\xb3\xee\x94\xea\xa6\x31\xfc\x06\x00\x00\xff\xff\xec\xe0\xb7\x2c\xe6\x00\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x44\x8e\xc1\x4a\x04\x31\x0c\x86\xef\x7d\x8a\x9f\xee\xd5\xc2\x78\x0d\x78\xf2\x22\xf8\x00\x9e\xe3\x6e\x66\x2c\xb6\x4d\x49\x53\x70\xde\x5e\x66\x07\x5d\x72\xfb\xf2\x91\x7c\x17\x5c\xb9\x4a\x49\xdf\x84\xc2\x6d\x9b\xbc\xc9\xcb\xce\xb5\x84\x70\xc1\x87\x65\x17\xec\x3a\x0d\xa6\xd3\x65\xe0\x4b\x4c\x9e\xb0\xaa\x41\x7e\xb8\xf6\x22\x14\x12\x56\xd3\x4a\x01\x00\xa6\x65\x42\xf4\x5c\xc5\xe8\x38\x12\xef\xb4\xb3\x71\x15\x17\x1b\xa7\x05\x74\xb1\xac\x37\x42\x7c\x5e\x96\xe5\x94\x86\x4b\xff\xdf\x27\x0c\xf1\xf4\xa9\xb7\xfd\x8f\x1c\x73\xd5\x36\x9c\x9b\x13\xe2\x9b\x94\xa2\x78\x3d\xca\xf1\x7e\x0f\xc0\xe3\x1f\x90\xe0\x4a\x88\x45\x37\xca\x6d\xd5\x18\x7e\x07\x00\xec\xe0\xb7\x2c\xe6\x00\x00\x00"),
 		},
 		"/traits.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "traits.yaml",
 			modTime:          time.Time{},
-			uncompressedSize: 34278,
+			uncompressedSize: 34160,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x7d\xfd\x6f\x1b\x37\xb6\xe8\xef\xfd\x2b\x08\xbd\x07\xd8\x32\xa4\xb1\xd3\x45\xb7\x5d\xbf\xd7\x57\xb8\x49\xba\xeb\xb4\x49\xfc\xe2\xb4\xbd\x17\xbd\x8b\x15\x35\x73\x24\x31\xe6\x90\xb3\x24\x47\x8e\x7a\x71\xff\xf7\x0b\x1e\x7e\xce\x68\x6c\x8f\x13\xbb\xf0\x02\x17\xf9\x21\x96\x34\x24\x0f\x0f\xcf\xf7\x07\xc7\x28\xca\x8c\x3e\xfd\x62\x4e\x04\xad\xe1\x94\xd0\xd5\x8a\x09\x66\x76\x5f\x10\xd2\x70\x6a\x56\x52\xd5\xa7\x64\x45\xb9\x06\xfb\x8d\x92\x2b\xc6\x41\x9f\x7e\x41\xc8\x9c\xfc\xd8\x2e\x41\x09\x30\xa0\xdd\x47\x41\x0d\xdb\x02\xfe\xfd\xb6\x01\x71\xb9\x61\x2b\xf3\x05\x21\x15\xe8\x52\xb1\xc6\x30\x29\x4e\xc9\x19\xe7\xf2\x5a\x93\x52\x0a\x6d\x57\x16\x4c\xac\xc9\xf5\x86\x95\x1b\x22\x64\x05\x9a\x98\x0d\x10\x26\x0c\xac\x15\xb5\x03\x48\x23\xab\x43\x3d\x25\x54\x01\x01\xce\xd6\x6c\xc9\x81\x18\x49\x96\x40\x74\xb9\x81\xaa\xe5\x50\x11\x29\x66\x64\x49\x35\xfe\x45\x38\x5d\x02\xd7\xf6\x2f\x3b\x95\x9d\x74\x46\xa4\x22\xd7\xcc\x6c\x70\x62\x35\x6f\x64\x15\x77\x49\xa8\xa8\x08\x15\x86\xcd\xc3\x37\x83\x53\x35\xb2\xb2\xa0\x51\x83\x80\x50\xae\x80\x56\x3b\xa2\x5a\x81\xf0\x67\x6b\xe9\x82\x9c\x9b\x03\x4d\x2a\xa6\xe9\xd2\xc2\xb6\xdc\x91\x0a\x56\xb4\xe5\xa6\x70\xf8\x6b\x40\x19\x16\x30\xe8\x50\x0e\x02\x9f\xfd\x82\x10\x42\xcc\xae\x81\x53\xb2\x94\x92\xe3\xc7\x0e\xee\x9e\x53\x61\x37\xde\x5a\xf0\x8c\xf4\xc3\xec\xe6\xfc\x6a\x84\x12\x3c\xcd\xc2\x62\xd9\xfd\xa9\x89\xde\x58\x90\xcd\x86\x59\xa4\xd7\xb5\xdd\x8c\x03\x62\x57\x64\x20\x34\xb2\x9a\x67\x27\x7f\x3b\x1c\x67\xfc\x9a\xee\xec\x74\x73\x2e\x4b\x6a\x40\x93\xba\xe5\x86\x35\x1c\x88\x82\x86\xb3\x92\x6a\x22\x57\x7b\x47\xc9\x1c\x9a\x34\xad\x1d\xae\xc8\xa1\xc7\x0c\x39\x42\xfa\x3a\x9a\xee\x41\x94\x1f\xcc\x9d\x60\xbd\x81\x2d\xa8\x47\x86\xca\x3e\x11\x21\x9a\x3b\x02\xc9\x00\x3b\xf8\xed\xef\xda\x28\x26\xd6\x07\xfb\xe0\xbd\x80\x15\x13\xa0\x09\x25\x1a\x8c\x85\xe4\xd1\x08\xfe\xa6\x83\xfd\x4c\x78\x91\x09\x0e\xed\xb4\x7c\x47\xcc\x46\x6a\x20\x35\x35\xe5\xc6\xb2\x80\x5d\x1a\x67\x27\x1a\x38\x94\x46\xaa\x19\x51\xc0\x51\x20\x58\xf0\xed\xef\x6b\xb6\x05\x81\x60\xe9\x86\x96\x30\x75\x0c\x65\x36\x30\xb0\x7d\xbd\x91\x2d\xaf\xec\xae\xe3\x79\x56\xc8\xc3\xb7\x92\xc8\xbf\xde\x06\x85\x34\x83\x9b\x0c\x5b\x5c\xb6\x8c\x57\xa0\x3a\xc2\xd8\xa8\xf6\x61\x64\xf1\xfb\x0d\x84\x05\x9c\xb4\x20\x4c\x3b\x19\x29\x28\xe7\xbb\x28\x68\x2a\x30\xa0\x6a\x26\x00\x77\xb9\x04\x6d\x88\x15\xde\x06\xd6\x3b\x24\x4d\x3b\x05\x0a\xd2\x52\x8a\x15\x5b\xb7\x0a\xc8\x79\xda\xf1\x8f\xcc\xe8\x27\x2d\xfb\xb6\xa0\x96\x12\xd5\xdb\xed\x20\xbc\x74\x6b\xfa\xc7\x09\x97\xeb\xb5\x97\xfe\x0e\x03\xa5\xac\x1b\x29\x40\x18\xaf\x2a\x74\xdb\x34\x52\x19\xc2\x0c\x39\x84\x62\x5d\x90\x1f\xa9\x60\x57\x01\x5f\x8d\xac\xa6\xe9\x9c\x4b\x4b\x74\x8f\x77\xca\xcf\xed\xf4\xfe\x8c\xcb\x2e\x26\xd3\x99\x6d\x41\x69\x26\x05\x4a\xc9\xb3\x86\x96\x71\xdc\x8f\x56\xd3\x19\x56\x03\x1e\x32\x52\x3d\x54\x84\xb3\xa5\xa2\x8a\x81\x9e\x11\x37\xab\xa7\xe5\xa0\xfa\x9e\xf4\x99\xfb\x0d\xcd\xfd\x9e\x33\x50\x9c\xb8\xd8\x07\xc6\xa2\x11\x4f\x69\x7e\x35\x0f\xe8\xf0\xa3\x2d\x70\xad\x06\xb2\x92\xaa\x2f\xce\xad\x39\x40\xe4\x16\x94\x62\x41\xd8\x07\xf5\x12\x06\x5b\xe1\xe3\x95\x50\xc6\x35\xe4\xc2\x53\x42\x46\x23\x52\x18\xca\xc4\x63\x4a\x83\xe7\x61\x89\xbb\x68\x25\x1d\x6c\xd0\xa9\x11\x3a\x72\xbd\x01\x05\x7b\x7a\xed\x9a\x71\x6e\xd1\x8e\x18\xa1\x5c\x4b\x3b\xc7\x16\xb1\x12\xa6\x75\x0f\x5a\x2c\x5e\x82\xda\xb2\xd2\xca\x66\xad\x65\xc9\x90\xde\xbc\x90\x8d\xeb\x3c\x69\xfa\xa2\xad\x91\x77\xae\x3f\x99\xe4\x14\x09\xff\x6c\x41\x9b\x79\xd9\xb4\x23\xa9\xb1\x66\x82\xd5\x6d\x4d\x68\x2d\x5b\x81\x0a\xec\xf9\xc5\xcf\x38\x0f\x53\x8e\xfd\xfa\x73\xd7\x50\x4b\xb5\xfb\xe4\xe9\xdd\xf0\xc1\x15\x38\xab\xd9\xbd\x60\xa7\x1f\x47\xc2\xee\x66\xbe\x1f\xe4\x7b\x93\xdf\x02\x39\x7c\x6c\xc6\x08\xff\x41\x5a\x39\x0e\x84\x82\x93\xa0\x0c\x65\x94\x5c\x45\xe6\x0b\x74\xdc\x35\x5a\x94\xc9\x56\x63\xc2\x0c\x6c\x22\x67\x35\x4a\x2a\xb6\x5a\x81\x02\x61\x70\xb0\x87\x18\x5d\x8b\x2e\xe3\x45\xcb\x75\xf1\xcd\xc9\x37\x27\x8b\x69\x7f\xd9\xb9\xfd\x73\x0c\x0e\x6f\x5d\xde\x4e\x12\x45\xdd\x58\x80\x36\xc6\x34\x5d\x80\xb4\x43\xcd\xfc\xde\xf8\x68\x45\x85\x42\xc6\xfa\x8c\x7e\x12\x07\x46\x77\x6d\xa7\x7a\xb5\xb7\x9d\x03\x88\x39\x8a\x6e\x86\xe7\x93\x10\x75\x23\x5c\x88\xb0\xfb\x01\xb7\x8f\xae\xb1\x10\x21\xf9\x33\x91\xad\x65\x47\x7a\xaf\xd4\xfe\x59\x91\x45\x26\x96\x17\x3d\x07\x35\x92\x8b\x92\x4b\xd0\xf3\xb1\x92\xf4\x02\x1f\x77\x06\x52\xd5\x67\x0e\x37\x57\x70\x50\x86\xa8\x03\x1d\xad\xc5\xb4\xbf\xfe\xbc\xa1\x66\x33\x62\xd3\x17\xd4\x6c\x2c\x2a\x69\x59\x82\x8e\x0b\xe1\x14\xe4\x30\xea\xdb\xc5\xf1\x06\x28\x37\x9b\xc5\xb4\x20\x6f\xa4\x81\x60\x9d\x33\x1d\x25\xb8\x3d\x12\x6b\xc5\x78\xcb\x0d\x2a\x29\xc8\x3f\x5b\xaa\xae\x5a\xdd\x31\x81\x34\x18\x63\x4d\x3f\x66\xbc\x5a\x03\x6d\x57\xf0\x5a\x3c\xd7\x7a\x2b\xca\x38\xba\x0f\x92\x68\x43\x95\xe9\x4a\xb6\x2d\x08\xd0\x7a\x6e\x7d\x17\x46\xf9\xbc\x02\x4e\x77\x5d\x5e\xf8\xd3\x97\x03\x7e\x6e\x5b\x2f\x41\x59\xb1\xa6\xa1\x94\xa2\xd2\x84\xae\x0c\xa8\x1e\x76\x37\x54\xbb\x25\x2d\x63\xc2\x4a\x2a\x88\x0b\x86\x13\xb1\x8a\xcc\xad\x6d\xfa\x32\xd7\x43\x66\x77\x2c\x5b\xf3\xe9\x30\x39\x76\x48\xc7\x61\x27\xd4\x44\xb6\x56\xa7\x36\x0d\xb7\xf6\x83\xd7\xeb\x5d\xe0\x06\xa1\x69\x40\x31\x59\xdd\x0d\xcc\xdf\xe4\x35\x91\x2b\x03\x68\x98\x35\xa0\xac\xa1\x94\x60\xf8\x94\x95\x75\x8b\xa4\x35\x37\x1b\x05\x7a\x23\xf9\x08\x20\x5e\x7b\xf5\x59\x4a\xa1\xa1\x6c\xd1\x4f\xf4\xd3\x80\x4e\xf2\xd3\x61\x45\x3a\x27\x50\x68\x56\x81\x82\x2a\x3c\xb8\x6a\xb9\xc7\xe3\x86\x6e\x2d\x19\x59\x72\x82\xaa\xb8\xff\x06\xec\xc0\x56\xc1\xe7\x6e\xc0\x4f\x73\x27\xfc\x0e\xce\x2e\xec\xb8\x27\xa8\xee\x03\xbe\x02\x5a\xb1\x3f\x94\x45\xe2\x8a\x77\xf2\x48\x82\xed\x0f\x64\x92\x1e\x78\xc3\xf0\x3c\x12\x9b\x8c\x5a\xfb\x69\x33\xca\xa8\x2d\x3c\x65\x56\xd9\xdb\x40\xf4\x0d\x15\x3a\xb1\x8f\x11\xb1\x3f\x40\xc7\x50\x59\xad\x3a\xe8\x13\xb6\xda\xc8\x9a\xfd\x1e\x82\x43\x76\x0b\xb2\x45\x2a\x77\x84\xc8\x4a\x24\x68\x75\x6c\x61\xf4\x61\xcb\x4c\x45\xea\x82\xfc\xba\x61\x1c\x88\x90\xaa\xc6\xb0\x13\x15\x1d\x15\xea\x8d\x76\x4d\x28\x69\x64\xe5\x11\x48\x5d\x08\xba\x6d\x5c\x48\xc2\x05\xe2\x67\x44\xcb\x1a\xb2\x65\xa9\xbe\xd2\x33\x8b\xcd\x0d\xa1\x9a\x2c\xa9\x29\x37\xe4\x83\x5c\xea\x59\x98\x34\xcc\x56\x1a\xb6\x45\x27\x93\x1a\xa2\x1b\x28\xd9\x8a\x95\x64\x23\x5b\x15\xdd\xdb\x8a\xee\x62\x1a\x81\xa6\x25\x50\x1e\xa1\x87\xc1\x44\x6b\x40\x17\xe4\x07\xa9\xdc\x8a\x7e\x75\x14\x39\x5d\xec\xd5\xd4\x80\x62\x94\x07\xa4\xe5\xbb\xa5\x76\x9f\xe9\x98\x10\xf1\xaf\xe4\x92\x30\xa1\x0d\xd0\xca\x2e\x45\xad\xd0\x12\x15\x55\x15\xa9\xa0\xe1\x72\x57\x83\x30\x33\x6b\x7d\x48\x85\xa1\x3c\x49\x34\xdd\x5a\x62\xd1\xb2\x55\xd6\x8b\xbe\xde\xc0\xbe\x69\x52\x49\x70\xd6\x8e\x00\xa8\xa2\x25\x6a\xc9\x17\xaa\x22\x0f\x45\x84\x70\x96\x95\x94\x64\xa5\xa4\x13\x12\x2b\xc9\xb9\xbc\xb6\xd4\x9a\xc5\xbd\x30\x6a\xbd\xa5\xbc\x45\x64\x06\x7f\x20\xee\xfe\x94\x2c\x90\x14\x16\x33\xb2\xb0\xdf\xda\xff\xad\x7d\x65\x7e\x5f\x14\x68\xba\xaa\x96\x7b\x8e\x69\xb5\x9d\x7a\x10\x15\xd4\x47\x17\x22\x04\xa7\x64\x1e\x26\x3e\x75\x7b\x75\xe7\xa3\x03\xad\x5e\x2b\x66\xac\x9c\xa3\xda\x01\x03\x1f\x1b\x05\x5a\x3b\xea\x7b\x59\xac\x0b\x3f\xfc\xd4\xb0\xf2\xea\x3b\x37\xf8\xdb\x3f\x9f\x9c\x9c\x9c\x2c\x0a\x3b\x77\x0f\xd6\xd3\x84\xd0\xde\x74\x09\xa9\x5e\xcb\x44\x49\x7f\xe8\xa5\xc0\xc4\x7f\x31\x21\x0d\x75\x3e\x80\x06\x63\xb1\x7f\x32\x0d\xa0\xd8\x39\x4f\x0d\x5d\x7e\x17\x02\xfe\xdf\x9e\x1c\x7f\xf9\xbf\xff\xb3\xe1\xad\xfe\xaf\xa3\xa1\xff\xbe\x5b\x58\xd2\xf4\xd0\x9d\x1a\xc5\xd6\x6b\x50\xdf\xd9\x69\xbe\x3d\x71\x4f\x9c\x1c\x7f\x79\xeb\xf8\xe2\xe0\x29\x07\x53\x02\x1e\x46\xfa\x3f\x81\x4e\xc2\xb0\x28\x81\xaf\x37\x92\xf7\xa3\x73\xab\x2c\x4b\x24\x2d\x07\x23\x79\x55\x50\x72\xaa\xa0\x42\xf6\xdd\x91\xba\xd5\xc6\xca\x66\x48\xa9\xa2\xde\xe4\x4c\xd7\x50\x6e\xa8\x60\xba\xb6\xbb\xbf\x96\xea\x8a\x94\x52\x29\x28\x0d\xef\xec\x25\x31\xcb\x88\xdd\x1c\x9c\x21\x5a\x28\xd1\xd0\x50\xe5\xa3\xae\xda\x04\x99\xe4\xa2\xb3\xfd\xb0\x73\xc6\xce\x51\x36\x57\x49\x3a\x78\x64\x24\x30\x23\x2d\xc7\x2d\x59\xc7\xd4\x11\x91\x75\xe6\x3e\xc6\x7c\xc0\x72\x97\xb1\x63\x71\x96\x24\x65\x5c\x47\xd9\xb1\x49\x9a\xda\xb5\x80\x5a\x7f\xd8\x3d\x09\x59\x90\xdc\x53\x7b\x38\x1b\xcf\xbf\xe9\x77\x27\x39\x91\x19\xe6\xe1\xb7\x7c\x99\xb4\xca\x21\x33\x07\x07\x56\x23\x82\x06\x11\xbd\xb0\x85\x54\xeb\x82\x62\x18\xbb\xc0\xb8\x6d\x71\x75\x1a\xe2\xb7\xc8\xcf\x3e\x80\xbd\x9b\x16\x97\xc1\xdd\xeb\x8b\xb2\xb2\x55\x0a\x84\xe1\xbb\xd3\x24\x03\x3c\x2c\x56\xed\x44\xd9\x75\x90\x1d\xf0\x8a\x72\xbe\xa4\xe5\xd5\x9d\x0c\xf3\xb3\x86\x4e\x3c\xd8\x9d\x26\xab\x1b\x0e\x56\xa0\x3b\x21\xed\x4f\xda\xad\x4e\x40\x54\x8d\x64\xc2\x90\xc3\xb0\xf4\x34\x57\x0c\x46\xed\xbc\xaf\x79\x8b\x86\xa1\x7a\x40\xa6\x76\x29\x54\xb8\x7d\x97\xbb\x79\x23\x39\x2b\xc7\x84\xdd\x0e\x2e\xfd\x09\x6b\xb2\x91\xd7\x68\xae\x28\xa0\x26\x4d\x66\xbc\x6e\x09\x09\x06\x4a\xec\xb2\xbf\x50\xce\x2a\x62\x15\x86\x63\xbc\xd3\x39\x99\x60\x85\xc0\xe4\x94\x50\x57\x29\xe0\x21\x44\x13\x48\xb5\x22\x9b\x91\xef\xfe\xcf\x9c\x4c\x7e\x90\x6a\xc9\xaa\x49\x8c\x26\x4c\x4f\x2d\x4d\x2d\x59\xa5\xf3\xc5\x55\x2b\xac\x25\x70\xc5\x9a\xc6\xa2\x48\xc0\x47\xfc\x8e\xb0\x95\xa5\x1b\x6b\xb1\xa0\x87\x6f\x5d\x02\x71\x70\x60\xc8\x8a\x09\xa6\x37\x50\x91\x1d\x18\xbb\xca\x3b\x68\x38\x2d\x61\x72\x6a\x55\x4a\x09\x5c\x27\xda\x88\x65\x00\x1f\xac\x6e\xc2\x04\x09\x3e\xab\x5d\x78\x00\xed\x05\x01\xd7\x44\x0a\x38\xb8\x6f\x84\xf8\xac\x35\xb2\xa6\x86\x95\xc8\x7f\x4e\xdb\x0f\x99\x0c\x41\xe4\x21\x2f\x53\xce\x33\x59\x06\xcc\x6c\x40\x39\xed\x8d\x96\xb1\x55\xe0\x99\x05\x63\x4d\xd1\xb6\x06\x45\x0e\xa5\xe0\xbb\x5b\xe9\x1c\x79\x22\xc6\x46\xa6\x56\xb8\x53\xd2\x50\xad\xad\xbd\x9b\xe6\xc1\x5c\xd7\xa2\x62\x56\xf8\x2d\x50\x20\xec\x3d\x34\x2d\x30\x1e\x15\xe2\xb1\xbe\xb4\x82\xf3\x7d\xb0\x74\x4f\xee\xba\x07\x10\xac\x64\x8b\x7a\x05\x6c\xed\x37\xaf\xe1\xa3\x2c\xf3\xd0\x3c\xab\x17\x83\x0f\x2f\x4e\x8e\x9f\x91\x23\xf7\x6f\x31\xbb\x46\x43\x74\xf1\xa7\xaf\x6a\xa7\x51\xbf\x3a\xd1\x0b\x9f\xd9\xca\x72\x75\x15\x34\x20\x2a\x10\x25\x43\x33\xfa\x91\x52\x31\x2f\xb2\x55\x6e\xcd\xce\xd2\x0e\x8d\xd0\xaa\x8a\xa1\xaa\x1c\xd0\x54\x2f\xd0\x27\x9f\x90\xa4\xb6\x13\x2a\x72\x4d\x51\x91\x20\xaf\xf5\x32\x2c\xe4\xb7\xbf\xe7\x38\xe0\x72\xf7\x98\xa9\xa8\xb0\xc2\xb0\xd7\x01\x1f\x1b\xce\x4a\x66\xd9\xcf\xe5\xe3\x71\x07\x57\x4c\xa0\x20\xdc\xb0\xf5\x86\x70\xd8\x02\x8f\x46\xb0\xdb\x26\x46\xeb\x86\xd9\xe8\x49\xa7\x93\xec\xc6\x46\x48\x61\x5f\x5c\x75\x23\x7e\x2a\xd0\xc8\x6e\xc9\x6d\x70\x28\x5b\x82\xb9\x06\x10\x64\x91\x7e\x08\x26\xfa\xfc\x83\x5c\x3a\x66\xb8\x72\x27\x37\xf7\xb1\xed\x85\x13\x36\xa5\x15\xf3\xa1\x40\x22\x79\x1c\x56\xad\x07\xb9\xb8\x87\xe8\x2e\x11\xd9\xd5\x1e\x95\x8d\xc2\x56\x23\x13\x29\xd0\x8d\x75\xc0\x97\xde\x7c\x5b\x83\x00\x95\x76\x91\xa9\xc7\x0c\x51\x89\x7e\x6a\x7a\x65\xc5\xe0\x2d\x39\xce\x60\x83\x94\xbc\xd5\x66\x2f\x53\x99\xf3\x11\x88\x2d\x53\x52\x3c\x2e\x0e\xb2\x45\x12\x12\xda\xe0\x87\x7b\x71\x62\x24\x61\xe2\x83\xa5\x94\xe8\x5d\xe6\xe3\xb6\x54\x31\x4b\xc4\x7a\x28\xca\x1d\x43\x6a\xc9\xd9\x5e\xbc\x39\x7b\xfd\xf2\xf2\xe2\xec\xf9\x4b\x4b\x49\x17\x6f\x5f\xfc\xc3\x7e\xe1\xf4\x89\xb4\x1a\xe9\x69\xd7\x84\xc4\x1d\xcd\x6b\x30\x74\x64\x69\x88\xf6\x18\xf4\x86\x5b\x86\x02\xa7\x46\x13\x16\x86\x31\x9b\x12\x22\xf6\xf8\x17\xd3\x48\x25\xeb\xf2\x91\x62\x3b\x96\x3a\xfe\xfa\x9c\xbc\x47\xa2\x58\x53\xb5\xa4\x6b\x98\x97\x92\x5b\x89\xa1\x9d\x21\x11\x59\x3a\x96\x3f\x0a\x49\xb8\x14\x6b\x50\x44\x40\x09\x5a\x53\xb5\x23\x6d\x23\xbb\x51\x9b\xb6\xa9\xa8\xf5\x12\x9e\xf2\x21\x57\x4c\x97\x72\x0b\x6a\x37\x2f\xad\x9b\x90\x81\x52\x1c\x37\x57\xeb\x63\x37\x6f\x7c\xea\xb9\x7d\xe8\xfd\xae\x81\x81\x52\xb2\xf0\x0c\x29\x39\xb3\x87\x8a\x13\x7a\x2f\xcc\x82\x3e\x23\xde\x0e\x5b\x84\xf2\x18\xcb\x16\x15\xd3\x57\x4e\xb8\xba\x04\xf5\x22\x23\x01\xff\x4d\x22\x02\x26\xd6\xd6\x6e\xb9\x2f\x25\xec\x9d\xf7\xb9\x9b\xe7\x46\xbd\x2a\xbd\x5f\x12\x72\x98\x59\x09\x06\x5a\xb3\x7b\xf6\x83\x0b\x4d\xca\xd6\x68\x56\x81\xf5\x29\x79\x15\xec\xde\x4c\x94\xfa\x65\x7d\x26\xd2\x9f\x7e\x96\x7d\x44\x75\x82\x15\x9c\x34\xa4\xcd\xd1\x76\xac\xaa\x54\xfb\x91\x2f\x7b\x68\x36\x4a\xb6\x6b\x07\xcf\x22\x2a\x25\xdc\xd5\xf4\x49\x93\xdd\x46\x6a\x33\xc6\xa5\x3a\x3a\x7a\xe7\xed\xe3\xa3\xa3\xa2\x9b\x6b\xb6\x7b\xb6\xd3\xf4\x53\xef\x9e\x46\x8a\x7b\x3b\x1a\xef\x87\xec\x48\x0c\xc4\x3a\x62\x89\x87\xd3\x3f\x86\x56\x63\x64\xf6\x6f\xef\xdf\x5f\x24\xf7\x34\x18\xef\x49\xd5\x33\x6d\x98\x7c\x44\x21\x76\x6e\xe7\xf7\x24\x4d\xa3\x15\x34\x58\xaf\x14\xea\xd7\x3c\x4d\xb9\x91\x81\xd8\x6b\xd0\x9b\xa4\xc4\x2c\x41\x97\x54\x65\x62\x1d\xd5\x57\x6b\x96\xb2\x15\x15\x39\xbf\x20\x8a\x8a\xf5\x13\x97\x72\x88\x8e\x11\xf4\xf6\x3c\x20\xcb\x9e\xe7\x21\xc6\x9d\xe6\x31\xee\x34\x8d\x81\xa7\xe7\xe7\x2f\xde\x11\xdd\x2e\x05\xc4\x3a\xc7\x4e\x29\x2b\x9a\x14\x25\x34\x59\x00\xd8\xa1\xb8\x51\xf2\xe3\x8e\x1c\x2e\x9e\x9d\x14\xf8\xef\xf8\x9b\xd9\xb3\xaf\xbf\x2c\x9e\xfd\x19\x3f\x3c\xfb\x72\xf6\xec\x2f\xf6\xd3\x37\xee\xe3\x9f\xf3\xca\x84\x4e\x25\x84\x3b\x8c\x3b\x31\xfa\x83\xf4\x5a\x0b\x5c\x7c\x01\x2d\x3d\x5f\x2b\xbd\xf0\x07\x5b\x20\x59\x16\x4c\x1e\xbb\x49\x17\x05\xf9\x3e\x09\xa4\x54\xf2\x9b\xa2\xb4\x4e\x33\x5b\x37\x2a\x33\x0d\x2d\x51\x60\xd9\x00\x18\xfb\x8b\x27\xda\x54\xfc\x13\x20\xff\x20\xb9\xbc\x62\xf4\x11\xd9\xe0\x95\x5b\x21\x30\x82\x0f\x91\xe9\x6e\x71\xae\x43\x4a\x78\xf4\x15\xdd\x52\x42\xd7\x18\x91\xbb\x04\x20\x1b\x63\x1a\x7d\x7a\x7c\xec\x81\x2d\xa4\x5a\x1f\x2b\xc0\x02\xa0\x12\x8e\x37\xa6\xe6\xc7\xf8\xb4\x2e\xec\xdf\x4f\x99\xf0\x4b\x3a\x2f\x41\x8d\x11\xb5\x16\x75\x17\x2f\x5f\x13\x10\xa5\xb4\xea\xe6\xf9\x19\xb1\x23\xd9\x8a\x95\xbe\x8c\x83\x71\x20\x0d\x35\x9b\x59\x84\x74\x0b\x8a\xad\x92\xbe\x8f\x8f\x83\x9e\xd1\x06\xdb\x0d\x2c\xf4\xe8\x23\x2d\x1a\x25\x8d\x2c\x25\xc7\x68\x08\x16\xf7\x68\x6f\x12\xb6\x1a\xe6\x5a\xf3\xb9\x9b\x66\x4e\x5b\xb3\x01\x61\xfc\xb2\xee\x71\xa4\xb8\x64\x17\x1c\x6f\xa9\x3a\x56\xad\x38\xd6\x50\x2a\x30\xfa\x38\x95\x9b\x59\x42\xf6\x82\x8c\x96\xa5\x6c\x85\x09\x1f\xe7\x25\x2d\x4a\x65\x16\xc8\x04\x91\x82\x3a\x6c\xe5\x21\x68\x14\x13\x25\x6b\x28\x1f\x59\x44\xff\x1e\x33\x9b\x7e\xcc\xa1\x9e\xfa\x64\x36\x46\xcc\x97\xa1\x24\x9e\x09\x42\x07\x30\x85\xd1\x0b\x2b\x9d\x42\xe5\x8e\x17\xc9\x81\x34\x83\x3e\x79\x5c\x84\xba\x27\x2f\xc2\x1e\xbe\x2d\xc5\xb7\x7a\xa7\x0d\xd4\xa7\x35\xd5\xd8\x2f\x64\x05\x17\xfa\xc3\xe2\xdb\x0d\xbd\x36\x4c\xce\xa5\xe0\x4c\x40\xe1\x3e\x15\x7a\x5b\xfa\xd5\x4b\xf1\xed\xca\x42\x60\x15\xa0\xe4\x50\xd8\x0f\xee\xe7\x9b\x11\x9f\xac\xd0\xb1\x3c\xf3\x13\xd3\x06\x5c\xa1\x2c\x26\x2f\x4a\xaa\x4d\xa8\xf1\xd4\xb7\x96\x55\xc1\x47\x03\xa2\x82\x2a\xa0\xa7\xdc\xc0\x88\x48\xf5\x6b\xeb\x0f\x1a\x5f\xc3\xb6\x7f\x8a\xde\x57\xd2\xe9\x8c\x57\x9c\xae\x83\x9f\x18\x96\x24\x57\xb0\x23\xad\xa6\x6b\x6b\x59\xa2\x32\x7d\xdc\x63\x75\x82\xfa\x66\xb4\x8f\xb4\xc2\x2c\x7d\xff\xcd\x5a\x5a\xb4\xaa\x94\xa7\xd1\x54\xaf\x11\x28\x15\x25\x62\x6c\x5a\x61\xc2\x4a\x08\x4c\x2e\x2d\x26\xff\x71\x34\x71\x26\xfe\xc4\xeb\xbd\x09\x82\x8b\x8c\x31\x0b\x76\x36\x28\x8d\xc3\x5c\xec\x06\x1d\x09\x01\x06\xd3\x33\xa8\x4f\x57\xb4\xcc\x1a\x93\x16\x93\xa3\x49\xaf\xba\x93\x6a\x7d\x2d\xd5\x98\x18\x11\x32\xac\x7f\xdc\x09\x33\x8b\xa3\x2e\x42\x67\xa4\x7f\x34\x68\x64\xb7\x1a\xd4\x82\xc8\xc6\xf5\x4d\xa1\x4e\xbc\x77\x65\xeb\x00\x7b\xbb\x6a\xc8\xac\x32\xf3\xeb\xaf\xbf\xe9\x6d\xcf\xd3\xc5\xd8\xed\xf9\xc7\x7d\x5d\x7e\xf2\xbd\xb0\xac\x12\x0f\xc3\xd3\x56\xb7\xe2\x52\xf7\xe9\x25\x03\xc1\xee\x7d\xe4\xf2\x18\x47\x4d\xae\xdf\x00\x7e\xbb\xf3\xde\x4c\xd8\x77\x72\xe6\xaf\x1b\xc0\x9d\x0d\x68\xa1\xac\x85\xea\x06\x28\xfa\xf1\x86\x5b\x98\xc5\x9d\xf9\xd8\xc6\xaa\xb3\x68\x2c\xd2\xaa\x62\x98\x74\xe2\xf1\xd4\xfd\x54\xd6\xbc\xae\xb0\x01\xab\x62\xe2\x9e\x46\xc7\xff\xc2\xbf\xe7\x1f\xb6\xf5\xdc\x19\x35\xbf\xbd\xfa\xe5\xb5\xe7\xc1\x6e\x2f\x81\x5f\x2c\xc5\xdb\x3e\x6c\xeb\xc7\x8b\xb3\x59\x28\xba\xf1\x35\xd3\x77\xda\xf0\x11\x6b\x34\xab\x56\xec\xf5\xfe\x3d\x69\x37\xa2\x82\x65\xbb\xbe\x3b\x61\x15\x4d\x4e\x05\xb5\x34\xe0\x86\xad\x7d\x71\x8e\x8f\x21\xf9\x2f\x2d\xdd\x3a\x78\xa9\x31\xb4\xdc\x24\x9f\xec\xd5\x2f\xaf\x67\x04\x8a\x75\x31\xf3\x75\x20\x58\x94\xbd\x92\xea\x9a\xaa\xca\xf1\x5d\x07\xac\xb9\x6e\x75\x03\xe2\x6e\xfc\x5c\xba\xe7\x1c\xe6\x0d\x55\x6b\x30\x78\x24\xac\xae\xa1\x62\xd4\x00\xdf\x85\x4a\x40\x13\x2b\xa9\x39\xd5\xda\x9e\x28\x97\xb4\xc2\x33\x48\x62\x89\x59\x1d\x6a\x3d\xa5\x11\x6b\x5b\x0b\xc3\x68\x5f\x82\x88\x43\xfc\x39\x59\x1d\x80\xb5\x35\x81\x40\x58\xbf\x52\x9a\xcb\xf5\x40\x74\xb0\x8f\x04\xaf\xa1\xc6\x48\x29\x45\x85\x46\xa9\x1b\xb4\x1a\x35\x41\xab\x49\x64\x5e\x6f\x5e\x60\x47\x29\x5c\xf3\x1d\xe1\xb4\x15\x78\x44\x16\xc0\x04\xca\xd1\xe9\x57\x27\x27\x5f\x75\x80\xf9\x54\x59\x61\x27\x0e\x63\x63\xde\x83\xd6\xc0\xc1\x3c\x62\x9a\x2d\xac\x90\x18\x97\xc6\xa5\xfc\x77\x81\x9b\x7c\x78\xfc\xc7\x30\x82\x89\x81\xb0\x94\xcf\xbd\x3d\x69\x56\xfe\x84\xd4\xb3\xdf\xbb\x8b\x08\x7b\xb9\x5c\x25\x54\x58\x62\x36\x1b\x60\x2a\x3a\xcf\x5d\x39\x7c\xb8\x1f\xef\x9b\x76\xca\x94\x47\xd9\x62\xcf\x6f\xa8\x85\xf1\x60\xb8\xce\x5b\xa4\x60\x49\xab\x74\x3c\xa1\x06\x20\x3b\xa6\x44\x60\xdd\xa4\xd6\x18\xd7\x3c\x52\xd6\x1e\x2d\xf5\x1c\xff\x5b\xc2\x51\x41\x09\xa2\x6d\x75\x43\x9e\xcc\x6a\xc8\x30\x63\x08\x4f\x0d\x57\x01\x66\x51\xd6\x94\xee\x2a\xc8\x3b\x3f\x6f\x5e\x6c\x99\x4f\x9a\x9a\xec\xaa\x4a\x23\x51\xcc\x75\x49\xb1\x5d\xe1\x10\xbb\x1c\xdc\x87\xb9\x91\xf3\xdf\x41\xc9\x29\x59\x01\x35\xad\x02\x3d\x23\xcb\xd6\xf8\x06\xe9\xf0\x1d\x66\x06\xb0\x82\xa0\x06\x6a\x97\x5d\xb5\x3c\x99\x8e\xbe\x1c\xa1\xd5\x70\x4b\xac\xf0\x89\x73\x4c\x40\x07\xea\x83\xfb\xc5\xd3\x4c\x46\x1c\xd9\x54\x5e\xb5\xc4\xee\x13\x57\xab\x60\x24\x06\x92\x16\x9b\x86\x16\xd9\xc3\x85\x27\xd5\xa2\x82\xad\x4f\xc8\xde\xf6\x40\xf6\xc3\xb4\x78\x67\x59\x36\x28\xd7\x00\x48\x25\xcb\x36\x15\x1a\xa1\x33\x29\xb1\xd8\xdd\x52\x7f\xb4\x47\x86\x30\x50\x83\x51\xac\x7c\x18\x14\xb8\xb9\x6e\xc2\x41\x56\x8b\xb4\x08\x15\x0c\x8a\x2c\xca\xa6\x0d\x1f\x1f\x73\x9f\xce\x40\xb8\xcb\xa5\xb9\x04\xaf\xd5\x91\xd1\xb1\x88\x2c\x02\xed\x8b\x10\xa4\xc2\xf6\xc6\x06\x54\x69\x01\x59\xa3\x2f\x67\x0d\x91\xec\xf6\x90\x7d\xa4\x4c\x53\xfd\xdc\x85\xac\x1e\x62\x73\x35\x13\xc8\xe2\x30\xc6\x4d\x0b\xfd\x9f\x22\x76\x2d\x5c\xc4\x5b\x50\x92\x6f\x11\x84\x97\xb5\xeb\xc4\x0e\x2b\xbd\x6f\xea\x83\x3e\xd0\xe4\xe8\xc8\x4a\x92\xa3\xa3\x4c\x0f\xcc\x82\xc0\xc0\x99\xf7\x6e\xe7\xd0\x28\x86\xa0\x22\x95\xbc\xc6\x5c\x93\x9d\xc0\x09\x16\x21\x4d\xe6\xda\x24\xe9\x5a\x65\x8d\x9f\xa8\x8b\x1f\x02\x73\xf4\xe3\x38\xcc\x9d\x09\xd2\x36\x0d\x28\xe2\x52\x04\xd1\x88\x1a\x40\xa2\x37\x7e\x55\x14\xd3\xc4\x2a\x36\xce\x81\x0f\x62\x30\x00\xbe\xa1\x9a\x58\xc9\x65\xf1\x51\xd2\xc6\x47\xb7\x71\x46\x47\x54\x3a\x55\xf9\x68\x43\x39\x77\xc3\x1f\x88\x37\x1e\xac\x64\xad\xaf\xda\x62\xe9\x5a\x29\x85\x73\x1a\x35\xd9\x48\x5e\x9d\x1e\x75\xda\xe2\xd1\xb3\x8a\x95\x1a\x7e\x0e\xaf\xa1\x8f\x50\xb0\x67\x65\xbc\x37\xd4\xbe\xa1\x02\x72\xe2\x23\x56\xad\x7d\x46\x2d\x5b\xdf\x98\x78\x18\x23\xc2\x1b\x0f\x5d\x6c\xfa\x50\xa1\x0e\x76\xbb\x6b\xbf\x0f\x43\x52\xae\x1e\xcb\xe3\x9c\xc9\x86\xb5\xbe\xd1\x16\x52\xfb\x36\x81\xab\xbc\x5f\xb5\x9c\xc7\x89\xba\xc6\x1b\x0b\x75\x0d\xae\x8c\x18\x2b\x8f\xcf\x5e\xbf\xfc\xe9\x1f\x3f\xbe\x39\x7b\x7f\xfe\xcb\xcb\x7f\x3c\x7f\xfb\xe6\x87\xf3\xbf\xfe\xfc\xee\xec\xfd\xf9\xdb\x37\xf6\x91\x57\x97\x6f\xdf\x10\x47\x42\x45\x76\xff\x44\x9a\xde\x57\xd9\xba\x82\x19\x63\x4f\xbe\xf5\x59\x67\x84\xa3\xbb\xfe\x9e\x13\xed\x4e\xd8\xcd\x1c\xcd\xf6\x1b\x32\xca\x43\x74\x12\x8b\x94\x9f\xb6\x99\xde\xc1\xc2\x18\x6d\xdb\x05\x25\x98\xec\x1d\xb4\x73\x30\x7b\xc7\xdb\x3d\xaf\x1c\x80\x0d\x15\x02\xf8\xdc\x53\xd5\x48\x8f\xee\x27\x6f\x95\xfb\xd1\x3e\x12\x42\x35\xf1\x85\x78\x72\xd5\x75\x98\xdc\x61\x5a\xe0\x63\xcf\x04\x16\x41\x87\x09\xbc\x59\x2f\x95\xa3\x0d\x47\x4a\x3f\xbf\x3b\xd7\x83\xa0\x32\x71\xf5\xd9\x80\x56\xa0\x0d\x13\xb1\x00\xfb\xe1\xa1\x0d\xc6\xef\x1f\x82\xd9\xc1\x75\x3f\x01\x4d\x61\xf0\x67\xe2\x29\x1a\xfe\xa3\x10\xb5\x85\x4f\xc6\x12\x8e\xc5\xe7\x75\xaa\x71\xdd\xab\xd6\x5b\x02\xd1\xed\xd2\x0e\x5f\x22\xdb\x0c\x82\x9c\xcd\xb4\x0f\x2f\x39\xf4\xd7\xbf\xd0\xd4\x10\xb1\x54\xf2\x0a\x54\x76\x73\x02\x6a\x9e\x89\x17\x4c\x93\xe9\xc0\x1e\x3f\xe5\x44\x46\xed\xb0\x51\xb2\x6a\x4b\x78\xc8\x8d\x75\xe0\x5f\x31\x6e\x40\xf9\x43\x9a\x07\xda\x1c\x5d\x32\xe7\x86\x7b\x43\x18\x01\xea\xd5\x2a\x6f\x80\x56\xa0\xc8\xa4\x84\xb9\x57\xb0\x1b\xa6\x8d\x54\xbb\x49\x41\x2e\x99\x28\xbd\x20\xb5\x32\x1d\x5b\xb1\x40\x31\x34\x69\xb8\x1f\xd9\xb1\xb5\xa0\x96\x5b\xa7\xc6\x28\x59\xb5\x26\xbb\xf6\x28\x53\xa4\xb3\x0c\xa8\x4c\xb3\xa0\x77\x3b\xd8\x4a\xc7\xb4\x8b\x99\x45\x1b\xa3\x76\x11\x44\x2a\xc8\xb3\xc0\xad\xdd\xcc\x74\x1d\xc5\xea\xdc\x8a\x65\x7a\x77\x3d\x43\xc0\x57\x90\xe6\x78\x4e\x97\x8e\xf1\x1b\x05\xe4\xa4\x78\xf6\x15\x71\x73\xb1\x25\xe3\xcc\xec\xc8\x8a\x7d\x04\x4d\x0e\x03\x9d\x67\x9b\xef\x6e\x5d\x77\x2f\xc2\x60\xe2\x6a\xbe\x64\xa2\x0a\x4a\xe6\xf6\xab\x00\x31\xb8\xe1\x1f\x1f\xaa\x0d\xa3\x38\x21\x5e\x8c\x92\x54\xd1\x25\x13\x57\xdf\xfb\x31\xc1\x6a\x29\xde\xa3\x3e\xcc\x94\xd8\x20\xae\x9d\x53\xa6\xdd\xbc\x6b\x0e\x38\x7d\x71\x5b\x51\xd9\xb8\x29\x0e\xe1\x63\x09\xcd\x0d\x23\x18\x9a\xdb\x6e\xea\xb4\x0b\x07\xf7\xf4\xde\x26\xb2\xbf\x48\x2c\xda\x76\x29\x05\x8c\x81\x9d\x60\x27\x64\x96\x49\xaa\x21\x71\x39\xe9\xc7\x6c\xf5\x7d\x8d\x2b\xdc\x12\xbc\x1a\x3a\xe4\x8e\x99\x6a\x9d\x5e\x65\xbd\xdc\x2c\x30\xd5\x2d\x0d\xaf\xa4\x3d\x79\xee\x38\x1b\xeb\xd3\x43\x71\x55\xb4\xd5\x8f\xdc\x4e\x8f\x82\x3d\x8f\xdc\x27\x05\xe6\xb8\xac\x08\x43\xe7\x46\x94\xfe\xc6\xcd\x83\xbc\xed\xac\x0b\xcd\xb5\x33\x2f\x03\x79\xba\x69\x93\x1a\x42\x51\x80\x6b\xb8\x94\x03\x59\x58\x0e\x3e\x9c\xb8\xe7\x4e\xb9\x2c\xaf\x10\xf3\x06\xb8\xdd\x71\x7d\xba\x94\x46\x4f\xa6\x45\x51\x2c\x0a\xf2\xe6\xed\xfb\x97\xa7\x4e\xfe\x78\x7c\x55\x95\x76\xd2\x92\x62\x33\x4b\xcd\x5c\x9b\xe9\x50\x05\x63\x2c\xb0\x74\xb5\x1a\x9d\x06\x5e\x05\xb4\x3a\xbe\x56\x2c\x5a\x6b\x35\x6d\xb4\xef\x2e\xa2\x78\x79\x5c\xdc\xb7\x02\x6b\x43\xba\x1c\x7b\x14\xd8\x49\xf3\xf4\x57\x41\xa9\x14\x35\xd1\xad\x11\xc8\xa7\xdd\x15\x7a\x0f\x56\xd3\x19\xaf\xf5\x12\x84\xae\xbf\xce\xc1\xd0\x29\x32\x2b\x79\x5b\xc1\xbc\xb2\xa7\x4e\x8d\xfd\xa3\xd3\xf4\x73\x67\x5a\x56\x38\xf8\x5d\x25\x44\x70\x37\x5c\x4f\xa3\xdd\x0a\x35\xd6\xe1\x14\x94\xef\x7e\xf7\xc1\x31\x6f\xc3\x95\xb2\x72\x75\x31\x55\xd5\xed\xdf\x89\xbd\x52\x28\xdd\x1c\x54\xc9\x26\x2b\xb0\x99\x32\x23\xf5\xc5\x1e\xfd\xfa\xc6\x6b\xf4\xb6\x16\xa8\x81\xfc\x77\x08\x5f\xbf\xf8\x33\x25\xe3\xfc\xe5\x98\x39\x30\x37\x89\xdb\x7d\xef\xa5\xa6\xcd\x98\x52\xd7\x37\xb4\x86\xec\x22\x39\x37\x30\x6b\xb9\xc8\x48\xc8\xaa\x7e\x27\x83\xec\xd6\x0a\xf2\xc2\x2d\x8d\x1c\x36\xf9\xbf\x19\xf5\xe2\x25\x4e\xff\x6f\x6e\x9f\x9a\x14\x2f\xa0\x51\x80\x17\x6c\x9e\x86\xfe\x3c\x04\x7c\x12\xe4\x12\x3e\x3d\xe9\x14\xce\x76\x7e\x1a\xb1\x8b\xc1\x4d\x1c\x73\xa0\x1a\x86\xda\x90\x3e\x7b\x4f\x43\xa0\x9a\x50\x9e\x7e\x47\x02\x70\xd7\x20\xa8\x03\x02\x3a\xbf\xcc\xd0\xae\x83\xe9\x80\x89\x8b\xe4\xbe\xa6\xcd\xc4\x32\xef\xe4\x27\xbb\xa9\xc9\xb4\x07\xa9\xfb\xb6\x73\xff\x0c\x5d\x02\x9f\x5f\xc1\x98\x16\xd2\x9f\xb0\xd4\x72\x10\x3f\xac\x02\x61\xd8\x6a\xe7\x5a\x04\xa5\x6b\xed\x34\x90\x6c\x8e\x01\xb4\xb9\x9e\x5f\xdf\x00\x2c\xd5\xfa\x38\x43\xe3\x00\x8c\x18\x45\x1b\x0d\x65\x16\x73\x7b\x00\x58\xfb\x8a\x01\x6f\xec\xfa\x22\xe5\x53\x41\xd0\x86\x3d\x5e\x0a\xd4\xfe\x78\x76\x71\x4e\x5e\x5c\xfe\x74\x7b\xa3\x21\x96\xea\xc5\x86\xaf\x4e\x5a\xc9\x47\xd6\xc2\x54\x56\x03\xf6\xab\xa2\xf3\xb6\x27\x6b\x4c\x3d\x62\xef\xe0\xdb\xeb\x74\x85\x25\x08\xed\x13\x10\xbe\xc5\x14\x37\x00\x55\x66\x91\x2c\x81\x4b\xd7\x37\xdd\x3f\x89\x25\x60\x9a\xc6\x8f\xc0\x2c\xa7\xa2\x42\xaf\x30\x04\x27\x84\x34\x21\xab\x26\xaa\xde\x3d\xcc\xf9\x2c\xd2\x47\xdf\x34\x78\x2b\x32\x2e\xfd\xa4\xe3\x4f\xce\x4c\x9e\x67\xfb\xbc\x47\x4d\xa8\xd7\x1a\x39\x92\x5c\x49\x54\x40\xa0\xea\x94\x52\xf8\xb5\xee\x75\x7f\x73\xb6\x8c\xc7\xfd\xfe\x0a\xb1\x54\xa3\x5a\x3e\x62\xd9\xf5\xc5\x8b\xef\xef\xb0\x97\x2f\x64\xf5\x82\x69\xd5\xe2\xa0\xef\xdb\x6a\x0d\x26\x49\xdc\x10\xed\x3f\xef\x5e\x13\xfd\xb4\xa9\xa3\x66\x62\x4e\xb7\x94\x71\x3b\xcf\xc8\xca\xb8\x94\x05\x41\xc1\x38\xb4\x6f\x64\x53\xac\x1e\xd6\xc6\x4b\xd6\xb8\x8a\xbf\x24\xc9\xba\xf9\x5b\x86\x2e\x43\x71\x9e\x7a\xc0\x5d\xac\x9f\x0a\x42\x97\x5a\xf2\xd6\xa4\xe5\x94\xeb\x77\xf7\xb9\xb7\xe2\xad\xf3\x24\x50\x01\x2e\x3a\xdb\xf0\xc5\xa6\x35\xfd\x38\x6f\x45\xf6\xad\x5f\x22\xde\x62\xd1\xcf\x0c\x65\x0f\x3f\x30\x26\x82\x3b\x2c\x1e\x18\x09\x59\x09\xcf\xb3\x05\x61\xfb\x88\xb0\x36\x21\xd7\x32\xf4\x35\x4c\x7b\x58\xeb\x63\xc8\xe1\xad\x3b\xc5\x3e\xd6\x22\x37\x7a\x3e\x7c\x3c\x0d\xd0\x2b\xe1\xc1\xc4\xcd\xd2\x1a\x62\xee\x33\xe2\x36\x2b\xd2\xa3\x5a\xb3\xb5\xe8\xdf\x3c\x95\x26\x91\xbd\x9f\x0a\x72\x2e\x48\x49\x7d\xce\x22\x3e\xc7\x34\x41\x87\x4f\xac\x67\xc9\xad\x70\xa8\x74\xa9\x61\x2b\x17\x50\x99\x60\x11\x9c\x3b\x80\x30\xba\x20\x18\x00\xf1\x05\x15\x18\x78\xf2\x8e\xa4\xd3\xc1\xab\x96\x13\x7f\xb1\x27\x7c\x34\x3a\x5d\xb3\xac\xe0\x40\x13\x21\xe3\xc5\x2f\xfe\x5e\x65\x42\xfd\x85\x29\x03\xf7\x2b\x77\xa0\x76\x49\x2e\x29\x12\x46\x3b\xf7\x92\xf8\xcb\x2e\x35\xde\x16\x33\x23\xda\x87\xdc\xdc\xb2\x96\x0c\xeb\x25\xa0\xbf\x90\xea\xd5\x58\x6d\x49\x4c\xc1\x9a\x69\xa3\x76\x4f\xbb\x1b\xcf\x9d\xc7\xdc\xef\x76\x4c\xa3\xdc\xde\x09\x1e\x42\xdd\x98\xdd\x34\x61\x34\xc6\x90\x06\x28\xe3\xde\x2e\xec\x5e\x6b\x5e\x05\x06\xca\xec\x26\xae\xbc\x99\x98\xad\x06\x28\x2b\x70\x62\xb0\x63\x0e\x59\x32\x5b\xc3\x77\x9d\xe3\x97\x82\xef\xb2\x0b\x2f\x1a\x25\x6b\x30\x1b\x68\xef\xdd\x7c\x7a\x9f\xb8\xd3\x45\x5c\x25\xb4\xa6\xe6\x0d\x69\xe9\xd7\x79\x08\x72\x66\xc9\x04\xcf\x3b\x41\x07\xeb\xd0\xc8\xba\xff\x7a\x0d\x6c\x4c\x5d\x5c\x86\x76\x51\x94\x5b\xfe\xd3\x6b\x29\x98\x91\x6a\x91\xcc\xb3\x54\xb3\xea\xf0\x1c\x4a\x96\xbc\x60\x2b\x15\x6d\xfa\xe1\xa6\x59\x3f\xde\x94\x6d\xeb\xad\x77\xfc\x5d\xe6\xd2\x15\x79\xe8\xd8\xc2\xb9\xa5\x6a\x47\xf6\xca\x42\xb2\xaa\x86\x50\x5a\x48\x7e\x0d\x6d\xb8\xe1\xa0\xff\x7f\xf7\x8e\xda\x59\x9c\x1b\x93\xe0\x7e\x7e\x07\xd2\x6b\x56\x2a\x79\xe1\xf3\xa0\xaf\xdd\x63\xe1\x06\xbe\xd8\xde\x8b\x3d\x61\x58\xfc\x3a\x62\xc6\xde\x26\x5f\xbd\xfe\x37\x7c\x40\x19\x50\x05\xf9\xf5\xec\xdd\x9b\xf3\x37\x7f\xf5\xd7\xe7\xa3\xeb\x90\xdd\x6a\x74\x13\xe2\xd3\x9d\x7f\x98\x00\xf0\xc5\xe1\x6b\x66\x36\xed\xb2\x28\x65\x7d\x5c\x4a\x05\x52\x1f\x27\xc2\x9c\x87\xa0\xca\x6f\x03\xf8\xfe\x7b\x10\x84\x71\x7e\xac\x3c\x67\x21\x7a\xb9\x8c\xa5\x12\x50\x15\xe4\xdf\x65\x8b\x27\x8c\x05\x48\xa1\x7f\xaa\x0e\x20\x1a\x19\xfa\x6a\xa2\x20\xdd\x23\xdc\x78\xb3\x16\x33\x1b\xd9\x9a\xdb\xc9\x60\x6f\x34\x1b\x7e\x7b\xd0\x93\x8e\xc5\x8d\x6d\xfd\xc8\x36\x7b\x53\xf7\xc7\x5f\xbe\xfe\xfa\x2f\x0b\x7c\x65\x93\xbb\xc5\xdc\x51\xa5\xa7\xf0\xc1\x1b\xbb\xfd\xd9\x8c\x6e\x96\xa0\xb7\x10\x9e\xdd\x66\x90\x92\xbd\x7a\xeb\x5b\x96\xbe\xbf\xdf\x72\x33\x04\x6e\xaa\xfd\x0e\x9c\x7d\x52\x8c\x4d\x4f\x9f\x1a\x76\x7b\x1f\xa2\xc5\x9e\x3d\x62\x90\x27\xa8\xf2\x3b\xd8\xbb\x5f\xe4\xeb\x6e\x40\x77\xf7\x95\x61\xcc\xc3\x2c\xb2\x39\xaf\x60\x37\x2d\x52\x64\x34\xbf\x73\x9b\xc3\xca\x10\x54\xa2\xe9\x1a\xaf\x59\x7c\xf9\x88\xbf\x9e\xc5\xbd\xa9\x27\xd4\xbc\x66\x20\x0d\x9b\x37\xb9\xb5\x76\x6e\xc2\xcd\xde\x7d\xac\x3a\x11\xe6\xa9\x2b\xd3\x78\x2d\xe7\x73\xd7\x60\xf9\x98\x6e\x63\xcb\x39\xb9\xc4\x55\x3c\xef\x6b\x97\x96\xb2\xcb\x13\xb7\x7c\xbc\xcd\x5c\x56\xb3\x14\x84\xc9\xe2\x76\x98\x2c\x30\x8a\xc1\xb6\x7f\xc9\xbc\xb3\xc2\x5c\x64\x46\xc4\x7b\xfc\xa2\x59\xe6\xc4\x4f\xbe\x54\xdf\x60\x27\x35\x15\x2d\x9a\x1c\x52\xe1\xfd\xa2\x68\xf1\xee\x64\x7b\xb0\xed\x28\xa6\x5e\x5b\x11\x96\xe3\x65\x0b\x26\x88\xc2\xd2\x61\x53\x8b\xcc\xdf\x09\xef\x3b\x71\x21\x6a\x7f\xc9\xa2\x83\x2b\x33\xd4\x11\x5c\xdc\xd8\x98\x9b\x24\x76\x56\x94\xa7\x37\x19\xdc\x17\x4c\x54\xff\xd6\xa0\xd0\xba\xad\x43\x08\xa9\x8f\x47\xe6\x6b\xcd\x1a\x85\xd9\x27\xec\xfa\xdb\xc9\x36\xdb\x6c\xf7\xa2\xd5\x01\x28\xec\xa6\x30\xa2\x86\xfb\x9a\x39\xb0\xa9\x88\x72\x39\xe5\x97\x9e\xb4\x25\xed\x4e\x6b\xf4\xbb\x13\x7a\xc4\x27\xf0\x2d\x09\x32\x34\xd1\xfb\xa0\x09\xa2\x33\x13\x0f\x31\xd5\xdf\xb1\x88\x0d\xbd\x02\x91\x0c\xe2\x41\xb2\x4a\xe7\xd1\x91\x17\x9f\x59\xdf\xd8\x6b\xaa\x8f\x16\x77\x5c\x6c\x8f\x8b\xad\x89\xee\xb4\xbc\xb5\x82\x76\x0d\x90\x45\xb7\x83\xbb\x92\xe5\x15\x28\x37\xf1\x07\x2d\xc5\x22\x89\x25\xff\x2e\x82\xc7\x73\x9b\x83\x21\xd9\xbf\x3f\xc0\x64\xbf\x45\x33\xf4\x96\x24\xd0\x93\xa5\xd1\x88\x88\x3b\x7c\xae\xfd\xfd\xba\xb3\x3a\x54\x80\xfd\xba\xae\xb2\xd7\x97\x51\xb4\x1a\x52\x11\x28\xa6\x92\x47\x28\x8d\x5b\xcf\xe1\x9d\x9d\xe4\xae\x77\x30\x99\x9e\x49\x9d\xfc\x37\x9f\x32\x1f\x52\x85\x4f\xf9\x70\xc6\xb6\x64\x8f\xb9\x09\x07\x51\xd0\x89\x66\x73\x3d\x77\xaf\xb1\x1b\x5b\x4f\x69\x0f\xe2\xfd\x4f\x97\x24\x1b\x85\x23\x66\x84\xb3\x2b\x20\x0b\xa8\xd6\xb0\x98\x91\x45\x43\xb5\xf6\x97\x11\xb9\xc6\x0c\x05\x20\x4a\xb5\x6b\xcc\xa2\x5b\x75\x9d\x0e\x68\xbf\xee\x3a\xeb\x94\xbd\xa1\xfa\xda\x6e\x20\x6b\xf0\xbd\xc7\x06\xfa\xcd\xfa\xd8\x48\xfb\xc0\x90\x8d\xcb\xf1\x0d\x41\x74\x05\xbb\x47\x83\xca\x5f\x01\xf2\x69\x28\x43\x55\x2d\x15\x33\xbb\x3f\x04\x83\x59\x35\xe5\xa7\xc1\x9d\x97\x63\x76\x6e\x30\x81\xf4\xaa\xb5\x60\x21\x62\x99\x5d\xc8\x55\xd3\xce\xb3\xfe\xdb\x15\xb3\xf0\x66\x73\x16\xc4\xd5\x35\x38\x93\x39\xd2\x78\x87\x3b\x30\x80\xc9\x38\x64\x0d\x22\x7e\xe9\xaa\x53\xde\x82\x77\xba\x22\x8b\x2a\xd7\x15\xc6\x0c\x62\xca\xbd\xa1\x87\xe0\xb5\x14\x31\x95\xa6\xa1\xb4\x9c\x5e\x4a\x21\xc0\x07\xbc\x57\x61\x29\xe0\x55\x7c\x69\x4f\x30\x5b\x67\x49\x00\x28\x52\xd3\x5d\x0c\x88\x86\xae\x89\x1e\xa2\xa8\xa8\xe2\xeb\x2f\xac\x28\x41\x4b\x64\x4b\x39\xab\xc2\x15\x57\x4c\xac\x11\x90\x8d\x75\x55\x43\x41\x0d\x3e\x76\xe8\x3f\x15\x22\xbc\xf5\xb3\xd0\xdb\x72\x3a\xf3\xdd\xb4\x3e\x1d\xc4\xc4\x4a\x51\x6d\x54\x5b\xa2\xbe\x08\x0e\x4d\xd5\x6d\xd8\xef\xd7\x51\xb9\x1b\x66\x1e\x9a\xcc\x98\x70\xf8\x9c\x5b\xf1\x95\x4b\xc4\xf1\x97\x3d\x77\x04\xb0\xbf\xee\xb9\x02\xca\xc3\x4b\x96\xfd\x81\x19\x45\x57\x2b\x56\x86\xb2\x2a\x2c\x15\xb4\xf2\xf2\x85\x53\x14\x4e\x56\xbe\x83\xd0\x5c\xe1\x1f\xff\xfc\xfd\xf6\x9c\xf4\xfb\x3b\x70\xb7\xaa\xe6\x6e\x73\xe7\xad\x61\xc6\x74\x91\x5d\xf4\xee\x43\x3c\x31\xe9\x75\x77\xf5\x8d\x53\x5c\xd2\xc5\x27\x9c\x8f\xea\x92\xa6\x87\x52\x75\x32\xed\xd3\xee\x0b\x8b\x23\xd5\xdd\xe8\x0c\xb1\xfd\x57\x1e\x67\x6d\x42\xb4\x7f\x89\x7c\x4a\x3b\xf9\xab\x00\x7b\xfd\x9a\x4f\xda\x80\x78\x98\x78\x3a\x96\x60\x61\x20\x3d\x1c\x9f\x75\xdc\x42\x76\xd9\x87\x87\xf6\x5e\x0a\xdd\x0b\x81\xdd\x5a\xf9\x19\x69\xa8\xf3\xfa\x37\xaa\xc9\x1b\x59\xc1\x85\x9d\x28\x4c\xfd\xa7\xd0\x74\xf6\x58\x31\x08\xb7\xc0\xb0\xa9\xd9\x45\x53\x48\x7b\xe4\x19\x7d\x5f\x53\x21\xaf\xe3\x3c\x32\x16\xb3\x22\x2e\x93\xa8\x8b\x75\x88\xa2\x72\x37\xe8\x5a\x07\x23\x26\x35\x2d\x3f\x9f\x5d\x9c\x93\x9a\x0a\xba\x06\xd7\xbf\xbc\x07\xde\x0d\x91\xd1\x7f\x79\x8a\xfc\xd4\x22\x45\x5d\x6e\x60\xb4\xaf\xed\x1e\x0e\xd5\x5d\xce\x91\x30\xd4\x5f\x0c\x1d\x0e\xa7\xff\x06\xc2\xce\x15\x43\xa3\xde\xc5\xe7\xae\x17\x32\x9b\xec\x2d\xb0\xf6\x5c\x99\x26\x4d\xbb\xe4\xee\x4e\xfd\xec\x32\xb3\xee\x12\x23\xa3\xc8\x18\x31\x4e\xf3\xeb\x74\x5b\xe8\xf0\x6b\x1e\x3b\x37\x65\xc4\xb9\xc6\xbe\x5d\x70\x60\x47\x96\xa3\xe6\xa1\xce\x29\xdd\x12\x77\xd3\x26\x7d\x05\x57\x81\xde\x7c\xf2\x14\x8d\xa2\xa5\x5b\xf1\xb1\x98\xfb\xbd\x5b\x61\x0c\x77\x7b\xc0\x03\x50\xb9\x46\xf5\xe9\x6f\xbb\x52\x98\x30\x4b\xc1\xf9\xdb\x82\x43\x3a\x23\xa5\xbc\xfd\x8b\xeb\x87\x3b\x18\x03\x41\xe3\x6c\x31\x15\x90\xe4\x81\x57\x72\x51\xbf\x91\x43\xff\xde\x01\x4d\x8e\x8e\x5e\x51\x58\x83\x3a\x3a\x9a\x16\x03\xbb\xfc\x1f\x21\xc1\xf0\x65\x50\x25\x6f\x31\x8f\x78\x43\x97\xc1\x10\xfe\x87\x32\x1c\xf7\x88\xe6\x89\xac\xfe\x35\xf0\x24\x6a\x88\xc0\x14\x3a\xae\x58\x51\x43\x23\x87\xdc\x58\x03\x39\x1d\x68\x5d\x1b\x09\x8b\xbf\xdb\x27\x52\x96\x07\x2b\xa7\xe1\x28\xf3\x86\x29\xf4\xc6\xcb\x4a\x34\xad\x1b\x0e\x6a\x74\xa9\xad\xb3\xd5\xec\x10\x1f\x42\x0a\x82\x61\x52\x4a\xa1\xcd\x64\x68\xee\x86\x2a\x5a\xdf\x73\xf2\xd8\xa3\x85\x83\xb3\x65\x9e\x4d\xa6\x5f\xfc\x77\x00\x00\x00\xff\xff\xa2\x7c\x24\x0a\xe6\x85\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe4\x7d\xfb\x73\x1b\x37\xd2\xe0\xef\xf9\x2b\x50\xbc\xab\xd2\xa3\xc8\x91\x9c\xad\x6c\xbc\xba\xf5\x6e\x69\x6d\x67\x57\x89\x1f\x3a\x5b\x49\xee\xab\xdc\xd6\x0e\x38\xd3\x24\x61\xcd\x00\xf3\x01\x18\xc9\xcc\xd5\xfd\xef\x57\x0d\x34\x1e\x43\x52\xd2\xc8\x91\x52\xba\x6f\x2b\x3f\xc4\xa4\x66\xd0\x0f\xf4\xbb\x1b\xa0\xd5\x5c\x58\x73\xf2\xd5\x8c\x49\xde\xc2\x09\xe3\x8b\x85\x90\xc2\xae\xbf\x62\xac\x6b\xb8\x5d\x28\xdd\x9e\xb0\x05\x6f\x0c\xe0\x37\x5a\x2d\x44\x03\xe6\xe4\x2b\xc6\x66\xec\x87\x7e\x0e\x5a\x82\x05\xe3\x3f\x4a\x6e\xc5\x15\x3e\x36\x63\xef\x3b\x90\x1f\x57\x62\x61\xbf\x62\xac\x06\x53\x69\xd1\x59\xa1\xe4\x09\x3b\x6d\x1a\x75\x6d\x58\xa5\xa4\x41\xc8\x52\xc8\x25\xbb\x5e\x89\x6a\xc5\xa4\xaa\xc1\x30\xbb\x02\x26\xa4\x85\xa5\xe6\xf8\x02\xeb\x54\xbd\x6f\x0e\x18\xd7\xc0\xa0\x11\x4b\x31\x6f\x80\x59\xc5\xe6\xc0\x4c\xb5\x82\xba\x6f\xa0\x66\x4a\x4e\xd9\x9c\x1b\xf7\x2f\xd6\xf0\x39\x34\x06\xff\x85\x4b\xe1\xa2\x53\xa6\x34\xbb\x16\x76\xe5\x16\xd6\xb3\x4e\xd5\x91\x4a\xc6\x65\xcd\xb8\xb4\x62\x16\xbe\xd9\xb9\x54\xa7\x6a\x44\x8d\x5b\x87\x08\x6f\x34\xf0\x7a\xcd\x74\x2f\x1d\xfe\x19\x2c\x53\xb0\x33\xbb\x67\x58\x2d\x0c\x9f\x23\x6e\xf3\x35\xab\x61\xc1\xfb\xc6\x16\x9e\x7f\x1d\x68\x2b\x02\x07\x3d\xcb\x41\xba\x67\xbf\x62\x8c\x31\xbb\xee\xe0\x84\xcd\x95\x6a\xdc\xc7\x01\xef\x5e\x72\x89\x84\xf7\x88\x9e\x55\xf4\x1a\x12\x47\xd0\x18\x67\xc8\x53\x5b\x20\x97\xfd\x3f\x0d\x33\x2b\x44\xd9\xae\x04\x32\xbd\x6d\x91\x18\x8f\xc4\xba\xc8\x50\xe8\x54\x1d\x39\x70\x27\x1e\xa7\xcd\x35\x5f\xe3\x72\xb3\x46\x55\xdc\x82\x61\x6d\xdf\x58\xd1\x35\xc0\x34\x74\x8d\xa8\xb8\x61\x6a\xb1\xb5\x95\xc2\xb3\xc9\xf0\xd6\xef\x0b\xdb\x27\xce\xb0\x43\x27\x5f\x87\x07\x5b\x18\xe5\x1b\x73\x27\x5a\xef\xe0\x0a\xf4\x23\x63\x85\x78\x47\x8c\x66\x5e\xd6\x32\xc4\xf6\x7e\xf9\xa7\xb1\x5a\xc8\xe5\xde\x36\x7a\xaf\x60\x21\x24\x18\xc6\x99\x01\x8b\xfc\x79\x34\x81\xdf\x62\xe3\xc3\xe0\xeb\x94\x60\x1f\x97\x6d\xd6\xcc\xae\x94\x01\xd6\x72\x5b\xad\x50\x05\x90\x0a\xb7\x3a\x33\xd0\x40\x65\x95\x9e\x32\x0d\x8d\x33\x08\xa8\xaf\xf8\xf7\xa5\xb8\x02\xe9\xf8\x68\x3a\x5e\xc1\x81\x57\x28\xbb\x82\x1d\xe4\x9b\x95\xea\x9b\x1a\xa9\x8e\xfb\x59\x3b\x1d\xbe\x55\x44\xfe\xff\x23\x50\x2a\xbb\x93\xc8\x40\xe2\xbc\x17\x4d\x0d\x7a\x60\x8c\xad\xee\x1f\xc6\x16\x5f\xac\x20\x00\xf0\xd6\x82\x09\xe3\x74\x43\x4b\xde\x34\xeb\x68\x68\x6a\xb0\xa0\x5b\x21\xd1\x8c\x00\x9b\x83\xb1\x0c\x8d\xb7\x85\xe5\x1a\xf7\xd6\x2d\xe1\x0c\x69\xa5\xe4\x42\x2c\x7b\x0d\xec\x2c\x51\xfc\x83\xb0\xe6\x49\xdb\xbe\x2b\xd0\x73\x65\xe0\x4e\x14\x5e\x7b\x98\xf4\x38\x6b\xd4\x72\x49\xd6\xdf\x73\xa0\x52\x6d\xa7\x24\x48\x4b\xae\xc2\xf4\x5d\xa7\xb4\x65\xc2\xb2\x7d\x28\x96\x05\xfb\x81\x4b\x71\x19\xf8\xd5\xa9\xfa\x20\xed\x73\x85\x42\xf7\x78\xbb\xfc\x12\x97\xa7\x3d\xae\x86\x9c\x4c\x7b\x76\x05\xda\x08\x25\x9d\x95\x3c\xed\x78\x15\xdf\xfb\x01\x3d\x9d\x15\x2d\xb8\x4d\x76\x6a\x0d\x35\x6b\xc4\x5c\x73\x2d\xc0\x4c\x91\xc2\x8a\x4b\x92\x65\xda\x90\xfa\x49\xef\x39\x11\x34\x23\x9a\x33\x54\xbc\x3d\xdc\x46\x06\xd9\xe8\x76\x69\x76\x39\x0b\xec\xa0\xb7\x91\x8d\xbd\x01\xb6\x50\x7a\xd3\x9c\x63\x38\xc0\xd4\x15\x68\x2d\x82\xb1\x0f\xee\x25\xbc\x8c\xc6\x87\x9c\x50\xa6\x35\xec\x9c\x24\x21\x93\x11\x25\x2d\x17\xf2\x31\xad\xc1\xcb\x00\xe2\x2e\x59\x49\x1b\x1b\x7c\x6a\x15\x5f\xbd\x5e\x81\x86\x4d\x46\xb0\x6b\xd1\x34\x28\x47\x8e\x23\xbc\x31\x0a\xf7\xe5\xca\x71\x25\x2c\xeb\x09\x47\x2e\x7e\x04\x7d\x25\x2a\x74\x3e\xc6\xa8\x4a\x38\xc3\x68\xd5\x10\xce\x93\x96\x2f\xde\x5b\x75\x27\xfc\xc9\x24\x7b\x43\xc3\x7f\xf6\x60\xec\xac\xea\xfa\x91\xd2\xd8\x0a\x29\xda\xbe\x65\xbc\x55\xbd\x74\x0e\xec\xe5\xf9\x8f\x6e\x1d\xa1\xa1\x2e\x76\xac\xdd\x42\xab\xf4\xfa\x8b\x97\xf7\xaf\xef\x84\xd0\x88\x56\xdc\x0b\x77\xfe\x79\x24\xee\x7e\xe5\xfb\x61\xce\x3f\x8f\xc7\x1c\x3e\x77\x63\x8c\xff\x4e\x59\x39\x0a\x82\xe2\x16\x41\xbd\xb8\x12\x9c\x5d\x46\xe5\x0b\x72\x9c\xc3\x43\x97\x90\x41\x13\xd2\xee\x20\x22\x57\x35\xce\x6a\xb1\x58\x80\x06\x69\xdd\xcb\x84\xb1\x4b\x2d\x06\x0a\x91\x22\xd7\xf2\xf9\xf1\xf3\xe3\x72\x23\x9c\xd6\x76\x86\x18\x8c\xe1\xe1\xad\xe0\x71\x91\x68\xea\xc6\x22\xb4\xb2\xb6\x1b\x22\x64\x3c\x6b\x66\xf7\xe6\x47\x2f\x6b\x67\x64\x30\x67\xa4\x45\x1c\x75\x1b\xb0\xdd\x57\xc2\x50\xec\x1c\x50\x4c\x18\x3d\x3f\xbe\x19\x9f\x2f\x62\xd4\x8d\x78\xe1\x62\xf7\x44\x6e\x9b\x5d\x63\x31\x72\xe2\x2f\x64\x06\x0b\xdf\xa4\xac\x14\xff\x59\xb3\x32\x33\xcb\xe5\x46\x82\x1a\xc0\x75\x5a\xcd\xc1\xcc\xc6\x5a\xd2\x73\xf7\xb8\x0f\x90\xea\x4d\xe5\xf0\x6b\x85\x04\x25\x21\x96\xc8\x75\x89\x56\x79\xb0\x09\x7f\xd6\x71\xbb\x1a\x41\xf4\x39\xb7\x2b\x64\x25\xaf\x2a\x30\x11\x90\x03\xcb\xf6\xa3\xbf\x2d\x8f\x56\xc0\x1b\xbb\x2a\x0f\x0a\xf6\x4e\x59\x08\xd1\xb9\x30\xd1\x82\x63\xfc\x2b\x55\x8c\xdc\xa0\x56\x92\xfd\x67\xcf\xf5\x65\x6f\x06\x21\x90\x01\x6b\x31\xf4\x13\x96\xdc\x1a\x98\xbe\x89\x5e\x3c\xf7\x7a\x0b\x2e\x1a\x7c\xd2\x2a\x66\x2c\xd7\x03\x1e\x37\x98\x2e\x80\x31\x33\xcc\x5d\x04\x6f\x66\x35\x34\x3c\xb7\x71\x42\xda\x3f\x7c\xbd\x4d\xee\xbb\xbe\x9d\x83\x46\x83\x6c\xa0\x52\xb2\x36\x8c\x2f\x2c\xe8\x0d\xee\xae\xb8\xf1\x20\x51\x31\x61\xa1\x34\x44\x80\x61\x47\xb0\x30\xe0\x61\x5b\xa8\x77\x62\x86\x14\xab\xde\x7e\x39\x4e\x5e\x1d\xd2\x76\xe0\x82\x86\xa9\x1e\x6b\x14\x5d\xd7\x08\x8c\x89\xbc\x5f\x1f\x22\xb7\x13\x9b\x0e\xb4\x50\xf5\xdd\xc8\xfc\x43\x5d\x33\xb5\xb0\x20\x71\xed\x0e\x34\xd6\xb0\x12\x0e\x5f\x02\xd9\xf4\x4e\xb4\x66\x76\xa5\xc1\xac\x54\x33\x02\x89\xb7\xe4\x3e\xb1\xd2\x05\x55\x8f\xf1\x17\xa3\x65\xc0\x24\xfb\x89\x18\x91\x19\xc0\x27\x45\x0d\x1a\xea\xf0\xe0\xa2\x6f\x88\x8f\x2b\x7e\x85\x62\x84\xe2\x04\x75\x71\x7f\x02\xf0\xc5\x5e\xc3\x6f\x25\x80\x96\xb9\x13\x7f\x7c\x0e\xea\x21\xee\x8e\x26\xa8\xef\x83\x3e\x96\xd9\xc4\xef\xaa\x22\x11\xe2\x9d\x3a\x92\x70\xfb\x1d\x95\x64\x03\xbd\xdd\xf8\x3c\x92\x9a\x8c\x82\xfd\xb4\x15\x65\x14\x09\x4f\x59\x55\xb6\x08\x08\xe8\x57\x5a\xc9\x47\xaa\xd8\xef\x61\xbc\xfe\x52\x2b\x79\x43\x4e\xd8\x1b\xab\x5a\xf1\x6b\x28\x0e\x21\x09\xaa\x77\x52\xee\x05\x51\x54\x4e\xa0\xf5\x11\xe2\x48\x65\xcb\xcc\x45\x9a\x82\xfd\xbc\x12\x0d\x96\x84\x75\xeb\xca\x4e\x5c\x0e\x12\x47\x0a\xda\x0d\xe3\x58\xa0\x23\x06\x72\x5f\x82\xee\x3b\xe7\x8f\xa9\x10\x3f\x65\x46\xb5\x90\x81\xe5\xe6\xd2\x4c\x91\x9b\x2b\xc6\x0d\x9b\x63\xb1\x92\x7d\x52\x73\x33\x0d\x99\x40\x58\xad\xb2\xe2\xca\x25\x99\x58\xb6\xe9\xa0\x12\x0b\x51\xb1\x95\xea\x75\x4c\x6f\x6b\xbe\x8e\x6d\x04\x9e\x40\x38\x7b\x84\xcf\xb4\x42\xf6\x16\x4c\xc1\xbe\x53\xda\x43\x24\xe8\xc8\x95\x6a\xc8\xbd\x96\x5b\xd0\x82\x37\x81\x69\x39\xb5\x1c\xe9\x4c\xdb\xe4\x18\xff\xbd\x9a\x33\x21\x8d\x05\x5e\x23\x28\x8e\x46\x4b\xd6\x5c\xd7\xac\x86\xae\x51\xeb\x16\xa4\x9d\x62\x21\x5b\x69\x0c\x41\x31\xd6\xe0\x57\x28\x2c\x46\xf5\x1a\xb3\xe8\xeb\x15\x6c\x87\x26\xb5\x02\x1f\xed\x48\x80\xc0\x56\xf8\x8c\x96\x1e\xea\x22\x2f\xe0\x85\x72\x16\x5a\x4a\xb6\xd0\xaa\x75\x4b\x2d\x14\x76\x72\x50\xd7\xb2\xba\x17\x5a\x4b\xb8\xe2\x4d\xcf\x6d\x96\x0f\x44\xea\x4f\x58\xe9\x44\xa1\x9c\xb2\x12\x79\x82\xff\xc7\xf8\xca\xfe\x5a\x16\x2e\x74\xd5\x7d\x43\x1a\xd3\x1b\x5c\x7a\x27\x2b\xb8\x86\x21\x06\x27\x6c\x16\x16\x3e\xf1\xb4\xfa\xfd\x31\x41\x56\xaf\xb5\xb0\x68\xe7\xb8\x61\x08\x16\x03\x6e\x0d\xc6\x15\xbb\x0a\xf6\x1a\x4b\x73\xfe\xf5\x13\x2b\xaa\xcb\xbf\xfa\x97\x5f\xfc\xf1\xf8\xf8\xf8\xb8\x2c\xd8\x6c\x0b\x57\x02\x12\x69\x4b\xcb\x25\xa6\x92\x97\x89\x96\x7e\x9f\xac\xc0\x84\xbe\x98\xb0\x0e\xd9\x29\x8c\xab\xae\x5b\xc5\x8e\x0f\x02\x2a\xb8\xe6\x89\xe5\xf3\xbf\x86\x82\xff\x8b\xe3\xa3\xaf\xff\xfb\xff\xe9\x9a\xde\xfc\xdf\xc3\x5d\xff\xfb\x6b\x89\xa2\x49\xd8\x9d\x58\x2d\x96\x4b\xd0\x7f\xc5\x65\x5e\x1c\xfb\x27\x8e\x8f\xbe\xbe\xf5\xfd\x62\xef\x29\x17\x53\x02\x1f\x46\xa4\x02\xc1\x56\xa1\xca\x84\xd7\xa2\x05\xbe\x5e\xa9\x66\xa0\x03\x05\x3b\x5b\x64\x5d\x22\x85\x1a\xec\x3a\x2f\x35\x54\x0d\xd7\x50\x3b\xf5\x5d\xb3\xb6\x37\x16\x3d\x0b\xa4\x56\xd1\xc6\xe2\xc2\xb4\x50\xad\xb8\x14\xa6\x45\x55\xba\x56\xfa\x92\x55\x4a\x6b\xa8\x6c\x33\xa0\x25\x29\xcb\x08\x6a\xf6\x4e\x5d\xcf\x0e\x1b\x14\x1d\xd7\x54\x75\x35\x36\xd8\x24\x5f\xd5\xcd\xd4\xcf\xe9\x69\xa6\xce\xd1\x36\xd7\xc9\x3a\x10\x33\x12\x9a\x51\x96\x23\x49\x98\x98\x7a\x21\x82\x9a\xc1\xe7\xd8\x0f\x98\xaf\x33\x75\x2c\x4e\x93\xa5\x8c\x70\x34\x66\x50\xc9\x9a\x22\x2c\xe0\x98\xa7\xfb\x27\x21\x2b\x92\x93\xb4\x87\xbd\x21\xfd\x4d\x7f\x77\xac\xf7\xca\x30\x0b\x7f\xcb\xc1\x24\x28\xfb\xc2\xee\xed\xa1\x47\x04\x03\x32\x66\x61\xa5\xd2\xcb\x82\xbb\x32\x76\xe1\xea\xb6\xc5\xe5\x49\xa8\xdf\xe2\xa2\x25\x15\xb0\xd7\x07\xc5\xc7\x90\xee\x25\xe8\x3e\xf0\xab\x7a\x8d\x65\x97\x66\x7d\x92\x6c\x00\xe1\x82\x6e\x27\xd8\x83\x62\x2f\xdb\xe0\x05\x6f\x9a\x39\xaf\x2e\xef\x54\x98\x1f\x0d\x0c\xea\xc1\x7e\x37\x45\xdb\x35\x80\x06\xdd\x09\x68\xd8\x69\x0f\x9d\x81\xac\x3b\x25\xa4\x65\xfb\x01\xf4\x41\xee\x18\xac\x5e\x53\xae\x79\x8b\x87\xe1\x66\x87\x4d\x1d\x4a\xa8\xf4\x74\x57\xeb\x59\xa7\x1a\x51\xad\xc7\x48\xea\x47\xda\x61\xc3\x56\xea\x1a\x65\xcb\x6a\xe0\x36\x2d\x66\xc9\xb7\x84\x06\x03\x67\x08\xf6\x27\xde\x88\x9a\xa1\xc3\xf0\x8a\x77\x32\x63\x13\x37\x21\x30\x39\x61\x1c\xff\x1f\x31\x74\x61\xa8\xee\x65\xb6\x62\xb3\xfe\x1f\x33\x36\xf9\x4e\xe9\xb9\xa8\x27\xb1\x9a\x70\x70\x82\x2a\x39\x17\xb5\xc9\x81\xeb\x5e\x62\x24\x70\x29\xba\x0e\x59\x24\xe1\xb3\xc5\x8c\x9e\x89\x05\xca\x0d\x46\x2c\x2e\xc3\xc7\x94\x40\xee\xed\x59\x86\x0d\x53\xb3\x82\x9a\xad\xc1\x22\x94\x0f\xd0\x35\xbc\x82\x09\x76\x6a\x64\x85\xfd\xd6\x88\x44\x1c\x03\xf8\x84\xbe\x09\xa3\x11\xff\xac\xc1\xb6\x08\xc5\x0b\x12\xae\x99\x92\xb0\x77\xdf\x0a\xf1\x69\x6f\x55\xcb\xad\xa8\x9c\xfe\x79\x6f\xbf\xe5\xc8\x79\x64\x92\xf7\x4b\xbc\x69\x32\x5b\x06\xc2\xae\x40\x7b\xef\x8d\xa4\x3b\x07\x9e\x45\x30\x18\x8a\xf6\x2d\x68\xb6\xaf\x64\xb3\xbe\x55\xce\x71\x87\x52\x6d\xe4\x00\x9d\x0e\x67\x1d\x37\x06\x53\xc3\xb4\x8e\xeb\x75\x95\xb5\x40\xe3\x57\x3a\x83\xb0\xf5\xd0\x41\xe1\xea\x51\x14\x85\xd5\x34\x5a\xd1\x34\xdb\x68\x99\x0d\xbb\xeb\x1f\x70\x68\xa5\x58\x94\x1c\x30\xd4\xd1\xc3\x47\x5b\x46\xd8\x3c\x6b\xcb\x9d\x0f\x97\xc7\x47\xcf\xd8\xa1\xff\xaf\x9c\x5e\xbb\x40\xb4\xfc\xc3\x37\xad\xf7\xa8\xdf\x1c\x9b\x92\x3a\x5b\x59\xaf\xae\x86\x0e\x64\x0d\xb2\x12\x60\x06\x31\xf7\x83\xb6\x62\x5e\x65\x50\x6e\xed\xce\xf2\x81\x8c\xf0\xba\x8e\xa5\xaa\x1c\xd1\x34\x2f\xb0\x29\x3e\xa1\x49\x8d\x0b\x6a\x76\xcd\xd1\xf8\x79\x5d\xdb\xe8\xb0\xb0\x5f\xfe\x99\xf3\xa0\x51\xeb\xc7\x6c\x45\x05\x08\xbb\xb3\x0e\xf8\x8c\x73\x26\x02\xd5\xcf\x0f\x1c\x38\x0a\x2e\x85\x74\x11\xf2\x4a\x2c\x57\xac\x81\x2b\x68\x62\x10\xec\x45\xcc\x55\xeb\x76\xab\xd1\x93\x6e\x27\x21\x61\x23\xac\x30\x0d\x57\xdd\xc8\x9f\x1a\x8c\x53\xb7\x94\x36\xb8\x95\xd9\x1c\xec\x35\x80\x64\x65\xfa\x43\x08\xd1\x67\x9f\xd4\xdc\x2b\xc3\xa5\xdf\xb9\x19\xd5\xb6\x4b\x6f\x6c\x2a\x34\xf3\x61\x40\x22\x65\x1c\xe8\xd6\x83\x5d\xdc\x62\x74\xa0\x2a\x41\x7b\x54\x35\x22\x18\x49\x89\x34\x98\x0e\x4b\x08\x73\x0a\xdf\x96\x20\x41\x27\x2a\x12\xa8\x0c\xc3\x4c\x7e\x5a\x7e\x09\xcc\xf4\x1a\x36\x29\x8b\x3d\xce\x10\x83\x54\x4d\x6f\x2c\xe8\x5b\xf4\x08\xe4\x95\xd0\x4a\x3e\x2e\x0f\x32\x20\x89\x09\x7d\xc8\xc3\xc9\x9c\x58\xc5\x84\xfc\x04\x95\x4d\xd9\x65\xfe\xde\x15\xd7\x02\x85\xd8\x04\xda\x72\xba\x63\x49\x2d\x25\xdb\xe5\xbb\xd3\xb7\xaf\x3f\x9e\x9f\xbe\x7c\x5d\x4e\x59\x79\xfe\xfe\xd5\xbf\xf0\x8b\xd2\x19\x6e\x85\x1e\xe9\x69\xcf\x84\x44\x8a\x66\x2d\x58\x7e\x27\x26\xbe\xf3\x61\x88\x83\x14\xb8\x65\x2c\x70\x64\x67\x5c\xd8\xcd\xd9\xd4\x10\x41\x4f\x52\x1e\x44\x29\x59\x56\x8f\x54\xdb\x41\xe9\xf8\xfb\x4b\x76\x81\xac\x61\x4b\xae\xe7\x7c\x09\xb3\x4a\x35\x68\x31\x0c\x46\x60\x99\x4a\xc7\xf1\x47\xa9\x58\xa3\xe4\x12\x5b\x4b\x80\x55\x3a\xae\xd7\xac\xef\xd4\xb0\x6a\xd3\x77\x35\x4e\x06\x3e\xe9\x4d\xae\x85\xa9\x70\x36\x63\x3d\xab\x30\x4d\xc8\x50\x29\x8e\xba\xcb\xe5\x91\x5b\xac\x88\x4f\xbd\xc4\x87\x2e\xd6\x1d\x6c\x23\xf9\x2a\x3c\xc3\xaa\x46\xe0\xa6\xba\x05\x29\x0b\x43\xf9\x9c\x32\x8a\xc3\x4a\x42\xbb\x2e\xa7\xee\xdf\x97\xde\xb8\xfa\xfe\x76\x99\x89\x00\x7d\x93\x84\x40\xc8\x25\x16\x2d\xee\x2b\x09\x03\x3c\x71\xbf\xcf\xfc\x3a\x37\xfa\x55\x45\x79\x49\xe8\x61\x66\x23\x18\x2e\x9a\xdd\x54\x7e\x8c\xf5\x57\x80\xe5\x6a\x2c\xca\x62\x4e\xd9\xd0\x93\x83\xea\x0d\x81\xa5\x4e\x24\xc8\xcd\xf1\x58\xe7\x4e\xdc\x04\x27\x0f\x6d\x73\x34\x53\xbc\xae\xd3\xec\x47\x0e\x76\xdf\xae\xb4\xea\x97\xbe\xb9\x54\x46\xa7\xe4\xa8\x3a\x78\xd2\x62\xb7\x52\xc6\x8e\x70\xe6\x7b\x87\x87\x1f\x28\x3e\x3e\x3c\x2c\x86\xbd\x66\xa4\x19\x97\x89\xad\x63\x2a\xb5\x91\x8c\x14\xf7\x4e\x34\x2e\x76\xc5\x91\xae\x10\xeb\x16\x4c\x9b\xb3\xb9\x0d\xbd\xc1\xcc\x8d\xfd\xe3\xe2\xe2\x3c\xa5\xa7\x21\x78\x4f\xae\x5e\x18\x2b\xd4\x23\x1a\xb1\x33\x5c\x9f\x44\x9a\x12\xc7\x9b\xe6\x95\xc2\xfc\x1a\xc9\x94\x7f\x33\x08\x7b\x0b\x66\x95\x9c\x18\x0a\x74\xc5\x75\x66\xd6\xd1\x8e\xab\xde\xce\x55\x2f\x6b\x76\x76\xce\x34\x97\xcb\x27\x6e\xe5\x1c\x3b\x46\xc8\xdb\xcb\xc0\x2c\xdc\xcf\x7d\x5c\x90\xcf\x62\xdd\xe9\x20\x16\x9e\x5e\x9e\xbd\xfa\xc0\x4c\x3f\x97\x10\xe7\x1c\x07\xa3\xac\x28\x1c\xba\x82\x2e\x2b\x00\x7b\x16\x77\x5a\x7d\x5e\xb3\xfd\xf2\xd9\x71\xe1\xfe\x3b\x7a\x3e\x7d\xf6\xed\xd7\xc5\xb3\x3f\xba\x0f\xcf\xbe\x9e\x3e\xfb\x13\x7e\x7a\xee\x3f\xfe\x31\x9f\x4c\x18\x4c\x42\xf8\xcd\xb8\x93\xa3\xdf\x29\xf2\x5a\xe0\xeb\x0b\x58\x15\x08\xb3\xd2\x25\x6d\x6c\xe1\xc4\xb2\x10\xea\xc8\x2f\x5a\x16\xec\x6f\xc9\x20\xa5\x91\xdf\x54\xa5\xf5\x9e\x19\xd3\xa8\x2c\x34\x44\xa1\x40\xea\xb1\x92\xab\x64\x10\xda\x34\xfc\x13\x30\xff\xa4\x1a\x75\x29\xf8\x23\xaa\xc1\xf7\x1e\x42\x50\x04\x2a\x91\x99\xe1\x70\x2e\x6e\x5b\x7a\xf4\x7b\x7e\xc5\x19\x5f\x82\xb4\x05\xfb\x08\xc0\x70\xf4\xc4\x9c\x1c\x1d\x11\xb2\x85\xd2\xcb\x23\x0d\x6e\xfe\xa8\x82\xa3\x95\x6d\x9b\x23\xf7\xb4\x29\xf0\xdf\x4f\x59\xf0\x2b\x3e\xab\x40\xdb\x11\xa2\x8f\xac\x3b\x7f\xfd\x96\x81\xac\x14\xba\x9b\x97\xa7\x0c\xdf\xc4\x2e\x10\x8d\x71\x60\x5d\x00\xa7\x51\xa6\x11\xd3\x2b\xd0\x62\x91\xfc\x7d\x7c\x1c\xcc\x94\x63\xd3\xae\x72\x84\xa0\xdd\x64\x65\xa7\x95\x55\x95\x6a\x5c\x35\xa4\x74\x1c\xa6\xca\x4a\x6f\x60\x66\x4c\x33\xf3\xcb\xcc\x78\x6f\x57\x20\x2d\x81\xf5\x8f\x3b\x89\x4b\x71\xc1\xd1\x15\xd7\x47\xba\x97\x47\x06\x2a\x0d\xd6\x1c\xa5\x71\x33\x14\x64\x32\x64\xbc\xaa\x70\x6a\x2f\x7c\x9c\x55\xbc\xa8\xb4\x2d\x9d\x12\x44\x09\x1a\xa8\x15\x61\xd0\x69\x21\x2b\xd1\xf1\x66\xe4\x10\x3d\xb2\x2e\xbe\x83\x27\x75\xfc\xc4\x87\xab\x98\xcf\xc3\x48\xbc\x90\x8c\xef\xe0\x14\x6e\xb8\xb3\x4e\x61\x72\xc7\xaa\x81\x68\x06\x7f\xf2\xb8\x0c\xf5\x4f\x9e\x07\x1a\x5e\x54\xf2\x85\x59\x1b\x0b\xed\x49\xcb\x31\x7b\x9b\x39\xc3\xe5\xf2\x61\xf9\x62\xc5\xaf\xad\x50\x33\x25\x1b\x21\xa1\xf0\x9f\x0a\x73\x55\x11\xf4\x4a\xbe\x58\x20\x06\xe8\x00\x55\x03\x05\x7e\xf0\x7f\xbe\x99\xf1\x29\x0a\x1d\xab\x33\x6f\x84\xb1\x20\xdd\x5e\xba\xe6\x45\xc5\x8d\x0d\x33\x9e\xe6\xd6\xb1\x2a\x2c\xe6\xcb\x1a\xea\xc0\x9e\x6a\x05\x23\x2a\xd5\x6f\x31\x1f\xb4\x34\xc3\xb6\xbd\x8b\x94\x2b\x99\xb4\xc7\x8b\x86\x2f\x43\x9e\x18\x40\xb2\x4b\xc0\x13\x05\x7c\x89\x91\xa5\xcb\x91\x1e\x77\x5b\x9d\xda\xdc\xc2\xf6\x91\x51\x18\xca\xf7\x3f\x30\xd2\xe2\x75\xad\x49\x46\xd3\xbc\x46\x90\x54\x67\x11\x83\x23\x9c\x63\x49\xc5\x2a\xd7\x5c\x2a\x27\xff\xfb\x70\xe2\x43\xfc\x09\xf9\xbd\x89\xa3\x6e\x89\x93\x39\xd3\x10\x67\x63\x9d\x13\x5f\x73\x2a\x80\x41\xf0\x9a\x49\xb0\xae\x8b\xe4\xfc\xe9\x82\x57\xd9\xc1\xa4\x72\x72\x38\x19\x4e\x07\x62\x7d\xf5\x5a\xe9\x7a\x24\x41\xe1\x71\x6f\xcc\x90\x47\x43\x86\x4e\xd9\xe6\xd6\x20\xa2\x25\x16\x08\x4b\xa6\x1c\x67\xc8\x27\xde\x7b\xb2\x75\x87\x7a\xfb\x69\xc8\x44\xdd\xf3\x6f\xbf\x7d\xbe\x41\x1e\xc9\xc5\x58\xf2\xe8\x71\x9a\xcb\x4f\xb9\x17\x4a\x94\xdf\x0c\x92\xad\x04\x94\xbe\x18\xca\x4b\x86\x02\xd2\x3e\x12\x3c\x3e\x9a\xa5\x7e\x3b\xf8\x3b\x5c\xf7\x66\xc1\xbe\x53\x33\x7f\x5e\x81\xa3\x6c\x5b\x2b\x4d\x94\xc6\x1b\xb1\x60\xe3\x95\xc5\xef\xf9\xd8\x83\x55\xa7\x31\x58\xe4\x75\x2d\xf0\x3b\xde\xc4\x5d\xa7\xa5\x30\xbc\xae\xdd\x09\xb3\x5a\xc8\x7b\x06\x1d\xff\xcd\xfd\x7b\xf6\xe9\xaa\x9d\xf9\x94\xe8\x97\xef\x7f\x7a\x4b\x3a\x18\xa2\x1c\x6a\x9f\x79\x60\xa9\xde\xf6\xe9\xaa\x7d\xbc\x3a\x1b\x62\x31\xac\xaf\x0d\x72\x10\x1b\x1e\xc1\xa0\x19\x1b\x52\x9b\xc9\xd4\x93\x4e\x23\x6a\x98\xf7\xcb\x3b\x11\x38\x8d\x21\xa7\x86\x16\x87\x6c\xdd\x6b\x4b\x1a\xce\xa1\x3e\x0f\x7d\x89\x72\xeb\xa3\x3f\x6e\x2d\xd6\x4b\x62\x4e\xf6\xfd\x4f\x6f\xa7\x0c\x1b\x37\x18\x6e\x61\x09\x19\x2d\xc4\x6c\xa1\xf4\x35\xd7\xb5\xd7\xbb\x01\x5a\x33\xd3\x1b\x6c\x75\xdc\x89\xde\x47\xff\x9c\x8f\x80\x2d\xd7\x4b\xb0\x6e\x4b\x44\xdb\x42\x8d\x23\x7e\xd8\xe5\xf6\x93\x80\x36\x4e\x52\x37\xdc\x18\x34\x77\x8d\xe2\x35\xd4\x19\x6c\x8c\x7b\xec\x0c\x79\xc6\x47\xc0\xc6\x08\xc3\xa5\x4c\xe8\x31\xdd\x2b\xb4\x4f\xe8\x03\xb0\x3b\x17\x48\x0f\x9e\x33\xd6\x20\x59\xa3\x96\x3b\xaa\x83\x9b\x4c\x20\x0f\x35\xc6\x4a\x69\x2e\x0d\xf2\x34\x7a\x35\x6e\x83\x57\x53\xac\x49\xe1\x05\xa2\x21\xe1\xba\x59\xb3\x86\xf7\xd2\x6d\x11\xb2\x2b\xa1\x72\x78\xf2\xcd\xf1\xf1\x37\xe5\xc1\x03\xd8\x0a\x5c\x38\xbc\x1b\xd6\xba\xc4\x9e\x38\xd8\x47\x6c\xb3\x05\x08\x49\x71\x79\x04\x45\xdf\x05\x6d\xa2\xf2\xf8\x0f\xe1\x0d\x21\xad\xda\x54\xe1\xd0\x7b\x7b\xda\x15\x81\xfb\xb7\x9e\x89\x76\x5f\x11\x26\xbb\x5c\x27\x56\xa0\x30\xe3\x41\x5e\x1d\x93\xe7\xa1\x1d\xde\xdf\xae\xf7\xe5\x22\x83\x12\x37\x42\x6c\x5f\xde\x30\x0b\x43\x68\x38\xea\x5c\x04\x85\x9a\x9a\xb6\x27\xcc\x00\x64\xdb\x34\x64\xc6\x4a\x69\xf1\xab\xfb\x7e\x46\x49\xd5\x48\xe1\x7d\x43\x08\x7c\xf4\x6f\x31\x1c\xac\x0e\x5d\xa4\xf7\xa7\xbd\x5d\xb1\xaf\x8b\x63\x56\x69\xa8\xd1\xa7\xf3\xc6\x64\xd8\xe2\xb1\x49\x49\x73\x6c\xba\x65\xe5\x9f\x49\x12\xff\x72\xf2\x67\x8f\xc4\x5f\xca\xe2\x67\x0c\xbd\x42\x65\x01\xf5\x91\x87\xb7\xfd\x74\x8c\xeb\xd8\x7b\xe0\xbe\xb4\x11\x6a\xb4\xa5\x9b\x74\x09\xe3\x2f\x98\xc2\xd3\xea\xc5\x80\xdc\x17\x11\x68\x19\x5c\x56\xaa\x56\x6c\xf4\xfb\xc6\x54\x2d\xa2\xd2\x0d\xb8\x84\xae\x71\xa3\x26\x72\x4b\xa5\x8e\x4c\x80\x0f\x3b\x6f\x68\x21\x62\xf0\x10\x56\x24\xf4\x6e\x18\x90\x4c\x66\x21\xeb\x04\x16\xec\x03\xad\x9b\xad\x69\xf2\x45\x09\x4d\x57\x7e\x36\x28\x22\x6a\x66\x2a\xee\x4e\x72\xec\xa3\xac\xd3\x87\x99\x55\xb3\x5f\x41\xab\x03\xb6\x00\x6e\x71\x30\x7e\xca\xe6\xbd\xa5\xb3\xe3\xe1\x3b\xd7\x34\x71\x5b\xd5\x02\x47\xb0\x38\xd6\x1f\xa3\x6a\x9a\xd4\xc0\xf3\xa3\x37\x97\x51\x9f\xb8\x31\x09\xec\x70\xae\x72\x94\x22\xd3\x6e\x7b\x77\x18\xb8\x9e\x2d\x45\x5e\x37\x00\xa4\xa1\x12\x9c\x6c\x05\x3c\x1e\xd5\xf1\x22\x7b\xb8\x20\x51\x2d\x6a\xb8\xa2\x5e\xf5\x6d\x0f\x64\x7f\x38\x28\x3e\x60\x94\x19\xe2\x8e\x80\x48\xad\xaa\x3e\xcd\x60\xa1\xe2\xb5\x18\x12\x08\x89\xaa\x1a\x43\xb5\x5d\x1c\x68\xc1\x6a\x51\x3d\x0c\x0b\xfc\x5a\x37\xf1\x20\x0e\x37\x55\xeb\x32\x0c\x77\x68\x56\x56\x5d\x1f\x3e\x3e\x26\x9d\x3e\x76\xba\x2b\xdb\xfb\x08\x14\xf0\xb8\x2a\x0f\xd4\x69\x22\xab\x5a\xd3\x7c\x86\xd2\xee\xe4\x67\x07\xba\x42\x86\x2f\x5d\x9a\x8b\x31\x5a\x76\xb1\xca\x36\x53\x0e\xd2\x68\xe1\xb9\xaa\x1f\x82\xb8\x56\x48\xa7\xe2\x70\x17\x4d\xf9\xd1\x58\x19\x0f\x74\x9c\xc7\x0b\x62\x52\xda\x15\x8c\x17\xb6\x4d\xe5\xda\x0d\xc1\xc7\xa0\x2a\x33\x3c\xd4\x11\x3b\x3c\x44\x4b\x72\x78\x98\xb9\xc8\x69\x30\x18\x2e\x6e\xde\xb4\x81\x98\x80\x23\xc2\x35\xab\xd5\xb5\x44\xea\x71\x01\x76\x1d\x7c\x46\xca\xfa\x92\x75\xad\xb3\x33\xb1\x88\xcf\x83\x70\x8e\x7f\x1e\xc7\xb9\x53\xc9\xfa\xae\x03\xcd\x7c\xf7\x24\xc6\x97\x3b\x98\x48\x79\x41\xe0\xa0\x90\x38\x2d\xcd\x9b\x06\x9a\x9d\x1c\x0c\x88\xe3\x99\x37\xac\x0f\x23\x3f\x2a\xde\x51\xe1\xdf\xad\xe8\x67\xa1\xe2\xe1\x40\xf4\x16\xbc\xc1\xfb\x49\x94\x7c\x08\x26\x3c\xe8\x34\xdf\xa6\x6b\x8b\x53\x7d\x38\x39\xee\xf2\x69\x9c\xb2\x6c\xea\x93\xc3\x7c\x4c\xdf\x7b\xf0\xe0\x2d\xc3\x1a\xe4\xa1\x0f\xd9\xe9\x60\x2a\x90\xdd\x30\x16\xe8\x1c\x90\x37\x1f\xc1\xfb\xfc\x96\x31\xbf\xcd\x60\xe2\x61\x82\x08\x0a\x1e\x86\xdc\xa4\x2a\xaa\x09\x29\x0d\x9e\xa0\x5a\xc4\x57\xd2\x18\x03\xba\x6f\x8a\x66\xdd\x18\x74\x0c\x13\x93\xb0\x45\x96\xfa\x43\x09\x8b\xbe\x69\xe2\x42\x41\x95\x22\xcb\xfd\x5a\x6e\x96\xc6\x2d\xf3\xf2\xf4\xed\xeb\x37\xff\xfa\xe1\xdd\xe9\xc5\xd9\x4f\xaf\xff\xf5\xf2\xfd\xbb\xef\xce\xfe\xfe\xe3\x87\xd3\x8b\xb3\xf7\xef\xf0\x91\xef\x3f\xbe\x7f\x87\x32\xdc\x72\x5b\x64\x57\x73\xa4\xe5\x69\x00\xd9\xcf\x12\x61\x31\x08\xbd\xb6\x5b\xd9\xe1\x31\x84\xbf\x55\x5f\xf0\x3b\xec\x57\x8e\x19\xcd\x76\xf0\x9d\xc2\x8f\x0d\x39\x89\xf3\xdb\x4f\x3b\x83\x19\x70\x61\x8c\xb7\x1d\xa2\x12\xb2\x99\x01\xdb\x1b\xb0\x5b\xdb\x3b\xdc\xaf\x1c\x81\x15\x97\x12\x9a\x19\x49\xd5\x3d\xf3\x05\x7a\x9b\x8a\x44\x78\x0a\xd1\x2d\x83\x02\x9b\x9b\x02\xda\x4c\x0c\xde\xa8\x16\xc0\x8c\x9b\x0f\x0f\xe0\x29\xe3\x51\xda\xcb\x86\x17\xa5\x1f\x3f\x9c\x99\x9d\xa8\x0a\x79\xf9\x9b\x11\xad\xc1\x58\x21\xe3\x6c\xfa\xc3\x63\x1b\x82\xdf\xdf\x85\xb3\x3b\xe1\x7e\x01\x9b\xc2\xcb\xbf\x91\x4f\x61\x99\x71\x8c\xba\x82\x2f\xe6\x92\x7b\xd7\xd1\x47\xfe\x76\xd3\x09\x39\xe3\x83\x77\xec\xf5\x73\x24\x77\xee\xd4\x66\x27\xca\xd9\x4a\xdb\xf8\xb2\x7d\xba\x19\x87\xa7\xb3\x22\x73\xad\x2e\x41\x67\x97\x4a\xb8\x81\xf4\x09\x19\xa6\xc9\xc1\x0e\x1a\xbf\x64\x47\x46\x51\xd8\x69\x55\xf7\x15\x3c\x24\x61\x03\xfc\x17\xa2\xc1\x06\xa2\xdf\xa4\x59\x90\xcd\x3b\x0d\x67\x98\x26\xf4\xaf\x53\x20\xec\x10\xda\x18\xe3\x5e\x01\xc7\x23\x3d\x93\x0a\x66\xe4\x60\x57\xc2\x58\xa5\xd7\x93\x82\x7d\x14\xb2\x22\x43\x8a\x36\x5d\xe2\xa8\x10\x68\xe1\x42\x9a\x86\xde\x1c\xc4\x5a\xd0\xaa\x2b\xef\xc6\x38\x5b\xf4\x36\xbb\x11\x2a\x73\xa4\xd3\x0c\xa9\xcc\xb3\xb8\xec\x36\xfa\x94\x9c\xcf\xc2\x60\x71\x07\x64\x8c\x31\x5c\x14\x65\x57\x5c\xb2\x67\x41\x5b\x73\x96\xb9\xc2\x45\xe4\x98\x6a\x3b\x6e\x47\xf3\x2b\x58\x73\xb7\x4f\x1f\xdd\x12\x78\xba\x83\x1d\x17\xcf\xbe\x71\x26\x9e\x5b\x31\x17\x0d\x5e\xfb\xb8\x10\x9f\xc1\xb0\xfd\x20\xe7\x19\xf1\x43\xd2\xcd\x60\x3f\x8d\x90\x97\x33\xec\xd3\x05\x27\x73\x1b\x56\x34\x8c\x4d\x8f\x6f\x31\x06\x7b\xdf\x6e\x41\x77\x67\x4c\x72\x45\x1f\x85\xbc\xfc\x1b\xbd\x13\xa2\x96\xe2\x02\xb7\x91\x36\xca\x99\x98\x9d\xbc\xf6\x49\x19\x56\x30\xf1\xf0\x64\x03\x6e\xf9\xe2\xb6\x79\xbb\x71\x4b\xec\xc3\x67\x1c\xe8\xd9\xfd\x86\x70\xe1\xb6\x1f\xf6\x49\x54\x78\xbc\x07\xcc\x1b\x15\x22\xd3\x1d\x6b\x71\x16\x2d\x75\xc7\x51\x08\x79\x88\x13\xb2\xc8\x24\x15\xac\x7c\xbb\x7e\x4c\x88\x19\x8b\x42\xe3\x6b\xc5\x7b\x18\x51\xbd\x75\x10\x6e\x29\x5e\x6d\xb1\x73\x73\xac\x0e\x93\x5e\x8d\x3d\xf0\xac\x30\x95\x62\x53\xcc\x32\x6a\x85\xd2\xd2\x38\xfd\x64\xd0\x64\x73\x67\x31\x56\x3f\xf4\x94\x1e\x92\x2b\xf1\xda\xa7\xa4\x6b\xff\xa1\x3b\x71\xc9\x8d\xac\x30\x0e\x3c\x73\x47\xe3\xd2\x59\xb9\x21\x36\x6e\x43\x53\xce\xe0\x97\x8d\xae\xd0\x9b\x02\x07\xc3\x77\x63\x58\x89\xb6\x7a\x7f\xe2\x9f\x3b\x69\x54\x75\xe9\x38\x6f\xa1\x41\x01\x6a\x4f\xe6\xca\x9a\xc9\x41\x51\x14\x65\xc1\xde\xbd\xbf\x78\x7d\xe2\xed\x0f\xf1\xab\xae\x8d\xb7\x96\xdc\x9d\xf3\x69\x85\x3f\x81\x1b\x12\xaf\x9c\x6f\x21\xf3\xa1\x31\x96\x74\x7a\x11\x0f\xa6\x00\xaf\x8f\xf0\x44\x6f\x88\xd6\x5a\xde\x19\x3a\x78\xc5\xdd\xbd\x7a\x91\x6e\x0d\x58\xb8\xc2\x63\x34\x75\xb8\xa3\x2d\xbf\xce\x68\x13\x8a\xb3\x4a\xd1\x13\xdd\x5a\x81\x7c\xda\x07\x66\xef\xa1\x6a\x26\x89\x44\x94\xe3\x18\xb6\xd8\x90\x45\xe4\x8b\x0b\x59\x35\x7d\x0d\x78\x5b\x0d\x2c\xb9\x85\x59\x7e\xcc\xe8\x4e\xa8\xae\xa4\xed\xf0\xf7\x43\x22\x21\xdd\xa0\x82\x76\x87\x07\x31\x30\xe1\x94\xbc\x59\xff\x4a\xc5\x31\x8a\xe1\x70\x36\x0b\x39\x85\xa3\xa9\x83\xa3\x4d\xf1\x18\x99\xb3\x6e\x1e\xab\x28\xc5\xa6\x70\xe7\x4c\x33\x51\x2f\xb7\xe4\x97\xce\xa4\xbb\x6c\xcb\x97\xce\xe9\x3b\x26\x32\xfe\x84\xb9\xd8\xd4\xa7\xa4\x7b\x43\x73\x64\x6e\x32\xb7\x39\x07\xa3\xd8\x8e\xc8\x5c\xf6\xde\xe1\xed\x51\xb4\x17\xf1\xc5\xec\x34\x4a\x26\x42\xe8\xfa\x51\x38\x80\xa1\x6a\x16\x78\x83\x2b\x82\x76\x36\x6a\xf2\xe7\x4c\x7a\x67\x88\xc7\x5f\xf0\xca\xd1\xcb\x49\xf1\x0a\x3a\x0d\x38\x32\x50\x9f\x84\xa3\x8b\x0e\xf1\x49\xb0\x4b\xee\xe9\xc9\x60\xa6\x78\xf0\xa7\x11\x54\xec\x24\xe2\xa8\x01\x6e\x52\x6a\xfe\x90\x34\xed\x42\xd5\xae\xbb\x31\xa8\x5e\xac\x3b\xc7\xef\x1d\x06\x3a\xd8\x10\x34\xd3\x08\x07\xed\xc2\xfe\xc4\xcf\xcd\xbe\xe5\xdd\x04\x95\x77\xf2\x06\x89\x9a\x1c\x6c\x60\xea\xbf\xcd\xf1\x72\xfd\x9a\xd9\x25\x8c\x39\x5d\xfb\x06\x9f\xdd\xcd\x1f\xe1\x5a\x4b\x8b\x35\xba\x23\x67\xfb\x50\x8b\x2d\xd5\x4f\x6f\x60\xdb\x56\x73\x28\x63\xe3\x0e\x1c\x5d\x15\x6d\x34\x96\x59\xcd\xed\x01\x70\xdd\x74\x0c\xc8\xbb\xe4\xf1\x55\x07\x92\x77\xe2\xf1\xba\xc3\xd8\x3a\x3e\x3d\x3f\x63\xaf\x3e\xbe\xb9\xfd\x0c\x26\xc6\x02\xe9\x2c\x5c\x86\xb1\x09\x95\xb5\xb0\x14\x7a\x40\x73\xcb\x89\x30\x0c\xa6\x1e\xf1\x58\xe5\xfb\xeb\x74\xbb\x27\x48\x43\x0d\x08\x3a\x7d\xeb\x08\x80\x3a\x0a\xbc\x61\x73\xc0\xa3\x3e\xbb\x76\x62\x0e\x48\x69\x78\x03\xdd\xaf\xc5\xa9\x82\x05\x8e\xb2\xb9\xbb\x60\x89\x7c\xfc\xcb\xf0\x8a\xea\x7c\x15\x45\xd5\x37\x03\x14\x45\x46\xd0\x4f\xba\xfe\xe4\x23\xed\x59\x46\xe7\xc8\xfc\xf4\x22\x79\x8d\x9c\x49\x7e\x5a\x2c\x30\x50\x43\xbd\x0d\xeb\x5e\x57\x5b\x67\x60\x88\xf7\xdb\x10\xc2\xfa\x5d\x3d\x7f\xa4\x98\x19\xb1\x38\x7f\xf5\xb7\x3b\xe2\xe5\x73\x55\xbf\x12\x46\xf7\xee\xa5\xbf\xf5\x35\xce\xe4\x04\x29\x88\xd5\xfe\xbc\xce\x8d\x56\xee\x49\x4b\x07\xf6\x92\xf8\x15\x17\x0d\xae\x33\xc2\x70\x5e\x0c\xba\x20\x48\xde\x4e\xba\x9d\x9a\xba\xc1\x6a\x63\xc9\xb2\x46\x28\x74\xfb\x15\x97\x0c\xae\x84\x9b\xae\x2d\xce\x62\x6a\x4f\xb5\x7e\x2e\x19\x9f\x1b\xd5\xf4\x36\x81\x73\xa5\xfd\xd8\x7b\x2b\xde\xfb\x4c\xc2\x39\xc0\x72\x40\x06\xcd\xe1\xb6\xfc\xf3\xac\x97\xd9\xb7\x04\x82\xaa\x20\xc3\x6b\x04\x37\x1e\x7e\x60\x4e\x10\xe4\x5e\x3e\x30\x13\xb2\xe9\xa6\x67\x25\x13\xdb\x8c\xc0\x98\x10\xef\x2f\xa6\xc1\x8c\x83\x0d\xae\x6d\x72\xc8\xf3\x6d\xb8\xc4\x36\xd7\x02\xcf\x82\x1e\x3e\x9e\x07\x08\xcb\x92\x5a\x22\x35\xae\xaa\x44\x9f\x1d\x6f\x69\x18\x04\xed\x3e\xb6\x74\x96\x72\xf3\x52\xae\xb4\x88\xda\xf8\x53\xc1\xce\xb0\xf9\x46\x3d\x8b\xf8\x9c\x30\xcc\x25\x7c\x38\x08\x18\xd3\x0a\xf4\xa6\xa1\x35\x8c\x76\xc1\x39\x13\xc6\x63\x89\x22\xbc\x8d\xfd\x0c\xcc\x18\x80\xbb\xda\x0c\x16\x9e\x28\x91\xf4\x3e\x18\x07\x2a\xe8\xce\x53\xf8\x6c\xdd\x7d\x57\x4e\x4d\x41\x03\xde\x7a\xaa\xe2\x15\x57\x74\xe5\x34\xb6\x44\xdd\x5d\x32\xd1\x14\xa5\x2e\xdf\x00\x6b\xdf\x72\x57\x32\x71\x74\x70\x65\x0b\xdd\x03\x6a\xf0\xd0\xe3\xe5\x14\x4b\x26\x55\x02\x8b\x62\xd8\xce\xc1\xe5\x0b\x54\x5c\x04\xcd\x44\x8b\x22\xa6\x61\x29\x8c\xd5\xeb\xa7\x7d\x50\xd1\x3b\xf7\x19\x51\x7b\x27\x26\x17\x3b\x76\x70\x1f\xda\xce\xae\x0f\x12\x47\x63\x0d\x69\x87\x64\xdc\x3b\x85\xdd\x3a\xb5\x88\xbf\x54\x50\x51\x49\xd9\x6e\x9c\x7c\x17\x8b\x1d\x92\x15\x34\x31\xc4\x31\xfb\xe1\x4a\xd7\xec\xbb\xc1\xf6\x63\xad\x25\xbb\x0b\xa4\xd3\xaa\xc5\x11\xec\xde\x3c\x92\x0f\xdd\x43\x95\x3d\x8f\x50\x48\x6f\xa2\x07\x45\xe5\x4d\x7f\xa5\x82\xa9\xfb\x91\x9c\x90\xff\x92\xee\x04\x1f\x6c\xc2\x19\xdf\xed\x5f\x1e\x71\x67\x76\x4b\x1a\x7d\x22\xbb\x45\x9f\xde\x2a\x29\xac\xd2\x65\x8a\x0c\xd3\x38\xaf\x5d\x25\x60\xd1\xb0\x55\x9a\x77\x9b\xe5\xa6\xe9\x66\xbd\x29\x23\xeb\x3d\x25\xfe\x88\x2d\xd0\x90\x07\x35\xaa\xe9\x84\xab\x7f\xed\xad\xa8\xb4\x3a\xa7\x5e\xe5\x5b\x37\x20\x83\x17\x08\x9e\x7e\x78\x77\xf6\xee\xef\x74\x49\xbf\x86\x41\x01\xe3\x46\x1a\xc2\x25\x80\x9e\x13\x61\x04\x7d\x29\xec\xaa\x9f\x17\x95\x6a\x8f\x2a\xa5\x41\x99\xa3\xb4\xc7\x33\x45\x68\xfe\xb2\x03\xf5\x7f\x06\x9b\x12\xd7\x77\xf3\xed\x22\x14\x02\xe7\x71\xea\x00\xef\xd7\xfb\x0f\xd5\x3b\x66\x61\x94\x16\x4e\x2f\xcf\xda\x80\xa2\x55\xe1\xf4\x4e\xb4\x49\x19\x44\x32\xd4\xe1\xfe\x2e\x61\x57\xaa\xb7\x9b\x0f\x0d\x39\xba\xf5\xb6\xd8\xfd\x1b\x45\x4f\xba\xac\x35\xf6\x80\x49\x46\xec\x4d\x67\x4c\xfe\xf4\xed\xb7\x7f\x2a\xdd\x0f\x43\xf9\xbb\xd2\x1d\x17\xd9\xff\xf4\x77\x3d\x0f\x6b\xfe\xc3\xbd\x19\x7d\x24\x83\xdf\xac\x3c\x68\xf2\xa2\xc1\x89\x28\x61\x9a\x57\xde\x06\xfa\xfe\x29\xc0\xcd\x18\xf8\xa5\xb6\xcf\xf9\x6c\x8b\x62\x3c\x5a\x95\x19\xbd\xbe\x69\x68\x52\xf6\x91\xac\x1e\x22\x7f\x8e\x2d\x37\x1a\x6e\x75\x62\x82\x73\x6f\x68\xef\x3a\xfc\x83\x07\x1f\x52\xca\x4e\xd5\xd3\x94\x87\x27\x88\xf8\x9d\x46\x2b\x01\x74\x87\xd4\xa6\x23\x76\x9e\x82\xcb\x78\xcb\x5d\xf4\xcc\x5e\x6d\x72\x50\x9b\x31\x1b\x6b\xb9\xec\xd1\xae\x31\xfc\xb1\x23\x41\x41\xcf\x5a\xf5\x7b\xd9\x98\x08\xd4\x9b\x87\x6e\x50\x9f\x73\x80\x09\xa3\x00\x3a\x10\x55\x66\x21\x6f\xf8\x35\x90\x72\x9a\x5d\x41\xe8\xf1\xca\x62\x35\x87\xae\x23\x2c\xfc\x0e\x19\xc8\x4d\x15\x4f\x47\xf9\xd7\xaa\x4f\x78\xde\x1f\x4d\xe7\x01\x30\xaf\x33\x38\xeb\x43\x55\x84\xf8\x3c\x3d\x25\x68\xdc\xa8\xd3\xee\x58\x88\x3b\x13\xb7\x56\x7d\x5a\x65\xe3\x1a\xd2\x1d\x58\x20\x51\x68\xfa\x3d\x5d\x53\xb6\x26\xcb\x19\xec\x49\x6a\x31\x3c\xe9\x60\xca\x0b\xec\xe8\x5f\x16\xd8\x10\x3e\x7c\x2d\x8c\x7e\x92\x78\xe0\xe0\x23\xb2\xb3\x81\x85\x65\x2e\xcc\x4a\xdd\xde\x41\x50\x64\xf9\x25\xc8\x14\x13\xed\x14\xab\xb4\x1f\x41\x22\x1e\x62\xc4\x2d\x4a\x97\x19\x86\x7c\x11\x18\x51\x47\x14\xe1\xf5\xbe\x62\x81\xd7\xe6\x09\x37\xbc\x85\x80\x58\x39\x3c\xdf\x5c\xab\xea\x12\xb4\x5f\xf8\x93\x51\x32\x33\x4b\x74\x53\xff\xe3\x65\x4e\xe4\x1e\xb6\x4e\xd7\xdb\xec\x6f\xe1\xfc\xc7\x4d\xea\xf7\xa4\x65\x34\x32\xe2\x0e\x0c\x54\xdb\x89\x66\x47\x04\xe9\x66\xe9\x38\xa3\x1e\xb0\xbf\xf0\x11\x51\x9a\xd2\x68\x2c\x36\x30\x0d\x5b\xb8\xf6\x0b\xa3\x03\x50\x86\x35\x78\xac\x96\x7e\xa1\xc5\xb5\xe0\xe8\x1c\x54\xf1\x2e\x0d\x67\x89\x86\x62\x3a\x4c\xb5\xe9\x0a\x42\xc3\xfe\xe3\xf4\xed\x1b\xb7\xce\xff\x7a\xfb\x86\x5a\xce\x26\xc9\x83\xeb\x5c\x8e\x70\x50\xb7\xee\xf9\x07\x5c\x84\x76\xbc\x1a\x72\x3e\x4a\x37\xb3\x1b\x61\x67\x5c\x31\x74\x68\x43\x10\x97\x31\xeb\xbf\xc2\x15\x35\xa3\xee\xa4\x71\x2c\xc8\x17\xb7\x8d\x99\xf9\x1f\x94\x1b\x3b\xbe\x87\xca\x77\xf1\xe6\x23\xcb\xde\x72\x02\x3a\x65\x8d\xb8\x04\x56\x42\xbd\x84\x72\xca\x4a\x1c\x52\xa5\x6b\x81\xfc\x39\x00\x0d\x20\x2b\xbd\xee\x6c\x39\x1c\xf2\x4d\x1b\xb4\x3d\xe6\x9b\x9d\x59\xbd\x61\xd8\x17\x09\xc8\x8e\xda\xde\x83\x80\xec\x2d\xea\x7d\x58\xf3\xd0\x98\x8d\x6b\x29\xed\xc2\x08\x4f\xe8\x3f\x16\x56\x15\xff\x0d\x2c\x0b\x87\xb4\xec\xfa\x77\xe1\x60\x36\xbc\xf7\x65\x78\xe7\xd3\x7f\x83\xbb\x44\x20\xfd\xe8\x59\x88\x46\x91\xd9\xb1\x35\xca\x07\xcf\xd2\xb7\x0b\x81\xf8\x66\x6b\x16\x8c\xfd\x9c\x32\xe9\x28\xe3\x03\xed\x40\x05\x47\x1b\x97\x1d\x03\x27\xd0\xd9\x5d\xd0\x56\xf9\xdb\x55\x9d\x8a\x6a\x17\x47\xa2\xf7\xc5\x5d\xf5\xbf\x95\xc3\xdc\x05\x11\xb1\x73\x83\x3f\x6e\xa0\x1d\xda\x12\xa8\xbe\xba\x08\xa0\xa0\xa9\x31\xaf\xc1\x48\x2e\x86\xc8\xd3\x64\x00\x34\x6b\xf9\x3a\x50\x1f\x87\xf4\x37\x18\xc5\x65\x1d\x7f\x88\x02\xef\x67\x40\xf1\xc1\x21\x7e\x51\x87\xcb\xa6\x84\x5c\xe2\x92\x66\x85\xe9\x1c\xe5\x2a\x6e\xe7\xd8\x3e\x7d\x2a\xe2\xef\x6f\xe2\xc5\x1b\x07\x53\x3a\xd7\x4a\xdd\x07\x21\x17\x9a\x1b\xab\xfb\xca\x55\x12\xe9\xe6\x47\xa8\x73\xbe\x9b\xad\xb1\x1d\x7f\xd7\xcb\x43\x8b\x99\x70\xbf\xab\xa2\x61\x86\xe6\x2b\xb7\x88\xe3\xaf\x5d\x1e\x18\x60\xba\x78\xb9\x06\xde\x84\x9f\x3b\xa6\x0d\xb3\x9a\x2f\x16\xa2\x0a\x53\x3c\x6e\x32\x0d\xed\xe5\x2b\xef\x28\xbc\xad\xfc\x00\x61\x96\x9f\x1e\xff\xed\xf4\x06\x6a\x69\x6b\x46\xf8\xe2\x8d\xd0\xec\x56\xd7\x4c\xc9\x2d\x39\xe7\x5b\xab\x5a\xe9\x4a\xb9\x98\x01\x87\xf2\x55\xf2\xeb\xfe\x12\x1a\x5f\x0f\x71\x57\x54\x86\xec\x18\x89\xf1\x37\xf8\x67\x0b\x9b\x83\x30\xf1\xef\xee\x25\x4e\x52\x77\x63\xe2\x25\xb6\x7f\x7c\x38\x3b\x95\xc2\x69\xbc\x34\x9d\x00\x88\x5d\x0e\xba\x94\x8f\x4e\x67\x84\x92\xc6\x93\x0e\x20\x1e\xa6\x7c\xeb\x26\x7e\xc4\x22\xdb\x3e\x4c\x12\x43\x33\x93\x4a\x28\x39\x58\xfc\x49\xe5\xcd\x5f\x94\xbb\x75\xd0\x30\xac\x3b\xfc\x21\x36\x6e\xd8\x3b\x55\xc3\x39\x2e\x14\x96\xfe\x43\x38\xe3\xf4\x58\xf5\x0e\x0f\x60\x77\xa8\x39\x64\x53\xa8\xb2\xe7\x0d\x64\x6a\xe1\xab\xeb\xb8\x8e\x8a\xb3\x93\x4e\xec\x92\xa9\x23\x6d\x74\x81\xb3\xbb\xcb\x16\x93\x99\xd0\x9b\x72\x6e\x12\xe7\x14\x5a\x2e\xf9\xd2\xdd\x44\x5f\x6c\xa3\x77\x43\xf5\xf0\xdf\x76\x26\x0e\xaf\x1b\x1f\x9d\xd7\xfb\x87\xc3\x30\x91\x4f\x24\x2c\xa7\x2b\x9a\xc3\xe6\xa4\x52\x25\x16\xa6\x07\x17\x2c\x8c\xfc\x55\x3c\xdc\x34\x7c\x34\x75\xc3\xdc\xbe\xe2\xcf\xdd\xf5\xf3\xc6\xdf\x6e\x9f\xa0\x1c\x95\x07\x5f\x50\x69\x45\x55\xcb\xd6\x0f\xc8\x0b\x13\x55\x29\x41\x78\x7e\x3c\x00\x91\xad\x35\xfb\x72\x8a\xd0\x41\xcc\xc2\x58\x4d\xba\xaf\xed\x26\x22\x69\x60\xa8\x70\x95\x83\x74\x3a\xcd\x6a\x5e\x79\x1e\x3e\x96\x72\x5f\x78\x08\x63\xb4\x9b\x10\x0f\x48\xe5\x1e\x95\xba\xad\x48\x74\x58\x30\xeb\xf8\xd0\xbd\xbd\xa1\xe4\x1f\x35\x95\xd3\x4f\xc8\x0f\xc1\x0c\x8c\x43\x80\x15\xcb\xe5\xc9\x1e\x90\x93\x8b\xfe\x8d\xed\xc7\xf4\xfb\xf0\xf0\x7b\x0e\x4b\xd0\x87\x87\x07\xc5\x0e\x2a\xff\xcd\x8d\x84\x33\x12\x53\x1a\x97\x45\xae\xa4\xe7\x03\xef\xd7\xd9\xb3\x03\xfe\xef\xea\x02\xdc\xa3\x72\x28\xb3\x71\xcb\xa0\x93\x2e\x9a\x08\x4a\x61\x22\xc4\x9a\x5b\x1e\x35\xe4\xc6\x91\xbb\x5c\x71\x03\x8e\x23\x71\xa1\x5b\x76\xc2\x5b\x01\xad\x5c\x86\x03\x8a\xfb\xbb\x25\x74\x20\x3e\x39\x26\x86\xe3\xb1\x29\x3d\x7a\xb2\x13\x2d\x94\x7f\xc5\xe1\x9d\x0c\xc3\x04\x4f\xb5\xda\xc9\xae\xb5\xf1\xdc\x71\x7b\xcf\xc5\x83\x2d\x72\x87\x96\xdb\x0c\xcc\xb3\xc9\xc1\x57\xff\x6f\x00\xd9\x44\x1e\x70\x70\x85\x00\x00"),
 		},
 		"/user-cluster-role.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "user-cluster-role.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 1315,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x53\xc1\x8e\xdb\x36\x10\xbd\xf3\x2b\x1e\xa4\x4b\x52\xac\xe5\xb6\xa7\x42\x3d\xb9\x9b\xdd\x56\x68\x60\x03\x2b\xa7\x41\x50\xf4\x40\x8b\x63\x69\xb0\x14\xa9\x0e\xa9\x55\xb6\x5f\x5f\x90\xb6\x37\x5e\x14\x3d\x04\x08\x6f\x24\x87\x6f\xde\x9b\xf7\x58\x62\xf5\xed\x96\x2a\xf1\x9e\x3b\x72\x81\x0c\xa2\x47\x1c\x08\x9b\x49\x77\x03\xa1\xf5\xc7\xb8\x68\x21\xdc\xfb\xd9\x19\x1d\xd9\x3b\xbc\xd9\xb4\xf7\x6f\x31\x3b\x43\x02\xef\x08\x5e\x30\x7a\x21\x55\xa2\xf3\x2e\x0a\x1f\xe6\xe8\x05\xf6\x04\x08\xdd\x0b\xd1\x48\x2e\x86\x0a\x68\x89\x32\xfa\x76\xb7\x6f\x6e\xef\x70\x64\x4b\x30\x1c\x4e\x8f\xc8\x60\xe1\x38\xa8\x12\x71\xe0\x80\xc5\xcb\x23\x8e\x5e\xa0\x8d\xe1\xd4\x58\x5b\xb0\x3b\x7a\x19\x4f\x34\x84\x7a\x2d\x86\x5d\x8f\xce\x4f\xcf\xc2\xfd\x10\xe1\x17\x47\x12\x06\x9e\x2a\x55\x62\x9f\x64\xb4\xf7\x17\x26\xe1\x04\x9b\x7b\x46\x8f\x4f\x7e\x3e\x6b\xb8\x92\x7b\x9e\xc2\x0d\xfe\x20\x09\xa9\xc9\x8f\xd5\xf7\xaa\xc4\x9b\x54\x52\x9c\x2f\x8b\xb7\x3f\xe3\xd9\xcf\x18\xf5\x33\x9c\x8f\x98\x03\x5d\x21\xd3\xe7\x8e\xa6\x08\x76\xe8\xfc\x38\x59\xd6\xae\xa3\x2f\xb2\x5e\x3a\x54\xc8\x04\x12\x86\x3f\x44\xcd\x0e\x3a\xcb\x80\x3f\x5e\x97\x41\x47\x55\xaa\x12\x79\x0d\x31\x4e\xf5\x7a\xbd\x2c\x4b\xa5\x33\xdd\xca\x4b\xbf\xbe\xa8\x5b\xbf\x6f\x6e\xef\xb6\xed\xdd\x2a\x53\x56\x25\x3e\x38\x4b\x21\x40\xe8\xef\x99\x85\x0c\x0e\xcf\xd0\xd3\x64\xb9\xd3\x07\x4b\xb0\x7a\x49\xc6\x65\x77\xb2\xe9\xec\xb0\x08\x47\x76\xfd\x0d\xc2\xd9\x75\x55\xbe\x72\xe7\xcb\xb8\x2e\xf4\x38\xbc\x2a\xf0\x0e\xda\xa1\xd8\xb4\x68\xda\x02\xbf\x6c\xda\xa6\xbd\x51\x25\x3e\x36\xfb\xdf\x76\x1f\xf6\xf8\xb8\x79\x78\xd8\x6c\xf7\xcd\x5d\x8b\xdd\x03\x6e\x77\xdb\x77\xcd\xbe\xd9\x6d\x5b\xec\xee\xb1\xd9\x7e\xc2\xef\xcd\xf6\xdd\x0d\x88\xe3\x40\x02\xfa\x3c\x49\xe2\xef\x05\x9c\x06\x49\x26\x79\x7a\x09\xd0\x85\x40\xca\x47\xda\x87\x89\x3a\x3e\x72\x07\xab\x5d\x3f\xeb\x9e\xd0\xfb\x27\x12\x97\xe2\x31\x91\x8c\x1c\x92\x9d\x01\xda\x19\x55\xc2\xf2\xc8\x31\xa7\x28\xfc\x57\x54\x6a\xf3\x2d\xff\x96\x7a\x64\x67\x6a\xdc\xda\x39\x44\x92\x07\x6f\x49\xe9\x89\xcf\x01\xab\x21\x07\xdd\x55\x7a\x8e\x83\x17\xfe\x27\x73\xaa\x1e\x7f\x0a\x15\xfb\xf5\xd3\x0f\x6a\xa4\xa8\x8d\x8e\xba\x56\x80\xd3\x23\xd5\xe8\xf4\x48\x76\xf5\x58\x93\xe1\xa8\x00\xab\x0f\x64\x43\xba\x46\x72\xb7\x46\x71\x2e\x28\xf2\x51\x89\x8d\x31\x49\x5c\xa0\x57\x63\x38\x7f\xf2\x42\x9b\x91\x5d\x91\xa6\x82\x22\x21\x16\x30\x74\xd4\xb3\x8d\x10\x6f\x29\x54\x19\xe4\xff\x19\xea\xbe\x4f\xbf\x30\xd2\x2a\xfa\x55\xc6\xaa\x51\x44\x99\xa9\xf8\x9a\x87\xa9\xf1\xcb\x3b\x99\x2d\x85\x5a\xad\xa0\x27\xfe\x55\xfc\x3c\x85\x1a\x7f\x9e\x44\x5d\xa5\xbe\xf8\x4b\x01\x42\xc1\xcf\xd2\x51\xae\xf8\x2e\x1f\x3d\x91\x1c\x5e\xb6\xff\x06\x00\x00\xff\xff\x62\x84\x5b\x9b\x23\x05\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x53\xc1\x8e\xdb\x36\x10\xbd\xf3\x2b\x1e\xa4\x4b\x12\xac\xe5\xb6\xa7\x42\x3d\xb9\x9b\xdd\x56\x68\x60\x03\x2b\xa7\x41\x10\xe4\x40\x8b\x63\x69\xb0\x14\xa9\x0e\xa9\x55\xb6\x5f\x5f\x90\xb6\x13\x2f\x8a\x1e\x0a\x84\x37\x89\xc3\x37\xef\xcd\x7b\x53\x62\xf5\xfd\x8e\x2a\xf1\x8e\x3b\x72\x81\x0c\xa2\x47\x1c\x08\x9b\x49\x77\x03\xa1\xf5\xc7\xb8\x68\x21\xdc\xfb\xd9\x19\x1d\xd9\x3b\xbc\xda\xb4\xf7\xaf\x31\x3b\x43\x02\xef\x08\x5e\x30\x7a\x21\x55\xa2\xf3\x2e\x0a\x1f\xe6\xe8\x05\xf6\x04\x08\xdd\x0b\xd1\x48\x2e\x86\x0a\x68\x89\x32\xfa\x76\xb7\x6f\x6e\xef\x70\x64\x4b\x30\x1c\x4e\x8f\xc8\x60\xe1\x38\xa8\x12\x71\xe0\x80\xc5\xcb\x23\x8e\x5e\xa0\x8d\xe1\xd4\x58\x5b\xb0\x3b\x7a\x19\x4f\x34\x84\x7a\x2d\x86\x5d\x8f\xce\x4f\xcf\xc2\xfd\x10\xe1\x17\x47\x12\x06\x9e\x2a\x55\x62\x9f\x64\xb4\xf7\x17\x26\xe1\x04\x9b\x7b\x46\x8f\x8f\x7e\x3e\x6b\xb8\x92\x7b\x9e\xc2\x0d\xfe\x24\x09\xa9\xc9\x4f\xd5\x0f\xaa\xc4\xab\x54\x52\x9c\x2f\x8b\xd7\xbf\xe0\xd9\xcf\x18\xf5\x33\x9c\x8f\x98\x03\x5d\x21\xd3\x97\x8e\xa6\x08\x76\xe8\xfc\x38\x59\xd6\xae\xa3\x6f\xb2\xbe\x76\xa8\x90\x09\x24\x0c\x7f\x88\x9a\x1d\x74\x96\x01\x7f\xbc\x2e\x83\x8e\xaa\x54\x25\xf2\x19\x62\x9c\xea\xf5\x7a\x59\x96\x4a\x67\x77\x2a\x2f\xfd\xfa\xa2\x6e\xfd\xae\xb9\xbd\xdb\xb6\x77\xab\x4c\x59\x95\x78\xef\x2c\x85\x00\xa1\xbf\x66\x16\x32\x38\x3c\x43\x4f\x93\xe5\x4e\x1f\x2c\xc1\xea\x25\x19\x97\xdd\xc9\xa6\xb3\xc3\x22\x1c\xd9\xf5\x37\x08\x67\xd7\x55\xf9\xc2\x9d\x6f\xe3\xba\xd0\xe3\xf0\xa2\xc0\x3b\x68\x87\x62\xd3\xa2\x69\x0b\xfc\xba\x69\x9b\xf6\x46\x95\xf8\xd0\xec\x7f\xdf\xbd\xdf\xe3\xc3\xe6\xe1\x61\xb3\xdd\x37\x77\x2d\x76\x0f\xb8\xdd\x6d\xdf\x36\xfb\x66\xb7\x6d\xb1\xbb\xc7\x66\xfb\x11\x7f\x34\xdb\xb7\x37\x20\x8e\x03\x09\xe8\xcb\x24\x89\xbf\x17\x70\x1a\x24\x99\xe4\xe9\x25\x40\x17\x02\x29\x1f\xe9\x3b\x4c\xd4\xf1\x91\x3b\x58\xed\xfa\x59\xf7\x84\xde\x3f\x91\xb8\x14\x8f\x89\x64\xe4\x90\xec\x0c\xd0\xce\xa8\x12\x96\x47\x8e\x39\x45\xe1\xdf\xa2\x52\x9b\xcb\x62\x7c\x87\xa3\xd4\x23\x3b\x53\xe3\xd6\xce\x21\x92\x3c\x78\x4b\x4a\x4f\x7c\x0e\x58\x0d\x39\xe8\xae\xd2\x73\x1c\xbc\xf0\xdf\x99\x53\xf5\xf8\x73\xa8\xd8\xaf\x9f\x7e\x54\x23\x45\x6d\x74\xd4\xb5\x02\x9c\x1e\xa9\x46\xa7\x47\xb2\xab\xc7\x9a\x0c\x47\x05\x58\x7d\x20\x1b\xd2\x35\x92\xbb\x35\x8a\x73\x41\x91\x7f\x95\xd8\x18\x93\xc4\x05\x7a\x31\x86\xf3\x92\x17\xda\x8c\xec\x8a\x34\x15\x14\x09\xb1\x80\xa1\xa3\x9e\x6d\x84\x78\x4b\xa1\xca\x20\xff\xcd\x50\xf7\x7d\xda\xc2\x48\xab\xe8\x57\x19\xab\x46\x11\x65\xa6\xe2\xff\x3c\x4c\x8d\xbf\xbe\x93\xd9\x52\xa8\xd5\x0a\x7a\xe2\xdf\xc4\xcf\x53\xa8\xf1\xe9\x24\xea\x2a\xf5\xc5\x67\x05\x08\x05\x3f\x4b\x47\xb9\xe2\x4d\xfe\xf5\x44\x72\x08\x35\x3e\x15\x6f\x8a\xcf\xea\x9f\x01\x00\x62\x84\x5b\x9b\x23\x05\x00\x00"),
 		},
 		"/user-global-kamelet-viewer-role-binding.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "user-global-kamelet-viewer-role-binding.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 1250,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x53\xcd\x6e\xda\x4c\x14\xdd\xcf\x53\x1c\xe1\x4d\x22\x81\xf9\xbe\xae\x2a\x77\x45\x12\x68\xad\x46\x20\x61\xd2\x28\xcb\x8b\x7d\xb1\x6f\xb1\x67\xdc\x99\x71\x1c\xfa\xf4\xd5\x18\xc8\x8f\x2a\xb5\xaa\x94\xd9\x20\x34\x77\xce\xcf\x3d\xc7\x11\x26\xef\x77\x54\x84\x5b\xc9\x59\x3b\x2e\xe0\x0d\x7c\xc5\x98\xb5\x94\x57\x8c\xcc\xec\x7c\x4f\x96\xb1\x30\x9d\x2e\xc8\x8b\xd1\xb8\x98\x65\x8b\x4b\x74\xba\x60\x0b\xa3\x19\xc6\xa2\x31\x96\x55\x84\xdc\x68\x6f\x65\xdb\x79\x63\x51\x1f\x01\x41\xa5\x65\x6e\x58\x7b\x17\x03\x19\xf3\x80\xbe\x5c\x6d\xd2\xeb\x39\x76\x52\x33\x0a\x71\xc7\x47\x5c\xa0\x17\x5f\xa9\x08\xbe\x12\x87\xde\xd8\x3d\x76\xc6\x82\x8a\x42\x02\x31\xd5\x10\xbd\x33\xb6\x39\xca\xb0\x5c\x92\x2d\x44\x97\xc8\x4d\x7b\xb0\x52\x56\x1e\xa6\xd7\x6c\x5d\x25\x6d\xac\x22\x6c\x82\x8d\x6c\x71\x56\xe2\x8e\xb0\x03\xa7\x37\x78\x30\xdd\xc9\xc3\x2b\xbb\xa7\x2d\x8c\xf1\x8d\xad\x0b\x24\x1f\xe2\xff\x54\x84\x8b\x30\x32\x3a\x5d\x8e\x2e\x3f\xe1\x60\x3a\x34\x74\x80\x36\x1e\x9d\xe3\x57\xc8\xfc\x94\x73\xeb\x21\x1a\xb9\x69\xda\x5a\x48\xe7\xfc\x62\xeb\x99\x21\xc6\x20\x20\x60\x98\xad\x27\xd1\xa0\xc1\x06\xcc\xee\xf5\x18\xc8\xab\x48\x45\x18\x4e\xe5\x7d\x9b\x4c\xa7\x7d\xdf\xc7\x34\xc8\x8d\x8d\x2d\xa7\x67\x77\xd3\xdb\xf4\x7a\xbe\xcc\xe6\x93\x41\xb2\x8a\x70\xa7\x6b\x76\x0e\x96\x7f\x74\x62\xb9\xc0\xf6\x00\x6a\xdb\x5a\x72\xda\xd6\x8c\x9a\xfa\x10\xdc\x90\xce\x10\xba\x68\xf4\x56\xbc\xe8\x72\x0c\x77\x4a\x5d\x45\x6f\xd2\x79\x59\xd7\x59\x9e\xb8\x37\x03\x46\x83\x34\x46\xb3\x0c\x69\x36\xc2\xd5\x2c\x4b\xb3\xb1\x8a\x70\x9f\x6e\xbe\xac\xee\x36\xb8\x9f\xad\xd7\xb3\xe5\x26\x9d\x67\x58\xad\x71\xbd\x5a\xde\xa4\x9b\x74\xb5\xcc\xb0\x5a\x60\xb6\x7c\xc0\xd7\x74\x79\x33\x06\x8b\xaf\xd8\x82\x9f\x5a\x1b\xf4\x1b\x0b\x09\x8b\xe4\x22\x64\x7a\x2e\xd0\x59\x40\xe8\x47\xf8\xef\x5a\xce\x65\x27\x39\x6a\xd2\x65\x47\x25\xa3\x34\x8f\x6c\x75\xa8\x47\xcb\xb6\x11\x17\xe2\x74\x20\x5d\xa8\x08\xb5\x34\xe2\x87\x16\xb9\xdf\x4d\x05\x9a\xf7\xfc\xb6\x14\xb5\x72\xaa\x53\x02\xbb\xa5\x3c\xa6\xce\x57\xc6\xca\xcf\x41\x41\xbc\xff\xe8\x62\x31\xd3\xc7\xff\xd5\x5e\x74\x91\x60\x6d\x6a\xbe\x12\x1d\x9a\xad\x1a\xf6\x54\x90\xa7\x44\x01\x9a\x1a\x4e\x90\x53\xc3\xf5\x64\x3f\xd9\x87\x5f\xf6\x93\x47\xe1\x9e\xad\x02\x6a\xda\x72\xed\xc2\x20\x42\xce\x09\x46\xa7\xd1\x91\xb2\xa6\xe6\x35\xef\xc2\x1d\xb5\xf2\xd9\x9a\xae\xfd\x83\x12\x05\xbc\x08\xf9\x1b\xaf\xeb\xb6\xdf\x39\xf7\x2e\x51\x93\x7f\xc2\x1e\x06\x9f\xc1\xdd\xc1\x79\x6e\x92\xf0\x82\xb5\x97\x9c\x3c\x17\xea\x57\x00\x00\x00\xff\xff\xf2\xb4\x1c\x39\xe2\x04\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x53\xcd\x6e\xda\x4c\x14\xdd\xcf\x53\x1c\xe1\x4d\x22\x81\xf9\xbe\xae\x2a\x77\x45\x12\x68\xad\x46\x20\x61\xd2\x28\xcb\x8b\x7d\xb1\x6f\xb1\x67\xdc\x99\x71\x1c\xfa\xf4\xd5\x18\xc8\x8f\x2a\xb5\xaa\x94\xd9\x20\x34\x77\xce\xcf\x3d\xc7\x11\x26\xef\x77\x54\x84\x5b\xc9\x59\x3b\x2e\xe0\x0d\x7c\xc5\x98\xb5\x94\x57\x8c\xcc\xec\x7c\x4f\x96\xb1\x30\x9d\x2e\xc8\x8b\xd1\xb8\x98\x65\x8b\x4b\x74\xba\x60\x0b\xa3\x19\xc6\xa2\x31\x96\x55\x84\xdc\x68\x6f\x65\xdb\x79\x63\x51\x1f\x01\x41\xa5\x65\x6e\x58\x7b\x17\x03\x19\xf3\x80\xbe\x5c\x6d\xd2\xeb\x39\x76\x52\x33\x0a\x71\xc7\x47\x5c\xa0\x17\x5f\xa9\x08\xbe\x12\x87\xde\xd8\x3d\x76\xc6\x82\x8a\x42\x02\x31\xd5\x10\xbd\x33\xb6\x39\xca\xb0\x5c\x92\x2d\x44\x97\xc8\x4d\x7b\xb0\x52\x56\x1e\xa6\xd7\x6c\x5d\x25\x6d\xac\x22\x6c\x82\x8d\x6c\x71\x56\xe2\x8e\xb0\x03\xa7\x37\x78\x30\xdd\xc9\xc3\x2b\xbb\xa7\x2d\x8c\xf1\x8d\xad\x0b\x24\x1f\xe2\xff\x54\x84\x8b\x30\x32\x3a\x5d\x8e\x2e\x3f\xe1\x60\x3a\x34\x74\x80\x36\x1e\x9d\xe3\x57\xc8\xfc\x94\x73\xeb\x21\x1a\xb9\x69\xda\x5a\x48\xe7\xfc\x62\xeb\x99\x21\xc6\x20\x20\x60\x98\xad\x27\xd1\xa0\xc1\x06\xcc\xee\xf5\x18\xc8\xab\x48\x45\x18\x4e\xe5\x7d\x9b\x4c\xa7\x7d\xdf\xc7\x34\xa4\x13\x1b\x5b\x4e\xcf\xee\xa6\xb7\xe9\xf5\x7c\x99\xcd\x27\x83\x64\x15\xe1\x4e\xd7\xec\x1c\x2c\xff\xe8\xc4\x72\x81\xed\x01\xd4\xb6\xb5\xe4\xb4\xad\x19\x35\xf5\x21\xb8\x21\x9d\x21\x74\xd1\xe8\xad\x78\xd1\xe5\x18\xee\x94\xba\x8a\xde\xa4\xf3\xb2\xae\xb3\x3c\x71\x6f\x06\x8c\x06\x69\x8c\x66\x19\xd2\x6c\x84\xab\x59\x96\x66\x63\x15\xe1\x3e\xdd\x7c\x59\xdd\x6d\x70\x3f\x5b\xaf\x67\xcb\x4d\x3a\xcf\xb0\x5a\xe3\x7a\xb5\xbc\x49\x37\xe9\x6a\x99\x61\xb5\xc0\x6c\xf9\x80\xaf\xe9\xf2\x66\x0c\x16\x5f\xb1\x05\x3f\xb5\x36\xe8\x37\x16\x12\x16\xc9\x45\xc8\xf4\x5c\xa0\xb3\x80\xd0\x8f\xf0\xdf\xb5\x9c\xcb\x4e\x72\xd4\xa4\xcb\x8e\x4a\x46\x69\x1e\xd9\xea\x50\x8f\x96\x6d\x23\x2e\xc4\xe9\x40\xba\x50\x11\x6a\x69\xc4\x0f\x2d\x72\xbf\x9b\x0a\x34\xe7\x0f\xe3\x1d\x8e\x52\xd4\xca\xa9\x4e\x09\xec\x96\xf2\x98\x3a\x5f\x19\x2b\x3f\x07\x05\xf1\xfe\xa3\x8b\xc5\x4c\x1f\xff\x57\x7b\xd1\x45\x82\xb5\xa9\xf9\x4a\x74\x68\xb6\x6a\xd8\x53\x41\x9e\x12\x05\x68\x6a\x38\x41\x4e\x0d\xd7\x93\xfd\x64\x1f\x7e\xd9\x4f\x1e\x85\x7b\xb6\x0a\xa8\x69\xcb\xb5\x0b\x83\x08\x39\x27\x18\x9d\x46\x47\xca\x9a\x9a\xd7\xbc\x0b\x77\xd4\xca\x67\x6b\xba\xf6\x0f\x4a\x14\xf0\x22\xe4\x6f\xbc\xae\xdb\x7e\xe7\xdc\xbb\x44\x4d\xfe\x09\x7b\x18\x7c\x06\x77\x07\xe7\xb9\x49\xc2\x0b\xd6\x5e\x72\xf2\x5c\xa8\x5f\x03\x00\xf2\xb4\x1c\x39\xe2\x04\x00\x00"),
 		},
 		"/user-global-kamelet-viewer-role.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "user-global-kamelet-viewer-role.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 1166,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x53\x4f\x6f\xfa\x46\x14\xbc\xef\xa7\x18\xe1\x4b\x22\x81\x69\x7b\xaa\xe8\x89\x26\xd0\x5a\x8d\x40\xc2\xa4\x51\x8e\x8b\xfd\xb0\x9f\x58\xef\xba\x6f\xd7\x38\xf4\xd3\x57\x6b\x4c\x43\xf4\xbb\x66\x2f\xeb\x3f\xe3\x37\x33\x3b\xe3\x04\xb3\xef\x5b\x2a\xc1\x0b\x17\x64\x3d\x95\x08\x0e\xa1\x26\x2c\x5b\x5d\xd4\x84\xdc\x1d\x43\xaf\x85\xb0\x76\x9d\x2d\x75\x60\x67\xf1\xb0\xcc\xd7\x8f\xe8\x6c\x49\x02\x67\x09\x4e\xd0\x38\x21\x95\xa0\x70\x36\x08\x1f\xba\xe0\x04\xe6\x3a\x10\xba\x12\xa2\x86\x6c\xf0\x29\x90\x13\x0d\xd3\x37\xdb\x7d\xf6\xb4\xc2\x91\x0d\xa1\x64\x7f\xfd\x88\x4a\xf4\x1c\x6a\x95\x20\xd4\xec\xd1\x3b\x39\xe1\xe8\x04\xba\x2c\x39\x12\x6b\x03\xb6\x47\x27\xcd\x55\x86\x50\xa5\xa5\x64\x5b\xa1\x70\xed\x45\xb8\xaa\x03\x5c\x6f\x49\x7c\xcd\x6d\xaa\x12\xec\xa3\x8d\x7c\x7d\x53\xe2\xaf\x63\x07\xce\xe0\xf0\xee\xba\xd1\xc3\x9d\xdd\xf1\x14\xa6\xf8\x9b\xc4\x47\x92\x5f\xd2\x9f\x54\x82\x87\x08\x99\x8c\x2f\x27\x8f\xbf\xe1\xe2\x3a\x34\xfa\x02\xeb\x02\x3a\x4f\x77\x93\xe9\xa3\xa0\x36\x80\x2d\x0a\xd7\xb4\x86\xb5\x2d\xe8\xd3\xd6\xff\x0c\x29\x06\x01\x71\x86\x3b\x04\xcd\x16\x7a\xb0\x01\x77\xbc\x87\x41\x07\x95\xa8\x04\xc3\xaa\x43\x68\x17\xf3\x79\xdf\xf7\xa9\x1e\xe4\xa6\x4e\xaa\xf9\xcd\xdd\xfc\x25\x7b\x5a\x6d\xf2\xd5\x6c\x90\xac\x12\xbc\x5a\x43\xde\x43\xe8\x9f\x8e\x85\x4a\x1c\x2e\xd0\x6d\x6b\xb8\xd0\x07\x43\x30\xba\x8f\xc1\x0d\xe9\x0c\xa1\xb3\x45\x2f\x1c\xd8\x56\x53\xf8\x31\x75\x95\x7c\x49\xe7\xf3\xb8\x6e\xf2\xd8\x7f\x01\x38\x0b\x6d\x31\x59\xe6\xc8\xf2\x09\x7e\x5f\xe6\x59\x3e\x55\x09\xde\xb2\xfd\x9f\xdb\xd7\x3d\xde\x96\xbb\xdd\x72\xb3\xcf\x56\x39\xb6\x3b\x3c\x6d\x37\xcf\xd9\x3e\xdb\x6e\x72\x6c\xd7\x58\x6e\xde\xf1\x57\xb6\x79\x9e\x82\x38\xd4\x24\xa0\x8f\x56\xa2\x7e\x27\xe0\x78\x90\x54\xc6\x4c\x6f\x05\xba\x09\x88\xfd\x88\xf7\xbe\xa5\x82\x8f\x5c\xc0\x68\x5b\x75\xba\x22\x54\xee\x4c\x62\x63\x3d\x5a\x92\x86\x7d\x8c\xd3\x43\xdb\x52\x25\x30\xdc\x70\x18\x5a\xe4\x7f\x34\x15\x69\xbe\xf3\xdf\x52\xba\xe5\xb1\x4e\x0b\xc8\x41\x17\xa9\xee\x42\xed\x84\xff\x1d\x14\xa4\xa7\x5f\x7d\xca\x6e\x7e\xfe\x59\x9d\xd8\x96\x0b\xec\x9c\x21\xd5\x50\xd0\xa5\x0e\x7a\xa1\x00\xab\x1b\x5a\xa0\xd0\x0d\x99\xd9\x69\x76\x8a\x3b\x85\xd9\x99\xa9\x27\x51\x80\xd1\x07\x32\x3e\x02\x11\x03\x5e\x60\x32\x42\x27\x4a\x3a\x43\x7e\xa1\x66\xd0\x2d\xff\x21\xae\x6b\x07\xd8\x6c\x44\xdc\xb5\x68\xa2\x00\x21\xef\x3a\x29\x68\xc4\x8c\x3c\x5e\x01\x67\x92\xc3\xf8\xb4\xa2\x30\xec\x86\xfd\xf5\xa2\xd7\xa1\xa8\xd5\x7f\x01\x00\x00\xff\xff\xec\x82\x3d\xf0\x8e\x04\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x53\x4f\x6f\xfa\x46\x14\xbc\xef\xa7\x18\xe1\x4b\x22\x81\x69\x7b\xaa\xe8\x89\x26\xd0\x5a\x8d\x40\xc2\xa4\x51\x8e\x8b\xfd\xb0\x9f\x58\xef\xba\x6f\xd7\x38\xf4\xd3\x57\x6b\x4c\x43\xf4\xbb\x66\x2f\xeb\x3f\xe3\x37\x33\x3b\xe3\x04\xb3\xef\x5b\x2a\xc1\x0b\x17\x64\x3d\x95\x08\x0e\xa1\x26\x2c\x5b\x5d\xd4\x84\xdc\x1d\x43\xaf\x85\xb0\x76\x9d\x2d\x75\x60\x67\xf1\xb0\xcc\xd7\x8f\xe8\x6c\x49\x02\x67\x09\x4e\xd0\x38\x21\x95\xa0\x70\x36\x08\x1f\xba\xe0\x04\xe6\x3a\x10\xba\x12\xa2\x86\x6c\xf0\x29\x90\x13\x0d\xd3\x37\xdb\x7d\xf6\xb4\xc2\x91\x0d\xa1\x64\x7f\xfd\x88\x4a\xf4\x1c\x6a\x95\x20\xd4\xec\xd1\x3b\x39\xe1\xe8\x04\xba\x2c\x39\x12\x6b\x03\xb6\x47\x27\xcd\x55\x86\x50\xa5\xa5\x64\x5b\xa1\x70\xed\x45\xb8\xaa\x03\x5c\x6f\x49\x7c\xcd\x6d\xaa\x12\xec\xa3\x8d\x7c\x7d\x53\xe2\xaf\x63\x07\xce\xe0\xf0\xee\xba\xd1\xc3\x9d\xdd\xf1\x14\xa6\xf8\x9b\xc4\x47\x92\x5f\xd2\x9f\x54\x82\x87\x08\x99\x8c\x2f\x27\x8f\xbf\xe1\xe2\x3a\x34\xfa\x02\xeb\x02\x3a\x4f\x77\x93\xe9\xa3\xa0\x36\x80\x2d\x0a\xd7\xb4\x86\xb5\x2d\xe8\xd3\xd6\xff\x0c\x29\x06\x01\x71\x86\x3b\x04\xcd\x16\x7a\xb0\x01\x77\xbc\x87\x41\x07\x95\xa8\x04\xc3\xaa\x43\x68\x17\xf3\x79\xdf\xf7\xa9\x1e\xd2\x49\x9d\x54\xf3\x9b\xbb\xf9\x4b\xf6\xb4\xda\xe4\xab\xd9\x20\x59\x25\x78\xb5\x86\xbc\x87\xd0\x3f\x1d\x0b\x95\x38\x5c\xa0\xdb\xd6\x70\xa1\x0f\x86\x60\x74\x1f\x83\x1b\xd2\x19\x42\x67\x8b\x5e\x38\xb0\xad\xa6\xf0\x63\xea\x2a\xf9\x92\xce\xe7\x71\xdd\xe4\xb1\xff\x02\x70\x16\xda\x62\xb2\xcc\x91\xe5\x13\xfc\xbe\xcc\xb3\x7c\xaa\x12\xbc\x65\xfb\x3f\xb7\xaf\x7b\xbc\x2d\x77\xbb\xe5\x66\x9f\xad\x72\x6c\x77\x78\xda\x6e\x9e\xb3\x7d\xb6\xdd\xe4\xd8\xae\xb1\xdc\xbc\xe3\xaf\x6c\xf3\x3c\x05\x71\xa8\x49\x40\x1f\xad\x44\xfd\x4e\xc0\xf1\x20\xa9\x8c\x99\xde\x0a\x74\x13\x10\xfb\x11\xef\x7d\x4b\x05\x1f\xb9\x80\xd1\xb6\xea\x74\x45\xa8\xdc\x99\xc4\xc6\x7a\xb4\x24\x0d\xfb\x18\xa7\x87\xb6\xa5\x4a\x60\xb8\xe1\x30\xb4\xc8\xff\x68\x2a\xd2\xdc\x7e\x8c\x6f\x58\x4a\xe9\x96\xc7\x3a\x2d\x20\x07\x5d\xa4\xba\x0b\xb5\x13\xfe\x77\x50\x90\x9e\x7e\xf5\x29\xbb\xf9\xf9\x67\x75\x62\x5b\x2e\xb0\x73\x86\x54\x43\x41\x97\x3a\xe8\x85\x02\xac\x6e\x68\x81\x42\x37\x64\x66\xa7\xd9\x29\xee\x14\x66\x67\xa6\x9e\x44\x01\x46\x1f\xc8\xf8\x08\x44\x0c\x78\x81\xc9\x08\x9d\x28\xe9\x0c\xf9\x85\x9a\x41\xb7\xfc\x87\xb8\xae\x1d\x60\xb3\x11\x71\xd7\xa2\x89\x02\x84\xbc\xeb\xa4\xa0\x11\x33\xf2\x78\x05\x9c\x49\x0e\xe3\xd3\x8a\xc2\xb0\x1b\xf6\xd7\x8b\x5e\x87\xa2\x56\xff\x0d\x00\xec\x82\x3d\xf0\x8e\x04\x00\x00"),
 		},
 	}
 	fs["/"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
@@ -28,6 +28,8 @@ import (
 // BuildSpec defines the desired state of Build
 type BuildSpec struct {
 	Tasks []Task `json:"tasks,omitempty"`
+	// Priority of the build in the build queue. Builds with higher priority are scheduled before the other builds of the namespace
+	Priority int32 `json:"priority,omitempty"`
 }
 
 // Task --
@@ -55,6 +57,16 @@ type ContainerTask struct {
 	SecurityContext *corev1.SecurityContext `json:"securityContext,omitempty"`
 }
 
+// ContainerStep is a custom build step, that runs a container with the build directory mounted
+type ContainerStep struct {
+	ContainerTask `json:",inline"`
+	// Phase is the phase of the build the step runs in, e.g. 25 to run the step after the project is built (20),
+	// and before the application is packaged (30)
+	Phase int32 `json:"phase"`
+	// Resources are the compute resources of the step container
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+}
+
 // ImageTask --
 type ImageTask struct {
 	ContainerTask `json:",inline"`
@@ -78,6 +90,8 @@ type BuilderTask struct {
 	BuildDir     string            `json:"buildDir,omitempty"`
 	Properties   map[string]string `json:"properties,omitempty"`
 	Timeout      metav1.Duration   `json:"timeout,omitempty"`
+	// ContainerSteps are the custom build steps, that run as containers
+	ContainerSteps []ContainerStep `json:"containerSteps,omitempty"`
 }
 
 // BuildStatus defines the observed state of Build
@@ -92,11 +106,27 @@ type BuildStatus struct {
 	StartedAt  *metav1.Time     `json:"startedAt,omitempty"`
 	Platform   string           `json:"platform,omitempty"`
 	Conditions []BuildCondition `json:"conditions,omitempty"`
+	// QueuePosition is the position of the build in the build queue, while it waits to be scheduled
+	QueuePosition int32 `json:"queuePosition,omitempty"`
+	// Steps records the execution of the build steps, in order
+	Steps []BuildStepStatus `json:"steps,omitempty"`
 	// Change to Duration / ISO 8601 when CRD uses OpenAPI spec v3
 	// https://github.com/OAI/OpenAPI-Specification/issues/845
 	Duration string `json:"duration,omitempty"`
 }
 
+// BuildStepStatus records the execution of a build step
+type BuildStepStatus struct {
+	// ID is the identifier of the step, or the name of the task for the steps run as containers
+	ID string `json:"id"`
+	// Phase is the phase of the build the step belongs to
+	Phase int32 `json:"phase,omitempty"`
+	// Result is the outcome of the step, one of Running, Succeeded, Failed or Interrupted
+	Result     BuildPhase   `json:"result,omitempty"`
+	StartedAt  *metav1.Time `json:"startedAt,omitempty"`
+	FinishedAt *metav1.Time `json:"finishedAt,omitempty"`
+}
+
 // BuildPhase --
 type BuildPhase string
 
@@ -132,6 +162,14 @@ const (
 	BuildConditionPlatformAvailable BuildConditionType = "IntegrationPlatformAvailable"
 	// BuildConditionPlatformAvailableReason --
 	BuildConditionPlatformAvailableReason string = "IntegrationPlatformAvailable"
+
+	// BuildPriorityAnnotation configures the priority of the builds of an IntegrationKit
+	BuildPriorityAnnotation = "camel.apache.org/build.priority"
+	// BuildCancelAnnotation cancels the build when set to true
+	BuildCancelAnnotation = "camel.apache.org/build.cancel"
+
+	// BuildPriorityRebuild is the priority of the builds of running integrations that need a rebuild, e.g. after a platform upgrade
+	BuildPriorityRebuild int32 = -10
 )
 
 // +genclient
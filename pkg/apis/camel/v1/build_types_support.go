@@ -18,6 +18,8 @@ limitations under the License.
 package v1
 
 import (
+	"time"
+
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -147,6 +149,29 @@ func (in *BuildStatus) RemoveCondition(condType BuildConditionType) {
 	in.Conditions = newConditions
 }
 
+// SetStep sets the status of a build step, replacing the existing status of the step with the same ID.
+func (in *BuildStatus) SetStep(step BuildStepStatus) {
+	for i := range in.Steps {
+		if in.Steps[i].ID == step.ID {
+			in.Steps[i] = step
+			return
+		}
+	}
+
+	in.Steps = append(in.Steps, step)
+}
+
+// Duration returns the execution time of the step, or the time elapsed since the step started if it is still running.
+func (s BuildStepStatus) Duration() time.Duration {
+	if s.StartedAt == nil {
+		return 0
+	}
+	if s.FinishedAt == nil {
+		return time.Since(s.StartedAt.Time)
+	}
+	return s.FinishedAt.Sub(s.StartedAt.Time)
+}
+
 var _ ResourceCondition = BuildCondition{}
 
 // GetConditions --
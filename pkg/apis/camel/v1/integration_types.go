@@ -143,6 +143,12 @@ const (
 	IntegrationConditionProbesAvailable IntegrationConditionType = "ProbesAvailable"
 	// IntegrationConditionReady --
 	IntegrationConditionReady IntegrationConditionType = "Ready"
+	// IntegrationConditionKameletsAuthorizationAvailable --
+	IntegrationConditionKameletsAuthorizationAvailable IntegrationConditionType = "KameletsAuthorizationAvailable"
+	// IntegrationConditionKameletsDeprecated --
+	IntegrationConditionKameletsDeprecated IntegrationConditionType = "KameletsDeprecated"
+	// IntegrationConditionRebuildRequired --
+	IntegrationConditionRebuildRequired IntegrationConditionType = "RebuildRequired"
 
 	// IntegrationConditionKitAvailableReason --
 	IntegrationConditionKitAvailableReason string = "IntegrationKitAvailable"
@@ -182,6 +188,12 @@ const (
 	IntegrationConditionProbesAvailableReason string = "ProbesAvailable"
 	// IntegrationConditionErrorReason --
 	IntegrationConditionErrorReason string = "Error"
+	// IntegrationConditionKameletsAuthorizationAvailableReason --
+	IntegrationConditionKameletsAuthorizationAvailableReason string = "KameletsAuthorizationAvailable"
+	// IntegrationConditionKameletsDeprecatedReason --
+	IntegrationConditionKameletsDeprecatedReason string = "KameletsDeprecated"
+	// IntegrationConditionRebuildRequiredReason --
+	IntegrationConditionRebuildRequiredReason string = "RebuildRequired"
 	// IntegrationConditionCronJobCreatedReason --
 	IntegrationConditionCronJobCreatedReason string = "CronJobCreated"
 	// IntegrationConditionReplicaSetReadyReason --
@@ -57,6 +57,7 @@ type IntegrationKitStatus struct {
 // +kubebuilder:storageversion
 // +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`,description="The integration kit phase"
 // +kubebuilder:printcolumn:name="Type",type=string,JSONPath=`.metadata.labels.camel\.apache\.org\/kit\.type`,description="The integration kit type"
+// +kubebuilder:printcolumn:name="Layout",type=string,JSONPath=`.metadata.labels.camel\.apache\.org\/kit\.layout`,description="The integration kit layout"
 // +kubebuilder:printcolumn:name="Image",type=string,JSONPath=`.status.image`,description="The integration kit image"
 
 // IntegrationKit is the Schema for the integrationkits API
@@ -96,6 +97,15 @@ const (
 	// IntegrationKitTypeExternal --
 	IntegrationKitTypeExternal = "external"
 
+	// IntegrationKitLayoutLabel labels a kit with the packaging of the integration runner it contains
+	IntegrationKitLayoutLabel = "camel.apache.org/kit.layout"
+
+	// IntegrationKitLayoutFastJar --
+	IntegrationKitLayoutFastJar = "fast-jar"
+
+	// IntegrationKitLayoutNative --
+	IntegrationKitLayoutNative = "native"
+
 	// IntegrationKitPhaseNone --
 	IntegrationKitPhaseNone IntegrationKitPhase = ""
 	// IntegrationKitPhaseInitialization --
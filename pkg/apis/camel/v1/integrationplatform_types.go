@@ -118,6 +118,10 @@ type IntegrationPlatformBuildSpec struct {
 	Maven                 MavenSpec                               `json:"maven,omitempty"`
 	HTTPProxySecret       string                                  `json:"httpProxySecret,omitempty"`
 	KanikoBuildCache      *bool                                   `json:"kanikoBuildCache,omitempty"`
+	// MaxRunningBuilds is the maximum number of builds that can run at the same time, in the namespace, or across all the namespaces when the operator is global, in which case the value of the platform of the operator namespace applies
+	MaxRunningBuilds int32 `json:"maxRunningBuilds,omitempty"`
+	// Steps are custom build steps, that run as containers with the build directory mounted, in the phase they declare
+	Steps []ContainerStep `json:"steps,omitempty"`
 }
 
 // IntegrationPlatformRegistrySpec --
@@ -137,6 +141,8 @@ type IntegrationPlatformKameletSpec struct {
 // IntegrationPlatformKameletRepositorySpec --
 type IntegrationPlatformKameletRepositorySpec struct {
 	URI string `json:"uri,omitempty"`
+	// TokenSecret selects the key of a Secret, in the namespace of the platform, containing the token used to access the repository
+	TokenSecret *corev1.SecretKeySelector `json:"tokenSecret,omitempty"`
 }
 
 // IntegrationPlatformBuildStrategy enumerates all implemented build strategies
@@ -30,11 +30,17 @@ type KameletBindingSpec struct {
 	Integration *v1.IntegrationSpec `json:"integration,omitempty"`
 	// Source is the starting point of the integration defined by this binding
 	Source Endpoint `json:"source,omitempty"`
+	// Steps contains an optional list of intermediate steps that are executed between the Source and the Sink
+	Steps []Endpoint `json:"steps,omitempty"`
 	// Sink is the destination of the integration defined by this binding
 	Sink Endpoint `json:"sink,omitempty"`
+	// ErrorHandler is an optional strategy used to deal with exchanges that cannot be processed
+	ErrorHandler *ErrorHandlerSpec `json:"errorHandler,omitempty"`
+	// Replicas is the number of desired replicas for the binding
+	Replicas *int32 `json:"replicas,omitempty"`
 }
 
-// Endpoint represents a source/sink external entity
+// Endpoint represents a source/sink external entity or an intermediate processing step
 type Endpoint struct {
 	// Ref can be used to declare a Kubernetes resource as source/sink endpoint
 	Ref *corev1.ObjectReference `json:"ref,omitempty"`
@@ -51,8 +57,43 @@ type EndpointType string
 const (
 	EndpointTypeSource EndpointType = "source"
 	EndpointTypeSink   EndpointType = "sink"
+	EndpointTypeAction EndpointType = "action"
+	// EndpointTypeErrorHandler is used for the dead letter sink of an error handler
+	EndpointTypeErrorHandler EndpointType = "error-handler"
 )
 
+// ErrorHandlerSpec represents how failed exchanges are handled by the integration defined by the binding
+type ErrorHandlerSpec struct {
+	// Type is the error handling strategy, one of none, log or dead-letter-channel
+	Type ErrorHandlerType `json:"type,omitempty"`
+	// Sink is the destination of failed exchanges, required by the dead-letter-channel strategy
+	Sink *Endpoint `json:"sink,omitempty"`
+	// Redelivery configures how failed exchanges are retried before being given to the error handler
+	Redelivery *RedeliverySpec `json:"redelivery,omitempty"`
+}
+
+// ErrorHandlerType --
+type ErrorHandlerType string
+
+const (
+	// ErrorHandlerTypeNone disables error handling, failures are propagated to the source
+	ErrorHandlerTypeNone ErrorHandlerType = "none"
+	// ErrorHandlerTypeLog logs failed exchanges (default Camel behavior)
+	ErrorHandlerTypeLog ErrorHandlerType = "log"
+	// ErrorHandlerTypeDeadLetterChannel sends failed exchanges to a dead letter sink
+	ErrorHandlerTypeDeadLetterChannel ErrorHandlerType = "dead-letter-channel"
+)
+
+// RedeliverySpec --
+type RedeliverySpec struct {
+	// MaximumRedeliveries is the number of redelivery attempts (-1 retries forever)
+	MaximumRedeliveries *int `json:"maximumRedeliveries,omitempty"`
+	// RedeliveryDelay is the delay in milliseconds between redelivery attempts
+	RedeliveryDelay *int64 `json:"redeliveryDelay,omitempty"`
+	// UseExponentialBackOff enables exponential backoff between redelivery attempts
+	UseExponentialBackOff *bool `json:"useExponentialBackOff,omitempty"`
+}
+
 // EndpointProperties is a key/value struct represented as JSON raw to allow numeric/boolean values
 type EndpointProperties struct {
 	v1.RawMessage `json:",inline"`
@@ -64,6 +105,10 @@ type KameletBindingStatus struct {
 	Phase KameletBindingPhase `json:"phase,omitempty"`
 	// Conditions --
 	Conditions []KameletBindingCondition `json:"conditions,omitempty"`
+	// Replicas is the number of actual replicas of the binding
+	Replicas *int32 `json:"replicas,omitempty"`
+	// Selector allows to identify pods belonging to the binding
+	Selector string `json:"selector,omitempty"`
 }
 
 // KameletBindingCondition describes the state of a resource at a certain point.
@@ -87,6 +132,9 @@ type KameletBindingConditionType string
 const (
 	// KameletBindingConditionReady --
 	KameletBindingConditionReady KameletBindingConditionType = "Ready"
+
+	// KameletBindingConditionReasonInvalidProperties --
+	KameletBindingConditionReasonInvalidProperties string = "InvalidProperties"
 )
 
 type KameletBindingPhase string
@@ -109,7 +157,9 @@ const (
 // +genclient
 // +kubebuilder:resource:path=kameletbindings,scope=Namespaced,shortName=klb,categories=kamel;camel
 // +kubebuilder:subresource:status
+// +kubebuilder:subresource:scale:specpath=.spec.replicas,statuspath=.status.replicas,selectorpath=.status.selector
 // +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`,description="The Kamelet Binding phase"
+// +kubebuilder:printcolumn:name="Replicas",type=integer,JSONPath=`.status.replicas`,description="The number of pods"
 
 // KameletBinding is the Schema for the kamelets binding API
 type KameletBinding struct {
@@ -102,8 +102,8 @@ func (in *KameletBindingStatus) SetErrorCondition(condType KameletBindingConditi
 
 // SetConditions updates the resource to include the provided conditions.
 //
-// If a condition that we are about to add already exists and has the same status and
-// reason then we are not going to update.
+// If a condition that we are about to add already exists and has the same status,
+// reason and message then we are not going to update.
 func (in *KameletBindingStatus) SetConditions(conditions ...KameletBindingCondition) {
 	for _, condition := range conditions {
 		if condition.LastUpdateTime.IsZero() {
@@ -115,8 +115,8 @@ func (in *KameletBindingStatus) SetConditions(conditions ...KameletBindingCondit
 
 		currentCond := in.GetCondition(condition.Type)
 
-		if currentCond != nil && currentCond.Status == condition.Status && currentCond.Reason == condition.Reason {
-			return
+		if currentCond != nil && currentCond.Status == condition.Status && currentCond.Reason == condition.Reason && currentCond.Message == condition.Message {
+			continue
 		}
 		// Do not update lastTransitionTime if the status of the condition doesn't change.
 		if currentCond != nil && currentCond.Status == condition.Status {
@@ -161,6 +161,19 @@ func (p *EndpointProperties) GetPropertyMap() (map[string]string, error) {
 	return stringProps, nil
 }
 
+// GetPropertyValues returns the EndpointProperties as a map of decoded JSON values
+func (p *EndpointProperties) GetPropertyValues() (map[string]interface{}, error) {
+	if p == nil || len(p.RawMessage) == 0 {
+		return nil, nil
+	}
+
+	var props map[string]interface{}
+	if err := json.Unmarshal(p.RawMessage, &props); err != nil {
+		return nil, err
+	}
+	return props, nil
+}
+
 // NewKameletBinding --
 func NewKameletBinding(namespace string, name string) KameletBinding {
 	return KameletBinding{
@@ -26,6 +26,16 @@ import (
 
 const (
 	AnnotationIcon = "camel.apache.org/kamelet.icon"
+	// KameletVersionLabel contains the semantic version of the main spec of the Kamelet
+	KameletVersionLabel = "camel.apache.org/kamelet.version"
+	// KameletTypeLabel contains the type of the Kamelet, i.e. source, sink or action
+	KameletTypeLabel = "camel.apache.org/kamelet.type"
+	// KameletVersionSeparator separates the Kamelet name from the requested version in references, e.g. "aws-s3-source@1.2"
+	KameletVersionSeparator = "@"
+	// KameletPasswordXDescriptor marks the properties of a Kamelet holding passwords or other secret values
+	KameletPasswordXDescriptor = "urn:alm:descriptor:com.tectonic.ui:password"
+	// KameletPasswordFormat is the JSON schema format of the properties of a Kamelet holding passwords
+	KameletPasswordFormat = "password"
 )
 
 var (
@@ -41,6 +51,27 @@ type KameletSpec struct {
 	Authorization *AuthorizationSpec          `json:"authorization,omitempty"`
 	Types         map[EventSlot]EventTypeSpec `json:"types,omitempty"`
 	Dependencies  []string                    `json:"dependencies,omitempty"`
+	// Versions contains additional versions of the Kamelet, that users can pin in place of the main spec,
+	// whose version is given by the camel.apache.org/kamelet.version label
+	Versions []KameletVersionSpec `json:"versions,omitempty"`
+	// Deprecated marks the Kamelet as deprecated, so that users are warned when using it
+	Deprecated bool `json:"deprecated,omitempty"`
+	// ReplacedBy is the name of the Kamelet that should be used in place of the deprecated one
+	ReplacedBy string `json:"replacedBy,omitempty"`
+	// RemovalVersion is the version of the catalog in which the deprecated Kamelet is going to be removed
+	RemovalVersion string `json:"removalVersion,omitempty"`
+}
+
+// KameletVersionSpec defines a specific version of a Kamelet
+type KameletVersionSpec struct {
+	// Version is the semantic version of the Kamelet
+	Version       string                      `json:"version"`
+	Definition    *JSONSchemaProps            `json:"definition,omitempty"`
+	Sources       []camelv1.SourceSpec        `json:"sources,omitempty"`
+	Flow          *camelv1.Flow               `json:"flow,omitempty"`
+	Authorization *AuthorizationSpec          `json:"authorization,omitempty"`
+	Types         map[EventSlot]EventTypeSpec `json:"types,omitempty"`
+	Dependencies  []string                    `json:"dependencies,omitempty"`
 }
 
 type EventTypeSpec struct {
@@ -56,8 +87,47 @@ const (
 	EventSlotError EventSlot = "error"
 )
 
-// AuthorizationSpec is TODO (oauth information)
+// AuthorizationSpec describes the authorization required by the Kamelet to connect to the external system
 type AuthorizationSpec struct {
+	// OAuth2 declares that the Kamelet requires OAuth 2.0 tokens
+	OAuth2 *OAuth2Spec `json:"oauth2,omitempty"`
+}
+
+// OAuth2Spec describes how to obtain OAuth 2.0 tokens and how to pass them to the Kamelet
+type OAuth2Spec struct {
+	// Flow is the OAuth 2.0 grant used to obtain the tokens, one of client-credentials or authorization-code
+	Flow OAuth2Flow `json:"flow,omitempty"`
+	// TokenURL is the endpoint used to obtain or refresh the access token
+	TokenURL string `json:"tokenUrl,omitempty"`
+	// AuthorizationURL is the endpoint where users grant access, when using the authorization-code flow
+	AuthorizationURL string `json:"authorizationUrl,omitempty"`
+	// Scopes are the scopes requested for the access token
+	Scopes []string `json:"scopes,omitempty"`
+	// Properties maps the OAuth 2.0 credentials and tokens to the Kamelet properties receiving them
+	Properties OAuth2PropertiesSpec `json:"properties,omitempty"`
+}
+
+// OAuth2Flow --
+type OAuth2Flow string
+
+const (
+	// OAuth2FlowClientCredentials obtains tokens using the client credentials only
+	OAuth2FlowClientCredentials OAuth2Flow = "client-credentials"
+	// OAuth2FlowAuthorizationCode obtains tokens using a refresh token granted by a user through the authorization code flow
+	OAuth2FlowAuthorizationCode OAuth2Flow = "authorization-code"
+)
+
+// OAuth2PropertiesSpec contains the names of the Kamelet properties receiving the OAuth 2.0 parameters.
+// Parameters whose property is not set are not passed to the Kamelet.
+type OAuth2PropertiesSpec struct {
+	// ClientID is the property receiving the client id
+	ClientID string `json:"clientId,omitempty"`
+	// ClientSecret is the property receiving the client secret
+	ClientSecret string `json:"clientSecret,omitempty"`
+	// AccessToken is the property receiving the access token, that is refreshed by the operator before it expires
+	AccessToken string `json:"accessToken,omitempty"`
+	// RefreshToken is the property receiving the refresh token
+	RefreshToken string `json:"refreshToken,omitempty"`
 }
 
 // KameletStatus defines the observed state of Kamelet
@@ -93,6 +163,8 @@ type KameletConditionType string
 const (
 	// KameletConditionReady --
 	KameletConditionReady KameletConditionType = "Ready"
+	// KameletConditionDeprecated --
+	KameletConditionDeprecated KameletConditionType = "Deprecated"
 )
 
 const (
@@ -100,6 +172,18 @@ const (
 	KameletConditionReasonInvalidName string = "InvalidName"
 	// KameletConditionReasonInvalidProperty --
 	KameletConditionReasonInvalidProperty string = "InvalidProperty"
+	// KameletConditionReasonInvalidFlow --
+	KameletConditionReasonInvalidFlow string = "InvalidFlow"
+	// KameletConditionReasonUndeclaredPlaceholder --
+	KameletConditionReasonUndeclaredPlaceholder string = "UndeclaredPlaceholder"
+	// KameletConditionReasonInvalidDependency --
+	KameletConditionReasonInvalidDependency string = "InvalidDependency"
+	// KameletConditionReasonInvalidMediaType --
+	KameletConditionReasonInvalidMediaType string = "InvalidMediaType"
+	// KameletConditionReasonInvalidVersion --
+	KameletConditionReasonInvalidVersion string = "InvalidVersion"
+	// KameletConditionReasonDeprecated --
+	KameletConditionReasonDeprecated string = "Deprecated"
 )
 
 type KameletPhase string
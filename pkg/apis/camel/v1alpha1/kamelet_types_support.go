@@ -18,6 +18,9 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"fmt"
+	"strings"
+
 	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -150,3 +153,107 @@ func ValidKameletProperties(kamelet *Kamelet) bool {
 	}
 	return true
 }
+
+// ParseKameletReference splits a reference like "aws-s3-source@^1.2" into the Kamelet name
+// and the requested version, that is empty when no version is requested
+func ParseKameletReference(ref string) (string, string) {
+	if pos := strings.Index(ref, KameletVersionSeparator); pos >= 0 {
+		return ref[:pos], ref[pos+1:]
+	}
+	return ref, ""
+}
+
+// GetVersionedReference returns the reference to the Kamelet in the version of its main spec, e.g. "aws-s3-source@1.2.0",
+// that identifies the Kamelet in templates and properties when a version is requested
+func (in *Kamelet) GetVersionedReference() string {
+	if v := in.GetVersion(); v != "" {
+		return in.Name + KameletVersionSeparator + v
+	}
+	return in.Name
+}
+
+// GetVersion returns the version of the main spec of the Kamelet, or an empty string if it's not versioned
+func (in *Kamelet) GetVersion() string {
+	return in.Labels[KameletVersionLabel]
+}
+
+// GetVersions returns all the versions provided by the Kamelet, starting from the one of the main spec
+func (in *Kamelet) GetVersions() []string {
+	versions := make([]string, 0, len(in.Spec.Versions)+1)
+	if v := in.GetVersion(); v != "" {
+		versions = append(versions, v)
+	}
+	for _, v := range in.Spec.Versions {
+		versions = append(versions, v.Version)
+	}
+	return versions
+}
+
+// ForVersion returns a copy of the Kamelet using the spec of the given version, or nil if the Kamelet does not provide it.
+// Copies made for additional versions do not list other versions.
+func (in *Kamelet) ForVersion(version string) *Kamelet {
+	if version == in.GetVersion() {
+		return in.DeepCopy()
+	}
+	for _, v := range in.Spec.Versions {
+		if v.Version != version {
+			continue
+		}
+		target := in.DeepCopy()
+		spec := v.DeepCopy()
+		target.Spec.Definition = spec.Definition
+		target.Spec.Sources = spec.Sources
+		target.Spec.Flow = spec.Flow
+		target.Spec.Authorization = spec.Authorization
+		target.Spec.Types = spec.Types
+		target.Spec.Dependencies = spec.Dependencies
+		target.Spec.Versions = nil
+		if target.Labels == nil {
+			target.Labels = make(map[string]string)
+		}
+		target.Labels[KameletVersionLabel] = version
+		// properties are computed for the main spec
+		target.Status.Properties = nil
+		return target
+	}
+	return nil
+}
+
+// IsDeprecated returns true if the Kamelet is marked as deprecated
+func (in *Kamelet) IsDeprecated() bool {
+	return in.Spec.Deprecated
+}
+
+// DeprecationMessage describes the deprecation of the Kamelet, along with the Kamelet that replaces it, if any
+func (in *Kamelet) DeprecationMessage() string {
+	message := fmt.Sprintf("kamelet %q is deprecated", in.Name)
+	if in.Spec.RemovalVersion != "" {
+		message = fmt.Sprintf("%s and will be removed in version %s", message, in.Spec.RemovalVersion)
+	}
+	if in.Spec.ReplacedBy != "" {
+		message = fmt.Sprintf("%s: use %q instead", message, in.Spec.ReplacedBy)
+	}
+	return message
+}
+
+// IsSensitive returns true when the property holds a password or another secret value, that must not be stored in clear text
+func (p JSONSchemaProp) IsSensitive() bool {
+	if p.Format == KameletPasswordFormat {
+		return true
+	}
+	for _, d := range p.XDescriptors {
+		if d == KameletPasswordXDescriptor {
+			return true
+		}
+	}
+	return false
+}
+
+// IsSensitiveProperty returns true when the given property is declared as sensitive in the definition of the Kamelet
+func (in *Kamelet) IsSensitiveProperty(name string) bool {
+	if in.Spec.Definition == nil {
+		return false
+	}
+	prop, ok := in.Spec.Definition.Properties[name]
+	return ok && prop.IsSensitive()
+}
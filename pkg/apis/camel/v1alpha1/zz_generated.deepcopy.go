@@ -14,6 +14,11 @@ import (
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AuthorizationSpec) DeepCopyInto(out *AuthorizationSpec) {
 	*out = *in
+	if in.OAuth2 != nil {
+		in, out := &in.OAuth2, &out.OAuth2
+		*out = new(OAuth2Spec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthorizationSpec.
@@ -83,6 +88,31 @@ func (in *EndpointProperties) DeepCopy() *EndpointProperties {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ErrorHandlerSpec) DeepCopyInto(out *ErrorHandlerSpec) {
+	*out = *in
+	if in.Sink != nil {
+		in, out := &in.Sink, &out.Sink
+		*out = new(Endpoint)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Redelivery != nil {
+		in, out := &in.Redelivery, &out.Redelivery
+		*out = new(RedeliverySpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ErrorHandlerSpec.
+func (in *ErrorHandlerSpec) DeepCopy() *ErrorHandlerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ErrorHandlerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EventTypeSpec) DeepCopyInto(out *EventTypeSpec) {
 	*out = *in
@@ -369,7 +399,24 @@ func (in *KameletBindingSpec) DeepCopyInto(out *KameletBindingSpec) {
 		(*in).DeepCopyInto(*out)
 	}
 	in.Source.DeepCopyInto(&out.Source)
+	if in.Steps != nil {
+		in, out := &in.Steps, &out.Steps
+		*out = make([]Endpoint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	in.Sink.DeepCopyInto(&out.Sink)
+	if in.ErrorHandler != nil {
+		in, out := &in.ErrorHandler, &out.ErrorHandler
+		*out = new(ErrorHandlerSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KameletBindingSpec.
@@ -392,6 +439,11 @@ func (in *KameletBindingStatus) DeepCopyInto(out *KameletBindingStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KameletBindingStatus.
@@ -491,7 +543,7 @@ func (in *KameletSpec) DeepCopyInto(out *KameletSpec) {
 	if in.Authorization != nil {
 		in, out := &in.Authorization, &out.Authorization
 		*out = new(AuthorizationSpec)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.Types != nil {
 		in, out := &in.Types, &out.Types
@@ -505,6 +557,13 @@ func (in *KameletSpec) DeepCopyInto(out *KameletSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Versions != nil {
+		in, out := &in.Versions, &out.Versions
+		*out = make([]KameletVersionSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KameletSpec.
@@ -544,6 +603,91 @@ func (in *KameletStatus) DeepCopy() *KameletStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KameletVersionSpec) DeepCopyInto(out *KameletVersionSpec) {
+	*out = *in
+	if in.Definition != nil {
+		in, out := &in.Definition, &out.Definition
+		*out = new(JSONSchemaProps)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Sources != nil {
+		in, out := &in.Sources, &out.Sources
+		*out = make([]v1.SourceSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Flow != nil {
+		in, out := &in.Flow, &out.Flow
+		*out = new(v1.Flow)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Authorization != nil {
+		in, out := &in.Authorization, &out.Authorization
+		*out = new(AuthorizationSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Types != nil {
+		in, out := &in.Types, &out.Types
+		*out = make(map[EventSlot]EventTypeSpec, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	if in.Dependencies != nil {
+		in, out := &in.Dependencies, &out.Dependencies
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KameletVersionSpec.
+func (in *KameletVersionSpec) DeepCopy() *KameletVersionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KameletVersionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OAuth2PropertiesSpec) DeepCopyInto(out *OAuth2PropertiesSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OAuth2PropertiesSpec.
+func (in *OAuth2PropertiesSpec) DeepCopy() *OAuth2PropertiesSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OAuth2PropertiesSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OAuth2Spec) DeepCopyInto(out *OAuth2Spec) {
+	*out = *in
+	if in.Scopes != nil {
+		in, out := &in.Scopes, &out.Scopes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	out.Properties = in.Properties
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OAuth2Spec.
+func (in *OAuth2Spec) DeepCopy() *OAuth2Spec {
+	if in == nil {
+		return nil
+	}
+	out := new(OAuth2Spec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in RawMessage) DeepCopyInto(out *RawMessage) {
 	{
@@ -562,3 +706,33 @@ func (in RawMessage) DeepCopy() RawMessage {
 	in.DeepCopyInto(out)
 	return *out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RedeliverySpec) DeepCopyInto(out *RedeliverySpec) {
+	*out = *in
+	if in.MaximumRedeliveries != nil {
+		in, out := &in.MaximumRedeliveries, &out.MaximumRedeliveries
+		*out = new(int)
+		**out = **in
+	}
+	if in.RedeliveryDelay != nil {
+		in, out := &in.RedeliveryDelay, &out.RedeliveryDelay
+		*out = new(int64)
+		**out = **in
+	}
+	if in.UseExponentialBackOff != nil {
+		in, out := &in.UseExponentialBackOff, &out.UseExponentialBackOff
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedeliverySpec.
+func (in *RedeliverySpec) DeepCopy() *RedeliverySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RedeliverySpec)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -18,11 +18,15 @@ limitations under the License.
 package builder
 
 import (
+	"context"
+	"fmt"
+	"io"
 	"io/ioutil"
 	"os"
 	"path"
 	"sort"
-	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
 	"github.com/apache/camel-k/pkg/client"
@@ -32,7 +36,6 @@ import (
 
 type defaultBuilder struct {
 	log    log.Logger
-	ctx    cancellable.Context
 	client client.Client
 }
 
@@ -40,7 +43,6 @@ type defaultBuilder struct {
 func New(c client.Client) Builder {
 	m := defaultBuilder{
 		log:    log.WithName("builder"),
-		ctx:    cancellable.NewContext(),
 		client: c,
 	}
 
@@ -48,7 +50,7 @@ func New(c client.Client) Builder {
 }
 
 // Run --
-func (b *defaultBuilder) Run(build v1.BuilderTask) v1.BuildStatus {
+func (b *defaultBuilder) Run(ctx context.Context, build v1.BuilderTask, progress ProgressHandler, output io.Writer) v1.BuildStatus {
 	result := v1.BuildStatus{}
 
 	var buildDir string
@@ -68,11 +70,18 @@ func (b *defaultBuilder) Run(build v1.BuilderTask) v1.BuildStatus {
 
 	c := Context{
 		Client:    b.client,
-		C:         b.ctx,
+		C:         cancellable.NewContextWithParent(ctx),
 		Path:      buildDir,
 		Namespace: build.Meta.Namespace,
 		Build:     build,
 		BaseImage: build.BaseImage,
+		Stdout:    os.Stdout,
+		Stderr:    os.Stderr,
+	}
+
+	if output != nil {
+		c.Stdout = io.MultiWriter(os.Stdout, output)
+		c.Stderr = io.MultiWriter(os.Stderr, output)
 	}
 
 	if build.Image != "" {
@@ -121,6 +130,10 @@ func (b *defaultBuilder) Run(build v1.BuilderTask) v1.BuildStatus {
 		}
 		steps = append(steps, s)
 	}
+	// Custom steps run after the built-in steps of the same phase
+	for _, step := range build.ContainerSteps {
+		steps = append(steps, NewContainerStep(step))
+	}
 	// Sort steps by phase
 	sort.SliceStable(steps, func(i, j int) bool {
 		return steps[i].Phase() < steps[j].Phase()
@@ -133,7 +146,7 @@ func (b *defaultBuilder) Run(build v1.BuilderTask) v1.BuildStatus {
 		}
 
 		select {
-		case <-b.ctx.Done():
+		case <-c.C.Done():
 			result.Phase = v1.BuildPhaseInterrupted
 		default:
 			l := b.log.WithValues(
@@ -144,18 +157,55 @@ func (b *defaultBuilder) Run(build v1.BuilderTask) v1.BuildStatus {
 			)
 
 			l.Infof("executing step")
+			if output != nil {
+				fmt.Fprintf(output, "Executing step %s\n", step.ID())
+			}
+
+			start := metav1.Now()
+			result.Steps = append(result.Steps, v1.BuildStepStatus{
+				ID:        step.ID(),
+				Phase:     step.Phase(),
+				Result:    v1.BuildPhaseRunning,
+				StartedAt: &start,
+			})
+			notifyProgress(progress, result.Steps)
 
-			start := time.Now()
 			c.Error = step.Execute(&c)
 
+			finish := metav1.Now()
+			status := &result.Steps[len(result.Steps)-1]
+			status.FinishedAt = &finish
+
+			switch {
+			case c.C.Err() != nil:
+				status.Result = v1.BuildPhaseInterrupted
+			case c.Error != nil:
+				status.Result = v1.BuildPhaseFailed
+			default:
+				status.Result = v1.BuildPhaseSucceeded
+			}
+			notifyProgress(progress, result.Steps)
+
 			if c.Error == nil {
-				l.Infof("step done in %f seconds", time.Since(start).Seconds())
+				l.Infof("step done in %f seconds", finish.Sub(start.Time).Seconds())
 			} else {
 				l.Infof("step failed with error: %s", c.Error)
 			}
+
+			// Persist the output of the step as soon as it completes
+			if f, ok := output.(flusher); ok {
+				if err := f.Flush(); err != nil {
+					l.Error(err, "cannot flush build output")
+				}
+			}
 		}
 	}
 
+	// The build may also have been cancelled while a step was executing
+	if c.C.Err() != nil {
+		result.Phase = v1.BuildPhaseInterrupted
+	}
+
 	if result.Phase != v1.BuildPhaseInterrupted {
 		result.BaseImage = c.BaseImage
 		result.Image = c.Image
@@ -181,3 +231,20 @@ func (b *defaultBuilder) Run(build v1.BuilderTask) v1.BuildStatus {
 
 	return result
 }
+
+func notifyProgress(progress ProgressHandler, steps []v1.BuildStepStatus) {
+	if progress == nil {
+		return
+	}
+	// Hand over a copy, so that the handler can retain it
+	s := make([]v1.BuildStepStatus, 0, len(steps))
+	for _, step := range steps {
+		s = append(s, *step.DeepCopy())
+	}
+	progress(s)
+}
+
+// flusher is implemented by the build outputs that buffer their content, like the build log
+type flusher interface {
+	Flush() error
+}
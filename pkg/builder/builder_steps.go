@@ -252,6 +252,10 @@ func listPublishedImages(context *Context) ([]v1.IntegrationKitStatus, error) {
 		if kit.Status.Phase != v1.IntegrationKitPhaseReady {
 			continue
 		}
+		// Native executables cannot be layered, so that native kits are never used as base images
+		if kit.Labels[v1.IntegrationKitLayoutLabel] == v1.IntegrationKitLayoutNative {
+			continue
+		}
 
 		images = append(images, kit.Status)
 	}
@@ -18,6 +18,8 @@ limitations under the License.
 package builder
 
 import (
+	"bytes"
+	"context"
 	"errors"
 	"testing"
 
@@ -61,6 +63,77 @@ func TestFailure(t *testing.T) {
 		Runtime: catalog.Runtime,
 	}
 
-	status := b.Run(r)
+	status := b.Run(context.TODO(), r, nil, nil)
 	assert.Equal(t, v1.BuildPhaseFailed, status.Phase)
 }
+
+type progressTestSteps struct {
+	SucceedingStep Step
+	FailingStep    Step
+	SkippedStep    Step
+}
+
+func TestStepsProgress(t *testing.T) {
+	catalog, err := camel.DefaultCatalog()
+	assert.Nil(t, err)
+
+	c, err := test.NewFakeClient()
+	assert.Nil(t, err)
+
+	b := New(c)
+
+	steps := progressTestSteps{
+		SucceedingStep: NewStep(InitPhase, func(i *Context) error {
+			return nil
+		}),
+		FailingStep: NewStep(ProjectBuildPhase, func(i *Context) error {
+			return errors.New("an error")
+		}),
+		SkippedStep: NewStep(ApplicationPublishPhase, func(i *Context) error {
+			return nil
+		}),
+	}
+
+	RegisterSteps(steps)
+
+	r := v1.BuilderTask{
+		BaseTask: v1.BaseTask{
+			Name: "builder",
+		},
+		BaseImage: "base-image",
+		Steps: StepIDsFor(
+			steps.SkippedStep,
+			steps.FailingStep,
+			steps.SucceedingStep,
+		),
+		Runtime: catalog.Runtime,
+	}
+
+	progress := make([][]v1.BuildStepStatus, 0)
+	output := bytes.Buffer{}
+	status := b.Run(context.TODO(), r, func(steps []v1.BuildStepStatus) {
+		progress = append(progress, steps)
+	}, &output)
+	assert.Equal(t, v1.BuildPhaseFailed, status.Phase)
+
+	assert.Len(t, status.Steps, 2)
+	assert.Equal(t, steps.SucceedingStep.ID(), status.Steps[0].ID)
+	assert.Equal(t, InitPhase, status.Steps[0].Phase)
+	assert.Equal(t, v1.BuildPhaseSucceeded, status.Steps[0].Result)
+	assert.NotNil(t, status.Steps[0].StartedAt)
+	assert.NotNil(t, status.Steps[0].FinishedAt)
+	assert.Equal(t, steps.FailingStep.ID(), status.Steps[1].ID)
+	assert.Equal(t, v1.BuildPhaseFailed, status.Steps[1].Result)
+
+	// Each step is reported when it starts and when it completes
+	assert.Len(t, progress, 4)
+	assert.Len(t, progress[0], 1)
+	assert.Equal(t, v1.BuildPhaseRunning, progress[0][0].Result)
+	assert.Nil(t, progress[0][0].FinishedAt)
+	assert.Equal(t, status.Steps, progress[3])
+
+	// The executed steps are reported in the build output
+	assert.Contains(t, output.String(), "Executing step "+steps.SucceedingStep.ID()+"\n")
+	assert.Contains(t, output.String(), "Executing step "+steps.FailingStep.ID()+"\n")
+	assert.NotContains(t, output.String(), steps.SkippedStep.ID())
+}
@@ -18,7 +18,9 @@ limitations under the License.
 package builder
 
 import (
+	"context"
 	"fmt"
+	"io"
 	"math"
 
 	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
@@ -45,9 +47,12 @@ const (
 
 // Builder --
 type Builder interface {
-	Run(build v1.BuilderTask) v1.BuildStatus
+	Run(ctx context.Context, build v1.BuilderTask, progress ProgressHandler, output io.Writer) v1.BuildStatus
 }
 
+// ProgressHandler is notified with the status of the executed steps, each time a step starts or completes
+type ProgressHandler func(steps []v1.BuildStepStatus)
+
 // Step --
 type Step interface {
 	ID() string
@@ -111,6 +116,9 @@ type Context struct {
 	Artifacts         []v1.Artifact
 	SelectedArtifacts []v1.Artifact
 	Resources         []Resource
+	// Stdout and Stderr receive the output of the build tools, that is also persisted in the build log
+	Stdout io.Writer
+	Stderr io.Writer
 
 	Maven struct {
 		Project      maven.Project
@@ -0,0 +1,333 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builder
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"time"
+
+	"github.com/pkg/errors"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/platform"
+	"github.com/apache/camel-k/pkg/util/defaults"
+	"github.com/apache/camel-k/pkg/util/kubernetes"
+	"github.com/apache/camel-k/pkg/util/log"
+	"github.com/apache/camel-k/pkg/util/tar"
+)
+
+const (
+	// ContainerStepIDPrefix prefixes the IDs of the custom build steps that run as containers
+	ContainerStepIDPrefix = "container/"
+
+	containerStepUpload   = "upload"
+	containerStepDownload = "download"
+	containerStepVolume   = "camel-k-build-dir"
+	containerStepSyncFile = "/tmp/camel-k-step-sync"
+)
+
+// The container step runs in a dedicated pod, with the build directory copied into an emptyDir volume
+// by the upload init container, before the step container runs, and copied back from the download container,
+// once the step container has completed.
+type containerStep struct {
+	spec v1.ContainerStep
+}
+
+// NewContainerStep creates a step that runs the given container with the build directory mounted
+func NewContainerStep(spec v1.ContainerStep) Step {
+	return &containerStep{
+		spec: spec,
+	}
+}
+
+func (s *containerStep) String() string {
+	return fmt.Sprintf("%s@%d", s.ID(), s.spec.Phase)
+}
+
+// ID --
+func (s *containerStep) ID() string {
+	return ContainerStepIDPrefix + s.spec.Name
+}
+
+// Phase --
+func (s *containerStep) Phase() int32 {
+	return s.spec.Phase
+}
+
+// Execute --
+func (s *containerStep) Execute(ctx *Context) error {
+	if s.spec.Name == "" || s.spec.Name == containerStepUpload || s.spec.Name == containerStepDownload {
+		return fmt.Errorf("invalid container step name: %q", s.spec.Name)
+	}
+
+	image, err := platform.GetCurrentOperatorImage(ctx.C, ctx.Client)
+	if err != nil {
+		return err
+	}
+	if image == "" {
+		image = defaults.ImageName + ":" + defaults.Version
+	}
+
+	pod := s.newPod(ctx, image)
+	if err := ctx.Client.Create(ctx.C, pod); err != nil {
+		return errors.Wrapf(err, "cannot create pod for step %s", s.spec.Name)
+	}
+	defer func() {
+		// The build may have been cancelled
+		if err := ctx.Client.Delete(context.Background(), pod); err != nil && !k8serrors.IsNotFound(err) {
+			log.Errorf(err, "cannot delete pod %s for step %s", pod.Name, s.spec.Name)
+		}
+	}()
+
+	if err := s.waitForContainer(ctx, pod, containerStepUpload); err != nil {
+		return err
+	}
+	if err := s.upload(ctx, pod); err != nil {
+		return errors.Wrapf(err, "cannot copy the build directory into step %s", s.spec.Name)
+	}
+
+	// The download container starts once the step container has completed successfully
+	err = s.waitForContainer(ctx, pod, containerStepDownload)
+	s.printLogs(ctx, pod)
+	if err != nil {
+		return err
+	}
+
+	if err := s.download(ctx, pod); err != nil {
+		return errors.Wrapf(err, "cannot copy the build directory from step %s", s.spec.Name)
+	}
+
+	return nil
+}
+
+func (s *containerStep) newPod(ctx *Context, image string) *corev1.Pod {
+	mount := corev1.VolumeMount{
+		Name:      containerStepVolume,
+		MountPath: ctx.Path,
+	}
+	// The upload and download containers wait for the build directory to be copied
+	sync := []string{"/bin/sh", "-c", fmt.Sprintf("until [ -f %s ]; do sleep 1; done", containerStepSyncFile)}
+
+	step := corev1.Container{
+		Name:            s.spec.Name,
+		Image:           s.spec.Image,
+		ImagePullPolicy: corev1.PullIfNotPresent,
+		Command:         s.spec.Command,
+		Args:            s.spec.Args,
+		Env:             s.spec.Env,
+		WorkingDir:      s.spec.WorkingDir,
+		SecurityContext: s.spec.SecurityContext,
+		VolumeMounts:    append([]corev1.VolumeMount{mount}, s.spec.VolumeMounts...),
+	}
+	if step.WorkingDir == "" {
+		step.WorkingDir = ctx.Path
+	}
+	if s.spec.Resources != nil {
+		step.Resources = *s.spec.Resources
+	}
+
+	pod := corev1.Pod{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: corev1.SchemeGroupVersion.String(),
+			Kind:       "Pod",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:    ctx.Namespace,
+			GenerateName: fmt.Sprintf("%s-%s-", ctx.Build.Meta.Name, s.spec.Name),
+			Labels: map[string]string{
+				"camel.apache.org/build":     ctx.Build.Meta.Name,
+				"camel.apache.org/component": "builder-step",
+			},
+		},
+		Spec: corev1.PodSpec{
+			RestartPolicy: corev1.RestartPolicyNever,
+			Affinity:      s.spec.Affinity,
+			Volumes: append([]corev1.Volume{
+				{
+					Name: containerStepVolume,
+					VolumeSource: corev1.VolumeSource{
+						EmptyDir: &corev1.EmptyDirVolumeSource{},
+					},
+				},
+			}, s.spec.Volumes...),
+			InitContainers: []corev1.Container{
+				{
+					Name:            containerStepUpload,
+					Image:           image,
+					ImagePullPolicy: corev1.PullIfNotPresent,
+					Command:         sync,
+					VolumeMounts:    []corev1.VolumeMount{mount},
+				},
+				step,
+			},
+			Containers: []corev1.Container{
+				{
+					Name:            containerStepDownload,
+					Image:           image,
+					ImagePullPolicy: corev1.PullIfNotPresent,
+					Command:         sync,
+					VolumeMounts:    []corev1.VolumeMount{mount},
+				},
+			},
+		},
+	}
+
+	// Garbage collect the pod with the integration kit, in case it cannot be deleted once the step completes
+	if ctx.Build.Meta.UID != "" {
+		pod.OwnerReferences = []metav1.OwnerReference{
+			{
+				APIVersion: v1.SchemeGroupVersion.String(),
+				Kind:       v1.IntegrationKitKind,
+				Name:       ctx.Build.Meta.Name,
+				UID:        ctx.Build.Meta.UID,
+			},
+		}
+	}
+
+	return &pod
+}
+
+func (s *containerStep) waitForContainer(ctx *Context, pod *corev1.Pod, container string) error {
+	err := wait.PollImmediateUntil(time.Second, func() (bool, error) {
+		if err := ctx.Client.Get(ctx.C, k8sclient.ObjectKey{Namespace: pod.Namespace, Name: pod.Name}, pod); err != nil {
+			return false, err
+		}
+		return isContainerStepRunning(pod, s.spec.Name, container)
+	}, ctx.C.Done())
+
+	if err == wait.ErrWaitTimeout && ctx.C.Err() != nil {
+		return ctx.C.Err()
+	}
+	return err
+}
+
+// isContainerStepRunning returns whether the given container of a step pod is running,
+// or an error if the pod cannot make progress
+func isContainerStepRunning(pod *corev1.Pod, step string, container string) (bool, error) {
+	statuses := make([]corev1.ContainerStatus, 0, len(pod.Status.InitContainerStatuses)+len(pod.Status.ContainerStatuses))
+	statuses = append(statuses, pod.Status.InitContainerStatuses...)
+	statuses = append(statuses, pod.Status.ContainerStatuses...)
+
+	for _, status := range statuses {
+		if terminated := status.State.Terminated; terminated != nil && terminated.ExitCode != 0 {
+			if status.Name == step {
+				return false, fmt.Errorf("step %s failed with exit code %d: %s", step, terminated.ExitCode, terminated.Message)
+			}
+			return false, fmt.Errorf("container %s of step %s failed with exit code %d: %s", status.Name, step, terminated.ExitCode, terminated.Message)
+		}
+		if waiting := status.State.Waiting; waiting != nil {
+			switch waiting.Reason {
+			case "ErrImagePull", "ImagePullBackOff", "InvalidImageName", "CreateContainerConfigError", "CreateContainerError":
+				return false, fmt.Errorf("cannot run container %s of step %s: %s: %s", status.Name, step, waiting.Reason, waiting.Message)
+			}
+		}
+		if status.Name == container && status.State.Running != nil {
+			return true, nil
+		}
+	}
+
+	if pod.Status.Phase == corev1.PodFailed {
+		return false, fmt.Errorf("pod for step %s failed: %s", step, pod.Status.Message)
+	}
+
+	return false, nil
+}
+
+func (s *containerStep) upload(ctx *Context, pod *corev1.Pod) error {
+	reader, writer := io.Pipe()
+	// Unblock the archiving if the copy fails
+	defer reader.Close()
+
+	go func() {
+		writer.CloseWithError(tar.Directory(ctx.Path, writer))
+	}()
+
+	stderr := bytes.Buffer{}
+	command := []string{"/bin/sh", "-c", fmt.Sprintf("tar -xf - -C '%s' && touch %s", ctx.Path, containerStepSyncFile)}
+	if err := kubernetes.Exec(ctx.Client, pod.Namespace, pod.Name, containerStepUpload, command, reader, nil, &stderr); err != nil {
+		return errors.Wrap(err, stderr.String())
+	}
+
+	return nil
+}
+
+func (s *containerStep) download(ctx *Context, pod *corev1.Pod) error {
+	// Replace the content of the build directory with the one of the step
+	entries, err := ioutil.ReadDir(ctx.Path)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		if err := os.RemoveAll(path.Join(ctx.Path, entry.Name())); err != nil {
+			return err
+		}
+	}
+
+	reader, writer := io.Pipe()
+	stderr := bytes.Buffer{}
+	done := make(chan error, 1)
+	go func() {
+		command := []string{"tar", "-cf", "-", "-C", ctx.Path, "."}
+		err := kubernetes.Exec(ctx.Client, pod.Namespace, pod.Name, containerStepDownload, command, nil, writer, &stderr)
+		writer.CloseWithError(err)
+		done <- err
+	}()
+
+	err = tar.Extract(reader, ctx.Path)
+	// Unblock the copy if the extraction fails
+	reader.Close()
+	if execErr := <-done; execErr != nil {
+		return errors.Wrap(execErr, stderr.String())
+	}
+	if err != nil {
+		return err
+	}
+
+	// Let the pod complete
+	return kubernetes.Exec(ctx.Client, pod.Namespace, pod.Name, containerStepDownload, []string{"touch", containerStepSyncFile}, nil, nil, nil)
+}
+
+func (s *containerStep) printLogs(ctx *Context, pod *corev1.Pod) {
+	if ctx.Stdout == nil {
+		return
+	}
+	stream, err := ctx.Client.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, &corev1.PodLogOptions{
+		Container: s.spec.Name,
+	}).Stream(ctx.C)
+	if err != nil {
+		log.Errorf(err, "cannot retrieve the logs of step %s", s.spec.Name)
+		return
+	}
+	defer stream.Close()
+
+	if _, err := io.Copy(ctx.Stdout, stream); err != nil {
+		log.Errorf(err, "cannot retrieve the logs of step %s", s.spec.Name)
+	}
+}
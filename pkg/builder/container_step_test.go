@@ -0,0 +1,238 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builder
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/util/camel"
+	"github.com/apache/camel-k/pkg/util/test"
+)
+
+func TestContainerStepPod(t *testing.T) {
+	step := containerStep{
+		spec: v1.ContainerStep{
+			ContainerTask: v1.ContainerTask{
+				BaseTask: v1.BaseTask{
+					Name: "scan",
+					Volumes: []corev1.Volume{
+						{Name: "config"},
+					},
+					VolumeMounts: []corev1.VolumeMount{
+						{Name: "config", MountPath: "/etc/scan"},
+					},
+				},
+				Image:   "scanner:latest",
+				Command: []string{"scan"},
+				Args:    []string{"--fail-on", "high"},
+			},
+			Phase: 25,
+		},
+	}
+
+	ctx := Context{
+		Path:      "/builder/kit-1",
+		Namespace: "ns",
+		Build: v1.BuilderTask{
+			Meta: metav1.ObjectMeta{
+				Name:      "kit-1",
+				Namespace: "ns",
+				UID:       "uid",
+			},
+		},
+	}
+
+	assert.Equal(t, "container/scan", step.ID())
+	assert.Equal(t, int32(25), step.Phase())
+
+	pod := step.newPod(&ctx, "operator:latest")
+
+	assert.Equal(t, "ns", pod.Namespace)
+	assert.Equal(t, "kit-1-scan-", pod.GenerateName)
+	assert.Equal(t, "kit-1", pod.Labels["camel.apache.org/build"])
+	assert.Equal(t, corev1.RestartPolicyNever, pod.Spec.RestartPolicy)
+	assert.Len(t, pod.OwnerReferences, 1)
+	assert.Equal(t, v1.IntegrationKitKind, pod.OwnerReferences[0].Kind)
+	assert.Len(t, pod.Spec.Volumes, 2)
+	assert.NotNil(t, pod.Spec.Volumes[0].EmptyDir)
+
+	assert.Len(t, pod.Spec.InitContainers, 2)
+	assert.Equal(t, containerStepUpload, pod.Spec.InitContainers[0].Name)
+	assert.Equal(t, "operator:latest", pod.Spec.InitContainers[0].Image)
+
+	container := pod.Spec.InitContainers[1]
+	assert.Equal(t, "scan", container.Name)
+	assert.Equal(t, "scanner:latest", container.Image)
+	assert.Equal(t, []string{"scan"}, container.Command)
+	assert.Equal(t, []string{"--fail-on", "high"}, container.Args)
+	assert.Equal(t, "/builder/kit-1", container.WorkingDir)
+	assert.Empty(t, container.Resources.Requests)
+	assert.Len(t, container.VolumeMounts, 2)
+	assert.Equal(t, "/builder/kit-1", container.VolumeMounts[0].MountPath)
+
+	assert.Len(t, pod.Spec.Containers, 1)
+	assert.Equal(t, containerStepDownload, pod.Spec.Containers[0].Name)
+	assert.Equal(t, "/builder/kit-1", pod.Spec.Containers[0].VolumeMounts[0].MountPath)
+}
+
+func TestContainerStepPodResources(t *testing.T) {
+	step := containerStep{
+		spec: v1.ContainerStep{
+			ContainerTask: v1.ContainerTask{
+				BaseTask: v1.BaseTask{
+					Name: "scan",
+				},
+				Image: "scanner:latest",
+			},
+			Phase: 25,
+			Resources: &corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{
+					corev1.ResourceMemory: resource.MustParse("1Gi"),
+				},
+				Limits: corev1.ResourceList{
+					corev1.ResourceMemory: resource.MustParse("2Gi"),
+				},
+			},
+		},
+	}
+
+	ctx := Context{
+		Path:      "/builder/kit-1",
+		Namespace: "ns",
+	}
+
+	pod := step.newPod(&ctx, "operator:latest")
+
+	container := pod.Spec.InitContainers[1]
+	assert.Equal(t, resource.MustParse("1Gi"), container.Resources.Requests[corev1.ResourceMemory])
+	assert.Equal(t, resource.MustParse("2Gi"), container.Resources.Limits[corev1.ResourceMemory])
+	// The containers copying the build directory are not affected
+	assert.Empty(t, pod.Spec.InitContainers[0].Resources.Requests)
+	assert.Empty(t, pod.Spec.Containers[0].Resources.Requests)
+}
+
+func TestContainerStepRunning(t *testing.T) {
+	pod := corev1.Pod{
+		Status: corev1.PodStatus{
+			Phase: corev1.PodPending,
+			InitContainerStatuses: []corev1.ContainerStatus{
+				{
+					Name: containerStepUpload,
+					State: corev1.ContainerState{
+						Running: &corev1.ContainerStateRunning{},
+					},
+				},
+			},
+		},
+	}
+
+	running, err := isContainerStepRunning(&pod, "scan", containerStepUpload)
+	assert.Nil(t, err)
+	assert.True(t, running)
+
+	running, err = isContainerStepRunning(&pod, "scan", containerStepDownload)
+	assert.Nil(t, err)
+	assert.False(t, running)
+
+	pod.Status.InitContainerStatuses[0].State = corev1.ContainerState{
+		Terminated: &corev1.ContainerStateTerminated{},
+	}
+	pod.Status.InitContainerStatuses = append(pod.Status.InitContainerStatuses, corev1.ContainerStatus{
+		Name: "scan",
+		State: corev1.ContainerState{
+			Terminated: &corev1.ContainerStateTerminated{
+				ExitCode: 2,
+				Message:  "vulnerabilities found",
+			},
+		},
+	})
+	pod.Status.Phase = corev1.PodFailed
+
+	_, err = isContainerStepRunning(&pod, "scan", containerStepDownload)
+	assert.EqualError(t, err, "step scan failed with exit code 2: vulnerabilities found")
+
+	pod.Status.InitContainerStatuses[1].State = corev1.ContainerState{
+		Waiting: &corev1.ContainerStateWaiting{
+			Reason:  "ImagePullBackOff",
+			Message: "image not found",
+		},
+	}
+	pod.Status.Phase = corev1.PodPending
+
+	_, err = isContainerStepRunning(&pod, "scan", containerStepDownload)
+	assert.EqualError(t, err, "cannot run container scan of step scan: ImagePullBackOff: image not found")
+}
+
+type containerTestSteps struct {
+	InitStep    Step
+	PackageStep Step
+}
+
+func TestContainerStepsOrder(t *testing.T) {
+	catalog, err := camel.DefaultCatalog()
+	assert.Nil(t, err)
+
+	c, err := test.NewFakeClient()
+	assert.Nil(t, err)
+
+	b := New(c)
+
+	steps := containerTestSteps{
+		InitStep: NewStep(InitPhase, func(i *Context) error {
+			return nil
+		}),
+		PackageStep: NewStep(ApplicationPackagePhase, func(i *Context) error {
+			return nil
+		}),
+	}
+
+	RegisterSteps(steps)
+
+	r := v1.BuilderTask{
+		BaseImage: "base-image",
+		Steps: StepIDsFor(
+			steps.PackageStep,
+			steps.InitStep,
+		),
+		// A step without name fails before any pod gets created
+		ContainerSteps: []v1.ContainerStep{
+			{
+				Phase: ProjectBuildPhase + 5,
+			},
+		},
+		Runtime: catalog.Runtime,
+	}
+
+	status := b.Run(context.TODO(), r, nil, nil)
+	assert.Equal(t, v1.BuildPhaseFailed, status.Phase)
+	assert.Equal(t, `invalid container step name: ""`, status.Error)
+
+	assert.Len(t, status.Steps, 2)
+	assert.Equal(t, steps.InitStep.ID(), status.Steps[0].ID)
+	assert.Equal(t, ContainerStepIDPrefix, status.Steps[1].ID)
+	assert.Equal(t, ProjectBuildPhase+5, status.Steps[1].Phase)
+	assert.Equal(t, v1.BuildPhaseFailed, status.Steps[1].Result)
+}
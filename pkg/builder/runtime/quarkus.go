@@ -44,6 +44,18 @@ var QuarkusSteps = []builder.Step{
 	Steps.ComputeQuarkusDependencies,
 }
 
+// QuarkusNativeSourcesDir is the directory, relative to the build directory, where the sources of the native executable
+// are generated, and where it is compiled
+const QuarkusNativeSourcesDir = "maven/target/native-sources"
+
+// QuarkusNativeSteps --
+var QuarkusNativeSteps = []builder.Step{
+	Steps.LoadCamelQuarkusCatalog,
+	Steps.GenerateQuarkusProject,
+	Steps.BuildQuarkusNativeRunner,
+	Steps.ComputeQuarkusNativeRunner,
+}
+
 func loadCamelQuarkusCatalog(ctx *builder.Context) error {
 	catalog, err := camel.LoadCatalog(ctx.C, ctx.Client, ctx.Build.Meta.Namespace, ctx.Build.Runtime)
 	if err != nil {
@@ -130,6 +142,9 @@ func buildQuarkusRunner(ctx *builder.Context) error {
 	mc.SettingsContent = ctx.Maven.SettingsData
 	mc.LocalRepository = ctx.Build.Maven.LocalRepository
 	mc.Timeout = ctx.Build.Maven.GetTimeout().Duration
+	mc.Parent = ctx.C
+	mc.Stdout = ctx.Stdout
+	mc.Stderr = ctx.Stderr
 
 	err := BuildQuarkusRunnerCommon(mc)
 	if err != nil {
@@ -139,6 +154,22 @@ func buildQuarkusRunner(ctx *builder.Context) error {
 	return nil
 }
 
+func buildQuarkusNativeRunner(ctx *builder.Context) error {
+	mc := maven.NewContext(path.Join(ctx.Path, "maven"), ctx.Maven.Project)
+	mc.SettingsContent = ctx.Maven.SettingsData
+	mc.LocalRepository = ctx.Build.Maven.LocalRepository
+	mc.Timeout = ctx.Build.Maven.GetTimeout().Duration
+	mc.Parent = ctx.C
+	mc.Stdout = ctx.Stdout
+	mc.Stderr = ctx.Stderr
+
+	// Only generate the sources of the native executable, that is compiled by the native-image container step,
+	// as GraalVM is not available to the builder
+	mc.AddArgument("-Dquarkus.package.type=native-sources")
+
+	return BuildQuarkusRunnerCommon(mc)
+}
+
 // BuildQuarkusRunnerCommon --
 func BuildQuarkusRunnerCommon(mc maven.Context) error {
 	resourcesPath := path.Join(mc.Path, "src", "main", "resources")
@@ -170,6 +201,9 @@ func computeQuarkusDependencies(ctx *builder.Context) error {
 	mc.SettingsContent = ctx.Maven.SettingsData
 	mc.LocalRepository = ctx.Build.Maven.LocalRepository
 	mc.Timeout = ctx.Build.Maven.GetTimeout().Duration
+	mc.Parent = ctx.C
+	mc.Stdout = ctx.Stdout
+	mc.Stderr = ctx.Stderr
 
 	// Compute dependencies.
 	content, err := ComputeQuarkusDependenciesCommon(mc, ctx.Catalog.Runtime.Version)
@@ -188,6 +222,26 @@ func computeQuarkusDependencies(ctx *builder.Context) error {
 	return nil
 }
 
+func computeQuarkusNativeRunner(ctx *builder.Context) error {
+	runner := "camel-k-integration-" + defaults.Version + "-runner"
+
+	// The native executable embeds all the dependencies, so that it's the only artifact
+	// to be added to the image
+	checksum, err := digest.ComputeSHA1(ctx.Path, QuarkusNativeSourcesDir, runner)
+	if err != nil {
+		return err
+	}
+
+	ctx.Artifacts = append(ctx.Artifacts, v1.Artifact{
+		ID:       runner,
+		Location: path.Join(ctx.Path, QuarkusNativeSourcesDir, runner),
+		Target:   runner,
+		Checksum: "sha1:" + checksum,
+	})
+
+	return nil
+}
+
 // ComputeQuarkusDependenciesCommon --
 func ComputeQuarkusDependenciesCommon(mc maven.Context, runtimeVersion string) ([]byte, error) {
 	// Retrieve the runtime dependencies
@@ -32,6 +32,8 @@ type steps struct {
 	GenerateQuarkusProject     builder.Step
 	BuildQuarkusRunner         builder.Step
 	ComputeQuarkusDependencies builder.Step
+	BuildQuarkusNativeRunner   builder.Step
+	ComputeQuarkusNativeRunner builder.Step
 }
 
 // Steps --
@@ -53,4 +55,12 @@ var Steps = steps{
 		builder.ProjectBuildPhase+1,
 		computeQuarkusDependencies,
 	),
+	BuildQuarkusNativeRunner: builder.NewStep(
+		builder.ProjectBuildPhase,
+		buildQuarkusNativeRunner,
+	),
+	ComputeQuarkusNativeRunner: builder.NewStep(
+		builder.ProjectBuildPhase+1,
+		computeQuarkusNativeRunner,
+	),
 }
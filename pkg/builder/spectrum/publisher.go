@@ -83,8 +83,8 @@ func publisher(ctx *builder.Context) error {
 		PushConfigDir: registryConfigDir,
 		Base:          ctx.BaseImage,
 		Target:        target,
-		Stdout:        os.Stdout,
-		Stderr:        os.Stderr,
+		Stdout:        ctx.Stdout,
+		Stderr:        ctx.Stderr,
 	}
 
 	digest, err := spectrum.Build(options, libraryPath+":/deployments/dependencies")
@@ -0,0 +1,323 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/apis/camel/v1alpha1"
+	"github.com/apache/camel-k/pkg/client"
+	"github.com/apache/camel-k/pkg/kamelet"
+	"github.com/apache/camel-k/pkg/kamelet/repository"
+	"github.com/apache/camel-k/pkg/util/kubernetes"
+	"github.com/apache/camel-k/pkg/util/reference"
+	"github.com/apache/camel-k/pkg/util/uri"
+)
+
+const (
+	sourceKey = "source"
+	sinkKey   = "sink"
+)
+
+func newCmdBind(rootCmdOptions *RootCmdOptions) (*cobra.Command, *bindCmdOptions) {
+	options := bindCmdOptions{
+		RootCmdOptions: rootCmdOptions,
+	}
+
+	cmd := cobra.Command{
+		Use:   "bind [source] [sink]",
+		Short: "Bind Kubernetes resources, such as Kamelets, in an integration flow",
+		Long: `Bind Kubernetes resources, such as Kamelets, in an integration flow by creating a KameletBinding.
+Endpoints are expected in the format "[[apigroup/]version:]kind:[namespace/]name" (e.g. "kamelet:timer-source", "channel:foo", "broker:default"),
+as plain Kamelet names or as Camel URIs.`,
+		Args:    options.validateArgs,
+		PreRunE: decode(&options),
+		RunE:    options.run,
+	}
+
+	cmd.Flags().String("name", "", "Name for the binding")
+	cmd.Flags().StringP("output", "o", "", "Output format. One of: json|yaml")
+	cmd.Flags().StringArrayP("property", "p", nil, `Add a binding property in the form of "source.<key>=<value>" or "sink.<key>=<value>"`)
+	cmd.Flags().Bool("skip-checks", false, "Do not verify the binding for compliance with the Kamelets definitions")
+
+	return &cmd, &options
+}
+
+type bindCmdOptions struct {
+	*RootCmdOptions
+	Name         string   `mapstructure:"name" yaml:",omitempty"`
+	OutputFormat string   `mapstructure:"output" yaml:",omitempty"`
+	Properties   []string `mapstructure:"properties" yaml:",omitempty"`
+	SkipChecks   bool     `mapstructure:"skip-checks" yaml:",omitempty"`
+}
+
+func (o *bindCmdOptions) validateArgs(_ *cobra.Command, args []string) error {
+	if len(args) > 2 {
+		return errors.New("too many arguments: expected source and sink")
+	} else if len(args) < 2 {
+		return errors.New("source or sink arguments are missing")
+	}
+
+	return nil
+}
+
+func (o *bindCmdOptions) validate() error {
+	for _, p := range o.Properties {
+		if _, _, _, err := parseBindProperty(p); err != nil {
+			return err
+		}
+	}
+
+	switch o.OutputFormat {
+	case "", "yaml", "json":
+		return nil
+	default:
+		return fmt.Errorf("invalid output format option '%s', should be one of: yaml|json", o.OutputFormat)
+	}
+}
+
+func (o *bindCmdOptions) run(cmd *cobra.Command, args []string) error {
+	if err := o.validate(); err != nil {
+		return err
+	}
+
+	source, err := o.decodeEndpoint(args[0], sourceKey)
+	if err != nil {
+		return err
+	}
+	sink, err := o.decodeEndpoint(args[1], sinkKey)
+	if err != nil {
+		return err
+	}
+
+	var c client.Client
+	if !o.SkipChecks {
+		if c, err = o.GetCmdClient(); err != nil {
+			return err
+		}
+		repo, err := repository.New(o.Context, c, o.Namespace)
+		if err != nil {
+			return err
+		}
+		if err := o.checkCompliance(cmd, repo, &source); err != nil {
+			return err
+		}
+		if err := o.checkCompliance(cmd, repo, &sink); err != nil {
+			return err
+		}
+	}
+
+	binding := v1alpha1.NewKameletBinding(o.Namespace, o.nameFor(source, sink))
+	binding.Spec = v1alpha1.KameletBindingSpec{
+		Source: source,
+		Sink:   sink,
+	}
+
+	switch o.OutputFormat {
+	case "":
+		// continue..
+	case "yaml":
+		data, err := kubernetes.ToYAML(&binding)
+		if err != nil {
+			return err
+		}
+		fmt.Fprint(cmd.OutOrStdout(), string(data))
+		return nil
+	case "json":
+		data, err := kubernetes.ToJSON(&binding)
+		if err != nil {
+			return err
+		}
+		fmt.Fprint(cmd.OutOrStdout(), string(data))
+		return nil
+	}
+
+	if c == nil {
+		if c, err = o.GetCmdClient(); err != nil {
+			return err
+		}
+	}
+
+	existed := false
+	err = c.Create(o.Context, &binding)
+	if err != nil && k8serrors.IsAlreadyExists(err) {
+		existed = true
+		existing := v1alpha1.KameletBinding{}
+		key := k8sclient.ObjectKey{
+			Namespace: binding.Namespace,
+			Name:      binding.Name,
+		}
+		if err := c.Get(o.Context, key, &existing); err != nil {
+			return err
+		}
+		binding.ResourceVersion = existing.ResourceVersion
+		binding.Spec.Integration = existing.Spec.Integration
+		err = c.Update(o.Context, &binding)
+	}
+	if err != nil {
+		return err
+	}
+
+	if !existed {
+		fmt.Fprintf(cmd.OutOrStdout(), "kamelet binding \"%s\" created\n", binding.Name)
+	} else {
+		fmt.Fprintf(cmd.OutOrStdout(), "kamelet binding \"%s\" updated\n", binding.Name)
+	}
+	return nil
+}
+
+// decodeEndpoint converts the textual representation of an endpoint into an Endpoint,
+// collecting the properties that have been specified for it
+func (o *bindCmdOptions) decodeEndpoint(str string, key string) (v1alpha1.Endpoint, error) {
+	endpoint := v1alpha1.Endpoint{}
+	props := make(map[string]interface{})
+
+	base := str
+	query := ""
+	if pos := strings.Index(str, "?"); pos >= 0 {
+		base = str[:pos]
+		query = str[pos+1:]
+	}
+
+	if ref, ok := reference.FromString(base); ok {
+		endpoint.Ref = &ref
+		params, err := url.ParseQuery(query)
+		if err != nil {
+			return endpoint, errors.Wrapf(err, "cannot parse parameters of %s %q", key, str)
+		}
+		for k := range params {
+			props[k] = params.Get(k)
+		}
+	} else if uri.GetComponent(str) != "" {
+		endpoint.URI = &str
+	} else {
+		return endpoint, fmt.Errorf("cannot interpret %s %q as a Kubernetes reference nor as a Camel URI", key, str)
+	}
+
+	for _, p := range o.Properties {
+		endpointKey, name, value, err := parseBindProperty(p)
+		if err != nil {
+			return endpoint, err
+		}
+		if endpointKey == key {
+			props[name] = value
+		}
+	}
+
+	if len(props) > 0 {
+		properties, err := asEndpointProperties(props)
+		if err != nil {
+			return endpoint, err
+		}
+		endpoint.Properties = properties
+	}
+	return endpoint, nil
+}
+
+// checkCompliance verifies the properties of Kamelet endpoints against the Kamelet definition
+// and converts them to the types declared in the schema
+func (o *bindCmdOptions) checkCompliance(cmd *cobra.Command, repo repository.KameletRepository, endpoint *v1alpha1.Endpoint) error {
+	if endpoint.Ref == nil || endpoint.Ref.Kind != v1alpha1.KameletKind || !strings.HasPrefix(endpoint.Ref.APIVersion, v1alpha1.SchemeGroupVersion.Group+"/") {
+		return nil
+	}
+
+	name, version := v1alpha1.ParseKameletReference(endpoint.Ref.Name)
+	k, err := repo.GetVersion(o.Context, name, version)
+	if err != nil {
+		return err
+	}
+	if k == nil {
+		// the Kamelet may be installed in the operator namespace, that is not known here
+		fmt.Fprintf(cmd.ErrOrStderr(), "Warning: kamelet %q not found in any of the defined repositories: %s\n", endpoint.Ref.Name, repo.String())
+		return nil
+	}
+
+	props, err := endpoint.Properties.GetPropertyValues()
+	if err != nil {
+		return err
+	}
+	if err := kamelet.ValidateProperties(k, props); err != nil {
+		return err
+	}
+
+	if k.Spec.Definition != nil && len(props) > 0 {
+		for name, value := range props {
+			if def, ok := k.Spec.Definition.Properties[name]; ok {
+				if props[name], err = kamelet.ToPropertyType(def, value); err != nil {
+					return err
+				}
+			}
+		}
+		if endpoint.Properties, err = asEndpointProperties(props); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (o *bindCmdOptions) nameFor(source, sink v1alpha1.Endpoint) string {
+	if o.Name != "" {
+		return kubernetes.SanitizeName(o.Name)
+	}
+	return kubernetes.SanitizeName(fmt.Sprintf("%s-to-%s", nameForEndpoint(source), nameForEndpoint(sink)))
+}
+
+func nameForEndpoint(endpoint v1alpha1.Endpoint) string {
+	if endpoint.URI != nil {
+		return uri.GetComponent(*endpoint.URI)
+	}
+	if endpoint.Ref != nil {
+		return endpoint.Ref.Name
+	}
+	return ""
+}
+
+func parseBindProperty(prop string) (string, string, string, error) {
+	parts := strings.SplitN(prop, "=", 2)
+	if len(parts) != 2 {
+		return "", "", "", fmt.Errorf(`property %q does not follow format "[source|sink].<key>=<value>"`, prop)
+	}
+	keyParts := strings.SplitN(parts[0], ".", 2)
+	if len(keyParts) != 2 || keyParts[1] == "" {
+		return "", "", "", fmt.Errorf(`property key %q does not follow format "[source|sink].<key>"`, parts[0])
+	}
+	if keyParts[0] != sourceKey && keyParts[0] != sinkKey {
+		return "", "", "", fmt.Errorf(`property key %q does not start with "source." or "sink."`, parts[0])
+	}
+	return keyParts[0], keyParts[1], parts[1], nil
+}
+
+func asEndpointProperties(props map[string]interface{}) (*v1alpha1.EndpointProperties, error) {
+	data, err := json.Marshal(props)
+	if err != nil {
+		return nil, err
+	}
+	return &v1alpha1.EndpointProperties{
+		RawMessage: v1.RawMessage(data),
+	}, nil
+}
@@ -0,0 +1,142 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"testing"
+
+	"github.com/apache/camel-k/pkg/apis/camel/v1alpha1"
+	"github.com/apache/camel-k/pkg/util/test"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const cmdBind = "bind"
+
+func initializeBindCmdOptions(t *testing.T, initObjs ...runtime.Object) (*bindCmdOptions, *cobra.Command, RootCmdOptions) {
+	options, rootCmd := kamelTestPreAddCommandInit()
+	fakeClient, err := test.NewFakeClient(initObjs...)
+	assert.Nil(t, err)
+	options._client = fakeClient
+	options.Namespace = "default"
+	bindCmdOptions := addTestBindCmd(*options, rootCmd)
+	kamelTestPostAddCommandInit(t, rootCmd)
+
+	return bindCmdOptions, rootCmd, *options
+}
+
+func addTestBindCmd(options RootCmdOptions, rootCmd *cobra.Command) *bindCmdOptions {
+	bindCmd, bindOptions := newCmdBind(&options)
+	bindCmd.PersistentPreRunE = func(c *cobra.Command, args []string) error {
+		return nil
+	}
+	rootCmd.AddCommand(bindCmd)
+	return bindOptions
+}
+
+func timerSourceKamelet() *v1alpha1.Kamelet {
+	return &v1alpha1.Kamelet{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "timer-source",
+		},
+		Spec: v1alpha1.KameletSpec{
+			Definition: &v1alpha1.JSONSchemaProps{
+				Required: []string{"message"},
+				Properties: map[string]v1alpha1.JSONSchemaProp{
+					"message": {Type: "string"},
+					"period":  {Type: "integer"},
+				},
+			},
+		},
+	}
+}
+
+func TestBindOutputYAML(t *testing.T) {
+	_, rootCmd, _ := initializeBindCmdOptions(t, timerSourceKamelet())
+	output, err := test.ExecuteCommand(rootCmd, cmdBind, "timer-source", "channel:mychannel",
+		"-p", "source.message=hello",
+		"-p", "source.period=1000",
+		"-o", "yaml")
+	assert.Nil(t, err)
+	assert.Equal(t, `apiVersion: camel.apache.org/v1alpha1
+kind: KameletBinding
+metadata:
+  creationTimestamp: null
+  name: timer-source-to-mychannel
+  namespace: default
+spec:
+  sink:
+    ref:
+      apiVersion: messaging.knative.dev/v1
+      kind: Channel
+      name: mychannel
+  source:
+    properties:
+      message: hello
+      period: 1000
+    ref:
+      apiVersion: camel.apache.org/v1alpha1
+      kind: Kamelet
+      name: timer-source
+status: {}
+`, output)
+}
+
+func TestBindURIAndName(t *testing.T) {
+	_, rootCmd, _ := initializeBindCmdOptions(t)
+	output, err := test.ExecuteCommand(rootCmd, cmdBind, "timer:tick?period=1000", "log:info",
+		"--name", "my-binding",
+		"-p", "sink.showAll=true",
+		"-o", "json")
+	assert.Nil(t, err)
+	assert.Contains(t, output, `"name":"my-binding"`)
+	assert.Contains(t, output, `"uri":"timer:tick?period=1000"`)
+	assert.Contains(t, output, `"properties":{"showAll":"true"}`)
+}
+
+func TestBindMissingRequiredProperty(t *testing.T) {
+	_, rootCmd, _ := initializeBindCmdOptions(t, timerSourceKamelet())
+	_, err := test.ExecuteCommand(rootCmd, cmdBind, "kamelet:timer-source", "log:info", "-o", "yaml")
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), `missing required property "message" for kamelet "timer-source"`)
+}
+
+func TestBindWrongPropertyType(t *testing.T) {
+	_, rootCmd, _ := initializeBindCmdOptions(t, timerSourceKamelet())
+	_, err := test.ExecuteCommand(rootCmd, cmdBind, "kamelet:timer-source", "log:info",
+		"-p", "source.message=hello",
+		"-p", "source.period=often",
+		"-o", "yaml")
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), `invalid value for property "period"`)
+}
+
+func TestBindSkipChecks(t *testing.T) {
+	_, rootCmd, _ := initializeBindCmdOptions(t, timerSourceKamelet())
+	_, err := test.ExecuteCommand(rootCmd, cmdBind, "kamelet:timer-source", "log:info", "--skip-checks", "-o", "yaml")
+	assert.Nil(t, err)
+}
+
+func TestBindInvalidProperty(t *testing.T) {
+	_, rootCmd, _ := initializeBindCmdOptions(t)
+	_, err := test.ExecuteCommand(rootCmd, cmdBind, "timer:tick", "log:info", "-p", "other.key=value")
+	assert.NotNil(t, err)
+}
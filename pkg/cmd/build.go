@@ -0,0 +1,60 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/client"
+	"github.com/apache/camel-k/pkg/util/kubernetes"
+)
+
+func newCmdBuild(rootCmdOptions *RootCmdOptions) *cobra.Command {
+	cmd := cobra.Command{
+		Use:   "build",
+		Short: "Manage the builds of Integration Kits",
+		Long:  `Manage the builds of Integration Kits.`,
+	}
+
+	cmd.AddCommand(cmdOnly(newBuildGetCmd(rootCmdOptions)))
+	cmd.AddCommand(cmdOnly(newBuildLogsCmd(rootCmdOptions)))
+	cmd.AddCommand(cmdOnly(newBuildCancelCmd(rootCmdOptions)))
+
+	return &cmd
+}
+
+func getBuild(ctx context.Context, c client.Client, namespace string, name string) (*v1.Build, error) {
+	build, err := kubernetes.GetBuild(ctx, c, name, namespace)
+	if err != nil && k8serrors.IsNotFound(err) {
+		return nil, fmt.Errorf("no build found with name %q", name)
+	}
+	return build, err
+}
+
+// formatBuildStep returns the progress of a build step, as tab separated name, result and duration
+func formatBuildStep(step v1.BuildStepStatus) string {
+	return fmt.Sprintf("%s\t%s\t%s", path.Base(step.ID), step.Result, step.Duration().Round(time.Millisecond))
+}
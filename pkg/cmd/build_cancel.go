@@ -0,0 +1,119 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+)
+
+func newBuildCancelCmd(rootCmdOptions *RootCmdOptions) (*cobra.Command, *buildCancelCommandOptions) {
+	options := buildCancelCommandOptions{
+		RootCmdOptions: rootCmdOptions,
+	}
+
+	cmd := cobra.Command{
+		Use:     "cancel [build]...",
+		Short:   "Cancel builds",
+		Long:    `Cancel builds, interrupting them if they are running. The integration kits of the cancelled builds end up in error.`,
+		PreRunE: decode(&options),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := options.validate(args); err != nil {
+				return err
+			}
+			return options.run(cmd, args)
+		},
+	}
+
+	cmd.Flags().Bool("all", false, "Cancel all the builds that have not completed")
+
+	return &cmd, &options
+}
+
+type buildCancelCommandOptions struct {
+	*RootCmdOptions
+	All bool `mapstructure:"all"`
+}
+
+func (command *buildCancelCommandOptions) validate(args []string) error {
+	if command.All && len(args) > 0 {
+		return errors.New("invalid combination: both all flag and named builds are set")
+	}
+	if !command.All && len(args) == 0 {
+		return errors.New("invalid combination: neither all flag nor named builds are set")
+	}
+
+	return nil
+}
+
+func (command *buildCancelCommandOptions) run(cmd *cobra.Command, args []string) error {
+	c, err := command.GetCmdClient()
+	if err != nil {
+		return err
+	}
+
+	builds := make([]v1.Build, 0)
+	if command.All {
+		list := v1.BuildList{}
+		if err := c.List(command.Context, &list, k8sclient.InNamespace(command.Namespace)); err != nil {
+			return err
+		}
+		for _, build := range list.Items {
+			if !isBuildCompleted(&build) {
+				builds = append(builds, build)
+			}
+		}
+	} else {
+		for _, name := range args {
+			build, err := getBuild(command.Context, c, command.Namespace, name)
+			if err != nil {
+				return err
+			}
+			if isBuildCompleted(build) {
+				return fmt.Errorf("build %q has already completed", name)
+			}
+			builds = append(builds, *build)
+		}
+	}
+
+	for _, build := range builds {
+		target := build.DeepCopy()
+		if target.Annotations == nil {
+			target.Annotations = make(map[string]string)
+		}
+		target.Annotations[v1.BuildCancelAnnotation] = "true"
+		if err := c.Patch(command.Context, target, k8sclient.MergeFrom(&build)); err != nil {
+			return errors.Wrapf(err, "cannot cancel build %q", build.Name)
+		}
+		fmt.Fprintf(cmd.OutOrStdout(), "build %q cancelled\n", build.Name)
+	}
+
+	return nil
+}
+
+func isBuildCompleted(build *v1.Build) bool {
+	return build.Status.Phase == v1.BuildPhaseSucceeded ||
+		build.Status.Phase == v1.BuildPhaseError ||
+		build.Status.Phase == v1.BuildPhaseInterrupted
+}
@@ -0,0 +1,88 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+)
+
+func newBuildGetCmd(rootCmdOptions *RootCmdOptions) (*cobra.Command, *buildGetCommandOptions) {
+	options := buildGetCommandOptions{
+		RootCmdOptions: rootCmdOptions,
+	}
+
+	cmd := cobra.Command{
+		Use:     "get [build]...",
+		Short:   "Get the builds",
+		Long:    `Get the builds, or the given ones, with their phase, queue position and duration.`,
+		PreRunE: decode(&options),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return options.run(cmd, args)
+		},
+	}
+
+	return &cmd, &options
+}
+
+type buildGetCommandOptions struct {
+	*RootCmdOptions
+}
+
+func (command *buildGetCommandOptions) run(cmd *cobra.Command, args []string) error {
+	c, err := command.GetCmdClient()
+	if err != nil {
+		return err
+	}
+
+	builds := make([]v1.Build, 0)
+	if len(args) == 0 {
+		list := v1.BuildList{}
+		if err := c.List(command.Context, &list, k8sclient.InNamespace(command.Namespace)); err != nil {
+			return err
+		}
+		builds = list.Items
+	} else {
+		for _, name := range args {
+			build, err := getBuild(command.Context, c, command.Namespace, name)
+			if err != nil {
+				return err
+			}
+			builds = append(builds, *build)
+		}
+	}
+
+	w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 8, 1, '\t', 0)
+	fmt.Fprintln(w, "NAME\tPHASE\tQUEUE POSITION\tDURATION\tIMAGE")
+	for _, build := range builds {
+		position := ""
+		if build.Status.QueuePosition > 0 {
+			position = fmt.Sprint(build.Status.QueuePosition)
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", build.Name, string(build.Status.Phase), position, build.Status.Duration, build.Status.Image)
+	}
+	w.Flush()
+
+	return nil
+}
@@ -0,0 +1,104 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"github.com/spf13/cobra"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/client"
+	k8slog "github.com/apache/camel-k/pkg/util/kubernetes/log"
+)
+
+func newBuildLogsCmd(rootCmdOptions *RootCmdOptions) (*cobra.Command, *buildLogsCommandOptions) {
+	options := buildLogsCommandOptions{
+		RootCmdOptions: rootCmdOptions,
+	}
+
+	cmd := cobra.Command{
+		Use:     "logs build",
+		Short:   "Print the logs of a build",
+		Long:    `Print the logs of a build, optionally following them until the build completes.`,
+		Aliases: []string{"log"},
+		PreRunE: decode(&options),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return errors.New("logs expects a build name argument")
+			}
+			return options.run(cmd, args[0])
+		},
+	}
+
+	cmd.Flags().BoolP("follow", "f", false, "Follow the logs until the build completes")
+
+	return &cmd, &options
+}
+
+type buildLogsCommandOptions struct {
+	*RootCmdOptions
+	Follow bool `mapstructure:"follow"`
+}
+
+func (command *buildLogsCommandOptions) run(cmd *cobra.Command, name string) error {
+	c, err := command.GetCmdClient()
+	if err != nil {
+		return err
+	}
+
+	build, err := getBuild(command.Context, c, command.Namespace, name)
+	if err != nil {
+		return err
+	}
+
+	return printBuildLog(command.Context, c, build, command.Follow, cmd.OutOrStdout())
+}
+
+// printBuildLog prints the persisted log of a build, or streams its builder pod logs when the log has not been persisted
+func printBuildLog(ctx context.Context, c client.Client, build *v1.Build, follow bool, out io.Writer) error {
+	cm := corev1.ConfigMap{}
+	err := c.Get(ctx, k8sclient.ObjectKey{Namespace: build.Namespace, Name: k8slog.BuildLogName(build.Name)}, &cm)
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return err
+	}
+
+	if k8serrors.IsNotFound(err) {
+		pods := corev1.PodList{}
+		err = c.List(ctx, &pods,
+			k8sclient.InNamespace(build.Namespace),
+			k8sclient.MatchingLabels{
+				"camel.apache.org/build":     build.Name,
+				"camel.apache.org/component": "builder",
+			})
+		if err != nil {
+			return err
+		}
+		if len(pods.Items) > 0 {
+			return k8slog.PrintPodContainers(ctx, c, build.Namespace, pods.Items[0].Name, out)
+		}
+	}
+
+	return k8slog.PrintBuildLog(ctx, c, build.Namespace, build.Name, follow, out)
+}
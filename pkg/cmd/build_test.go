@@ -0,0 +1,150 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/client"
+	k8slog "github.com/apache/camel-k/pkg/util/kubernetes/log"
+	"github.com/apache/camel-k/pkg/util/test"
+)
+
+func initializeBuildCmd(t *testing.T, initObjs ...runtime.Object) (*cobra.Command, client.Client) {
+	options, rootCmd := kamelTestPreAddCommandInit()
+	fakeClient, err := test.NewFakeClient(initObjs...)
+	assert.Nil(t, err)
+	options._client = fakeClient
+	options.Namespace = "default"
+
+	rootCmd.AddCommand(newCmdBuild(options))
+	rootCmd.AddCommand(newCmdKit(options))
+	kamelTestPostAddCommandInit(t, rootCmd)
+
+	return rootCmd, fakeClient
+}
+
+func newTestBuild(name string, phase v1.BuildPhase) *v1.Build {
+	return &v1.Build{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: v1.SchemeGroupVersion.String(),
+			Kind:       v1.BuildKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      name,
+		},
+		Status: v1.BuildStatus{
+			Phase:         phase,
+			QueuePosition: 2,
+		},
+	}
+}
+
+func TestBuildGet(t *testing.T) {
+	rootCmd, _ := initializeBuildCmd(t, newTestBuild("kit-1", v1.BuildPhaseScheduling))
+
+	output, err := test.ExecuteCommand(rootCmd, "build", "get")
+	assert.Nil(t, err)
+	assert.Contains(t, output, "kit-1")
+	assert.Contains(t, output, "Scheduling")
+
+	_, err = test.ExecuteCommand(rootCmd, "build", "get", "missing")
+	assert.EqualError(t, err, `no build found with name "missing"`)
+}
+
+func TestBuildCancel(t *testing.T) {
+	rootCmd, c := initializeBuildCmd(t,
+		newTestBuild("kit-1", v1.BuildPhaseRunning),
+		newTestBuild("kit-2", v1.BuildPhaseSucceeded),
+	)
+
+	output, err := test.ExecuteCommand(rootCmd, "build", "cancel", "kit-1")
+	assert.Nil(t, err)
+	assert.Equal(t, "build \"kit-1\" cancelled\n", output)
+
+	build := v1.Build{}
+	assert.Nil(t, c.Get(context.TODO(), k8sclient.ObjectKey{Namespace: "default", Name: "kit-1"}, &build))
+	assert.Equal(t, "true", build.Annotations[v1.BuildCancelAnnotation])
+
+	_, err = test.ExecuteCommand(rootCmd, "build", "cancel", "kit-2")
+	assert.EqualError(t, err, `build "kit-2" has already completed`)
+
+	_, err = test.ExecuteCommand(rootCmd, "build", "cancel")
+	assert.NotNil(t, err)
+}
+
+func TestKitRebuild(t *testing.T) {
+	kit := v1.NewIntegrationKit("default", "kit-1")
+	kit.Status.Phase = v1.IntegrationKitPhaseError
+	rootCmd, c := initializeBuildCmd(t, &kit, newTestBuild("kit-1", v1.BuildPhaseInterrupted))
+
+	output, err := test.ExecuteCommand(rootCmd, "kit", "rebuild", "kit-1")
+	assert.Nil(t, err)
+	assert.Equal(t, "integration kit \"kit-1\" rebuilding\n", output)
+
+	key := k8sclient.ObjectKey{Namespace: "default", Name: "kit-1"}
+	rebuilt := v1.IntegrationKit{}
+	assert.Nil(t, c.Get(context.TODO(), key, &rebuilt))
+	assert.Equal(t, v1.IntegrationKitPhaseNone, rebuilt.Status.Phase)
+	assert.True(t, k8serrors.IsNotFound(c.Get(context.TODO(), key, &v1.Build{})))
+}
+
+func TestBuildLogs(t *testing.T) {
+	kit := v1.NewIntegrationKit("default", "kit-1")
+	build := newTestBuild("kit-1", v1.BuildPhaseSucceeded)
+	logs := corev1.ConfigMap{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: corev1.SchemeGroupVersion.String(),
+			Kind:       "ConfigMap",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      k8slog.BuildLogName("kit-1"),
+		},
+		Data: map[string]string{
+			k8slog.BuildLogKey: "[INFO] BUILD SUCCESS\n",
+		},
+	}
+	rootCmd, _ := initializeBuildCmd(t, &kit, build, &logs, newTestBuild("kit-2", v1.BuildPhaseRunning))
+
+	output, err := test.ExecuteCommand(rootCmd, "build", "logs", "kit-1")
+	assert.Nil(t, err)
+	assert.Equal(t, "[INFO] BUILD SUCCESS\n", output)
+
+	output, err = test.ExecuteCommand(rootCmd, "kit", "logs", "kit-1", "--follow")
+	assert.Nil(t, err)
+	assert.Equal(t, "[INFO] BUILD SUCCESS\n", output)
+
+	_, err = test.ExecuteCommand(rootCmd, "build", "logs", "kit-2")
+	assert.EqualError(t, err, `no log found for build "kit-2"`)
+
+	_, err = test.ExecuteCommand(rootCmd, "kit", "logs", "missing")
+	assert.EqualError(t, err, `no integration kit found with name "missing"`)
+}
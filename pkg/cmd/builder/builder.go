@@ -18,7 +18,9 @@ limitations under the License.
 package builder
 
 import (
+	"context"
 	"fmt"
+	"io"
 	"math/rand"
 	"os"
 	"reflect"
@@ -38,6 +40,7 @@ import (
 	"github.com/apache/camel-k/pkg/client"
 	"github.com/apache/camel-k/pkg/util/cancellable"
 	"github.com/apache/camel-k/pkg/util/defaults"
+	k8slog "github.com/apache/camel-k/pkg/util/kubernetes/log"
 	logger "github.com/apache/camel-k/pkg/util/log"
 	"github.com/apache/camel-k/pkg/util/patch"
 )
@@ -80,23 +83,38 @@ func Run(namespace string, buildName string, taskName string) {
 			reflect.TypeOf(v1.BuilderTask{}).Name(), taskName, namespace, buildName), "")
 	}
 
-	status := builder.New(c).Run(*task)
+	// Persist the build output, so that it remains available once the builder pod is gone
+	var output io.Writer
+	logs, err := k8slog.NewBuildLogWriter(ctx, c, build)
+	if err != nil {
+		log.Error(err, "cannot persist build log")
+	} else {
+		output = logs
+	}
+
+	// Steps executed by previous tasks
+	previous := build.Status.Steps
+
+	status := builder.New(c).Run(ctx, *task, func(steps []v1.BuildStepStatus) {
+		target := build.DeepCopy()
+		target.Status.Steps = append(append([]v1.BuildStepStatus{}, previous...), steps...)
+		// Report the build progress, on a best-effort basis
+		if err := patchBuildStatus(ctx, c, build, target); err != nil {
+			log.Error(err, "cannot report build progress")
+		}
+	}, output)
+	if logs != nil {
+		if err := logs.Close(); err != nil {
+			log.Error(err, "cannot persist build log")
+		}
+	}
+
 	target := build.DeepCopy()
 	target.Status = status
+	target.Status.Steps = append(append([]v1.BuildStepStatus{}, previous...), status.Steps...)
 	// Copy the failure field from the build to persist recovery state
 	target.Status.Failure = build.Status.Failure
-	// Patch the build status with the result
-	p, err := patch.PositiveMergePatch(build, target)
-	exitOnError(err, "cannot create merge patch")
-	if len(p) > 0 {
-		exitOnError(
-			c.Status().Patch(ctx, target, controller.RawPatch(types.MergePatchType, p)),
-			fmt.Sprintf("\n--- patch ---\n%s\n-------------\n", string(p)),
-		)
-	} else {
-		log.Info("Patch not applied (no difference)")
-	}
-	build.Status = target.Status
+	exitOnError(patchBuildStatus(ctx, c, build, target), "")
 
 	switch build.Status.Phase {
 	case v1.BuildPhaseFailed:
@@ -107,6 +125,23 @@ func Run(namespace string, buildName string, taskName string) {
 	}
 }
 
+func patchBuildStatus(ctx context.Context, c client.Client, build *v1.Build, target *v1.Build) error {
+	// Patch the build status with the result
+	p, err := patch.PositiveMergePatch(build, target)
+	if err != nil {
+		return errors.Wrap(err, "cannot create merge patch")
+	}
+	if len(p) > 0 {
+		if err := c.Status().Patch(ctx, target, controller.RawPatch(types.MergePatchType, p)); err != nil {
+			return errors.Wrapf(err, "\n--- patch ---\n%s\n-------------\n", string(p))
+		}
+	} else {
+		log.Info("Patch not applied (no difference)")
+	}
+	build.Status = target.Status
+	return nil
+}
+
 func exitOnError(err error, msg string) {
 	if err != nil {
 		log.Error(err, msg)
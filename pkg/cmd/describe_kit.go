@@ -24,10 +24,13 @@ import (
 
 	"github.com/spf13/cobra"
 
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+
 	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
 
 	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
 	"github.com/apache/camel-k/pkg/util/indentedwriter"
+	"github.com/apache/camel-k/pkg/util/kubernetes"
 )
 
 func newDescribeKitCmd(rootCmdOptions *RootCmdOptions) (*cobra.Command, *describeKitCommandOptions) {
@@ -79,7 +82,12 @@ func (command *describeKitCommandOptions) run(args []string) error {
 	}
 
 	if err := c.Get(command.Context, kitKey, &kit); err == nil {
-		fmt.Print(command.describeIntegrationKit(kit))
+		// The build may not exist, e.g. for external kits
+		build, err := kubernetes.GetBuild(command.Context, c, kit.Name, kit.Namespace)
+		if err != nil && !k8serrors.IsNotFound(err) {
+			return err
+		}
+		fmt.Print(command.describeIntegrationKit(kit, build))
 	} else {
 		fmt.Printf("IntegrationKit '%s' does not exist.\n", args[0])
 	}
@@ -87,7 +95,7 @@ func (command *describeKitCommandOptions) run(args []string) error {
 	return nil
 }
 
-func (command *describeKitCommandOptions) describeIntegrationKit(kit v1.IntegrationKit) (string, error) {
+func (command *describeKitCommandOptions) describeIntegrationKit(kit v1.IntegrationKit, build *v1.Build) (string, error) {
 	return indentedwriter.IndentedString(func(out io.Writer) error {
 		w := indentedwriter.NewWriter(out)
 
@@ -105,6 +113,20 @@ func (command *describeKitCommandOptions) describeIntegrationKit(kit v1.Integrat
 			}
 		}
 
+		if build != nil {
+			w.Write(0, "Build:\n")
+			w.Write(1, "Phase:\t%s\n", build.Status.Phase)
+			if build.Status.Duration != "" {
+				w.Write(1, "Duration:\t%s\n", build.Status.Duration)
+			}
+			if len(build.Status.Steps) > 0 {
+				w.Write(1, "Steps:\n")
+				for _, step := range build.Status.Steps {
+					w.Write(2, "%s\n", formatBuildStep(step))
+				}
+			}
+		}
+
 		if len(kit.Spec.Configuration) > 0 {
 			w.Write(0, "Configuration:\n")
 			for _, config := range kit.Spec.Configuration {
@@ -97,6 +97,7 @@ func newCmdInstall(rootCmdOptions *RootCmdOptions) (*cobra.Command, *installCmdO
 	cmd.Flags().String("build-strategy", "", "Set the build strategy")
 	cmd.Flags().String("build-publish-strategy", "", "Set the build publish strategy")
 	cmd.Flags().String("build-timeout", "", "Set how long the build process can last")
+	cmd.Flags().Int32("max-running-builds", 0, "Set the maximum number of builds that can run at the same time in the namespace")
 	cmd.Flags().String("trait-profile", "", "The profile to use for traits")
 	cmd.Flags().Bool("kaniko-build-cache", false, "To enable or disable the Kaniko cache")
 	cmd.Flags().String("http-proxy-secret", "", "Configure the source of the secret holding HTTP proxy server details "+
@@ -163,6 +164,7 @@ type installCmdOptions struct {
 	BuildStrategy           string   `mapstructure:"build-strategy"`
 	BuildPublishStrategy    string   `mapstructure:"build-publish-strategy"`
 	BuildTimeout            string   `mapstructure:"build-timeout"`
+	MaxRunningBuilds        int32    `mapstructure:"max-running-builds"`
 	MavenRepositories       []string `mapstructure:"maven-repositories"`
 	MavenSettings           string   `mapstructure:"maven-settings"`
 	HealthPort              int32    `mapstructure:"health-port"`
@@ -335,6 +337,9 @@ func (o *installCmdOptions) install(cobraCmd *cobra.Command, _ []string) error {
 				Duration: d,
 			}
 		}
+		if o.MaxRunningBuilds > 0 {
+			platform.Spec.Build.MaxRunningBuilds = o.MaxRunningBuilds
+		}
 		if o.TraitProfile != "" {
 			platform.Spec.Profile = v1.TraitProfileByName(o.TraitProfile)
 		}
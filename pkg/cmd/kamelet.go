@@ -0,0 +1,68 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/apache/camel-k/pkg/kamelet/repository"
+)
+
+func newCmdKamelet(rootCmdOptions *RootCmdOptions) *cobra.Command {
+	cmd := cobra.Command{
+		Use:   "kamelet",
+		Short: "Browse the Kamelets catalog",
+		Long:  `Browse the Kamelets available in the cluster and in the configured Kamelet repositories.`,
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			// Offline commands do not need a connection to the cluster
+			if offline, err := cmd.Flags().GetBool("offline"); err == nil && offline {
+				return nil
+			}
+			return rootCmdOptions.preRun(cmd, args)
+		},
+	}
+
+	cmd.AddCommand(cmdOnly(newKameletListCmd(rootCmdOptions)))
+	cmd.AddCommand(cmdOnly(newKameletGetCmd(rootCmdOptions)))
+	cmd.AddCommand(cmdOnly(newKameletDescribeCmd(rootCmdOptions)))
+	cmd.AddCommand(cmdOnly(newKameletTestCmd(rootCmdOptions)))
+
+	return &cmd
+}
+
+// kameletRepositoryOptions contains the options shared by all commands looking up Kamelet repositories
+type kameletRepositoryOptions struct {
+	Offline      bool     `mapstructure:"offline"`
+	Repositories []string `mapstructure:"repositories"`
+}
+
+func addKameletRepositoryFlags(cmd *cobra.Command) {
+	cmd.Flags().Bool("offline", false, "Do not connect to the cluster and only look up the remote Kamelet repositories")
+	cmd.Flags().StringArray("repository", nil, "A Kamelet repository URI to look up in offline mode, e.g. \"github:apache/camel-kamelets\", \"git:https://example.com/kamelets.git#main/path\", \"oci:quay.io/org/kamelets:1.0\" or \"file:/path/to/kamelets\". Defaults to "+repository.DefaultRemoteRepository)
+}
+
+func (o *kameletRepositoryOptions) newKameletRepository(rootCmdOptions *RootCmdOptions) (repository.KameletRepository, error) {
+	if o.Offline {
+		return repository.NewStandalone(o.Repositories...)
+	}
+	c, err := rootCmdOptions.GetCmdClient()
+	if err != nil {
+		return nil, err
+	}
+	return repository.New(rootCmdOptions.Context, c, rootCmdOptions.Namespace)
+}
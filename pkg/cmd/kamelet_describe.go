@@ -0,0 +1,239 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/apache/camel-k/pkg/apis/camel/v1alpha1"
+	"github.com/apache/camel-k/pkg/kamelet/repository"
+	"github.com/apache/camel-k/pkg/util/indentedwriter"
+)
+
+func newKameletDescribeCmd(rootCmdOptions *RootCmdOptions) (*cobra.Command, *kameletDescribeCommandOptions) {
+	options := kameletDescribeCommandOptions{
+		RootCmdOptions: rootCmdOptions,
+	}
+
+	cmd := cobra.Command{
+		Use:     "describe <name>[@<version>]",
+		Short:   "Describe a Kamelet",
+		Long:    `Describe the properties, event types and dependencies of a Kamelet.`,
+		PreRunE: decode(&options),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := options.validate(args); err != nil {
+				return err
+			}
+			return options.run(cmd, args)
+		},
+	}
+
+	addKameletRepositoryFlags(&cmd)
+
+	return &cmd, &options
+}
+
+type kameletDescribeCommandOptions struct {
+	*RootCmdOptions
+	kameletRepositoryOptions `mapstructure:",squash"`
+}
+
+func (command *kameletDescribeCommandOptions) validate(args []string) error {
+	if len(args) != 1 {
+		return errors.New("describe expects a kamelet name argument")
+	}
+	return nil
+}
+
+func (command *kameletDescribeCommandOptions) run(cmd *cobra.Command, args []string) error {
+	repo, err := command.newKameletRepository(command.RootCmdOptions)
+	if err != nil {
+		return err
+	}
+
+	name, version := v1alpha1.ParseKameletReference(args[0])
+	for _, r := range repository.Unwrap(repo) {
+		kamelet, err := r.Get(command.Context, name)
+		if err != nil {
+			return err
+		}
+		if kamelet == nil {
+			continue
+		}
+		versions := kamelet.GetVersions()
+		if kamelet, err = repository.SelectVersion(kamelet, version); err != nil {
+			return err
+		}
+		if kamelet != nil {
+			desc, err := command.describeKamelet(kamelet, versions, r)
+			if err != nil {
+				return err
+			}
+			fmt.Fprint(cmd.OutOrStdout(), desc)
+			return nil
+		}
+	}
+	return fmt.Errorf("kamelet %q not found in any of the defined repositories: %s", args[0], repo.String())
+}
+
+func (command *kameletDescribeCommandOptions) describeKamelet(kamelet *v1alpha1.Kamelet, versions []string, repo repository.KameletRepository) (string, error) {
+	return indentedwriter.IndentedString(func(out io.Writer) error {
+		w := indentedwriter.NewWriter(out)
+
+		describeObjectMeta(w, kamelet.ObjectMeta)
+
+		w.Write(0, "Repository:\t%s\n", repo.String())
+		if kamelet.Status.Phase != "" {
+			w.Write(0, "Phase:\t%s\n", kamelet.Status.Phase)
+		}
+		if v := kamelet.GetVersion(); v != "" {
+			w.Write(0, "Version:\t%s\n", v)
+		}
+		if len(versions) > 1 {
+			w.Write(0, "Versions:\t%s\n", strings.Join(versions, ", "))
+		}
+		if kamelet.IsDeprecated() {
+			w.Write(0, "Deprecated:\t%s\n", deprecationSummary(kamelet))
+		}
+
+		if def := kamelet.Spec.Definition; def != nil {
+			if def.Title != "" {
+				w.Write(0, "Title:\t%s\n", def.Title)
+			}
+			if def.Description != "" {
+				w.Write(0, "Description:\t%s\n", strings.TrimSpace(def.Description))
+			}
+			if err := describeKameletProperties(w, def); err != nil {
+				return err
+			}
+		}
+
+		if len(kamelet.Spec.Types) > 0 {
+			w.Write(0, "Types:\n")
+			slots := make([]string, 0, len(kamelet.Spec.Types))
+			for slot := range kamelet.Spec.Types {
+				slots = append(slots, string(slot))
+			}
+			sort.Strings(slots)
+			for _, slot := range slots {
+				t := kamelet.Spec.Types[v1alpha1.EventSlot(slot)]
+				w.Write(1, "%s:\n", slot)
+				w.Write(2, "Media Type:\t%s\n", t.MediaType)
+				if t.Schema != nil {
+					w.Write(2, "Schema:\t%s\n", describeSchemaSummary(t.Schema))
+				}
+			}
+		}
+
+		if len(kamelet.Spec.Dependencies) > 0 {
+			w.Write(0, "Dependencies:\n")
+			for _, dependency := range kamelet.Spec.Dependencies {
+				w.Write(1, "%s\n", dependency)
+			}
+		}
+
+		return nil
+	})
+}
+
+func describeKameletProperties(w *indentedwriter.Writer, def *v1alpha1.JSONSchemaProps) error {
+	if len(def.Properties) == 0 {
+		return nil
+	}
+
+	required := make(map[string]bool, len(def.Required))
+	for _, r := range def.Required {
+		required[r] = true
+	}
+
+	names := make([]string, 0, len(def.Properties))
+	for name := range def.Properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	w.Write(0, "Properties:\n")
+	for _, name := range names {
+		prop := def.Properties[name]
+		w.Write(1, "%s:\n", name)
+		if prop.Title != "" {
+			w.Write(2, "Title:\t%s\n", prop.Title)
+		}
+		if prop.Description != "" {
+			w.Write(2, "Description:\t%s\n", strings.TrimSpace(prop.Description))
+		}
+		w.Write(2, "Type:\t%s\n", prop.Type)
+		if prop.Format != "" {
+			w.Write(2, "Format:\t%s\n", prop.Format)
+		}
+		w.Write(2, "Required:\t%t\n", required[name])
+		if prop.Default != nil {
+			w.Write(2, "Default:\t%s\n", rawValue(prop.Default))
+		}
+		if prop.Example != nil {
+			w.Write(2, "Example:\t%s\n", rawValue(prop.Example))
+		}
+		if len(prop.Enum) > 0 {
+			values := make([]string, 0, len(prop.Enum))
+			for _, e := range prop.Enum {
+				values = append(values, rawValue(&e))
+			}
+			w.Write(2, "Enum:\t%s\n", strings.Join(values, ", "))
+		}
+		if len(prop.XDescriptors) > 0 {
+			w.Write(2, "X-Descriptors:\n")
+			for _, d := range prop.XDescriptors {
+				w.Write(3, "%s\n", d)
+			}
+		}
+	}
+	return nil
+}
+
+func describeSchemaSummary(schema *v1alpha1.JSONSchemaProps) string {
+	parts := make([]string, 0, 2)
+	if schema.Type != "" {
+		parts = append(parts, schema.Type)
+	}
+	if schema.ID != "" {
+		parts = append(parts, schema.ID)
+	}
+	if len(schema.Properties) > 0 {
+		parts = append(parts, fmt.Sprintf("%d properties", len(schema.Properties)))
+	}
+	if len(parts) == 0 {
+		return "<defined>"
+	}
+	return strings.Join(parts, ", ")
+}
+
+// rawValue returns the JSON value as a plain string, without quotes for string values
+func rawValue(value *v1alpha1.JSON) string {
+	var v interface{}
+	if err := json.Unmarshal(value.RawMessage, &v); err != nil {
+		return string(value.RawMessage)
+	}
+	return fmt.Sprintf("%v", v)
+}
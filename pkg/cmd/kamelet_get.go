@@ -0,0 +1,102 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/apache/camel-k/pkg/apis/camel/v1alpha1"
+	"github.com/apache/camel-k/pkg/util/kubernetes"
+)
+
+func newKameletGetCmd(rootCmdOptions *RootCmdOptions) (*cobra.Command, *kameletGetCommandOptions) {
+	options := kameletGetCommandOptions{
+		RootCmdOptions: rootCmdOptions,
+	}
+
+	cmd := cobra.Command{
+		Use:     "get <name>[@<version>]",
+		Short:   "Get the definition of a Kamelet",
+		Long:    `Get the definition of a Kamelet, looking it up in all the configured repositories. A specific version can be requested by appending "@<version>" to the name, e.g. "aws-s3-source@^1.2".`,
+		PreRunE: decode(&options),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := options.validate(args); err != nil {
+				return err
+			}
+			return options.run(cmd, args)
+		},
+	}
+
+	cmd.Flags().StringP("output", "o", "yaml", "Output format. One of: json|yaml")
+	addKameletRepositoryFlags(&cmd)
+
+	return &cmd, &options
+}
+
+type kameletGetCommandOptions struct {
+	*RootCmdOptions
+	kameletRepositoryOptions `mapstructure:",squash"`
+	OutputFormat             string `mapstructure:"output"`
+}
+
+func (command *kameletGetCommandOptions) validate(args []string) error {
+	if len(args) != 1 {
+		return errors.New("get expects a kamelet name argument")
+	}
+	if command.OutputFormat != "yaml" && command.OutputFormat != "json" {
+		return fmt.Errorf("invalid output format option '%s', should be one of: yaml|json", command.OutputFormat)
+	}
+	return nil
+}
+
+func (command *kameletGetCommandOptions) run(cmd *cobra.Command, args []string) error {
+	repo, err := command.newKameletRepository(command.RootCmdOptions)
+	if err != nil {
+		return err
+	}
+
+	name, version := v1alpha1.ParseKameletReference(args[0])
+	kamelet, err := repo.GetVersion(command.Context, name, version)
+	if err != nil {
+		return err
+	}
+	if kamelet == nil {
+		return fmt.Errorf("kamelet %q not found in any of the defined repositories: %s", args[0], repo.String())
+	}
+
+	if kamelet.Kind == "" {
+		// objects retrieved from the cluster do not always carry type information
+		kamelet.APIVersion = v1alpha1.SchemeGroupVersion.String()
+		kamelet.Kind = v1alpha1.KameletKind
+	}
+
+	var data []byte
+	if command.OutputFormat == "json" {
+		data, err = kubernetes.ToJSON(kamelet)
+	} else {
+		data, err = kubernetes.ToYAML(kamelet)
+	}
+	if err != nil {
+		return err
+	}
+	fmt.Fprint(cmd.OutOrStdout(), string(data))
+	return nil
+}
@@ -0,0 +1,613 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
+
+	"github.com/apache/camel-k/pkg/apis/camel/v1alpha1"
+	"github.com/apache/camel-k/pkg/util"
+)
+
+const (
+	// kameletTestEndpointID is the id of the Kamelet endpoint used by the harness route
+	kameletTestEndpointID = "test"
+	// kameletTestOutputMarker prefixes the messages logged by the harness route
+	kameletTestOutputMarker = "kamelet-test-output: "
+	// kameletTestFailureMarker is logged by Camel when an exchange cannot be delivered
+	kameletTestFailureMarker = "Failed delivery for"
+	// kameletTestRouteFile is the name of the file containing the harness route
+	kameletTestRouteFile = "kamelet-test.yaml"
+)
+
+func newKameletTestCmd(rootCmdOptions *RootCmdOptions) (*cobra.Command, *kameletTestCommandOptions) {
+	options := kameletTestCommandOptions{
+		RootCmdOptions: rootCmdOptions,
+	}
+
+	cmd := cobra.Command{
+		Use:   "test <name>[@<version>]|<kamelet file>",
+		Short: "Test a Kamelet locally",
+		Long: `Run a Kamelet locally, within a harness route generated according to its type.
+Source Kamelets are expected to produce messages, while the test inputs are sent to action and sink Kamelets.
+The test passes when all the expected outputs have been observed before the timeout expires.`,
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			// Kamelet files and offline lookups do not need a connection to the cluster
+			if offline, err := cmd.Flags().GetBool("offline"); err == nil && offline {
+				return nil
+			}
+			if len(args) == 1 && isLocalAndFileExists(args[0]) {
+				return nil
+			}
+			return rootCmdOptions.preRun(cmd, args)
+		},
+		PreRunE: decode(&options),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := options.validate(args); err != nil {
+				return err
+			}
+			if err := options.init(); err != nil {
+				return err
+			}
+			err := options.run(cmd, args)
+			if derr := options.deinit(); derr != nil && err == nil {
+				return derr
+			}
+			return err
+		},
+	}
+
+	addKameletRepositoryFlags(&cmd)
+	cmd.Flags().StringArrayP("property", "p", nil, "A sample value for a property of the Kamelet. E.g. \"-p message=hello\"")
+	cmd.Flags().StringArray("input", nil, "A test message sent to action and sink Kamelets, one per flag occurrence")
+	cmd.Flags().StringArray("expect", nil, "A text expected to be contained in one of the messages produced by source and action Kamelets")
+	cmd.Flags().String("type", "", "The type of the Kamelet (source, sink or action), if not set in the "+v1alpha1.KameletTypeLabel+" label")
+	cmd.Flags().Duration("timeout", time.Minute, "The maximum time to wait for the expected outputs, once the Kamelet is running")
+	cmd.Flags().String("junit", "", "Write the test result to the given file, in JUnit XML format")
+	cmd.Flags().Bool("verbose", false, "Print the output of the Kamelet while the test runs")
+	cmd.Flags().StringArrayP("dependency", "d", nil, additionalDependencyUsageMessage)
+	cmd.Flags().StringArray("maven-repository", nil, "Use a maven repository")
+	cmd.Flags().Bool("containerize", false, "Run the test in a local container.")
+	cmd.Flags().String("image", "", "Full path to the test image including registry, when running in a local container.")
+
+	return &cmd, &options
+}
+
+type kameletTestCommandOptions struct {
+	*RootCmdOptions
+	kameletRepositoryOptions `mapstructure:",squash"`
+	Properties               []string      `mapstructure:"properties"`
+	Inputs                   []string      `mapstructure:"inputs"`
+	Expectations             []string      `mapstructure:"expects"`
+	Type                     string        `mapstructure:"type"`
+	Timeout                  time.Duration `mapstructure:"timeout"`
+	JUnit                    string        `mapstructure:"junit"`
+	Verbose                  bool          `mapstructure:"verbose"`
+	AdditionalDependencies   []string      `mapstructure:"dependencies"`
+	MavenRepositories        []string      `mapstructure:"maven-repositories"`
+	Containerize             bool          `mapstructure:"containerize"`
+	Image                    string        `mapstructure:"image"`
+}
+
+func (command *kameletTestCommandOptions) validate(args []string) error {
+	if len(args) != 1 {
+		return errors.New("test expects a kamelet name or file argument")
+	}
+	for _, p := range command.Properties {
+		if kv := strings.SplitN(p, "=", 2); len(kv) != 2 || kv[0] == "" {
+			return fmt.Errorf("invalid property %q, expected format is <name>=<value>", p)
+		}
+	}
+	if command.Type != "" && !isKameletType(command.Type) {
+		return fmt.Errorf("invalid kamelet type %q, expected one of: source, sink, action", command.Type)
+	}
+	if command.Timeout <= 0 {
+		return errors.New("the timeout must be greater than zero")
+	}
+	if command.Containerize && command.Image == "" {
+		return errors.New("containerization is active but no image name has been provided")
+	}
+	return validateAdditionalDependencies(command.AdditionalDependencies)
+}
+
+func (command *kameletTestCommandOptions) init() error {
+	if command.Containerize {
+		if err := createDockerBaseWorkingDirectory(); err != nil {
+			return err
+		}
+		if err := createDockerWorkingDirectory(); err != nil {
+			return err
+		}
+	}
+	if err := createMavenWorkingDirectory(); err != nil {
+		return err
+	}
+	return util.CreateLocalKameletsDirectory()
+}
+
+func (command *kameletTestCommandOptions) deinit() error {
+	if command.Containerize {
+		if err := deleteDockerBaseWorkingDirectory(); err != nil {
+			return err
+		}
+		if err := deleteDockerWorkingDirectory(); err != nil {
+			return err
+		}
+	}
+	return deleteMavenWorkingDirectory()
+}
+
+func (command *kameletTestCommandOptions) run(cmd *cobra.Command, args []string) error {
+	kamelet, key, err := command.loadKamelet(args[0])
+	if err != nil {
+		return err
+	}
+
+	test, err := newKameletTest(kamelet, key, command.Type, command.Inputs, command.Expectations)
+	if err != nil {
+		return err
+	}
+
+	if missing := missingRequiredProperties(kamelet, command.Properties); len(missing) > 0 {
+		return fmt.Errorf("missing sample values for the required properties of kamelet %s: %s", key, strings.Join(missing, ", "))
+	}
+
+	result := command.runTest(cmd, kamelet, test)
+
+	if result.Failure == "" {
+		fmt.Fprintf(cmd.OutOrStdout(), "PASS: kamelet %s (%s)\n", key, result.Duration.Round(time.Millisecond))
+	} else {
+		if !command.Verbose {
+			fmt.Fprint(cmd.ErrOrStderr(), result.Output)
+		}
+		fmt.Fprintf(cmd.OutOrStdout(), "FAIL: kamelet %s (%s): %s\n", key, result.Duration.Round(time.Millisecond), result.Failure)
+	}
+
+	if command.JUnit != "" {
+		if err := writeJUnitReport(command.JUnit, result); err != nil {
+			return err
+		}
+	}
+
+	if result.Failure != "" {
+		return fmt.Errorf("test of kamelet %s failed", key)
+	}
+	return nil
+}
+
+// loadKamelet loads the Kamelet from the given file, if it exists, or looks it up by name in the Kamelet repositories
+func (command *kameletTestCommandOptions) loadKamelet(ref string) (*v1alpha1.Kamelet, string, error) {
+	if isLocalAndFileExists(ref) {
+		content, err := ioutil.ReadFile(ref)
+		if err != nil {
+			return nil, "", err
+		}
+		kamelet := v1alpha1.Kamelet{}
+		if err := yaml.Unmarshal(content, &kamelet); err != nil {
+			return nil, "", errors.Wrapf(err, "cannot parse kamelet file %s", ref)
+		}
+		if kamelet.Kind != v1alpha1.KameletKind || kamelet.Name == "" {
+			return nil, "", fmt.Errorf("file %s does not contain a named %s", ref, v1alpha1.KameletKind)
+		}
+		return &kamelet, kamelet.Name, nil
+	}
+
+	repo, err := command.newKameletRepository(command.RootCmdOptions)
+	if err != nil {
+		return nil, "", err
+	}
+	name, version := v1alpha1.ParseKameletReference(ref)
+	kamelet, err := repo.GetVersion(command.Context, name, version)
+	if err != nil {
+		return nil, "", err
+	}
+	if kamelet == nil {
+		return nil, "", fmt.Errorf("kamelet %s not found in any of the defined repositories: %s", ref, repo.String())
+	}
+	if version != "" {
+		// the harness references the version the Kamelet has been resolved to
+		return kamelet, kamelet.GetVersionedReference(), nil
+	}
+	return kamelet, ref, nil
+}
+
+// runTest runs the harness route around the given Kamelet and observes its output until the test completes
+func (command *kameletTestCommandOptions) runTest(cmd *cobra.Command, kamelet *v1alpha1.Kamelet, test *kameletTest) *kameletTestResult {
+	start := time.Now()
+	result := kameletTestResult{
+		Name: test.Key,
+	}
+
+	var echo io.Writer
+	if command.Verbose {
+		echo = cmd.ErrOrStderr()
+	}
+	ctx, cancel := context.WithCancel(command.Context)
+	defer cancel()
+	observer := newKameletTestObserver(test, echo, cancel)
+
+	err := command.runHarness(ctx, cmd, kamelet, test, observer)
+
+	result.Duration = time.Since(start)
+	result.Output = observer.output()
+	if err != nil && !observer.completed() {
+		result.Failure = err.Error()
+	} else {
+		result.Failure = observer.failure()
+	}
+	return &result
+}
+
+func (command *kameletTestCommandOptions) runHarness(ctx context.Context, cmd *cobra.Command, kamelet *v1alpha1.Kamelet, test *kameletTest, observer *kameletTestObserver) error {
+	l := localKamelets{}
+	for _, p := range command.Properties {
+		kv := strings.SplitN(p, "=", 2)
+		l.Properties = append(l.Properties, fmt.Sprintf("camel.kamelet.%s.%s.%s=%s", test.Key, kameletTestEndpointID, kv[0], kv[1]))
+	}
+	if err := l.addKameletDefinition(kamelet, test.Key, l.Properties); err != nil {
+		return err
+	}
+
+	content, err := test.route()
+	if err != nil {
+		return err
+	}
+	route := path.Join(util.GetLocalKameletsDir(), kameletTestRouteFile)
+	if err := ioutil.WriteFile(route, content, 0644); err != nil {
+		return err
+	}
+	routes := append([]string{route}, l.Routes...)
+
+	additionalDependencies := append(append([]string{}, command.AdditionalDependencies...), l.Dependencies...)
+	dependencies, err := getDependencies(routes, additionalDependencies, command.MavenRepositories, true)
+	if err != nil {
+		return err
+	}
+
+	propertyFile, err := l.writeProperties()
+	if err != nil {
+		return err
+	}
+	var propertyFiles []string
+	if propertyFile != "" {
+		propertyFiles = append(propertyFiles, propertyFile)
+	}
+
+	// The timeout applies from the time the Kamelet is started, after the dependencies are resolved
+	if command.Containerize {
+		err = createAndBuildIntegrationImage(ctx, "", false, command.Image, propertyFiles, dependencies, routes, cmd.OutOrStdout(), cmd.ErrOrStderr())
+		if err != nil {
+			return err
+		}
+		observer.start(command.Timeout)
+		return runIntegrationImage(ctx, command.Image, observer, observer)
+	}
+	observer.start(command.Timeout)
+	return RunLocalIntegrationRunCommand(ctx, propertyFiles, dependencies, routes, observer, observer)
+}
+
+func isKameletType(t string) bool {
+	switch v1alpha1.EndpointType(t) {
+	case v1alpha1.EndpointTypeSource, v1alpha1.EndpointTypeSink, v1alpha1.EndpointTypeAction:
+		return true
+	}
+	return false
+}
+
+// missingRequiredProperties returns the required properties of the Kamelet that have neither a sample value nor a default
+func missingRequiredProperties(kamelet *v1alpha1.Kamelet, properties []string) []string {
+	if kamelet.Spec.Definition == nil {
+		return nil
+	}
+	var missing []string
+	for _, name := range kamelet.Spec.Definition.Required {
+		if hasProperty(properties, name) {
+			continue
+		}
+		if prop, ok := kamelet.Spec.Definition.Properties[name]; ok && prop.Default != nil {
+			continue
+		}
+		missing = append(missing, name)
+	}
+	return missing
+}
+
+// kameletTest describes the harness generated around a Kamelet and the outputs expected from it
+type kameletTest struct {
+	Key          string
+	Type         v1alpha1.EndpointType
+	Inputs       []string
+	Expectations []string
+}
+
+func newKameletTest(kamelet *v1alpha1.Kamelet, key string, kameletType string, inputs []string, expectations []string) (*kameletTest, error) {
+	if kameletType == "" {
+		kameletType = kamelet.Labels[v1alpha1.KameletTypeLabel]
+	}
+	if !isKameletType(kameletType) {
+		return nil, fmt.Errorf("cannot determine the type of kamelet %s, set the %s label or use the --type flag", key, v1alpha1.KameletTypeLabel)
+	}
+	test := kameletTest{
+		Key:          key,
+		Type:         v1alpha1.EndpointType(kameletType),
+		Inputs:       inputs,
+		Expectations: expectations,
+	}
+	switch test.Type {
+	case v1alpha1.EndpointTypeSource:
+		if len(inputs) > 0 {
+			return nil, fmt.Errorf("source kamelet %s does not accept test inputs", key)
+		}
+	case v1alpha1.EndpointTypeSink:
+		if len(inputs) == 0 {
+			return nil, fmt.Errorf("sink kamelet %s requires at least one test input", key)
+		}
+		if len(expectations) > 0 {
+			return nil, fmt.Errorf("sink kamelet %s does not produce outputs to assert on", key)
+		}
+	case v1alpha1.EndpointTypeAction:
+		if len(inputs) == 0 {
+			return nil, fmt.Errorf("action kamelet %s requires at least one test input", key)
+		}
+	}
+	return &test, nil
+}
+
+// route generates the harness route: source Kamelets are consumed, while the inputs are sent to action and sink Kamelets.
+// The messages resulting from each exchange are logged, so that they can be matched against the expectations.
+func (t *kameletTest) route() ([]byte, error) {
+	uri := fmt.Sprintf("kamelet:%s/%s", t.Key, kameletTestEndpointID)
+	output := map[string]interface{}{
+		"log": kameletTestOutputMarker + "${body}",
+	}
+
+	var flows []map[string]interface{}
+	if t.Type == v1alpha1.EndpointTypeSource {
+		flows = append(flows, map[string]interface{}{
+			"from": map[string]interface{}{
+				"uri":   uri,
+				"steps": []interface{}{output},
+			},
+		})
+	}
+	if t.Type != v1alpha1.EndpointTypeSource {
+		for i, input := range t.Inputs {
+			flows = append(flows, map[string]interface{}{
+				"from": map[string]interface{}{
+					"uri": fmt.Sprintf("timer:kamelet-test-%d?repeatCount=1", i),
+					"steps": []interface{}{
+						map[string]interface{}{
+							"set-body": map[string]interface{}{
+								"constant": input,
+							},
+						},
+						map[string]interface{}{
+							"to": uri,
+						},
+						output,
+					},
+				},
+			})
+		}
+	}
+	return yaml.Marshal(flows)
+}
+
+// failure returns the reason why the test is failing given the observed outputs, or an empty string if the test passes
+func (t *kameletTest) failure(outputs []string) string {
+	if t.Type == v1alpha1.EndpointTypeSource && len(outputs) == 0 {
+		return "no message produced by the kamelet"
+	}
+	if t.Type != v1alpha1.EndpointTypeSource && len(outputs) < len(t.Inputs) {
+		return fmt.Sprintf("%d out of %d test inputs processed by the kamelet", len(outputs), len(t.Inputs))
+	}
+	for _, expected := range t.Expectations {
+		found := false
+		for _, output := range outputs {
+			if strings.Contains(output, expected) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Sprintf("expected output %q not produced by the kamelet", expected)
+		}
+	}
+	return ""
+}
+
+// kameletTestObserver collects the output of the harness route, and stops it once the test is complete
+type kameletTestObserver struct {
+	lock     sync.Mutex
+	test     *kameletTest
+	echo     io.Writer
+	stop     context.CancelFunc
+	buffer   bytes.Buffer
+	line     []byte
+	outputs  []string
+	errors   []string
+	done     bool
+	timedOut bool
+}
+
+func newKameletTestObserver(test *kameletTest, echo io.Writer, stop context.CancelFunc) *kameletTestObserver {
+	return &kameletTestObserver{
+		test: test,
+		echo: echo,
+		stop: stop,
+	}
+}
+
+// start stops the test after the given timeout, unless it completes earlier
+func (o *kameletTestObserver) start(timeout time.Duration) {
+	time.AfterFunc(timeout, func() {
+		o.lock.Lock()
+		defer o.lock.Unlock()
+		if !o.done {
+			o.timedOut = true
+			o.done = true
+			o.stop()
+		}
+	})
+}
+
+func (o *kameletTestObserver) Write(p []byte) (int, error) {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+
+	o.buffer.Write(p)
+	if o.echo != nil {
+		if _, err := o.echo.Write(p); err != nil {
+			return 0, err
+		}
+	}
+
+	o.line = append(o.line, p...)
+	for {
+		i := bytes.IndexByte(o.line, '\n')
+		if i < 0 {
+			break
+		}
+		o.observe(string(o.line[:i]))
+		o.line = o.line[i+1:]
+	}
+	return len(p), nil
+}
+
+func (o *kameletTestObserver) observe(line string) {
+	if o.done {
+		return
+	}
+	if i := strings.Index(line, kameletTestOutputMarker); i >= 0 {
+		o.outputs = append(o.outputs, strings.TrimRight(line[i+len(kameletTestOutputMarker):], "\r"))
+	} else if strings.Contains(line, kameletTestFailureMarker) {
+		o.errors = append(o.errors, strings.TrimSpace(line))
+	}
+	if len(o.errors) > 0 || o.test.failure(o.outputs) == "" {
+		o.done = true
+		o.stop()
+	}
+}
+
+func (o *kameletTestObserver) completed() bool {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+	return o.done
+}
+
+func (o *kameletTestObserver) output() string {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+	return o.buffer.String()
+}
+
+// failure returns the reason why the test failed, or an empty string if it passed
+func (o *kameletTestObserver) failure() string {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+	if len(o.errors) > 0 {
+		return "the kamelet failed to process a message: " + o.errors[0]
+	}
+	if !o.done {
+		return "the kamelet terminated before the test completed"
+	}
+	if failure := o.test.failure(o.outputs); failure != "" {
+		if o.timedOut {
+			return "timed out: " + failure
+		}
+		return failure
+	}
+	return ""
+}
+
+// kameletTestResult contains the outcome of a Kamelet test
+type kameletTestResult struct {
+	Name     string
+	Duration time.Duration
+	Failure  string
+	Output   string
+}
+
+type junitTestSuites struct {
+	XMLName xml.Name         `xml:"testsuites"`
+	Suites  []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name      string          `xml:"name,attr"`
+	Tests     int             `xml:"tests,attr"`
+	Failures  int             `xml:"failures,attr"`
+	Time      string          `xml:"time,attr"`
+	TestCases []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	ClassName string        `xml:"classname,attr"`
+	Name      string        `xml:"name,attr"`
+	Time      string        `xml:"time,attr"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+	SystemOut string        `xml:"system-out,omitempty"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr"`
+}
+
+// writeJUnitReport writes the result of the test to the given file, in JUnit XML format
+func writeJUnitReport(fileName string, result *kameletTestResult) error {
+	seconds := fmt.Sprintf("%.3f", result.Duration.Seconds())
+	testCase := junitTestCase{
+		ClassName: "kamelet",
+		Name:      result.Name,
+		Time:      seconds,
+		SystemOut: result.Output,
+	}
+	suite := junitTestSuite{
+		Name:  "kamelet-test",
+		Tests: 1,
+		Time:  seconds,
+	}
+	if result.Failure != "" {
+		testCase.Failure = &junitFailure{
+			Message: result.Failure,
+			Type:    "KameletTestFailure",
+		}
+		suite.Failures = 1
+	}
+	suite.TestCases = append(suite.TestCases, testCase)
+
+	data, err := xml.MarshalIndent(junitTestSuites{Suites: []junitTestSuite{suite}}, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(fileName, append([]byte(xml.Header), append(data, '\n')...), 0644)
+}
@@ -0,0 +1,179 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/apache/camel-k/pkg/apis/camel/v1alpha1"
+	"github.com/apache/camel-k/pkg/util/test"
+	"github.com/stretchr/testify/assert"
+)
+
+const testedKamelet = `apiVersion: camel.apache.org/v1alpha1
+kind: Kamelet
+metadata:
+  name: echo-action
+  labels:
+    camel.apache.org/kamelet.type: action
+spec:
+  definition:
+    required:
+    - prefix
+    properties:
+      prefix:
+        type: string
+  flow:
+    from:
+      uri: kamelet:source
+      steps:
+      - set-body:
+          simple: "{{prefix}}${body}"
+`
+
+func TestKameletTestSourceRoute(t *testing.T) {
+	kamelet := v1alpha1.Kamelet{}
+	kamelet.Name = "timer-source"
+	kamelet.Labels = map[string]string{v1alpha1.KameletTypeLabel: "source"}
+
+	kt, err := newKameletTest(&kamelet, "timer-source", "", nil, []string{"hello"})
+	assert.Nil(t, err)
+	route, err := kt.route()
+	assert.Nil(t, err)
+	assert.Equal(t, `- from:
+    steps:
+    - log: 'kamelet-test-output: ${body}'
+    uri: kamelet:timer-source/test
+`, string(route))
+
+	_, err = newKameletTest(&kamelet, "timer-source", "", []string{"input"}, nil)
+	assert.NotNil(t, err)
+}
+
+func TestKameletTestActionRoute(t *testing.T) {
+	kamelet := v1alpha1.Kamelet{}
+	kamelet.Name = "echo-action"
+
+	_, err := newKameletTest(&kamelet, "echo-action", "", []string{"hello"}, nil)
+	assert.NotNil(t, err)
+	_, err = newKameletTest(&kamelet, "echo-action", "action", nil, nil)
+	assert.NotNil(t, err)
+
+	kt, err := newKameletTest(&kamelet, "echo-action", "action", []string{"hello"}, nil)
+	assert.Nil(t, err)
+	route, err := kt.route()
+	assert.Nil(t, err)
+	assert.Equal(t, `- from:
+    steps:
+    - set-body:
+        constant: hello
+    - to: kamelet:echo-action/test
+    - log: 'kamelet-test-output: ${body}'
+    uri: timer:kamelet-test-0?repeatCount=1
+`, string(route))
+}
+
+func TestKameletTestObserver(t *testing.T) {
+	kt := kameletTest{
+		Key:          "echo-action",
+		Type:         v1alpha1.EndpointTypeAction,
+		Inputs:       []string{"a", "b"},
+		Expectations: []string{"> b"},
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	observer := newKameletTestObserver(&kt, nil, cancel)
+
+	_, err := observer.Write([]byte("INFO [route1] kamelet-test-output: > a\nINFO [route2] kamelet-"))
+	assert.Nil(t, err)
+	assert.False(t, observer.completed())
+	assert.Nil(t, ctx.Err())
+
+	_, err = observer.Write([]byte("test-output: > b\n"))
+	assert.Nil(t, err)
+	assert.True(t, observer.completed())
+	assert.NotNil(t, ctx.Err())
+	assert.Equal(t, "", observer.failure())
+}
+
+func TestKameletTestObserverFailures(t *testing.T) {
+	kt := kameletTest{
+		Key:    "log-sink",
+		Type:   v1alpha1.EndpointTypeSink,
+		Inputs: []string{"a"},
+	}
+	observer := newKameletTestObserver(&kt, nil, func() {})
+	_, err := observer.Write([]byte("ERROR Failed delivery for (MessageId: 123)\n"))
+	assert.Nil(t, err)
+	assert.True(t, observer.completed())
+	assert.Equal(t, "the kamelet failed to process a message: ERROR Failed delivery for (MessageId: 123)", observer.failure())
+
+	observer = newKameletTestObserver(&kt, nil, func() {})
+	observer.start(time.Millisecond)
+	assert.Eventually(t, observer.completed, time.Second, time.Millisecond)
+	assert.Equal(t, "timed out: 0 out of 1 test inputs processed by the kamelet", observer.failure())
+}
+
+func TestKameletTestMissingProperties(t *testing.T) {
+	dir, err := ioutil.TempDir("", "camel-k-test-")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	file := path.Join(dir, "echo-action.kamelet.yaml")
+	assert.Nil(t, ioutil.WriteFile(file, []byte(testedKamelet), 0644))
+
+	rootCmd := initializeKameletCmd(t)
+	_, err = test.ExecuteCommand(rootCmd, cmdKamelet, "test", file, "--input", "hello")
+	assert.NotNil(t, err)
+	assert.Equal(t, "missing sample values for the required properties of kamelet echo-action: prefix", err.Error())
+
+	rootCmd = initializeKameletCmd(t)
+	_, err = test.ExecuteCommand(rootCmd, cmdKamelet, "test", file, "-p", "prefix=>")
+	assert.NotNil(t, err)
+	assert.Equal(t, "action kamelet echo-action requires at least one test input", err.Error())
+}
+
+func TestWriteJUnitReport(t *testing.T) {
+	dir, err := ioutil.TempDir("", "camel-k-test-")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	file := path.Join(dir, "report.xml")
+
+	err = writeJUnitReport(file, &kameletTestResult{
+		Name:     "timer-source",
+		Duration: 1500 * time.Millisecond,
+		Failure:  "no message produced by the kamelet",
+		Output:   "starting",
+	})
+	assert.Nil(t, err)
+	data, err := ioutil.ReadFile(file)
+	assert.Nil(t, err)
+	assert.Equal(t, `<?xml version="1.0" encoding="UTF-8"?>
+<testsuites>
+  <testsuite name="kamelet-test" tests="1" failures="1" time="1.500">
+    <testcase classname="kamelet" name="timer-source" time="1.500">
+      <failure message="no message produced by the kamelet" type="KameletTestFailure"></failure>
+      <system-out>starting</system-out>
+    </testcase>
+  </testsuite>
+</testsuites>
+`, string(data))
+}
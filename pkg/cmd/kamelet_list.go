@@ -0,0 +1,114 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/apache/camel-k/pkg/apis/camel/v1alpha1"
+	"github.com/apache/camel-k/pkg/kamelet/repository"
+)
+
+func newKameletListCmd(rootCmdOptions *RootCmdOptions) (*cobra.Command, *kameletListCommandOptions) {
+	options := kameletListCommandOptions{
+		RootCmdOptions: rootCmdOptions,
+	}
+
+	cmd := cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Short:   "List the available Kamelets",
+		Long: `List the Kamelets available in all the configured repositories, along with the repository they come from.
+Use --deprecation to show whether they are deprecated, which requires fetching each Kamelet from its repository.`,
+		PreRunE: decode(&options),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return options.run(cmd)
+		},
+	}
+
+	addKameletRepositoryFlags(&cmd)
+	cmd.Flags().Bool("deprecation", false, "Show whether the Kamelets are deprecated, fetching each of them from its repository")
+
+	return &cmd, &options
+}
+
+type kameletListCommandOptions struct {
+	*RootCmdOptions
+	kameletRepositoryOptions `mapstructure:",squash"`
+	Deprecation              bool `mapstructure:"deprecation"`
+}
+
+func (command *kameletListCommandOptions) run(cmd *cobra.Command) error {
+	repo, err := command.newKameletRepository(command.RootCmdOptions)
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 8, 1, '\t', 0)
+	if command.Deprecation {
+		fmt.Fprintln(w, "NAME\tREPOSITORY\tDEPRECATED")
+	} else {
+		fmt.Fprintln(w, "NAME\tREPOSITORY")
+	}
+	// Kamelets in the first repositories shadow the ones with the same name in the following ones
+	found := make(map[string]bool)
+	for _, r := range repository.Unwrap(repo) {
+		names, err := r.List(command.Context)
+		if err != nil {
+			return err
+		}
+		for _, name := range names {
+			if found[name] {
+				continue
+			}
+			found[name] = true
+			if !command.Deprecation {
+				fmt.Fprintf(w, "%s\t%s\n", name, r.String())
+				continue
+			}
+			kamelet, err := r.Get(command.Context, name)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(w, "%s\t%s\t%s\n", name, r.String(), deprecationSummary(kamelet))
+		}
+	}
+	return w.Flush()
+}
+
+// deprecationSummary returns a short description of the deprecation of the Kamelet, or an empty string if it's not deprecated
+func deprecationSummary(kamelet *v1alpha1.Kamelet) string {
+	if kamelet == nil || !kamelet.IsDeprecated() {
+		return ""
+	}
+	details := make([]string, 0, 2)
+	if kamelet.Spec.ReplacedBy != "" {
+		details = append(details, "replaced by "+kamelet.Spec.ReplacedBy)
+	}
+	if kamelet.Spec.RemovalVersion != "" {
+		details = append(details, "removal in "+kamelet.Spec.RemovalVersion)
+	}
+	if len(details) == 0 {
+		return "yes"
+	}
+	return fmt.Sprintf("yes (%s)", strings.Join(details, ", "))
+}
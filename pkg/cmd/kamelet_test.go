@@ -0,0 +1,161 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"encoding/json"
+	"testing"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/apis/camel/v1alpha1"
+	"github.com/apache/camel-k/pkg/util/test"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const cmdKamelet = "kamelet"
+
+func initializeKameletCmd(t *testing.T, initObjs ...runtime.Object) *cobra.Command {
+	options, rootCmd := kamelTestPreAddCommandInit()
+	// Use a platform with no remote repositories to avoid looking up the default one
+	platform := v1.NewIntegrationPlatform("default", "camel-k")
+	platform.Spec.Kamelet.Repositories = []v1.IntegrationPlatformKameletRepositorySpec{{URI: "none"}}
+	fakeClient, err := test.NewFakeClient(append(initObjs, &platform)...)
+	assert.Nil(t, err)
+	options._client = fakeClient
+	options.Namespace = "default"
+
+	kameletCmd := newCmdKamelet(options)
+	kameletCmd.PersistentPreRunE = func(c *cobra.Command, args []string) error {
+		return nil
+	}
+	rootCmd.AddCommand(kameletCmd)
+	kamelTestPostAddCommandInit(t, rootCmd)
+
+	return rootCmd
+}
+
+func describedKamelet() *v1alpha1.Kamelet {
+	defaultPeriod, _ := json.Marshal(1000)
+	return &v1alpha1.Kamelet{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "timer-source",
+		},
+		Spec: v1alpha1.KameletSpec{
+			Definition: &v1alpha1.JSONSchemaProps{
+				Title:    "Timer Source",
+				Required: []string{"message"},
+				Properties: map[string]v1alpha1.JSONSchemaProp{
+					"message": {
+						Type:         "string",
+						Description:  "The message to generate",
+						XDescriptors: []string{"urn:alm:descriptor:com.tectonic.ui:text"},
+					},
+					"period": {
+						Type:    "integer",
+						Default: &v1alpha1.JSON{RawMessage: defaultPeriod},
+					},
+				},
+			},
+			Types: map[v1alpha1.EventSlot]v1alpha1.EventTypeSpec{
+				v1alpha1.EventSlotOut: {MediaType: "text/plain"},
+			},
+			Dependencies: []string{"camel:timer"},
+		},
+	}
+}
+
+func TestKameletList(t *testing.T) {
+	rootCmd := initializeKameletCmd(t, describedKamelet())
+	output, err := test.ExecuteCommand(rootCmd, cmdKamelet, "list")
+	assert.Nil(t, err)
+	assert.Contains(t, output, "NAME")
+	assert.Regexp(t, `timer-source\s+Kubernetes\[namespace=default\]`, output)
+}
+
+func TestKameletGet(t *testing.T) {
+	rootCmd := initializeKameletCmd(t, describedKamelet())
+	output, err := test.ExecuteCommand(rootCmd, cmdKamelet, "get", "timer-source")
+	assert.Nil(t, err)
+	assert.Contains(t, output, "kind: Kamelet")
+	assert.Contains(t, output, "name: timer-source")
+
+	_, err = test.ExecuteCommand(rootCmd, cmdKamelet, "get", "missing")
+	assert.NotNil(t, err)
+}
+
+func TestKameletDescribe(t *testing.T) {
+	rootCmd := initializeKameletCmd(t, describedKamelet())
+	output, err := test.ExecuteCommand(rootCmd, cmdKamelet, "describe", "timer-source")
+	assert.Nil(t, err)
+	assert.Contains(t, output, "Repository:")
+	assert.Contains(t, output, "Kubernetes[namespace=default]")
+	assert.Contains(t, output, "Title:")
+	assert.Regexp(t, `message:\s+Description:\s+The message to generate\s+Type:\s+string\s+Required:\s+true\s+X-Descriptors:\s+urn:alm:descriptor:com.tectonic.ui:text`, output)
+	assert.Regexp(t, `period:\s+Type:\s+integer\s+Required:\s+false\s+Default:\s+1000`, output)
+	assert.Regexp(t, `Types:\s+out:\s+Media Type:\s+text/plain`, output)
+	assert.Regexp(t, `Dependencies:\s+camel:timer`, output)
+}
+
+func TestKameletDescribeVersion(t *testing.T) {
+	kamelet := describedKamelet()
+	kamelet.Labels = map[string]string{v1alpha1.KameletVersionLabel: "2.0.0"}
+	kamelet.Spec.Versions = []v1alpha1.KameletVersionSpec{
+		{
+			Version:      "1.0.0",
+			Dependencies: []string{"camel:timer", "camel:log"},
+		},
+	}
+	rootCmd := initializeKameletCmd(t, kamelet)
+	output, err := test.ExecuteCommand(rootCmd, cmdKamelet, "describe", "timer-source")
+	assert.Nil(t, err)
+	assert.Regexp(t, `Version:\s+2.0.0`, output)
+	assert.Regexp(t, `Versions:\s+2.0.0, 1.0.0`, output)
+
+	output, err = test.ExecuteCommand(rootCmd, cmdKamelet, "describe", "timer-source@1.x")
+	assert.Nil(t, err)
+	assert.Regexp(t, `Version:\s+1.0.0`, output)
+	assert.Regexp(t, `Dependencies:\s+camel:timer\s+camel:log`, output)
+
+	_, err = test.ExecuteCommand(rootCmd, cmdKamelet, "describe", "timer-source@3.x")
+	assert.NotNil(t, err)
+}
+
+func TestKameletListDeprecated(t *testing.T) {
+	kamelet := describedKamelet()
+	kamelet.Spec.Deprecated = true
+	kamelet.Spec.ReplacedBy = "cron-source"
+	kamelet.Spec.RemovalVersion = "2.0.0"
+	rootCmd := initializeKameletCmd(t, kamelet)
+	output, err := test.ExecuteCommand(rootCmd, cmdKamelet, "list")
+	assert.Nil(t, err)
+	assert.NotContains(t, output, "DEPRECATED")
+	assert.Regexp(t, `timer-source\s+Kubernetes\[namespace=default\]`, output)
+
+	output, err = test.ExecuteCommand(rootCmd, cmdKamelet, "list", "--deprecation")
+	assert.Nil(t, err)
+	assert.Contains(t, output, "DEPRECATED")
+	assert.Regexp(t, `timer-source\s+Kubernetes\[namespace=default\]\s+yes \(replaced by cron-source, removal in 2.0.0\)`, output)
+
+	output, err = test.ExecuteCommand(rootCmd, cmdKamelet, "describe", "timer-source")
+	assert.Nil(t, err)
+	assert.Regexp(t, `Deprecated:\s+yes \(replaced by cron-source, removal in 2.0.0\)`, output)
+}
@@ -0,0 +1,81 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/apache/camel-k/pkg/util/kubernetes"
+)
+
+func newKitLogsCmd(rootCmdOptions *RootCmdOptions) (*cobra.Command, *kitLogsCommandOptions) {
+	options := kitLogsCommandOptions{
+		RootCmdOptions: rootCmdOptions,
+	}
+
+	cmd := cobra.Command{
+		Use:     "logs kit",
+		Short:   "Print the build logs of an Integration Kit",
+		Long:    `Print the build logs of an Integration Kit, optionally following them until the build completes.`,
+		Aliases: []string{"log"},
+		PreRunE: decode(&options),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return errors.New("logs expects a kit name argument")
+			}
+			return options.run(cmd, args[0])
+		},
+	}
+
+	cmd.Flags().BoolP("follow", "f", false, "Follow the logs until the build completes")
+
+	return &cmd, &options
+}
+
+type kitLogsCommandOptions struct {
+	*RootCmdOptions
+	Follow bool `mapstructure:"follow"`
+}
+
+func (command *kitLogsCommandOptions) run(cmd *cobra.Command, name string) error {
+	c, err := command.GetCmdClient()
+	if err != nil {
+		return err
+	}
+
+	kit, err := kubernetes.GetIntegrationKit(command.Context, c, name, command.Namespace)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return fmt.Errorf("no integration kit found with name %q", name)
+		}
+		return err
+	}
+
+	// The build of a kit is named after the kit
+	build, err := getBuild(command.Context, c, kit.Namespace, kit.Name)
+	if err != nil {
+		return err
+	}
+
+	return printBuildLog(command.Context, c, build, command.Follow, cmd.OutOrStdout())
+}
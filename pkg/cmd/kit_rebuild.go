@@ -0,0 +1,135 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/client"
+)
+
+func newKitRebuildCmd(rootCmdOptions *RootCmdOptions) (*cobra.Command, *kitRebuildCommandOptions) {
+	options := kitRebuildCommandOptions{
+		RootCmdOptions: rootCmdOptions,
+	}
+
+	cmd := cobra.Command{
+		Use:     "rebuild [kit]...",
+		Short:   "Rebuild Integration Kits",
+		Long:    `Clear the state of one or more Integration Kits, and delete their builds, causing a rebuild.`,
+		PreRunE: decode(&options),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := options.validate(args); err != nil {
+				return err
+			}
+			return options.run(cmd, args)
+		},
+	}
+
+	cmd.Flags().Bool("all", false, "Rebuild all the Integration Kits")
+
+	return &cmd, &options
+}
+
+type kitRebuildCommandOptions struct {
+	*RootCmdOptions
+	All bool `mapstructure:"all"`
+}
+
+func (command *kitRebuildCommandOptions) validate(args []string) error {
+	if command.All && len(args) > 0 {
+		return errors.New("invalid combination: both all flag and named Kits are set")
+	}
+	if !command.All && len(args) == 0 {
+		return errors.New("invalid combination: neither all flag nor named Kits are set")
+	}
+
+	return nil
+}
+
+func (command *kitRebuildCommandOptions) run(cmd *cobra.Command, args []string) error {
+	c, err := command.GetCmdClient()
+	if err != nil {
+		return err
+	}
+
+	kits := make([]v1.IntegrationKit, 0)
+	if command.All {
+		list := v1.NewIntegrationKitList()
+		if err := c.List(command.Context, &list, k8sclient.InNamespace(command.Namespace)); err != nil {
+			return err
+		}
+		for _, kit := range list.Items {
+			// External kits are not built
+			if kit.Labels["camel.apache.org/kit.type"] != v1.IntegrationKitTypeExternal {
+				kits = append(kits, kit)
+			}
+		}
+	} else {
+		for _, name := range args {
+			kit := v1.NewIntegrationKit(command.Namespace, name)
+			key := k8sclient.ObjectKey{
+				Namespace: command.Namespace,
+				Name:      name,
+			}
+			if err := c.Get(command.Context, key, &kit); err != nil {
+				if k8serrors.IsNotFound(err) {
+					return fmt.Errorf("no integration kit found with name %q", name)
+				}
+				return err
+			}
+			if kit.Labels["camel.apache.org/kit.type"] == v1.IntegrationKitTypeExternal {
+				return fmt.Errorf("integration kit %q is external and cannot be rebuilt", name)
+			}
+			kits = append(kits, kit)
+		}
+	}
+
+	for _, kit := range kits {
+		if err := command.rebuild(c, kit); err != nil {
+			return err
+		}
+		fmt.Fprintf(cmd.OutOrStdout(), "integration kit %q rebuilding\n", kit.Name)
+	}
+
+	return nil
+}
+
+func (command *kitRebuildCommandOptions) rebuild(c client.Client, kit v1.IntegrationKit) error {
+	// Delete the previous build first, so that it's not picked up again once the kit is reset
+	build := v1.Build{}
+	build.Namespace = kit.Namespace
+	build.Name = kit.Name
+	if err := c.Delete(command.Context, &build); err != nil && !k8serrors.IsNotFound(err) {
+		return errors.Wrapf(err, "cannot delete the build of integration kit %q", kit.Name)
+	}
+
+	kit.Status = v1.IntegrationKitStatus{}
+	if err := c.Status().Update(command.Context, &kit); err != nil {
+		return errors.Wrapf(err, "cannot rebuild integration kit %q", kit.Name)
+	}
+
+	return nil
+}
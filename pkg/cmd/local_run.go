@@ -20,6 +20,7 @@ package cmd
 import (
 	"fmt"
 
+	"github.com/apache/camel-k/pkg/kamelet/repository"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 )
@@ -30,9 +31,10 @@ func newCmdLocalRun(rootCmdOptions *RootCmdOptions) (*cobra.Command, *localRunCm
 	}
 
 	cmd := cobra.Command{
-		Use:     "run [integration files]",
-		Short:   "Run integration locally.",
-		Long:    `Run integration locally using the input integration files.`,
+		Use:   "run [integration files]",
+		Short: "Run integration locally.",
+		Long: `Run integration locally using the input integration files.
+KameletBinding files are translated into routes, and the Kamelets referenced by the integration are looked up in the Kamelet repositories.`,
 		PreRunE: decode(&options),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if err := options.validate(args); err != nil {
@@ -63,6 +65,7 @@ func newCmdLocalRun(rootCmdOptions *RootCmdOptions) (*cobra.Command, *localRunCm
 	cmd.Flags().StringArrayP("property", "p", nil, "Add a Camel property to the integration.")
 	cmd.Flags().StringArrayP("dependency", "d", nil, additionalDependencyUsageMessage)
 	cmd.Flags().StringArray("maven-repository", nil, "Use a maven repository")
+	cmd.Flags().StringArray("kamelet-repository", nil, "A Kamelet repository URI used to look up the Kamelets referenced by the integration, e.g. \"file:/path/to/kamelets\". Defaults to "+repository.DefaultRemoteRepository)
 
 	return &cmd, &options
 }
@@ -77,6 +80,7 @@ type localRunCmdOptions struct {
 	Properties             []string `mapstructure:"properties"`
 	AdditionalDependencies []string `mapstructure:"dependencies"`
 	MavenRepositories      []string `mapstructure:"maven-repositories"`
+	KameletRepositories    []string `mapstructure:"kamelet-repositories"`
 }
 
 func (command *localRunCmdOptions) validate(args []string) error {
@@ -140,8 +144,16 @@ func (command *localRunCmdOptions) run(cmd *cobra.Command, args []string) error
 		return nil
 	}
 
+	// Translate KameletBindings into routes and materialize the Kamelets used by the routes.
+	kamelets, err := resolveLocalKamelets(command.Context, command.KameletRepositories, args, command.Properties, cmd.ErrOrStderr())
+	if err != nil {
+		return err
+	}
+	routes := kamelets.Routes
+
 	// Fetch dependencies.
-	dependencies, err := getDependencies(args, command.AdditionalDependencies, command.MavenRepositories, true)
+	additionalDependencies := append(append([]string{}, command.AdditionalDependencies...), kamelets.Dependencies...)
+	dependencies, err := getDependencies(routes, additionalDependencies, command.MavenRepositories, true)
 	if err != nil {
 		return err
 	}
@@ -152,10 +164,19 @@ func (command *localRunCmdOptions) run(cmd *cobra.Command, args []string) error
 		return err
 	}
 
+	// Add the properties required by the Kamelets.
+	kameletPropertyFile, err := kamelets.writeProperties()
+	if err != nil {
+		return err
+	}
+	if kameletPropertyFile != "" {
+		propertyFiles = append(propertyFiles, kameletPropertyFile)
+	}
+
 	// If this is a containerized local run, create, build and run the container image.
 	if command.Containerize {
 		// Create and build integration image.
-		err = createAndBuildIntegrationImage(command.Context, "", false, command.Image, propertyFiles, dependencies, args, cmd.OutOrStdout(), cmd.ErrOrStderr())
+		err = createAndBuildIntegrationImage(command.Context, "", false, command.Image, propertyFiles, dependencies, routes, cmd.OutOrStdout(), cmd.ErrOrStderr())
 		if err != nil {
 			return err
 		}
@@ -167,7 +188,7 @@ func (command *localRunCmdOptions) run(cmd *cobra.Command, args []string) error
 		}
 	} else {
 		// Run integration locally.
-		err = RunLocalIntegrationRunCommand(command.Context, propertyFiles, dependencies, args, cmd.OutOrStdout(), cmd.ErrOrStderr())
+		err = RunLocalIntegrationRunCommand(command.Context, propertyFiles, dependencies, routes, cmd.OutOrStdout(), cmd.ErrOrStderr())
 		if err != nil {
 			return err
 		}
@@ -18,10 +18,18 @@ limitations under the License.
 package cmd
 
 import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"os"
+	"path"
 	"testing"
 
+	"github.com/apache/camel-k/pkg/util"
 	"github.com/apache/camel-k/pkg/util/test"
 	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func addTestLocalRunCmd(rootCmdOptions *RootCmdOptions, rootCmd *cobra.Command) *localRunCmdOptions {
@@ -96,3 +104,134 @@ func TestLocalRunAdditionalDependenciesFlag(t *testing.T) {
 		t.Fatalf("Additional dependencies expected to be: \n %v\nGot:\n %v\n", "[mvn:camel-component-1, mvn:camel-component-2]", localRunCmdOptions.AdditionalDependencies)
 	}
 }
+
+func TestLocalRunKameletRepositoryFlag(t *testing.T) {
+	options, rootCmd := kamelTestPreAddCommandInit()
+
+	localRunCmdOptions := addTestLocalRunCmd(options, rootCmd)
+
+	kamelTestPostAddCommandInit(t, rootCmd)
+
+	_, err := test.ExecuteCommand(rootCmd, "local", "run", "binding.yaml", "--kamelet-repository", "file:/kamelets")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"file:/kamelets"}, localRunCmdOptions.KameletRepositories)
+}
+
+const testLocalKamelet = `apiVersion: camel.apache.org/v1alpha1
+kind: Kamelet
+metadata:
+  name: greeting-source
+spec:
+  definition:
+    properties:
+      message:
+        type: string
+        default: hello
+      period:
+        type: integer
+        default: 1000
+      token:
+        type: string
+        format: password
+  dependencies:
+  - camel:timer
+  flow:
+    from:
+      uri: timer:tick
+      parameters:
+        period: "{{period}}"
+      steps:
+      - set-body:
+          constant: "{{message}}"
+      - to: kamelet:sink
+`
+
+const testLocalBinding = `apiVersion: camel.apache.org/v1alpha1
+kind: KameletBinding
+metadata:
+  name: greeting
+spec:
+  integration:
+    configuration:
+    - type: property
+      value: camel.component.log.level=INFO
+  source:
+    ref:
+      apiVersion: camel.apache.org/v1alpha1
+      kind: Kamelet
+      name: greeting-source
+    properties:
+      message: hi
+      token: s3cr3t
+  sink:
+    uri: log:info
+`
+
+func TestLocalRunKameletBinding(t *testing.T) {
+	dir, err := ioutil.TempDir("", "camel-k-local-run-test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	kameletsDir := path.Join(dir, "repo")
+	require.NoError(t, os.Mkdir(kameletsDir, 0755))
+	require.NoError(t, ioutil.WriteFile(path.Join(kameletsDir, "greeting-source.kamelet.yaml"), []byte(testLocalKamelet), 0644))
+	binding := path.Join(dir, "binding.yaml")
+	require.NoError(t, ioutil.WriteFile(binding, []byte(testLocalBinding), 0644))
+
+	util.MavenWorkingDirectory = path.Join(dir, "maven")
+	defer func() {
+		util.MavenWorkingDirectory = ""
+	}()
+
+	stderr := bytes.Buffer{}
+	kamelets, err := resolveLocalKamelets(context.TODO(), []string{"file:" + kameletsDir}, []string{binding}, []string{"camel.kamelet.greeting-source.period=5000"}, &stderr)
+	require.NoError(t, err)
+	assert.Empty(t, stderr.String())
+
+	assert.Equal(t, []string{
+		path.Join(util.GetLocalKameletsDir(), "greeting.yaml"),
+		path.Join(util.GetLocalKameletsDir(), "greeting-source.kamelet.yaml"),
+	}, kamelets.Routes)
+	route, err := ioutil.ReadFile(kamelets.Routes[0])
+	require.NoError(t, err)
+	assert.Contains(t, string(route), "kamelet:greeting-source?message=hi")
+	assert.NotContains(t, string(route), "s3cr3t")
+
+	assert.Equal(t, []string{
+		"camel.kamelet.greeting-source.token=s3cr3t",
+		"camel.component.log.level=INFO",
+		"camel.kamelet.greeting-source.message=hello",
+	}, kamelets.Properties)
+	assert.Equal(t, []string{"camel:timer"}, kamelets.Dependencies)
+
+	assert.Equal(t, []string{
+		"file:" + kamelets.Routes[0] + "?language=yaml",
+		"file:" + kamelets.Routes[1] + "?language=yaml&type=template&name=greeting-source",
+	}, formatRoutes(kamelets.Routes))
+}
+
+func TestLocalRunKameletWithInvalidSourceName(t *testing.T) {
+	dir, err := ioutil.TempDir("", "camel-k-local-run-test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	kameletsDir := path.Join(dir, "repo")
+	require.NoError(t, os.Mkdir(kameletsDir, 0755))
+	kamelet := testLocalKamelet + `  sources:
+  - name: ../../.bashrc
+    content: echo pwned
+`
+	require.NoError(t, ioutil.WriteFile(path.Join(kameletsDir, "greeting-source.kamelet.yaml"), []byte(kamelet), 0644))
+	binding := path.Join(dir, "binding.yaml")
+	require.NoError(t, ioutil.WriteFile(binding, []byte(testLocalBinding), 0644))
+
+	util.MavenWorkingDirectory = path.Join(dir, "maven")
+	defer func() {
+		util.MavenWorkingDirectory = ""
+	}()
+
+	stderr := bytes.Buffer{}
+	_, err = resolveLocalKamelets(context.TODO(), []string{"file:" + kameletsDir}, []string{binding}, nil, &stderr)
+	assert.EqualError(t, err, `invalid name "../../.bashrc" for source of kamelet greeting-source`)
+	assert.NoFileExists(t, path.Join(dir, ".bashrc"))
+}
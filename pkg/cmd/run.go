@@ -42,6 +42,7 @@ import (
 	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
 
 	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/apis/camel/v1alpha1"
 	"github.com/apache/camel-k/pkg/client"
 	"github.com/apache/camel-k/pkg/trait"
 	"github.com/apache/camel-k/pkg/util"
@@ -64,7 +65,7 @@ func newCmdRun(rootCmdOptions *RootCmdOptions) (*cobra.Command, *runCmdOptions)
 	cmd := cobra.Command{
 		Use:      "run [file to run]",
 		Short:    "Run a integration on Kubernetes",
-		Long:     `Deploys and execute a integration pod on Kubernetes.`,
+		Long:     `Deploys and execute a integration pod on Kubernetes. A KameletBinding manifest can be given in place of the integration sources.`,
 		Args:     options.validateArgs,
 		PreRunE:  options.decode,
 		RunE:     options.run,
@@ -250,7 +251,12 @@ func (o *runCmdOptions) run(cmd *cobra.Command, args []string) error {
 		}
 	}
 
-	integration, err := o.createIntegration(c, args, catalog)
+	binding, err := o.resolveKameletBinding(args)
+	if err != nil {
+		return err
+	}
+
+	integration, err := o.createIntegration(c, args, binding, catalog)
 	if err != nil {
 		return err
 	}
@@ -264,8 +270,14 @@ func (o *runCmdOptions) run(cmd *cobra.Command, args []string) error {
 				// Context canceled
 				return
 			}
-			fmt.Printf("Run integration terminating\n")
-			err := DeleteIntegration(o.Context, c, integration.Name, integration.Namespace)
+			var err error
+			if binding != nil {
+				fmt.Printf("Run kamelet binding terminating\n")
+				err = DeleteKameletBinding(o.Context, c, integration.Name, integration.Namespace)
+			} else {
+				fmt.Printf("Run integration terminating\n")
+				err = DeleteIntegration(o.Context, c, integration.Name, integration.Namespace)
+			}
 			if err != nil {
 				fmt.Println(err)
 				os.Exit(1)
@@ -288,6 +300,14 @@ func (o *runCmdOptions) run(cmd *cobra.Command, args []string) error {
 		})
 	}
 	if o.Wait || o.Dev {
+		// Report the progress of the integration kit build
+		selector := fmt.Sprintf("%s=%s,%s=%s",
+			kubernetes.CamelCreatorLabelKind, v1.IntegrationKind, kubernetes.CamelCreatorLabelName, integration.Name)
+		// nolint: errcheck
+		go watch.HandleBuildStepChanges(o.Context, integration.Namespace, selector, func(build *v1.Build, step *v1.BuildStepStatus) bool {
+			fmt.Fprintf(cmd.OutOrStdout(), "Progress: build %q step %s\n", build.Name, strings.ReplaceAll(formatBuildStep(*step), "\t", " "))
+			return true
+		})
 		for {
 			integrationPhase, err := o.waitForIntegrationReady(cmd, integration)
 			if err != nil {
@@ -402,7 +422,12 @@ func (o *runCmdOptions) syncIntegration(cmd *cobra.Command, c client.Client, sou
 						newCmd.Args = o.validateArgs
 						newCmd.PreRunE = o.decode
 						newCmd.RunE = func(cmd *cobra.Command, args []string) error {
-							_, err := o.updateIntegrationCode(c, sources, catalog)
+							// the sources have changed, and may now define another binding
+							binding, err := o.resolveKameletBinding(sources)
+							if err != nil {
+								return err
+							}
+							_, err = o.updateIntegrationCode(c, sources, binding, catalog)
 							return err
 						}
 						newCmd.PostRunE = nil
@@ -425,12 +450,16 @@ func (o *runCmdOptions) syncIntegration(cmd *cobra.Command, c client.Client, sou
 	return nil
 }
 
-func (o *runCmdOptions) createIntegration(c client.Client, sources []string, catalog *trait.Catalog) (*v1.Integration, error) {
-	return o.updateIntegrationCode(c, sources, catalog)
+func (o *runCmdOptions) createIntegration(c client.Client, sources []string, binding *v1alpha1.KameletBinding, catalog *trait.Catalog) (*v1.Integration, error) {
+	return o.updateIntegrationCode(c, sources, binding, catalog)
 }
 
 //nolint: gocyclo
-func (o *runCmdOptions) updateIntegrationCode(c client.Client, sources []string, catalog *trait.Catalog) (*v1.Integration, error) {
+func (o *runCmdOptions) updateIntegrationCode(c client.Client, sources []string, binding *v1alpha1.KameletBinding, catalog *trait.Catalog) (*v1.Integration, error) {
+	if binding != nil {
+		return o.updateKameletBinding(c, binding, catalog)
+	}
+
 	namespace := o.Namespace
 
 	name := o.GetIntegrationName(sources)
@@ -494,63 +523,8 @@ func (o *runCmdOptions) updateIntegrationCode(c client.Client, sources []string,
 		}
 	}
 
-	for _, resource := range o.Resources {
-		data, compressed, err := loadContent(resource, o.Compression, o.CompressBinary)
-		if err != nil {
-			return nil, err
-		}
-
-		integration.Spec.AddResources(v1.ResourceSpec{
-			DataSpec: v1.DataSpec{
-				Name:        path.Base(resource),
-				Content:     data,
-				Compression: compressed,
-			},
-			Type: v1.ResourceTypeData,
-		})
-	}
-
-	for _, resource := range o.OpenAPIs {
-		data, compressed, err := loadContent(resource, o.Compression, o.CompressBinary)
-		if err != nil {
-			return nil, err
-		}
-
-		integration.Spec.AddResources(v1.ResourceSpec{
-			DataSpec: v1.DataSpec{
-				Name:        path.Base(resource),
-				Content:     data,
-				Compression: compressed,
-			},
-			Type: v1.ResourceTypeOpenAPI,
-		})
-	}
-
-	for _, item := range o.Dependencies {
-		integration.Spec.AddDependency(item)
-	}
-	for _, pf := range o.PropertyFiles {
-		if err := addPropertyFile(pf, &integration.Spec); err != nil {
-			return nil, err
-		}
-	}
-	for _, item := range o.Properties {
-		integration.Spec.AddConfiguration("property", item)
-	}
-	for _, item := range o.LoggingLevels {
-		integration.Spec.AddConfiguration("property", "logging.level."+item)
-	}
-	for _, item := range o.ConfigMaps {
-		integration.Spec.AddConfiguration("configmap", item)
-	}
-	for _, item := range o.Secrets {
-		integration.Spec.AddConfiguration("secret", item)
-	}
-	for _, item := range o.Volumes {
-		integration.Spec.AddConfiguration("volume", item)
-	}
-	for _, item := range o.EnvVars {
-		integration.Spec.AddConfiguration("env", item)
+	if err := o.configureIntegrationSpec(&integration.Spec); err != nil {
+		return nil, err
 	}
 
 	if err := o.configureTraits(&integration, o.Traits, catalog); err != nil {
@@ -623,6 +597,198 @@ func (o *runCmdOptions) updateIntegrationCode(c client.Client, sources []string,
 	return &integration, nil
 }
 
+// resolveKameletBinding returns the KameletBinding defined by the given sources, if any
+func (o *runCmdOptions) resolveKameletBinding(sources []string) (*v1alpha1.KameletBinding, error) {
+	srcs := make([]string, 0, len(sources)+len(o.Sources))
+	srcs = append(srcs, sources...)
+	srcs = append(srcs, o.Sources...)
+
+	resolvedSources, err := ResolveSources(context.Background(), srcs, false)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, source := range resolvedSources {
+		if !strings.HasSuffix(source.Name, ".yaml") && !strings.HasSuffix(source.Name, ".yml") {
+			continue
+		}
+		binding, err := loadKameletBinding(source.Content)
+		if err != nil {
+			return nil, err
+		}
+		if binding != nil {
+			if len(resolvedSources) > 1 {
+				return nil, fmt.Errorf("kamelet binding %q cannot be run together with other sources", source.Location)
+			}
+			return binding, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// updateKameletBinding applies the given KameletBinding, after configuring its integration spec with the command line
+// options, and returns a reference to the Integration the operator materializes out of it
+func (o *runCmdOptions) updateKameletBinding(c client.Client, binding *v1alpha1.KameletBinding, catalog *trait.Catalog) (*v1.Integration, error) {
+	if o.IntegrationName != "" {
+		binding.Name = kubernetes.SanitizeName(o.IntegrationName)
+	}
+	if binding.Name == "" {
+		return nil, errors.New("unable to determine kamelet binding name")
+	}
+	binding.Namespace = o.Namespace
+
+	for _, label := range o.Labels {
+		parts := strings.Split(label, "=")
+		if len(parts) == 2 {
+			if binding.Labels == nil {
+				binding.Labels = make(map[string]string)
+			}
+			binding.Labels[parts[0]] = parts[1]
+		}
+	}
+
+	if binding.Spec.Integration == nil {
+		binding.Spec.Integration = &v1.IntegrationSpec{}
+	}
+	spec := binding.Spec.Integration
+	if o.IntegrationKit != "" {
+		spec.Kit = o.IntegrationKit
+	}
+	if o.Profile != "" {
+		spec.Profile = v1.TraitProfileByName(o.Profile)
+	}
+	spec.Repositories = append(spec.Repositories, o.Repositories...)
+
+	if err := o.configureIntegrationSpec(spec); err != nil {
+		return nil, err
+	}
+
+	traits, err := configureTraits(o.Traits, catalog)
+	if err != nil {
+		return nil, err
+	}
+	if err := mergeTraits(spec, traits); err != nil {
+		return nil, err
+	}
+
+	switch o.OutputFormat {
+	case "":
+		// continue..
+	case "yaml":
+		data, err := kubernetes.ToYAML(binding)
+		if err != nil {
+			return nil, err
+		}
+		fmt.Print(string(data))
+		return nil, nil
+
+	case "json":
+		data, err := kubernetes.ToJSON(binding)
+		if err != nil {
+			return nil, err
+		}
+		fmt.Print(string(data))
+		return nil, nil
+
+	default:
+		return nil, fmt.Errorf("invalid output format option '%s', should be one of: yaml|json", o.OutputFormat)
+	}
+
+	existed := false
+	err = c.Create(o.Context, binding)
+	if err != nil && k8serrors.IsAlreadyExists(err) {
+		existed = true
+		existing := v1alpha1.KameletBinding{}
+		key := k8sclient.ObjectKey{
+			Namespace: binding.Namespace,
+			Name:      binding.Name,
+		}
+		if err := c.Get(o.Context, key, &existing); err != nil {
+			return nil, err
+		}
+		binding.ResourceVersion = existing.ResourceVersion
+		err = c.Update(o.Context, binding)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if !existed {
+		fmt.Printf("kamelet binding \"%s\" created\n", binding.Name)
+	} else {
+		fmt.Printf("kamelet binding \"%s\" updated\n", binding.Name)
+	}
+
+	// The integration is named after the binding
+	integration := v1.NewIntegration(binding.Namespace, binding.Name)
+	return &integration, nil
+}
+
+// configureIntegrationSpec applies the resources, dependencies and configurations provided on the command line to the given spec
+func (o *runCmdOptions) configureIntegrationSpec(spec *v1.IntegrationSpec) error {
+	for _, resource := range o.Resources {
+		data, compressed, err := loadContent(resource, o.Compression, o.CompressBinary)
+		if err != nil {
+			return err
+		}
+
+		spec.AddResources(v1.ResourceSpec{
+			DataSpec: v1.DataSpec{
+				Name:        path.Base(resource),
+				Content:     data,
+				Compression: compressed,
+			},
+			Type: v1.ResourceTypeData,
+		})
+	}
+
+	for _, resource := range o.OpenAPIs {
+		data, compressed, err := loadContent(resource, o.Compression, o.CompressBinary)
+		if err != nil {
+			return err
+		}
+
+		spec.AddResources(v1.ResourceSpec{
+			DataSpec: v1.DataSpec{
+				Name:        path.Base(resource),
+				Content:     data,
+				Compression: compressed,
+			},
+			Type: v1.ResourceTypeOpenAPI,
+		})
+	}
+
+	for _, item := range o.Dependencies {
+		spec.AddDependency(item)
+	}
+	for _, pf := range o.PropertyFiles {
+		if err := addPropertyFile(pf, spec); err != nil {
+			return err
+		}
+	}
+	for _, item := range o.Properties {
+		spec.AddConfiguration("property", item)
+	}
+	for _, item := range o.LoggingLevels {
+		spec.AddConfiguration("property", "logging.level."+item)
+	}
+	for _, item := range o.ConfigMaps {
+		spec.AddConfiguration("configmap", item)
+	}
+	for _, item := range o.Secrets {
+		spec.AddConfiguration("secret", item)
+	}
+	for _, item := range o.Volumes {
+		spec.AddConfiguration("volume", item)
+	}
+	for _, item := range o.EnvVars {
+		spec.AddConfiguration("env", item)
+	}
+
+	return nil
+}
+
 func (o *runCmdOptions) GetIntegrationName(sources []string) string {
 	name := ""
 	if o.IntegrationName != "" {
@@ -645,6 +811,40 @@ func (*runCmdOptions) configureTraits(integration *v1.Integration, options []str
 	return nil
 }
 
+// mergeTraits overlays the given trait configurations on top of the ones already defined in the spec
+func mergeTraits(spec *v1.IntegrationSpec, traits map[string]v1.TraitSpec) error {
+	if len(traits) == 0 {
+		return nil
+	}
+	if spec.Traits == nil {
+		spec.Traits = make(map[string]v1.TraitSpec)
+	}
+	for id, t := range traits {
+		existing, ok := spec.Traits[id]
+		if !ok || len(existing.Configuration.RawMessage) == 0 {
+			spec.Traits[id] = t
+			continue
+		}
+		config := make(map[string]interface{})
+		if err := json.Unmarshal(existing.Configuration.RawMessage, &config); err != nil {
+			return err
+		}
+		if err := json.Unmarshal(t.Configuration.RawMessage, &config); err != nil {
+			return err
+		}
+		data, err := json.Marshal(config)
+		if err != nil {
+			return err
+		}
+		spec.Traits[id] = v1.TraitSpec{
+			Configuration: v1.TraitConfiguration{
+				RawMessage: data,
+			},
+		}
+	}
+	return nil
+}
+
 func isLocalAndFileExists(fileName string) bool {
 	info, err := os.Stat(fileName)
 	if os.IsNotExist(err) {
@@ -20,13 +20,16 @@ package cmd
 import (
 	"io/ioutil"
 	"os"
+	"path"
 	"testing"
 
 	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/apis/camel/v1alpha1"
 	"github.com/apache/camel-k/pkg/trait"
 	"github.com/apache/camel-k/pkg/util/test"
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
+	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 const cmdRun = "run"
@@ -477,3 +480,98 @@ func TestRunWithSavedValues(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Len(t, runCmdOptions.Sources, 2)
 }*/
+
+const kameletBindingSource = `apiVersion: camel.apache.org/v1alpha1
+kind: KameletBinding
+metadata:
+  name: timer-to-log
+spec:
+  integration:
+    traits:
+      container:
+        configuration:
+          port: 8081
+  source:
+    ref:
+      kind: Kamelet
+      apiVersion: camel.apache.org/v1alpha1
+      name: timer-source
+    properties:
+      message: hello
+  sink:
+    uri: log:info
+`
+
+func TestRunKameletBinding(t *testing.T) {
+	runCmdOptions, rootCmd, _ := initializeRunCmdOptions(t)
+
+	dir, err := ioutil.TempDir("", "camel-k-test-")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	file := path.Join(dir, "binding.yaml")
+	assert.Nil(t, ioutil.WriteFile(file, []byte(kameletBindingSource), 0644))
+
+	_, err = test.ExecuteCommand(rootCmd, cmdRun,
+		"-p", "my.property=value",
+		"-t", "container.port-name=web",
+		"--label", "app=timer",
+		file)
+	assert.Nil(t, err)
+
+	client, err := runCmdOptions.GetCmdClient()
+	assert.Nil(t, err)
+	runCmdOptions.Namespace = "default"
+	catalog := trait.NewCatalog(runCmdOptions.Context, client)
+
+	binding, err := runCmdOptions.resolveKameletBinding([]string{file})
+	assert.Nil(t, err)
+	assert.NotNil(t, binding)
+
+	integration, err := runCmdOptions.createIntegration(client, []string{file}, binding, catalog)
+	assert.Nil(t, err)
+	assert.Equal(t, "timer-to-log", integration.Name)
+	assert.Equal(t, "default", integration.Namespace)
+
+	applied := v1alpha1.KameletBinding{}
+	assert.Nil(t, client.Get(runCmdOptions.Context, k8sclient.ObjectKey{Namespace: "default", Name: "timer-to-log"}, &applied))
+	assert.Equal(t, "timer", applied.Labels["app"])
+	assert.Equal(t, "log:info", *applied.Spec.Sink.URI)
+	assert.Contains(t, applied.Spec.Integration.Configuration, v1.ConfigurationSpec{Type: "property", Value: "my.property=value"})
+	assertTraitConfiguration(t, applied.Spec.Integration.Traits, "container", `{"port":8081,"portName":"web"}`)
+
+	// running it again updates the existing binding
+	binding, err = runCmdOptions.resolveKameletBinding([]string{file})
+	assert.Nil(t, err)
+	_, err = runCmdOptions.createIntegration(client, []string{file}, binding, catalog)
+	assert.Nil(t, err)
+}
+
+func TestRunKameletBindingWithOtherSources(t *testing.T) {
+	runCmdOptions, _, _ := initializeRunCmdOptions(t)
+
+	dir, err := ioutil.TempDir("", "camel-k-test-")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	file := path.Join(dir, "binding.yaml")
+	assert.Nil(t, ioutil.WriteFile(file, []byte(kameletBindingSource), 0644))
+
+	_, err = runCmdOptions.resolveKameletBinding([]string{file, "run_test.go"})
+	assert.NotNil(t, err)
+}
+
+func TestMergeTraits(t *testing.T) {
+	spec := v1.IntegrationSpec{
+		Traits: map[string]v1.TraitSpec{
+			"container": {Configuration: v1.TraitConfiguration{RawMessage: []byte(`{"port":8081,"portName":"http"}`)}},
+		},
+	}
+	err := mergeTraits(&spec, map[string]v1.TraitSpec{
+		"container": {Configuration: v1.TraitConfiguration{RawMessage: []byte(`{"portName":"web"}`)}},
+		"jvm":       {Configuration: v1.TraitConfiguration{RawMessage: []byte(`{"printCommand":false}`)}},
+	})
+	assert.Nil(t, err)
+	assertTraitConfiguration(t, spec.Traits, "container", `{"port":8081,"portName":"web"}`)
+	assertTraitConfiguration(t, spec.Traits, "jvm", `{"printCommand":false}`)
+}
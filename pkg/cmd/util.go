@@ -33,6 +33,7 @@ import (
 	"github.com/mitchellh/mapstructure"
 
 	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/apis/camel/v1alpha1"
 	"github.com/apache/camel-k/pkg/client"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
@@ -61,6 +62,12 @@ func DeleteIntegration(ctx context.Context, c client.Client, name string, namesp
 	return c.Delete(ctx, &integration)
 }
 
+// DeleteKameletBinding --
+func DeleteKameletBinding(ctx context.Context, c client.Client, name string, namespace string) error {
+	binding := v1alpha1.NewKameletBinding(namespace, name)
+	return c.Delete(ctx, &binding)
+}
+
 func bindPFlagsHierarchy(cmd *cobra.Command) error {
 	for _, c := range cmd.Commands() {
 		if err := bindPFlags(c); err != nil {
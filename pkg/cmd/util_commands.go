@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"io"
 	"os/exec"
+	"path"
 	"strings"
 
 	"github.com/apache/camel-k/pkg/util"
@@ -37,6 +38,13 @@ func formatRoutes(files []string) []string {
 		// Extract extension.
 		extension := a[len(a)-1]
 
+		// Route templates generated from Kamelets are loaded as templates named after the Kamelet.
+		templateSuffix := kameletTemplateSuffix + "." + extension
+		if base := path.Base(route); strings.HasSuffix(base, templateSuffix) {
+			routes = append(routes, "file:"+route+"?language="+extension+"&type=template&name="+strings.TrimSuffix(base, templateSuffix))
+			continue
+		}
+
 		// Add file with extension.
 		routes = append(routes, "file:"+route+"?language="+extension)
 	}
@@ -0,0 +1,297 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path"
+	"strings"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/apis/camel/v1alpha1"
+	"github.com/apache/camel-k/pkg/controller/kameletbinding"
+	kameletutils "github.com/apache/camel-k/pkg/kamelet"
+	"github.com/apache/camel-k/pkg/kamelet/repository"
+	"github.com/apache/camel-k/pkg/trait"
+	"github.com/apache/camel-k/pkg/util"
+	"github.com/apache/camel-k/pkg/util/bindings"
+	"github.com/apache/camel-k/pkg/util/flow"
+	"github.com/apache/camel-k/pkg/util/gzip"
+	"github.com/magiconair/properties"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/yaml"
+)
+
+// kameletTemplateSuffix marks the files containing the route templates of Kamelets, e.g. "timer-source.kamelet.yaml"
+const kameletTemplateSuffix = ".kamelet"
+
+// localKamelets contains the routes, properties and dependencies required to run KameletBindings and routes
+// using Kamelets outside of the cluster
+type localKamelets struct {
+	Routes       []string
+	Properties   []string
+	Dependencies []string
+}
+
+// resolveLocalKamelets translates the KameletBindings found in the given files into routes and materializes the Kamelets
+// referenced by the routes, as done by the kamelets trait, looking them up in the given repositories.
+// Files that are not KameletBindings are returned as routes unchanged.
+func resolveLocalKamelets(ctx context.Context, repositories []string, files []string, userProperties []string, stderr io.Writer) (*localKamelets, error) {
+	answer := localKamelets{}
+
+	var repo repository.KameletRepository
+	getRepository := func() (repository.KameletRepository, error) {
+		if repo == nil {
+			var err error
+			if repo, err = repository.NewStandalone(repositories...); err != nil {
+				return nil, err
+			}
+		}
+		return repo, nil
+	}
+
+	if err := util.CreateLocalKameletsDirectory(); err != nil {
+		return nil, err
+	}
+
+	for _, file := range files {
+		content, _, err := loadContent(file, false, false)
+		if err != nil {
+			return nil, err
+		}
+		binding, err := loadKameletBinding(content)
+		if err != nil {
+			return nil, errors.Wrapf(err, "cannot parse file %s", file)
+		}
+		if binding == nil {
+			answer.Routes = append(answer.Routes, file)
+			continue
+		}
+		r, err := getRepository()
+		if err != nil {
+			return nil, err
+		}
+		if err := answer.addKameletBinding(ctx, r, binding, stderr); err != nil {
+			return nil, errors.Wrapf(err, "cannot translate kamelet binding %s", file)
+		}
+	}
+
+	catalog, err := createCamelCatalog()
+	if err != nil {
+		return nil, err
+	}
+	sources := make([]v1.SourceSpec, 0, len(answer.Routes))
+	for _, route := range answer.Routes {
+		content, _, err := loadContent(route, false, false)
+		if err != nil {
+			return nil, err
+		}
+		sources = append(sources, v1.SourceSpec{
+			DataSpec: v1.DataSpec{
+				Name:    path.Base(route),
+				Content: content,
+			},
+		})
+	}
+	keys := trait.KameletKeys(catalog, sources)
+	if len(keys) == 0 {
+		return &answer, nil
+	}
+
+	r, err := getRepository()
+	if err != nil {
+		return nil, err
+	}
+	for _, key := range keys {
+		if err := answer.addKamelet(ctx, r, key, userProperties); err != nil {
+			return nil, err
+		}
+	}
+
+	return &answer, nil
+}
+
+// loadKameletBinding returns the KameletBinding defined in the given content, or nil if it does not contain a KameletBinding
+func loadKameletBinding(content string) (*v1alpha1.KameletBinding, error) {
+	meta := metav1.TypeMeta{}
+	if err := yaml.Unmarshal([]byte(content), &meta); err != nil {
+		// not a Kubernetes resource
+		return nil, nil
+	}
+	gv, err := schema.ParseGroupVersion(meta.APIVersion)
+	if err != nil || meta.Kind != v1alpha1.KameletBindingKind || gv.Group != v1alpha1.SchemeGroupVersion.Group {
+		return nil, nil
+	}
+	binding := v1alpha1.KameletBinding{}
+	if err := yaml.Unmarshal([]byte(content), &binding); err != nil {
+		return nil, err
+	}
+	return &binding, nil
+}
+
+func (l *localKamelets) addKameletBinding(ctx context.Context, repo repository.KameletRepository, binding *v1alpha1.KameletBinding, stderr io.Writer) error {
+	it, secret, err := kameletbinding.CreateIntegrationFor(bindings.BindingContext{
+		Ctx:        ctx,
+		Namespace:  binding.Namespace,
+		Profile:    v1.TraitProfileKubernetes,
+		Repository: repo,
+	}, binding)
+	if err != nil {
+		return err
+	}
+
+	content, err := flow.ToYamlDSL(it.Spec.Flows)
+	if err != nil {
+		return err
+	}
+	route := path.Join(util.GetLocalKameletsDir(), binding.Name+".yaml")
+	if err := ioutil.WriteFile(route, content, 0644); err != nil {
+		return err
+	}
+	l.Routes = append(l.Routes, route)
+
+	// sensitive properties are kept in a secret in the cluster, while they are provided to the local process
+	if secret != nil {
+		for _, data := range secret.Data {
+			props, err := properties.Load(data, properties.UTF8)
+			if err != nil {
+				return err
+			}
+			for _, k := range props.Keys() {
+				l.Properties = append(l.Properties, fmt.Sprintf("%s=%s", k, props.GetString(k, "")))
+			}
+		}
+	}
+	for _, c := range it.Spec.Configuration {
+		switch {
+		case c.Type == "property":
+			l.Properties = append(l.Properties, c.Value)
+		case secret != nil && c.Type == "secret" && c.Value == secret.Name:
+			continue
+		default:
+			fmt.Fprintf(stderr, "Warning: configuration %s %q of kamelet binding %s cannot be used when running locally\n", c.Type, c.Value, binding.Name)
+		}
+	}
+	util.StringSliceUniqueConcat(&l.Dependencies, it.Spec.Dependencies)
+
+	return nil
+}
+
+func (l *localKamelets) addKamelet(ctx context.Context, repo repository.KameletRepository, key string, userProperties []string) error {
+	name, version := v1alpha1.ParseKameletReference(key)
+	kamelet, err := repo.GetVersion(ctx, name, version)
+	if err != nil {
+		return err
+	}
+	if kamelet == nil {
+		return fmt.Errorf("kamelet %s not found in any of the defined repositories: %s", key, repo.String())
+	}
+	// version ranges are not resolved at runtime, see the kamelets trait
+	if version != "" && kamelet.GetVersionedReference() != key {
+		return fmt.Errorf("kamelet %s must be referenced with an exact version, e.g. kamelet:%s: version ranges are only supported by KameletBindings", key, kamelet.GetVersionedReference())
+	}
+
+	return l.addKameletDefinition(kamelet, key, userProperties)
+}
+
+// addKameletDefinition materializes the sources, the default properties and the dependencies of the given Kamelet,
+// which is referenced in the routes using the given key
+func (l *localKamelets) addKameletDefinition(kamelet *v1alpha1.Kamelet, key string, userProperties []string) error {
+	// Kamelets from remote repositories are not initialized
+	kamelet, err := kameletutils.Initialize(kamelet)
+	if err != nil {
+		return err
+	}
+	if kamelet.Status.Phase != v1alpha1.KameletPhaseReady {
+		return fmt.Errorf("kamelet %q is not %s: %s", key, v1alpha1.KameletPhaseReady, kamelet.Status.Phase)
+	}
+
+	sources, err := trait.KameletSources(kamelet, key)
+	if err != nil {
+		return err
+	}
+	for _, s := range sources {
+		if s.ContentRef != "" {
+			return fmt.Errorf("source %s of kamelet %s refers to content stored in the cluster", s.Name, key)
+		}
+		content := []byte(s.Content)
+		if s.Compression {
+			if content, err = gzip.UncompressBase64(content); err != nil {
+				return err
+			}
+		}
+		// The source is written in the local kamelets directory and cannot refer to other locations
+		if s.Name == "" || path.IsAbs(s.Name) || strings.ContainsAny(s.Name, "/\\") || strings.Contains(s.Name, "..") {
+			return fmt.Errorf("invalid name %q for source of kamelet %s", s.Name, key)
+		}
+		fileName := s.Name
+		if s.Type == v1.SourceTypeTemplate {
+			ext := path.Ext(fileName)
+			fileName = strings.TrimSuffix(fileName, ext) + kameletTemplateSuffix + ext
+		}
+		route := path.Join(util.GetLocalKameletsDir(), fileName)
+		if err := ioutil.WriteFile(route, content, 0644); err != nil {
+			return err
+		}
+		l.Routes = append(l.Routes, route)
+	}
+
+	// Configuring defaults from Kamelet, unless already provided
+	for _, prop := range kamelet.Status.Properties {
+		if prop.Default == "" {
+			continue
+		}
+		propName := fmt.Sprintf("camel.kamelet.%s.%s", key, prop.Name)
+		if hasProperty(userProperties, propName) || hasProperty(l.Properties, propName) {
+			continue
+		}
+		l.Properties = append(l.Properties, fmt.Sprintf("%s=%s", propName, prop.Default))
+	}
+
+	util.StringSliceUniqueConcat(&l.Dependencies, kamelet.Spec.Dependencies)
+	return nil
+}
+
+// writeProperties writes the properties required by the Kamelets to a file in the local properties directory, returning its path
+func (l *localKamelets) writeProperties() (string, error) {
+	if len(l.Properties) == 0 {
+		return "", nil
+	}
+	if err := util.CreateLocalPropertiesDirectory(); err != nil {
+		return "", err
+	}
+	propertyFilePath := path.Join(util.GetLocalPropertiesDir(), "kamelets.properties")
+	if err := ioutil.WriteFile(propertyFilePath, []byte(strings.Join(l.Properties, "\n")), 0600); err != nil {
+		return "", err
+	}
+	return propertyFilePath, nil
+}
+
+func hasProperty(props []string, name string) bool {
+	for _, p := range props {
+		if strings.HasPrefix(p, name+"=") {
+			return true
+		}
+	}
+	return false
+}
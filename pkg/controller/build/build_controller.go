@@ -90,8 +90,10 @@ func add(mgr manager.Manager, r reconcile.Reconciler) error {
 				// Ignore updates to the build status in which case metadata.Generation does not change,
 				// or except when the build phase changes as it's used to transition from one phase
 				// to another
+				// Also watch for the cancellation annotation, that does not change metadata.Generation either
 				return oldBuild.Generation != newBuild.Generation ||
-					oldBuild.Status.Phase != newBuild.Status.Phase
+					oldBuild.Status.Phase != newBuild.Status.Phase ||
+					oldBuild.Annotations[v1.BuildCancelAnnotation] != newBuild.Annotations[v1.BuildCancelAnnotation]
 			},
 		})
 	if err != nil {
@@ -162,6 +164,8 @@ func (r *reconcileBuild) Reconcile(request reconcile.Request) (reconcile.Result,
 		if errors.IsNotFound(err) {
 			// Request object not found, could have been deleted after reconcile request.
 			// Owned objects are automatically garbage collected. For additional cleanup logic use finalizers.
+			// The build routine, if any, is interrupted, e.g., when the owning kit has been deleted.
+			cancelBuildRoutine(&r.routines, request.NamespacedName.String())
 			// Return and don't requeue
 			return reconcile.Result{}, nil
 		}
@@ -200,6 +204,7 @@ func (r *reconcileBuild) Reconcile(request reconcile.Request) (reconcile.Result,
 	switch pl.Status.Build.BuildStrategy {
 	case v1.IntegrationPlatformBuildStrategyPod:
 		actions = []Action{
+			NewCancelAction(nil),
 			NewInitializePodAction(),
 			NewSchedulePodAction(r.reader),
 			NewMonitorPodAction(),
@@ -208,6 +213,7 @@ func (r *reconcileBuild) Reconcile(request reconcile.Request) (reconcile.Result,
 		}
 	case v1.IntegrationPlatformBuildStrategyRoutine:
 		actions = []Action{
+			NewCancelAction(&r.routines),
 			NewInitializeRoutineAction(),
 			NewScheduleRoutineAction(r.reader, r.builder, &r.routines),
 			NewMonitorRoutineAction(&r.routines),
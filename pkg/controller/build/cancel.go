@@ -0,0 +1,105 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package build
+
+import (
+	"context"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/util/cancellable"
+)
+
+// NewCancelAction creates a new action that interrupts the cancelled builds
+func NewCancelAction(r *sync.Map) Action {
+	return &cancelAction{
+		routines: r,
+	}
+}
+
+type cancelAction struct {
+	baseAction
+	routines *sync.Map
+}
+
+// Name returns a common name of the action
+func (action *cancelAction) Name() string {
+	return "cancel"
+}
+
+// CanHandle tells whether this action can handle the build
+func (action *cancelAction) CanHandle(build *v1.Build) bool {
+	return isBuildCancelled(build) &&
+		build.Status.Phase != v1.BuildPhaseSucceeded &&
+		build.Status.Phase != v1.BuildPhaseError &&
+		build.Status.Phase != v1.BuildPhaseInterrupted
+}
+
+// Handle handles the builds
+func (action *cancelAction) Handle(ctx context.Context, build *v1.Build) (*v1.Build, error) {
+	// Interrupt the build routine, if any
+	cancelBuildRoutine(action.routines, routineKey(build))
+
+	// Or delete the build pod, if any
+	pod, err := getBuilderPod(ctx, action.client, build)
+	if err != nil {
+		return nil, err
+	}
+	if pod != nil {
+		if err := action.client.Delete(ctx, pod); err != nil && !k8serrors.IsNotFound(err) {
+			return nil, errors.Wrap(err, "cannot delete build pod")
+		}
+	}
+
+	build.Status.Phase = v1.BuildPhaseInterrupted
+	build.Status.Error = "build cancelled"
+	if build.Status.StartedAt != nil {
+		duration := metav1.Now().Sub(build.Status.StartedAt.Time)
+		build.Status.Duration = duration.String()
+
+		// Account for the Build metrics
+		observeBuildResult(build, build.Status.Phase, duration)
+	}
+
+	return build, nil
+}
+
+func isBuildCancelled(build *v1.Build) bool {
+	return build.Annotations[v1.BuildCancelAnnotation] == "true"
+}
+
+// cancelBuildRoutine cancels the context of the routine running the build with the given key, if any
+func cancelBuildRoutine(routines *sync.Map, key string) {
+	if routines == nil {
+		return
+	}
+	if ctx, ok := routines.Load(key); ok {
+		ctx.(cancellable.Context).Cancel()
+	}
+}
+
+// routineKey returns the key of the routine running the build, as builds in different namespaces can have the same name
+func routineKey(build *v1.Build) string {
+	return types.NamespacedName{Namespace: build.Namespace, Name: build.Name}.String()
+}
@@ -0,0 +1,96 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package build
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/util/cancellable"
+	"github.com/apache/camel-k/pkg/util/log"
+	"github.com/apache/camel-k/pkg/util/test"
+)
+
+func TestCancelRoutineBuild(t *testing.T) {
+	build := newTestBuild("build", v1.BuildPhaseRunning)
+	now := metav1.Now()
+	build.Status.StartedAt = &now
+
+	c, err := test.NewFakeClient(&build)
+	assert.Nil(t, err)
+
+	routines := sync.Map{}
+	ctx := cancellable.NewContext()
+	routines.Store(routineKey(&build), ctx)
+
+	action := NewCancelAction(&routines)
+	action.InjectClient(c)
+	action.InjectLogger(log.Log)
+
+	assert.False(t, action.CanHandle(&build))
+
+	build.Annotations = map[string]string{v1.BuildCancelAnnotation: "true"}
+	assert.True(t, action.CanHandle(&build))
+
+	target, err := action.Handle(context.TODO(), &build)
+	assert.Nil(t, err)
+	assert.Equal(t, v1.BuildPhase(v1.BuildPhaseInterrupted), target.Status.Phase)
+	assert.NotEmpty(t, target.Status.Duration)
+	assert.NotNil(t, ctx.Err())
+
+	// Completed builds cannot be cancelled
+	assert.False(t, action.CanHandle(target))
+}
+
+func TestCancelPodBuild(t *testing.T) {
+	build := newTestBuild("build", v1.BuildPhaseScheduling)
+	build.Annotations = map[string]string{v1.BuildCancelAnnotation: "true"}
+	pod := corev1.Pod{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: corev1.SchemeGroupVersion.String(),
+			Kind:       "Pod",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: build.Namespace,
+			Name:      buildPodName(&build),
+		},
+	}
+
+	c, err := test.NewFakeClient(&build, &pod)
+	assert.Nil(t, err)
+
+	action := NewCancelAction(nil)
+	action.InjectClient(c)
+	action.InjectLogger(log.Log)
+
+	target, err := action.Handle(context.TODO(), &build)
+	assert.Nil(t, err)
+	assert.Equal(t, v1.BuildPhase(v1.BuildPhaseInterrupted), target.Status.Phase)
+
+	err = c.Get(context.TODO(), k8sclient.ObjectKey{Namespace: pod.Namespace, Name: pod.Name}, &corev1.Pod{})
+	assert.True(t, k8serrors.IsNotFound(err))
+}
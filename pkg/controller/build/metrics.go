@@ -28,7 +28,11 @@ import (
 	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
 )
 
-const buildResultLabel = "result"
+const (
+	buildResultLabel    = "result"
+	buildNamespaceLabel = "namespace"
+	buildStepLabel      = "step"
+)
 
 var (
 	buildDuration = prometheus.NewHistogramVec(
@@ -60,6 +64,29 @@ var (
 		},
 	)
 
+	stepDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name: "camel_k_build_step_duration_seconds",
+			Help: "Camel K build step duration",
+			Buckets: []float64{
+				1 * time.Second.Seconds(),
+				5 * time.Second.Seconds(),
+				15 * time.Second.Seconds(),
+				30 * time.Second.Seconds(),
+				1 * time.Minute.Seconds(),
+				2 * time.Minute.Seconds(),
+				5 * time.Minute.Seconds(),
+				10 * time.Minute.Seconds(),
+				15 * time.Minute.Seconds(),
+				30 * time.Minute.Seconds(),
+			},
+		},
+		[]string{
+			buildStepLabel,
+			buildResultLabel,
+		},
+	)
+
 	queueDuration = prometheus.NewHistogram(
 		prometheus.HistogramOpts{
 			Name: "camel_k_build_queue_duration_seconds",
@@ -73,11 +100,21 @@ var (
 			},
 		},
 	)
+
+	queueDepth = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "camel_k_build_queue_depth",
+			Help: "Camel K builds waiting to be scheduled",
+		},
+		[]string{
+			buildNamespaceLabel,
+		},
+	)
 )
 
 func init() {
 	// Register custom metrics with the global prometheus registry
-	metrics.Registry.MustRegister(buildDuration, buildRecovery, queueDuration)
+	metrics.Registry.MustRegister(buildDuration, buildRecovery, stepDuration, queueDuration, queueDepth)
 }
 
 func observeBuildResult(build *v1.Build, phase v1.BuildPhase, duration time.Duration) {
@@ -93,6 +130,15 @@ func observeBuildResult(build *v1.Build, phase v1.BuildPhase, duration time.Dura
 	buildDuration.WithLabelValues(phase.String()).Observe(duration.Seconds())
 }
 
+func observeBuildSteps(steps []v1.BuildStepStatus) {
+	for _, step := range steps {
+		if step.FinishedAt == nil {
+			continue
+		}
+		stepDuration.WithLabelValues(step.ID, step.Result.String()).Observe(step.Duration().Seconds())
+	}
+}
+
 func getBuildAttemptFor(build *v1.Build) (int, int) {
 	attempt := 0
 	attemptMax := math.MaxInt32
@@ -18,12 +18,16 @@ limitations under the License.
 package build
 
 import (
+	"bytes"
 	"context"
+	"fmt"
+	"io"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	k8slog "github.com/apache/camel-k/pkg/util/kubernetes/log"
 )
 
 // NewMonitorPodAction creates a new monitor action for scheduled pod
@@ -52,6 +56,10 @@ func (action *monitorPodAction) Handle(ctx context.Context, build *v1.Build) (*v
 		return nil, err
 	}
 
+	if pod != nil {
+		action.setImageTaskSteps(build, pod)
+	}
+
 	switch {
 	case pod == nil:
 		build.Status.Phase = v1.BuildPhaseScheduling
@@ -66,12 +74,15 @@ func (action *monitorPodAction) Handle(ctx context.Context, build *v1.Build) (*v
 		}
 
 	case pod.Status.Phase == corev1.PodSucceeded:
+		action.persistImageTaskLogs(ctx, build, pod)
+
 		build.Status.Phase = v1.BuildPhaseSucceeded
 		duration := metav1.Now().Sub(build.Status.StartedAt.Time)
 		build.Status.Duration = duration.String()
 
 		// Account for the Build metrics
 		observeBuildResult(build, build.Status.Phase, duration)
+		observeBuildSteps(build.Status.Steps)
 
 		for _, task := range build.Spec.Tasks {
 			if task.Image != nil {
@@ -88,17 +99,114 @@ func (action *monitorPodAction) Handle(ctx context.Context, build *v1.Build) (*v
 		}
 
 	case pod.Status.Phase == corev1.PodFailed:
+		action.persistImageTaskLogs(ctx, build, pod)
+
 		build.Status.Phase = v1.BuildPhaseFailed
 		duration := metav1.Now().Sub(build.Status.StartedAt.Time)
 		build.Status.Duration = duration.String()
 
 		// Account for the Build metrics
 		observeBuildResult(build, build.Status.Phase, duration)
+		observeBuildSteps(build.Status.Steps)
 	}
 
 	return build, nil
 }
 
+// setImageTaskSteps records the execution of the image tasks, from the status of their containers.
+// The steps of the builder tasks are reported by the builder containers themselves.
+func (action *monitorPodAction) setImageTaskSteps(build *v1.Build, pod *corev1.Pod) {
+	statuses := containerStatuses(pod)
+
+	for _, task := range build.Spec.Tasks {
+		if task.Image == nil {
+			continue
+		}
+		status, ok := statuses[task.Image.Name]
+		if !ok {
+			continue
+		}
+
+		step := v1.BuildStepStatus{
+			ID: task.Image.Name,
+		}
+		switch {
+		case status.State.Running != nil:
+			step.Result = v1.BuildPhaseRunning
+			step.StartedAt = status.State.Running.StartedAt.DeepCopy()
+		case status.State.Terminated != nil:
+			step.Result = v1.BuildPhaseSucceeded
+			if status.State.Terminated.ExitCode != 0 {
+				step.Result = v1.BuildPhaseFailed
+			}
+			step.StartedAt = status.State.Terminated.StartedAt.DeepCopy()
+			step.FinishedAt = status.State.Terminated.FinishedAt.DeepCopy()
+		default:
+			continue
+		}
+
+		build.Status.SetStep(step)
+	}
+}
+
+// persistImageTaskLogs appends the logs of the image task containers to the build log.
+// The output of the builder tasks is persisted by the builder containers themselves.
+// The containers whose log has been appended are recorded in the build log, so that their log is not appended again
+// when the reconciliation is retried.
+func (action *monitorPodAction) persistImageTaskLogs(ctx context.Context, build *v1.Build, pod *corev1.Pod) {
+	logs, err := k8slog.NewBuildLogWriter(ctx, action.client, build)
+	if err != nil {
+		action.L.Error(err, "Cannot persist build log", "build", build.Name)
+		return
+	}
+	defer func() {
+		if err := logs.Close(); err != nil {
+			action.L.Error(err, "Cannot persist build log", "build", build.Name)
+		}
+	}()
+
+	statuses := containerStatuses(pod)
+	for _, task := range build.Spec.Tasks {
+		if task.Image == nil {
+			continue
+		}
+		// Skip the containers that have not run
+		if status, ok := statuses[task.Image.Name]; !ok || status.State.Terminated == nil {
+			continue
+		}
+		if logs.HasContainerLog(task.Image.Name) {
+			continue
+		}
+
+		stream, err := action.client.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, &corev1.PodLogOptions{
+			Container: task.Image.Name,
+		}).Stream(ctx)
+		if err != nil {
+			action.L.Error(err, "Cannot retrieve container logs", "build", build.Name, "container", task.Image.Name)
+			continue
+		}
+		content := bytes.Buffer{}
+		fmt.Fprintf(&content, "Executing task %s\n", task.Image.Name)
+		_, err = io.Copy(&content, stream)
+		stream.Close()
+		if err != nil {
+			action.L.Error(err, "Cannot retrieve container logs", "build", build.Name, "container", task.Image.Name)
+		}
+		logs.WriteContainerLog(task.Image.Name, content.Bytes())
+	}
+}
+
+func containerStatuses(pod *corev1.Pod) map[string]corev1.ContainerStatus {
+	statuses := make(map[string]corev1.ContainerStatus)
+	for _, status := range pod.Status.InitContainerStatuses {
+		statuses[status.Name] = status
+	}
+	for _, status := range pod.Status.ContainerStatuses {
+		statuses[status.Name] = status
+	}
+	return statuses
+}
+
 func (action *monitorPodAction) isPodScheduled(pod *corev1.Pod) bool {
 	for _, condition := range pod.Status.Conditions {
 		if condition.Type == corev1.PodScheduled && condition.Status == corev1.ConditionTrue {
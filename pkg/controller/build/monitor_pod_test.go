@@ -0,0 +1,89 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package build
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+)
+
+func TestMonitorPodImageTaskSteps(t *testing.T) {
+	build := newTestBuild("build", v1.BuildPhaseRunning)
+	build.Spec.Tasks = append(build.Spec.Tasks,
+		v1.Task{Image: &v1.ImageTask{ContainerTask: v1.ContainerTask{BaseTask: v1.BaseTask{Name: "kaniko"}}}},
+		v1.Task{Image: &v1.ImageTask{ContainerTask: v1.ContainerTask{BaseTask: v1.BaseTask{Name: "push"}}}},
+	)
+	build.Status.Steps = []v1.BuildStepStatus{
+		{ID: "builder-step", Result: v1.BuildPhaseSucceeded},
+		{ID: "kaniko", Result: v1.BuildPhaseRunning},
+	}
+
+	started := metav1.NewTime(time.Now().Add(-time.Minute))
+	finished := metav1.Now()
+	pod := corev1.Pod{
+		Status: corev1.PodStatus{
+			InitContainerStatuses: []corev1.ContainerStatus{
+				{
+					Name: "builder",
+					State: corev1.ContainerState{
+						Terminated: &corev1.ContainerStateTerminated{StartedAt: started, FinishedAt: started},
+					},
+				},
+				{
+					Name: "kaniko",
+					State: corev1.ContainerState{
+						Terminated: &corev1.ContainerStateTerminated{StartedAt: started, FinishedAt: finished},
+					},
+				},
+			},
+			ContainerStatuses: []corev1.ContainerStatus{
+				{
+					Name: "push",
+					State: corev1.ContainerState{
+						Waiting: &corev1.ContainerStateWaiting{},
+					},
+				},
+			},
+		},
+	}
+
+	action := monitorPodAction{}
+	action.setImageTaskSteps(&build, &pod)
+
+	assert.Len(t, build.Status.Steps, 2)
+	assert.Equal(t, "builder-step", build.Status.Steps[0].ID)
+	assert.Equal(t, "kaniko", build.Status.Steps[1].ID)
+	assert.Equal(t, v1.BuildPhaseSucceeded, build.Status.Steps[1].Result)
+	assert.Equal(t, time.Minute.Round(time.Second), build.Status.Steps[1].Duration().Round(time.Second))
+
+	pod.Status.ContainerStatuses[0].State = corev1.ContainerState{
+		Terminated: &corev1.ContainerStateTerminated{ExitCode: 1, StartedAt: finished, FinishedAt: finished},
+	}
+	action.setImageTaskSteps(&build, &pod)
+
+	assert.Len(t, build.Status.Steps, 3)
+	assert.Equal(t, "push", build.Status.Steps[2].ID)
+	assert.Equal(t, v1.BuildPhaseFailed, build.Status.Steps[2].Result)
+}
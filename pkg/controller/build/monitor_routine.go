@@ -49,7 +49,7 @@ func (action *monitorRoutineAction) CanHandle(build *v1.Build) bool {
 // Handle handles the builds
 func (action *monitorRoutineAction) Handle(ctx context.Context, build *v1.Build) (*v1.Build, error) {
 	// Check the build routine
-	if _, ok := action.routines.Load(build.Name); !ok && build.Status.Phase != v1.BuildPhaseFailed {
+	if _, ok := action.routines.Load(routineKey(build)); !ok && build.Status.Phase != v1.BuildPhaseFailed {
 		// and recover the build if it's missing. This can happen when the operator
 		// stops abruptly and restarts or the build status update fails.
 		build.Status.Phase = v1.BuildPhaseFailed
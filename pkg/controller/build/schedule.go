@@ -0,0 +1,293 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package build
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/builder"
+	"github.com/apache/camel-k/pkg/platform"
+	"github.com/apache/camel-k/pkg/util"
+)
+
+// listBuilds returns the builds competing for the same resources as the given build, that is the builds of its
+// namespace, or the builds of all the namespaces handled by the operator when it is global
+func listBuilds(ctx context.Context, c client.Client, reader client.Reader, build *v1.Build) ([]v1.Build, error) {
+	builds := &v1.BuildList{}
+	// We use the non-caching client as informers cache is not invalidated nor updated
+	// atomically by write operations
+	if !platform.IsCurrentOperatorGlobal() {
+		if err := reader.List(ctx, builds, client.InNamespace(build.Namespace)); err != nil {
+			return nil, err
+		}
+		return builds.Items, nil
+	}
+
+	if err := reader.List(ctx, builds); err != nil {
+		return nil, err
+	}
+	// Ignore the builds of the namespaces owned by other operators
+	allowed := make(map[string]bool)
+	answer := make([]v1.Build, 0, len(builds.Items))
+	for _, b := range builds.Items {
+		ok, found := allowed[b.Namespace]
+		if !found {
+			var err error
+			if ok, err = isOperatorAllowedOnNamespace(ctx, c, b.Namespace); err != nil {
+				return nil, err
+			}
+			allowed[b.Namespace] = ok
+		}
+		if ok {
+			answer = append(answer, b)
+		}
+	}
+	return answer, nil
+}
+
+// namespaceLookupTTL is the time during which the namespaces the operator is allowed on are not looked up again
+const namespaceLookupTTL = time.Minute
+
+type namespaceLookup struct {
+	allowed   bool
+	timestamp time.Time
+}
+
+var (
+	namespaceLookupsLock sync.Mutex
+	namespaceLookups     = make(map[string]namespaceLookup)
+)
+
+// isOperatorAllowedOnNamespace caches the lookup of the operator lock of the namespace, as builds are scheduled
+// every few seconds while they are waiting
+func isOperatorAllowedOnNamespace(ctx context.Context, c client.Client, namespace string) (bool, error) {
+	namespaceLookupsLock.Lock()
+	defer namespaceLookupsLock.Unlock()
+
+	if lookup, ok := namespaceLookups[namespace]; ok && time.Since(lookup.timestamp) < namespaceLookupTTL {
+		return lookup.allowed, nil
+	}
+	allowed, err := platform.IsOperatorAllowedOnNamespace(ctx, c, namespace)
+	if err != nil {
+		return false, err
+	}
+	for ns, lookup := range namespaceLookups {
+		if time.Since(lookup.timestamp) >= namespaceLookupTTL {
+			delete(namespaceLookups, ns)
+		}
+	}
+	namespaceLookups[namespace] = namespaceLookup{
+		allowed:   allowed,
+		timestamp: time.Now(),
+	}
+	return allowed, nil
+}
+
+// getMaxRunningBuilds returns the maximum number of builds that can run at the same time. When the operator is global,
+// the limit applies to the builds of all the namespaces, so it is taken from the platform of the operator namespace,
+// or defaults to the one of the build strategy, rather than from the platform of the build.
+func getMaxRunningBuilds(ctx context.Context, c client.Client, build *v1.Build, strategy v1.IntegrationPlatformBuildStrategy) (int32, error) {
+	if !platform.IsCurrentOperatorGlobal() {
+		pl, err := platform.GetOrLookupCurrent(ctx, c, build.Namespace, build.Status.Platform)
+		if err != nil {
+			return 0, err
+		}
+		return pl.Status.Build.MaxRunningBuilds, nil
+	}
+
+	if operatorNamespace := platform.GetOperatorNamespace(); operatorNamespace != "" {
+		pl, err := platform.GetCurrentPlatform(ctx, c, operatorNamespace)
+		if err != nil && !k8serrors.IsNotFound(err) {
+			return 0, err
+		}
+		if pl != nil && pl.Status.Build.MaxRunningBuilds > 0 {
+			return pl.Status.Build.MaxRunningBuilds, nil
+		}
+	}
+	return platform.GetDefaultMaxRunningBuilds(strategy), nil
+}
+
+// schedule tells whether the build can start given the other builds, or its position in the build queue and the reason
+// why it has to wait.
+//
+// The namespaces with fewer running builds are served first, so that they get a fair share of the running builds,
+// and the queued builds of a namespace are ordered by priority, then by queuing time. At most maxRunningBuilds builds can
+// run at the same time, and a build waits for the running builds that may produce its base image, so that incremental
+// builds keep reusing the images of their lineage.
+func schedule(build *v1.Build, builds []v1.Build, maxRunningBuilds int32) (bool, int32, string) {
+	running := make([]*v1.Build, 0)
+	runningByNamespace := make(map[string]int)
+	queue := make([]*v1.Build, 0)
+	for i := range builds {
+		b := &builds[i]
+		switch {
+		case b.Namespace == build.Namespace && b.Name == build.Name:
+			continue
+		case isBuildRunning(b):
+			running = append(running, b)
+			runningByNamespace[b.Namespace]++
+		case b.Status.Phase == v1.BuildPhaseScheduling:
+			queue = append(queue, b)
+		}
+	}
+	queue = append(queue, build)
+
+	for position := int32(1); ; position++ {
+		i := nextQueued(queue, runningByNamespace)
+		next := queue[i]
+		queue = append(queue[:i], queue[i+1:]...)
+
+		ok, reason := canSchedule(next, running, maxRunningBuilds)
+		if next == build {
+			return ok, position, reason
+		}
+		if ok {
+			// The build ahead in the queue takes a slot
+			running = append(running, next)
+			runningByNamespace[next.Namespace]++
+		}
+	}
+}
+
+// nextQueued returns the index of the next build of the queue. Priorities only apply among the builds of a namespace,
+// so that the builds of a namespace cannot take precedence over the ones of the other namespaces.
+func nextQueued(queue []*v1.Build, runningByNamespace map[string]int) int {
+	heads := make(map[string]int)
+	for i, b := range queue {
+		if h, ok := heads[b.Namespace]; !ok || queuedBefore(b, queue[h]) {
+			heads[b.Namespace] = i
+		}
+	}
+	next := -1
+	for i, b := range queue {
+		if heads[b.Namespace] != i {
+			continue
+		}
+		if next < 0 || servedBefore(b, queue[next], runningByNamespace) {
+			next = i
+		}
+	}
+	return next
+}
+
+// queuedBefore orders the builds of a namespace
+func queuedBefore(a *v1.Build, b *v1.Build) bool {
+	if a.Spec.Priority != b.Spec.Priority {
+		return a.Spec.Priority > b.Spec.Priority
+	}
+	ta, tb := getBuildQueuingTime(a), getBuildQueuingTime(b)
+	if !ta.Equal(tb) {
+		return ta.Before(tb)
+	}
+	return a.Name < b.Name
+}
+
+// servedBefore orders the next builds of different namespaces
+func servedBefore(a *v1.Build, b *v1.Build, runningByNamespace map[string]int) bool {
+	if runningByNamespace[a.Namespace] != runningByNamespace[b.Namespace] {
+		return runningByNamespace[a.Namespace] < runningByNamespace[b.Namespace]
+	}
+	ta, tb := getBuildQueuingTime(a), getBuildQueuingTime(b)
+	if !ta.Equal(tb) {
+		return ta.Before(tb)
+	}
+	return a.Namespace < b.Namespace
+}
+
+// canSchedule tells whether the build can start given the running builds, or the reason why it has to wait
+func canSchedule(build *v1.Build, running []*v1.Build, maxRunningBuilds int32) (bool, string) {
+	for _, b := range running {
+		if sharesLineage(build, b) {
+			return false, "waiting for build " + b.Name + " that may provide the base image"
+		}
+	}
+
+	if maxRunningBuilds > 0 && int32(len(running)) >= maxRunningBuilds {
+		return false, "maximum number of running builds reached"
+	}
+
+	return true, ""
+}
+
+// observeQueueDepth reports the number of builds waiting to be scheduled in each namespace,
+// accounting for the given build being scheduled
+func observeQueueDepth(builds []v1.Build, build *v1.Build, scheduled bool) {
+	depth := make(map[string]int)
+	for _, b := range builds {
+		if _, ok := depth[b.Namespace]; !ok {
+			depth[b.Namespace] = 0
+		}
+		if b.Status.Phase != v1.BuildPhaseScheduling {
+			continue
+		}
+		if scheduled && b.Namespace == build.Namespace && b.Name == build.Name {
+			continue
+		}
+		depth[b.Namespace]++
+	}
+	for namespace, queued := range depth {
+		queueDepth.WithLabelValues(namespace).Set(float64(queued))
+	}
+}
+
+func isBuildRunning(build *v1.Build) bool {
+	return build.Status.Phase == v1.BuildPhasePending || build.Status.Phase == v1.BuildPhaseRunning
+}
+
+// sharesLineage tells whether the running build may produce an image that the given build can use as base image,
+// that is when the latter is an incremental build, both share the same namespace, runtime and base image,
+// and the dependencies of the running build are a subset of the ones of the given build
+func sharesLineage(build *v1.Build, running *v1.Build) bool {
+	if build.Namespace != running.Namespace {
+		return false
+	}
+	task := getBuilderTask(build)
+	if task == nil || !util.StringSliceExists(task.Steps, builder.Steps.IncrementalImageContext.ID()) {
+		return false
+	}
+	runningTask := getBuilderTask(running)
+	if runningTask == nil {
+		return false
+	}
+	if task.Runtime.Version != runningTask.Runtime.Version ||
+		task.Runtime.Provider != runningTask.Runtime.Provider ||
+		task.BaseImage != runningTask.BaseImage {
+		return false
+	}
+	for _, d := range runningTask.Dependencies {
+		if !util.StringSliceExists(task.Dependencies, d) {
+			return false
+		}
+	}
+	return true
+}
+
+func getBuilderTask(build *v1.Build) *v1.BuilderTask {
+	for _, t := range build.Spec.Tasks {
+		if t.Builder != nil {
+			return t.Builder
+		}
+	}
+	return nil
+}
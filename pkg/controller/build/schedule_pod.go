@@ -66,21 +66,30 @@ func (action *schedulePodAction) Handle(ctx context.Context, build *v1.Build) (*
 	action.lock.Lock()
 	defer action.lock.Unlock()
 
-	builds := &v1.BuildList{}
-	// We use the non-caching client as informers cache is not invalidated nor updated
-	// atomically by write operations
-	err := action.reader.List(ctx, builds, client.InNamespace(build.Namespace))
+	builds, err := listBuilds(ctx, action.client, action.reader, build)
 	if err != nil {
 		return nil, err
 	}
 
-	// Emulate a serialized working queue to only allow one build to run at a given time.
-	// This is currently necessary for the incremental build to work as expected.
-	for _, b := range builds.Items {
-		if b.Status.Phase == v1.BuildPhasePending || b.Status.Phase == v1.BuildPhaseRunning {
-			// Let's requeue the build in case one is already running
+	maxRunningBuilds, err := getMaxRunningBuilds(ctx, action.client, build, v1.IntegrationPlatformBuildStrategyPod)
+	if err != nil {
+		return nil, err
+	}
+
+	// Limit the number of builds running at the same time, share them fairly across namespaces, ordering the
+	// builds of each namespace by priority, and serialize the builds sharing the same lineage so that incremental
+	// builds work as expected
+	ok, position, reason := schedule(build, builds, maxRunningBuilds)
+	observeQueueDepth(builds, build, ok)
+	if !ok {
+		// Let's requeue the build
+		action.L.Debug("Build scheduling postponed", "reason", reason, "position", position)
+		if build.Status.QueuePosition == position {
 			return nil, nil
 		}
+		target := build.DeepCopy()
+		target.Status.QueuePosition = position
+		return target, nil
 	}
 
 	pod, err := getBuilderPod(ctx, action.client, build)
@@ -110,6 +119,7 @@ func (action *schedulePodAction) Handle(ctx context.Context, build *v1.Build) (*
 	}
 
 	build.Status.Phase = v1.BuildPhasePending
+	build.Status.QueuePosition = 0
 
 	return build, nil
 }
@@ -20,6 +20,7 @@ package build
 import (
 	"context"
 	"fmt"
+	"io"
 	"sync"
 	"time"
 
@@ -30,6 +31,8 @@ import (
 	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
 	"github.com/apache/camel-k/pkg/builder"
 	camelevent "github.com/apache/camel-k/pkg/event"
+	"github.com/apache/camel-k/pkg/util/cancellable"
+	k8slog "github.com/apache/camel-k/pkg/util/kubernetes/log"
 	"github.com/apache/camel-k/pkg/util/patch"
 )
 
@@ -66,27 +69,37 @@ func (action *scheduleRoutineAction) Handle(ctx context.Context, build *v1.Build
 	action.lock.Lock()
 	defer action.lock.Unlock()
 
-	builds := &v1.BuildList{}
-	// We use the non-caching client as informers cache is not invalidated nor updated
-	// atomically by write operations
-	err := action.reader.List(ctx, builds, client.InNamespace(build.Namespace))
+	builds, err := listBuilds(ctx, action.client, action.reader, build)
 	if err != nil {
 		return nil, err
 	}
 
-	// Emulate a serialized working queue to only allow one build to run at a given time.
-	// This is currently necessary for the incremental build to work as expected.
-	for _, b := range builds.Items {
-		if b.Status.Phase == v1.BuildPhasePending || b.Status.Phase == v1.BuildPhaseRunning {
-			// Let's requeue the build in case one is already running
+	maxRunningBuilds, err := getMaxRunningBuilds(ctx, action.client, build, v1.IntegrationPlatformBuildStrategyRoutine)
+	if err != nil {
+		return nil, err
+	}
+
+	// Limit the number of builds running at the same time, share them fairly across namespaces, ordering the
+	// builds of each namespace by priority, and serialize the builds sharing the same lineage so that incremental
+	// builds work as expected
+	ok, position, reason := schedule(build, builds, maxRunningBuilds)
+	observeQueueDepth(builds, build, ok)
+	if !ok {
+		// Let's requeue the build
+		action.L.Debug("Build scheduling postponed", "reason", reason, "position", position)
+		if build.Status.QueuePosition == position {
 			return nil, nil
 		}
+		target := build.DeepCopy()
+		target.Status.QueuePosition = position
+		return target, nil
 	}
 
 	// Transition the build to pending state
 	// This must be done in the critical section rather than delegated to the controller
 	target := build.DeepCopy()
 	target.Status.Phase = v1.BuildPhasePending
+	target.Status.QueuePosition = 0
 	action.L.Info("Build state transition", "phase", target.Status.Phase)
 	err = action.client.Status().Update(ctx, target)
 	if err != nil {
@@ -98,16 +111,19 @@ func (action *scheduleRoutineAction) Handle(ctx context.Context, build *v1.Build
 
 	camelevent.NotifyBuildUpdated(ctx, action.client, action.recorder, build, target)
 
-	// Start the build asynchronously to avoid blocking the reconcile loop
-	action.routines.Store(build.Name, true)
+	// Start the build asynchronously to avoid blocking the reconcile loop,
+	// with a context that can be cancelled to interrupt the build
+	buildCtx := cancellable.NewContextWithParent(context.Background())
+	action.routines.Store(routineKey(build), buildCtx)
 
-	go action.runBuild(ctx, build)
+	go action.runBuild(buildCtx, build)
 
 	return nil, nil
 }
 
-func (action *scheduleRoutineAction) runBuild(ctx context.Context, build *v1.Build) {
-	defer action.routines.Delete(build.Name)
+func (action *scheduleRoutineAction) runBuild(ctx cancellable.Context, build *v1.Build) {
+	defer action.routines.Delete(routineKey(build))
+	defer ctx.Cancel()
 
 	now := metav1.Now()
 	status := v1.BuildStatus{
@@ -118,6 +134,21 @@ func (action *scheduleRoutineAction) runBuild(ctx context.Context, build *v1.Bui
 		return
 	}
 
+	// Persist the build output, that otherwise gets mixed with the operator logs.
+	// The log outlives the build routine, so that it gets persisted when the build is cancelled.
+	var output io.Writer
+	logs, err := k8slog.NewBuildLogWriter(context.Background(), action.client, build)
+	if err != nil {
+		action.L.Error(err, "Cannot persist build log", "build", build.Name)
+	} else {
+		output = logs
+		defer func() {
+			if err := logs.Close(); err != nil {
+				action.L.Error(err, "Cannot persist build log", "build", build.Name)
+			}
+		}()
+	}
+
 	for i, task := range build.Spec.Tasks {
 		if task.Builder == nil {
 			duration := metav1.Now().Sub(build.Status.StartedAt.Time)
@@ -136,18 +167,44 @@ func (action *scheduleRoutineAction) runBuild(ctx context.Context, build *v1.Bui
 			break
 		}
 
-		status := action.builder.Run(*task.Builder)
+		// Steps executed by previous tasks
+		previous := build.Status.Steps
+
+		status := action.builder.Run(ctx, *task.Builder, func(steps []v1.BuildStepStatus) {
+			if ctx.Err() != nil {
+				// The build has been cancelled
+				return
+			}
+			progress := build.Status.DeepCopy()
+			progress.Steps = append(append([]v1.BuildStepStatus{}, previous...), steps...)
+			// Report the build progress, on a best-effort basis
+			_ = action.updateBuildStatus(ctx, build, *progress)
+		}, output)
+		status.Steps = append(append([]v1.BuildStepStatus{}, previous...), status.Steps...)
+		if status.Phase == v1.BuildPhaseInterrupted {
+			// The build has been cancelled, and its status already reports the interruption
+			action.L.Infof("Build %s interrupted", build.Name)
+			break
+		}
 		lastTask := i == len(build.Spec.Tasks)-1
 		taskFailed := status.Phase == v1.BuildPhaseFailed
 		if lastTask && !taskFailed {
 			status.Phase = v1.BuildPhaseSucceeded
 		}
 		if lastTask || taskFailed {
+			// Persist the build log before the build completes, so that it can be followed until the end
+			if logs != nil {
+				if err := logs.Flush(); err != nil {
+					action.L.Error(err, "Cannot persist build log", "build", build.Name)
+				}
+			}
+
 			duration := metav1.Now().Sub(build.Status.StartedAt.Time)
 			status.Duration = duration.String()
 
 			// Account for the Build metrics
 			observeBuildResult(build, status.Phase, duration)
+			observeBuildSteps(status.Steps)
 		}
 
 		err := action.updateBuildStatus(ctx, build, status)
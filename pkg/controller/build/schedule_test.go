@@ -0,0 +1,242 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package build
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/builder"
+	"github.com/apache/camel-k/pkg/platform"
+	"github.com/apache/camel-k/pkg/util/test"
+)
+
+func newTestBuild(name string, phase v1.BuildPhase, dependencies ...string) v1.Build {
+	return v1.Build{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      name,
+		},
+		Spec: v1.BuildSpec{
+			Tasks: []v1.Task{
+				{
+					Builder: &v1.BuilderTask{
+						BaseTask: v1.BaseTask{
+							Name: "builder",
+						},
+						Runtime: v1.RuntimeSpec{
+							Version:  "1.0.0",
+							Provider: v1.RuntimeProviderQuarkus,
+						},
+						Dependencies: dependencies,
+						Steps:        builder.StepIDsFor(builder.DefaultSteps...),
+					},
+				},
+			},
+		},
+		Status: v1.BuildStatus{
+			Phase: phase,
+		},
+	}
+}
+
+func TestScheduleMaxRunningBuilds(t *testing.T) {
+	build := newTestBuild("build", v1.BuildPhaseScheduling, "camel:log", "camel:timer")
+	builds := []v1.Build{
+		build,
+		newTestBuild("running-1", v1.BuildPhaseRunning, "camel:http"),
+		newTestBuild("pending", v1.BuildPhasePending, "camel:kafka"),
+		newTestBuild("succeeded", v1.BuildPhaseSucceeded, "camel:log"),
+	}
+
+	ok, position, _ := schedule(&build, builds, 3)
+	assert.True(t, ok)
+	assert.Equal(t, int32(1), position)
+
+	ok, _, reason := schedule(&build, builds, 2)
+	assert.False(t, ok)
+	assert.Equal(t, "maximum number of running builds reached", reason)
+}
+
+func TestScheduleLineage(t *testing.T) {
+	build := newTestBuild("build", v1.BuildPhaseScheduling, "camel:log", "camel:timer")
+
+	// The running build may provide the base image of the scheduling one
+	ok, _, reason := schedule(&build, []v1.Build{build, newTestBuild("base", v1.BuildPhaseRunning, "camel:log")}, 10)
+	assert.False(t, ok)
+	assert.Equal(t, "waiting for build base that may provide the base image", reason)
+
+	// The running build cannot provide the base image of the scheduling one
+	ok, _, _ = schedule(&build, []v1.Build{build, newTestBuild("other", v1.BuildPhaseRunning, "camel:log", "camel:http")}, 10)
+	assert.True(t, ok)
+
+	// Builds from other namespaces do not share lineage
+	base := newTestBuild("base", v1.BuildPhaseRunning, "camel:log")
+	base.Namespace = "other"
+	ok, _, _ = schedule(&build, []v1.Build{build, base}, 10)
+	assert.True(t, ok)
+
+	// Non incremental builds do not depend on other builds
+	build.Spec.Tasks[0].Builder.Steps = builder.StepIDsFor(builder.Steps.StandardImageContext)
+	ok, _, _ = schedule(&build, []v1.Build{build, newTestBuild("base", v1.BuildPhaseRunning, "camel:log")}, 10)
+	assert.True(t, ok)
+}
+
+func TestSchedulePriority(t *testing.T) {
+	now := time.Now()
+	build := newTestBuild("build", v1.BuildPhaseScheduling, "camel:log")
+	build.CreationTimestamp = metav1.NewTime(now)
+	older := newTestBuild("older", v1.BuildPhaseScheduling, "camel:http")
+	older.CreationTimestamp = metav1.NewTime(now.Add(-time.Minute))
+	builds := []v1.Build{build, older}
+
+	// The older build goes first
+	ok, position, _ := schedule(&build, builds, 1)
+	assert.False(t, ok)
+	assert.Equal(t, int32(2), position)
+
+	// Unless the build has a higher priority
+	build.Spec.Priority = 10
+	ok, position, _ = schedule(&build, builds, 1)
+	assert.True(t, ok)
+	assert.Equal(t, int32(1), position)
+
+	// Builds with a lower priority go last
+	build.Spec.Priority = v1.BuildPriorityRebuild
+	ok, position, _ = schedule(&build, builds, 2)
+	assert.True(t, ok)
+	assert.Equal(t, int32(2), position)
+}
+
+func TestScheduleFairness(t *testing.T) {
+	now := time.Now()
+	newBuild := func(namespace string, name string, phase v1.BuildPhase, age time.Duration) v1.Build {
+		b := newTestBuild(name, phase, "camel:"+name)
+		b.Namespace = namespace
+		b.CreationTimestamp = metav1.NewTime(now.Add(-age))
+		return b
+	}
+
+	build := newBuild("ns2", "build", v1.BuildPhaseScheduling, 0)
+	builds := []v1.Build{
+		build,
+		newBuild("ns1", "running", v1.BuildPhaseRunning, 3*time.Minute),
+		newBuild("ns1", "queued-1", v1.BuildPhaseScheduling, 2*time.Minute),
+		newBuild("ns1", "queued-2", v1.BuildPhaseScheduling, time.Minute),
+	}
+
+	// The namespace without running builds goes first, despite queuing later
+	ok, position, _ := schedule(&build, builds, 2)
+	assert.True(t, ok)
+	assert.Equal(t, int32(1), position)
+
+	// Namespaces are served in turn
+	queued := builds[3]
+	ok, position, _ = schedule(&queued, builds, 4)
+	assert.True(t, ok)
+	assert.Equal(t, int32(3), position)
+	ok, position, _ = schedule(&queued, builds, 3)
+	assert.False(t, ok)
+	assert.Equal(t, int32(3), position)
+
+	// Priorities do not take precedence over the other namespaces
+	builds[2].Spec.Priority = 100
+	builds[3].Spec.Priority = 1000
+	ok, position, _ = schedule(&build, builds, 2)
+	assert.True(t, ok)
+	assert.Equal(t, int32(1), position)
+	queued = builds[3]
+	ok, position, _ = schedule(&queued, builds, 3)
+	assert.True(t, ok)
+	assert.Equal(t, int32(2), position)
+}
+
+func TestMaxRunningBuildsOfGlobalOperator(t *testing.T) {
+	assert.NoError(t, os.Unsetenv("WATCH_NAMESPACE"))
+	assert.NoError(t, os.Setenv("NAMESPACE", "operator"))
+	defer os.Unsetenv("NAMESPACE")
+
+	newPlatform := func(namespace string, maxRunningBuilds int32) *v1.IntegrationPlatform {
+		return &v1.IntegrationPlatform{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: namespace,
+				Name:      platform.DefaultPlatformName,
+			},
+			Status: v1.IntegrationPlatformStatus{
+				IntegrationPlatformSpec: v1.IntegrationPlatformSpec{
+					Build: v1.IntegrationPlatformBuildSpec{
+						MaxRunningBuilds: maxRunningBuilds,
+					},
+				},
+				Phase: v1.IntegrationPlatformPhaseReady,
+			},
+		}
+	}
+	build := newTestBuild("build", v1.BuildPhaseScheduling)
+
+	// The limit of the operator platform applies to all the namespaces
+	c, err := test.NewFakeClient(newPlatform("ns", 1), newPlatform("operator", 5))
+	assert.NoError(t, err)
+	maxRunningBuilds, err := getMaxRunningBuilds(context.TODO(), c, &build, v1.IntegrationPlatformBuildStrategyRoutine)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(5), maxRunningBuilds)
+
+	// The default limit of the build strategy applies when there is no operator platform
+	c, err = test.NewFakeClient(newPlatform("ns", 1))
+	assert.NoError(t, err)
+	maxRunningBuilds, err = getMaxRunningBuilds(context.TODO(), c, &build, v1.IntegrationPlatformBuildStrategyRoutine)
+	assert.NoError(t, err)
+	assert.Equal(t, platform.GetDefaultMaxRunningBuilds(v1.IntegrationPlatformBuildStrategyRoutine), maxRunningBuilds)
+}
+
+func TestListBuildsOfGlobalOperator(t *testing.T) {
+	assert.NoError(t, os.Unsetenv("WATCH_NAMESPACE"))
+
+	build := newTestBuild("build", v1.BuildPhaseScheduling)
+	other := newTestBuild("other", v1.BuildPhaseScheduling)
+	other.Namespace = "other"
+	lock := corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "other",
+			Name:      platform.OperatorLockName,
+		},
+	}
+	c, err := test.NewFakeClient(&build, &other, &lock)
+	assert.NoError(t, err)
+
+	// The builds of the namespaces owned by other operators are ignored
+	builds, err := listBuilds(context.TODO(), c, c, &build)
+	assert.NoError(t, err)
+	assert.Len(t, builds, 1)
+	assert.Equal(t, "build", builds[0].Name)
+
+	// The namespace lookups are cached
+	assert.NoError(t, c.Delete(context.TODO(), &lock))
+	builds, err = listBuilds(context.TODO(), c, c, &build)
+	assert.NoError(t, err)
+	assert.Len(t, builds, 1)
+	assert.Contains(t, namespaceLookups, "other")
+}
@@ -20,6 +20,9 @@ package integration
 import (
 	"context"
 	"fmt"
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
 
 	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
 	"github.com/apache/camel-k/pkg/trait"
@@ -84,6 +87,7 @@ func (action *buildKitAction) Handle(ctx context.Context, integration *v1.Integr
 		if kit.Status.Phase == v1.IntegrationKitPhaseReady {
 			integration.Status.Image = kit.Status.Image
 			integration.SetIntegrationKit(kit)
+			integration.Status.RemoveCondition(v1.IntegrationConditionRebuildRequired)
 
 			if _, err := trait.Apply(ctx, action.client, integration, kit); err != nil {
 				return nil, err
@@ -101,6 +105,11 @@ func (action *buildKitAction) Handle(ctx context.Context, integration *v1.Integr
 		return nil, nil
 	}
 
+	layout, err := trait.IntegrationKitLayoutFor(integration.Spec.Traits)
+	if err != nil {
+		return nil, err
+	}
+
 	platformKitName := fmt.Sprintf("kit-%s", xid.New())
 	platformKit := v1.NewIntegrationKit(integration.Namespace, platformKitName)
 
@@ -113,6 +122,14 @@ func (action *buildKitAction) Handle(ctx context.Context, integration *v1.Integr
 		"camel.apache.org/created.by.version": integration.ResourceVersion,
 		"camel.apache.org/runtime.version":    integration.Status.RuntimeVersion,
 		"camel.apache.org/runtime.provider":   string(integration.Status.RuntimeProvider),
+		v1.IntegrationKitLayoutLabel:          layout,
+	}
+
+	// Rebuilds of running integrations get a lower priority than new deployments
+	if c := integration.Status.GetCondition(v1.IntegrationConditionRebuildRequired); c != nil && c.Status == corev1.ConditionTrue {
+		platformKit.Annotations = map[string]string{
+			v1.BuildPriorityAnnotation: strconv.Itoa(int(v1.BuildPriorityRebuild)),
+		}
 	}
 
 	// Set the kit to have the same characteristics as the integrations
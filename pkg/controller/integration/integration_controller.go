@@ -19,6 +19,7 @@ package integration
 
 import (
 	"context"
+	"time"
 
 	appsv1 "k8s.io/api/apps/v1"
 	"k8s.io/api/batch/v1beta1"
@@ -312,6 +313,14 @@ func (r *reconcileIntegration) Reconcile(request reconcile.Request) (reconcile.R
 		}
 	}
 
+	// Periodically check whether the access tokens used by Kamelets need to be refreshed
+	if instance.Status.Phase == v1.IntegrationPhaseRunning &&
+		instance.Status.GetCondition(v1.IntegrationConditionKameletsAuthorizationAvailable) != nil {
+		return reconcile.Result{
+			RequeueAfter: time.Minute,
+		}, nil
+	}
+
 	return reconcile.Result{}, nil
 }
 
@@ -59,6 +59,9 @@ func (action *monitorAction) Handle(ctx context.Context, integration *v1.Integra
 
 		integration.Initialize()
 		integration.Status.Digest = hash
+		// Track the rebuild, so that it gets a lower priority than the builds of new deployments
+		integration.Status.SetCondition(v1.IntegrationConditionRebuildRequired, corev1.ConditionTrue,
+			v1.IntegrationConditionRebuildRequiredReason, "the running integration needs a rebuild")
 
 		return integration, nil
 	}
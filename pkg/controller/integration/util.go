@@ -27,6 +27,7 @@ import (
 	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
 
 	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/trait"
 	"github.com/apache/camel-k/pkg/util"
 	"github.com/apache/camel-k/pkg/util/controller"
 	"github.com/apache/camel-k/pkg/util/kubernetes"
@@ -43,6 +44,11 @@ func LookupKitForIntegration(ctx context.Context, c k8sclient.Reader, integratio
 		return kit, nil
 	}
 
+	layout, err := trait.IntegrationKitLayoutFor(integration.Spec.Traits)
+	if err != nil {
+		return nil, err
+	}
+
 	options := []k8sclient.ListOption{
 		k8sclient.InNamespace(integration.Namespace),
 		k8sclient.MatchingLabels{
@@ -81,6 +87,11 @@ func LookupKitForIntegration(ctx context.Context, c k8sclient.Reader, integratio
 			continue
 		}
 
+		// Never mix JVM and native kits, accounting for the kits created before the layout label was introduced
+		if (kit.Labels[v1.IntegrationKitLayoutLabel] == v1.IntegrationKitLayoutNative) != (layout == v1.IntegrationKitLayoutNative) {
+			continue
+		}
+
 		ideps := len(integration.Status.Dependencies)
 		cdeps := len(kit.Spec.Dependencies)
 
@@ -271,3 +271,80 @@ func TestLookupKitForIntegration_DiscardKitsWithIncompatibleTraits(t *testing.T)
 	assert.NotNil(t, i)
 	assert.Equal(t, "my-kit-4", i.Name)
 }
+
+func TestLookupKitForIntegration_DiscardKitsWithIncompatibleLayout(t *testing.T) {
+	newKit := func(name string, layout string) *v1.IntegrationKit {
+		kit := &v1.IntegrationKit{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: v1.SchemeGroupVersion.String(),
+				Kind:       v1.IntegrationKitKind,
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "ns",
+				Name:      name,
+				Labels: map[string]string{
+					"camel.apache.org/kit.type":  v1.IntegrationKitTypePlatform,
+					v1.IntegrationKitLayoutLabel: layout,
+				},
+			},
+			Spec: v1.IntegrationKitSpec{
+				Dependencies: []string{
+					"camel-core",
+					"camel-irc",
+				},
+			},
+			Status: v1.IntegrationKitStatus{
+				Phase: v1.IntegrationKitPhaseReady,
+			},
+		}
+		if layout == v1.IntegrationKitLayoutNative {
+			kit.Spec.Traits = map[string]v1.TraitSpec{
+				"quarkus": test.TraitSpecFromMap(t, map[string]interface{}{
+					"native": true,
+				}),
+			}
+		}
+		return kit
+	}
+
+	integration := &v1.Integration{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: v1.SchemeGroupVersion.String(),
+			Kind:       v1.IntegrationKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "my-integration",
+		},
+		Spec: v1.IntegrationSpec{
+			Traits: map[string]v1.TraitSpec{
+				"quarkus": test.TraitSpecFromMap(t, map[string]interface{}{
+					"native": true,
+				}),
+			},
+		},
+		Status: v1.IntegrationStatus{
+			Dependencies: []string{
+				"camel-core",
+				"camel-irc",
+			},
+		},
+	}
+
+	// A native integration does not use JVM kits
+	c, err := test.NewFakeClient(newKit("my-kit-1", v1.IntegrationKitLayoutFastJar))
+	assert.Nil(t, err)
+
+	i, err := LookupKitForIntegration(context.TODO(), c, integration)
+	assert.Nil(t, err)
+	assert.Nil(t, i)
+
+	// A native integration uses native kits
+	c, err = test.NewFakeClient(newKit("my-kit-1", v1.IntegrationKitLayoutFastJar), newKit("my-kit-2", v1.IntegrationKitLayoutNative))
+	assert.Nil(t, err)
+
+	i, err = LookupKitForIntegration(context.TODO(), c, integration)
+	assert.Nil(t, err)
+	assert.NotNil(t, i)
+	assert.Equal(t, "my-kit-2", i.Name)
+}
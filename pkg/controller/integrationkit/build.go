@@ -20,6 +20,7 @@ package integrationkit
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -68,9 +69,14 @@ func (action *buildAction) handleBuildSubmitted(ctx context.Context, kit *v1.Int
 		return nil, err
 	}
 
+	if err == nil && build.Status.Phase == v1.BuildPhaseInterrupted {
+		// The build has been cancelled before it started running
+		kit.Status.Phase = v1.IntegrationKitPhaseError
+		return kit, nil
+	}
+
 	if err != nil && k8serrors.IsNotFound(err) ||
 		build.Status.Phase == v1.BuildPhaseError ||
-		build.Status.Phase == v1.BuildPhaseInterrupted ||
 		build.Status.Phase == v1.BuildPhaseSucceeded {
 
 		env, err := trait.Apply(ctx, action.client, nil, kit)
@@ -93,7 +99,8 @@ func (action *buildAction) handleBuildSubmitted(ctx context.Context, kit *v1.Int
 				Labels:    kubernetes.FilterCamelCreatorLabels(kit.Labels),
 			},
 			Spec: v1.BuildSpec{
-				Tasks: env.BuildTasks,
+				Tasks:    env.BuildTasks,
+				Priority: buildPriorityFor(kit),
 			},
 		}
 
@@ -191,3 +198,17 @@ func (action *buildAction) handleBuildRunning(ctx context.Context, kit *v1.Integ
 
 	return nil, nil
 }
+
+// buildPriorityFor returns the priority of the builds of the kit, as set by the build priority annotation
+func buildPriorityFor(kit *v1.IntegrationKit) int32 {
+	value, ok := kit.Annotations[v1.BuildPriorityAnnotation]
+	if !ok {
+		return 0
+	}
+	priority, err := strconv.ParseInt(value, 10, 32)
+	if err != nil {
+		Log.ForIntegrationKit(kit).Infof("Ignoring invalid build priority %q", value)
+		return 0
+	}
+	return int32(priority)
+}
@@ -21,7 +21,7 @@ import (
 	"context"
 
 	"github.com/apache/camel-k/pkg/apis/camel/v1alpha1"
-	kameletutils "github.com/apache/camel-k/pkg/kamelet"
+	corev1 "k8s.io/api/core/v1"
 )
 
 // NewMonitorAction returns an action that monitors the kamelet after it's fully initialized
@@ -38,9 +38,25 @@ func (action *monitorAction) Name() string {
 }
 
 func (action *monitorAction) CanHandle(kamelet *v1alpha1.Kamelet) bool {
-	return kamelet.Status.Phase == v1alpha1.KameletPhaseReady
+	return kamelet.Status.Phase == v1alpha1.KameletPhaseReady || kamelet.Status.Phase == v1alpha1.KameletPhaseError
 }
 
 func (action *monitorAction) Handle(ctx context.Context, kamelet *v1alpha1.Kamelet) (*v1alpha1.Kamelet, error) {
-	return kameletutils.Initialize(kamelet)
+	target, err := initializeAndValidate(ctx, action.client, kamelet)
+	if err != nil {
+		return nil, err
+	}
+
+	if target.Status.Phase == v1alpha1.KameletPhaseReady && target.IsDeprecated() {
+		target.Status.SetCondition(
+			v1alpha1.KameletConditionDeprecated,
+			corev1.ConditionTrue,
+			v1alpha1.KameletConditionReasonDeprecated,
+			target.DeprecationMessage(),
+		)
+	} else {
+		target.Status.RemoveCondition(v1alpha1.KameletConditionDeprecated)
+	}
+
+	return target, nil
 }
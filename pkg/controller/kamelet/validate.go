@@ -0,0 +1,83 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kamelet
+
+import (
+	"context"
+	"strings"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/apis/camel/v1alpha1"
+	"github.com/apache/camel-k/pkg/client"
+	kameletutils "github.com/apache/camel-k/pkg/kamelet"
+	"github.com/apache/camel-k/pkg/platform"
+	"github.com/apache/camel-k/pkg/util/camel"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// initializeAndValidate initializes the kamelet and then validates its spec, moving it to the error phase
+// when any violation is found
+func initializeAndValidate(ctx context.Context, c client.Client, kamelet *v1alpha1.Kamelet) (*v1alpha1.Kamelet, error) {
+	target, err := kameletutils.Initialize(kamelet)
+	if err != nil {
+		return nil, err
+	}
+	if target.Status.Phase != v1alpha1.KameletPhaseReady {
+		return target, nil
+	}
+
+	catalog, err := loadCatalog(ctx, c, kamelet.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := kameletutils.ValidateSpec(target, catalog)
+	if len(violations) == 0 {
+		return target, nil
+	}
+
+	messages := make([]string, 0, len(violations))
+	for _, v := range violations {
+		messages = append(messages, v.Message)
+	}
+	target.Status.Phase = v1alpha1.KameletPhaseError
+	target.Status.SetCondition(
+		v1alpha1.KameletConditionReady,
+		corev1.ConditionFalse,
+		violations[0].Reason,
+		strings.Join(messages, "; "),
+	)
+	return target, nil
+}
+
+// loadCatalog returns the catalog of the runtime used by the current platform, falling back to the default catalog
+func loadCatalog(ctx context.Context, c client.Client, namespace string) (*camel.RuntimeCatalog, error) {
+	if pl, err := platform.GetCurrentPlatform(ctx, c, namespace); err == nil && pl.Status.Build.RuntimeVersion != "" {
+		catalog, err := camel.LoadCatalog(ctx, c, namespace, v1.RuntimeSpec{
+			Version:  pl.Status.Build.RuntimeVersion,
+			Provider: v1.RuntimeProviderQuarkus,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if catalog != nil {
+			return catalog, nil
+		}
+	}
+	return camel.DefaultCatalog()
+}
@@ -24,15 +24,38 @@ import (
 	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
 	"github.com/apache/camel-k/pkg/apis/camel/v1alpha1"
 	"github.com/apache/camel-k/pkg/client"
+	kameletutils "github.com/apache/camel-k/pkg/kamelet"
+	"github.com/apache/camel-k/pkg/kamelet/repository"
 	"github.com/apache/camel-k/pkg/platform"
 	"github.com/apache/camel-k/pkg/util/bindings"
 	"github.com/apache/camel-k/pkg/util/knative"
 	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
-func createIntegrationFor(ctx context.Context, c client.Client, kameletbinding *v1alpha1.KameletBinding) (*v1.Integration, error) {
+// createIntegrationFor returns the integration materializing the binding, along with the secret holding the sensitive
+// properties of the Kamelets, if any. Kamelets are looked up in the given repository.
+func createIntegrationFor(ctx context.Context, c client.Client, repo repository.KameletRepository, kameletbinding *v1alpha1.KameletBinding) (*v1.Integration, *corev1.Secret, error) {
+	profile, err := determineProfile(ctx, c, kameletbinding)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return CreateIntegrationFor(bindings.BindingContext{
+		Ctx:        ctx,
+		Client:     c,
+		Namespace:  kameletbinding.Namespace,
+		Profile:    profile,
+		Repository: repo,
+	}, kameletbinding)
+}
+
+// CreateIntegrationFor translates the binding into an integration using the given binding context, that determines
+// the profile of the integration and how Kamelets are looked up. It can be used without a cluster when the context
+// provides a Kamelet repository and the endpoints only refer to Kamelets or URIs.
+func CreateIntegrationFor(bindingContext bindings.BindingContext, kameletbinding *v1alpha1.KameletBinding) (*v1.Integration, *corev1.Secret, error) {
 	controller := true
 	blockOwnerDeletion := true
 	it := v1.Integration{
@@ -55,58 +78,94 @@ func createIntegrationFor(ctx context.Context, c client.Client, kameletbinding *
 	if kameletbinding.Spec.Integration != nil {
 		it.Spec = *kameletbinding.Spec.Integration.DeepCopy()
 	}
-
-	profile, err := determineProfile(ctx, c, kameletbinding)
-	if err != nil {
-		return nil, err
+	// the replicas defined on the binding take precedence
+	if kameletbinding.Spec.Replicas != nil {
+		replicas := *kameletbinding.Spec.Replicas
+		it.Spec.Replicas = &replicas
 	}
-	it.Spec.Profile = profile
 
-	bindingContext := bindings.BindingContext{
-		Ctx:       ctx,
-		Client:    c,
-		Namespace: it.Namespace,
-		Profile:   profile,
-	}
+	it.Spec.Profile = bindingContext.Profile
 
 	from, err := bindings.Translate(bindingContext, v1alpha1.EndpointTypeSource, kameletbinding.Spec.Source)
 	if err != nil {
-		return nil, errors.Wrap(err, "could not determine source URI")
+		return nil, nil, errors.Wrap(err, "could not determine source URI")
+	}
+	steps := make([]*bindings.Binding, 0, len(kameletbinding.Spec.Steps))
+	for idx, step := range kameletbinding.Spec.Steps {
+		stepBinding, err := bindings.Translate(bindingContext, v1alpha1.EndpointTypeAction, step)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "could not determine URI for step %d", idx)
+		}
+		steps = append(steps, stepBinding)
 	}
 	to, err := bindings.Translate(bindingContext, v1alpha1.EndpointTypeSink, kameletbinding.Spec.Sink)
 	if err != nil {
-		return nil, errors.Wrap(err, "could not determine sink URI")
+		return nil, nil, errors.Wrap(err, "could not determine sink URI")
+	}
+
+	// source, steps and sink in order
+	endpoints := make([]*bindings.Binding, 0, len(steps)+2)
+	endpoints = append(endpoints, from)
+	endpoints = append(endpoints, steps...)
+	endpoints = append(endpoints, to)
+
+	errorHandler, errorHandlerSink, err := errorHandlerFor(bindingContext, kameletbinding.Spec.ErrorHandler)
+	if err != nil {
+		return nil, nil, err
+	}
+	if errorHandlerSink != nil {
+		endpoints = append(endpoints, errorHandlerSink)
 	}
 
-	if len(from.Traits) > 0 || len(to.Traits) > 0 {
+	for _, endpoint := range endpoints {
+		if len(endpoint.Traits) == 0 {
+			continue
+		}
 		if it.Spec.Traits == nil {
 			it.Spec.Traits = make(map[string]v1.TraitSpec)
 		}
-		for k, v := range from.Traits {
-			it.Spec.Traits[k] = v
-		}
-		for k, v := range to.Traits {
+		for k, v := range endpoint.Traits {
 			it.Spec.Traits[k] = v
 		}
 	}
 
+	secret, err := secretPropertiesFor(kameletbinding, endpoints)
+	if err != nil {
+		return nil, nil, err
+	}
+	mountSecretProperties(&it, secret)
+
+	flowSteps := make([]map[string]interface{}, 0, len(steps)+1)
+	for _, step := range steps {
+		flowSteps = append(flowSteps, map[string]interface{}{
+			"to": step.URI,
+		})
+	}
+	flowSteps = append(flowSteps, map[string]interface{}{
+		"to": to.URI,
+	})
+
 	flow := map[string]interface{}{
 		"from": map[string]interface{}{
-			"uri": from.URI,
-			"steps": []map[string]interface{}{
-				{
-					"to": to.URI,
-				},
-			},
+			"uri":   from.URI,
+			"steps": flowSteps,
 		},
 	}
+	if errorHandler != nil {
+		// the error handler must be defined before the route it applies to
+		encodedErrorHandler, err := json.Marshal(errorHandler)
+		if err != nil {
+			return nil, nil, err
+		}
+		it.Spec.Flows = append(it.Spec.Flows, v1.Flow{RawMessage: encodedErrorHandler})
+	}
 	encodedFlow, err := json.Marshal(flow)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	it.Spec.Flows = append(it.Spec.Flows, v1.Flow{RawMessage: encodedFlow})
 
-	return &it, nil
+	return &it, secret, nil
 }
 
 func determineProfile(ctx context.Context, c client.Client, binding *v1alpha1.KameletBinding) (v1.TraitProfile, error) {
@@ -137,3 +196,20 @@ func determineProfile(ctx context.Context, c client.Client, binding *v1alpha1.Ka
 	}
 	return v1.DefaultTraitProfile, nil
 }
+
+// invalidPropertiesFor returns a copy of the binding moved to the error phase when the given error
+// reports endpoint properties not matching the Kamelet definitions
+func invalidPropertiesFor(binding *v1alpha1.KameletBinding, err error) (*v1alpha1.KameletBinding, bool) {
+	var invalid *kameletutils.InvalidPropertiesError
+	if err == nil || !errors.As(err, &invalid) {
+		return nil, false
+	}
+	target := binding.DeepCopy()
+	target.Status.Phase = v1alpha1.KameletBindingPhaseError
+	target.Status.SetErrorCondition(
+		v1alpha1.KameletBindingConditionReady,
+		v1alpha1.KameletBindingConditionReasonInvalidProperties,
+		err,
+	)
+	return target, true
+}
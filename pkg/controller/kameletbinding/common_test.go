@@ -0,0 +1,129 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kameletbinding
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/apis/camel/v1alpha1"
+	"github.com/apache/camel-k/pkg/util/test"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestCreateIntegrationForSteps(t *testing.T) {
+	source := "timer:tick"
+	firstStep := "log:first"
+	secondStep := "log:second"
+	sink := "log:info"
+	deadLetter := "log:dead"
+	binding := v1alpha1.KameletBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "my-binding",
+		},
+		Spec: v1alpha1.KameletBindingSpec{
+			Integration: &v1.IntegrationSpec{
+				Profile: v1.TraitProfileKubernetes,
+			},
+			Source: v1alpha1.Endpoint{URI: &source},
+			Steps: []v1alpha1.Endpoint{
+				{URI: &firstStep},
+				{URI: &secondStep},
+			},
+			Sink: v1alpha1.Endpoint{URI: &sink},
+			ErrorHandler: &v1alpha1.ErrorHandlerSpec{
+				Type: v1alpha1.ErrorHandlerTypeDeadLetterChannel,
+				Sink: &v1alpha1.Endpoint{URI: &deadLetter},
+			},
+		},
+	}
+
+	c, err := test.NewFakeClient()
+	assert.Nil(t, err)
+
+	it, secret, err := createIntegrationFor(context.TODO(), c, nil, &binding)
+	assert.Nil(t, err)
+	assert.Nil(t, secret)
+	assert.Len(t, it.Spec.Flows, 2)
+
+	// the error handler is defined before the route
+	var errorHandler map[string]interface{}
+	assert.Nil(t, json.Unmarshal(it.Spec.Flows[0].RawMessage, &errorHandler))
+	assert.Equal(t, map[string]interface{}{
+		"error-handler": map[string]interface{}{
+			"dead-letter-channel": map[string]interface{}{
+				"dead-letter-uri": "log:dead",
+			},
+		},
+	}, errorHandler)
+
+	// the route goes from the source through the steps in order, then to the sink
+	var flow map[string]interface{}
+	assert.Nil(t, json.Unmarshal(it.Spec.Flows[1].RawMessage, &flow))
+	assert.Equal(t, map[string]interface{}{
+		"from": map[string]interface{}{
+			"uri": "timer:tick",
+			"steps": []interface{}{
+				map[string]interface{}{"to": "log:first"},
+				map[string]interface{}{"to": "log:second"},
+				map[string]interface{}{"to": "log:info"},
+			},
+		},
+	}, flow)
+}
+
+func TestCreateIntegrationForReplicas(t *testing.T) {
+	source := "timer:tick"
+	sink := "log:info"
+	replicas := int32(3)
+	integrationReplicas := int32(1)
+	binding := v1alpha1.KameletBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "my-binding",
+		},
+		Spec: v1alpha1.KameletBindingSpec{
+			Integration: &v1.IntegrationSpec{
+				Profile:  v1.TraitProfileKubernetes,
+				Replicas: &integrationReplicas,
+			},
+			Replicas: &replicas,
+			Source:   v1alpha1.Endpoint{URI: &source},
+			Sink:     v1alpha1.Endpoint{URI: &sink},
+		},
+	}
+
+	c, err := test.NewFakeClient()
+	assert.Nil(t, err)
+
+	// the replicas of the binding take precedence over the ones of the integration spec
+	it, _, err := createIntegrationFor(context.TODO(), c, nil, &binding)
+	assert.Nil(t, err)
+	assert.NotNil(t, it.Spec.Replicas)
+	assert.Equal(t, int32(3), *it.Spec.Replicas)
+
+	binding.Spec.Replicas = nil
+	it, _, err = createIntegrationFor(context.TODO(), c, nil, &binding)
+	assert.Nil(t, err)
+	assert.NotNil(t, it.Spec.Replicas)
+	assert.Equal(t, int32(1), *it.Spec.Replicas)
+}
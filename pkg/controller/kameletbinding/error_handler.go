@@ -0,0 +1,94 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kameletbinding
+
+import (
+	"fmt"
+
+	"github.com/apache/camel-k/pkg/apis/camel/v1alpha1"
+	"github.com/apache/camel-k/pkg/util/bindings"
+	"github.com/pkg/errors"
+)
+
+// errorHandlerFor translates the error handler of the binding into a Camel error handler definition,
+// returning also the binding of the dead letter sink, if any
+func errorHandlerFor(ctx bindings.BindingContext, spec *v1alpha1.ErrorHandlerSpec) (map[string]interface{}, *bindings.Binding, error) {
+	if spec == nil {
+		return nil, nil, nil
+	}
+
+	switch spec.Type {
+	case v1alpha1.ErrorHandlerTypeNone:
+		return map[string]interface{}{
+			"error-handler": map[string]interface{}{
+				"no-error-handler": map[string]interface{}{},
+			},
+		}, nil, nil
+	case v1alpha1.ErrorHandlerTypeLog, "":
+		handler := make(map[string]interface{})
+		if policy := redeliveryPolicyFor(spec.Redelivery); policy != nil {
+			handler["redelivery-policy"] = policy
+		}
+		return map[string]interface{}{
+			"error-handler": map[string]interface{}{
+				"default": handler,
+			},
+		}, nil, nil
+	case v1alpha1.ErrorHandlerTypeDeadLetterChannel:
+		if spec.Sink == nil {
+			return nil, nil, errors.New("a sink is required by the dead-letter-channel error handler")
+		}
+		sink, err := bindings.Translate(ctx, v1alpha1.EndpointTypeErrorHandler, *spec.Sink)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "could not determine error handler sink URI")
+		}
+		handler := map[string]interface{}{
+			"dead-letter-uri": sink.URI,
+		}
+		if policy := redeliveryPolicyFor(spec.Redelivery); policy != nil {
+			handler["redelivery-policy"] = policy
+		}
+		return map[string]interface{}{
+			"error-handler": map[string]interface{}{
+				"dead-letter-channel": handler,
+			},
+		}, sink, nil
+	default:
+		return nil, nil, fmt.Errorf("unsupported error handler type %q", spec.Type)
+	}
+}
+
+func redeliveryPolicyFor(spec *v1alpha1.RedeliverySpec) map[string]interface{} {
+	if spec == nil {
+		return nil
+	}
+	policy := make(map[string]interface{})
+	if spec.MaximumRedeliveries != nil {
+		policy["maximum-redeliveries"] = *spec.MaximumRedeliveries
+	}
+	if spec.RedeliveryDelay != nil {
+		policy["redelivery-delay"] = *spec.RedeliveryDelay
+	}
+	if spec.UseExponentialBackOff != nil {
+		policy["use-exponential-back-off"] = *spec.UseExponentialBackOff
+	}
+	if len(policy) == 0 {
+		return nil
+	}
+	return policy
+}
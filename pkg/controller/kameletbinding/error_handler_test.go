@@ -0,0 +1,107 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kameletbinding
+
+import (
+	"context"
+	"testing"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/apis/camel/v1alpha1"
+	"github.com/apache/camel-k/pkg/util/bindings"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestErrorHandler(t *testing.T) {
+	bindingContext := bindings.BindingContext{
+		Ctx:       context.TODO(),
+		Namespace: "default",
+		Profile:   v1.TraitProfileKubernetes,
+	}
+	deadLetterURI := "log:dead"
+	maximumRedeliveries := 3
+	redeliveryDelay := int64(2000)
+
+	handler, sink, err := errorHandlerFor(bindingContext, nil)
+	assert.NoError(t, err)
+	assert.Nil(t, handler)
+	assert.Nil(t, sink)
+
+	handler, sink, err = errorHandlerFor(bindingContext, &v1alpha1.ErrorHandlerSpec{
+		Type: v1alpha1.ErrorHandlerTypeNone,
+	})
+	assert.NoError(t, err)
+	assert.Nil(t, sink)
+	assert.Equal(t, map[string]interface{}{
+		"error-handler": map[string]interface{}{
+			"no-error-handler": map[string]interface{}{},
+		},
+	}, handler)
+
+	handler, sink, err = errorHandlerFor(bindingContext, &v1alpha1.ErrorHandlerSpec{
+		Type: v1alpha1.ErrorHandlerTypeLog,
+		Redelivery: &v1alpha1.RedeliverySpec{
+			MaximumRedeliveries: &maximumRedeliveries,
+		},
+	})
+	assert.NoError(t, err)
+	assert.Nil(t, sink)
+	assert.Equal(t, map[string]interface{}{
+		"error-handler": map[string]interface{}{
+			"default": map[string]interface{}{
+				"redelivery-policy": map[string]interface{}{
+					"maximum-redeliveries": 3,
+				},
+			},
+		},
+	}, handler)
+
+	handler, sink, err = errorHandlerFor(bindingContext, &v1alpha1.ErrorHandlerSpec{
+		Type: v1alpha1.ErrorHandlerTypeDeadLetterChannel,
+		Sink: &v1alpha1.Endpoint{
+			URI: &deadLetterURI,
+		},
+		Redelivery: &v1alpha1.RedeliverySpec{
+			MaximumRedeliveries: &maximumRedeliveries,
+			RedeliveryDelay:     &redeliveryDelay,
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "log:dead", sink.URI)
+	assert.Equal(t, map[string]interface{}{
+		"error-handler": map[string]interface{}{
+			"dead-letter-channel": map[string]interface{}{
+				"dead-letter-uri": "log:dead",
+				"redelivery-policy": map[string]interface{}{
+					"maximum-redeliveries": 3,
+					"redelivery-delay":     int64(2000),
+				},
+			},
+		},
+	}, handler)
+
+	_, _, err = errorHandlerFor(bindingContext, &v1alpha1.ErrorHandlerSpec{
+		Type: v1alpha1.ErrorHandlerTypeDeadLetterChannel,
+	})
+	assert.Error(t, err)
+
+	_, _, err = errorHandlerFor(bindingContext, &v1alpha1.ErrorHandlerSpec{
+		Type: "unknown",
+	})
+	assert.Error(t, err)
+}
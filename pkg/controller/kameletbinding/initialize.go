@@ -50,25 +50,43 @@ func (action *initializeAction) CanHandle(kameletbinding *v1alpha1.KameletBindin
 }
 
 func (action *initializeAction) Handle(ctx context.Context, kameletbinding *v1alpha1.KameletBinding) (*v1alpha1.KameletBinding, error) {
-	it, err := createIntegrationFor(ctx, action.client, kameletbinding)
+	// The repository is shared by the translation of the binding and the lookup of the icon
+	repo, err := repository.New(ctx, action.client, kameletbinding.Namespace, platform.GetOperatorNamespace())
 	if err != nil {
 		return nil, err
 	}
 
+	it, secret, err := createIntegrationFor(ctx, action.client, repo, kameletbinding)
+	if target, ok := invalidPropertiesFor(kameletbinding, err); ok {
+		return target, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	if secret != nil {
+		if err := kubernetes.ReplaceResource(ctx, action.client, secret); err != nil {
+			return nil, errors.Wrap(err, "could not create secret for kamelet binding properties")
+		}
+	}
+
 	if err := kubernetes.ReplaceResource(ctx, action.client, it); err != nil {
 		return nil, errors.Wrap(err, "could not create integration for kamelet binding")
 	}
 
+	if err := deleteStaleSecretProperties(ctx, action.client, kameletbinding, secret); err != nil {
+		return nil, errors.Wrap(err, "could not delete stale secrets for kamelet binding properties")
+	}
+
 	// propagate Kamelet icon (best effort)
-	action.propagateIcon(ctx, kameletbinding)
+	action.propagateIcon(ctx, repo, kameletbinding)
 
 	target := kameletbinding.DeepCopy()
 	target.Status.Phase = v1alpha1.KameletBindingPhaseCreating
 	return target, nil
 }
 
-func (action *initializeAction) propagateIcon(ctx context.Context, binding *v1alpha1.KameletBinding) {
-	icon, err := action.findIcon(ctx, binding)
+func (action *initializeAction) propagateIcon(ctx context.Context, repo repository.KameletRepository, binding *v1alpha1.KameletBinding) {
+	icon, err := action.findIcon(ctx, repo, binding)
 	if err != nil {
 		action.L.Errorf(err, "cannot find icon for kamelet binding %q", binding.Name)
 		return
@@ -98,7 +116,7 @@ func (action *initializeAction) propagateIcon(ctx context.Context, binding *v1al
 	}
 }
 
-func (action *initializeAction) findIcon(ctx context.Context, binding *v1alpha1.KameletBinding) (string, error) {
+func (action *initializeAction) findIcon(ctx context.Context, repo repository.KameletRepository, binding *v1alpha1.KameletBinding) (string, error) {
 	var kameletRef *corev1.ObjectReference
 	if binding.Spec.Source.Ref != nil && binding.Spec.Source.Ref.Kind == "Kamelet" && strings.HasPrefix(binding.Spec.Source.Ref.APIVersion, "camel.apache.org/") {
 		kameletRef = binding.Spec.Source.Ref
@@ -110,12 +128,8 @@ func (action *initializeAction) findIcon(ctx context.Context, binding *v1alpha1.
 		return "", nil
 	}
 
-	repo, err := repository.New(ctx, action.client, binding.Namespace, platform.GetOperatorNamespace())
-	if err != nil {
-		return "", err
-	}
-
-	kamelet, err := repo.Get(ctx, kameletRef.Name)
+	name, version := v1alpha1.ParseKameletReference(kameletRef.Name)
+	kamelet, err := repo.GetVersion(ctx, name, version)
 	if err != nil {
 		return "", err
 	}
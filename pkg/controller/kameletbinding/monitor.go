@@ -22,6 +22,8 @@ import (
 
 	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
 	"github.com/apache/camel-k/pkg/apis/camel/v1alpha1"
+	"github.com/apache/camel-k/pkg/kamelet/repository"
+	"github.com/apache/camel-k/pkg/platform"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
@@ -49,6 +51,18 @@ func (action *monitorAction) CanHandle(kameletbinding *v1alpha1.KameletBinding)
 }
 
 func (action *monitorAction) Handle(ctx context.Context, kameletbinding *v1alpha1.KameletBinding) (*v1alpha1.KameletBinding, error) {
+	repo, err := repository.New(ctx, action.client, kameletbinding.Namespace, platform.GetOperatorNamespace())
+	if err != nil {
+		return nil, err
+	}
+	// Refuse to touch the integration while the binding is invalid
+	expected, _, err := createIntegrationFor(ctx, action.client, repo, kameletbinding)
+	if target, ok := invalidPropertiesFor(kameletbinding, err); ok {
+		return target, nil
+	} else if err != nil {
+		return nil, err
+	}
+
 	key := client.ObjectKey{
 		Namespace: kameletbinding.Namespace,
 		Name:      kameletbinding.Name,
@@ -69,13 +83,10 @@ func (action *monitorAction) Handle(ctx context.Context, kameletbinding *v1alpha
 		return nil, errors.Wrapf(err, "could not load integration for KameletBinding %q", kameletbinding.Name)
 	}
 
-	// Check if the integration needs to be changed
-	expected, err := createIntegrationFor(ctx, action.client, kameletbinding)
-	if err != nil {
-		return nil, err
-	}
-
-	if !equality.Semantic.DeepDerivative(expected.Spec, it.Spec) {
+	// Check if the integration needs to be changed, scaling being handled separately
+	expectedSpec := expected.Spec.DeepCopy()
+	expectedSpec.Replicas = it.Spec.Replicas
+	if !equality.Semantic.DeepDerivative(*expectedSpec, it.Spec) {
 		// KameletBinding has changed and needs rebuild
 		target := kameletbinding.DeepCopy()
 		// Rebuild the integration
@@ -89,32 +100,71 @@ func (action *monitorAction) Handle(ctx context.Context, kameletbinding *v1alpha
 		return target, nil
 	}
 
+	// Scaling the binding only requires the integration to be scaled
+	if replicas := expected.Spec.Replicas; replicas != nil && (it.Spec.Replicas == nil || *it.Spec.Replicas != *replicas) {
+		scaled := it.DeepCopy()
+		scaled.Spec.Replicas = replicas
+		if err := action.client.Patch(ctx, scaled, client.MergeFrom(&it)); err != nil {
+			return nil, errors.Wrapf(err, "could not scale integration for KameletBinding %q", kameletbinding.Name)
+		}
+		it = *scaled
+	}
+
 	// Map integration phases to KameletBinding phases
 	target := kameletbinding.DeepCopy()
+	// Report the scale of the integration, as required by the scale subresource
+	target.Status.Replicas = it.Status.Replicas
+	target.Status.Selector = it.Status.Selector
+	ready := corev1.ConditionFalse
+	reason := ""
 	if it.Status.Phase == v1.IntegrationPhaseRunning {
 		target.Status.Phase = v1alpha1.KameletBindingPhaseReady
-		target.Status.SetCondition(
-			v1alpha1.KameletBindingConditionReady,
-			corev1.ConditionTrue,
-			"",
-			"",
-		)
+		ready = corev1.ConditionTrue
 	} else if it.Status.Phase == v1.IntegrationPhaseError {
 		target.Status.Phase = v1alpha1.KameletBindingPhaseError
-		target.Status.SetCondition(
-			v1alpha1.KameletBindingConditionReady,
-			corev1.ConditionFalse,
-			string(target.Status.Phase),
-			"",
-		)
+		reason = string(target.Status.Phase)
 	} else {
 		target.Status.Phase = v1alpha1.KameletBindingPhaseCreating
-		target.Status.SetCondition(
-			v1alpha1.KameletBindingConditionReady,
-			corev1.ConditionFalse,
-			string(target.Status.Phase),
-			"",
-		)
+		reason = string(target.Status.Phase)
+	}
+	message := ""
+	if integrationReady := it.Status.GetCondition(v1.IntegrationConditionReady); integrationReady != nil && ready != corev1.ConditionTrue {
+		// Report why the integration is not ready
+		message = integrationReady.Message
 	}
+	target.Status.SetCondition(v1alpha1.KameletBindingConditionReady, ready, reason, message)
+	propagateIntegrationConditions(target, &it)
 	return target, nil
 }
+
+// kameletBindingConditions are the conditions owned by the binding, that are not propagated from the integration
+var kameletBindingConditions = map[v1alpha1.KameletBindingConditionType]bool{
+	v1alpha1.KameletBindingConditionReady: true,
+}
+
+// propagateIntegrationConditions reports the conditions of the integration on the binding, so that the reason why
+// a binding is not ready is visible on the binding itself. The conditions owned by the binding are kept.
+func propagateIntegrationConditions(binding *v1alpha1.KameletBinding, it *v1.Integration) {
+	reported := make(map[v1alpha1.KameletBindingConditionType]bool, len(it.Status.Conditions))
+	for _, c := range it.Status.Conditions {
+		conditionType := v1alpha1.KameletBindingConditionType(c.Type)
+		if kameletBindingConditions[conditionType] {
+			continue
+		}
+		reported[conditionType] = true
+		binding.Status.SetConditions(v1alpha1.KameletBindingCondition{
+			Type:               conditionType,
+			Status:             c.Status,
+			LastUpdateTime:     c.LastUpdateTime,
+			LastTransitionTime: c.LastTransitionTime,
+			Reason:             c.Reason,
+			Message:            c.Message,
+		})
+	}
+	// conditions no longer reported by the integration are dropped
+	for _, c := range append([]v1alpha1.KameletBindingCondition(nil), binding.Status.Conditions...) {
+		if !kameletBindingConditions[c.Type] && !reported[c.Type] {
+			binding.Status.RemoveCondition(c.Type)
+		}
+	}
+}
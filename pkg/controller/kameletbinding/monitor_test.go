@@ -0,0 +1,218 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kameletbinding
+
+import (
+	"context"
+	"testing"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/apis/camel/v1alpha1"
+	"github.com/apache/camel-k/pkg/util/log"
+	"github.com/apache/camel-k/pkg/util/test"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestMonitorPropagatesIntegrationConditions(t *testing.T) {
+	source := "timer:tick"
+	sink := "log:info"
+	binding := v1alpha1.KameletBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "my-binding",
+		},
+		Spec: v1alpha1.KameletBindingSpec{
+			Integration: &v1.IntegrationSpec{
+				Profile: v1.TraitProfileKubernetes,
+			},
+			Source: v1alpha1.Endpoint{URI: &source},
+			Sink:   v1alpha1.Endpoint{URI: &sink},
+		},
+		Status: v1alpha1.KameletBindingStatus{
+			Phase: v1alpha1.KameletBindingPhaseCreating,
+			Conditions: []v1alpha1.KameletBindingCondition{
+				{
+					Type:   v1alpha1.KameletBindingConditionType(v1.IntegrationConditionCronJobAvailable),
+					Status: corev1.ConditionFalse,
+				},
+			},
+		},
+	}
+
+	c, err := test.NewFakeClient()
+	assert.Nil(t, err)
+
+	it, _, err := createIntegrationFor(context.TODO(), c, nil, &binding)
+	assert.Nil(t, err)
+	it.Status.Phase = v1.IntegrationPhaseError
+	it.Status.SetCondition(v1.IntegrationConditionKitAvailable, corev1.ConditionTrue, "", "")
+	it.Status.SetCondition(v1.IntegrationConditionDeploymentAvailable, corev1.ConditionFalse, "DeploymentUnavailable", "no replicas")
+	it.Status.SetCondition(v1.IntegrationConditionReady, corev1.ConditionFalse, "Error", "back-off restarting failed container")
+
+	c, err = test.NewFakeClient(it)
+	assert.Nil(t, err)
+
+	a := NewMonitorAction()
+	a.InjectLogger(log.Log)
+	a.InjectClient(c)
+
+	target, err := a.Handle(context.TODO(), &binding)
+	assert.Nil(t, err)
+	assert.NotNil(t, target)
+	assert.Equal(t, v1alpha1.KameletBindingPhaseError, target.Status.Phase)
+	assert.Len(t, target.Status.Conditions, 3)
+
+	ready := target.Status.GetCondition(v1alpha1.KameletBindingConditionReady)
+	assert.NotNil(t, ready)
+	assert.Equal(t, corev1.ConditionFalse, ready.Status)
+	assert.Equal(t, "Error", ready.Reason)
+	assert.Equal(t, "back-off restarting failed container", ready.Message)
+
+	kit := target.Status.GetCondition(v1alpha1.KameletBindingConditionType(v1.IntegrationConditionKitAvailable))
+	assert.NotNil(t, kit)
+	assert.Equal(t, corev1.ConditionTrue, kit.Status)
+
+	deployment := target.Status.GetCondition(v1alpha1.KameletBindingConditionType(v1.IntegrationConditionDeploymentAvailable))
+	assert.NotNil(t, deployment)
+	assert.Equal(t, corev1.ConditionFalse, deployment.Status)
+	assert.Equal(t, "DeploymentUnavailable", deployment.Reason)
+	assert.Equal(t, "no replicas", deployment.Message)
+
+	assert.Nil(t, target.Status.GetCondition(v1alpha1.KameletBindingConditionType(v1.IntegrationConditionCronJobAvailable)))
+}
+
+func TestMonitorKeepsBindingConditions(t *testing.T) {
+	source := "timer:tick"
+	sink := "log:info"
+	binding := v1alpha1.KameletBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "my-binding",
+		},
+		Spec: v1alpha1.KameletBindingSpec{
+			Integration: &v1.IntegrationSpec{
+				Profile: v1.TraitProfileKubernetes,
+			},
+			Source: v1alpha1.Endpoint{URI: &source},
+			Sink:   v1alpha1.Endpoint{URI: &sink},
+		},
+		Status: v1alpha1.KameletBindingStatus{
+			Phase: v1alpha1.KameletBindingPhaseCreating,
+		},
+	}
+
+	c, err := test.NewFakeClient()
+	assert.Nil(t, err)
+
+	it, _, err := createIntegrationFor(context.TODO(), c, nil, &binding)
+	assert.Nil(t, err)
+	it.Status.Phase = v1.IntegrationPhaseRunning
+	it.Status.SetCondition(v1.IntegrationConditionDeploymentAvailable, corev1.ConditionTrue, "DeploymentAvailable", "")
+	it.Status.SetCondition(v1.IntegrationConditionReady, corev1.ConditionFalse, "Progressing", "0/1 ready replicas")
+
+	c, err = test.NewFakeClient(it)
+	assert.Nil(t, err)
+
+	a := NewMonitorAction()
+	a.InjectLogger(log.Log)
+	a.InjectClient(c)
+
+	target, err := a.Handle(context.TODO(), &binding)
+	assert.Nil(t, err)
+	assert.NotNil(t, target)
+	assert.Equal(t, v1alpha1.KameletBindingPhaseReady, target.Status.Phase)
+	assert.Len(t, target.Status.Conditions, 2)
+
+	// the Ready condition of the binding is not overwritten by the one of the integration
+	ready := target.Status.GetCondition(v1alpha1.KameletBindingConditionReady)
+	assert.NotNil(t, ready)
+	assert.Equal(t, corev1.ConditionTrue, ready.Status)
+	assert.Equal(t, "", ready.Message)
+
+	deployment := target.Status.GetCondition(v1alpha1.KameletBindingConditionType(v1.IntegrationConditionDeploymentAvailable))
+	assert.NotNil(t, deployment)
+	assert.Equal(t, corev1.ConditionTrue, deployment.Status)
+}
+
+func TestMonitorScalesIntegration(t *testing.T) {
+	source := "timer:tick"
+	sink := "log:info"
+	binding := v1alpha1.KameletBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "my-binding",
+		},
+		Spec: v1alpha1.KameletBindingSpec{
+			Integration: &v1.IntegrationSpec{
+				Profile: v1.TraitProfileKubernetes,
+			},
+			Source: v1alpha1.Endpoint{URI: &source},
+			Sink:   v1alpha1.Endpoint{URI: &sink},
+		},
+		Status: v1alpha1.KameletBindingStatus{
+			Phase: v1alpha1.KameletBindingPhaseReady,
+		},
+	}
+
+	c, err := test.NewFakeClient()
+	assert.Nil(t, err)
+
+	it, _, err := createIntegrationFor(context.TODO(), c, nil, &binding)
+	assert.Nil(t, err)
+	replicas := int32(1)
+	it.Spec.Replicas = &replicas
+	it.Status.Phase = v1.IntegrationPhaseRunning
+	it.Status.Replicas = &replicas
+	it.Status.Selector = "camel.apache.org/integration=my-binding"
+
+	c, err = test.NewFakeClient(it)
+	assert.Nil(t, err)
+
+	a := NewMonitorAction()
+	a.InjectLogger(log.Log)
+	a.InjectClient(c)
+
+	// scaling the binding does not require the integration to be recreated
+	scale := int32(3)
+	binding.Spec.Replicas = &scale
+
+	target, err := a.Handle(context.TODO(), &binding)
+	assert.Nil(t, err)
+	assert.NotNil(t, target)
+	assert.Equal(t, v1alpha1.KameletBindingPhaseReady, target.Status.Phase)
+	assert.Equal(t, &replicas, target.Status.Replicas)
+	assert.Equal(t, "camel.apache.org/integration=my-binding", target.Status.Selector)
+
+	scaled := v1.Integration{}
+	assert.Nil(t, c.Get(context.TODO(), client.ObjectKey{Namespace: "ns", Name: "my-binding"}, &scaled))
+	assert.NotNil(t, scaled.Spec.Replicas)
+	assert.Equal(t, int32(3), *scaled.Spec.Replicas)
+	assert.Equal(t, v1.IntegrationPhaseRunning, scaled.Status.Phase)
+
+	// other changes require the integration to be recreated
+	other := "log:other"
+	binding.Spec.Sink = v1alpha1.Endpoint{URI: &other}
+
+	target, err = a.Handle(context.TODO(), &binding)
+	assert.Nil(t, err)
+	assert.NotNil(t, target)
+	assert.Equal(t, v1alpha1.KameletBindingPhaseNone, target.Status.Phase)
+}
@@ -0,0 +1,129 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kameletbinding
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"sort"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/apis/camel/v1alpha1"
+	"github.com/apache/camel-k/pkg/client"
+	"github.com/apache/camel-k/pkg/util/bindings"
+	"github.com/magiconair/properties"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	kameletBindingLabel = "camel.apache.org/kamelet.binding"
+
+	secretPropertiesKey = "kamelets.properties"
+)
+
+// secretPropertiesFor returns the secret holding the sensitive properties of the endpoints, if any.
+// The secret name contains a digest of its content, so that the integration is redeployed when properties change.
+func secretPropertiesFor(binding *v1alpha1.KameletBinding, endpoints []*bindings.Binding) (*corev1.Secret, error) {
+	props := make(map[string]string)
+	for _, endpoint := range endpoints {
+		for k, v := range endpoint.SecretProperties {
+			props[k] = v
+		}
+	}
+	if len(props) == 0 {
+		return nil, nil
+	}
+
+	keys := make([]string, 0, len(props))
+	for k := range props {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	content := properties.NewProperties()
+	for _, k := range keys {
+		if _, _, err := content.Set(k, props[k]); err != nil {
+			return nil, err
+		}
+	}
+	data := content.String()
+
+	controller := true
+	blockOwnerDeletion := true
+	return &corev1.Secret{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Secret",
+			APIVersion: "v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: binding.Namespace,
+			Name:      fmt.Sprintf("%s-kamelet-properties-%x", binding.Name, sha256.Sum256([]byte(data)))[:len(binding.Name)+28],
+			Labels: map[string]string{
+				kameletBindingLabel: binding.Name,
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion:         binding.APIVersion,
+					Kind:               binding.Kind,
+					Name:               binding.Name,
+					UID:                binding.UID,
+					Controller:         &controller,
+					BlockOwnerDeletion: &blockOwnerDeletion,
+				},
+			},
+		},
+		Type: corev1.SecretTypeOpaque,
+		Data: map[string][]byte{
+			secretPropertiesKey: []byte(data),
+		},
+	}, nil
+}
+
+// mountSecretProperties adds the secret holding the sensitive properties to the configuration of the integration,
+// so that it's mounted under the secrets mount path and loaded by the runtime
+func mountSecretProperties(it *v1.Integration, secret *corev1.Secret) {
+	if secret == nil {
+		return
+	}
+	it.Spec.Configuration = append(it.Spec.Configuration, v1.ConfigurationSpec{
+		Type:  "secret",
+		Value: secret.Name,
+	})
+}
+
+// deleteStaleSecretProperties deletes the secrets holding sensitive properties of previous versions of the binding
+func deleteStaleSecretProperties(ctx context.Context, c client.Client, binding *v1alpha1.KameletBinding, current *corev1.Secret) error {
+	secrets, err := c.CoreV1().Secrets(binding.Namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("%s=%s", kameletBindingLabel, binding.Name),
+	})
+	if err != nil {
+		return err
+	}
+	for _, secret := range secrets.Items {
+		if current != nil && secret.Name == current.Name {
+			continue
+		}
+		err := c.CoreV1().Secrets(binding.Namespace).Delete(ctx, secret.Name, metav1.DeleteOptions{})
+		if err != nil && !k8serrors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
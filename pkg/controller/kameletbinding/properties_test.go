@@ -0,0 +1,119 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kameletbinding
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/apis/camel/v1alpha1"
+	"github.com/apache/camel-k/pkg/util/log"
+	"github.com/apache/camel-k/pkg/util/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestSensitivePropertiesStoredInSecret(t *testing.T) {
+	kamelet := v1alpha1.Kamelet{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "my-source",
+		},
+		Spec: v1alpha1.KameletSpec{
+			Definition: &v1alpha1.JSONSchemaProps{
+				Properties: map[string]v1alpha1.JSONSchemaProp{
+					"user":     {Type: "string"},
+					"password": {Type: "string", Format: "password"},
+				},
+			},
+		},
+	}
+	sink := "log:info"
+	binding := v1alpha1.KameletBinding{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: v1alpha1.SchemeGroupVersion.String(),
+			Kind:       v1alpha1.KameletBindingKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "my-binding",
+		},
+		Spec: v1alpha1.KameletBindingSpec{
+			Integration: &v1.IntegrationSpec{
+				Profile: v1.TraitProfileKubernetes,
+			},
+			Source: v1alpha1.Endpoint{
+				Ref: &corev1.ObjectReference{
+					Kind:       v1alpha1.KameletKind,
+					APIVersion: v1alpha1.SchemeGroupVersion.String(),
+					Name:       "my-source",
+				},
+				Properties: &v1alpha1.EndpointProperties{
+					RawMessage: []byte(`{"user":"admin","password":"s3cr3t"}`),
+				},
+			},
+			Sink: v1alpha1.Endpoint{URI: &sink},
+		},
+	}
+	pl := v1.NewIntegrationPlatform("ns", "camel-k")
+	pl.Spec.Kamelet.Repositories = []v1.IntegrationPlatformKameletRepositorySpec{{URI: "none"}}
+	stale := corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "my-binding-kamelet-properties-00000000",
+			Labels:    map[string]string{kameletBindingLabel: "my-binding"},
+		},
+	}
+
+	c, err := test.NewFakeClient(&pl, &kamelet, &stale)
+	require.NoError(t, err)
+
+	a := NewInitializeAction()
+	a.InjectLogger(log.Log)
+	a.InjectClient(c)
+	target, err := a.Handle(context.TODO(), &binding)
+	require.NoError(t, err)
+	assert.Equal(t, v1alpha1.KameletBindingPhaseCreating, target.Status.Phase)
+
+	it := v1.Integration{}
+	require.NoError(t, c.Get(context.TODO(), k8sclient.ObjectKey{Namespace: "ns", Name: "my-binding"}, &it))
+	require.Len(t, it.Spec.Configuration, 1)
+	assert.Equal(t, "secret", it.Spec.Configuration[0].Type)
+	secretName := it.Spec.Configuration[0].Value
+	assert.True(t, strings.HasPrefix(secretName, "my-binding-kamelet-properties-"))
+
+	flows, err := json.Marshal(it.Spec.Flows)
+	require.NoError(t, err)
+	assert.Contains(t, string(flows), "kamelet:my-source?user=admin")
+	assert.NotContains(t, string(flows), "s3cr3t")
+
+	secret := corev1.Secret{}
+	require.NoError(t, c.Get(context.TODO(), k8sclient.ObjectKey{Namespace: "ns", Name: secretName}, &secret))
+	assert.Equal(t, "my-binding", secret.Labels[kameletBindingLabel])
+	assert.Equal(t, "camel.kamelet.my-source.password = s3cr3t\n", string(secret.Data[secretPropertiesKey]))
+
+	_, err = c.CoreV1().Secrets("ns").Get(context.TODO(), stale.Name, metav1.GetOptions{})
+	assert.True(t, k8serrors.IsNotFound(err))
+}
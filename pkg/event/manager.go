@@ -68,6 +68,9 @@ const (
 	// ReasonKameletPhaseUpdated --
 	ReasonKameletPhaseUpdated = "KameletPhaseUpdated"
 
+	// ReasonKameletDeprecated --
+	ReasonKameletDeprecated = "KameletDeprecated"
+
 	// ReasonKameletBindingError --
 	ReasonKameletBindingError = "KameletBindingError"
 	// ReasonKameletBindingConditionChanged --
@@ -106,6 +109,17 @@ func NotifyIntegrationUpdated(ctx context.Context, c client.Client, recorder rec
 		notifyIfConditionUpdated(recorder, new, oldConditions, new.Status.GetConditions(), "Integration", new.Name, ReasonIntegrationConditionChanged)
 	}
 	notifyIfPhaseUpdated(ctx, c, recorder, new, oldPhase, string(new.Status.Phase), "Integration", new.Name, ReasonIntegrationPhaseUpdated, "")
+
+	// Warn about deprecated kamelets used by the integration
+	if cond := new.Status.GetCondition(v1.IntegrationConditionKameletsDeprecated); cond != nil && cond.Status == corev1.ConditionTrue {
+		var oldCond *v1.IntegrationCondition
+		if old != nil {
+			oldCond = old.Status.GetCondition(v1.IntegrationConditionKameletsDeprecated)
+		}
+		if oldCond == nil || oldCond.Status != cond.Status || oldCond.Message != cond.Message {
+			recorder.Eventf(new, corev1.EventTypeWarning, ReasonKameletDeprecated, "Integration %s uses deprecated kamelets: %s", new.Name, cond.Message)
+		}
+	}
 }
 
 // NotifyIntegrationKitUpdated automatically generates events when an integration kit changes
@@ -201,15 +215,26 @@ func NotifyKameletBindingUpdated(ctx context.Context, c client.Client, recorder
 		return
 	}
 	oldPhase := ""
+	oldMessage := ""
 	var oldConditions []v1.ResourceCondition
 	if old != nil {
 		oldPhase = string(old.Status.Phase)
 		oldConditions = old.Status.GetConditions()
+		if ready := old.Status.GetCondition(v1alpha1.KameletBindingConditionReady); ready != nil {
+			oldMessage = ready.Message
+		}
 	}
 	if new.Status.Phase != v1alpha1.KameletBindingPhaseNone {
 		notifyIfConditionUpdated(recorder, new, oldConditions, new.Status.GetConditions(), "KameletBinding", new.Name, ReasonKameletBindingConditionChanged)
 	}
 	notifyIfPhaseUpdated(ctx, c, recorder, new, oldPhase, string(new.Status.Phase), "KameletBinding", new.Name, ReasonKameletBindingPhaseUpdated, "")
+	// Report why the binding has failed, as propagated from the integration, and each time the reason changes
+	if new.Status.Phase == v1alpha1.KameletBindingPhaseError {
+		if ready := new.Status.GetCondition(v1alpha1.KameletBindingConditionReady); ready != nil && ready.Message != "" &&
+			(oldPhase != string(new.Status.Phase) || oldMessage != ready.Message) {
+			recorder.Eventf(new, corev1.EventTypeWarning, ReasonKameletBindingError, "KameletBinding %s failed: %s", new.Name, ready.Message)
+		}
+	}
 }
 
 // NotifyKameletBindingError automatically generates error events when the kameletBinding reconcile cycle phase has an error
@@ -221,7 +246,7 @@ func NotifyKameletBindingError(ctx context.Context, c client.Client, recorder re
 	if k == nil {
 		return
 	}
-	recorder.Eventf(k, corev1.EventTypeWarning, ReasonKameletError, "Cannot reconcile KameletBinding %s: %v", k.Name, err)
+	recorder.Eventf(k, corev1.EventTypeWarning, ReasonKameletBindingError, "Cannot reconcile KameletBinding %s: %v", k.Name, err)
 }
 
 // NotifyBuildUpdated automatically generates events when a build changes
@@ -0,0 +1,120 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kamelet
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/apache/camel-k/pkg/apis/camel/v1alpha1"
+	"github.com/pkg/errors"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	// OAuth2ClientIDKey is the key of the client id in the Secret holding the OAuth 2.0 credentials of a Kamelet
+	OAuth2ClientIDKey = "clientId"
+	// OAuth2ClientSecretKey is the key of the client secret in the Secret holding the OAuth 2.0 credentials of a Kamelet
+	OAuth2ClientSecretKey = "clientSecret"
+	// OAuth2RefreshTokenKey is the key of the refresh token in the Secret holding the OAuth 2.0 credentials of a Kamelet
+	OAuth2RefreshTokenKey = "refreshToken"
+)
+
+// OAuth2Credentials contains the credentials used to obtain OAuth 2.0 tokens
+type OAuth2Credentials struct {
+	ClientID     string
+	ClientSecret string
+	RefreshToken string
+}
+
+// OAuth2CredentialsFromSecret reads the OAuth 2.0 credentials from the given Secret
+func OAuth2CredentialsFromSecret(secret *corev1.Secret) OAuth2Credentials {
+	credentials := OAuth2Credentials{
+		ClientID:     string(secret.Data[OAuth2ClientIDKey]),
+		ClientSecret: string(secret.Data[OAuth2ClientSecretKey]),
+		RefreshToken: string(secret.Data[OAuth2RefreshTokenKey]),
+	}
+	// string data is only merged into data by the API server on write
+	if v, ok := secret.StringData[OAuth2ClientIDKey]; ok {
+		credentials.ClientID = v
+	}
+	if v, ok := secret.StringData[OAuth2ClientSecretKey]; ok {
+		credentials.ClientSecret = v
+	}
+	if v, ok := secret.StringData[OAuth2RefreshTokenKey]; ok {
+		credentials.RefreshToken = v
+	}
+	return credentials
+}
+
+// Digest returns a value that changes whenever the credentials or the token request change
+func (c OAuth2Credentials) Digest(spec *v1alpha1.OAuth2Spec) string {
+	h := sha256.New()
+	for _, v := range []string{c.ClientID, c.ClientSecret, c.RefreshToken, string(spec.Flow), spec.TokenURL, strings.Join(spec.Scopes, " ")} {
+		h.Write([]byte(v))
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// OAuth2Token obtains an access token from the token endpoint of the given OAuth 2.0 specification
+func OAuth2Token(ctx context.Context, spec *v1alpha1.OAuth2Spec, credentials OAuth2Credentials) (*oauth2.Token, error) {
+	if spec.TokenURL == "" {
+		return nil, errors.New("no token URL defined")
+	}
+
+	switch spec.Flow {
+	case v1alpha1.OAuth2FlowClientCredentials, "":
+		config := clientcredentials.Config{
+			ClientID:     credentials.ClientID,
+			ClientSecret: credentials.ClientSecret,
+			TokenURL:     spec.TokenURL,
+			Scopes:       spec.Scopes,
+		}
+		token, err := config.Token(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot obtain access token using client credentials")
+		}
+		return token, nil
+	case v1alpha1.OAuth2FlowAuthorizationCode:
+		if credentials.RefreshToken == "" {
+			return nil, fmt.Errorf("a refresh token is required by the %s flow", spec.Flow)
+		}
+		config := oauth2.Config{
+			ClientID:     credentials.ClientID,
+			ClientSecret: credentials.ClientSecret,
+			Endpoint: oauth2.Endpoint{
+				AuthURL:  spec.AuthorizationURL,
+				TokenURL: spec.TokenURL,
+			},
+			Scopes: spec.Scopes,
+		}
+		token, err := config.TokenSource(ctx, &oauth2.Token{RefreshToken: credentials.RefreshToken}).Token()
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot refresh access token")
+		}
+		return token, nil
+	default:
+		return nil, fmt.Errorf("unsupported OAuth 2.0 flow %q", spec.Flow)
+	}
+}
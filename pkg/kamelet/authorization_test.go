@@ -0,0 +1,86 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kamelet
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/apache/camel-k/pkg/apis/camel/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestOAuth2Token(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.NoError(t, r.ParseForm())
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Form.Get("grant_type") {
+		case "client_credentials":
+			_, _ = w.Write([]byte(`{"access_token":"client-token","token_type":"bearer","expires_in":3600}`))
+		case "refresh_token":
+			assert.Equal(t, "my-refresh-token", r.Form.Get("refresh_token"))
+			_, _ = w.Write([]byte(`{"access_token":"user-token","token_type":"bearer","refresh_token":"new-refresh-token"}`))
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer server.Close()
+
+	credentials := OAuth2CredentialsFromSecret(&corev1.Secret{
+		Data: map[string][]byte{
+			OAuth2ClientIDKey:     []byte("my-client"),
+			OAuth2ClientSecretKey: []byte("my-secret"),
+		},
+		StringData: map[string]string{
+			OAuth2RefreshTokenKey: "my-refresh-token",
+		},
+	})
+	assert.Equal(t, OAuth2Credentials{
+		ClientID:     "my-client",
+		ClientSecret: "my-secret",
+		RefreshToken: "my-refresh-token",
+	}, credentials)
+
+	spec := v1alpha1.OAuth2Spec{
+		Flow:     v1alpha1.OAuth2FlowClientCredentials,
+		TokenURL: server.URL,
+		Scopes:   []string{"read"},
+	}
+	token, err := OAuth2Token(context.TODO(), &spec, credentials)
+	assert.NoError(t, err)
+	assert.Equal(t, "client-token", token.AccessToken)
+	assert.False(t, token.Expiry.IsZero())
+
+	digest := credentials.Digest(&spec)
+	spec.Flow = v1alpha1.OAuth2FlowAuthorizationCode
+	assert.NotEqual(t, digest, credentials.Digest(&spec))
+	token, err = OAuth2Token(context.TODO(), &spec, credentials)
+	assert.NoError(t, err)
+	assert.Equal(t, "user-token", token.AccessToken)
+	assert.Equal(t, "new-refresh-token", token.RefreshToken)
+
+	_, err = OAuth2Token(context.TODO(), &spec, OAuth2Credentials{ClientID: "my-client"})
+	assert.Error(t, err)
+
+	spec.Flow = "implicit"
+	_, err = OAuth2Token(context.TODO(), &spec, credentials)
+	assert.Error(t, err)
+}
@@ -0,0 +1,279 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repository
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/apache/camel-k/pkg/apis/camel/v1alpha1"
+)
+
+const (
+	// CacheDirEnvVar can be used to change the directory where the content of remote repositories is cached
+	CacheDirEnvVar = "KAMEL_KAMELET_CACHE_DIR"
+	// CacheTTLEnvVar can be used to change how long the content of remote repositories is cached, e.g. "10m" ("0" disables the cache)
+	CacheTTLEnvVar = "KAMEL_KAMELET_CACHE_TTL"
+)
+
+// maxCacheEntries bounds the number of entries kept in memory, the oldest entries being evicted first
+const maxCacheEntries = 1000
+
+// DefaultCacheTTL is the time the content of remote repositories is used without looking it up again
+var DefaultCacheTTL = 5 * time.Minute
+
+// defaultCache is shared by all the repositories created in the process
+var defaultCache = newKameletCache(cacheDir())
+
+// cacheEntry is the unit of data stored in the cache
+type cacheEntry struct {
+	Timestamp time.Time `json:"timestamp"`
+	Data      []byte    `json:"data"`
+}
+
+// kameletCache keeps entries in memory and, when a directory is given, on disk, so that they can be reused by other processes
+type kameletCache struct {
+	lock    sync.Mutex
+	dir     string
+	entries map[string]cacheEntry
+}
+
+func newKameletCache(dir string) *kameletCache {
+	return &kameletCache{
+		dir:     dir,
+		entries: make(map[string]cacheEntry),
+	}
+}
+
+func (c *kameletCache) get(key string) (cacheEntry, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if entry, ok := c.entries[key]; ok {
+		return entry, true
+	}
+	if c.dir == "" {
+		return cacheEntry{}, false
+	}
+	content, err := ioutil.ReadFile(c.fileName(key))
+	if err != nil {
+		return cacheEntry{}, false
+	}
+	var entry cacheEntry
+	if err := json.Unmarshal(content, &entry); err != nil {
+		return cacheEntry{}, false
+	}
+	c.add(key, entry)
+	return entry, true
+}
+
+func (c *kameletCache) put(key string, data []byte) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	entry := cacheEntry{
+		Timestamp: time.Now(),
+		Data:      data,
+	}
+	c.add(key, entry)
+	if c.dir == "" {
+		return
+	}
+	// the disk cache is best effort
+	if content, err := json.Marshal(entry); err == nil {
+		if err := os.MkdirAll(c.dir, 0700); err == nil {
+			tmp := c.fileName(key) + ".tmp"
+			if err := ioutil.WriteFile(tmp, content, 0600); err == nil {
+				_ = os.Rename(tmp, c.fileName(key))
+			}
+		}
+	}
+}
+
+// add stores the entry in memory, evicting the oldest entry when the cache is full. It must be called holding the lock.
+func (c *kameletCache) add(key string, entry cacheEntry) {
+	if _, ok := c.entries[key]; !ok && len(c.entries) >= maxCacheEntries {
+		oldest := ""
+		for k, e := range c.entries {
+			if oldest == "" || e.Timestamp.Before(c.entries[oldest].Timestamp) {
+				oldest = k
+			}
+		}
+		delete(c.entries, oldest)
+	}
+	c.entries[key] = entry
+}
+
+func (c *kameletCache) delete(key string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	delete(c.entries, key)
+	if c.dir != "" {
+		_ = os.Remove(c.fileName(key))
+	}
+}
+
+func (c *kameletCache) fileName(key string) string {
+	return filepath.Join(c.dir, fmt.Sprintf("%x.json", sha256.Sum256([]byte(key))))
+}
+
+// httpCache adapts the kameletCache to cache HTTP responses, that are revalidated using their ETag when stale
+type httpCache struct {
+	cache  *kameletCache
+	prefix string
+}
+
+func (c *httpCache) Get(key string) ([]byte, bool) {
+	entry, ok := c.cache.get(c.prefix + key)
+	return entry.Data, ok
+}
+
+func (c *httpCache) Set(key string, data []byte) {
+	c.cache.put(c.prefix+key, data)
+}
+
+func (c *httpCache) Delete(key string) {
+	c.cache.delete(c.prefix + key)
+}
+
+// cachingKameletRepository is a decorator that caches the content of the delegate repository for a given TTL.
+// Expired content is still used when the delegate repository cannot be reached.
+// Entries are keyed by the credentials used to access the repository, so that they are never shared with
+// clients that are not allowed to read the repository.
+type cachingKameletRepository struct {
+	delegate KameletRepository
+	cache    *kameletCache
+	ttl      time.Duration
+	prefix   string
+}
+
+func newCachingKameletRepository(delegate KameletRepository, cache *kameletCache, ttl time.Duration, credentials repositoryCredentials) KameletRepository {
+	return &cachingKameletRepository{
+		delegate: delegate,
+		cache:    cache,
+		ttl:      ttl,
+		prefix:   credentialsDigest(credentials) + "/",
+	}
+}
+
+// Enforce type
+var _ KameletRepository = &cachingKameletRepository{}
+
+func (c *cachingKameletRepository) List(ctx context.Context) ([]string, error) {
+	var res []string
+	key := c.key("list")
+	if c.lookup(key, true, &res) {
+		return res, nil
+	}
+	res, err := c.delegate.List(ctx)
+	if err != nil {
+		if c.lookup(key, false, &res) {
+			return res, nil
+		}
+		return nil, err
+	}
+	c.store(key, res)
+	return res, nil
+}
+
+func (c *cachingKameletRepository) Get(ctx context.Context, name string) (*v1alpha1.Kamelet, error) {
+	var kamelet *v1alpha1.Kamelet
+	key := c.key("kamelet/" + name)
+	if c.lookup(key, true, &kamelet) {
+		return kamelet, nil
+	}
+	kamelet, err := c.delegate.Get(ctx, name)
+	if err != nil {
+		if c.lookup(key, false, &kamelet) {
+			return kamelet, nil
+		}
+		return nil, err
+	}
+	c.store(key, kamelet)
+	return kamelet, nil
+}
+
+func (c *cachingKameletRepository) GetVersion(ctx context.Context, name string, version string) (*v1alpha1.Kamelet, error) {
+	return getVersion(ctx, c, name, version)
+}
+
+func (c *cachingKameletRepository) String() string {
+	return c.delegate.String()
+}
+
+func (c *cachingKameletRepository) key(item string) string {
+	return c.prefix + c.delegate.String() + "/" + item
+}
+
+// credentialsDigest identifies the credentials used to access a repository, without exposing them
+func credentialsDigest(credentials repositoryCredentials) string {
+	content := credentials.token + "\x00" + string(credentials.dockerConfig) + "\x00" + credentials.insecureRegistry
+	return fmt.Sprintf("%x", sha256.Sum256([]byte(content)))
+}
+
+// lookup decodes the cached entry into the target, if present and, when requested, not expired
+func (c *cachingKameletRepository) lookup(key string, fresh bool, target interface{}) bool {
+	entry, ok := c.cache.get(key)
+	if !ok || (fresh && time.Since(entry.Timestamp) > c.ttl) {
+		return false
+	}
+	return json.Unmarshal(entry.Data, target) == nil
+}
+
+func (c *cachingKameletRepository) store(key string, value interface{}) {
+	if data, err := json.Marshal(value); err == nil {
+		c.cache.put(key, data)
+	}
+}
+
+// withCache wraps a remote repository with the cache, unless caching is disabled
+func withCache(repo KameletRepository, credentials repositoryCredentials) KameletRepository {
+	ttl := cacheTTL()
+	if ttl <= 0 {
+		return repo
+	}
+	return newCachingKameletRepository(repo, defaultCache, ttl, credentials)
+}
+
+func cacheTTL() time.Duration {
+	if value, ok := os.LookupEnv(CacheTTLEnvVar); ok {
+		if ttl, err := time.ParseDuration(value); err == nil {
+			return ttl
+		}
+	}
+	return DefaultCacheTTL
+}
+
+// cacheDir returns the directory where the cache is persisted, or an empty string if the cache is kept in memory only
+func cacheDir() string {
+	if dir, ok := os.LookupEnv(CacheDirEnvVar); ok {
+		return dir
+	}
+	if dir, err := os.UserCacheDir(); err == nil {
+		return filepath.Join(dir, "camel-k", "kamelets")
+	}
+	return filepath.Join(os.TempDir(), "camel-k-kamelets-cache")
+}
@@ -0,0 +1,153 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/apache/camel-k/pkg/apis/camel/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// countingKameletRepository counts the lookups and fails them on demand
+type countingKameletRepository struct {
+	lists int
+	gets  int
+	fail  bool
+}
+
+func (c *countingKameletRepository) List(_ context.Context) ([]string, error) {
+	c.lists++
+	if c.fail {
+		return nil, errors.New("unavailable")
+	}
+	return []string{"kamelet1"}, nil
+}
+
+func (c *countingKameletRepository) Get(_ context.Context, name string) (*v1alpha1.Kamelet, error) {
+	c.gets++
+	if c.fail {
+		return nil, errors.New("unavailable")
+	}
+	if name != "kamelet1" {
+		return nil, nil
+	}
+	return &v1alpha1.Kamelet{ObjectMeta: metav1.ObjectMeta{Name: name}}, nil
+}
+
+func (c *countingKameletRepository) GetVersion(ctx context.Context, name string, version string) (*v1alpha1.Kamelet, error) {
+	return getVersion(ctx, c, name, version)
+}
+
+func (c *countingKameletRepository) String() string {
+	return "Counting"
+}
+
+func TestCachingRepository(t *testing.T) {
+	ctx := context.Background()
+	dir, err := ioutil.TempDir("", "camel-k-kamelets-cache-test")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	delegate := &countingKameletRepository{}
+	repo := newCachingKameletRepository(delegate, newKameletCache(dir), time.Hour, repositoryCredentials{})
+
+	for i := 0; i < 2; i++ {
+		list, err := repo.List(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"kamelet1"}, list)
+		k, err := repo.Get(ctx, "kamelet1")
+		assert.NoError(t, err)
+		assert.Equal(t, "kamelet1", k.Name)
+		k, err = repo.Get(ctx, "missing")
+		assert.NoError(t, err)
+		assert.Nil(t, k)
+	}
+	assert.Equal(t, 1, delegate.lists)
+	assert.Equal(t, 2, delegate.gets)
+
+	// entries are persisted on disk
+	repo = newCachingKameletRepository(delegate, newKameletCache(dir), time.Hour, repositoryCredentials{})
+	k, err := repo.Get(ctx, "kamelet1")
+	assert.NoError(t, err)
+	assert.Equal(t, "kamelet1", k.Name)
+	assert.Equal(t, 2, delegate.gets)
+}
+
+func TestCachingRepositoryExpiration(t *testing.T) {
+	ctx := context.Background()
+	delegate := &countingKameletRepository{}
+	repo := newCachingKameletRepository(delegate, newKameletCache(""), time.Nanosecond, repositoryCredentials{})
+
+	k, err := repo.Get(ctx, "kamelet1")
+	assert.NoError(t, err)
+	assert.NotNil(t, k)
+	time.Sleep(time.Millisecond)
+	k, err = repo.Get(ctx, "kamelet1")
+	assert.NoError(t, err)
+	assert.NotNil(t, k)
+	assert.Equal(t, 2, delegate.gets)
+
+	// expired entries are used when the delegate fails
+	delegate.fail = true
+	k, err = repo.Get(ctx, "kamelet1")
+	assert.NoError(t, err)
+	assert.Equal(t, "kamelet1", k.Name)
+	_, err = repo.List(ctx)
+	assert.Error(t, err)
+}
+
+func TestCachingRepositoryCredentials(t *testing.T) {
+	ctx := context.Background()
+	cache := newKameletCache("")
+	delegate := &countingKameletRepository{}
+
+	repo := newCachingKameletRepository(delegate, cache, time.Hour, repositoryCredentials{token: "token1"})
+	_, err := repo.Get(ctx, "kamelet1")
+	assert.NoError(t, err)
+	_, err = repo.Get(ctx, "kamelet1")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, delegate.gets)
+
+	// entries are not shared with clients using different credentials
+	repo = newCachingKameletRepository(delegate, cache, time.Hour, repositoryCredentials{token: "token2"})
+	_, err = repo.Get(ctx, "kamelet1")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, delegate.gets)
+	repo = newCachingKameletRepository(delegate, cache, time.Hour, repositoryCredentials{dockerConfig: []byte("{}")})
+	_, err = repo.Get(ctx, "kamelet1")
+	assert.NoError(t, err)
+	assert.Equal(t, 3, delegate.gets)
+}
+
+func TestCacheBound(t *testing.T) {
+	cache := newKameletCache("")
+	for i := 0; i <= maxCacheEntries; i++ {
+		cache.put(fmt.Sprintf("key%d", i), []byte("data"))
+	}
+	assert.Len(t, cache.entries, maxCacheEntries)
+	_, ok := cache.get(fmt.Sprintf("key%d", maxCacheEntries))
+	assert.True(t, ok)
+}
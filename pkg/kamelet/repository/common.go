@@ -18,7 +18,11 @@ limitations under the License.
 package repository
 
 import (
+	"encoding/json"
 	"strings"
+
+	"github.com/apache/camel-k/pkg/apis/camel/v1alpha1"
+	"k8s.io/apimachinery/pkg/util/yaml"
 )
 
 var fileSuffixes = []string{".kamelet.yaml", ".kamelet.yml", ".kamelet.json"}
@@ -48,3 +52,20 @@ func getKameletNameFromFile(fileName string) string {
 	}
 	return name
 }
+
+// parseKamelet decodes the content of a Kamelet file, that is in YAML format unless the file name has a JSON extension
+func parseKamelet(fileName string, content []byte) (*v1alpha1.Kamelet, error) {
+	if strings.HasSuffix(fileName, ".yaml") || strings.HasSuffix(fileName, ".yml") {
+		var err error
+		content, err = yaml.ToJSON(content)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var kamelet v1alpha1.Kamelet
+	if err := json.Unmarshal(content, &kamelet); err != nil {
+		return nil, err
+	}
+	return &kamelet, nil
+}
@@ -67,6 +67,17 @@ func (c compositeKameletRepository) Get(ctx context.Context, name string) (*v1al
 	return nil, nil
 }
 
+func (c compositeKameletRepository) GetVersion(ctx context.Context, name string, version string) (*v1alpha1.Kamelet, error) {
+	// a version missing in one repository may be provided by the next ones
+	for _, repo := range c.repositories {
+		kam, err := repo.GetVersion(ctx, name, version)
+		if kam != nil || err != nil {
+			return kam, err
+		}
+	}
+	return nil, nil
+}
+
 func (c *compositeKameletRepository) String() string {
 	descs := make([]string, 0, len(c.repositories))
 	for _, repo := range c.repositories {
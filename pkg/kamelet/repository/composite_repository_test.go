@@ -56,3 +56,14 @@ func TestKubernetesRepositoryMultiNS(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, "kamelet2", k2.Name)
 }
+
+func TestUnwrapRepository(t *testing.T) {
+	fakeClient := fake.NewSimpleClientset()
+	repo1 := newKubernetesKameletRepository(fakeClient, "test1")
+	repo2 := newKubernetesKameletRepository(fakeClient, "test2")
+	repo3 := newEmptyKameletRepository()
+	repo := newCompositeKameletRepository(repo1, newCompositeKameletRepository(repo2, repo3))
+
+	assert.Equal(t, []KameletRepository{repo1, repo2, repo3}, Unwrap(repo))
+	assert.Equal(t, []KameletRepository{repo1}, Unwrap(repo1))
+}
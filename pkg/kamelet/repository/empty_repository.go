@@ -41,6 +41,10 @@ func (e *emptyKameletRepository) Get(_ context.Context, _ string) (*v1alpha1.Kam
 	return nil, nil
 }
 
+func (e *emptyKameletRepository) GetVersion(_ context.Context, _ string, _ string) (*v1alpha1.Kamelet, error) {
+	return nil, nil
+}
+
 func (c *emptyKameletRepository) String() string {
 	return "Empty[]"
 }
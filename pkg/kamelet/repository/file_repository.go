@@ -0,0 +1,92 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repository
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/apache/camel-k/pkg/apis/camel/v1alpha1"
+)
+
+// fileKameletRepository looks up Kamelets in a directory of the local file system
+type fileKameletRepository struct {
+	path string
+}
+
+func newFileKameletRepository(path string) KameletRepository {
+	return &fileKameletRepository{
+		path: path,
+	}
+}
+
+// Enforce type
+var _ KameletRepository = &fileKameletRepository{}
+
+func (c *fileKameletRepository) List(_ context.Context) ([]string, error) {
+	files, err := ioutil.ReadDir(c.path)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]string, 0, len(files))
+	for _, file := range files {
+		if !file.IsDir() && isKameletFileName(file.Name()) {
+			res = append(res, getKameletNameFromFile(file.Name()))
+		}
+	}
+	sort.Strings(res)
+	return res, nil
+}
+
+func (c *fileKameletRepository) Get(_ context.Context, name string) (*v1alpha1.Kamelet, error) {
+	if name == "" || strings.ContainsAny(name, `/\`) {
+		return nil, nil
+	}
+	for _, suffix := range fileSuffixes {
+		fileName := filepath.Join(c.path, name+suffix)
+		content, err := ioutil.ReadFile(fileName)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, err
+		}
+		kamelet, err := parseKamelet(fileName, content)
+		if err != nil {
+			return nil, err
+		}
+		if kamelet.Name != name {
+			return nil, fmt.Errorf("kamelet names do not match: expected %s, got %s", name, kamelet.Name)
+		}
+		return kamelet, nil
+	}
+	return nil, nil
+}
+
+func (c *fileKameletRepository) GetVersion(ctx context.Context, name string, version string) (*v1alpha1.Kamelet, error) {
+	return getVersion(ctx, c, name, version)
+}
+
+func (c *fileKameletRepository) String() string {
+	return fmt.Sprintf("File[path=%s]", c.path)
+}
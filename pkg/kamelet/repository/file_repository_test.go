@@ -0,0 +1,68 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repository
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testKameletYAML = `apiVersion: camel.apache.org/v1alpha1
+kind: Kamelet
+metadata:
+  name: timer-source
+spec:
+  dependencies:
+  - camel:timer
+`
+
+func TestFileRepository(t *testing.T) {
+	ctx := context.Background()
+	dir, err := ioutil.TempDir("", "camel-k-kamelets-test")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "timer-source.kamelet.yaml"), []byte(testKameletYAML), 0644))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "other-source.kamelet.json"), []byte(`{"metadata":{"name":"other-source"}}`), 0644))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "wrong-name.kamelet.yaml"), []byte(testKameletYAML), 0644))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "README.md"), []byte("# Kamelets"), 0644))
+
+	repo, err := newFromURI("file:" + dir)
+	assert.NoError(t, err)
+	list, err := repo.List(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"other-source", "timer-source", "wrong-name"}, list)
+
+	k, err := repo.Get(ctx, "timer-source")
+	assert.NoError(t, err)
+	assert.Equal(t, "timer-source", k.Name)
+	assert.Equal(t, []string{"camel:timer"}, k.Spec.Dependencies)
+	k, err = repo.Get(ctx, "other-source")
+	assert.NoError(t, err)
+	assert.Equal(t, "other-source", k.Name)
+	k, err = repo.Get(ctx, "non-existing")
+	assert.NoError(t, err)
+	assert.Nil(t, k)
+	_, err = repo.Get(ctx, "wrong-name")
+	assert.Error(t, err)
+}
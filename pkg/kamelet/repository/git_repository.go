@@ -0,0 +1,191 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repository
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/apache/camel-k/pkg/apis/camel/v1alpha1"
+)
+
+// gitSyncInterval is the minimum time between two fetches of the same Git repository
+const gitSyncInterval = 1 * time.Minute
+
+// gitCheckouts tracks the local checkouts, that are shared by all repository instances
+var gitCheckouts = struct {
+	sync.Mutex
+	checkouts map[string]*gitCheckout
+}{
+	checkouts: make(map[string]*gitCheckout),
+}
+
+// gitCheckout is locked while the checkout is synchronized, so that fetching a repository does not block the other ones
+type gitCheckout struct {
+	sync.Mutex
+	lastSync time.Time
+}
+
+var (
+	// gitSchemes are the schemes of the Git repository URLs that are supported
+	gitSchemes = map[string]bool{
+		"https": true,
+		"ssh":   true,
+		"git":   true,
+	}
+	// gitSCPLikeURLRegexp matches the scp-like syntax of SSH URLs, e.g. "git@github.com:apache/camel-kamelets.git"
+	gitSCPLikeURLRegexp = regexp.MustCompile(`^[A-Za-z0-9._-]+@[A-Za-z0-9.-]+:[^:]+$`)
+	// gitRefRegexp matches the refs that can be fetched, e.g. branches, tags or commits
+	gitRefRegexp = regexp.MustCompile(`^[A-Za-z0-9_][A-Za-z0-9._-]*$`)
+)
+
+// validateGitRepository checks that the URL and the ref of the Git repository cannot be interpreted as git options
+func validateGitRepository(repositoryURL, ref string) error {
+	if strings.HasPrefix(repositoryURL, "-") {
+		return fmt.Errorf("invalid git repository URL: %s", repositoryURL)
+	}
+	if !gitSCPLikeURLRegexp.MatchString(repositoryURL) {
+		u, err := url.Parse(repositoryURL)
+		if err != nil {
+			return fmt.Errorf("invalid git repository URL: %s: %v", repositoryURL, err)
+		}
+		if !gitSchemes[u.Scheme] || u.Host == "" {
+			return fmt.Errorf("invalid git repository URL: %s: only https, ssh and git URLs are supported", repositoryURL)
+		}
+	}
+	if ref != "" && !gitRefRegexp.MatchString(ref) {
+		return fmt.Errorf("invalid git ref: %s", ref)
+	}
+	return nil
+}
+
+func getGitCheckout(dir string) *gitCheckout {
+	gitCheckouts.Lock()
+	defer gitCheckouts.Unlock()
+
+	checkout, ok := gitCheckouts.checkouts[dir]
+	if !ok {
+		checkout = &gitCheckout{}
+		gitCheckouts.checkouts[dir] = checkout
+	}
+	return checkout
+}
+
+// gitKameletRepository looks up Kamelets in a directory of a Git repository, that is checked out using the git command
+type gitKameletRepository struct {
+	url  string
+	ref  string
+	path string
+	dir  string
+}
+
+func newGitKameletRepository(url, ref, path string) KameletRepository {
+	checkoutID := fmt.Sprintf("%x", sha256.Sum256([]byte(url+"#"+ref)))
+	return &gitKameletRepository{
+		url:  url,
+		ref:  ref,
+		path: path,
+		dir:  filepath.Join(os.TempDir(), "camel-k-kamelets", checkoutID[:16]),
+	}
+}
+
+// Enforce type
+var _ KameletRepository = &gitKameletRepository{}
+
+func (c *gitKameletRepository) List(ctx context.Context) ([]string, error) {
+	if err := c.sync(ctx); err != nil {
+		return nil, err
+	}
+	return c.files().List(ctx)
+}
+
+func (c *gitKameletRepository) Get(ctx context.Context, name string) (*v1alpha1.Kamelet, error) {
+	if err := c.sync(ctx); err != nil {
+		return nil, err
+	}
+	return c.files().Get(ctx, name)
+}
+
+func (c *gitKameletRepository) GetVersion(ctx context.Context, name string, version string) (*v1alpha1.Kamelet, error) {
+	return getVersion(ctx, c, name, version)
+}
+
+func (c *gitKameletRepository) String() string {
+	return fmt.Sprintf("Git[url=%s, ref=%s, path=%s]", c.url, c.ref, c.path)
+}
+
+func (c *gitKameletRepository) files() KameletRepository {
+	return newFileKameletRepository(filepath.Join(c.dir, filepath.FromSlash(c.path)))
+}
+
+// sync fetches the requested ref into the local checkout, unless it has been recently fetched
+func (c *gitKameletRepository) sync(ctx context.Context) error {
+	checkout := getGitCheckout(c.dir)
+	checkout.Lock()
+	defer checkout.Unlock()
+
+	if !checkout.lastSync.IsZero() && time.Since(checkout.lastSync) < gitSyncInterval {
+		return nil
+	}
+
+	if _, err := os.Stat(filepath.Join(c.dir, ".git")); os.IsNotExist(err) {
+		if err := os.MkdirAll(c.dir, 0755); err != nil {
+			return err
+		}
+		if err := c.git(ctx, "init", "-q"); err != nil {
+			return err
+		}
+	} else if err != nil {
+		return err
+	}
+
+	ref := c.ref
+	if ref == "" {
+		ref = "HEAD"
+	}
+	// options are separated from the arguments, so that the URL and the ref are never interpreted as options
+	if err := c.git(ctx, "fetch", "-q", "--depth", "1", "--", c.url, ref); err != nil {
+		return err
+	}
+	if err := c.git(ctx, "checkout", "-q", "--force", "FETCH_HEAD", "--"); err != nil {
+		return err
+	}
+
+	checkout.lastSync = time.Now()
+	return nil
+}
+
+func (c *gitKameletRepository) git(ctx context.Context, args ...string) error {
+	cmd := exec.CommandContext(ctx, "git", args...)
+	cmd.Dir = c.dir
+	// never wait for credentials on the terminal
+	cmd.Env = append(os.Environ(), "GIT_TERMINAL_PROMPT=0")
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("git %s failed for repository %s: %v: %s", args[0], c.url, err, strings.TrimSpace(string(out)))
+	}
+	return nil
+}
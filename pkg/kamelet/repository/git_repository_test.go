@@ -0,0 +1,71 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repository
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGitRepository(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not available")
+	}
+	ctx := context.Background()
+	dir, err := ioutil.TempDir("", "camel-k-kamelets-test")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	assert.NoError(t, os.MkdirAll(filepath.Join(dir, "kamelets"), 0755))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "kamelets", "timer-source.kamelet.yaml"), []byte(testKameletYAML), 0644))
+	for _, args := range [][]string{
+		{"init", "-q"},
+		{"checkout", "-q", "-b", "release"},
+		{"add", "."},
+		{"-c", "user.name=test", "-c", "user.email=test@example.com", "commit", "-q", "-m", "kamelets"},
+	} {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		out, err := cmd.CombinedOutput()
+		assert.NoError(t, err, string(out))
+	}
+
+	repo := newGitKameletRepository("file://"+dir, "release", "kamelets")
+	defer os.RemoveAll(repo.(*gitKameletRepository).dir)
+
+	list, err := repo.List(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"timer-source"}, list)
+	k, err := repo.Get(ctx, "timer-source")
+	assert.NoError(t, err)
+	assert.Equal(t, "timer-source", k.Name)
+	k, err = repo.Get(ctx, "non-existing")
+	assert.NoError(t, err)
+	assert.Nil(t, k)
+
+	missing := newGitKameletRepository("file://"+dir, "missing", "")
+	defer os.RemoveAll(missing.(*gitKameletRepository).dir)
+	_, err = missing.List(ctx)
+	assert.Error(t, err)
+}
@@ -19,20 +19,18 @@ package repository
 
 import (
 	"context"
-	"encoding/json"
+	"crypto/sha256"
 	"fmt"
 	"io/ioutil"
 	"net/http"
 	"os"
 	"sort"
-	"strings"
+	"time"
 
 	"github.com/apache/camel-k/pkg/apis/camel/v1alpha1"
 	"github.com/google/go-github/v32/github"
 	"golang.org/x/oauth2"
 
-	"k8s.io/apimachinery/pkg/util/yaml"
-
 	"github.com/gregjones/httpcache"
 )
 
@@ -44,13 +42,27 @@ type githubKameletRepository struct {
 	ref        string
 }
 
-func newGithubKameletRepository(owner, repo, path, ref string) KameletRepository {
-	httpClient := httpcache.NewMemoryCacheTransport().Client()
-	if token, ok := os.LookupEnv("GITHUB_TOKEN"); ok {
+// remoteRepositoryTimeout bounds the time spent in each request to a remote repository
+const remoteRepositoryTimeout = 30 * time.Second
+
+// GithubTokenEnvVar contains the token used to access GitHub repositories that do not declare one
+const GithubTokenEnvVar = "GITHUB_TOKEN"
+
+func newGithubKameletRepository(owner, repo, path, ref, token string) KameletRepository {
+	if token == "" {
+		token = os.Getenv(GithubTokenEnvVar)
+	}
+	// Responses are cached per token, and revalidated using their ETag, that does not count against the rate limit
+	httpClient := httpcache.NewTransport(&httpCache{
+		cache:  defaultCache,
+		prefix: fmt.Sprintf("github/%x/", sha256.Sum256([]byte(token))),
+	}).Client()
+	if token != "" {
 		ts := oauth2.StaticTokenSource(&oauth2.Token{AccessToken: token})
 		ctx := context.WithValue(context.Background(), oauth2.HTTPClient, httpClient)
 		httpClient = oauth2.NewClient(ctx, ts)
 	}
+	httpClient.Timeout = remoteRepositoryTimeout
 
 	return &githubKameletRepository{
 		httpClient: httpClient,
@@ -103,6 +115,10 @@ func (c *githubKameletRepository) Get(ctx context.Context, name string) (*v1alph
 	return nil, nil
 }
 
+func (c *githubKameletRepository) GetVersion(ctx context.Context, name string, version string) (*v1alpha1.Kamelet, error) {
+	return getVersion(ctx, c, name, version)
+}
+
 func (c *githubKameletRepository) listFiles(ctx context.Context) ([]*github.RepositoryContent, error) {
 	gc := github.NewClient(c.httpClient)
 	var ref *github.RepositoryContentGetOptions
@@ -131,18 +147,7 @@ func (c *githubKameletRepository) downloadKamelet(ctx context.Context, url strin
 	if err != nil {
 		return nil, err
 	}
-	if strings.HasSuffix(url, ".yaml") || strings.HasSuffix(url, ".yml") {
-		content, err = yaml.ToJSON(content)
-		if err != nil {
-			return nil, err
-		}
-	}
-
-	var kamelet v1alpha1.Kamelet
-	if err := json.Unmarshal(content, &kamelet); err != nil {
-		return nil, err
-	}
-	return &kamelet, nil
+	return parseKamelet(url, content)
 }
 
 func (c *githubKameletRepository) String() string {
@@ -26,7 +26,7 @@ import (
 
 func TestGithubRepository(t *testing.T) {
 	ctx := context.Background()
-	repo := newGithubKameletRepository("apache", "camel-kamelets", "", "")
+	repo := newGithubKameletRepository("apache", "camel-kamelets", "", "", "")
 	list, err := repo.List(ctx)
 	assert.NoError(t, err)
 	assert.True(t, len(list) > 0)
@@ -66,6 +66,10 @@ func (c *kubernetesKameletRepository) Get(ctx context.Context, name string) (*v1
 	return kamelet, err
 }
 
+func (c *kubernetesKameletRepository) GetVersion(ctx context.Context, name string, version string) (*v1alpha1.Kamelet, error) {
+	return getVersion(ctx, c, name, version)
+}
+
 func (c *kubernetesKameletRepository) String() string {
 	return fmt.Sprintf("Kubernetes[namespace=%s]", c.namespace)
 }
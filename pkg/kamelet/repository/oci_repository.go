@@ -0,0 +1,406 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repository
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/apache/camel-k/pkg/apis/camel/v1alpha1"
+)
+
+const (
+	ociManifestMediaType    = "application/vnd.oci.image.manifest.v1+json"
+	dockerManifestMediaType = "application/vnd.docker.distribution.manifest.v2+json"
+	// ociTitleAnnotation contains the file name of a layer, as set by tools like ORAS
+	ociTitleAnnotation = "org.opencontainers.image.title"
+)
+
+type ociDescriptor struct {
+	MediaType   string            `json:"mediaType,omitempty"`
+	Digest      string            `json:"digest"`
+	Size        int64             `json:"size,omitempty"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+type ociManifest struct {
+	SchemaVersion int             `json:"schemaVersion"`
+	MediaType     string          `json:"mediaType,omitempty"`
+	Config        ociDescriptor   `json:"config"`
+	Layers        []ociDescriptor `json:"layers"`
+}
+
+// ociKameletRepository looks up Kamelets in a bundle stored as an OCI artifact, containing one layer per Kamelet file,
+// named after the "org.opencontainers.image.title" annotation
+type ociKameletRepository struct {
+	httpClient *http.Client
+	scheme     string
+	registry   string
+	repository string
+	reference  string
+	username   string
+	password   string
+
+	lock sync.Mutex
+	// token is the bearer token obtained from the registry authorization service
+	token string
+}
+
+func newOCIKameletRepository(registry, repository, reference string, credentials repositoryCredentials) KameletRepository {
+	scheme := "https"
+	host := strings.Split(registry, ":")[0]
+	if registry == credentials.insecureRegistry || host == "localhost" || host == "127.0.0.1" {
+		scheme = "http"
+	}
+	dockerConfig := credentials.dockerConfig
+	if dockerConfig == nil {
+		dockerConfig = defaultDockerConfig()
+	}
+	username, password := registryAuth(dockerConfig, registry)
+
+	return &ociKameletRepository{
+		httpClient: &http.Client{Timeout: remoteRepositoryTimeout},
+		scheme:     scheme,
+		registry:   registry,
+		repository: repository,
+		reference:  reference,
+		username:   username,
+		password:   password,
+	}
+}
+
+// Enforce type
+var _ KameletRepository = &ociKameletRepository{}
+
+func (c *ociKameletRepository) List(ctx context.Context) ([]string, error) {
+	manifest, err := c.manifest(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]string, 0, len(manifest.Layers))
+	for _, layer := range manifest.Layers {
+		if fileName := layer.Annotations[ociTitleAnnotation]; isKameletFileName(fileName) {
+			res = append(res, getKameletNameFromFile(fileName))
+		}
+	}
+	sort.Strings(res)
+	return res, nil
+}
+
+func (c *ociKameletRepository) Get(ctx context.Context, name string) (*v1alpha1.Kamelet, error) {
+	manifest, err := c.manifest(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, layer := range manifest.Layers {
+		fileName := layer.Annotations[ociTitleAnnotation]
+		if !isFileNameForKamelet(name, fileName) {
+			continue
+		}
+		content, err := c.blob(ctx, layer.Digest)
+		if err != nil {
+			return nil, err
+		}
+		kamelet, err := parseKamelet(fileName, content)
+		if err != nil {
+			return nil, err
+		}
+		if kamelet.Name != name {
+			return nil, fmt.Errorf("kamelet names do not match: expected %s, got %s", name, kamelet.Name)
+		}
+		return kamelet, nil
+	}
+	return nil, nil
+}
+
+func (c *ociKameletRepository) GetVersion(ctx context.Context, name string, version string) (*v1alpha1.Kamelet, error) {
+	return getVersion(ctx, c, name, version)
+}
+
+func (c *ociKameletRepository) String() string {
+	return fmt.Sprintf("OCI[registry=%s, repository=%s, reference=%s]", c.registry, c.repository, c.reference)
+}
+
+func (c *ociKameletRepository) manifest(ctx context.Context) (*ociManifest, error) {
+	content, err := c.get(ctx, fmt.Sprintf("/v2/%s/manifests/%s", c.repository, c.reference), ociManifestMediaType+", "+dockerManifestMediaType)
+	if err != nil {
+		return nil, err
+	}
+	var manifest ociManifest
+	if err := json.Unmarshal(content, &manifest); err != nil {
+		return nil, err
+	}
+	return &manifest, nil
+}
+
+func (c *ociKameletRepository) blob(ctx context.Context, digest string) ([]byte, error) {
+	content, err := c.get(ctx, fmt.Sprintf("/v2/%s/blobs/%s", c.repository, digest), "")
+	if err != nil {
+		return nil, err
+	}
+	if actual := fmt.Sprintf("sha256:%x", sha256.Sum256(content)); strings.HasPrefix(digest, "sha256:") && actual != digest {
+		return nil, fmt.Errorf("digest mismatch for blob %s of %s: got %s", digest, c.String(), actual)
+	}
+	return content, nil
+}
+
+// get performs a request against the registry API, authenticating as requested by the registry
+func (c *ociKameletRepository) get(ctx context.Context, path string, accept string) ([]byte, error) {
+	resp, err := c.do(ctx, path, accept)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusUnauthorized {
+		challenge := resp.Header.Get("WWW-Authenticate")
+		resp.Body.Close()
+		if err := c.authenticate(ctx, challenge); err != nil {
+			return nil, err
+		}
+		if resp, err = c.do(ctx, path, accept); err != nil {
+			return nil, err
+		}
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("cannot get %s from %s: %s", path, c.String(), resp.Status)
+	}
+	return ioutil.ReadAll(resp.Body)
+}
+
+func (c *ociKameletRepository) do(ctx context.Context, path string, accept string) (*http.Response, error) {
+	host := c.registry
+	if host == "docker.io" {
+		host = "registry-1.docker.io"
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s://%s%s", c.scheme, host, path), nil)
+	if err != nil {
+		return nil, err
+	}
+	if accept != "" {
+		req.Header.Set("Accept", accept)
+	}
+	c.lock.Lock()
+	token := c.token
+	c.lock.Unlock()
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	} else if c.username != "" {
+		req.SetBasicAuth(c.username, c.password)
+	}
+	return c.httpClient.Do(req)
+}
+
+// authenticate obtains a bearer token from the authorization service indicated by the registry challenge
+func (c *ociKameletRepository) authenticate(ctx context.Context, challenge string) error {
+	scheme, params := parseChallenge(challenge)
+	if !strings.EqualFold(scheme, "bearer") || params["realm"] == "" {
+		return fmt.Errorf("cannot authenticate to %s: unsupported challenge %q", c.String(), challenge)
+	}
+
+	realm, err := url.Parse(params["realm"])
+	if err != nil {
+		return err
+	}
+	query := realm.Query()
+	if service := params["service"]; service != "" {
+		query.Set("service", service)
+	}
+	if scope := params["scope"]; scope != "" {
+		query.Set("scope", scope)
+	} else {
+		query.Set("scope", fmt.Sprintf("repository:%s:pull", c.repository))
+	}
+	realm.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, realm.String(), nil)
+	if err != nil {
+		return err
+	}
+	if c.username != "" {
+		req.SetBasicAuth(c.username, c.password)
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("cannot authenticate to %s: %s", c.String(), resp.Status)
+	}
+
+	var token struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&token); err != nil {
+		return err
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.token = token.Token
+	if c.token == "" {
+		c.token = token.AccessToken
+	}
+	if c.token == "" {
+		return fmt.Errorf("cannot authenticate to %s: no token returned", c.String())
+	}
+	return nil
+}
+
+// parseChallenge parses a WWW-Authenticate header like `Bearer realm="https://auth.example.com/token",service="registry"`
+func parseChallenge(challenge string) (string, map[string]string) {
+	params := make(map[string]string)
+	parts := strings.SplitN(strings.TrimSpace(challenge), " ", 2)
+	if len(parts) < 2 {
+		return parts[0], params
+	}
+	for _, param := range splitChallengeParams(parts[1]) {
+		kv := strings.SplitN(param, "=", 2)
+		if len(kv) == 2 {
+			params[strings.ToLower(strings.TrimSpace(kv[0]))] = strings.Trim(strings.TrimSpace(kv[1]), `"`)
+		}
+	}
+	return parts[0], params
+}
+
+// splitChallengeParams splits the challenge parameters on commas that are not quoted
+func splitChallengeParams(s string) []string {
+	res := make([]string, 0)
+	quoted := false
+	start := 0
+	for i, ch := range s {
+		switch {
+		case ch == '"':
+			quoted = !quoted
+		case ch == ',' && !quoted:
+			res = append(res, s[start:i])
+			start = i + 1
+		}
+	}
+	return append(res, s[start:])
+}
+
+// parseOCIReference splits a reference like "registry:5000/org/kamelets:1.0" into registry, repository and tag (or digest)
+func parseOCIReference(ref string) (string, string, string, error) {
+	reference := "latest"
+	if pos := strings.Index(ref, "@"); pos >= 0 {
+		reference = ref[pos+1:]
+		ref = ref[0:pos]
+	} else if pos := strings.LastIndex(ref, ":"); pos > strings.LastIndex(ref, "/") {
+		reference = ref[pos+1:]
+		ref = ref[0:pos]
+	}
+	parts := strings.SplitN(ref, "/", 2)
+	if len(parts) < 2 || parts[0] == "" || parts[1] == "" || reference == "" {
+		return "", "", "", errors.New("missing registry or repository")
+	}
+	return parts[0], parts[1], reference, nil
+}
+
+// dockerConfigFromSecret returns the Docker configuration contained in a registry secret
+func dockerConfigFromSecret(data map[string][]byte) []byte {
+	for _, key := range []string{".dockerconfigjson", "config.json", ".dockercfg"} {
+		if content, ok := data[key]; ok {
+			return content
+		}
+	}
+	return nil
+}
+
+// defaultDockerConfig reads the Docker configuration of the current user, if any
+func defaultDockerConfig() []byte {
+	dir := os.Getenv("DOCKER_CONFIG")
+	if dir == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return nil
+		}
+		dir = filepath.Join(home, ".docker")
+	}
+	content, err := ioutil.ReadFile(filepath.Join(dir, "config.json"))
+	if err != nil {
+		return nil
+	}
+	return content
+}
+
+type dockerAuth struct {
+	Auth     string `json:"auth,omitempty"`
+	Username string `json:"username,omitempty"`
+	Password string `json:"password,omitempty"`
+}
+
+// registryAuth returns the credentials for the given registry contained in a Docker configuration,
+// either in the config.json or in the legacy .dockercfg format
+func registryAuth(config []byte, registry string) (string, string) {
+	if len(config) == 0 {
+		return "", ""
+	}
+	var dockerConfig struct {
+		Auths map[string]dockerAuth `json:"auths"`
+	}
+	if err := json.Unmarshal(config, &dockerConfig); err != nil {
+		return "", ""
+	}
+	auths := dockerConfig.Auths
+	if auths == nil {
+		if err := json.Unmarshal(config, &auths); err != nil {
+			return "", ""
+		}
+	}
+	for server, auth := range auths {
+		if registryHost(server) != registryHost(registry) {
+			continue
+		}
+		if auth.Username != "" {
+			return auth.Username, auth.Password
+		}
+		decoded, err := base64.StdEncoding.DecodeString(auth.Auth)
+		if err != nil {
+			continue
+		}
+		credentials := strings.SplitN(string(decoded), ":", 2)
+		if len(credentials) == 2 {
+			return credentials[0], credentials[1]
+		}
+	}
+	return "", ""
+}
+
+// registryHost normalizes the server keys used in Docker configurations, e.g. "https://index.docker.io/v1/"
+func registryHost(server string) string {
+	host := strings.TrimPrefix(strings.TrimPrefix(server, "https://"), "http://")
+	host = strings.SplitN(host, "/", 2)[0]
+	if host == "index.docker.io" || host == "registry-1.docker.io" {
+		return "docker.io"
+	}
+	return host
+}
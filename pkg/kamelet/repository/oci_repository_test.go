@@ -0,0 +1,159 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repository
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newTestRegistry starts a registry stand-in serving a Kamelet bundle, that requires a bearer token obtained with basic authentication
+func newTestRegistry(t *testing.T, files map[string]string) *httptest.Server {
+	blobs := make(map[string]string)
+	manifest := ociManifest{
+		SchemaVersion: 2,
+		MediaType:     ociManifestMediaType,
+		Config: ociDescriptor{
+			MediaType: "application/vnd.unknown.config.v1+json",
+			Digest:    fmt.Sprintf("sha256:%x", sha256.Sum256([]byte("{}"))),
+			Size:      2,
+		},
+	}
+	for name, content := range files {
+		digest := fmt.Sprintf("sha256:%x", sha256.Sum256([]byte(content)))
+		blobs[digest] = content
+		manifest.Layers = append(manifest.Layers, ociDescriptor{
+			MediaType:   "application/vnd.camel.kamelet.layer.v1+yaml",
+			Digest:      digest,
+			Size:        int64(len(content)),
+			Annotations: map[string]string{ociTitleAnnotation: name},
+		})
+	}
+	manifestContent, err := json.Marshal(manifest)
+	assert.NoError(t, err)
+
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/token" {
+			if user, pass, ok := r.BasicAuth(); !ok || user != "user" || pass != "secret" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			assert.Equal(t, "repository:kamelets/catalog:pull", r.URL.Query().Get("scope"))
+			_, _ = w.Write([]byte(`{"token": "the-token"}`))
+			return
+		}
+		if r.Header.Get("Authorization") != "Bearer the-token" {
+			w.Header().Set("WWW-Authenticate", fmt.Sprintf(`Bearer realm="%s/token",service="test",scope="repository:kamelets/catalog:pull"`, server.URL))
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch {
+		case r.URL.Path == "/v2/kamelets/catalog/manifests/1.0":
+			w.Header().Set("Content-Type", ociManifestMediaType)
+			_, _ = w.Write(manifestContent)
+		case strings.HasPrefix(r.URL.Path, "/v2/kamelets/catalog/blobs/"):
+			content, ok := blobs[strings.TrimPrefix(r.URL.Path, "/v2/kamelets/catalog/blobs/")]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_, _ = w.Write([]byte(content))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	return server
+}
+
+func TestOCIRepository(t *testing.T) {
+	ctx := context.Background()
+	server := newTestRegistry(t, map[string]string{
+		"timer-source.kamelet.yaml": testKameletYAML,
+		"README.md":                 "# Kamelets",
+	})
+	defer server.Close()
+	registry := strings.TrimPrefix(server.URL, "http://")
+
+	dockerConfig := fmt.Sprintf(`{"auths":{"%s":{"auth":"%s"}}}`, registry, base64.StdEncoding.EncodeToString([]byte("user:secret")))
+	registry, repository, reference, err := parseOCIReference(registry + "/kamelets/catalog:1.0")
+	assert.NoError(t, err)
+	repo := newOCIKameletRepository(registry, repository, reference, repositoryCredentials{
+		dockerConfig: dockerConfigFromSecret(map[string][]byte{".dockerconfigjson": []byte(dockerConfig)}),
+	})
+
+	list, err := repo.List(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"timer-source"}, list)
+	k, err := repo.Get(ctx, "timer-source")
+	assert.NoError(t, err)
+	assert.Equal(t, "timer-source", k.Name)
+	assert.Equal(t, []string{"camel:timer"}, k.Spec.Dependencies)
+	k, err = repo.Get(ctx, "non-existing")
+	assert.NoError(t, err)
+	assert.Nil(t, k)
+
+	anonymous := newOCIKameletRepository(registry, repository, reference, repositoryCredentials{dockerConfig: []byte("{}")})
+	_, err = anonymous.List(ctx)
+	assert.Error(t, err)
+}
+
+func TestOCIReferenceParse(t *testing.T) {
+	tests := []struct {
+		ref        string
+		registry   string
+		repository string
+		reference  string
+	}{
+		{ref: "quay.io/org/kamelets:1.0", registry: "quay.io", repository: "org/kamelets", reference: "1.0"},
+		{ref: "quay.io/org/kamelets", registry: "quay.io", repository: "org/kamelets", reference: "latest"},
+		{ref: "localhost:5000/kamelets", registry: "localhost:5000", repository: "kamelets", reference: "latest"},
+		{ref: "localhost:5000/kamelets@sha256:abc", registry: "localhost:5000", repository: "kamelets", reference: "sha256:abc"},
+	}
+	for _, test := range tests {
+		registry, repository, reference, err := parseOCIReference(test.ref)
+		assert.NoError(t, err)
+		assert.Equal(t, test.registry, registry)
+		assert.Equal(t, test.repository, repository)
+		assert.Equal(t, test.reference, reference)
+	}
+
+	_, _, _, err := parseOCIReference("kamelets:1.0")
+	assert.Error(t, err)
+}
+
+func TestRegistryAuth(t *testing.T) {
+	encoded := base64.StdEncoding.EncodeToString([]byte("user:secret"))
+	user, pass := registryAuth([]byte(`{"auths":{"https://index.docker.io/v1/":{"auth":"`+encoded+`"}}}`), "docker.io")
+	assert.Equal(t, "user", user)
+	assert.Equal(t, "secret", pass)
+	user, pass = registryAuth([]byte(`{"quay.io":{"username":"user","password":"secret"}}`), "quay.io")
+	assert.Equal(t, "user", user)
+	assert.Equal(t, "secret", pass)
+	user, _ = registryAuth([]byte(`{"auths":{"quay.io":{"auth":"`+encoded+`"}}}`), "docker.io")
+	assert.Equal(t, "", user)
+}
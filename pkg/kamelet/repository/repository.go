@@ -25,7 +25,9 @@ import (
 	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
 	"github.com/apache/camel-k/pkg/apis/camel/v1alpha1"
 	camel "github.com/apache/camel-k/pkg/client/camel/clientset/versioned"
+	"github.com/pkg/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
 )
 
 const (
@@ -44,6 +46,10 @@ type KameletRepository interface {
 	// Get the Kamelet corresponding to the given name, or nil if not found
 	Get(ctx context.Context, name string) (*v1alpha1.Kamelet, error)
 
+	// GetVersion returns the Kamelet corresponding to the given name, using the spec of the highest version
+	// matching the given semver constraint, or nil if not found
+	GetVersion(ctx context.Context, name string, version string) (*v1alpha1.Kamelet, error)
+
 	// String information about the repository
 	String() string
 }
@@ -73,8 +79,12 @@ func NewForPlatform(ctx context.Context, client camel.Interface, platform *v1.In
 	}
 	if platform != nil {
 		repos := getRepositoriesFromPlatform(platform)
-		for _, repoURI := range repos {
-			repoImpl, err := newFromURI(repoURI)
+		for _, repo := range repos {
+			credentials, err := getRepositoryCredentials(ctx, client, platform, repo)
+			if err != nil {
+				return nil, err
+			}
+			repoImpl, err := newFromURIWithCredentials(repo.URI, credentials)
 			if err != nil {
 				return nil, err
 			}
@@ -118,6 +128,19 @@ func NewStandalone(uris ...string) (KameletRepository, error) {
 	return newCompositeKameletRepository(repoImpls...), nil
 }
 
+// Unwrap returns the physical repositories backing the given KameletRepository, in the order they are looked up.
+// It can be used to determine which repository provides a given Kamelet.
+func Unwrap(repo KameletRepository) []KameletRepository {
+	if composite, ok := repo.(*compositeKameletRepository); ok {
+		res := make([]KameletRepository, 0, len(composite.repositories))
+		for _, r := range composite.repositories {
+			res = append(res, Unwrap(r)...)
+		}
+		return res
+	}
+	return []KameletRepository{repo}
+}
+
 func lookupPlatform(ctx context.Context, client camel.Interface, namespaces ...string) (*v1.IntegrationPlatform, error) {
 	for _, namespace := range namespaces {
 		pls, err := client.CamelV1().IntegrationPlatforms(namespace).List(ctx, metav1.ListOptions{})
@@ -137,7 +160,7 @@ func lookupPlatform(ctx context.Context, client camel.Interface, namespaces ...s
 	return nil, nil
 }
 
-func getRepositoriesFromPlatform(platform *v1.IntegrationPlatform) []string {
+func getRepositoriesFromPlatform(platform *v1.IntegrationPlatform) []v1.IntegrationPlatformKameletRepositorySpec {
 	if platform == nil {
 		return nil
 	}
@@ -146,14 +169,66 @@ func getRepositoriesFromPlatform(platform *v1.IntegrationPlatform) []string {
 		// Maybe not reconciled yet
 		repos = platform.Spec.Kamelet.Repositories
 	}
-	res := make([]string, 0, len(repos))
-	for _, repo := range repos {
-		res = append(res, repo.URI)
+	return repos
+}
+
+// repositoryCredentials contains the information used to access remote repositories
+type repositoryCredentials struct {
+	// token used to access GitHub repositories
+	token string
+	// dockerConfig is the content of the platform registry secret, used to access OCI repositories
+	dockerConfig []byte
+	// insecureRegistry is the address of a registry that is accessed via plain HTTP
+	insecureRegistry string
+}
+
+// getRepositoryCredentials reads the token of the repository from the Secret referenced by the repository spec, if any,
+// and the credentials of the platform registry
+func getRepositoryCredentials(ctx context.Context, client camel.Interface, platform *v1.IntegrationPlatform, repo v1.IntegrationPlatformKameletRepositorySpec) (repositoryCredentials, error) {
+	credentials := repositoryCredentials{}
+	registry := platform.Status.Build.Registry
+	if registry.Address == "" {
+		// Maybe not reconciled yet
+		registry = platform.Spec.Build.Registry
+	}
+	if registry.Insecure {
+		credentials.insecureRegistry = registry.Address
 	}
-	return res
+	if repo.TokenSecret == nil && (registry.Secret == "" || !strings.HasPrefix(repo.URI, "oci:")) {
+		return credentials, nil
+	}
+
+	kubeClient, ok := client.(kubernetes.Interface)
+	if !ok {
+		return credentials, fmt.Errorf("cannot read the secrets of repository %s: no Kubernetes client available", repo.URI)
+	}
+	if repo.TokenSecret != nil {
+		secret, err := kubeClient.CoreV1().Secrets(platform.Namespace).Get(ctx, repo.TokenSecret.Name, metav1.GetOptions{})
+		if err != nil {
+			return credentials, errors.Wrapf(err, "cannot read the token secret %q of repository %s", repo.TokenSecret.Name, repo.URI)
+		}
+		token, ok := secret.Data[repo.TokenSecret.Key]
+		if !ok {
+			return credentials, fmt.Errorf("key %q not found in the token secret %q of repository %s", repo.TokenSecret.Key, repo.TokenSecret.Name, repo.URI)
+		}
+		credentials.token = strings.TrimSpace(string(token))
+	}
+	if registry.Secret != "" && strings.HasPrefix(repo.URI, "oci:") {
+		secret, err := kubeClient.CoreV1().Secrets(platform.Namespace).Get(ctx, registry.Secret, metav1.GetOptions{})
+		if err != nil {
+			return credentials, errors.Wrapf(err, "cannot read the registry secret %q for repository %s", registry.Secret, repo.URI)
+		}
+		credentials.dockerConfig = dockerConfigFromSecret(secret.Data)
+	}
+	return credentials, nil
 }
 
 func newFromURI(uri string) (KameletRepository, error) {
+	return newFromURIWithCredentials(uri, repositoryCredentials{})
+}
+
+// newFromURIWithCredentials creates the repository corresponding to the URI. Remote repositories are cached, and accessed using the given credentials.
+func newFromURIWithCredentials(uri string, credentials repositoryCredentials) (KameletRepository, error) {
 	if uri == NoneRepository {
 		return newEmptyKameletRepository(), nil
 	} else if strings.HasPrefix(uri, "github:") {
@@ -174,7 +249,42 @@ func newFromURI(uri string) (KameletRepository, error) {
 		if len(parts) >= 3 {
 			path = strings.Join(parts[2:], "/")
 		}
-		return newGithubKameletRepository(owner, repo, path, version), nil
+		return withCache(newGithubKameletRepository(owner, repo, path, version, credentials.token), credentials), nil
+	} else if strings.HasPrefix(uri, "file:") {
+		path := strings.TrimPrefix(uri, "file:")
+		if strings.HasPrefix(path, "//") {
+			// file:///path/to/kamelets
+			path = strings.TrimPrefix(path, "//")
+		}
+		if path == "" {
+			return nil, fmt.Errorf("expected format is file:/path/to/kamelets, got: %s", uri)
+		}
+		return newFileKameletRepository(path), nil
+	} else if strings.HasPrefix(uri, "git:") {
+		url := strings.TrimPrefix(uri, "git:")
+		var ref, path string
+		if pos := strings.LastIndex(url, "#"); pos >= 0 {
+			ref = url[pos+1:]
+			url = url[0:pos]
+			if strings.Contains(ref, "/") {
+				parts := strings.SplitN(ref, "/", 2)
+				ref = parts[0]
+				path = parts[1]
+			}
+		}
+		if url == "" {
+			return nil, fmt.Errorf("expected format is git:url[#ref[/path]], got: %s", uri)
+		}
+		if err := validateGitRepository(url, ref); err != nil {
+			return nil, err
+		}
+		return withCache(newGitKameletRepository(url, ref, path), credentials), nil
+	} else if strings.HasPrefix(uri, "oci:") {
+		registry, repository, reference, err := parseOCIReference(strings.TrimPrefix(uri, "oci:"))
+		if err != nil {
+			return nil, errors.Wrapf(err, "expected format is oci:registry/repository[:tag|@digest], got: %s", uri)
+		}
+		return withCache(newOCIKameletRepository(registry, repository, reference, credentials), credentials), nil
 	}
 	return nil, fmt.Errorf("invalid uri: %s", uri)
 }
@@ -97,10 +97,83 @@ func TestURIParse(t *testing.T) {
 			uri:        "none",
 			repository: &emptyKameletRepository{},
 		},
+		{
+			uri:        "file:/path/to/kamelets",
+			repository: &fileKameletRepository{path: "/path/to/kamelets"},
+		},
+		{
+			uri:        "file:///path/to/kamelets",
+			repository: &fileKameletRepository{path: "/path/to/kamelets"},
+		},
+		{
+			uri:   "file:",
+			error: true,
+		},
+		{
+			uri:        "git:https://git.example.com/team/kamelets.git",
+			repository: &gitKameletRepository{url: "https://git.example.com/team/kamelets.git"},
+		},
+		{
+			uri: "git:https://git.example.com/team/kamelets.git#v1.2.3",
+			repository: &gitKameletRepository{
+				url: "https://git.example.com/team/kamelets.git",
+				ref: "v1.2.3",
+			},
+		},
+		{
+			uri: "git:git@git.example.com:team/kamelets.git#main/the/path",
+			repository: &gitKameletRepository{
+				url:  "git@git.example.com:team/kamelets.git",
+				ref:  "main",
+				path: "the/path",
+			},
+		},
+		{
+			uri:   "git:#main",
+			error: true,
+		},
+		{
+			uri:   "git:--upload-pack=touch /tmp/pwned",
+			error: true,
+		},
+		{
+			uri:   "git:https://git.example.com/team/kamelets.git#--upload-pack=touch",
+			error: true,
+		},
+		{
+			uri:   "git:file:///path/to/kamelets",
+			error: true,
+		},
+		{
+			uri:   "git:ext::sh -c touch% /tmp/pwned",
+			error: true,
+		},
+		{
+			uri: "git:ssh://git@git.example.com/team/kamelets.git#v1.2.3",
+			repository: &gitKameletRepository{
+				url: "ssh://git@git.example.com/team/kamelets.git",
+				ref: "v1.2.3",
+			},
+		},
+		{
+			uri: "oci:quay.io/my-org/kamelets:1.0",
+			repository: &ociKameletRepository{
+				registry:   "quay.io",
+				repository: "my-org/kamelets",
+				reference:  "1.0",
+			},
+		},
+		{
+			uri:   "oci:kamelets",
+			error: true,
+		},
 	}
 	for i, test := range tests {
 		t.Run(fmt.Sprintf("%d-%s", i, test.uri), func(t *testing.T) {
 			catalog, err := newFromURI(test.uri)
+			if cached, ok := catalog.(*cachingKameletRepository); ok {
+				catalog = cached.delegate
+			}
 			if test.error {
 				assert.Error(t, err)
 			} else {
@@ -113,6 +186,22 @@ func TestURIParse(t *testing.T) {
 					assert.Equal(t, r.repo, gc.repo)
 					assert.Equal(t, r.path, gc.path)
 					assert.Equal(t, r.ref, gc.ref)
+				case *fileKameletRepository:
+					fc, ok := catalog.(*fileKameletRepository)
+					assert.True(t, ok)
+					assert.Equal(t, r.path, fc.path)
+				case *gitKameletRepository:
+					gc, ok := catalog.(*gitKameletRepository)
+					assert.True(t, ok)
+					assert.Equal(t, r.url, gc.url)
+					assert.Equal(t, r.ref, gc.ref)
+					assert.Equal(t, r.path, gc.path)
+				case *ociKameletRepository:
+					oc, ok := catalog.(*ociKameletRepository)
+					assert.True(t, ok)
+					assert.Equal(t, r.registry, oc.registry)
+					assert.Equal(t, r.repository, oc.repository)
+					assert.Equal(t, r.reference, oc.reference)
 				case *emptyKameletRepository:
 					_, ok := catalog.(*emptyKameletRepository)
 					assert.True(t, ok)
@@ -0,0 +1,69 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Masterminds/semver"
+	"github.com/apache/camel-k/pkg/apis/camel/v1alpha1"
+)
+
+// SelectVersion returns a copy of the Kamelet using the spec of the highest version matching the given semver constraint,
+// or nil if none matches. Versions that are declared literally as the given constraint take precedence.
+// The Kamelet is returned as is when no constraint is given.
+func SelectVersion(kamelet *v1alpha1.Kamelet, constraint string) (*v1alpha1.Kamelet, error) {
+	if kamelet == nil || constraint == "" {
+		return kamelet, nil
+	}
+	if k := kamelet.ForVersion(constraint); k != nil {
+		return k, nil
+	}
+
+	c, err := semver.NewConstraint(constraint)
+	if err != nil {
+		return nil, fmt.Errorf("invalid version %q requested for kamelet %q: %v", constraint, kamelet.Name, err)
+	}
+	var selected *semver.Version
+	var selectedName string
+	for _, v := range kamelet.GetVersions() {
+		version, err := semver.NewVersion(v)
+		if err != nil {
+			// not a semantic version
+			continue
+		}
+		if c.Check(version) && (selected == nil || version.GreaterThan(selected)) {
+			selected = version
+			selectedName = v
+		}
+	}
+	if selected == nil {
+		return nil, nil
+	}
+	return kamelet.ForVersion(selectedName), nil
+}
+
+// getVersion looks up the Kamelet in the given repository and selects the version matching the constraint
+func getVersion(ctx context.Context, repo KameletRepository, name, constraint string) (*v1alpha1.Kamelet, error) {
+	kamelet, err := repo.Get(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	return SelectVersion(kamelet, constraint)
+}
@@ -0,0 +1,108 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/apache/camel-k/pkg/apis/camel/v1alpha1"
+	"github.com/apache/camel-k/pkg/client/camel/clientset/versioned/fake"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func versionedKamelet(namespace string, version string, versions ...string) *v1alpha1.Kamelet {
+	kamelet := v1alpha1.Kamelet{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      "kamelet1",
+			Labels: map[string]string{
+				v1alpha1.KameletVersionLabel: version,
+			},
+		},
+		Spec: v1alpha1.KameletSpec{
+			Dependencies: []string{"camel:" + version},
+		},
+	}
+	for _, v := range versions {
+		kamelet.Spec.Versions = append(kamelet.Spec.Versions, v1alpha1.KameletVersionSpec{
+			Version:      v,
+			Dependencies: []string{"camel:" + v},
+		})
+	}
+	return &kamelet
+}
+
+func TestSelectVersion(t *testing.T) {
+	kamelet := versionedKamelet("test", "2.0.0", "1.2.0", "1.2.3", "1.3.0", "latest")
+
+	testcases := []struct {
+		constraint string
+		version    string
+	}{
+		{constraint: "", version: "2.0.0"},
+		{constraint: "2.0.0", version: "2.0.0"},
+		{constraint: "1.2", version: "1.2.0"},
+		{constraint: "1.2.x", version: "1.2.3"},
+		{constraint: "~1.2", version: "1.2.3"},
+		{constraint: "^1.2", version: "1.3.0"},
+		{constraint: "*", version: "2.0.0"},
+		{constraint: "latest", version: "latest"},
+		{constraint: "3.x", version: ""},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.constraint, func(t *testing.T) {
+			selected, err := SelectVersion(kamelet, tc.constraint)
+			assert.NoError(t, err)
+			if tc.version == "" {
+				assert.Nil(t, selected)
+				return
+			}
+			assert.NotNil(t, selected)
+			assert.Equal(t, tc.version, selected.GetVersion())
+			assert.Equal(t, []string{"camel:" + tc.version}, selected.Spec.Dependencies)
+		})
+	}
+
+	_, err := SelectVersion(kamelet, "not a version")
+	assert.Error(t, err)
+}
+
+func TestCompositeRepositoryGetVersion(t *testing.T) {
+	ctx := context.Background()
+	fakeClient := fake.NewSimpleClientset(
+		versionedKamelet("test1", "2.0.0"),
+		versionedKamelet("test2", "1.0.0", "1.1.0"),
+	)
+	repo := newCompositeKameletRepository(
+		newKubernetesKameletRepository(fakeClient, "test1"),
+		newKubernetesKameletRepository(fakeClient, "test2"),
+	)
+
+	k, err := repo.GetVersion(ctx, "kamelet1", "")
+	assert.NoError(t, err)
+	assert.Equal(t, "2.0.0", k.GetVersion())
+	k, err = repo.GetVersion(ctx, "kamelet1", "^1.0")
+	assert.NoError(t, err)
+	assert.Equal(t, "1.1.0", k.GetVersion())
+	k, err = repo.GetVersion(ctx, "kamelet1", "0.x")
+	assert.NoError(t, err)
+	assert.Nil(t, k)
+}
@@ -0,0 +1,230 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kamelet
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/apache/camel-k/pkg/apis/camel/v1alpha1"
+)
+
+// InvalidPropertiesError is returned when the properties provided to a Kamelet do not comply with its definition
+type InvalidPropertiesError struct {
+	// Kamelet is the name of the Kamelet
+	Kamelet string
+	// Violations contains a description of each failed check
+	Violations []string
+}
+
+func (e *InvalidPropertiesError) Error() string {
+	return strings.Join(e.Violations, "; ")
+}
+
+// ValidateProperties checks the given property values against the JSON schema definition of the Kamelet.
+// Presence of required properties, types, allowed values, patterns and numeric bounds are verified.
+//
+// Since properties are eventually rendered as strings in Camel URIs and configuration,
+// textual values are accepted for numeric and boolean properties as long as they can be parsed.
+// Properties not declared in the definition are ignored.
+func ValidateProperties(kamelet *v1alpha1.Kamelet, properties map[string]interface{}) error {
+	if kamelet == nil || kamelet.Spec.Definition == nil {
+		return nil
+	}
+	definition := kamelet.Spec.Definition
+
+	violations := make([]string, 0)
+	for _, name := range definition.Required {
+		if _, ok := properties[name]; !ok {
+			violations = append(violations, fmt.Sprintf("missing required property %q for kamelet %q", name, kamelet.Name))
+		}
+	}
+
+	names := make([]string, 0, len(properties))
+	for name := range properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		prop, ok := definition.Properties[name]
+		if !ok {
+			continue
+		}
+		if err := validateProperty(prop, properties[name]); err != nil {
+			if prop.IsSensitive() {
+				// do not disclose sensitive values in error messages and conditions
+				violations = append(violations, fmt.Sprintf("invalid value for sensitive property %q of kamelet %q", name, kamelet.Name))
+				continue
+			}
+			violations = append(violations, fmt.Sprintf("invalid value for property %q of kamelet %q: %v", name, kamelet.Name, err))
+		}
+	}
+
+	if len(violations) > 0 {
+		return &InvalidPropertiesError{
+			Kamelet:    kamelet.Name,
+			Violations: violations,
+		}
+	}
+	return nil
+}
+
+func validateProperty(prop v1alpha1.JSONSchemaProp, value interface{}) error {
+	typed, err := ToPropertyType(prop, value)
+	if err != nil {
+		return err
+	}
+
+	if len(prop.Enum) > 0 {
+		if err := validateEnum(prop, typed); err != nil {
+			return err
+		}
+	}
+
+	switch v := typed.(type) {
+	case string:
+		return validateString(prop, v)
+	case int64:
+		return validateNumber(prop, float64(v))
+	case float64:
+		return validateNumber(prop, v)
+	}
+	return nil
+}
+
+func validateEnum(prop v1alpha1.JSONSchemaProp, value interface{}) error {
+	allowed := make([]interface{}, 0, len(prop.Enum))
+	for _, e := range prop.Enum {
+		var v interface{}
+		if err := json.Unmarshal(e.RawMessage, &v); err != nil {
+			return err
+		}
+		if reflect.DeepEqual(v, value) || fmt.Sprintf("%v", v) == fmt.Sprintf("%v", value) {
+			return nil
+		}
+		allowed = append(allowed, v)
+	}
+	return fmt.Errorf("value %v is not one of %v", value, allowed)
+}
+
+func validateString(prop v1alpha1.JSONSchemaProp, value string) error {
+	if prop.MinLength != nil && int64(len(value)) < *prop.MinLength {
+		return fmt.Errorf("value %q is shorter than %d characters", value, *prop.MinLength)
+	}
+	if prop.MaxLength != nil && int64(len(value)) > *prop.MaxLength {
+		return fmt.Errorf("value %q is longer than %d characters", value, *prop.MaxLength)
+	}
+	if prop.Pattern != "" {
+		re, err := regexp.Compile(prop.Pattern)
+		if err != nil {
+			return fmt.Errorf("invalid pattern %q in the kamelet definition: %v", prop.Pattern, err)
+		}
+		if !re.MatchString(value) {
+			return fmt.Errorf("value %q does not match pattern %q", value, prop.Pattern)
+		}
+	}
+	return nil
+}
+
+func validateNumber(prop v1alpha1.JSONSchemaProp, value float64) error {
+	if prop.Minimum != nil {
+		min, err := prop.Minimum.Float64()
+		if err != nil {
+			return err
+		}
+		if value < min || (prop.ExclusiveMinimum && value == min) {
+			return fmt.Errorf("value %v is lower than the minimum %v", value, prop.Minimum)
+		}
+	}
+	if prop.Maximum != nil {
+		max, err := prop.Maximum.Float64()
+		if err != nil {
+			return err
+		}
+		if value > max || (prop.ExclusiveMaximum && value == max) {
+			return fmt.Errorf("value %v is greater than the maximum %v", value, prop.Maximum)
+		}
+	}
+	return nil
+}
+
+// ToPropertyType converts the given value to the type declared in the property schema.
+// Textual values are parsed when the property is numeric or boolean: integers are returned as int64 and numbers as float64.
+func ToPropertyType(prop v1alpha1.JSONSchemaProp, value interface{}) (interface{}, error) {
+	switch prop.Type {
+	case "integer":
+		switch v := value.(type) {
+		case float64:
+			if v != float64(int64(v)) {
+				return nil, fmt.Errorf("%v is not an integer", value)
+			}
+			return int64(v), nil
+		case int:
+			return int64(v), nil
+		case int64:
+			return v, nil
+		case string:
+			i, err := strconv.ParseInt(v, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("%q is not an integer", v)
+			}
+			return i, nil
+		}
+	case "number":
+		switch v := value.(type) {
+		case float64:
+			return v, nil
+		case int:
+			return float64(v), nil
+		case int64:
+			return float64(v), nil
+		case string:
+			f, err := strconv.ParseFloat(v, 64)
+			if err != nil {
+				return nil, fmt.Errorf("%q is not a number", v)
+			}
+			return f, nil
+		}
+	case "boolean":
+		switch v := value.(type) {
+		case bool:
+			return v, nil
+		case string:
+			b, err := strconv.ParseBool(v)
+			if err != nil {
+				return nil, fmt.Errorf("%q is not a boolean", v)
+			}
+			return b, nil
+		}
+	case "string":
+		switch value.(type) {
+		case map[string]interface{}, []interface{}:
+			return nil, fmt.Errorf("expected a string, got %v", value)
+		}
+		return fmt.Sprintf("%v", value), nil
+	default:
+		return value, nil
+	}
+	return nil, fmt.Errorf("expected a value of type %s, got %v", prop.Type, value)
+}
@@ -0,0 +1,291 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kamelet
+
+import (
+	"encoding/json"
+	"fmt"
+	"mime"
+	"regexp"
+	"sort"
+	"strings"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/apis/camel/v1alpha1"
+	"github.com/apache/camel-k/pkg/util/camel"
+	"github.com/apache/camel-k/pkg/util/flow"
+	"github.com/apache/camel-k/pkg/util/gzip"
+	"github.com/apache/camel-k/pkg/util/jitpack"
+	"github.com/apache/camel-k/pkg/util/maven"
+	"github.com/apache/camel-k/pkg/util/source"
+)
+
+var (
+	// placeholderRegexp matches Camel property placeholders, e.g. "{{name}}", "{{?name}}" or "{{name:default}}"
+	placeholderRegexp = regexp.MustCompile(`\{\{\s*(\?)?([^{}:\s]+)\s*(:[^{}]*)?\}\}`)
+
+	// implicitPlaceholders are set by the Kamelet component on every route template
+	implicitPlaceholders = map[string]bool{
+		"routeId":    true,
+		"templateId": true,
+	}
+)
+
+// SpecViolation describes a problem found in the spec of a Kamelet
+type SpecViolation struct {
+	// Reason is the reason of the Ready condition of the Kamelet
+	Reason string
+	// Message is a human readable description of the problem
+	Message string
+}
+
+// ValidateSpec checks that the flow of the Kamelet can be parsed, that all the placeholders used in the flow and in the template
+// sources are declared in the definition, that the dependencies can be resolved against the given catalog and that media types are well formed.
+// The additional versions of the Kamelet are validated in the same way.
+func ValidateSpec(kamelet *v1alpha1.Kamelet, catalog *camel.RuntimeCatalog) []SpecViolation {
+	violations := validateVersionSpec(kamelet, catalog)
+
+	versions := map[string]bool{
+		kamelet.GetVersion(): true,
+	}
+	for _, v := range kamelet.Spec.Versions {
+		if v.Version == "" {
+			violations = append(violations, SpecViolation{
+				Reason:  v1alpha1.KameletConditionReasonInvalidVersion,
+				Message: "invalid version: the version is empty",
+			})
+			continue
+		}
+		if versions[v.Version] {
+			violations = append(violations, SpecViolation{
+				Reason:  v1alpha1.KameletConditionReasonInvalidVersion,
+				Message: fmt.Sprintf("invalid version %q: the version is declared more than once", v.Version),
+			})
+			continue
+		}
+		versions[v.Version] = true
+		for _, violation := range validateVersionSpec(kamelet.ForVersion(v.Version), catalog) {
+			violations = append(violations, SpecViolation{
+				Reason:  violation.Reason,
+				Message: fmt.Sprintf("version %s: %s", v.Version, violation.Message),
+			})
+		}
+	}
+	return violations
+}
+
+func validateVersionSpec(kamelet *v1alpha1.Kamelet, catalog *camel.RuntimeCatalog) []SpecViolation {
+	violations := make([]SpecViolation, 0)
+	violations = append(violations, validateFlow(kamelet, catalog)...)
+	violations = append(violations, validatePlaceholders(kamelet)...)
+	violations = append(violations, validateDependencies(kamelet, catalog)...)
+	violations = append(violations, validateMediaTypes(kamelet)...)
+	return violations
+}
+
+func validateFlow(kamelet *v1alpha1.Kamelet, catalog *camel.RuntimeCatalog) []SpecViolation {
+	if kamelet.Spec.Flow == nil {
+		return nil
+	}
+	invalid := func(format string, args ...interface{}) []SpecViolation {
+		return []SpecViolation{{
+			Reason:  v1alpha1.KameletConditionReasonInvalidFlow,
+			Message: fmt.Sprintf("invalid flow: "+format, args...),
+		}}
+	}
+
+	var route map[string]interface{}
+	if err := json.Unmarshal(kamelet.Spec.Flow.RawMessage, &route); err != nil {
+		return invalid("%v", err)
+	}
+	from, ok := route["from"].(map[string]interface{})
+	if !ok || len(route) != 1 {
+		return invalid(`the flow must contain a single "from" element`)
+	}
+	if uri, ok := from["uri"].(string); !ok || uri == "" {
+		return invalid(`the "from" element must have a "uri"`)
+	}
+	if steps, ok := from["steps"]; ok {
+		if _, ok := steps.([]interface{}); !ok {
+			return invalid(`the "steps" of the "from" element must be a list`)
+		}
+	}
+
+	content, err := flow.ToYamlDSL([]v1.Flow{*kamelet.Spec.Flow})
+	if err != nil {
+		return invalid("%v", err)
+	}
+	if catalog != nil {
+		meta := source.NewMetadata()
+		inspector := source.InspectorForLanguage(catalog, v1.LanguageYaml)
+		err := inspector.Extract(v1.SourceSpec{
+			DataSpec: v1.DataSpec{
+				Name:    kamelet.Name + ".yaml",
+				Content: string(content),
+			},
+			Language: v1.LanguageYaml,
+		}, &meta)
+		if err != nil {
+			return invalid("%v", err)
+		}
+	}
+	return nil
+}
+
+// validatePlaceholders checks the placeholders used in the flow and in the template sources, that are resolved against the Kamelet properties.
+// Other sources are not checked, as they can reference any property of the integration.
+func validatePlaceholders(kamelet *v1alpha1.Kamelet) []SpecViolation {
+	contents := make(map[string]string)
+	if kamelet.Spec.Flow != nil {
+		if content, err := flow.ToYamlDSL([]v1.Flow{*kamelet.Spec.Flow}); err == nil {
+			contents["flow"] = string(content)
+		}
+	}
+	for _, s := range kamelet.Spec.Sources {
+		if s.Type != v1.SourceTypeTemplate || s.ContentRef != "" {
+			continue
+		}
+		content := []byte(s.Content)
+		if s.Compression {
+			var err error
+			if content, err = gzip.UncompressBase64(content); err != nil {
+				continue
+			}
+		}
+		contents[fmt.Sprintf("source %q", s.Name)] = string(content)
+	}
+
+	declared := make(map[string]bool)
+	if kamelet.Spec.Definition != nil {
+		for name := range kamelet.Spec.Definition.Properties {
+			declared[name] = true
+		}
+	}
+
+	locations := make([]string, 0, len(contents))
+	for location := range contents {
+		locations = append(locations, location)
+	}
+	sort.Strings(locations)
+
+	violations := make([]SpecViolation, 0)
+	for _, location := range locations {
+		reported := make(map[string]bool)
+		for _, match := range placeholderRegexp.FindAllStringSubmatch(contents[location], -1) {
+			optional, name, defaultValue := match[1] != "", match[2], match[3]
+			if optional || defaultValue != "" || declared[name] || implicitPlaceholders[name] || reported[name] {
+				continue
+			}
+			reported[name] = true
+			violations = append(violations, SpecViolation{
+				Reason:  v1alpha1.KameletConditionReasonUndeclaredPlaceholder,
+				Message: fmt.Sprintf("placeholder %q used in %s is not declared in the definition and has no default value", name, location),
+			})
+		}
+	}
+	return violations
+}
+
+func validateDependencies(kamelet *v1alpha1.Kamelet, catalog *camel.RuntimeCatalog) []SpecViolation {
+	violations := make([]SpecViolation, 0)
+	for _, d := range kamelet.Spec.Dependencies {
+		if err := validateDependency(d, catalog); err != nil {
+			violations = append(violations, SpecViolation{
+				Reason:  v1alpha1.KameletConditionReasonInvalidDependency,
+				Message: fmt.Sprintf("invalid dependency %q: %v", d, err),
+			})
+		}
+	}
+	return violations
+}
+
+func validateDependency(dependency string, catalog *camel.RuntimeCatalog) error {
+	switch {
+	case strings.HasPrefix(dependency, "camel:"):
+		name := strings.TrimPrefix(strings.TrimPrefix(dependency, "camel:"), "camel-")
+		if catalog == nil {
+			return nil
+		}
+		candidates := []string{"camel-" + name}
+		if catalog.Runtime.Provider == v1.RuntimeProviderQuarkus {
+			candidates = append(candidates, "camel-quarkus-"+name)
+		}
+		for _, artifact := range candidates {
+			if _, ok := catalog.Artifacts[artifact]; ok {
+				return nil
+			}
+		}
+		// Components provided by the runtime, e.g. "camel:kamelet"
+		if catalog.GetArtifactByScheme(name) != nil {
+			return nil
+		}
+		return fmt.Errorf("no artifact found in the Camel catalog for runtime %s %s", catalog.Runtime.Provider, catalog.Runtime.Version)
+	case strings.HasPrefix(dependency, "camel-k:"), strings.HasPrefix(dependency, "camel-quarkus:"):
+		if strings.HasSuffix(dependency, ":") {
+			return fmt.Errorf("missing artifact name")
+		}
+		return nil
+	case strings.HasPrefix(dependency, "mvn:"), strings.HasPrefix(dependency, "bom:"):
+		gav := dependency[4:]
+		if strings.Count(gav, ":") < 1 {
+			return fmt.Errorf("expected format is <groupId>:<artifactId>[:<version>]")
+		}
+		_, err := maven.ParseGAV(gav)
+		return err
+	default:
+		if jitpack.ToDependency(dependency) != nil {
+			return nil
+		}
+		return fmt.Errorf("unknown dependency type, expected one of camel:, camel-k:, camel-quarkus:, mvn:, bom:, github:")
+	}
+}
+
+func validateMediaTypes(kamelet *v1alpha1.Kamelet) []SpecViolation {
+	slots := make([]string, 0, len(kamelet.Spec.Types))
+	for slot := range kamelet.Spec.Types {
+		slots = append(slots, string(slot))
+	}
+	sort.Strings(slots)
+
+	violations := make([]SpecViolation, 0)
+	for _, slot := range slots {
+		mediaType := kamelet.Spec.Types[v1alpha1.EventSlot(slot)].MediaType
+		if mediaType == "" {
+			continue
+		}
+		if err := validateMediaType(mediaType); err != nil {
+			violations = append(violations, SpecViolation{
+				Reason:  v1alpha1.KameletConditionReasonInvalidMediaType,
+				Message: fmt.Sprintf("invalid media type %q for slot %q: %v", mediaType, slot, err),
+			})
+		}
+	}
+	return violations
+}
+
+func validateMediaType(mediaType string) error {
+	mt, _, err := mime.ParseMediaType(mediaType)
+	if err != nil {
+		return err
+	}
+	parts := strings.Split(mt, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return fmt.Errorf("expected format is <type>/<subtype>")
+	}
+	return nil
+}
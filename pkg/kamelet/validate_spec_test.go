@@ -0,0 +1,195 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kamelet
+
+import (
+	"testing"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/apis/camel/v1alpha1"
+	"github.com/apache/camel-k/pkg/util/camel"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestValidateSpec(t *testing.T) {
+	catalog, err := camel.DefaultCatalog()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		flow       string
+		sources    []v1.SourceSpec
+		deps       []string
+		types      map[v1alpha1.EventSlot]v1alpha1.EventTypeSpec
+		versions   []v1alpha1.KameletVersionSpec
+		violations []SpecViolation
+	}{
+		{
+			name: "valid",
+			flow: `{"from":{"uri":"timer:{{routeId}}","parameters":{"period":"{{period}}"},"steps":[{"set-body":{"constant":"{{?message}}"}},{"to":"log:{{logger:info}}"},{"to":"kamelet:sink"}]}}`,
+			sources: []v1.SourceSpec{{
+				DataSpec: v1.DataSpec{Name: "support.groovy", Content: `def p = '{{period}}'`},
+			}},
+			deps:  []string{"camel:timer", "camel:log", "camel:kamelet", "camel-quarkus:core", "mvn:org.acme:my-lib:1.0", "github:apache/camel-sample/1.0"},
+			types: map[v1alpha1.EventSlot]v1alpha1.EventTypeSpec{"out": {MediaType: "application/json; charset=utf-8"}},
+		},
+		{
+			name: "invalid flow",
+			flow: `{"from":{"parameters":{}}}`,
+			violations: []SpecViolation{{
+				Reason:  v1alpha1.KameletConditionReasonInvalidFlow,
+				Message: `invalid flow: the "from" element must have a "uri"`,
+			}},
+		},
+		{
+			name: "invalid steps",
+			flow: `{"from":{"uri":"timer:tick","steps":{"to":"log:info"}}}`,
+			violations: []SpecViolation{{
+				Reason:  v1alpha1.KameletConditionReasonInvalidFlow,
+				Message: `invalid flow: the "steps" of the "from" element must be a list`,
+			}},
+		},
+		{
+			name: "undeclared placeholder",
+			flow: `{"from":{"uri":"timer:tick","steps":[{"to":"log:{{logger}}"},{"to":"log:{{logger}}"}]}}`,
+			sources: []v1.SourceSpec{
+				{
+					DataSpec: v1.DataSpec{Name: "support.groovy", Content: `def t = '{{ token }}'`},
+				},
+				{
+					DataSpec: v1.DataSpec{Name: "route.groovy", Content: `from('timer:tick').to('log:{{ logger }}?level={{level}}')`},
+					Type:     v1.SourceTypeTemplate,
+				},
+			},
+			violations: []SpecViolation{
+				{
+					Reason:  v1alpha1.KameletConditionReasonUndeclaredPlaceholder,
+					Message: `placeholder "logger" used in flow is not declared in the definition and has no default value`,
+				},
+				{
+					Reason:  v1alpha1.KameletConditionReasonUndeclaredPlaceholder,
+					Message: `placeholder "logger" used in source "route.groovy" is not declared in the definition and has no default value`,
+				},
+				{
+					Reason:  v1alpha1.KameletConditionReasonUndeclaredPlaceholder,
+					Message: `placeholder "level" used in source "route.groovy" is not declared in the definition and has no default value`,
+				},
+			},
+		},
+		{
+			name: "invalid versions",
+			flow: `{"from":{"uri":"timer:tick"}}`,
+			versions: []v1alpha1.KameletVersionSpec{
+				{
+					Version: "1.0.0",
+					Flow:    &v1.Flow{RawMessage: []byte(`{"from":{"uri":"timer:tick","steps":[{"to":"log:{{logger}}"}]}}`)},
+				},
+				{
+					Version: "1.0.0",
+					Flow:    &v1.Flow{RawMessage: []byte(`{"from":{"uri":"timer:tick"}}`)},
+				},
+				{
+					Flow: &v1.Flow{RawMessage: []byte(`{"from":{"uri":"timer:tick"}}`)},
+				},
+				{
+					Version:      "2.0.0",
+					Flow:         &v1.Flow{RawMessage: []byte(`{"from":{"uri":"timer:tick"}}`)},
+					Dependencies: []string{"npm:left-pad"},
+				},
+			},
+			violations: []SpecViolation{
+				{
+					Reason:  v1alpha1.KameletConditionReasonUndeclaredPlaceholder,
+					Message: `version 1.0.0: placeholder "logger" used in flow is not declared in the definition and has no default value`,
+				},
+				{
+					Reason:  v1alpha1.KameletConditionReasonInvalidVersion,
+					Message: `invalid version "1.0.0": the version is declared more than once`,
+				},
+				{
+					Reason:  v1alpha1.KameletConditionReasonInvalidVersion,
+					Message: `invalid version: the version is empty`,
+				},
+				{
+					Reason:  v1alpha1.KameletConditionReasonInvalidDependency,
+					Message: `version 2.0.0: invalid dependency "npm:left-pad": unknown dependency type, expected one of camel:, camel-k:, camel-quarkus:, mvn:, bom:, github:`,
+				},
+			},
+		},
+		{
+			name: "invalid dependencies",
+			flow: `{"from":{"uri":"timer:tick"}}`,
+			deps: []string{"camel:not-a-component", "mvn:org.acme", "npm:left-pad"},
+			violations: []SpecViolation{
+				{
+					Reason:  v1alpha1.KameletConditionReasonInvalidDependency,
+					Message: `invalid dependency "camel:not-a-component": no artifact found in the Camel catalog for runtime quarkus ` + catalog.Runtime.Version,
+				},
+				{
+					Reason:  v1alpha1.KameletConditionReasonInvalidDependency,
+					Message: `invalid dependency "mvn:org.acme": expected format is <groupId>:<artifactId>[:<version>]`,
+				},
+				{
+					Reason:  v1alpha1.KameletConditionReasonInvalidDependency,
+					Message: `invalid dependency "npm:left-pad": unknown dependency type, expected one of camel:, camel-k:, camel-quarkus:, mvn:, bom:, github:`,
+				},
+			},
+		},
+		{
+			name:  "invalid media type",
+			flow:  `{"from":{"uri":"timer:tick"}}`,
+			types: map[v1alpha1.EventSlot]v1alpha1.EventTypeSpec{"out": {MediaType: "application/json;"}, "in": {MediaType: "json"}},
+			violations: []SpecViolation{
+				{
+					Reason:  v1alpha1.KameletConditionReasonInvalidMediaType,
+					Message: `invalid media type "json" for slot "in": expected format is <type>/<subtype>`,
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			kamelet := v1alpha1.Kamelet{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "my-kamelet",
+				},
+				Spec: v1alpha1.KameletSpec{
+					Definition: &v1alpha1.JSONSchemaProps{
+						Properties: map[string]v1alpha1.JSONSchemaProp{
+							"period": {Type: "integer"},
+						},
+					},
+					Flow:         &v1.Flow{RawMessage: []byte(test.flow)},
+					Sources:      test.sources,
+					Dependencies: test.deps,
+					Types:        test.types,
+					Versions:     test.versions,
+				},
+			}
+			violations := ValidateSpec(&kamelet, catalog)
+			if len(test.violations) == 0 {
+				assert.Empty(t, violations)
+			} else {
+				assert.Equal(t, test.violations, violations)
+			}
+		})
+	}
+}
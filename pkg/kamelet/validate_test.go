@@ -0,0 +1,137 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kamelet
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/apache/camel-k/pkg/apis/camel/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestValidateProperties(t *testing.T) {
+	one := json.Number("1")
+	ten := json.Number("10")
+	three := int64(3)
+	kamelet := v1alpha1.Kamelet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "my-kamelet",
+		},
+		Spec: v1alpha1.KameletSpec{
+			Definition: &v1alpha1.JSONSchemaProps{
+				Required: []string{"topic"},
+				Properties: map[string]v1alpha1.JSONSchemaProp{
+					"topic":   {Type: "string", Pattern: "^[a-z]+$", MinLength: &three},
+					"retries": {Type: "integer", Minimum: &one, Maximum: &ten, ExclusiveMaximum: true},
+					"ratio":   {Type: "number"},
+					"enabled": {Type: "boolean"},
+					"mode":    {Type: "string", Enum: []v1alpha1.JSON{asJSON("fast"), asJSON("slow")}},
+					"secret":  {Type: "string", Format: "password", MinLength: &three},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		properties map[string]interface{}
+		violations []string
+	}{
+		{
+			name: "valid",
+			properties: map[string]interface{}{
+				"topic":   "orders",
+				"retries": float64(5),
+				"ratio":   "0.5",
+				"enabled": "true",
+				"mode":    "slow",
+				"other":   "ignored",
+			},
+		},
+		{
+			name:       "missing required",
+			properties: map[string]interface{}{},
+			violations: []string{`missing required property "topic" for kamelet "my-kamelet"`},
+		},
+		{
+			name: "wrong types",
+			properties: map[string]interface{}{
+				"topic":   "orders",
+				"retries": "many",
+				"ratio":   true,
+				"enabled": "yes",
+			},
+			violations: []string{
+				`invalid value for property "enabled" of kamelet "my-kamelet": "yes" is not a boolean`,
+				`invalid value for property "ratio" of kamelet "my-kamelet": expected a value of type number, got true`,
+				`invalid value for property "retries" of kamelet "my-kamelet": "many" is not an integer`,
+			},
+		},
+		{
+			name: "constraints",
+			properties: map[string]interface{}{
+				"topic":   "Orders",
+				"retries": "10",
+				"mode":    "medium",
+			},
+			violations: []string{
+				`invalid value for property "mode" of kamelet "my-kamelet": value medium is not one of [fast slow]`,
+				`invalid value for property "retries" of kamelet "my-kamelet": value 10 is greater than the maximum 10`,
+				`invalid value for property "topic" of kamelet "my-kamelet": value "Orders" does not match pattern "^[a-z]+$"`,
+			},
+		},
+		{
+			name: "bounds",
+			properties: map[string]interface{}{
+				"topic":   "ab",
+				"retries": float64(0),
+				"secret":  "pw",
+			},
+			violations: []string{
+				`invalid value for property "retries" of kamelet "my-kamelet": value 0 is lower than the minimum 1`,
+				`invalid value for sensitive property "secret" of kamelet "my-kamelet"`,
+				`invalid value for property "topic" of kamelet "my-kamelet": value "ab" is shorter than 3 characters`,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateProperties(&kamelet, tc.properties)
+			if len(tc.violations) == 0 {
+				assert.NoError(t, err)
+				return
+			}
+			assert.Error(t, err)
+			invalid, ok := err.(*InvalidPropertiesError)
+			assert.True(t, ok)
+			assert.Equal(t, "my-kamelet", invalid.Kamelet)
+			assert.Equal(t, tc.violations, invalid.Violations)
+		})
+	}
+}
+
+func asJSON(value interface{}) v1alpha1.JSON {
+	data, err := json.Marshal(value)
+	if err != nil {
+		panic(err)
+	}
+	return v1alpha1.JSON{RawMessage: data}
+}
@@ -47,6 +47,20 @@ import (
 // BuilderServiceAccount --
 const BuilderServiceAccount = "camel-k-builder"
 
+const (
+	defaultMaxRunningRoutineBuilds = 3
+	defaultMaxRunningPodBuilds     = 10
+)
+
+// GetDefaultMaxRunningBuilds returns the default maximum number of builds that can run at the same time with the given build strategy
+func GetDefaultMaxRunningBuilds(strategy v1.IntegrationPlatformBuildStrategy) int32 {
+	if strategy == v1.IntegrationPlatformBuildStrategyRoutine {
+		// Routine builds run within the operator process
+		return defaultMaxRunningRoutineBuilds
+	}
+	return defaultMaxRunningPodBuilds
+}
+
 // ConfigureDefaults fills with default values all missing details about the integration platform.
 // Defaults are set in the status fields, not in the spec.
 func ConfigureDefaults(ctx context.Context, c client.Client, p *v1.IntegrationPlatform, verbose bool) error {
@@ -175,6 +189,10 @@ func setPlatformDefaults(ctx context.Context, c client.Client, p *v1.Integration
 		p.Status.Build.PersistentVolumeClaim = p.Name
 	}
 
+	if p.Status.Build.MaxRunningBuilds <= 0 {
+		p.Status.Build.MaxRunningBuilds = GetDefaultMaxRunningBuilds(p.Status.Build.BuildStrategy)
+	}
+
 	if p.Status.Build.GetTimeout().Duration != 0 {
 		d := p.Status.Build.GetTimeout().Duration.Truncate(time.Second)
 
@@ -176,13 +176,14 @@ func (t *builderTrait) builderTask(e *Environment) *v1.BuilderTask {
 		BaseTask: v1.BaseTask{
 			Name: "builder",
 		},
-		Meta:         e.IntegrationKit.ObjectMeta,
-		BaseImage:    e.Platform.Status.Build.BaseImage,
-		Runtime:      e.CamelCatalog.Runtime,
-		Dependencies: e.IntegrationKit.Spec.Dependencies,
-		Properties:   e.Platform.Status.Build.Properties,
-		Timeout:      e.Platform.Status.Build.GetTimeout(),
-		Maven:        e.Platform.Status.Build.Maven,
+		Meta:           e.IntegrationKit.ObjectMeta,
+		BaseImage:      e.Platform.Status.Build.BaseImage,
+		Runtime:        e.CamelCatalog.Runtime,
+		Dependencies:   e.IntegrationKit.Spec.Dependencies,
+		Properties:     e.Platform.Status.Build.Properties,
+		Timeout:        e.Platform.Status.Build.GetTimeout(),
+		Maven:          e.Platform.Status.Build.Maven,
+		ContainerSteps: e.Platform.Status.Build.Steps,
 	}
 
 	steps := make([]builder.Step, 0)
@@ -200,6 +201,7 @@ func (t *builderTrait) builderTask(e *Environment) *v1.BuilderTask {
 
 	quarkus := e.Catalog.GetTrait("quarkus").(*quarkusTrait)
 	quarkus.addBuildSteps(&steps)
+	quarkus.configureBuildTask(task)
 
 	// sort steps by phase
 	sort.SliceStable(steps, func(i, j int) bool {
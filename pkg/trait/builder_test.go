@@ -109,6 +109,29 @@ func TestKanikoBuilderTrait(t *testing.T) {
 	assert.NotNil(t, env.BuildTasks[1].Image)
 }
 
+func TestBuilderTraitContainerSteps(t *testing.T) {
+	env := createBuilderTestEnv(v1.IntegrationPlatformClusterKubernetes, v1.IntegrationPlatformBuildPublishStrategyKaniko)
+	env.Platform.Spec.Build.Steps = []v1.ContainerStep{
+		{
+			ContainerTask: v1.ContainerTask{
+				BaseTask: v1.BaseTask{
+					Name: "scan",
+				},
+				Image: "scanner:latest",
+			},
+			Phase: 25,
+		},
+	}
+	env.Platform.ResyncStatusFullConfig()
+
+	err := NewBuilderTestCatalog().apply(env)
+
+	assert.Nil(t, err)
+	assert.Len(t, env.BuildTasks, 2)
+	assert.NotNil(t, env.BuildTasks[0].Builder)
+	assert.Equal(t, env.Platform.Spec.Build.Steps, env.BuildTasks[0].Builder.ContainerSteps)
+}
+
 func createBuilderTestEnv(cluster v1.IntegrationPlatformCluster, strategy v1.IntegrationPlatformBuildPublishStrategy) *Environment {
 	c, err := camel.DefaultCatalog()
 	if err != nil {
@@ -19,6 +19,7 @@ package trait
 
 import (
 	"fmt"
+	"path"
 	"sort"
 	"strings"
 
@@ -89,6 +90,14 @@ func (t *jvmTrait) Apply(e *Environment) error {
 		return fmt.Errorf("unable to find integration kit %s", e.Integration.Status.Kit)
 	}
 
+	layout, err := IntegrationKitLayoutFor(kit.Spec.Traits)
+	if err != nil {
+		return err
+	}
+	if layout == v1.IntegrationKitLayoutNative {
+		return t.applyNative(e, kit)
+	}
+
 	classpath := strset.New()
 
 	classpath.Add("/etc/camel/resources")
@@ -182,6 +191,39 @@ func (t *jvmTrait) Apply(e *Environment) error {
 	return nil
 }
 
+// applyNative configures the container to run the native executable of the kit, that does not need a JVM
+func (t *jvmTrait) applyNative(e *Environment, kit *v1.IntegrationKit) error {
+	container := e.getIntegrationContainer()
+	if container == nil {
+		return nil
+	}
+
+	var runner string
+	for _, artifact := range kit.Status.Artifacts {
+		if strings.HasSuffix(artifact.ID, "-runner") {
+			runner = path.Join("/deployments", artifact.Target)
+		}
+	}
+	if runner == "" {
+		return fmt.Errorf("unable to find the native executable of integration kit %s", kit.Name)
+	}
+
+	// Only retain the system properties other traits may have contributed,
+	// as JVM options like Java agents are not supported by native executables
+	args := make([]string, 0, len(container.Args))
+	for _, arg := range container.Args {
+		if strings.HasPrefix(arg, "-D") {
+			args = append(args, arg)
+		}
+	}
+
+	container.Command = []string{runner}
+	container.Args = args
+	container.WorkingDir = "/deployments"
+
+	return nil
+}
+
 // IsPlatformTrait overrides base class method
 func (t *jvmTrait) IsPlatformTrait() bool {
 	return true
@@ -179,6 +179,44 @@ func TestApplyJvmTraitWithDebugEnabled(t *testing.T) {
 	)
 }
 
+func TestApplyJvmTraitWithNativeKit(t *testing.T) {
+	trait, environment := createNominalJvmTest()
+	environment.IntegrationKit.Spec.Traits = map[string]v1.TraitSpec{
+		"quarkus": test.TraitSpecFromMap(t, map[string]interface{}{
+			"native": true,
+		}),
+	}
+	environment.IntegrationKit.Status.Artifacts = []v1.Artifact{
+		{
+			ID:     "camel-k-integration-1.4.0-runner",
+			Target: "camel-k-integration-1.4.0-runner",
+		},
+	}
+
+	d := appsv1.Deployment{
+		Spec: appsv1.DeploymentSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{
+							Name: defaultContainerName,
+							Args: []string{"-javaagent:/opt/agent.jar", "-Dfoo=bar"},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	environment.Resources.Add(&d)
+
+	err := trait.Apply(environment)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"/deployments/camel-k-integration-1.4.0-runner"}, d.Spec.Template.Spec.Containers[0].Command)
+	assert.Equal(t, []string{"-Dfoo=bar"}, d.Spec.Template.Spec.Containers[0].Args)
+}
+
 func createNominalJvmTest() (*jvmTrait, *Environment) {
 	return createJvmTestWithKitType(v1.IntegrationKitTypePlatform)
 }
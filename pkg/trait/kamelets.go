@@ -18,11 +18,14 @@ limitations under the License.
 package trait
 
 import (
+	"crypto/sha256"
 	"fmt"
 	"regexp"
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
+	"time"
 
 	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
 	"github.com/apache/camel-k/pkg/apis/camel/v1alpha1"
@@ -31,11 +34,16 @@ import (
 	"github.com/apache/camel-k/pkg/metadata"
 	"github.com/apache/camel-k/pkg/platform"
 	"github.com/apache/camel-k/pkg/util"
+	"github.com/apache/camel-k/pkg/util/camel"
 	"github.com/apache/camel-k/pkg/util/digest"
 	"github.com/apache/camel-k/pkg/util/flow"
 	"github.com/apache/camel-k/pkg/util/kubernetes"
+	"github.com/magiconair/properties"
+	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 // The kamelets trait is a platform trait used to inject Kamelets into the integration runtime.
@@ -47,16 +55,23 @@ type kameletsTrait struct {
 	Auto *bool `property:"auto"`
 	// Comma separated list of Kamelet names to load into the current integration
 	List string `property:"list"`
+	// List of Secrets holding the OAuth 2.0 credentials of Kamelets, in the form `<kamelet>:<secret>`.
+	// When not set for a Kamelet, the only Secret labeled with `camel.apache.org/kamelet.authorization=<kamelet>` is used.
+	AuthorizationSecrets []string `property:"authorization-secrets"`
+
+	authorizationDigests map[string]string
 }
 
 type configurationKey struct {
 	kamelet         string
+	version         string
 	configurationID string
 }
 
-func newConfigurationKey(kamelet, configurationID string) configurationKey {
+func newConfigurationKey(kamelet, version, configurationID string) configurationKey {
 	return configurationKey{
 		kamelet:         kamelet,
+		version:         version,
 		configurationID: configurationID,
 	}
 }
@@ -67,10 +82,21 @@ const (
 
 	kameletLabel              = "camel.apache.org/kamelet"
 	kameletConfigurationLabel = "camel.apache.org/kamelet.configuration"
+	kameletAuthorizationLabel = "camel.apache.org/kamelet.authorization"
+
+	kameletAuthorizationDigestAnnotation = "camel.apache.org/kamelet.authorization.digest"
+	kameletAuthorizationExpiryAnnotation = "camel.apache.org/kamelet.authorization.expiry"
+
+	authorizationKey = "authorization.properties"
+	// sensitive default values of Kamelet properties are stored in a secret under this key
+	sensitivePropertiesKey = "kamelet.properties"
+	// access tokens are refreshed when they are about to expire within this margin
+	authorizationRefreshMargin = 5 * time.Minute
 )
 
 var (
-	kameletNameRegexp = regexp.MustCompile("kamelet:(?://)?([a-z0-9-.]+(/[a-z0-9-.]+)?)(?:$|[^a-z0-9-.].*)")
+	kameletNameRegexp    = regexp.MustCompile("kamelet:(?://)?([a-z0-9-.]+(@[0-9A-Za-z.^~*-]+)?(/[a-z0-9-.]+)?)(?:$|[^a-z0-9-.].*)")
+	kameletVersionRegexp = regexp.MustCompile("^[0-9A-Za-z.^~*-]+$")
 )
 
 func newKameletsTrait() Trait {
@@ -95,23 +121,21 @@ func (t *kameletsTrait) Configure(e *Environment) (bool, error) {
 
 	if t.Auto == nil || *t.Auto {
 		if t.List == "" {
-			var kamelets []string
 			sources, err := kubernetes.ResolveIntegrationSources(e.C, e.Client, e.Integration, e.Resources)
 			if err != nil {
 				return false, err
 			}
-			metadata.Each(e.CamelCatalog, sources, func(_ int, meta metadata.IntegrationMetadata) bool {
-				util.StringSliceUniqueConcat(&kamelets, extractKamelets(meta.FromURIs))
-				util.StringSliceUniqueConcat(&kamelets, extractKamelets(meta.ToURIs))
-				return true
-			})
-			sort.Strings(kamelets)
-			t.List = strings.Join(kamelets, ",")
+			t.List = strings.Join(kameletsFromSources(e.CamelCatalog, sources), ",")
 		}
 
 	}
 
-	return len(t.getKameletKeys()) > 0, nil
+	if len(t.getKameletKeys()) > 0 {
+		return true, nil
+	}
+	// The deprecation warning of Kamelets that are not used anymore must be cleared
+	deprecated := e.Integration.Status.GetCondition(v1.IntegrationConditionKameletsDeprecated)
+	return deprecated != nil && e.IntegrationInPhase(v1.IntegrationPhaseInitialization), nil
 }
 
 func (t *kameletsTrait) Apply(e *Environment) error {
@@ -130,6 +154,7 @@ func (t *kameletsTrait) Apply(e *Environment) error {
 }
 
 func (t *kameletsTrait) addKamelets(e *Environment) error {
+	deprecations := make([]string, 0)
 	kameletKeys := t.getKameletKeys()
 	if len(kameletKeys) > 0 {
 		repo, err := repository.NewForPlatform(e.C, e.Client, e.Platform, e.Integration.Namespace, platform.GetOperatorNamespace())
@@ -137,12 +162,12 @@ func (t *kameletsTrait) addKamelets(e *Environment) error {
 			return err
 		}
 		for _, k := range t.getKameletKeys() {
-			kamelet, err := repo.Get(e.C, k)
+			kamelet, err := getKamelet(e, repo, k)
 			if err != nil {
 				return err
 			}
-			if kamelet == nil {
-				return fmt.Errorf("kamelet %s not found in any of the defined repositories: %s", k, repo.String())
+			if kamelet.IsDeprecated() {
+				deprecations = append(deprecations, kamelet.DeprecationMessage())
 			}
 
 			// Initialize remote kamelets
@@ -155,16 +180,44 @@ func (t *kameletsTrait) addKamelets(e *Environment) error {
 				return fmt.Errorf("kamelet %q is not %s: %s", k, v1alpha1.KameletPhaseReady, kamelet.Status.Phase)
 			}
 
-			if err := t.addKameletAsSource(e, kamelet); err != nil {
+			if err := t.addKameletAsSource(e, kamelet, k); err != nil {
 				return err
 			}
 
+			// Mounting the secret holding the sensitive default values, that is populated when deploying
+			if hasSensitiveDefaults(kamelet) {
+				e.Integration.Status.AddConfigurationsIfMissing(v1.ConfigurationSpec{
+					Type:  "secret",
+					Value: sensitivePropertiesSecretName(e.Integration.Name, k),
+				})
+			}
+
+			// Mounting the secret holding the authorization properties, that is populated when deploying
+			if hasOAuth2(kamelet) {
+				e.Integration.Status.AddConfigurationsIfMissing(v1.ConfigurationSpec{
+					Type:  "secret",
+					Value: authorizationSecretName(e.Integration.Name, k),
+				})
+			}
+
 			// Adding dependencies from Kamelets
 			util.StringSliceUniqueConcat(&e.Integration.Status.Dependencies, kamelet.Spec.Dependencies)
 		}
 		// resort dependencies
 		sort.Strings(e.Integration.Status.Dependencies)
 	}
+
+	// Warn users about deprecated Kamelets, a Warning event is emitted when the condition changes
+	if len(deprecations) > 0 {
+		e.Integration.Status.SetCondition(
+			v1.IntegrationConditionKameletsDeprecated,
+			corev1.ConditionTrue,
+			v1.IntegrationConditionKameletsDeprecatedReason,
+			strings.Join(deprecations, "; "),
+		)
+	} else {
+		e.Integration.Status.RemoveCondition(v1.IntegrationConditionKameletsDeprecated)
+	}
 	return nil
 }
 
@@ -175,13 +228,10 @@ func (t *kameletsTrait) configureApplicationProperties(e *Environment) error {
 			return err
 		}
 		for _, k := range t.getKameletKeys() {
-			kamelet, err := repo.Get(e.C, k)
+			kamelet, err := getKamelet(e, repo, k)
 			if err != nil {
 				return err
 			}
-			if kamelet == nil {
-				return fmt.Errorf("kamelet %s not found in any of the defined repositories: %s", k, repo.String())
-			}
 
 			// remote Kamelets may not be fully initialized
 			kamelet, err = kameletutils.Initialize(kamelet)
@@ -190,11 +240,12 @@ func (t *kameletsTrait) configureApplicationProperties(e *Environment) error {
 			}
 
 			// Configuring defaults from Kamelet
+			sensitiveDefaults := make(map[string]string)
 			for _, prop := range kamelet.Status.Properties {
 				if prop.Default != "" {
 					// Check whether user specified a value
 					userDefined := false
-					propName := fmt.Sprintf("camel.kamelet.%s.%s", kamelet.Name, prop.Name)
+					propName := fmt.Sprintf("camel.kamelet.%s.%s", k, prop.Name)
 					propPrefix := propName + "="
 					for _, userProp := range e.Integration.Spec.Configuration {
 						if strings.HasPrefix(userProp.Value, propPrefix) {
@@ -202,49 +253,410 @@ func (t *kameletsTrait) configureApplicationProperties(e *Environment) error {
 							break
 						}
 					}
-					if !userDefined {
+					if userDefined {
+						continue
+					}
+					// Sensitive values are never stored in clear text in the application properties
+					if kamelet.IsSensitiveProperty(prop.Name) {
+						sensitiveDefaults[propName] = prop.Default
+					} else {
 						e.ApplicationProperties[propName] = prop.Default
 					}
 				}
 			}
+			if hasSensitiveDefaults(kamelet) {
+				if err := t.configureSensitiveDefaults(e, kamelet, k, sensitiveDefaults); err != nil {
+					return err
+				}
+			}
+
+			if hasOAuth2(kamelet) {
+				if err := t.configureAuthorization(e, kamelet, k); err != nil {
+					return err
+				}
+			}
 		}
 	}
 	return nil
 }
 
-func (t *kameletsTrait) addKameletAsSource(e *Environment, kamelet *v1alpha1.Kamelet) error {
-	sources := make([]v1.SourceSpec, 0)
+// configureSensitiveDefaults stores the default values of the sensitive properties of the Kamelet into a Secret owned by the integration
+func (t *kameletsTrait) configureSensitiveDefaults(e *Environment, kamelet *v1alpha1.Kamelet, key string, defaults map[string]string) error {
+	keys := make([]string, 0, len(defaults))
+	for k := range defaults {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	content := properties.NewProperties()
+	for _, k := range keys {
+		if _, _, err := content.Set(k, defaults[k]); err != nil {
+			return err
+		}
+	}
 
-	if kamelet.Spec.Flow != nil {
+	e.Resources.Add(&corev1.Secret{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Secret",
+			APIVersion: "v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      sensitivePropertiesSecretName(e.Integration.Name, key),
+			Namespace: e.Integration.Namespace,
+			Labels: map[string]string{
+				v1.IntegrationLabel: e.Integration.Name,
+				kameletLabel:        kamelet.Name,
+			},
+		},
+		Type: corev1.SecretTypeOpaque,
+		Data: map[string][]byte{
+			sensitivePropertiesKey: []byte(content.String()),
+		},
+	})
+	return nil
+}
 
-		flowData, err := flow.ToYamlDSL([]v1.Flow{*kamelet.Spec.Flow})
-		if err != nil {
-			return err
+// configureAuthorization obtains the OAuth 2.0 tokens required by the Kamelet, using the credentials stored in the Secret
+// labeled with the Kamelet name, and stores them, along with the credentials, into a Secret owned by the integration.
+// Access tokens are refreshed before they expire, and integration pods are restarted to pick up the new tokens.
+func (t *kameletsTrait) configureAuthorization(e *Environment, kamelet *v1alpha1.Kamelet, key string) error {
+	spec := kamelet.Spec.Authorization.OAuth2
+
+	credentialsSecret, err := t.authorizationSecret(e, kamelet)
+	if err != nil {
+		return err
+	}
+	credentials := kameletutils.OAuth2CredentialsFromSecret(credentialsSecret)
+	credentialsDigest := credentials.Digest(spec)
+
+	name := authorizationSecretName(e.Integration.Name, key)
+
+	prefix := fmt.Sprintf("camel.kamelet.%s.", key)
+	props := make(map[string]string)
+	if spec.Properties.ClientID != "" {
+		props[prefix+spec.Properties.ClientID] = credentials.ClientID
+	}
+	if spec.Properties.ClientSecret != "" {
+		props[prefix+spec.Properties.ClientSecret] = credentials.ClientSecret
+	}
+	if spec.Properties.RefreshToken != "" {
+		props[prefix+spec.Properties.RefreshToken] = credentials.RefreshToken
+	}
+
+	expiry := ""
+	if spec.Properties.AccessToken != "" {
+		accessTokenProperty := prefix + spec.Properties.AccessToken
+		cacheKey := strings.Join([]string{e.Integration.Namespace, e.Integration.Name, key, credentialsDigest}, "/")
+		accessToken, tokenExpiry, ok := accessTokens.get(cacheKey)
+		if !ok {
+			existing := corev1.Secret{}
+			err = t.Client.Get(e.C, k8sclient.ObjectKey{Namespace: e.Integration.Namespace, Name: name}, &existing)
+			if err != nil && !k8serrors.IsNotFound(err) {
+				return err
+			}
+			accessToken, tokenExpiry, ok = reusableAccessToken(&existing, accessTokenProperty, credentialsDigest)
 		}
+		if !ok {
+			token, err := kameletutils.OAuth2Token(e.C, spec, credentials)
+			if err != nil {
+				return errors.Wrapf(err, "cannot obtain OAuth 2.0 access token for kamelet %q", kamelet.Name)
+			}
+			accessToken = token.AccessToken
+			tokenExpiry = ""
+			if !token.Expiry.IsZero() {
+				tokenExpiry = token.Expiry.UTC().Format(time.RFC3339)
+			}
+		}
+		accessTokens.put(cacheKey, accessToken, tokenExpiry)
+		props[accessTokenProperty] = accessToken
+		expiry = tokenExpiry
+	}
 
-		propertyNames := make([]string, 0, len(kamelet.Status.Properties))
-		for _, p := range kamelet.Status.Properties {
-			propertyNames = append(propertyNames, p.Name)
+	keys := make([]string, 0, len(props))
+	for k := range props {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	content := properties.NewProperties()
+	for _, k := range keys {
+		if _, _, err := content.Set(k, props[k]); err != nil {
+			return err
 		}
+	}
+	data := content.String()
 
-		flowSource := v1.SourceSpec{
-			DataSpec: v1.DataSpec{
-				Name:    fmt.Sprintf("%s.yaml", kamelet.Name),
-				Content: string(flowData),
+	secret := corev1.Secret{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Secret",
+			APIVersion: "v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: e.Integration.Namespace,
+			Labels: map[string]string{
+				v1.IntegrationLabel: e.Integration.Name,
+				kameletLabel:        kamelet.Name,
 			},
-			Language:      v1.LanguageYaml,
-			Type:          v1.SourceTypeTemplate,
-			PropertyNames: propertyNames,
+			Annotations: map[string]string{
+				kameletAuthorizationDigestAnnotation: credentialsDigest,
+			},
+		},
+		Type: corev1.SecretTypeOpaque,
+		Data: map[string][]byte{
+			authorizationKey: []byte(data),
+		},
+	}
+	if expiry != "" {
+		secret.Annotations[kameletAuthorizationExpiryAnnotation] = expiry
+	}
+	e.Resources.Add(&secret)
+
+	message := fmt.Sprintf("authorization configured for kamelet %q", kamelet.Name)
+	if expiry != "" {
+		message = fmt.Sprintf("access token for kamelet %q valid until %s", kamelet.Name, expiry)
+	}
+	e.Integration.Status.SetCondition(
+		v1.IntegrationConditionKameletsAuthorizationAvailable,
+		corev1.ConditionTrue,
+		v1.IntegrationConditionKameletsAuthorizationAvailableReason,
+		message,
+	)
+
+	// Roll out the integration pods when tokens change, as properties are only read on startup
+	if t.authorizationDigests == nil {
+		t.authorizationDigests = make(map[string]string)
+		e.PostProcessors = append(e.PostProcessors, t.annotateAuthorizationDigest)
+	}
+	t.authorizationDigests[key] = fmt.Sprintf("%x", sha256.Sum256([]byte(data)))
+
+	return nil
+}
+
+func (t *kameletsTrait) annotateAuthorizationDigest(e *Environment) error {
+	kamelets := make([]string, 0, len(t.authorizationDigests))
+	for k := range t.authorizationDigests {
+		kamelets = append(kamelets, k)
+	}
+	sort.Strings(kamelets)
+	h := sha256.New()
+	for _, k := range kamelets {
+		h.Write([]byte(k + "=" + t.authorizationDigests[k] + "\n"))
+	}
+	digest := fmt.Sprintf("%x", h.Sum(nil))
+
+	e.Resources.VisitPodTemplateMeta(func(meta *metav1.ObjectMeta) {
+		if meta.Annotations == nil {
+			meta.Annotations = make(map[string]string)
 		}
-		flowSource, err = integrationSourceFromKameletSource(e, kamelet, flowSource, fmt.Sprintf("%s-kamelet-%s-flow", e.Integration.Name, kamelet.Name))
-		if err != nil {
-			return err
+		meta.Annotations[kameletAuthorizationDigestAnnotation] = digest
+	})
+	return nil
+}
+
+// authorizationSecret returns the Secret holding the OAuth 2.0 credentials of the Kamelet, either referenced
+// by the authorization-secrets property, or the only one labeled with the Kamelet name
+func (t *kameletsTrait) authorizationSecret(e *Environment, kamelet *v1alpha1.Kamelet) (*corev1.Secret, error) {
+	for _, ref := range t.AuthorizationSecrets {
+		parts := strings.SplitN(ref, ":", 2)
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return nil, fmt.Errorf("invalid authorization secret %q: expected format is <kamelet>:<secret>", ref)
+		}
+		if parts[0] != kamelet.Name {
+			continue
+		}
+		secret := corev1.Secret{}
+		if err := t.Client.Get(e.C, k8sclient.ObjectKey{Namespace: e.Integration.Namespace, Name: parts[1]}, &secret); err != nil {
+			return nil, errors.Wrapf(err, "cannot read the OAuth 2.0 credentials of kamelet %q", kamelet.Name)
+		}
+		return &secret, nil
+	}
+
+	secrets, err := t.Client.CoreV1().Secrets(e.Integration.Namespace).List(e.C, metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("%s=%s", kameletAuthorizationLabel, kamelet.Name),
+	})
+	if err != nil {
+		return nil, err
+	}
+	switch len(secrets.Items) {
+	case 0:
+		return nil, fmt.Errorf("kamelet %q requires OAuth 2.0 credentials: no secret labeled %s=%s found", kamelet.Name, kameletAuthorizationLabel, kamelet.Name)
+	case 1:
+		return &secrets.Items[0], nil
+	default:
+		names := make([]string, 0, len(secrets.Items))
+		for _, secret := range secrets.Items {
+			names = append(names, secret.Name)
 		}
-		sources = append(sources, flowSource)
+		sort.Strings(names)
+		return nil, fmt.Errorf("kamelet %q requires OAuth 2.0 credentials: several secrets labeled %s=%s found (%s), "+
+			"select one with the kamelets.authorization-secrets trait property", kamelet.Name, kameletAuthorizationLabel, kamelet.Name, strings.Join(names, ", "))
 	}
+}
+
+// maxCachedAccessTokens bounds the number of access tokens kept in memory
+const maxCachedAccessTokens = 1000
+
+// accessTokens caches the access tokens obtained for the integrations, until they are about to expire
+var accessTokens = accessTokenCache{
+	tokens: make(map[string]cachedAccessToken),
+}
+
+type cachedAccessToken struct {
+	value  string
+	expiry string
+}
+
+type accessTokenCache struct {
+	lock   sync.Mutex
+	tokens map[string]cachedAccessToken
+}
+
+func (c *accessTokenCache) get(key string) (string, string, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	token, ok := c.tokens[key]
+	if !ok {
+		return "", "", false
+	}
+	if isExpiring(token.expiry) {
+		delete(c.tokens, key)
+		return "", "", false
+	}
+	return token.value, token.expiry, true
+}
+
+func (c *accessTokenCache) put(key string, value string, expiry string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	for k, token := range c.tokens {
+		if isExpiring(token.expiry) {
+			delete(c.tokens, k)
+		}
+	}
+	if len(c.tokens) >= maxCachedAccessTokens {
+		c.tokens = make(map[string]cachedAccessToken)
+	}
+	c.tokens[key] = cachedAccessToken{
+		value:  value,
+		expiry: expiry,
+	}
+}
+
+// isExpiring returns true when the access token with the given expiry must be refreshed
+func isExpiring(expiry string) bool {
+	if expiry == "" {
+		return false
+	}
+	expiryTime, err := time.Parse(time.RFC3339, expiry)
+	return err != nil || time.Now().Add(authorizationRefreshMargin).After(expiryTime)
+}
+
+// reusableAccessToken returns the access token stored in the existing authorization secret, unless the credentials
+// have changed or the token is about to expire
+func reusableAccessToken(existing *corev1.Secret, property string, credentialsDigest string) (string, string, bool) {
+	if existing.Annotations[kameletAuthorizationDigestAnnotation] != credentialsDigest {
+		return "", "", false
+	}
+	expiry := existing.Annotations[kameletAuthorizationExpiryAnnotation]
+	if isExpiring(expiry) {
+		return "", "", false
+	}
+	content, err := properties.Load(existing.Data[authorizationKey], properties.UTF8)
+	if err != nil {
+		return "", "", false
+	}
+	accessToken, ok := content.Get(property)
+	if !ok || accessToken == "" {
+		return "", "", false
+	}
+	return accessToken, expiry, true
+}
+
+func hasOAuth2(kamelet *v1alpha1.Kamelet) bool {
+	return kamelet.Spec.Authorization != nil && kamelet.Spec.Authorization.OAuth2 != nil
+}
+
+// hasSensitiveDefaults returns true when the Kamelet provides default values for sensitive properties
+func hasSensitiveDefaults(kamelet *v1alpha1.Kamelet) bool {
+	if kamelet.Spec.Definition == nil {
+		return false
+	}
+	for _, prop := range kamelet.Spec.Definition.Properties {
+		if prop.IsSensitive() && prop.Default != nil {
+			return true
+		}
+	}
+	return false
+}
+
+func sensitivePropertiesSecretName(integration string, key string) string {
+	return fmt.Sprintf("%s-kamelet-%s-properties", integration, kameletResourceName(key))
+}
 
-	for idx, s := range kamelet.Spec.Sources {
-		intSource, err := integrationSourceFromKameletSource(e, kamelet, s, fmt.Sprintf("%s-kamelet-%s-%03d", e.Integration.Name, kamelet.Name, idx))
+func authorizationSecretName(integration string, key string) string {
+	return fmt.Sprintf("%s-kamelet-%s-authorization", integration, kameletResourceName(key))
+}
+
+// getKamelet looks up the Kamelet, in the version requested by the given key, if any
+func getKamelet(e *Environment, repo repository.KameletRepository, key string) (*v1alpha1.Kamelet, error) {
+	name, version := v1alpha1.ParseKameletReference(key)
+	kamelet, err := repo.GetVersion(e.C, name, version)
+	if err != nil {
+		return nil, err
+	}
+	if kamelet == nil {
+		if version != "" {
+			return nil, fmt.Errorf("kamelet %s with version %s not found in any of the defined repositories: %s", name, version, repo.String())
+		}
+		return nil, fmt.Errorf("kamelet %s not found in any of the defined repositories: %s", name, repo.String())
+	}
+	if err := checkVersionedReference(kamelet, key); err != nil {
+		return nil, err
+	}
+	return kamelet, nil
+}
+
+// checkVersionedReference checks that the key references the version the Kamelet has been resolved to, as the key identifies
+// the Kamelet template and properties at runtime, where version ranges are not resolved
+func checkVersionedReference(kamelet *v1alpha1.Kamelet, key string) error {
+	if _, version := v1alpha1.ParseKameletReference(key); version == "" {
+		return nil
+	}
+	if ref := kamelet.GetVersionedReference(); ref != key {
+		return fmt.Errorf("kamelet %s must be referenced with an exact version, e.g. kamelet:%s: version ranges are only supported by KameletBindings", key, ref)
+	}
+	return nil
+}
+
+// kameletResourceName returns a name suitable for Kubernetes resources generated for the Kamelet key,
+// that replaces the requested version, if any, with a short digest of it
+func kameletResourceName(key string) string {
+	name, version := v1alpha1.ParseKameletReference(key)
+	if version == "" {
+		return name
+	}
+	return fmt.Sprintf("%s-%x", name, sha256.Sum256([]byte(version)))[:len(name)+9]
+}
+
+func (t *kameletsTrait) addKameletAsSource(e *Environment, kamelet *v1alpha1.Kamelet, key string) error {
+	kameletSources, err := KameletSources(kamelet, key)
+	if err != nil {
+		return err
+	}
+
+	// the source generated from the flow, if any, comes first
+	flowSources := 0
+	if kamelet.Spec.Flow != nil {
+		flowSources = 1
+	}
+	sources := make([]v1.SourceSpec, 0, len(kameletSources))
+	for idx, s := range kameletSources {
+		name := fmt.Sprintf("%s-kamelet-%s-flow", e.Integration.Name, kameletResourceName(key))
+		if idx >= flowSources {
+			name = fmt.Sprintf("%s-kamelet-%s-%03d", e.Integration.Name, kameletResourceName(key), idx-flowSources)
+		}
+		intSource, err := integrationSourceFromKameletSource(e, kamelet, s, name)
 		if err != nil {
 			return err
 		}
@@ -269,7 +681,7 @@ func (t *kameletsTrait) addKameletAsSource(e *Environment, kamelet *v1alpha1.Kam
 	}
 
 	if kameletCounter > 1 {
-		return fmt.Errorf(`kamelet %s contains %d sources of type "kamelet": at most one is allowed`, kamelet.Name, kameletCounter)
+		return fmt.Errorf(`kamelet %s contains %d sources of type "kamelet": at most one is allowed`, key, kameletCounter)
 	}
 
 	return nil
@@ -277,19 +689,23 @@ func (t *kameletsTrait) addKameletAsSource(e *Environment, kamelet *v1alpha1.Kam
 
 func (t *kameletsTrait) addConfigurationSecrets(e *Environment) error {
 	for _, k := range t.getConfigurationKeys() {
-		var options = metav1.ListOptions{
-			LabelSelector: fmt.Sprintf("%s=%s", kameletLabel, k.kamelet),
+		selector := fmt.Sprintf("%s=%s", kameletLabel, k.kamelet)
+		if k.version != "" {
+			selector = fmt.Sprintf("%s,%s=%s", selector, v1alpha1.KameletVersionLabel, k.version)
 		}
 		if k.configurationID != "" {
-			options.LabelSelector = fmt.Sprintf("%s=%s,%s=%s", kameletLabel, k.kamelet, kameletConfigurationLabel, k.configurationID)
+			selector = fmt.Sprintf("%s,%s=%s", selector, kameletConfigurationLabel, k.configurationID)
 		}
-		secrets, err := t.Client.CoreV1().Secrets(e.Integration.Namespace).List(e.C, options)
+		secrets, err := t.Client.CoreV1().Secrets(e.Integration.Namespace).List(e.C, metav1.ListOptions{
+			LabelSelector: selector,
+		})
 		if err != nil {
 			return err
 		}
 
 		for _, item := range secrets.Items {
-			if item.Labels != nil && item.Labels[kameletConfigurationLabel] != k.configurationID {
+			// configurations of the versioned Kamelet, or of a specific route, are only used for the matching key
+			if item.Labels != nil && (item.Labels[kameletConfigurationLabel] != k.configurationID || item.Labels[v1alpha1.KameletVersionLabel] != k.version) {
 				continue
 			}
 
@@ -309,7 +725,11 @@ func (t *kameletsTrait) getKameletKeys() []string {
 		if strings.Contains(i, "/") {
 			i = strings.SplitN(i, "/", 2)[0]
 		}
-		if i != "" && v1alpha1.ValidKameletName(i) {
+		name, version := v1alpha1.ParseKameletReference(i)
+		if version != "" && !kameletVersionRegexp.MatchString(version) {
+			continue
+		}
+		if name != "" && v1alpha1.ValidKameletName(name) {
 			util.StringSliceUniqueAdd(&answer, i)
 		}
 	}
@@ -320,21 +740,19 @@ func (t *kameletsTrait) getKameletKeys() []string {
 func (t *kameletsTrait) getConfigurationKeys() []configurationKey {
 	answer := make([]configurationKey, 0)
 	for _, item := range t.getKameletKeys() {
-		answer = append(answer, newConfigurationKey(item, ""))
+		name, version := v1alpha1.ParseKameletReference(item)
+		newKey := newConfigurationKey(name, version, "")
+		if !containsConfigurationKey(answer, newKey) {
+			answer = append(answer, newKey)
+		}
 	}
 	for _, item := range strings.Split(t.List, ",") {
 		i := strings.Trim(item, " \t\"")
 		if strings.Contains(i, "/") {
 			parts := strings.SplitN(i, "/", 2)
-			newKey := newConfigurationKey(parts[0], parts[1])
-			alreadyPresent := false
-			for _, existing := range answer {
-				if existing == newKey {
-					alreadyPresent = true
-					break
-				}
-			}
-			if !alreadyPresent {
+			name, version := v1alpha1.ParseKameletReference(parts[0])
+			newKey := newConfigurationKey(name, version, parts[1])
+			if !containsConfigurationKey(answer, newKey) {
 				answer = append(answer, newKey)
 			}
 		}
@@ -342,18 +760,85 @@ func (t *kameletsTrait) getConfigurationKeys() []configurationKey {
 	sort.Slice(answer, func(i, j int) bool {
 		o1 := answer[i]
 		o2 := answer[j]
-		return o1.kamelet < o2.kamelet || (o1.kamelet == o2.kamelet && o1.configurationID < o2.configurationID)
+		if o1.kamelet != o2.kamelet {
+			return o1.kamelet < o2.kamelet
+		}
+		if o1.version != o2.version {
+			return o1.version < o2.version
+		}
+		return o1.configurationID < o2.configurationID
 	})
 	return answer
 }
 
-func integrationSourceFromKameletSource(e *Environment, kamelet *v1alpha1.Kamelet, source v1.SourceSpec, name string) (v1.SourceSpec, error) {
-	if source.Type == v1.SourceTypeTemplate {
-		// Kamelets must be named "<kamelet-name>.extension"
-		language := source.InferLanguage()
-		source.Name = fmt.Sprintf("%s.%s", kamelet.Name, string(language))
+func containsConfigurationKey(keys []configurationKey, key configurationKey) bool {
+	for _, existing := range keys {
+		if existing == key {
+			return true
+		}
 	}
+	return false
+}
+
+// KameletSources returns the sources materializing the Kamelet identified by the given key, i.e. the route template
+// generated from its flow, if any, followed by its additional sources
+func KameletSources(kamelet *v1alpha1.Kamelet, key string) ([]v1.SourceSpec, error) {
+	sources := make([]v1.SourceSpec, 0, len(kamelet.Spec.Sources)+1)
 
+	if kamelet.Spec.Flow != nil {
+		flowData, err := flow.ToYamlDSL([]v1.Flow{*kamelet.Spec.Flow})
+		if err != nil {
+			return nil, err
+		}
+
+		propertyNames := make([]string, 0, len(kamelet.Status.Properties))
+		for _, p := range kamelet.Status.Properties {
+			propertyNames = append(propertyNames, p.Name)
+		}
+
+		sources = append(sources, v1.SourceSpec{
+			DataSpec: v1.DataSpec{
+				Name:    fmt.Sprintf("%s.yaml", key),
+				Content: string(flowData),
+			},
+			Language:      v1.LanguageYaml,
+			Type:          v1.SourceTypeTemplate,
+			PropertyNames: propertyNames,
+		})
+	}
+
+	for _, source := range kamelet.Spec.Sources {
+		if source.Type == v1.SourceTypeTemplate {
+			// Kamelets must be named "<kamelet-name>.extension", or "<kamelet-name>@<version>.extension" when a version is requested
+			language := source.InferLanguage()
+			source.Name = fmt.Sprintf("%s.%s", key, string(language))
+		}
+		sources = append(sources, source)
+	}
+
+	return sources, nil
+}
+
+// KameletKeys returns the keys of the Kamelets referenced by the given sources, i.e. the Kamelet names along with the requested versions, if any
+func KameletKeys(catalog *camel.RuntimeCatalog, sources []v1.SourceSpec) []string {
+	t := kameletsTrait{
+		List: strings.Join(kameletsFromSources(catalog, sources), ","),
+	}
+	return t.getKameletKeys()
+}
+
+func kameletsFromSources(catalog *camel.RuntimeCatalog, sources []v1.SourceSpec) []string {
+	var kamelets []string
+	metadata.Each(catalog, sources, func(_ int, meta metadata.IntegrationMetadata) bool {
+		util.StringSliceUniqueConcat(&kamelets, extractKamelets(meta.FromURIs))
+		util.StringSliceUniqueConcat(&kamelets, extractKamelets(meta.ToURIs))
+		return true
+	})
+	sort.Strings(kamelets)
+	return kamelets
+}
+
+func integrationSourceFromKameletSource(e *Environment, kamelet *v1alpha1.Kamelet, source v1.SourceSpec, name string) (v1.SourceSpec, error) {
 	if source.DataSpec.ContentRef != "" {
 		return source, nil
 	}
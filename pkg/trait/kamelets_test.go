@@ -20,6 +20,8 @@ package trait
 import (
 	"context"
 	"encoding/json"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
@@ -28,6 +30,7 @@ import (
 	"github.com/apache/camel-k/pkg/util/kubernetes"
 	"github.com/apache/camel-k/pkg/util/test"
 	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -65,14 +68,14 @@ func TestConfigurationWithKamelets(t *testing.T) {
 	assert.True(t, enabled)
 	assert.Equal(t, []string{"c0", "c1", "c2", "complex-.-.-1a", "complex-.-.-1b", "complex-.-.-1c"}, trait.getKameletKeys())
 	assert.Equal(t, []configurationKey{
-		newConfigurationKey("c0", ""),
-		newConfigurationKey("c1", ""),
-		newConfigurationKey("c2", ""),
-		newConfigurationKey("complex-.-.-1a", ""),
-		newConfigurationKey("complex-.-.-1b", ""),
-		newConfigurationKey("complex-.-.-1b", "a"),
-		newConfigurationKey("complex-.-.-1c", ""),
-		newConfigurationKey("complex-.-.-1c", "b"),
+		newConfigurationKey("c0", "", ""),
+		newConfigurationKey("c1", "", ""),
+		newConfigurationKey("c2", "", ""),
+		newConfigurationKey("complex-.-.-1a", "", ""),
+		newConfigurationKey("complex-.-.-1b", "", ""),
+		newConfigurationKey("complex-.-.-1b", "", "a"),
+		newConfigurationKey("complex-.-.-1c", "", ""),
+		newConfigurationKey("complex-.-.-1c", "", "b"),
 	}, trait.getConfigurationKeys())
 }
 
@@ -120,6 +123,209 @@ func TestKameletLookup(t *testing.T) {
 	assert.Equal(t, []string{"camel:log", "camel:timer"}, environment.Integration.Status.Dependencies)
 }
 
+func versionedTimerKamelet() *v1alpha1.Kamelet {
+	return &v1alpha1.Kamelet{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test",
+			Name:      "timer",
+			Labels: map[string]string{
+				v1alpha1.KameletVersionLabel: "2.0.0",
+			},
+		},
+		Spec: v1alpha1.KameletSpec{
+			Flow: marshalOrFail(map[string]interface{}{
+				"from": map[string]interface{}{
+					"uri": "timer:tick",
+				},
+			}),
+			Dependencies: []string{
+				"camel:timer",
+			},
+			Versions: []v1alpha1.KameletVersionSpec{
+				{
+					Version: "1.1.0",
+					Definition: &v1alpha1.JSONSchemaProps{
+						Properties: map[string]v1alpha1.JSONSchemaProp{
+							"period": {
+								Type:    "integer",
+								Default: &v1alpha1.JSON{RawMessage: []byte("1000")},
+							},
+						},
+					},
+					Flow: marshalOrFail(map[string]interface{}{
+						"from": map[string]interface{}{
+							"uri": "timer:tick?period={{period}}",
+						},
+					}),
+					Dependencies: []string{
+						"camel:timer",
+						"camel:log",
+					},
+				},
+			},
+		},
+		Status: v1alpha1.KameletStatus{Phase: v1alpha1.KameletPhaseReady},
+	}
+}
+
+func TestKameletVersionLookup(t *testing.T) {
+	trait, environment := createKameletsTestEnvironment(`
+- from:
+    uri: kamelet:timer@1.1.0
+    steps:
+    - to: log:info
+`, versionedTimerKamelet(), &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test",
+			Name:      "timer-config",
+			Labels: map[string]string{
+				"camel.apache.org/kamelet": "timer",
+			},
+		},
+	}, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test",
+			Name:      "timer-1.1.0-config",
+			Labels: map[string]string{
+				"camel.apache.org/kamelet":         "timer",
+				"camel.apache.org/kamelet.version": "1.1.0",
+			},
+		},
+	})
+	enabled, err := trait.Configure(environment)
+	assert.NoError(t, err)
+	assert.True(t, enabled)
+	assert.Equal(t, []string{"timer@1.1.0"}, trait.getKameletKeys())
+	assert.Equal(t, []configurationKey{newConfigurationKey("timer", "1.1.0", "")}, trait.getConfigurationKeys())
+
+	err = trait.Apply(environment)
+	assert.NoError(t, err)
+	cm := environment.Resources.GetConfigMap(func(_ *corev1.ConfigMap) bool { return true })
+	assert.NotNil(t, cm)
+	assert.Regexp(t, "^it-kamelet-timer-[0-9a-f]{8}-flow$", cm.Name)
+	assert.Contains(t, cm.Data[contentKey], "period={{period}}")
+
+	assert.Len(t, environment.Integration.Status.GeneratedSources, 1)
+	source := environment.Integration.Status.GeneratedSources[0]
+	assert.Equal(t, "timer@1.1.0.yaml", source.Name)
+	assert.Equal(t, []string{"period"}, source.PropertyNames)
+
+	assert.Equal(t, []string{"camel:log", "camel:timer"}, environment.Integration.Status.Dependencies)
+
+	// only the configuration of the requested version is used, as it's keyed the same way as the template
+	assert.Contains(t, environment.Integration.Status.Configuration, v1.ConfigurationSpec{Type: "secret", Value: "timer-1.1.0-config"})
+	assert.NotContains(t, environment.Integration.Status.Configuration, v1.ConfigurationSpec{Type: "secret", Value: "timer-config"})
+
+	environment.Integration.Status.Phase = v1.IntegrationPhaseDeploying
+	environment.ApplicationProperties = make(map[string]string)
+	err = trait.Apply(environment)
+	assert.NoError(t, err)
+	assert.Equal(t, "1000", environment.ApplicationProperties["camel.kamelet.timer@1.1.0.period"])
+}
+
+func TestKameletVersionRange(t *testing.T) {
+	trait, environment := createKameletsTestEnvironment(`
+- from:
+    uri: kamelet:timer@^1.0
+    steps:
+    - to: log:info
+`, versionedTimerKamelet())
+	enabled, err := trait.Configure(environment)
+	assert.NoError(t, err)
+	assert.True(t, enabled)
+
+	err = trait.Apply(environment)
+	assert.EqualError(t, err, "kamelet timer@^1.0 must be referenced with an exact version, e.g. kamelet:timer@1.1.0: "+
+		"version ranges are only supported by KameletBindings")
+}
+
+func TestKameletVersionNotFound(t *testing.T) {
+	trait, environment := createKameletsTestEnvironment(`
+- from:
+    uri: kamelet:timer@3.x
+    steps:
+    - to: log:info
+`, &v1alpha1.Kamelet{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test",
+			Name:      "timer",
+			Labels: map[string]string{
+				v1alpha1.KameletVersionLabel: "2.0.0",
+			},
+		},
+		Spec: v1alpha1.KameletSpec{
+			Flow: marshalOrFail(map[string]interface{}{
+				"from": map[string]interface{}{
+					"uri": "timer:tick",
+				},
+			}),
+		},
+		Status: v1alpha1.KameletStatus{Phase: v1alpha1.KameletPhaseReady},
+	})
+	platform := v1.NewIntegrationPlatform("test", "camel-k")
+	platform.Spec.Kamelet.Repositories = []v1.IntegrationPlatformKameletRepositorySpec{{URI: "none"}}
+	environment.Platform = &platform
+
+	enabled, err := trait.Configure(environment)
+	assert.NoError(t, err)
+	assert.True(t, enabled)
+
+	err = trait.Apply(environment)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "kamelet timer with version 3.x not found")
+}
+
+func TestKameletDeprecation(t *testing.T) {
+	trait, environment := createKameletsTestEnvironment(`
+- from:
+    uri: kamelet:timer
+    steps:
+    - to: log:info
+`, &v1alpha1.Kamelet{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test",
+			Name:      "timer",
+		},
+		Spec: v1alpha1.KameletSpec{
+			Flow: marshalOrFail(map[string]interface{}{
+				"from": map[string]interface{}{
+					"uri": "timer:tick",
+				},
+			}),
+			Deprecated:     true,
+			ReplacedBy:     "cron",
+			RemovalVersion: "2.0.0",
+		},
+		Status: v1alpha1.KameletStatus{Phase: v1alpha1.KameletPhaseReady},
+	})
+	enabled, err := trait.Configure(environment)
+	assert.NoError(t, err)
+	assert.True(t, enabled)
+
+	err = trait.Apply(environment)
+	assert.NoError(t, err)
+	cond := environment.Integration.Status.GetCondition(v1.IntegrationConditionKameletsDeprecated)
+	assert.NotNil(t, cond)
+	assert.Equal(t, corev1.ConditionTrue, cond.Status)
+	assert.Equal(t, `kamelet "timer" is deprecated and will be removed in version 2.0.0: use "cron" instead`, cond.Message)
+
+	// the condition is cleared once the deprecated Kamelet is not used anymore
+	trait.List = ""
+	environment.Integration.Spec.Sources[0].Content = `
+- from:
+    uri: timer:tick
+    steps:
+    - to: log:info
+`
+	enabled, err = trait.Configure(environment)
+	assert.NoError(t, err)
+	assert.True(t, enabled)
+
+	err = trait.Apply(environment)
+	assert.NoError(t, err)
+	assert.Nil(t, environment.Integration.Status.GetCondition(v1.IntegrationConditionKameletsDeprecated))
+}
+
 func TestKameletSecondarySourcesLookup(t *testing.T) {
 	trait, environment := createKameletsTestEnvironment(`
 - from:
@@ -405,7 +611,7 @@ func TestKameletConfigLookup(t *testing.T) {
 	assert.NoError(t, err)
 	assert.True(t, enabled)
 	assert.Equal(t, []string{"timer"}, trait.getKameletKeys())
-	assert.Equal(t, []configurationKey{newConfigurationKey("timer", "")}, trait.getConfigurationKeys())
+	assert.Equal(t, []configurationKey{newConfigurationKey("timer", "", "")}, trait.getConfigurationKeys())
 
 	err = trait.Apply(environment)
 	assert.NoError(t, err)
@@ -470,8 +676,8 @@ func TestKameletNamedConfigLookup(t *testing.T) {
 	assert.True(t, enabled)
 	assert.Equal(t, []string{"timer"}, trait.getKameletKeys())
 	assert.Equal(t, []configurationKey{
-		newConfigurationKey("timer", ""),
-		newConfigurationKey("timer", "id2"),
+		newConfigurationKey("timer", "", ""),
+		newConfigurationKey("timer", "", "id2"),
 	}, trait.getConfigurationKeys())
 
 	err = trait.Apply(environment)
@@ -482,6 +688,274 @@ func TestKameletNamedConfigLookup(t *testing.T) {
 	assert.NotContains(t, environment.Integration.Status.Configuration, v1.ConfigurationSpec{Type: "secret", Value: "my-secret3"})
 }
 
+func TestKameletOAuth2Authorization(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		assert.NoError(t, r.ParseForm())
+		assert.Equal(t, "client_credentials", r.Form.Get("grant_type"))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"access_token":"the-token","token_type":"bearer","expires_in":3600}`))
+	}))
+	defer server.Close()
+
+	kamelet := &v1alpha1.Kamelet{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test",
+			Name:      "saas",
+		},
+		Spec: v1alpha1.KameletSpec{
+			Flow: marshalOrFail(map[string]interface{}{
+				"from": map[string]interface{}{
+					"uri": "timer:tick",
+				},
+			}),
+			Authorization: &v1alpha1.AuthorizationSpec{
+				OAuth2: &v1alpha1.OAuth2Spec{
+					Flow:     v1alpha1.OAuth2FlowClientCredentials,
+					TokenURL: server.URL,
+					Properties: v1alpha1.OAuth2PropertiesSpec{
+						ClientID:    "clientId",
+						AccessToken: "accessToken",
+					},
+				},
+			},
+		},
+		Status: v1alpha1.KameletStatus{Phase: v1alpha1.KameletPhaseReady},
+	}
+	credentials := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test",
+			Name:      "saas-credentials",
+			Labels: map[string]string{
+				"camel.apache.org/kamelet.authorization": "saas",
+			},
+		},
+		Data: map[string][]byte{
+			"clientId":     []byte("my-client"),
+			"clientSecret": []byte("my-secret"),
+		},
+	}
+	flow := `
+- from:
+    uri: kamelet:saas
+    steps:
+    - to: log:info
+`
+
+	trait, environment := createKameletsTestEnvironment(flow, kamelet, credentials)
+	enabled, err := trait.Configure(environment)
+	assert.NoError(t, err)
+	assert.True(t, enabled)
+	err = trait.Apply(environment)
+	assert.NoError(t, err)
+	assert.Contains(t, environment.Integration.Status.Configuration, v1.ConfigurationSpec{Type: "secret", Value: "it-kamelet-saas-authorization"})
+	assert.Equal(t, 0, requests)
+
+	trait, environment = createKameletsTestEnvironment(flow, kamelet, credentials)
+	environment.Integration.Status.Phase = v1.IntegrationPhaseDeploying
+	environment.ApplicationProperties = make(map[string]string)
+	environment.Resources.Add(&appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test",
+			Name:      "it",
+			Labels: map[string]string{
+				v1.IntegrationLabel: "it",
+			},
+		},
+	})
+	enabled, err = trait.Configure(environment)
+	assert.NoError(t, err)
+	assert.True(t, enabled)
+	err = trait.Apply(environment)
+	assert.NoError(t, err)
+	for _, processor := range environment.PostProcessors {
+		assert.NoError(t, processor(environment))
+	}
+	assert.Equal(t, 1, requests)
+
+	secret := environment.Resources.GetSecret(func(s *corev1.Secret) bool {
+		return s.Name == "it-kamelet-saas-authorization"
+	})
+	assert.NotNil(t, secret)
+	assert.Contains(t, string(secret.Data["authorization.properties"]), "camel.kamelet.saas.accessToken = the-token")
+	assert.Contains(t, string(secret.Data["authorization.properties"]), "camel.kamelet.saas.clientId = my-client")
+	assert.NotContains(t, string(secret.Data["authorization.properties"]), "my-secret")
+	assert.NotEmpty(t, secret.Annotations["camel.apache.org/kamelet.authorization.expiry"])
+
+	deployment := environment.Resources.GetDeploymentForIntegration(environment.Integration)
+	assert.NotNil(t, deployment)
+	assert.NotEmpty(t, deployment.Spec.Template.Annotations["camel.apache.org/kamelet.authorization.digest"])
+
+	condition := environment.Integration.Status.GetCondition(v1.IntegrationConditionKameletsAuthorizationAvailable)
+	assert.NotNil(t, condition)
+	assert.Equal(t, corev1.ConditionTrue, condition.Status)
+
+	// the access token is reused until it's about to expire
+	trait, environment = createKameletsTestEnvironment(flow, kamelet, credentials, secret)
+	environment.Integration.Status.Phase = v1.IntegrationPhaseRunning
+	environment.ApplicationProperties = make(map[string]string)
+	enabled, err = trait.Configure(environment)
+	assert.NoError(t, err)
+	assert.True(t, enabled)
+	err = trait.Apply(environment)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, requests)
+
+	// the access token is cached in memory
+	trait, environment = createKameletsTestEnvironment(flow, kamelet, credentials)
+	environment.Integration.Status.Phase = v1.IntegrationPhaseRunning
+	environment.ApplicationProperties = make(map[string]string)
+	enabled, err = trait.Configure(environment)
+	assert.NoError(t, err)
+	assert.True(t, enabled)
+	err = trait.Apply(environment)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, requests)
+}
+
+func TestKameletOAuth2AuthorizationSecret(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"access_token":"the-token","token_type":"bearer","expires_in":3600}`))
+	}))
+	defer server.Close()
+
+	kamelet := &v1alpha1.Kamelet{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test",
+			Name:      "saas",
+		},
+		Spec: v1alpha1.KameletSpec{
+			Flow: marshalOrFail(map[string]interface{}{
+				"from": map[string]interface{}{
+					"uri": "timer:tick",
+				},
+			}),
+			Authorization: &v1alpha1.AuthorizationSpec{
+				OAuth2: &v1alpha1.OAuth2Spec{
+					Flow:     v1alpha1.OAuth2FlowClientCredentials,
+					TokenURL: server.URL,
+					Properties: v1alpha1.OAuth2PropertiesSpec{
+						ClientID:    "clientId",
+						AccessToken: "accessToken",
+					},
+				},
+			},
+		},
+		Status: v1alpha1.KameletStatus{Phase: v1alpha1.KameletPhaseReady},
+	}
+	credentials := func(name string, clientID string) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "test",
+				Name:      name,
+				Labels: map[string]string{
+					"camel.apache.org/kamelet.authorization": "saas",
+				},
+			},
+			Data: map[string][]byte{
+				"clientId":     []byte(clientID),
+				"clientSecret": []byte("my-secret"),
+			},
+		}
+	}
+	flow := `
+- from:
+    uri: kamelet:saas
+    steps:
+    - to: log:info
+`
+
+	// the secret to use is ambiguous
+	trait, environment := createKameletsTestEnvironment(flow, kamelet, credentials("saas-credentials-1", "client-1"), credentials("saas-credentials-2", "client-2"))
+	environment.Integration.Status.Phase = v1.IntegrationPhaseDeploying
+	environment.ApplicationProperties = make(map[string]string)
+	enabled, err := trait.Configure(environment)
+	assert.NoError(t, err)
+	assert.True(t, enabled)
+	err = trait.Apply(environment)
+	assert.EqualError(t, err, `kamelet "saas" requires OAuth 2.0 credentials: several secrets labeled camel.apache.org/kamelet.authorization=saas `+
+		`found (saas-credentials-1, saas-credentials-2), select one with the kamelets.authorization-secrets trait property`)
+
+	// the secret is referenced explicitly
+	trait, environment = createKameletsTestEnvironment(flow, kamelet, credentials("saas-credentials-1", "client-1"), credentials("saas-credentials-2", "client-2"))
+	trait.AuthorizationSecrets = []string{"other:other-credentials", "saas:saas-credentials-2"}
+	environment.Integration.Status.Phase = v1.IntegrationPhaseDeploying
+	environment.ApplicationProperties = make(map[string]string)
+	enabled, err = trait.Configure(environment)
+	assert.NoError(t, err)
+	assert.True(t, enabled)
+	err = trait.Apply(environment)
+	assert.NoError(t, err)
+
+	secret := environment.Resources.GetSecret(func(s *corev1.Secret) bool {
+		return s.Name == "it-kamelet-saas-authorization"
+	})
+	assert.NotNil(t, secret)
+	assert.Contains(t, string(secret.Data["authorization.properties"]), "camel.kamelet.saas.clientId = client-2")
+}
+
+func TestKameletSensitiveDefaults(t *testing.T) {
+	kamelet := &v1alpha1.Kamelet{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test",
+			Name:      "db",
+		},
+		Spec: v1alpha1.KameletSpec{
+			Definition: &v1alpha1.JSONSchemaProps{
+				Properties: map[string]v1alpha1.JSONSchemaProp{
+					"user": {
+						Type:    "string",
+						Default: &v1alpha1.JSON{RawMessage: []byte(`"admin"`)},
+					},
+					"password": {
+						Type:    "string",
+						Format:  "password",
+						Default: &v1alpha1.JSON{RawMessage: []byte(`"changeit"`)},
+					},
+				},
+			},
+			Flow: marshalOrFail(map[string]interface{}{
+				"from": map[string]interface{}{
+					"uri": "timer:tick",
+				},
+			}),
+		},
+		Status: v1alpha1.KameletStatus{Phase: v1alpha1.KameletPhaseReady},
+	}
+	flow := `
+- from:
+    uri: kamelet:db
+    steps:
+    - to: log:info
+`
+
+	trait, environment := createKameletsTestEnvironment(flow, kamelet)
+	enabled, err := trait.Configure(environment)
+	assert.NoError(t, err)
+	assert.True(t, enabled)
+	err = trait.Apply(environment)
+	assert.NoError(t, err)
+	assert.Contains(t, environment.Integration.Status.Configuration, v1.ConfigurationSpec{Type: "secret", Value: "it-kamelet-db-properties"})
+
+	trait, environment = createKameletsTestEnvironment(flow, kamelet)
+	environment.Integration.Status.Phase = v1.IntegrationPhaseDeploying
+	environment.ApplicationProperties = make(map[string]string)
+	enabled, err = trait.Configure(environment)
+	assert.NoError(t, err)
+	assert.True(t, enabled)
+	err = trait.Apply(environment)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"camel.kamelet.db.user": "admin"}, environment.ApplicationProperties)
+
+	secret := environment.Resources.GetSecret(func(s *corev1.Secret) bool {
+		return s.Name == "it-kamelet-db-properties"
+	})
+	assert.NotNil(t, secret)
+	assert.Equal(t, "camel.kamelet.db.password = changeit\n", string(secret.Data["kamelet.properties"]))
+}
+
 func createKameletsTestEnvironment(flow string, objects ...runtime.Object) (*kameletsTrait, *Environment) {
 	catalog, _ := camel.DefaultCatalog()
 
@@ -491,6 +965,7 @@ func createKameletsTestEnvironment(flow string, objects ...runtime.Object) (*kam
 	trait.Client = client
 
 	environment := &Environment{
+		C:            context.TODO(),
 		Catalog:      NewCatalog(context.TODO(), client),
 		Client:       client,
 		CamelCatalog: catalog,
@@ -18,10 +18,29 @@ limitations under the License.
 package trait
 
 import (
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
 	"github.com/apache/camel-k/pkg/builder"
 	"github.com/apache/camel-k/pkg/builder/runtime"
 )
 
+const (
+	// The minimal base image for native executables, that don't need a JVM
+	nativeBaseImage = "quay.io/quarkus/quarkus-micro-image:1.0"
+	// The image of the native-image build step, that provides GraalVM
+	nativeBuilderImage = "quay.io/quarkus/ubi-quarkus-mandrel:20.3-java11"
+	// The name of the build step that compiles the native executable
+	nativeBuildStepName = "native-image"
+	// Native compilation takes much longer than the JVM packaging
+	nativeBuildTimeout = 30 * time.Minute
+)
+
 // The Quarkus trait activates the Quarkus runtime.
 //
 // It's enabled by default.
@@ -29,7 +48,8 @@ import (
 // +camel-k:trait=quarkus
 type quarkusTrait struct {
 	BaseTrait `property:",squash"`
-	// The Quarkus runtime type (reserved for future use)
+	// Compiles the integration into a native executable, that starts faster and uses less memory than the JVM.
+	// Native compilation only supports YAML and XML sources.
 	Native bool `property:"native" json:"native,omitempty"`
 }
 
@@ -48,6 +68,15 @@ func (t *quarkusTrait) Configure(e *Environment) (bool, error) {
 }
 
 func (t *quarkusTrait) Apply(e *Environment) error {
+	if t.Native && e.IntegrationInPhase(v1.IntegrationPhaseInitialization) {
+		for _, source := range e.Integration.Sources() {
+			if language := source.InferLanguage(); language != v1.LanguageYaml && language != v1.LanguageXML {
+				return fmt.Errorf("invalid source %s: native compilation only supports %s and %s sources, found %s",
+					source.Name, v1.LanguageYaml, v1.LanguageXML, language)
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -62,5 +91,66 @@ func (t *quarkusTrait) InfluencesKit() bool {
 }
 
 func (t *quarkusTrait) addBuildSteps(steps *[]builder.Step) {
-	*steps = append(*steps, runtime.QuarkusSteps...)
+	if !t.Native {
+		*steps = append(*steps, runtime.QuarkusSteps...)
+		return
+	}
+
+	*steps = append(*steps, runtime.QuarkusNativeSteps...)
+	// The native executable cannot be layered on top of other kit images
+	for i, step := range *steps {
+		if step.ID() == builder.Steps.IncrementalImageContext.ID() {
+			(*steps)[i] = builder.Steps.StandardImageContext
+		}
+	}
+}
+
+// configureBuildTask tunes the builder task for native compilation, that runs GraalVM native-image in a dedicated
+// container step, on the sources generated by the Maven build, and requires more time and resources
+func (t *quarkusTrait) configureBuildTask(task *v1.BuilderTask) {
+	if !t.Native {
+		return
+	}
+
+	task.BaseImage = nativeBaseImage
+
+	if task.Timeout.Duration < nativeBuildTimeout {
+		task.Timeout = metav1.Duration{Duration: nativeBuildTimeout}
+	}
+
+	task.ContainerSteps = append(task.ContainerSteps, v1.ContainerStep{
+		ContainerTask: v1.ContainerTask{
+			BaseTask: v1.BaseTask{
+				Name: nativeBuildStepName,
+			},
+			Image: nativeBuilderImage,
+			// The step runs in the build directory
+			Command: []string{"/bin/sh", "-c", fmt.Sprintf("cd %s && native-image $(cat native-image.args)", runtime.QuarkusNativeSourcesDir)},
+		},
+		// Runs after the generation of the native sources, and before the native executable is added to the artifacts
+		Phase: builder.ProjectBuildPhase,
+		Resources: &corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("1"),
+				corev1.ResourceMemory: resource.MustParse("4Gi"),
+			},
+			Limits: corev1.ResourceList{
+				corev1.ResourceMemory: resource.MustParse("6Gi"),
+			},
+		},
+	})
+}
+
+// IntegrationKitLayoutFor returns the layout of the kit built according to the given traits configuration
+func IntegrationKitLayoutFor(traits map[string]v1.TraitSpec) (string, error) {
+	t := newQuarkusTrait().(*quarkusTrait)
+	if spec, ok := traits[string(t.ID())]; ok {
+		if err := decodeTraitSpec(&spec, t); err != nil {
+			return "", err
+		}
+	}
+	if t.Native {
+		return v1.IntegrationKitLayoutNative, nil
+	}
+	return v1.IntegrationKitLayoutFastJar, nil
 }
@@ -19,12 +19,17 @@ package trait
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
 	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
 	"github.com/apache/camel-k/pkg/builder"
+	"github.com/apache/camel-k/pkg/builder/runtime"
 	"github.com/apache/camel-k/pkg/util/camel"
+	"github.com/apache/camel-k/pkg/util/test"
 )
 
 func TestConfigureQuarkusTraitShouldSucceed(t *testing.T) {
@@ -65,6 +70,74 @@ func TestQuarkusTraitAddBuildStepsShouldSucceed(t *testing.T) {
 	assert.Len(t, steps, 9)
 }
 
+func TestQuarkusTraitAddNativeBuildSteps(t *testing.T) {
+	quarkusTrait, _ := createNominalQuarkusTest()
+	quarkusTrait.Native = true
+
+	steps := make([]builder.Step, 0)
+	steps = append(steps, builder.DefaultSteps...)
+
+	quarkusTrait.addBuildSteps(&steps)
+
+	ids := builder.StepIDsFor(steps...)
+	assert.Contains(t, ids, runtime.Steps.BuildQuarkusNativeRunner.ID())
+	assert.Contains(t, ids, builder.Steps.StandardImageContext.ID())
+	assert.NotContains(t, ids, runtime.Steps.BuildQuarkusRunner.ID())
+	assert.NotContains(t, ids, builder.Steps.IncrementalImageContext.ID())
+}
+
+func TestQuarkusTraitConfigureNativeBuildTask(t *testing.T) {
+	quarkusTrait, _ := createNominalQuarkusTest()
+
+	task := &v1.BuilderTask{
+		BaseImage: "adoptopenjdk/openjdk11:slim",
+		Timeout:   metav1.Duration{Duration: 5 * time.Minute},
+	}
+
+	quarkusTrait.configureBuildTask(task)
+	assert.Equal(t, "adoptopenjdk/openjdk11:slim", task.BaseImage)
+	assert.Empty(t, task.ContainerSteps)
+
+	quarkusTrait.Native = true
+	quarkusTrait.configureBuildTask(task)
+	assert.Equal(t, nativeBaseImage, task.BaseImage)
+	assert.Equal(t, nativeBuildTimeout, task.Timeout.Duration)
+	// GraalVM native-image runs in a dedicated container step
+	assert.Len(t, task.ContainerSteps, 1)
+	step := task.ContainerSteps[0]
+	assert.Equal(t, nativeBuildStepName, step.Name)
+	assert.Equal(t, nativeBuilderImage, step.Image)
+	assert.Equal(t, builder.ProjectBuildPhase, step.Phase)
+	assert.NotNil(t, step.Resources)
+}
+
+func TestApplyNativeQuarkusTraitWithUnsupportedSource(t *testing.T) {
+	quarkusTrait, environment := createNominalQuarkusTest()
+	quarkusTrait.Native = true
+	environment.Integration.Status.Phase = v1.IntegrationPhaseInitialization
+
+	err := quarkusTrait.Apply(environment)
+	assert.NotNil(t, err)
+
+	environment.Integration.Spec.Sources[0].Language = v1.LanguageYaml
+	err = quarkusTrait.Apply(environment)
+	assert.Nil(t, err)
+}
+
+func TestIntegrationKitLayoutFor(t *testing.T) {
+	layout, err := IntegrationKitLayoutFor(nil)
+	assert.Nil(t, err)
+	assert.Equal(t, v1.IntegrationKitLayoutFastJar, layout)
+
+	layout, err = IntegrationKitLayoutFor(map[string]v1.TraitSpec{
+		"quarkus": test.TraitSpecFromMap(t, map[string]interface{}{
+			"native": true,
+		}),
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, v1.IntegrationKitLayoutNative, layout)
+}
+
 func createNominalQuarkusTest() (*quarkusTrait, *Environment) {
 	trait := newQuarkusTrait().(*quarkusTrait)
 	enabled := true
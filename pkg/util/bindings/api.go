@@ -24,6 +24,7 @@ import (
 	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
 	"github.com/apache/camel-k/pkg/apis/camel/v1alpha1"
 	"github.com/apache/camel-k/pkg/client"
+	"github.com/apache/camel-k/pkg/kamelet/repository"
 )
 
 const (
@@ -38,6 +39,9 @@ type Binding struct {
 	URI string
 	// Traits is a partial trait specification that should be merged into the integration
 	Traits map[string]v1.TraitSpec
+	// SecretProperties are the application properties holding sensitive values, that must be stored in a secret
+	// instead of being part of the URI
+	SecretProperties map[string]string
 }
 
 // BindingProvider maps a KameletBinding endpoint into Camel K resources
@@ -55,4 +59,7 @@ type BindingContext struct {
 	Client    client.Client
 	Namespace string
 	Profile   v1.TraitProfile
+	// Repository is used to look up Kamelets, e.g. to share it across the endpoints of a binding or to translate bindings offline.
+	// The repositories configured for the namespace are used when it's not set.
+	Repository repository.KameletRepository
 }
@@ -27,9 +27,11 @@ import (
 	camelv1 "github.com/apache/camel-k/pkg/apis/camel/v1"
 	knativeapis "github.com/apache/camel-k/pkg/apis/camel/v1/knative"
 	"github.com/apache/camel-k/pkg/apis/camel/v1alpha1"
+	"github.com/apache/camel-k/pkg/kamelet"
 	"github.com/apache/camel-k/pkg/util/test"
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 func TestBindings(t *testing.T) {
@@ -150,6 +152,20 @@ func TestBindings(t *testing.T) {
 			},
 			uri: "kamelet:mykamelet/myid%3F?mymessage=myval",
 		},
+		{
+			endpoint: v1alpha1.Endpoint{
+				Ref: &corev1.ObjectReference{
+					Kind:       "Kamelet",
+					APIVersion: "camel.apache.org/v1any1",
+					Name:       "mykamelet@^1.2",
+				},
+				Properties: asEndpointProperties(map[string]string{
+					"id":        "myid",
+					"mymessage": "myval",
+				}),
+			},
+			uri: "kamelet:mykamelet@^1.2/myid?mymessage=myval",
+		},
 		{
 			endpointType: v1alpha1.EndpointTypeSink,
 			endpoint: v1alpha1.Endpoint{
@@ -179,6 +195,27 @@ func TestBindings(t *testing.T) {
 			},
 			uri: "docker://xxx",
 		},
+		{
+			endpointType: v1alpha1.EndpointTypeAction,
+			endpoint: v1alpha1.Endpoint{
+				Ref: &corev1.ObjectReference{
+					Kind:       "Kamelet",
+					APIVersion: "camel.apache.org/v1alpha1",
+					Name:       "mytransformer",
+				},
+				Properties: asEndpointProperties(map[string]string{
+					"field": "value",
+				}),
+			},
+			uri: "kamelet:mytransformer?field=value",
+		},
+		{
+			endpointType: v1alpha1.EndpointTypeAction,
+			endpoint: v1alpha1.Endpoint{
+				URI: asStringPointer("https://myurl/hey"),
+			},
+			uri: "https://myurl/hey",
+		},
 	}
 
 	for i, tc := range testcases {
@@ -186,7 +223,7 @@ func TestBindings(t *testing.T) {
 			ctx, cancel := context.WithCancel(context.Background())
 			defer cancel()
 
-			client, err := test.NewFakeClient()
+			client, err := test.NewFakeClient(platformWithoutRemoteRepositories())
 			assert.NoError(t, err)
 
 			profile := tc.profile
@@ -210,6 +247,191 @@ func TestBindings(t *testing.T) {
 	}
 }
 
+func TestKameletBindingValidation(t *testing.T) {
+	k := v1alpha1.Kamelet{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test",
+			Name:      "mykamelet",
+		},
+		Spec: v1alpha1.KameletSpec{
+			Definition: &v1alpha1.JSONSchemaProps{
+				Required: []string{"mymessage"},
+				Properties: map[string]v1alpha1.JSONSchemaProp{
+					"mymessage": {Type: "string"},
+					"period":    {Type: "integer"},
+				},
+			},
+		},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	client, err := test.NewFakeClient(platformWithoutRemoteRepositories(), &k)
+	assert.NoError(t, err)
+
+	bindingContext := BindingContext{
+		Ctx:       ctx,
+		Client:    client,
+		Namespace: "test",
+		Profile:   camelv1.TraitProfileKubernetes,
+	}
+	ref := corev1.ObjectReference{
+		Kind:       "Kamelet",
+		APIVersion: "camel.apache.org/v1alpha1",
+		Name:       "mykamelet",
+	}
+
+	binding, err := Translate(bindingContext, v1alpha1.EndpointTypeSource, v1alpha1.Endpoint{
+		Ref: &ref,
+		Properties: asEndpointProperties(map[string]string{
+			"id":        "myid",
+			"mymessage": "hello",
+			"period":    "1000",
+		}),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "kamelet:mykamelet/myid?mymessage=hello&period=1000", binding.URI)
+
+	_, err = Translate(bindingContext, v1alpha1.EndpointTypeSource, v1alpha1.Endpoint{
+		Ref: &ref,
+		Properties: asEndpointProperties(map[string]string{
+			"period": "often",
+		}),
+	})
+	assert.Error(t, err)
+	invalid, ok := err.(*kamelet.InvalidPropertiesError)
+	assert.True(t, ok)
+	assert.Equal(t, []string{
+		`missing required property "mymessage" for kamelet "mykamelet"`,
+		`invalid value for property "period" of kamelet "mykamelet": "often" is not an integer`,
+	}, invalid.Violations)
+}
+
+func TestKameletBindingSensitiveProperties(t *testing.T) {
+	k := v1alpha1.Kamelet{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test",
+			Name:      "mykamelet",
+		},
+		Spec: v1alpha1.KameletSpec{
+			Definition: &v1alpha1.JSONSchemaProps{
+				Properties: map[string]v1alpha1.JSONSchemaProp{
+					"user":     {Type: "string"},
+					"password": {Type: "string", Format: "password"},
+					"token":    {Type: "string", XDescriptors: []string{"urn:alm:descriptor:com.tectonic.ui:password"}},
+				},
+			},
+		},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	client, err := test.NewFakeClient(platformWithoutRemoteRepositories(), &k)
+	assert.NoError(t, err)
+
+	bindingContext := BindingContext{
+		Ctx:       ctx,
+		Client:    client,
+		Namespace: "test",
+		Profile:   camelv1.TraitProfileKubernetes,
+	}
+	ref := corev1.ObjectReference{
+		Kind:       "Kamelet",
+		APIVersion: "camel.apache.org/v1alpha1",
+		Name:       "mykamelet",
+	}
+
+	binding, err := Translate(bindingContext, v1alpha1.EndpointTypeSource, v1alpha1.Endpoint{
+		Ref: &ref,
+		Properties: asEndpointProperties(map[string]string{
+			"user":     "admin",
+			"password": "s3cr3t",
+			"token":    "t0k3n",
+		}),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "kamelet:mykamelet?user=admin", binding.URI)
+	assert.Equal(t, map[string]string{
+		"camel.kamelet.mykamelet.password": "s3cr3t",
+		"camel.kamelet.mykamelet.token":    "t0k3n",
+	}, binding.SecretProperties)
+
+	binding, err = Translate(bindingContext, v1alpha1.EndpointTypeSource, v1alpha1.Endpoint{
+		Ref: &ref,
+		Properties: asEndpointProperties(map[string]string{
+			"id":       "myid",
+			"password": "s3cr3t",
+		}),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "kamelet:mykamelet/myid", binding.URI)
+	assert.Equal(t, map[string]string{
+		"camel.kamelet.mykamelet.myid.password": "s3cr3t",
+	}, binding.SecretProperties)
+}
+
+func TestKameletBindingVersionResolution(t *testing.T) {
+	k := v1alpha1.Kamelet{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test",
+			Name:      "mykamelet",
+			Labels: map[string]string{
+				v1alpha1.KameletVersionLabel: "2.0.0",
+			},
+		},
+		Spec: v1alpha1.KameletSpec{
+			Versions: []v1alpha1.KameletVersionSpec{
+				{
+					Version: "1.1.0",
+				},
+				{
+					Version: "1.2.0",
+					Definition: &v1alpha1.JSONSchemaProps{
+						Properties: map[string]v1alpha1.JSONSchemaProp{
+							"password": {Type: "string", Format: "password"},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	client, err := test.NewFakeClient(platformWithoutRemoteRepositories(), &k)
+	assert.NoError(t, err)
+
+	bindingContext := BindingContext{
+		Ctx:       context.TODO(),
+		Client:    client,
+		Namespace: "test",
+		Profile:   camelv1.TraitProfileKubernetes,
+	}
+
+	// the range is resolved, so that the URI and the properties use the same key as the kamelets trait
+	binding, err := Translate(bindingContext, v1alpha1.EndpointTypeSource, v1alpha1.Endpoint{
+		Ref: &corev1.ObjectReference{
+			Kind:       "Kamelet",
+			APIVersion: "camel.apache.org/v1alpha1",
+			Name:       "mykamelet@^1.0",
+		},
+		Properties: asEndpointProperties(map[string]string{
+			"password": "s3cr3t",
+		}),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "kamelet:mykamelet@1.2.0", binding.URI)
+	assert.Equal(t, map[string]string{
+		"camel.kamelet.mykamelet@1.2.0.password": "s3cr3t",
+	}, binding.SecretProperties)
+}
+
+func platformWithoutRemoteRepositories() *camelv1.IntegrationPlatform {
+	platform := camelv1.NewIntegrationPlatform("test", "camel-k")
+	platform.Spec.Kamelet.Repositories = []camelv1.IntegrationPlatformKameletRepositorySpec{{URI: "none"}}
+	return &platform
+}
+
 func asEndpointProperties(props map[string]string) *v1alpha1.EndpointProperties {
 	serialized, err := json.Marshal(props)
 	if err != nil {
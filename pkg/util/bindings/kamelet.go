@@ -22,6 +22,9 @@ import (
 	"net/url"
 
 	"github.com/apache/camel-k/pkg/apis/camel/v1alpha1"
+	kameletutils "github.com/apache/camel-k/pkg/kamelet"
+	"github.com/apache/camel-k/pkg/kamelet/repository"
+	"github.com/apache/camel-k/pkg/platform"
 	"github.com/apache/camel-k/pkg/util/uri"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
@@ -44,27 +47,81 @@ func (k KameletBindingProvider) Translate(ctx BindingContext, endpointType v1alp
 	}
 	// it translates only Kamelet refs
 	if e.Ref.Kind == v1alpha1.KameletKind && gv.Group == v1alpha1.SchemeGroupVersion.Group {
-		kameletURI := fmt.Sprintf("kamelet:%s", url.PathEscape(e.Ref.Name))
+		kamelet, err := validateKameletProperties(ctx, e)
+		if err != nil {
+			return nil, err
+		}
+
+		name, version := v1alpha1.ParseKameletReference(e.Ref.Name)
+		key := name
+		kameletURI := fmt.Sprintf("kamelet:%s", url.PathEscape(name))
+		if version != "" {
+			// the requested version, that may be a range, is resolved to the version of the Kamelet,
+			// that the kamelets trait matches literally
+			if kamelet != nil {
+				version = kamelet.GetVersion()
+			}
+			key = fmt.Sprintf("%s%s%s", key, v1alpha1.KameletVersionSeparator, version)
+			kameletURI = fmt.Sprintf("%s%s%s", kameletURI, v1alpha1.KameletVersionSeparator, version)
+		}
 
 		props, err := e.Properties.GetPropertyMap()
 		if err != nil {
 			return nil, err
 		}
 
+		propertyPrefix := fmt.Sprintf("camel.kamelet.%s.", key)
 		if id, ok := props[v1alpha1.KameletIDProperty]; ok && id != "" {
 			delete(props, v1alpha1.KameletIDProperty)
 			kameletURI = fmt.Sprintf("%s/%s", kameletURI, url.PathEscape(id))
+			propertyPrefix = fmt.Sprintf("%s%s.", propertyPrefix, id)
+		}
+
+		// sensitive properties are moved out of the URI, so that they are never stored in clear text
+		var secretProps map[string]string
+		for k, v := range props {
+			if kamelet != nil && kamelet.IsSensitiveProperty(k) {
+				if secretProps == nil {
+					secretProps = make(map[string]string)
+				}
+				secretProps[propertyPrefix+k] = v
+				delete(props, k)
+			}
 		}
 
 		kameletURI = uri.AppendParameters(kameletURI, props)
 
 		return &Binding{
-			URI: kameletURI,
+			URI:              kameletURI,
+			SecretProperties: secretProps,
 		}, nil
 	}
 	return nil, nil
 }
 
+// validateKameletProperties checks the endpoint properties against the definition of the referenced Kamelet, that is returned.
+// Kamelets that cannot be found are not validated, as they are reported when materializing the integration.
+func validateKameletProperties(ctx BindingContext, e v1alpha1.Endpoint) (*v1alpha1.Kamelet, error) {
+	repo := ctx.Repository
+	if repo == nil {
+		var err error
+		if repo, err = repository.New(ctx.Ctx, ctx.Client, ctx.Namespace, platform.GetOperatorNamespace()); err != nil {
+			return nil, err
+		}
+	}
+	name, version := v1alpha1.ParseKameletReference(e.Ref.Name)
+	kamelet, err := repo.GetVersion(ctx.Ctx, name, version)
+	if err != nil || kamelet == nil {
+		return nil, err
+	}
+	props, err := e.Properties.GetPropertyValues()
+	if err != nil {
+		return nil, err
+	}
+	delete(props, v1alpha1.KameletIDProperty)
+	return kamelet, kameletutils.ValidateProperties(kamelet, props)
+}
+
 func (k KameletBindingProvider) Order() int {
 	return OrderStandard
 }
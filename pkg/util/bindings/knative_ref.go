@@ -77,7 +77,7 @@ func (k KnativeRefBindingProvider) Translate(ctx BindingContext, endpointType v1
 			delete(props, "type")
 			serviceURI = fmt.Sprintf("knative:%s/%s", *serviceType, eventType)
 		} else {
-			if endpointType == v1alpha1.EndpointTypeSink {
+			if endpointType != v1alpha1.EndpointTypeSource {
 				// Allowing no event type, but it can fail. See https://github.com/apache/camel-k-runtime/issues/536
 				serviceURI = fmt.Sprintf("knative:%s", *serviceType)
 			} else {
@@ -48,7 +48,7 @@ func (k KnativeURIBindingProvider) Translate(ctx BindingContext, endpointType v1
 		// only translates http/https uri to Knative calls
 		return nil, nil
 	}
-	if endpointType == v1alpha1.EndpointTypeSource {
+	if endpointType != v1alpha1.EndpointTypeSink {
 		// HTTP/HTTPS uri are translated to Knative endpoints only when used as sinks
 		return nil, nil
 	}
@@ -0,0 +1,62 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"io"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/tools/remotecommand"
+
+	"github.com/apache/camel-k/pkg/client"
+)
+
+// Exec runs a command in a running container of a pod, streaming its standard input and outputs
+func Exec(c client.Client, ns, pod, container string, command []string, stdin io.Reader, stdOut, stdErr io.Writer) error {
+	cc, err := corev1client.NewForConfig(c.GetConfig())
+	if err != nil {
+		return err
+	}
+
+	url := cc.RESTClient().Post().
+		Resource("pods").
+		Namespace(ns).
+		Name(pod).
+		SubResource("exec").
+		VersionedParams(&corev1.PodExecOptions{
+			Container: container,
+			Command:   command,
+			Stdin:     stdin != nil,
+			Stdout:    stdOut != nil,
+			Stderr:    stdErr != nil,
+		}, scheme.ParameterCodec).
+		URL()
+
+	executor, err := remotecommand.NewSPDYExecutor(c.GetConfig(), "POST", url)
+	if err != nil {
+		return err
+	}
+
+	return executor.Stream(remotecommand.StreamOptions{
+		Stdin:  stdin,
+		Stdout: stdOut,
+		Stderr: stdErr,
+	})
+}
@@ -0,0 +1,389 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package log
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/util"
+	klog "github.com/apache/camel-k/pkg/util/log"
+)
+
+const (
+	// BuildLogKey is the key of the build log in the ConfigMap that stores it
+	BuildLogKey = "build.log"
+	// BuildLogSizeAnnotation records the total size of the build log, including the lines that have been dropped
+	BuildLogSizeAnnotation = "camel.apache.org/build.log.size"
+	// BuildLogContainersAnnotation records the containers whose log has been appended to the build log
+	BuildLogContainersAnnotation = "camel.apache.org/build.log.containers"
+	// BuildLogMaxSize is the maximum size of a stored build log, whose oldest lines get dropped when exceeded
+	BuildLogMaxSize = 512 * 1024
+
+	// The log is also flushed once each build step completes
+	buildLogFlushPeriod  = 10 * time.Second
+	buildLogPollPeriod   = time.Second
+	buildLogTruncatedMsg = "[...]\n"
+)
+
+// BuildLogName returns the name of the ConfigMap that stores the log of the given build
+func BuildLogName(buildName string) string {
+	return buildName + "-log"
+}
+
+// BuildLogWriter persists the output of a build into a size-capped ConfigMap,
+// that is periodically updated until the writer is closed
+type BuildLogWriter struct {
+	ctx       context.Context
+	client    ctrl.Client
+	build     *v1.Build
+	lock      sync.Mutex
+	flushLock sync.Mutex
+	content   []byte
+	size      int64
+	// containers whose log has been appended
+	containers []string
+	dirty      bool
+	done       chan struct{}
+	doneOnce   sync.Once
+	L          klog.Logger
+}
+
+// NewBuildLogWriter creates a writer that appends to the log of the given build
+func NewBuildLogWriter(ctx context.Context, c ctrl.Client, build *v1.Build) (*BuildLogWriter, error) {
+	w := BuildLogWriter{
+		ctx:    ctx,
+		client: c,
+		build:  build,
+		done:   make(chan struct{}),
+		L:      klog.WithName("build-log").WithValues("name", build.Name),
+	}
+
+	cm := corev1.ConfigMap{}
+	err := c.Get(ctx, ctrl.ObjectKey{Namespace: build.Namespace, Name: BuildLogName(build.Name)}, &cm)
+	switch {
+	case err == nil:
+		w.content = []byte(cm.Data[BuildLogKey])
+		w.size = buildLogSize(&cm)
+		if containers := cm.Annotations[BuildLogContainersAnnotation]; containers != "" {
+			w.containers = strings.Split(containers, ",")
+		}
+	case !k8serrors.IsNotFound(err):
+		return nil, errors.Wrap(err, "cannot retrieve build log")
+	}
+
+	go w.periodicFlush()
+
+	return &w, nil
+}
+
+// Write appends the given bytes to the build log, dropping its oldest lines when it exceeds the maximum size
+func (w *BuildLogWriter) Write(p []byte) (int, error) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	w.write(p)
+
+	return len(p), nil
+}
+
+// HasContainerLog tells whether the log of the given container has already been appended to the build log
+func (w *BuildLogWriter) HasContainerLog(container string) bool {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	return util.StringSliceExists(w.containers, container)
+}
+
+// WriteContainerLog appends the log of the given container to the build log, unless it has already been appended.
+// The container is recorded along with its log, so that it does not get appended twice.
+func (w *BuildLogWriter) WriteContainerLog(container string, p []byte) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	if util.StringSliceExists(w.containers, container) {
+		return
+	}
+	w.write(p)
+	w.containers = append(w.containers, container)
+}
+
+func (w *BuildLogWriter) write(p []byte) {
+	w.content = append(w.content, p...)
+	w.size += int64(len(p))
+	if len(w.content) > BuildLogMaxSize {
+		w.content = truncateBuildLog(w.content)
+	}
+	w.dirty = true
+}
+
+// Flush persists the content written so far
+func (w *BuildLogWriter) Flush() error {
+	w.flushLock.Lock()
+	defer w.flushLock.Unlock()
+
+	w.lock.Lock()
+	if !w.dirty {
+		w.lock.Unlock()
+		return nil
+	}
+	content := string(w.content)
+	size := w.size
+	containers := strings.Join(w.containers, ",")
+	w.dirty = false
+	w.lock.Unlock()
+
+	annotations := map[string]string{
+		BuildLogSizeAnnotation: strconv.FormatInt(size, 10),
+	}
+	if containers != "" {
+		annotations[BuildLogContainersAnnotation] = containers
+	}
+
+	cm := corev1.ConfigMap{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: corev1.SchemeGroupVersion.String(),
+			Kind:       "ConfigMap",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: w.build.Namespace,
+			Name:      BuildLogName(w.build.Name),
+			Labels: map[string]string{
+				"camel.apache.org/build":     w.build.Name,
+				"camel.apache.org/component": "build-log",
+			},
+			Annotations: annotations,
+			// The log is garbage collected with the build
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion: v1.SchemeGroupVersion.String(),
+					Kind:       v1.BuildKind,
+					Name:       w.build.Name,
+					UID:        w.build.UID,
+				},
+			},
+		},
+		Data: map[string]string{
+			BuildLogKey: content,
+		},
+	}
+
+	err := w.client.Create(w.ctx, &cm)
+	if k8serrors.IsAlreadyExists(err) {
+		err = w.client.Patch(w.ctx, &cm, ctrl.Merge)
+	}
+	if err != nil {
+		// Make sure the content gets persisted on the next flush
+		w.lock.Lock()
+		w.dirty = true
+		w.lock.Unlock()
+		return errors.Wrap(err, "cannot persist build log")
+	}
+
+	return nil
+}
+
+// Close stops the periodic persistence of the build log, and flushes the remaining content
+func (w *BuildLogWriter) Close() error {
+	w.doneOnce.Do(func() {
+		close(w.done)
+	})
+	return w.Flush()
+}
+
+func (w *BuildLogWriter) periodicFlush() {
+	ticker := time.NewTicker(buildLogFlushPeriod)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-w.done:
+			return
+		case <-ticker.C:
+			if err := w.Flush(); err != nil {
+				w.L.Error(err, "Cannot persist build log")
+			}
+		}
+	}
+}
+
+// truncateBuildLog drops the oldest lines of the build log, so that it fits the maximum size
+func truncateBuildLog(content []byte) []byte {
+	tail := content[len(content)-BuildLogMaxSize:]
+	if i := bytes.IndexByte(tail, '\n'); i >= 0 && i < len(tail)-1 {
+		tail = tail[i+1:]
+	}
+	// Do not retain the dropped content
+	return append([]byte{}, tail...)
+}
+
+func buildLogSize(cm *corev1.ConfigMap) int64 {
+	if size, err := strconv.ParseInt(cm.Annotations[BuildLogSizeAnnotation], 10, 64); err == nil {
+		return size
+	}
+	return int64(len(cm.Data[BuildLogKey]))
+}
+
+// BuildLogScraper scrapes the log of a build
+type BuildLogScraper struct {
+	client    ctrl.Reader
+	namespace string
+	buildName string
+	follow    bool
+	L         klog.Logger
+}
+
+// NewBuildLogScraper creates a new build log scraper, that follows the build log until the build completes if requested
+func NewBuildLogScraper(c ctrl.Reader, namespace string, buildName string, follow bool) *BuildLogScraper {
+	return &BuildLogScraper{
+		client:    c,
+		namespace: namespace,
+		buildName: buildName,
+		follow:    follow,
+		L:         klog.WithName("scraper").WithName("build").WithValues("name", buildName),
+	}
+}
+
+// Start returns a reader that streams the build log
+func (s *BuildLogScraper) Start(ctx context.Context) *bufio.Reader {
+	pipeIn, pipeOut := io.Pipe()
+	bufPipeIn := bufio.NewReader(pipeIn)
+	bufPipeOut := bufio.NewWriter(pipeOut)
+	closeFun := func() error {
+		bufPipeOut.Flush()
+		return pipeOut.Close()
+	}
+	go s.doScrape(ctx, bufPipeOut, closeFun)
+	return bufPipeIn
+}
+
+func (s *BuildLogScraper) doScrape(ctx context.Context, out *bufio.Writer, clientCloser func() error) {
+	defer func() {
+		if err := clientCloser(); err != nil {
+			s.L.Error(err, "Unable to close the client")
+		}
+	}()
+
+	// The position in the build log, including the lines that may have been dropped
+	var offset int64
+	for {
+		// Check the build before reading its log, so that no content gets missed when it completes
+		completed := true
+		if s.follow {
+			var err error
+			if completed, err = s.isBuildCompleted(ctx); err != nil {
+				s.L.Error(err, "Cannot retrieve build")
+				return
+			}
+		}
+
+		cm := corev1.ConfigMap{}
+		err := s.client.Get(ctx, ctrl.ObjectKey{Namespace: s.namespace, Name: BuildLogName(s.buildName)}, &cm)
+		if err != nil && !k8serrors.IsNotFound(err) {
+			s.L.Error(err, "Cannot retrieve build log")
+			return
+		}
+		if err == nil {
+			content := cm.Data[BuildLogKey]
+			size := buildLogSize(&cm)
+			start := size - int64(len(content))
+			if offset > size {
+				// The log has been recreated
+				offset = 0
+			}
+			if offset < start {
+				if _, err := out.WriteString(buildLogTruncatedMsg); err != nil {
+					s.L.Error(err, "Cannot write to output")
+					return
+				}
+				offset = start
+			}
+			if offset < size {
+				if _, err := out.WriteString(content[offset-start:]); err != nil {
+					s.L.Error(err, "Cannot write to output")
+					return
+				}
+				offset = size
+			}
+			out.Flush()
+		}
+
+		if completed {
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(buildLogPollPeriod):
+		}
+	}
+}
+
+func (s *BuildLogScraper) isBuildCompleted(ctx context.Context) (bool, error) {
+	build := v1.NewBuild(s.namespace, s.buildName)
+	if err := s.client.Get(ctx, ctrl.ObjectKey{Namespace: s.namespace, Name: s.buildName}, &build); err != nil {
+		if k8serrors.IsNotFound(err) {
+			return true, nil
+		}
+		return false, err
+	}
+
+	switch build.Status.Phase {
+	case v1.BuildPhaseSucceeded, v1.BuildPhaseError, v1.BuildPhaseFailed, v1.BuildPhaseInterrupted:
+		return true, nil
+	default:
+		return false, nil
+	}
+}
+
+// PrintBuildLog prints the log of a build, following it until the build completes if requested
+func PrintBuildLog(ctx context.Context, c ctrl.Reader, namespace string, buildName string, follow bool, out io.Writer) error {
+	if !follow {
+		cm := corev1.ConfigMap{}
+		err := c.Get(ctx, ctrl.ObjectKey{Namespace: namespace, Name: BuildLogName(buildName)}, &cm)
+		if err != nil && k8serrors.IsNotFound(err) {
+			return fmt.Errorf("no log found for build %q", buildName)
+		} else if err != nil {
+			return err
+		}
+	}
+
+	scraper := NewBuildLogScraper(c, namespace, buildName, follow)
+	reader := scraper.Start(ctx)
+
+	_, err := io.Copy(out, ioutil.NopCloser(reader))
+	return err
+}
@@ -0,0 +1,124 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package log
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	corev1 "k8s.io/api/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/util/test"
+)
+
+func TestBuildLogWriter(t *testing.T) {
+	build := v1.NewBuild("ns", "build")
+	build.Status.Phase = v1.BuildPhaseSucceeded
+
+	c, err := test.NewFakeClient(&build)
+	assert.Nil(t, err)
+
+	w, err := NewBuildLogWriter(context.TODO(), c, &build)
+	assert.Nil(t, err)
+	_, err = w.Write([]byte("first line\n"))
+	assert.Nil(t, err)
+	assert.Nil(t, w.Close())
+
+	// Writers append to the existing log
+	w, err = NewBuildLogWriter(context.TODO(), c, &build)
+	assert.Nil(t, err)
+	_, err = w.Write([]byte("second line\n"))
+	assert.Nil(t, err)
+	assert.Nil(t, w.Close())
+
+	cm := corev1.ConfigMap{}
+	err = c.Get(context.TODO(), ctrl.ObjectKey{Namespace: "ns", Name: "build-log"}, &cm)
+	assert.Nil(t, err)
+	assert.Equal(t, "first line\nsecond line\n", cm.Data[BuildLogKey])
+	assert.Equal(t, "23", cm.Annotations[BuildLogSizeAnnotation])
+	assert.Equal(t, "build", cm.OwnerReferences[0].Name)
+
+	out := bytes.Buffer{}
+	assert.Nil(t, PrintBuildLog(context.TODO(), c, "ns", "build", true, &out))
+	assert.Equal(t, "first line\nsecond line\n", out.String())
+}
+
+func TestBuildLogWriterContainerLogs(t *testing.T) {
+	build := v1.NewBuild("ns", "build")
+
+	c, err := test.NewFakeClient(&build)
+	assert.Nil(t, err)
+
+	// The log of a container is appended once, even by different writers
+	for i := 0; i < 2; i++ {
+		w, err := NewBuildLogWriter(context.TODO(), c, &build)
+		assert.Nil(t, err)
+		assert.Equal(t, i > 0, w.HasContainerLog("kaniko"))
+		w.WriteContainerLog("kaniko", []byte("kaniko line\n"))
+		w.WriteContainerLog("kaniko", []byte("kaniko line\n"))
+		assert.Nil(t, w.Close())
+	}
+
+	cm := corev1.ConfigMap{}
+	err = c.Get(context.TODO(), ctrl.ObjectKey{Namespace: "ns", Name: "build-log"}, &cm)
+	assert.Nil(t, err)
+	assert.Equal(t, "kaniko line\n", cm.Data[BuildLogKey])
+	assert.Equal(t, "kaniko", cm.Annotations[BuildLogContainersAnnotation])
+}
+
+func TestBuildLogWriterTruncation(t *testing.T) {
+	build := v1.NewBuild("ns", "build")
+
+	c, err := test.NewFakeClient(&build)
+	assert.Nil(t, err)
+
+	w, err := NewBuildLogWriter(context.TODO(), c, &build)
+	assert.Nil(t, err)
+	line := strings.Repeat("x", 1023) + "\n"
+	for i := 0; i < BuildLogMaxSize/len(line)+2; i++ {
+		_, err = w.Write([]byte(line))
+		assert.Nil(t, err)
+	}
+	_, err = w.Write([]byte("last line\n"))
+	assert.Nil(t, err)
+	assert.Nil(t, w.Close())
+
+	out := bytes.Buffer{}
+	assert.Nil(t, PrintBuildLog(context.TODO(), c, "ns", "build", false, &out))
+	assert.LessOrEqual(t, out.Len(), BuildLogMaxSize+len(buildLogTruncatedMsg))
+	// The oldest lines are dropped as a whole
+	assert.True(t, strings.HasPrefix(out.String(), buildLogTruncatedMsg+line))
+	assert.True(t, strings.HasSuffix(out.String(), line+"last line\n"))
+}
+
+func TestPrintMissingBuildLog(t *testing.T) {
+	c, err := test.NewFakeClient()
+	assert.Nil(t, err)
+
+	err = PrintBuildLog(context.TODO(), c, "ns", "build", false, &bytes.Buffer{})
+	assert.EqualError(t, err, `no log found for build "build"`)
+
+	// A missing build is deemed completed when following its log
+	assert.Nil(t, PrintBuildLog(context.TODO(), c, "ns", "build", true, &bytes.Buffer{}))
+}
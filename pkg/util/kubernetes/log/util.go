@@ -22,10 +22,15 @@ import (
 	"fmt"
 	"io"
 	"io/ioutil"
+	"time"
 
 	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
 
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/kubernetes"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
 )
 
 // Print prints integrations logs to the stdout
@@ -44,3 +49,61 @@ func PrintUsingSelector(ctx context.Context, client kubernetes.Interface, namesp
 
 	return nil
 }
+
+// PrintPodContainers prints the logs of all the containers of a pod, init containers first,
+// following each container logs until it terminates
+func PrintPodContainers(ctx context.Context, client kubernetes.Interface, namespace, podName string, out io.Writer) error {
+	pods := client.CoreV1().Pods(namespace)
+	pod, err := pods.Get(ctx, podName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	containers := make([]corev1.Container, 0, len(pod.Spec.InitContainers)+len(pod.Spec.Containers))
+	containers = append(containers, pod.Spec.InitContainers...)
+	containers = append(containers, pod.Spec.Containers...)
+	for _, container := range containers {
+		started, err := waitForContainerStarted(ctx, pods, podName, container.Name)
+		if err != nil {
+			return err
+		}
+		if !started {
+			// The pod has completed before the container could start
+			break
+		}
+		stream, err := pods.GetLogs(podName, &corev1.PodLogOptions{Container: container.Name, Follow: true}).Stream(ctx)
+		if err != nil {
+			return err
+		}
+		_, err = io.Copy(out, stream)
+		stream.Close()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// waitForContainerStarted waits for a pod container to start, and returns false if the pod completes before
+func waitForContainerStarted(ctx context.Context, pods typedcorev1.PodInterface, podName string, containerName string) (bool, error) {
+	started := false
+	err := wait.PollImmediateUntil(time.Second, func() (bool, error) {
+		pod, err := pods.Get(ctx, podName, metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+		statuses := make([]corev1.ContainerStatus, 0, len(pod.Status.InitContainerStatuses)+len(pod.Status.ContainerStatuses))
+		statuses = append(statuses, pod.Status.InitContainerStatuses...)
+		statuses = append(statuses, pod.Status.ContainerStatuses...)
+		for _, status := range statuses {
+			if status.Name == containerName && (status.State.Running != nil || status.State.Terminated != nil) {
+				started = true
+				return true, nil
+			}
+		}
+		return pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed, nil
+	}, ctx.Done())
+
+	return started, err
+}
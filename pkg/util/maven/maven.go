@@ -115,12 +115,21 @@ func Run(ctx Context) error {
 		timeout = math.MaxInt64
 	}
 
-	c, cancel := context.WithTimeout(context.Background(), timeout)
+	parent := ctx.Parent
+	if parent == nil {
+		parent = context.Background()
+	}
+
+	c, cancel := context.WithTimeout(parent, timeout)
 	defer cancel()
 
 	cmd := exec.CommandContext(c, mvnCmd, args...)
 	cmd.Dir = ctx.Path
-	cmd.Stderr = os.Stderr
+	if ctx.Stderr != nil {
+		cmd.Stderr = ctx.Stderr
+	} else {
+		cmd.Stderr = os.Stderr
+	}
 	if ctx.Stdout != nil {
 		cmd.Stdout = ctx.Stdout
 	} else {
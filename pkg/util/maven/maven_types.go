@@ -19,6 +19,7 @@ package maven
 
 import (
 	"bytes"
+	"context"
 	"encoding/xml"
 	"fmt"
 	"io"
@@ -118,6 +119,9 @@ type Context struct {
 	Timeout             time.Duration
 	LocalRepository     string
 	Stdout              io.Writer
+	Stderr              io.Writer
+	// Parent is the context of the Maven execution, that kills the Maven process when done
+	Parent context.Context
 }
 
 // AddEntry --
@@ -0,0 +1,87 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package reference parses the textual representation of Kubernetes object references used by the CLI
+package reference
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/apache/camel-k/pkg/apis/camel/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var (
+	simpleNameRegexp = regexp.MustCompile(`^[a-z0-9-.]+$`)
+	fullNameRegexp   = regexp.MustCompile(`^(?:((?:[a-z0-9-.]+/)?[a-z0-9-.]+):)?([A-Za-z0-9-.]+):(?:([a-z0-9-]+)/)?([a-z0-9-.]+)$`)
+	versionRegexp    = regexp.MustCompile(`^v\d+((alpha|beta)\d+)?$`)
+
+	// KnownKinds contains the kinds that can be referenced using a short alias, without specifying the apiVersion
+	KnownKinds = map[string]schema.GroupVersionKind{
+		"kamelet":         v1alpha1.SchemeGroupVersion.WithKind(v1alpha1.KameletKind),
+		"channel":         {Group: "messaging.knative.dev", Version: "v1", Kind: "Channel"},
+		"inmemorychannel": {Group: "messaging.knative.dev", Version: "v1", Kind: "InMemoryChannel"},
+		"kafkachannel":    {Group: "messaging.knative.dev", Version: "v1beta1", Kind: "KafkaChannel"},
+		"broker":          {Group: "eventing.knative.dev", Version: "v1", Kind: "Broker"},
+		"ksvc":            {Group: "serving.knative.dev", Version: "v1", Kind: "Service"},
+	}
+)
+
+// FromString converts a string in the format "[[apigroup/]version:]kind:[namespace/]name" into an object reference.
+// A plain name is considered a reference to a Kamelet, while the kind can be omitted only for the aliases listed in KnownKinds.
+// It returns false if the string does not represent a reference, e.g. when it's a Camel URI.
+func FromString(str string) (corev1.ObjectReference, bool) {
+	if simpleNameRegexp.MatchString(str) {
+		return corev1.ObjectReference{
+			APIVersion: v1alpha1.SchemeGroupVersion.String(),
+			Kind:       v1alpha1.KameletKind,
+			Name:       str,
+		}, true
+	}
+
+	match := fullNameRegexp.FindStringSubmatch(str)
+	if match == nil {
+		return corev1.ObjectReference{}, false
+	}
+	apiVersion, kind, namespace, name := match[1], match[2], match[3], match[4]
+	if apiVersion != "" && !strings.Contains(apiVersion, "/") && !versionRegexp.MatchString(apiVersion) {
+		// the prefix is neither a group/version nor a version, e.g. "jms:queue:orders" is a Camel URI
+		return corev1.ObjectReference{}, false
+	}
+
+	if known, ok := KnownKinds[strings.ToLower(kind)]; ok {
+		kind = known.Kind
+		if apiVersion == "" {
+			apiVersion = known.GroupVersion().String()
+		} else if !strings.Contains(apiVersion, "/") {
+			// only the version has been specified
+			apiVersion = schema.GroupVersion{Group: known.Group, Version: apiVersion}.String()
+		}
+	} else if apiVersion == "" {
+		// unknown kinds require the apiVersion, otherwise they cannot be distinguished from Camel URIs
+		return corev1.ObjectReference{}, false
+	}
+
+	return corev1.ObjectReference{
+		APIVersion: apiVersion,
+		Kind:       kind,
+		Namespace:  namespace,
+		Name:       name,
+	}, true
+}
@@ -0,0 +1,119 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reference
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestFromString(t *testing.T) {
+	tests := []struct {
+		str      string
+		error    bool
+		expected corev1.ObjectReference
+	}{
+		{
+			str: "timer-source",
+			expected: corev1.ObjectReference{
+				APIVersion: "camel.apache.org/v1alpha1",
+				Kind:       "Kamelet",
+				Name:       "timer-source",
+			},
+		},
+		{
+			str: "kamelet:timer-source",
+			expected: corev1.ObjectReference{
+				APIVersion: "camel.apache.org/v1alpha1",
+				Kind:       "Kamelet",
+				Name:       "timer-source",
+			},
+		},
+		{
+			str: "channel:foo",
+			expected: corev1.ObjectReference{
+				APIVersion: "messaging.knative.dev/v1",
+				Kind:       "Channel",
+				Name:       "foo",
+			},
+		},
+		{
+			str: "broker:default",
+			expected: corev1.ObjectReference{
+				APIVersion: "eventing.knative.dev/v1",
+				Kind:       "Broker",
+				Name:       "default",
+			},
+		},
+		{
+			str: "v1beta1:broker:default",
+			expected: corev1.ObjectReference{
+				APIVersion: "eventing.knative.dev/v1beta1",
+				Kind:       "Broker",
+				Name:       "default",
+			},
+		},
+		{
+			str: "messaging.knative.dev/v1alpha1:NatssChannel:ns1/foo",
+			expected: corev1.ObjectReference{
+				APIVersion: "messaging.knative.dev/v1alpha1",
+				Kind:       "NatssChannel",
+				Namespace:  "ns1",
+				Name:       "foo",
+			},
+		},
+		{
+			str:   "timer:tick",
+			error: true,
+		},
+		{
+			str:   "log:info?showAll=true",
+			error: true,
+		},
+		{
+			str:   "https://myurl/hey",
+			error: true,
+		},
+		{
+			str:   "jms:queue:orders",
+			error: true,
+		},
+		{
+			str:   "activemq:topic:foo",
+			error: true,
+		},
+		{
+			str:   "knative:channel:foo",
+			error: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.str, func(t *testing.T) {
+			ref, ok := FromString(tc.str)
+			if tc.error {
+				assert.False(t, ok)
+			} else {
+				assert.True(t, ok)
+				assert.Equal(t, tc.expected, ref)
+			}
+		})
+	}
+}
@@ -62,6 +62,14 @@ func (i YAMLInspector) parseStep(key string, content interface{}, meta *Metadata
 		meta.RequiredCapabilities.Add(v1.CapabilityRest)
 	case "circuitBreaker":
 		meta.RequiredCapabilities.Add(v1.CapabilityCircuitBreaker)
+	case "error-handler":
+		if cm, ok := content.(map[interface{}]interface{}); ok {
+			if dlc, dlcOk := cm["dead-letter-channel"].(map[interface{}]interface{}); dlcOk {
+				if deadLetterURI, uriOk := dlc["dead-letter-uri"].(string); uriOk {
+					meta.ToURIs = append(meta.ToURIs, deadLetterURI)
+				}
+			}
+		}
 	case "unmarshal":
 		fallthrough
 	case "marshal":
@@ -62,6 +62,17 @@ const YAMLRouteTransformer = `
           uri: knative:endpoint/service
 `
 
+const YAMLRouteWithDeadLetterChannel = `
+- error-handler:
+    dead-letter-channel:
+      dead-letter-uri: knative:endpoint/service
+- from:
+    uri: timer:tick
+    steps:
+      - to:
+          uri: "log:out"
+`
+
 func TestYAMLDependencies(t *testing.T) {
 	tests := []struct {
 		name                string
@@ -89,6 +100,12 @@ func TestYAMLDependencies(t *testing.T) {
 				`mvn:org.apache.camel.k:camel-k-knative-consumer`,
 			},
 		},
+		{
+			name:                "dead-letter-channel",
+			source:              YAMLRouteWithDeadLetterChannel,
+			dependencies:        []string{`mvn:org.apache.camel.k:camel-k-knative-producer`},
+			missingDependencies: []string{`mvn:org.apache.camel.k:camel-k-knative-consumer`},
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
@@ -0,0 +1,204 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tar
+
+import (
+	"archive/tar"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Directory writes a tar archive of the content of the given directory, with paths relative to that directory
+func Directory(dir string, out io.Writer) error {
+	tw := tar.NewWriter(out)
+
+	err := filepath.Walk(dir, func(filePath string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		relPath, err := filepath.Rel(dir, filePath)
+		if err != nil {
+			return err
+		}
+		if relPath == "." {
+			return nil
+		}
+
+		link := ""
+		if info.Mode()&os.ModeSymlink != 0 {
+			if link, err = os.Readlink(filePath); err != nil {
+				return err
+			}
+		}
+		header, err := tar.FileInfoHeader(info, link)
+		if err != nil {
+			return err
+		}
+		header.Name = filepath.ToSlash(relPath)
+		if err := tw.WriteHeader(header); err != nil {
+			return err
+		}
+
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+		file, err := os.Open(filePath)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		_, err = io.Copy(tw, file)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	return tw.Close()
+}
+
+// Extract extracts a tar archive into the given directory. Entries, and links, that resolve outside of the directory are rejected.
+func Extract(in io.Reader, dir string) error {
+	dir = filepath.Clean(dir)
+	// The directory itself may be reached through links
+	root, err := filepath.EvalSymlinks(dir)
+	if err != nil {
+		root = dir
+	}
+
+	tr := tar.NewReader(in)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		target := filepath.Join(dir, filepath.FromSlash(header.Name))
+		// Do not let the archive write outside of the directory
+		if !isWithin(dir, target) {
+			return fmt.Errorf("invalid archive entry: %s", header.Name)
+		}
+		name, err := filepath.Rel(dir, target)
+		if err != nil {
+			return err
+		}
+		// Resolve the parent directory through the links extracted so far, before creating anything
+		parent, err := resolve(root, filepath.Dir(name))
+		if err != nil {
+			return err
+		}
+		if !isWithin(root, parent) {
+			return fmt.Errorf("invalid archive entry: %s resolves outside of the directory", header.Name)
+		}
+		target = filepath.Join(parent, filepath.Base(name))
+
+		mode := os.FileMode(header.Mode).Perm()
+		switch header.Typeflag {
+		case tar.TypeDir:
+			resolved, err := resolve(parent, filepath.Base(name))
+			if err != nil {
+				return err
+			}
+			if !isWithin(root, resolved) {
+				return fmt.Errorf("invalid archive entry: %s resolves outside of the directory", header.Name)
+			}
+			if err := os.MkdirAll(resolved, mode|0700); err != nil {
+				return err
+			}
+		case tar.TypeSymlink:
+			// Links must not let the following entries write outside of the directory
+			link := filepath.FromSlash(header.Linkname)
+			if filepath.IsAbs(link) {
+				return fmt.Errorf("invalid archive entry: %s: link %s points outside of the directory", header.Name, header.Linkname)
+			}
+			resolved, err := resolve(parent, link)
+			if err != nil {
+				return err
+			}
+			if !isWithin(root, resolved) {
+				return fmt.Errorf("invalid archive entry: %s: link %s points outside of the directory", header.Name, header.Linkname)
+			}
+			if err := os.MkdirAll(parent, 0755); err != nil {
+				return err
+			}
+			if err := os.Symlink(link, target); err != nil {
+				return err
+			}
+		case tar.TypeReg:
+			if err := os.MkdirAll(parent, 0755); err != nil {
+				return err
+			}
+			if err := extractFile(tr, target, mode); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// isWithin tells whether the path is the directory or one of its descendants
+func isWithin(dir string, path string) bool {
+	return path == dir || strings.HasPrefix(path, dir+string(os.PathSeparator))
+}
+
+// resolve walks the relative path from the given resolved directory, one element at a time, following the existing links.
+// Elements that do not exist yet are kept as is.
+func resolve(dir string, path string) (string, error) {
+	resolved := dir
+	for _, element := range strings.Split(path, string(os.PathSeparator)) {
+		switch element {
+		case "", ".":
+			continue
+		case "..":
+			resolved = filepath.Dir(resolved)
+			continue
+		}
+		next := filepath.Join(resolved, element)
+		target, err := filepath.EvalSymlinks(next)
+		if err == nil {
+			next = target
+		} else if !os.IsNotExist(err) {
+			return "", err
+		}
+		resolved = next
+	}
+	return resolved, nil
+}
+
+func extractFile(in io.Reader, target string, mode os.FileMode) error {
+	// Do not write through an existing link
+	if info, err := os.Lstat(target); err == nil && info.Mode()&os.ModeSymlink != 0 {
+		if err := os.Remove(target); err != nil {
+			return err
+		}
+	}
+
+	file, err := os.OpenFile(target, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, mode)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	_, err = io.Copy(file, in)
+	return err
+}
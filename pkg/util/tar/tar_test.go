@@ -0,0 +1,142 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tar
+
+import (
+	"archive/tar"
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDirectory(t *testing.T) {
+	source, err := ioutil.TempDir("", "camel-k-tar-")
+	assert.Nil(t, err)
+	defer os.RemoveAll(source)
+
+	assert.Nil(t, os.MkdirAll(path.Join(source, "maven", "target"), 0755))
+	assert.Nil(t, ioutil.WriteFile(path.Join(source, "maven", "pom.xml"), []byte("<project/>"), 0644))
+	assert.Nil(t, ioutil.WriteFile(path.Join(source, "maven", "target", "runner"), []byte("binary"), 0755))
+	assert.Nil(t, os.Symlink("../pom.xml", path.Join(source, "maven", "target", "pom.xml")))
+
+	archive := bytes.Buffer{}
+	assert.Nil(t, Directory(source, &archive))
+
+	target, err := ioutil.TempDir("", "camel-k-tar-")
+	assert.Nil(t, err)
+	defer os.RemoveAll(target)
+
+	assert.Nil(t, Extract(&archive, target))
+
+	content, err := ioutil.ReadFile(path.Join(target, "maven", "pom.xml"))
+	assert.Nil(t, err)
+	assert.Equal(t, "<project/>", string(content))
+
+	info, err := os.Stat(path.Join(target, "maven", "target", "runner"))
+	assert.Nil(t, err)
+	assert.Equal(t, os.FileMode(0755), info.Mode().Perm())
+
+	// Links within the directory are preserved
+	content, err = ioutil.ReadFile(path.Join(target, "maven", "target", "pom.xml"))
+	assert.Nil(t, err)
+	assert.Equal(t, "<project/>", string(content))
+}
+
+func TestExtractOutsideDirectory(t *testing.T) {
+	archive := bytes.Buffer{}
+	tw := tar.NewWriter(&archive)
+	assert.Nil(t, tw.WriteHeader(&tar.Header{Name: "../escape", Typeflag: tar.TypeReg, Mode: 0644}))
+	assert.Nil(t, tw.Close())
+
+	target, err := ioutil.TempDir("", "camel-k-tar-")
+	assert.Nil(t, err)
+	defer os.RemoveAll(target)
+
+	assert.EqualError(t, Extract(&archive, target), "invalid archive entry: ../escape")
+}
+
+func TestExtractLinksOutsideDirectory(t *testing.T) {
+	outside, err := ioutil.TempDir("", "camel-k-tar-")
+	assert.Nil(t, err)
+	defer os.RemoveAll(outside)
+
+	for _, link := range []string{"../" + path.Base(outside), outside} {
+		archive := bytes.Buffer{}
+		tw := tar.NewWriter(&archive)
+		assert.Nil(t, tw.WriteHeader(&tar.Header{Name: "link", Typeflag: tar.TypeSymlink, Linkname: link}))
+		assert.Nil(t, tw.WriteHeader(&tar.Header{Name: "link/pwned", Typeflag: tar.TypeReg, Mode: 0644}))
+		assert.Nil(t, tw.Close())
+
+		target, err := ioutil.TempDir("", "camel-k-tar-")
+		assert.Nil(t, err)
+		defer os.RemoveAll(target)
+
+		assert.EqualError(t, Extract(&archive, target), "invalid archive entry: link: link "+link+" points outside of the directory")
+		_, err = os.Stat(path.Join(outside, "pwned"))
+		assert.True(t, os.IsNotExist(err))
+	}
+
+	// Links already present in the directory are not followed outside of it
+	target, err := ioutil.TempDir("", "camel-k-tar-")
+	assert.Nil(t, err)
+	defer os.RemoveAll(target)
+	assert.Nil(t, os.Symlink(outside, path.Join(target, "link")))
+
+	archive := bytes.Buffer{}
+	tw := tar.NewWriter(&archive)
+	assert.Nil(t, tw.WriteHeader(&tar.Header{Name: "link/pwned", Typeflag: tar.TypeReg, Mode: 0644}))
+	assert.Nil(t, tw.Close())
+
+	assert.Error(t, Extract(&archive, target))
+	_, err = os.Stat(path.Join(outside, "pwned"))
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestExtractChainedLinksOutsideDirectory(t *testing.T) {
+	parent, err := ioutil.TempDir("", "camel-k-tar-")
+	assert.Nil(t, err)
+	defer os.RemoveAll(parent)
+	target := path.Join(parent, "target")
+	assert.Nil(t, os.Mkdir(target, 0755))
+
+	archive := bytes.Buffer{}
+	tw := tar.NewWriter(&archive)
+	assert.Nil(t, tw.WriteHeader(&tar.Header{Name: "s1", Typeflag: tar.TypeSymlink, Linkname: "."}))
+	assert.Nil(t, tw.WriteHeader(&tar.Header{Name: "s1/s2", Typeflag: tar.TypeSymlink, Linkname: "../escaped"}))
+	assert.Nil(t, tw.WriteHeader(&tar.Header{Name: "s1/s2/newdir/sub/", Typeflag: tar.TypeDir, Mode: 0755}))
+	assert.Nil(t, tw.Close())
+
+	assert.EqualError(t, Extract(&archive, target), "invalid archive entry: s1/s2: link ../escaped points outside of the directory")
+	_, err = os.Stat(path.Join(parent, "escaped"))
+	assert.True(t, os.IsNotExist(err))
+
+	// Entries resolving outside of the directory through existing links are rejected before anything is created
+	assert.Nil(t, os.Symlink("..", path.Join(target, "s2")))
+	archive = bytes.Buffer{}
+	tw = tar.NewWriter(&archive)
+	assert.Nil(t, tw.WriteHeader(&tar.Header{Name: "s2/escaped/newdir/sub/", Typeflag: tar.TypeDir, Mode: 0755}))
+	assert.Nil(t, tw.Close())
+
+	assert.EqualError(t, Extract(&archive, target), "invalid archive entry: s2/escaped/newdir/sub/ resolves outside of the directory")
+	_, err = os.Stat(path.Join(parent, "escaped"))
+	assert.True(t, os.IsNotExist(err))
+}
@@ -50,6 +50,9 @@ const DefaultPropertiesDirectoryName = "properties"
 // DefaultRoutesDirectoryName --
 const DefaultRoutesDirectoryName = "routes"
 
+// DefaultKameletsDirectoryName --
+const DefaultKameletsDirectoryName = "kamelets"
+
 // DefaultWorkingDirectoryName --
 const DefaultWorkingDirectoryName = "workspace"
 
@@ -193,7 +196,8 @@ func CopyFile(src, dst string) (int64, error) {
 		return 0, err
 	}
 
-	destination, err := os.Create(dst)
+	// Preserve the permissions, e.g., for executables
+	destination, err := os.OpenFile(dst, os.O_RDWR|os.O_CREATE|os.O_TRUNC, sourceFileStat.Mode().Perm())
 	if err != nil {
 		return 0, err
 	}
@@ -395,6 +399,11 @@ func GetLocalRoutesDir() string {
 	return path.Join(MavenWorkingDirectory, DefaultRoutesDirectoryName)
 }
 
+// GetLocalKameletsDir -- <mavenWorkingDirectory>/kamelets
+func GetLocalKameletsDir() string {
+	return path.Join(MavenWorkingDirectory, DefaultKameletsDirectoryName)
+}
+
 // CreateLocalPropertiesDirectory --
 func CreateLocalPropertiesDirectory() error {
 	// Do not create a directory unless the maven directory contains a valid value.
@@ -458,6 +467,27 @@ func CreateLocalRoutesDirectory() error {
 	return nil
 }
 
+// CreateLocalKameletsDirectory --
+func CreateLocalKameletsDirectory() error {
+	// Do not create a directory unless the maven directory contains a valid value.
+	if MavenWorkingDirectory == "" {
+		return nil
+	}
+
+	directoryExists, err := DirectoryExists(GetLocalKameletsDir())
+	if err != nil {
+		return err
+	}
+
+	if !directoryExists {
+		err := os.MkdirAll(GetLocalKameletsDir(), 0777)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // GetEnvironmentVariable --
 func GetEnvironmentVariable(variable string) (string, error) {
 	value, isPresent := os.LookupEnv(variable)
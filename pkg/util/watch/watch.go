@@ -110,6 +110,92 @@ func HandleIntegrationStateChanges(ctx context.Context, integration *v1.Integrat
 	}
 }
 
+//
+// HandleBuildStepChanges watches the builds matching the given label selector and invokes the given handler
+// each time one of their steps starts or completes.
+//
+//     err := watch.HandleBuildStepChanges(ctx, namespace, selector, func(b *v1.Build, step *v1.BuildStepStatus) bool {
+//         println(step.ID, step.Result)
+//         return true
+//     })
+//
+// The builds that have already completed when they are first observed are ignored.
+// This function blocks until the handler function returns false or either the events channel or the context is closed.
+//
+func HandleBuildStepChanges(ctx context.Context, namespace string, selector string,
+	handler func(build *v1.Build, step *v1.BuildStepStatus) bool) error {
+	dynamicClient, err := customclient.GetDefaultDynamicClientFor("builds", namespace)
+	if err != nil {
+		return err
+	}
+
+	watcher, err := dynamicClient.Watch(ctx, metav1.ListOptions{
+		LabelSelector: selector,
+	})
+	if err != nil {
+		return err
+	}
+
+	defer watcher.Stop()
+	events := watcher.ResultChan()
+
+	// The last observed result of the steps, by build
+	observed := make(map[string]map[string]v1.BuildPhase)
+
+	var handlerWrapper = func(b *v1.Build) bool {
+		steps, ok := observed[b.Name]
+		if !ok {
+			switch b.Status.Phase {
+			case v1.BuildPhaseSucceeded, v1.BuildPhaseError, v1.BuildPhaseFailed, v1.BuildPhaseInterrupted:
+				return true
+			}
+			steps = make(map[string]v1.BuildPhase)
+			observed[b.Name] = steps
+		}
+		for i := range b.Status.Steps {
+			step := &b.Status.Steps[i]
+			if result, ok := steps[step.ID]; ok && result == step.Result {
+				continue
+			}
+			steps[step.ID] = step.Result
+			if !handler(b, step) {
+				return false
+			}
+		}
+		return true
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case e, ok := <-events:
+			if !ok {
+				return nil
+			}
+
+			if e.Object != nil {
+				if runtimeUnstructured, ok := e.Object.(runtime.Unstructured); ok {
+					jsondata, err := kubernetes.ToJSON(runtimeUnstructured)
+					if err != nil {
+						return err
+					}
+					b := v1.Build{}
+					err = json.Unmarshal(jsondata, &b)
+					if err != nil {
+						log.Error(err, "Unexpected error detected when watching resource")
+						return nil
+					}
+
+					if !handlerWrapper(&b) {
+						return nil
+					}
+				}
+			}
+		}
+	}
+}
+
 //
 // HandleIntegrationEvents watches all events related to the given integration.
 //